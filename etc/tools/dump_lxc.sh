@@ -142,6 +142,9 @@ do_cmds_ribx() {
     ech "ribsc -a 127.0.0.1:50073"
     ribsc
 
+    ech "bfdc session show"
+    bfdc session show
+
     ech "journalctl -t ribcd"
     journalctl -t ribcd
 }
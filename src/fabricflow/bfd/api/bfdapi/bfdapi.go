@@ -0,0 +1,146 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdapi
+
+import (
+	"fabricflow/bfd/pkgs/bfdlib"
+	"fmt"
+	"net"
+	"time"
+)
+
+//
+// NewSessionKey returns new SessionKey.
+//
+func NewSessionKey(peer net.IP, ifname string, multihop bool) *SessionKey {
+	return NewSessionKeyFromNative(bfdlib.NewSessionKey(peer, ifname, multihop))
+}
+
+//
+// NewSessionKeyFromNative returns new SessionKey.
+//
+func NewSessionKeyFromNative(n bfdlib.SessionKey) *SessionKey {
+	return &SessionKey{
+		Peer:     n.Peer,
+		Ifname:   n.Ifname,
+		Multihop: n.MultiHop,
+	}
+}
+
+//
+// ToNative converts to bfdlib.SessionKey.
+//
+func (k *SessionKey) ToNative() (bfdlib.SessionKey, error) {
+	peer := net.ParseIP(k.Peer)
+	if peer == nil {
+		return bfdlib.SessionKey{}, fmt.Errorf("invalid peer. '%s'", k.Peer)
+	}
+
+	return bfdlib.NewSessionKey(peer, k.Ifname, k.Multihop), nil
+}
+
+//
+// NewSessionConfigFromNative returns new SessionConfig.
+//
+func NewSessionConfigFromNative(n *bfdlib.SessionConfig) *SessionConfig {
+	local := ""
+	if n.Local != nil {
+		local = n.Local.String()
+	}
+
+	return &SessionConfig{
+		Key:           NewSessionKeyFromNative(n.Key()),
+		Local:         local,
+		DesiredMinTx:  uint32(n.DesiredMinTx / time.Microsecond),
+		RequiredMinRx: uint32(n.RequiredMinRx / time.Microsecond),
+		DetectMult:    uint32(n.DetectMult),
+		MinTtl:        uint32(n.MinTTL),
+	}
+}
+
+//
+// ToNative converts to bfdlib.SessionConfig.
+//
+func (c *SessionConfig) ToNative() (*bfdlib.SessionConfig, error) {
+	if c.Key == nil {
+		return nil, fmt.Errorf("session key not specified.")
+	}
+
+	peer := net.ParseIP(c.Key.Peer)
+	if peer == nil {
+		return nil, fmt.Errorf("invalid peer. '%s'", c.Key.Peer)
+	}
+
+	var local net.IP
+	if len(c.Local) != 0 {
+		if local = net.ParseIP(c.Local); local == nil {
+			return nil, fmt.Errorf("invalid local. '%s'", c.Local)
+		}
+	}
+
+	if c.DetectMult > 255 || c.MinTtl > 255 {
+		return nil, fmt.Errorf("invalid detect mult or min ttl. %d %d", c.DetectMult, c.MinTtl)
+	}
+
+	return &bfdlib.SessionConfig{
+		Peer:          peer,
+		Local:         local,
+		Ifname:        c.Key.Ifname,
+		MultiHop:      c.Key.Multihop,
+		DesiredMinTx:  time.Duration(c.DesiredMinTx) * time.Microsecond,
+		RequiredMinRx: time.Duration(c.RequiredMinRx) * time.Microsecond,
+		DetectMult:    uint8(c.DetectMult),
+		MinTTL:        uint8(c.MinTtl),
+	}, nil
+}
+
+//
+// NewSessionFromNative returns new Session.
+//
+func NewSessionFromNative(n *bfdlib.SessionStatus) *Session {
+	return &Session{
+		Config:      NewSessionConfigFromNative(&n.Config),
+		State:       Session_State(n.State),
+		RemoteState: Session_State(n.RemoteState),
+		Diag:        Session_Diag(n.Diag),
+		LocalDisc:   n.LocalDisc,
+		RemoteDisc:  n.RemoteDisc,
+		TxInterval:  uint32(n.TxInterval / time.Microsecond),
+		DetectTime:  uint32(n.DetectTime / time.Microsecond),
+		TxPackets:   n.TxPackets,
+		RxPackets:   n.RxPackets,
+		UpCount:     n.UpCount,
+		LastChange:  n.LastChange.Unix(),
+	}
+}
+
+//
+// GetPeerIP returns peer address.
+//
+func (s *Session) GetPeerIP() net.IP {
+	return net.ParseIP(s.GetConfig().GetKey().GetPeer())
+}
+
+//
+// IsPathDown returns true if forwarding path to peer has failed.
+// Sessions which have never been up and sessions which remote system
+// set to AdminDown are not considered as failure (RFC5882 3.2).
+//
+func (s *Session) IsPathDown() bool {
+	return s.State == Session_DOWN && s.UpCount > 0 && s.RemoteState != Session_ADMIN_DOWN
+}
@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: bfdapi.proto
+
+package bfdapi
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Session_State int32
+
+const (
+	Session_ADMIN_DOWN Session_State = 0
+	Session_DOWN       Session_State = 1
+	Session_INIT       Session_State = 2
+	Session_UP         Session_State = 3
+)
+
+var Session_State_name = map[int32]string{
+	0: "ADMIN_DOWN",
+	1: "DOWN",
+	2: "INIT",
+	3: "UP",
+}
+
+var Session_State_value = map[string]int32{
+	"ADMIN_DOWN": 0,
+	"DOWN":       1,
+	"INIT":       2,
+	"UP":         3,
+}
+
+func (x Session_State) String() string {
+	return proto.EnumName(Session_State_name, int32(x))
+}
+
+func (Session_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{2, 0}
+}
+
+type Session_Diag int32
+
+const (
+	Session_NO_DIAG              Session_Diag = 0
+	Session_CTRL_DETECT_EXPIRED  Session_Diag = 1
+	Session_ECHO_FAILED          Session_Diag = 2
+	Session_NEIGHBOR_DOWN        Session_Diag = 3
+	Session_FWD_PLANE_RESET      Session_Diag = 4
+	Session_PATH_DOWN            Session_Diag = 5
+	Session_CONCAT_PATH_DOWN     Session_Diag = 6
+	Session_ADMIN_DOWN_DIAG      Session_Diag = 7
+	Session_REV_CONCAT_PATH_DOWN Session_Diag = 8
+)
+
+var Session_Diag_name = map[int32]string{
+	0: "NO_DIAG",
+	1: "CTRL_DETECT_EXPIRED",
+	2: "ECHO_FAILED",
+	3: "NEIGHBOR_DOWN",
+	4: "FWD_PLANE_RESET",
+	5: "PATH_DOWN",
+	6: "CONCAT_PATH_DOWN",
+	7: "ADMIN_DOWN_DIAG",
+	8: "REV_CONCAT_PATH_DOWN",
+}
+
+var Session_Diag_value = map[string]int32{
+	"NO_DIAG":              0,
+	"CTRL_DETECT_EXPIRED":  1,
+	"ECHO_FAILED":          2,
+	"NEIGHBOR_DOWN":        3,
+	"FWD_PLANE_RESET":      4,
+	"PATH_DOWN":            5,
+	"CONCAT_PATH_DOWN":     6,
+	"ADMIN_DOWN_DIAG":      7,
+	"REV_CONCAT_PATH_DOWN": 8,
+}
+
+func (x Session_Diag) String() string {
+	return proto.EnumName(Session_Diag_name, int32(x))
+}
+
+func (Session_Diag) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{2, 1}
+}
+
+//
+// SessionKey identifies session.
+// ifname is used by single-hop session only.
+//
+type SessionKey struct {
+	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Ifname               string   `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Multihop             bool     `protobuf:"varint,3,opt,name=multihop,proto3" json:"multihop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{0}
+}
+
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionKey.Unmarshal(m, b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return xxx_messageInfo_SessionKey.Size(m)
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *SessionKey) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *SessionKey) GetMultihop() bool {
+	if m != nil {
+		return m.Multihop
+	}
+	return false
+}
+
+//
+// SessionConfig
+// intervals are in micro seconds. 0 means default value of bfdd.
+//
+type SessionConfig struct {
+	Key                  *SessionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Local                string      `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	DesiredMinTx         uint32      `protobuf:"varint,3,opt,name=desired_min_tx,json=desiredMinTx,proto3" json:"desired_min_tx,omitempty"`
+	RequiredMinRx        uint32      `protobuf:"varint,4,opt,name=required_min_rx,json=requiredMinRx,proto3" json:"required_min_rx,omitempty"`
+	DetectMult           uint32      `protobuf:"varint,5,opt,name=detect_mult,json=detectMult,proto3" json:"detect_mult,omitempty"`
+	MinTtl               uint32      `protobuf:"varint,6,opt,name=min_ttl,json=minTtl,proto3" json:"min_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SessionConfig) Reset()         { *m = SessionConfig{} }
+func (m *SessionConfig) String() string { return proto.CompactTextString(m) }
+func (*SessionConfig) ProtoMessage()    {}
+func (*SessionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{1}
+}
+
+func (m *SessionConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionConfig.Unmarshal(m, b)
+}
+func (m *SessionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionConfig.Marshal(b, m, deterministic)
+}
+func (m *SessionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionConfig.Merge(m, src)
+}
+func (m *SessionConfig) XXX_Size() int {
+	return xxx_messageInfo_SessionConfig.Size(m)
+}
+func (m *SessionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionConfig proto.InternalMessageInfo
+
+func (m *SessionConfig) GetKey() *SessionKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SessionConfig) GetLocal() string {
+	if m != nil {
+		return m.Local
+	}
+	return ""
+}
+
+func (m *SessionConfig) GetDesiredMinTx() uint32 {
+	if m != nil {
+		return m.DesiredMinTx
+	}
+	return 0
+}
+
+func (m *SessionConfig) GetRequiredMinRx() uint32 {
+	if m != nil {
+		return m.RequiredMinRx
+	}
+	return 0
+}
+
+func (m *SessionConfig) GetDetectMult() uint32 {
+	if m != nil {
+		return m.DetectMult
+	}
+	return 0
+}
+
+func (m *SessionConfig) GetMinTtl() uint32 {
+	if m != nil {
+		return m.MinTtl
+	}
+	return 0
+}
+
+//
+// Session
+//
+type Session struct {
+	Config               *SessionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	State                Session_State  `protobuf:"varint,2,opt,name=state,proto3,enum=bfdapi.Session_State" json:"state,omitempty"`
+	RemoteState          Session_State  `protobuf:"varint,3,opt,name=remote_state,json=remoteState,proto3,enum=bfdapi.Session_State" json:"remote_state,omitempty"`
+	Diag                 Session_Diag   `protobuf:"varint,4,opt,name=diag,proto3,enum=bfdapi.Session_Diag" json:"diag,omitempty"`
+	LocalDisc            uint32         `protobuf:"varint,5,opt,name=local_disc,json=localDisc,proto3" json:"local_disc,omitempty"`
+	RemoteDisc           uint32         `protobuf:"varint,6,opt,name=remote_disc,json=remoteDisc,proto3" json:"remote_disc,omitempty"`
+	TxInterval           uint32         `protobuf:"varint,7,opt,name=tx_interval,json=txInterval,proto3" json:"tx_interval,omitempty"`
+	DetectTime           uint32         `protobuf:"varint,8,opt,name=detect_time,json=detectTime,proto3" json:"detect_time,omitempty"`
+	TxPackets            uint64         `protobuf:"varint,9,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	RxPackets            uint64         `protobuf:"varint,10,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	UpCount              uint64         `protobuf:"varint,11,opt,name=up_count,json=upCount,proto3" json:"up_count,omitempty"`
+	LastChange           int64          `protobuf:"varint,12,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{2}
+}
+
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetConfig() *SessionConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *Session) GetState() Session_State {
+	if m != nil {
+		return m.State
+	}
+	return Session_ADMIN_DOWN
+}
+
+func (m *Session) GetRemoteState() Session_State {
+	if m != nil {
+		return m.RemoteState
+	}
+	return Session_ADMIN_DOWN
+}
+
+func (m *Session) GetDiag() Session_Diag {
+	if m != nil {
+		return m.Diag
+	}
+	return Session_NO_DIAG
+}
+
+func (m *Session) GetLocalDisc() uint32 {
+	if m != nil {
+		return m.LocalDisc
+	}
+	return 0
+}
+
+func (m *Session) GetRemoteDisc() uint32 {
+	if m != nil {
+		return m.RemoteDisc
+	}
+	return 0
+}
+
+func (m *Session) GetTxInterval() uint32 {
+	if m != nil {
+		return m.TxInterval
+	}
+	return 0
+}
+
+func (m *Session) GetDetectTime() uint32 {
+	if m != nil {
+		return m.DetectTime
+	}
+	return 0
+}
+
+func (m *Session) GetTxPackets() uint64 {
+	if m != nil {
+		return m.TxPackets
+	}
+	return 0
+}
+
+func (m *Session) GetRxPackets() uint64 {
+	if m != nil {
+		return m.RxPackets
+	}
+	return 0
+}
+
+func (m *Session) GetUpCount() uint64 {
+	if m != nil {
+		return m.UpCount
+	}
+	return 0
+}
+
+func (m *Session) GetLastChange() int64 {
+	if m != nil {
+		return m.LastChange
+	}
+	return 0
+}
+
+type AddSessionRequest struct {
+	Config               *SessionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddSessionRequest) Reset()         { *m = AddSessionRequest{} }
+func (m *AddSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*AddSessionRequest) ProtoMessage()    {}
+func (*AddSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{3}
+}
+
+func (m *AddSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSessionRequest.Unmarshal(m, b)
+}
+func (m *AddSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *AddSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSessionRequest.Merge(m, src)
+}
+func (m *AddSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_AddSessionRequest.Size(m)
+}
+func (m *AddSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSessionRequest proto.InternalMessageInfo
+
+func (m *AddSessionRequest) GetConfig() *SessionConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type AddSessionReply struct {
+	LocalDisc            uint32   `protobuf:"varint,1,opt,name=local_disc,json=localDisc,proto3" json:"local_disc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSessionReply) Reset()         { *m = AddSessionReply{} }
+func (m *AddSessionReply) String() string { return proto.CompactTextString(m) }
+func (*AddSessionReply) ProtoMessage()    {}
+func (*AddSessionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{4}
+}
+
+func (m *AddSessionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSessionReply.Unmarshal(m, b)
+}
+func (m *AddSessionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSessionReply.Marshal(b, m, deterministic)
+}
+func (m *AddSessionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSessionReply.Merge(m, src)
+}
+func (m *AddSessionReply) XXX_Size() int {
+	return xxx_messageInfo_AddSessionReply.Size(m)
+}
+func (m *AddSessionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSessionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSessionReply proto.InternalMessageInfo
+
+func (m *AddSessionReply) GetLocalDisc() uint32 {
+	if m != nil {
+		return m.LocalDisc
+	}
+	return 0
+}
+
+type DeleteSessionRequest struct {
+	Key                  *SessionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DeleteSessionRequest) Reset()         { *m = DeleteSessionRequest{} }
+func (m *DeleteSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSessionRequest) ProtoMessage()    {}
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{5}
+}
+
+func (m *DeleteSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSessionRequest.Unmarshal(m, b)
+}
+func (m *DeleteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSessionRequest.Merge(m, src)
+}
+func (m *DeleteSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSessionRequest.Size(m)
+}
+func (m *DeleteSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSessionRequest proto.InternalMessageInfo
+
+func (m *DeleteSessionRequest) GetKey() *SessionKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type DeleteSessionReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSessionReply) Reset()         { *m = DeleteSessionReply{} }
+func (m *DeleteSessionReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteSessionReply) ProtoMessage()    {}
+func (*DeleteSessionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{6}
+}
+
+func (m *DeleteSessionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSessionReply.Unmarshal(m, b)
+}
+func (m *DeleteSessionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSessionReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteSessionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSessionReply.Merge(m, src)
+}
+func (m *DeleteSessionReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteSessionReply.Size(m)
+}
+func (m *DeleteSessionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSessionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSessionReply proto.InternalMessageInfo
+
+type GetSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSessionsRequest) Reset()         { *m = GetSessionsRequest{} }
+func (m *GetSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSessionsRequest) ProtoMessage()    {}
+func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{7}
+}
+
+func (m *GetSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSessionsRequest.Unmarshal(m, b)
+}
+func (m *GetSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionsRequest.Merge(m, src)
+}
+func (m *GetSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSessionsRequest.Size(m)
+}
+func (m *GetSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSessionsRequest proto.InternalMessageInfo
+
+type MonSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonSessionsRequest) Reset()         { *m = MonSessionsRequest{} }
+func (m *MonSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*MonSessionsRequest) ProtoMessage()    {}
+func (*MonSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b50ee6ba961d13, []int{8}
+}
+
+func (m *MonSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonSessionsRequest.Unmarshal(m, b)
+}
+func (m *MonSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *MonSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonSessionsRequest.Merge(m, src)
+}
+func (m *MonSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_MonSessionsRequest.Size(m)
+}
+func (m *MonSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonSessionsRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("bfdapi.Session_State", Session_State_name, Session_State_value)
+	proto.RegisterEnum("bfdapi.Session_Diag", Session_Diag_name, Session_Diag_value)
+	proto.RegisterType((*SessionKey)(nil), "bfdapi.SessionKey")
+	proto.RegisterType((*SessionConfig)(nil), "bfdapi.SessionConfig")
+	proto.RegisterType((*Session)(nil), "bfdapi.Session")
+	proto.RegisterType((*AddSessionRequest)(nil), "bfdapi.AddSessionRequest")
+	proto.RegisterType((*AddSessionReply)(nil), "bfdapi.AddSessionReply")
+	proto.RegisterType((*DeleteSessionRequest)(nil), "bfdapi.DeleteSessionRequest")
+	proto.RegisterType((*DeleteSessionReply)(nil), "bfdapi.DeleteSessionReply")
+	proto.RegisterType((*GetSessionsRequest)(nil), "bfdapi.GetSessionsRequest")
+	proto.RegisterType((*MonSessionsRequest)(nil), "bfdapi.MonSessionsRequest")
+}
+
+func init() { proto.RegisterFile("bfdapi.proto", fileDescriptor_14b50ee6ba961d13) }
+
+var fileDescriptor_14b50ee6ba961d13 = []byte{
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6a, 0xe3, 0x46,
+	0x18, 0x8d, 0xfc, 0x23, 0xdb, 0x9f, 0xe3, 0xd8, 0xfb, 0xad, 0xdb, 0x68, 0x4d, 0x4b, 0x83, 0x58,
+	0x8a, 0xa1, 0x34, 0x2c, 0xd9, 0x9b, 0x5e, 0x94, 0x82, 0x23, 0x29, 0x89, 0xd8, 0xf8, 0x87, 0x89,
+	0xda, 0xed, 0xdd, 0xa0, 0x95, 0x26, 0xd9, 0x61, 0x65, 0x49, 0x91, 0xc6, 0x45, 0x79, 0x94, 0xbe,
+	0x48, 0xa1, 0xcf, 0xd1, 0x17, 0x2a, 0x9a, 0x51, 0x62, 0x57, 0x4e, 0x4b, 0xf7, 0x4e, 0xdf, 0x39,
+	0xe7, 0xfb, 0x39, 0x87, 0xc1, 0x86, 0xc3, 0x0f, 0xb7, 0xa1, 0x9f, 0xf2, 0xd3, 0x34, 0x4b, 0x44,
+	0x82, 0xba, 0xaa, 0x4c, 0x0f, 0xe0, 0x86, 0xe5, 0x39, 0x4f, 0xe2, 0x77, 0xec, 0x01, 0x11, 0x5a,
+	0x29, 0x63, 0x99, 0xa1, 0x9d, 0x68, 0xd3, 0x1e, 0x91, 0xdf, 0xf8, 0x25, 0xe8, 0xfc, 0x36, 0xf6,
+	0xd7, 0xcc, 0x68, 0x48, 0xb4, 0xaa, 0x70, 0x02, 0xdd, 0xf5, 0x26, 0x12, 0xfc, 0x63, 0x92, 0x1a,
+	0xcd, 0x13, 0x6d, 0xda, 0x25, 0x4f, 0xb5, 0xf9, 0x97, 0x06, 0x83, 0x6a, 0xac, 0x95, 0xc4, 0xb7,
+	0xfc, 0x0e, 0x5f, 0x43, 0xf3, 0x13, 0x7b, 0x90, 0x83, 0xfb, 0x67, 0x78, 0x5a, 0xdd, 0xb2, 0x5d,
+	0x4d, 0x4a, 0x1a, 0xc7, 0xd0, 0x8e, 0x92, 0xc0, 0x8f, 0xaa, 0x55, 0xaa, 0xc0, 0xd7, 0x70, 0x14,
+	0xb2, 0x9c, 0x67, 0x2c, 0xa4, 0x6b, 0x1e, 0x53, 0x51, 0xc8, 0x7d, 0x03, 0x72, 0x58, 0xa1, 0x73,
+	0x1e, 0x7b, 0x05, 0x7e, 0x0b, 0xc3, 0x8c, 0xdd, 0x6f, 0x9e, 0x64, 0x59, 0x61, 0xb4, 0xa4, 0x6c,
+	0xf0, 0x08, 0xcf, 0x79, 0x4c, 0x0a, 0xfc, 0x06, 0xfa, 0x21, 0x13, 0x2c, 0x10, 0xb4, 0x3c, 0xd7,
+	0x68, 0x4b, 0x0d, 0x28, 0x68, 0xbe, 0x89, 0x04, 0x1e, 0x43, 0x47, 0xae, 0x11, 0x91, 0xa1, 0x4b,
+	0x52, 0x5f, 0xf3, 0xd8, 0x13, 0x91, 0xf9, 0x47, 0x1b, 0x3a, 0xd5, 0xc5, 0xf8, 0x3d, 0xe8, 0x81,
+	0x74, 0x56, 0x59, 0xfa, 0xa2, 0x66, 0x49, 0xd9, 0x26, 0x95, 0x08, 0xbf, 0x83, 0x76, 0x2e, 0x7c,
+	0xa1, 0x32, 0x3c, 0xda, 0x53, 0x9f, 0xde, 0x94, 0x24, 0x51, 0x1a, 0xfc, 0x01, 0x0e, 0x33, 0xb6,
+	0x4e, 0x04, 0xa3, 0xaa, 0xa7, 0xf9, 0x5f, 0x3d, 0x7d, 0x25, 0x95, 0x05, 0x4e, 0xa1, 0x15, 0x72,
+	0xff, 0x4e, 0x1a, 0x3f, 0x3a, 0x1b, 0xd7, 0x3b, 0x6c, 0xee, 0xdf, 0x11, 0xa9, 0xc0, 0xaf, 0x01,
+	0x64, 0xb8, 0x34, 0xe4, 0x79, 0x50, 0x85, 0xd0, 0x93, 0x88, 0xcd, 0xf3, 0xa0, 0x0c, 0xa9, 0x3a,
+	0x41, 0xf2, 0x2a, 0x07, 0x50, 0xd0, 0xa3, 0x40, 0x14, 0x94, 0xc7, 0x82, 0x65, 0xbf, 0xf9, 0x91,
+	0xd1, 0x51, 0x02, 0x51, 0xb8, 0x15, 0xb2, 0x13, 0xb3, 0xe0, 0x6b, 0x66, 0x74, 0x77, 0x63, 0xf6,
+	0xf8, 0x9a, 0x95, 0x17, 0x88, 0x82, 0xa6, 0x7e, 0xf0, 0x89, 0x89, 0xdc, 0xe8, 0x9d, 0x68, 0xd3,
+	0x16, 0xe9, 0x89, 0x62, 0xa5, 0x80, 0x92, 0xce, 0xb6, 0x34, 0x28, 0x3a, 0x7b, 0xa2, 0x5f, 0x41,
+	0x77, 0x93, 0xd2, 0x20, 0xd9, 0xc4, 0xc2, 0xe8, 0x4b, 0xb2, 0xb3, 0x49, 0xad, 0xb2, 0x2c, 0x37,
+	0x47, 0x7e, 0x2e, 0x68, 0xf0, 0xd1, 0x8f, 0xef, 0x98, 0x71, 0x78, 0xa2, 0x4d, 0x9b, 0x04, 0x4a,
+	0xc8, 0x92, 0x88, 0xf9, 0x16, 0xda, 0x2a, 0xae, 0x23, 0x80, 0x99, 0x3d, 0x77, 0x17, 0xd4, 0x5e,
+	0xbe, 0x5f, 0x8c, 0x0e, 0xb0, 0x0b, 0x2d, 0xf9, 0xa5, 0x95, 0x5f, 0xee, 0xc2, 0xf5, 0x46, 0x0d,
+	0xd4, 0xa1, 0xf1, 0xf3, 0x6a, 0xd4, 0x34, 0xff, 0xd4, 0xa0, 0x55, 0xe6, 0x87, 0x7d, 0xe8, 0x2c,
+	0x96, 0xd4, 0x76, 0x67, 0x97, 0xa3, 0x03, 0x3c, 0x86, 0x97, 0x96, 0x47, 0xae, 0xa9, 0xed, 0x78,
+	0x8e, 0xe5, 0x51, 0xe7, 0xd7, 0x95, 0x4b, 0x1c, 0x7b, 0xa4, 0xe1, 0x10, 0xfa, 0x8e, 0x75, 0xb5,
+	0xa4, 0x17, 0x33, 0xf7, 0xda, 0xb1, 0x47, 0x0d, 0x7c, 0x01, 0x83, 0x85, 0xe3, 0x5e, 0x5e, 0x9d,
+	0x2f, 0x89, 0x5a, 0xd7, 0xc4, 0x97, 0x30, 0xbc, 0x78, 0x6f, 0xd3, 0xd5, 0xf5, 0x6c, 0xe1, 0x50,
+	0xe2, 0xdc, 0x38, 0xde, 0xa8, 0x85, 0x03, 0xe8, 0xad, 0x66, 0xde, 0x95, 0xd2, 0xb4, 0x71, 0x0c,
+	0x23, 0x6b, 0xb9, 0xb0, 0x66, 0x1e, 0xdd, 0xa2, 0x7a, 0xd9, 0xb9, 0x3d, 0x5c, 0xdd, 0xd2, 0x41,
+	0x03, 0xc6, 0xc4, 0xf9, 0x85, 0xee, 0xc9, 0xbb, 0xe6, 0x39, 0xbc, 0x98, 0x85, 0x61, 0xf5, 0x0a,
+	0x08, 0xbb, 0xdf, 0xb0, 0x5c, 0x7c, 0xe6, 0x0b, 0x36, 0xdf, 0xc0, 0x70, 0x77, 0x46, 0x1a, 0x3d,
+	0xd4, 0xde, 0x90, 0x56, 0x7b, 0x43, 0xe6, 0x8f, 0x30, 0xb6, 0x59, 0xc4, 0x04, 0xab, 0x2d, 0xfe,
+	0x5f, 0x3f, 0x05, 0xe6, 0x18, 0xb0, 0xd6, 0x9d, 0x46, 0x12, 0xbd, 0x64, 0xa2, 0x82, 0xf2, 0x6a,
+	0x62, 0x89, 0xce, 0x93, 0xb8, 0x86, 0x9e, 0xfd, 0xde, 0x00, 0xfd, 0xfc, 0xc2, 0x9e, 0xa5, 0x1c,
+	0xcf, 0x01, 0xb6, 0xc7, 0xe3, 0xab, 0xc7, 0x9d, 0x7b, 0xa1, 0x4c, 0x8e, 0x9f, 0xa3, 0xca, 0xc5,
+	0x07, 0xf8, 0x0e, 0x06, 0xff, 0x38, 0x08, 0xbf, 0x7a, 0xd4, 0x3e, 0xe7, 0x72, 0x32, 0xf9, 0x17,
+	0x56, 0x0d, 0xfb, 0x09, 0xfa, 0x3b, 0x3e, 0xf0, 0x49, 0xbc, 0x6f, 0x6e, 0x32, 0xac, 0x25, 0x64,
+	0x1e, 0xbc, 0xd1, 0xca, 0xfe, 0x1d, 0xc7, 0xdb, 0xfe, 0xfd, 0x18, 0x9e, 0xed, 0xff, 0xa0, 0xcb,
+	0x7f, 0x81, 0xb7, 0x7f, 0x0f, 0x00, 0xba, 0xa1, 0xd7, 0xff, 0x15, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BFDApiClient is the client API for BFDApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BFDApiClient interface {
+	AddSession(ctx context.Context, in *AddSessionRequest, opts ...grpc.CallOption) (*AddSessionReply, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionReply, error)
+	GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (BFDApi_GetSessionsClient, error)
+	MonSessions(ctx context.Context, in *MonSessionsRequest, opts ...grpc.CallOption) (BFDApi_MonSessionsClient, error)
+}
+
+type bFDApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBFDApiClient(cc *grpc.ClientConn) BFDApiClient {
+	return &bFDApiClient{cc}
+}
+
+func (c *bFDApiClient) AddSession(ctx context.Context, in *AddSessionRequest, opts ...grpc.CallOption) (*AddSessionReply, error) {
+	out := new(AddSessionReply)
+	err := c.cc.Invoke(ctx, "/bfdapi.BFDApi/AddSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bFDApiClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionReply, error) {
+	out := new(DeleteSessionReply)
+	err := c.cc.Invoke(ctx, "/bfdapi.BFDApi/DeleteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bFDApiClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (BFDApi_GetSessionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BFDApi_serviceDesc.Streams[0], "/bfdapi.BFDApi/GetSessions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bFDApiGetSessionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BFDApi_GetSessionsClient interface {
+	Recv() (*Session, error)
+	grpc.ClientStream
+}
+
+type bFDApiGetSessionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bFDApiGetSessionsClient) Recv() (*Session, error) {
+	m := new(Session)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bFDApiClient) MonSessions(ctx context.Context, in *MonSessionsRequest, opts ...grpc.CallOption) (BFDApi_MonSessionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BFDApi_serviceDesc.Streams[1], "/bfdapi.BFDApi/MonSessions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bFDApiMonSessionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BFDApi_MonSessionsClient interface {
+	Recv() (*Session, error)
+	grpc.ClientStream
+}
+
+type bFDApiMonSessionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bFDApiMonSessionsClient) Recv() (*Session, error) {
+	m := new(Session)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BFDApiServer is the server API for BFDApi service.
+type BFDApiServer interface {
+	AddSession(context.Context, *AddSessionRequest) (*AddSessionReply, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionReply, error)
+	GetSessions(*GetSessionsRequest, BFDApi_GetSessionsServer) error
+	MonSessions(*MonSessionsRequest, BFDApi_MonSessionsServer) error
+}
+
+// UnimplementedBFDApiServer can be embedded to have forward compatible implementations.
+type UnimplementedBFDApiServer struct {
+}
+
+func (*UnimplementedBFDApiServer) AddSession(ctx context.Context, req *AddSessionRequest) (*AddSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSession not implemented")
+}
+func (*UnimplementedBFDApiServer) DeleteSession(ctx context.Context, req *DeleteSessionRequest) (*DeleteSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (*UnimplementedBFDApiServer) GetSessions(req *GetSessionsRequest, srv BFDApi_GetSessionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (*UnimplementedBFDApiServer) MonSessions(req *MonSessionsRequest, srv BFDApi_MonSessionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MonSessions not implemented")
+}
+
+func RegisterBFDApiServer(s *grpc.Server, srv BFDApiServer) {
+	s.RegisterService(&_BFDApi_serviceDesc, srv)
+}
+
+func _BFDApi_AddSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BFDApiServer).AddSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bfdapi.BFDApi/AddSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BFDApiServer).AddSession(ctx, req.(*AddSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BFDApi_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BFDApiServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bfdapi.BFDApi/DeleteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BFDApiServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BFDApi_GetSessions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSessionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BFDApiServer).GetSessions(m, &bFDApiGetSessionsServer{stream})
+}
+
+type BFDApi_GetSessionsServer interface {
+	Send(*Session) error
+	grpc.ServerStream
+}
+
+type bFDApiGetSessionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bFDApiGetSessionsServer) Send(m *Session) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BFDApi_MonSessions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonSessionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BFDApiServer).MonSessions(m, &bFDApiMonSessionsServer{stream})
+}
+
+type BFDApi_MonSessionsServer interface {
+	Send(*Session) error
+	grpc.ServerStream
+}
+
+type bFDApiMonSessionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bFDApiMonSessionsServer) Send(m *Session) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BFDApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bfdapi.BFDApi",
+	HandlerType: (*BFDApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSession",
+			Handler:    _BFDApi_AddSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _BFDApi_DeleteSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetSessions",
+			Handler:       _BFDApi_GetSessions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MonSessions",
+			Handler:       _BFDApi_MonSessions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bfdapi.proto",
+}
@@ -0,0 +1,628 @@
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: bfdapi.proto
+
+import sys
+_b=sys.version_info[0]<3 and (lambda x:x) or (lambda x:x.encode('latin1'))
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from google.protobuf import reflection as _reflection
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+
+
+DESCRIPTOR = _descriptor.FileDescriptor(
+  name='bfdapi.proto',
+  package='bfdapi',
+  syntax='proto3',
+  serialized_options=None,
+  serialized_pb=_b('\n\x0c\x62\x66\x64\x61pi.proto\x12\x06\x62\x66\x64\x61pi\"<\n\nSessionKey\x12\x0c\n\x04peer\x18\x01 \x01(\t\x12\x0e\n\x06ifname\x18\x02 \x01(\t\x12\x10\n\x08multihop\x18\x03 \x01(\x08\"\x96\x01\n\rSessionConfig\x12\x1f\n\x03key\x18\x01 \x01(\x0b\x32\x12.bfdapi.SessionKey\x12\r\n\x05local\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65sired_min_tx\x18\x03 \x01(\r\x12\x17\n\x0frequired_min_rx\x18\x04 \x01(\r\x12\x13\n\x0b\x64\x65tect_mult\x18\x05 \x01(\r\x12\x0f\n\x07min_ttl\x18\x06 \x01(\r\"\xba\x04\n\x07Session\x12%\n\x06\x63onfig\x18\x01 \x01(\x0b\x32\x15.bfdapi.SessionConfig\x12$\n\x05state\x18\x02 \x01(\x0e\x32\x15.bfdapi.Session.State\x12+\n\x0cremote_state\x18\x03 \x01(\x0e\x32\x15.bfdapi.Session.State\x12\"\n\x04\x64iag\x18\x04 \x01(\x0e\x32\x14.bfdapi.Session.Diag\x12\x12\n\nlocal_disc\x18\x05 \x01(\r\x12\x13\n\x0bremote_disc\x18\x06 \x01(\r\x12\x13\n\x0btx_interval\x18\x07 \x01(\r\x12\x13\n\x0b\x64\x65tect_time\x18\x08 \x01(\r\x12\x12\n\ntx_packets\x18\t \x01(\x04\x12\x12\n\nrx_packets\x18\n \x01(\x04\x12\x10\n\x08up_count\x18\x0b \x01(\x04\x12\x13\n\x0blast_change\x18\x0c \x01(\x03\"3\n\x05State\x12\x0e\n\nADMIN_DOWN\x10\x00\x12\x08\n\x04\x44OWN\x10\x01\x12\x08\n\x04INIT\x10\x02\x12\x06\n\x02UP\x10\x03\"\xb9\x01\n\x04\x44iag\x12\x0b\n\x07NO_DIAG\x10\x00\x12\x17\n\x13\x43TRL_DETECT_EXPIRED\x10\x01\x12\x0f\n\x0b\x45\x43HO_FAILED\x10\x02\x12\x11\n\rNEIGHBOR_DOWN\x10\x03\x12\x13\n\x0f\x46WD_PLANE_RESET\x10\x04\x12\r\n\tPATH_DOWN\x10\x05\x12\x14\n\x10\x43ONCAT_PATH_DOWN\x10\x06\x12\x13\n\x0f\x41\x44MIN_DOWN_DIAG\x10\x07\x12\x18\n\x14REV_CONCAT_PATH_DOWN\x10\x08\":\n\x11\x41\x64\x64SessionRequest\x12%\n\x06\x63onfig\x18\x01 \x01(\x0b\x32\x15.bfdapi.SessionConfig\"%\n\x0f\x41\x64\x64SessionReply\x12\x12\n\nlocal_disc\x18\x01 \x01(\r\"7\n\x14\x44\x65leteSessionRequest\x12\x1f\n\x03key\x18\x01 \x01(\x0b\x32\x12.bfdapi.SessionKey\"\x14\n\x12\x44\x65leteSessionReply\"\x14\n\x12GetSessionsRequest\"\x14\n\x12MonSessionsRequest2\x99\x02\n\x06\x42\x46\x44\x41pi\x12\x42\n\nAddSession\x12\x19.bfdapi.AddSessionRequest\x1a\x17.bfdapi.AddSessionReply\"\x00\x12K\n\rDeleteSession\x12\x1c.bfdapi.DeleteSessionRequest\x1a\x1a.bfdapi.DeleteSessionReply\"\x00\x12>\n\x0bGetSessions\x12\x1a.bfdapi.GetSessionsRequest\x1a\x0f.bfdapi.Session\"\x00\x30\x01\x12>\n\x0bMonSessions\x12\x1a.bfdapi.MonSessionsRequest\x1a\x0f.bfdapi.Session\"\x00\x30\x01\x62\x06proto3')
+)
+
+
+
+_SESSION_STATE = _descriptor.EnumDescriptor(
+  name='State',
+  full_name='bfdapi.Session.State',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='ADMIN_DOWN', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DOWN', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='INIT', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='UP', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=571,
+  serialized_end=622,
+)
+_sym_db.RegisterEnumDescriptor(_SESSION_STATE)
+
+_SESSION_DIAG = _descriptor.EnumDescriptor(
+  name='Diag',
+  full_name='bfdapi.Session.Diag',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='NO_DIAG', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='CTRL_DETECT_EXPIRED', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ECHO_FAILED', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='NEIGHBOR_DOWN', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='FWD_PLANE_RESET', index=4, number=4,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PATH_DOWN', index=5, number=5,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='CONCAT_PATH_DOWN', index=6, number=6,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ADMIN_DOWN_DIAG', index=7, number=7,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='REV_CONCAT_PATH_DOWN', index=8, number=8,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=625,
+  serialized_end=810,
+)
+_sym_db.RegisterEnumDescriptor(_SESSION_DIAG)
+
+
+_SESSIONKEY = _descriptor.Descriptor(
+  name='SessionKey',
+  full_name='bfdapi.SessionKey',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='peer', full_name='bfdapi.SessionKey.peer', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ifname', full_name='bfdapi.SessionKey.ifname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='multihop', full_name='bfdapi.SessionKey.multihop', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=24,
+  serialized_end=84,
+)
+
+
+_SESSIONCONFIG = _descriptor.Descriptor(
+  name='SessionConfig',
+  full_name='bfdapi.SessionConfig',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='bfdapi.SessionConfig.key', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='local', full_name='bfdapi.SessionConfig.local', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='desired_min_tx', full_name='bfdapi.SessionConfig.desired_min_tx', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='required_min_rx', full_name='bfdapi.SessionConfig.required_min_rx', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='detect_mult', full_name='bfdapi.SessionConfig.detect_mult', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='min_ttl', full_name='bfdapi.SessionConfig.min_ttl', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=87,
+  serialized_end=237,
+)
+
+
+_SESSION = _descriptor.Descriptor(
+  name='Session',
+  full_name='bfdapi.Session',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='config', full_name='bfdapi.Session.config', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='bfdapi.Session.state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='remote_state', full_name='bfdapi.Session.remote_state', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='diag', full_name='bfdapi.Session.diag', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='local_disc', full_name='bfdapi.Session.local_disc', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='remote_disc', full_name='bfdapi.Session.remote_disc', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tx_interval', full_name='bfdapi.Session.tx_interval', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='detect_time', full_name='bfdapi.Session.detect_time', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tx_packets', full_name='bfdapi.Session.tx_packets', index=8,
+      number=9, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rx_packets', full_name='bfdapi.Session.rx_packets', index=9,
+      number=10, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='up_count', full_name='bfdapi.Session.up_count', index=10,
+      number=11, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_change', full_name='bfdapi.Session.last_change', index=11,
+      number=12, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _SESSION_STATE,
+    _SESSION_DIAG,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=240,
+  serialized_end=810,
+)
+
+
+_ADDSESSIONREQUEST = _descriptor.Descriptor(
+  name='AddSessionRequest',
+  full_name='bfdapi.AddSessionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='config', full_name='bfdapi.AddSessionRequest.config', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=812,
+  serialized_end=870,
+)
+
+
+_ADDSESSIONREPLY = _descriptor.Descriptor(
+  name='AddSessionReply',
+  full_name='bfdapi.AddSessionReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='local_disc', full_name='bfdapi.AddSessionReply.local_disc', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=872,
+  serialized_end=909,
+)
+
+
+_DELETESESSIONREQUEST = _descriptor.Descriptor(
+  name='DeleteSessionRequest',
+  full_name='bfdapi.DeleteSessionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='bfdapi.DeleteSessionRequest.key', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=911,
+  serialized_end=966,
+)
+
+
+_DELETESESSIONREPLY = _descriptor.Descriptor(
+  name='DeleteSessionReply',
+  full_name='bfdapi.DeleteSessionReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=968,
+  serialized_end=988,
+)
+
+
+_GETSESSIONSREQUEST = _descriptor.Descriptor(
+  name='GetSessionsRequest',
+  full_name='bfdapi.GetSessionsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=990,
+  serialized_end=1010,
+)
+
+
+_MONSESSIONSREQUEST = _descriptor.Descriptor(
+  name='MonSessionsRequest',
+  full_name='bfdapi.MonSessionsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1012,
+  serialized_end=1032,
+)
+
+_SESSIONCONFIG.fields_by_name['key'].message_type = _SESSIONKEY
+_SESSION.fields_by_name['config'].message_type = _SESSIONCONFIG
+_SESSION.fields_by_name['state'].enum_type = _SESSION_STATE
+_SESSION.fields_by_name['remote_state'].enum_type = _SESSION_STATE
+_SESSION.fields_by_name['diag'].enum_type = _SESSION_DIAG
+_SESSION_STATE.containing_type = _SESSION
+_SESSION_DIAG.containing_type = _SESSION
+_ADDSESSIONREQUEST.fields_by_name['config'].message_type = _SESSIONCONFIG
+_DELETESESSIONREQUEST.fields_by_name['key'].message_type = _SESSIONKEY
+DESCRIPTOR.message_types_by_name['SessionKey'] = _SESSIONKEY
+DESCRIPTOR.message_types_by_name['SessionConfig'] = _SESSIONCONFIG
+DESCRIPTOR.message_types_by_name['Session'] = _SESSION
+DESCRIPTOR.message_types_by_name['AddSessionRequest'] = _ADDSESSIONREQUEST
+DESCRIPTOR.message_types_by_name['AddSessionReply'] = _ADDSESSIONREPLY
+DESCRIPTOR.message_types_by_name['DeleteSessionRequest'] = _DELETESESSIONREQUEST
+DESCRIPTOR.message_types_by_name['DeleteSessionReply'] = _DELETESESSIONREPLY
+DESCRIPTOR.message_types_by_name['GetSessionsRequest'] = _GETSESSIONSREQUEST
+DESCRIPTOR.message_types_by_name['MonSessionsRequest'] = _MONSESSIONSREQUEST
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+SessionKey = _reflection.GeneratedProtocolMessageType('SessionKey', (_message.Message,), dict(
+  DESCRIPTOR = _SESSIONKEY,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.SessionKey)
+  ))
+_sym_db.RegisterMessage(SessionKey)
+
+SessionConfig = _reflection.GeneratedProtocolMessageType('SessionConfig', (_message.Message,), dict(
+  DESCRIPTOR = _SESSIONCONFIG,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.SessionConfig)
+  ))
+_sym_db.RegisterMessage(SessionConfig)
+
+Session = _reflection.GeneratedProtocolMessageType('Session', (_message.Message,), dict(
+  DESCRIPTOR = _SESSION,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.Session)
+  ))
+_sym_db.RegisterMessage(Session)
+
+AddSessionRequest = _reflection.GeneratedProtocolMessageType('AddSessionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADDSESSIONREQUEST,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.AddSessionRequest)
+  ))
+_sym_db.RegisterMessage(AddSessionRequest)
+
+AddSessionReply = _reflection.GeneratedProtocolMessageType('AddSessionReply', (_message.Message,), dict(
+  DESCRIPTOR = _ADDSESSIONREPLY,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.AddSessionReply)
+  ))
+_sym_db.RegisterMessage(AddSessionReply)
+
+DeleteSessionRequest = _reflection.GeneratedProtocolMessageType('DeleteSessionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DELETESESSIONREQUEST,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.DeleteSessionRequest)
+  ))
+_sym_db.RegisterMessage(DeleteSessionRequest)
+
+DeleteSessionReply = _reflection.GeneratedProtocolMessageType('DeleteSessionReply', (_message.Message,), dict(
+  DESCRIPTOR = _DELETESESSIONREPLY,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.DeleteSessionReply)
+  ))
+_sym_db.RegisterMessage(DeleteSessionReply)
+
+GetSessionsRequest = _reflection.GeneratedProtocolMessageType('GetSessionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETSESSIONSREQUEST,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.GetSessionsRequest)
+  ))
+_sym_db.RegisterMessage(GetSessionsRequest)
+
+MonSessionsRequest = _reflection.GeneratedProtocolMessageType('MonSessionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _MONSESSIONSREQUEST,
+  __module__ = 'bfdapi_pb2'
+  # @@protoc_insertion_point(class_scope:bfdapi.MonSessionsRequest)
+  ))
+_sym_db.RegisterMessage(MonSessionsRequest)
+
+
+
+_BFDAPI = _descriptor.ServiceDescriptor(
+  name='BFDApi',
+  full_name='bfdapi.BFDApi',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=None,
+  serialized_start=1035,
+  serialized_end=1316,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='AddSession',
+    full_name='bfdapi.BFDApi.AddSession',
+    index=0,
+    containing_service=None,
+    input_type=_ADDSESSIONREQUEST,
+    output_type=_ADDSESSIONREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteSession',
+    full_name='bfdapi.BFDApi.DeleteSession',
+    index=1,
+    containing_service=None,
+    input_type=_DELETESESSIONREQUEST,
+    output_type=_DELETESESSIONREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetSessions',
+    full_name='bfdapi.BFDApi.GetSessions',
+    index=2,
+    containing_service=None,
+    input_type=_GETSESSIONSREQUEST,
+    output_type=_SESSION,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='MonSessions',
+    full_name='bfdapi.BFDApi.MonSessions',
+    index=3,
+    containing_service=None,
+    input_type=_MONSESSIONSREQUEST,
+    output_type=_SESSION,
+    serialized_options=None,
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_BFDAPI)
+
+DESCRIPTOR.services_by_name['BFDApi'] = _BFDAPI
+
+# @@protoc_insertion_point(module_scope)
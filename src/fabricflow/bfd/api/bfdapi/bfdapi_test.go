@@ -0,0 +1,94 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdapi
+
+import (
+	"fabricflow/bfd/pkgs/bfdlib"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestSessionConfig_ToNative(t *testing.T) {
+	c := &SessionConfig{
+		Key:           NewSessionKey(net.ParseIP("10.0.0.1"), "eth1", false),
+		Local:         "10.0.0.2",
+		DesiredMinTx:  100000,
+		RequiredMinRx: 200000,
+		DetectMult:    3,
+	}
+
+	n, err := c.ToNative()
+	if err != nil {
+		t.Errorf("SessionConfig.ToNative error. %s", err)
+	}
+	if !n.Peer.Equal(net.ParseIP("10.0.0.1")) || !n.Local.Equal(net.ParseIP("10.0.0.2")) || n.Ifname != "eth1" {
+		t.Errorf("SessionConfig.ToNative unmatch. %s", n)
+	}
+	if n.DesiredMinTx != 100*time.Millisecond || n.RequiredMinRx != 200*time.Millisecond || n.DetectMult != 3 {
+		t.Errorf("SessionConfig.ToNative unmatch. %s", n)
+	}
+
+	if r := NewSessionConfigFromNative(n); r.String() != c.String() {
+		t.Errorf("NewSessionConfigFromNative unmatch. %v", r)
+	}
+
+	c.Key.Peer = "10.0.0"
+	if _, err := c.ToNative(); err == nil {
+		t.Errorf("SessionConfig.ToNative must be error.")
+	}
+
+	c.Key = nil
+	if _, err := c.ToNative(); err == nil {
+		t.Errorf("SessionConfig.ToNative must be error.")
+	}
+}
+
+func TestSession_IsPathDown(t *testing.T) {
+	st := &bfdlib.SessionStatus{
+		Config: bfdlib.SessionConfig{
+			Peer: net.ParseIP("10.0.0.1"),
+		},
+		State:       bfdlib.STATE_DOWN,
+		RemoteState: bfdlib.STATE_DOWN,
+	}
+
+	if s := NewSessionFromNative(st); s.IsPathDown() {
+		t.Errorf("Session.IsPathDown must be false before up. %v", s)
+	}
+
+	st.UpCount = 1
+	s := NewSessionFromNative(st)
+	if !s.IsPathDown() {
+		t.Errorf("Session.IsPathDown must be true. %v", s)
+	}
+	if !s.GetPeerIP().Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("Session.GetPeerIP unmatch. %s", s.GetPeerIP())
+	}
+
+	st.RemoteState = bfdlib.STATE_ADMIN_DOWN
+	if s := NewSessionFromNative(st); s.IsPathDown() {
+		t.Errorf("Session.IsPathDown must be false. %v", s)
+	}
+
+	st.RemoteState = bfdlib.STATE_UP
+	st.State = bfdlib.STATE_UP
+	if s := NewSessionFromNative(st); s.IsPathDown() {
+		t.Errorf("Session.IsPathDown must be false. %v", s)
+	}
+}
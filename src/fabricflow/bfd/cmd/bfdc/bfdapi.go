@@ -0,0 +1,233 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fabricflow/bfd/api/bfdapi"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+type BFDAPICommand struct {
+	Addr string
+
+	Local      string
+	Ifname     string
+	MultiHop   bool
+	TxInterval uint32
+	RxInterval uint32
+	DetectMult uint32
+	MinTTL     uint32
+}
+
+func (c *BFDAPICommand) setFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.Addr, "bfd-addr", "", "localhost:50095", "BFD API address.")
+	return cmd
+}
+
+func (c *BFDAPICommand) setSessionFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.Ifname, "ifname", "i", "", "Interface name (single-hop).")
+	cmd.Flags().BoolVarP(&c.MultiHop, "multihop", "m", false, "Multi-hop session.")
+	return cmd
+}
+
+func (c *BFDAPICommand) setSessionConfigFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.Local, "local", "l", "", "Local address.")
+	cmd.Flags().Uint32VarP(&c.TxInterval, "tx-interval", "", 0, "Desired min tx interval (msec).")
+	cmd.Flags().Uint32VarP(&c.RxInterval, "rx-interval", "", 0, "Required min rx interval (msec).")
+	cmd.Flags().Uint32VarP(&c.DetectMult, "detect-mult", "", 0, "Detect multiplier.")
+	cmd.Flags().Uint32VarP(&c.MinTTL, "min-ttl", "", 0, "Min TTL (multi-hop).")
+	return cmd
+}
+
+func (c *BFDAPICommand) connect(f func(bfdapi.BFDApiClient) error) error {
+	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return f(bfdapi.NewBFDApiClient(conn))
+}
+
+func (c *BFDAPICommand) sessionKey(peer string) (*bfdapi.SessionKey, error) {
+	ip := net.ParseIP(peer)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid peer. '%s'", peer)
+	}
+	return bfdapi.NewSessionKey(ip, c.Ifname, c.MultiHop), nil
+}
+
+func printSession(s *bfdapi.Session) {
+	cfg := s.GetConfig()
+	key := cfg.GetKey()
+
+	fmt.Printf("Peer: %s ifname:'%s' multihop:%t local:'%s'\n",
+		key.GetPeer(), key.GetIfname(), key.GetMultihop(), cfg.GetLocal())
+	fmt.Printf("  State  : %s (remote: %s) diag:%s up:%d last:%s\n",
+		s.GetState(), s.GetRemoteState(), s.GetDiag(), s.GetUpCount(), time.Unix(s.GetLastChange(), 0))
+	fmt.Printf("  Disc   : local:%d remote:%d\n", s.GetLocalDisc(), s.GetRemoteDisc())
+	fmt.Printf("  Timers : tx:%dus rx:%dus mult:%d / tx:%dus detect:%dus\n",
+		cfg.GetDesiredMinTx(), cfg.GetRequiredMinRx(), cfg.GetDetectMult(), s.GetTxInterval(), s.GetDetectTime())
+	fmt.Printf("  Packets: tx:%d rx:%d\n", s.GetTxPackets(), s.GetRxPackets())
+}
+
+func (c *BFDAPICommand) show() error {
+	return c.connect(func(client bfdapi.BFDApiClient) error {
+		stream, err := client.GetSessions(context.Background(), &bfdapi.GetSessionsRequest{})
+		if err != nil {
+			return err
+		}
+
+		for {
+			s, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			printSession(s)
+		}
+	})
+}
+
+func (c *BFDAPICommand) monitor() error {
+	return c.connect(func(client bfdapi.BFDApiClient) error {
+		stream, err := client.MonSessions(context.Background(), &bfdapi.MonSessionsRequest{})
+		if err != nil {
+			return err
+		}
+
+		for {
+			s, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			printSession(s)
+		}
+	})
+}
+
+func (c *BFDAPICommand) add(peer string) error {
+	key, err := c.sessionKey(peer)
+	if err != nil {
+		return err
+	}
+
+	req := bfdapi.AddSessionRequest{
+		Config: &bfdapi.SessionConfig{
+			Key:           key,
+			Local:         c.Local,
+			DesiredMinTx:  c.TxInterval * 1000,
+			RequiredMinRx: c.RxInterval * 1000,
+			DetectMult:    c.DetectMult,
+			MinTtl:        c.MinTTL,
+		},
+	}
+
+	return c.connect(func(client bfdapi.BFDApiClient) error {
+		reply, err := client.AddSession(context.Background(), &req)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("session added. disc:%d\n", reply.LocalDisc)
+		return nil
+	})
+}
+
+func (c *BFDAPICommand) del(peer string) error {
+	key, err := c.sessionKey(peer)
+	if err != nil {
+		return err
+	}
+
+	return c.connect(func(client bfdapi.BFDApiClient) error {
+		_, err := client.DeleteSession(context.Background(), &bfdapi.DeleteSessionRequest{Key: key})
+		return err
+	})
+}
+
+func bfdAPICmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:     "session",
+		Aliases: []string{"sess"},
+		Short:   "BFD session command.",
+	}
+
+	api := BFDAPICommand{}
+
+	rootCmd.AddCommand(api.setFlags(
+		&cobra.Command{
+			Use:   "show",
+			Short: "show sessions.",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return api.show()
+			},
+		},
+	))
+
+	rootCmd.AddCommand(api.setFlags(
+		&cobra.Command{
+			Use:     "monitor",
+			Aliases: []string{"mon"},
+			Short:   "monitor sessions.",
+			Args:    cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return api.monitor()
+			},
+		},
+	))
+
+	rootCmd.AddCommand(api.setSessionConfigFlags(api.setSessionFlags(api.setFlags(
+		&cobra.Command{
+			Use:   "add <peer>",
+			Short: "add session.",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return api.add(args[0])
+			},
+		},
+	))))
+
+	rootCmd.AddCommand(api.setSessionFlags(api.setFlags(
+		&cobra.Command{
+			Use:     "delete <peer>",
+			Aliases: []string{"del"},
+			Short:   "delete session.",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return api.del(args[0])
+			},
+		},
+	)))
+
+	return rootCmd
+}
@@ -0,0 +1,149 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fabricflow/bfd/api/bfdapi"
+	"fabricflow/bfd/pkgs/bfdlib"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//
+// APIServer is bfd api server.
+//
+type APIServer struct {
+	manager *bfdlib.Manager
+
+	log *log.Entry
+}
+
+//
+// NewAPIServer returns new APIServer.
+//
+func NewAPIServer(manager *bfdlib.Manager) *APIServer {
+	return &APIServer{
+		manager: manager,
+		log:     log.WithFields(log.Fields{"module": "apisrv"}),
+	}
+}
+
+//
+// Start starts grpc server.
+//
+func (s *APIServer) Start(addr string) error {
+	listen, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	bfdapi.RegisterBFDApiServer(server, s)
+	go server.Serve(listen)
+
+	s.log.Infof("Start: %s", addr)
+	return nil
+}
+
+//
+// AddSession adds session.
+//
+func (s *APIServer) AddSession(ctxt context.Context, req *bfdapi.AddSessionRequest) (*bfdapi.AddSessionReply, error) {
+	cfg, err := req.GetConfig().ToNative()
+	if err != nil {
+		s.log.Errorf("AddSession: invalid config. %s", err)
+		return nil, err
+	}
+
+	session, err := s.manager.AddSession(cfg)
+	if err != nil {
+		s.log.Errorf("AddSession: %s", err)
+		return nil, err
+	}
+
+	return &bfdapi.AddSessionReply{
+		LocalDisc: session.LocalDisc(),
+	}, nil
+}
+
+//
+// DeleteSession deletes session.
+//
+func (s *APIServer) DeleteSession(ctxt context.Context, req *bfdapi.DeleteSessionRequest) (*bfdapi.DeleteSessionReply, error) {
+	key, err := req.GetKey().ToNative()
+	if err != nil {
+		s.log.Errorf("DeleteSession: invalid key. %s", err)
+		return nil, err
+	}
+
+	if err := s.manager.DeleteSession(key); err != nil {
+		s.log.Errorf("DeleteSession: %s", err)
+		return nil, err
+	}
+
+	return &bfdapi.DeleteSessionReply{}, nil
+}
+
+func (s *APIServer) sendSessions(f func(*bfdapi.Session) error) error {
+	var err error
+	s.manager.Sessions(func(session *bfdlib.Session) {
+		if err == nil {
+			err = f(bfdapi.NewSessionFromNative(session.Status()))
+		}
+	})
+	return err
+}
+
+//
+// GetSessions returns all sessions.
+//
+func (s *APIServer) GetSessions(req *bfdapi.GetSessionsRequest, stream bfdapi.BFDApi_GetSessionsServer) error {
+	return s.sendSessions(stream.Send)
+}
+
+//
+// MonSessions sends all sessions and then changes of sessions.
+//
+func (s *APIServer) MonSessions(req *bfdapi.MonSessionsRequest, stream bfdapi.BFDApi_MonSessionsServer) error {
+	ch, cancel := s.manager.Watch()
+	defer cancel()
+
+	s.log.Infof("MonSessions: start")
+
+	if err := s.sendSessions(stream.Send); err != nil {
+		s.log.Errorf("MonSessions: send error. %s", err)
+		return err
+	}
+
+	for {
+		select {
+		case status := <-ch:
+			if err := stream.Send(bfdapi.NewSessionFromNative(status)); err != nil {
+				s.log.Errorf("MonSessions: send error. %s", err)
+				return err
+			}
+
+		case <-stream.Context().Done():
+			s.log.Infof("MonSessions: exit.")
+			return nil
+		}
+	}
+}
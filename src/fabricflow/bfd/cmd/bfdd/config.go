@@ -0,0 +1,126 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fabricflow/bfd/pkgs/bfdlib"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	// BFDAPIAddr is default address of bfd api.
+	BFDAPIAddr = "127.0.0.1:50095"
+)
+
+//
+// SessionConfig is config of bfd session.
+//
+type SessionConfig struct {
+	Peer     string `toml:"peer"`
+	Local    string `toml:"local"`
+	Ifname   string `toml:"ifname"`
+	MultiHop bool   `toml:"multihop"`
+	MinTTL   uint8  `toml:"min_ttl"`
+}
+
+//
+// ToNative converts to bfdlib.SessionConfig.
+//
+func (c *SessionConfig) ToNative() (*bfdlib.SessionConfig, error) {
+	peer := net.ParseIP(c.Peer)
+	if peer == nil {
+		return nil, fmt.Errorf("invalid peer. '%s'", c.Peer)
+	}
+
+	var local net.IP
+	if len(c.Local) != 0 {
+		if local = net.ParseIP(c.Local); local == nil {
+			return nil, fmt.Errorf("invalid local. '%s'", c.Local)
+		}
+	}
+
+	return &bfdlib.SessionConfig{
+		Peer:     peer,
+		Local:    local,
+		Ifname:   c.Ifname,
+		MultiHop: c.MultiHop,
+		MinTTL:   c.MinTTL,
+	}, nil
+}
+
+//
+// BFDConfig is config of bfdd.
+// intervals are in milliseconds.
+//
+type BFDConfig struct {
+	Disable    bool             `toml:"disable"`
+	API        string           `toml:"api"`
+	TxInterval int64            `toml:"tx_interval"`
+	RxInterval int64            `toml:"rx_interval"`
+	DetectMult uint8            `toml:"detect_mult"`
+	Sessions   []*SessionConfig `toml:"sessions"`
+}
+
+//
+// GetTxInterval returns default desired min tx interval.
+//
+func (c *BFDConfig) GetTxInterval() time.Duration {
+	return time.Duration(c.TxInterval) * time.Millisecond
+}
+
+//
+// GetRxInterval returns default required min rx interval.
+//
+func (c *BFDConfig) GetRxInterval() time.Duration {
+	return time.Duration(c.RxInterval) * time.Millisecond
+}
+
+//
+// Config is root config.
+//
+type Config struct {
+	BFD BFDConfig `toml:"bfd"`
+}
+
+//
+// ReadConfig reads config from file.
+//
+func ReadConfig(path string, cfg *Config) error {
+	if _, err := toml.DecodeFile(path, cfg); err != nil {
+		return err
+	}
+
+	if len(cfg.BFD.API) == 0 {
+		cfg.BFD.API = BFDAPIAddr
+	}
+	if cfg.BFD.TxInterval == 0 {
+		cfg.BFD.TxInterval = int64(bfdlib.DESIRED_MIN_TX_DEFAULT / time.Millisecond)
+	}
+	if cfg.BFD.RxInterval == 0 {
+		cfg.BFD.RxInterval = int64(bfdlib.REQUIRED_MIN_RX_DEFAULT / time.Millisecond)
+	}
+	if cfg.BFD.DetectMult == 0 {
+		cfg.BFD.DetectMult = bfdlib.DETECT_MULT_DEFAULT
+	}
+
+	return nil
+}
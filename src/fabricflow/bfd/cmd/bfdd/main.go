@@ -0,0 +1,154 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fabricflow/bfd/pkgs/bfdlib"
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	flag "github.com/spf13/pflag"
+)
+
+//
+// App is application
+//
+type App struct {
+	configFile string
+	verbose    bool
+	trace      bool
+
+	log *log.Entry
+}
+
+//
+// NewApp returns new App.
+//
+func NewApp() *App {
+	return &App{
+		log: log.WithFields(log.Fields{"module": "app"}),
+	}
+}
+
+func (a *App) parseArgs() {
+	flag.StringVarP(&a.configFile, "config-file", "c", "/etc/beluganos/ribxd.conf", "config filename.")
+	flag.BoolVarP(&a.verbose, "verbose", "v", false, "show detail messages.")
+	flag.BoolVarP(&a.trace, "trace", "", false, "show more detail messages.")
+
+	flag.Parse()
+}
+
+func (a *App) startListeners(m *bfdlib.Manager) ([]*bfdlib.UDPListener, error) {
+	listeners := []*bfdlib.UDPListener{}
+	for _, network := range []string{"udp4", "udp6"} {
+		for _, multihop := range []bool{false, true} {
+			l, err := bfdlib.NewUDPListener(network, multihop)
+			if err != nil {
+				a.log.Errorf("listen error. %s multihop:%t %s", network, multihop, err)
+				for _, l := range listeners {
+					l.Close()
+				}
+				return nil, err
+			}
+
+			go l.Serve(m)
+			listeners = append(listeners, l)
+		}
+	}
+
+	return listeners, nil
+}
+
+func (a *App) run() error {
+	a.parseArgs()
+
+	if a.trace {
+		log.SetLevel(log.TraceLevel)
+	} else if a.verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	var cfg Config
+	if err := ReadConfig(a.configFile, &cfg); err != nil {
+		a.log.Errorf("run: ReadConfig error. %s", err)
+		return err
+	}
+
+	a.log.Infof("config: %s", a.configFile)
+	a.log.Infof("config: api         : %s", cfg.BFD.API)
+	a.log.Infof("config: tx_interval : %s", cfg.BFD.GetTxInterval())
+	a.log.Infof("config: rx_interval : %s", cfg.BFD.GetRxInterval())
+	a.log.Infof("config: detect_mult : %d", cfg.BFD.DetectMult)
+
+	if cfg.BFD.Disable {
+		a.log.Infof("BFD is disabled.")
+		return nil
+	}
+
+	m := bfdlib.NewManager(bfdlib.NewUDPSender)
+	m.DesiredMinTx = cfg.BFD.GetTxInterval()
+	m.RequiredMinRx = cfg.BFD.GetRxInterval()
+	m.DetectMult = cfg.BFD.DetectMult
+	defer m.Close()
+
+	listeners, err := a.startListeners(m)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+
+	for _, sessionCfg := range cfg.BFD.Sessions {
+		c, err := sessionCfg.ToNative()
+		if err != nil {
+			a.log.Errorf("run: invalid session config. %s", err)
+			return err
+		}
+
+		if _, err := m.AddSession(c); err != nil {
+			a.log.Errorf("run: AddSession error. %s", err)
+			return err
+		}
+	}
+
+	if err := NewAPIServer(m).Start(cfg.BFD.API); err != nil {
+		a.log.Errorf("run: start api server error. %s", err)
+		return err
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	sig := <-sigCh
+	a.log.Infof("signal received. %s", sig)
+
+	return nil
+}
+
+func main() {
+	if err := NewApp().run(); err != nil {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
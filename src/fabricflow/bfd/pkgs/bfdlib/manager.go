@@ -0,0 +1,297 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	WATCH_CHAN_SIZE = 1024
+)
+
+//
+// SenderFactory creates sender of session.
+//
+type SenderFactory func(*SessionConfig) (Sender, error)
+
+//
+// RecvInfo is attributes of received packet.
+// TTL is ttl(ipv4) or hop limit(ipv6) of received packet.
+//
+type RecvInfo struct {
+	Peer     net.IP
+	Ifname   string
+	TTL      uint8
+	MultiHop bool
+}
+
+func (r *RecvInfo) String() string {
+	return fmt.Sprintf("peer:%s if:'%s' ttl:%d multihop:%t", r.Peer, r.Ifname, r.TTL, r.MultiHop)
+}
+
+//
+// Manager has bfd sessions and demultiplexes received packets.
+//
+type Manager struct {
+	mutex    sync.RWMutex
+	sessions map[SessionKey]*Session
+	discs    map[uint32]*Session
+	nextDisc uint32
+	factory  SenderFactory
+
+	watchMutex sync.Mutex
+	watchers   map[int]chan *SessionStatus
+	watchId    int
+
+	DesiredMinTx  time.Duration
+	RequiredMinRx time.Duration
+	DetectMult    uint8
+
+	log *log.Entry
+}
+
+//
+// NewManager returns new Manager.
+//
+func NewManager(factory SenderFactory) *Manager {
+	return &Manager{
+		sessions: map[SessionKey]*Session{},
+		discs:    map[uint32]*Session{},
+		nextDisc: rand.Uint32(),
+		factory:  factory,
+		watchers: map[int]chan *SessionStatus{},
+
+		DesiredMinTx:  DESIRED_MIN_TX_DEFAULT,
+		RequiredMinRx: REQUIRED_MIN_RX_DEFAULT,
+		DetectMult:    DETECT_MULT_DEFAULT,
+
+		log: log.WithFields(log.Fields{"module": "bfd"}),
+	}
+}
+
+func (m *Manager) newDisc() uint32 {
+	for {
+		m.nextDisc++
+		if disc := m.nextDisc; disc != 0 {
+			if _, ok := m.discs[disc]; !ok {
+				return disc
+			}
+		}
+	}
+}
+
+//
+// AddSession creates and starts new session.
+//
+func (m *Manager) AddSession(config *SessionConfig) (*Session, error) {
+	cfg := *config
+	cfg.SetDefaults(m.DesiredMinTx, m.RequiredMinRx, m.DetectMult)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := cfg.Key()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.sessions[key]; ok {
+		return nil, fmt.Errorf("session already exists. %s", key)
+	}
+
+	sender, err := m.factory(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewSession(&cfg, m.newDisc(), sender)
+	m.sessions[key] = s
+	m.discs[s.LocalDisc()] = s
+
+	m.log.Infof("session added. %s disc:%d", &cfg, s.LocalDisc())
+
+	s.Start(m.notify)
+	m.notify(s.Status())
+
+	return s, nil
+}
+
+//
+// DeleteSession stops and deletes session.
+//
+func (m *Manager) DeleteSession(key SessionKey) error {
+	m.mutex.Lock()
+	s, ok := m.sessions[key]
+	if ok {
+		delete(m.sessions, key)
+		delete(m.discs, s.LocalDisc())
+	}
+	m.mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("session not found. %s", key)
+	}
+
+	s.Stop()
+	m.notify(s.Status())
+
+	m.log.Infof("session deleted. %s", key)
+	return nil
+}
+
+//
+// Session returns session.
+//
+func (m *Manager) Session(key SessionKey) (*Session, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	s, ok := m.sessions[key]
+	return s, ok
+}
+
+//
+// Sessions enumerates all sessions.
+//
+func (m *Manager) Sessions(f func(*Session)) {
+	m.mutex.RLock()
+	sessions := make([]*Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		sessions = append(sessions, s)
+	}
+	m.mutex.RUnlock()
+
+	for _, s := range sessions {
+		f(s)
+	}
+}
+
+//
+// Close deletes all sessions.
+//
+func (m *Manager) Close() {
+	keys := []SessionKey{}
+	m.Sessions(func(s *Session) {
+		keys = append(keys, s.Key())
+	})
+
+	for _, key := range keys {
+		m.DeleteSession(key)
+	}
+}
+
+//
+// lookup finds session of received packet (RFC5880 6.8.6).
+// session is selected by your discriminator if it is not zero,
+// otherwise by peer address and interface.
+//
+func (m *Manager) lookup(p *ControlPacket, info *RecvInfo) (*Session, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if p.YourDisc != 0 {
+		s, ok := m.discs[p.YourDisc]
+		return s, ok
+	}
+
+	if s, ok := m.sessions[NewSessionKey(info.Peer, info.Ifname, info.MultiHop)]; ok {
+		return s, true
+	}
+
+	if !info.MultiHop && len(info.Ifname) != 0 {
+		s, ok := m.sessions[NewSessionKey(info.Peer, "", false)]
+		return s, ok
+	}
+
+	return nil, false
+}
+
+//
+// Recv dispatches received packet to session.
+//
+func (m *Manager) Recv(p *ControlPacket, info *RecvInfo) error {
+	s, ok := m.lookup(p, info)
+	if !ok {
+		return fmt.Errorf("session not found. %s disc:%d", info, p.YourDisc)
+	}
+
+	cfg := s.Config()
+	if !cfg.Peer.Equal(info.Peer) || cfg.MultiHop != info.MultiHop {
+		return fmt.Errorf("session mismatch. %s %s", s.Key(), info)
+	}
+
+	if cfg.MultiHop {
+		if info.TTL < cfg.MinTTL {
+			return fmt.Errorf("ttl too small. %s min:%d", info, cfg.MinTTL)
+		}
+	} else if info.TTL != BFD_SINGLEHOP_TTL {
+		// RFC5881 5. (GTSM)
+		return fmt.Errorf("invalid ttl. %s", info)
+	}
+
+	s.Input(p)
+	return nil
+}
+
+//
+// Watch returns channel to receive status of sessions
+// when they are added, deleted or state changed.
+// cancel must be called to stop watching.
+//
+func (m *Manager) Watch() (ch <-chan *SessionStatus, cancel func()) {
+	m.watchMutex.Lock()
+	defer m.watchMutex.Unlock()
+
+	id := m.watchId
+	m.watchId++
+
+	c := make(chan *SessionStatus, WATCH_CHAN_SIZE)
+	m.watchers[id] = c
+
+	return c, func() {
+		m.watchMutex.Lock()
+		defer m.watchMutex.Unlock()
+
+		if c, ok := m.watchers[id]; ok {
+			delete(m.watchers, id)
+			close(c)
+		}
+	}
+}
+
+func (m *Manager) notify(status *SessionStatus) {
+	m.watchMutex.Lock()
+	defer m.watchMutex.Unlock()
+
+	m.log.Debugf("notify: %s", status)
+
+	for id, c := range m.watchers {
+		select {
+		case c <- status:
+		default:
+			m.log.Warnf("notify: watcher(%d) queue full. %s", id, status)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+//
+// testLinkSender delivers packets to manager of remote system.
+//
+type testLinkSender struct {
+	remote *Manager
+	info   RecvInfo
+}
+
+func (s *testLinkSender) Send(b []byte) error {
+	p, err := DecodeControlPacket(b)
+	if err != nil {
+		return err
+	}
+	info := s.info
+	return s.remote.Recv(p, &info)
+}
+
+func (s *testLinkSender) Close() error {
+	return nil
+}
+
+func newTestManagerPair() (*Manager, *Manager) {
+	var m1, m2 *Manager
+
+	m1 = NewManager(func(cfg *SessionConfig) (Sender, error) {
+		return &testLinkSender{
+			remote: m2,
+			info:   RecvInfo{Peer: net.ParseIP("10.0.0.1"), Ifname: "eth1", TTL: BFD_SINGLEHOP_TTL},
+		}, nil
+	})
+	m2 = NewManager(func(cfg *SessionConfig) (Sender, error) {
+		return &testLinkSender{
+			remote: m1,
+			info:   RecvInfo{Peer: net.ParseIP("10.0.0.2"), Ifname: "eth1", TTL: BFD_SINGLEHOP_TTL},
+		}, nil
+	})
+
+	for _, m := range []*Manager{m1, m2} {
+		m.DesiredMinTx = 10 * time.Millisecond
+		m.RequiredMinRx = 10 * time.Millisecond
+	}
+
+	return m1, m2
+}
+
+func waitState(t *testing.T, ch <-chan *SessionStatus, state State) *SessionStatus {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case st := <-ch:
+			if st.State == state {
+				return st
+			}
+		case <-timeout:
+			t.Fatalf("wait %s timeout.", state)
+			return nil
+		}
+	}
+}
+
+func TestManager_UpDown(t *testing.T) {
+	m1, m2 := newTestManagerPair()
+	defer m1.Close()
+	defer m2.Close()
+
+	ch, cancel := m2.Watch()
+	defer cancel()
+
+	if _, err := m1.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.2"), Ifname: "eth1"}); err != nil {
+		t.Fatalf("Manager.AddSession error. %s", err)
+	}
+	if _, err := m2.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.1"), Ifname: "eth1"}); err != nil {
+		t.Fatalf("Manager.AddSession error. %s", err)
+	}
+
+	if _, err := m2.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.1"), Ifname: "eth1"}); err == nil {
+		t.Errorf("Manager.AddSession must be error.")
+	}
+
+	st := waitState(t, ch, STATE_UP)
+	if st.RemoteState != STATE_UP && st.RemoteState != STATE_INIT {
+		t.Errorf("Manager session unmatch. %s", st)
+	}
+
+	// remote system is going to AdminDown.
+	if err := m1.DeleteSession(NewSessionKey(net.ParseIP("10.0.0.2"), "eth1", false)); err != nil {
+		t.Errorf("Manager.DeleteSession error. %s", err)
+	}
+
+	st = waitState(t, ch, STATE_DOWN)
+	if st.RemoteState != STATE_ADMIN_DOWN || st.Diag != DIAG_NEIGHBOR_DOWN {
+		t.Errorf("Manager session unmatch. %s", st)
+	}
+}
+
+func TestManager_DetectExpired(t *testing.T) {
+	stopped := make(chan struct{})
+
+	m1, m2 := newTestManagerPair()
+	defer m1.Close()
+	defer m2.Close()
+
+	m1.factory = func(cfg *SessionConfig) (Sender, error) {
+		return &testDropSender{
+			sender:  &testLinkSender{remote: m2, info: RecvInfo{Peer: net.ParseIP("10.0.0.1"), Ifname: "eth1", TTL: BFD_SINGLEHOP_TTL}},
+			stopped: stopped,
+		}, nil
+	}
+
+	ch, cancel := m2.Watch()
+	defer cancel()
+
+	m1.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.2"), Ifname: "eth1"})
+	m2.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.1"), Ifname: "eth1"})
+
+	waitState(t, ch, STATE_UP)
+
+	// packets from remote system are lost.
+	close(stopped)
+
+	st := waitState(t, ch, STATE_DOWN)
+	if st.Diag != DIAG_CTRL_DETECT_EXPIRED || st.RemoteState != STATE_DOWN {
+		t.Errorf("Manager session unmatch. %s", st)
+	}
+}
+
+type testDropSender struct {
+	sender  Sender
+	stopped chan struct{}
+}
+
+func (s *testDropSender) Send(b []byte) error {
+	select {
+	case <-s.stopped:
+		return nil
+	default:
+		return s.sender.Send(b)
+	}
+}
+
+func (s *testDropSender) Close() error {
+	return s.sender.Close()
+}
+
+func TestManager_Recv(t *testing.T) {
+	m := NewManager(func(cfg *SessionConfig) (Sender, error) {
+		return newTestSender(), nil
+	})
+	defer m.Close()
+
+	s1, _ := m.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.0.2")})
+	m.AddSession(&SessionConfig{Peer: net.ParseIP("10.0.1.2"), MultiHop: true, MinTTL: 250})
+
+	p := &ControlPacket{Version: BFD_VERSION, State: STATE_DOWN, DetectMult: 3, MyDisc: 1}
+
+	// found by address with any interface.
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.0.2"), Ifname: "eth1", TTL: 255}); err != nil {
+		t.Errorf("Manager.Recv error. %s", err)
+	}
+
+	// GTSM
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.0.2"), TTL: 254}); err == nil {
+		t.Errorf("Manager.Recv must be error. ttl")
+	}
+
+	// multihop
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.1.2"), TTL: 250, MultiHop: true}); err != nil {
+		t.Errorf("Manager.Recv error. %s", err)
+	}
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.1.2"), TTL: 249, MultiHop: true}); err == nil {
+		t.Errorf("Manager.Recv must be error. min ttl")
+	}
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.1.2"), TTL: 255}); err == nil {
+		t.Errorf("Manager.Recv must be error. singlehop")
+	}
+
+	// found by discriminator.
+	p.YourDisc = s1.LocalDisc()
+	p.State = STATE_INIT
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.0.2"), TTL: 255}); err != nil {
+		t.Errorf("Manager.Recv error. %s", err)
+	}
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.0.3"), TTL: 255}); err == nil {
+		t.Errorf("Manager.Recv must be error. peer")
+	}
+
+	p.YourDisc = s1.LocalDisc() + 100
+	if err := m.Recv(p, &RecvInfo{Peer: net.ParseIP("10.0.0.2"), TTL: 255}); err == nil {
+		t.Errorf("Manager.Recv must be error. disc")
+	}
+}
@@ -0,0 +1,223 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	BFD_VERSION         = 1
+	BFD_CTRL_PACKET_LEN = 24
+
+	BFD_PORT_SINGLEHOP  = 3784 // RFC5881
+	BFD_PORT_MULTIHOP   = 4784 // RFC5883
+	BFD_SRC_PORT_MIN    = 49152
+	BFD_SRC_PORT_MAX    = 65535
+	BFD_SINGLEHOP_TTL   = 255
+	BFD_MULTIHOP_TTL    = 255
+	BFD_DETECT_MULT_MIN = 1
+)
+
+//
+// State is session state (RFC5880 4.1)
+//
+type State uint8
+
+const (
+	STATE_ADMIN_DOWN State = iota
+	STATE_DOWN
+	STATE_INIT
+	STATE_UP
+)
+
+var stateNames = map[State]string{
+	STATE_ADMIN_DOWN: "AdminDown",
+	STATE_DOWN:       "Down",
+	STATE_INIT:       "Init",
+	STATE_UP:         "Up",
+}
+
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", s)
+}
+
+//
+// Diag is diagnostic code (RFC5880 4.1)
+//
+type Diag uint8
+
+const (
+	DIAG_NONE Diag = iota
+	DIAG_CTRL_DETECT_EXPIRED
+	DIAG_ECHO_FAILED
+	DIAG_NEIGHBOR_DOWN
+	DIAG_FWD_PLANE_RESET
+	DIAG_PATH_DOWN
+	DIAG_CONCAT_PATH_DOWN
+	DIAG_ADMIN_DOWN
+	DIAG_REV_CONCAT_PATH_DOWN
+)
+
+var diagNames = map[Diag]string{
+	DIAG_NONE:                 "NoDiag",
+	DIAG_CTRL_DETECT_EXPIRED:  "ControlDetectionTimeExpired",
+	DIAG_ECHO_FAILED:          "EchoFunctionFailed",
+	DIAG_NEIGHBOR_DOWN:        "NeighborSignaledSessionDown",
+	DIAG_FWD_PLANE_RESET:      "ForwardingPlaneReset",
+	DIAG_PATH_DOWN:            "PathDown",
+	DIAG_CONCAT_PATH_DOWN:     "ConcatenatedPathDown",
+	DIAG_ADMIN_DOWN:           "AdministrativelyDown",
+	DIAG_REV_CONCAT_PATH_DOWN: "ReverseConcatenatedPathDown",
+}
+
+func (d Diag) String() string {
+	if name, ok := diagNames[d]; ok {
+		return name
+	}
+	return fmt.Sprintf("Diag(%d)", d)
+}
+
+//
+// Flags is flag bits of control packet.
+//
+type Flags uint8
+
+const (
+	FLAG_POLL           Flags = 0x20
+	FLAG_FINAL          Flags = 0x10
+	FLAG_CTRL_PLANE_IND Flags = 0x08
+	FLAG_AUTH           Flags = 0x04
+	FLAG_DEMAND         Flags = 0x02
+	FLAG_MULTIPOINT     Flags = 0x01
+)
+
+func (f Flags) String() string {
+	s := ""
+	for _, v := range []struct {
+		flag Flags
+		name string
+	}{
+		{FLAG_POLL, "P"},
+		{FLAG_FINAL, "F"},
+		{FLAG_CTRL_PLANE_IND, "C"},
+		{FLAG_AUTH, "A"},
+		{FLAG_DEMAND, "D"},
+		{FLAG_MULTIPOINT, "M"},
+	} {
+		if (f & v.flag) != 0 {
+			s += v.name
+		}
+	}
+	return s
+}
+
+//
+// ControlPacket is bfd control packet without authentication section.
+// Intervals are in microseconds.
+//
+type ControlPacket struct {
+	Version        uint8
+	Diag           Diag
+	State          State
+	Flags          Flags
+	DetectMult     uint8
+	MyDisc         uint32
+	YourDisc       uint32
+	DesiredMinTx   uint32
+	RequiredMinRx  uint32
+	RequiredEchoRx uint32
+}
+
+func (p *ControlPacket) String() string {
+	return fmt.Sprintf("v:%d diag:%s state:%s flags:'%s' mult:%d my:%d your:%d tx:%d rx:%d echo:%d",
+		p.Version, p.Diag, p.State, p.Flags, p.DetectMult, p.MyDisc, p.YourDisc,
+		p.DesiredMinTx, p.RequiredMinRx, p.RequiredEchoRx)
+}
+
+//
+// Encode returns bytes of control packet.
+//
+func (p *ControlPacket) Encode() []byte {
+	b := make([]byte, BFD_CTRL_PACKET_LEN)
+	b[0] = (p.Version << 5) | (uint8(p.Diag) & 0x1f)
+	b[1] = (uint8(p.State) << 6) | (uint8(p.Flags) & 0x3f)
+	b[2] = p.DetectMult
+	b[3] = BFD_CTRL_PACKET_LEN
+	binary.BigEndian.PutUint32(b[4:], p.MyDisc)
+	binary.BigEndian.PutUint32(b[8:], p.YourDisc)
+	binary.BigEndian.PutUint32(b[12:], p.DesiredMinTx)
+	binary.BigEndian.PutUint32(b[16:], p.RequiredMinRx)
+	binary.BigEndian.PutUint32(b[20:], p.RequiredEchoRx)
+	return b
+}
+
+//
+// DecodeControlPacket parses bytes and checks packet (RFC5880 6.8.6).
+// Packets with authentication section are discarded
+// because authentication is not supported.
+//
+func DecodeControlPacket(b []byte) (*ControlPacket, error) {
+	if len(b) < BFD_CTRL_PACKET_LEN {
+		return nil, fmt.Errorf("too short. %d", len(b))
+	}
+
+	p := &ControlPacket{
+		Version:        b[0] >> 5,
+		Diag:           Diag(b[0] & 0x1f),
+		State:          State(b[1] >> 6),
+		Flags:          Flags(b[1] & 0x3f),
+		DetectMult:     b[2],
+		MyDisc:         binary.BigEndian.Uint32(b[4:]),
+		YourDisc:       binary.BigEndian.Uint32(b[8:]),
+		DesiredMinTx:   binary.BigEndian.Uint32(b[12:]),
+		RequiredMinRx:  binary.BigEndian.Uint32(b[16:]),
+		RequiredEchoRx: binary.BigEndian.Uint32(b[20:]),
+	}
+
+	length := int(b[3])
+
+	switch {
+	case p.Version != BFD_VERSION:
+		return nil, fmt.Errorf("invalid version. %d", p.Version)
+
+	case length < BFD_CTRL_PACKET_LEN || length > len(b):
+		return nil, fmt.Errorf("invalid length. %d/%d", length, len(b))
+
+	case (p.Flags & FLAG_AUTH) != 0:
+		return nil, fmt.Errorf("authentication not supported.")
+
+	case p.DetectMult == 0:
+		return nil, fmt.Errorf("invalid detect mult. %d", p.DetectMult)
+
+	case (p.Flags & FLAG_MULTIPOINT) != 0:
+		return nil, fmt.Errorf("multipoint not supported.")
+
+	case p.MyDisc == 0:
+		return nil, fmt.Errorf("invalid my discriminator. %d", p.MyDisc)
+
+	case p.YourDisc == 0 && p.State != STATE_DOWN && p.State != STATE_ADMIN_DOWN:
+		return nil, fmt.Errorf("your discriminator is zero. state:%s", p.State)
+	}
+
+	return p, nil
+}
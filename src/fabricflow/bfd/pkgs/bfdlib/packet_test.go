@@ -0,0 +1,113 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"testing"
+)
+
+func TestControlPacket_Encode(t *testing.T) {
+	p := &ControlPacket{
+		Version:        BFD_VERSION,
+		Diag:           DIAG_NEIGHBOR_DOWN,
+		State:          STATE_UP,
+		Flags:          FLAG_POLL,
+		DetectMult:     3,
+		MyDisc:         0x01020304,
+		YourDisc:       0x05060708,
+		DesiredMinTx:   300000,
+		RequiredMinRx:  200000,
+		RequiredEchoRx: 0,
+	}
+
+	b := p.Encode()
+	if len(b) != BFD_CTRL_PACKET_LEN {
+		t.Errorf("ControlPacket.Encode len unmatch. %d", len(b))
+	}
+	if b[0] != 0x23 || b[1] != 0xe0 || b[2] != 3 || b[3] != 24 {
+		t.Errorf("ControlPacket.Encode header unmatch. %v", b[:4])
+	}
+	if b[4] != 0x01 || b[7] != 0x04 || b[8] != 0x05 || b[11] != 0x08 {
+		t.Errorf("ControlPacket.Encode disc unmatch. %v", b[4:12])
+	}
+
+	d, err := DecodeControlPacket(b)
+	if err != nil {
+		t.Errorf("DecodeControlPacket error. %s", err)
+	}
+	if *d != *p {
+		t.Errorf("DecodeControlPacket unmatch. %s", d)
+	}
+}
+
+func TestDecodeControlPacket_Invalid(t *testing.T) {
+	newPacket := func() *ControlPacket {
+		return &ControlPacket{
+			Version:    BFD_VERSION,
+			State:      STATE_UP,
+			DetectMult: 3,
+			MyDisc:     1,
+			YourDisc:   2,
+		}
+	}
+
+	if _, err := DecodeControlPacket(newPacket().Encode()[:20]); err == nil {
+		t.Errorf("DecodeControlPacket must be error. short")
+	}
+
+	p := newPacket()
+	p.Version = 0
+	if _, err := DecodeControlPacket(p.Encode()); err == nil {
+		t.Errorf("DecodeControlPacket must be error. version")
+	}
+
+	b := newPacket().Encode()
+	b[3] = 30
+	if _, err := DecodeControlPacket(b); err == nil {
+		t.Errorf("DecodeControlPacket must be error. length")
+	}
+
+	p = newPacket()
+	p.Flags = FLAG_AUTH
+	if _, err := DecodeControlPacket(p.Encode()); err == nil {
+		t.Errorf("DecodeControlPacket must be error. auth")
+	}
+
+	p = newPacket()
+	p.DetectMult = 0
+	if _, err := DecodeControlPacket(p.Encode()); err == nil {
+		t.Errorf("DecodeControlPacket must be error. mult")
+	}
+
+	p = newPacket()
+	p.MyDisc = 0
+	if _, err := DecodeControlPacket(p.Encode()); err == nil {
+		t.Errorf("DecodeControlPacket must be error. my disc")
+	}
+
+	p = newPacket()
+	p.YourDisc = 0
+	if _, err := DecodeControlPacket(p.Encode()); err == nil {
+		t.Errorf("DecodeControlPacket must be error. your disc")
+	}
+
+	p.State = STATE_DOWN
+	if _, err := DecodeControlPacket(p.Encode()); err != nil {
+		t.Errorf("DecodeControlPacket error. %s", err)
+	}
+}
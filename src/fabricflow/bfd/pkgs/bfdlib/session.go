@@ -0,0 +1,567 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DESIRED_MIN_TX_DEFAULT  = 300 * time.Millisecond
+	REQUIRED_MIN_RX_DEFAULT = 300 * time.Millisecond
+	DETECT_MULT_DEFAULT     = 3
+	SLOW_TX_INTERVAL        = 1 * time.Second // RFC5880 6.8.3
+	SESSION_RECV_CHAN_SIZE  = 64
+)
+
+func durationToUsec(d time.Duration) uint32 {
+	return uint32(d / time.Microsecond)
+}
+
+func usecToDuration(usec uint32) time.Duration {
+	return time.Duration(usec) * time.Microsecond
+}
+
+//
+// SessionKey identifies session.
+// Ifname is empty if session is multihop or not bound to interface.
+//
+type SessionKey struct {
+	Peer     string
+	Ifname   string
+	MultiHop bool
+}
+
+//
+// NewSessionKey returns new SessionKey.
+//
+func NewSessionKey(peer net.IP, ifname string, multihop bool) SessionKey {
+	if multihop {
+		ifname = ""
+	}
+	return SessionKey{
+		Peer:     peer.String(),
+		Ifname:   ifname,
+		MultiHop: multihop,
+	}
+}
+
+func (k SessionKey) String() string {
+	if k.MultiHop {
+		return fmt.Sprintf("%s multihop", k.Peer)
+	}
+	if len(k.Ifname) == 0 {
+		return k.Peer
+	}
+	return fmt.Sprintf("%s%%%s", k.Peer, k.Ifname)
+}
+
+//
+// SessionConfig is parameters of session.
+// MinTTL is used by multihop session only.
+//
+type SessionConfig struct {
+	Peer          net.IP
+	Local         net.IP
+	Ifname        string
+	MultiHop      bool
+	DesiredMinTx  time.Duration
+	RequiredMinRx time.Duration
+	DetectMult    uint8
+	MinTTL        uint8
+}
+
+//
+// Key returns key of session.
+//
+func (c *SessionConfig) Key() SessionKey {
+	return NewSessionKey(c.Peer, c.Ifname, c.MultiHop)
+}
+
+//
+// SetDefaults sets default values to unspecified parameters.
+//
+func (c *SessionConfig) SetDefaults(tx, rx time.Duration, mult uint8) {
+	if c.DesiredMinTx == 0 {
+		c.DesiredMinTx = tx
+	}
+	if c.RequiredMinRx == 0 {
+		c.RequiredMinRx = rx
+	}
+	if c.DetectMult == 0 {
+		c.DetectMult = mult
+	}
+}
+
+//
+// Validate checks parameters.
+//
+func (c *SessionConfig) Validate() error {
+	if c.Peer == nil {
+		return fmt.Errorf("peer not specified.")
+	}
+	if c.Local != nil && (c.Local.To4() == nil) != (c.Peer.To4() == nil) {
+		return fmt.Errorf("address family mismatch. %s %s", c.Local, c.Peer)
+	}
+	if c.DesiredMinTx <= 0 || c.RequiredMinRx <= 0 {
+		return fmt.Errorf("invalid interval. tx:%s rx:%s", c.DesiredMinTx, c.RequiredMinRx)
+	}
+	if c.DetectMult < BFD_DETECT_MULT_MIN {
+		return fmt.Errorf("invalid detect mult. %d", c.DetectMult)
+	}
+	return nil
+}
+
+func (c *SessionConfig) String() string {
+	return fmt.Sprintf("%s local:%s tx:%s rx:%s mult:%d min-ttl:%d",
+		c.Key(), c.Local, c.DesiredMinTx, c.RequiredMinRx, c.DetectMult, c.MinTTL)
+}
+
+//
+// SessionStatus is snapshot of session.
+//
+type SessionStatus struct {
+	Config      SessionConfig
+	State       State
+	RemoteState State
+	Diag        Diag
+	LocalDisc   uint32
+	RemoteDisc  uint32
+	TxInterval  time.Duration
+	DetectTime  time.Duration
+	TxPackets   uint64
+	RxPackets   uint64
+	UpCount     uint64
+	LastChange  time.Time
+}
+
+func (s *SessionStatus) String() string {
+	return fmt.Sprintf("%s state:%s remote:%s diag:%s disc:%d/%d",
+		s.Config.Key(), s.State, s.RemoteState, s.Diag, s.LocalDisc, s.RemoteDisc)
+}
+
+//
+// Sender sends bfd control packets of session.
+//
+type Sender interface {
+	Send([]byte) error
+	Close() error
+}
+
+//
+// Session is bfd session in asynchronous mode (RFC5880).
+// Intervals received from remote system are in microseconds.
+//
+type Session struct {
+	mutex sync.Mutex
+
+	config      SessionConfig
+	state       State
+	remoteState State
+	diag        Diag
+	localDisc   uint32
+	remoteDisc  uint32
+	remoteMinRx uint32
+	remoteMinTx uint32
+	remoteMult  uint8
+	poll        bool
+	final       bool
+	txPackets   uint64
+	rxPackets   uint64
+	upCount     uint64
+	lastChange  time.Time
+
+	sender Sender
+	recvCh chan *ControlPacket
+	done   chan struct{}
+	log    *log.Entry
+}
+
+//
+// NewSession returns new session in Down state.
+//
+func NewSession(config *SessionConfig, localDisc uint32, sender Sender) *Session {
+	return &Session{
+		config:      *config,
+		state:       STATE_DOWN,
+		remoteState: STATE_DOWN,
+		diag:        DIAG_NONE,
+		localDisc:   localDisc,
+		remoteMinRx: 1, // RFC5880 6.8.1
+		lastChange:  time.Now(),
+		sender:      sender,
+		recvCh:      make(chan *ControlPacket, SESSION_RECV_CHAN_SIZE),
+		done:        make(chan struct{}),
+		log:         log.WithFields(log.Fields{"module": "bfd", "session": config.Key()}),
+	}
+}
+
+//
+// Key returns key of session.
+//
+func (s *Session) Key() SessionKey {
+	return s.config.Key()
+}
+
+//
+// LocalDisc returns local discriminator.
+//
+func (s *Session) LocalDisc() uint32 {
+	return s.localDisc
+}
+
+//
+// Config returns copy of config.
+//
+func (s *Session) Config() SessionConfig {
+	return s.config
+}
+
+func (s *Session) setState(state State, diag Diag) bool {
+	if s.state == state {
+		return false
+	}
+
+	s.log.Infof("state %s -> %s diag:%s", s.state, state, diag)
+
+	if state == STATE_UP {
+		s.upCount++
+		// transmit interval changes from slow rate to configured one.
+		s.poll = s.config.DesiredMinTx != SLOW_TX_INTERVAL
+	}
+
+	if state == STATE_DOWN {
+		s.poll = false
+	}
+
+	s.state = state
+	s.diag = diag
+	s.lastChange = time.Now()
+	return true
+}
+
+//
+// desiredMinTx returns advertised interval.
+// It is not less than 1 second while session is not up (RFC5880 6.8.3).
+//
+func (s *Session) desiredMinTx() time.Duration {
+	if s.state != STATE_UP && s.config.DesiredMinTx < SLOW_TX_INTERVAL {
+		return SLOW_TX_INTERVAL
+	}
+	return s.config.DesiredMinTx
+}
+
+func (s *Session) txInterval() time.Duration {
+	tx := s.desiredMinTx()
+	if rx := usecToDuration(s.remoteMinRx); rx > tx {
+		return rx
+	}
+	return tx
+}
+
+func (s *Session) detectTime() time.Duration {
+	rx := s.config.RequiredMinRx
+	if tx := usecToDuration(s.remoteMinTx); tx > rx {
+		rx = tx
+	}
+	return time.Duration(s.remoteMult) * rx
+}
+
+//
+// TxInterval returns interval of periodic transmission (without jitter).
+//
+func (s *Session) TxInterval() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.txInterval()
+}
+
+//
+// DetectTime returns detection time.
+// It is zero until packet is received from remote system.
+//
+func (s *Session) DetectTime() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.detectTime()
+}
+
+//
+// JitteredTxInterval returns interval reduced by 0-25% to avoid
+// self-synchronization. It is reduced by 10-25% if detect mult is 1
+// (RFC5880 6.8.7).
+//
+func (s *Session) JitteredTxInterval() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	maxPercent := 100
+	if s.config.DetectMult == 1 {
+		maxPercent = 90
+	}
+	percent := 75 + rand.Intn(maxPercent-75+1)
+	return s.txInterval() * time.Duration(percent) / 100
+}
+
+//
+// Recv processes received control packet (RFC5880 6.8.6).
+// It returns changed=true if state is changed and
+// reply=true if packet with final bit should be sent immediately.
+//
+func (s *Session) Recv(p *ControlPacket) (changed bool, reply bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if p.YourDisc == 0 && p.State != STATE_DOWN && p.State != STATE_ADMIN_DOWN {
+		s.log.Debugf("your discriminator is zero. state:%s", p.State)
+		return false, false
+	}
+
+	if p.YourDisc != 0 && p.YourDisc != s.localDisc {
+		s.log.Debugf("your discriminator mismatch. %d", p.YourDisc)
+		return false, false
+	}
+
+	s.rxPackets++
+	s.remoteDisc = p.MyDisc
+	s.remoteState = p.State
+	s.remoteMinRx = p.RequiredMinRx
+	s.remoteMinTx = p.DesiredMinTx
+	s.remoteMult = p.DetectMult
+
+	if (p.Flags&FLAG_FINAL) != 0 && s.poll {
+		s.poll = false
+	}
+
+	if (p.Flags & FLAG_POLL) != 0 {
+		s.final = true
+		reply = true
+	}
+
+	switch s.state {
+	case STATE_ADMIN_DOWN:
+		return false, false
+
+	case STATE_DOWN:
+		switch p.State {
+		case STATE_DOWN:
+			changed = s.setState(STATE_INIT, DIAG_NONE)
+		case STATE_INIT:
+			changed = s.setState(STATE_UP, DIAG_NONE)
+		}
+
+	case STATE_INIT:
+		switch p.State {
+		case STATE_INIT, STATE_UP:
+			changed = s.setState(STATE_UP, DIAG_NONE)
+		case STATE_ADMIN_DOWN:
+			changed = s.setState(STATE_DOWN, DIAG_NEIGHBOR_DOWN)
+		}
+
+	case STATE_UP:
+		switch p.State {
+		case STATE_DOWN, STATE_ADMIN_DOWN:
+			changed = s.setState(STATE_DOWN, DIAG_NEIGHBOR_DOWN)
+		}
+	}
+
+	return changed, reply || changed
+}
+
+//
+// Expire processes expiration of detection time.
+// It returns true if state is changed to Down.
+//
+func (s *Session) Expire() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch s.state {
+	case STATE_INIT, STATE_UP:
+		s.remoteDisc = 0
+		s.remoteState = STATE_DOWN
+		s.remoteMult = 0
+		return s.setState(STATE_DOWN, DIAG_CTRL_DETECT_EXPIRED)
+
+	default:
+		return false
+	}
+}
+
+//
+// AdminDown changes state to AdminDown.
+//
+func (s *Session) AdminDown() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remoteMult = 0
+	return s.setState(STATE_ADMIN_DOWN, DIAG_ADMIN_DOWN)
+}
+
+//
+// NextPacket returns control packet to send.
+//
+func (s *Session) NextPacket() *ControlPacket {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	p := &ControlPacket{
+		Version:       BFD_VERSION,
+		Diag:          s.diag,
+		State:         s.state,
+		DetectMult:    s.config.DetectMult,
+		MyDisc:        s.localDisc,
+		YourDisc:      s.remoteDisc,
+		DesiredMinTx:  durationToUsec(s.desiredMinTx()),
+		RequiredMinRx: durationToUsec(s.config.RequiredMinRx),
+	}
+
+	if s.final {
+		p.Flags |= FLAG_FINAL
+		s.final = false
+	} else if s.poll {
+		p.Flags |= FLAG_POLL
+	}
+
+	s.txPackets++
+	return p
+}
+
+//
+// Status returns snapshot of session.
+//
+func (s *Session) Status() *SessionStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return &SessionStatus{
+		Config:      s.config,
+		State:       s.state,
+		RemoteState: s.remoteState,
+		Diag:        s.diag,
+		LocalDisc:   s.localDisc,
+		RemoteDisc:  s.remoteDisc,
+		TxInterval:  s.txInterval(),
+		DetectTime:  s.detectTime(),
+		TxPackets:   s.txPackets,
+		RxPackets:   s.rxPackets,
+		UpCount:     s.upCount,
+		LastChange:  s.lastChange,
+	}
+}
+
+func (s *Session) send() {
+	p := s.NextPacket()
+	if err := s.sender.Send(p.Encode()); err != nil {
+		s.log.Debugf("send error. %s", err)
+	}
+}
+
+//
+// Input queues received packet.
+//
+func (s *Session) Input(p *ControlPacket) {
+	select {
+	case s.recvCh <- p:
+	default:
+		s.log.Warnf("recv queue full. packet dropped.")
+	}
+}
+
+//
+// Start starts transmission and detection timers.
+// notify is called when state is changed.
+//
+func (s *Session) Start(notify func(*SessionStatus)) {
+	go s.serve(notify)
+}
+
+//
+// Stop sends AdminDown to remote system and stops session.
+//
+func (s *Session) Stop() {
+	if s.AdminDown() {
+		s.send()
+	}
+	close(s.done)
+	s.sender.Close()
+}
+
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
+
+func (s *Session) serve(notify func(*SessionStatus)) {
+	txTimer := time.NewTimer(0)
+	detectTimer := time.NewTimer(time.Hour)
+	detectTimer.Stop()
+
+	defer txTimer.Stop()
+	defer detectTimer.Stop()
+
+	s.log.Debugf("serve: start")
+
+	for {
+		select {
+		case p := <-s.recvCh:
+			changed, reply := s.Recv(p)
+
+			if d := s.DetectTime(); d > 0 {
+				resetTimer(detectTimer, d)
+			}
+
+			if reply {
+				s.send()
+			}
+
+			if changed {
+				// interval is changed with state.
+				resetTimer(txTimer, s.JitteredTxInterval())
+				notify(s.Status())
+			}
+
+		case <-txTimer.C:
+			s.send()
+			txTimer.Reset(s.JitteredTxInterval())
+
+		case <-detectTimer.C:
+			if s.Expire() {
+				notify(s.Status())
+			}
+
+		case <-s.done:
+			s.log.Debugf("serve: exit")
+			return
+		}
+	}
+}
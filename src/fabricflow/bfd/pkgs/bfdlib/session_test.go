@@ -0,0 +1,222 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+type testSender struct {
+	packets chan *ControlPacket
+}
+
+func newTestSender() *testSender {
+	return &testSender{
+		packets: make(chan *ControlPacket, 1024),
+	}
+}
+
+func (s *testSender) Send(b []byte) error {
+	p, err := DecodeControlPacket(b)
+	if err != nil {
+		return err
+	}
+	select {
+	case s.packets <- p:
+	default:
+	}
+	return nil
+}
+
+func (s *testSender) Close() error {
+	return nil
+}
+
+func newTestSession(disc uint32) *Session {
+	cfg := &SessionConfig{
+		Peer:          net.ParseIP("10.0.0.2"),
+		Ifname:        "eth1",
+		DesiredMinTx:  100 * time.Millisecond,
+		RequiredMinRx: 200 * time.Millisecond,
+		DetectMult:    3,
+	}
+	return NewSession(cfg, disc, newTestSender())
+}
+
+func TestSessionKey(t *testing.T) {
+	k1 := NewSessionKey(net.ParseIP("10.0.0.1"), "eth1", true)
+	k2 := NewSessionKey(net.ParseIP("10.0.0.1"), "eth2", true)
+	if k1 != k2 {
+		t.Errorf("NewSessionKey unmatch. %s %s", k1, k2)
+	}
+
+	k3 := NewSessionKey(net.ParseIP("10.0.0.1"), "eth1", false)
+	k4 := NewSessionKey(net.ParseIP("10.0.0.1"), "eth2", false)
+	if k3 == k4 {
+		t.Errorf("NewSessionKey unmatch. %s %s", k3, k4)
+	}
+}
+
+func TestSessionConfig_Validate(t *testing.T) {
+	cfg := SessionConfig{
+		Peer: net.ParseIP("10.0.0.1"),
+	}
+
+	if err := cfg.Validate(); err == nil {
+		t.Errorf("SessionConfig.Validate must be error. %s", &cfg)
+	}
+
+	cfg.SetDefaults(DESIRED_MIN_TX_DEFAULT, REQUIRED_MIN_RX_DEFAULT, DETECT_MULT_DEFAULT)
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("SessionConfig.Validate error. %s", err)
+	}
+
+	cfg.Local = net.ParseIP("2001:db8::1")
+	if err := cfg.Validate(); err == nil {
+		t.Errorf("SessionConfig.Validate must be error. %s", &cfg)
+	}
+}
+
+func TestSession_Recv(t *testing.T) {
+	s := newTestSession(10)
+
+	p := &ControlPacket{
+		Version:       BFD_VERSION,
+		State:         STATE_DOWN,
+		DetectMult:    3,
+		MyDisc:        20,
+		DesiredMinTx:  300000,
+		RequiredMinRx: 50000,
+	}
+
+	// Down -> Init
+	if changed, reply := s.Recv(p); !changed || !reply {
+		t.Errorf("Session.Recv unmatch. changed=%t reply=%t", changed, reply)
+	}
+	if st := s.Status(); st.State != STATE_INIT || st.RemoteDisc != 20 {
+		t.Errorf("Session.Recv unmatch. %s", st)
+	}
+
+	// zero your discriminator with state Init/Up must be discarded.
+	for _, state := range []State{STATE_INIT, STATE_UP} {
+		p0 := *p
+		p0.State = state
+		if changed, reply := s.Recv(&p0); changed || reply {
+			t.Errorf("Session.Recv unmatch. %s changed=%t reply=%t", state, changed, reply)
+		}
+	}
+	if st := s.Status(); st.State != STATE_INIT || st.RxPackets != 1 {
+		t.Errorf("Session.Recv unmatch. %s", st)
+	}
+
+	// Init -> Up
+	p.State = STATE_UP
+	p.YourDisc = 10
+	if changed, _ := s.Recv(p); !changed {
+		t.Errorf("Session.Recv unmatch. changed=%t", changed)
+	}
+	if st := s.Status(); st.State != STATE_UP || st.UpCount != 1 {
+		t.Errorf("Session.Recv unmatch. %s", st)
+	}
+
+	// tx: max(local desired, remote required), detect: remote mult * max(local required, remote desired)
+	if d := s.TxInterval(); d != 100*time.Millisecond {
+		t.Errorf("Session.TxInterval unmatch. %s", d)
+	}
+	if d := s.DetectTime(); d != 900*time.Millisecond {
+		t.Errorf("Session.DetectTime unmatch. %s", d)
+	}
+
+	// discriminator mismatch
+	p.YourDisc = 11
+	p.State = STATE_DOWN
+	if changed, _ := s.Recv(p); changed {
+		t.Errorf("Session.Recv unmatch. changed=%t", changed)
+	}
+
+	// Up -> Down
+	p.YourDisc = 10
+	if changed, _ := s.Recv(p); !changed {
+		t.Errorf("Session.Recv unmatch. changed=%t", changed)
+	}
+	if st := s.Status(); st.State != STATE_DOWN || st.Diag != DIAG_NEIGHBOR_DOWN {
+		t.Errorf("Session.Recv unmatch. %s", st)
+	}
+}
+
+func TestSession_Expire(t *testing.T) {
+	s := newTestSession(10)
+
+	if s.Expire() {
+		t.Errorf("Session.Expire must be false in Down state.")
+	}
+
+	s.Recv(&ControlPacket{Version: BFD_VERSION, State: STATE_INIT, DetectMult: 3, MyDisc: 20, YourDisc: 10})
+	if st := s.Status(); st.State != STATE_UP {
+		t.Errorf("Session.Recv unmatch. %s", st)
+	}
+
+	if !s.Expire() {
+		t.Errorf("Session.Expire must be true in Up state.")
+	}
+	if st := s.Status(); st.State != STATE_DOWN || st.Diag != DIAG_CTRL_DETECT_EXPIRED || st.RemoteDisc != 0 {
+		t.Errorf("Session.Expire unmatch. %s", st)
+	}
+}
+
+func TestSession_PollFinal(t *testing.T) {
+	s := newTestSession(10)
+
+	if p := s.NextPacket(); p.DesiredMinTx != 1000000 || p.Flags != 0 {
+		t.Errorf("Session.NextPacket unmatch. %s", p)
+	}
+
+	s.Recv(&ControlPacket{Version: BFD_VERSION, State: STATE_INIT, DetectMult: 3, MyDisc: 20, YourDisc: 10})
+
+	// poll sequence after interval is changed.
+	if p := s.NextPacket(); p.DesiredMinTx != 100000 || p.Flags != FLAG_POLL || p.YourDisc != 20 {
+		t.Errorf("Session.NextPacket unmatch. %s", p)
+	}
+
+	s.Recv(&ControlPacket{Version: BFD_VERSION, State: STATE_UP, Flags: FLAG_FINAL, DetectMult: 3, MyDisc: 20, YourDisc: 10})
+	if p := s.NextPacket(); p.Flags != 0 {
+		t.Errorf("Session.NextPacket unmatch. %s", p)
+	}
+
+	// reply final to poll.
+	if _, reply := s.Recv(&ControlPacket{Version: BFD_VERSION, State: STATE_UP, Flags: FLAG_POLL, DetectMult: 3, MyDisc: 20, YourDisc: 10}); !reply {
+		t.Errorf("Session.Recv unmatch. reply=%t", reply)
+	}
+	if p := s.NextPacket(); p.Flags != FLAG_FINAL {
+		t.Errorf("Session.NextPacket unmatch. %s", p)
+	}
+}
+
+func TestSession_JitteredTxInterval(t *testing.T) {
+	s := newTestSession(10)
+	s.Recv(&ControlPacket{Version: BFD_VERSION, State: STATE_INIT, DetectMult: 3, MyDisc: 20, YourDisc: 10})
+
+	for i := 0; i < 100; i++ {
+		d := s.JitteredTxInterval()
+		if d < 75*time.Millisecond || d > 100*time.Millisecond {
+			t.Errorf("Session.JitteredTxInterval out of range. %s", d)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfdlib
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	UDP_RECV_BUF_SIZE  = 1500
+	UDP_OOB_BUF_SIZE   = 128
+	UDP_SRC_PORT_RETRY = 32
+)
+
+func isIPv4(ip net.IP) bool {
+	return ip.To4() != nil
+}
+
+func bfdPort(multihop bool) int {
+	if multihop {
+		return BFD_PORT_MULTIHOP
+	}
+	return BFD_PORT_SINGLEHOP
+}
+
+//
+// UDPSender sends control packets to peer.
+// source port is selected from 49152-65535 (RFC5881 4).
+//
+type UDPSender struct {
+	conn *net.UDPConn
+}
+
+//
+// NewUDPSender returns new UDPSender.
+// single-hop session is bound to interface if ifname is specified.
+//
+func NewUDPSender(config *SessionConfig) (Sender, error) {
+	ifname := ""
+	if !config.MultiHop {
+		ifname = config.Ifname
+	}
+
+	v4 := isIPv4(config.Peer)
+
+	control := func(network, address string, c syscall.RawConn) error {
+		var err error
+		c.Control(func(fd uintptr) {
+			if v4 {
+				err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_TTL, BFD_SINGLEHOP_TTL)
+			} else {
+				err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_UNICAST_HOPS, BFD_SINGLEHOP_TTL)
+			}
+			if err == nil && len(ifname) != 0 {
+				err = unix.BindToDevice(int(fd), ifname)
+			}
+		})
+		return err
+	}
+
+	remote := &net.UDPAddr{
+		IP:   config.Peer,
+		Port: bfdPort(config.MultiHop),
+		Zone: ifname,
+	}
+
+	port := BFD_SRC_PORT_MIN + rand.Intn(BFD_SRC_PORT_MAX-BFD_SRC_PORT_MIN+1)
+
+	var lastErr error
+	for i := 0; i < UDP_SRC_PORT_RETRY; i++ {
+		dialer := net.Dialer{
+			LocalAddr: &net.UDPAddr{IP: config.Local, Port: port},
+			Control:   control,
+		}
+
+		conn, err := dialer.Dial("udp", remote.String())
+		if err == nil {
+			return &UDPSender{conn: conn.(*net.UDPConn)}, nil
+		}
+
+		lastErr = err
+		if port++; port > BFD_SRC_PORT_MAX {
+			port = BFD_SRC_PORT_MIN
+		}
+	}
+
+	return nil, lastErr
+}
+
+//
+// Send sends packet.
+//
+func (s *UDPSender) Send(b []byte) error {
+	_, err := s.conn.Write(b)
+	return err
+}
+
+//
+// Close closes socket.
+//
+func (s *UDPSender) Close() error {
+	return s.conn.Close()
+}
+
+//
+// UDPListener receives control packets and dispatches them to manager.
+//
+type UDPListener struct {
+	conn     *net.UDPConn
+	v4       bool
+	multihop bool
+	log      *log.Entry
+}
+
+//
+// NewUDPListener returns new UDPListener.
+// network is "udp4" or "udp6".
+//
+func NewUDPListener(network string, multihop bool) (*UDPListener, error) {
+	var v4 bool
+	switch network {
+	case "udp4":
+		v4 = true
+	case "udp6":
+		v4 = false
+	default:
+		return nil, fmt.Errorf("invalid network. %s", network)
+	}
+
+	control := func(network, address string, c syscall.RawConn) error {
+		var err error
+		c.Control(func(fd uintptr) {
+			if v4 {
+				if err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_RECVTTL, 1); err == nil {
+					err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_PKTINFO, 1)
+				}
+			} else {
+				if err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_RECVHOPLIMIT, 1); err == nil {
+					err = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_RECVPKTINFO, 1)
+				}
+			}
+		})
+		return err
+	}
+
+	lc := net.ListenConfig{Control: control}
+	conn, err := lc.ListenPacket(nil, network, net.JoinHostPort("", strconv.Itoa(bfdPort(multihop))))
+	if err != nil {
+		return nil, err
+	}
+
+	return &UDPListener{
+		conn:     conn.(*net.UDPConn),
+		v4:       v4,
+		multihop: multihop,
+		log:      log.WithFields(log.Fields{"module": "bfd", "listen": conn.LocalAddr()}),
+	}, nil
+}
+
+//
+// parseRecvInfo returns ttl and ifindex in control messages.
+//
+func (l *UDPListener) parseRecvInfo(oob []byte) (uint8, int, error) {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		ttl     uint8
+		ifindex int
+	)
+
+	for _, msg := range msgs {
+		switch {
+		case msg.Header.Level == unix.IPPROTO_IP && msg.Header.Type == unix.IP_TTL && len(msg.Data) >= 4:
+			ttl = uint8(*(*int32)(unsafe.Pointer(&msg.Data[0])))
+
+		case msg.Header.Level == unix.IPPROTO_IP && msg.Header.Type == unix.IP_PKTINFO && len(msg.Data) >= unix.SizeofInet4Pktinfo:
+			info := (*unix.Inet4Pktinfo)(unsafe.Pointer(&msg.Data[0]))
+			ifindex = int(info.Ifindex)
+
+		case msg.Header.Level == unix.IPPROTO_IPV6 && msg.Header.Type == unix.IPV6_HOPLIMIT && len(msg.Data) >= 4:
+			ttl = uint8(*(*int32)(unsafe.Pointer(&msg.Data[0])))
+
+		case msg.Header.Level == unix.IPPROTO_IPV6 && msg.Header.Type == unix.IPV6_PKTINFO && len(msg.Data) >= unix.SizeofInet6Pktinfo:
+			info := (*unix.Inet6Pktinfo)(unsafe.Pointer(&msg.Data[0]))
+			ifindex = int(info.Ifindex)
+		}
+	}
+
+	return ttl, ifindex, nil
+}
+
+//
+// Serve receives packets until listener is closed.
+//
+func (l *UDPListener) Serve(m *Manager) {
+	buf := make([]byte, UDP_RECV_BUF_SIZE)
+	oob := make([]byte, UDP_OOB_BUF_SIZE)
+
+	l.log.Infof("Serve: start")
+
+	for {
+		n, oobn, _, addr, err := l.conn.ReadMsgUDP(buf, oob)
+		if err != nil {
+			l.log.Infof("Serve: exit. %s", err)
+			return
+		}
+
+		p, err := DecodeControlPacket(buf[:n])
+		if err != nil {
+			l.log.Debugf("Serve: invalid packet from %s. %s", addr, err)
+			continue
+		}
+
+		ttl, ifindex, err := l.parseRecvInfo(oob[:oobn])
+		if err != nil {
+			l.log.Debugf("Serve: invalid control message from %s. %s", addr, err)
+			continue
+		}
+
+		info := &RecvInfo{
+			Peer:     addr.IP,
+			TTL:      ttl,
+			MultiHop: l.multihop,
+		}
+
+		if ifindex != 0 {
+			if iface, err := net.InterfaceByIndex(ifindex); err == nil {
+				info.Ifname = iface.Name
+			}
+		}
+
+		if err := m.Recv(p, info); err != nil {
+			l.log.Debugf("Serve: %s", err)
+		}
+	}
+}
+
+//
+// Close closes listener.
+//
+func (l *UDPListener) Close() error {
+	return l.conn.Close()
+}
@@ -0,0 +1,132 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lldp
+
+import (
+	"context"
+	"fabricflow/ffctl/fflib"
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type Command struct {
+	fibc *fflib.FibcClient
+
+	dpID         uint64
+	mismatchOnly bool
+}
+
+func NewCommand() *Command {
+	return &Command{
+		fibc: fflib.NewFibcClient(),
+	}
+}
+
+func (c *Command) setFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.fibc.Host, "fibc-addr", "", fflib.FibcHost, "fibcd addr.")
+	cmd.Flags().Uint16VarP(&c.fibc.Port, "fibc-port", "", fflib.FibcPort, "fibcd port.")
+	cmd.Flags().Uint64VarP(&c.dpID, "dp-id", "", 0, "dp_id. (default: all)")
+	cmd.Flags().BoolVarP(&c.mismatchOnly, "mismatch", "", false, "show mismatched neighbors only.")
+	return cmd
+}
+
+func (c *Command) show() error {
+	return c.fibc.Connect(func(client fibcapi.FIBCApApiClient) error {
+		return c.writeNeighbors(os.Stdout, client)
+	})
+}
+
+func (c *Command) writeNeighbors(w io.Writer, client fibcapi.FIBCApApiClient) error {
+	req := &fibcapi.ApGetLldpNeighborsRequest{
+		DpId: c.dpID,
+	}
+
+	stream, err := client.GetLldpNeighbors(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	total, mismatch := 0, 0
+
+	fmt.Fprintf(w, "%-16s %-5s %-12s %-12s %-16s %-16s %-4s %-4s %s\n",
+		"DPID", "PORT", "RE_ID", "IFNAME", "SYSTEM_NAME", "PORT_ID", "TTL", "AGE", "STATE")
+
+FOR_LOOP:
+	for {
+		n, err := stream.Recv()
+		if err == io.EOF {
+			break FOR_LOOP
+		}
+		if err != nil {
+			return err
+		}
+
+		total++
+		if n.Mismatch {
+			mismatch++
+		} else if c.mismatchOnly {
+			continue
+		}
+
+		writeNeighbor(w, n, now)
+	}
+
+	fmt.Fprintf(w, "NEIGHBORS: %d, MISMATCH: %d\n", total, mismatch)
+
+	return nil
+}
+
+func writeNeighbor(w io.Writer, n *fibcapi.LldpNeighbor, now int64) {
+	state := "OK"
+	if n.Mismatch {
+		state = fmt.Sprintf("MISMATCH (expected %s)", n.Expected)
+	} else if len(n.Expected) == 0 {
+		state = "-"
+	}
+
+	fmt.Fprintf(w, "%-16d %-5d %-12s %-12s %-16s %-16s %-4d %-4d %s\n",
+		n.DpId, n.PortNo, n.ReId, n.Ifname, n.SystemName, n.PortId, n.Ttl, now-n.LastUpdate, state)
+}
+
+func NewCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "lldp",
+		Short: "lldp command.",
+	}
+
+	lldp := NewCommand()
+
+	rootCmd.AddCommand(lldp.setFlags(
+		&cobra.Command{
+			Use:     "show",
+			Short:   "show lldp neighbors learned by fibcd.",
+			Aliases: []string{"s"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return lldp.show()
+			},
+		},
+	))
+
+	return rootCmd
+}
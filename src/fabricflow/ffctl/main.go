@@ -23,6 +23,7 @@ import (
 	"fabricflow/ffctl/container"
 	dhcplib "fabricflow/ffctl/dhcp"
 	"fabricflow/ffctl/ethtools"
+	"fabricflow/ffctl/lldp"
 	"fabricflow/ffctl/maintenance"
 	"fabricflow/ffctl/mkpb"
 	"fabricflow/ffctl/monitor"
@@ -70,6 +71,7 @@ func rootCmd(name string) *cobra.Command {
 		container.NewCmd(),
 		dhcplib.NewIPv4Cmd(),
 		ethtools.NewCmd(),
+		lldp.NewCmd(),
 		mkpb.NewCmd(),
 		monitor.NewCmd(),
 		maintenance.NewCmd(),
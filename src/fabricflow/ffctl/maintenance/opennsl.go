@@ -41,6 +41,8 @@ var openNSLDumperList = []opennsl.Dumper{
 	opennsl.NewL3Route(),
 	opennsl.NewTunnelInitiator(),
 	opennsl.NewTunnelTerminator(),
+	opennsl.NewMirrorSession(),
+	opennsl.NewTrunk(),
 	opennsl.NewIDMap(),
 }
 
@@ -210,6 +212,8 @@ func NewOpennNSLCommand() *cobra.Command {
 		},
 	))
 
+	rootCmd.AddCommand(NewOpenNSLMirrorCommand())
+
 	rootCmd.AddCommand(
 		&cobra.Command{
 			Use:   "data-names",
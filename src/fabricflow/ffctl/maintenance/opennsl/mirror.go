@@ -0,0 +1,67 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opennsl
+
+import (
+	"context"
+	"fmt"
+	api "gonsl/api"
+	"io"
+	"strings"
+)
+
+type MirrorSession struct {
+}
+
+func NewMirrorSession() *MirrorSession {
+	return &MirrorSession{}
+}
+
+func (e *MirrorSession) Name() string {
+	return "mirror"
+}
+
+func (e *MirrorSession) Dump(w io.Writer, client api.GoNSLApiClient) error {
+	reply, err := client.GetMirrorSessions(context.Background(), api.NewGetMirrorSessionsRequest())
+	if err != nil {
+		return err
+	}
+
+	for _, m := range reply.Sessions {
+		fmt.Fprintf(w, "Mirror: name:%s type:%s dir:%s src:%v dst:%d id:%d\n",
+			m.GetName(),
+			strings.ToLower(m.GetType().String()),
+			strings.ToLower(m.GetDirection().String()),
+			m.GetSrcPorts(),
+			m.GetDstPort(),
+			m.GetDestId(),
+		)
+
+		if m.GetType() == api.MirrorSession_ERSPAN {
+			fmt.Fprintf(w, "        ip:%s-%s mac:%s-%s vlan:%d ttl:%d tos:%d\n",
+				m.GetSrcIp(), m.GetDstIp(),
+				m.GetSrcMac(), m.GetDstMac(),
+				m.GetVlan(),
+				m.GetTtl(),
+				m.GetTos(),
+			)
+		}
+	}
+
+	return nil
+}
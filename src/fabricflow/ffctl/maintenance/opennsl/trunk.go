@@ -0,0 +1,77 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opennsl
+
+import (
+	"context"
+	"fmt"
+	api "gonsl/api"
+	"io"
+	"strings"
+)
+
+type Trunk struct {
+}
+
+func NewTrunk() *Trunk {
+	return &Trunk{}
+}
+
+func (e *Trunk) Name() string {
+	return "trunk"
+}
+
+func (e *Trunk) Dump(w io.Writer, client api.GoNSLApiClient) error {
+	reply, err := client.GetTrunks(context.Background(), api.NewGetTrunksRequest())
+	if err != nil {
+		return err
+	}
+
+	for _, t := range reply.Trunks {
+		fmt.Fprintf(w, "Trunk: id:%d port:0x%x vid:%d mode:%s hash:%s psc:%s min:%d agg:%d\n",
+			t.GetTrunkId(),
+			t.GetPortId(),
+			t.GetVlanVid(),
+			strings.ToLower(t.GetMode()),
+			strings.ToLower(t.GetHashPolicy()),
+			t.GetPsc(),
+			t.GetMinLinks(),
+			t.GetAggregatorId(),
+		)
+
+		for _, m := range t.Members {
+			fmt.Fprintf(w, "        port:%d enabled:%t up:%t active:%t col:%t dist:%t agg:%d\n",
+				m.GetPort(),
+				m.GetEnabled(),
+				m.GetLinkUp(),
+				m.GetActive(),
+				m.GetCollecting(),
+				m.GetDistributing(),
+				m.GetAggregatorId(),
+			)
+			fmt.Fprintf(w, "        in:%d octets %d ucast, out:%d octets %d ucast\n",
+				m.GetInOctets(),
+				m.GetInUcastPackets(),
+				m.GetOutOctets(),
+				m.GetOutUcastPackets(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"context"
+	"fabricflow/ffctl/fflib"
+	"fabricflow/ffctl/maintenance/opennsl"
+	"fmt"
+	gonslapi "gonsl/api"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type OpenNSLMirrorCmd struct {
+	gonsl *fflib.GonslClient
+
+	mtype     string
+	direction string
+	srcPorts  []uint
+	dstPort   uint32
+	srcIP     string
+	dstIP     string
+	srcMAC    string
+	dstMAC    string
+	vlan      uint16
+	ttl       uint8
+	tos       uint8
+}
+
+func NewOpenNSLMirrorCmd() *OpenNSLMirrorCmd {
+	return &OpenNSLMirrorCmd{
+		gonsl: fflib.NewGonslClient(),
+	}
+}
+
+func (c *OpenNSLMirrorCmd) setFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.gonsl.Host, "addr", "a", fflib.GonslHost, "OpenNSL agent addr.")
+	cmd.Flags().Uint16VarP(&c.gonsl.Port, "port", "p", fflib.GonslPort, "OpenNSL agent port.")
+	return cmd
+}
+
+func (c *OpenNSLMirrorCmd) setAddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.mtype, "type", "t", "span", "session type. (span or erspan)")
+	cmd.Flags().StringVarP(&c.direction, "direction", "d", "both", "mirrored traffic. (both, ingress or egress)")
+	cmd.Flags().UintSliceVarP(&c.srcPorts, "src-ports", "s", []uint{}, "source ports.")
+	cmd.Flags().Uint32VarP(&c.dstPort, "dst-port", "o", 0, "destination (output) port.")
+	cmd.Flags().StringVarP(&c.srcIP, "src-ip", "", "", "source ip of GRE header. (erspan)")
+	cmd.Flags().StringVarP(&c.dstIP, "dst-ip", "", "", "collector ip. (erspan)")
+	cmd.Flags().StringVarP(&c.srcMAC, "src-mac", "", "", "source mac. (erspan)")
+	cmd.Flags().StringVarP(&c.dstMAC, "dst-mac", "", "", "nexthop mac to collector. (erspan)")
+	cmd.Flags().Uint16VarP(&c.vlan, "vlan", "", 0, "vlan id. (erspan)")
+	cmd.Flags().Uint8VarP(&c.ttl, "ttl", "", 0, "ttl of GRE header. (erspan)")
+	cmd.Flags().Uint8VarP(&c.tos, "tos", "", 0, "tos of GRE header. (erspan)")
+	return c.setFlags(cmd)
+}
+
+func (c *OpenNSLMirrorCmd) newSession(name string) (*gonslapi.MirrorSession, error) {
+	mtype, ok := gonslapi.MirrorSession_Type_value[strings.ToUpper(c.mtype)]
+	if !ok {
+		return nil, fmt.Errorf("Invalid type. %s", c.mtype)
+	}
+
+	direction, ok := gonslapi.MirrorSession_Direction_value[strings.ToUpper(c.direction)]
+	if !ok {
+		return nil, fmt.Errorf("Invalid direction. %s", c.direction)
+	}
+
+	srcPorts := make([]uint32, len(c.srcPorts))
+	for index, port := range c.srcPorts {
+		srcPorts[index] = uint32(port)
+	}
+
+	return &gonslapi.MirrorSession{
+		Name:      name,
+		Type:      gonslapi.MirrorSession_Type(mtype),
+		Direction: gonslapi.MirrorSession_Direction(direction),
+		SrcPorts:  srcPorts,
+		DstPort:   c.dstPort,
+		SrcIp:     c.srcIP,
+		DstIp:     c.dstIP,
+		SrcMac:    c.srcMAC,
+		DstMac:    c.dstMAC,
+		Vlan:      uint32(c.vlan),
+		Ttl:       uint32(c.ttl),
+		Tos:       uint32(c.tos),
+	}, nil
+}
+
+func (c *OpenNSLMirrorCmd) add(name string) error {
+	session, err := c.newSession(name)
+	if err != nil {
+		return err
+	}
+
+	return c.gonsl.Connect(func(client gonslapi.GoNSLApiClient) error {
+		_, err := client.AddMirrorSession(context.Background(), gonslapi.NewAddMirrorSessionRequest(session))
+		return err
+	})
+}
+
+func (c *OpenNSLMirrorCmd) del(name string) error {
+	return c.gonsl.Connect(func(client gonslapi.GoNSLApiClient) error {
+		_, err := client.DelMirrorSession(context.Background(), gonslapi.NewDelMirrorSessionRequest(name))
+		return err
+	})
+}
+
+func (c *OpenNSLMirrorCmd) show() error {
+	return c.gonsl.Connect(func(client gonslapi.GoNSLApiClient) error {
+		return opennsl.NewMirrorSession().Dump(os.Stdout, client)
+	})
+}
+
+func NewOpenNSLMirrorCommand() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "mirror",
+		Short: "mirror session command.",
+	}
+
+	mirror := NewOpenNSLMirrorCmd()
+
+	rootCmd.AddCommand(mirror.setAddFlags(
+		&cobra.Command{
+			Use:   "add <name>",
+			Short: "add mirror session.",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return mirror.add(args[0])
+			},
+		},
+	))
+
+	rootCmd.AddCommand(mirror.setFlags(
+		&cobra.Command{
+			Use:     "del <name>",
+			Aliases: []string{"delete"},
+			Short:   "delete mirror session.",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return mirror.del(args[0])
+			},
+		},
+	))
+
+	rootCmd.AddCommand(mirror.setFlags(
+		&cobra.Command{
+			Use:     "show",
+			Aliases: []string{"list"},
+			Short:   "show mirror sessions.",
+			Args:    cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return mirror.show()
+			},
+		},
+	))
+
+	return rootCmd
+}
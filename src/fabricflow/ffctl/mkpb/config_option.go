@@ -66,6 +66,8 @@ type OptionConfig struct {
 	VPNPseudoBridge      string   `mapstructure:"vpn-pseudo-bridge"`
 	GonsldListenAddr     string   `mapstructure:"gonsld-listen-addr"`
 	GonsldListenPort     uint16   `mapstructure:"gonsld-listen-port"`
+	GonsldQosTrust       string   `mapstructure:"gonsld-qos-trust"`
+	GonsldQosSched       string   `mapstructure:"gonsld-qos-sched"`
 	InChOpeSSHPort       uint16   `mapstructure:"inchannel-ope-ssh-port"`
 	InChOpeSNMPPort      uint16   `mapstructure:"inchannel-ope-snmp-port"`
 	InChOpeSNMPTrapPort  uint16   `mapstructure:"inchannel-ope-snmp-trap-port"`
@@ -125,6 +127,8 @@ var optionConfigDefaults = map[string]interface{}{
 	"vpn-pseudo-bridge":            "ffbr0",
 	"gonsld-listen-addr":           "",
 	"gonsld-listen-port":           uint16(50061),
+	"gonsld-qos-trust":             "dscp",
+	"gonsld-qos-sched":             "",
 	"inchannel-ope-ssh-port":       uint16(122),
 	"inchannel-ope-snmp-port":      uint16(1161),
 	"inchannel-ope-snmp-trap-port": uint16(1162),
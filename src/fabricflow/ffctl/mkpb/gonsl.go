@@ -82,6 +82,8 @@ func (c *GonslCmd) createGonsldYaml(playbookName string) error {
 	t.L3PortStart = opt.L3PortStart
 	t.L3PortEnd = opt.L3PortEnd
 	t.L3VlanBase = opt.L3VlanBase
+	t.QosTrust = opt.GonsldQosTrust
+	t.QosSched = opt.GonsldQosSched
 
 	return t.Execute(f)
 }
@@ -38,10 +38,33 @@ dpaths:
 
     block_bcast:
       range: { min: {{ .L3PortStart }}, max: {{ .L3PortEnd }}, base_vid: {{ .L3VlanBase }} }
+{{- if .QosSched }}
+
+    qos:
+      classes:
+        - { tc: 7, dscp: [48, 56], pcp: [7] }
+        - { tc: 6, pcp: [6] }
+        - { tc: 5, dscp: [46], pcp: [5] }
+        - { tc: 4, dscp: [32, 34, 36, 38], pcp: [4] }
+        - { tc: 3, dscp: [24, 26, 28, 30], pcp: [3] }
+        - { tc: 2, dscp: [16, 18, 20, 22], pcp: [2] }
+        - { tc: 1, dscp: [8, 10, 12, 14], pcp: [1] }
+      ports:
+        - ports: []
+          trust: {{ .QosTrust }}
+          sched: {{ .QosSched }}
+          queues:
+          {{- range $tc := .QosQueues }}
+            - { tc: {{ $tc }}, weight: {{ $tc | inc }} }
+          {{- end }}
+{{- end }}
 `
 
 func NewPlaybookGonsldYamlTemplate() *template.Template {
-	return template.Must(template.New("gonsld.yaml").Parse(playbookGonsldYaml))
+	funcs := template.FuncMap{
+		"inc": func(n int) int { return n + 1 },
+	}
+	return template.Must(template.New("gonsld.yaml").Funcs(funcs).Parse(playbookGonsldYaml))
 }
 
 type PlaybookGonsldYaml struct {
@@ -54,6 +77,8 @@ type PlaybookGonsldYaml struct {
 	L3PortStart     uint32
 	L3PortEnd       uint32
 	L3VlanBase      uint16
+	QosTrust        string
+	QosSched        string // qos is disabled if empty.
 }
 
 func NewPlaybookGonsldYaml() *PlaybookGonsldYaml {
@@ -67,9 +92,15 @@ func NewPlaybookGonsldYaml() *PlaybookGonsldYaml {
 		L3PortStart:     1,
 		L3PortEnd:       190,
 		L3VlanBase:      3900,
+		QosTrust:        "dscp",
+		QosSched:        "",
 	}
 }
 
+func (p *PlaybookGonsldYaml) QosQueues() []int {
+	return []int{7, 6, 5, 4, 3, 2, 1, 0}
+}
+
 func (p *PlaybookGonsldYaml) Execute(w io.Writer) error {
 	return NewPlaybookGonsldYamlTemplate().Execute(w, p)
 }
@@ -21,12 +21,19 @@ import (
 	"context"
 	"fabricflow/ffctl/fflib"
 	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"io"
+	"os"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
 )
 
 type AuditCmd struct {
 	fibc *fflib.FibcClient
+
+	reID   string
+	repair bool
 }
 
 func NewAuditCmd() *AuditCmd {
@@ -50,3 +57,80 @@ func (c *AuditCmd) routeCnt() error {
 		return err
 	})
 }
+
+func (c *AuditCmd) setModsFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringVarP(&c.reID, "re-id", "", "", "re_id. (default: all)")
+	cmd.Flags().BoolVarP(&c.repair, "repair", "", false, "repair unmatched entries in dp.")
+	return c.setFlags(cmd)
+}
+
+func (c *AuditCmd) mods() error {
+	return c.fibc.Connect(func(client fibcapi.FIBCApApiClient) error {
+		return c.writeMods(os.Stdout, client)
+	})
+}
+
+func (c *AuditCmd) writeMods(w io.Writer, client fibcapi.FIBCApApiClient) error {
+	req := fibcapi.NewApAuditModsRequest(c.reID, c.repair)
+
+	stream, err := client.AuditMods(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	results := map[fibcapi.ApAuditModsEntry_Result]int{}
+	repaired := 0
+
+FOR_LOOP:
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break FOR_LOOP
+		}
+		if err != nil {
+			return err
+		}
+
+		results[e.Result]++
+		if e.Repaired {
+			repaired++
+		}
+
+		writeAuditModsEntry(w, e)
+	}
+
+	fmt.Fprintf(w, "MISSING: %d, EXTRA: %d, DIFFERENT: %d, REPAIRED: %d\n",
+		results[fibcapi.ApAuditModsEntry_MISSING],
+		results[fibcapi.ApAuditModsEntry_EXTRA],
+		results[fibcapi.ApAuditModsEntry_DIFFERENT],
+		repaired,
+	)
+
+	return nil
+}
+
+func writeAuditModsEntry(w io.Writer, e *fibcapi.ApAuditModsEntry) {
+	repaired := ""
+	if e.Repaired {
+		repaired = " (repaired)"
+	}
+
+	fmt.Fprintf(w, "%-9s re_id:%s dp_id:%d%s\n", e.Result, e.ReId, e.DpId, repaired)
+
+	writeMod := func(prefix string, m proto.Message) {
+		fmt.Fprintf(w, "  %s %s\n", prefix, proto.CompactTextString(m))
+	}
+
+	if e.VmFlow != nil {
+		writeMod("VM:", e.VmFlow)
+	}
+	if e.DpFlow != nil {
+		writeMod("DP:", e.DpFlow)
+	}
+	if e.VmGroup != nil {
+		writeMod("VM:", e.VmGroup)
+	}
+	if e.DpGroup != nil {
+		writeMod("DP:", e.DpGroup)
+	}
+}
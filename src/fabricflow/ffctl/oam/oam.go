@@ -51,5 +51,16 @@ func NewAuditCommand() *cobra.Command {
 		},
 	))
 
+	rootCmd.AddCommand(audit.setModsFlags(
+		&cobra.Command{
+			Use:     "mods",
+			Short:   "audit flow/group entries between vm and dp.",
+			Aliases: []string{"m"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return audit.mods()
+			},
+		},
+	))
+
 	return rootCmd
 }
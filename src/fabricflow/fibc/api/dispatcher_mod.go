@@ -56,6 +56,12 @@ func DispatchFlowMod(hdr *fibcnet.Header, mod *FlowMod, handler interface{}) err
 			return nil
 		}
 
+	case FlowMod_MULTICAST_ROUTING:
+		if h, ok := handler.(FIBCMulticastRoutingFlowModHandler); ok {
+			h.FIBCMulticastRoutingFlowMod(hdr, mod, mod.GetMcast())
+			return nil
+		}
+
 	case FlowMod_BRIDGING:
 		if h, ok := handler.(FIBCBridgingFlowModHandler); ok {
 			h.FIBCBridgingFlowMod(hdr, mod, mod.GetBridging())
@@ -68,6 +74,12 @@ func DispatchFlowMod(hdr *fibcnet.Header, mod *FlowMod, handler interface{}) err
 			return nil
 		}
 
+	case FlowMod_SRV6_LOCAL_SID:
+		if h, ok := handler.(FIBCSRv6LocalSidFlowModHandler); ok {
+			h.FIBCSRv6LocalSidFlowMod(hdr, mod, mod.GetSrv6Local())
+			return nil
+		}
+
 	default:
 		log.Warnf("DispatchFlowMod: not dispatched. %s", mod.Table)
 		return fmt.Errorf("invalid type. %s", mod.Table)
@@ -96,6 +108,36 @@ func DispatchGroupMod(hdr *fibcnet.Header, mod *GroupMod, handler interface{}) e
 			return nil
 		}
 
+	case GroupMod_L3_ECMP:
+		if h, ok := handler.(FIBCL3EcmpGroupModHandler); ok {
+			h.FIBCL3EcmpGroupMod(hdr, mod, mod.GetL3Ecmp())
+			return nil
+		}
+
+	case GroupMod_L3_MULTICAST:
+		if h, ok := handler.(FIBCL3MulticastGroupModHandler); ok {
+			h.FIBCL3MulticastGroupMod(hdr, mod, mod.GetL3Mcast())
+			return nil
+		}
+
+	case GroupMod_L2_OVERLAY_FL_UC, GroupMod_L2_OVERLAY_FL_MC, GroupMod_L2_OVERLAY_MC_UC, GroupMod_L2_OVERLAY_MC_MC:
+		if h, ok := handler.(FIBCL2OverlayGroupModHandler); ok {
+			h.FIBCL2OverlayGroupMod(hdr, mod, mod.GetL2Overlay())
+			return nil
+		}
+
+	case GroupMod_VXLAN_TUNNEL:
+		if h, ok := handler.(FIBCVxlanTunnelModHandler); ok {
+			h.FIBCVxlanTunnelMod(hdr, mod, mod.GetVxlanTunnel())
+			return nil
+		}
+
+	case GroupMod_SRV6_ENCAP:
+		if h, ok := handler.(FIBCSRv6EncapGroupModHandler); ok {
+			h.FIBCSRv6EncapGroupMod(hdr, mod, mod.GetSrv6Encap())
+			return nil
+		}
+
 	case GroupMod_MPLS_INTERFACE:
 		if h, ok := handler.(FIBCMPLSInterfaceGroupModHandler); ok {
 			h.FIBCMPLSInterfaceGroupMod(hdr, mod, mod.GetMplsIface())
@@ -41,6 +41,15 @@ func DispatchApMonitorReply(r *ApMonitorReply, i interface{}) error {
 			return nil
 		}
 
+	case *ApMonitorReply_PortStatus:
+		if h, ok := i.(ApMonitorReplyPortStatusHandler); ok {
+			hdr := fibcnet.Header{
+				Type: uint16(FFM_AP_MON_REPLY),
+			}
+			h.FIBCApMonitorReplyPortStatus(&hdr, body.PortStatus)
+			return nil
+		}
+
 	default:
 		return fmt.Errorf("Invalid type. %v", body)
 	}
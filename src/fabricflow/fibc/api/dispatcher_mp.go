@@ -49,6 +49,18 @@ func DispatchFFMultipartRequest(hdr *fibcnet.Header, msg *FFMultipart_Request, i
 			return nil
 		}
 
+	case *FFMultipart_Request_Flow:
+		if h, ok := i.(FFMultipartFlowRequestHandler); ok {
+			h.FIBCFFMultipartFlowRequest(hdr, msg, mp.Flow)
+			return nil
+		}
+
+	case *FFMultipart_Request_GroupDesc:
+		if h, ok := i.(FFMultipartGroupDescRequestHandler); ok {
+			h.FIBCFFMultipartGroupDescRequest(hdr, msg, mp.GroupDesc)
+			return nil
+		}
+
 	default:
 		return fmt.Errorf("Invalid mp type. %v", mp)
 	}
@@ -83,6 +95,18 @@ func DispatchFFMultipartReply(hdr *fibcnet.Header, msg *FFMultipart_Reply, i int
 			return nil
 		}
 
+	case *FFMultipart_Reply_Flow:
+		if h, ok := i.(FFMultipartFlowReplyHandler); ok {
+			h.FIBCFFMultipartFlowReply(hdr, msg, mp.Flow)
+			return nil
+		}
+
+	case *FFMultipart_Reply_GroupDesc:
+		if h, ok := i.(FFMultipartGroupDescReplyHandler); ok {
+			h.FIBCFFMultipartGroupDescReply(hdr, msg, mp.GroupDesc)
+			return nil
+		}
+
 	default:
 		return fmt.Errorf("Invalid mp type. %v", mp)
 	}
@@ -33,6 +33,7 @@ const (
 	ETHTYPE_ARP     = unix.ETH_P_ARP     // 0x0806
 	ETHTYPE_VLAN_Q  = unix.ETH_P_8021Q   // 0x8100
 	ETHTYPE_VLAN_AD = unix.ETH_P_8021AD  // 0x88a8
+	ETHTYPE_LLDP    = unix.ETH_P_LLDP    // 0x88cc
 )
 
 const (
@@ -47,6 +48,7 @@ const (
 	HWADDR_MULTICAST6_MATCH = HWADDR_MULTICAST6 + "/" + HWADDR_MULTICAST6_MASK
 	HWADDR_ISIS_LEVEL1      = "01:80:C2:00:00:14"
 	HWADDR_ISIS_LEVEL2      = "01:80:C2:00:00:15"
+	HWADDR_LLDP             = "01:80:C2:00:00:0E"
 )
 
 var (
@@ -59,6 +61,7 @@ var (
 	HardwareAddrMulticast6Mask = net.HardwareAddr{0xff, 0xff, 0x00, 0x00, 0x00, 0x00}
 	HardwareAddrISISLevel1     = net.HardwareAddr{0x01, 0x80, 0xC2, 0x00, 0x00, 0x14}
 	HardwareAddrISISLevel2     = net.HardwareAddr{0x01, 0x80, 0xC2, 0x00, 0x00, 0x15}
+	HardwareAddrLLDP           = net.HardwareAddr{0x01, 0x80, 0xC2, 0x00, 0x00, 0x0E}
 )
 
 const (
@@ -335,6 +338,7 @@ var linkType_native_values = map[string]LinkType_Type{
 	"bridge_slave": LinkType_BRIDGE_SLAVE,
 	"bond":         LinkType_BOND,
 	"bond_slave":   LinkType_BOND_SLAVE,
+	"vxlan":        LinkType_VXLAN,
 }
 
 func ParseLinkTypeFromNative(s string) (LinkType_Type, error) {
@@ -353,7 +357,7 @@ func ParseLinkTypeFromNativeMust(s string, defaultType LinkType_Type) LinkType_T
 
 func (t LinkType_Type) IsVirtual() bool {
 	switch t {
-	case LinkType_IPTUN, LinkType_BRIDGE, LinkType_BOND:
+	case LinkType_IPTUN, LinkType_BRIDGE, LinkType_BOND, LinkType_VXLAN:
 		return true
 	default:
 		return false
@@ -242,6 +242,7 @@ const (
 	LinkType_BRIDGE_SLAVE LinkType_Type = 3
 	LinkType_BOND         LinkType_Type = 4
 	LinkType_BOND_SLAVE   LinkType_Type = 5
+	LinkType_VXLAN        LinkType_Type = 6
 )
 
 var LinkType_Type_name = map[int32]string{
@@ -251,6 +252,7 @@ var LinkType_Type_name = map[int32]string{
 	3: "BRIDGE_SLAVE",
 	4: "BOND",
 	5: "BOND_SLAVE",
+	6: "VXLAN",
 }
 
 var LinkType_Type_value = map[string]int32{
@@ -260,6 +262,7 @@ var LinkType_Type_value = map[string]int32{
 	"BRIDGE_SLAVE": 3,
 	"BOND":         4,
 	"BOND_SLAVE":   5,
+	"VXLAN":        6,
 }
 
 func (x LinkType_Type) String() string {
@@ -355,6 +358,7 @@ const (
 	FlowMod_MULTICAST_ROUTING FlowMod_Table = 40
 	FlowMod_BRIDGING          FlowMod_Table = 50
 	FlowMod_POLICY_ACL        FlowMod_Table = 60
+	FlowMod_SRV6_LOCAL_SID    FlowMod_Table = 70
 )
 
 var FlowMod_Table_name = map[int32]string{
@@ -372,6 +376,7 @@ var FlowMod_Table_name = map[int32]string{
 	40: "MULTICAST_ROUTING",
 	50: "BRIDGING",
 	60: "POLICY_ACL",
+	70: "SRV6_LOCAL_SID",
 }
 
 var FlowMod_Table_value = map[string]int32{
@@ -389,6 +394,7 @@ var FlowMod_Table_value = map[string]int32{
 	"MULTICAST_ROUTING": 40,
 	"BRIDGING":          50,
 	"POLICY_ACL":        60,
+	"SRV6_LOCAL_SID":    70,
 }
 
 func (x FlowMod_Table) String() string {
@@ -455,6 +461,8 @@ const (
 	GroupMod_MPLS_FF          GroupMod_GType = 166
 	GroupMod_MPLS_ECMP        GroupMod_GType = 168
 	GroupMod_L2_UF_INTERFACE  GroupMod_GType = 176
+	GroupMod_VXLAN_TUNNEL     GroupMod_GType = 192
+	GroupMod_SRV6_ENCAP       GroupMod_GType = 193
 )
 
 var GroupMod_GType_name = map[int32]string{
@@ -480,6 +488,8 @@ var GroupMod_GType_name = map[int32]string{
 	166: "MPLS_FF",
 	168: "MPLS_ECMP",
 	176: "L2_UF_INTERFACE",
+	192: "VXLAN_TUNNEL",
+	193: "SRV6_ENCAP",
 }
 
 var GroupMod_GType_value = map[string]int32{
@@ -505,6 +515,8 @@ var GroupMod_GType_value = map[string]int32{
 	"MPLS_FF":          166,
 	"MPLS_ECMP":        168,
 	"L2_UF_INTERFACE":  176,
+	"VXLAN_TUNNEL":     192,
+	"SRV6_ENCAP":       193,
 }
 
 func (x GroupMod_GType) String() string {
@@ -743,24 +755,85 @@ func (x BridgingFlow_Action_Name) String() string {
 }
 
 func (BridgingFlow_Action_Name) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{13, 1, 0}
+	return fileDescriptor_68149358b23bd798, []int{14, 1, 0}
+}
+
+type SRv6LocalSidFlow_Action_Name int32
+
+const (
+	SRv6LocalSidFlow_Action_UNSPEC  SRv6LocalSidFlow_Action_Name = 0
+	SRv6LocalSidFlow_Action_END     SRv6LocalSidFlow_Action_Name = 1
+	SRv6LocalSidFlow_Action_END_X   SRv6LocalSidFlow_Action_Name = 2
+	SRv6LocalSidFlow_Action_END_T   SRv6LocalSidFlow_Action_Name = 3
+	SRv6LocalSidFlow_Action_END_DX6 SRv6LocalSidFlow_Action_Name = 5
+	SRv6LocalSidFlow_Action_END_DX4 SRv6LocalSidFlow_Action_Name = 6
+	SRv6LocalSidFlow_Action_END_DT6 SRv6LocalSidFlow_Action_Name = 7
+	SRv6LocalSidFlow_Action_END_DT4 SRv6LocalSidFlow_Action_Name = 8
+)
+
+var SRv6LocalSidFlow_Action_Name_name = map[int32]string{
+	0: "UNSPEC",
+	1: "END",
+	2: "END_X",
+	3: "END_T",
+	5: "END_DX6",
+	6: "END_DX4",
+	7: "END_DT6",
+	8: "END_DT4",
+}
+
+var SRv6LocalSidFlow_Action_Name_value = map[string]int32{
+	"UNSPEC":  0,
+	"END":     1,
+	"END_X":   2,
+	"END_T":   3,
+	"END_DX6": 5,
+	"END_DX4": 6,
+	"END_DT6": 7,
+	"END_DT4": 8,
+}
+
+func (x SRv6LocalSidFlow_Action_Name) String() string {
+	return proto.EnumName(SRv6LocalSidFlow_Action_Name_name, int32(x))
+}
+
+func (SRv6LocalSidFlow_Action_Name) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{15, 1, 0}
 }
 
 type PolicyACLFlow_Action_Name int32
 
 const (
-	PolicyACLFlow_Action_UNSPEC PolicyACLFlow_Action_Name = 0
-	PolicyACLFlow_Action_OUTPUT PolicyACLFlow_Action_Name = 1
+	PolicyACLFlow_Action_UNSPEC    PolicyACLFlow_Action_Name = 0
+	PolicyACLFlow_Action_OUTPUT    PolicyACLFlow_Action_Name = 1
+	PolicyACLFlow_Action_SET_VRF   PolicyACLFlow_Action_Name = 2
+	PolicyACLFlow_Action_DROP      PolicyACLFlow_Action_Name = 3
+	PolicyACLFlow_Action_PERMIT    PolicyACLFlow_Action_Name = 4
+	PolicyACLFlow_Action_MIRROR    PolicyACLFlow_Action_Name = 5
+	PolicyACLFlow_Action_SET_QUEUE PolicyACLFlow_Action_Name = 6
+	PolicyACLFlow_Action_SET_DSCP  PolicyACLFlow_Action_Name = 7
 )
 
 var PolicyACLFlow_Action_Name_name = map[int32]string{
 	0: "UNSPEC",
 	1: "OUTPUT",
+	2: "SET_VRF",
+	3: "DROP",
+	4: "PERMIT",
+	5: "MIRROR",
+	6: "SET_QUEUE",
+	7: "SET_DSCP",
 }
 
 var PolicyACLFlow_Action_Name_value = map[string]int32{
-	"UNSPEC": 0,
-	"OUTPUT": 1,
+	"UNSPEC":    0,
+	"OUTPUT":    1,
+	"SET_VRF":   2,
+	"DROP":      3,
+	"PERMIT":    4,
+	"MIRROR":    5,
+	"SET_QUEUE": 6,
+	"SET_DSCP":  7,
 }
 
 func (x PolicyACLFlow_Action_Name) String() string {
@@ -768,7 +841,106 @@ func (x PolicyACLFlow_Action_Name) String() string {
 }
 
 func (PolicyACLFlow_Action_Name) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{14, 1, 0}
+	return fileDescriptor_68149358b23bd798, []int{16, 1, 0}
+}
+
+type BondAttrs_Mode int32
+
+const (
+	BondAttrs_BALANCE_RR    BondAttrs_Mode = 0
+	BondAttrs_ACTIVE_BACKUP BondAttrs_Mode = 1
+	BondAttrs_BALANCE_XOR   BondAttrs_Mode = 2
+	BondAttrs_BROADCAST     BondAttrs_Mode = 3
+	BondAttrs_IEEE_802_3AD  BondAttrs_Mode = 4
+	BondAttrs_BALANCE_TLB   BondAttrs_Mode = 5
+	BondAttrs_BALANCE_ALB   BondAttrs_Mode = 6
+)
+
+var BondAttrs_Mode_name = map[int32]string{
+	0: "BALANCE_RR",
+	1: "ACTIVE_BACKUP",
+	2: "BALANCE_XOR",
+	3: "BROADCAST",
+	4: "IEEE_802_3AD",
+	5: "BALANCE_TLB",
+	6: "BALANCE_ALB",
+}
+
+var BondAttrs_Mode_value = map[string]int32{
+	"BALANCE_RR":    0,
+	"ACTIVE_BACKUP": 1,
+	"BALANCE_XOR":   2,
+	"BROADCAST":     3,
+	"IEEE_802_3AD":  4,
+	"BALANCE_TLB":   5,
+	"BALANCE_ALB":   6,
+}
+
+func (x BondAttrs_Mode) String() string {
+	return proto.EnumName(BondAttrs_Mode_name, int32(x))
+}
+
+func (BondAttrs_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{18, 0}
+}
+
+type BondAttrs_HashPolicy int32
+
+const (
+	BondAttrs_LAYER2   BondAttrs_HashPolicy = 0
+	BondAttrs_LAYER3_4 BondAttrs_HashPolicy = 1
+	BondAttrs_LAYER2_3 BondAttrs_HashPolicy = 2
+	BondAttrs_ENCAP2_3 BondAttrs_HashPolicy = 3
+	BondAttrs_ENCAP3_4 BondAttrs_HashPolicy = 4
+)
+
+var BondAttrs_HashPolicy_name = map[int32]string{
+	0: "LAYER2",
+	1: "LAYER3_4",
+	2: "LAYER2_3",
+	3: "ENCAP2_3",
+	4: "ENCAP3_4",
+}
+
+var BondAttrs_HashPolicy_value = map[string]int32{
+	"LAYER2":   0,
+	"LAYER3_4": 1,
+	"LAYER2_3": 2,
+	"ENCAP2_3": 3,
+	"ENCAP3_4": 4,
+}
+
+func (x BondAttrs_HashPolicy) String() string {
+	return proto.EnumName(BondAttrs_HashPolicy_name, int32(x))
+}
+
+func (BondAttrs_HashPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{18, 1}
+}
+
+type SRv6EncapGroup_Mode int32
+
+const (
+	SRv6EncapGroup_INLINE SRv6EncapGroup_Mode = 0
+	SRv6EncapGroup_ENCAP  SRv6EncapGroup_Mode = 1
+)
+
+var SRv6EncapGroup_Mode_name = map[int32]string{
+	0: "INLINE",
+	1: "ENCAP",
+}
+
+var SRv6EncapGroup_Mode_value = map[string]int32{
+	"INLINE": 0,
+	"ENCAP":  1,
+}
+
+func (x SRv6EncapGroup_Mode) String() string {
+	return proto.EnumName(SRv6EncapGroup_Mode_name, int32(x))
+}
+
+func (SRv6EncapGroup_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{25, 0}
 }
 
 type FFHello_DpType int32
@@ -796,7 +968,7 @@ func (x FFHello_DpType) String() string {
 }
 
 func (FFHello_DpType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{19, 0}
+	return fileDescriptor_68149358b23bd798, []int{28, 0}
 }
 
 type FFPortStats_Cmd int32
@@ -827,7 +999,7 @@ func (x FFPortStats_Cmd) String() string {
 }
 
 func (FFPortStats_Cmd) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{21, 0}
+	return fileDescriptor_68149358b23bd798, []int{30, 0}
 }
 
 type OAM_OAMType int32
@@ -852,7 +1024,7 @@ func (x OAM_OAMType) String() string {
 }
 
 func (OAM_OAMType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22, 0}
+	return fileDescriptor_68149358b23bd798, []int{31, 0}
 }
 
 type FFMultipart_MpType int32
@@ -913,7 +1085,7 @@ func (x FFMultipart_MpType) String() string {
 }
 
 func (FFMultipart_MpType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 0}
+	return fileDescriptor_68149358b23bd798, []int{32, 0}
 }
 
 type FFPortStatus_Reason int32
@@ -941,7 +1113,7 @@ func (x FFPortStatus_Reason) String() string {
 }
 
 func (FFPortStatus_Reason) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{27, 0}
+	return fileDescriptor_68149358b23bd798, []int{36, 0}
 }
 
 type L2Addr_Reason int32
@@ -969,7 +1141,7 @@ func (x L2Addr_Reason) String() string {
 }
 
 func (L2Addr_Reason) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{31, 0}
+	return fileDescriptor_68149358b23bd798, []int{40, 0}
 }
 
 type Hello struct {
@@ -1320,6 +1492,8 @@ type FlowMod struct {
 	//	*FlowMod_Unicast
 	//	*FlowMod_Bridging
 	//	*FlowMod_Acl
+	//	*FlowMod_Mcast
+	//	*FlowMod_Srv6Local
 	Entry                isFlowMod_Entry `protobuf_oneof:"entry"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -1400,6 +1574,14 @@ type FlowMod_Acl struct {
 	Acl *PolicyACLFlow `protobuf:"bytes,9,opt,name=acl,proto3,oneof"`
 }
 
+type FlowMod_Mcast struct {
+	Mcast *MulticastRoutingFlow `protobuf:"bytes,10,opt,name=mcast,proto3,oneof"`
+}
+
+type FlowMod_Srv6Local struct {
+	Srv6Local *SRv6LocalSidFlow `protobuf:"bytes,11,opt,name=srv6_local,json=srv6Local,proto3,oneof"`
+}
+
 func (*FlowMod_Vlan) isFlowMod_Entry() {}
 
 func (*FlowMod_TermMac) isFlowMod_Entry() {}
@@ -1412,6 +1594,10 @@ func (*FlowMod_Bridging) isFlowMod_Entry() {}
 
 func (*FlowMod_Acl) isFlowMod_Entry() {}
 
+func (*FlowMod_Mcast) isFlowMod_Entry() {}
+
+func (*FlowMod_Srv6Local) isFlowMod_Entry() {}
+
 func (m *FlowMod) GetEntry() isFlowMod_Entry {
 	if m != nil {
 		return m.Entry
@@ -1461,6 +1647,20 @@ func (m *FlowMod) GetAcl() *PolicyACLFlow {
 	return nil
 }
 
+func (m *FlowMod) GetMcast() *MulticastRoutingFlow {
+	if x, ok := m.GetEntry().(*FlowMod_Mcast); ok {
+		return x.Mcast
+	}
+	return nil
+}
+
+func (m *FlowMod) GetSrv6Local() *SRv6LocalSidFlow {
+	if x, ok := m.GetEntry().(*FlowMod_Srv6Local); ok {
+		return x.Srv6Local
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FlowMod) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1470,6 +1670,8 @@ func (*FlowMod) XXX_OneofWrappers() []interface{} {
 		(*FlowMod_Unicast)(nil),
 		(*FlowMod_Bridging)(nil),
 		(*FlowMod_Acl)(nil),
+		(*FlowMod_Mcast)(nil),
+		(*FlowMod_Srv6Local)(nil),
 	}
 }
 
@@ -1482,6 +1684,11 @@ type GroupMod struct {
 	//	*GroupMod_L3Unicast
 	//	*GroupMod_MplsIface
 	//	*GroupMod_MplsLabel
+	//	*GroupMod_L3Ecmp
+	//	*GroupMod_L3Mcast
+	//	*GroupMod_L2Overlay
+	//	*GroupMod_VxlanTunnel
+	//	*GroupMod_Srv6Encap
 	Entry                isGroupMod_Entry `protobuf_oneof:"entry"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
@@ -1554,6 +1761,26 @@ type GroupMod_MplsLabel struct {
 	MplsLabel *MPLSLabelGroup `protobuf:"bytes,7,opt,name=mpls_label,json=mplsLabel,proto3,oneof"`
 }
 
+type GroupMod_L3Ecmp struct {
+	L3Ecmp *L3EcmpGroup `protobuf:"bytes,8,opt,name=l3_ecmp,json=l3Ecmp,proto3,oneof"`
+}
+
+type GroupMod_L3Mcast struct {
+	L3Mcast *L3MulticastGroup `protobuf:"bytes,9,opt,name=l3_mcast,json=l3Mcast,proto3,oneof"`
+}
+
+type GroupMod_L2Overlay struct {
+	L2Overlay *L2OverlayGroup `protobuf:"bytes,10,opt,name=l2_overlay,json=l2Overlay,proto3,oneof"`
+}
+
+type GroupMod_VxlanTunnel struct {
+	VxlanTunnel *VxlanTunnel `protobuf:"bytes,11,opt,name=vxlan_tunnel,json=vxlanTunnel,proto3,oneof"`
+}
+
+type GroupMod_Srv6Encap struct {
+	Srv6Encap *SRv6EncapGroup `protobuf:"bytes,12,opt,name=srv6_encap,json=srv6Encap,proto3,oneof"`
+}
+
 func (*GroupMod_L2Iface) isGroupMod_Entry() {}
 
 func (*GroupMod_L3Unicast) isGroupMod_Entry() {}
@@ -1562,6 +1789,16 @@ func (*GroupMod_MplsIface) isGroupMod_Entry() {}
 
 func (*GroupMod_MplsLabel) isGroupMod_Entry() {}
 
+func (*GroupMod_L3Ecmp) isGroupMod_Entry() {}
+
+func (*GroupMod_L3Mcast) isGroupMod_Entry() {}
+
+func (*GroupMod_L2Overlay) isGroupMod_Entry() {}
+
+func (*GroupMod_VxlanTunnel) isGroupMod_Entry() {}
+
+func (*GroupMod_Srv6Encap) isGroupMod_Entry() {}
+
 func (m *GroupMod) GetEntry() isGroupMod_Entry {
 	if m != nil {
 		return m.Entry
@@ -1597,6 +1834,41 @@ func (m *GroupMod) GetMplsLabel() *MPLSLabelGroup {
 	return nil
 }
 
+func (m *GroupMod) GetL3Ecmp() *L3EcmpGroup {
+	if x, ok := m.GetEntry().(*GroupMod_L3Ecmp); ok {
+		return x.L3Ecmp
+	}
+	return nil
+}
+
+func (m *GroupMod) GetL3Mcast() *L3MulticastGroup {
+	if x, ok := m.GetEntry().(*GroupMod_L3Mcast); ok {
+		return x.L3Mcast
+	}
+	return nil
+}
+
+func (m *GroupMod) GetL2Overlay() *L2OverlayGroup {
+	if x, ok := m.GetEntry().(*GroupMod_L2Overlay); ok {
+		return x.L2Overlay
+	}
+	return nil
+}
+
+func (m *GroupMod) GetVxlanTunnel() *VxlanTunnel {
+	if x, ok := m.GetEntry().(*GroupMod_VxlanTunnel); ok {
+		return x.VxlanTunnel
+	}
+	return nil
+}
+
+func (m *GroupMod) GetSrv6Encap() *SRv6EncapGroup {
+	if x, ok := m.GetEntry().(*GroupMod_Srv6Encap); ok {
+		return x.Srv6Encap
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GroupMod) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1604,6 +1876,11 @@ func (*GroupMod) XXX_OneofWrappers() []interface{} {
 		(*GroupMod_L3Unicast)(nil),
 		(*GroupMod_MplsIface)(nil),
 		(*GroupMod_MplsLabel)(nil),
+		(*GroupMod_L3Ecmp)(nil),
+		(*GroupMod_L3Mcast)(nil),
+		(*GroupMod_L2Overlay)(nil),
+		(*GroupMod_VxlanTunnel)(nil),
+		(*GroupMod_Srv6Encap)(nil),
 	}
 }
 
@@ -2259,6 +2536,124 @@ func (m *UnicastRoutingFlow_Action) GetValue() uint32 {
 	return 0
 }
 
+type MulticastRoutingFlow struct {
+	Match                *MulticastRoutingFlow_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	GType                GroupMod_GType              `protobuf:"varint,2,opt,name=g_type,json=gType,proto3,enum=fibcapi.GroupMod_GType" json:"g_type,omitempty"`
+	GId                  uint32                      `protobuf:"varint,3,opt,name=g_id,json=gId,proto3" json:"g_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *MulticastRoutingFlow) Reset()         { *m = MulticastRoutingFlow{} }
+func (m *MulticastRoutingFlow) String() string { return proto.CompactTextString(m) }
+func (*MulticastRoutingFlow) ProtoMessage()    {}
+func (*MulticastRoutingFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{13}
+}
+
+func (m *MulticastRoutingFlow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MulticastRoutingFlow.Unmarshal(m, b)
+}
+func (m *MulticastRoutingFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MulticastRoutingFlow.Marshal(b, m, deterministic)
+}
+func (m *MulticastRoutingFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MulticastRoutingFlow.Merge(m, src)
+}
+func (m *MulticastRoutingFlow) XXX_Size() int {
+	return xxx_messageInfo_MulticastRoutingFlow.Size(m)
+}
+func (m *MulticastRoutingFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MulticastRoutingFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MulticastRoutingFlow proto.InternalMessageInfo
+
+func (m *MulticastRoutingFlow) GetMatch() *MulticastRoutingFlow_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *MulticastRoutingFlow) GetGType() GroupMod_GType {
+	if m != nil {
+		return m.GType
+	}
+	return GroupMod_UNSPEC
+}
+
+func (m *MulticastRoutingFlow) GetGId() uint32 {
+	if m != nil {
+		return m.GId
+	}
+	return 0
+}
+
+type MulticastRoutingFlow_Match struct {
+	IpSrc                string   `protobuf:"bytes,1,opt,name=ip_src,json=ipSrc,proto3" json:"ip_src,omitempty"`
+	IpDst                string   `protobuf:"bytes,2,opt,name=ip_dst,json=ipDst,proto3" json:"ip_dst,omitempty"`
+	Vrf                  uint32   `protobuf:"varint,3,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	VlanVid              uint32   `protobuf:"varint,4,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MulticastRoutingFlow_Match) Reset()         { *m = MulticastRoutingFlow_Match{} }
+func (m *MulticastRoutingFlow_Match) String() string { return proto.CompactTextString(m) }
+func (*MulticastRoutingFlow_Match) ProtoMessage()    {}
+func (*MulticastRoutingFlow_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{13, 0}
+}
+
+func (m *MulticastRoutingFlow_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MulticastRoutingFlow_Match.Unmarshal(m, b)
+}
+func (m *MulticastRoutingFlow_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MulticastRoutingFlow_Match.Marshal(b, m, deterministic)
+}
+func (m *MulticastRoutingFlow_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MulticastRoutingFlow_Match.Merge(m, src)
+}
+func (m *MulticastRoutingFlow_Match) XXX_Size() int {
+	return xxx_messageInfo_MulticastRoutingFlow_Match.Size(m)
+}
+func (m *MulticastRoutingFlow_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_MulticastRoutingFlow_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MulticastRoutingFlow_Match proto.InternalMessageInfo
+
+func (m *MulticastRoutingFlow_Match) GetIpSrc() string {
+	if m != nil {
+		return m.IpSrc
+	}
+	return ""
+}
+
+func (m *MulticastRoutingFlow_Match) GetIpDst() string {
+	if m != nil {
+		return m.IpDst
+	}
+	return ""
+}
+
+func (m *MulticastRoutingFlow_Match) GetVrf() uint32 {
+	if m != nil {
+		return m.Vrf
+	}
+	return 0
+}
+
+func (m *MulticastRoutingFlow_Match) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
 type BridgingFlow struct {
 	Match                *BridgingFlow_Match  `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
 	Action               *BridgingFlow_Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
@@ -2271,7 +2666,7 @@ func (m *BridgingFlow) Reset()         { *m = BridgingFlow{} }
 func (m *BridgingFlow) String() string { return proto.CompactTextString(m) }
 func (*BridgingFlow) ProtoMessage()    {}
 func (*BridgingFlow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{13}
+	return fileDescriptor_68149358b23bd798, []int{14}
 }
 
 func (m *BridgingFlow) XXX_Unmarshal(b []byte) error {
@@ -2319,7 +2714,7 @@ func (m *BridgingFlow_Match) Reset()         { *m = BridgingFlow_Match{} }
 func (m *BridgingFlow_Match) String() string { return proto.CompactTextString(m) }
 func (*BridgingFlow_Match) ProtoMessage()    {}
 func (*BridgingFlow_Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{13, 0}
+	return fileDescriptor_68149358b23bd798, []int{14, 0}
 }
 
 func (m *BridgingFlow_Match) XXX_Unmarshal(b []byte) error {
@@ -2373,7 +2768,7 @@ func (m *BridgingFlow_Action) Reset()         { *m = BridgingFlow_Action{} }
 func (m *BridgingFlow_Action) String() string { return proto.CompactTextString(m) }
 func (*BridgingFlow_Action) ProtoMessage()    {}
 func (*BridgingFlow_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{13, 1}
+	return fileDescriptor_68149358b23bd798, []int{14, 1}
 }
 
 func (m *BridgingFlow_Action) XXX_Unmarshal(b []byte) error {
@@ -2408,121 +2803,300 @@ func (m *BridgingFlow_Action) GetValue() uint32 {
 	return 0
 }
 
-type PolicyACLFlow struct {
-	Match                *PolicyACLFlow_Match  `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
-	Action               *PolicyACLFlow_Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type SRv6LocalSidFlow struct {
+	Match                *SRv6LocalSidFlow_Match  `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Action               *SRv6LocalSidFlow_Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	GType                GroupMod_GType           `protobuf:"varint,3,opt,name=g_type,json=gType,proto3,enum=fibcapi.GroupMod_GType" json:"g_type,omitempty"`
+	GId                  uint32                   `protobuf:"varint,4,opt,name=g_id,json=gId,proto3" json:"g_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *PolicyACLFlow) Reset()         { *m = PolicyACLFlow{} }
-func (m *PolicyACLFlow) String() string { return proto.CompactTextString(m) }
-func (*PolicyACLFlow) ProtoMessage()    {}
-func (*PolicyACLFlow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{14}
+func (m *SRv6LocalSidFlow) Reset()         { *m = SRv6LocalSidFlow{} }
+func (m *SRv6LocalSidFlow) String() string { return proto.CompactTextString(m) }
+func (*SRv6LocalSidFlow) ProtoMessage()    {}
+func (*SRv6LocalSidFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{15}
 }
 
-func (m *PolicyACLFlow) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyACLFlow.Unmarshal(m, b)
+func (m *SRv6LocalSidFlow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SRv6LocalSidFlow.Unmarshal(m, b)
 }
-func (m *PolicyACLFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyACLFlow.Marshal(b, m, deterministic)
+func (m *SRv6LocalSidFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SRv6LocalSidFlow.Marshal(b, m, deterministic)
 }
-func (m *PolicyACLFlow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyACLFlow.Merge(m, src)
+func (m *SRv6LocalSidFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRv6LocalSidFlow.Merge(m, src)
 }
-func (m *PolicyACLFlow) XXX_Size() int {
-	return xxx_messageInfo_PolicyACLFlow.Size(m)
+func (m *SRv6LocalSidFlow) XXX_Size() int {
+	return xxx_messageInfo_SRv6LocalSidFlow.Size(m)
 }
-func (m *PolicyACLFlow) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyACLFlow.DiscardUnknown(m)
+func (m *SRv6LocalSidFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRv6LocalSidFlow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyACLFlow proto.InternalMessageInfo
+var xxx_messageInfo_SRv6LocalSidFlow proto.InternalMessageInfo
 
-func (m *PolicyACLFlow) GetMatch() *PolicyACLFlow_Match {
+func (m *SRv6LocalSidFlow) GetMatch() *SRv6LocalSidFlow_Match {
 	if m != nil {
 		return m.Match
 	}
 	return nil
 }
 
-func (m *PolicyACLFlow) GetAction() *PolicyACLFlow_Action {
+func (m *SRv6LocalSidFlow) GetAction() *SRv6LocalSidFlow_Action {
 	if m != nil {
 		return m.Action
 	}
 	return nil
 }
 
-type PolicyACLFlow_Match struct {
-	IpDst                string   `protobuf:"bytes,1,opt,name=ip_dst,json=ipDst,proto3" json:"ip_dst,omitempty"`
+func (m *SRv6LocalSidFlow) GetGType() GroupMod_GType {
+	if m != nil {
+		return m.GType
+	}
+	return GroupMod_UNSPEC
+}
+
+func (m *SRv6LocalSidFlow) GetGId() uint32 {
+	if m != nil {
+		return m.GId
+	}
+	return 0
+}
+
+type SRv6LocalSidFlow_Match struct {
+	Sid                  string   `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
 	Vrf                  uint32   `protobuf:"varint,2,opt,name=vrf,proto3" json:"vrf,omitempty"`
-	EthType              uint32   `protobuf:"varint,3,opt,name=eth_type,json=ethType,proto3" json:"eth_type,omitempty"`
-	IpProto              uint32   `protobuf:"varint,4,opt,name=ip_proto,json=ipProto,proto3" json:"ip_proto,omitempty"`
-	TpSrc                uint32   `protobuf:"varint,5,opt,name=tp_src,json=tpSrc,proto3" json:"tp_src,omitempty"`
-	TpDst                uint32   `protobuf:"varint,6,opt,name=tp_dst,json=tpDst,proto3" json:"tp_dst,omitempty"`
-	EthDst               string   `protobuf:"bytes,7,opt,name=eth_dst,json=ethDst,proto3" json:"eth_dst,omitempty"`
-	InPort               uint32   `protobuf:"varint,8,opt,name=in_port,json=inPort,proto3" json:"in_port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyACLFlow_Match) Reset()         { *m = PolicyACLFlow_Match{} }
-func (m *PolicyACLFlow_Match) String() string { return proto.CompactTextString(m) }
-func (*PolicyACLFlow_Match) ProtoMessage()    {}
-func (*PolicyACLFlow_Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{14, 0}
+func (m *SRv6LocalSidFlow_Match) Reset()         { *m = SRv6LocalSidFlow_Match{} }
+func (m *SRv6LocalSidFlow_Match) String() string { return proto.CompactTextString(m) }
+func (*SRv6LocalSidFlow_Match) ProtoMessage()    {}
+func (*SRv6LocalSidFlow_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{15, 0}
 }
 
-func (m *PolicyACLFlow_Match) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyACLFlow_Match.Unmarshal(m, b)
+func (m *SRv6LocalSidFlow_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SRv6LocalSidFlow_Match.Unmarshal(m, b)
 }
-func (m *PolicyACLFlow_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyACLFlow_Match.Marshal(b, m, deterministic)
+func (m *SRv6LocalSidFlow_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SRv6LocalSidFlow_Match.Marshal(b, m, deterministic)
 }
-func (m *PolicyACLFlow_Match) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyACLFlow_Match.Merge(m, src)
+func (m *SRv6LocalSidFlow_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRv6LocalSidFlow_Match.Merge(m, src)
 }
-func (m *PolicyACLFlow_Match) XXX_Size() int {
-	return xxx_messageInfo_PolicyACLFlow_Match.Size(m)
+func (m *SRv6LocalSidFlow_Match) XXX_Size() int {
+	return xxx_messageInfo_SRv6LocalSidFlow_Match.Size(m)
 }
-func (m *PolicyACLFlow_Match) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyACLFlow_Match.DiscardUnknown(m)
+func (m *SRv6LocalSidFlow_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRv6LocalSidFlow_Match.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyACLFlow_Match proto.InternalMessageInfo
+var xxx_messageInfo_SRv6LocalSidFlow_Match proto.InternalMessageInfo
 
-func (m *PolicyACLFlow_Match) GetIpDst() string {
+func (m *SRv6LocalSidFlow_Match) GetSid() string {
 	if m != nil {
-		return m.IpDst
+		return m.Sid
 	}
 	return ""
 }
 
-func (m *PolicyACLFlow_Match) GetVrf() uint32 {
+func (m *SRv6LocalSidFlow_Match) GetVrf() uint32 {
 	if m != nil {
 		return m.Vrf
 	}
 	return 0
 }
 
-func (m *PolicyACLFlow_Match) GetEthType() uint32 {
-	if m != nil {
-		return m.EthType
-	}
-	return 0
+type SRv6LocalSidFlow_Action struct {
+	Name                 SRv6LocalSidFlow_Action_Name `protobuf:"varint,1,opt,name=name,proto3,enum=fibcapi.SRv6LocalSidFlow_Action_Name" json:"name,omitempty"`
+	Value                uint32                       `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *PolicyACLFlow_Match) GetIpProto() uint32 {
-	if m != nil {
-		return m.IpProto
-	}
-	return 0
+func (m *SRv6LocalSidFlow_Action) Reset()         { *m = SRv6LocalSidFlow_Action{} }
+func (m *SRv6LocalSidFlow_Action) String() string { return proto.CompactTextString(m) }
+func (*SRv6LocalSidFlow_Action) ProtoMessage()    {}
+func (*SRv6LocalSidFlow_Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{15, 1}
 }
 
-func (m *PolicyACLFlow_Match) GetTpSrc() uint32 {
+func (m *SRv6LocalSidFlow_Action) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SRv6LocalSidFlow_Action.Unmarshal(m, b)
+}
+func (m *SRv6LocalSidFlow_Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SRv6LocalSidFlow_Action.Marshal(b, m, deterministic)
+}
+func (m *SRv6LocalSidFlow_Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRv6LocalSidFlow_Action.Merge(m, src)
+}
+func (m *SRv6LocalSidFlow_Action) XXX_Size() int {
+	return xxx_messageInfo_SRv6LocalSidFlow_Action.Size(m)
+}
+func (m *SRv6LocalSidFlow_Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRv6LocalSidFlow_Action.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRv6LocalSidFlow_Action proto.InternalMessageInfo
+
+func (m *SRv6LocalSidFlow_Action) GetName() SRv6LocalSidFlow_Action_Name {
+	if m != nil {
+		return m.Name
+	}
+	return SRv6LocalSidFlow_Action_UNSPEC
+}
+
+func (m *SRv6LocalSidFlow_Action) GetValue() uint32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type PolicyACLFlow struct {
+	Match                *PolicyACLFlow_Match    `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Action               *PolicyACLFlow_Action   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Priority             uint32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Actions              []*PolicyACLFlow_Action `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PolicyACLFlow) Reset()         { *m = PolicyACLFlow{} }
+func (m *PolicyACLFlow) String() string { return proto.CompactTextString(m) }
+func (*PolicyACLFlow) ProtoMessage()    {}
+func (*PolicyACLFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{16}
+}
+
+func (m *PolicyACLFlow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyACLFlow.Unmarshal(m, b)
+}
+func (m *PolicyACLFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyACLFlow.Marshal(b, m, deterministic)
+}
+func (m *PolicyACLFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyACLFlow.Merge(m, src)
+}
+func (m *PolicyACLFlow) XXX_Size() int {
+	return xxx_messageInfo_PolicyACLFlow.Size(m)
+}
+func (m *PolicyACLFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyACLFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyACLFlow proto.InternalMessageInfo
+
+func (m *PolicyACLFlow) GetMatch() *PolicyACLFlow_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *PolicyACLFlow) GetAction() *PolicyACLFlow_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *PolicyACLFlow) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow) GetActions() []*PolicyACLFlow_Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type PolicyACLFlow_Match struct {
+	IpDst                string   `protobuf:"bytes,1,opt,name=ip_dst,json=ipDst,proto3" json:"ip_dst,omitempty"`
+	Vrf                  uint32   `protobuf:"varint,2,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	EthType              uint32   `protobuf:"varint,3,opt,name=eth_type,json=ethType,proto3" json:"eth_type,omitempty"`
+	IpProto              uint32   `protobuf:"varint,4,opt,name=ip_proto,json=ipProto,proto3" json:"ip_proto,omitempty"`
+	TpSrc                uint32   `protobuf:"varint,5,opt,name=tp_src,json=tpSrc,proto3" json:"tp_src,omitempty"`
+	TpDst                uint32   `protobuf:"varint,6,opt,name=tp_dst,json=tpDst,proto3" json:"tp_dst,omitempty"`
+	EthDst               string   `protobuf:"bytes,7,opt,name=eth_dst,json=ethDst,proto3" json:"eth_dst,omitempty"`
+	InPort               uint32   `protobuf:"varint,8,opt,name=in_port,json=inPort,proto3" json:"in_port,omitempty"`
+	IpSrc                string   `protobuf:"bytes,9,opt,name=ip_src,json=ipSrc,proto3" json:"ip_src,omitempty"`
+	VlanVid              uint32   `protobuf:"varint,10,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	IpDscp               uint32   `protobuf:"varint,11,opt,name=ip_dscp,json=ipDscp,proto3" json:"ip_dscp,omitempty"`
+	IpDscpMask           uint32   `protobuf:"varint,12,opt,name=ip_dscp_mask,json=ipDscpMask,proto3" json:"ip_dscp_mask,omitempty"`
+	TpSrcMask            uint32   `protobuf:"varint,13,opt,name=tp_src_mask,json=tpSrcMask,proto3" json:"tp_src_mask,omitempty"`
+	TpDstMask            uint32   `protobuf:"varint,14,opt,name=tp_dst_mask,json=tpDstMask,proto3" json:"tp_dst_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyACLFlow_Match) Reset()         { *m = PolicyACLFlow_Match{} }
+func (m *PolicyACLFlow_Match) String() string { return proto.CompactTextString(m) }
+func (*PolicyACLFlow_Match) ProtoMessage()    {}
+func (*PolicyACLFlow_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{16, 0}
+}
+
+func (m *PolicyACLFlow_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyACLFlow_Match.Unmarshal(m, b)
+}
+func (m *PolicyACLFlow_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyACLFlow_Match.Marshal(b, m, deterministic)
+}
+func (m *PolicyACLFlow_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyACLFlow_Match.Merge(m, src)
+}
+func (m *PolicyACLFlow_Match) XXX_Size() int {
+	return xxx_messageInfo_PolicyACLFlow_Match.Size(m)
+}
+func (m *PolicyACLFlow_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyACLFlow_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyACLFlow_Match proto.InternalMessageInfo
+
+func (m *PolicyACLFlow_Match) GetIpDst() string {
+	if m != nil {
+		return m.IpDst
+	}
+	return ""
+}
+
+func (m *PolicyACLFlow_Match) GetVrf() uint32 {
+	if m != nil {
+		return m.Vrf
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetEthType() uint32 {
+	if m != nil {
+		return m.EthType
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetIpProto() uint32 {
+	if m != nil {
+		return m.IpProto
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetTpSrc() uint32 {
 	if m != nil {
 		return m.TpSrc
 	}
@@ -2550,6 +3124,48 @@ func (m *PolicyACLFlow_Match) GetInPort() uint32 {
 	return 0
 }
 
+func (m *PolicyACLFlow_Match) GetIpSrc() string {
+	if m != nil {
+		return m.IpSrc
+	}
+	return ""
+}
+
+func (m *PolicyACLFlow_Match) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetIpDscp() uint32 {
+	if m != nil {
+		return m.IpDscp
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetIpDscpMask() uint32 {
+	if m != nil {
+		return m.IpDscpMask
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetTpSrcMask() uint32 {
+	if m != nil {
+		return m.TpSrcMask
+	}
+	return 0
+}
+
+func (m *PolicyACLFlow_Match) GetTpDstMask() uint32 {
+	if m != nil {
+		return m.TpDstMask
+	}
+	return 0
+}
+
 type PolicyACLFlow_Action struct {
 	Name                 PolicyACLFlow_Action_Name `protobuf:"varint,1,opt,name=name,proto3,enum=fibcapi.PolicyACLFlow_Action_Name" json:"name,omitempty"`
 	Value                uint32                    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -2562,7 +3178,7 @@ func (m *PolicyACLFlow_Action) Reset()         { *m = PolicyACLFlow_Action{} }
 func (m *PolicyACLFlow_Action) String() string { return proto.CompactTextString(m) }
 func (*PolicyACLFlow_Action) ProtoMessage()    {}
 func (*PolicyACLFlow_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{14, 1}
+	return fileDescriptor_68149358b23bd798, []int{16, 1}
 }
 
 func (m *PolicyACLFlow_Action) XXX_Unmarshal(b []byte) error {
@@ -2599,194 +3215,736 @@ func (m *PolicyACLFlow_Action) GetValue() uint32 {
 
 // 0x0vvvPPPP (vvv:VID, PPPP:port_id)
 type L2InterfaceGroup struct {
-	PortId               uint32   `protobuf:"varint,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	VlanVid              uint32   `protobuf:"varint,2,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
-	VlanTranslation      bool     `protobuf:"varint,3,opt,name=vlan_translation,json=vlanTranslation,proto3" json:"vlan_translation,omitempty"`
-	HwAddr               string   `protobuf:"bytes,4,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
-	Mtu                  uint32   `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Vrf                  uint32   `protobuf:"varint,6,opt,name=vrf,proto3" json:"vrf,omitempty"`
-	Master               uint32   `protobuf:"varint,7,opt,name=master,proto3" json:"master,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PortId               uint32          `protobuf:"varint,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	VlanVid              uint32          `protobuf:"varint,2,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	VlanTranslation      bool            `protobuf:"varint,3,opt,name=vlan_translation,json=vlanTranslation,proto3" json:"vlan_translation,omitempty"`
+	HwAddr               string          `protobuf:"bytes,4,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	Mtu                  uint32          `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Vrf                  uint32          `protobuf:"varint,6,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	Master               uint32          `protobuf:"varint,7,opt,name=master,proto3" json:"master,omitempty"`
+	Bond                 *BondAttrs      `protobuf:"bytes,8,opt,name=bond,proto3" json:"bond,omitempty"`
+	BondSlave            *BondSlaveAttrs `protobuf:"bytes,9,opt,name=bond_slave,json=bondSlave,proto3" json:"bond_slave,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *L2InterfaceGroup) Reset()         { *m = L2InterfaceGroup{} }
 func (m *L2InterfaceGroup) String() string { return proto.CompactTextString(m) }
 func (*L2InterfaceGroup) ProtoMessage()    {}
 func (*L2InterfaceGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{15}
+	return fileDescriptor_68149358b23bd798, []int{17}
+}
+
+func (m *L2InterfaceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L2InterfaceGroup.Unmarshal(m, b)
+}
+func (m *L2InterfaceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L2InterfaceGroup.Marshal(b, m, deterministic)
+}
+func (m *L2InterfaceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L2InterfaceGroup.Merge(m, src)
+}
+func (m *L2InterfaceGroup) XXX_Size() int {
+	return xxx_messageInfo_L2InterfaceGroup.Size(m)
+}
+func (m *L2InterfaceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_L2InterfaceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L2InterfaceGroup proto.InternalMessageInfo
+
+func (m *L2InterfaceGroup) GetPortId() uint32 {
+	if m != nil {
+		return m.PortId
+	}
+	return 0
+}
+
+func (m *L2InterfaceGroup) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
+func (m *L2InterfaceGroup) GetVlanTranslation() bool {
+	if m != nil {
+		return m.VlanTranslation
+	}
+	return false
+}
+
+func (m *L2InterfaceGroup) GetHwAddr() string {
+	if m != nil {
+		return m.HwAddr
+	}
+	return ""
+}
+
+func (m *L2InterfaceGroup) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *L2InterfaceGroup) GetVrf() uint32 {
+	if m != nil {
+		return m.Vrf
+	}
+	return 0
+}
+
+func (m *L2InterfaceGroup) GetMaster() uint32 {
+	if m != nil {
+		return m.Master
+	}
+	return 0
+}
+
+func (m *L2InterfaceGroup) GetBond() *BondAttrs {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *L2InterfaceGroup) GetBondSlave() *BondSlaveAttrs {
+	if m != nil {
+		return m.BondSlave
+	}
+	return nil
+}
+
+type BondAttrs struct {
+	Mode                 BondAttrs_Mode       `protobuf:"varint,1,opt,name=mode,proto3,enum=fibcapi.BondAttrs_Mode" json:"mode,omitempty"`
+	HashPolicy           BondAttrs_HashPolicy `protobuf:"varint,2,opt,name=hash_policy,json=hashPolicy,proto3,enum=fibcapi.BondAttrs_HashPolicy" json:"hash_policy,omitempty"`
+	MinLinks             uint32               `protobuf:"varint,3,opt,name=min_links,json=minLinks,proto3" json:"min_links,omitempty"`
+	AggregatorId         uint32               `protobuf:"varint,4,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BondAttrs) Reset()         { *m = BondAttrs{} }
+func (m *BondAttrs) String() string { return proto.CompactTextString(m) }
+func (*BondAttrs) ProtoMessage()    {}
+func (*BondAttrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{18}
+}
+
+func (m *BondAttrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BondAttrs.Unmarshal(m, b)
+}
+func (m *BondAttrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BondAttrs.Marshal(b, m, deterministic)
+}
+func (m *BondAttrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondAttrs.Merge(m, src)
+}
+func (m *BondAttrs) XXX_Size() int {
+	return xxx_messageInfo_BondAttrs.Size(m)
+}
+func (m *BondAttrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondAttrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondAttrs proto.InternalMessageInfo
+
+func (m *BondAttrs) GetMode() BondAttrs_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return BondAttrs_BALANCE_RR
+}
+
+func (m *BondAttrs) GetHashPolicy() BondAttrs_HashPolicy {
+	if m != nil {
+		return m.HashPolicy
+	}
+	return BondAttrs_LAYER2
+}
+
+func (m *BondAttrs) GetMinLinks() uint32 {
+	if m != nil {
+		return m.MinLinks
+	}
+	return 0
+}
+
+func (m *BondAttrs) GetAggregatorId() uint32 {
+	if m != nil {
+		return m.AggregatorId
+	}
+	return 0
+}
+
+type BondSlaveAttrs struct {
+	Active               bool     `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	LinkUp               bool     `protobuf:"varint,2,opt,name=link_up,json=linkUp,proto3" json:"link_up,omitempty"`
+	AggregatorId         uint32   `protobuf:"varint,3,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	Collecting           bool     `protobuf:"varint,4,opt,name=collecting,proto3" json:"collecting,omitempty"`
+	Distributing         bool     `protobuf:"varint,5,opt,name=distributing,proto3" json:"distributing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BondSlaveAttrs) Reset()         { *m = BondSlaveAttrs{} }
+func (m *BondSlaveAttrs) String() string { return proto.CompactTextString(m) }
+func (*BondSlaveAttrs) ProtoMessage()    {}
+func (*BondSlaveAttrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{19}
+}
+
+func (m *BondSlaveAttrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BondSlaveAttrs.Unmarshal(m, b)
+}
+func (m *BondSlaveAttrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BondSlaveAttrs.Marshal(b, m, deterministic)
+}
+func (m *BondSlaveAttrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondSlaveAttrs.Merge(m, src)
+}
+func (m *BondSlaveAttrs) XXX_Size() int {
+	return xxx_messageInfo_BondSlaveAttrs.Size(m)
+}
+func (m *BondSlaveAttrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondSlaveAttrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondSlaveAttrs proto.InternalMessageInfo
+
+func (m *BondSlaveAttrs) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *BondSlaveAttrs) GetLinkUp() bool {
+	if m != nil {
+		return m.LinkUp
+	}
+	return false
+}
+
+func (m *BondSlaveAttrs) GetAggregatorId() uint32 {
+	if m != nil {
+		return m.AggregatorId
+	}
+	return 0
+}
+
+func (m *BondSlaveAttrs) GetCollecting() bool {
+	if m != nil {
+		return m.Collecting
+	}
+	return false
+}
+
+func (m *BondSlaveAttrs) GetDistributing() bool {
+	if m != nil {
+		return m.Distributing
+	}
+	return false
+}
+
+// 0x20VVNNNN (VV:VRF, NNNN:NeId)
+type L3UnicastGroup struct {
+	NeId                 uint32          `protobuf:"varint,1,opt,name=ne_id,json=neId,proto3" json:"ne_id,omitempty"`
+	PortId               uint32          `protobuf:"varint,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	VlanVid              uint32          `protobuf:"varint,3,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	EthDst               string          `protobuf:"bytes,4,opt,name=eth_dst,json=ethDst,proto3" json:"eth_dst,omitempty"`
+	EthSrc               string          `protobuf:"bytes,5,opt,name=eth_src,json=ethSrc,proto3" json:"eth_src,omitempty"`
+	PhyPortId            uint32          `protobuf:"varint,6,opt,name=phy_port_id,json=phyPortId,proto3" json:"phy_port_id,omitempty"`
+	TunType              TunnelType_Type `protobuf:"varint,7,opt,name=tun_type,json=tunType,proto3,enum=fibcapi.TunnelType_Type" json:"tun_type,omitempty"`
+	TunRemote            string          `protobuf:"bytes,8,opt,name=tun_remote,json=tunRemote,proto3" json:"tun_remote,omitempty"`
+	TunLocal             string          `protobuf:"bytes,9,opt,name=tun_local,json=tunLocal,proto3" json:"tun_local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *L3UnicastGroup) Reset()         { *m = L3UnicastGroup{} }
+func (m *L3UnicastGroup) String() string { return proto.CompactTextString(m) }
+func (*L3UnicastGroup) ProtoMessage()    {}
+func (*L3UnicastGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{20}
+}
+
+func (m *L3UnicastGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L3UnicastGroup.Unmarshal(m, b)
+}
+func (m *L3UnicastGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L3UnicastGroup.Marshal(b, m, deterministic)
+}
+func (m *L3UnicastGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L3UnicastGroup.Merge(m, src)
+}
+func (m *L3UnicastGroup) XXX_Size() int {
+	return xxx_messageInfo_L3UnicastGroup.Size(m)
+}
+func (m *L3UnicastGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_L3UnicastGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L3UnicastGroup proto.InternalMessageInfo
+
+func (m *L3UnicastGroup) GetNeId() uint32 {
+	if m != nil {
+		return m.NeId
+	}
+	return 0
+}
+
+func (m *L3UnicastGroup) GetPortId() uint32 {
+	if m != nil {
+		return m.PortId
+	}
+	return 0
+}
+
+func (m *L3UnicastGroup) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
+func (m *L3UnicastGroup) GetEthDst() string {
+	if m != nil {
+		return m.EthDst
+	}
+	return ""
+}
+
+func (m *L3UnicastGroup) GetEthSrc() string {
+	if m != nil {
+		return m.EthSrc
+	}
+	return ""
+}
+
+func (m *L3UnicastGroup) GetPhyPortId() uint32 {
+	if m != nil {
+		return m.PhyPortId
+	}
+	return 0
+}
+
+func (m *L3UnicastGroup) GetTunType() TunnelType_Type {
+	if m != nil {
+		return m.TunType
+	}
+	return TunnelType_NOP
+}
+
+func (m *L3UnicastGroup) GetTunRemote() string {
+	if m != nil {
+		return m.TunRemote
+	}
+	return ""
+}
+
+func (m *L3UnicastGroup) GetTunLocal() string {
+	if m != nil {
+		return m.TunLocal
+	}
+	return ""
+}
+
+// 0x7NNNNNNN (N:EcmpId)
+type L3EcmpGroup struct {
+	EcmpId               uint32   `protobuf:"varint,1,opt,name=ecmp_id,json=ecmpId,proto3" json:"ecmp_id,omitempty"`
+	NeIds                []uint32 `protobuf:"varint,2,rep,packed,name=ne_ids,json=neIds,proto3" json:"ne_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *L3EcmpGroup) Reset()         { *m = L3EcmpGroup{} }
+func (m *L3EcmpGroup) String() string { return proto.CompactTextString(m) }
+func (*L3EcmpGroup) ProtoMessage()    {}
+func (*L3EcmpGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{21}
+}
+
+func (m *L3EcmpGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L3EcmpGroup.Unmarshal(m, b)
+}
+func (m *L3EcmpGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L3EcmpGroup.Marshal(b, m, deterministic)
+}
+func (m *L3EcmpGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L3EcmpGroup.Merge(m, src)
+}
+func (m *L3EcmpGroup) XXX_Size() int {
+	return xxx_messageInfo_L3EcmpGroup.Size(m)
+}
+func (m *L3EcmpGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_L3EcmpGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L3EcmpGroup proto.InternalMessageInfo
+
+func (m *L3EcmpGroup) GetEcmpId() uint32 {
+	if m != nil {
+		return m.EcmpId
+	}
+	return 0
+}
+
+func (m *L3EcmpGroup) GetNeIds() []uint32 {
+	if m != nil {
+		return m.NeIds
+	}
+	return nil
+}
+
+// 0x6vvvNNNN (vvv:VID, NNNN:McId)
+type L3MulticastGroup struct {
+	McId                 uint32                   `protobuf:"varint,1,opt,name=mc_id,json=mcId,proto3" json:"mc_id,omitempty"`
+	VlanVid              uint32                   `protobuf:"varint,2,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	Ports                []*L3MulticastGroup_Port `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *L3MulticastGroup) Reset()         { *m = L3MulticastGroup{} }
+func (m *L3MulticastGroup) String() string { return proto.CompactTextString(m) }
+func (*L3MulticastGroup) ProtoMessage()    {}
+func (*L3MulticastGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{22}
+}
+
+func (m *L3MulticastGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L3MulticastGroup.Unmarshal(m, b)
+}
+func (m *L3MulticastGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L3MulticastGroup.Marshal(b, m, deterministic)
+}
+func (m *L3MulticastGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L3MulticastGroup.Merge(m, src)
+}
+func (m *L3MulticastGroup) XXX_Size() int {
+	return xxx_messageInfo_L3MulticastGroup.Size(m)
+}
+func (m *L3MulticastGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_L3MulticastGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L3MulticastGroup proto.InternalMessageInfo
+
+func (m *L3MulticastGroup) GetMcId() uint32 {
+	if m != nil {
+		return m.McId
+	}
+	return 0
+}
+
+func (m *L3MulticastGroup) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
+func (m *L3MulticastGroup) GetPorts() []*L3MulticastGroup_Port {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type L3MulticastGroup_Port struct {
+	PortId               uint32   `protobuf:"varint,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	VlanVid              uint32   `protobuf:"varint,2,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	EthSrc               string   `protobuf:"bytes,3,opt,name=eth_src,json=ethSrc,proto3" json:"eth_src,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *L3MulticastGroup_Port) Reset()         { *m = L3MulticastGroup_Port{} }
+func (m *L3MulticastGroup_Port) String() string { return proto.CompactTextString(m) }
+func (*L3MulticastGroup_Port) ProtoMessage()    {}
+func (*L3MulticastGroup_Port) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{22, 0}
 }
 
-func (m *L2InterfaceGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_L2InterfaceGroup.Unmarshal(m, b)
+func (m *L3MulticastGroup_Port) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L3MulticastGroup_Port.Unmarshal(m, b)
 }
-func (m *L2InterfaceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_L2InterfaceGroup.Marshal(b, m, deterministic)
+func (m *L3MulticastGroup_Port) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L3MulticastGroup_Port.Marshal(b, m, deterministic)
 }
-func (m *L2InterfaceGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_L2InterfaceGroup.Merge(m, src)
+func (m *L3MulticastGroup_Port) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L3MulticastGroup_Port.Merge(m, src)
 }
-func (m *L2InterfaceGroup) XXX_Size() int {
-	return xxx_messageInfo_L2InterfaceGroup.Size(m)
+func (m *L3MulticastGroup_Port) XXX_Size() int {
+	return xxx_messageInfo_L3MulticastGroup_Port.Size(m)
 }
-func (m *L2InterfaceGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_L2InterfaceGroup.DiscardUnknown(m)
+func (m *L3MulticastGroup_Port) XXX_DiscardUnknown() {
+	xxx_messageInfo_L3MulticastGroup_Port.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_L2InterfaceGroup proto.InternalMessageInfo
+var xxx_messageInfo_L3MulticastGroup_Port proto.InternalMessageInfo
 
-func (m *L2InterfaceGroup) GetPortId() uint32 {
+func (m *L3MulticastGroup_Port) GetPortId() uint32 {
 	if m != nil {
 		return m.PortId
 	}
 	return 0
 }
 
-func (m *L2InterfaceGroup) GetVlanVid() uint32 {
+func (m *L3MulticastGroup_Port) GetVlanVid() uint32 {
 	if m != nil {
 		return m.VlanVid
 	}
 	return 0
 }
 
-func (m *L2InterfaceGroup) GetVlanTranslation() bool {
+func (m *L3MulticastGroup_Port) GetEthSrc() string {
 	if m != nil {
-		return m.VlanTranslation
+		return m.EthSrc
 	}
-	return false
+	return ""
 }
 
-func (m *L2InterfaceGroup) GetHwAddr() string {
+// 0x8TTTTSII (TTTT:TunnelId, S:SubType, II:Index)
+type L2OverlayGroup struct {
+	TunnelId             uint32   `protobuf:"varint,1,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	VlanVid              uint32   `protobuf:"varint,3,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	TunPorts             []uint32 `protobuf:"varint,4,rep,packed,name=tun_ports,json=tunPorts,proto3" json:"tun_ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *L2OverlayGroup) Reset()         { *m = L2OverlayGroup{} }
+func (m *L2OverlayGroup) String() string { return proto.CompactTextString(m) }
+func (*L2OverlayGroup) ProtoMessage()    {}
+func (*L2OverlayGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{23}
+}
+
+func (m *L2OverlayGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_L2OverlayGroup.Unmarshal(m, b)
+}
+func (m *L2OverlayGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_L2OverlayGroup.Marshal(b, m, deterministic)
+}
+func (m *L2OverlayGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L2OverlayGroup.Merge(m, src)
+}
+func (m *L2OverlayGroup) XXX_Size() int {
+	return xxx_messageInfo_L2OverlayGroup.Size(m)
+}
+func (m *L2OverlayGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_L2OverlayGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L2OverlayGroup proto.InternalMessageInfo
+
+func (m *L2OverlayGroup) GetTunnelId() uint32 {
 	if m != nil {
-		return m.HwAddr
+		return m.TunnelId
 	}
-	return ""
+	return 0
 }
 
-func (m *L2InterfaceGroup) GetMtu() uint32 {
+func (m *L2OverlayGroup) GetIndex() uint32 {
 	if m != nil {
-		return m.Mtu
+		return m.Index
 	}
 	return 0
 }
 
-func (m *L2InterfaceGroup) GetVrf() uint32 {
+func (m *L2OverlayGroup) GetVlanVid() uint32 {
 	if m != nil {
-		return m.Vrf
+		return m.VlanVid
 	}
 	return 0
 }
 
-func (m *L2InterfaceGroup) GetMaster() uint32 {
+func (m *L2OverlayGroup) GetTunPorts() []uint32 {
 	if m != nil {
-		return m.Master
+		return m.TunPorts
 	}
-	return 0
+	return nil
 }
 
-// 0x20VVNNNN (VV:VRF, NNNN:NeId)
-type L3UnicastGroup struct {
-	NeId                 uint32          `protobuf:"varint,1,opt,name=ne_id,json=neId,proto3" json:"ne_id,omitempty"`
-	PortId               uint32          `protobuf:"varint,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	VlanVid              uint32          `protobuf:"varint,3,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
-	EthDst               string          `protobuf:"bytes,4,opt,name=eth_dst,json=ethDst,proto3" json:"eth_dst,omitempty"`
-	EthSrc               string          `protobuf:"bytes,5,opt,name=eth_src,json=ethSrc,proto3" json:"eth_src,omitempty"`
-	PhyPortId            uint32          `protobuf:"varint,6,opt,name=phy_port_id,json=phyPortId,proto3" json:"phy_port_id,omitempty"`
-	TunType              TunnelType_Type `protobuf:"varint,7,opt,name=tun_type,json=tunType,proto3,enum=fibcapi.TunnelType_Type" json:"tun_type,omitempty"`
-	TunRemote            string          `protobuf:"bytes,8,opt,name=tun_remote,json=tunRemote,proto3" json:"tun_remote,omitempty"`
-	TunLocal             string          `protobuf:"bytes,9,opt,name=tun_local,json=tunLocal,proto3" json:"tun_local,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// 0xC000NNNN (NNNN:TunPortId)
+// dp port: NewDPPortId(tun_port_id, LinkType.VXLAN)
+type VxlanTunnel struct {
+	TunPortId            uint32   `protobuf:"varint,1,opt,name=tun_port_id,json=tunPortId,proto3" json:"tun_port_id,omitempty"`
+	Vni                  uint32   `protobuf:"varint,2,opt,name=vni,proto3" json:"vni,omitempty"`
+	VlanVid              uint32   `protobuf:"varint,3,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	Local                string   `protobuf:"bytes,4,opt,name=local,proto3" json:"local,omitempty"`
+	Remote               string   `protobuf:"bytes,5,opt,name=remote,proto3" json:"remote,omitempty"`
+	UdpPort              uint32   `protobuf:"varint,6,opt,name=udp_port,json=udpPort,proto3" json:"udp_port,omitempty"`
+	Ttl                  uint32   `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	NeId                 uint32   `protobuf:"varint,8,opt,name=ne_id,json=neId,proto3" json:"ne_id,omitempty"`
+	PortId               uint32   `protobuf:"varint,9,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *L3UnicastGroup) Reset()         { *m = L3UnicastGroup{} }
-func (m *L3UnicastGroup) String() string { return proto.CompactTextString(m) }
-func (*L3UnicastGroup) ProtoMessage()    {}
-func (*L3UnicastGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{16}
+func (m *VxlanTunnel) Reset()         { *m = VxlanTunnel{} }
+func (m *VxlanTunnel) String() string { return proto.CompactTextString(m) }
+func (*VxlanTunnel) ProtoMessage()    {}
+func (*VxlanTunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{24}
 }
 
-func (m *L3UnicastGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_L3UnicastGroup.Unmarshal(m, b)
+func (m *VxlanTunnel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VxlanTunnel.Unmarshal(m, b)
 }
-func (m *L3UnicastGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_L3UnicastGroup.Marshal(b, m, deterministic)
+func (m *VxlanTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VxlanTunnel.Marshal(b, m, deterministic)
 }
-func (m *L3UnicastGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_L3UnicastGroup.Merge(m, src)
+func (m *VxlanTunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VxlanTunnel.Merge(m, src)
 }
-func (m *L3UnicastGroup) XXX_Size() int {
-	return xxx_messageInfo_L3UnicastGroup.Size(m)
+func (m *VxlanTunnel) XXX_Size() int {
+	return xxx_messageInfo_VxlanTunnel.Size(m)
 }
-func (m *L3UnicastGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_L3UnicastGroup.DiscardUnknown(m)
+func (m *VxlanTunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_VxlanTunnel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_L3UnicastGroup proto.InternalMessageInfo
+var xxx_messageInfo_VxlanTunnel proto.InternalMessageInfo
 
-func (m *L3UnicastGroup) GetNeId() uint32 {
+func (m *VxlanTunnel) GetTunPortId() uint32 {
 	if m != nil {
-		return m.NeId
+		return m.TunPortId
 	}
 	return 0
 }
 
-func (m *L3UnicastGroup) GetPortId() uint32 {
+func (m *VxlanTunnel) GetVni() uint32 {
 	if m != nil {
-		return m.PortId
+		return m.Vni
 	}
 	return 0
 }
 
-func (m *L3UnicastGroup) GetVlanVid() uint32 {
+func (m *VxlanTunnel) GetVlanVid() uint32 {
 	if m != nil {
 		return m.VlanVid
 	}
 	return 0
 }
 
-func (m *L3UnicastGroup) GetEthDst() string {
+func (m *VxlanTunnel) GetLocal() string {
 	if m != nil {
-		return m.EthDst
+		return m.Local
 	}
 	return ""
 }
 
-func (m *L3UnicastGroup) GetEthSrc() string {
+func (m *VxlanTunnel) GetRemote() string {
 	if m != nil {
-		return m.EthSrc
+		return m.Remote
 	}
 	return ""
 }
 
-func (m *L3UnicastGroup) GetPhyPortId() uint32 {
+func (m *VxlanTunnel) GetUdpPort() uint32 {
 	if m != nil {
-		return m.PhyPortId
+		return m.UdpPort
 	}
 	return 0
 }
 
-func (m *L3UnicastGroup) GetTunType() TunnelType_Type {
+func (m *VxlanTunnel) GetTtl() uint32 {
 	if m != nil {
-		return m.TunType
+		return m.Ttl
 	}
-	return TunnelType_NOP
+	return 0
 }
 
-func (m *L3UnicastGroup) GetTunRemote() string {
+func (m *VxlanTunnel) GetNeId() uint32 {
 	if m != nil {
-		return m.TunRemote
+		return m.NeId
 	}
-	return ""
+	return 0
 }
 
-func (m *L3UnicastGroup) GetTunLocal() string {
+func (m *VxlanTunnel) GetPortId() uint32 {
 	if m != nil {
-		return m.TunLocal
+		return m.PortId
 	}
-	return ""
+	return 0
+}
+
+// 0xC1NNNNNN (NNNNNN:EncapId)
+type SRv6EncapGroup struct {
+	EncapId              uint32              `protobuf:"varint,1,opt,name=encap_id,json=encapId,proto3" json:"encap_id,omitempty"`
+	Mode                 SRv6EncapGroup_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=fibcapi.SRv6EncapGroup_Mode" json:"mode,omitempty"`
+	Segments             []string            `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	NeId                 uint32              `protobuf:"varint,4,opt,name=ne_id,json=neId,proto3" json:"ne_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SRv6EncapGroup) Reset()         { *m = SRv6EncapGroup{} }
+func (m *SRv6EncapGroup) String() string { return proto.CompactTextString(m) }
+func (*SRv6EncapGroup) ProtoMessage()    {}
+func (*SRv6EncapGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{25}
+}
+
+func (m *SRv6EncapGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SRv6EncapGroup.Unmarshal(m, b)
+}
+func (m *SRv6EncapGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SRv6EncapGroup.Marshal(b, m, deterministic)
+}
+func (m *SRv6EncapGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SRv6EncapGroup.Merge(m, src)
+}
+func (m *SRv6EncapGroup) XXX_Size() int {
+	return xxx_messageInfo_SRv6EncapGroup.Size(m)
+}
+func (m *SRv6EncapGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_SRv6EncapGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SRv6EncapGroup proto.InternalMessageInfo
+
+func (m *SRv6EncapGroup) GetEncapId() uint32 {
+	if m != nil {
+		return m.EncapId
+	}
+	return 0
+}
+
+func (m *SRv6EncapGroup) GetMode() SRv6EncapGroup_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return SRv6EncapGroup_INLINE
+}
+
+func (m *SRv6EncapGroup) GetSegments() []string {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *SRv6EncapGroup) GetNeId() uint32 {
+	if m != nil {
+		return m.NeId
+	}
+	return 0
 }
 
 // 0x90VVNNNN (VV:VRF, NNNN:NeId)
@@ -2805,7 +3963,7 @@ func (m *MPLSInterfaceGroup) Reset()         { *m = MPLSInterfaceGroup{} }
 func (m *MPLSInterfaceGroup) String() string { return proto.CompactTextString(m) }
 func (*MPLSInterfaceGroup) ProtoMessage()    {}
 func (*MPLSInterfaceGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{17}
+	return fileDescriptor_68149358b23bd798, []int{26}
 }
 
 func (m *MPLSInterfaceGroup) XXX_Unmarshal(b []byte) error {
@@ -2877,7 +4035,7 @@ func (m *MPLSLabelGroup) Reset()         { *m = MPLSLabelGroup{} }
 func (m *MPLSLabelGroup) String() string { return proto.CompactTextString(m) }
 func (*MPLSLabelGroup) ProtoMessage()    {}
 func (*MPLSLabelGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{18}
+	return fileDescriptor_68149358b23bd798, []int{27}
 }
 
 func (m *MPLSLabelGroup) XXX_Unmarshal(b []byte) error {
@@ -2946,7 +4104,7 @@ func (m *FFHello) Reset()         { *m = FFHello{} }
 func (m *FFHello) String() string { return proto.CompactTextString(m) }
 func (*FFHello) ProtoMessage()    {}
 func (*FFHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{19}
+	return fileDescriptor_68149358b23bd798, []int{28}
 }
 
 func (m *FFHello) XXX_Unmarshal(b []byte) error {
@@ -3001,7 +4159,7 @@ func (m *FFPort) Reset()         { *m = FFPort{} }
 func (m *FFPort) String() string { return proto.CompactTextString(m) }
 func (*FFPort) ProtoMessage()    {}
 func (*FFPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{20}
+	return fileDescriptor_68149358b23bd798, []int{29}
 }
 
 func (m *FFPort) XXX_Unmarshal(b []byte) error {
@@ -3099,7 +4257,7 @@ func (m *FFPortStats) Reset()         { *m = FFPortStats{} }
 func (m *FFPortStats) String() string { return proto.CompactTextString(m) }
 func (*FFPortStats) ProtoMessage()    {}
 func (*FFPortStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{21}
+	return fileDescriptor_68149358b23bd798, []int{30}
 }
 
 func (m *FFPortStats) XXX_Unmarshal(b []byte) error {
@@ -3152,7 +4310,7 @@ func (m *OAM) Reset()         { *m = OAM{} }
 func (m *OAM) String() string { return proto.CompactTextString(m) }
 func (*OAM) ProtoMessage()    {}
 func (*OAM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22}
+	return fileDescriptor_68149358b23bd798, []int{31}
 }
 
 func (m *OAM) XXX_Unmarshal(b []byte) error {
@@ -3183,7 +4341,7 @@ func (m *OAM_AuditRouteCntRequest) Reset()         { *m = OAM_AuditRouteCntReque
 func (m *OAM_AuditRouteCntRequest) String() string { return proto.CompactTextString(m) }
 func (*OAM_AuditRouteCntRequest) ProtoMessage()    {}
 func (*OAM_AuditRouteCntRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22, 0}
+	return fileDescriptor_68149358b23bd798, []int{31, 0}
 }
 
 func (m *OAM_AuditRouteCntRequest) XXX_Unmarshal(b []byte) error {
@@ -3215,7 +4373,7 @@ func (m *OAM_AuditRouteCntReply) Reset()         { *m = OAM_AuditRouteCntReply{}
 func (m *OAM_AuditRouteCntReply) String() string { return proto.CompactTextString(m) }
 func (*OAM_AuditRouteCntReply) ProtoMessage()    {}
 func (*OAM_AuditRouteCntReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22, 1}
+	return fileDescriptor_68149358b23bd798, []int{31, 1}
 }
 
 func (m *OAM_AuditRouteCntReply) XXX_Unmarshal(b []byte) error {
@@ -3259,7 +4417,7 @@ func (m *OAM_Request) Reset()         { *m = OAM_Request{} }
 func (m *OAM_Request) String() string { return proto.CompactTextString(m) }
 func (*OAM_Request) ProtoMessage()    {}
 func (*OAM_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22, 2}
+	return fileDescriptor_68149358b23bd798, []int{31, 2}
 }
 
 func (m *OAM_Request) XXX_Unmarshal(b []byte) error {
@@ -3348,7 +4506,7 @@ func (m *OAM_Reply) Reset()         { *m = OAM_Reply{} }
 func (m *OAM_Reply) String() string { return proto.CompactTextString(m) }
 func (*OAM_Reply) ProtoMessage()    {}
 func (*OAM_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{22, 3}
+	return fileDescriptor_68149358b23bd798, []int{31, 3}
 }
 
 func (m *OAM_Reply) XXX_Unmarshal(b []byte) error {
@@ -3432,7 +4590,7 @@ func (m *FFMultipart) Reset()         { *m = FFMultipart{} }
 func (m *FFMultipart) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart) ProtoMessage()    {}
 func (*FFMultipart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23}
+	return fileDescriptor_68149358b23bd798, []int{32}
 }
 
 func (m *FFMultipart) XXX_Unmarshal(b []byte) error {
@@ -3466,7 +4624,7 @@ func (m *FFMultipart_PortRequest) Reset()         { *m = FFMultipart_PortRequest
 func (m *FFMultipart_PortRequest) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_PortRequest) ProtoMessage()    {}
 func (*FFMultipart_PortRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 0}
+	return fileDescriptor_68149358b23bd798, []int{32, 0}
 }
 
 func (m *FFMultipart_PortRequest) XXX_Unmarshal(b []byte) error {
@@ -3519,7 +4677,7 @@ func (m *FFMultipart_PortReply) Reset()         { *m = FFMultipart_PortReply{} }
 func (m *FFMultipart_PortReply) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_PortReply) ProtoMessage()    {}
 func (*FFMultipart_PortReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 1}
+	return fileDescriptor_68149358b23bd798, []int{32, 1}
 }
 
 func (m *FFMultipart_PortReply) XXX_Unmarshal(b []byte) error {
@@ -3558,7 +4716,7 @@ func (m *FFMultipart_PortDescRequest) Reset()         { *m = FFMultipart_PortDes
 func (m *FFMultipart_PortDescRequest) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_PortDescRequest) ProtoMessage()    {}
 func (*FFMultipart_PortDescRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 2}
+	return fileDescriptor_68149358b23bd798, []int{32, 2}
 }
 
 func (m *FFMultipart_PortDescRequest) XXX_Unmarshal(b []byte) error {
@@ -3598,7 +4756,7 @@ func (m *FFMultipart_PortDescReply) Reset()         { *m = FFMultipart_PortDescR
 func (m *FFMultipart_PortDescReply) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_PortDescReply) ProtoMessage()    {}
 func (*FFMultipart_PortDescReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 3}
+	return fileDescriptor_68149358b23bd798, []int{32, 3}
 }
 
 func (m *FFMultipart_PortDescReply) XXX_Unmarshal(b []byte) error {
@@ -3633,12 +4791,154 @@ func (m *FFMultipart_PortDescReply) GetPort() []*FFPort {
 	return nil
 }
 
+type FFMultipart_FlowRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FFMultipart_FlowRequest) Reset()         { *m = FFMultipart_FlowRequest{} }
+func (m *FFMultipart_FlowRequest) String() string { return proto.CompactTextString(m) }
+func (*FFMultipart_FlowRequest) ProtoMessage()    {}
+func (*FFMultipart_FlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{32, 4}
+}
+
+func (m *FFMultipart_FlowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FFMultipart_FlowRequest.Unmarshal(m, b)
+}
+func (m *FFMultipart_FlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FFMultipart_FlowRequest.Marshal(b, m, deterministic)
+}
+func (m *FFMultipart_FlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FFMultipart_FlowRequest.Merge(m, src)
+}
+func (m *FFMultipart_FlowRequest) XXX_Size() int {
+	return xxx_messageInfo_FFMultipart_FlowRequest.Size(m)
+}
+func (m *FFMultipart_FlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FFMultipart_FlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FFMultipart_FlowRequest proto.InternalMessageInfo
+
+type FFMultipart_FlowReply struct {
+	Flows                []*FlowMod `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *FFMultipart_FlowReply) Reset()         { *m = FFMultipart_FlowReply{} }
+func (m *FFMultipart_FlowReply) String() string { return proto.CompactTextString(m) }
+func (*FFMultipart_FlowReply) ProtoMessage()    {}
+func (*FFMultipart_FlowReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{32, 5}
+}
+
+func (m *FFMultipart_FlowReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FFMultipart_FlowReply.Unmarshal(m, b)
+}
+func (m *FFMultipart_FlowReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FFMultipart_FlowReply.Marshal(b, m, deterministic)
+}
+func (m *FFMultipart_FlowReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FFMultipart_FlowReply.Merge(m, src)
+}
+func (m *FFMultipart_FlowReply) XXX_Size() int {
+	return xxx_messageInfo_FFMultipart_FlowReply.Size(m)
+}
+func (m *FFMultipart_FlowReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FFMultipart_FlowReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FFMultipart_FlowReply proto.InternalMessageInfo
+
+func (m *FFMultipart_FlowReply) GetFlows() []*FlowMod {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+type FFMultipart_GroupDescRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FFMultipart_GroupDescRequest) Reset()         { *m = FFMultipart_GroupDescRequest{} }
+func (m *FFMultipart_GroupDescRequest) String() string { return proto.CompactTextString(m) }
+func (*FFMultipart_GroupDescRequest) ProtoMessage()    {}
+func (*FFMultipart_GroupDescRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{32, 6}
+}
+
+func (m *FFMultipart_GroupDescRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FFMultipart_GroupDescRequest.Unmarshal(m, b)
+}
+func (m *FFMultipart_GroupDescRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FFMultipart_GroupDescRequest.Marshal(b, m, deterministic)
+}
+func (m *FFMultipart_GroupDescRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FFMultipart_GroupDescRequest.Merge(m, src)
+}
+func (m *FFMultipart_GroupDescRequest) XXX_Size() int {
+	return xxx_messageInfo_FFMultipart_GroupDescRequest.Size(m)
+}
+func (m *FFMultipart_GroupDescRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FFMultipart_GroupDescRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FFMultipart_GroupDescRequest proto.InternalMessageInfo
+
+type FFMultipart_GroupDescReply struct {
+	Groups               []*GroupMod `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FFMultipart_GroupDescReply) Reset()         { *m = FFMultipart_GroupDescReply{} }
+func (m *FFMultipart_GroupDescReply) String() string { return proto.CompactTextString(m) }
+func (*FFMultipart_GroupDescReply) ProtoMessage()    {}
+func (*FFMultipart_GroupDescReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68149358b23bd798, []int{32, 7}
+}
+
+func (m *FFMultipart_GroupDescReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FFMultipart_GroupDescReply.Unmarshal(m, b)
+}
+func (m *FFMultipart_GroupDescReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FFMultipart_GroupDescReply.Marshal(b, m, deterministic)
+}
+func (m *FFMultipart_GroupDescReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FFMultipart_GroupDescReply.Merge(m, src)
+}
+func (m *FFMultipart_GroupDescReply) XXX_Size() int {
+	return xxx_messageInfo_FFMultipart_GroupDescReply.Size(m)
+}
+func (m *FFMultipart_GroupDescReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FFMultipart_GroupDescReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FFMultipart_GroupDescReply proto.InternalMessageInfo
+
+func (m *FFMultipart_GroupDescReply) GetGroups() []*GroupMod {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
 type FFMultipart_Request struct {
 	DpId   uint64             `protobuf:"varint,1,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
 	MpType FFMultipart_MpType `protobuf:"varint,2,opt,name=mp_type,json=mpType,proto3,enum=fibcapi.FFMultipart_MpType" json:"mp_type,omitempty"`
 	// Types that are valid to be assigned to Body:
 	//	*FFMultipart_Request_Port
 	//	*FFMultipart_Request_PortDesc
+	//	*FFMultipart_Request_Flow
+	//	*FFMultipart_Request_GroupDesc
 	Body                 isFFMultipart_Request_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -3649,7 +4949,7 @@ func (m *FFMultipart_Request) Reset()         { *m = FFMultipart_Request{} }
 func (m *FFMultipart_Request) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_Request) ProtoMessage()    {}
 func (*FFMultipart_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 4}
+	return fileDescriptor_68149358b23bd798, []int{32, 8}
 }
 
 func (m *FFMultipart_Request) XXX_Unmarshal(b []byte) error {
@@ -3696,10 +4996,22 @@ type FFMultipart_Request_PortDesc struct {
 	PortDesc *FFMultipart_PortDescRequest `protobuf:"bytes,4,opt,name=port_desc,json=portDesc,proto3,oneof"`
 }
 
+type FFMultipart_Request_Flow struct {
+	Flow *FFMultipart_FlowRequest `protobuf:"bytes,5,opt,name=flow,proto3,oneof"`
+}
+
+type FFMultipart_Request_GroupDesc struct {
+	GroupDesc *FFMultipart_GroupDescRequest `protobuf:"bytes,6,opt,name=group_desc,json=groupDesc,proto3,oneof"`
+}
+
 func (*FFMultipart_Request_Port) isFFMultipart_Request_Body() {}
 
 func (*FFMultipart_Request_PortDesc) isFFMultipart_Request_Body() {}
 
+func (*FFMultipart_Request_Flow) isFFMultipart_Request_Body() {}
+
+func (*FFMultipart_Request_GroupDesc) isFFMultipart_Request_Body() {}
+
 func (m *FFMultipart_Request) GetBody() isFFMultipart_Request_Body {
 	if m != nil {
 		return m.Body
@@ -3721,11 +5033,27 @@ func (m *FFMultipart_Request) GetPortDesc() *FFMultipart_PortDescRequest {
 	return nil
 }
 
+func (m *FFMultipart_Request) GetFlow() *FFMultipart_FlowRequest {
+	if x, ok := m.GetBody().(*FFMultipart_Request_Flow); ok {
+		return x.Flow
+	}
+	return nil
+}
+
+func (m *FFMultipart_Request) GetGroupDesc() *FFMultipart_GroupDescRequest {
+	if x, ok := m.GetBody().(*FFMultipart_Request_GroupDesc); ok {
+		return x.GroupDesc
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FFMultipart_Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*FFMultipart_Request_Port)(nil),
 		(*FFMultipart_Request_PortDesc)(nil),
+		(*FFMultipart_Request_Flow)(nil),
+		(*FFMultipart_Request_GroupDesc)(nil),
 	}
 }
 
@@ -3735,6 +5063,8 @@ type FFMultipart_Reply struct {
 	// Types that are valid to be assigned to Body:
 	//	*FFMultipart_Reply_Port
 	//	*FFMultipart_Reply_PortDesc
+	//	*FFMultipart_Reply_Flow
+	//	*FFMultipart_Reply_GroupDesc
 	Body                 isFFMultipart_Reply_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -3745,7 +5075,7 @@ func (m *FFMultipart_Reply) Reset()         { *m = FFMultipart_Reply{} }
 func (m *FFMultipart_Reply) String() string { return proto.CompactTextString(m) }
 func (*FFMultipart_Reply) ProtoMessage()    {}
 func (*FFMultipart_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{23, 5}
+	return fileDescriptor_68149358b23bd798, []int{32, 9}
 }
 
 func (m *FFMultipart_Reply) XXX_Unmarshal(b []byte) error {
@@ -3792,10 +5122,22 @@ type FFMultipart_Reply_PortDesc struct {
 	PortDesc *FFMultipart_PortDescReply `protobuf:"bytes,4,opt,name=port_desc,json=portDesc,proto3,oneof"`
 }
 
+type FFMultipart_Reply_Flow struct {
+	Flow *FFMultipart_FlowReply `protobuf:"bytes,5,opt,name=flow,proto3,oneof"`
+}
+
+type FFMultipart_Reply_GroupDesc struct {
+	GroupDesc *FFMultipart_GroupDescReply `protobuf:"bytes,6,opt,name=group_desc,json=groupDesc,proto3,oneof"`
+}
+
 func (*FFMultipart_Reply_Port) isFFMultipart_Reply_Body() {}
 
 func (*FFMultipart_Reply_PortDesc) isFFMultipart_Reply_Body() {}
 
+func (*FFMultipart_Reply_Flow) isFFMultipart_Reply_Body() {}
+
+func (*FFMultipart_Reply_GroupDesc) isFFMultipart_Reply_Body() {}
+
 func (m *FFMultipart_Reply) GetBody() isFFMultipart_Reply_Body {
 	if m != nil {
 		return m.Body
@@ -3817,11 +5159,27 @@ func (m *FFMultipart_Reply) GetPortDesc() *FFMultipart_PortDescReply {
 	return nil
 }
 
+func (m *FFMultipart_Reply) GetFlow() *FFMultipart_FlowReply {
+	if x, ok := m.GetBody().(*FFMultipart_Reply_Flow); ok {
+		return x.Flow
+	}
+	return nil
+}
+
+func (m *FFMultipart_Reply) GetGroupDesc() *FFMultipart_GroupDescReply {
+	if x, ok := m.GetBody().(*FFMultipart_Reply_GroupDesc); ok {
+		return x.GroupDesc
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FFMultipart_Reply) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*FFMultipart_Reply_Port)(nil),
 		(*FFMultipart_Reply_PortDesc)(nil),
+		(*FFMultipart_Reply_Flow)(nil),
+		(*FFMultipart_Reply_GroupDesc)(nil),
 	}
 }
 
@@ -3839,7 +5197,7 @@ func (m *FFPacketIn) Reset()         { *m = FFPacketIn{} }
 func (m *FFPacketIn) String() string { return proto.CompactTextString(m) }
 func (*FFPacketIn) ProtoMessage()    {}
 func (*FFPacketIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{24}
+	return fileDescriptor_68149358b23bd798, []int{33}
 }
 
 func (m *FFPacketIn) XXX_Unmarshal(b []byte) error {
@@ -3895,7 +5253,7 @@ func (m *FFPacketOut) Reset()         { *m = FFPacketOut{} }
 func (m *FFPacketOut) String() string { return proto.CompactTextString(m) }
 func (*FFPacketOut) ProtoMessage()    {}
 func (*FFPacketOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{25}
+	return fileDescriptor_68149358b23bd798, []int{34}
 }
 
 func (m *FFPacketOut) XXX_Unmarshal(b []byte) error {
@@ -3951,7 +5309,7 @@ func (m *FFPacket) Reset()         { *m = FFPacket{} }
 func (m *FFPacket) String() string { return proto.CompactTextString(m) }
 func (*FFPacket) ProtoMessage()    {}
 func (*FFPacket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{26}
+	return fileDescriptor_68149358b23bd798, []int{35}
 }
 
 func (m *FFPacket) XXX_Unmarshal(b []byte) error {
@@ -4014,7 +5372,7 @@ func (m *FFPortStatus) Reset()         { *m = FFPortStatus{} }
 func (m *FFPortStatus) String() string { return proto.CompactTextString(m) }
 func (*FFPortStatus) ProtoMessage()    {}
 func (*FFPortStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{27}
+	return fileDescriptor_68149358b23bd798, []int{36}
 }
 
 func (m *FFPortStatus) XXX_Unmarshal(b []byte) error {
@@ -4071,7 +5429,7 @@ func (m *FFPortMod) Reset()         { *m = FFPortMod{} }
 func (m *FFPortMod) String() string { return proto.CompactTextString(m) }
 func (*FFPortMod) ProtoMessage()    {}
 func (*FFPortMod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{28}
+	return fileDescriptor_68149358b23bd798, []int{37}
 }
 
 func (m *FFPortMod) XXX_Unmarshal(b []byte) error {
@@ -4133,7 +5491,7 @@ func (m *FFL2AddrStatus) Reset()         { *m = FFL2AddrStatus{} }
 func (m *FFL2AddrStatus) String() string { return proto.CompactTextString(m) }
 func (*FFL2AddrStatus) ProtoMessage()    {}
 func (*FFL2AddrStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{29}
+	return fileDescriptor_68149358b23bd798, []int{38}
 }
 
 func (m *FFL2AddrStatus) XXX_Unmarshal(b []byte) error {
@@ -4181,7 +5539,7 @@ func (m *L2AddrStatus) Reset()         { *m = L2AddrStatus{} }
 func (m *L2AddrStatus) String() string { return proto.CompactTextString(m) }
 func (*L2AddrStatus) ProtoMessage()    {}
 func (*L2AddrStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{30}
+	return fileDescriptor_68149358b23bd798, []int{39}
 }
 
 func (m *L2AddrStatus) XXX_Unmarshal(b []byte) error {
@@ -4232,7 +5590,7 @@ func (m *L2Addr) Reset()         { *m = L2Addr{} }
 func (m *L2Addr) String() string { return proto.CompactTextString(m) }
 func (*L2Addr) ProtoMessage()    {}
 func (*L2Addr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68149358b23bd798, []int{31}
+	return fileDescriptor_68149358b23bd798, []int{40}
 }
 
 func (m *L2Addr) XXX_Unmarshal(b []byte) error {
@@ -4306,7 +5664,11 @@ func init() {
 	proto.RegisterEnum("fibcapi.UnicastRoutingFlow_Origin", UnicastRoutingFlow_Origin_name, UnicastRoutingFlow_Origin_value)
 	proto.RegisterEnum("fibcapi.UnicastRoutingFlow_Action_Name", UnicastRoutingFlow_Action_Name_name, UnicastRoutingFlow_Action_Name_value)
 	proto.RegisterEnum("fibcapi.BridgingFlow_Action_Name", BridgingFlow_Action_Name_name, BridgingFlow_Action_Name_value)
+	proto.RegisterEnum("fibcapi.SRv6LocalSidFlow_Action_Name", SRv6LocalSidFlow_Action_Name_name, SRv6LocalSidFlow_Action_Name_value)
 	proto.RegisterEnum("fibcapi.PolicyACLFlow_Action_Name", PolicyACLFlow_Action_Name_name, PolicyACLFlow_Action_Name_value)
+	proto.RegisterEnum("fibcapi.BondAttrs_Mode", BondAttrs_Mode_name, BondAttrs_Mode_value)
+	proto.RegisterEnum("fibcapi.BondAttrs_HashPolicy", BondAttrs_HashPolicy_name, BondAttrs_HashPolicy_value)
+	proto.RegisterEnum("fibcapi.SRv6EncapGroup_Mode", SRv6EncapGroup_Mode_name, SRv6EncapGroup_Mode_value)
 	proto.RegisterEnum("fibcapi.FFHello_DpType", FFHello_DpType_name, FFHello_DpType_value)
 	proto.RegisterEnum("fibcapi.FFPortStats_Cmd", FFPortStats_Cmd_name, FFPortStats_Cmd_value)
 	proto.RegisterEnum("fibcapi.OAM_OAMType", OAM_OAMType_name, OAM_OAMType_value)
@@ -4334,14 +5696,27 @@ func init() {
 	proto.RegisterType((*UnicastRoutingFlow)(nil), "fibcapi.UnicastRoutingFlow")
 	proto.RegisterType((*UnicastRoutingFlow_Match)(nil), "fibcapi.UnicastRoutingFlow.Match")
 	proto.RegisterType((*UnicastRoutingFlow_Action)(nil), "fibcapi.UnicastRoutingFlow.Action")
+	proto.RegisterType((*MulticastRoutingFlow)(nil), "fibcapi.MulticastRoutingFlow")
+	proto.RegisterType((*MulticastRoutingFlow_Match)(nil), "fibcapi.MulticastRoutingFlow.Match")
 	proto.RegisterType((*BridgingFlow)(nil), "fibcapi.BridgingFlow")
 	proto.RegisterType((*BridgingFlow_Match)(nil), "fibcapi.BridgingFlow.Match")
 	proto.RegisterType((*BridgingFlow_Action)(nil), "fibcapi.BridgingFlow.Action")
+	proto.RegisterType((*SRv6LocalSidFlow)(nil), "fibcapi.SRv6LocalSidFlow")
+	proto.RegisterType((*SRv6LocalSidFlow_Match)(nil), "fibcapi.SRv6LocalSidFlow.Match")
+	proto.RegisterType((*SRv6LocalSidFlow_Action)(nil), "fibcapi.SRv6LocalSidFlow.Action")
 	proto.RegisterType((*PolicyACLFlow)(nil), "fibcapi.PolicyACLFlow")
 	proto.RegisterType((*PolicyACLFlow_Match)(nil), "fibcapi.PolicyACLFlow.Match")
 	proto.RegisterType((*PolicyACLFlow_Action)(nil), "fibcapi.PolicyACLFlow.Action")
 	proto.RegisterType((*L2InterfaceGroup)(nil), "fibcapi.L2InterfaceGroup")
+	proto.RegisterType((*BondAttrs)(nil), "fibcapi.BondAttrs")
+	proto.RegisterType((*BondSlaveAttrs)(nil), "fibcapi.BondSlaveAttrs")
 	proto.RegisterType((*L3UnicastGroup)(nil), "fibcapi.L3UnicastGroup")
+	proto.RegisterType((*L3EcmpGroup)(nil), "fibcapi.L3EcmpGroup")
+	proto.RegisterType((*L3MulticastGroup)(nil), "fibcapi.L3MulticastGroup")
+	proto.RegisterType((*L3MulticastGroup_Port)(nil), "fibcapi.L3MulticastGroup.Port")
+	proto.RegisterType((*L2OverlayGroup)(nil), "fibcapi.L2OverlayGroup")
+	proto.RegisterType((*VxlanTunnel)(nil), "fibcapi.VxlanTunnel")
+	proto.RegisterType((*SRv6EncapGroup)(nil), "fibcapi.SRv6EncapGroup")
 	proto.RegisterType((*MPLSInterfaceGroup)(nil), "fibcapi.MPLSInterfaceGroup")
 	proto.RegisterType((*MPLSLabelGroup)(nil), "fibcapi.MPLSLabelGroup")
 	proto.RegisterType((*FFHello)(nil), "fibcapi.FFHello")
@@ -4359,6 +5734,10 @@ func init() {
 	proto.RegisterType((*FFMultipart_PortReply)(nil), "fibcapi.FFMultipart.PortReply")
 	proto.RegisterType((*FFMultipart_PortDescRequest)(nil), "fibcapi.FFMultipart.PortDescRequest")
 	proto.RegisterType((*FFMultipart_PortDescReply)(nil), "fibcapi.FFMultipart.PortDescReply")
+	proto.RegisterType((*FFMultipart_FlowRequest)(nil), "fibcapi.FFMultipart.FlowRequest")
+	proto.RegisterType((*FFMultipart_FlowReply)(nil), "fibcapi.FFMultipart.FlowReply")
+	proto.RegisterType((*FFMultipart_GroupDescRequest)(nil), "fibcapi.FFMultipart.GroupDescRequest")
+	proto.RegisterType((*FFMultipart_GroupDescReply)(nil), "fibcapi.FFMultipart.GroupDescReply")
 	proto.RegisterType((*FFMultipart_Request)(nil), "fibcapi.FFMultipart.Request")
 	proto.RegisterType((*FFMultipart_Reply)(nil), "fibcapi.FFMultipart.Reply")
 	proto.RegisterType((*FFPacketIn)(nil), "fibcapi.FFPacketIn")
@@ -4374,221 +5753,294 @@ func init() {
 func init() { proto.RegisterFile("fibcapi.proto", fileDescriptor_68149358b23bd798) }
 
 var fileDescriptor_68149358b23bd798 = []byte{
-	// 3454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4d, 0x8f, 0xdb, 0x48,
-	0x76, 0xa6, 0x28, 0x51, 0xd2, 0xeb, 0xaf, 0x72, 0xb9, 0x6d, 0xb7, 0xe5, 0x8f, 0x78, 0xb8, 0xc9,
-	0xda, 0x63, 0x64, 0x7b, 0xc6, 0xea, 0x59, 0xcf, 0x64, 0x32, 0x08, 0xc2, 0x96, 0x48, 0x35, 0xb3,
-	0x94, 0xc8, 0xa1, 0xa8, 0xf6, 0xf8, 0xc4, 0xd0, 0x22, 0xdd, 0x2d, 0x58, 0x5f, 0x91, 0xd8, 0xf6,
-	0xf6, 0xe6, 0x92, 0x6c, 0x3e, 0xae, 0xf9, 0x5c, 0x20, 0x41, 0xf6, 0x9c, 0x4d, 0x10, 0x20, 0x48,
-	0x80, 0xe4, 0x10, 0xe4, 0xbc, 0x8b, 0x00, 0x39, 0xe6, 0x94, 0x53, 0xf6, 0x94, 0x53, 0xfe, 0xc3,
-	0x06, 0xaf, 0xaa, 0x28, 0x92, 0x92, 0xba, 0xdb, 0xce, 0x6e, 0xb0, 0x87, 0x86, 0xaa, 0x5e, 0xbd,
-	0xf7, 0xea, 0xd5, 0xfb, 0xaa, 0xc7, 0x57, 0x0d, 0x5b, 0xaf, 0x06, 0x2f, 0xfb, 0xc1, 0x74, 0xb0,
-	0x3f, 0x9d, 0x4d, 0xe2, 0x09, 0x2d, 0x8b, 0xa9, 0x7a, 0x0f, 0x4a, 0x47, 0xd1, 0x70, 0x38, 0xa1,
-	0x37, 0xa0, 0x34, 0x8b, 0xfc, 0x41, 0xb8, 0x27, 0x3d, 0x94, 0x1e, 0x57, 0xdd, 0xe2, 0x2c, 0x32,
-	0x43, 0xf5, 0x3b, 0x50, 0x69, 0x4e, 0xbb, 0x71, 0x10, 0x9f, 0xcd, 0xe9, 0xc7, 0xa0, 0xcc, 0xd9,
-	0x88, 0x61, 0x6c, 0xd7, 0xf7, 0xf6, 0x13, 0x96, 0x09, 0xca, 0x3e, 0xff, 0x71, 0x05, 0x5e, 0xca,
-	0xb2, 0x90, 0x61, 0xf9, 0x08, 0x14, 0xc1, 0xb0, 0x0c, 0x72, 0xc7, 0x76, 0xc8, 0x35, 0x5a, 0x85,
-	0x92, 0xde, 0xf1, 0x74, 0x97, 0x48, 0x38, 0xb4, 0x74, 0xed, 0x58, 0x27, 0x05, 0x55, 0x07, 0xf0,
-	0xce, 0xc6, 0xe3, 0x68, 0xe8, 0x9d, 0x4f, 0x23, 0xf5, 0x53, 0x28, 0xe2, 0x6f, 0x4a, 0x54, 0x81,
-	0xa2, 0xe9, 0x98, 0x0e, 0x91, 0xf8, 0xe8, 0xf8, 0x19, 0x29, 0xe0, 0xa8, 0xe5, 0xea, 0x9f, 0x10,
-	0x59, 0x8c, 0x9e, 0x91, 0xa2, 0xfa, 0x0a, 0xb6, 0x0f, 0x67, 0x83, 0xf0, 0x24, 0x3a, 0x1e, 0x06,
-	0x63, 0x73, 0xfc, 0x6a, 0xa2, 0x7a, 0x50, 0x32, 0x86, 0xc1, 0x49, 0x46, 0x00, 0x00, 0xa5, 0xad,
-	0x75, 0xb9, 0x04, 0x15, 0x28, 0x3a, 0xc7, 0x66, 0x93, 0x14, 0xe8, 0x26, 0x54, 0x7a, 0x1d, 0x4f,
-	0x6b, 0xb5, 0xf4, 0x26, 0x29, 0xd2, 0x1d, 0xd8, 0x70, 0xb5, 0x4e, 0x4b, 0xf7, 0x0f, 0xf5, 0x96,
-	0xd9, 0x21, 0x15, 0xba, 0x05, 0x55, 0x0e, 0xd0, 0x3b, 0x4d, 0x42, 0xd4, 0xbf, 0x93, 0x00, 0x9c,
-	0xc9, 0x2c, 0x16, 0x87, 0xab, 0x2f, 0x69, 0xab, 0xb6, 0xd0, 0x56, 0x8a, 0xf4, 0x2e, 0xfa, 0xa2,
-	0xb7, 0xa1, 0x3c, 0x9d, 0xcc, 0x62, 0x04, 0xcb, 0x0f, 0xa5, 0xc7, 0x5b, 0xae, 0x82, 0x53, 0x33,
-	0xa4, 0xb7, 0x40, 0x19, 0xbc, 0x1a, 0x07, 0xa3, 0x68, 0xaf, 0xc8, 0xd0, 0xc5, 0x4c, 0xfd, 0xda,
-	0xaa, 0x82, 0x15, 0x28, 0xf4, 0x84, 0xa6, 0x9a, 0xf6, 0xf3, 0x0e, 0x29, 0xa8, 0x01, 0x54, 0xac,
-	0xc1, 0xf8, 0x35, 0x53, 0x6d, 0x4f, 0xa8, 0x16, 0x40, 0x69, 0xea, 0xc7, 0x66, 0x43, 0xe7, 0x26,
-	0x31, 0x1d, 0xaf, 0xd7, 0x21, 0x12, 0x82, 0x0f, 0x5d, 0xb3, 0xd9, 0xd2, 0x49, 0x81, 0x12, 0xd8,
-	0xe4, 0x63, 0xbf, 0x6b, 0xa1, 0x95, 0x98, 0xa2, 0x0f, 0xed, 0x0e, 0x2a, 0x68, 0x1b, 0x00, 0x47,
-	0x62, 0xa5, 0xa4, 0xfe, 0xa0, 0xc0, 0x15, 0xd2, 0x98, 0x8c, 0x5f, 0x0d, 0x4e, 0xe8, 0x87, 0x20,
-	0xf7, 0x47, 0xa1, 0xd0, 0xc6, 0xed, 0x9c, 0x36, 0x38, 0xc6, 0x7e, 0x63, 0x14, 0xba, 0x88, 0xb3,
-	0x5e, 0x0f, 0xe9, 0x71, 0xe5, 0xec, 0x71, 0xb3, 0xfa, 0x29, 0xe6, 0xf4, 0x43, 0xa1, 0x38, 0x1c,
-	0x8c, 0x5f, 0xef, 0x95, 0x38, 0x13, 0x1c, 0x23, 0x93, 0x51, 0x30, 0x8f, 0xa3, 0xd9, 0x9e, 0xc2,
-	0x99, 0xf0, 0x19, 0x32, 0x09, 0xa7, 0x3e, 0x12, 0xee, 0x95, 0x39, 0x93, 0x70, 0x8a, 0x92, 0x65,
-	0xcc, 0x58, 0x79, 0x57, 0x33, 0xaa, 0x1f, 0x81, 0xdc, 0x18, 0x85, 0xa9, 0xf6, 0xcb, 0x20, 0x6b,
-	0xcd, 0x26, 0xd7, 0x64, 0xdb, 0x6e, 0x9a, 0xc6, 0x0b, 0x52, 0xe0, 0xca, 0xb6, 0x74, 0x4f, 0x27,
-	0xb2, 0xfa, 0x5f, 0x25, 0x28, 0x1b, 0xc3, 0xc9, 0xdb, 0xf6, 0x24, 0xa4, 0x5f, 0xcf, 0xaa, 0x69,
-	0x77, 0xb1, 0x9b, 0x58, 0x4e, 0x75, 0xf4, 0xcb, 0x50, 0x8a, 0x83, 0x97, 0xc3, 0x88, 0xe9, 0x68,
-	0xbb, 0x7e, 0x6b, 0x05, 0xd3, 0xc3, 0x55, 0x97, 0x23, 0xa5, 0x1a, 0x95, 0x33, 0x1a, 0x7d, 0x04,
-	0xc5, 0x37, 0xc3, 0x60, 0xcc, 0xd4, 0xb6, 0x51, 0xbf, 0xbe, 0xe0, 0x70, 0x6c, 0x69, 0x1d, 0xe4,
-	0x72, 0x74, 0xcd, 0x65, 0x08, 0xf4, 0x33, 0xa8, 0xc4, 0xd1, 0x6c, 0xe4, 0x8f, 0x82, 0x3e, 0xd3,
-	0xe6, 0x46, 0xfd, 0xee, 0x02, 0xd9, 0x8b, 0x66, 0xa3, 0xc1, 0x38, 0x88, 0x07, 0x93, 0x71, 0x3b,
-	0xe8, 0x0b, 0xb2, 0x32, 0xa2, 0xb7, 0x83, 0x3e, 0xfd, 0x10, 0x4a, 0xa3, 0xe9, 0x70, 0xfe, 0x94,
-	0xa9, 0x3b, 0xbb, 0x47, 0xdb, 0xb1, 0xba, 0x02, 0x99, 0x63, 0xd0, 0x4f, 0xa1, 0x7c, 0x36, 0x1e,
-	0xf4, 0x83, 0x39, 0x37, 0x41, 0x76, 0x8f, 0x1e, 0x87, 0xbb, 0x93, 0xb3, 0x78, 0x30, 0x3e, 0x49,
-	0xf6, 0x10, 0xd8, 0xf4, 0x00, 0x2a, 0x2f, 0x31, 0xc0, 0x07, 0xe3, 0x13, 0x66, 0xa4, 0x8d, 0xfa,
-	0xcd, 0x05, 0xe5, 0xa1, 0x58, 0x10, 0x34, 0x0b, 0x44, 0xfa, 0x04, 0xe4, 0xa0, 0x3f, 0xdc, 0xab,
-	0x32, 0xfc, 0x5b, 0x19, 0xa3, 0x0e, 0x07, 0xfd, 0x73, 0xad, 0x61, 0x09, 0x02, 0x44, 0x52, 0x7b,
-	0xef, 0x62, 0xcf, 0xeb, 0xb0, 0xc5, 0xc7, 0x7e, 0xd7, 0x73, 0xcd, 0x86, 0x47, 0xe4, 0x8c, 0x89,
-	0x8b, 0xb8, 0xcc, 0xc7, 0xc9, 0x72, 0x49, 0xfd, 0xb1, 0x04, 0x25, 0x66, 0x24, 0x8c, 0x2a, 0xb3,
-	0xd3, 0x72, 0xf5, 0x6e, 0xd7, 0x77, 0x6c, 0xd7, 0xe3, 0xc9, 0x0d, 0xad, 0x40, 0x00, 0x93, 0x90,
-	0xa7, 0xbb, 0x6d, 0xbf, 0xad, 0x35, 0xc8, 0x2e, 0xdd, 0x80, 0xb2, 0x75, 0xe0, 0x7b, 0x2f, 0x1c,
-	0x9d, 0xdc, 0xc4, 0x18, 0x45, 0x35, 0x7e, 0x4c, 0x6e, 0x27, 0xc3, 0xa7, 0x64, 0x2f, 0x19, 0xd6,
-	0xc9, 0x1d, 0xe4, 0x8b, 0x43, 0x3f, 0x21, 0xb9, 0x4b, 0x77, 0x81, 0x70, 0x88, 0x76, 0xa8, 0x5b,
-	0xbe, 0xe7, 0xf6, 0xba, 0x1e, 0xb9, 0x87, 0x99, 0x8c, 0x41, 0x19, 0xd2, 0x7d, 0x7a, 0x03, 0x76,
-	0x7a, 0x1d, 0xb3, 0xa1, 0x75, 0x3d, 0xdf, 0xb5, 0x7b, 0x9e, 0xd9, 0x69, 0x91, 0x07, 0xf4, 0x26,
-	0x5c, 0x6f, 0xf7, 0x2c, 0x2f, 0x0f, 0x7e, 0x8c, 0xe2, 0xb1, 0x84, 0x80, 0xb3, 0x3a, 0xa6, 0x00,
-	0xc7, 0xb6, 0xcc, 0xc6, 0x0b, 0x5f, 0x6b, 0x58, 0xe4, 0x8b, 0xc3, 0x32, 0x94, 0xa2, 0x71, 0x3c,
-	0x3b, 0x57, 0xff, 0x49, 0x81, 0x4a, 0x6b, 0x36, 0x39, 0x9b, 0xa2, 0x8b, 0x3f, 0xca, 0xba, 0x78,
-	0x6a, 0xab, 0x64, 0x3d, 0xf5, 0xf1, 0x7d, 0x50, 0x4e, 0xfc, 0xf8, 0x7c, 0x9a, 0x38, 0xf9, 0xed,
-	0x55, 0xdc, 0x16, 0x66, 0x2e, 0xb7, 0x74, 0xc2, 0x12, 0xd8, 0x5a, 0x2f, 0x7f, 0x06, 0x95, 0x61,
-	0xdd, 0x1f, 0xbc, 0x0a, 0xfa, 0x91, 0xf0, 0xf4, 0x3b, 0x0b, 0x36, 0x56, 0xdd, 0x1c, 0xc7, 0xd1,
-	0x0c, 0xd7, 0x18, 0x47, 0x74, 0xab, 0x61, 0xdd, 0xc4, 0x39, 0xfd, 0x0c, 0x60, 0x78, 0xe0, 0x27,
-	0x2e, 0xc9, 0xdd, 0x3e, 0x15, 0xc0, 0x3a, 0x10, 0x4e, 0x99, 0xd0, 0x55, 0x87, 0x09, 0x84, 0x7e,
-	0x01, 0x80, 0x2e, 0x2d, 0xf6, 0x54, 0x96, 0x9c, 0x19, 0x35, 0xbd, 0xb2, 0x6b, 0x15, 0x09, 0x16,
-	0xfb, 0x32, 0xea, 0x61, 0xf0, 0x32, 0x1a, 0x8a, 0x50, 0xb8, 0x9d, 0xa3, 0xb6, 0x70, 0x25, 0x47,
-	0xc9, 0x20, 0xef, 0x9f, 0x77, 0xbe, 0x27, 0x43, 0xa9, 0x95, 0xa4, 0xfe, 0x5e, 0xa7, 0xeb, 0xe8,
-	0x0d, 0x72, 0x0d, 0xbd, 0xc6, 0xaa, 0xfb, 0x26, 0x5e, 0xc8, 0x86, 0xd6, 0xd0, 0x89, 0x84, 0x66,
-	0xb5, 0xea, 0xbe, 0xab, 0x3f, 0x77, 0x4d, 0x4f, 0x27, 0x84, 0xcd, 0x0f, 0x7c, 0xe1, 0x23, 0xe4,
-	0xa1, 0xa0, 0x58, 0xb8, 0x07, 0xf9, 0x18, 0xdd, 0xc2, 0xaa, 0xfb, 0x86, 0x65, 0xdb, 0x4d, 0xf2,
-	0xeb, 0x6c, 0xfd, 0x20, 0xc3, 0xd1, 0x11, 0x90, 0x94, 0xe2, 0x37, 0x85, 0x67, 0xeb, 0x8d, 0xb6,
-	0x43, 0xa6, 0xf4, 0x26, 0x10, 0xab, 0xee, 0xdb, 0xc7, 0xba, 0x6b, 0x69, 0x2f, 0x7c, 0xc3, 0xf2,
-	0x7b, 0x0d, 0xf2, 0x3b, 0xd2, 0x2a, 0xb8, 0xdd, 0x20, 0xbf, 0xbb, 0x0c, 0x6e, 0x37, 0x10, 0xfb,
-	0xbb, 0x6b, 0xc0, 0xed, 0x06, 0xf9, 0x3d, 0x89, 0xde, 0x80, 0x6d, 0xe6, 0xec, 0xa9, 0x38, 0x7f,
-	0x2c, 0x51, 0x02, 0x1b, 0x3c, 0x2e, 0xea, 0xfe, 0xb1, 0xd3, 0x21, 0x7f, 0x92, 0x81, 0x1c, 0x30,
-	0xc8, 0x9f, 0x4a, 0xf4, 0xba, 0x88, 0x26, 0xaf, 0xd7, 0xe9, 0xe8, 0xd6, 0x53, 0xf2, 0x67, 0xcb,
-	0xa0, 0x3a, 0xf9, 0x73, 0xd4, 0x15, 0x8f, 0xa5, 0xee, 0x73, 0xcd, 0x21, 0xdf, 0x93, 0xe8, 0x26,
-	0x94, 0xd9, 0xdc, 0x30, 0xc8, 0x5f, 0xa7, 0xab, 0xec, 0x9c, 0x7f, 0x23, 0xd1, 0x5d, 0xd8, 0xb1,
-	0xea, 0x7e, 0xcf, 0xc8, 0x48, 0xf3, 0x0f, 0x52, 0x1a, 0x36, 0x3f, 0x96, 0xa1, 0x92, 0x64, 0x63,
-	0xfa, 0x0d, 0x28, 0x8d, 0x82, 0xb8, 0x7f, 0xca, 0x02, 0x27, 0xeb, 0x13, 0x09, 0xc6, 0x7e, 0x1b,
-	0x97, 0x5d, 0x8e, 0x45, 0xeb, 0x50, 0x0e, 0xfa, 0x98, 0x96, 0xe7, 0x7b, 0x85, 0x87, 0xf2, 0xe3,
-	0x8d, 0x4c, 0xbd, 0xb6, 0x20, 0xd0, 0x18, 0x82, 0x9b, 0x20, 0xd2, 0xfb, 0x00, 0x27, 0x93, 0x78,
-	0xe2, 0xf3, 0x9b, 0x85, 0x97, 0x1b, 0x55, 0x84, 0xb0, 0x3c, 0x55, 0x6b, 0x43, 0x89, 0x6d, 0x81,
-	0xd7, 0xe5, 0x60, 0xcc, 0xaf, 0x4b, 0x89, 0x5f, 0x97, 0x83, 0x31, 0xbb, 0x2e, 0x09, 0xc8, 0x6f,
-	0xc4, 0xbd, 0xbd, 0xe5, 0xe2, 0x90, 0xde, 0x81, 0xca, 0x9b, 0x41, 0xe8, 0x8f, 0x82, 0xf9, 0x6b,
-	0xc1, 0xb0, 0xfc, 0x66, 0x10, 0xb6, 0x83, 0xf9, 0xeb, 0xda, 0x77, 0x0b, 0xa0, 0x70, 0x09, 0xe8,
-	0x53, 0x28, 0xb2, 0xab, 0x9d, 0xe7, 0x84, 0xfb, 0x17, 0x49, 0xba, 0xdf, 0x09, 0x46, 0x91, 0xcb,
-	0x50, 0xe9, 0x2e, 0x94, 0xde, 0x04, 0xc3, 0xb3, 0x48, 0x6c, 0xc6, 0x27, 0xea, 0xdf, 0x4b, 0x50,
-	0x44, 0xa4, 0x65, 0x8f, 0xee, 0xea, 0x9e, 0x8f, 0xcc, 0x7c, 0x2c, 0xed, 0x24, 0xf4, 0x36, 0x06,
-	0x71, 0x0d, 0x5e, 0xe7, 0xe1, 0xc4, 0xc6, 0x25, 0x19, 0x33, 0x35, 0xce, 0xd2, 0x84, 0x58, 0xc4,
-	0xfc, 0xe8, 0xf4, 0xba, 0x47, 0x8c, 0x01, 0x29, 0x21, 0xbe, 0x63, 0x3b, 0x7c, 0xa6, 0x60, 0x4a,
-	0x5d, 0xe0, 0x5b, 0x75, 0x4e, 0x52, 0x4e, 0xb8, 0x70, 0xc7, 0xf0, 0xcd, 0x26, 0xa9, 0x24, 0x88,
-	0x4c, 0x8a, 0x04, 0xb1, 0xaa, 0xfe, 0x85, 0x0c, 0x74, 0xf5, 0x0e, 0xa5, 0x9f, 0xe6, 0x8d, 0xfd,
-	0xc1, 0x25, 0xf7, 0x6d, 0xde, 0xec, 0x5f, 0x2c, 0x9b, 0x5d, 0xbd, 0x8c, 0xf4, 0x3d, 0x1d, 0x60,
-	0x72, 0xa5, 0x03, 0xdc, 0x81, 0x4a, 0x14, 0x9f, 0xa6, 0x49, 0x7b, 0xcb, 0x2d, 0x47, 0xf1, 0x29,
-	0xcb, 0x31, 0xb7, 0x01, 0x87, 0x7e, 0x38, 0x8f, 0x93, 0x0a, 0x2e, 0x8a, 0x4f, 0x9b, 0x73, 0x46,
-	0x83, 0x65, 0x86, 0xff, 0x66, 0x51, 0xc2, 0x95, 0x71, 0x7e, 0x3c, 0x08, 0x6b, 0xbf, 0xbd, 0xf0,
-	0x90, 0x5f, 0xcd, 0x79, 0xc8, 0xa3, 0xab, 0x0f, 0x75, 0xb5, 0xaf, 0x3c, 0x58, 0xe3, 0x2a, 0x00,
-	0x8a, 0xdd, 0xf3, 0x9c, 0x9e, 0x47, 0x24, 0xf5, 0xfb, 0x25, 0xa8, 0x24, 0x75, 0xca, 0xc5, 0xd1,
-	0x97, 0x60, 0xbc, 0x73, 0xf4, 0x2d, 0x08, 0x96, 0x95, 0x9f, 0x5e, 0x77, 0xf2, 0x3b, 0x5d, 0x77,
-	0xd7, 0xa1, 0x78, 0x92, 0x96, 0xbd, 0xf2, 0x89, 0x19, 0x2e, 0xd9, 0xaf, 0xb4, 0x6c, 0xbf, 0x8f,
-	0x12, 0xfb, 0x11, 0x90, 0x5f, 0x4e, 0xf8, 0xa7, 0x49, 0xc5, 0xc5, 0x21, 0xaa, 0x88, 0xdf, 0x38,
-	0x42, 0x45, 0x6c, 0x52, 0xfb, 0x4b, 0xf9, 0xca, 0x10, 0x5d, 0x3a, 0xce, 0xd5, 0x6a, 0xff, 0x61,
-	0x61, 0x8d, 0xde, 0x31, 0xc4, 0x6c, 0x87, 0xd7, 0x25, 0x3c, 0x3e, 0x9b, 0x7a, 0xc3, 0xf7, 0x3c,
-	0x8b, 0x14, 0xf0, 0xcb, 0xab, 0x61, 0x3b, 0x2f, 0x70, 0xe6, 0x9b, 0x1d, 0x22, 0xe3, 0xfd, 0xc3,
-	0x01, 0x0d, 0x9c, 0x17, 0xb3, 0xd1, 0x5c, 0x5a, 0x8e, 0x47, 0x56, 0x50, 0x29, 0xab, 0x51, 0xbd,
-	0x36, 0x44, 0x05, 0xe8, 0x4b, 0x1b, 0xaf, 0x87, 0xa6, 0xfe, 0x15, 0xa9, 0x62, 0xdd, 0xc3, 0xb0,
-	0x5c, 0xcd, 0x30, 0xcc, 0x86, 0xdf, 0xb0, 0xb4, 0x6e, 0x97, 0x00, 0xa5, 0xb0, 0x8d, 0x60, 0x76,
-	0xad, 0xf1, 0x3d, 0x36, 0x16, 0x62, 0x19, 0xa6, 0x6e, 0x35, 0xc9, 0x26, 0x72, 0xc3, 0x33, 0x35,
-	0x9e, 0xfb, 0xb6, 0xeb, 0x6b, 0x8d, 0x23, 0xb2, 0x95, 0x4b, 0x1d, 0xdb, 0x09, 0x82, 0x55, 0xf7,
-	0x8f, 0x74, 0xad, 0xa9, 0xbb, 0x64, 0x07, 0xcf, 0xca, 0xf8, 0xb6, 0x75, 0x07, 0x45, 0x22, 0x74,
-	0x0f, 0x76, 0x11, 0xe0, 0xb8, 0xb6, 0xa7, 0x37, 0x3c, 0xd3, 0xee, 0x08, 0xc9, 0xae, 0xab, 0xbf,
-	0x5f, 0x04, 0xba, 0x5a, 0x19, 0x5f, 0x9c, 0x39, 0x56, 0x71, 0xf3, 0x2e, 0xfb, 0x39, 0x28, 0xdc,
-	0x13, 0x99, 0xb9, 0xb2, 0x89, 0x63, 0x0d, 0xa5, 0xf0, 0x5d, 0x41, 0xf1, 0x33, 0x70, 0xdd, 0xda,
-	0x30, 0xf1, 0xcd, 0x9b, 0xa0, 0x0c, 0xa6, 0x2c, 0x4d, 0xf0, 0x4e, 0x44, 0x69, 0x30, 0xc5, 0x2c,
-	0x81, 0x57, 0xcb, 0xec, 0xd5, 0xe2, 0x6a, 0x99, 0xbd, 0x42, 0x81, 0x27, 0xb3, 0xc1, 0xc9, 0x60,
-	0x2c, 0x36, 0xbd, 0x54, 0x60, 0x9b, 0x61, 0xba, 0x82, 0xa2, 0xf6, 0x57, 0xd2, 0x95, 0x99, 0xe5,
-	0xc2, 0x53, 0x5f, 0xed, 0xe2, 0xbf, 0x76, 0x79, 0x66, 0x41, 0xc3, 0x37, 0x2c, 0x5d, 0x43, 0xaf,
-	0x40, 0x93, 0x76, 0x49, 0x21, 0xeb, 0xf1, 0xb2, 0xfa, 0x04, 0x14, 0x2e, 0x6f, 0x8e, 0x43, 0x15,
-	0x4a, 0x1d, 0xdd, 0x6c, 0x1d, 0xf1, 0x36, 0x09, 0xd6, 0xe0, 0x3a, 0x29, 0xa8, 0xff, 0x56, 0x80,
-	0xcd, 0xec, 0x67, 0x0e, 0x7d, 0x9a, 0x77, 0x80, 0xbb, 0x6b, 0x3f, 0x86, 0xf2, 0xa6, 0xff, 0x64,
-	0xc9, 0xf4, 0xf7, 0xd6, 0xd3, 0xe4, 0x8d, 0x5e, 0xfb, 0x2a, 0x73, 0x1b, 0x24, 0x99, 0x5d, 0xba,
-	0x30, 0xb3, 0x17, 0x72, 0x99, 0x9d, 0xde, 0x85, 0x6a, 0xcc, 0xba, 0x3b, 0x69, 0x63, 0xa3, 0xc2,
-	0x01, 0x66, 0x58, 0x3b, 0x5b, 0x18, 0xe7, 0x9b, 0x39, 0xe3, 0x7c, 0x70, 0x99, 0x5c, 0x3f, 0x7d,
-	0xc2, 0xff, 0x57, 0x19, 0xb6, 0x72, 0x5f, 0x80, 0xb4, 0x9e, 0xd7, 0xe5, 0xbd, 0xf5, 0x1f, 0x8a,
-	0x79, 0x65, 0x7e, 0x73, 0x49, 0x99, 0xf7, 0x2f, 0x20, 0x5a, 0xd2, 0xe6, 0x8f, 0xa4, 0xf7, 0x0e,
-	0x80, 0xec, 0x65, 0x2b, 0xe7, 0x2f, 0xdb, 0x3b, 0x50, 0x19, 0x4c, 0x7d, 0xd6, 0xeb, 0x4b, 0xee,
-	0xd4, 0xc1, 0xd4, 0x61, 0xad, 0xbf, 0x9b, 0xa0, 0xc4, 0x53, 0x7f, 0x3e, 0xeb, 0x8b, 0xfb, 0xa1,
-	0x14, 0x4f, 0xbb, 0xb3, 0xbe, 0x00, 0xe3, 0xae, 0x4a, 0x02, 0xc6, 0x5d, 0x33, 0xb6, 0x2d, 0xe7,
-	0x6c, 0x9b, 0x29, 0x01, 0x2a, 0xd9, 0x12, 0xa0, 0xf6, 0x66, 0x61, 0xbc, 0x67, 0x39, 0xe3, 0xa9,
-	0x97, 0xea, 0xe1, 0xa7, 0xb7, 0xde, 0x8f, 0x24, 0x2c, 0xf8, 0xf3, 0x9f, 0x56, 0xd9, 0xee, 0x90,
-	0x94, 0xeb, 0x0e, 0x5d, 0xe2, 0x9a, 0x1f, 0x02, 0x61, 0x4b, 0xf1, 0x2c, 0x18, 0xcf, 0x87, 0xac,
-	0xb4, 0x60, 0xea, 0xad, 0xb8, 0x3b, 0x08, 0xf7, 0x52, 0x30, 0xb2, 0x3f, 0x7d, 0xeb, 0x07, 0x61,
-	0x38, 0x4b, 0x9a, 0x70, 0xa7, 0x6f, 0xb5, 0x30, 0x9c, 0xa1, 0xb1, 0x46, 0xf1, 0x99, 0xd0, 0x30,
-	0x0e, 0x13, 0xf3, 0x29, 0xa9, 0xf9, 0xd2, 0x66, 0x94, 0xe8, 0x39, 0xf1, 0x99, 0xfa, 0xfd, 0x02,
-	0x6c, 0xe7, 0xbf, 0x2f, 0xf1, 0xcb, 0x76, 0x1c, 0xa5, 0x87, 0x28, 0x8e, 0x97, 0x3a, 0x83, 0x85,
-	0x0b, 0xcf, 0x26, 0xe7, 0xcf, 0x96, 0x31, 0x67, 0x71, 0xd9, 0x9c, 0xb8, 0x90, 0xb8, 0x05, 0x5f,
-	0x40, 0xbf, 0x78, 0x00, 0x1b, 0xd3, 0xd3, 0x73, 0x3f, 0xd9, 0x89, 0xcb, 0x5f, 0x9d, 0x9e, 0x9e,
-	0x3b, 0x7c, 0xb3, 0x03, 0xc0, 0xb8, 0xe5, 0x4e, 0x58, 0x5e, 0x6a, 0x0c, 0xa7, 0xfd, 0xdb, 0x7d,
-	0x96, 0xfd, 0xcb, 0xf1, 0xd9, 0x98, 0xb9, 0xe7, 0x7d, 0x00, 0x24, 0x9a, 0x45, 0xa3, 0x49, 0x1c,
-	0x31, 0xff, 0xa9, 0xba, 0x98, 0x0f, 0x5c, 0x06, 0x10, 0xc9, 0xc1, 0x1f, 0x4e, 0xfa, 0x01, 0xef,
-	0xd1, 0x54, 0x59, 0x72, 0xb0, 0x70, 0xae, 0xfe, 0x91, 0x04, 0x74, 0xf5, 0x23, 0xfa, 0xe7, 0xa8,
-	0x22, 0xf5, 0x07, 0x12, 0xff, 0xa6, 0x4c, 0x3f, 0xcc, 0x31, 0x9a, 0xc2, 0x79, 0xc6, 0xed, 0x4a,
-	0xe1, 0x1c, 0xb7, 0xbd, 0x0b, 0xd5, 0x71, 0xf4, 0xd6, 0xcf, 0x56, 0x5a, 0x95, 0x71, 0xf4, 0x96,
-	0x11, 0xa6, 0x27, 0x90, 0x33, 0x27, 0xb8, 0x07, 0x80, 0x14, 0x82, 0x59, 0x71, 0x41, 0xd2, 0x64,
-	0xfc, 0xd2, 0x7b, 0xb7, 0xf4, 0x2e, 0xf7, 0xae, 0xfa, 0x1d, 0x28, 0x1b, 0xc6, 0xa2, 0xdf, 0x1f,
-	0x4e, 0x13, 0x01, 0x8b, 0x6e, 0x31, 0x9c, 0x9a, 0x21, 0xfd, 0x98, 0xf5, 0x41, 0xd7, 0xb6, 0x5c,
-	0x04, 0xdd, 0x7e, 0x73, 0xca, 0x18, 0x2a, 0x21, 0xfb, 0x55, 0x1f, 0x83, 0xc2, 0x21, 0x69, 0xdf,
-	0x61, 0x03, 0xca, 0xb6, 0xa3, 0x77, 0x3a, 0x5d, 0x8b, 0xb7, 0x9c, 0x0d, 0xe3, 0xb8, 0x4b, 0x0a,
-	0xea, 0xff, 0x48, 0xa0, 0x18, 0x06, 0xfb, 0x4a, 0x48, 0xcc, 0x32, 0x9e, 0x64, 0xa3, 0xb2, 0x33,
-	0xc9, 0xc6, 0x53, 0x21, 0x17, 0x4f, 0x54, 0xa4, 0x12, 0xd1, 0xd9, 0x61, 0x69, 0xe2, 0x16, 0x28,
-	0x7d, 0xd6, 0x39, 0x4e, 0x1a, 0xbf, 0x7c, 0x86, 0xe9, 0x63, 0x1e, 0x07, 0x71, 0x52, 0xff, 0xf2,
-	0x09, 0x72, 0xe8, 0x9f, 0xcd, 0x66, 0xc2, 0x81, 0xd9, 0x98, 0x3e, 0x00, 0x08, 0xc2, 0x37, 0xd1,
-	0x2c, 0x1e, 0xcc, 0xa3, 0x50, 0x44, 0x61, 0x06, 0x82, 0x6e, 0x8a, 0x78, 0xfe, 0x7c, 0x1a, 0x45,
-	0xa1, 0x48, 0x73, 0x55, 0x84, 0x74, 0x11, 0x80, 0xd6, 0x1c, 0x05, 0xdf, 0x16, 0xab, 0x55, 0x6e,
-	0x9a, 0x51, 0xf0, 0x6d, 0xb6, 0x88, 0x65, 0xee, 0x06, 0x3f, 0x6e, 0x37, 0x0e, 0xe2, 0xf9, 0xc5,
-	0x67, 0xfe, 0x0c, 0x14, 0x96, 0xe0, 0x92, 0x2f, 0x85, 0x87, 0x19, 0x95, 0x2f, 0xc8, 0xf7, 0x8f,
-	0x19, 0x8a, 0x3e, 0x8e, 0x67, 0xe7, 0xae, 0xc0, 0xa7, 0x5f, 0x40, 0x65, 0xee, 0x0b, 0x5a, 0x99,
-	0xd1, 0x7e, 0xb0, 0x96, 0xb6, 0x9b, 0x25, 0x2e, 0xcf, 0xf9, 0xac, 0xf6, 0x2b, 0xb0, 0x91, 0x81,
-	0x63, 0x7e, 0x7a, 0x1d, 0x9d, 0x8b, 0x2b, 0x07, 0x87, 0xf9, 0x34, 0x5c, 0x14, 0x69, 0xf8, 0xf3,
-	0xc2, 0x67, 0x52, 0xed, 0x73, 0xd8, 0xec, 0xbe, 0x07, 0x6d, 0x35, 0x43, 0xab, 0xee, 0x2f, 0xba,
-	0x54, 0x2d, 0xdd, 0xe3, 0x55, 0x4d, 0xd7, 0xd3, 0x5c, 0x8f, 0xfb, 0x4a, 0xd7, 0xb3, 0x1d, 0x52,
-	0x60, 0xf5, 0x8d, 0xde, 0xd5, 0x3d, 0x22, 0xab, 0xff, 0x2d, 0x83, 0x6c, 0x6b, 0xed, 0xda, 0x2d,
-	0xd8, 0xd5, 0xce, 0xc2, 0x01, 0xab, 0xc7, 0xa2, 0xc6, 0x38, 0x76, 0xa3, 0xdf, 0x3a, 0x8b, 0xe6,
-	0x71, 0xed, 0x09, 0xd0, 0x25, 0xf8, 0x74, 0xc8, 0xf6, 0xef, 0x4f, 0xce, 0xc6, 0xb1, 0xf0, 0x6e,
-	0x3e, 0xa9, 0xfd, 0x8b, 0x04, 0x65, 0x41, 0xb7, 0xde, 0xff, 0xd7, 0xbe, 0x3c, 0x7c, 0x04, 0x95,
-	0x49, 0x30, 0xca, 0x96, 0xb7, 0x69, 0x5f, 0xde, 0xd6, 0xda, 0xf8, 0xc7, 0xb3, 0xdb, 0x24, 0x18,
-	0xb1, 0x48, 0xf8, 0x16, 0xec, 0x04, 0x28, 0x92, 0x3f, 0x43, 0x99, 0xfc, 0xfe, 0x38, 0x16, 0x9d,
-	0xc7, 0x0f, 0x72, 0x74, 0xeb, 0x8e, 0x73, 0x74, 0xcd, 0xdd, 0x0a, 0xb2, 0xf0, 0x43, 0x05, 0x8a,
-	0x2f, 0x27, 0xe1, 0x79, 0xed, 0x9f, 0x25, 0x28, 0xf1, 0xb3, 0xfd, 0x3f, 0x4a, 0x6e, 0x5e, 0x24,
-	0xf9, 0x2f, 0x5c, 0x26, 0xf9, 0x74, 0x78, 0x7e, 0xa1, 0xdc, 0xea, 0x23, 0x28, 0x8b, 0x6d, 0xd2,
-	0x0c, 0x71, 0x03, 0x76, 0xb4, 0x5e, 0xd3, 0xe4, 0x8d, 0x64, 0xdd, 0x6f, 0x74, 0xf0, 0xfe, 0xfe,
-	0xc7, 0x32, 0x06, 0x4c, 0xfb, 0x6c, 0x18, 0x0f, 0xa6, 0xc1, 0x2c, 0xae, 0x9d, 0xc2, 0x06, 0xba,
-	0x70, 0x62, 0xaf, 0x0b, 0xe3, 0x67, 0x17, 0x4a, 0x98, 0x0e, 0x78, 0xf8, 0x54, 0x5d, 0x3e, 0xa1,
-	0x4f, 0x78, 0x93, 0x59, 0x5e, 0xba, 0x91, 0xb2, 0x61, 0x91, 0xf4, 0x99, 0x6b, 0x9f, 0x42, 0x95,
-	0xef, 0x84, 0xda, 0x7d, 0xc2, 0xb3, 0x07, 0x7e, 0x1c, 0x63, 0x44, 0xed, 0xae, 0x23, 0xe5, 0x39,
-	0x65, 0x5e, 0xfb, 0x06, 0xec, 0x20, 0xac, 0x19, 0xcd, 0xfb, 0x89, 0x98, 0x35, 0xa8, 0x0c, 0xf0,
-	0x62, 0x1a, 0x07, 0x43, 0xf1, 0x79, 0xbd, 0x98, 0xd7, 0x1c, 0x2c, 0x2f, 0x13, 0x74, 0xdc, 0xeb,
-	0x12, 0x64, 0xfa, 0x35, 0x28, 0xb2, 0xe2, 0x8a, 0x27, 0x85, 0x9d, 0x25, 0x31, 0x5c, 0xb6, 0x58,
-	0xfb, 0xcf, 0xab, 0x1c, 0xfa, 0x13, 0x28, 0x8f, 0x72, 0x09, 0xfd, 0x6e, 0x86, 0xd1, 0x42, 0xd7,
-	0xfb, 0x6d, 0x91, 0xd4, 0x47, 0x3c, 0x95, 0x3f, 0x13, 0x7b, 0xcb, 0xcc, 0xf6, 0x0f, 0xd7, 0x92,
-	0x64, 0x6c, 0x73, 0x74, 0x8d, 0x8b, 0x43, 0x1b, 0x50, 0x65, 0x36, 0x0a, 0xa3, 0x79, 0x5f, 0x38,
-	0xce, 0x2f, 0x5e, 0x48, 0x9c, 0xd1, 0xda, 0xd1, 0x35, 0xb7, 0x32, 0x15, 0xa0, 0x85, 0xc3, 0xff,
-	0xc7, 0xe5, 0x0e, 0xff, 0x7f, 0x3b, 0xd9, 0x27, 0xb9, 0x93, 0x3d, 0xb8, 0xe4, 0x64, 0xdc, 0xa9,
-	0xf9, 0xb9, 0xb4, 0xd5, 0x73, 0xa9, 0x57, 0x9c, 0x8b, 0x93, 0xaf, 0x9c, 0x4a, 0xfd, 0x77, 0x09,
-	0x94, 0xf6, 0x74, 0xe5, 0x29, 0xdb, 0xb0, 0xec, 0xe7, 0x44, 0xa2, 0x5b, 0x50, 0xd5, 0x5a, 0x2d,
-	0x57, 0x6f, 0x69, 0xf8, 0x6d, 0x87, 0x69, 0xd0, 0xd3, 0x0e, 0x2d, 0xf1, 0xce, 0xca, 0xda, 0x0c,
-	0x45, 0x04, 0x7e, 0xd9, 0xd3, 0x7b, 0x3a, 0x29, 0xe1, 0xb0, 0xe5, 0xda, 0x3d, 0x87, 0x28, 0x74,
-	0x1b, 0x80, 0x0d, 0xfd, 0xa6, 0xde, 0x6d, 0x90, 0x32, 0x7b, 0x06, 0xd2, 0x3d, 0xdd, 0x25, 0x55,
-	0xf6, 0x0c, 0x84, 0x43, 0xbf, 0x61, 0x77, 0x0c, 0xb3, 0x45, 0x80, 0x3d, 0x56, 0x31, 0x88, 0xa1,
-	0x6b, 0x5e, 0xcf, 0xd5, 0xc9, 0x06, 0x82, 0xd8, 0x56, 0x0b, 0xd0, 0x26, 0xef, 0xc9, 0xb8, 0x1e,
-	0xe7, 0xb8, 0x45, 0x29, 0x6c, 0xea, 0x5f, 0x39, 0xba, 0x6b, 0xb6, 0xf9, 0x63, 0xfd, 0x4f, 0x7e,
-	0x22, 0xab, 0x1d, 0x00, 0xc3, 0x70, 0x82, 0xfe, 0xeb, 0x28, 0x36, 0xc7, 0xeb, 0xed, 0x94, 0x89,
-	0xdb, 0x42, 0x2e, 0x6e, 0x29, 0x14, 0xc3, 0x20, 0x0e, 0x98, 0x29, 0x36, 0x5d, 0x36, 0x56, 0x6d,
-	0x76, 0x67, 0x32, 0x7e, 0xf6, 0x59, 0xfc, 0x33, 0x60, 0x18, 0x41, 0x25, 0x61, 0xf8, 0x9e, 0xdc,
-	0xd6, 0x3e, 0x26, 0x5d, 0xf4, 0xe6, 0xfe, 0xb7, 0x12, 0x6c, 0xa6, 0xf9, 0x81, 0x3f, 0xe5, 0xaf,
-	0xee, 0x95, 0x86, 0xb4, 0x74, 0x61, 0x48, 0xe3, 0xb7, 0xf8, 0x2c, 0x0a, 0xe6, 0x93, 0xa4, 0xab,
-	0x71, 0x6f, 0x4d, 0x02, 0x3a, 0x9b, 0xef, 0xbb, 0x0c, 0xc7, 0x15, 0xb8, 0xea, 0x87, 0xa0, 0x70,
-	0x48, 0xf2, 0xea, 0x73, 0x2d, 0xf3, 0xd2, 0x93, 0x7b, 0x01, 0x52, 0xff, 0x50, 0x82, 0x2a, 0x67,
-	0xd5, 0x9e, 0x84, 0xef, 0xa9, 0x94, 0x4c, 0x7d, 0x26, 0xe7, 0xea, 0xb3, 0xf4, 0x9d, 0xbc, 0xf8,
-	0xce, 0xef, 0xe4, 0x16, 0x6c, 0x1b, 0x86, 0x55, 0x47, 0xfa, 0xcb, 0xb4, 0xf6, 0x4b, 0x50, 0xc2,
-	0x0d, 0xe7, 0x2b, 0x99, 0x90, 0x93, 0xba, 0x7c, 0x55, 0xfd, 0x0d, 0xd8, 0x5c, 0xe6, 0xb5, 0xf2,
-	0xff, 0x2c, 0xef, 0xca, 0xeb, 0x87, 0x12, 0x28, 0x1c, 0x92, 0x3d, 0xb1, 0x94, 0x3b, 0xf1, 0x25,
-	0x1f, 0x90, 0xef, 0xfb, 0x2f, 0x1b, 0x58, 0xc6, 0x0b, 0x9b, 0x97, 0x96, 0x5e, 0xf3, 0xb9, 0x14,
-	0xcb, 0xd6, 0xfe, 0x7a, 0xd6, 0xda, 0xab, 0x8f, 0x7d, 0xc2, 0xec, 0x85, 0x27, 0x7f, 0x20, 0x83,
-	0x6c, 0x18, 0xed, 0xe5, 0x2e, 0xd2, 0x91, 0x6e, 0x59, 0x36, 0x91, 0xe8, 0x0e, 0x6c, 0xb0, 0x00,
-	0xef, 0x7a, 0x9a, 0xd7, 0xeb, 0xf2, 0x4e, 0x2b, 0x03, 0x88, 0x44, 0x21, 0xd3, 0x4d, 0xa8, 0x60,
-	0x66, 0xf2, 0xdb, 0x76, 0x93, 0xbf, 0x83, 0xf0, 0x1c, 0x83, 0xd3, 0x12, 0x4e, 0x9b, 0x4e, 0x42,
-	0xac, 0x30, 0x5c, 0xc3, 0xe7, 0xbc, 0xcb, 0x74, 0x0f, 0x76, 0x0d, 0x83, 0xbf, 0xf0, 0x39, 0x9a,
-	0xeb, 0xf9, 0xae, 0xfe, 0x65, 0x4f, 0xef, 0x7a, 0xa4, 0x42, 0x6f, 0x01, 0x5d, 0x5a, 0x71, 0xac,
-	0x17, 0x3c, 0x4d, 0x19, 0x86, 0xef, 0x68, 0x8d, 0x6f, 0xe9, 0x9e, 0x6f, 0x76, 0x78, 0x9a, 0x4a,
-	0x21, 0x76, 0xcf, 0x23, 0x1b, 0x94, 0xa2, 0xcf, 0xf8, 0x59, 0xa9, 0x37, 0x51, 0xea, 0x04, 0x86,
-	0x82, 0x6d, 0x21, 0x9d, 0x55, 0xd7, 0x9a, 0x4d, 0x37, 0xc1, 0xd9, 0xa6, 0xbb, 0x40, 0x0c, 0xc3,
-	0xcf, 0x43, 0x77, 0x70, 0x4b, 0x0d, 0x4f, 0xd3, 0x11, 0x42, 0x5c, 0x47, 0xc8, 0x71, 0x7b, 0x01,
-	0xf1, 0x08, 0x45, 0x48, 0x33, 0x8b, 0x73, 0x83, 0xe1, 0x74, 0x33, 0x90, 0x5d, 0x94, 0xc0, 0xd6,
-	0xda, 0x8b, 0x33, 0xde, 0x44, 0xd5, 0x70, 0x00, 0xae, 0xdf, 0x7a, 0xa9, 0xb0, 0x46, 0xcc, 0xc1,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x72, 0x6f, 0x35, 0x6f, 0x85, 0x25, 0x00, 0x00,
+	// 4611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x8c, 0xe3, 0xc8,
+	0x75, 0x43, 0x51, 0xa2, 0xa4, 0xa7, 0xfe, 0xd4, 0x70, 0xe7, 0xa3, 0xd5, 0x7c, 0x3c, 0xcb, 0xcd,
+	0xfe, 0xc6, 0x71, 0xef, 0xae, 0x7a, 0x76, 0x76, 0x76, 0xbd, 0x31, 0xc2, 0x96, 0xa8, 0x1e, 0x66,
+	0xa9, 0x8f, 0x4b, 0x54, 0xef, 0xcc, 0x89, 0xe6, 0x88, 0x9c, 0x6e, 0x65, 0x24, 0x4a, 0x11, 0xd9,
+	0x3d, 0xdb, 0x36, 0x02, 0x24, 0xce, 0xe7, 0x90, 0x1c, 0xf2, 0x07, 0x12, 0xc4, 0x67, 0x3b, 0x1f,
+	0x24, 0xc8, 0x25, 0x01, 0x7c, 0x73, 0x0c, 0x18, 0x08, 0x10, 0x04, 0x39, 0xe7, 0x16, 0x20, 0x40,
+	0x4e, 0x39, 0x04, 0x48, 0x0e, 0xb9, 0x39, 0x78, 0x55, 0x45, 0x91, 0x94, 0xd4, 0xdd, 0x33, 0xce,
+	0x06, 0x3e, 0x74, 0xab, 0xea, 0xd5, 0x7b, 0xaf, 0xaa, 0xde, 0xaf, 0x1e, 0x5f, 0x15, 0x6c, 0x3e,
+	0x1d, 0x3d, 0x19, 0xba, 0xb3, 0xd1, 0xce, 0x6c, 0x3e, 0x8d, 0xa6, 0x6a, 0x51, 0x74, 0xb5, 0x9b,
+	0x50, 0x78, 0xe8, 0x8f, 0xc7, 0x53, 0xf5, 0x15, 0x28, 0xcc, 0x7d, 0x67, 0xe4, 0x55, 0xa5, 0x3b,
+	0xd2, 0xdb, 0x65, 0x9a, 0x9f, 0xfb, 0xa6, 0xa7, 0x7d, 0x13, 0x4a, 0xcd, 0x59, 0x3f, 0x72, 0xa3,
+	0xe3, 0x50, 0x7d, 0x0f, 0x94, 0x90, 0xb5, 0x18, 0xc6, 0x56, 0xbd, 0xba, 0x13, 0xb3, 0x8c, 0x51,
+	0x76, 0xf8, 0x0f, 0x15, 0x78, 0x09, 0xcb, 0x5c, 0x8a, 0xe5, 0x5b, 0xa0, 0x08, 0x86, 0x45, 0x90,
+	0x3b, 0xdd, 0x1e, 0xb9, 0xa4, 0x96, 0xa1, 0x60, 0x74, 0x6c, 0x83, 0x12, 0x09, 0x9b, 0x96, 0xa1,
+	0x1f, 0x18, 0x24, 0xa7, 0x19, 0x00, 0xf6, 0x71, 0x10, 0xf8, 0x63, 0xfb, 0x74, 0xe6, 0x6b, 0x1f,
+	0x42, 0x1e, 0x7f, 0x13, 0xa2, 0x12, 0xe4, 0xcd, 0x9e, 0xd9, 0x23, 0x12, 0x6f, 0x1d, 0xdc, 0x27,
+	0x39, 0x6c, 0xed, 0x53, 0xe3, 0x1e, 0x91, 0x45, 0xeb, 0x3e, 0xc9, 0x6b, 0x4f, 0x61, 0x6b, 0x6f,
+	0x3e, 0xf2, 0x0e, 0xfd, 0x83, 0xb1, 0x1b, 0x98, 0xc1, 0xd3, 0xa9, 0x66, 0x43, 0xa1, 0x35, 0x76,
+	0x0f, 0x53, 0x0b, 0x00, 0x50, 0xda, 0x7a, 0x9f, 0xaf, 0xa0, 0x04, 0xf9, 0xde, 0x81, 0xd9, 0x24,
+	0x39, 0x75, 0x03, 0x4a, 0x83, 0x8e, 0xad, 0xef, 0xef, 0x1b, 0x4d, 0x92, 0x57, 0xb7, 0xa1, 0x42,
+	0xf5, 0xce, 0xbe, 0xe1, 0xec, 0x19, 0xfb, 0x66, 0x87, 0x94, 0xd4, 0x4d, 0x28, 0x73, 0x80, 0xd1,
+	0x69, 0x12, 0xa2, 0xfd, 0xa5, 0x04, 0xd0, 0x9b, 0xce, 0x23, 0xb1, 0xb9, 0xfa, 0x92, 0xb4, 0x6a,
+	0x0b, 0x69, 0x25, 0x48, 0x2f, 0x22, 0x2f, 0xf5, 0x3a, 0x14, 0x67, 0xd3, 0x79, 0x84, 0x60, 0xf9,
+	0x8e, 0xf4, 0xf6, 0x26, 0x55, 0xb0, 0x6b, 0x7a, 0xea, 0x35, 0x50, 0x46, 0x4f, 0x03, 0x77, 0xe2,
+	0x57, 0xf3, 0x0c, 0x5d, 0xf4, 0xb4, 0xd7, 0x57, 0x05, 0xac, 0x40, 0x6e, 0x20, 0x24, 0xd5, 0xec,
+	0x7e, 0xd6, 0x21, 0x39, 0x6d, 0x0c, 0x25, 0x6b, 0x14, 0x3c, 0x63, 0xa2, 0xfd, 0x86, 0x10, 0x2d,
+	0x80, 0xd2, 0x34, 0x0e, 0xcc, 0x86, 0xc1, 0x55, 0x62, 0xf6, 0xec, 0x41, 0x87, 0x48, 0x08, 0xde,
+	0xa3, 0x66, 0x73, 0xdf, 0x20, 0x39, 0x95, 0xc0, 0x06, 0x6f, 0x3b, 0x7d, 0x0b, 0xb5, 0xc4, 0x04,
+	0xbd, 0xd7, 0xed, 0xa0, 0x80, 0xb6, 0x00, 0xb0, 0x25, 0x46, 0x0a, 0xc8, 0xe2, 0xe0, 0x91, 0xa5,
+	0x77, 0x88, 0xa2, 0x7d, 0x2f, 0xc7, 0x65, 0xd3, 0x98, 0x06, 0x4f, 0x47, 0x87, 0xea, 0x3b, 0x20,
+	0x0f, 0x27, 0x9e, 0x10, 0xcc, 0xf5, 0x8c, 0x60, 0x38, 0xc6, 0x4e, 0x63, 0xe2, 0x51, 0xc4, 0x59,
+	0x2f, 0x92, 0x64, 0xe7, 0x72, 0x7a, 0xe7, 0x69, 0x51, 0xe5, 0x33, 0xa2, 0x52, 0x21, 0x3f, 0x1e,
+	0x05, 0xcf, 0xaa, 0x05, 0xce, 0x04, 0xdb, 0xc8, 0x64, 0xe2, 0x86, 0x91, 0x3f, 0xaf, 0x2a, 0x9c,
+	0x09, 0xef, 0x21, 0x13, 0x6f, 0xe6, 0x20, 0x61, 0xb5, 0xc8, 0x99, 0x78, 0x33, 0x5c, 0x59, 0x4a,
+	0xa3, 0xa5, 0x17, 0xd5, 0xa8, 0xf6, 0x2e, 0xc8, 0x8d, 0x89, 0x97, 0x28, 0xa2, 0x08, 0xb2, 0xde,
+	0x6c, 0x72, 0xa1, 0xb6, 0xbb, 0x4d, 0xb3, 0xf5, 0x98, 0xe4, 0xb8, 0xdc, 0x2d, 0xc3, 0x36, 0x88,
+	0xac, 0xfd, 0xbb, 0x02, 0xc5, 0xd6, 0x78, 0xfa, 0xbc, 0x3d, 0xf5, 0xd4, 0x37, 0xd3, 0x62, 0xba,
+	0xb2, 0x98, 0x4d, 0x0c, 0x27, 0x32, 0xfa, 0x59, 0x28, 0x44, 0xee, 0x93, 0xb1, 0xcf, 0x64, 0xb4,
+	0x55, 0xbf, 0xb6, 0x82, 0x69, 0xe3, 0x28, 0xe5, 0x48, 0x89, 0x44, 0xe5, 0x94, 0x44, 0xdf, 0x82,
+	0xfc, 0xc9, 0xd8, 0x0d, 0x98, 0xd8, 0x2a, 0xf5, 0xcb, 0x0b, 0x0e, 0x07, 0x96, 0xde, 0x41, 0x2e,
+	0x0f, 0x2f, 0x51, 0x86, 0xa0, 0x3e, 0x80, 0x52, 0xe4, 0xcf, 0x27, 0xce, 0xc4, 0x1d, 0x32, 0x69,
+	0x56, 0xea, 0x37, 0x16, 0xc8, 0xb6, 0x3f, 0x9f, 0x8c, 0x02, 0x37, 0x1a, 0x4d, 0x83, 0xb6, 0x3b,
+	0x14, 0x64, 0x45, 0x44, 0x6f, 0xbb, 0x43, 0xf5, 0x1d, 0x28, 0x4c, 0x66, 0xe3, 0xf0, 0xfd, 0xaa,
+	0xb2, 0x34, 0x47, 0xbb, 0x67, 0xf5, 0x05, 0x32, 0xc7, 0x50, 0x3f, 0x84, 0xe2, 0x71, 0x30, 0x1a,
+	0xba, 0x21, 0x57, 0x41, 0x7a, 0x8e, 0x01, 0x87, 0xd3, 0xe9, 0x71, 0x34, 0x0a, 0x0e, 0xe3, 0x39,
+	0x04, 0xb6, 0xba, 0x0b, 0xa5, 0x27, 0xe8, 0xeb, 0xa3, 0xe0, 0x90, 0x29, 0xa9, 0x52, 0xbf, 0xba,
+	0xa0, 0xdc, 0x13, 0x03, 0x82, 0x66, 0x81, 0xa8, 0xde, 0x05, 0xd9, 0x1d, 0x8e, 0xab, 0x65, 0x86,
+	0x7f, 0x2d, 0xa5, 0xd4, 0xf1, 0x68, 0x78, 0xaa, 0x37, 0x2c, 0x41, 0x80, 0x48, 0xea, 0x07, 0x50,
+	0x98, 0xb0, 0x75, 0x01, 0xc3, 0xbe, 0x95, 0x6c, 0xe2, 0x78, 0x1c, 0xad, 0x59, 0x19, 0xc7, 0x56,
+	0x3f, 0x06, 0x08, 0xe7, 0x27, 0xf7, 0x9d, 0xf1, 0x74, 0xe8, 0x8e, 0xab, 0x15, 0x46, 0xfb, 0xea,
+	0x82, 0xb6, 0x4f, 0x4f, 0xee, 0x5b, 0x38, 0xd2, 0x1f, 0x79, 0x82, 0xae, 0x1c, 0xce, 0x05, 0x4c,
+	0x1b, 0xbc, 0x88, 0x09, 0x5d, 0x86, 0x4d, 0xde, 0x76, 0xfa, 0x36, 0x35, 0x1b, 0x36, 0x91, 0x53,
+	0x56, 0x95, 0xc7, 0x61, 0xde, 0x8e, 0x87, 0x0b, 0xda, 0x7f, 0x4b, 0x50, 0x60, 0x76, 0x81, 0x3e,
+	0x6d, 0x76, 0xf6, 0xa9, 0xd1, 0xef, 0x3b, 0xbd, 0x2e, 0xb5, 0x79, 0x68, 0x45, 0xc5, 0x13, 0xc0,
+	0x10, 0x68, 0x1b, 0xb4, 0xed, 0xb4, 0xf5, 0x06, 0xb9, 0xa2, 0x56, 0xa0, 0x68, 0xed, 0x3a, 0xf6,
+	0xe3, 0x9e, 0x41, 0xae, 0xa2, 0x7b, 0xa3, 0xe6, 0xde, 0x23, 0xd7, 0xe3, 0xe6, 0xfb, 0xa4, 0x1a,
+	0x37, 0xeb, 0xe4, 0x55, 0xe4, 0x8b, 0x4d, 0x27, 0x26, 0xb9, 0xa1, 0x5e, 0x01, 0xc2, 0x21, 0xfa,
+	0x9e, 0x61, 0x39, 0x36, 0x1d, 0xf4, 0x6d, 0x72, 0x13, 0xe3, 0x28, 0x83, 0x32, 0xa4, 0x5b, 0xea,
+	0x2b, 0xb0, 0x3d, 0xe8, 0x98, 0x0d, 0xbd, 0x6f, 0x3b, 0xb4, 0x3b, 0xb0, 0xcd, 0xce, 0x3e, 0xb9,
+	0xad, 0x5e, 0x85, 0xcb, 0xed, 0x81, 0x65, 0x67, 0xc1, 0x6f, 0xe3, 0xf2, 0x58, 0x38, 0xc2, 0x5e,
+	0x1d, 0x03, 0x50, 0xaf, 0x6b, 0x99, 0x8d, 0xc7, 0x8e, 0xde, 0xb0, 0xc8, 0x27, 0xaa, 0x0a, 0x5b,
+	0x7d, 0x7a, 0x70, 0xdf, 0xb1, 0xba, 0x0d, 0xdd, 0x72, 0xfa, 0x66, 0x93, 0xb4, 0xf6, 0x8a, 0x50,
+	0xf0, 0x83, 0x68, 0x7e, 0xaa, 0xfd, 0x67, 0x09, 0x4a, 0xfb, 0xf3, 0xe9, 0xf1, 0x0c, 0x3d, 0xed,
+	0xad, 0xb4, 0xa7, 0x25, 0x26, 0x13, 0x8f, 0x27, 0xae, 0xb6, 0x03, 0xca, 0xa1, 0x13, 0x9d, 0xce,
+	0x62, 0x5f, 0xbb, 0xbe, 0x8a, 0xbb, 0x8f, 0xb1, 0x94, 0x16, 0x0e, 0xf1, 0x67, 0xbd, 0xb3, 0xdd,
+	0x87, 0xd2, 0xb8, 0xee, 0x8c, 0x9e, 0xba, 0x43, 0xbf, 0x9a, 0x5f, 0xb2, 0x05, 0xab, 0x6e, 0x06,
+	0x91, 0x3f, 0xc7, 0x31, 0xc6, 0x11, 0xad, 0x7b, 0x5c, 0x37, 0xb1, 0xaf, 0x3e, 0x00, 0x18, 0xef,
+	0x3a, 0xb1, 0x67, 0x70, 0xef, 0x4b, 0x16, 0x60, 0xed, 0x0a, 0xdf, 0x88, 0xe9, 0xca, 0xe3, 0x18,
+	0xa2, 0x7e, 0x02, 0x80, 0x9e, 0x25, 0xe6, 0x54, 0x96, 0x7c, 0x0a, 0xa5, 0xbf, 0x32, 0x6b, 0x19,
+	0x09, 0x16, 0xf3, 0x32, 0xea, 0xb1, 0xfb, 0xc4, 0x1f, 0x57, 0x8b, 0x4b, 0xf3, 0x22, 0xb5, 0x85,
+	0x23, 0x19, 0x4a, 0x06, 0x51, 0xdf, 0x85, 0xe2, 0x78, 0xd7, 0xf1, 0x87, 0x93, 0x99, 0x70, 0xc7,
+	0x2b, 0xa9, 0xe5, 0x1a, 0xc3, 0xc9, 0x2c, 0xa6, 0x51, 0xc6, 0xac, 0xcb, 0x44, 0xb3, 0xeb, 0x70,
+	0x17, 0x2b, 0x2f, 0x8b, 0x66, 0x77, 0xe1, 0x64, 0x89, 0x68, 0x76, 0xdb, 0x6c, 0x83, 0x28, 0x9a,
+	0xba, 0x33, 0x3d, 0xf1, 0xe7, 0x63, 0xf7, 0xb4, 0x0a, 0x4b, 0x4b, 0xb4, 0xea, 0x5d, 0x3e, 0x92,
+	0x88, 0x26, 0x86, 0xa8, 0x1f, 0xc1, 0xc6, 0xc9, 0xe7, 0x63, 0x37, 0x70, 0x22, 0x96, 0x6b, 0x54,
+	0x2b, 0x4b, 0xeb, 0x3c, 0xc0, 0x41, 0x9e, 0x87, 0x3c, 0xbc, 0x44, 0x2b, 0x27, 0x49, 0x17, 0x27,
+	0x65, 0x5e, 0xed, 0x07, 0x43, 0x77, 0x56, 0xdd, 0x58, 0x9a, 0x14, 0xbd, 0xda, 0xc0, 0x91, 0xc5,
+	0xa4, 0xe1, 0x5c, 0x40, 0x5e, 0xfe, 0x58, 0xf8, 0xbe, 0x0c, 0x85, 0xfd, 0xf8, 0x90, 0x1e, 0x74,
+	0xfa, 0x3d, 0xa3, 0x41, 0x2e, 0xa1, 0x87, 0x59, 0x75, 0xc7, 0xc4, 0xd4, 0xa9, 0xa5, 0x37, 0x0c,
+	0x22, 0xa1, 0x0b, 0x58, 0x75, 0x87, 0x1a, 0x9f, 0x51, 0xd3, 0x36, 0x08, 0x61, 0xfd, 0x5d, 0x47,
+	0xf8, 0x13, 0xb9, 0x23, 0x28, 0x16, 0xae, 0x44, 0xde, 0x43, 0x17, 0xb2, 0xea, 0x4e, 0xcb, 0xea,
+	0x76, 0x9b, 0xe4, 0xe7, 0xd9, 0xf8, 0x6e, 0x8a, 0x63, 0x4f, 0x40, 0x12, 0x8a, 0x6f, 0x88, 0x28,
+	0x60, 0x34, 0xda, 0x3d, 0x32, 0x53, 0xaf, 0x02, 0xb1, 0xea, 0x4e, 0xf7, 0xc0, 0xa0, 0x96, 0xfe,
+	0xd8, 0x69, 0x59, 0xce, 0xa0, 0x41, 0x7e, 0x45, 0x5a, 0x05, 0xb7, 0x1b, 0xe4, 0x57, 0x97, 0xc1,
+	0xed, 0x06, 0x62, 0x7f, 0x7b, 0x0d, 0xb8, 0xdd, 0x20, 0xbf, 0x26, 0xa9, 0xaf, 0xc0, 0x16, 0x0b,
+	0x0c, 0xc9, 0x72, 0x7e, 0x57, 0x52, 0x09, 0x54, 0x18, 0xd0, 0xaa, 0x3b, 0x07, 0xbd, 0x0e, 0xf9,
+	0xbd, 0x14, 0x64, 0x97, 0x41, 0x7e, 0x5f, 0x52, 0x2f, 0x8b, 0xc8, 0x63, 0x0f, 0x3a, 0x1d, 0xc3,
+	0x7a, 0x9f, 0xfc, 0xc1, 0x32, 0xa8, 0x4e, 0xfe, 0x10, 0x65, 0xc5, 0xe3, 0x4e, 0xff, 0x33, 0xbd,
+	0x47, 0xfe, 0x48, 0x52, 0x37, 0xa0, 0xc8, 0xfa, 0xad, 0x16, 0xf9, 0x6e, 0x32, 0xca, 0xf6, 0xf9,
+	0x67, 0x92, 0x7a, 0x05, 0xb6, 0xad, 0xba, 0x33, 0x68, 0xa5, 0x56, 0xf3, 0x37, 0x8c, 0x2d, 0xcb,
+	0x71, 0x04, 0x5f, 0xf2, 0x03, 0x49, 0xdd, 0x06, 0x60, 0x51, 0xc7, 0xe8, 0x34, 0xf4, 0x1e, 0xf9,
+	0x7b, 0x29, 0x09, 0x39, 0xff, 0x2a, 0x43, 0x29, 0x3e, 0x50, 0xd5, 0xaf, 0x40, 0x61, 0xe2, 0x46,
+	0xc3, 0xa3, 0xaa, 0xb4, 0x64, 0x37, 0x31, 0xc6, 0x4e, 0x1b, 0x87, 0x29, 0xc7, 0x52, 0xeb, 0x50,
+	0x74, 0x87, 0x78, 0xb2, 0x86, 0xd5, 0xdc, 0x1d, 0xf9, 0xed, 0x4a, 0xbd, 0xba, 0x4a, 0xa0, 0x33,
+	0x04, 0x1a, 0x23, 0xaa, 0xb7, 0x00, 0x0e, 0xa7, 0xd1, 0xd4, 0xe1, 0xc9, 0x01, 0x4f, 0x1e, 0xcb,
+	0x08, 0x61, 0x71, 0xbf, 0xd6, 0x86, 0x02, 0x9b, 0x02, 0x33, 0x9e, 0x51, 0xc0, 0x33, 0x1e, 0x89,
+	0x21, 0x29, 0xa3, 0x80, 0x65, 0x3c, 0x04, 0xe4, 0x13, 0x91, 0x7a, 0x6d, 0x52, 0x6c, 0xaa, 0xaf,
+	0x42, 0xe9, 0x64, 0xe4, 0x39, 0x13, 0x37, 0x7c, 0x26, 0x18, 0x16, 0x4f, 0x46, 0x5e, 0xdb, 0x0d,
+	0x9f, 0xd5, 0xbe, 0x9d, 0x03, 0x85, 0xaf, 0x40, 0x7d, 0x1f, 0xf2, 0x2c, 0x3b, 0xe3, 0xf1, 0xf4,
+	0xd6, 0x59, 0x2b, 0xdd, 0xe9, 0xb8, 0x13, 0x9f, 0x32, 0x54, 0xf5, 0x0a, 0x14, 0x4e, 0xdc, 0xf1,
+	0xb1, 0x2f, 0x26, 0xe3, 0x1d, 0xed, 0xaf, 0x25, 0xc8, 0x23, 0xd2, 0xb2, 0xd5, 0xf7, 0x0d, 0xdb,
+	0x41, 0x66, 0x0e, 0x26, 0xea, 0x12, 0x5a, 0x24, 0x83, 0xd0, 0x16, 0xcf, 0xda, 0xb1, 0xd3, 0xc5,
+	0x21, 0x19, 0x4f, 0x3e, 0xec, 0x25, 0x07, 0x4c, 0x1e, 0xcf, 0x9b, 0xde, 0xa0, 0xff, 0x90, 0x31,
+	0x20, 0x05, 0xc4, 0xef, 0x75, 0x7b, 0xbc, 0xa7, 0xe0, 0x11, 0xb5, 0xc0, 0xb7, 0xea, 0x9c, 0xa4,
+	0x18, 0x73, 0xe1, 0x4a, 0x76, 0xcc, 0x26, 0x29, 0xc5, 0x88, 0x6c, 0x15, 0x31, 0x62, 0x59, 0xfb,
+	0x63, 0x19, 0xd4, 0xd5, 0x34, 0x48, 0xfd, 0x30, 0xab, 0xec, 0xd7, 0xce, 0x49, 0x99, 0xb2, 0x6a,
+	0xff, 0x64, 0x59, 0xed, 0xda, 0x79, 0xa4, 0x2f, 0x69, 0x00, 0xd3, 0x0b, 0x0d, 0xe0, 0x55, 0x28,
+	0xf9, 0xd1, 0x51, 0x72, 0xe0, 0x6d, 0xd2, 0xa2, 0x1f, 0x1d, 0xb1, 0x38, 0x74, 0x1d, 0xb0, 0xe9,
+	0x78, 0x61, 0x14, 0x27, 0xe1, 0x7e, 0x74, 0xd4, 0x0c, 0x19, 0x0d, 0x66, 0x8a, 0xce, 0xc9, 0x22,
+	0x0b, 0x2f, 0x62, 0xff, 0x60, 0xe4, 0xd5, 0xbe, 0xb5, 0xb0, 0x90, 0xaf, 0x66, 0x2c, 0xe4, 0xad,
+	0x8b, 0x37, 0x75, 0xb1, 0xad, 0xdc, 0x5e, 0x63, 0x2a, 0x00, 0x4a, 0x77, 0x60, 0xf7, 0x06, 0x36,
+	0x91, 0xb4, 0xef, 0x14, 0xa0, 0x14, 0xa7, 0x9a, 0x67, 0x7b, 0x5f, 0x8c, 0xf1, 0xc2, 0xde, 0xb7,
+	0x20, 0x58, 0x16, 0x7e, 0x92, 0x2a, 0xc8, 0x2f, 0x94, 0x2a, 0x5c, 0x86, 0xfc, 0x61, 0xf2, 0xe5,
+	0x22, 0x1f, 0x9a, 0xde, 0x92, 0xfe, 0x0a, 0xcb, 0xfa, 0x7b, 0x37, 0xd6, 0x1f, 0x01, 0xf9, 0xc9,
+	0x94, 0x7f, 0x68, 0x96, 0x28, 0x36, 0x51, 0x44, 0xfc, 0xb4, 0x16, 0x22, 0x62, 0x9d, 0xda, 0x9f,
+	0xc8, 0x17, 0xba, 0xe8, 0xd2, 0x76, 0x2e, 0x16, 0xfb, 0x8f, 0x72, 0x6b, 0xe4, 0x8e, 0x2e, 0xd6,
+	0xed, 0xf1, 0x3c, 0x8f, 0xfb, 0x67, 0xd3, 0x68, 0x38, 0xb6, 0x6d, 0x91, 0x1c, 0x7e, 0x47, 0x37,
+	0xba, 0xbd, 0xc7, 0xd8, 0x73, 0xcc, 0x0e, 0x91, 0xf1, 0x8c, 0xe2, 0x80, 0x06, 0xf6, 0xf3, 0x69,
+	0x6f, 0x2e, 0x2c, 0xfb, 0x23, 0x4b, 0x50, 0x95, 0x55, 0xaf, 0x5e, 0xeb, 0xa2, 0x02, 0xf4, 0xf5,
+	0x2e, 0x1e, 0x21, 0x4d, 0xe3, 0x11, 0x29, 0x63, 0x1e, 0xc9, 0xb0, 0xa8, 0xde, 0x6a, 0x99, 0x0d,
+	0xa7, 0x61, 0xe9, 0xfd, 0x3e, 0x01, 0x96, 0x29, 0x1a, 0xb6, 0xc3, 0x8e, 0x3e, 0x3e, 0x47, 0x65,
+	0xb1, 0xac, 0x96, 0x69, 0x58, 0x4d, 0xb2, 0x81, 0xdc, 0x70, 0x4f, 0x8d, 0xcf, 0x9c, 0x2e, 0x75,
+	0xf4, 0xc6, 0x43, 0xb2, 0x99, 0x09, 0x1d, 0x5b, 0x31, 0x82, 0x55, 0x77, 0x1e, 0x1a, 0x7a, 0xd3,
+	0xa0, 0x64, 0x1b, 0xf7, 0xca, 0xf8, 0xb6, 0x8d, 0x1e, 0x2e, 0x89, 0xa8, 0x55, 0xb8, 0x82, 0x80,
+	0x1e, 0xed, 0xda, 0x46, 0xc3, 0x36, 0xbb, 0x1d, 0xb1, 0xb2, 0xcb, 0xda, 0xaf, 0xe7, 0x41, 0x5d,
+	0xfd, 0xb8, 0x39, 0x3b, 0x72, 0xac, 0xe2, 0x66, 0x4d, 0xf6, 0x63, 0x50, 0xb8, 0x25, 0x32, 0x75,
+	0xa5, 0x03, 0xc7, 0x1a, 0x4a, 0x61, 0xbb, 0x82, 0xe2, 0x0b, 0x30, 0xdd, 0xda, 0x38, 0xb6, 0xcd,
+	0xab, 0xa0, 0x8c, 0x66, 0x2c, 0x4c, 0xf0, 0xba, 0x52, 0x61, 0x34, 0x6b, 0x86, 0xfc, 0x68, 0x99,
+	0x3f, 0x5d, 0x1c, 0x2d, 0xf3, 0xa7, 0xb8, 0xe0, 0xe9, 0x7c, 0x74, 0x38, 0x0a, 0xc4, 0xa4, 0xe7,
+	0x2e, 0xb8, 0xcb, 0x30, 0xa9, 0xa0, 0xa8, 0xfd, 0xa9, 0x74, 0x61, 0x64, 0x39, 0x73, 0xd7, 0x17,
+	0x9b, 0xf8, 0xd7, 0xce, 0x8f, 0x2c, 0xa8, 0xf8, 0x86, 0x65, 0xe8, 0x68, 0x15, 0xa8, 0xd2, 0x3e,
+	0xc9, 0xa5, 0x2d, 0x5e, 0xd6, 0xee, 0x82, 0xc2, 0xd7, 0x9b, 0xe1, 0x50, 0x86, 0x42, 0xc7, 0x30,
+	0xf7, 0x1f, 0xf2, 0xa2, 0x17, 0x7e, 0xd3, 0x60, 0xd1, 0xeb, 0x7f, 0x24, 0xb8, 0xb2, 0xee, 0x5b,
+	0x52, 0xfd, 0x28, 0x6b, 0x08, 0xaf, 0x9f, 0xfb, 0xe5, 0x99, 0x35, 0x85, 0x97, 0xfd, 0x68, 0x89,
+	0xd5, 0x29, 0x27, 0xea, 0x7c, 0x92, 0x55, 0x67, 0x38, 0x1f, 0x26, 0xea, 0xec, 0xcf, 0x87, 0x29,
+	0x2d, 0xe7, 0xd6, 0x68, 0x59, 0x4e, 0xb4, 0x7c, 0xf6, 0xe9, 0xa0, 0xfd, 0x43, 0x0e, 0x36, 0xd2,
+	0x1f, 0xe9, 0xea, 0xfb, 0xd9, 0x2d, 0xdf, 0x58, 0xfb, 0x29, 0x9f, 0xdd, 0xea, 0xbd, 0x25, 0xab,
+	0xbf, 0xb9, 0x9e, 0x26, 0x6b, 0xef, 0xb5, 0x47, 0xa9, 0x83, 0x30, 0x3e, 0xd4, 0xa4, 0x33, 0x0f,
+	0xb5, 0x5c, 0x66, 0xd9, 0xea, 0x0d, 0x28, 0xf3, 0x4f, 0x87, 0x44, 0x64, 0x25, 0x0e, 0x30, 0xbd,
+	0xda, 0xf1, 0xc2, 0x2e, 0x3f, 0xc8, 0xd8, 0xe5, 0x6b, 0xe7, 0xad, 0xeb, 0xff, 0x7e, 0xd6, 0xfd,
+	0x9d, 0x0c, 0x64, 0xb9, 0xaa, 0xc0, 0x6a, 0x17, 0x29, 0x71, 0x7e, 0xe9, 0xcc, 0xfa, 0x43, 0x56,
+	0xa4, 0x0f, 0x96, 0x44, 0x7a, 0xe7, 0x6c, 0xba, 0x2f, 0x3e, 0x8c, 0x7c, 0x39, 0x75, 0xc4, 0x85,
+	0x8b, 0xda, 0x34, 0x36, 0x57, 0x23, 0x48, 0xed, 0x07, 0x49, 0x14, 0xf8, 0x28, 0x23, 0xed, 0x37,
+	0x2e, 0x5a, 0xf2, 0xc5, 0x12, 0xf7, 0xd7, 0x48, 0xbc, 0x08, 0x32, 0x96, 0x7e, 0x25, 0x5e, 0xbf,
+	0x6e, 0x3a, 0x8f, 0x48, 0x2e, 0x6e, 0x62, 0x01, 0xa6, 0x02, 0x45, 0x6c, 0x36, 0x1f, 0xdd, 0x27,
+	0x85, 0xa4, 0x73, 0x8f, 0x28, 0x8b, 0x8e, 0x7d, 0x9f, 0x14, 0x93, 0xce, 0x3d, 0x52, 0xd2, 0x7e,
+	0x4b, 0x81, 0xcd, 0x4c, 0xe1, 0x49, 0xad, 0x67, 0xb5, 0x76, 0x73, 0x7d, 0x7d, 0x2a, 0xab, 0xb2,
+	0x0f, 0x96, 0x54, 0x76, 0xeb, 0x0c, 0xa2, 0x25, 0x7d, 0xd5, 0xa0, 0x34, 0x9b, 0x8f, 0xa6, 0xf3,
+	0x51, 0x74, 0x1a, 0x1b, 0x72, 0xdc, 0xc7, 0x92, 0x5c, 0x9c, 0x01, 0xe5, 0xef, 0xc8, 0x17, 0xf3,
+	0x8c, 0xb1, 0x6b, 0xff, 0x95, 0x7b, 0xe9, 0x93, 0x20, 0x9d, 0x75, 0xca, 0xd9, 0xac, 0xf3, 0x55,
+	0x28, 0x8d, 0x66, 0x0e, 0xbb, 0xc2, 0x88, 0xc3, 0xc7, 0x68, 0xd6, 0xc3, 0x2e, 0xb2, 0x8f, 0x78,
+	0x64, 0xe2, 0x89, 0x52, 0x21, 0x8a, 0x23, 0x53, 0xc4, 0x67, 0x55, 0x62, 0x30, 0xce, 0x9a, 0xf2,
+	0xf4, 0x62, 0xc6, 0xd3, 0x53, 0xb9, 0x70, 0x29, 0x93, 0x0b, 0x27, 0x91, 0xaf, 0x9c, 0x8e, 0x7c,
+	0xe9, 0xc8, 0x00, 0xd9, 0xc8, 0x80, 0xac, 0x70, 0xea, 0xe1, 0xac, 0x5a, 0x11, 0xac, 0x66, 0xcd,
+	0x70, 0x38, 0x53, 0xef, 0xc0, 0x86, 0x18, 0xe0, 0x5f, 0x52, 0x1b, 0x6c, 0x14, 0xf8, 0x28, 0x7e,
+	0x4c, 0xa9, 0xb7, 0xa1, 0xc2, 0x37, 0xc3, 0x11, 0x36, 0x79, 0xea, 0xc7, 0x76, 0x94, 0x1a, 0xf7,
+	0xc2, 0x88, 0x8f, 0x6f, 0xc5, 0xe3, 0xcd, 0x30, 0x62, 0x1f, 0x63, 0x3f, 0x4c, 0x5c, 0xe1, 0x7e,
+	0xc6, 0x15, 0xb4, 0x73, 0xd5, 0x76, 0xb1, 0x1f, 0xfc, 0xe2, 0x05, 0x67, 0x61, 0xe6, 0x53, 0x0c,
+	0xaf, 0x1b, 0x68, 0xb7, 0xc7, 0x8b, 0x91, 0x3d, 0x83, 0xb6, 0x4d, 0x9b, 0xe4, 0xb1, 0xdd, 0x36,
+	0x29, 0xed, 0x52, 0x52, 0xc0, 0x44, 0x91, 0xa5, 0x68, 0x03, 0x63, 0x60, 0x10, 0x25, 0xfe, 0x76,
+	0x6b, 0xf6, 0x1b, 0x3d, 0x52, 0xd4, 0xbe, 0x9b, 0xc3, 0xba, 0x40, 0xb6, 0x32, 0x95, 0xae, 0xf1,
+	0x4b, 0x99, 0x1a, 0xff, 0x39, 0x21, 0xfa, 0x1d, 0x20, 0x6c, 0x28, 0x9a, 0xbb, 0x41, 0x38, 0x66,
+	0x5f, 0x17, 0xcc, 0xb0, 0x4a, 0x74, 0x1b, 0xe1, 0x76, 0x02, 0x46, 0xf6, 0x47, 0xcf, 0x1d, 0xd7,
+	0xf3, 0xe6, 0xf1, 0xad, 0xca, 0xd1, 0x73, 0xdd, 0xf3, 0xe6, 0x68, 0xa6, 0x93, 0xe8, 0x58, 0xd8,
+	0x16, 0x36, 0x63, 0xc3, 0x55, 0x12, 0xc3, 0x4d, 0xae, 0x14, 0xc4, 0xcd, 0x01, 0xef, 0xa9, 0x6f,
+	0x42, 0xfe, 0xc9, 0x34, 0xf0, 0x44, 0x0d, 0x4c, 0x4d, 0x62, 0xff, 0x34, 0xf0, 0xf4, 0x28, 0x9a,
+	0x87, 0x94, 0x8d, 0xab, 0xf7, 0x01, 0xf0, 0xd7, 0x09, 0xc7, 0xee, 0x89, 0x2f, 0xea, 0x5f, 0xd7,
+	0x33, 0xd8, 0x7d, 0x1c, 0xe1, 0x24, 0xe5, 0x27, 0x71, 0x5f, 0xfb, 0x6d, 0x19, 0xca, 0x0b, 0x5e,
+	0xea, 0x97, 0x21, 0x3f, 0x99, 0x7a, 0xfe, 0xca, 0xf5, 0xca, 0x02, 0x63, 0xa7, 0x3d, 0xf5, 0x7c,
+	0xca, 0x90, 0xd4, 0xaf, 0x41, 0xe5, 0xc8, 0x0d, 0x8f, 0x9c, 0x19, 0xb3, 0x06, 0x91, 0x20, 0xdc,
+	0x5a, 0x43, 0xf3, 0xd0, 0x0d, 0x8f, 0xb8, 0xc9, 0x50, 0x38, 0x5a, 0xb4, 0xf1, 0xf4, 0x9b, 0x8c,
+	0x02, 0x07, 0x6f, 0x54, 0xc2, 0x38, 0x68, 0x4c, 0x46, 0x01, 0xde, 0x2d, 0x85, 0xea, 0xeb, 0xb0,
+	0xe9, 0x1e, 0x1e, 0xce, 0xfd, 0x43, 0x37, 0x9a, 0xce, 0x93, 0xc8, 0xbe, 0x91, 0x00, 0x4d, 0x4f,
+	0xfb, 0x65, 0xc8, 0xe3, 0x7a, 0xd8, 0xf5, 0x91, 0x6e, 0xe9, 0x9d, 0x86, 0xe1, 0x50, 0x4a, 0x2e,
+	0x61, 0x56, 0x85, 0xf9, 0xd4, 0x81, 0xe1, 0xec, 0xe9, 0x8d, 0x4f, 0xd9, 0xf5, 0xd5, 0x36, 0x54,
+	0x62, 0x94, 0x47, 0x5d, 0x4a, 0x72, 0x68, 0x3e, 0x7b, 0xb4, 0xab, 0x37, 0x59, 0x65, 0x4a, 0x66,
+	0x95, 0x6c, 0xc3, 0x30, 0x9c, 0x07, 0xef, 0xd5, 0x9d, 0x5d, 0x5d, 0x5c, 0xda, 0xc5, 0x14, 0xb6,
+	0xb5, 0x47, 0x0a, 0x69, 0x80, 0x6e, 0xed, 0x11, 0x45, 0xeb, 0x01, 0x24, 0x5b, 0x43, 0xdb, 0xb4,
+	0xf4, 0xc7, 0x06, 0xad, 0x93, 0x4b, 0xac, 0x32, 0x86, 0xed, 0x5d, 0xe7, 0x1e, 0x91, 0x16, 0xbd,
+	0xba, 0xb3, 0xcb, 0x8b, 0x0c, 0xac, 0xbe, 0x83, 0x3d, 0x79, 0xd1, 0x43, 0xcc, 0xbc, 0xf6, 0x17,
+	0x12, 0x6c, 0x65, 0x75, 0x85, 0x86, 0x81, 0xf1, 0xf0, 0xc4, 0x17, 0xdf, 0x68, 0xa2, 0x87, 0xd6,
+	0x86, 0x92, 0x73, 0x8e, 0x67, 0x4c, 0xf2, 0x25, 0xaa, 0x60, 0x77, 0x30, 0x5b, 0x95, 0x9c, 0xbc,
+	0x2a, 0x39, 0xf5, 0x36, 0xc0, 0x70, 0x3a, 0x1e, 0xfb, 0x43, 0x4c, 0xfb, 0x98, 0x6c, 0x4b, 0x34,
+	0x05, 0x51, 0x35, 0xd8, 0xf0, 0x46, 0x61, 0x34, 0x1f, 0x3d, 0x61, 0x89, 0x21, 0xb3, 0xdd, 0x12,
+	0xcd, 0xc0, 0xb4, 0xef, 0xe4, 0x60, 0x2b, 0x5b, 0x39, 0xc6, 0x9a, 0x75, 0xe0, 0x27, 0xfe, 0x95,
+	0x0f, 0x96, 0x6e, 0x21, 0x73, 0x67, 0xba, 0x9d, 0xbc, 0x12, 0xff, 0xe2, 0x18, 0x9b, 0x5f, 0x8e,
+	0xb1, 0x38, 0x10, 0xc7, 0x6a, 0x3e, 0x80, 0xc1, 0xf4, 0x36, 0x54, 0x66, 0x47, 0xa7, 0x4e, 0x3c,
+	0x13, 0x77, 0xad, 0xf2, 0xec, 0xe8, 0xb4, 0xc7, 0x27, 0xdb, 0x05, 0x4c, 0xad, 0xf8, 0xc9, 0x50,
+	0x5c, 0xba, 0x84, 0x4e, 0xee, 0x8a, 0x77, 0xf0, 0x1f, 0x2d, 0x46, 0xc7, 0x01, 0x36, 0xf0, 0x2b,
+	0x1a, 0x89, 0xe6, 0xfe, 0x64, 0x1a, 0xf9, 0xcc, 0x07, 0xcb, 0x14, 0x53, 0x36, 0xca, 0x00, 0x22,
+	0x7f, 0x13, 0x57, 0x33, 0x3c, 0xb4, 0xe3, 0x24, 0xfc, 0xf2, 0xe5, 0xe7, 0xa0, 0x92, 0x2a, 0x54,
+	0xb3, 0x85, 0x0f, 0x27, 0xb3, 0x54, 0xf0, 0xc1, 0xae, 0xe9, 0xe1, 0xe1, 0xc0, 0x64, 0xc6, 0xeb,
+	0x03, 0x9b, 0xb4, 0x80, 0x42, 0x0b, 0xb5, 0x7f, 0x92, 0x80, 0x2c, 0x97, 0xad, 0x51, 0xbe, 0x93,
+	0x61, 0x4a, 0xbe, 0x93, 0xe1, 0xf9, 0xd1, 0xeb, 0x1e, 0x14, 0x50, 0x20, 0xe8, 0x5e, 0x78, 0xf0,
+	0xde, 0x3e, 0xb3, 0x20, 0xce, 0xee, 0x21, 0x29, 0x47, 0xae, 0xf5, 0x21, 0x8f, 0xdd, 0x9f, 0x28,
+	0x5e, 0xa6, 0xf4, 0x23, 0xa7, 0xf5, 0xa3, 0x7d, 0x0b, 0xb6, 0xb2, 0xb5, 0xf4, 0x6c, 0xf6, 0x2b,
+	0x65, 0xb3, 0x5f, 0x3c, 0x42, 0x46, 0x81, 0xe7, 0x7f, 0x1e, 0x1f, 0x21, 0xac, 0x73, 0x9e, 0xc5,
+	0x08, 0x5d, 0xf0, 0xed, 0xe6, 0xef, 0xc8, 0x82, 0x1b, 0x6e, 0x24, 0xd4, 0xfe, 0x4d, 0x82, 0x4a,
+	0xaa, 0x1a, 0xcf, 0xce, 0x40, 0x81, 0x9c, 0x4c, 0x5e, 0x16, 0xe8, 0x26, 0x4f, 0x10, 0x83, 0xd1,
+	0x22, 0xb1, 0x08, 0x46, 0xe7, 0xcd, 0x8c, 0x05, 0x13, 0x66, 0x01, 0xdc, 0x52, 0x79, 0x07, 0xfd,
+	0x56, 0x98, 0x8d, 0xb0, 0x53, 0xde, 0x43, 0x46, 0xc7, 0xf1, 0x25, 0x31, 0x37, 0xd2, 0xe2, 0xb1,
+	0xb8, 0x25, 0x26, 0x20, 0x47, 0xd1, 0x58, 0x1c, 0x00, 0xd8, 0x4c, 0xfc, 0xa9, 0xb4, 0xde, 0x9f,
+	0xca, 0x69, 0xb5, 0x68, 0x7f, 0x25, 0xe1, 0xad, 0x55, 0xfa, 0xea, 0x00, 0x67, 0x63, 0x57, 0x0c,
+	0xc9, 0x2e, 0x8b, 0xac, 0x6f, 0x7a, 0xea, 0x7b, 0x22, 0xd6, 0xf3, 0xb8, 0x7d, 0xf3, 0x8c, 0xcb,
+	0x87, 0x74, 0xc0, 0xaf, 0x41, 0x29, 0xf4, 0x0f, 0x27, 0x7e, 0x20, 0x0c, 0xaa, 0x4c, 0x17, 0xfd,
+	0x64, 0xa5, 0xf9, 0x64, 0xa5, 0xda, 0x2d, 0x11, 0x9f, 0x01, 0x14, 0xb3, 0x63, 0x99, 0x1d, 0x23,
+	0x7e, 0xb0, 0x81, 0xe5, 0x6d, 0x49, 0xfb, 0x1d, 0x09, 0xd4, 0xd5, 0x0b, 0xa4, 0x9f, 0x62, 0x10,
+	0xd1, 0xbe, 0x27, 0xf1, 0x7b, 0x83, 0xe4, 0x52, 0x0a, 0xdd, 0x13, 0x73, 0xa5, 0xc5, 0x72, 0x0a,
+	0x5e, 0x88, 0xd3, 0xde, 0x80, 0x72, 0xe0, 0x3f, 0x77, 0xd2, 0x95, 0xb2, 0x52, 0xe0, 0x3f, 0x67,
+	0x84, 0xc9, 0x0e, 0xe4, 0xd4, 0x0e, 0x6e, 0x02, 0x20, 0x85, 0x60, 0x96, 0x5f, 0x90, 0x34, 0x19,
+	0xbf, 0xe4, 0x83, 0xa7, 0xf0, 0x22, 0x1f, 0x3c, 0xda, 0x37, 0xa1, 0xd8, 0x6a, 0x2d, 0x5e, 0xdf,
+	0x78, 0x0b, 0x05, 0xe7, 0x69, 0xde, 0xe3, 0xda, 0xc5, 0xa7, 0x08, 0x6b, 0xbf, 0xdc, 0x05, 0xdd,
+	0x4e, 0x73, 0xc6, 0x18, 0x2a, 0x1e, 0xfb, 0xd5, 0xde, 0x06, 0x85, 0x43, 0x92, 0xbb, 0xa5, 0x0a,
+	0x14, 0xbb, 0x3d, 0xa3, 0xd3, 0xe9, 0x5b, 0xfc, 0x01, 0x48, 0xab, 0x75, 0xd0, 0x27, 0x39, 0xed,
+	0x3f, 0x24, 0x50, 0x5a, 0xad, 0x4c, 0x88, 0x08, 0xa6, 0xe9, 0x10, 0xd1, 0x99, 0xa6, 0x93, 0xa1,
+	0x5c, 0x26, 0x19, 0x52, 0x45, 0x4e, 0x29, 0x6e, 0x35, 0xb1, 0x8d, 0xbe, 0x32, 0x64, 0x8f, 0x37,
+	0xe2, 0xb7, 0x17, 0xbc, 0x87, 0x9e, 0x85, 0x8f, 0x21, 0xe2, 0xfa, 0x25, 0xef, 0x20, 0x87, 0xe1,
+	0xf1, 0x7c, 0x2e, 0xbc, 0x87, 0xb5, 0xf1, 0x3c, 0x73, 0xbd, 0x13, 0x7f, 0x1e, 0x8d, 0x42, 0xdf,
+	0x13, 0x1e, 0x94, 0x82, 0x60, 0x20, 0x47, 0x3c, 0x27, 0x9c, 0xf9, 0x7e, 0xec, 0x4d, 0x65, 0x84,
+	0xf4, 0x11, 0xc0, 0x52, 0x11, 0xf7, 0x73, 0x31, 0x5a, 0x16, 0xa9, 0x88, 0xfb, 0x39, 0x1b, 0xc4,
+	0x32, 0x65, 0x85, 0x6f, 0x17, 0x5f, 0x68, 0x84, 0x67, 0xef, 0xf9, 0x01, 0x28, 0x2c, 0xd3, 0x8d,
+	0x2b, 0xbd, 0x77, 0x52, 0x22, 0x5f, 0x90, 0xef, 0x1c, 0x30, 0x14, 0x03, 0xaf, 0x75, 0xa8, 0xc0,
+	0x57, 0x3f, 0x81, 0x52, 0xe8, 0x08, 0x5a, 0x1e, 0xaa, 0x5f, 0x5b, 0x4b, 0xdb, 0x4f, 0x13, 0x17,
+	0x43, 0xde, 0xab, 0x7d, 0x04, 0x95, 0x14, 0x1c, 0xc3, 0xc8, 0x33, 0xff, 0x34, 0xfe, 0xde, 0x7d,
+	0xe6, 0x9f, 0x66, 0xf3, 0xf1, 0xbc, 0xc8, 0xc7, 0x3f, 0xce, 0x3d, 0x90, 0x6a, 0x1f, 0xc3, 0x46,
+	0xff, 0x25, 0x68, 0xcb, 0x29, 0x5a, 0x6d, 0x67, 0x71, 0x13, 0xb9, 0x6f, 0xd8, 0xdc, 0xb3, 0xfb,
+	0xb6, 0x4e, 0x6d, 0x6e, 0x2b, 0x7d, 0xbb, 0xdb, 0xe3, 0x1f, 0xb5, 0xd4, 0xe8, 0x1b, 0x36, 0xbe,
+	0x4f, 0x91, 0x41, 0xee, 0xea, 0xed, 0xda, 0x35, 0xb8, 0xa2, 0x1f, 0x7b, 0x23, 0x56, 0x74, 0xf2,
+	0x1b, 0x41, 0x44, 0xfd, 0x5f, 0x3a, 0xf6, 0xc3, 0xa8, 0x76, 0x17, 0xd4, 0x25, 0xf8, 0x6c, 0xcc,
+	0xe6, 0x1f, 0x4e, 0x8f, 0x83, 0x48, 0x58, 0x37, 0xef, 0xd4, 0xbe, 0x2f, 0x41, 0x51, 0xd0, 0xad,
+	0xb7, 0xff, 0xb5, 0x8f, 0x7f, 0xde, 0x85, 0xd2, 0xd4, 0x9d, 0xa4, 0xeb, 0x0a, 0xc9, 0x65, 0x6d,
+	0x57, 0x6f, 0xe3, 0x1f, 0x3f, 0xff, 0xa7, 0xee, 0x04, 0x1b, 0xea, 0xa7, 0xb0, 0xed, 0xe2, 0x92,
+	0x9c, 0x39, 0xae, 0xc9, 0x19, 0x06, 0x91, 0xb8, 0x75, 0x7f, 0x2d, 0x43, 0xb7, 0x6e, 0x3b, 0x0f,
+	0x2f, 0xd1, 0x4d, 0x37, 0x0d, 0xdf, 0x53, 0x30, 0x95, 0xf7, 0x4e, 0x6b, 0x7f, 0x2b, 0x41, 0x81,
+	0xef, 0xed, 0xff, 0x71, 0xe5, 0xe6, 0x59, 0x2b, 0xff, 0xd2, 0x79, 0x2b, 0x9f, 0x8d, 0x4f, 0xcf,
+	0x5c, 0xb7, 0xf6, 0x16, 0x14, 0xc5, 0x34, 0x49, 0x84, 0x78, 0x05, 0xb6, 0xf5, 0x41, 0xd3, 0xe4,
+	0x0f, 0x2b, 0x0c, 0xa7, 0xd1, 0xc1, 0x12, 0xd2, 0x0f, 0x01, 0x1d, 0x86, 0x25, 0x18, 0x33, 0x77,
+	0x1e, 0xd5, 0x8e, 0xa0, 0xc2, 0xd2, 0x0b, 0xa1, 0xaf, 0x33, 0xfd, 0xe7, 0x0a, 0x14, 0x30, 0x1c,
+	0x70, 0xf7, 0x29, 0x53, 0xde, 0x51, 0xef, 0xf2, 0x07, 0x16, 0xf2, 0x52, 0xce, 0x96, 0x76, 0x8b,
+	0xf8, 0x8d, 0x45, 0xed, 0x43, 0x28, 0xf3, 0x99, 0x50, 0xba, 0x77, 0x79, 0xf4, 0xc0, 0xcb, 0x0d,
+	0x39, 0x73, 0x2f, 0x9f, 0x22, 0xe5, 0x31, 0x25, 0xac, 0x7d, 0x05, 0xb6, 0x11, 0xd6, 0xf4, 0xc3,
+	0x61, 0xbc, 0xcc, 0x1a, 0x94, 0x46, 0x78, 0x30, 0x05, 0xee, 0x58, 0xa4, 0xde, 0x8b, 0x7e, 0xad,
+	0x07, 0x9b, 0x09, 0x3a, 0xce, 0x75, 0x0e, 0xb2, 0xfa, 0x3a, 0xe4, 0xd9, 0x69, 0xcf, 0x83, 0xc2,
+	0xf6, 0xd2, 0x32, 0x28, 0x1b, 0xac, 0x6d, 0x42, 0x05, 0x3f, 0xa6, 0x63, 0x5f, 0xd8, 0x85, 0x32,
+	0xef, 0x22, 0xf3, 0x37, 0xa1, 0xf0, 0x74, 0x3c, 0x7d, 0x1e, 0x6f, 0x84, 0x2c, 0x3f, 0xd2, 0xa2,
+	0x7c, 0xb8, 0xa6, 0x02, 0x61, 0x87, 0x45, 0x6a, 0x17, 0xb5, 0xaf, 0xc2, 0x56, 0x0a, 0x86, 0xdc,
+	0xde, 0x01, 0xe5, 0x10, 0x21, 0x31, 0xbb, 0xcb, 0x2b, 0x27, 0x0d, 0x15, 0x08, 0xb5, 0x7f, 0xc9,
+	0x5d, 0xe0, 0x65, 0xf7, 0xa0, 0x38, 0xc9, 0x9c, 0x32, 0x37, 0x52, 0xbb, 0x5b, 0x18, 0xc0, 0x4e,
+	0x5b, 0x9c, 0x34, 0x13, 0xf6, 0x8b, 0x65, 0x05, 0x26, 0x10, 0x79, 0xa9, 0x28, 0x98, 0x26, 0x49,
+	0x19, 0x0c, 0x3e, 0x20, 0x43, 0x7c, 0xb5, 0x01, 0x65, 0xfc, 0x75, 0x3c, 0x3f, 0x1c, 0x0a, 0x6b,
+	0xfe, 0x99, 0x33, 0x89, 0x53, 0x42, 0xc0, 0x27, 0x5b, 0x33, 0x01, 0xc2, 0xc9, 0x51, 0x5a, 0xd5,
+	0xc2, 0x39, 0x93, 0xa7, 0x34, 0x81, 0x93, 0x23, 0xbe, 0xda, 0x02, 0x60, 0x52, 0xe1, 0xb3, 0xf3,
+	0x77, 0x30, 0x6f, 0xac, 0xa5, 0x5e, 0xd6, 0x01, 0xbe, 0xdf, 0x38, 0x8c, 0x61, 0x8b, 0x28, 0xf0,
+	0xcf, 0xb9, 0x73, 0xa3, 0xc0, 0x4f, 0x26, 0xd9, 0x7b, 0x19, 0xc9, 0xde, 0x3e, 0x47, 0xb2, 0xdc,
+	0xd3, 0xb9, 0x5c, 0xf5, 0x55, 0xb9, 0x6a, 0x17, 0xc8, 0x95, 0x93, 0x27, 0x52, 0xbd, 0x97, 0x91,
+	0xea, 0xed, 0x73, 0xa4, 0x2a, 0x26, 0x66, 0x32, 0x6d, 0xae, 0x91, 0xe9, 0xeb, 0x17, 0xc9, 0x94,
+	0x33, 0x58, 0x95, 0xa8, 0xf6, 0x8f, 0x12, 0x28, 0xed, 0xd9, 0xca, 0x4b, 0xdf, 0x96, 0xd5, 0xfd,
+	0x8c, 0x48, 0xf8, 0xbd, 0xaf, 0xef, 0xef, 0x53, 0x63, 0x5f, 0xb7, 0x0d, 0x7e, 0x2e, 0xd9, 0xfa,
+	0x9e, 0x25, 0x9e, 0xa1, 0xb2, 0x7b, 0xbb, 0x3c, 0x02, 0x79, 0x39, 0x89, 0xbd, 0x40, 0xdd, 0xa7,
+	0xdd, 0x41, 0x8f, 0x28, 0x58, 0x5d, 0x60, 0x4d, 0xa7, 0x69, 0xf4, 0x1b, 0xa4, 0x88, 0x43, 0x6d,
+	0x03, 0x1f, 0xfc, 0x96, 0xd9, 0x3b, 0x35, 0x6c, 0x3a, 0x8d, 0x6e, 0xa7, 0x65, 0xee, 0x13, 0x60,
+	0xaf, 0xe9, 0x18, 0xa4, 0x65, 0xe8, 0xf6, 0x80, 0x1a, 0xa4, 0x82, 0x20, 0x36, 0xd5, 0x02, 0xb4,
+	0xc1, 0x2f, 0x39, 0xa9, 0xcd, 0x39, 0x6e, 0xaa, 0x2a, 0x6c, 0x18, 0x8f, 0x7a, 0x06, 0x35, 0xdb,
+	0xfc, 0x2d, 0xf3, 0x8f, 0x7f, 0x2c, 0x6b, 0x1d, 0x80, 0x56, 0xab, 0xe7, 0x0e, 0x9f, 0xf9, 0x91,
+	0x19, 0xac, 0xb7, 0x91, 0x54, 0x20, 0xcd, 0x65, 0x02, 0xa9, 0x0a, 0x79, 0xcf, 0x8d, 0x5c, 0x66,
+	0x06, 0x1b, 0x94, 0xb5, 0xb5, 0x2e, 0x54, 0x62, 0x7e, 0xdd, 0xe3, 0xe8, 0x0b, 0x60, 0xe8, 0x43,
+	0x29, 0x66, 0xf8, 0x92, 0xdc, 0xd6, 0xbe, 0x6c, 0x3b, 0xeb, 0x49, 0xf2, 0x9f, 0x4b, 0xb0, 0x91,
+	0x04, 0xec, 0xe3, 0x70, 0xfd, 0x5c, 0x49, 0x8c, 0x95, 0xce, 0x8c, 0xb1, 0x78, 0xc3, 0x33, 0xf7,
+	0xdd, 0x70, 0x1a, 0x5f, 0x13, 0xde, 0x5c, 0x73, 0x22, 0x1c, 0x87, 0x3b, 0x94, 0xe1, 0x50, 0x81,
+	0xab, 0xbd, 0x03, 0x0a, 0x87, 0xc4, 0x4f, 0xad, 0x2e, 0xa5, 0x9e, 0x57, 0x65, 0x9e, 0x5d, 0x69,
+	0xbf, 0x29, 0x41, 0x99, 0xb3, 0xc2, 0x97, 0x81, 0x2f, 0x27, 0x94, 0x54, 0xc2, 0x2c, 0x67, 0x12,
+	0xe6, 0xe4, 0xed, 0x70, 0xfe, 0x85, 0xdf, 0x0e, 0x5b, 0xb0, 0xd5, 0x6a, 0x59, 0x75, 0xa4, 0x3f,
+	0x4f, 0x6a, 0x6f, 0x40, 0x01, 0x27, 0x0c, 0x57, 0x8e, 0x26, 0x4e, 0x4a, 0xf9, 0xa8, 0xf6, 0x0b,
+	0xb0, 0xc1, 0x01, 0xfd, 0xa5, 0xb7, 0xe6, 0xa9, 0xe7, 0xfe, 0x2f, 0xca, 0xeb, 0x47, 0x12, 0x28,
+	0x1c, 0x92, 0xde, 0xb1, 0x94, 0xd9, 0xf1, 0xf9, 0xe5, 0x85, 0x97, 0x7a, 0xd1, 0x8e, 0xdf, 0x55,
+	0x42, 0xe7, 0x85, 0xa5, 0x17, 0xce, 0x7c, 0x15, 0xcb, 0xda, 0x7e, 0x33, 0xad, 0xed, 0xd5, 0x17,
+	0x76, 0x42, 0xed, 0xb9, 0xbb, 0xbf, 0x21, 0x83, 0xdc, 0x6a, 0xb5, 0x97, 0xaf, 0x65, 0x1f, 0x1a,
+	0x96, 0xd5, 0xe5, 0xe5, 0x46, 0xe6, 0xe0, 0x7d, 0x5b, 0xb7, 0x07, 0x7d, 0x92, 0x5b, 0x00, 0x44,
+	0xa0, 0x60, 0x85, 0x3f, 0x8c, 0x4c, 0x4e, 0xbb, 0xdb, 0xe4, 0x0f, 0x8b, 0x78, 0x8c, 0xc1, 0x2e,
+	0xab, 0x6d, 0x37, 0x7b, 0x31, 0x31, 0xab, 0x6d, 0xb7, 0x5a, 0x0e, 0xe7, 0x5d, 0xc4, 0x87, 0x00,
+	0xad, 0x16, 0x7f, 0x56, 0xd7, 0xd3, 0xa9, 0xed, 0x50, 0xe3, 0xeb, 0x03, 0xa3, 0x6f, 0x93, 0x92,
+	0x7a, 0x0d, 0xd4, 0xa5, 0x91, 0x9e, 0xf5, 0x98, 0x87, 0xa9, 0x56, 0xcb, 0xe9, 0xe9, 0x8d, 0x4f,
+	0x0d, 0x1b, 0x1f, 0x4a, 0xb0, 0x30, 0x95, 0x40, 0xba, 0x03, 0x7c, 0xb4, 0xa0, 0xa2, 0xcd, 0x38,
+	0xe9, 0x55, 0x6f, 0xe0, 0xaa, 0x63, 0x18, 0x2e, 0x6c, 0x13, 0xe9, 0xac, 0xba, 0xde, 0x6c, 0xd2,
+	0x18, 0x67, 0x0b, 0x9f, 0x59, 0xb4, 0x5a, 0x4e, 0x16, 0xba, 0x8d, 0x53, 0xea, 0xb8, 0x9b, 0x8e,
+	0x58, 0xc4, 0x65, 0x84, 0x1c, 0xb4, 0x17, 0x10, 0x9b, 0xa8, 0x08, 0x69, 0xa6, 0x71, 0x5e, 0x61,
+	0x38, 0xfd, 0x14, 0xe4, 0x0a, 0xae, 0xa0, 0xab, 0xb7, 0x17, 0x7b, 0xbc, 0x8a, 0xa2, 0xe1, 0x00,
+	0x1c, 0xbf, 0xf6, 0x44, 0x61, 0x17, 0x3a, 0xbb, 0xff, 0x3b, 0x00, 0x63, 0x8f, 0xf4, 0xf1, 0xa4,
+	0x32, 0x00, 0x00,
 }
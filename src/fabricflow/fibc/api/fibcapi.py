@@ -404,7 +404,8 @@ def new_policy_acl_match(**kwargs):
         ip_proto=kwargs.get("ip_proto", 0),
         tp_src=kwargs.get("tp_src", 0),
         tp_dst=kwargs.get("tp_dst", 0),
-        eth_dst=kwargs.get("eth_dst", ""))
+        eth_dst=kwargs.get("eth_dst", ""),
+        ip_src=kwargs.get("ip_src", ""))
 
 def new_policy_acl_action(name, value=0):
     """
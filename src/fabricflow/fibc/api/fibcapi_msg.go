@@ -194,3 +194,30 @@ func NewOAMAuditRouteCntReply(count uint64) *OAM_AuditRouteCntReply {
 		Count: count,
 	}
 }
+
+func NewApAuditModsRequest(reID string, repair bool) *ApAuditModsRequest {
+	return &ApAuditModsRequest{
+		ReId:   reID,
+		Repair: repair,
+	}
+}
+
+func NewApAuditModsEntry(result ApAuditModsEntry_Result, reID string, dpID uint64) *ApAuditModsEntry {
+	return &ApAuditModsEntry{
+		Result: result,
+		ReId:   reID,
+		DpId:   dpID,
+	}
+}
+
+func (e *ApAuditModsEntry) SetFlows(vm, dp *FlowMod) *ApAuditModsEntry {
+	e.VmFlow = vm
+	e.DpFlow = dp
+	return e
+}
+
+func (e *ApAuditModsEntry) SetGroups(vm, dp *GroupMod) *ApAuditModsEntry {
+	e.VmGroup = vm
+	e.DpGroup = dp
+	return e
+}
@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='fibcapi',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\rfibcapi.proto\x12\x07\x66ibcapi\"\x16\n\x05Hello\x12\r\n\x05re_id\x18\x01 \x01(\t\"l\n\x08\x44pStatus\x12(\n\x06status\x18\x01 \x01(\x0e\x32\x18.fibcapi.DpStatus.Status\x12\r\n\x05re_id\x18\x02 \x01(\t\"\'\n\x06Status\x12\x07\n\x03NOP\x10\x00\x12\t\n\x05\x45NTER\x10\x01\x12\t\n\x05LEAVE\x10\x02\"E\n\nTunnelType\"7\n\x04Type\x12\x07\n\x03NOP\x10\x00\x12\x08\n\x04IPIP\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x08\n\x04GRE4\x10\x03\x12\x08\n\x04GRE6\x10\x04\"f\n\x0e\x42ridgeVlanInfo\"T\n\x05\x46lags\x12\x07\n\x03NOP\x10\x00\x12\n\n\x06MASTER\x10\x01\x12\x08\n\x04PVID\x10\x02\x12\x0c\n\x08UNTAGGED\x10\x04\x12\x0f\n\x0bRANGE_BEGIN\x10\x08\x12\r\n\tRANGE_END\x10\x10\"\x8d\x01\n\nPortStatus\x12*\n\x06status\x18\x01 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\x0e\n\x06ifname\x18\x04 \x01(\t\"#\n\x06Status\x12\x07\n\x03NOP\x10\x00\x12\x06\n\x02UP\x10\x01\x12\x08\n\x04\x44OWN\x10\x02\"a\n\x08LinkType\"U\n\x04Type\x12\n\n\x06\x44\x45VICE\x10\x00\x12\t\n\x05IPTUN\x10\x01\x12\n\n\x06\x42RIDGE\x10\x02\x12\x10\n\x0c\x42RIDGE_SLAVE\x10\x03\x12\x08\n\x04\x42OND\x10\x04\x12\x0e\n\nBOND_SLAVE\x10\x05\"\xee\x01\n\nPortConfig\x12$\n\x03\x63md\x18\x01 \x01(\x0e\x32\x17.fibcapi.PortConfig.Cmd\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0e\n\x06ifname\x18\x03 \x01(\t\x12\x0f\n\x07port_id\x18\x04 \x01(\r\x12\x0c\n\x04link\x18\x05 \x01(\t\x12\x0e\n\x06master\x18\x06 \x01(\t\x12\x0f\n\x07\x64p_port\x18\x07 \x01(\r\x12*\n\x06status\x18\x08 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\"/\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06\x44\x45LETE\x10\x03\"\x9f\x05\n\x07\x46lowMod\x12!\n\x03\x63md\x18\x01 \x01(\x0e\x32\x14.fibcapi.FlowMod.Cmd\x12%\n\x05table\x18\x02 \x01(\x0e\x32\x16.fibcapi.FlowMod.Table\x12\r\n\x05re_id\x18\x03 \x01(\t\x12!\n\x04vlan\x18\x04 \x01(\x0b\x32\x11.fibcapi.VLANFlowH\x00\x12/\n\x08term_mac\x18\x05 \x01(\x0b\x32\x1b.fibcapi.TerminationMacFlowH\x00\x12\"\n\x05mpls1\x18\x06 \x01(\x0b\x32\x11.fibcapi.MPLSFlowH\x00\x12.\n\x07unicast\x18\x07 \x01(\x0b\x32\x1b.fibcapi.UnicastRoutingFlowH\x00\x12)\n\x08\x62ridging\x18\x08 \x01(\x0b\x32\x15.fibcapi.BridgingFlowH\x00\x12%\n\x03\x61\x63l\x18\t \x01(\x0b\x32\x16.fibcapi.PolicyACLFlowH\x00\"U\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\x11\n\rMODIFY_STRICT\x10\x03\x12\n\n\x06\x44\x45LETE\x10\x04\x12\x11\n\rDELETE_STRICT\x10\x05\"\xe0\x01\n\x05Table\x12\x10\n\x0cINGRESS_PORT\x10\x00\x12\x08\n\x04VLAN\x10\n\x12\x0c\n\x08TERM_MAC\x10\x14\x12\x0b\n\x07L3_TYPE\x10\x15\x12\t\n\x05MPLS0\x10\x17\x12\t\n\x05MPLS1\x10\x18\x12\t\n\x05MPLS2\x10\x19\x12\x10\n\x0cMPLS_L3_TYPE\x10\x1b\x12\x14\n\x10MPLS_LABEL_TRUST\x10\x1c\x12\r\n\tMPLS_TYPE\x10\x1d\x12\x13\n\x0fUNICAST_ROUTING\x10\x1e\x12\x15\n\x11MULTICAST_ROUTING\x10(\x12\x0c\n\x08\x42RIDGING\x10\x32\x12\x0e\n\nPOLICY_ACL\x10<B\x07\n\x05\x65ntry\"\xf8\x05\n\x08GroupMod\x12\"\n\x03\x63md\x18\x01 \x01(\x0e\x32\x15.fibcapi.GroupMod.Cmd\x12\'\n\x06g_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\r\n\x05re_id\x18\x03 \x01(\t\x12-\n\x08l2_iface\x18\x04 \x01(\x0b\x32\x19.fibcapi.L2InterfaceGroupH\x00\x12-\n\nl3_unicast\x18\x05 \x01(\x0b\x32\x17.fibcapi.L3UnicastGroupH\x00\x12\x31\n\nmpls_iface\x18\x06 \x01(\x0b\x32\x1b.fibcapi.MPLSInterfaceGroupH\x00\x12-\n\nmpls_label\x18\x07 \x01(\x0b\x32\x17.fibcapi.MPLSLabelGroupH\x00\"/\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06\x44\x45LETE\x10\x03\"\x95\x03\n\x05GType\x12\n\n\x06UNSPEC\x10\x00\x12\x10\n\x0cL2_INTERFACE\x10\x01\x12\x0e\n\nL2_REWRITE\x10\x10\x12\x0e\n\nL3_UNICAST\x10 \x12\x10\n\x0cL2_MULTICAST\x10\x30\x12\x0c\n\x08L2_FLOOD\x10@\x12\x10\n\x0cL3_INTERFACE\x10P\x12\x10\n\x0cL3_MULTICAST\x10`\x12\x0b\n\x07L3_ECMP\x10p\x12\x15\n\x10L2_OVERLAY_FL_UC\x10\x80\x01\x12\x15\n\x10L2_OVERLAY_FL_MC\x10\x81\x01\x12\x15\n\x10L2_OVERLAY_MC_UC\x10\x82\x01\x12\x15\n\x10L2_OVERLAY_MC_MC\x10\x83\x01\x12\x13\n\x0eMPLS_INTERFACE\x10\x90\x01\x12\x10\n\x0bMPLS_L2_VPN\x10\x91\x01\x12\x10\n\x0bMPLS_L3_VPN\x10\x92\x01\x12\x11\n\x0cMPLS_TUNNEL1\x10\x93\x01\x12\x11\n\x0cMPLS_TUNNEL2\x10\x94\x01\x12\x0e\n\tMPLS_SWAP\x10\x95\x01\x12\x0c\n\x07MPLS_FF\x10\xa6\x01\x12\x0e\n\tMPLS_ECMP\x10\xa8\x01\x12\x14\n\x0fL2_UF_INTERFACE\x10\xb0\x01\x42\x07\n\x05\x65ntry\"\xa2\x03\n\x08VLANFlow\x12&\n\x05match\x18\x01 \x01(\x0b\x32\x17.fibcapi.VLANFlow.Match\x12)\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\x18.fibcapi.VLANFlow.Action\x12\x12\n\ngoto_table\x18\x03 \x01(\r\x1a\x37\n\x05Match\x12\x0f\n\x07in_port\x18\x01 \x01(\r\x12\x0b\n\x03vid\x18\x02 \x01(\r\x12\x10\n\x08vid_mask\x18\x03 \x01(\r\x1a\xf5\x01\n\x06\x41\x63tion\x12+\n\x04name\x18\x01 \x01(\x0e\x32\x1d.fibcapi.VLANFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\xae\x01\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\x10\n\x0cSET_VLAN_VID\x10\x01\x12\x0b\n\x07SET_VRF\x10\x02\x12\x0c\n\x08SET_OVID\x10\x03\x12\x11\n\rSET_MPLS_TYPE\x10\x04\x12\r\n\tPUSH_VLAN\x10\x05\x12\x0c\n\x08POP_VLAN\x10\x06\x12\x14\n\x10SET_MPLS_L2_TYPE\x10\x07\x12\x11\n\rSET_TUNNEL_ID\x10\x08\x12\x14\n\x10SET_VLAN_L2_TYPE\x10\t\"\xce\x02\n\x12TerminationMacFlow\x12\x30\n\x05match\x18\x01 \x01(\x0b\x32!.fibcapi.TerminationMacFlow.Match\x12\x33\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\".fibcapi.TerminationMacFlow.Action\x12\x12\n\ngoto_table\x18\x03 \x01(\r\x1aM\n\x05Match\x12\x0f\n\x07in_port\x18\x01 \x01(\r\x12\x10\n\x08\x65th_type\x18\x02 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x03 \x01(\t\x12\x10\n\x08vlan_vid\x18\x04 \x01(\r\x1an\n\x06\x41\x63tion\x12\x35\n\x04name\x18\x01 \x01(\x0e\x32\'.fibcapi.TerminationMacFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\x1e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\"\xdc\x04\n\x08MPLSFlow\x12&\n\x05match\x18\x01 \x01(\x0b\x32\x17.fibcapi.MPLSFlow.Match\x12)\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\x18.fibcapi.MPLSFlow.Action\x12\'\n\x06g_type\x18\x03 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x04 \x01(\r\x12\x12\n\ngoto_table\x18\x05 \x01(\r\x1a#\n\x05Match\x12\x0b\n\x03\x62os\x18\x01 \x01(\x08\x12\r\n\x05label\x18\x02 \x01(\r\x1a\x8c\x03\n\x06\x41\x63tion\x12+\n\x04name\x18\x01 \x01(\x0e\x32\x1d.fibcapi.MPLSFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\xc5\x02\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\r\n\tPOP_LABEL\x10\x01\x12\x0b\n\x07\x44\x45\x43_TTL\x10\x02\x12\x0f\n\x0b\x43OPY_TTL_IN\x10\x03\x12\x0e\n\nCOPY_TC_IN\x10\x04\x12\x0b\n\x07SET_VRF\x10\x05\x12\x14\n\x10SET_MPLS_L2_PORT\x10\x06\x12\x11\n\rSET_MPLS_TYPE\x10\x07\x12\x11\n\rSET_TUNNEL_ID\x10\x08\x12\x11\n\rSET_QOS_INDEX\x10\t\x12\x15\n\x11SET_TRAFFIC_CLASS\x10\n\x12\x12\n\x0eSET_L3_IN_PORT\x10\x0b\x12\x0e\n\nCOPY_FIELD\x10\x0c\x12\x11\n\rPOP_CW_OR_ACH\x10\r\x12\x0c\n\x08POP_VLAN\x10\x0e\x12\x11\n\rPOP_L2_HEADER\x10\x0f\x12\x0f\n\x0bSET_LMEP_ID\x10\x10\x12\x18\n\x14SET_PROTECTION_INDEX\x10\x11\"\xc8\x03\n\x12UnicastRoutingFlow\x12\x30\n\x05match\x18\x01 \x01(\x0b\x32!.fibcapi.UnicastRoutingFlow.Match\x12\x32\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\".fibcapi.UnicastRoutingFlow.Action\x12\'\n\x06g_type\x18\x03 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x04 \x01(\r\x1aX\n\x05Match\x12\x0e\n\x06ip_dst\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\x12\x32\n\x06origin\x18\x03 \x01(\x0e\x32\".fibcapi.UnicastRoutingFlow.Origin\x1a\x8e\x01\n\x06\x41\x63tion\x12\x35\n\x04name\x18\x01 \x01(\x0e\x32\'.fibcapi.UnicastRoutingFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\">\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\x12\x11\n\rCLEAR_ACTIONS\x10\x02\x12\x0b\n\x07\x44\x45\x43_TTL\x10\x03\"*\n\x06Origin\x12\n\n\x06UNSPEC\x10\x00\x12\t\n\x05NEIGH\x10\x01\x12\t\n\x05ROUTE\x10\x02\"\x91\x02\n\x0c\x42ridgingFlow\x12*\n\x05match\x18\x01 \x01(\x0b\x32\x1b.fibcapi.BridgingFlow.Match\x12,\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\x1c.fibcapi.BridgingFlow.Action\x1a=\n\x05Match\x12\x0f\n\x07\x65th_dst\x18\x01 \x01(\t\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x11\n\ttunnel_id\x18\x03 \x01(\r\x1ah\n\x06\x41\x63tion\x12/\n\x04name\x18\x01 \x01(\x0e\x32!.fibcapi.BridgingFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\x1e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\"\xe3\x02\n\rPolicyACLFlow\x12+\n\x05match\x18\x01 \x01(\x0b\x32\x1c.fibcapi.PolicyACLFlow.Match\x12-\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\x1d.fibcapi.PolicyACLFlow.Action\x1a\x8a\x01\n\x05Match\x12\x0e\n\x06ip_dst\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\x12\x10\n\x08\x65th_type\x18\x03 \x01(\r\x12\x10\n\x08ip_proto\x18\x04 \x01(\r\x12\x0e\n\x06tp_src\x18\x05 \x01(\r\x12\x0e\n\x06tp_dst\x18\x06 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x07 \x01(\t\x12\x0f\n\x07in_port\x18\x08 \x01(\r\x1ai\n\x06\x41\x63tion\x12\x30\n\x04name\x18\x01 \x01(\x0e\x32\".fibcapi.PolicyACLFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\x1e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\"\x8a\x01\n\x10L2InterfaceGroup\x12\x0f\n\x07port_id\x18\x01 \x01(\r\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x18\n\x10vlan_translation\x18\x03 \x01(\x08\x12\x0f\n\x07hw_addr\x18\x04 \x01(\t\x12\x0b\n\x03mtu\x18\x05 \x01(\r\x12\x0b\n\x03vrf\x18\x06 \x01(\r\x12\x0e\n\x06master\x18\x07 \x01(\r\"\xcc\x01\n\x0eL3UnicastGroup\x12\r\n\x05ne_id\x18\x01 \x01(\r\x12\x0f\n\x07port_id\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x04 \x01(\t\x12\x0f\n\x07\x65th_src\x18\x05 \x01(\t\x12\x13\n\x0bphy_port_id\x18\x06 \x01(\r\x12*\n\x08tun_type\x18\x07 \x01(\x0e\x32\x18.fibcapi.TunnelType.Type\x12\x12\n\ntun_remote\x18\x08 \x01(\t\x12\x11\n\ttun_local\x18\t \x01(\t\"h\n\x12MPLSInterfaceGroup\x12\r\n\x05ne_id\x18\x01 \x01(\r\x12\x0f\n\x07port_id\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x04 \x01(\t\x12\x0f\n\x07\x65th_src\x18\x05 \x01(\t\"\x7f\n\x0eMPLSLabelGroup\x12\x0e\n\x06\x64st_id\x18\x01 \x01(\r\x12\x11\n\tnew_label\x18\x02 \x01(\r\x12\r\n\x05ne_id\x18\x03 \x01(\r\x12\x12\n\nnew_dst_id\x18\x04 \x01(\r\x12\'\n\x06g_type\x18\x05 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\"l\n\x07\x46\x46Hello\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"(\n\x06\x44pType\x12\x07\n\x03NOP\x10\x00\x12\x0b\n\x07OPENNSL\x10\x01\x12\x08\n\x04\x46\x46VS\x10\x02\"\xa0\x01\n\x06\x46\x46Port\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\x0f\n\x07hw_addr\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0e\n\x06\x63onfig\x18\x04 \x01(\r\x12\r\n\x05state\x18\x05 \x01(\r\x12\x0c\n\x04\x63urr\x18\x06 \x01(\r\x12\x12\n\nadvertised\x18\x07 \x01(\r\x12\x12\n\ncurr_speed\x18\x08 \x01(\r\x12\x11\n\tmax_speed\x18\t \x01(\r\"\x94\x02\n\x0b\x46\x46PortStats\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\x30\n\x06values\x18\x02 \x03(\x0b\x32 .fibcapi.FFPortStats.ValuesEntry\x12\x33\n\x08s_values\x18\x03 \x03(\x0b\x32!.fibcapi.FFPortStats.SValuesEntry\x1a-\n\x0bValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\x1a.\n\x0cSValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\".\n\x03\x43md\x12\x07\n\x03GET\x10\x00\x12\t\n\x05START\x10\x01\x12\x08\n\x04STOP\x10\x02\x12\t\n\x05RESET\x10\x03\"\x97\x03\n\x03OAM\x1a\x16\n\x14\x41uditRouteCntRequest\x1a#\n\x12\x41uditRouteCntReply\x12\r\n\x05\x63ount\x18\x01 \x01(\x04\x1a\x95\x01\n\x07Request\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12&\n\x08oam_type\x18\x03 \x01(\x0e\x32\x14.fibcapi.OAM.OAMType\x12<\n\x0f\x61udit_route_cnt\x18\x04 \x01(\x0b\x32!.fibcapi.OAM.AuditRouteCntRequestH\x00\x42\x06\n\x04\x62ody\x1a\x91\x01\n\x05Reply\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12&\n\x08oam_type\x18\x03 \x01(\x0e\x32\x14.fibcapi.OAM.OAMType\x12:\n\x0f\x61udit_route_cnt\x18\x04 \x01(\x0b\x32\x1f.fibcapi.OAM.AuditRouteCntReplyH\x00\x42\x06\n\x04\x62ody\"\'\n\x07OAMType\x12\x07\n\x03NOP\x10\x00\x12\x13\n\x0f\x41UDIT_ROUTE_CNT\x10\x01\"\xc0\x06\n\x0b\x46\x46Multipart\x1aT\n\x0bPortRequest\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\r\n\x05names\x18\x02 \x03(\t\x12%\n\x03\x63md\x18\x03 \x01(\x0e\x32\x18.fibcapi.FFPortStats.Cmd\x1a\x30\n\tPortReply\x12#\n\x05stats\x18\x01 \x03(\x0b\x32\x14.fibcapi.FFPortStats\x1a#\n\x0fPortDescRequest\x12\x10\n\x08internal\x18\x01 \x01(\x08\x1a@\n\rPortDescReply\x12\x10\n\x08internal\x18\x01 \x01(\x08\x12\x1d\n\x04port\x18\x02 \x03(\x0b\x32\x0f.fibcapi.FFPort\x1a\xbb\x01\n\x07Request\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12,\n\x07mp_type\x18\x02 \x01(\x0e\x32\x1b.fibcapi.FFMultipart.MpType\x12\x30\n\x04port\x18\x03 \x01(\x0b\x32 .fibcapi.FFMultipart.PortRequestH\x00\x12\x39\n\tport_desc\x18\x04 \x01(\x0b\x32$.fibcapi.FFMultipart.PortDescRequestH\x00\x42\x06\n\x04\x62ody\x1a\xb5\x01\n\x05Reply\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12,\n\x07mp_type\x18\x02 \x01(\x0e\x32\x1b.fibcapi.FFMultipart.MpType\x12.\n\x04port\x18\x03 \x01(\x0b\x32\x1e.fibcapi.FFMultipart.PortReplyH\x00\x12\x37\n\tport_desc\x18\x04 \x01(\x0b\x32\".fibcapi.FFMultipart.PortDescReplyH\x00\x42\x06\n\x04\x62ody\"\xcb\x01\n\x06MpType\x12\x07\n\x03NOP\x10\x00\x12\x08\n\x04\x46LOW\x10\x01\x12\r\n\tAGGREGATE\x10\x02\x12\t\n\x05TABLE\x10\x03\x12\x08\n\x04PORT\x10\x04\x12\t\n\x05QUEUE\x10\x05\x12\t\n\x05GROUP\x10\x06\x12\x0e\n\nGROUP_DESC\x10\x07\x12\t\n\x05METER\x10\t\x12\x10\n\x0cMETER_CONFIG\x10\n\x12\x11\n\rMETER_FEATURE\x10\x0b\x12\x11\n\rTABLE_FEATURE\x10\x0c\x12\r\n\tPORT_DESC\x10\r\x12\x12\n\x0c\x45XPERIMENTER\x10\xff\xff\x03\":\n\nFFPacketIn\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\";\n\x0b\x46\x46PacketOut\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"I\n\x08\x46\x46Packet\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\r\n\x05re_id\x18\x03 \x01(\t\x12\x0e\n\x06ifname\x18\x04 \x01(\t\"\x95\x01\n\x0c\x46\x46PortStatus\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x1d\n\x04port\x18\x02 \x01(\x0b\x32\x0f.fibcapi.FFPort\x12,\n\x06reason\x18\x03 \x01(\x0e\x32\x1c.fibcapi.FFPortStatus.Reason\")\n\x06Reason\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\n\n\x06\x44\x45LETE\x10\x01\x12\n\n\x06MODIFY\x10\x02\"h\n\tFFPortMod\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0f\n\x07hw_addr\x18\x03 \x01(\t\x12*\n\x06status\x18\x04 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\"?\n\x0e\x46\x46L2AddrStatus\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x1e\n\x05\x61\x64\x64rs\x18\x02 \x03(\x0b\x32\x0f.fibcapi.L2Addr\"=\n\x0cL2AddrStatus\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\x1e\n\x05\x61\x64\x64rs\x18\x02 \x03(\x0b\x32\x0f.fibcapi.L2Addr\"\x9c\x01\n\x06L2Addr\x12\x0f\n\x07hw_addr\x18\x01 \x01(\t\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\x0e\n\x06ifname\x18\x04 \x01(\t\x12&\n\x06reason\x18\x05 \x01(\x0e\x32\x16.fibcapi.L2Addr.Reason\"&\n\x06Reason\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06\x44\x45LETE\x10\x02*\x85\x03\n\x03\x46\x46M\x12\n\n\x06UNSPEC\x10\x00\x12\t\n\x05HELLO\x10\x01\x12\x0f\n\x0bPORT_STATUS\x10\x02\x12\x0f\n\x0bPORT_CONFIG\x10\x03\x12\x0c\n\x08\x46LOW_MOD\x10\x04\x12\r\n\tGROUP_MOD\x10\x05\x12\r\n\tDP_STATUS\x10\x06\x12\x0c\n\x08\x46\x46_HELLO\x10\x07\x12\x18\n\x14\x46\x46_MULTIPART_REQUEST\x10\x08\x12\x16\n\x12\x46\x46_MULTIPART_REPLY\x10\t\x12\x10\n\x0c\x46\x46_PACKET_IN\x10\n\x12\x11\n\rFF_PACKET_OUT\x10\x0b\x12\x12\n\x0e\x46\x46_PORT_STATUS\x10\x0c\x12\x0f\n\x0b\x46\x46_PORT_MOD\x10\r\x12\x11\n\rL2ADDR_STATUS\x10\x0e\x12\x14\n\x10\x46\x46_L2ADDR_STATUS\x10\x0f\x12\x10\n\x0c\x41P_MON_REPLY\x10\x11\x12\x10\n\x0cVM_MON_REPLT\x10\x12\x12\x10\n\x0c\x44P_MON_REPLY\x10\x13\x12\x10\n\x0cVS_MON_REPLY\x10\x14\x12\x0f\n\x0bOAM_REQUEST\x10\x15\x12\r\n\tOAM_REPLY\x10\x16\x62\x06proto3')
+  serialized_pb=_b('\n\rfibcapi.proto\x12\x07\x66ibcapi\"\x16\n\x05Hello\x12\r\n\x05re_id\x18\x01 \x01(\t\"l\n\x08\x44pStatus\x12(\n\x06status\x18\x01 \x01(\x0e\x32\x18.fibcapi.DpStatus.Status\x12\r\n\x05re_id\x18\x02 \x01(\t\"\'\n\x06Status\x12\x07\n\x03NOP\x10\x00\x12\t\n\x05\x45NTER\x10\x01\x12\t\n\x05LEAVE\x10\x02\"E\n\nTunnelType\"7\n\x04Type\x12\x07\n\x03NOP\x10\x00\x12\x08\n\x04IPIP\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x08\n\x04GRE4\x10\x03\x12\x08\n\x04GRE6\x10\x04\"f\n\x0e\x42ridgeVlanInfo\"T\n\x05\x46lags\x12\x07\n\x03NOP\x10\x00\x12\n\n\x06MASTER\x10\x01\x12\x08\n\x04PVID\x10\x02\x12\x0c\n\x08UNTAGGED\x10\x04\x12\x0f\n\x0bRANGE_BEGIN\x10\x08\x12\r\n\tRANGE_END\x10\x10\"\x8d\x01\n\nPortStatus\x12*\n\x06status\x18\x01 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\x0e\n\x06ifname\x18\x04 \x01(\t\"#\n\x06Status\x12\x07\n\x03NOP\x10\x00\x12\x06\n\x02UP\x10\x01\x12\x08\n\x04\x44OWN\x10\x02\"l\n\x08LinkType\"`\n\x04Type\x12\n\n\x06\x44\x45VICE\x10\x00\x12\t\n\x05IPTUN\x10\x01\x12\n\n\x06\x42RIDGE\x10\x02\x12\x10\n\x0c\x42RIDGE_SLAVE\x10\x03\x12\x08\n\x04\x42OND\x10\x04\x12\x0e\n\nBOND_SLAVE\x10\x05\x12\t\n\x05VXLAN\x10\x06\"\xee\x01\n\nPortConfig\x12$\n\x03\x63md\x18\x01 \x01(\x0e\x32\x17.fibcapi.PortConfig.Cmd\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0e\n\x06ifname\x18\x03 \x01(\t\x12\x0f\n\x07port_id\x18\x04 \x01(\r\x12\x0c\n\x04link\x18\x05 \x01(\t\x12\x0e\n\x06master\x18\x06 \x01(\t\x12\x0f\n\x07\x64p_port\x18\x07 \x01(\r\x12*\n\x06status\x18\x08 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\"/\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06\x44\x45LETE\x10\x03\"\x94\x06\n\x07\x46lowMod\x12!\n\x03\x63md\x18\x01 \x01(\x0e\x32\x14.fibcapi.FlowMod.Cmd\x12%\n\x05table\x18\x02 \x01(\x0e\x32\x16.fibcapi.FlowMod.Table\x12\r\n\x05re_id\x18\x03 \x01(\t\x12!\n\x04vlan\x18\x04 \x01(\x0b\x32\x11.fibcapi.VLANFlowH\x00\x12/\n\x08term_mac\x18\x05 \x01(\x0b\x32\x1b.fibcapi.TerminationMacFlowH\x00\x12\"\n\x05mpls1\x18\x06 \x01(\x0b\x32\x11.fibcapi.MPLSFlowH\x00\x12.\n\x07unicast\x18\x07 \x01(\x0b\x32\x1b.fibcapi.UnicastRoutingFlowH\x00\x12)\n\x08\x62ridging\x18\x08 \x01(\x0b\x32\x15.fibcapi.BridgingFlowH\x00\x12%\n\x03\x61\x63l\x18\t \x01(\x0b\x32\x16.fibcapi.PolicyACLFlowH\x00\x12.\n\x05mcast\x18\n \x01(\x0b\x32\x1d.fibcapi.MulticastRoutingFlowH\x00\x12/\n\nsrv6_local\x18\x0b \x01(\x0b\x32\x19.fibcapi.SRv6LocalSidFlowH\x00\"U\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\x11\n\rMODIFY_STRICT\x10\x03\x12\n\n\x06\x44\x45LETE\x10\x04\x12\x11\n\rDELETE_STRICT\x10\x05\"\xf4\x01\n\x05Table\x12\x10\n\x0cINGRESS_PORT\x10\x00\x12\x08\n\x04VLAN\x10\n\x12\x0c\n\x08TERM_MAC\x10\x14\x12\x0b\n\x07L3_TYPE\x10\x15\x12\t\n\x05MPLS0\x10\x17\x12\t\n\x05MPLS1\x10\x18\x12\t\n\x05MPLS2\x10\x19\x12\x10\n\x0cMPLS_L3_TYPE\x10\x1b\x12\x14\n\x10MPLS_LABEL_TRUST\x10\x1c\x12\r\n\tMPLS_TYPE\x10\x1d\x12\x13\n\x0fUNICAST_ROUTING\x10\x1e\x12\x15\n\x11MULTICAST_ROUTING\x10(\x12\x0c\n\x08\x42RIDGING\x10\x32\x12\x0e\n\nPOLICY_ACL\x10<\x12\x12\n\x0eSRV6_LOCAL_SID\x10\x46\x42\x07\n\x05\x65ntry\"\x80\x08\n\x08GroupMod\x12\"\n\x03\x63md\x18\x01 \x01(\x0e\x32\x15.fibcapi.GroupMod.Cmd\x12\'\n\x06g_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\r\n\x05re_id\x18\x03 \x01(\t\x12-\n\x08l2_iface\x18\x04 \x01(\x0b\x32\x19.fibcapi.L2InterfaceGroupH\x00\x12-\n\nl3_unicast\x18\x05 \x01(\x0b\x32\x17.fibcapi.L3UnicastGroupH\x00\x12\x31\n\nmpls_iface\x18\x06 \x01(\x0b\x32\x1b.fibcapi.MPLSInterfaceGroupH\x00\x12-\n\nmpls_label\x18\x07 \x01(\x0b\x32\x17.fibcapi.MPLSLabelGroupH\x00\x12\'\n\x07l3_ecmp\x18\x08 \x01(\x0b\x32\x14.fibcapi.L3EcmpGroupH\x00\x12-\n\x08l3_mcast\x18\t \x01(\x0b\x32\x19.fibcapi.L3MulticastGroupH\x00\x12-\n\nl2_overlay\x18\n \x01(\x0b\x32\x17.fibcapi.L2OverlayGroupH\x00\x12,\n\x0cvxlan_tunnel\x18\x0b \x01(\x0b\x32\x14.fibcapi.VxlanTunnelH\x00\x12-\n\nsrv6_encap\x18\x0c \x01(\x0b\x32\x17.fibcapi.SRv6EncapGroupH\x00\"/\n\x03\x43md\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06\x44\x45LETE\x10\x03\"\xb9\x03\n\x05GType\x12\n\n\x06UNSPEC\x10\x00\x12\x10\n\x0cL2_INTERFACE\x10\x01\x12\x0e\n\nL2_REWRITE\x10\x10\x12\x0e\n\nL3_UNICAST\x10 \x12\x10\n\x0cL2_MULTICAST\x10\x30\x12\x0c\n\x08L2_FLOOD\x10@\x12\x10\n\x0cL3_INTERFACE\x10P\x12\x10\n\x0cL3_MULTICAST\x10`\x12\x0b\n\x07L3_ECMP\x10p\x12\x15\n\x10L2_OVERLAY_FL_UC\x10\x80\x01\x12\x15\n\x10L2_OVERLAY_FL_MC\x10\x81\x01\x12\x15\n\x10L2_OVERLAY_MC_UC\x10\x82\x01\x12\x15\n\x10L2_OVERLAY_MC_MC\x10\x83\x01\x12\x13\n\x0eMPLS_INTERFACE\x10\x90\x01\x12\x10\n\x0bMPLS_L2_VPN\x10\x91\x01\x12\x10\n\x0bMPLS_L3_VPN\x10\x92\x01\x12\x11\n\x0cMPLS_TUNNEL1\x10\x93\x01\x12\x11\n\x0cMPLS_TUNNEL2\x10\x94\x01\x12\x0e\n\tMPLS_SWAP\x10\x95\x01\x12\x0c\n\x07MPLS_FF\x10\xa6\x01\x12\x0e\n\tMPLS_ECMP\x10\xa8\x01\x12\x14\n\x0fL2_UF_INTERFACE\x10\xb0\x01\x12\x11\n\x0cVXLAN_TUNNEL\x10\xc0\x01\x12\x0f\n\nSRV6_ENCAP\x10\xc1\x01\x42\x07\n\x05\x65ntry\"\xa2\x03\n\x08VLANFlow\x12&\n\x05match\x18\x01 \x01(\x0b\x32\x17.fibcapi.VLANFlow.Match\x12)\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\x18.fibcapi.VLANFlow.Action\x12\x12\n\ngoto_table\x18\x03 \x01(\r\x1a\x37\n\x05Match\x12\x0f\n\x07in_port\x18\x01 \x01(\r\x12\x0b\n\x03vid\x18\x02 \x01(\r\x12\x10\n\x08vid_mask\x18\x03 \x01(\r\x1a\xf5\x01\n\x06\x41\x63tion\x12+\n\x04name\x18\x01 \x01(\x0e\x32\x1d.fibcapi.VLANFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\xae\x01\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\x10\n\x0cSET_VLAN_VID\x10\x01\x12\x0b\n\x07SET_VRF\x10\x02\x12\x0c\n\x08SET_OVID\x10\x03\x12\x11\n\rSET_MPLS_TYPE\x10\x04\x12\r\n\tPUSH_VLAN\x10\x05\x12\x0c\n\x08POP_VLAN\x10\x06\x12\x14\n\x10SET_MPLS_L2_TYPE\x10\x07\x12\x11\n\rSET_TUNNEL_ID\x10\x08\x12\x14\n\x10SET_VLAN_L2_TYPE\x10\t\"\xce\x02\n\x12TerminationMacFlow\x12\x30\n\x05match\x18\x01 \x01(\x0b\x32!.fibcapi.TerminationMacFlow.Match\x12\x33\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\".fibcapi.TerminationMacFlow.Action\x12\x12\n\ngoto_table\x18\x03 \x01(\r\x1aM\n\x05Match\x12\x0f\n\x07in_port\x18\x01 \x01(\r\x12\x10\n\x08\x65th_type\x18\x02 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x03 \x01(\t\x12\x10\n\x08vlan_vid\x18\x04 \x01(\r\x1an\n\x06\x41\x63tion\x12\x35\n\x04name\x18\x01 \x01(\x0e\x32\'.fibcapi.TerminationMacFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\x1e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\"\xdc\x04\n\x08MPLSFlow\x12&\n\x05match\x18\x01 \x01(\x0b\x32\x17.fibcapi.MPLSFlow.Match\x12)\n\x07\x61\x63tions\x18\x02 \x03(\x0b\x32\x18.fibcapi.MPLSFlow.Action\x12\'\n\x06g_type\x18\x03 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x04 \x01(\r\x12\x12\n\ngoto_table\x18\x05 \x01(\r\x1a#\n\x05Match\x12\x0b\n\x03\x62os\x18\x01 \x01(\x08\x12\r\n\x05label\x18\x02 \x01(\r\x1a\x8c\x03\n\x06\x41\x63tion\x12+\n\x04name\x18\x01 \x01(\x0e\x32\x1d.fibcapi.MPLSFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\xc5\x02\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\r\n\tPOP_LABEL\x10\x01\x12\x0b\n\x07\x44\x45\x43_TTL\x10\x02\x12\x0f\n\x0b\x43OPY_TTL_IN\x10\x03\x12\x0e\n\nCOPY_TC_IN\x10\x04\x12\x0b\n\x07SET_VRF\x10\x05\x12\x14\n\x10SET_MPLS_L2_PORT\x10\x06\x12\x11\n\rSET_MPLS_TYPE\x10\x07\x12\x11\n\rSET_TUNNEL_ID\x10\x08\x12\x11\n\rSET_QOS_INDEX\x10\t\x12\x15\n\x11SET_TRAFFIC_CLASS\x10\n\x12\x12\n\x0eSET_L3_IN_PORT\x10\x0b\x12\x0e\n\nCOPY_FIELD\x10\x0c\x12\x11\n\rPOP_CW_OR_ACH\x10\r\x12\x0c\n\x08POP_VLAN\x10\x0e\x12\x11\n\rPOP_L2_HEADER\x10\x0f\x12\x0f\n\x0bSET_LMEP_ID\x10\x10\x12\x18\n\x14SET_PROTECTION_INDEX\x10\x11\"\xc8\x03\n\x12UnicastRoutingFlow\x12\x30\n\x05match\x18\x01 \x01(\x0b\x32!.fibcapi.UnicastRoutingFlow.Match\x12\x32\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\".fibcapi.UnicastRoutingFlow.Action\x12\'\n\x06g_type\x18\x03 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x04 \x01(\r\x1aX\n\x05Match\x12\x0e\n\x06ip_dst\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\x12\x32\n\x06origin\x18\x03 \x01(\x0e\x32\".fibcapi.UnicastRoutingFlow.Origin\x1a\x8e\x01\n\x06\x41\x63tion\x12\x35\n\x04name\x18\x01 \x01(\x0e\x32\'.fibcapi.UnicastRoutingFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\">\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\x12\x11\n\rCLEAR_ACTIONS\x10\x02\x12\x0b\n\x07\x44\x45\x43_TTL\x10\x03\"*\n\x06Origin\x12\n\n\x06UNSPEC\x10\x00\x12\t\n\x05NEIGH\x10\x01\x12\t\n\x05ROUTE\x10\x02\"\xc9\x01\n\x14MulticastRoutingFlow\x12\x32\n\x05match\x18\x01 \x01(\x0b\x32#.fibcapi.MulticastRoutingFlow.Match\x12\'\n\x06g_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x03 \x01(\r\x1a\x46\n\x05Match\x12\x0e\n\x06ip_src\x18\x01 \x01(\t\x12\x0e\n\x06ip_dst\x18\x02 \x01(\t\x12\x0b\n\x03vrf\x18\x03 \x01(\r\x12\x10\n\x08vlan_vid\x18\x04 \x01(\r\"\x91\x02\n\x0c\x42ridgingFlow\x12*\n\x05match\x18\x01 \x01(\x0b\x32\x1b.fibcapi.BridgingFlow.Match\x12,\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\x1c.fibcapi.BridgingFlow.Action\x1a=\n\x05Match\x12\x0f\n\x07\x65th_dst\x18\x01 \x01(\t\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x11\n\ttunnel_id\x18\x03 \x01(\r\x1ah\n\x06\x41\x63tion\x12/\n\x04name\x18\x01 \x01(\x0e\x32!.fibcapi.BridgingFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"\x1e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\"\x84\x03\n\x10SRv6LocalSidFlow\x12.\n\x05match\x18\x01 \x01(\x0b\x32\x1f.fibcapi.SRv6LocalSidFlow.Match\x12\x30\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32 .fibcapi.SRv6LocalSidFlow.Action\x12\'\n\x06g_type\x18\x03 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\x12\x0c\n\x04g_id\x18\x04 \x01(\r\x1a!\n\x05Match\x12\x0b\n\x03sid\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\x1a\xb3\x01\n\x06\x41\x63tion\x12\x33\n\x04name\x18\x01 \x01(\x0e\x32%.fibcapi.SRv6LocalSidFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"e\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\t\n\x05\x45ND_X\x10\x02\x12\t\n\x05\x45ND_T\x10\x03\x12\x0b\n\x07\x45ND_DX6\x10\x05\x12\x0b\n\x07\x45ND_DX4\x10\x06\x12\x0b\n\x07\x45ND_DT6\x10\x07\x12\x0b\n\x07\x45ND_DT4\x10\x08\"\xe5\x04\n\rPolicyACLFlow\x12+\n\x05match\x18\x01 \x01(\x0b\x32\x1c.fibcapi.PolicyACLFlow.Match\x12-\n\x06\x61\x63tion\x18\x02 \x01(\x0b\x32\x1d.fibcapi.PolicyACLFlow.Action\x12\x10\n\x08priority\x18\x03 \x01(\r\x12.\n\x07\x61\x63tions\x18\x04 \x03(\x0b\x32\x1d.fibcapi.PolicyACLFlow.Action\x1a\xfd\x01\n\x05Match\x12\x0e\n\x06ip_dst\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\x12\x10\n\x08\x65th_type\x18\x03 \x01(\r\x12\x10\n\x08ip_proto\x18\x04 \x01(\r\x12\x0e\n\x06tp_src\x18\x05 \x01(\r\x12\x0e\n\x06tp_dst\x18\x06 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x07 \x01(\t\x12\x0f\n\x07in_port\x18\x08 \x01(\r\x12\x0e\n\x06ip_src\x18\t \x01(\t\x12\x10\n\x08vlan_vid\x18\n \x01(\r\x12\x0f\n\x07ip_dscp\x18\x0b \x01(\r\x12\x14\n\x0cip_dscp_mask\x18\x0c \x01(\r\x12\x13\n\x0btp_src_mask\x18\r \x01(\r\x12\x13\n\x0btp_dst_mask\x18\x0e \x01(\r\x1a\xb5\x01\n\x06\x41\x63tion\x12\x30\n\x04name\x18\x01 \x01(\x0e\x32\".fibcapi.PolicyACLFlow.Action.Name\x12\r\n\x05value\x18\x02 \x01(\r\"j\n\x04Name\x12\n\n\x06UNSPEC\x10\x00\x12\n\n\x06OUTPUT\x10\x01\x12\x0b\n\x07SET_VRF\x10\x02\x12\x08\n\x04\x44ROP\x10\x03\x12\n\n\x06PERMIT\x10\x04\x12\n\n\x06MIRROR\x10\x05\x12\r\n\tSET_QUEUE\x10\x06\x12\x0c\n\x08SET_DSCP\x10\x07\"\xd9\x01\n\x10L2InterfaceGroup\x12\x0f\n\x07port_id\x18\x01 \x01(\r\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x18\n\x10vlan_translation\x18\x03 \x01(\x08\x12\x0f\n\x07hw_addr\x18\x04 \x01(\t\x12\x0b\n\x03mtu\x18\x05 \x01(\r\x12\x0b\n\x03vrf\x18\x06 \x01(\r\x12\x0e\n\x06master\x18\x07 \x01(\r\x12 \n\x04\x62ond\x18\x08 \x01(\x0b\x32\x12.fibcapi.BondAttrs\x12+\n\nbond_slave\x18\t \x01(\x0b\x32\x17.fibcapi.BondSlaveAttrs\"\xe1\x02\n\tBondAttrs\x12%\n\x04mode\x18\x01 \x01(\x0e\x32\x17.fibcapi.BondAttrs.Mode\x12\x32\n\x0bhash_policy\x18\x02 \x01(\x0e\x32\x1d.fibcapi.BondAttrs.HashPolicy\x12\x11\n\tmin_links\x18\x03 \x01(\r\x12\x15\n\raggregator_id\x18\x04 \x01(\r\"}\n\x04Mode\x12\x0e\n\nBALANCE_RR\x10\x00\x12\x11\n\rACTIVE_BACKUP\x10\x01\x12\x0f\n\x0b\x42\x41LANCE_XOR\x10\x02\x12\r\n\tBROADCAST\x10\x03\x12\x10\n\x0cIEEE_802_3AD\x10\x04\x12\x0f\n\x0b\x42\x41LANCE_TLB\x10\x05\x12\x0f\n\x0b\x42\x41LANCE_ALB\x10\x06\"P\n\nHashPolicy\x12\n\n\x06LAYER2\x10\x00\x12\x0c\n\x08LAYER3_4\x10\x01\x12\x0c\n\x08LAYER2_3\x10\x02\x12\x0c\n\x08\x45NCAP2_3\x10\x03\x12\x0c\n\x08\x45NCAP3_4\x10\x04\"r\n\x0e\x42ondSlaveAttrs\x12\x0e\n\x06\x61\x63tive\x18\x01 \x01(\x08\x12\x0f\n\x07link_up\x18\x02 \x01(\x08\x12\x15\n\raggregator_id\x18\x03 \x01(\r\x12\x12\n\ncollecting\x18\x04 \x01(\x08\x12\x14\n\x0c\x64istributing\x18\x05 \x01(\x08\"\xcc\x01\n\x0eL3UnicastGroup\x12\r\n\x05ne_id\x18\x01 \x01(\r\x12\x0f\n\x07port_id\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x04 \x01(\t\x12\x0f\n\x07\x65th_src\x18\x05 \x01(\t\x12\x13\n\x0bphy_port_id\x18\x06 \x01(\r\x12*\n\x08tun_type\x18\x07 \x01(\x0e\x32\x18.fibcapi.TunnelType.Type\x12\x12\n\ntun_remote\x18\x08 \x01(\t\x12\x11\n\ttun_local\x18\t \x01(\t\".\n\x0bL3EcmpGroup\x12\x0f\n\x07\x65\x63mp_id\x18\x01 \x01(\r\x12\x0e\n\x06ne_ids\x18\x02 \x03(\r\"\x9e\x01\n\x10L3MulticastGroup\x12\r\n\x05mc_id\x18\x01 \x01(\r\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12-\n\x05ports\x18\x03 \x03(\x0b\x32\x1e.fibcapi.L3MulticastGroup.Port\x1a:\n\x04Port\x12\x0f\n\x07port_id\x18\x01 \x01(\r\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x0f\n\x07\x65th_src\x18\x03 \x01(\t\"W\n\x0eL2OverlayGroup\x12\x11\n\ttunnel_id\x18\x01 \x01(\r\x12\r\n\x05index\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x11\n\ttun_ports\x18\x04 \x03(\r\"\x9f\x01\n\x0bVxlanTunnel\x12\x13\n\x0btun_port_id\x18\x01 \x01(\r\x12\x0b\n\x03vni\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\r\n\x05local\x18\x04 \x01(\t\x12\x0e\n\x06remote\x18\x05 \x01(\t\x12\x10\n\x08udp_port\x18\x06 \x01(\r\x12\x0b\n\x03ttl\x18\x07 \x01(\r\x12\r\n\x05ne_id\x18\x08 \x01(\r\x12\x0f\n\x07port_id\x18\t \x01(\r\"\x8e\x01\n\x0eSRv6EncapGroup\x12\x10\n\x08\x65ncap_id\x18\x01 \x01(\r\x12*\n\x04mode\x18\x02 \x01(\x0e\x32\x1c.fibcapi.SRv6EncapGroup.Mode\x12\x10\n\x08segments\x18\x03 \x03(\t\x12\r\n\x05ne_id\x18\x04 \x01(\r\"\x1d\n\x04Mode\x12\n\n\x06INLINE\x10\x00\x12\t\n\x05\x45NCAP\x10\x01\"h\n\x12MPLSInterfaceGroup\x12\r\n\x05ne_id\x18\x01 \x01(\r\x12\x0f\n\x07port_id\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x0f\n\x07\x65th_dst\x18\x04 \x01(\t\x12\x0f\n\x07\x65th_src\x18\x05 \x01(\t\"\x7f\n\x0eMPLSLabelGroup\x12\x0e\n\x06\x64st_id\x18\x01 \x01(\r\x12\x11\n\tnew_label\x18\x02 \x01(\r\x12\r\n\x05ne_id\x18\x03 \x01(\r\x12\x12\n\nnew_dst_id\x18\x04 \x01(\r\x12\'\n\x06g_type\x18\x05 \x01(\x0e\x32\x17.fibcapi.GroupMod.GType\"l\n\x07\x46\x46Hello\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"(\n\x06\x44pType\x12\x07\n\x03NOP\x10\x00\x12\x0b\n\x07OPENNSL\x10\x01\x12\x08\n\x04\x46\x46VS\x10\x02\"\xa0\x01\n\x06\x46\x46Port\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\x0f\n\x07hw_addr\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0e\n\x06\x63onfig\x18\x04 \x01(\r\x12\r\n\x05state\x18\x05 \x01(\r\x12\x0c\n\x04\x63urr\x18\x06 \x01(\r\x12\x12\n\nadvertised\x18\x07 \x01(\r\x12\x12\n\ncurr_speed\x18\x08 \x01(\r\x12\x11\n\tmax_speed\x18\t \x01(\r\"\x94\x02\n\x0b\x46\x46PortStats\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\x30\n\x06values\x18\x02 \x03(\x0b\x32 .fibcapi.FFPortStats.ValuesEntry\x12\x33\n\x08s_values\x18\x03 \x03(\x0b\x32!.fibcapi.FFPortStats.SValuesEntry\x1a-\n\x0bValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\x1a.\n\x0cSValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\".\n\x03\x43md\x12\x07\n\x03GET\x10\x00\x12\t\n\x05START\x10\x01\x12\x08\n\x04STOP\x10\x02\x12\t\n\x05RESET\x10\x03\"\x97\x03\n\x03OAM\x1a\x16\n\x14\x41uditRouteCntRequest\x1a#\n\x12\x41uditRouteCntReply\x12\r\n\x05\x63ount\x18\x01 \x01(\x04\x1a\x95\x01\n\x07Request\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12&\n\x08oam_type\x18\x03 \x01(\x0e\x32\x14.fibcapi.OAM.OAMType\x12<\n\x0f\x61udit_route_cnt\x18\x04 \x01(\x0b\x32!.fibcapi.OAM.AuditRouteCntRequestH\x00\x42\x06\n\x04\x62ody\x1a\x91\x01\n\x05Reply\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12&\n\x08oam_type\x18\x03 \x01(\x0e\x32\x14.fibcapi.OAM.OAMType\x12:\n\x0f\x61udit_route_cnt\x18\x04 \x01(\x0b\x32\x1f.fibcapi.OAM.AuditRouteCntReplyH\x00\x42\x06\n\x04\x62ody\"\'\n\x07OAMType\x12\x07\n\x03NOP\x10\x00\x12\x13\n\x0f\x41UDIT_ROUTE_CNT\x10\x01\"\xa0\t\n\x0b\x46\x46Multipart\x1aT\n\x0bPortRequest\x12\x0f\n\x07port_no\x18\x01 \x01(\r\x12\r\n\x05names\x18\x02 \x03(\t\x12%\n\x03\x63md\x18\x03 \x01(\x0e\x32\x18.fibcapi.FFPortStats.Cmd\x1a\x30\n\tPortReply\x12#\n\x05stats\x18\x01 \x03(\x0b\x32\x14.fibcapi.FFPortStats\x1a#\n\x0fPortDescRequest\x12\x10\n\x08internal\x18\x01 \x01(\x08\x1a@\n\rPortDescReply\x12\x10\n\x08internal\x18\x01 \x01(\x08\x12\x1d\n\x04port\x18\x02 \x03(\x0b\x32\x0f.fibcapi.FFPort\x1a\r\n\x0b\x46lowRequest\x1a,\n\tFlowReply\x12\x1f\n\x05\x66lows\x18\x01 \x03(\x0b\x32\x10.fibcapi.FlowMod\x1a\x12\n\x10GroupDescRequest\x1a\x33\n\x0eGroupDescReply\x12!\n\x06groups\x18\x01 \x03(\x0b\x32\x11.fibcapi.GroupMod\x1a\xaa\x02\n\x07Request\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12,\n\x07mp_type\x18\x02 \x01(\x0e\x32\x1b.fibcapi.FFMultipart.MpType\x12\x30\n\x04port\x18\x03 \x01(\x0b\x32 .fibcapi.FFMultipart.PortRequestH\x00\x12\x39\n\tport_desc\x18\x04 \x01(\x0b\x32$.fibcapi.FFMultipart.PortDescRequestH\x00\x12\x30\n\x04\x66low\x18\x05 \x01(\x0b\x32 .fibcapi.FFMultipart.FlowRequestH\x00\x12;\n\ngroup_desc\x18\x06 \x01(\x0b\x32%.fibcapi.FFMultipart.GroupDescRequestH\x00\x42\x06\n\x04\x62ody\x1a\xa0\x02\n\x05Reply\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12,\n\x07mp_type\x18\x02 \x01(\x0e\x32\x1b.fibcapi.FFMultipart.MpType\x12.\n\x04port\x18\x03 \x01(\x0b\x32\x1e.fibcapi.FFMultipart.PortReplyH\x00\x12\x37\n\tport_desc\x18\x04 \x01(\x0b\x32\".fibcapi.FFMultipart.PortDescReplyH\x00\x12.\n\x04\x66low\x18\x05 \x01(\x0b\x32\x1e.fibcapi.FFMultipart.FlowReplyH\x00\x12\x39\n\ngroup_desc\x18\x06 \x01(\x0b\x32#.fibcapi.FFMultipart.GroupDescReplyH\x00\x42\x06\n\x04\x62ody\"\xcb\x01\n\x06MpType\x12\x07\n\x03NOP\x10\x00\x12\x08\n\x04\x46LOW\x10\x01\x12\r\n\tAGGREGATE\x10\x02\x12\t\n\x05TABLE\x10\x03\x12\x08\n\x04PORT\x10\x04\x12\t\n\x05QUEUE\x10\x05\x12\t\n\x05GROUP\x10\x06\x12\x0e\n\nGROUP_DESC\x10\x07\x12\t\n\x05METER\x10\t\x12\x10\n\x0cMETER_CONFIG\x10\n\x12\x11\n\rMETER_FEATURE\x10\x0b\x12\x11\n\rTABLE_FEATURE\x10\x0c\x12\r\n\tPORT_DESC\x10\r\x12\x12\n\x0c\x45XPERIMENTER\x10\xff\xff\x03\":\n\nFFPacketIn\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\";\n\x0b\x46\x46PacketOut\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"I\n\x08\x46\x46Packet\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\r\n\x05re_id\x18\x03 \x01(\t\x12\x0e\n\x06ifname\x18\x04 \x01(\t\"\x95\x01\n\x0c\x46\x46PortStatus\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x1d\n\x04port\x18\x02 \x01(\x0b\x32\x0f.fibcapi.FFPort\x12,\n\x06reason\x18\x03 \x01(\x0e\x32\x1c.fibcapi.FFPortStatus.Reason\")\n\x06Reason\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\n\n\x06\x44\x45LETE\x10\x01\x12\n\n\x06MODIFY\x10\x02\"h\n\tFFPortMod\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\x0f\n\x07hw_addr\x18\x03 \x01(\t\x12*\n\x06status\x18\x04 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\"?\n\x0e\x46\x46L2AddrStatus\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x1e\n\x05\x61\x64\x64rs\x18\x02 \x03(\x0b\x32\x0f.fibcapi.L2Addr\"=\n\x0cL2AddrStatus\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\x1e\n\x05\x61\x64\x64rs\x18\x02 \x03(\x0b\x32\x0f.fibcapi.L2Addr\"\x9c\x01\n\x06L2Addr\x12\x0f\n\x07hw_addr\x18\x01 \x01(\t\x12\x10\n\x08vlan_vid\x18\x02 \x01(\r\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\x0e\n\x06ifname\x18\x04 \x01(\t\x12&\n\x06reason\x18\x05 \x01(\x0e\x32\x16.fibcapi.L2Addr.Reason\"&\n\x06Reason\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03\x41\x44\x44\x10\x01\x12\n\n\x06\x44\x45LETE\x10\x02*\x85\x03\n\x03\x46\x46M\x12\n\n\x06UNSPEC\x10\x00\x12\t\n\x05HELLO\x10\x01\x12\x0f\n\x0bPORT_STATUS\x10\x02\x12\x0f\n\x0bPORT_CONFIG\x10\x03\x12\x0c\n\x08\x46LOW_MOD\x10\x04\x12\r\n\tGROUP_MOD\x10\x05\x12\r\n\tDP_STATUS\x10\x06\x12\x0c\n\x08\x46\x46_HELLO\x10\x07\x12\x18\n\x14\x46\x46_MULTIPART_REQUEST\x10\x08\x12\x16\n\x12\x46\x46_MULTIPART_REPLY\x10\t\x12\x10\n\x0c\x46\x46_PACKET_IN\x10\n\x12\x11\n\rFF_PACKET_OUT\x10\x0b\x12\x12\n\x0e\x46\x46_PORT_STATUS\x10\x0c\x12\x0f\n\x0b\x46\x46_PORT_MOD\x10\r\x12\x11\n\rL2ADDR_STATUS\x10\x0e\x12\x14\n\x10\x46\x46_L2ADDR_STATUS\x10\x0f\x12\x10\n\x0c\x41P_MON_REPLY\x10\x11\x12\x10\n\x0cVM_MON_REPLT\x10\x12\x12\x10\n\x0c\x44P_MON_REPLY\x10\x13\x12\x10\n\x0cVS_MON_REPLY\x10\x14\x12\x0f\n\x0bOAM_REQUEST\x10\x15\x12\r\n\tOAM_REPLY\x10\x16\x62\x06proto3')
 )
 
 _FFM = _descriptor.EnumDescriptor(
@@ -120,8 +120,8 @@ _FFM = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7836,
-  serialized_end=8225,
+  serialized_start=10589,
+  serialized_end=10978,
 )
 _sym_db.RegisterEnumDescriptor(_FFM)
 
@@ -304,11 +304,15 @@ _LINKTYPE_TYPE = _descriptor.EnumDescriptor(
       name='BOND_SLAVE', index=5, number=5,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='VXLAN', index=6, number=6,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
   serialized_start=491,
-  serialized_end=576,
+  serialized_end=587,
 )
 _sym_db.RegisterEnumDescriptor(_LINKTYPE_TYPE)
 
@@ -337,8 +341,8 @@ _PORTCONFIG_CMD = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=770,
-  serialized_end=817,
+  serialized_start=781,
+  serialized_end=828,
 )
 _sym_db.RegisterEnumDescriptor(_PORTCONFIG_CMD)
 
@@ -375,8 +379,8 @@ _FLOWMOD_CMD = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1170,
-  serialized_end=1255,
+  serialized_start=1278,
+  serialized_end=1363,
 )
 _sym_db.RegisterEnumDescriptor(_FLOWMOD_CMD)
 
@@ -442,11 +446,15 @@ _FLOWMOD_TABLE = _descriptor.EnumDescriptor(
       name='POLICY_ACL', index=13, number=60,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SRV6_LOCAL_SID', index=14, number=70,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1258,
-  serialized_end=1482,
+  serialized_start=1366,
+  serialized_end=1610,
 )
 _sym_db.RegisterEnumDescriptor(_FLOWMOD_TABLE)
 
@@ -475,8 +483,8 @@ _GROUPMOD_CMD = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=770,
-  serialized_end=817,
+  serialized_start=781,
+  serialized_end=828,
 )
 _sym_db.RegisterEnumDescriptor(_GROUPMOD_CMD)
 
@@ -574,11 +582,19 @@ _GROUPMOD_GTYPE = _descriptor.EnumDescriptor(
       name='L2_UF_INTERFACE', index=21, number=176,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='VXLAN_TUNNEL', index=22, number=192,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SRV6_ENCAP', index=23, number=193,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1840,
-  serialized_end=2245,
+  serialized_start=2196,
+  serialized_end=2637,
 )
 _sym_db.RegisterEnumDescriptor(_GROUPMOD_GTYPE)
 
@@ -631,8 +647,8 @@ _VLANFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2501,
-  serialized_end=2675,
+  serialized_start=2893,
+  serialized_end=3067,
 )
 _sym_db.RegisterEnumDescriptor(_VLANFLOW_ACTION_NAME)
 
@@ -653,8 +669,8 @@ _TERMINATIONMACFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2982,
-  serialized_end=3012,
+  serialized_start=3374,
+  serialized_end=3404,
 )
 _sym_db.RegisterEnumDescriptor(_TERMINATIONMACFLOW_ACTION_NAME)
 
@@ -739,8 +755,8 @@ _MPLSFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=3294,
-  serialized_end=3619,
+  serialized_start=3686,
+  serialized_end=4011,
 )
 _sym_db.RegisterEnumDescriptor(_MPLSFLOW_ACTION_NAME)
 
@@ -769,8 +785,8 @@ _UNICASTROUTINGFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=3972,
-  serialized_end=4034,
+  serialized_start=4364,
+  serialized_end=4426,
 )
 _sym_db.RegisterEnumDescriptor(_UNICASTROUTINGFLOW_ACTION_NAME)
 
@@ -795,8 +811,8 @@ _UNICASTROUTINGFLOW_ORIGIN = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=4036,
-  serialized_end=4078,
+  serialized_start=4428,
+  serialized_end=4470,
 )
 _sym_db.RegisterEnumDescriptor(_UNICASTROUTINGFLOW_ORIGIN)
 
@@ -817,11 +833,57 @@ _BRIDGINGFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2982,
-  serialized_end=3012,
+  serialized_start=3374,
+  serialized_end=3404,
 )
 _sym_db.RegisterEnumDescriptor(_BRIDGINGFLOW_ACTION_NAME)
 
+_SRV6LOCALSIDFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
+  name='Name',
+  full_name='fibcapi.SRv6LocalSidFlow.Action.Name',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='UNSPEC', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_X', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_T', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_DX6', index=4, number=5,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_DX4', index=5, number=6,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_DT6', index=6, number=7,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='END_DT4', index=7, number=8,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5240,
+  serialized_end=5341,
+)
+_sym_db.RegisterEnumDescriptor(_SRV6LOCALSIDFLOW_ACTION_NAME)
+
 _POLICYACLFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
   name='Name',
   full_name='fibcapi.PolicyACLFlow.Action.Name',
@@ -836,14 +898,136 @@ _POLICYACLFLOW_ACTION_NAME = _descriptor.EnumDescriptor(
       name='OUTPUT', index=1, number=1,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SET_VRF', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DROP', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PERMIT', index=4, number=4,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MIRROR', index=5, number=5,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SET_QUEUE', index=6, number=6,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='SET_DSCP', index=7, number=7,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2982,
-  serialized_end=3012,
+  serialized_start=5851,
+  serialized_end=5957,
 )
 _sym_db.RegisterEnumDescriptor(_POLICYACLFLOW_ACTION_NAME)
 
+_BONDATTRS_MODE = _descriptor.EnumDescriptor(
+  name='Mode',
+  full_name='fibcapi.BondAttrs.Mode',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='BALANCE_RR', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ACTIVE_BACKUP', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BALANCE_XOR', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BROADCAST', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='IEEE_802_3AD', index=4, number=4,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BALANCE_TLB', index=5, number=5,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BALANCE_ALB', index=6, number=6,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6326,
+  serialized_end=6451,
+)
+_sym_db.RegisterEnumDescriptor(_BONDATTRS_MODE)
+
+_BONDATTRS_HASHPOLICY = _descriptor.EnumDescriptor(
+  name='HashPolicy',
+  full_name='fibcapi.BondAttrs.HashPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='LAYER2', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LAYER3_4', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LAYER2_3', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ENCAP2_3', index=3, number=3,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ENCAP3_4', index=4, number=4,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6453,
+  serialized_end=6533,
+)
+_sym_db.RegisterEnumDescriptor(_BONDATTRS_HASHPOLICY)
+
+_SRV6ENCAPGROUP_MODE = _descriptor.EnumDescriptor(
+  name='Mode',
+  full_name='fibcapi.SRv6EncapGroup.Mode',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='INLINE', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ENCAP', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=7432,
+  serialized_end=7461,
+)
+_sym_db.RegisterEnumDescriptor(_SRV6ENCAPGROUP_MODE)
+
 _FFHELLO_DPTYPE = _descriptor.EnumDescriptor(
   name='DpType',
   full_name='fibcapi.FFHello.DpType',
@@ -865,8 +1049,8 @@ _FFHELLO_DPTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=5365,
-  serialized_end=5405,
+  serialized_start=7766,
+  serialized_end=7806,
 )
 _sym_db.RegisterEnumDescriptor(_FFHELLO_DPTYPE)
 
@@ -895,8 +1079,8 @@ _FFPORTSTATS_CMD = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=5801,
-  serialized_end=5847,
+  serialized_start=8202,
+  serialized_end=8248,
 )
 _sym_db.RegisterEnumDescriptor(_FFPORTSTATS_CMD)
 
@@ -917,8 +1101,8 @@ _OAM_OAMTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6218,
-  serialized_end=6257,
+  serialized_start=8619,
+  serialized_end=8658,
 )
 _sym_db.RegisterEnumDescriptor(_OAM_OAMTYPE)
 
@@ -987,8 +1171,8 @@ _FFMULTIPART_MPTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6889,
-  serialized_end=7092,
+  serialized_start=9642,
+  serialized_end=9845,
 )
 _sym_db.RegisterEnumDescriptor(_FFMULTIPART_MPTYPE)
 
@@ -1013,8 +1197,8 @@ _FFPORTSTATUS_REASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7399,
-  serialized_end=7440,
+  serialized_start=10152,
+  serialized_end=10193,
 )
 _sym_db.RegisterEnumDescriptor(_FFPORTSTATUS_REASON)
 
@@ -1039,8 +1223,8 @@ _L2ADDR_REASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7795,
-  serialized_end=7833,
+  serialized_start=10548,
+  serialized_end=10586,
 )
 _sym_db.RegisterEnumDescriptor(_L2ADDR_REASON)
 
@@ -1239,7 +1423,7 @@ _LINKTYPE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=479,
-  serialized_end=576,
+  serialized_end=587,
 )
 
 
@@ -1319,8 +1503,8 @@ _PORTCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=579,
-  serialized_end=817,
+  serialized_start=590,
+  serialized_end=828,
 )
 
 
@@ -1394,6 +1578,20 @@ _FLOWMOD = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mcast', full_name='fibcapi.FlowMod.mcast', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='srv6_local', full_name='fibcapi.FlowMod.srv6_local', index=10,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1411,8 +1609,8 @@ _FLOWMOD = _descriptor.Descriptor(
       name='entry', full_name='fibcapi.FlowMod.entry',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=820,
-  serialized_end=1491,
+  serialized_start=831,
+  serialized_end=1619,
 )
 
 
@@ -1472,6 +1670,41 @@ _GROUPMOD = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='l3_ecmp', full_name='fibcapi.GroupMod.l3_ecmp', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='l3_mcast', full_name='fibcapi.GroupMod.l3_mcast', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='l2_overlay', full_name='fibcapi.GroupMod.l2_overlay', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vxlan_tunnel', full_name='fibcapi.GroupMod.vxlan_tunnel', index=10,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='srv6_encap', full_name='fibcapi.GroupMod.srv6_encap', index=11,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1489,8 +1722,8 @@ _GROUPMOD = _descriptor.Descriptor(
       name='entry', full_name='fibcapi.GroupMod.entry',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=1494,
-  serialized_end=2254,
+  serialized_start=1622,
+  serialized_end=2646,
 )
 
 
@@ -1534,8 +1767,8 @@ _VLANFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2372,
-  serialized_end=2427,
+  serialized_start=2764,
+  serialized_end=2819,
 )
 
 _VLANFLOW_ACTION = _descriptor.Descriptor(
@@ -1572,8 +1805,8 @@ _VLANFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2430,
-  serialized_end=2675,
+  serialized_start=2822,
+  serialized_end=3067,
 )
 
 _VLANFLOW = _descriptor.Descriptor(
@@ -1616,8 +1849,8 @@ _VLANFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2257,
-  serialized_end=2675,
+  serialized_start=2649,
+  serialized_end=3067,
 )
 
 
@@ -1668,8 +1901,8 @@ _TERMINATIONMACFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2823,
-  serialized_end=2900,
+  serialized_start=3215,
+  serialized_end=3292,
 )
 
 _TERMINATIONMACFLOW_ACTION = _descriptor.Descriptor(
@@ -1706,8 +1939,8 @@ _TERMINATIONMACFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2902,
-  serialized_end=3012,
+  serialized_start=3294,
+  serialized_end=3404,
 )
 
 _TERMINATIONMACFLOW = _descriptor.Descriptor(
@@ -1750,8 +1983,8 @@ _TERMINATIONMACFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2678,
-  serialized_end=3012,
+  serialized_start=3070,
+  serialized_end=3404,
 )
 
 
@@ -1788,8 +2021,8 @@ _MPLSFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3185,
-  serialized_end=3220,
+  serialized_start=3577,
+  serialized_end=3612,
 )
 
 _MPLSFLOW_ACTION = _descriptor.Descriptor(
@@ -1826,8 +2059,8 @@ _MPLSFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3223,
-  serialized_end=3619,
+  serialized_start=3615,
+  serialized_end=4011,
 )
 
 _MPLSFLOW = _descriptor.Descriptor(
@@ -1884,8 +2117,8 @@ _MPLSFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3015,
-  serialized_end=3619,
+  serialized_start=3407,
+  serialized_end=4011,
 )
 
 
@@ -1929,8 +2162,8 @@ _UNICASTROUTINGFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3801,
-  serialized_end=3889,
+  serialized_start=4193,
+  serialized_end=4281,
 )
 
 _UNICASTROUTINGFLOW_ACTION = _descriptor.Descriptor(
@@ -1967,8 +2200,8 @@ _UNICASTROUTINGFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3892,
-  serialized_end=4034,
+  serialized_start=4284,
+  serialized_end=4426,
 )
 
 _UNICASTROUTINGFLOW = _descriptor.Descriptor(
@@ -2019,8 +2252,104 @@ _UNICASTROUTINGFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3622,
-  serialized_end=4078,
+  serialized_start=4014,
+  serialized_end=4470,
+)
+
+
+_MULTICASTROUTINGFLOW_MATCH = _descriptor.Descriptor(
+  name='Match',
+  full_name='fibcapi.MulticastRoutingFlow.Match',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ip_src', full_name='fibcapi.MulticastRoutingFlow.Match.ip_src', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_dst', full_name='fibcapi.MulticastRoutingFlow.Match.ip_dst', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vrf', full_name='fibcapi.MulticastRoutingFlow.Match.vrf', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='fibcapi.MulticastRoutingFlow.Match.vlan_vid', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4604,
+  serialized_end=4674,
+)
+
+_MULTICASTROUTINGFLOW = _descriptor.Descriptor(
+  name='MulticastRoutingFlow',
+  full_name='fibcapi.MulticastRoutingFlow',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='match', full_name='fibcapi.MulticastRoutingFlow.match', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='g_type', full_name='fibcapi.MulticastRoutingFlow.g_type', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='g_id', full_name='fibcapi.MulticastRoutingFlow.g_id', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_MULTICASTROUTINGFLOW_MATCH, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4473,
+  serialized_end=4674,
 )
 
 
@@ -2064,8 +2393,8 @@ _BRIDGINGFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4187,
-  serialized_end=4248,
+  serialized_start=4783,
+  serialized_end=4844,
 )
 
 _BRIDGINGFLOW_ACTION = _descriptor.Descriptor(
@@ -2102,8 +2431,8 @@ _BRIDGINGFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4250,
-  serialized_end=4354,
+  serialized_start=4846,
+  serialized_end=4950,
 )
 
 _BRIDGINGFLOW = _descriptor.Descriptor(
@@ -2139,35 +2468,162 @@ _BRIDGINGFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4081,
-  serialized_end=4354,
+  serialized_start=4677,
+  serialized_end=4950,
 )
 
 
-_POLICYACLFLOW_MATCH = _descriptor.Descriptor(
+_SRV6LOCALSIDFLOW_MATCH = _descriptor.Descriptor(
   name='Match',
-  full_name='fibcapi.PolicyACLFlow.Match',
+  full_name='fibcapi.SRv6LocalSidFlow.Match',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='ip_dst', full_name='fibcapi.PolicyACLFlow.Match.ip_dst', index=0,
+      name='sid', full_name='fibcapi.SRv6LocalSidFlow.Match.sid', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vrf', full_name='fibcapi.PolicyACLFlow.Match.vrf', index=1,
+      name='vrf', full_name='fibcapi.SRv6LocalSidFlow.Match.vrf', index=1,
       number=2, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='eth_type', full_name='fibcapi.PolicyACLFlow.Match.eth_type', index=2,
-      number=3, type=13, cpp_type=3, label=1,
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5126,
+  serialized_end=5159,
+)
+
+_SRV6LOCALSIDFLOW_ACTION = _descriptor.Descriptor(
+  name='Action',
+  full_name='fibcapi.SRv6LocalSidFlow.Action',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='fibcapi.SRv6LocalSidFlow.Action.name', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='fibcapi.SRv6LocalSidFlow.Action.value', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _SRV6LOCALSIDFLOW_ACTION_NAME,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5162,
+  serialized_end=5341,
+)
+
+_SRV6LOCALSIDFLOW = _descriptor.Descriptor(
+  name='SRv6LocalSidFlow',
+  full_name='fibcapi.SRv6LocalSidFlow',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='match', full_name='fibcapi.SRv6LocalSidFlow.match', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='action', full_name='fibcapi.SRv6LocalSidFlow.action', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='g_type', full_name='fibcapi.SRv6LocalSidFlow.g_type', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='g_id', full_name='fibcapi.SRv6LocalSidFlow.g_id', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SRV6LOCALSIDFLOW_MATCH, _SRV6LOCALSIDFLOW_ACTION, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4953,
+  serialized_end=5341,
+)
+
+
+_POLICYACLFLOW_MATCH = _descriptor.Descriptor(
+  name='Match',
+  full_name='fibcapi.PolicyACLFlow.Match',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ip_dst', full_name='fibcapi.PolicyACLFlow.Match.ip_dst', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vrf', full_name='fibcapi.PolicyACLFlow.Match.vrf', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eth_type', full_name='fibcapi.PolicyACLFlow.Match.eth_type', index=2,
+      number=3, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -2207,6 +2663,48 @@ _POLICYACLFLOW_MATCH = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_src', full_name='fibcapi.PolicyACLFlow.Match.ip_src', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='fibcapi.PolicyACLFlow.Match.vlan_vid', index=9,
+      number=10, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_dscp', full_name='fibcapi.PolicyACLFlow.Match.ip_dscp', index=10,
+      number=11, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_dscp_mask', full_name='fibcapi.PolicyACLFlow.Match.ip_dscp_mask', index=11,
+      number=12, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tp_src_mask', full_name='fibcapi.PolicyACLFlow.Match.tp_src_mask', index=12,
+      number=13, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tp_dst_mask', full_name='fibcapi.PolicyACLFlow.Match.tp_dst_mask', index=13,
+      number=14, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -2219,8 +2717,8 @@ _POLICYACLFLOW_MATCH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4467,
-  serialized_end=4605,
+  serialized_start=5520,
+  serialized_end=5773,
 )
 
 _POLICYACLFLOW_ACTION = _descriptor.Descriptor(
@@ -2257,8 +2755,8 @@ _POLICYACLFLOW_ACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4607,
-  serialized_end=4712,
+  serialized_start=5776,
+  serialized_end=5957,
 )
 
 _POLICYACLFLOW = _descriptor.Descriptor(
@@ -2282,6 +2780,20 @@ _POLICYACLFLOW = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='priority', full_name='fibcapi.PolicyACLFlow.priority', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='actions', full_name='fibcapi.PolicyACLFlow.actions', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -2294,8 +2806,8 @@ _POLICYACLFLOW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4357,
-  serialized_end=4712,
+  serialized_start=5344,
+  serialized_end=5957,
 )
 
 
@@ -2335,23 +2847,416 @@ _L2INTERFACEGROUP = _descriptor.Descriptor(
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='mtu', full_name='fibcapi.L2InterfaceGroup.mtu', index=4,
-      number=5, type=13, cpp_type=3, label=1,
+      name='mtu', full_name='fibcapi.L2InterfaceGroup.mtu', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vrf', full_name='fibcapi.L2InterfaceGroup.vrf', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='master', full_name='fibcapi.L2InterfaceGroup.master', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bond', full_name='fibcapi.L2InterfaceGroup.bond', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bond_slave', full_name='fibcapi.L2InterfaceGroup.bond_slave', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5960,
+  serialized_end=6177,
+)
+
+
+_BONDATTRS = _descriptor.Descriptor(
+  name='BondAttrs',
+  full_name='fibcapi.BondAttrs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='fibcapi.BondAttrs.mode', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hash_policy', full_name='fibcapi.BondAttrs.hash_policy', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='min_links', full_name='fibcapi.BondAttrs.min_links', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='aggregator_id', full_name='fibcapi.BondAttrs.aggregator_id', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _BONDATTRS_MODE,
+    _BONDATTRS_HASHPOLICY,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6180,
+  serialized_end=6533,
+)
+
+
+_BONDSLAVEATTRS = _descriptor.Descriptor(
+  name='BondSlaveAttrs',
+  full_name='fibcapi.BondSlaveAttrs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='active', full_name='fibcapi.BondSlaveAttrs.active', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='link_up', full_name='fibcapi.BondSlaveAttrs.link_up', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='aggregator_id', full_name='fibcapi.BondSlaveAttrs.aggregator_id', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='collecting', full_name='fibcapi.BondSlaveAttrs.collecting', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='distributing', full_name='fibcapi.BondSlaveAttrs.distributing', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6535,
+  serialized_end=6649,
+)
+
+
+_L3UNICASTGROUP = _descriptor.Descriptor(
+  name='L3UnicastGroup',
+  full_name='fibcapi.L3UnicastGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ne_id', full_name='fibcapi.L3UnicastGroup.ne_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_id', full_name='fibcapi.L3UnicastGroup.port_id', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='fibcapi.L3UnicastGroup.vlan_vid', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eth_dst', full_name='fibcapi.L3UnicastGroup.eth_dst', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eth_src', full_name='fibcapi.L3UnicastGroup.eth_src', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='phy_port_id', full_name='fibcapi.L3UnicastGroup.phy_port_id', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tun_type', full_name='fibcapi.L3UnicastGroup.tun_type', index=6,
+      number=7, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tun_remote', full_name='fibcapi.L3UnicastGroup.tun_remote', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tun_local', full_name='fibcapi.L3UnicastGroup.tun_local', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6652,
+  serialized_end=6856,
+)
+
+
+_L3ECMPGROUP = _descriptor.Descriptor(
+  name='L3EcmpGroup',
+  full_name='fibcapi.L3EcmpGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ecmp_id', full_name='fibcapi.L3EcmpGroup.ecmp_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ne_ids', full_name='fibcapi.L3EcmpGroup.ne_ids', index=1,
+      number=2, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6858,
+  serialized_end=6904,
+)
+
+
+_L3MULTICASTGROUP_PORT = _descriptor.Descriptor(
+  name='Port',
+  full_name='fibcapi.L3MulticastGroup.Port',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='port_id', full_name='fibcapi.L3MulticastGroup.Port.port_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='fibcapi.L3MulticastGroup.Port.vlan_vid', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='eth_src', full_name='fibcapi.L3MulticastGroup.Port.eth_src', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7007,
+  serialized_end=7065,
+)
+
+_L3MULTICASTGROUP = _descriptor.Descriptor(
+  name='L3MulticastGroup',
+  full_name='fibcapi.L3MulticastGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mc_id', full_name='fibcapi.L3MulticastGroup.mc_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='fibcapi.L3MulticastGroup.vlan_vid', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='fibcapi.L3MulticastGroup.ports', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_L3MULTICASTGROUP_PORT, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6907,
+  serialized_end=7065,
+)
+
+
+_L2OVERLAYGROUP = _descriptor.Descriptor(
+  name='L2OverlayGroup',
+  full_name='fibcapi.L2OverlayGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tunnel_id', full_name='fibcapi.L2OverlayGroup.tunnel_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='fibcapi.L2OverlayGroup.index', index=1,
+      number=2, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vrf', full_name='fibcapi.L2InterfaceGroup.vrf', index=5,
-      number=6, type=13, cpp_type=3, label=1,
+      name='vlan_vid', full_name='fibcapi.L2OverlayGroup.vlan_vid', index=2,
+      number=3, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='master', full_name='fibcapi.L2InterfaceGroup.master', index=6,
-      number=7, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
+      name='tun_ports', full_name='fibcapi.L2OverlayGroup.tun_ports', index=3,
+      number=4, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -2367,78 +3272,130 @@ _L2INTERFACEGROUP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4715,
-  serialized_end=4853,
+  serialized_start=7067,
+  serialized_end=7154,
 )
 
 
-_L3UNICASTGROUP = _descriptor.Descriptor(
-  name='L3UnicastGroup',
-  full_name='fibcapi.L3UnicastGroup',
+_VXLANTUNNEL = _descriptor.Descriptor(
+  name='VxlanTunnel',
+  full_name='fibcapi.VxlanTunnel',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='ne_id', full_name='fibcapi.L3UnicastGroup.ne_id', index=0,
+      name='tun_port_id', full_name='fibcapi.VxlanTunnel.tun_port_id', index=0,
       number=1, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='port_id', full_name='fibcapi.L3UnicastGroup.port_id', index=1,
+      name='vni', full_name='fibcapi.VxlanTunnel.vni', index=1,
       number=2, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vlan_vid', full_name='fibcapi.L3UnicastGroup.vlan_vid', index=2,
+      name='vlan_vid', full_name='fibcapi.VxlanTunnel.vlan_vid', index=2,
       number=3, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='eth_dst', full_name='fibcapi.L3UnicastGroup.eth_dst', index=3,
+      name='local', full_name='fibcapi.VxlanTunnel.local', index=3,
       number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='eth_src', full_name='fibcapi.L3UnicastGroup.eth_src', index=4,
+      name='remote', full_name='fibcapi.VxlanTunnel.remote', index=4,
       number=5, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='phy_port_id', full_name='fibcapi.L3UnicastGroup.phy_port_id', index=5,
+      name='udp_port', full_name='fibcapi.VxlanTunnel.udp_port', index=5,
       number=6, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='tun_type', full_name='fibcapi.L3UnicastGroup.tun_type', index=6,
-      number=7, type=14, cpp_type=8, label=1,
+      name='ttl', full_name='fibcapi.VxlanTunnel.ttl', index=6,
+      number=7, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='tun_remote', full_name='fibcapi.L3UnicastGroup.tun_remote', index=7,
-      number=8, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='ne_id', full_name='fibcapi.VxlanTunnel.ne_id', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='tun_local', full_name='fibcapi.L3UnicastGroup.tun_local', index=8,
-      number=9, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='port_id', full_name='fibcapi.VxlanTunnel.port_id', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7157,
+  serialized_end=7316,
+)
+
+
+_SRV6ENCAPGROUP = _descriptor.Descriptor(
+  name='SRv6EncapGroup',
+  full_name='fibcapi.SRv6EncapGroup',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='encap_id', full_name='fibcapi.SRv6EncapGroup.encap_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='fibcapi.SRv6EncapGroup.mode', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='segments', full_name='fibcapi.SRv6EncapGroup.segments', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ne_id', full_name='fibcapi.SRv6EncapGroup.ne_id', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -2447,6 +3404,7 @@ _L3UNICASTGROUP = _descriptor.Descriptor(
   ],
   nested_types=[],
   enum_types=[
+    _SRV6ENCAPGROUP_MODE,
   ],
   serialized_options=None,
   is_extendable=False,
@@ -2454,8 +3412,8 @@ _L3UNICASTGROUP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4856,
-  serialized_end=5060,
+  serialized_start=7319,
+  serialized_end=7461,
 )
 
 
@@ -2513,8 +3471,8 @@ _MPLSINTERFACEGROUP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5062,
-  serialized_end=5166,
+  serialized_start=7463,
+  serialized_end=7567,
 )
 
 
@@ -2572,8 +3530,8 @@ _MPLSLABELGROUP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5168,
-  serialized_end=5295,
+  serialized_start=7569,
+  serialized_end=7696,
 )
 
 
@@ -2611,8 +3569,8 @@ _FFHELLO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5297,
-  serialized_end=5405,
+  serialized_start=7698,
+  serialized_end=7806,
 )
 
 
@@ -2698,8 +3656,8 @@ _FFPORT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5408,
-  serialized_end=5568,
+  serialized_start=7809,
+  serialized_end=7969,
 )
 
 
@@ -2736,8 +3694,8 @@ _FFPORTSTATS_VALUESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5706,
-  serialized_end=5751,
+  serialized_start=8107,
+  serialized_end=8152,
 )
 
 _FFPORTSTATS_SVALUESENTRY = _descriptor.Descriptor(
@@ -2773,8 +3731,8 @@ _FFPORTSTATS_SVALUESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5753,
-  serialized_end=5799,
+  serialized_start=8154,
+  serialized_end=8200,
 )
 
 _FFPORTSTATS = _descriptor.Descriptor(
@@ -2818,8 +3776,8 @@ _FFPORTSTATS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5571,
-  serialized_end=5847,
+  serialized_start=7972,
+  serialized_end=8248,
 )
 
 
@@ -2842,8 +3800,8 @@ _OAM_AUDITROUTECNTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5857,
-  serialized_end=5879,
+  serialized_start=8258,
+  serialized_end=8280,
 )
 
 _OAM_AUDITROUTECNTREPLY = _descriptor.Descriptor(
@@ -2872,8 +3830,8 @@ _OAM_AUDITROUTECNTREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5881,
-  serialized_end=5916,
+  serialized_start=8282,
+  serialized_end=8317,
 )
 
 _OAM_REQUEST = _descriptor.Descriptor(
@@ -2926,8 +3884,8 @@ _OAM_REQUEST = _descriptor.Descriptor(
       name='body', full_name='fibcapi.OAM.Request.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=5919,
-  serialized_end=6068,
+  serialized_start=8320,
+  serialized_end=8469,
 )
 
 _OAM_REPLY = _descriptor.Descriptor(
@@ -2980,8 +3938,8 @@ _OAM_REPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.OAM.Reply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=6071,
-  serialized_end=6216,
+  serialized_start=8472,
+  serialized_end=8617,
 )
 
 _OAM = _descriptor.Descriptor(
@@ -3004,8 +3962,8 @@ _OAM = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5850,
-  serialized_end=6257,
+  serialized_start=8251,
+  serialized_end=8658,
 )
 
 
@@ -3049,8 +4007,8 @@ _FFMULTIPART_PORTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6275,
-  serialized_end=6359,
+  serialized_start=8676,
+  serialized_end=8760,
 )
 
 _FFMULTIPART_PORTREPLY = _descriptor.Descriptor(
@@ -3079,8 +4037,8 @@ _FFMULTIPART_PORTREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6361,
-  serialized_end=6409,
+  serialized_start=8762,
+  serialized_end=8810,
 )
 
 _FFMULTIPART_PORTDESCREQUEST = _descriptor.Descriptor(
@@ -3109,8 +4067,8 @@ _FFMULTIPART_PORTDESCREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6411,
-  serialized_end=6446,
+  serialized_start=8812,
+  serialized_end=8847,
 )
 
 _FFMULTIPART_PORTDESCREPLY = _descriptor.Descriptor(
@@ -3146,8 +4104,114 @@ _FFMULTIPART_PORTDESCREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6448,
-  serialized_end=6512,
+  serialized_start=8849,
+  serialized_end=8913,
+)
+
+_FFMULTIPART_FLOWREQUEST = _descriptor.Descriptor(
+  name='FlowRequest',
+  full_name='fibcapi.FFMultipart.FlowRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8915,
+  serialized_end=8928,
+)
+
+_FFMULTIPART_FLOWREPLY = _descriptor.Descriptor(
+  name='FlowReply',
+  full_name='fibcapi.FFMultipart.FlowReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='flows', full_name='fibcapi.FFMultipart.FlowReply.flows', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8930,
+  serialized_end=8974,
+)
+
+_FFMULTIPART_GROUPDESCREQUEST = _descriptor.Descriptor(
+  name='GroupDescRequest',
+  full_name='fibcapi.FFMultipart.GroupDescRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8976,
+  serialized_end=8994,
+)
+
+_FFMULTIPART_GROUPDESCREPLY = _descriptor.Descriptor(
+  name='GroupDescReply',
+  full_name='fibcapi.FFMultipart.GroupDescReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='groups', full_name='fibcapi.FFMultipart.GroupDescReply.groups', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8996,
+  serialized_end=9047,
 )
 
 _FFMULTIPART_REQUEST = _descriptor.Descriptor(
@@ -3185,6 +4249,20 @@ _FFMULTIPART_REQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flow', full_name='fibcapi.FFMultipart.Request.flow', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group_desc', full_name='fibcapi.FFMultipart.Request.group_desc', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -3200,8 +4278,8 @@ _FFMULTIPART_REQUEST = _descriptor.Descriptor(
       name='body', full_name='fibcapi.FFMultipart.Request.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=6515,
-  serialized_end=6702,
+  serialized_start=9050,
+  serialized_end=9348,
 )
 
 _FFMULTIPART_REPLY = _descriptor.Descriptor(
@@ -3239,6 +4317,20 @@ _FFMULTIPART_REPLY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flow', full_name='fibcapi.FFMultipart.Reply.flow', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group_desc', full_name='fibcapi.FFMultipart.Reply.group_desc', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -3254,8 +4346,8 @@ _FFMULTIPART_REPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.FFMultipart.Reply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=6705,
-  serialized_end=6886,
+  serialized_start=9351,
+  serialized_end=9639,
 )
 
 _FFMULTIPART = _descriptor.Descriptor(
@@ -3268,7 +4360,7 @@ _FFMULTIPART = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_FFMULTIPART_PORTREQUEST, _FFMULTIPART_PORTREPLY, _FFMULTIPART_PORTDESCREQUEST, _FFMULTIPART_PORTDESCREPLY, _FFMULTIPART_REQUEST, _FFMULTIPART_REPLY, ],
+  nested_types=[_FFMULTIPART_PORTREQUEST, _FFMULTIPART_PORTREPLY, _FFMULTIPART_PORTDESCREQUEST, _FFMULTIPART_PORTDESCREPLY, _FFMULTIPART_FLOWREQUEST, _FFMULTIPART_FLOWREPLY, _FFMULTIPART_GROUPDESCREQUEST, _FFMULTIPART_GROUPDESCREPLY, _FFMULTIPART_REQUEST, _FFMULTIPART_REPLY, ],
   enum_types=[
     _FFMULTIPART_MPTYPE,
   ],
@@ -3278,8 +4370,8 @@ _FFMULTIPART = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6260,
-  serialized_end=7092,
+  serialized_start=8661,
+  serialized_end=9845,
 )
 
 
@@ -3323,8 +4415,8 @@ _FFPACKETIN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7094,
-  serialized_end=7152,
+  serialized_start=9847,
+  serialized_end=9905,
 )
 
 
@@ -3368,8 +4460,8 @@ _FFPACKETOUT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7154,
-  serialized_end=7213,
+  serialized_start=9907,
+  serialized_end=9966,
 )
 
 
@@ -3420,8 +4512,8 @@ _FFPACKET = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7215,
-  serialized_end=7288,
+  serialized_start=9968,
+  serialized_end=10041,
 )
 
 
@@ -3466,8 +4558,8 @@ _FFPORTSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7291,
-  serialized_end=7440,
+  serialized_start=10044,
+  serialized_end=10193,
 )
 
 
@@ -3518,8 +4610,8 @@ _FFPORTMOD = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7442,
-  serialized_end=7546,
+  serialized_start=10195,
+  serialized_end=10299,
 )
 
 
@@ -3556,8 +4648,8 @@ _FFL2ADDRSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7548,
-  serialized_end=7611,
+  serialized_start=10301,
+  serialized_end=10364,
 )
 
 
@@ -3594,8 +4686,8 @@ _L2ADDRSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7613,
-  serialized_end=7674,
+  serialized_start=10366,
+  serialized_end=10427,
 )
 
 
@@ -3654,8 +4746,8 @@ _L2ADDR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7677,
-  serialized_end=7833,
+  serialized_start=10430,
+  serialized_end=10586,
 )
 
 _DPSTATUS.fields_by_name['status'].enum_type = _DPSTATUS_STATUS
@@ -3676,6 +4768,8 @@ _FLOWMOD.fields_by_name['mpls1'].message_type = _MPLSFLOW
 _FLOWMOD.fields_by_name['unicast'].message_type = _UNICASTROUTINGFLOW
 _FLOWMOD.fields_by_name['bridging'].message_type = _BRIDGINGFLOW
 _FLOWMOD.fields_by_name['acl'].message_type = _POLICYACLFLOW
+_FLOWMOD.fields_by_name['mcast'].message_type = _MULTICASTROUTINGFLOW
+_FLOWMOD.fields_by_name['srv6_local'].message_type = _SRV6LOCALSIDFLOW
 _FLOWMOD_CMD.containing_type = _FLOWMOD
 _FLOWMOD_TABLE.containing_type = _FLOWMOD
 _FLOWMOD.oneofs_by_name['entry'].fields.append(
@@ -3696,12 +4790,23 @@ _FLOWMOD.fields_by_name['bridging'].containing_oneof = _FLOWMOD.oneofs_by_name['
 _FLOWMOD.oneofs_by_name['entry'].fields.append(
   _FLOWMOD.fields_by_name['acl'])
 _FLOWMOD.fields_by_name['acl'].containing_oneof = _FLOWMOD.oneofs_by_name['entry']
+_FLOWMOD.oneofs_by_name['entry'].fields.append(
+  _FLOWMOD.fields_by_name['mcast'])
+_FLOWMOD.fields_by_name['mcast'].containing_oneof = _FLOWMOD.oneofs_by_name['entry']
+_FLOWMOD.oneofs_by_name['entry'].fields.append(
+  _FLOWMOD.fields_by_name['srv6_local'])
+_FLOWMOD.fields_by_name['srv6_local'].containing_oneof = _FLOWMOD.oneofs_by_name['entry']
 _GROUPMOD.fields_by_name['cmd'].enum_type = _GROUPMOD_CMD
 _GROUPMOD.fields_by_name['g_type'].enum_type = _GROUPMOD_GTYPE
 _GROUPMOD.fields_by_name['l2_iface'].message_type = _L2INTERFACEGROUP
 _GROUPMOD.fields_by_name['l3_unicast'].message_type = _L3UNICASTGROUP
 _GROUPMOD.fields_by_name['mpls_iface'].message_type = _MPLSINTERFACEGROUP
 _GROUPMOD.fields_by_name['mpls_label'].message_type = _MPLSLABELGROUP
+_GROUPMOD.fields_by_name['l3_ecmp'].message_type = _L3ECMPGROUP
+_GROUPMOD.fields_by_name['l3_mcast'].message_type = _L3MULTICASTGROUP
+_GROUPMOD.fields_by_name['l2_overlay'].message_type = _L2OVERLAYGROUP
+_GROUPMOD.fields_by_name['vxlan_tunnel'].message_type = _VXLANTUNNEL
+_GROUPMOD.fields_by_name['srv6_encap'].message_type = _SRV6ENCAPGROUP
 _GROUPMOD_CMD.containing_type = _GROUPMOD
 _GROUPMOD_GTYPE.containing_type = _GROUPMOD
 _GROUPMOD.oneofs_by_name['entry'].fields.append(
@@ -3716,6 +4821,21 @@ _GROUPMOD.fields_by_name['mpls_iface'].containing_oneof = _GROUPMOD.oneofs_by_na
 _GROUPMOD.oneofs_by_name['entry'].fields.append(
   _GROUPMOD.fields_by_name['mpls_label'])
 _GROUPMOD.fields_by_name['mpls_label'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
+_GROUPMOD.oneofs_by_name['entry'].fields.append(
+  _GROUPMOD.fields_by_name['l3_ecmp'])
+_GROUPMOD.fields_by_name['l3_ecmp'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
+_GROUPMOD.oneofs_by_name['entry'].fields.append(
+  _GROUPMOD.fields_by_name['l3_mcast'])
+_GROUPMOD.fields_by_name['l3_mcast'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
+_GROUPMOD.oneofs_by_name['entry'].fields.append(
+  _GROUPMOD.fields_by_name['l2_overlay'])
+_GROUPMOD.fields_by_name['l2_overlay'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
+_GROUPMOD.oneofs_by_name['entry'].fields.append(
+  _GROUPMOD.fields_by_name['vxlan_tunnel'])
+_GROUPMOD.fields_by_name['vxlan_tunnel'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
+_GROUPMOD.oneofs_by_name['entry'].fields.append(
+  _GROUPMOD.fields_by_name['srv6_encap'])
+_GROUPMOD.fields_by_name['srv6_encap'].containing_oneof = _GROUPMOD.oneofs_by_name['entry']
 _VLANFLOW_MATCH.containing_type = _VLANFLOW
 _VLANFLOW_ACTION.fields_by_name['name'].enum_type = _VLANFLOW_ACTION_NAME
 _VLANFLOW_ACTION.containing_type = _VLANFLOW
@@ -3744,19 +4864,40 @@ _UNICASTROUTINGFLOW.fields_by_name['match'].message_type = _UNICASTROUTINGFLOW_M
 _UNICASTROUTINGFLOW.fields_by_name['action'].message_type = _UNICASTROUTINGFLOW_ACTION
 _UNICASTROUTINGFLOW.fields_by_name['g_type'].enum_type = _GROUPMOD_GTYPE
 _UNICASTROUTINGFLOW_ORIGIN.containing_type = _UNICASTROUTINGFLOW
+_MULTICASTROUTINGFLOW_MATCH.containing_type = _MULTICASTROUTINGFLOW
+_MULTICASTROUTINGFLOW.fields_by_name['match'].message_type = _MULTICASTROUTINGFLOW_MATCH
+_MULTICASTROUTINGFLOW.fields_by_name['g_type'].enum_type = _GROUPMOD_GTYPE
 _BRIDGINGFLOW_MATCH.containing_type = _BRIDGINGFLOW
 _BRIDGINGFLOW_ACTION.fields_by_name['name'].enum_type = _BRIDGINGFLOW_ACTION_NAME
 _BRIDGINGFLOW_ACTION.containing_type = _BRIDGINGFLOW
 _BRIDGINGFLOW_ACTION_NAME.containing_type = _BRIDGINGFLOW_ACTION
 _BRIDGINGFLOW.fields_by_name['match'].message_type = _BRIDGINGFLOW_MATCH
 _BRIDGINGFLOW.fields_by_name['action'].message_type = _BRIDGINGFLOW_ACTION
+_SRV6LOCALSIDFLOW_MATCH.containing_type = _SRV6LOCALSIDFLOW
+_SRV6LOCALSIDFLOW_ACTION.fields_by_name['name'].enum_type = _SRV6LOCALSIDFLOW_ACTION_NAME
+_SRV6LOCALSIDFLOW_ACTION.containing_type = _SRV6LOCALSIDFLOW
+_SRV6LOCALSIDFLOW_ACTION_NAME.containing_type = _SRV6LOCALSIDFLOW_ACTION
+_SRV6LOCALSIDFLOW.fields_by_name['match'].message_type = _SRV6LOCALSIDFLOW_MATCH
+_SRV6LOCALSIDFLOW.fields_by_name['action'].message_type = _SRV6LOCALSIDFLOW_ACTION
+_SRV6LOCALSIDFLOW.fields_by_name['g_type'].enum_type = _GROUPMOD_GTYPE
 _POLICYACLFLOW_MATCH.containing_type = _POLICYACLFLOW
 _POLICYACLFLOW_ACTION.fields_by_name['name'].enum_type = _POLICYACLFLOW_ACTION_NAME
 _POLICYACLFLOW_ACTION.containing_type = _POLICYACLFLOW
 _POLICYACLFLOW_ACTION_NAME.containing_type = _POLICYACLFLOW_ACTION
 _POLICYACLFLOW.fields_by_name['match'].message_type = _POLICYACLFLOW_MATCH
 _POLICYACLFLOW.fields_by_name['action'].message_type = _POLICYACLFLOW_ACTION
+_POLICYACLFLOW.fields_by_name['actions'].message_type = _POLICYACLFLOW_ACTION
+_L2INTERFACEGROUP.fields_by_name['bond'].message_type = _BONDATTRS
+_L2INTERFACEGROUP.fields_by_name['bond_slave'].message_type = _BONDSLAVEATTRS
+_BONDATTRS.fields_by_name['mode'].enum_type = _BONDATTRS_MODE
+_BONDATTRS.fields_by_name['hash_policy'].enum_type = _BONDATTRS_HASHPOLICY
+_BONDATTRS_MODE.containing_type = _BONDATTRS
+_BONDATTRS_HASHPOLICY.containing_type = _BONDATTRS
 _L3UNICASTGROUP.fields_by_name['tun_type'].enum_type = _TUNNELTYPE_TYPE
+_L3MULTICASTGROUP_PORT.containing_type = _L3MULTICASTGROUP
+_L3MULTICASTGROUP.fields_by_name['ports'].message_type = _L3MULTICASTGROUP_PORT
+_SRV6ENCAPGROUP.fields_by_name['mode'].enum_type = _SRV6ENCAPGROUP_MODE
+_SRV6ENCAPGROUP_MODE.containing_type = _SRV6ENCAPGROUP
 _MPLSLABELGROUP.fields_by_name['g_type'].enum_type = _GROUPMOD_GTYPE
 _FFHELLO.fields_by_name['dp_type'].enum_type = _FFHELLO_DPTYPE
 _FFHELLO_DPTYPE.containing_type = _FFHELLO
@@ -3787,9 +4928,17 @@ _FFMULTIPART_PORTREPLY.containing_type = _FFMULTIPART
 _FFMULTIPART_PORTDESCREQUEST.containing_type = _FFMULTIPART
 _FFMULTIPART_PORTDESCREPLY.fields_by_name['port'].message_type = _FFPORT
 _FFMULTIPART_PORTDESCREPLY.containing_type = _FFMULTIPART
+_FFMULTIPART_FLOWREQUEST.containing_type = _FFMULTIPART
+_FFMULTIPART_FLOWREPLY.fields_by_name['flows'].message_type = _FLOWMOD
+_FFMULTIPART_FLOWREPLY.containing_type = _FFMULTIPART
+_FFMULTIPART_GROUPDESCREQUEST.containing_type = _FFMULTIPART
+_FFMULTIPART_GROUPDESCREPLY.fields_by_name['groups'].message_type = _GROUPMOD
+_FFMULTIPART_GROUPDESCREPLY.containing_type = _FFMULTIPART
 _FFMULTIPART_REQUEST.fields_by_name['mp_type'].enum_type = _FFMULTIPART_MPTYPE
 _FFMULTIPART_REQUEST.fields_by_name['port'].message_type = _FFMULTIPART_PORTREQUEST
 _FFMULTIPART_REQUEST.fields_by_name['port_desc'].message_type = _FFMULTIPART_PORTDESCREQUEST
+_FFMULTIPART_REQUEST.fields_by_name['flow'].message_type = _FFMULTIPART_FLOWREQUEST
+_FFMULTIPART_REQUEST.fields_by_name['group_desc'].message_type = _FFMULTIPART_GROUPDESCREQUEST
 _FFMULTIPART_REQUEST.containing_type = _FFMULTIPART
 _FFMULTIPART_REQUEST.oneofs_by_name['body'].fields.append(
   _FFMULTIPART_REQUEST.fields_by_name['port'])
@@ -3797,9 +4946,17 @@ _FFMULTIPART_REQUEST.fields_by_name['port'].containing_oneof = _FFMULTIPART_REQU
 _FFMULTIPART_REQUEST.oneofs_by_name['body'].fields.append(
   _FFMULTIPART_REQUEST.fields_by_name['port_desc'])
 _FFMULTIPART_REQUEST.fields_by_name['port_desc'].containing_oneof = _FFMULTIPART_REQUEST.oneofs_by_name['body']
+_FFMULTIPART_REQUEST.oneofs_by_name['body'].fields.append(
+  _FFMULTIPART_REQUEST.fields_by_name['flow'])
+_FFMULTIPART_REQUEST.fields_by_name['flow'].containing_oneof = _FFMULTIPART_REQUEST.oneofs_by_name['body']
+_FFMULTIPART_REQUEST.oneofs_by_name['body'].fields.append(
+  _FFMULTIPART_REQUEST.fields_by_name['group_desc'])
+_FFMULTIPART_REQUEST.fields_by_name['group_desc'].containing_oneof = _FFMULTIPART_REQUEST.oneofs_by_name['body']
 _FFMULTIPART_REPLY.fields_by_name['mp_type'].enum_type = _FFMULTIPART_MPTYPE
 _FFMULTIPART_REPLY.fields_by_name['port'].message_type = _FFMULTIPART_PORTREPLY
 _FFMULTIPART_REPLY.fields_by_name['port_desc'].message_type = _FFMULTIPART_PORTDESCREPLY
+_FFMULTIPART_REPLY.fields_by_name['flow'].message_type = _FFMULTIPART_FLOWREPLY
+_FFMULTIPART_REPLY.fields_by_name['group_desc'].message_type = _FFMULTIPART_GROUPDESCREPLY
 _FFMULTIPART_REPLY.containing_type = _FFMULTIPART
 _FFMULTIPART_REPLY.oneofs_by_name['body'].fields.append(
   _FFMULTIPART_REPLY.fields_by_name['port'])
@@ -3807,6 +4964,12 @@ _FFMULTIPART_REPLY.fields_by_name['port'].containing_oneof = _FFMULTIPART_REPLY.
 _FFMULTIPART_REPLY.oneofs_by_name['body'].fields.append(
   _FFMULTIPART_REPLY.fields_by_name['port_desc'])
 _FFMULTIPART_REPLY.fields_by_name['port_desc'].containing_oneof = _FFMULTIPART_REPLY.oneofs_by_name['body']
+_FFMULTIPART_REPLY.oneofs_by_name['body'].fields.append(
+  _FFMULTIPART_REPLY.fields_by_name['flow'])
+_FFMULTIPART_REPLY.fields_by_name['flow'].containing_oneof = _FFMULTIPART_REPLY.oneofs_by_name['body']
+_FFMULTIPART_REPLY.oneofs_by_name['body'].fields.append(
+  _FFMULTIPART_REPLY.fields_by_name['group_desc'])
+_FFMULTIPART_REPLY.fields_by_name['group_desc'].containing_oneof = _FFMULTIPART_REPLY.oneofs_by_name['body']
 _FFMULTIPART_MPTYPE.containing_type = _FFMULTIPART
 _FFPORTSTATUS.fields_by_name['port'].message_type = _FFPORT
 _FFPORTSTATUS.fields_by_name['reason'].enum_type = _FFPORTSTATUS_REASON
@@ -3829,10 +4992,19 @@ DESCRIPTOR.message_types_by_name['VLANFlow'] = _VLANFLOW
 DESCRIPTOR.message_types_by_name['TerminationMacFlow'] = _TERMINATIONMACFLOW
 DESCRIPTOR.message_types_by_name['MPLSFlow'] = _MPLSFLOW
 DESCRIPTOR.message_types_by_name['UnicastRoutingFlow'] = _UNICASTROUTINGFLOW
+DESCRIPTOR.message_types_by_name['MulticastRoutingFlow'] = _MULTICASTROUTINGFLOW
 DESCRIPTOR.message_types_by_name['BridgingFlow'] = _BRIDGINGFLOW
+DESCRIPTOR.message_types_by_name['SRv6LocalSidFlow'] = _SRV6LOCALSIDFLOW
 DESCRIPTOR.message_types_by_name['PolicyACLFlow'] = _POLICYACLFLOW
 DESCRIPTOR.message_types_by_name['L2InterfaceGroup'] = _L2INTERFACEGROUP
+DESCRIPTOR.message_types_by_name['BondAttrs'] = _BONDATTRS
+DESCRIPTOR.message_types_by_name['BondSlaveAttrs'] = _BONDSLAVEATTRS
 DESCRIPTOR.message_types_by_name['L3UnicastGroup'] = _L3UNICASTGROUP
+DESCRIPTOR.message_types_by_name['L3EcmpGroup'] = _L3ECMPGROUP
+DESCRIPTOR.message_types_by_name['L3MulticastGroup'] = _L3MULTICASTGROUP
+DESCRIPTOR.message_types_by_name['L2OverlayGroup'] = _L2OVERLAYGROUP
+DESCRIPTOR.message_types_by_name['VxlanTunnel'] = _VXLANTUNNEL
+DESCRIPTOR.message_types_by_name['SRv6EncapGroup'] = _SRV6ENCAPGROUP
 DESCRIPTOR.message_types_by_name['MPLSInterfaceGroup'] = _MPLSINTERFACEGROUP
 DESCRIPTOR.message_types_by_name['MPLSLabelGroup'] = _MPLSLABELGROUP
 DESCRIPTOR.message_types_by_name['FFHello'] = _FFHELLO
@@ -4006,6 +5178,21 @@ _sym_db.RegisterMessage(UnicastRoutingFlow)
 _sym_db.RegisterMessage(UnicastRoutingFlow.Match)
 _sym_db.RegisterMessage(UnicastRoutingFlow.Action)
 
+MulticastRoutingFlow = _reflection.GeneratedProtocolMessageType('MulticastRoutingFlow', (_message.Message,), dict(
+
+  Match = _reflection.GeneratedProtocolMessageType('Match', (_message.Message,), dict(
+    DESCRIPTOR = _MULTICASTROUTINGFLOW_MATCH,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.MulticastRoutingFlow.Match)
+    ))
+  ,
+  DESCRIPTOR = _MULTICASTROUTINGFLOW,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.MulticastRoutingFlow)
+  ))
+_sym_db.RegisterMessage(MulticastRoutingFlow)
+_sym_db.RegisterMessage(MulticastRoutingFlow.Match)
+
 BridgingFlow = _reflection.GeneratedProtocolMessageType('BridgingFlow', (_message.Message,), dict(
 
   Match = _reflection.GeneratedProtocolMessageType('Match', (_message.Message,), dict(
@@ -4029,6 +5216,29 @@ _sym_db.RegisterMessage(BridgingFlow)
 _sym_db.RegisterMessage(BridgingFlow.Match)
 _sym_db.RegisterMessage(BridgingFlow.Action)
 
+SRv6LocalSidFlow = _reflection.GeneratedProtocolMessageType('SRv6LocalSidFlow', (_message.Message,), dict(
+
+  Match = _reflection.GeneratedProtocolMessageType('Match', (_message.Message,), dict(
+    DESCRIPTOR = _SRV6LOCALSIDFLOW_MATCH,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.SRv6LocalSidFlow.Match)
+    ))
+  ,
+
+  Action = _reflection.GeneratedProtocolMessageType('Action', (_message.Message,), dict(
+    DESCRIPTOR = _SRV6LOCALSIDFLOW_ACTION,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.SRv6LocalSidFlow.Action)
+    ))
+  ,
+  DESCRIPTOR = _SRV6LOCALSIDFLOW,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.SRv6LocalSidFlow)
+  ))
+_sym_db.RegisterMessage(SRv6LocalSidFlow)
+_sym_db.RegisterMessage(SRv6LocalSidFlow.Match)
+_sym_db.RegisterMessage(SRv6LocalSidFlow.Action)
+
 PolicyACLFlow = _reflection.GeneratedProtocolMessageType('PolicyACLFlow', (_message.Message,), dict(
 
   Match = _reflection.GeneratedProtocolMessageType('Match', (_message.Message,), dict(
@@ -4059,6 +5269,20 @@ L2InterfaceGroup = _reflection.GeneratedProtocolMessageType('L2InterfaceGroup',
   ))
 _sym_db.RegisterMessage(L2InterfaceGroup)
 
+BondAttrs = _reflection.GeneratedProtocolMessageType('BondAttrs', (_message.Message,), dict(
+  DESCRIPTOR = _BONDATTRS,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.BondAttrs)
+  ))
+_sym_db.RegisterMessage(BondAttrs)
+
+BondSlaveAttrs = _reflection.GeneratedProtocolMessageType('BondSlaveAttrs', (_message.Message,), dict(
+  DESCRIPTOR = _BONDSLAVEATTRS,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.BondSlaveAttrs)
+  ))
+_sym_db.RegisterMessage(BondSlaveAttrs)
+
 L3UnicastGroup = _reflection.GeneratedProtocolMessageType('L3UnicastGroup', (_message.Message,), dict(
   DESCRIPTOR = _L3UNICASTGROUP,
   __module__ = 'fibcapi_pb2'
@@ -4066,6 +5290,49 @@ L3UnicastGroup = _reflection.GeneratedProtocolMessageType('L3UnicastGroup', (_me
   ))
 _sym_db.RegisterMessage(L3UnicastGroup)
 
+L3EcmpGroup = _reflection.GeneratedProtocolMessageType('L3EcmpGroup', (_message.Message,), dict(
+  DESCRIPTOR = _L3ECMPGROUP,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.L3EcmpGroup)
+  ))
+_sym_db.RegisterMessage(L3EcmpGroup)
+
+L3MulticastGroup = _reflection.GeneratedProtocolMessageType('L3MulticastGroup', (_message.Message,), dict(
+
+  Port = _reflection.GeneratedProtocolMessageType('Port', (_message.Message,), dict(
+    DESCRIPTOR = _L3MULTICASTGROUP_PORT,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.L3MulticastGroup.Port)
+    ))
+  ,
+  DESCRIPTOR = _L3MULTICASTGROUP,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.L3MulticastGroup)
+  ))
+_sym_db.RegisterMessage(L3MulticastGroup)
+_sym_db.RegisterMessage(L3MulticastGroup.Port)
+
+L2OverlayGroup = _reflection.GeneratedProtocolMessageType('L2OverlayGroup', (_message.Message,), dict(
+  DESCRIPTOR = _L2OVERLAYGROUP,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.L2OverlayGroup)
+  ))
+_sym_db.RegisterMessage(L2OverlayGroup)
+
+VxlanTunnel = _reflection.GeneratedProtocolMessageType('VxlanTunnel', (_message.Message,), dict(
+  DESCRIPTOR = _VXLANTUNNEL,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.VxlanTunnel)
+  ))
+_sym_db.RegisterMessage(VxlanTunnel)
+
+SRv6EncapGroup = _reflection.GeneratedProtocolMessageType('SRv6EncapGroup', (_message.Message,), dict(
+  DESCRIPTOR = _SRV6ENCAPGROUP,
+  __module__ = 'fibcapi_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.SRv6EncapGroup)
+  ))
+_sym_db.RegisterMessage(SRv6EncapGroup)
+
 MPLSInterfaceGroup = _reflection.GeneratedProtocolMessageType('MPLSInterfaceGroup', (_message.Message,), dict(
   DESCRIPTOR = _MPLSINTERFACEGROUP,
   __module__ = 'fibcapi_pb2'
@@ -4186,6 +5453,34 @@ FFMultipart = _reflection.GeneratedProtocolMessageType('FFMultipart', (_message.
     ))
   ,
 
+  FlowRequest = _reflection.GeneratedProtocolMessageType('FlowRequest', (_message.Message,), dict(
+    DESCRIPTOR = _FFMULTIPART_FLOWREQUEST,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.FFMultipart.FlowRequest)
+    ))
+  ,
+
+  FlowReply = _reflection.GeneratedProtocolMessageType('FlowReply', (_message.Message,), dict(
+    DESCRIPTOR = _FFMULTIPART_FLOWREPLY,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.FFMultipart.FlowReply)
+    ))
+  ,
+
+  GroupDescRequest = _reflection.GeneratedProtocolMessageType('GroupDescRequest', (_message.Message,), dict(
+    DESCRIPTOR = _FFMULTIPART_GROUPDESCREQUEST,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.FFMultipart.GroupDescRequest)
+    ))
+  ,
+
+  GroupDescReply = _reflection.GeneratedProtocolMessageType('GroupDescReply', (_message.Message,), dict(
+    DESCRIPTOR = _FFMULTIPART_GROUPDESCREPLY,
+    __module__ = 'fibcapi_pb2'
+    # @@protoc_insertion_point(class_scope:fibcapi.FFMultipart.GroupDescReply)
+    ))
+  ,
+
   Request = _reflection.GeneratedProtocolMessageType('Request', (_message.Message,), dict(
     DESCRIPTOR = _FFMULTIPART_REQUEST,
     __module__ = 'fibcapi_pb2'
@@ -4208,6 +5503,10 @@ _sym_db.RegisterMessage(FFMultipart.PortRequest)
 _sym_db.RegisterMessage(FFMultipart.PortReply)
 _sym_db.RegisterMessage(FFMultipart.PortDescRequest)
 _sym_db.RegisterMessage(FFMultipart.PortDescReply)
+_sym_db.RegisterMessage(FFMultipart.FlowRequest)
+_sym_db.RegisterMessage(FFMultipart.FlowReply)
+_sym_db.RegisterMessage(FFMultipart.GroupDescRequest)
+_sym_db.RegisterMessage(FFMultipart.GroupDescReply)
 _sym_db.RegisterMessage(FFMultipart.Request)
 _sym_db.RegisterMessage(FFMultipart.Reply)
 
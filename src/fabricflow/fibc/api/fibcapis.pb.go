@@ -24,6 +24,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ApAuditModsEntry_Result int32
+
+const (
+	ApAuditModsEntry_NOP       ApAuditModsEntry_Result = 0
+	ApAuditModsEntry_MISSING   ApAuditModsEntry_Result = 1
+	ApAuditModsEntry_EXTRA     ApAuditModsEntry_Result = 2
+	ApAuditModsEntry_DIFFERENT ApAuditModsEntry_Result = 3
+)
+
+var ApAuditModsEntry_Result_name = map[int32]string{
+	0: "NOP",
+	1: "MISSING",
+	2: "EXTRA",
+	3: "DIFFERENT",
+}
+
+var ApAuditModsEntry_Result_value = map[string]int32{
+	"NOP":       0,
+	"MISSING":   1,
+	"EXTRA":     2,
+	"DIFFERENT": 3,
+}
+
+func (x ApAuditModsEntry_Result) String() string {
+	return proto.EnumName(ApAuditModsEntry_Result_name, int32(x))
+}
+
+func (ApAuditModsEntry_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{25, 0}
+}
+
 type DbDpEntry_Type int32
 
 const (
@@ -55,7 +86,7 @@ func (x DbDpEntry_Type) String() string {
 }
 
 func (DbDpEntry_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{39, 0}
+	return fileDescriptor_5600d3affcc40088, []int{45, 0}
 }
 
 //
@@ -460,9 +491,81 @@ func (m *ApMonitorReplyLog) GetTime() int64 {
 	return 0
 }
 
+type ApMonitorReplyPortStatus struct {
+	DpId                 uint64            `protobuf:"varint,1,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
+	PortNo               uint32            `protobuf:"varint,2,opt,name=port_no,json=portNo,proto3" json:"port_no,omitempty"`
+	Status               PortStatus_Status `protobuf:"varint,3,opt,name=status,proto3,enum=fibcapi.PortStatus_Status" json:"status,omitempty"`
+	ReId                 string            `protobuf:"bytes,4,opt,name=re_id,json=reId,proto3" json:"re_id,omitempty"`
+	Ifname               string            `protobuf:"bytes,5,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApMonitorReplyPortStatus) Reset()         { *m = ApMonitorReplyPortStatus{} }
+func (m *ApMonitorReplyPortStatus) String() string { return proto.CompactTextString(m) }
+func (*ApMonitorReplyPortStatus) ProtoMessage()    {}
+func (*ApMonitorReplyPortStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{12}
+}
+
+func (m *ApMonitorReplyPortStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApMonitorReplyPortStatus.Unmarshal(m, b)
+}
+func (m *ApMonitorReplyPortStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApMonitorReplyPortStatus.Marshal(b, m, deterministic)
+}
+func (m *ApMonitorReplyPortStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApMonitorReplyPortStatus.Merge(m, src)
+}
+func (m *ApMonitorReplyPortStatus) XXX_Size() int {
+	return xxx_messageInfo_ApMonitorReplyPortStatus.Size(m)
+}
+func (m *ApMonitorReplyPortStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApMonitorReplyPortStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApMonitorReplyPortStatus proto.InternalMessageInfo
+
+func (m *ApMonitorReplyPortStatus) GetDpId() uint64 {
+	if m != nil {
+		return m.DpId
+	}
+	return 0
+}
+
+func (m *ApMonitorReplyPortStatus) GetPortNo() uint32 {
+	if m != nil {
+		return m.PortNo
+	}
+	return 0
+}
+
+func (m *ApMonitorReplyPortStatus) GetStatus() PortStatus_Status {
+	if m != nil {
+		return m.Status
+	}
+	return PortStatus_NOP
+}
+
+func (m *ApMonitorReplyPortStatus) GetReId() string {
+	if m != nil {
+		return m.ReId
+	}
+	return ""
+}
+
+func (m *ApMonitorReplyPortStatus) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
 type ApMonitorReply struct {
 	// Types that are valid to be assigned to Body:
 	//	*ApMonitorReply_Log
+	//	*ApMonitorReply_PortStatus
 	Body                 isApMonitorReply_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -473,7 +576,7 @@ func (m *ApMonitorReply) Reset()         { *m = ApMonitorReply{} }
 func (m *ApMonitorReply) String() string { return proto.CompactTextString(m) }
 func (*ApMonitorReply) ProtoMessage()    {}
 func (*ApMonitorReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{12}
+	return fileDescriptor_5600d3affcc40088, []int{13}
 }
 
 func (m *ApMonitorReply) XXX_Unmarshal(b []byte) error {
@@ -502,8 +605,14 @@ type ApMonitorReply_Log struct {
 	Log *ApMonitorReplyLog `protobuf:"bytes,1,opt,name=log,proto3,oneof"`
 }
 
+type ApMonitorReply_PortStatus struct {
+	PortStatus *ApMonitorReplyPortStatus `protobuf:"bytes,2,opt,name=port_status,json=portStatus,proto3,oneof"`
+}
+
 func (*ApMonitorReply_Log) isApMonitorReply_Body() {}
 
+func (*ApMonitorReply_PortStatus) isApMonitorReply_Body() {}
+
 func (m *ApMonitorReply) GetBody() isApMonitorReply_Body {
 	if m != nil {
 		return m.Body
@@ -518,10 +627,18 @@ func (m *ApMonitorReply) GetLog() *ApMonitorReplyLog {
 	return nil
 }
 
+func (m *ApMonitorReply) GetPortStatus() *ApMonitorReplyPortStatus {
+	if x, ok := m.GetBody().(*ApMonitorReply_PortStatus); ok {
+		return x.PortStatus
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ApMonitorReply) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ApMonitorReply_Log)(nil),
+		(*ApMonitorReply_PortStatus)(nil),
 	}
 }
 
@@ -535,7 +652,7 @@ func (m *ApGetPortEntriesRequest) Reset()         { *m = ApGetPortEntriesRequest
 func (m *ApGetPortEntriesRequest) String() string { return proto.CompactTextString(m) }
 func (*ApGetPortEntriesRequest) ProtoMessage()    {}
 func (*ApGetPortEntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{13}
+	return fileDescriptor_5600d3affcc40088, []int{14}
 }
 
 func (m *ApGetPortEntriesRequest) XXX_Unmarshal(b []byte) error {
@@ -566,7 +683,7 @@ func (m *ApGetIdEntriesRequest) Reset()         { *m = ApGetIdEntriesRequest{} }
 func (m *ApGetIdEntriesRequest) String() string { return proto.CompactTextString(m) }
 func (*ApGetIdEntriesRequest) ProtoMessage()    {}
 func (*ApGetIdEntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{14}
+	return fileDescriptor_5600d3affcc40088, []int{15}
 }
 
 func (m *ApGetIdEntriesRequest) XXX_Unmarshal(b []byte) error {
@@ -598,7 +715,7 @@ func (m *ApGetDpEntriesRequest) Reset()         { *m = ApGetDpEntriesRequest{} }
 func (m *ApGetDpEntriesRequest) String() string { return proto.CompactTextString(m) }
 func (*ApGetDpEntriesRequest) ProtoMessage()    {}
 func (*ApGetDpEntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{15}
+	return fileDescriptor_5600d3affcc40088, []int{16}
 }
 
 func (m *ApGetDpEntriesRequest) XXX_Unmarshal(b []byte) error {
@@ -636,7 +753,7 @@ func (m *ApAddPortEntryReply) Reset()         { *m = ApAddPortEntryReply{} }
 func (m *ApAddPortEntryReply) String() string { return proto.CompactTextString(m) }
 func (*ApAddPortEntryReply) ProtoMessage()    {}
 func (*ApAddPortEntryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{16}
+	return fileDescriptor_5600d3affcc40088, []int{17}
 }
 
 func (m *ApAddPortEntryReply) XXX_Unmarshal(b []byte) error {
@@ -667,7 +784,7 @@ func (m *ApAddIdEntryReply) Reset()         { *m = ApAddIdEntryReply{} }
 func (m *ApAddIdEntryReply) String() string { return proto.CompactTextString(m) }
 func (*ApAddIdEntryReply) ProtoMessage()    {}
 func (*ApAddIdEntryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{17}
+	return fileDescriptor_5600d3affcc40088, []int{18}
 }
 
 func (m *ApAddIdEntryReply) XXX_Unmarshal(b []byte) error {
@@ -698,7 +815,7 @@ func (m *ApDelPortEntryReply) Reset()         { *m = ApDelPortEntryReply{} }
 func (m *ApDelPortEntryReply) String() string { return proto.CompactTextString(m) }
 func (*ApDelPortEntryReply) ProtoMessage()    {}
 func (*ApDelPortEntryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{18}
+	return fileDescriptor_5600d3affcc40088, []int{19}
 }
 
 func (m *ApDelPortEntryReply) XXX_Unmarshal(b []byte) error {
@@ -729,7 +846,7 @@ func (m *ApDelIdEntryReply) Reset()         { *m = ApDelIdEntryReply{} }
 func (m *ApDelIdEntryReply) String() string { return proto.CompactTextString(m) }
 func (*ApDelIdEntryReply) ProtoMessage()    {}
 func (*ApDelIdEntryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{19}
+	return fileDescriptor_5600d3affcc40088, []int{20}
 }
 
 func (m *ApDelIdEntryReply) XXX_Unmarshal(b []byte) error {
@@ -763,7 +880,7 @@ func (m *ApGetPortStatsRequest) Reset()         { *m = ApGetPortStatsRequest{} }
 func (m *ApGetPortStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ApGetPortStatsRequest) ProtoMessage()    {}
 func (*ApGetPortStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{20}
+	return fileDescriptor_5600d3affcc40088, []int{21}
 }
 
 func (m *ApGetPortStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -819,7 +936,7 @@ func (m *ApModPortStatsRequest) Reset()         { *m = ApModPortStatsRequest{} }
 func (m *ApModPortStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ApModPortStatsRequest) ProtoMessage()    {}
 func (*ApModPortStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{21}
+	return fileDescriptor_5600d3affcc40088, []int{22}
 }
 
 func (m *ApModPortStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -878,7 +995,7 @@ func (m *ApModPortStatsReply) Reset()         { *m = ApModPortStatsReply{} }
 func (m *ApModPortStatsReply) String() string { return proto.CompactTextString(m) }
 func (*ApModPortStatsReply) ProtoMessage()    {}
 func (*ApModPortStatsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{22}
+	return fileDescriptor_5600d3affcc40088, []int{23}
 }
 
 func (m *ApModPortStatsReply) XXX_Unmarshal(b []byte) error {
@@ -899,6 +1016,314 @@ func (m *ApModPortStatsReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApModPortStatsReply proto.InternalMessageInfo
 
+type ApAuditModsRequest struct {
+	ReId                 string   `protobuf:"bytes,1,opt,name=re_id,json=reId,proto3" json:"re_id,omitempty"`
+	Repair               bool     `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApAuditModsRequest) Reset()         { *m = ApAuditModsRequest{} }
+func (m *ApAuditModsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApAuditModsRequest) ProtoMessage()    {}
+func (*ApAuditModsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{24}
+}
+
+func (m *ApAuditModsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApAuditModsRequest.Unmarshal(m, b)
+}
+func (m *ApAuditModsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApAuditModsRequest.Marshal(b, m, deterministic)
+}
+func (m *ApAuditModsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApAuditModsRequest.Merge(m, src)
+}
+func (m *ApAuditModsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApAuditModsRequest.Size(m)
+}
+func (m *ApAuditModsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApAuditModsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApAuditModsRequest proto.InternalMessageInfo
+
+func (m *ApAuditModsRequest) GetReId() string {
+	if m != nil {
+		return m.ReId
+	}
+	return ""
+}
+
+func (m *ApAuditModsRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+type ApAuditModsEntry struct {
+	Result               ApAuditModsEntry_Result `protobuf:"varint,1,opt,name=result,proto3,enum=fibcapi.ApAuditModsEntry_Result" json:"result,omitempty"`
+	ReId                 string                  `protobuf:"bytes,2,opt,name=re_id,json=reId,proto3" json:"re_id,omitempty"`
+	DpId                 uint64                  `protobuf:"varint,3,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
+	VmFlow               *FlowMod                `protobuf:"bytes,4,opt,name=vm_flow,json=vmFlow,proto3" json:"vm_flow,omitempty"`
+	DpFlow               *FlowMod                `protobuf:"bytes,5,opt,name=dp_flow,json=dpFlow,proto3" json:"dp_flow,omitempty"`
+	VmGroup              *GroupMod               `protobuf:"bytes,6,opt,name=vm_group,json=vmGroup,proto3" json:"vm_group,omitempty"`
+	DpGroup              *GroupMod               `protobuf:"bytes,7,opt,name=dp_group,json=dpGroup,proto3" json:"dp_group,omitempty"`
+	Repaired             bool                    `protobuf:"varint,8,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ApAuditModsEntry) Reset()         { *m = ApAuditModsEntry{} }
+func (m *ApAuditModsEntry) String() string { return proto.CompactTextString(m) }
+func (*ApAuditModsEntry) ProtoMessage()    {}
+func (*ApAuditModsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{25}
+}
+
+func (m *ApAuditModsEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApAuditModsEntry.Unmarshal(m, b)
+}
+func (m *ApAuditModsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApAuditModsEntry.Marshal(b, m, deterministic)
+}
+func (m *ApAuditModsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApAuditModsEntry.Merge(m, src)
+}
+func (m *ApAuditModsEntry) XXX_Size() int {
+	return xxx_messageInfo_ApAuditModsEntry.Size(m)
+}
+func (m *ApAuditModsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApAuditModsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApAuditModsEntry proto.InternalMessageInfo
+
+func (m *ApAuditModsEntry) GetResult() ApAuditModsEntry_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ApAuditModsEntry_NOP
+}
+
+func (m *ApAuditModsEntry) GetReId() string {
+	if m != nil {
+		return m.ReId
+	}
+	return ""
+}
+
+func (m *ApAuditModsEntry) GetDpId() uint64 {
+	if m != nil {
+		return m.DpId
+	}
+	return 0
+}
+
+func (m *ApAuditModsEntry) GetVmFlow() *FlowMod {
+	if m != nil {
+		return m.VmFlow
+	}
+	return nil
+}
+
+func (m *ApAuditModsEntry) GetDpFlow() *FlowMod {
+	if m != nil {
+		return m.DpFlow
+	}
+	return nil
+}
+
+func (m *ApAuditModsEntry) GetVmGroup() *GroupMod {
+	if m != nil {
+		return m.VmGroup
+	}
+	return nil
+}
+
+func (m *ApAuditModsEntry) GetDpGroup() *GroupMod {
+	if m != nil {
+		return m.DpGroup
+	}
+	return nil
+}
+
+func (m *ApAuditModsEntry) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+type ApGetLldpNeighborsRequest struct {
+	DpId                 uint64   `protobuf:"varint,1,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApGetLldpNeighborsRequest) Reset()         { *m = ApGetLldpNeighborsRequest{} }
+func (m *ApGetLldpNeighborsRequest) String() string { return proto.CompactTextString(m) }
+func (*ApGetLldpNeighborsRequest) ProtoMessage()    {}
+func (*ApGetLldpNeighborsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{26}
+}
+
+func (m *ApGetLldpNeighborsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApGetLldpNeighborsRequest.Unmarshal(m, b)
+}
+func (m *ApGetLldpNeighborsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApGetLldpNeighborsRequest.Marshal(b, m, deterministic)
+}
+func (m *ApGetLldpNeighborsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApGetLldpNeighborsRequest.Merge(m, src)
+}
+func (m *ApGetLldpNeighborsRequest) XXX_Size() int {
+	return xxx_messageInfo_ApGetLldpNeighborsRequest.Size(m)
+}
+func (m *ApGetLldpNeighborsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApGetLldpNeighborsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApGetLldpNeighborsRequest proto.InternalMessageInfo
+
+func (m *ApGetLldpNeighborsRequest) GetDpId() uint64 {
+	if m != nil {
+		return m.DpId
+	}
+	return 0
+}
+
+type LldpNeighbor struct {
+	DpId                 uint64   `protobuf:"varint,1,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
+	PortNo               uint32   `protobuf:"varint,2,opt,name=port_no,json=portNo,proto3" json:"port_no,omitempty"`
+	ReId                 string   `protobuf:"bytes,3,opt,name=re_id,json=reId,proto3" json:"re_id,omitempty"`
+	Ifname               string   `protobuf:"bytes,4,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	ChassisId            string   `protobuf:"bytes,5,opt,name=chassis_id,json=chassisId,proto3" json:"chassis_id,omitempty"`
+	PortId               string   `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PortDesc             string   `protobuf:"bytes,7,opt,name=port_desc,json=portDesc,proto3" json:"port_desc,omitempty"`
+	SystemName           string   `protobuf:"bytes,8,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	Ttl                  uint32   `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	LastUpdate           int64    `protobuf:"varint,10,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	Mismatch             bool     `protobuf:"varint,11,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+	Expected             string   `protobuf:"bytes,12,opt,name=expected,proto3" json:"expected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LldpNeighbor) Reset()         { *m = LldpNeighbor{} }
+func (m *LldpNeighbor) String() string { return proto.CompactTextString(m) }
+func (*LldpNeighbor) ProtoMessage()    {}
+func (*LldpNeighbor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{27}
+}
+
+func (m *LldpNeighbor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LldpNeighbor.Unmarshal(m, b)
+}
+func (m *LldpNeighbor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LldpNeighbor.Marshal(b, m, deterministic)
+}
+func (m *LldpNeighbor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LldpNeighbor.Merge(m, src)
+}
+func (m *LldpNeighbor) XXX_Size() int {
+	return xxx_messageInfo_LldpNeighbor.Size(m)
+}
+func (m *LldpNeighbor) XXX_DiscardUnknown() {
+	xxx_messageInfo_LldpNeighbor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LldpNeighbor proto.InternalMessageInfo
+
+func (m *LldpNeighbor) GetDpId() uint64 {
+	if m != nil {
+		return m.DpId
+	}
+	return 0
+}
+
+func (m *LldpNeighbor) GetPortNo() uint32 {
+	if m != nil {
+		return m.PortNo
+	}
+	return 0
+}
+
+func (m *LldpNeighbor) GetReId() string {
+	if m != nil {
+		return m.ReId
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetChassisId() string {
+	if m != nil {
+		return m.ChassisId
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetPortDesc() string {
+	if m != nil {
+		return m.PortDesc
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetSystemName() string {
+	if m != nil {
+		return m.SystemName
+	}
+	return ""
+}
+
+func (m *LldpNeighbor) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *LldpNeighbor) GetLastUpdate() int64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *LldpNeighbor) GetMismatch() bool {
+	if m != nil {
+		return m.Mismatch
+	}
+	return false
+}
+
+func (m *LldpNeighbor) GetExpected() string {
+	if m != nil {
+		return m.Expected
+	}
+	return ""
+}
+
 //
 // FIBCVmApi
 //
@@ -913,7 +1338,7 @@ func (m *VmMonitorRequest) Reset()         { *m = VmMonitorRequest{} }
 func (m *VmMonitorRequest) String() string { return proto.CompactTextString(m) }
 func (*VmMonitorRequest) ProtoMessage()    {}
 func (*VmMonitorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{23}
+	return fileDescriptor_5600d3affcc40088, []int{28}
 }
 
 func (m *VmMonitorRequest) XXX_Unmarshal(b []byte) error {
@@ -957,7 +1382,7 @@ func (m *VmMonitorReply) Reset()         { *m = VmMonitorReply{} }
 func (m *VmMonitorReply) String() string { return proto.CompactTextString(m) }
 func (*VmMonitorReply) ProtoMessage()    {}
 func (*VmMonitorReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{24}
+	return fileDescriptor_5600d3affcc40088, []int{29}
 }
 
 func (m *VmMonitorReply) XXX_Unmarshal(b []byte) error {
@@ -1066,7 +1491,7 @@ func (m *VsMonitorRequest) Reset()         { *m = VsMonitorRequest{} }
 func (m *VsMonitorRequest) String() string { return proto.CompactTextString(m) }
 func (*VsMonitorRequest) ProtoMessage()    {}
 func (*VsMonitorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{25}
+	return fileDescriptor_5600d3affcc40088, []int{30}
 }
 
 func (m *VsMonitorRequest) XXX_Unmarshal(b []byte) error {
@@ -1116,7 +1541,7 @@ func (m *VsMonitorReply) Reset()         { *m = VsMonitorReply{} }
 func (m *VsMonitorReply) String() string { return proto.CompactTextString(m) }
 func (*VsMonitorReply) ProtoMessage()    {}
 func (*VsMonitorReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{26}
+	return fileDescriptor_5600d3affcc40088, []int{31}
 }
 
 func (m *VsMonitorReply) XXX_Unmarshal(b []byte) error {
@@ -1211,7 +1636,7 @@ func (m *DpMultipartRequest) Reset()         { *m = DpMultipartRequest{} }
 func (m *DpMultipartRequest) String() string { return proto.CompactTextString(m) }
 func (*DpMultipartRequest) ProtoMessage()    {}
 func (*DpMultipartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{27}
+	return fileDescriptor_5600d3affcc40088, []int{32}
 }
 
 func (m *DpMultipartRequest) XXX_Unmarshal(b []byte) error {
@@ -1258,7 +1683,7 @@ func (m *DpMultipartReply) Reset()         { *m = DpMultipartReply{} }
 func (m *DpMultipartReply) String() string { return proto.CompactTextString(m) }
 func (*DpMultipartReply) ProtoMessage()    {}
 func (*DpMultipartReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{28}
+	return fileDescriptor_5600d3affcc40088, []int{33}
 }
 
 func (m *DpMultipartReply) XXX_Unmarshal(b []byte) error {
@@ -1303,7 +1728,7 @@ func (m *DpMultipartReplyAck) Reset()         { *m = DpMultipartReplyAck{} }
 func (m *DpMultipartReplyAck) String() string { return proto.CompactTextString(m) }
 func (*DpMultipartReplyAck) ProtoMessage()    {}
 func (*DpMultipartReplyAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{29}
+	return fileDescriptor_5600d3affcc40088, []int{34}
 }
 
 func (m *DpMultipartReplyAck) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +1761,7 @@ func (m *DpMonitorRequest) Reset()         { *m = DpMonitorRequest{} }
 func (m *DpMonitorRequest) String() string { return proto.CompactTextString(m) }
 func (*DpMonitorRequest) ProtoMessage()    {}
 func (*DpMonitorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{30}
+	return fileDescriptor_5600d3affcc40088, []int{35}
 }
 
 func (m *DpMonitorRequest) XXX_Unmarshal(b []byte) error {
@@ -1389,7 +1814,7 @@ func (m *DpMonitorReply) Reset()         { *m = DpMonitorReply{} }
 func (m *DpMonitorReply) String() string { return proto.CompactTextString(m) }
 func (*DpMonitorReply) ProtoMessage()    {}
 func (*DpMonitorReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{31}
+	return fileDescriptor_5600d3affcc40088, []int{36}
 }
 
 func (m *DpMonitorReply) XXX_Unmarshal(b []byte) error {
@@ -1526,7 +1951,7 @@ func (m *OAMRequest) Reset()         { *m = OAMRequest{} }
 func (m *OAMRequest) String() string { return proto.CompactTextString(m) }
 func (*OAMRequest) ProtoMessage()    {}
 func (*OAMRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{32}
+	return fileDescriptor_5600d3affcc40088, []int{37}
 }
 
 func (m *OAMRequest) XXX_Unmarshal(b []byte) error {
@@ -1573,7 +1998,7 @@ func (m *OAMReply) Reset()         { *m = OAMReply{} }
 func (m *OAMReply) String() string { return proto.CompactTextString(m) }
 func (*OAMReply) ProtoMessage()    {}
 func (*OAMReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{33}
+	return fileDescriptor_5600d3affcc40088, []int{38}
 }
 
 func (m *OAMReply) XXX_Unmarshal(b []byte) error {
@@ -1618,7 +2043,7 @@ func (m *OAMReplyAck) Reset()         { *m = OAMReplyAck{} }
 func (m *OAMReplyAck) String() string { return proto.CompactTextString(m) }
 func (*OAMReplyAck) ProtoMessage()    {}
 func (*OAMReplyAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{34}
+	return fileDescriptor_5600d3affcc40088, []int{39}
 }
 
 func (m *OAMReplyAck) XXX_Unmarshal(b []byte) error {
@@ -1654,7 +2079,7 @@ func (m *DbPortKey) Reset()         { *m = DbPortKey{} }
 func (m *DbPortKey) String() string { return proto.CompactTextString(m) }
 func (*DbPortKey) ProtoMessage()    {}
 func (*DbPortKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{35}
+	return fileDescriptor_5600d3affcc40088, []int{40}
 }
 
 func (m *DbPortKey) XXX_Unmarshal(b []byte) error {
@@ -1703,7 +2128,7 @@ func (m *DbPortValue) Reset()         { *m = DbPortValue{} }
 func (m *DbPortValue) String() string { return proto.CompactTextString(m) }
 func (*DbPortValue) ProtoMessage()    {}
 func (*DbPortValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{36}
+	return fileDescriptor_5600d3affcc40088, []int{41}
 }
 
 func (m *DbPortValue) XXX_Unmarshal(b []byte) error {
@@ -1768,7 +2193,7 @@ func (m *DbPortEntry) Reset()         { *m = DbPortEntry{} }
 func (m *DbPortEntry) String() string { return proto.CompactTextString(m) }
 func (*DbPortEntry) ProtoMessage()    {}
 func (*DbPortEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{37}
+	return fileDescriptor_5600d3affcc40088, []int{42}
 }
 
 func (m *DbPortEntry) XXX_Unmarshal(b []byte) error {
@@ -1843,7 +2268,7 @@ func (m *DbIdEntry) Reset()         { *m = DbIdEntry{} }
 func (m *DbIdEntry) String() string { return proto.CompactTextString(m) }
 func (*DbIdEntry) ProtoMessage()    {}
 func (*DbIdEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{38}
+	return fileDescriptor_5600d3affcc40088, []int{43}
 }
 
 func (m *DbIdEntry) XXX_Unmarshal(b []byte) error {
@@ -1878,6 +2303,61 @@ func (m *DbIdEntry) GetDpId() uint64 {
 	return 0
 }
 
+type DbModEntry struct {
+	DpId                 uint64    `protobuf:"varint,1,opt,name=dp_id,json=dpId,proto3" json:"dp_id,omitempty"`
+	FlowMod              *FlowMod  `protobuf:"bytes,2,opt,name=flow_mod,json=flowMod,proto3" json:"flow_mod,omitempty"`
+	GroupMod             *GroupMod `protobuf:"bytes,3,opt,name=group_mod,json=groupMod,proto3" json:"group_mod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DbModEntry) Reset()         { *m = DbModEntry{} }
+func (m *DbModEntry) String() string { return proto.CompactTextString(m) }
+func (*DbModEntry) ProtoMessage()    {}
+func (*DbModEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{44}
+}
+
+func (m *DbModEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DbModEntry.Unmarshal(m, b)
+}
+func (m *DbModEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DbModEntry.Marshal(b, m, deterministic)
+}
+func (m *DbModEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DbModEntry.Merge(m, src)
+}
+func (m *DbModEntry) XXX_Size() int {
+	return xxx_messageInfo_DbModEntry.Size(m)
+}
+func (m *DbModEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DbModEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DbModEntry proto.InternalMessageInfo
+
+func (m *DbModEntry) GetDpId() uint64 {
+	if m != nil {
+		return m.DpId
+	}
+	return 0
+}
+
+func (m *DbModEntry) GetFlowMod() *FlowMod {
+	if m != nil {
+		return m.FlowMod
+	}
+	return nil
+}
+
+func (m *DbModEntry) GetGroupMod() *GroupMod {
+	if m != nil {
+		return m.GroupMod
+	}
+	return nil
+}
+
 type DbDpEntry struct {
 	Type                 DbDpEntry_Type `protobuf:"varint,1,opt,name=type,proto3,enum=fibcapi.DbDpEntry_Type" json:"type,omitempty"`
 	Id                   string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1891,7 +2371,7 @@ func (m *DbDpEntry) Reset()         { *m = DbDpEntry{} }
 func (m *DbDpEntry) String() string { return proto.CompactTextString(m) }
 func (*DbDpEntry) ProtoMessage()    {}
 func (*DbDpEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{39}
+	return fileDescriptor_5600d3affcc40088, []int{45}
 }
 
 func (m *DbDpEntry) XXX_Unmarshal(b []byte) error {
@@ -1946,7 +2426,7 @@ func (m *StatsEntry) Reset()         { *m = StatsEntry{} }
 func (m *StatsEntry) String() string { return proto.CompactTextString(m) }
 func (*StatsEntry) ProtoMessage()    {}
 func (*StatsEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{40}
+	return fileDescriptor_5600d3affcc40088, []int{46}
 }
 
 func (m *StatsEntry) XXX_Unmarshal(b []byte) error {
@@ -1998,7 +2478,7 @@ func (m *ApGetStatsRequest) Reset()         { *m = ApGetStatsRequest{} }
 func (m *ApGetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ApGetStatsRequest) ProtoMessage()    {}
 func (*ApGetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5600d3affcc40088, []int{41}
+	return fileDescriptor_5600d3affcc40088, []int{47}
 }
 
 func (m *ApGetStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -2019,7 +2499,140 @@ func (m *ApGetStatsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApGetStatsRequest proto.InternalMessageInfo
 
+//
+// FIBCHaApi
+//
+// epoch is epoch of active known by node.
+// active is demoted if epoch is newer than it's own.
+type HaSyncRequest struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HaSyncRequest) Reset()         { *m = HaSyncRequest{} }
+func (m *HaSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*HaSyncRequest) ProtoMessage()    {}
+func (*HaSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{48}
+}
+
+func (m *HaSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HaSyncRequest.Unmarshal(m, b)
+}
+func (m *HaSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HaSyncRequest.Marshal(b, m, deterministic)
+}
+func (m *HaSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaSyncRequest.Merge(m, src)
+}
+func (m *HaSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_HaSyncRequest.Size(m)
+}
+func (m *HaSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaSyncRequest proto.InternalMessageInfo
+
+func (m *HaSyncRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *HaSyncRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// reply which has same seq as previous one is keepalive (no entries).
+type HaSyncReply struct {
+	Seq                  uint64         `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	IdEntries            []*DbIdEntry   `protobuf:"bytes,2,rep,name=id_entries,json=idEntries,proto3" json:"id_entries,omitempty"`
+	PortEntries          []*DbPortEntry `protobuf:"bytes,3,rep,name=port_entries,json=portEntries,proto3" json:"port_entries,omitempty"`
+	DpEntries            []*DbDpEntry   `protobuf:"bytes,4,rep,name=dp_entries,json=dpEntries,proto3" json:"dp_entries,omitempty"`
+	ModEntries           []*DbModEntry  `protobuf:"bytes,5,rep,name=mod_entries,json=modEntries,proto3" json:"mod_entries,omitempty"`
+	Epoch                uint64         `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HaSyncReply) Reset()         { *m = HaSyncReply{} }
+func (m *HaSyncReply) String() string { return proto.CompactTextString(m) }
+func (*HaSyncReply) ProtoMessage()    {}
+func (*HaSyncReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600d3affcc40088, []int{49}
+}
+
+func (m *HaSyncReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HaSyncReply.Unmarshal(m, b)
+}
+func (m *HaSyncReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HaSyncReply.Marshal(b, m, deterministic)
+}
+func (m *HaSyncReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaSyncReply.Merge(m, src)
+}
+func (m *HaSyncReply) XXX_Size() int {
+	return xxx_messageInfo_HaSyncReply.Size(m)
+}
+func (m *HaSyncReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaSyncReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaSyncReply proto.InternalMessageInfo
+
+func (m *HaSyncReply) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *HaSyncReply) GetIdEntries() []*DbIdEntry {
+	if m != nil {
+		return m.IdEntries
+	}
+	return nil
+}
+
+func (m *HaSyncReply) GetPortEntries() []*DbPortEntry {
+	if m != nil {
+		return m.PortEntries
+	}
+	return nil
+}
+
+func (m *HaSyncReply) GetDpEntries() []*DbDpEntry {
+	if m != nil {
+		return m.DpEntries
+	}
+	return nil
+}
+
+func (m *HaSyncReply) GetModEntries() []*DbModEntry {
+	if m != nil {
+		return m.ModEntries
+	}
+	return nil
+}
+
+func (m *HaSyncReply) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("fibcapi.ApAuditModsEntry_Result", ApAuditModsEntry_Result_name, ApAuditModsEntry_Result_value)
 	proto.RegisterEnum("fibcapi.DbDpEntry_Type", DbDpEntry_Type_name, DbDpEntry_Type_value)
 	proto.RegisterType((*HelloReply)(nil), "fibcapi.HelloReply")
 	proto.RegisterType((*PortConfigReply)(nil), "fibcapi.PortConfigReply")
@@ -2033,6 +2646,7 @@ func init() {
 	proto.RegisterType((*FFPortStatusReply)(nil), "fibcapi.FFPortStatusReply")
 	proto.RegisterType((*ApMonitorRequest)(nil), "fibcapi.ApMonitorRequest")
 	proto.RegisterType((*ApMonitorReplyLog)(nil), "fibcapi.ApMonitorReplyLog")
+	proto.RegisterType((*ApMonitorReplyPortStatus)(nil), "fibcapi.ApMonitorReplyPortStatus")
 	proto.RegisterType((*ApMonitorReply)(nil), "fibcapi.ApMonitorReply")
 	proto.RegisterType((*ApGetPortEntriesRequest)(nil), "fibcapi.ApGetPortEntriesRequest")
 	proto.RegisterType((*ApGetIdEntriesRequest)(nil), "fibcapi.ApGetIdEntriesRequest")
@@ -2044,6 +2658,10 @@ func init() {
 	proto.RegisterType((*ApGetPortStatsRequest)(nil), "fibcapi.ApGetPortStatsRequest")
 	proto.RegisterType((*ApModPortStatsRequest)(nil), "fibcapi.ApModPortStatsRequest")
 	proto.RegisterType((*ApModPortStatsReply)(nil), "fibcapi.ApModPortStatsReply")
+	proto.RegisterType((*ApAuditModsRequest)(nil), "fibcapi.ApAuditModsRequest")
+	proto.RegisterType((*ApAuditModsEntry)(nil), "fibcapi.ApAuditModsEntry")
+	proto.RegisterType((*ApGetLldpNeighborsRequest)(nil), "fibcapi.ApGetLldpNeighborsRequest")
+	proto.RegisterType((*LldpNeighbor)(nil), "fibcapi.LldpNeighbor")
 	proto.RegisterType((*VmMonitorRequest)(nil), "fibcapi.VmMonitorRequest")
 	proto.RegisterType((*VmMonitorReply)(nil), "fibcapi.VmMonitorReply")
 	proto.RegisterType((*VsMonitorRequest)(nil), "fibcapi.VsMonitorRequest")
@@ -2060,114 +2678,152 @@ func init() {
 	proto.RegisterType((*DbPortValue)(nil), "fibcapi.DbPortValue")
 	proto.RegisterType((*DbPortEntry)(nil), "fibcapi.DbPortEntry")
 	proto.RegisterType((*DbIdEntry)(nil), "fibcapi.DbIdEntry")
+	proto.RegisterType((*DbModEntry)(nil), "fibcapi.DbModEntry")
 	proto.RegisterType((*DbDpEntry)(nil), "fibcapi.DbDpEntry")
 	proto.RegisterType((*StatsEntry)(nil), "fibcapi.StatsEntry")
 	proto.RegisterType((*ApGetStatsRequest)(nil), "fibcapi.ApGetStatsRequest")
+	proto.RegisterType((*HaSyncRequest)(nil), "fibcapi.HaSyncRequest")
+	proto.RegisterType((*HaSyncReply)(nil), "fibcapi.HaSyncReply")
 }
 
 func init() { proto.RegisterFile("fibcapis.proto", fileDescriptor_5600d3affcc40088) }
 
 var fileDescriptor_5600d3affcc40088 = []byte{
-	// 1569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x96, 0x44, 0x59, 0xb6, 0xc6, 0xb2, 0x22, 0xaf, 0xed, 0x58, 0xe1, 0x09, 0x0e, 0x0c, 0xe2,
-	0x00, 0x31, 0xce, 0x41, 0x74, 0x5c, 0x15, 0x31, 0x52, 0x04, 0x6d, 0xa1, 0x84, 0x51, 0x2c, 0xc4,
-	0xb2, 0x5d, 0xa6, 0x30, 0x50, 0x14, 0xa8, 0x20, 0x7b, 0x69, 0x43, 0x08, 0x29, 0x6e, 0x49, 0x4a,
-	0xa9, 0x9e, 0xa0, 0x4f, 0xd1, 0xdb, 0x02, 0x7d, 0x8a, 0x5e, 0x16, 0x7d, 0x90, 0xde, 0xf4, 0x05,
-	0x7a, 0x5b, 0xcc, 0xfe, 0x50, 0xcb, 0x1f, 0x05, 0x09, 0xd2, 0xa2, 0x57, 0xda, 0x9f, 0x99, 0xe1,
-	0xec, 0x37, 0xdf, 0xee, 0x7e, 0x2b, 0x68, 0xde, 0x4c, 0xae, 0xae, 0xc7, 0x6c, 0x12, 0x75, 0x58,
-	0x18, 0xc4, 0x01, 0x59, 0x97, 0x7d, 0x73, 0x4b, 0x36, 0xc4, 0xb8, 0xd5, 0x00, 0x38, 0x71, 0x3d,
-	0x2f, 0x70, 0x5c, 0xe6, 0x2d, 0xac, 0x6d, 0xb8, 0x73, 0x11, 0x84, 0xf1, 0xb3, 0x60, 0x7a, 0x33,
-	0xb9, 0x15, 0x43, 0x5b, 0xb0, 0x79, 0xda, 0xed, 0x51, 0x1a, 0x8a, 0x6e, 0x13, 0x1a, 0x7d, 0x2f,
-	0x78, 0x33, 0x0c, 0xa8, 0xe8, 0xdf, 0x81, 0xad, 0x17, 0x61, 0x30, 0x63, 0xc9, 0xc0, 0x0e, 0x6c,
-	0x0b, 0xfb, 0x57, 0xf1, 0x38, 0x9e, 0x45, 0x4b, 0xaf, 0xbe, 0xf6, 0x9d, 0x3b, 0xb0, 0xd5, 0xef,
-	0x5f, 0x8c, 0xaf, 0x5f, 0xbb, 0x71, 0xf2, 0x61, 0x35, 0x30, 0x98, 0x26, 0x81, 0xfa, 0x7d, 0xcc,
-	0x46, 0x0f, 0x44, 0xa0, 0xd5, 0x63, 0xc3, 0x60, 0x3a, 0x89, 0x83, 0xd0, 0x71, 0xbf, 0x9d, 0xb9,
-	0x51, 0x6c, 0x7d, 0x01, 0xdb, 0xda, 0x18, 0xf3, 0x16, 0xa7, 0xc1, 0x2d, 0x21, 0x50, 0xf5, 0x26,
-	0x53, 0xb7, 0x5d, 0x3e, 0x28, 0x1f, 0xd6, 0x1d, 0xde, 0x26, 0xbb, 0xb0, 0xe6, 0xb9, 0x73, 0xd7,
-	0x6b, 0x57, 0x0e, 0xca, 0x87, 0x5b, 0x8e, 0xe8, 0xa0, 0x65, 0x3c, 0xf1, 0xdd, 0xb6, 0x71, 0x50,
-	0x3e, 0x34, 0x1c, 0xde, 0xb6, 0x4e, 0xa0, 0x99, 0x0e, 0x49, 0x3a, 0x60, 0x78, 0xc1, 0x2d, 0x0f,
-	0xb7, 0xd9, 0x35, 0x3b, 0x0a, 0xc4, 0xdc, 0x87, 0x4f, 0x4a, 0x0e, 0x1a, 0x3e, 0xad, 0x41, 0xf5,
-	0x2a, 0xa0, 0x0b, 0xeb, 0x1e, 0xec, 0xf7, 0xd8, 0x0b, 0x37, 0xc6, 0x85, 0x3c, 0x9f, 0xc6, 0xe1,
-	0xc4, 0x8d, 0x54, 0xde, 0xfb, 0xb0, 0xc7, 0xa7, 0x06, 0x34, 0x33, 0x61, 0xcb, 0x09, 0x9b, 0xa5,
-	0x27, 0xc8, 0xff, 0xa0, 0x1a, 0x2f, 0x98, 0x58, 0x54, 0xb3, 0xbb, 0x9f, 0x64, 0x61, 0x5f, 0x09,
-	0xd3, 0x45, 0xe7, 0xcb, 0x05, 0x73, 0x1d, 0x6e, 0x64, 0xed, 0xc1, 0x4e, 0x8f, 0xf5, 0x28, 0x55,
-	0x5f, 0x5e, 0x24, 0xb0, 0xf2, 0x61, 0xf1, 0x55, 0x39, 0xc8, 0x6d, 0x6d, 0xd7, 0x2b, 0xb2, 0xb5,
-	0x5d, 0x2f, 0x65, 0xfb, 0xb5, 0xcc, 0x4e, 0x95, 0x26, 0xc9, 0x6e, 0x07, 0xd6, 0x28, 0x1b, 0x4d,
-	0x28, 0x4f, 0xaf, 0xea, 0x54, 0x29, 0x1b, 0x50, 0xb2, 0x0f, 0xeb, 0x2c, 0x08, 0xe3, 0xd1, 0x34,
-	0x90, 0xa8, 0xd7, 0xb0, 0x7b, 0x16, 0x60, 0x31, 0xa6, 0x63, 0xdf, 0x8d, 0xda, 0xc6, 0x81, 0x71,
-	0x58, 0x77, 0x44, 0xc7, 0xfa, 0xbe, 0x8c, 0xd1, 0x87, 0x01, 0xfd, 0xc0, 0xe8, 0xff, 0x05, 0xe3,
-	0xda, 0xa7, 0xbc, 0xa6, 0xcd, 0x6e, 0x3b, 0x01, 0x6a, 0x49, 0xa8, 0xa8, 0xf3, 0xcc, 0xa7, 0x0e,
-	0x1a, 0x2d, 0x33, 0xa9, 0xea, 0x99, 0x70, 0x48, 0xd2, 0x89, 0xe0, 0xea, 0x1f, 0x40, 0xeb, 0xd2,
-	0x4f, 0x13, 0x10, 0x53, 0x0b, 0x5d, 0x95, 0x5a, 0xdd, 0xa9, 0x86, 0xee, 0x80, 0x5a, 0xbf, 0x97,
-	0xa1, 0xa9, 0x59, 0x22, 0x87, 0x8e, 0x61, 0x93, 0x67, 0x1b, 0x71, 0x42, 0x4b, 0x2e, 0xed, 0x24,
-	0xc9, 0x2d, 0xb9, 0x7e, 0x52, 0x72, 0x80, 0x25, 0x3d, 0x72, 0x04, 0x75, 0xca, 0x94, 0x57, 0x85,
-	0x7b, 0x6d, 0x2f, 0x6b, 0xcf, 0x12, 0x9f, 0x0d, 0x2a, 0xdb, 0xe4, 0x53, 0x68, 0x7a, 0xdd, 0xd1,
-	0x98, 0xd2, 0x50, 0xb9, 0x19, 0xdc, 0x6d, 0x2f, 0x71, 0xd3, 0xf7, 0xe8, 0x49, 0xc9, 0x69, 0x78,
-	0x5a, 0x9f, 0x3c, 0x00, 0x23, 0x18, 0xfb, 0xed, 0x6a, 0x26, 0xc1, 0xf3, 0xde, 0x50, 0x2e, 0x19,
-	0x59, 0x1e, 0x8c, 0xfd, 0x84, 0xe5, 0x5f, 0x41, 0xeb, 0x32, 0xca, 0xa3, 0x32, 0x8f, 0xb4, 0x82,
-	0xcd, 0xa3, 0x01, 0x25, 0x47, 0xb0, 0x4e, 0xd9, 0x88, 0x93, 0xb8, 0x92, 0x21, 0xb1, 0x3c, 0x20,
-	0x3a, 0x36, 0xe3, 0x24, 0xae, 0x51, 0xfe, 0x6b, 0xfd, 0x84, 0x38, 0x46, 0x29, 0x1c, 0x1f, 0x01,
-	0x30, 0x7e, 0x54, 0x8c, 0x82, 0x59, 0x2c, 0x61, 0xdc, 0xd5, 0x6b, 0xcc, 0x27, 0xcf, 0x67, 0x98,
-	0x66, 0x9d, 0xa9, 0x0e, 0xf9, 0x3f, 0x6c, 0x70, 0xf8, 0xfd, 0x80, 0x4a, 0x14, 0x49, 0x86, 0x18,
-	0xc3, 0x80, 0x9e, 0x94, 0x1c, 0x4e, 0xa9, 0x61, 0x40, 0x15, 0x0c, 0xc6, 0x3b, 0xc3, 0xf0, 0x0d,
-	0x10, 0x9b, 0x0d, 0x67, 0x5e, 0x3c, 0x61, 0xe3, 0x30, 0x56, 0x40, 0xb4, 0xc0, 0xf8, 0x4e, 0xc2,
-	0xb0, 0xe5, 0x60, 0x93, 0x1c, 0xc3, 0x7a, 0x28, 0x26, 0x65, 0x22, 0xf7, 0xb5, 0x44, 0x12, 0xff,
-	0x8e, 0x0c, 0xe0, 0x28, 0x63, 0xeb, 0x12, 0x5a, 0xa9, 0xf8, 0x08, 0x46, 0x3e, 0xfa, 0x11, 0xd2,
-	0x91, 0x79, 0x0b, 0x19, 0xdb, 0x5c, 0x11, 0x9b, 0x79, 0x0b, 0x47, 0x18, 0x22, 0xd7, 0xb3, 0x71,
-	0x7b, 0xd7, 0xaf, 0xb1, 0xaa, 0x36, 0xcb, 0x57, 0x35, 0xbf, 0x0d, 0xdf, 0xbf, 0xaa, 0xbf, 0x54,
-	0xa0, 0x69, 0xb3, 0x7f, 0xa4, 0xaa, 0x0f, 0x61, 0xe3, 0xc6, 0x0b, 0xde, 0x70, 0x07, 0x51, 0xda,
-	0xd6, 0xd2, 0x41, 0x5c, 0x6d, 0x68, 0x7e, 0x23, 0x9a, 0xb8, 0xf9, 0x6e, 0xf1, 0x82, 0xe3, 0xf6,
-	0xd5, 0xcc, 0xe6, 0x53, 0x57, 0x1f, 0x6e, 0xbe, 0x5b, 0xd9, 0x26, 0x4f, 0xa0, 0xee, 0x2b, 0x2c,
-	0xdb, 0x6b, 0xdc, 0xe3, 0x5f, 0xda, 0x76, 0xcd, 0xf2, 0x03, 0x97, 0x93, 0xd8, 0x2b, 0xce, 0xd5,
-	0xde, 0x99, 0x73, 0x67, 0x00, 0xcb, 0xc9, 0x02, 0x36, 0x74, 0xb2, 0x5c, 0xdb, 0xd5, 0x83, 0xe6,
-	0x39, 0xd6, 0x87, 0x0d, 0x1e, 0xaf, 0x98, 0x5b, 0x87, 0x69, 0x6e, 0x91, 0x4c, 0x2c, 0x8d, 0x53,
-	0x5b, 0xb0, 0xa9, 0xe2, 0x20, 0x97, 0x1e, 0x43, 0xdd, 0xbe, 0xc2, 0x6a, 0xbc, 0x74, 0x17, 0x85,
-	0x07, 0x26, 0xb9, 0x0b, 0xb5, 0xc9, 0x0d, 0x9e, 0xbd, 0x3c, 0x76, 0xdd, 0x91, 0x3d, 0xeb, 0x06,
-	0x36, 0x85, 0xe7, 0xe5, 0xd8, 0x9b, 0xb9, 0xc5, 0x04, 0x4c, 0x02, 0x56, 0xb4, 0x80, 0xea, 0x72,
-	0x98, 0x88, 0x3a, 0xcb, 0xcb, 0x61, 0xc0, 0x0f, 0x7c, 0x77, 0x1a, 0xbb, 0x21, 0x2f, 0xe7, 0x86,
-	0x23, 0x3a, 0xd6, 0x8f, 0x15, 0xf5, 0x21, 0x7e, 0xd9, 0x91, 0xff, 0x80, 0xf1, 0xda, 0x5d, 0x48,
-	0x22, 0x12, 0xed, 0xae, 0x95, 0xab, 0x70, 0x70, 0x9a, 0x7c, 0x84, 0xac, 0x0d, 0xdd, 0x69, 0x3c,
-	0x42, 0xe3, 0xca, 0x4a, 0xe3, 0xba, 0xb0, 0x7a, 0x29, 0x5c, 0xfc, 0x71, 0x14, 0xbb, 0x21, 0x77,
-	0x31, 0x56, 0xbb, 0x08, 0x2b, 0x74, 0x79, 0x08, 0xeb, 0x73, 0x7f, 0x84, 0xe9, 0x4b, 0x0a, 0xee,
-	0x66, 0xec, 0x39, 0x36, 0x4e, 0x6d, 0xee, 0x63, 0x07, 0xcd, 0x29, 0x13, 0xe6, 0x6b, 0x6f, 0x33,
-	0xa7, 0x4c, 0x99, 0xcf, 0x23, 0x61, 0x5e, 0x7b, 0x6b, 0xf4, 0x08, 0x3b, 0xd6, 0x23, 0x2c, 0xa5,
-	0x94, 0x04, 0xc5, 0xa5, 0x4c, 0x6a, 0x54, 0x59, 0xd6, 0xc8, 0xfa, 0xa1, 0x8c, 0x7e, 0x52, 0xa8,
-	0xbc, 0x97, 0x94, 0x21, 0x4d, 0xa8, 0x24, 0xb5, 0xad, 0x4c, 0x38, 0x55, 0x42, 0xd7, 0x0f, 0x62,
-	0x21, 0xda, 0xea, 0x8e, 0xec, 0x59, 0x4f, 0xa0, 0x8a, 0x5e, 0x64, 0x1d, 0x8c, 0xb3, 0xf3, 0x8b,
-	0x56, 0x89, 0xd4, 0x61, 0xad, 0x77, 0x31, 0x3c, 0x3f, 0x6b, 0x95, 0xb1, 0x79, 0x39, 0xc4, 0x66,
-	0x05, 0x9b, 0x36, 0x1f, 0x35, 0xf8, 0xe8, 0x2b, 0x6c, 0x56, 0xad, 0x53, 0x00, 0x7e, 0xcf, 0x8b,
-	0xfc, 0x76, 0x61, 0x8d, 0x6f, 0x68, 0xb9, 0x2e, 0xd1, 0x41, 0xad, 0xa8, 0x31, 0x94, 0xb7, 0xd1,
-	0x72, 0x8e, 0xf8, 0xf0, 0x5c, 0xaa, 0x8e, 0xe8, 0x08, 0xe9, 0xf4, 0xc2, 0x4d, 0x69, 0x98, 0xee,
-	0xaf, 0x35, 0xa8, 0xf7, 0x07, 0x4f, 0x9f, 0xf5, 0x58, 0x8f, 0x4d, 0x48, 0x0f, 0xd6, 0xe5, 0x01,
-	0x48, 0xee, 0x15, 0x09, 0x4a, 0xee, 0x63, 0xee, 0xaf, 0xd0, 0x9a, 0x56, 0xe9, 0xa8, 0x4c, 0x4e,
-	0xa0, 0xa1, 0x2b, 0x31, 0xf2, 0x6f, 0xcd, 0xb8, 0x40, 0xa2, 0x99, 0xbb, 0x45, 0x4a, 0x88, 0x47,
-	0x3a, 0x83, 0x86, 0x2e, 0x76, 0x52, 0x91, 0x0a, 0xe4, 0x98, 0x79, 0x7f, 0xe5, 0x3c, 0xcf, 0x8d,
-	0x9c, 0x42, 0x33, 0xad, 0x7a, 0xc9, 0x41, 0x3e, 0xb7, 0xb4, 0xbc, 0x35, 0xb3, 0xb4, 0xe3, 0x95,
-	0xe0, 0xd9, 0xf5, 0xf9, 0x3a, 0x07, 0xb6, 0x8a, 0x95, 0x59, 0x67, 0x56, 0x41, 0x9b, 0xfa, 0x76,
-	0x92, 0x4c, 0xd5, 0xe2, 0x24, 0xba, 0x3a, 0x1b, 0x27, 0x2b, 0xb8, 0x53, 0x71, 0x24, 0x2f, 0x79,
-	0x1c, 0x1b, 0x1a, 0xba, 0xb2, 0x26, 0x85, 0x99, 0xa7, 0x30, 0xca, 0x0b, 0xf1, 0x12, 0xf9, 0x0c,
-	0x00, 0x85, 0xb8, 0x2d, 0x62, 0x14, 0xe4, 0x6c, 0x9a, 0xe9, 0x08, 0x29, 0x1d, 0x5e, 0x22, 0x4f,
-	0xa1, 0xa1, 0x6b, 0x76, 0x52, 0x70, 0x88, 0xa4, 0x72, 0xc8, 0x0b, 0x7c, 0x9e, 0x03, 0x0a, 0xfc,
-	0x77, 0xcc, 0x21, 0xfb, 0x16, 0x28, 0x91, 0xcf, 0x61, 0x43, 0xb1, 0x9c, 0x98, 0x69, 0x34, 0x53,
-	0x7c, 0x59, 0x5e, 0x65, 0xcb, 0x4d, 0xc6, 0xa1, 0x3c, 0x86, 0x9a, 0x33, 0x9b, 0x9e, 0xf7, 0x86,
-	0xa4, 0xf0, 0x62, 0x32, 0x77, 0xd3, 0x77, 0xa0, 0xbc, 0x4e, 0x4a, 0xdd, 0x3f, 0x2a, 0x62, 0x2f,
-	0x5d, 0xfa, 0xb8, 0x97, 0xba, 0x50, 0x7f, 0xe5, 0x4e, 0x29, 0xd7, 0x1a, 0xa4, 0x99, 0xb8, 0xf0,
-	0xbe, 0xf6, 0x6d, 0xed, 0x09, 0x5a, 0x22, 0x3d, 0x68, 0xa2, 0xcf, 0xf2, 0xc1, 0x4b, 0xd2, 0x5a,
-	0x5c, 0x0c, 0x9a, 0xed, 0x82, 0x41, 0x15, 0xe2, 0x31, 0x6c, 0x62, 0x08, 0x29, 0x1b, 0x48, 0x4e,
-	0x48, 0x98, 0x7b, 0xd9, 0x11, 0xe5, 0xf9, 0x04, 0x1a, 0xe8, 0xa9, 0x04, 0x04, 0xc9, 0x6b, 0x0a,
-	0xf3, 0x6e, 0x6e, 0x48, 0x39, 0x7f, 0x22, 0x9c, 0x93, 0x7b, 0x7a, 0x3b, 0x87, 0xd1, 0x2a, 0xd8,
-	0x8a, 0x0f, 0x9d, 0xec, 0x8b, 0x46, 0x3b, 0x74, 0xd2, 0x4f, 0x18, 0xac, 0x58, 0xf7, 0x67, 0x85,
-	0x7c, 0x84, 0xc8, 0x1f, 0xeb, 0xc8, 0xb7, 0xb2, 0xba, 0x4f, 0x07, 0xa0, 0x9f, 0x42, 0x5f, 0x02,
-	0xa0, 0x74, 0x9d, 0xb6, 0x06, 0x35, 0xa4, 0x01, 0x90, 0xfe, 0xb3, 0x00, 0x59, 0xc7, 0x9d, 0xd5,
-	0x1f, 0x06, 0x5a, 0xe1, 0x96, 0xff, 0x22, 0x98, 0xed, 0x82, 0xc1, 0xbf, 0x0d, 0xc1, 0x68, 0x35,
-	0x82, 0x51, 0x0e, 0xc1, 0xdf, 0x0c, 0x81, 0xa0, 0xcd, 0x3e, 0x04, 0xc1, 0x0f, 0x06, 0xe1, 0xf9,
-	0x72, 0x03, 0xc8, 0x87, 0xdf, 0x5e, 0xc1, 0xfd, 0x30, 0x8b, 0x4c, 0xb3, 0x70, 0x58, 0x85, 0x19,
-	0x40, 0x0b, 0xc3, 0xe8, 0x2f, 0x4a, 0xa2, 0x3f, 0x00, 0xf4, 0x09, 0x2d, 0x54, 0xfe, 0x5f, 0xa2,
-	0x12, 0x39, 0x07, 0x82, 0xa1, 0x32, 0x4f, 0x9c, 0x7b, 0xc5, 0xea, 0x19, 0x8b, 0x74, 0x7f, 0xe5,
-	0x94, 0x28, 0xd6, 0x5f, 0x5d, 0x67, 0x7b, 0xf5, 0xf5, 0x6c, 0xe7, 0xae, 0xe7, 0xab, 0x1a, 0xff,
-	0x8f, 0xed, 0xe3, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xe5, 0xdb, 0xa8, 0x8d, 0x13, 0x00,
-	0x00,
+	// 2138 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x5d, 0x8f, 0x1b, 0x49,
+	0xd1, 0x9e, 0xf1, 0xd7, 0x94, 0xbd, 0x8e, 0xd3, 0xbb, 0x9b, 0x38, 0x93, 0x03, 0x96, 0x11, 0xd2,
+	0x05, 0x8e, 0x33, 0x39, 0xc3, 0x85, 0x45, 0x11, 0x87, 0x9c, 0xcc, 0x7a, 0xd7, 0xba, 0xf5, 0x6e,
+	0x6e, 0x36, 0xac, 0x40, 0x48, 0x58, 0x5e, 0x77, 0xef, 0x66, 0x94, 0x19, 0x4f, 0x9f, 0x67, 0xbc,
+	0x77, 0x7e, 0xe1, 0x15, 0x89, 0x07, 0xfe, 0x01, 0xaf, 0x48, 0xfc, 0x0a, 0x1e, 0x91, 0xf8, 0x1f,
+	0xf7, 0xc2, 0x1f, 0xe0, 0x0d, 0xa1, 0xfe, 0x98, 0x99, 0x9e, 0x0f, 0x47, 0x89, 0x02, 0xba, 0x27,
+	0x77, 0x55, 0x57, 0x55, 0xd7, 0x54, 0x55, 0xd7, 0x47, 0x1b, 0xba, 0xd7, 0xee, 0xd5, 0x62, 0x4e,
+	0xdd, 0x70, 0x40, 0x57, 0x41, 0x14, 0xa0, 0xa6, 0x84, 0xcd, 0x1d, 0xb9, 0x10, 0x78, 0xab, 0x03,
+	0x70, 0x42, 0x3c, 0x2f, 0x70, 0x08, 0xf5, 0x36, 0xd6, 0x5d, 0xb8, 0xf3, 0x22, 0x58, 0x45, 0xcf,
+	0x83, 0xe5, 0xb5, 0x7b, 0x23, 0x50, 0x3b, 0xd0, 0x3e, 0x1d, 0x8e, 0x30, 0x5e, 0x09, 0xb0, 0x0b,
+	0x9d, 0xb1, 0x17, 0x7c, 0x35, 0x0d, 0xb0, 0x80, 0xef, 0xc0, 0xce, 0xf1, 0x2a, 0x58, 0xd3, 0x04,
+	0xb1, 0x0b, 0x77, 0x05, 0xfd, 0x45, 0x34, 0x8f, 0xd6, 0x61, 0xca, 0x35, 0x56, 0xce, 0xb9, 0x03,
+	0x3b, 0xe3, 0xf1, 0x8b, 0xf9, 0xe2, 0x35, 0x89, 0x92, 0x83, 0x63, 0xc4, 0x64, 0x99, 0x08, 0x1a,
+	0x8f, 0x99, 0x36, 0xaa, 0x20, 0x04, 0xbd, 0x11, 0x9d, 0x06, 0x4b, 0x37, 0x0a, 0x56, 0x0e, 0xf9,
+	0x72, 0x4d, 0xc2, 0xc8, 0xfa, 0x02, 0xee, 0x2a, 0x38, 0xea, 0x6d, 0x4e, 0x83, 0x1b, 0x84, 0xa0,
+	0xe6, 0xb9, 0x4b, 0xd2, 0xaf, 0x1e, 0x54, 0x1f, 0x19, 0x0e, 0x5f, 0xa3, 0x3d, 0xa8, 0x7b, 0xe4,
+	0x96, 0x78, 0x7d, 0xed, 0xa0, 0xfa, 0x68, 0xc7, 0x11, 0x00, 0xa3, 0x8c, 0x5c, 0x9f, 0xf4, 0xf5,
+	0x83, 0xea, 0x23, 0xdd, 0xe1, 0x6b, 0xeb, 0x6f, 0x55, 0xe8, 0x67, 0x65, 0xa6, 0x8a, 0xa0, 0x5d,
+	0xa8, 0x63, 0x3a, 0x73, 0x31, 0x97, 0x5d, 0x73, 0x6a, 0x98, 0x4e, 0x30, 0xba, 0x0f, 0x4d, 0x1a,
+	0xac, 0xa2, 0xd9, 0x32, 0x90, 0xd2, 0x1b, 0x0c, 0x3c, 0x0b, 0xd0, 0x10, 0x1a, 0x21, 0xe7, 0xe3,
+	0x07, 0x74, 0x87, 0xe6, 0x20, 0x76, 0x40, 0x2a, 0x72, 0x20, 0x7e, 0x9c, 0x46, 0x98, 0x9c, 0xb0,
+	0x22, 0xec, 0x84, 0x9a, 0xd0, 0x7e, 0x45, 0x26, 0x18, 0xdd, 0x83, 0x86, 0x7b, 0xbd, 0x9c, 0xfb,
+	0xa4, 0x5f, 0xe7, 0x58, 0x09, 0x59, 0x7f, 0xae, 0x42, 0x37, 0xab, 0x2b, 0x1a, 0x80, 0xee, 0x05,
+	0x37, 0x5c, 0xbf, 0xb6, 0x72, 0x60, 0xc1, 0x4a, 0x27, 0x15, 0x87, 0x11, 0x22, 0x1b, 0xda, 0x5c,
+	0x79, 0xa9, 0xa8, 0xc6, 0xf9, 0xbe, 0xbf, 0x85, 0x2f, 0x55, 0xfb, 0xa4, 0xe2, 0x00, 0x4d, 0xa0,
+	0x67, 0x0d, 0xa8, 0x5d, 0x05, 0x78, 0x63, 0x3d, 0x80, 0xfb, 0x23, 0x7a, 0x4c, 0x22, 0x46, 0x78,
+	0xb4, 0x8c, 0x56, 0x2e, 0x09, 0x63, 0x57, 0xdd, 0x87, 0x7d, 0xbe, 0x35, 0xc1, 0xb9, 0x0d, 0x5b,
+	0x6e, 0xd8, 0x34, 0xbb, 0x81, 0x3e, 0x82, 0x5a, 0xb4, 0xa1, 0xc2, 0x8f, 0xdd, 0xe1, 0xfd, 0x44,
+	0x27, 0xfb, 0x4a, 0x90, 0x6e, 0x06, 0x2f, 0x37, 0x94, 0x38, 0x9c, 0xc8, 0xda, 0x87, 0xdd, 0x11,
+	0x1d, 0x61, 0x1c, 0x9f, 0xbc, 0x49, 0x22, 0x89, 0xa3, 0x27, 0x58, 0x41, 0x72, 0x5a, 0x9b, 0x78,
+	0x65, 0xb4, 0x36, 0xf1, 0x32, 0xb4, 0xbf, 0x93, 0xda, 0xc5, 0x9f, 0x9e, 0x68, 0xf7, 0x6e, 0xa1,
+	0xb0, 0x07, 0x75, 0xe6, 0x31, 0x16, 0x09, 0xfa, 0x23, 0xc3, 0x11, 0x80, 0xf5, 0xc7, 0x2a, 0x93,
+	0x3e, 0x0d, 0xf0, 0x7b, 0x4a, 0xff, 0x11, 0xe8, 0x0b, 0x1f, 0xcb, 0x28, 0xeb, 0x27, 0x86, 0x4a,
+	0xef, 0x50, 0x38, 0x78, 0xee, 0x63, 0x87, 0x11, 0xa5, 0x9a, 0xd4, 0x54, 0x4d, 0xb8, 0x49, 0xb2,
+	0x8a, 0xb0, 0xaf, 0x1f, 0x01, 0x1a, 0xd1, 0xd1, 0x1a, 0xbb, 0xd1, 0x34, 0xc0, 0xaa, 0x72, 0x2b,
+	0x12, 0x2b, 0xa7, 0xc4, 0xe8, 0x8a, 0xd0, 0xb9, 0xbb, 0xe2, 0xba, 0xb5, 0x1c, 0x09, 0x59, 0xff,
+	0xd1, 0xa0, 0xa7, 0xc8, 0xe0, 0xa6, 0x45, 0x87, 0x8c, 0x38, 0x5c, 0x7b, 0x91, 0x74, 0xee, 0x81,
+	0x12, 0x70, 0x59, 0xd2, 0x81, 0xc3, 0xe9, 0x1c, 0x49, 0x9f, 0x9e, 0xad, 0x29, 0x67, 0x27, 0xd6,
+	0xd2, 0x15, 0x6b, 0xfd, 0x10, 0x9a, 0xb7, 0xfe, 0xec, 0xda, 0x0b, 0xbe, 0xe2, 0x77, 0xa9, 0x3d,
+	0xec, 0xa5, 0x86, 0x91, 0x69, 0xac, 0x71, 0xeb, 0xb3, 0x25, 0x23, 0xc5, 0x54, 0x90, 0xd6, 0xb7,
+	0x91, 0x62, 0xca, 0x49, 0x7f, 0x0c, 0xad, 0x5b, 0x7f, 0x76, 0xc3, 0xf2, 0x5e, 0xbf, 0xc1, 0x69,
+	0xef, 0x26, 0xb4, 0x49, 0x36, 0x6c, 0xde, 0xfa, 0x7c, 0xcd, 0xa8, 0x31, 0x95, 0xd4, 0xcd, 0xad,
+	0xd4, 0x98, 0x0a, 0x6a, 0x13, 0x5a, 0xc2, 0x68, 0x04, 0xf7, 0x5b, 0xdc, 0x88, 0x09, 0x6c, 0x1d,
+	0x42, 0x43, 0x58, 0x02, 0x35, 0x41, 0x3f, 0x3b, 0x7f, 0xd1, 0xab, 0xa0, 0x36, 0x34, 0xa7, 0x93,
+	0x8b, 0x8b, 0xc9, 0xd9, 0x71, 0xaf, 0x8a, 0x0c, 0xa8, 0x1f, 0xfd, 0xe6, 0xa5, 0x33, 0xea, 0x69,
+	0x68, 0x07, 0x0c, 0x7b, 0x32, 0x1e, 0x1f, 0x39, 0x47, 0x67, 0x2f, 0x7b, 0xba, 0xf5, 0x18, 0x1e,
+	0xf0, 0x08, 0x3e, 0xf5, 0x30, 0x3d, 0x23, 0xee, 0xcd, 0xab, 0xab, 0x60, 0xf5, 0xc6, 0x38, 0xb3,
+	0xfe, 0xa9, 0x41, 0x47, 0xa5, 0x7e, 0xc7, 0x68, 0x4c, 0x5c, 0xa4, 0x97, 0xa6, 0xb0, 0x9a, 0x9a,
+	0xc2, 0xd0, 0x77, 0x00, 0x16, 0xaf, 0xe6, 0x61, 0xe8, 0x86, 0x8c, 0x43, 0xa4, 0x37, 0x43, 0x62,
+	0x94, 0x43, 0x5c, 0xcc, 0xad, 0x6d, 0x88, 0x43, 0x26, 0x18, 0x3d, 0x04, 0x83, 0x6f, 0x60, 0x12,
+	0x2e, 0xb8, 0x69, 0x0d, 0xa7, 0xc5, 0x10, 0x36, 0x09, 0x17, 0xe8, 0x7b, 0xd0, 0x0e, 0x37, 0x61,
+	0x44, 0xfc, 0x19, 0x3f, 0xb1, 0xc5, 0xb7, 0x41, 0xa0, 0xce, 0xd8, 0xa9, 0x3d, 0xd0, 0xa3, 0xc8,
+	0xeb, 0x1b, 0x5c, 0x6f, 0xb6, 0x64, 0x2c, 0xde, 0x3c, 0x8c, 0x66, 0x6b, 0x8a, 0xe7, 0x11, 0xe9,
+	0x03, 0xaf, 0x08, 0xc0, 0x50, 0xbf, 0xe6, 0x18, 0xe6, 0x1c, 0xdf, 0x0d, 0xfd, 0x79, 0xb4, 0x78,
+	0xd5, 0x6f, 0x0b, 0xe7, 0xc4, 0x30, 0xdb, 0x23, 0x5f, 0x53, 0xb2, 0x88, 0x08, 0xee, 0x77, 0x84,
+	0x2e, 0x31, 0x6c, 0x7d, 0x08, 0xbd, 0x4b, 0x3f, 0x5b, 0xb6, 0x4a, 0x2f, 0x90, 0xf5, 0xaf, 0x2a,
+	0x74, 0x2f, 0xfd, 0x4c, 0x32, 0x7f, 0x92, 0x4d, 0xce, 0x22, 0xa9, 0xef, 0x96, 0x54, 0x91, 0x6c,
+	0x3a, 0x46, 0x8f, 0xc1, 0xc0, 0x34, 0x9b, 0xd2, 0xd3, 0xb8, 0xb3, 0x69, 0xc2, 0xd3, 0xc2, 0x72,
+	0x8d, 0x7e, 0x09, 0x5d, 0x6f, 0x38, 0x9b, 0x63, 0xbc, 0x9a, 0x29, 0x25, 0xab, 0x3d, 0xdc, 0x4f,
+	0xd8, 0xd4, 0xca, 0x7e, 0x52, 0x71, 0x3a, 0x9e, 0x02, 0xa3, 0x0f, 0x41, 0x0f, 0xe6, 0x7e, 0xbf,
+	0x96, 0x53, 0xf0, 0x7c, 0x34, 0x95, 0x9f, 0xcc, 0xca, 0x4d, 0x30, 0xf7, 0x93, 0x42, 0xf1, 0x5b,
+	0xe8, 0x5d, 0x86, 0x45, 0xab, 0xdc, 0x86, 0x4a, 0x94, 0xdd, 0xb2, 0x00, 0x78, 0xcc, 0xaf, 0x26,
+	0xaf, 0x03, 0x5a, 0xae, 0x0e, 0xc8, 0xb6, 0x62, 0x60, 0x53, 0x5e, 0x07, 0x1a, 0x98, 0xff, 0xb2,
+	0x02, 0xde, 0xbd, 0x0c, 0x33, 0x76, 0xfc, 0x14, 0x80, 0xf2, 0x06, 0x63, 0x16, 0xac, 0x23, 0x69,
+	0xc6, 0x3d, 0x35, 0x4d, 0xf2, 0xcd, 0xf3, 0x35, 0x53, 0xd3, 0xa0, 0x31, 0x80, 0x7e, 0x02, 0x3c,
+	0xa4, 0x66, 0x7e, 0x80, 0xa5, 0x15, 0x51, 0x2e, 0xb7, 0x4e, 0x03, 0x7c, 0x52, 0x71, 0x78, 0x88,
+	0x4e, 0x03, 0x1c, 0x9b, 0x41, 0x7f, 0x6b, 0x33, 0xfc, 0x1e, 0x90, 0x4d, 0xa7, 0x6b, 0x2f, 0x72,
+	0xe9, 0x7c, 0x15, 0xc5, 0x86, 0xe8, 0x81, 0xfe, 0xb5, 0x34, 0xc3, 0x8e, 0xc3, 0x96, 0xe8, 0x09,
+	0x34, 0x57, 0x62, 0x53, 0x2a, 0xf2, 0x81, 0xa2, 0x48, 0xc2, 0x3f, 0x90, 0x02, 0x9c, 0x98, 0xd8,
+	0xba, 0x84, 0x5e, 0x46, 0x3e, 0x33, 0x46, 0x51, 0xfa, 0x63, 0x16, 0x8e, 0xd4, 0xdb, 0xf4, 0xb5,
+	0x5c, 0xd7, 0x90, 0x95, 0x4d, 0xbd, 0x8d, 0x23, 0x08, 0x59, 0xb9, 0xc8, 0xcb, 0x1d, 0x2d, 0x5e,
+	0x33, 0xaf, 0xda, 0xb4, 0xe8, 0xd5, 0x62, 0xee, 0x78, 0x77, 0xaf, 0xfe, 0x43, 0x83, 0xae, 0x4d,
+	0xbf, 0x15, 0xaf, 0x7e, 0x0c, 0x2d, 0x56, 0x1a, 0x38, 0x83, 0x5e, 0x5e, 0x1e, 0x18, 0xf9, 0xb5,
+	0x58, 0xb2, 0xcb, 0xc7, 0x13, 0x3e, 0xa7, 0xaf, 0x6d, 0x49, 0xfa, 0xec, 0xf2, 0xdd, 0xc8, 0x35,
+	0x7a, 0x0a, 0x86, 0x1f, 0xdb, 0x52, 0x16, 0xa0, 0x87, 0xca, 0x75, 0xcd, 0xc7, 0x07, 0xfb, 0x9c,
+	0x84, 0x3e, 0x8e, 0xb9, 0xc6, 0x5b, 0xc7, 0xdc, 0x19, 0x40, 0xba, 0x59, 0x12, 0x0d, 0x83, 0x7c,
+	0xac, 0xed, 0xa9, 0x42, 0x8b, 0x31, 0x36, 0x86, 0x16, 0x97, 0x57, 0x1e, 0x5b, 0x8f, 0xb2, 0xb1,
+	0x85, 0x72, 0xb2, 0x94, 0x98, 0xda, 0x81, 0x76, 0x2c, 0x87, 0xc5, 0xd2, 0x21, 0x18, 0xf6, 0x15,
+	0xf3, 0xc6, 0xe7, 0x64, 0xb3, 0xb5, 0xe3, 0x90, 0x25, 0x45, 0xcb, 0x74, 0xc5, 0xd7, 0xd0, 0x16,
+	0x9c, 0x97, 0x73, 0x6f, 0x4d, 0xca, 0x03, 0xb0, 0xb4, 0x8d, 0x50, 0x8a, 0x8d, 0x9e, 0x56, 0xb4,
+	0x09, 0xef, 0x99, 0xc8, 0x32, 0x22, 0x2b, 0xee, 0xce, 0x96, 0x23, 0x00, 0xeb, 0xaf, 0x5a, 0x7c,
+	0x90, 0x68, 0x6a, 0x7e, 0x00, 0xfa, 0x6b, 0xb2, 0xe9, 0x57, 0x73, 0x1f, 0x9a, 0x7c, 0x85, 0xc3,
+	0xb6, 0xd1, 0x27, 0x2c, 0x6a, 0x57, 0x64, 0x19, 0xcd, 0x18, 0xb1, 0xb6, 0x95, 0xd8, 0x10, 0x54,
+	0x9f, 0x0b, 0x16, 0x7f, 0x1e, 0x46, 0x64, 0xc5, 0x59, 0xf4, 0xed, 0x2c, 0x82, 0x8a, 0xb1, 0x7c,
+	0xcc, 0x9b, 0x1f, 0xa6, 0x7e, 0xbf, 0x96, 0x73, 0xa2, 0x62, 0x1b, 0xd6, 0x00, 0x31, 0x80, 0x91,
+	0x63, 0x2a, 0xc8, 0xeb, 0x6f, 0x22, 0xc7, 0x34, 0x26, 0xbf, 0x0d, 0x05, 0x79, 0xe3, 0x8d, 0xd2,
+	0x43, 0x06, 0x58, 0x9f, 0x32, 0x57, 0xca, 0xae, 0xba, 0xdc, 0x95, 0x89, 0x8f, 0x34, 0xa5, 0x0d,
+	0xf9, 0x03, 0x80, 0x7d, 0x35, 0x0d, 0x52, 0xbe, 0xa2, 0x1b, 0x3f, 0x52, 0xae, 0xa6, 0xb6, 0xa5,
+	0x73, 0x4b, 0x2e, 0xe6, 0x40, 0xbd, 0x98, 0xfa, 0xb6, 0x6e, 0x2c, 0xb9, 0x96, 0xd6, 0x5f, 0xaa,
+	0x60, 0x24, 0xb3, 0xc6, 0x3b, 0x4d, 0x23, 0xa8, 0x0b, 0x5a, 0x12, 0x5b, 0x9a, 0x2b, 0x9b, 0x63,
+	0x3f, 0x88, 0x88, 0xec, 0x89, 0x24, 0x64, 0x3d, 0x85, 0x1a, 0xe3, 0x4a, 0x7b, 0x3a, 0x03, 0xea,
+	0xa3, 0x17, 0xd3, 0xf3, 0x33, 0xd1, 0xd1, 0x5d, 0x4e, 0xd9, 0x52, 0x63, 0x4b, 0x9b, 0x63, 0x75,
+	0x8e, 0xbd, 0x60, 0xcb, 0x9a, 0x75, 0x0a, 0xc0, 0x5b, 0x75, 0xa1, 0xdf, 0x1e, 0xd4, 0x45, 0x9f,
+	0x29, 0xec, 0x2a, 0x00, 0x36, 0xe1, 0x2a, 0x37, 0x84, 0xaf, 0x19, 0xe5, 0x2d, 0xf3, 0x8f, 0xec,
+	0x96, 0x05, 0x20, 0xa6, 0x9f, 0x63, 0x92, 0x19, 0x43, 0xac, 0xcf, 0x60, 0xe7, 0x64, 0x7e, 0xb1,
+	0x59, 0x2e, 0x24, 0x82, 0x5d, 0x91, 0x65, 0x80, 0x15, 0xff, 0x35, 0x18, 0x28, 0xaf, 0x08, 0x0d,
+	0x16, 0xaf, 0xa4, 0x07, 0x05, 0x60, 0xfd, 0x49, 0x83, 0x76, 0x2c, 0x40, 0xe6, 0x87, 0x90, 0x7c,
+	0x29, 0x5d, 0xc8, 0x96, 0x2c, 0xb6, 0x5d, 0x3c, 0x23, 0x62, 0xf2, 0xeb, 0x6b, 0x07, 0x7a, 0x2e,
+	0xb6, 0xe3, 0x61, 0xcc, 0x70, 0xe3, 0xb9, 0x11, 0xfd, 0x1c, 0x3a, 0xfc, 0x9a, 0xc6, 0x4c, 0xfa,
+	0x81, 0x5e, 0x12, 0x82, 0x82, 0xad, 0x4d, 0xe5, 0x92, 0x31, 0x7e, 0x02, 0x80, 0x69, 0xc2, 0x56,
+	0x2b, 0x9c, 0x25, 0x1d, 0xe9, 0x18, 0x38, 0x1e, 0x45, 0xd1, 0xcf, 0xa0, 0xed, 0x07, 0xa9, 0x7e,
+	0xf5, 0x03, 0x3d, 0x93, 0x65, 0xd3, 0xf8, 0x74, 0xc0, 0x0f, 0x12, 0x0d, 0x13, 0x63, 0x34, 0x14,
+	0x63, 0x0c, 0xbf, 0x69, 0x82, 0x31, 0x9e, 0x3c, 0x7b, 0x3e, 0xa2, 0x23, 0xea, 0xa2, 0x11, 0x34,
+	0x65, 0x35, 0x43, 0x0f, 0xca, 0xc6, 0x6d, 0x6e, 0x6f, 0xf3, 0xfe, 0x96, 0x49, 0xdc, 0xaa, 0x3c,
+	0xae, 0xa2, 0x13, 0xe8, 0xa8, 0x93, 0x29, 0xfa, 0xae, 0x42, 0x5c, 0x32, 0xb2, 0x9a, 0x7b, 0x65,
+	0x93, 0x21, 0x97, 0x74, 0x06, 0x1d, 0x75, 0xf8, 0xcb, 0x48, 0x2a, 0x19, 0x4f, 0xcd, 0x0f, 0xb6,
+	0xee, 0x73, 0xdd, 0xd0, 0x29, 0x74, 0xb3, 0xaf, 0x00, 0xe8, 0xa0, 0xa8, 0x5b, 0x76, 0xdc, 0x37,
+	0x4b, 0x1d, 0xc8, 0xb5, 0x1b, 0xf3, 0xef, 0x9c, 0xd8, 0xb1, 0xac, 0xdc, 0x77, 0xe6, 0x5f, 0x14,
+	0xcc, 0x92, 0xf8, 0x51, 0xe4, 0xd8, 0x74, 0x8b, 0x1c, 0x9b, 0xbe, 0x41, 0x8e, 0x4d, 0x53, 0x39,
+	0x36, 0x74, 0xd4, 0x97, 0x06, 0x54, 0xaa, 0x79, 0xc6, 0x46, 0xc5, 0x87, 0x89, 0x0a, 0xfa, 0x0c,
+	0x80, 0x3d, 0x4c, 0xd8, 0x42, 0x46, 0x89, 0xce, 0xa6, 0x99, 0x95, 0x90, 0x79, 0x97, 0xa8, 0xa0,
+	0x67, 0xd0, 0x51, 0xdf, 0x30, 0x50, 0x49, 0x45, 0xc8, 0xe8, 0x50, 0x7c, 0xf0, 0xe0, 0x3a, 0xb0,
+	0x07, 0x8f, 0xb7, 0xd4, 0x21, 0xff, 0x36, 0x52, 0x41, 0xbf, 0x82, 0x56, 0x9c, 0x32, 0x90, 0x99,
+	0xb5, 0x66, 0x26, 0x5e, 0xd2, 0x1b, 0x93, 0x66, 0x2c, 0x6e, 0xca, 0x27, 0xd0, 0x70, 0xd6, 0xcb,
+	0xf3, 0xd1, 0x14, 0x95, 0x76, 0x19, 0xe6, 0x5e, 0xb6, 0xa1, 0x91, 0xbd, 0x41, 0x05, 0x1d, 0x83,
+	0x91, 0xbc, 0x13, 0xa0, 0x87, 0x65, 0xaf, 0x07, 0xb1, 0x84, 0x07, 0x5b, 0x9f, 0x16, 0xb8, 0x02,
+	0x5f, 0x40, 0x2f, 0x3f, 0x1b, 0x23, 0x2b, 0xfb, 0x25, 0x65, 0x83, 0xb3, 0xa9, 0x0c, 0x46, 0xca,
+	0x36, 0x13, 0x39, 0xfc, 0xb7, 0x26, 0xee, 0xf9, 0xa5, 0xcf, 0xee, 0xf9, 0x10, 0x8c, 0x0b, 0xb2,
+	0xc4, 0xbc, 0xa9, 0x45, 0xdd, 0x84, 0x8b, 0xc3, 0xe6, 0x6e, 0x16, 0x8e, 0xcd, 0x3a, 0x82, 0x2e,
+	0xe3, 0x49, 0xdf, 0x63, 0x51, 0x76, 0xe8, 0x13, 0x48, 0xb3, 0x5f, 0x82, 0x8c, 0x45, 0x1c, 0x42,
+	0x9b, 0x89, 0x90, 0x45, 0x10, 0x15, 0xca, 0xa2, 0xb9, 0x9f, 0xc7, 0xc4, 0x9c, 0x4f, 0xa1, 0xc3,
+	0x38, 0xe3, 0x82, 0x88, 0x8a, 0x35, 0xd2, 0xbc, 0x57, 0x40, 0xc5, 0xcc, 0xbf, 0x10, 0xcc, 0x49,
+	0x43, 0x78, 0xb7, 0xe0, 0xbf, 0xad, 0x2e, 0x2d, 0x4d, 0x88, 0x97, 0xfe, 0xd6, 0x84, 0x98, 0x9d,
+	0x95, 0xb9, 0xe5, 0xff, 0x1e, 0x5b, 0x3e, 0x64, 0x96, 0x7f, 0xa2, 0x5a, 0xbe, 0x97, 0x1f, 0x30,
+	0xcc, 0xfd, 0x3c, 0x26, 0x67, 0x80, 0x78, 0x80, 0x50, 0xbe, 0x21, 0x46, 0x99, 0xf7, 0x0a, 0xa8,
+	0xf4, 0x46, 0x70, 0xe6, 0xf8, 0x3d, 0x1b, 0xed, 0x16, 0x28, 0x27, 0x4b, 0xb3, 0x5f, 0x82, 0xfc,
+	0xbf, 0x59, 0x30, 0xdc, 0x6e, 0xc1, 0xb0, 0x60, 0xc1, 0x6f, 0x74, 0x61, 0x41, 0x9b, 0xbe, 0x8f,
+	0x05, 0xdf, 0xdb, 0x08, 0x47, 0xe9, 0x05, 0x90, 0x2f, 0x0c, 0xfb, 0x25, 0xb5, 0x6b, 0x1d, 0x9a,
+	0x66, 0x29, 0x3a, 0x16, 0x33, 0x81, 0x1e, 0x13, 0xa3, 0x3e, 0x5d, 0x20, 0x75, 0xd2, 0x54, 0x37,
+	0x14, 0x51, 0xc5, 0x3f, 0x31, 0x2a, 0xe8, 0x1c, 0x10, 0x13, 0x95, 0x9b, 0xa5, 0x1f, 0x94, 0x8f,
+	0x69, 0xd4, 0x53, 0x53, 0x6f, 0xd9, 0xa4, 0xfc, 0xbf, 0xf7, 0xb3, 0xbd, 0xbd, 0x75, 0xb0, 0x0b,
+	0xad, 0xc3, 0xf0, 0x48, 0xb8, 0xf9, 0x64, 0xce, 0xdc, 0x7c, 0x08, 0x35, 0xd6, 0xa2, 0xa1, 0x34,
+	0xaa, 0x33, 0x4d, 0x9f, 0xb9, 0x57, 0xc0, 0x4b, 0x31, 0x57, 0x0d, 0xfe, 0x4f, 0xd2, 0x4f, 0xff,
+	0x3b, 0x00, 0xc2, 0x4e, 0x87, 0x14, 0x73, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2194,6 +2850,8 @@ type FIBCApApiClient interface {
 	DelIDEntry(ctx context.Context, in *DbIdEntry, opts ...grpc.CallOption) (*ApDelIdEntryReply, error)
 	GetStats(ctx context.Context, in *ApGetStatsRequest, opts ...grpc.CallOption) (FIBCApApi_GetStatsClient, error)
 	RunOAM(ctx context.Context, in *OAM_Request, opts ...grpc.CallOption) (*OAMReplyAck, error)
+	AuditMods(ctx context.Context, in *ApAuditModsRequest, opts ...grpc.CallOption) (FIBCApApi_AuditModsClient, error)
+	GetLldpNeighbors(ctx context.Context, in *ApGetLldpNeighborsRequest, opts ...grpc.CallOption) (FIBCApApi_GetLldpNeighborsClient, error)
 }
 
 type fIBCApApiClient struct {
@@ -2450,6 +3108,70 @@ func (c *fIBCApApiClient) RunOAM(ctx context.Context, in *OAM_Request, opts ...g
 	return out, nil
 }
 
+func (c *fIBCApApiClient) AuditMods(ctx context.Context, in *ApAuditModsRequest, opts ...grpc.CallOption) (FIBCApApi_AuditModsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FIBCApApi_serviceDesc.Streams[6], "/fibcapi.FIBCApApi/AuditMods", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fIBCApApiAuditModsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FIBCApApi_AuditModsClient interface {
+	Recv() (*ApAuditModsEntry, error)
+	grpc.ClientStream
+}
+
+type fIBCApApiAuditModsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fIBCApApiAuditModsClient) Recv() (*ApAuditModsEntry, error) {
+	m := new(ApAuditModsEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fIBCApApiClient) GetLldpNeighbors(ctx context.Context, in *ApGetLldpNeighborsRequest, opts ...grpc.CallOption) (FIBCApApi_GetLldpNeighborsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FIBCApApi_serviceDesc.Streams[7], "/fibcapi.FIBCApApi/GetLldpNeighbors", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fIBCApApiGetLldpNeighborsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FIBCApApi_GetLldpNeighborsClient interface {
+	Recv() (*LldpNeighbor, error)
+	grpc.ClientStream
+}
+
+type fIBCApApiGetLldpNeighborsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fIBCApApiGetLldpNeighborsClient) Recv() (*LldpNeighbor, error) {
+	m := new(LldpNeighbor)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FIBCApApiServer is the server API for FIBCApApi service.
 type FIBCApApiServer interface {
 	Monitor(*ApMonitorRequest, FIBCApApi_MonitorServer) error
@@ -2464,6 +3186,8 @@ type FIBCApApiServer interface {
 	DelIDEntry(context.Context, *DbIdEntry) (*ApDelIdEntryReply, error)
 	GetStats(*ApGetStatsRequest, FIBCApApi_GetStatsServer) error
 	RunOAM(context.Context, *OAM_Request) (*OAMReplyAck, error)
+	AuditMods(*ApAuditModsRequest, FIBCApApi_AuditModsServer) error
+	GetLldpNeighbors(*ApGetLldpNeighborsRequest, FIBCApApi_GetLldpNeighborsServer) error
 }
 
 // UnimplementedFIBCApApiServer can be embedded to have forward compatible implementations.
@@ -2506,6 +3230,12 @@ func (*UnimplementedFIBCApApiServer) GetStats(req *ApGetStatsRequest, srv FIBCAp
 func (*UnimplementedFIBCApApiServer) RunOAM(ctx context.Context, req *OAM_Request) (*OAMReplyAck, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunOAM not implemented")
 }
+func (*UnimplementedFIBCApApiServer) AuditMods(req *ApAuditModsRequest, srv FIBCApApi_AuditModsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AuditMods not implemented")
+}
+func (*UnimplementedFIBCApApiServer) GetLldpNeighbors(req *ApGetLldpNeighborsRequest, srv FIBCApApi_GetLldpNeighborsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLldpNeighbors not implemented")
+}
 
 func RegisterFIBCApApiServer(s *grpc.Server, srv FIBCApApiServer) {
 	s.RegisterService(&_FIBCApApi_serviceDesc, srv)
@@ -2745,6 +3475,48 @@ func _FIBCApApi_RunOAM_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FIBCApApi_AuditMods_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApAuditModsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FIBCApApiServer).AuditMods(m, &fIBCApApiAuditModsServer{stream})
+}
+
+type FIBCApApi_AuditModsServer interface {
+	Send(*ApAuditModsEntry) error
+	grpc.ServerStream
+}
+
+type fIBCApApiAuditModsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fIBCApApiAuditModsServer) Send(m *ApAuditModsEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FIBCApApi_GetLldpNeighbors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApGetLldpNeighborsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FIBCApApiServer).GetLldpNeighbors(m, &fIBCApApiGetLldpNeighborsServer{stream})
+}
+
+type FIBCApApi_GetLldpNeighborsServer interface {
+	Send(*LldpNeighbor) error
+	grpc.ServerStream
+}
+
+type fIBCApApiGetLldpNeighborsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fIBCApApiGetLldpNeighborsServer) Send(m *LldpNeighbor) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _FIBCApApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fibcapi.FIBCApApi",
 	HandlerType: (*FIBCApApiServer)(nil),
@@ -2805,6 +3577,16 @@ var _FIBCApApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _FIBCApApi_GetStats_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AuditMods",
+			Handler:       _FIBCApApi_AuditMods_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLldpNeighbors",
+			Handler:       _FIBCApApi_GetLldpNeighbors_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "fibcapis.proto",
 }
@@ -3648,3 +4430,102 @@ var _FIBCDpApi_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "fibcapis.proto",
 }
+
+// FIBCHaApiClient is the client API for FIBCHaApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FIBCHaApiClient interface {
+	Sync(ctx context.Context, in *HaSyncRequest, opts ...grpc.CallOption) (FIBCHaApi_SyncClient, error)
+}
+
+type fIBCHaApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFIBCHaApiClient(cc *grpc.ClientConn) FIBCHaApiClient {
+	return &fIBCHaApiClient{cc}
+}
+
+func (c *fIBCHaApiClient) Sync(ctx context.Context, in *HaSyncRequest, opts ...grpc.CallOption) (FIBCHaApi_SyncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FIBCHaApi_serviceDesc.Streams[0], "/fibcapi.FIBCHaApi/Sync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fIBCHaApiSyncClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FIBCHaApi_SyncClient interface {
+	Recv() (*HaSyncReply, error)
+	grpc.ClientStream
+}
+
+type fIBCHaApiSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *fIBCHaApiSyncClient) Recv() (*HaSyncReply, error) {
+	m := new(HaSyncReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FIBCHaApiServer is the server API for FIBCHaApi service.
+type FIBCHaApiServer interface {
+	Sync(*HaSyncRequest, FIBCHaApi_SyncServer) error
+}
+
+// UnimplementedFIBCHaApiServer can be embedded to have forward compatible implementations.
+type UnimplementedFIBCHaApiServer struct {
+}
+
+func (*UnimplementedFIBCHaApiServer) Sync(req *HaSyncRequest, srv FIBCHaApi_SyncServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+
+func RegisterFIBCHaApiServer(s *grpc.Server, srv FIBCHaApiServer) {
+	s.RegisterService(&_FIBCHaApi_serviceDesc, srv)
+}
+
+func _FIBCHaApi_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HaSyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FIBCHaApiServer).Sync(m, &fIBCHaApiSyncServer{stream})
+}
+
+type FIBCHaApi_SyncServer interface {
+	Send(*HaSyncReply) error
+	grpc.ServerStream
+}
+
+type fIBCHaApiSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *fIBCHaApiSyncServer) Send(m *HaSyncReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _FIBCHaApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fibcapi.FIBCHaApi",
+	HandlerType: (*FIBCHaApiServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sync",
+			Handler:       _FIBCHaApi_Sync_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fibcapis.proto",
+}
@@ -20,12 +20,42 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='fibcapi',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\x0e\x66ibcapis.proto\x12\x07\x66ibcapi\x1a\rfibcapi.proto\"\x0c\n\nHelloReply\"\x11\n\x0fPortConfigReply\"\r\n\x0bL2AddrReply\"\x0e\n\x0c\x46lowModReply\"\x0f\n\rGroupModReply\"\x13\n\x11L2AddrStatusReply\"\x0e\n\x0c\x46\x46HelloReply\"\x0f\n\rFFPacketReply\"\x11\n\x0f\x46\x46PacketInReply\"\x13\n\x11\x46\x46PortStatusReply\"\x12\n\x10\x41pMonitorRequest\">\n\x11\x41pMonitorReplyLog\x12\x0c\n\x04line\x18\x01 \x01(\t\x12\r\n\x05level\x18\x02 \x01(\r\x12\x0c\n\x04time\x18\x03 \x01(\x03\"C\n\x0e\x41pMonitorReply\x12)\n\x03log\x18\x01 \x01(\x0b\x32\x1a.fibcapi.ApMonitorReplyLogH\x00\x42\x06\n\x04\x62ody\"\x19\n\x17\x41pGetPortEntriesRequest\"\x17\n\x15\x41pGetIdEntriesRequest\">\n\x15\x41pGetDpEntriesRequest\x12%\n\x04type\x18\x01 \x01(\x0e\x32\x17.fibcapi.DbDpEntry.Type\"\x15\n\x13\x41pAddPortEntryReply\"\x13\n\x11\x41pAddIdEntryReply\"\x15\n\x13\x41pDelPortEntryReply\"\x13\n\x11\x41pDelIdEntryReply\"F\n\x15\x41pGetPortStatsRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\r\n\x05names\x18\x03 \x03(\t\"m\n\x15\x41pModPortStatsRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12%\n\x03\x63md\x18\x03 \x01(\x0e\x32\x18.fibcapi.FFPortStats.Cmd\x12\r\n\x05names\x18\x04 \x03(\t\"\x15\n\x13\x41pModPortStatsReply\"!\n\x10VmMonitorRequest\x12\r\n\x05re_id\x18\x01 \x01(\t\"\xc1\x01\n\x0eVmMonitorReply\x12*\n\x0bport_status\x18\x01 \x01(\x0b\x32\x13.fibcapi.PortStatusH\x00\x12&\n\tdp_status\x18\x02 \x01(\x0b\x32\x11.fibcapi.DpStatusH\x00\x12/\n\x0el2_addr_status\x18\x03 \x01(\x0b\x32\x15.fibcapi.L2AddrStatusH\x00\x12\"\n\x03oam\x18\x04 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"K\n\x10VsMonitorRequest\x12\r\n\x05vs_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"\x90\x01\n\x0eVsMonitorReply\x12*\n\npacket_out\x18\x01 \x01(\x0b\x32\x14.fibcapi.FFPacketOutH\x00\x12&\n\x08port_mod\x18\x02 \x01(\x0b\x32\x12.fibcapi.FFPortModH\x00\x12\"\n\x03oam\x18\x03 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"P\n\x12\x44pMultipartRequest\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12-\n\x07request\x18\x02 \x01(\x0b\x32\x1c.fibcapi.FFMultipart.Request\"J\n\x10\x44pMultipartReply\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12)\n\x05reply\x18\x02 \x01(\x0b\x32\x1a.fibcapi.FFMultipart.Reply\"\x15\n\x13\x44pMultipartReplyAck\"K\n\x10\x44pMonitorRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"\x90\x02\n\x0e\x44pMonitorReply\x12*\n\npacket_out\x18\x01 \x01(\x0b\x32\x14.fibcapi.FFPacketOutH\x00\x12&\n\x08port_mod\x18\x02 \x01(\x0b\x32\x12.fibcapi.FFPortModH\x00\x12$\n\x08\x66low_mod\x18\x03 \x01(\x0b\x32\x10.fibcapi.FlowModH\x00\x12&\n\tgroup_mod\x18\x04 \x01(\x0b\x32\x11.fibcapi.GroupModH\x00\x12\x30\n\tmultipart\x18\x05 \x01(\x0b\x32\x1b.fibcapi.DpMultipartRequestH\x00\x12\"\n\x03oam\x18\x06 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"@\n\nOAMRequest\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12%\n\x07request\x18\x02 \x01(\x0b\x32\x14.fibcapi.OAM.Request\":\n\x08OAMReply\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12!\n\x05reply\x18\x02 \x01(\x0b\x32\x12.fibcapi.OAM.Reply\"\r\n\x0bOAMReplyAck\"*\n\tDbPortKey\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\x0e\n\x06ifname\x18\x02 \x01(\t\"K\n\x0b\x44\x62PortValue\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\r\n\x05\x65nter\x18\x04 \x01(\x08\"\xf3\x01\n\x0b\x44\x62PortEntry\x12\x1f\n\x03key\x18\x01 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12&\n\nparent_key\x18\x02 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12&\n\nmaster_key\x18\x03 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12%\n\x07vm_port\x18\x04 \x01(\x0b\x32\x14.fibcapi.DbPortValue\x12%\n\x07\x64p_port\x18\x05 \x01(\x0b\x32\x14.fibcapi.DbPortValue\x12%\n\x07vs_port\x18\x06 \x01(\x0b\x32\x14.fibcapi.DbPortValue\")\n\tDbIdEntry\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\r\n\x05\x64p_id\x18\x02 \x01(\x04\"\x8b\x01\n\tDbDpEntry\x12%\n\x04type\x18\x01 \x01(\x0e\x32\x17.fibcapi.DbDpEntry.Type\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0e\n\x06remote\x18\x03 \x01(\t\";\n\x04Type\x12\x07\n\x03NOP\x10\x00\x12\t\n\x05\x41PMON\x10\x01\x12\t\n\x05VMMON\x10\x02\x12\t\n\x05\x44PMON\x10\x03\x12\t\n\x05VSMON\x10\x04\"8\n\nStatsEntry\x12\r\n\x05group\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\x04\"\x13\n\x11\x41pGetStatsRequest2\xc9\x06\n\tFIBCApApi\x12\x41\n\x07Monitor\x12\x19.fibcapi.ApMonitorRequest\x1a\x17.fibcapi.ApMonitorReply\"\x00\x30\x01\x12H\n\x0cGetPortStats\x12\x1e.fibcapi.ApGetPortStatsRequest\x1a\x14.fibcapi.FFPortStats\"\x00\x30\x01\x12N\n\x0cModPortStats\x12\x1e.fibcapi.ApModPortStatsRequest\x1a\x1c.fibcapi.ApModPortStatsReply\"\x00\x12L\n\x0eGetPortEntries\x12 .fibcapi.ApGetPortEntriesRequest\x1a\x14.fibcapi.DbPortEntry\"\x00\x30\x01\x12\x46\n\x0cGetIDEntries\x12\x1e.fibcapi.ApGetIdEntriesRequest\x1a\x12.fibcapi.DbIdEntry\"\x00\x30\x01\x12\x46\n\x0cGetDpEntries\x12\x1e.fibcapi.ApGetDpEntriesRequest\x1a\x12.fibcapi.DbDpEntry\"\x00\x30\x01\x12\x44\n\x0c\x41\x64\x64PortEntry\x12\x14.fibcapi.DbPortEntry\x1a\x1c.fibcapi.ApAddPortEntryReply\"\x00\x12>\n\nAddIDEntry\x12\x12.fibcapi.DbIdEntry\x1a\x1a.fibcapi.ApAddIdEntryReply\"\x00\x12\x42\n\x0c\x44\x65lPortEntry\x12\x12.fibcapi.DbPortKey\x1a\x1c.fibcapi.ApDelPortEntryReply\"\x00\x12>\n\nDelIDEntry\x12\x12.fibcapi.DbIdEntry\x1a\x1a.fibcapi.ApDelIdEntryReply\"\x00\x12?\n\x08GetStats\x12\x1a.fibcapi.ApGetStatsRequest\x1a\x13.fibcapi.StatsEntry\"\x00\x30\x01\x12\x36\n\x06RunOAM\x12\x14.fibcapi.OAM.Request\x1a\x14.fibcapi.OAMReplyAck\"\x00\x32\xf7\x02\n\tFIBCVmApi\x12\x32\n\tSendHello\x12\x0e.fibcapi.Hello\x1a\x13.fibcapi.HelloReply\"\x00\x12\x41\n\x0eSendPortConfig\x12\x13.fibcapi.PortConfig\x1a\x18.fibcapi.PortConfigReply\"\x00\x12\x38\n\x0bSendFlowMod\x12\x10.fibcapi.FlowMod\x1a\x15.fibcapi.FlowModReply\"\x00\x12;\n\x0cSendGroupMod\x12\x11.fibcapi.GroupMod\x1a\x16.fibcapi.GroupModReply\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.VmMonitorRequest\x1a\x17.fibcapi.VmMonitorReply\"\x00\x30\x01\x32\xbf\x02\n\tFIBCVsApi\x12\x36\n\tSendHello\x12\x10.fibcapi.FFHello\x1a\x15.fibcapi.FFHelloReply\"\x00\x12;\n\x0cSendFFPacket\x12\x11.fibcapi.FFPacket\x1a\x16.fibcapi.FFPacketReply\"\x00\x12?\n\x0cSendPacketIn\x12\x13.fibcapi.FFPacketIn\x1a\x18.fibcapi.FFPacketInReply\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.VsMonitorRequest\x1a\x17.fibcapi.VsMonitorReply\"\x00\x30\x01\x32\xe5\x03\n\tFIBCDpApi\x12\x36\n\tSendHello\x12\x10.fibcapi.FFHello\x1a\x15.fibcapi.FFHelloReply\"\x00\x12?\n\x0cSendPacketIn\x12\x13.fibcapi.FFPacketIn\x1a\x18.fibcapi.FFPacketInReply\"\x00\x12\x45\n\x0eSendPortStatus\x12\x15.fibcapi.FFPortStatus\x1a\x1a.fibcapi.FFPortStatusReply\"\x00\x12I\n\x10SendL2AddrStatus\x12\x17.fibcapi.FFL2AddrStatus\x1a\x1a.fibcapi.L2AddrStatusReply\"\x00\x12O\n\x12SendMultipartReply\x12\x19.fibcapi.DpMultipartReply\x1a\x1c.fibcapi.DpMultipartReplyAck\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.DpMonitorRequest\x1a\x17.fibcapi.DpMonitorReply\"\x00\x30\x01\x62\x06proto3')
+  serialized_pb=_b('\n\x0e\x66ibcapis.proto\x12\x07\x66ibcapi\x1a\rfibcapi.proto\"\x0c\n\nHelloReply\"\x11\n\x0fPortConfigReply\"\r\n\x0bL2AddrReply\"\x0e\n\x0c\x46lowModReply\"\x0f\n\rGroupModReply\"\x13\n\x11L2AddrStatusReply\"\x0e\n\x0c\x46\x46HelloReply\"\x0f\n\rFFPacketReply\"\x11\n\x0f\x46\x46PacketInReply\"\x13\n\x11\x46\x46PortStatusReply\"\x12\n\x10\x41pMonitorRequest\">\n\x11\x41pMonitorReplyLog\x12\x0c\n\x04line\x18\x01 \x01(\t\x12\r\n\x05level\x18\x02 \x01(\r\x12\x0c\n\x04time\x18\x03 \x01(\x03\"\x85\x01\n\x18\x41pMonitorReplyPortStatus\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12*\n\x06status\x18\x03 \x01(\x0e\x32\x1a.fibcapi.PortStatus.Status\x12\r\n\x05re_id\x18\x04 \x01(\t\x12\x0e\n\x06ifname\x18\x05 \x01(\t\"}\n\x0e\x41pMonitorReply\x12)\n\x03log\x18\x01 \x01(\x0b\x32\x1a.fibcapi.ApMonitorReplyLogH\x00\x12\x38\n\x0bport_status\x18\x02 \x01(\x0b\x32!.fibcapi.ApMonitorReplyPortStatusH\x00\x42\x06\n\x04\x62ody\"\x19\n\x17\x41pGetPortEntriesRequest\"\x17\n\x15\x41pGetIdEntriesRequest\">\n\x15\x41pGetDpEntriesRequest\x12%\n\x04type\x18\x01 \x01(\x0e\x32\x17.fibcapi.DbDpEntry.Type\"\x15\n\x13\x41pAddPortEntryReply\"\x13\n\x11\x41pAddIdEntryReply\"\x15\n\x13\x41pDelPortEntryReply\"\x13\n\x11\x41pDelIdEntryReply\"F\n\x15\x41pGetPortStatsRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\r\n\x05names\x18\x03 \x03(\t\"m\n\x15\x41pModPortStatsRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12%\n\x03\x63md\x18\x03 \x01(\x0e\x32\x18.fibcapi.FFPortStats.Cmd\x12\r\n\x05names\x18\x04 \x03(\t\"\x15\n\x13\x41pModPortStatsReply\"3\n\x12\x41pAuditModsRequest\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\x0e\n\x06repair\x18\x02 \x01(\x08\"\xbe\x02\n\x10\x41pAuditModsEntry\x12\x30\n\x06result\x18\x01 \x01(\x0e\x32 .fibcapi.ApAuditModsEntry.Result\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\r\n\x05\x64p_id\x18\x03 \x01(\x04\x12!\n\x07vm_flow\x18\x04 \x01(\x0b\x32\x10.fibcapi.FlowMod\x12!\n\x07\x64p_flow\x18\x05 \x01(\x0b\x32\x10.fibcapi.FlowMod\x12#\n\x08vm_group\x18\x06 \x01(\x0b\x32\x11.fibcapi.GroupMod\x12#\n\x08\x64p_group\x18\x07 \x01(\x0b\x32\x11.fibcapi.GroupMod\x12\x10\n\x08repaired\x18\x08 \x01(\x08\"8\n\x06Result\x12\x07\n\x03NOP\x10\x00\x12\x0b\n\x07MISSING\x10\x01\x12\t\n\x05\x45XTRA\x10\x02\x12\r\n\tDIFFERENT\x10\x03\"*\n\x19\x41pGetLldpNeighborsRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\"\xe0\x01\n\x0cLldpNeighbor\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\x0f\n\x07port_no\x18\x02 \x01(\r\x12\r\n\x05re_id\x18\x03 \x01(\t\x12\x0e\n\x06ifname\x18\x04 \x01(\t\x12\x12\n\nchassis_id\x18\x05 \x01(\t\x12\x0f\n\x07port_id\x18\x06 \x01(\t\x12\x11\n\tport_desc\x18\x07 \x01(\t\x12\x13\n\x0bsystem_name\x18\x08 \x01(\t\x12\x0b\n\x03ttl\x18\t \x01(\r\x12\x13\n\x0blast_update\x18\n \x01(\x03\x12\x10\n\x08mismatch\x18\x0b \x01(\x08\x12\x10\n\x08\x65xpected\x18\x0c \x01(\t\"!\n\x10VmMonitorRequest\x12\r\n\x05re_id\x18\x01 \x01(\t\"\xc1\x01\n\x0eVmMonitorReply\x12*\n\x0bport_status\x18\x01 \x01(\x0b\x32\x13.fibcapi.PortStatusH\x00\x12&\n\tdp_status\x18\x02 \x01(\x0b\x32\x11.fibcapi.DpStatusH\x00\x12/\n\x0el2_addr_status\x18\x03 \x01(\x0b\x32\x15.fibcapi.L2AddrStatusH\x00\x12\"\n\x03oam\x18\x04 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"K\n\x10VsMonitorRequest\x12\r\n\x05vs_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"\x90\x01\n\x0eVsMonitorReply\x12*\n\npacket_out\x18\x01 \x01(\x0b\x32\x14.fibcapi.FFPacketOutH\x00\x12&\n\x08port_mod\x18\x02 \x01(\x0b\x32\x12.fibcapi.FFPortModH\x00\x12\"\n\x03oam\x18\x03 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"P\n\x12\x44pMultipartRequest\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12-\n\x07request\x18\x02 \x01(\x0b\x32\x1c.fibcapi.FFMultipart.Request\"J\n\x10\x44pMultipartReply\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12)\n\x05reply\x18\x02 \x01(\x0b\x32\x1a.fibcapi.FFMultipart.Reply\"\x15\n\x13\x44pMultipartReplyAck\"K\n\x10\x44pMonitorRequest\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12(\n\x07\x64p_type\x18\x02 \x01(\x0e\x32\x17.fibcapi.FFHello.DpType\"\x90\x02\n\x0e\x44pMonitorReply\x12*\n\npacket_out\x18\x01 \x01(\x0b\x32\x14.fibcapi.FFPacketOutH\x00\x12&\n\x08port_mod\x18\x02 \x01(\x0b\x32\x12.fibcapi.FFPortModH\x00\x12$\n\x08\x66low_mod\x18\x03 \x01(\x0b\x32\x10.fibcapi.FlowModH\x00\x12&\n\tgroup_mod\x18\x04 \x01(\x0b\x32\x11.fibcapi.GroupModH\x00\x12\x30\n\tmultipart\x18\x05 \x01(\x0b\x32\x1b.fibcapi.DpMultipartRequestH\x00\x12\"\n\x03oam\x18\x06 \x01(\x0b\x32\x13.fibcapi.OAMRequestH\x00\x42\x06\n\x04\x62ody\"@\n\nOAMRequest\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12%\n\x07request\x18\x02 \x01(\x0b\x32\x14.fibcapi.OAM.Request\":\n\x08OAMReply\x12\x0b\n\x03xid\x18\x01 \x01(\r\x12!\n\x05reply\x18\x02 \x01(\x0b\x32\x12.fibcapi.OAM.Reply\"\r\n\x0bOAMReplyAck\"*\n\tDbPortKey\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\x0e\n\x06ifname\x18\x02 \x01(\t\"K\n\x0b\x44\x62PortValue\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\r\n\x05re_id\x18\x02 \x01(\t\x12\x0f\n\x07port_id\x18\x03 \x01(\r\x12\r\n\x05\x65nter\x18\x04 \x01(\x08\"\xf3\x01\n\x0b\x44\x62PortEntry\x12\x1f\n\x03key\x18\x01 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12&\n\nparent_key\x18\x02 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12&\n\nmaster_key\x18\x03 \x01(\x0b\x32\x12.fibcapi.DbPortKey\x12%\n\x07vm_port\x18\x04 \x01(\x0b\x32\x14.fibcapi.DbPortValue\x12%\n\x07\x64p_port\x18\x05 \x01(\x0b\x32\x14.fibcapi.DbPortValue\x12%\n\x07vs_port\x18\x06 \x01(\x0b\x32\x14.fibcapi.DbPortValue\")\n\tDbIdEntry\x12\r\n\x05re_id\x18\x01 \x01(\t\x12\r\n\x05\x64p_id\x18\x02 \x01(\x04\"e\n\nDbModEntry\x12\r\n\x05\x64p_id\x18\x01 \x01(\x04\x12\"\n\x08\x66low_mod\x18\x02 \x01(\x0b\x32\x10.fibcapi.FlowMod\x12$\n\tgroup_mod\x18\x03 \x01(\x0b\x32\x11.fibcapi.GroupMod\"\x8b\x01\n\tDbDpEntry\x12%\n\x04type\x18\x01 \x01(\x0e\x32\x17.fibcapi.DbDpEntry.Type\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0e\n\x06remote\x18\x03 \x01(\t\";\n\x04Type\x12\x07\n\x03NOP\x10\x00\x12\t\n\x05\x41PMON\x10\x01\x12\t\n\x05VMMON\x10\x02\x12\t\n\x05\x44PMON\x10\x03\x12\t\n\x05VSMON\x10\x04\"8\n\nStatsEntry\x12\r\n\x05group\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\x04\"\x13\n\x11\x41pGetStatsRequest\"/\n\rHaSyncRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\x12\r\n\x05\x65poch\x18\x02 \x01(\x04\"\xcf\x01\n\x0bHaSyncReply\x12\x0b\n\x03seq\x18\x01 \x01(\x04\x12&\n\nid_entries\x18\x02 \x03(\x0b\x32\x12.fibcapi.DbIdEntry\x12*\n\x0cport_entries\x18\x03 \x03(\x0b\x32\x14.fibcapi.DbPortEntry\x12&\n\ndp_entries\x18\x04 \x03(\x0b\x32\x12.fibcapi.DbDpEntry\x12(\n\x0bmod_entries\x18\x05 \x03(\x0b\x32\x13.fibcapi.DbModEntry\x12\r\n\x05\x65poch\x18\x06 \x01(\x04\x32\xe5\x07\n\tFIBCApApi\x12\x41\n\x07Monitor\x12\x19.fibcapi.ApMonitorRequest\x1a\x17.fibcapi.ApMonitorReply\"\x00\x30\x01\x12H\n\x0cGetPortStats\x12\x1e.fibcapi.ApGetPortStatsRequest\x1a\x14.fibcapi.FFPortStats\"\x00\x30\x01\x12N\n\x0cModPortStats\x12\x1e.fibcapi.ApModPortStatsRequest\x1a\x1c.fibcapi.ApModPortStatsReply\"\x00\x12L\n\x0eGetPortEntries\x12 .fibcapi.ApGetPortEntriesRequest\x1a\x14.fibcapi.DbPortEntry\"\x00\x30\x01\x12\x46\n\x0cGetIDEntries\x12\x1e.fibcapi.ApGetIdEntriesRequest\x1a\x12.fibcapi.DbIdEntry\"\x00\x30\x01\x12\x46\n\x0cGetDpEntries\x12\x1e.fibcapi.ApGetDpEntriesRequest\x1a\x12.fibcapi.DbDpEntry\"\x00\x30\x01\x12\x44\n\x0c\x41\x64\x64PortEntry\x12\x14.fibcapi.DbPortEntry\x1a\x1c.fibcapi.ApAddPortEntryReply\"\x00\x12>\n\nAddIDEntry\x12\x12.fibcapi.DbIdEntry\x1a\x1a.fibcapi.ApAddIdEntryReply\"\x00\x12\x42\n\x0c\x44\x65lPortEntry\x12\x12.fibcapi.DbPortKey\x1a\x1c.fibcapi.ApDelPortEntryReply\"\x00\x12>\n\nDelIDEntry\x12\x12.fibcapi.DbIdEntry\x1a\x1a.fibcapi.ApDelIdEntryReply\"\x00\x12?\n\x08GetStats\x12\x1a.fibcapi.ApGetStatsRequest\x1a\x13.fibcapi.StatsEntry\"\x00\x30\x01\x12\x36\n\x06RunOAM\x12\x14.fibcapi.OAM.Request\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12G\n\tAuditMods\x12\x1b.fibcapi.ApAuditModsRequest\x1a\x19.fibcapi.ApAuditModsEntry\"\x00\x30\x01\x12Q\n\x10GetLldpNeighbors\x12\".fibcapi.ApGetLldpNeighborsRequest\x1a\x15.fibcapi.LldpNeighbor\"\x00\x30\x01\x32\xf7\x02\n\tFIBCVmApi\x12\x32\n\tSendHello\x12\x0e.fibcapi.Hello\x1a\x13.fibcapi.HelloReply\"\x00\x12\x41\n\x0eSendPortConfig\x12\x13.fibcapi.PortConfig\x1a\x18.fibcapi.PortConfigReply\"\x00\x12\x38\n\x0bSendFlowMod\x12\x10.fibcapi.FlowMod\x1a\x15.fibcapi.FlowModReply\"\x00\x12;\n\x0cSendGroupMod\x12\x11.fibcapi.GroupMod\x1a\x16.fibcapi.GroupModReply\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.VmMonitorRequest\x1a\x17.fibcapi.VmMonitorReply\"\x00\x30\x01\x32\xbf\x02\n\tFIBCVsApi\x12\x36\n\tSendHello\x12\x10.fibcapi.FFHello\x1a\x15.fibcapi.FFHelloReply\"\x00\x12;\n\x0cSendFFPacket\x12\x11.fibcapi.FFPacket\x1a\x16.fibcapi.FFPacketReply\"\x00\x12?\n\x0cSendPacketIn\x12\x13.fibcapi.FFPacketIn\x1a\x18.fibcapi.FFPacketInReply\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.VsMonitorRequest\x1a\x17.fibcapi.VsMonitorReply\"\x00\x30\x01\x32\xe5\x03\n\tFIBCDpApi\x12\x36\n\tSendHello\x12\x10.fibcapi.FFHello\x1a\x15.fibcapi.FFHelloReply\"\x00\x12?\n\x0cSendPacketIn\x12\x13.fibcapi.FFPacketIn\x1a\x18.fibcapi.FFPacketInReply\"\x00\x12\x45\n\x0eSendPortStatus\x12\x15.fibcapi.FFPortStatus\x1a\x1a.fibcapi.FFPortStatusReply\"\x00\x12I\n\x10SendL2AddrStatus\x12\x17.fibcapi.FFL2AddrStatus\x1a\x1a.fibcapi.L2AddrStatusReply\"\x00\x12O\n\x12SendMultipartReply\x12\x19.fibcapi.DpMultipartReply\x1a\x1c.fibcapi.DpMultipartReplyAck\"\x00\x12\x39\n\x0cSendOAMReply\x12\x11.fibcapi.OAMReply\x1a\x14.fibcapi.OAMReplyAck\"\x00\x12\x41\n\x07Monitor\x12\x19.fibcapi.DpMonitorRequest\x1a\x17.fibcapi.DpMonitorReply\"\x00\x30\x01\x32\x45\n\tFIBCHaApi\x12\x38\n\x04Sync\x12\x16.fibcapi.HaSyncRequest\x1a\x14.fibcapi.HaSyncReply\"\x00\x30\x01\x62\x06proto3')
   ,
   dependencies=[fibcapi__pb2.DESCRIPTOR,])
 
 
 
+_APAUDITMODSENTRY_RESULT = _descriptor.EnumDescriptor(
+  name='Result',
+  full_name='fibcapi.ApAuditModsEntry.Result',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='NOP', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MISSING', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='EXTRA', index=2, number=2,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DIFFERENT', index=3, number=3,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1290,
+  serialized_end=1346,
+)
+_sym_db.RegisterEnumDescriptor(_APAUDITMODSENTRY_RESULT)
+
 _DBDPENTRY_TYPE = _descriptor.EnumDescriptor(
   name='Type',
   full_name='fibcapi.DbDpEntry.Type',
@@ -55,8 +85,8 @@ _DBDPENTRY_TYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2400,
-  serialized_end=2459,
+  serialized_start=3342,
+  serialized_end=3401,
 )
 _sym_db.RegisterEnumDescriptor(_DBDPENTRY_TYPE)
 
@@ -370,6 +400,65 @@ _APMONITORREPLYLOG = _descriptor.Descriptor(
 )
 
 
+_APMONITORREPLYPORTSTATUS = _descriptor.Descriptor(
+  name='ApMonitorReplyPortStatus',
+  full_name='fibcapi.ApMonitorReplyPortStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='dp_id', full_name='fibcapi.ApMonitorReplyPortStatus.dp_id', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_no', full_name='fibcapi.ApMonitorReplyPortStatus.port_no', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='fibcapi.ApMonitorReplyPortStatus.status', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='re_id', full_name='fibcapi.ApMonitorReplyPortStatus.re_id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ifname', full_name='fibcapi.ApMonitorReplyPortStatus.ifname', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=302,
+  serialized_end=435,
+)
+
+
 _APMONITORREPLY = _descriptor.Descriptor(
   name='ApMonitorReply',
   full_name='fibcapi.ApMonitorReply',
@@ -384,6 +473,13 @@ _APMONITORREPLY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_status', full_name='fibcapi.ApMonitorReply.port_status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -399,8 +495,8 @@ _APMONITORREPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.ApMonitorReply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=301,
-  serialized_end=368,
+  serialized_start=437,
+  serialized_end=562,
 )
 
 
@@ -423,8 +519,8 @@ _APGETPORTENTRIESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=370,
-  serialized_end=395,
+  serialized_start=564,
+  serialized_end=589,
 )
 
 
@@ -447,8 +543,8 @@ _APGETIDENTRIESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=397,
-  serialized_end=420,
+  serialized_start=591,
+  serialized_end=614,
 )
 
 
@@ -478,8 +574,8 @@ _APGETDPENTRIESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=422,
-  serialized_end=484,
+  serialized_start=616,
+  serialized_end=678,
 )
 
 
@@ -502,8 +598,8 @@ _APADDPORTENTRYREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=486,
-  serialized_end=507,
+  serialized_start=680,
+  serialized_end=701,
 )
 
 
@@ -526,8 +622,8 @@ _APADDIDENTRYREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=509,
-  serialized_end=528,
+  serialized_start=703,
+  serialized_end=722,
 )
 
 
@@ -550,8 +646,8 @@ _APDELPORTENTRYREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=530,
-  serialized_end=551,
+  serialized_start=724,
+  serialized_end=745,
 )
 
 
@@ -574,8 +670,8 @@ _APDELIDENTRYREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=553,
-  serialized_end=572,
+  serialized_start=747,
+  serialized_end=766,
 )
 
 
@@ -619,8 +715,8 @@ _APGETPORTSTATSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=574,
-  serialized_end=644,
+  serialized_start=768,
+  serialized_end=838,
 )
 
 
@@ -671,8 +767,8 @@ _APMODPORTSTATSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=646,
-  serialized_end=755,
+  serialized_start=840,
+  serialized_end=949,
 )
 
 
@@ -695,8 +791,266 @@ _APMODPORTSTATSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=757,
-  serialized_end=778,
+  serialized_start=951,
+  serialized_end=972,
+)
+
+
+_APAUDITMODSREQUEST = _descriptor.Descriptor(
+  name='ApAuditModsRequest',
+  full_name='fibcapi.ApAuditModsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='re_id', full_name='fibcapi.ApAuditModsRequest.re_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='repair', full_name='fibcapi.ApAuditModsRequest.repair', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=974,
+  serialized_end=1025,
+)
+
+
+_APAUDITMODSENTRY = _descriptor.Descriptor(
+  name='ApAuditModsEntry',
+  full_name='fibcapi.ApAuditModsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='fibcapi.ApAuditModsEntry.result', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='re_id', full_name='fibcapi.ApAuditModsEntry.re_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dp_id', full_name='fibcapi.ApAuditModsEntry.dp_id', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vm_flow', full_name='fibcapi.ApAuditModsEntry.vm_flow', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dp_flow', full_name='fibcapi.ApAuditModsEntry.dp_flow', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vm_group', full_name='fibcapi.ApAuditModsEntry.vm_group', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dp_group', full_name='fibcapi.ApAuditModsEntry.dp_group', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='repaired', full_name='fibcapi.ApAuditModsEntry.repaired', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _APAUDITMODSENTRY_RESULT,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1028,
+  serialized_end=1346,
+)
+
+
+_APGETLLDPNEIGHBORSREQUEST = _descriptor.Descriptor(
+  name='ApGetLldpNeighborsRequest',
+  full_name='fibcapi.ApGetLldpNeighborsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='dp_id', full_name='fibcapi.ApGetLldpNeighborsRequest.dp_id', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1348,
+  serialized_end=1390,
+)
+
+
+_LLDPNEIGHBOR = _descriptor.Descriptor(
+  name='LldpNeighbor',
+  full_name='fibcapi.LldpNeighbor',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='dp_id', full_name='fibcapi.LldpNeighbor.dp_id', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_no', full_name='fibcapi.LldpNeighbor.port_no', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='re_id', full_name='fibcapi.LldpNeighbor.re_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ifname', full_name='fibcapi.LldpNeighbor.ifname', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='chassis_id', full_name='fibcapi.LldpNeighbor.chassis_id', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_id', full_name='fibcapi.LldpNeighbor.port_id', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_desc', full_name='fibcapi.LldpNeighbor.port_desc', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='system_name', full_name='fibcapi.LldpNeighbor.system_name', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ttl', full_name='fibcapi.LldpNeighbor.ttl', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='last_update', full_name='fibcapi.LldpNeighbor.last_update', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mismatch', full_name='fibcapi.LldpNeighbor.mismatch', index=10,
+      number=11, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='expected', full_name='fibcapi.LldpNeighbor.expected', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1393,
+  serialized_end=1617,
 )
 
 
@@ -726,8 +1080,8 @@ _VMMONITORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=780,
-  serialized_end=813,
+  serialized_start=1619,
+  serialized_end=1652,
 )
 
 
@@ -781,8 +1135,8 @@ _VMMONITORREPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.VmMonitorReply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=816,
-  serialized_end=1009,
+  serialized_start=1655,
+  serialized_end=1848,
 )
 
 
@@ -819,8 +1173,8 @@ _VSMONITORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1011,
-  serialized_end=1086,
+  serialized_start=1850,
+  serialized_end=1925,
 )
 
 
@@ -867,8 +1221,8 @@ _VSMONITORREPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.VsMonitorReply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=1089,
-  serialized_end=1233,
+  serialized_start=1928,
+  serialized_end=2072,
 )
 
 
@@ -905,8 +1259,8 @@ _DPMULTIPARTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1235,
-  serialized_end=1315,
+  serialized_start=2074,
+  serialized_end=2154,
 )
 
 
@@ -943,8 +1297,8 @@ _DPMULTIPARTREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1317,
-  serialized_end=1391,
+  serialized_start=2156,
+  serialized_end=2230,
 )
 
 
@@ -967,8 +1321,8 @@ _DPMULTIPARTREPLYACK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1393,
-  serialized_end=1414,
+  serialized_start=2232,
+  serialized_end=2253,
 )
 
 
@@ -1005,8 +1359,8 @@ _DPMONITORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1416,
-  serialized_end=1491,
+  serialized_start=2255,
+  serialized_end=2330,
 )
 
 
@@ -1074,8 +1428,8 @@ _DPMONITORREPLY = _descriptor.Descriptor(
       name='body', full_name='fibcapi.DpMonitorReply.body',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=1494,
-  serialized_end=1766,
+  serialized_start=2333,
+  serialized_end=2605,
 )
 
 
@@ -1112,8 +1466,8 @@ _OAMREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1768,
-  serialized_end=1832,
+  serialized_start=2607,
+  serialized_end=2671,
 )
 
 
@@ -1150,8 +1504,8 @@ _OAMREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1834,
-  serialized_end=1892,
+  serialized_start=2673,
+  serialized_end=2731,
 )
 
 
@@ -1174,8 +1528,8 @@ _OAMREPLYACK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1894,
-  serialized_end=1907,
+  serialized_start=2733,
+  serialized_end=2746,
 )
 
 
@@ -1212,8 +1566,8 @@ _DBPORTKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1909,
-  serialized_end=1951,
+  serialized_start=2748,
+  serialized_end=2790,
 )
 
 
@@ -1264,8 +1618,8 @@ _DBPORTVALUE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1953,
-  serialized_end=2028,
+  serialized_start=2792,
+  serialized_end=2867,
 )
 
 
@@ -1330,8 +1684,8 @@ _DBPORTENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2031,
-  serialized_end=2274,
+  serialized_start=2870,
+  serialized_end=3113,
 )
 
 
@@ -1368,8 +1722,53 @@ _DBIDENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2276,
-  serialized_end=2317,
+  serialized_start=3115,
+  serialized_end=3156,
+)
+
+
+_DBMODENTRY = _descriptor.Descriptor(
+  name='DbModEntry',
+  full_name='fibcapi.DbModEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='dp_id', full_name='fibcapi.DbModEntry.dp_id', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flow_mod', full_name='fibcapi.DbModEntry.flow_mod', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group_mod', full_name='fibcapi.DbModEntry.group_mod', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3158,
+  serialized_end=3259,
 )
 
 
@@ -1414,8 +1813,8 @@ _DBDPENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2320,
-  serialized_end=2459,
+  serialized_start=3262,
+  serialized_end=3401,
 )
 
 
@@ -1459,8 +1858,8 @@ _STATSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2461,
-  serialized_end=2517,
+  serialized_start=3403,
+  serialized_end=3459,
 )
 
 
@@ -1483,16 +1882,131 @@ _APGETSTATSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2519,
-  serialized_end=2538,
+  serialized_start=3461,
+  serialized_end=3480,
+)
+
+
+_HASYNCREQUEST = _descriptor.Descriptor(
+  name='HaSyncRequest',
+  full_name='fibcapi.HaSyncRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='fibcapi.HaSyncRequest.node_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='epoch', full_name='fibcapi.HaSyncRequest.epoch', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3482,
+  serialized_end=3529,
+)
+
+
+_HASYNCREPLY = _descriptor.Descriptor(
+  name='HaSyncReply',
+  full_name='fibcapi.HaSyncReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='seq', full_name='fibcapi.HaSyncReply.seq', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id_entries', full_name='fibcapi.HaSyncReply.id_entries', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_entries', full_name='fibcapi.HaSyncReply.port_entries', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dp_entries', full_name='fibcapi.HaSyncReply.dp_entries', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mod_entries', full_name='fibcapi.HaSyncReply.mod_entries', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='epoch', full_name='fibcapi.HaSyncReply.epoch', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3532,
+  serialized_end=3739,
 )
 
+_APMONITORREPLYPORTSTATUS.fields_by_name['status'].enum_type = fibcapi__pb2._PORTSTATUS_STATUS
 _APMONITORREPLY.fields_by_name['log'].message_type = _APMONITORREPLYLOG
+_APMONITORREPLY.fields_by_name['port_status'].message_type = _APMONITORREPLYPORTSTATUS
 _APMONITORREPLY.oneofs_by_name['body'].fields.append(
   _APMONITORREPLY.fields_by_name['log'])
 _APMONITORREPLY.fields_by_name['log'].containing_oneof = _APMONITORREPLY.oneofs_by_name['body']
+_APMONITORREPLY.oneofs_by_name['body'].fields.append(
+  _APMONITORREPLY.fields_by_name['port_status'])
+_APMONITORREPLY.fields_by_name['port_status'].containing_oneof = _APMONITORREPLY.oneofs_by_name['body']
 _APGETDPENTRIESREQUEST.fields_by_name['type'].enum_type = _DBDPENTRY_TYPE
 _APMODPORTSTATSREQUEST.fields_by_name['cmd'].enum_type = fibcapi__pb2._FFPORTSTATS_CMD
+_APAUDITMODSENTRY.fields_by_name['result'].enum_type = _APAUDITMODSENTRY_RESULT
+_APAUDITMODSENTRY.fields_by_name['vm_flow'].message_type = fibcapi__pb2._FLOWMOD
+_APAUDITMODSENTRY.fields_by_name['dp_flow'].message_type = fibcapi__pb2._FLOWMOD
+_APAUDITMODSENTRY.fields_by_name['vm_group'].message_type = fibcapi__pb2._GROUPMOD
+_APAUDITMODSENTRY.fields_by_name['dp_group'].message_type = fibcapi__pb2._GROUPMOD
+_APAUDITMODSENTRY_RESULT.containing_type = _APAUDITMODSENTRY
 _VMMONITORREPLY.fields_by_name['port_status'].message_type = fibcapi__pb2._PORTSTATUS
 _VMMONITORREPLY.fields_by_name['dp_status'].message_type = fibcapi__pb2._DPSTATUS
 _VMMONITORREPLY.fields_by_name['l2_addr_status'].message_type = fibcapi__pb2._L2ADDRSTATUS
@@ -1557,8 +2071,14 @@ _DBPORTENTRY.fields_by_name['master_key'].message_type = _DBPORTKEY
 _DBPORTENTRY.fields_by_name['vm_port'].message_type = _DBPORTVALUE
 _DBPORTENTRY.fields_by_name['dp_port'].message_type = _DBPORTVALUE
 _DBPORTENTRY.fields_by_name['vs_port'].message_type = _DBPORTVALUE
+_DBMODENTRY.fields_by_name['flow_mod'].message_type = fibcapi__pb2._FLOWMOD
+_DBMODENTRY.fields_by_name['group_mod'].message_type = fibcapi__pb2._GROUPMOD
 _DBDPENTRY.fields_by_name['type'].enum_type = _DBDPENTRY_TYPE
 _DBDPENTRY_TYPE.containing_type = _DBDPENTRY
+_HASYNCREPLY.fields_by_name['id_entries'].message_type = _DBIDENTRY
+_HASYNCREPLY.fields_by_name['port_entries'].message_type = _DBPORTENTRY
+_HASYNCREPLY.fields_by_name['dp_entries'].message_type = _DBDPENTRY
+_HASYNCREPLY.fields_by_name['mod_entries'].message_type = _DBMODENTRY
 DESCRIPTOR.message_types_by_name['HelloReply'] = _HELLOREPLY
 DESCRIPTOR.message_types_by_name['PortConfigReply'] = _PORTCONFIGREPLY
 DESCRIPTOR.message_types_by_name['L2AddrReply'] = _L2ADDRREPLY
@@ -1571,6 +2091,7 @@ DESCRIPTOR.message_types_by_name['FFPacketInReply'] = _FFPACKETINREPLY
 DESCRIPTOR.message_types_by_name['FFPortStatusReply'] = _FFPORTSTATUSREPLY
 DESCRIPTOR.message_types_by_name['ApMonitorRequest'] = _APMONITORREQUEST
 DESCRIPTOR.message_types_by_name['ApMonitorReplyLog'] = _APMONITORREPLYLOG
+DESCRIPTOR.message_types_by_name['ApMonitorReplyPortStatus'] = _APMONITORREPLYPORTSTATUS
 DESCRIPTOR.message_types_by_name['ApMonitorReply'] = _APMONITORREPLY
 DESCRIPTOR.message_types_by_name['ApGetPortEntriesRequest'] = _APGETPORTENTRIESREQUEST
 DESCRIPTOR.message_types_by_name['ApGetIdEntriesRequest'] = _APGETIDENTRIESREQUEST
@@ -1582,6 +2103,10 @@ DESCRIPTOR.message_types_by_name['ApDelIdEntryReply'] = _APDELIDENTRYREPLY
 DESCRIPTOR.message_types_by_name['ApGetPortStatsRequest'] = _APGETPORTSTATSREQUEST
 DESCRIPTOR.message_types_by_name['ApModPortStatsRequest'] = _APMODPORTSTATSREQUEST
 DESCRIPTOR.message_types_by_name['ApModPortStatsReply'] = _APMODPORTSTATSREPLY
+DESCRIPTOR.message_types_by_name['ApAuditModsRequest'] = _APAUDITMODSREQUEST
+DESCRIPTOR.message_types_by_name['ApAuditModsEntry'] = _APAUDITMODSENTRY
+DESCRIPTOR.message_types_by_name['ApGetLldpNeighborsRequest'] = _APGETLLDPNEIGHBORSREQUEST
+DESCRIPTOR.message_types_by_name['LldpNeighbor'] = _LLDPNEIGHBOR
 DESCRIPTOR.message_types_by_name['VmMonitorRequest'] = _VMMONITORREQUEST
 DESCRIPTOR.message_types_by_name['VmMonitorReply'] = _VMMONITORREPLY
 DESCRIPTOR.message_types_by_name['VsMonitorRequest'] = _VSMONITORREQUEST
@@ -1598,9 +2123,12 @@ DESCRIPTOR.message_types_by_name['DbPortKey'] = _DBPORTKEY
 DESCRIPTOR.message_types_by_name['DbPortValue'] = _DBPORTVALUE
 DESCRIPTOR.message_types_by_name['DbPortEntry'] = _DBPORTENTRY
 DESCRIPTOR.message_types_by_name['DbIdEntry'] = _DBIDENTRY
+DESCRIPTOR.message_types_by_name['DbModEntry'] = _DBMODENTRY
 DESCRIPTOR.message_types_by_name['DbDpEntry'] = _DBDPENTRY
 DESCRIPTOR.message_types_by_name['StatsEntry'] = _STATSENTRY
 DESCRIPTOR.message_types_by_name['ApGetStatsRequest'] = _APGETSTATSREQUEST
+DESCRIPTOR.message_types_by_name['HaSyncRequest'] = _HASYNCREQUEST
+DESCRIPTOR.message_types_by_name['HaSyncReply'] = _HASYNCREPLY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 HelloReply = _reflection.GeneratedProtocolMessageType('HelloReply', (_message.Message,), dict(
@@ -1687,6 +2215,13 @@ ApMonitorReplyLog = _reflection.GeneratedProtocolMessageType('ApMonitorReplyLog'
   ))
 _sym_db.RegisterMessage(ApMonitorReplyLog)
 
+ApMonitorReplyPortStatus = _reflection.GeneratedProtocolMessageType('ApMonitorReplyPortStatus', (_message.Message,), dict(
+  DESCRIPTOR = _APMONITORREPLYPORTSTATUS,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.ApMonitorReplyPortStatus)
+  ))
+_sym_db.RegisterMessage(ApMonitorReplyPortStatus)
+
 ApMonitorReply = _reflection.GeneratedProtocolMessageType('ApMonitorReply', (_message.Message,), dict(
   DESCRIPTOR = _APMONITORREPLY,
   __module__ = 'fibcapis_pb2'
@@ -1764,6 +2299,34 @@ ApModPortStatsReply = _reflection.GeneratedProtocolMessageType('ApModPortStatsRe
   ))
 _sym_db.RegisterMessage(ApModPortStatsReply)
 
+ApAuditModsRequest = _reflection.GeneratedProtocolMessageType('ApAuditModsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _APAUDITMODSREQUEST,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.ApAuditModsRequest)
+  ))
+_sym_db.RegisterMessage(ApAuditModsRequest)
+
+ApAuditModsEntry = _reflection.GeneratedProtocolMessageType('ApAuditModsEntry', (_message.Message,), dict(
+  DESCRIPTOR = _APAUDITMODSENTRY,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.ApAuditModsEntry)
+  ))
+_sym_db.RegisterMessage(ApAuditModsEntry)
+
+ApGetLldpNeighborsRequest = _reflection.GeneratedProtocolMessageType('ApGetLldpNeighborsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _APGETLLDPNEIGHBORSREQUEST,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.ApGetLldpNeighborsRequest)
+  ))
+_sym_db.RegisterMessage(ApGetLldpNeighborsRequest)
+
+LldpNeighbor = _reflection.GeneratedProtocolMessageType('LldpNeighbor', (_message.Message,), dict(
+  DESCRIPTOR = _LLDPNEIGHBOR,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.LldpNeighbor)
+  ))
+_sym_db.RegisterMessage(LldpNeighbor)
+
 VmMonitorRequest = _reflection.GeneratedProtocolMessageType('VmMonitorRequest', (_message.Message,), dict(
   DESCRIPTOR = _VMMONITORREQUEST,
   __module__ = 'fibcapis_pb2'
@@ -1876,6 +2439,13 @@ DbIdEntry = _reflection.GeneratedProtocolMessageType('DbIdEntry', (_message.Mess
   ))
 _sym_db.RegisterMessage(DbIdEntry)
 
+DbModEntry = _reflection.GeneratedProtocolMessageType('DbModEntry', (_message.Message,), dict(
+  DESCRIPTOR = _DBMODENTRY,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.DbModEntry)
+  ))
+_sym_db.RegisterMessage(DbModEntry)
+
 DbDpEntry = _reflection.GeneratedProtocolMessageType('DbDpEntry', (_message.Message,), dict(
   DESCRIPTOR = _DBDPENTRY,
   __module__ = 'fibcapis_pb2'
@@ -1897,6 +2467,20 @@ ApGetStatsRequest = _reflection.GeneratedProtocolMessageType('ApGetStatsRequest'
   ))
 _sym_db.RegisterMessage(ApGetStatsRequest)
 
+HaSyncRequest = _reflection.GeneratedProtocolMessageType('HaSyncRequest', (_message.Message,), dict(
+  DESCRIPTOR = _HASYNCREQUEST,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.HaSyncRequest)
+  ))
+_sym_db.RegisterMessage(HaSyncRequest)
+
+HaSyncReply = _reflection.GeneratedProtocolMessageType('HaSyncReply', (_message.Message,), dict(
+  DESCRIPTOR = _HASYNCREPLY,
+  __module__ = 'fibcapis_pb2'
+  # @@protoc_insertion_point(class_scope:fibcapi.HaSyncReply)
+  ))
+_sym_db.RegisterMessage(HaSyncReply)
+
 
 
 _FIBCAPAPI = _descriptor.ServiceDescriptor(
@@ -1905,8 +2489,8 @@ _FIBCAPAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=2541,
-  serialized_end=3382,
+  serialized_start=3742,
+  serialized_end=4739,
   methods=[
   _descriptor.MethodDescriptor(
     name='Monitor',
@@ -2016,6 +2600,24 @@ _FIBCAPAPI = _descriptor.ServiceDescriptor(
     output_type=_OAMREPLYACK,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='AuditMods',
+    full_name='fibcapi.FIBCApApi.AuditMods',
+    index=12,
+    containing_service=None,
+    input_type=_APAUDITMODSREQUEST,
+    output_type=_APAUDITMODSENTRY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetLldpNeighbors',
+    full_name='fibcapi.FIBCApApi.GetLldpNeighbors',
+    index=13,
+    containing_service=None,
+    input_type=_APGETLLDPNEIGHBORSREQUEST,
+    output_type=_LLDPNEIGHBOR,
+    serialized_options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_FIBCAPAPI)
 
@@ -2028,8 +2630,8 @@ _FIBCVMAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   serialized_options=None,
-  serialized_start=3385,
-  serialized_end=3760,
+  serialized_start=4742,
+  serialized_end=5117,
   methods=[
   _descriptor.MethodDescriptor(
     name='SendHello',
@@ -2097,8 +2699,8 @@ _FIBCVSAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=2,
   serialized_options=None,
-  serialized_start=3763,
-  serialized_end=4082,
+  serialized_start=5120,
+  serialized_end=5439,
   methods=[
   _descriptor.MethodDescriptor(
     name='SendHello',
@@ -2157,8 +2759,8 @@ _FIBCDPAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=3,
   serialized_options=None,
-  serialized_start=4085,
-  serialized_end=4570,
+  serialized_start=5442,
+  serialized_end=5927,
   methods=[
   _descriptor.MethodDescriptor(
     name='SendHello',
@@ -2228,4 +2830,28 @@ _sym_db.RegisterServiceDescriptor(_FIBCDPAPI)
 
 DESCRIPTOR.services_by_name['FIBCDpApi'] = _FIBCDPAPI
 
+
+_FIBCHAAPI = _descriptor.ServiceDescriptor(
+  name='FIBCHaApi',
+  full_name='fibcapi.FIBCHaApi',
+  file=DESCRIPTOR,
+  index=4,
+  serialized_options=None,
+  serialized_start=5929,
+  serialized_end=5998,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Sync',
+    full_name='fibcapi.FIBCHaApi.Sync',
+    index=0,
+    containing_service=None,
+    input_type=_HASYNCREQUEST,
+    output_type=_HASYNCREPLY,
+    serialized_options=None,
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_FIBCHAAPI)
+
+DESCRIPTOR.services_by_name['FIBCHaApi'] = _FIBCHAAPI
+
 # @@protoc_insertion_point(module_scope)
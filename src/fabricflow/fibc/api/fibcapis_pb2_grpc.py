@@ -75,6 +75,16 @@ class FIBCApApiStub(object):
         request_serializer=fibcapi__pb2.OAM.Request.SerializeToString,
         response_deserializer=fibcapis__pb2.OAMReplyAck.FromString,
         )
+    self.AuditMods = channel.unary_stream(
+        '/fibcapi.FIBCApApi/AuditMods',
+        request_serializer=fibcapis__pb2.ApAuditModsRequest.SerializeToString,
+        response_deserializer=fibcapis__pb2.ApAuditModsEntry.FromString,
+        )
+    self.GetLldpNeighbors = channel.unary_stream(
+        '/fibcapi.FIBCApApi/GetLldpNeighbors',
+        request_serializer=fibcapis__pb2.ApGetLldpNeighborsRequest.SerializeToString,
+        response_deserializer=fibcapis__pb2.LldpNeighbor.FromString,
+        )
 
 
 class FIBCApApiServicer(object):
@@ -165,6 +175,20 @@ class FIBCApApiServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def AuditMods(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetLldpNeighbors(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_FIBCApApiServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -228,6 +252,16 @@ def add_FIBCApApiServicer_to_server(servicer, server):
           request_deserializer=fibcapi__pb2.OAM.Request.FromString,
           response_serializer=fibcapis__pb2.OAMReplyAck.SerializeToString,
       ),
+      'AuditMods': grpc.unary_stream_rpc_method_handler(
+          servicer.AuditMods,
+          request_deserializer=fibcapis__pb2.ApAuditModsRequest.FromString,
+          response_serializer=fibcapis__pb2.ApAuditModsEntry.SerializeToString,
+      ),
+      'GetLldpNeighbors': grpc.unary_stream_rpc_method_handler(
+          servicer.GetLldpNeighbors,
+          request_deserializer=fibcapis__pb2.ApGetLldpNeighborsRequest.FromString,
+          response_serializer=fibcapis__pb2.LldpNeighbor.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'fibcapi.FIBCApApi', rpc_method_handlers)
@@ -613,3 +647,45 @@ def add_FIBCDpApiServicer_to_server(servicer, server):
   generic_handler = grpc.method_handlers_generic_handler(
       'fibcapi.FIBCDpApi', rpc_method_handlers)
   server.add_generic_rpc_handlers((generic_handler,))
+
+
+class FIBCHaApiStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Sync = channel.unary_stream(
+        '/fibcapi.FIBCHaApi/Sync',
+        request_serializer=fibcapis__pb2.HaSyncRequest.SerializeToString,
+        response_deserializer=fibcapis__pb2.HaSyncReply.FromString,
+        )
+
+
+class FIBCHaApiServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Sync(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_FIBCHaApiServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Sync': grpc.unary_stream_rpc_method_handler(
+          servicer.Sync,
+          request_deserializer=fibcapis__pb2.HaSyncRequest.FromString,
+          response_serializer=fibcapis__pb2.HaSyncReply.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'fibcapi.FIBCHaApi', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
@@ -18,6 +18,7 @@
 package fibcapi
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/golang/protobuf/proto"
@@ -44,6 +45,41 @@ func NewFlowModFromBytes(data []byte) (*FlowMod, error) {
 	return flow_mod, nil
 }
 
+func (f *FlowMod) GetMatch() proto.Message {
+	switch e := f.Entry.(type) {
+	case *FlowMod_Vlan:
+		return e.Vlan.GetMatch()
+	case *FlowMod_TermMac:
+		return e.TermMac.GetMatch()
+	case *FlowMod_Mpls1:
+		return e.Mpls1.GetMatch()
+	case *FlowMod_Unicast:
+		return e.Unicast.GetMatch()
+	case *FlowMod_Bridging:
+		return e.Bridging.GetMatch()
+	case *FlowMod_Acl:
+		return e.Acl.GetMatch()
+	case *FlowMod_Mcast:
+		return e.Mcast.GetMatch()
+	case *FlowMod_Srv6Local:
+		return e.Srv6Local.GetMatch()
+	default:
+		return nil
+	}
+}
+
+//
+// MatchKey returns key to identify flow entry (table + match).
+//
+func (f *FlowMod) MatchKey() string {
+	m := f.GetMatch()
+	if m == nil {
+		return fmt.Sprintf("%d/", f.Table)
+	}
+
+	return fmt.Sprintf("%d/%s", f.Table, proto.CompactTextString(m))
+}
+
 //
 // VLAN Flow Table
 //
@@ -228,6 +264,75 @@ func (f *UnicastRoutingFlow) ToMod(cmd FlowMod_Cmd, reId string) *FlowMod {
 	}
 }
 
+//
+// SRv6 Local SID Flow Table
+//
+func NewSRv6LocalSidMatch(sid *net.IPNet, vrf uint8) *SRv6LocalSidFlow_Match {
+	return &SRv6LocalSidFlow_Match{
+		Sid: sid.String(),
+		Vrf: uint32(vrf),
+	}
+}
+
+func NewSRv6LocalSidAction(name SRv6LocalSidFlow_Action_Name, value uint32) *SRv6LocalSidFlow_Action {
+	return &SRv6LocalSidFlow_Action{
+		Name:  name,
+		Value: value,
+	}
+}
+
+func NewSRv6LocalSidFlow(match *SRv6LocalSidFlow_Match, action *SRv6LocalSidFlow_Action, gtype GroupMod_GType, gid uint32) *SRv6LocalSidFlow {
+	return &SRv6LocalSidFlow{
+		Match:  match,
+		Action: action,
+		GType:  gtype,
+		GId:    gid,
+	}
+}
+
+func (f *SRv6LocalSidFlow) ToMod(cmd FlowMod_Cmd, reId string) *FlowMod {
+	return &FlowMod{
+		Cmd:   cmd,
+		Table: FlowMod_SRV6_LOCAL_SID,
+		ReId:  reId,
+		Entry: &FlowMod_Srv6Local{Srv6Local: f},
+	}
+}
+
+//
+// Multicast Routing Flow Table
+//
+func NewMulticastRoutingMatch(ipSrc, ipDst net.IP, vrf uint8, vlanVid uint16) *MulticastRoutingFlow_Match {
+	src := ""
+	if ipSrc != nil && !ipSrc.IsUnspecified() {
+		src = ipSrc.String()
+	}
+
+	return &MulticastRoutingFlow_Match{
+		IpSrc:   src,
+		IpDst:   ipDst.String(),
+		Vrf:     uint32(vrf),
+		VlanVid: uint32(vlanVid),
+	}
+}
+
+func NewMulticastRoutingFlow(match *MulticastRoutingFlow_Match, gtype GroupMod_GType, gid uint32) *MulticastRoutingFlow {
+	return &MulticastRoutingFlow{
+		Match: match,
+		GType: gtype,
+		GId:   gid,
+	}
+}
+
+func (f *MulticastRoutingFlow) ToMod(cmd FlowMod_Cmd, reId string) *FlowMod {
+	return &FlowMod{
+		Cmd:   cmd,
+		Table: FlowMod_MULTICAST_ROUTING,
+		ReId:  reId,
+		Entry: &FlowMod_Mcast{Mcast: f},
+	}
+}
+
 //
 // Bridging Flow Table
 //
@@ -279,6 +384,46 @@ func (f *PolicyACLFlow) ToMod(cmd FlowMod_Cmd, reId string) *FlowMod {
 	}
 }
 
+//
+// AllActions returns action and additional actions.
+//
+func (f *PolicyACLFlow) AllActions() []*PolicyACLFlow_Action {
+	actions := []*PolicyACLFlow_Action{}
+	if f.Action != nil {
+		actions = append(actions, f.Action)
+	}
+
+	return append(actions, f.Actions...)
+}
+
+//
+// NewPolicyACLFlowAction returns new action.
+//
+func NewPolicyACLFlowAction(name PolicyACLFlow_Action_Name, value uint32) *PolicyACLFlow_Action {
+	return &PolicyACLFlow_Action{
+		Name:  name,
+		Value: value,
+	}
+}
+
+//
+// NewPolicyACLFlow returns new flow.
+// actions[0] is set to Action and others are set to Actions.
+//
+func NewPolicyACLFlow(match *PolicyACLFlow_Match, priority uint32, actions ...*PolicyACLFlow_Action) *PolicyACLFlow {
+	f := &PolicyACLFlow{
+		Match:    match,
+		Priority: priority,
+	}
+
+	if len(actions) > 0 {
+		f.Action = actions[0]
+		f.Actions = actions[1:]
+	}
+
+	return f
+}
+
 //
 // Policy ACL Flow (match ip_dst and send controller)
 //
@@ -304,3 +449,30 @@ func NewPolicyACLFlowByAddr(family int32, ipDst net.IP, vrf uint8, inPort uint32
 		},
 	}
 }
+
+//
+// Policy ACL Flow (match ip_src and set vrf)
+//
+func NewPolicyACLFlowBySrc(family int32, ipSrc *net.IPNet, vrf uint8, inPort uint32, setVrf uint8) *PolicyACLFlow {
+	return &PolicyACLFlow{
+		Match: &PolicyACLFlow_Match{
+			InPort: inPort,
+			IpSrc:  ipSrc.String(),
+			Vrf:    uint32(vrf),
+			EthType: func() uint32 {
+				switch family {
+				case unix.AF_INET:
+					return unix.ETH_P_IP
+				case unix.AF_INET6:
+					return unix.ETH_P_IPV6
+				default:
+					return 0
+				}
+			}(),
+		},
+		Action: &PolicyACLFlow_Action{
+			Name:  PolicyACLFlow_Action_SET_VRF,
+			Value: uint32(setVrf),
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcapi
+
+import (
+	"testing"
+)
+
+//
+// Policy ACL Flow
+//
+func TestNewPolicyACLFlow(t *testing.T) {
+	m := &PolicyACLFlow_Match{EthType: 0x0800, IpSrc: "10.0.0.0/8"}
+	a1 := NewPolicyACLFlowAction(PolicyACLFlow_Action_SET_QUEUE, 3)
+	a2 := NewPolicyACLFlowAction(PolicyACLFlow_Action_SET_DSCP, 46)
+	f := NewPolicyACLFlow(m, 100, a1, a2)
+
+	if v := f.Priority; v != 100 {
+		t.Errorf("NewPolicyACLFlow unmatch. priority=%d", v)
+	}
+
+	if v := f.Action; v != a1 {
+		t.Errorf("NewPolicyACLFlow unmatch. action=%v", v)
+	}
+
+	if v := f.AllActions(); len(v) != 2 || v[0] != a1 || v[1] != a2 {
+		t.Errorf("NewPolicyACLFlow unmatch. actions=%v", v)
+	}
+
+	if v := f.ToMod(FlowMod_ADD, "1.1.1.1"); v.Table != FlowMod_POLICY_ACL || v.GetAcl() != f {
+		t.Errorf("PolicyACLFlow.ToMod unmatch. %v", v)
+	}
+}
+
+func TestNewPolicyACLFlow_NoAction(t *testing.T) {
+	f := NewPolicyACLFlow(&PolicyACLFlow_Match{}, 0)
+
+	if v := f.AllActions(); len(v) != 0 {
+		t.Errorf("NewPolicyACLFlow unmatch. actions=%v", v)
+	}
+}
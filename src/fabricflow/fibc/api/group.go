@@ -40,6 +40,34 @@ func NewGroupModFromBytes(data []byte) (*GroupMod, error) {
 	return group_mod, nil
 }
 
+//
+// GroupID returns group id of entry.
+//
+func (g *GroupMod) GroupID() uint32 {
+	switch e := g.Entry.(type) {
+	case *GroupMod_L2Iface:
+		return NewL2InterfaceGroupID(e.L2Iface.PortId, uint16(e.L2Iface.VlanVid))
+	case *GroupMod_L3Unicast:
+		return NewL3UnicastGroupID(e.L3Unicast.NeId)
+	case *GroupMod_MplsIface:
+		return NewMPLSInterfaceGroupID(e.MplsIface.NeId)
+	case *GroupMod_MplsLabel:
+		return NewMPLSLabelGroupID(MPLSLabelGroup_subtype[e.MplsLabel.GType], e.MplsLabel.DstId)
+	case *GroupMod_L3Ecmp:
+		return NewL3EcmpGroupId(e.L3Ecmp.EcmpId)
+	case *GroupMod_L3Mcast:
+		return NewL3MulticastGroupID(uint16(e.L3Mcast.McId), uint16(e.L3Mcast.VlanVid))
+	case *GroupMod_L2Overlay:
+		return NewOverlayGroupID(uint16(e.L2Overlay.TunnelId), L2OverlayGroup_subtype[g.GType], uint16(e.L2Overlay.Index))
+	case *GroupMod_VxlanTunnel:
+		return NewVxlanTunnelID(e.VxlanTunnel.TunPortId)
+	case *GroupMod_Srv6Encap:
+		return NewSRv6EncapGroupID(e.Srv6Encap.EncapId)
+	default:
+		return 0
+	}
+}
+
 //
 // L2 Interface Group
 //
@@ -175,6 +203,31 @@ func NewL3MulticastGroupID(mcId uint16, vlanId uint16) uint32 {
 	return 0x60000000 + ((AdjustVlanVID(vlanId) << 16) & 0x0fff0000) + (uint32(mcId) & 0xffff)
 }
 
+func NewL3MulticastGroupPort(portId uint32, vlanVid uint16, ethSrc net.HardwareAddr) *L3MulticastGroup_Port {
+	return &L3MulticastGroup_Port{
+		PortId:  portId,
+		VlanVid: uint32(vlanVid),
+		EthSrc:  ethSrc.String(),
+	}
+}
+
+func NewL3MulticastGroup(mcId uint32, vlanVid uint16, ports []*L3MulticastGroup_Port) *L3MulticastGroup {
+	return &L3MulticastGroup{
+		McId:    mcId,
+		VlanVid: uint32(vlanVid),
+		Ports:   ports,
+	}
+}
+
+func (g *L3MulticastGroup) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
+	return &GroupMod{
+		Cmd:   cmd,
+		GType: GroupMod_L3_MULTICAST,
+		ReId:  reId,
+		Entry: &GroupMod_L3Mcast{L3Mcast: g},
+	}
+}
+
 //
 // L3 ECMP Group
 //
@@ -182,6 +235,22 @@ func NewL3EcmpGroupId(ecmpId uint32) uint32 {
 	return 0x70000000 + (ecmpId & 0x0fffffff)
 }
 
+func NewL3EcmpGroup(ecmpId uint32, neIds []uint32) *L3EcmpGroup {
+	return &L3EcmpGroup{
+		EcmpId: ecmpId,
+		NeIds:  neIds,
+	}
+}
+
+func (g *L3EcmpGroup) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
+	return &GroupMod{
+		Cmd:   cmd,
+		GType: GroupMod_L3_ECMP,
+		ReId:  reId,
+		Entry: &GroupMod_L3Ecmp{L3Ecmp: g},
+	}
+}
+
 //
 // L2 Overlay Group
 //
@@ -192,6 +261,145 @@ func NewOverlayGroupID(tunnelId uint16, subType uint16, index uint16) uint32 {
 		((uint32)(index) & 0x07ff)
 }
 
+var L2OverlayGroup_subtype = map[GroupMod_GType]uint16{
+	GroupMod_L2_OVERLAY_FL_UC: 0,
+	GroupMod_L2_OVERLAY_FL_MC: 1,
+	GroupMod_L2_OVERLAY_MC_UC: 2,
+	GroupMod_L2_OVERLAY_MC_MC: 3,
+}
+
+func NewL2OverlayGroup(tunnelId uint32, index uint16, vlanVid uint16, tunPorts []uint32) *L2OverlayGroup {
+	return &L2OverlayGroup{
+		TunnelId: tunnelId,
+		Index:    uint32(index),
+		VlanVid:  uint32(vlanVid),
+		TunPorts: tunPorts,
+	}
+}
+
+func (g *L2OverlayGroup) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
+	return &GroupMod{
+		Cmd:   cmd,
+		GType: GroupMod_L2_OVERLAY_FL_UC,
+		ReId:  reId,
+		Entry: &GroupMod_L2Overlay{L2Overlay: g},
+	}
+}
+
+//
+// VXLAN Tunnel (VTEP tunnel port)
+//
+const (
+	VXLAN_UDP_PORT_DEFAULT = 4789
+)
+
+func NewVxlanTunnelID(tunPortId uint32) uint32 {
+	return 0xc0000000 + (tunPortId & 0xffff)
+}
+
+func NewVxlanTunnelDPPort(tunPortId uint32) uint32 {
+	return NewDPPortId(tunPortId, LinkType_VXLAN)
+}
+
+func NewVxlanTunnel(tunPortId uint32, vni uint32, vlanVid uint16, local, remote net.IP, udpPort uint16, neId, portId uint32) *VxlanTunnel {
+	return &VxlanTunnel{
+		TunPortId: tunPortId,
+		Vni:       vni,
+		VlanVid:   uint32(vlanVid),
+		Local:     local.String(),
+		Remote:    remote.String(),
+		UdpPort:   uint32(udpPort),
+		NeId:      neId,
+		PortId:    portId,
+	}
+}
+
+func (g *VxlanTunnel) GetLocalIP() net.IP {
+	if ip := net.ParseIP(g.Local); ip != nil {
+		return ip
+	}
+	return net.IP{}
+}
+
+func (g *VxlanTunnel) GetRemoteIP() net.IP {
+	if ip := net.ParseIP(g.Remote); ip != nil {
+		return ip
+	}
+	return net.IP{}
+}
+
+func (g *VxlanTunnel) GetAdjustedUdpPort() uint16 {
+	if g.UdpPort == 0 {
+		return VXLAN_UDP_PORT_DEFAULT
+	}
+	return uint16(g.UdpPort)
+}
+
+func (g *VxlanTunnel) DPPort() uint32 {
+	return NewVxlanTunnelDPPort(g.TunPortId)
+}
+
+func (g *VxlanTunnel) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
+	return &GroupMod{
+		Cmd:   cmd,
+		GType: GroupMod_VXLAN_TUNNEL,
+		ReId:  reId,
+		Entry: &GroupMod_VxlanTunnel{VxlanTunnel: g},
+	}
+}
+
+//
+// SRv6 Encap Group
+//
+func NewSRv6EncapGroupID(encapId uint32) uint32 {
+	return 0xc1000000 + (encapId & 0x00ffffff)
+}
+
+func NewSRv6EncapGroup(encapId uint32, mode SRv6EncapGroup_Mode, segments []net.IP, neId uint32) *SRv6EncapGroup {
+	segs := make([]string, len(segments))
+	for i, seg := range segments {
+		segs[i] = seg.String()
+	}
+
+	return &SRv6EncapGroup{
+		EncapId:  encapId,
+		Mode:     mode,
+		Segments: segs,
+		NeId:     neId,
+	}
+}
+
+func (g *SRv6EncapGroup) GetSegmentIPs() []net.IP {
+	ips := []net.IP{}
+	for _, seg := range g.Segments {
+		if ip := net.ParseIP(seg); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+//
+// GetFirstSID returns active segment (destination of outer ipv6 header).
+//
+func (g *SRv6EncapGroup) GetFirstSID() net.IP {
+	if n := len(g.Segments); n > 0 {
+		if ip := net.ParseIP(g.Segments[n-1]); ip != nil {
+			return ip
+		}
+	}
+	return net.IP{}
+}
+
+func (g *SRv6EncapGroup) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
+	return &GroupMod{
+		Cmd:   cmd,
+		GType: GroupMod_SRV6_ENCAP,
+		ReId:  reId,
+		Entry: &GroupMod_Srv6Encap{Srv6Encap: g},
+	}
+}
+
 //
 // MPLS Interface Group
 //
@@ -209,6 +417,24 @@ func NewMPLSInterfaceGroup(neId, portId uint32, vlanVid uint16, ethDst, ethSrc n
 	}
 }
 
+func (g *MPLSInterfaceGroup) GetAdjustedVlanVid() uint16 {
+	return AdjustVlanVID16(uint16(g.VlanVid))
+}
+
+func (g *MPLSInterfaceGroup) GetEthDstHwAddr() net.HardwareAddr {
+	if hwaddr, err := net.ParseMAC(g.EthDst); err == nil {
+		return hwaddr
+	}
+	return net.HardwareAddr{}
+}
+
+func (g *MPLSInterfaceGroup) GetEthSrcHwAddr() net.HardwareAddr {
+	if hwaddr, err := net.ParseMAC(g.EthSrc); err == nil {
+		return hwaddr
+	}
+	return net.HardwareAddr{}
+}
+
 func (g *MPLSInterfaceGroup) ToMod(cmd GroupMod_Cmd, reId string) *GroupMod {
 	return &GroupMod{
 		Cmd:   cmd,
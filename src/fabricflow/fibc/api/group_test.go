@@ -18,6 +18,7 @@
 package fibcapi
 
 import (
+	"net"
 	"testing"
 )
 
@@ -31,3 +32,128 @@ func TestGroupMod_Type(t *testing.T) {
 		t.Errorf("GroupMod Type unmatch. %d", v)
 	}
 }
+
+//
+// L3 ECMP Group
+//
+func TestL3EcmpGroup_ToMod(t *testing.T) {
+	g := NewL3EcmpGroup(10, []uint32{0x11, 0x12})
+	mod := g.ToMod(GroupMod_ADD, "1.1.1.1")
+
+	if v := mod.GType; v != GroupMod_L3_ECMP {
+		t.Errorf("L3EcmpGroup ToMod unmatch. gtype=%s", v)
+	}
+
+	if v := mod.GroupID(); v != 0x7000000a {
+		t.Errorf("L3EcmpGroup GroupID unmatch. %08x", v)
+	}
+
+	if v := mod.GetL3Ecmp(); v != g {
+		t.Errorf("L3EcmpGroup ToMod unmatch. %v", v)
+	}
+}
+
+//
+// L3 Multicast Group
+//
+func TestL3MulticastGroup_ToMod(t *testing.T) {
+	hwaddr, _ := net.ParseMAC("11:22:33:44:55:66")
+	ports := []*L3MulticastGroup_Port{
+		NewL3MulticastGroupPort(0x11, 0, hwaddr),
+		NewL3MulticastGroupPort(0x12, 100, hwaddr),
+	}
+	g := NewL3MulticastGroup(10, 100, ports)
+	mod := g.ToMod(GroupMod_ADD, "1.1.1.1")
+
+	if v := mod.GType; v != GroupMod_L3_MULTICAST {
+		t.Errorf("L3MulticastGroup ToMod unmatch. gtype=%s", v)
+	}
+
+	if v := mod.GroupID(); v != 0x6064000a {
+		t.Errorf("L3MulticastGroup GroupID unmatch. %08x", v)
+	}
+
+	if v := mod.GetL3Mcast(); v != g {
+		t.Errorf("L3MulticastGroup ToMod unmatch. %v", v)
+	}
+}
+
+//
+// L2 Overlay Group
+//
+func TestL2OverlayGroup_ToMod(t *testing.T) {
+	g := NewL2OverlayGroup(100, 0, 10, []uint32{NewVxlanTunnelDPPort(1), NewVxlanTunnelDPPort(2)})
+	mod := g.ToMod(GroupMod_ADD, "1.1.1.1")
+
+	if v := mod.GType; v != GroupMod_L2_OVERLAY_FL_UC {
+		t.Errorf("L2OverlayGroup ToMod unmatch. gtype=%s", v)
+	}
+
+	if v := mod.GroupID(); v != 0x80064000 {
+		t.Errorf("L2OverlayGroup GroupID unmatch. %08x", v)
+	}
+
+	if v := mod.GetL2Overlay(); v != g {
+		t.Errorf("L2OverlayGroup ToMod unmatch. %v", v)
+	}
+}
+
+//
+// VXLAN Tunnel
+//
+func TestVxlanTunnel_ToMod(t *testing.T) {
+	local := net.ParseIP("10.0.0.1")
+	remote := net.ParseIP("10.0.0.2")
+	g := NewVxlanTunnel(3, 100, 10, local, remote, 0, 0x11, 0x21)
+	mod := g.ToMod(GroupMod_ADD, "1.1.1.1")
+
+	if v := mod.GType; v != GroupMod_VXLAN_TUNNEL {
+		t.Errorf("VxlanTunnel ToMod unmatch. gtype=%s", v)
+	}
+
+	if v := mod.GroupID(); v != 0xc0000003 {
+		t.Errorf("VxlanTunnel GroupID unmatch. %08x", v)
+	}
+
+	if v := g.DPPort(); v != 0x06000003 {
+		t.Errorf("VxlanTunnel DPPort unmatch. %08x", v)
+	}
+
+	if v := g.GetAdjustedUdpPort(); v != 4789 {
+		t.Errorf("VxlanTunnel UdpPort unmatch. %d", v)
+	}
+
+	if v := g.GetRemoteIP(); !v.Equal(remote) {
+		t.Errorf("VxlanTunnel Remote unmatch. %s", v)
+	}
+
+	if v := mod.GetVxlanTunnel(); v != g {
+		t.Errorf("VxlanTunnel ToMod unmatch. %v", v)
+	}
+}
+
+func TestSRv6EncapGroup_ToMod(t *testing.T) {
+	segs := []net.IP{net.ParseIP("fc00::3"), net.ParseIP("fc00::2")}
+	g := NewSRv6EncapGroup(5, SRv6EncapGroup_ENCAP, segs, 0x11)
+	mod := g.ToMod(GroupMod_ADD, "1.1.1.1")
+
+	if v := mod.GType; v != GroupMod_SRV6_ENCAP {
+		t.Errorf("SRv6EncapGroup ToMod unmatch. gtype=%s", v)
+	}
+
+	if v := mod.GroupID(); v != 0xc1000005 {
+		t.Errorf("SRv6EncapGroup GroupID unmatch. %08x", v)
+	}
+
+	if v := g.GetFirstSID(); !v.Equal(net.ParseIP("fc00::2")) {
+		t.Errorf("SRv6EncapGroup FirstSID unmatch. %s", v)
+	}
+
+	if v := g.GetSegmentIPs(); len(v) != 2 || !v[0].Equal(segs[0]) {
+		t.Errorf("SRv6EncapGroup Segments unmatch. %v", v)
+	}
+
+	if v := mod.GetSrv6Encap(); v != g {
+		t.Errorf("SRv6EncapGroup ToMod unmatch. %v", v)
+	}
+}
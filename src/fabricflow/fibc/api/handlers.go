@@ -123,3 +123,10 @@ type FFL2AddrStatusHandler interface {
 type ApMonitorReplyLogHandler interface {
 	FIBCApMonitorReplyLog(*fibcnet.Header, *ApMonitorReplyLog)
 }
+
+//
+// ApMonitorReplyPortStatus
+//
+type ApMonitorReplyPortStatusHandler interface {
+	FIBCApMonitorReplyPortStatus(*fibcnet.Header, *ApMonitorReplyPortStatus)
+}
@@ -41,6 +41,11 @@ type FIBCUnicastRoutingFlowModHandler interface {
 	FIBCUnicastRoutingFlowMod(*fibcnet.Header, *FlowMod, *UnicastRoutingFlow)
 }
 
+// MulticastRoutingFlow
+type FIBCMulticastRoutingFlowModHandler interface {
+	FIBCMulticastRoutingFlowMod(*fibcnet.Header, *FlowMod, *MulticastRoutingFlow)
+}
+
 // BridgingFlow
 type FIBCBridgingFlowModHandler interface {
 	FIBCBridgingFlowMod(*fibcnet.Header, *FlowMod, *BridgingFlow)
@@ -51,6 +56,11 @@ type FIBCPolicyACLFlowModHandler interface {
 	FIBCPolicyACLFlowMod(*fibcnet.Header, *FlowMod, *PolicyACLFlow)
 }
 
+// SRv6LocalSidFlow
+type FIBCSRv6LocalSidFlowModHandler interface {
+	FIBCSRv6LocalSidFlowMod(*fibcnet.Header, *FlowMod, *SRv6LocalSidFlow)
+}
+
 // L2InterfaceGroup
 type FIBCL2InterfaceGroupModHandler interface {
 	FIBCL2InterfaceGroupMod(*fibcnet.Header, *GroupMod, *L2InterfaceGroup)
@@ -61,6 +71,31 @@ type FIBCL3UnicastGroupModHandler interface {
 	FIBCL3UnicastGroupMod(*fibcnet.Header, *GroupMod, *L3UnicastGroup)
 }
 
+// L3EcmpGroup
+type FIBCL3EcmpGroupModHandler interface {
+	FIBCL3EcmpGroupMod(*fibcnet.Header, *GroupMod, *L3EcmpGroup)
+}
+
+// L3MulticastGroup
+type FIBCL3MulticastGroupModHandler interface {
+	FIBCL3MulticastGroupMod(*fibcnet.Header, *GroupMod, *L3MulticastGroup)
+}
+
+// L2OverlayGroup
+type FIBCL2OverlayGroupModHandler interface {
+	FIBCL2OverlayGroupMod(*fibcnet.Header, *GroupMod, *L2OverlayGroup)
+}
+
+// VxlanTunnel
+type FIBCVxlanTunnelModHandler interface {
+	FIBCVxlanTunnelMod(*fibcnet.Header, *GroupMod, *VxlanTunnel)
+}
+
+// SRv6EncapGroup
+type FIBCSRv6EncapGroupModHandler interface {
+	FIBCSRv6EncapGroupMod(*fibcnet.Header, *GroupMod, *SRv6EncapGroup)
+}
+
 // MPLSInterfaceGroup
 type FIBCMPLSInterfaceGroupModHandler interface {
 	FIBCMPLSInterfaceGroupMod(*fibcnet.Header, *GroupMod, *MPLSInterfaceGroup)
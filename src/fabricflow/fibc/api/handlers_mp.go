@@ -51,3 +51,25 @@ type FFMultipartPortDescRequestHandler interface {
 type FFMultipartPortDescReplyHandler interface {
 	FIBCFFMultipartPortDescReply(*fibcnet.Header, *FFMultipart_Reply, *FFMultipart_PortDescReply)
 }
+
+//
+// Multipart.Flow
+//
+type FFMultipartFlowRequestHandler interface {
+	FIBCFFMultipartFlowRequest(*fibcnet.Header, *FFMultipart_Request, *FFMultipart_FlowRequest)
+}
+
+type FFMultipartFlowReplyHandler interface {
+	FIBCFFMultipartFlowReply(*fibcnet.Header, *FFMultipart_Reply, *FFMultipart_FlowReply)
+}
+
+//
+// Multipart.GroupDesc
+//
+type FFMultipartGroupDescRequestHandler interface {
+	FIBCFFMultipartGroupDescRequest(*fibcnet.Header, *FFMultipart_Request, *FFMultipart_GroupDescRequest)
+}
+
+type FFMultipartGroupDescReplyHandler interface {
+	FIBCFFMultipartGroupDescReply(*fibcnet.Header, *FFMultipart_Reply, *FFMultipart_GroupDescReply)
+}
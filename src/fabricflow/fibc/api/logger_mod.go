@@ -93,6 +93,33 @@ func LogUnicastRoutingFlow(logger LogLogger, level log.Level, flow *UnicastRouti
 	logger.Logf(level, "FlowMod(U.C.): group  %s 0x%x", flow.GType, flow.GId)
 }
 
+func LogMulticastRoutingFlow(logger LogLogger, level log.Level, flow *MulticastRoutingFlow) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "FlowMod(M.C.): match  sip : '%s'", flow.Match.IpSrc)
+	logger.Logf(level, "FlowMod(M.C.): match  dip : '%s'", flow.Match.IpDst)
+	logger.Logf(level, "FlowMod(M.C.): match  vrf : %d", flow.Match.Vrf)
+	logger.Logf(level, "FlowMod(M.C.): match  vid : %d", flow.Match.VlanVid)
+	logger.Logf(level, "FlowMod(M.C.): group  %s 0x%x", flow.GType, flow.GId)
+}
+
+func LogSRv6LocalSidFlow(logger LogLogger, level log.Level, flow *SRv6LocalSidFlow) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "FlowMod(SRv6): match  sid : '%s'", flow.Match.Sid)
+	logger.Logf(level, "FlowMod(SRv6): match  vrf : %d", flow.Match.Vrf)
+
+	if a := flow.Action; a != nil {
+		logger.Logf(level, "FlowMod(SRv6): action %s:%d", a.Name, a.Value)
+	}
+
+	logger.Logf(level, "FlowMod(SRv6): group  %s 0x%x", flow.GType, flow.GId)
+}
+
 func LogBridgingFlow(logger LogLogger, level log.Level, flow *BridgingFlow) {
 	if isSkipLog(level) {
 		return
@@ -118,9 +145,14 @@ func LogPolicyACLFlow(logger LogLogger, level log.Level, flow *PolicyACLFlow) {
 	logger.Logf(level, "FlowMod(ACL): match  dstmac : '%s'", flow.Match.EthDst)
 	logger.Logf(level, "FlowMod(ACL): match  proto  : %d", flow.Match.IpProto)
 	logger.Logf(level, "FlowMod(ACL): match  dstip  : '%s'", flow.Match.IpDst)
-	logger.Logf(level, "FlowMod(ACL): match  port   : %d->%d", flow.Match.TpSrc, flow.Match.TpDst)
-
-	if a := flow.Action; a != nil {
+	logger.Logf(level, "FlowMod(ACL): match  srcip  : '%s'", flow.Match.IpSrc)
+	logger.Logf(level, "FlowMod(ACL): match  port   : %d/0x%x->%d/0x%x",
+		flow.Match.TpSrc, flow.Match.TpSrcMask, flow.Match.TpDst, flow.Match.TpDstMask)
+	logger.Logf(level, "FlowMod(ACL): match  vid    : %d", flow.Match.VlanVid)
+	logger.Logf(level, "FlowMod(ACL): match  dscp   : %d/0x%x", flow.Match.IpDscp, flow.Match.IpDscpMask)
+	logger.Logf(level, "FlowMod(ACL): prio   %d", flow.Priority)
+
+	for _, a := range flow.AllActions() {
 		logger.Logf(level, "FlowMod(ACL): action %s:%d", a.Name, a.Value)
 	}
 }
@@ -137,6 +169,14 @@ func LogL2InterfaceGroup(logger LogLogger, level log.Level, g *L2InterfaceGroup)
 	logger.Logf(level, "GroupMod(L2-IF): mac   : '%s'", g.HwAddr)
 	logger.Logf(level, "GroupMod(L2-IF): mtu   : %d", g.Mtu)
 	logger.Logf(level, "GroupMod(L2-IF): master: %d", g.Master)
+	if b := g.Bond; b != nil {
+		logger.Logf(level, "GroupMod(L2-IF): bond  : %s %s min:%d agg:%d",
+			b.Mode, b.HashPolicy, b.MinLinks, b.AggregatorId)
+	}
+	if b := g.BondSlave; b != nil {
+		logger.Logf(level, "GroupMod(L2-IF): slave : active:%t up:%t agg:%d col:%t dist:%t",
+			b.Active, b.LinkUp, b.AggregatorId, b.Collecting, b.Distributing)
+	}
 }
 
 func LogL3UnicastGroup(logger LogLogger, level log.Level, g *L3UnicastGroup) {
@@ -153,6 +193,67 @@ func LogL3UnicastGroup(logger LogLogger, level log.Level, g *L3UnicastGroup) {
 	logger.Logf(level, "GroupMod(L3-UC): local :'%s'", g.EthSrc)
 }
 
+func LogL3EcmpGroup(logger LogLogger, level log.Level, g *L3EcmpGroup) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "GroupMod(L3-ECMP): ecmp  : %d", g.EcmpId)
+	logger.Logf(level, "GroupMod(L3-ECMP): neighs: %v", g.NeIds)
+}
+
+func LogL2OverlayGroup(logger LogLogger, level log.Level, g *L2OverlayGroup) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "GroupMod(L2-OVL): tunnel: %d", g.TunnelId)
+	logger.Logf(level, "GroupMod(L2-OVL): index : %d", g.Index)
+	logger.Logf(level, "GroupMod(L2-OVL): vid   : %d", g.VlanVid)
+	for _, port := range g.TunPorts {
+		logger.Logf(level, "GroupMod(L2-OVL): port  : 0x%x", port)
+	}
+}
+
+func LogVxlanTunnel(logger LogLogger, level log.Level, t *VxlanTunnel) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "GroupMod(VXLAN): tunport: %d (0x%x)", t.TunPortId, t.DPPort())
+	logger.Logf(level, "GroupMod(VXLAN): vni    : %d", t.Vni)
+	logger.Logf(level, "GroupMod(VXLAN): vid    : %d", t.VlanVid)
+	logger.Logf(level, "GroupMod(VXLAN): local  : '%s'", t.Local)
+	logger.Logf(level, "GroupMod(VXLAN): remote : '%s'", t.Remote)
+	logger.Logf(level, "GroupMod(VXLAN): udp    : %d", t.GetAdjustedUdpPort())
+	logger.Logf(level, "GroupMod(VXLAN): ttl    : %d", t.Ttl)
+	logger.Logf(level, "GroupMod(VXLAN): neigh  : %d", t.NeId)
+	logger.Logf(level, "GroupMod(VXLAN): port   : %d (0x%x)", t.PortId, t.PortId)
+}
+
+func LogSRv6EncapGroup(logger LogLogger, level log.Level, g *SRv6EncapGroup) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "GroupMod(SRv6): encap : %d", g.EncapId)
+	logger.Logf(level, "GroupMod(SRv6): mode  : %s", g.Mode)
+	logger.Logf(level, "GroupMod(SRv6): segs  : %v", g.Segments)
+	logger.Logf(level, "GroupMod(SRv6): neigh : %d", g.NeId)
+}
+
+func LogL3MulticastGroup(logger LogLogger, level log.Level, g *L3MulticastGroup) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "GroupMod(L3-MC): mcast : %d", g.McId)
+	logger.Logf(level, "GroupMod(L3-MC): vid   : %d", g.VlanVid)
+	for _, port := range g.Ports {
+		logger.Logf(level, "GroupMod(L3-MC): port  : %d (0x%x) vid %d '%s'", port.PortId, port.PortId, port.VlanVid, port.EthSrc)
+	}
+}
+
 func LogMPLSInterfaceGroup(logger LogLogger, level log.Level, g *MPLSInterfaceGroup) {
 	if isSkipLog(level) {
 		return
@@ -231,6 +332,10 @@ func (h *logModHandler) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *Flow
 	LogUnicastRoutingFlow(h.logger, h.level, flow)
 }
 
+func (h *logModHandler) FIBCMulticastRoutingFlowMod(hdr *fibcnet.Header, mod *FlowMod, flow *MulticastRoutingFlow) {
+	LogMulticastRoutingFlow(h.logger, h.level, flow)
+}
+
 func (h *logModHandler) FIBCBridgingFlowMod(hdr *fibcnet.Header, mod *FlowMod, flow *BridgingFlow) {
 	LogBridgingFlow(h.logger, h.level, flow)
 }
@@ -239,6 +344,10 @@ func (h *logModHandler) FIBCPolicyACLFlowMod(hdr *fibcnet.Header, mod *FlowMod,
 	LogPolicyACLFlow(h.logger, h.level, flow)
 }
 
+func (h *logModHandler) FIBCSRv6LocalSidFlowMod(hdr *fibcnet.Header, mod *FlowMod, flow *SRv6LocalSidFlow) {
+	LogSRv6LocalSidFlow(h.logger, h.level, flow)
+}
+
 func (h *logModHandler) FIBCL2InterfaceGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *L2InterfaceGroup) {
 	LogL2InterfaceGroup(h.logger, h.level, grp)
 }
@@ -247,6 +356,26 @@ func (h *logModHandler) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *GroupMod
 	LogL3UnicastGroup(h.logger, h.level, grp)
 }
 
+func (h *logModHandler) FIBCL3EcmpGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *L3EcmpGroup) {
+	LogL3EcmpGroup(h.logger, h.level, grp)
+}
+
+func (h *logModHandler) FIBCL3MulticastGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *L3MulticastGroup) {
+	LogL3MulticastGroup(h.logger, h.level, grp)
+}
+
+func (h *logModHandler) FIBCL2OverlayGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *L2OverlayGroup) {
+	LogL2OverlayGroup(h.logger, h.level, grp)
+}
+
+func (h *logModHandler) FIBCVxlanTunnelMod(hdr *fibcnet.Header, mod *GroupMod, tun *VxlanTunnel) {
+	LogVxlanTunnel(h.logger, h.level, tun)
+}
+
+func (h *logModHandler) FIBCSRv6EncapGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *SRv6EncapGroup) {
+	LogSRv6EncapGroup(h.logger, h.level, grp)
+}
+
 func (h *logModHandler) FIBCMPLSInterfaceGroupMod(hdr *fibcnet.Header, mod *GroupMod, grp *MPLSInterfaceGroup) {
 	LogMPLSInterfaceGroup(h.logger, h.level, grp)
 }
@@ -97,6 +97,22 @@ func (h *logApMonitorReplyHandler) FIBCApMonitorReplyLog(hdr *fibcnet.Header, ms
 	)
 }
 
+func (h *logApMonitorReplyHandler) FIBCApMonitorReplyPortStatus(hdr *fibcnet.Header, msg *ApMonitorReplyPortStatus) {
+	LogApMonitorReplyPortStatus(h.logger, h.level, msg)
+}
+
+func LogApMonitorReplyPortStatus(logger LogLogger, level log.Level, msg *ApMonitorReplyPortStatus) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "ApPortStatus: dpid  : %d", msg.DpId)
+	logger.Logf(level, "ApPortStatus: port  : %d", msg.PortNo)
+	logger.Logf(level, "ApPortStatus: status: %s", msg.Status)
+	logger.Logf(level, "ApPortStatus: reid  : '%s'", msg.ReId)
+	logger.Logf(level, "ApPortStatus: ifname: '%s'", msg.Ifname)
+}
+
 func LogApMonitorReply(logger LogLogger, level log.Level, msg *ApMonitorReply) {
 	if isSkipLog(level) {
 		return
@@ -72,6 +72,46 @@ func LogFFMultipartPortDescReply(logger LogLogger, level log.Level, m *FFMultipa
 	}
 }
 
+func LogFFMultipartFlowRequest(logger LogLogger, level log.Level, m *FFMultipart_FlowRequest) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "MP.FlowRequest:")
+}
+
+func LogFFMultipartFlowReply(logger LogLogger, level log.Level, m *FFMultipart_FlowReply) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "MP.FlowReply: #flows: %d", len(m.Flows))
+	for index, flow := range m.Flows {
+		logger.Logf(level, "MP.FlowReply[%d]:", index)
+		LogFlowMod(logger, level, flow)
+	}
+}
+
+func LogFFMultipartGroupDescRequest(logger LogLogger, level log.Level, m *FFMultipart_GroupDescRequest) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "MP.GroupDescRequest:")
+}
+
+func LogFFMultipartGroupDescReply(logger LogLogger, level log.Level, m *FFMultipart_GroupDescReply) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "MP.GroupDescReply: #groups: %d", len(m.Groups))
+	for index, group := range m.Groups {
+		logger.Logf(level, "MP.GroupDescReply[%d]:", index)
+		LogGroupMod(logger, level, group)
+	}
+}
+
 type logMPHandler struct {
 	level  log.Level
 	logger LogLogger
@@ -117,6 +157,22 @@ func (h *logMPHandler) FIBCFFMultipartPortDescReply(hdr *fibcnet.Header, mp *FFM
 	LogFFMultipartPortDescReply(h.logger, h.level, reply)
 }
 
+func (h *logMPHandler) FIBCFFMultipartFlowRequest(hdr *fibcnet.Header, mp *FFMultipart_Request, req *FFMultipart_FlowRequest) {
+	LogFFMultipartFlowRequest(h.logger, h.level, req)
+}
+
+func (h *logMPHandler) FIBCFFMultipartFlowReply(hdr *fibcnet.Header, mp *FFMultipart_Reply, reply *FFMultipart_FlowReply) {
+	LogFFMultipartFlowReply(h.logger, h.level, reply)
+}
+
+func (h *logMPHandler) FIBCFFMultipartGroupDescRequest(hdr *fibcnet.Header, mp *FFMultipart_Request, req *FFMultipart_GroupDescRequest) {
+	LogFFMultipartGroupDescRequest(h.logger, h.level, req)
+}
+
+func (h *logMPHandler) FIBCFFMultipartGroupDescReply(hdr *fibcnet.Header, mp *FFMultipart_Reply, reply *FFMultipart_GroupDescReply) {
+	LogFFMultipartGroupDescReply(h.logger, h.level, reply)
+}
+
 func LogFFMultipartRequest(logger LogLogger, level log.Level, m *FFMultipart_Request, xid uint32) {
 	if isSkipLog(level) {
 		return
@@ -140,6 +140,52 @@ func NewMultipart_Reply_PortDesc(dpId uint64, ports []*FFPort, internal bool) *F
 	}
 }
 
+//
+// Multipart Request (Flow)
+//
+func NewFFMultipart_Request_Flow(dpId uint64) *FFMultipart_Request {
+	return NewFFMultipartRequest(dpId).SetFlow(NewFFMultipartFlowRequest())
+}
+
+func newFFMultipart_Reply_Flow(flows []*FlowMod) *FFMultipart_Reply_Flow {
+	return &FFMultipart_Reply_Flow{
+		Flow: &FFMultipart_FlowReply{
+			Flows: flows,
+		},
+	}
+}
+
+func NewFFMultipart_Reply_Flow(dpId uint64, flows []*FlowMod) *FFMultipart_Reply {
+	return &FFMultipart_Reply{
+		DpId:   dpId,
+		MpType: FFMultipart_FLOW,
+		Body:   newFFMultipart_Reply_Flow(flows),
+	}
+}
+
+//
+// Multipart Request (GroupDesc)
+//
+func NewFFMultipart_Request_GroupDesc(dpId uint64) *FFMultipart_Request {
+	return NewFFMultipartRequest(dpId).SetGroupDesc(NewFFMultipartGroupDescRequest())
+}
+
+func newFFMultipart_Reply_GroupDesc(groups []*GroupMod) *FFMultipart_Reply_GroupDesc {
+	return &FFMultipart_Reply_GroupDesc{
+		GroupDesc: &FFMultipart_GroupDescReply{
+			Groups: groups,
+		},
+	}
+}
+
+func NewFFMultipart_Reply_GroupDesc(dpId uint64, groups []*GroupMod) *FFMultipart_Reply {
+	return &FFMultipart_Reply{
+		DpId:   dpId,
+		MpType: FFMultipart_GROUP_DESC,
+		Body:   newFFMultipart_Reply_GroupDesc(groups),
+	}
+}
+
 func NewFFMultipartRequest(dpId uint64) *FFMultipart_Request {
 	return &FFMultipart_Request{
 		DpId: dpId,
@@ -164,6 +210,24 @@ func (r *FFMultipart_Request) SetPortDesc(portDesc *FFMultipart_PortDescRequest)
 	return r
 }
 
+func (r *FFMultipart_Request) SetFlow(flow *FFMultipart_FlowRequest) *FFMultipart_Request {
+	r.MpType = FFMultipart_FLOW
+	r.Body = &FFMultipart_Request_Flow{
+		Flow: flow,
+	}
+
+	return r
+}
+
+func (r *FFMultipart_Request) SetGroupDesc(groupDesc *FFMultipart_GroupDescRequest) *FFMultipart_Request {
+	r.MpType = FFMultipart_GROUP_DESC
+	r.Body = &FFMultipart_Request_GroupDesc{
+		GroupDesc: groupDesc,
+	}
+
+	return r
+}
+
 func NewFFMultipartPortRequest(portNo uint32, names []string, cmd FFPortStats_Cmd) *FFMultipart_PortRequest {
 	return &FFMultipart_PortRequest{
 		PortNo: portNo,
@@ -178,6 +242,14 @@ func NewFFMultipartPortDescRequest(internal bool) *FFMultipart_PortDescRequest {
 	}
 }
 
+func NewFFMultipartFlowRequest() *FFMultipart_FlowRequest {
+	return &FFMultipart_FlowRequest{}
+}
+
+func NewFFMultipartGroupDescRequest() *FFMultipart_GroupDescRequest {
+	return &FFMultipart_GroupDescRequest{}
+}
+
 func NewDpMultipartRequest(xid uint32, request *FFMultipart_Request) *DpMultipartRequest {
 	return &DpMultipartRequest{
 		Xid:     xid,
@@ -63,3 +63,44 @@ func TestNewFFMultipart_Reply_Port(t *testing.T) {
 		t.Errorf("TestNewFFMultipart_Request_Port Port.Stats unmatch. %v", v)
 	}
 }
+
+func TestNewFFMultipart_Request_Flow(t *testing.T) {
+	dpId := uint64(0x123456789a)
+	req := NewFFMultipart_Request_Flow(dpId)
+
+	if v := req.DpId; v != dpId {
+		t.Errorf("NewFFMultipart_Request_Flow DpId unmatch. %d/%d", dpId, v)
+	}
+
+	if v := req.MpType; v != FFMultipart_FLOW {
+		t.Errorf("NewFFMultipart_Request_Flow MpType unmatch. %d", v)
+	}
+
+	if v := req.GetFlow(); v == nil {
+		t.Errorf("NewFFMultipart_Request_Flow Flow unmatch. %v", req.Body)
+	}
+}
+
+func TestNewFFMultipart_Reply_GroupDesc(t *testing.T) {
+	dpId := uint64(0x123456789a)
+	groups := []*GroupMod{
+		NewL3UnicastGroup(10, 1, 1, 0, nil, nil).ToMod(GroupMod_ADD, "1.1.1.1"),
+	}
+	reply := NewFFMultipart_Reply_GroupDesc(dpId, groups)
+
+	if v := reply.DpId; v != dpId {
+		t.Errorf("NewFFMultipart_Reply_GroupDesc DpId unmatch. %d/%d", dpId, v)
+	}
+
+	if v := reply.MpType; v != FFMultipart_GROUP_DESC {
+		t.Errorf("NewFFMultipart_Reply_GroupDesc MpType unmatch. %d", v)
+	}
+
+	groupDesc := reply.GetGroupDesc()
+	if v := len(groupDesc.Groups); v != 1 {
+		t.Errorf("NewFFMultipart_Reply_GroupDesc #Groups unmatch. %d", v)
+	}
+	if v := groupDesc.Groups; v[0] != groups[0] {
+		t.Errorf("NewFFMultipart_Reply_GroupDesc Groups unmatch. %v", v)
+	}
+}
@@ -26,7 +26,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 //
@@ -42,7 +41,7 @@ func (c *APAPICommand) setFlags(cmd *cobra.Command) *cobra.Command {
 }
 
 func (c *APAPICommand) connect(f func(fibcapi.FIBCApApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
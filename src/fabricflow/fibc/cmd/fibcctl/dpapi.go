@@ -26,7 +26,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 //
@@ -64,7 +63,7 @@ func (c *DPAPICommand) setMultipartPortFlags(cmd *cobra.Command) *cobra.Command
 }
 
 func (c *DPAPICommand) connect(f func(fibcapi.FIBCDpApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
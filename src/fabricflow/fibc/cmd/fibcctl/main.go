@@ -18,14 +18,30 @@
 package main
 
 import (
+	ffgrpc "fabricflow/util/grpc"
 	"os"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
 const FibcAddr = "localhost:50070"
 
+var fibcAuth = ffgrpc.ClientAuth{}
+
+//
+// dialFibc connects to fibcd with tls and token settings.
+//
+func dialFibc(addr string) (*grpc.ClientConn, error) {
+	opts, err := fibcAuth.DialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Dial(ffgrpc.DialTarget(addr), opts...)
+}
+
 //
 // Command is rot command.
 //
@@ -64,6 +80,17 @@ func (c *Command) execute(name string) error {
 	rootCmd.PersistentFlags().BoolVar(
 		&c.Completion, "show-completion", false, "Show bash-comnpletion")
 
+	rootCmd.PersistentFlags().StringVarP(
+		&fibcAuth.CAFile, "tls-ca", "", "", "CA file to verify fibcd certificate.")
+	rootCmd.PersistentFlags().StringVarP(
+		&fibcAuth.CertFile, "tls-cert", "", "", "client certificate file.")
+	rootCmd.PersistentFlags().StringVarP(
+		&fibcAuth.KeyFile, "tls-key", "", "", "client private key file.")
+	rootCmd.PersistentFlags().StringVarP(
+		&fibcAuth.ServerName, "tls-server-name", "", "", "fibcd server name.")
+	rootCmd.PersistentFlags().StringVarP(
+		&fibcAuth.Token, "token", "", "", "token to connect fibcd.")
+
 	rootCmd.AddCommand(
 		dpAPICmd(),
 		apAPICmd(),
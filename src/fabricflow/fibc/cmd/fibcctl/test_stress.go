@@ -28,7 +28,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 //
@@ -68,7 +67,7 @@ func (c *TestStressCmd) setRunPacketFlags(cmd *cobra.Command) *cobra.Command {
 }
 
 func (c *TestStressCmd) connectVMAPI(f func(fibcapi.FIBCVmApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
@@ -78,7 +77,7 @@ func (c *TestStressCmd) connectVMAPI(f func(fibcapi.FIBCVmApiClient) error) erro
 }
 
 func (c *TestStressCmd) connectVSAPI(f func(fibcapi.FIBCVsApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
@@ -25,7 +25,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 //
@@ -65,7 +64,7 @@ func (c *VMAPICommand) setPortConfigFlags(cmd *cobra.Command) *cobra.Command {
 }
 
 func (c *VMAPICommand) connect(f func(fibcapi.FIBCVmApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
@@ -26,7 +26,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 //
@@ -44,7 +43,7 @@ func (c *VSAPICommand) setFlags(cmd *cobra.Command) *cobra.Command {
 }
 
 func (c *VSAPICommand) connect(f func(fibcapi.FIBCVsApiClient) error) error {
-	conn, err := grpc.Dial(c.Addr, grpc.WithInsecure())
+	conn, err := dialFibc(c.Addr)
 	if err != nil {
 		return err
 	}
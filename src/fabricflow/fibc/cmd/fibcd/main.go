@@ -20,9 +20,11 @@ package main
 import (
 	"fabricflow/fibc/pkgs/fibccfg"
 	"fabricflow/fibc/pkgs/fibcsrv"
+	ffgrpc "fabricflow/util/grpc"
 	"fmt"
 	"net"
 	"os"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
@@ -37,6 +39,9 @@ const (
 	argsListenPort   = 50061
 	argNcConfigPath  = "/tmp/ncmi.yaml"
 	argNcConfigType  = "yaml"
+	argsTokenType    = "yaml"
+	argsHATimeout    = 5 * time.Second
+	argsMetricsIntvl = 15 * time.Second
 )
 
 //
@@ -51,6 +56,21 @@ type App struct {
 	ListenPort   uint16
 	NcConfigPath string
 	NcConfigType string
+	TLSCertFile  string
+	TLSKeyFile   string
+	TLSCAFile    string
+	TokenFile    string
+	TokenType    string
+	HAStandby    bool
+	HAPeer       string
+	HANodeID     string
+	HATimeout    time.Duration
+	HAToken      string
+	HAServerName string
+	MetricsAddr  string
+	MetricsIntvl time.Duration
+	LLDPIntvl    time.Duration
+	LLDPSysName  string
 
 	Verbose bool
 	Trace   bool
@@ -67,6 +87,21 @@ func (a *App) parseArgs() {
 	flag.Uint16VarP(&a.ListenPort, "listen-port", "p", argsListenPort, "listen port.")
 	flag.StringVarP(&a.NcConfigPath, "netconf-config-file", "", argNcConfigPath, "netconf config path.")
 	flag.StringVarP(&a.NcConfigType, "netconf-config-type", "", argNcConfigType, "netconf config type.")
+	flag.StringVarP(&a.TLSCertFile, "tls-cert-file", "", "", "server certificate file.")
+	flag.StringVarP(&a.TLSKeyFile, "tls-key-file", "", "", "server private key file.")
+	flag.StringVarP(&a.TLSCAFile, "tls-ca-file", "", "", "CA file to verify client certificate.")
+	flag.StringVarP(&a.TokenFile, "token-file", "", "", "token file of each role (ap, vm, vs, dp).")
+	flag.StringVarP(&a.TokenType, "token-type", "", argsTokenType, "token file type.")
+	flag.BoolVarP(&a.HAStandby, "ha-standby", "", false, "start as standby of ha-peer.")
+	flag.StringVarP(&a.HAPeer, "ha-peer", "", "", "address of active fibcd (host:port).")
+	flag.StringVarP(&a.HANodeID, "ha-node-id", "", "", "node id of this fibcd.")
+	flag.DurationVarP(&a.HATimeout, "ha-timeout", "", argsHATimeout, "time to promote to active after active is lost.")
+	flag.StringVarP(&a.HAToken, "ha-token", "", "", "token to connect active fibcd.")
+	flag.StringVarP(&a.HAServerName, "ha-tls-server-name", "", "", "server name of active fibcd.")
+	flag.StringVarP(&a.MetricsAddr, "metrics-addr", "", "", "listen address of metrics (host:port). disabled if empty.")
+	flag.DurationVarP(&a.MetricsIntvl, "metrics-interval", "", argsMetricsIntvl, "interval to fetch port stats for metrics.")
+	flag.DurationVarP(&a.LLDPIntvl, "lldp-interval", "", 0, "interval to send lldp frames. disabled if 0.")
+	flag.StringVarP(&a.LLDPSysName, "lldp-system-name", "", "", "system name of lldp frames. re_id is used if empty.")
 	flag.BoolVarP(&a.Verbose, "verbose", "v", false, "show deail messages.")
 	flag.BoolVarP(&a.Trace, "trace", "", false, "show more deail messages.")
 	flag.Parse()
@@ -81,6 +116,19 @@ func (a *App) dumpArgs() {
 	a.log.Infof("listen-port    : %d", a.ListenPort)
 	a.log.Infof("nc-config-file : '%s'", a.NcConfigPath)
 	a.log.Infof("nc-config-type : '%s'", a.NcConfigType)
+	a.log.Infof("tls-cert-file  : '%s'", a.TLSCertFile)
+	a.log.Infof("tls-key-file   : '%s'", a.TLSKeyFile)
+	a.log.Infof("tls-ca-file    : '%s'", a.TLSCAFile)
+	a.log.Infof("token-file     : '%s'", a.TokenFile)
+	a.log.Infof("token-type     : '%s'", a.TokenType)
+	a.log.Infof("ha-standby     : %t", a.HAStandby)
+	a.log.Infof("ha-peer        : '%s'", a.HAPeer)
+	a.log.Infof("ha-node-id     : '%s'", a.HANodeID)
+	a.log.Infof("ha-timeout     : %s", a.HATimeout)
+	a.log.Infof("metrics-addr   : '%s'", a.MetricsAddr)
+	a.log.Infof("metrics-intvl  : %s", a.MetricsIntvl)
+	a.log.Infof("lldp-intvl     : %s", a.LLDPIntvl)
+	a.log.Infof("lldp-sysname   : '%s'", a.LLDPSysName)
 	a.log.Infof("verbose        : %t", a.Verbose)
 	a.log.Infof("trace          : %t", a.Trace)
 }
@@ -99,6 +147,35 @@ func (a *App) dumpConfig(cfg *fibccfg.Config) {
 	}
 }
 
+func (a *App) newServerAuth() (*ffgrpc.ServerAuth, error) {
+	auth := fibcsrv.NewServerAuth()
+	auth.CertFile = a.TLSCertFile
+	auth.KeyFile = a.TLSKeyFile
+	auth.CAFile = a.TLSCAFile
+
+	if len(a.TokenFile) != 0 {
+		tokens, err := fibcsrv.ReadAuthTokens(a.TokenFile, a.TokenType)
+		if err != nil {
+			return nil, err
+		}
+		auth.Tokens = tokens
+	}
+
+	a.log.Infof("auth: %s", auth)
+
+	return auth, nil
+}
+
+func (a *App) newHAClientAuth() *ffgrpc.ClientAuth {
+	return &ffgrpc.ClientAuth{
+		CAFile:     a.TLSCAFile,
+		CertFile:   a.TLSCertFile,
+		KeyFile:    a.TLSKeyFile,
+		ServerName: a.HAServerName,
+		Token:      a.HAToken,
+	}
+}
+
 func newApp() *App {
 	app := App{
 		log: log.WithFields(log.Fields{"module": "main"}),
@@ -124,6 +201,52 @@ func (a *App) run() error {
 
 	a.dumpConfig(cfg)
 
+	auth, err := a.newServerAuth()
+	if err != nil {
+		a.log.Errorf("Auth error. %s", err)
+		return err
+	}
+
+	opts, err := auth.ServerOptions()
+	if err != nil {
+		a.log.Errorf("Auth option error. %s", err)
+		return err
+	}
+
+	s := fibcsrv.NewServer(opts...)
+	s.SetConfig(cfg)
+	s.SetNetconfConfig(a.NcConfigPath, a.NcConfigType)
+
+	if a.HAStandby {
+		if len(a.HAPeer) == 0 {
+			err := fmt.Errorf("ha-peer not specified.")
+			a.log.Errorf("Standby error. %s", err)
+			return err
+		}
+
+		haOpts, err := a.newHAClientAuth().DialOptions()
+		if err != nil {
+			a.log.Errorf("HA auth error. %s", err)
+			return err
+		}
+
+		if err := s.Standby(a.HAPeer, a.HANodeID, a.HATimeout, haOpts, nil); err != nil {
+			a.log.Errorf("Standby error. %s", err)
+			return err
+		}
+	}
+
+	if len(a.MetricsAddr) != 0 {
+		if err := s.ServeMetrics(a.MetricsAddr, a.MetricsIntvl, nil); err != nil {
+			a.log.Errorf("Metrics error. %s %s", a.MetricsAddr, err)
+			return err
+		}
+	}
+
+	if a.LLDPIntvl > 0 {
+		s.ServeLLDP(a.LLDPIntvl, a.LLDPSysName, nil)
+	}
+
 	listenAddr := fmt.Sprintf("%s:%d", a.ListenIP, a.ListenPort)
 	lis, err := net.Listen(a.ListenNW, listenAddr)
 	if err != nil {
@@ -131,9 +254,6 @@ func (a *App) run() error {
 		return err
 	}
 
-	s := fibcsrv.NewServer()
-	s.SetConfig(cfg)
-	s.SetNetconfConfig(a.NcConfigPath, a.NcConfigType)
 	s.Serve(lis)
 
 	return nil
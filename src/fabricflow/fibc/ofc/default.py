@@ -84,6 +84,13 @@ def unicast_routing_flow(dpath, mod, ofctl):
     _LOG.debug("Unicast Routing FLow: %d %s %s", dpath.id, mod, ofctl)
 
 
+def multicast_routing_flow(dpath, mod, ofctl):
+    """
+    Create flow_mod for Multicast Routing flow table.
+    """
+    _LOG.debug("Multicast Routing FLow: %d %s %s", dpath.id, mod, ofctl)
+
+
 def bridging_flow(dpath, mod, ofctl):
     """
     Bridging flow table.
@@ -98,6 +105,13 @@ def policy_acl_flow(dpath, mod, ofctl):
     _LOG.debug("ACL FLow: %d %s %s", dpath.id, mod, ofctl)
 
 
+def srv6_local_sid_flow(dpath, mod, ofctl):
+    """
+    SRv6 Local SID flow table.
+    """
+    _LOG.debug("SRv6 Local SID FLow: %d %s %s", dpath.id, mod, ofctl)
+
+
 def setup_group(dpath, mod, ofctl):
     """
     Default Group.
@@ -126,6 +140,34 @@ def l3_ecmp_group(dpath, mod, ofctl):
     _LOG.debug("L3 ECMP Group: %d %s %s", dpath.id, mod, ofctl)
 
 
+def l3_multicast_group(dpath, mod, ofctl):
+    """
+    L3 Multicast Group
+    """
+    _LOG.debug("L3 Multicast Group: %d %s %s", dpath.id, mod, ofctl)
+
+
+def l2_overlay_group(dpath, mod, ofctl):
+    """
+    L2 Overlay Group
+    """
+    _LOG.debug("L2 Overlay Group: %d %s %s", dpath.id, mod, ofctl)
+
+
+def vxlan_tunnel(dpath, mod, ofctl):
+    """
+    VXLAN Tunnel
+    """
+    _LOG.debug("VXLAN Tunnel: %d %s %s", dpath.id, mod, ofctl)
+
+
+def srv6_encap_group(dpath, mod, ofctl):
+    """
+    SRv6 Encap Group
+    """
+    _LOG.debug("SRv6 Encap Group: %d %s %s", dpath.id, mod, ofctl)
+
+
 def mpls_interface_group(dpath, mod, ofctl):
     """
     MPLS Interface group
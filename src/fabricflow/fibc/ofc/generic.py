@@ -285,6 +285,45 @@ def unicast_routing_flow(dpath, mod, ofctl, use_metadata=True):
     ofctl.mod_flow_entry(dpath, flow, cmd)
 
 
+def multicast_routing_flow(dpath, mod, ofctl, use_metadata=True):
+    """
+    Create flow_mod for Multicast Routing flow table.
+    """
+    _LOG.debug("Multicast Routing FLow: %d %s", dpath.id, mod)
+
+    cmd = fibcapi.flow_mod_cmd(mod.cmd, dpath.ofproto)
+    entry = mod.mcast
+    vlan_vid = fibcapi.adjust_vlan_vid(entry.match.vlan_vid)
+
+    match = ofmatch.Match().vlan_vid(vlan_vid).ip_dst(entry.match.ip_dst)
+    if entry.match.ip_src:
+        match.ip_src(entry.match.ip_src)
+    match.vrf(entry.match.vrf, use_metadata)
+
+    def _actions():
+        if not offlow.is_action_needed(dpath, cmd):
+            return []
+        return [ofaction.goto_table(pb.FlowMod.POLICY_ACL)]
+
+    def _writes():
+        if not offlow.is_action_needed(dpath, cmd):
+            return []
+
+        if entry.g_type == pb.GroupMod.L3_MULTICAST:
+            return [ofaction.group(fibcapi.l3_multicast_group_id(entry.g_id, vlan_vid))]
+
+        return []
+
+    # (S,G) entry takes precedence over (*,G) entry.
+    priority = fibcapi.PRIORITY_NORMAL + (1 if entry.match.ip_src else 0)
+
+    flow = offlow.flow_mod(
+        match=match, actions=_actions, writes=_writes,
+        table_id=pb.FlowMod.MULTICAST_ROUTING, priority=priority)
+
+    ofctl.mod_flow_entry(dpath, flow, cmd)
+
+
 def bridging_flow(dpath, mod, ofctl):
     """
     Bridging flow table.
@@ -305,6 +344,17 @@ def policy_acl_flow(dpath, mod, ofctl, use_metadata=True):
         # flows for a port are send by setup_flow().
         return
 
+    if entry.priority:
+        # acl rules (ribcd --acl-rules-path) are supported by gonsld only.
+        _LOG.warning("ACL Flow: acl rule not supported. %s", entry)
+        return
+
+    if entry.match.ip_src:
+        # policy acl table is applied after unicast routing table,
+        # so set vrf action has no effect on routing.
+        _LOG.warning("ACL Flow: ip_src not supported. %s", entry)
+        return
+
     cmd = fibcapi.flow_mod_cmd(mod.cmd, dpath.ofproto)
     match = ofmatch.Match().ip_dst(entry.match.ip_dst).vrf(entry.match.vrf, use_metadata)
     def _actions():
@@ -319,6 +369,13 @@ def policy_acl_flow(dpath, mod, ofctl, use_metadata=True):
     ofctl.mod_flow_entry(dpath, flow, cmd)
 
 
+def srv6_local_sid_flow(dpath, mod, ofctl):
+    """
+    SRv6 Local SID flow table. (not supported by OF-DPA pipeline)
+    """
+    _LOG.debug("SRv6 Local SID FLow: %d %s %s", dpath.id, mod, ofctl)
+
+
 def setup_group(dpath, mod, ofctl):
     """
     Setup Group.
@@ -380,7 +437,69 @@ def l3_ecmp_group(dpath, mod, ofctl):
     """
     ECMP Group
     """
-    _LOG.debug("L3 ECMP Group: %d %s %s", dpath.id, mod, ofctl)
+    _LOG.debug("L3 ECMP Group: %d %s", dpath.id, mod)
+
+    entry = mod.l3_ecmp
+    cmd = fibcapi.group_mod_cmd(mod.cmd, dpath.ofproto)
+    gid = fibcapi.l3_ecmp_group_id(entry.ecmp_id)
+    def _buckets():
+        if not ofgroup.is_bucket_needed(dpath, cmd):
+            return []
+
+        return [dict(weight=1, actions=[ofaction.group(fibcapi.l3_unicast_group_id(ne_id))])
+                for ne_id in entry.ne_ids]
+
+    group = ofgroup.group_mod(gid, "SELECT", _buckets)
+    ofctl.mod_group_entry(dpath, group, cmd)
+
+
+def l3_multicast_group(dpath, mod, ofctl):
+    """
+    L3 Multicast Group
+    """
+    _LOG.debug("L3 Multicast Group: %d %s", dpath.id, mod)
+
+    entry = mod.l3_mcast
+    cmd = fibcapi.group_mod_cmd(mod.cmd, dpath.ofproto)
+    gid = fibcapi.l3_multicast_group_id(entry.mc_id, entry.vlan_vid)
+    def _bucket(port):
+        next_gid = fibcapi.l2_interface_group_id(port.port_id, port.vlan_vid)
+        vlan_vid = fibcapi.adjust_vlan_vid(port.vlan_vid) | fibcapi.OFPVID_PRESENT
+        return dict(actions=[
+            ofaction.set_field("eth_src", port.eth_src),
+            ofaction.set_field("vlan_vid", vlan_vid),
+            ofaction.group(next_gid),
+        ])
+
+    def _buckets():
+        if not ofgroup.is_bucket_needed(dpath, cmd):
+            return []
+
+        return [_bucket(port) for port in entry.ports]
+
+    group = ofgroup.group_mod(gid, "ALL", _buckets)
+    ofctl.mod_group_entry(dpath, group, cmd)
+
+
+def l2_overlay_group(dpath, mod, ofctl):
+    """
+    L2 Overlay Group
+    """
+    _LOG.debug("L2 Overlay Group: %d %s %s", dpath.id, mod, ofctl)
+
+
+def vxlan_tunnel(dpath, mod, ofctl):
+    """
+    VXLAN Tunnel
+    """
+    _LOG.debug("VXLAN Tunnel: %d %s %s", dpath.id, mod, ofctl)
+
+
+def srv6_encap_group(dpath, mod, ofctl):
+    """
+    SRv6 Encap Group (not supported by OF-DPA pipeline)
+    """
+    _LOG.debug("SRv6 Encap Group: %d %s %s", dpath.id, mod, ofctl)
 
 
 def mpls_interface_group(dpath, mod, ofctl):
@@ -33,8 +33,10 @@ def _flow_dict(mod):
         pb.FlowMod.TERM_MAC       : mod.termination_mac_flow,
         pb.FlowMod.MPLS1          : mod.mpls1_flow,
         pb.FlowMod.UNICAST_ROUTING: mod.unicast_routing_flow,
+        pb.FlowMod.MULTICAST_ROUTING: mod.multicast_routing_flow,
         pb.FlowMod.BRIDGING       : mod.bridging_flow,
         pb.FlowMod.POLICY_ACL     : mod.policy_acl_flow,
+        pb.FlowMod.SRV6_LOCAL_SID : mod.srv6_local_sid_flow,
     }
 
 def _group_dict(mod):
@@ -43,6 +45,10 @@ def _group_dict(mod):
         pb.GroupMod.L2_INTERFACE   : mod.l2_interface_group,
         pb.GroupMod.L3_UNICAST     : mod.l3_unicast_group,
         pb.GroupMod.L3_ECMP        : mod.l3_ecmp_group,
+        pb.GroupMod.L3_MULTICAST   : mod.l3_multicast_group,
+        pb.GroupMod.L2_OVERLAY_FL_UC: mod.l2_overlay_group,
+        pb.GroupMod.VXLAN_TUNNEL   : mod.vxlan_tunnel,
+        pb.GroupMod.SRV6_ENCAP     : mod.srv6_encap_group,
         pb.GroupMod.MPLS_INTERFACE : mod.mpls_interface_group,
         pb.GroupMod.MPLS_L3_VPN    : mod.mpls_l3_vpn_group,
         pb.GroupMod.MPLS_TUNNEL1   : mod.mpls_tun1_group,
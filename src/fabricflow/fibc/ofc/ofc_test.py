@@ -51,8 +51,10 @@ class TestFIBCOfc(unittest.TestCase):
             pb.FlowMod.TERM_MAC,
             pb.FlowMod.MPLS1,
             pb.FlowMod.UNICAST_ROUTING,
+            pb.FlowMod.MULTICAST_ROUTING,
             pb.FlowMod.BRIDGING,
             pb.FlowMod.POLICY_ACL,
+            pb.FlowMod.SRV6_LOCAL_SID,
         ]
 
         for dp_type in DP_TYPES:
@@ -66,6 +68,10 @@ class TestFIBCOfc(unittest.TestCase):
             pb.GroupMod.L2_INTERFACE,
             pb.GroupMod.L3_UNICAST,
             pb.GroupMod.L3_ECMP,
+            pb.GroupMod.L3_MULTICAST,
+            pb.GroupMod.L2_OVERLAY_FL_UC,
+            pb.GroupMod.VXLAN_TUNNEL,
+            pb.GroupMod.SRV6_ENCAP,
             pb.GroupMod.MPLS_INTERFACE,
             pb.GroupMod.MPLS_L3_VPN,
             pb.GroupMod.MPLS_TUNNEL1,
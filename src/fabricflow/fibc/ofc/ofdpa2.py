@@ -61,6 +61,13 @@ def unicast_routing_flow(dpath, mod, ofctl):
     return generic.unicast_routing_flow(dpath, mod, ofctl, False)
 
 
+def multicast_routing_flow(dpath, mod, ofctl):
+    """
+    Create flow_mod for Multicast Routing flow table.
+    """
+    return generic.multicast_routing_flow(dpath, mod, ofctl, False)
+
+
 def bridging_flow(dpath, mod, ofctl):
     """
     Bridging flow table.
@@ -75,6 +82,13 @@ def policy_acl_flow(dpath, mod, ofctl):
     return generic.policy_acl_flow(dpath, mod, ofctl, False)
 
 
+def srv6_local_sid_flow(dpath, mod, ofctl):
+    """
+    SRv6 Local SID flow table.
+    """
+    return generic.srv6_local_sid_flow(dpath, mod, ofctl)
+
+
 def setup_group(dpath, mod, ofctl):
     """
     Setup Group.
@@ -103,6 +117,34 @@ def l3_ecmp_group(dpath, mod, ofctl):
     return generic.l3_ecmp_group(dpath, mod, ofctl)
 
 
+def l3_multicast_group(dpath, mod, ofctl):
+    """
+    L3 Multicast Group
+    """
+    return generic.l3_multicast_group(dpath, mod, ofctl)
+
+
+def l2_overlay_group(dpath, mod, ofctl):
+    """
+    L2 Overlay Group
+    """
+    return generic.l2_overlay_group(dpath, mod, ofctl)
+
+
+def vxlan_tunnel(dpath, mod, ofctl):
+    """
+    VXLAN Tunnel
+    """
+    return generic.vxlan_tunnel(dpath, mod, ofctl)
+
+
+def srv6_encap_group(dpath, mod, ofctl):
+    """
+    SRv6 Encap Group
+    """
+    return generic.srv6_encap_group(dpath, mod, ofctl)
+
+
 def mpls_interface_group(dpath, mod, ofctl):
     """
     MPLS Interface group
@@ -99,6 +99,15 @@ def unicast_routing_flow(dpath, mod, ofctl):
     dpath.send_msg(pb.FLOW_MOD, mod)
 
 
+def multicast_routing_flow(dpath, mod, ofctl):
+    """
+    Create flow_mod for Multicast Routing flow table.
+    """
+    _LOG.debug("Multicast Routing FLow: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.FLOW_MOD, mod)
+
+
 def bridging_flow(dpath, mod, ofctl):
     """
     Bridging flow table.
@@ -121,6 +130,15 @@ def policy_acl_flow(dpath, mod, ofctl):
     dpath.send_msg(pb.FLOW_MOD, mod)
 
 
+def srv6_local_sid_flow(dpath, mod, ofctl):
+    """
+    SRv6 Local SID flow table.
+    """
+    _LOG.debug("SRv6 Local SID FLow: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.FLOW_MOD, mod)
+
+
 def setup_group(dpath, mod, ofctl):
     """
     Setup Group.
@@ -155,6 +173,42 @@ def l3_ecmp_group(dpath, mod, ofctl):
     dpath.send_msg(pb.GROUP_MOD, mod)
 
 
+def l3_multicast_group(dpath, mod, ofctl):
+    """
+    L3 Multicast Group.
+    """
+    _LOG.debug("L3 Multicast Group: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.GROUP_MOD, mod)
+
+
+def l2_overlay_group(dpath, mod, ofctl):
+    """
+    L2 Overlay Group.
+    """
+    _LOG.debug("L2 Overlay Group: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.GROUP_MOD, mod)
+
+
+def vxlan_tunnel(dpath, mod, ofctl):
+    """
+    VXLAN Tunnel.
+    """
+    _LOG.debug("VXLAN Tunnel: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.GROUP_MOD, mod)
+
+
+def srv6_encap_group(dpath, mod, ofctl):
+    """
+    SRv6 Encap Group.
+    """
+    _LOG.debug("SRv6 Encap Group: %d %s", dpath.id, mod)
+
+    dpath.send_msg(pb.GROUP_MOD, mod)
+
+
 def mpls_interface_group(dpath, mod, ofctl):
     """
     MPLS Interface group.
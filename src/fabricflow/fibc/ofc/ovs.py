@@ -34,12 +34,18 @@ vlan_flow = generic.vlan_flow
 termination_mac_flow = generic.termination_mac_flow
 mpls1_flow = generic.mpls1_flow
 unicast_routing_flow = generic.unicast_routing_flow
+multicast_routing_flow = generic.multicast_routing_flow
 bridging_flow = generic.bridging_flow
 policy_acl_flow = generic.policy_acl_flow
+srv6_local_sid_flow = generic.srv6_local_sid_flow
 setup_group = generic.setup_group
 l2_interface_group = generic.l2_interface_group
 l3_unicast_group = generic.l3_unicast_group
 l3_ecmp_group = generic.l3_ecmp_group
+l3_multicast_group = generic.l3_multicast_group
+l2_overlay_group = generic.l2_overlay_group
+vxlan_tunnel = generic.vxlan_tunnel
+srv6_encap_group = generic.srv6_encap_group
 mpls_interface_group = generic.mpls_interface_group
 mpls_l3_vpn_group = _mpls_l3_vpn_group
 mpls_tun1_group = generic.mpls_tun1_group
@@ -30,15 +30,24 @@ import (
 // PortConfig is port configuration.
 //
 type PortConfig struct {
-	Name   string `mapstructure:"name"`
-	PortID uint16 `mapstructure:"port"`
+	Name     string `mapstructure:"name"`
+	PortID   uint16 `mapstructure:"port"`
+	PeerName string `mapstructure:"peer_name"`
+	PeerPort string `mapstructure:"peer_port"`
 }
 
 //
 // String is stringer.
 //
 func (c *PortConfig) String() string {
-	return fmt.Sprintf("{name:'%s', port:%d}", c.Name, c.PortID)
+	return fmt.Sprintf("{name:'%s', port:%d, peer:'%s/%s'}", c.Name, c.PortID, c.PeerName, c.PeerPort)
+}
+
+//
+// HasPeer returns true if expected LLDP neighbor is configured.
+//
+func (c *PortConfig) HasPeer() bool {
+	return len(c.PeerName) > 0 || len(c.PeerPort) > 0
 }
 
 //
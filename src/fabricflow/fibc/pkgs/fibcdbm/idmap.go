@@ -224,6 +224,60 @@ func (m *IDMap) UnregisterByReID(reID string) {
 	}
 }
 
+//
+// Reset replaces all entries. (used by standby to apply entries of active)
+//
+func (m *IDMap) Reset(entries []*IDEntry) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.entries = map[uint64]*IDEntry{}
+	m.reIDKey = NewIDMapReIDKey()
+
+	for _, e := range entries {
+		e.version = m.version
+		m.entries[e.DpID] = e
+		m.reIDKey.Add(e.ReID, e.DpID)
+	}
+}
+
+//
+// Merge updates entries and deletes entries of deleted dpIDs.
+// entries not in both are kept. (used by standby to apply entries of active)
+//
+func (m *IDMap) Merge(entries []*IDEntry, deleted []uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, dpID := range deleted {
+		if e := m.find(dpID); e != nil {
+			delete(m.entries, dpID)
+			m.reIDKey.Delete(e.ReID)
+		}
+	}
+
+	for _, e := range entries {
+		if old := m.find(e.DpID); old != nil {
+			if old.ReID == e.ReID {
+				old.version = m.version
+				continue
+			}
+
+			m.reIDKey.Delete(old.ReID)
+		}
+
+		if dpID, ok := m.reIDKey.Select(e.ReID); ok && dpID != e.DpID {
+			// re_id is moved to other dp_id.
+			delete(m.entries, dpID)
+			m.reIDKey.Delete(e.ReID)
+		}
+
+		e.version = m.version
+		m.entries[e.DpID] = e
+		m.reIDKey.Add(e.ReID, e.DpID)
+	}
+}
+
 //
 // Range enumerate all entry.
 //
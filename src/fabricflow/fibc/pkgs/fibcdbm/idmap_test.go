@@ -0,0 +1,65 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	"testing"
+)
+
+func TestIDMapMerge(t *testing.T) {
+	m := NewIDMap()
+
+	m.Register(NewIDEntry(10, "1.1.1.1"))
+	m.Register(NewIDEntry(20, "2.2.2.2"))
+	m.Register(NewIDEntry(30, "3.3.3.3"))
+
+	m.Merge([]*IDEntry{
+		NewIDEntry(10, "1.1.1.1"),
+		NewIDEntry(20, "2.2.2.20"),
+		NewIDEntry(40, "3.3.3.3"),
+	}, []uint64{50})
+
+	for dpID, reID := range map[uint64]string{
+		10: "1.1.1.1",
+		20: "2.2.2.20",
+		40: "3.3.3.3",
+	} {
+		if v, ok := m.SelectByDpID(dpID); !ok || v != reID {
+			t.Errorf("Merge unmatch. %d %s", dpID, v)
+		}
+
+		if v, ok := m.SelectByReID(reID); !ok || v != dpID {
+			t.Errorf("Merge unmatch. %s %d", reID, v)
+		}
+	}
+
+	for _, dpID := range []uint64{30} {
+		if v, ok := m.SelectByDpID(dpID); ok {
+			t.Errorf("Merge unmatch. %d %s", dpID, v)
+		}
+	}
+
+	if v, ok := m.SelectByReID("2.2.2.2"); ok {
+		t.Errorf("Merge unmatch. %d", v)
+	}
+
+	m.Merge([]*IDEntry{}, []uint64{10})
+	if v, ok := m.SelectByDpID(10); ok {
+		t.Errorf("Merge unmatch. %s", v)
+	}
+}
@@ -0,0 +1,253 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+//
+// LLDPKey is key of LLDPNeighborTable.
+//
+type LLDPKey struct {
+	DpID   uint64
+	PortID uint32
+}
+
+//
+// String returns string.
+//
+func (k *LLDPKey) String() string {
+	return fmt.Sprintf("dp:%d port:%d", k.DpID, k.PortID)
+}
+
+//
+// LLDPExpect is expected neighbor configured in port map.
+//
+type LLDPExpect struct {
+	SystemName string
+	PortID     string
+}
+
+//
+// String returns string.
+//
+func (e *LLDPExpect) String() string {
+	return fmt.Sprintf("%s/%s", e.SystemName, e.PortID)
+}
+
+//
+// Match returns true if system name and port id are expected.
+// Empty field of expect matches any value.
+//
+func (e *LLDPExpect) Match(systemName, portID string) bool {
+	if len(e.SystemName) > 0 && e.SystemName != systemName {
+		return false
+	}
+	if len(e.PortID) > 0 && e.PortID != portID {
+		return false
+	}
+	return true
+}
+
+//
+// LLDPNeighbor is entry of LLDPNeighborTable.
+//
+type LLDPNeighbor struct {
+	Key        LLDPKey
+	ChassisID  string
+	PortID     string
+	PortDesc   string
+	SystemName string
+	TTL        uint16
+	LastUpdate time.Time
+
+	Expect   *LLDPExpect
+	Mismatch bool
+}
+
+//
+// String returns string.
+//
+func (n *LLDPNeighbor) String() string {
+	return fmt.Sprintf("%s chassis:'%s' port:'%s' sysname:'%s' ttl:%d mismatch:%t",
+		&n.Key, n.ChassisID, n.PortID, n.SystemName, n.TTL, n.Mismatch)
+}
+
+//
+// IsExpired returns true if ttl of neighbor is expired.
+//
+func (n *LLDPNeighbor) IsExpired(now time.Time) bool {
+	return now.Sub(n.LastUpdate) > time.Duration(n.TTL)*time.Second
+}
+
+//
+// LLDPNeighborTable is table of neighbors learned by LLDP.
+//
+type LLDPNeighborTable struct {
+	mutex     sync.RWMutex
+	neighbors map[LLDPKey]*LLDPNeighbor
+	expects   map[LLDPKey]*LLDPExpect
+}
+
+//
+// NewLLDPNeighborTable returns new LLDPNeighborTable.
+//
+func NewLLDPNeighborTable() *LLDPNeighborTable {
+	return &LLDPNeighborTable{
+		neighbors: map[LLDPKey]*LLDPNeighbor{},
+		expects:   map[LLDPKey]*LLDPExpect{},
+	}
+}
+
+//
+// SetExpect registers expected neighbor of dpID/portID.
+//
+func (t *LLDPNeighborTable) SetExpect(dpID uint64, portID uint32, expect *LLDPExpect) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := LLDPKey{DpID: dpID, PortID: portID}
+	if expect == nil {
+		delete(t.expects, key)
+	} else {
+		t.expects[key] = expect
+	}
+
+	if n, ok := t.neighbors[key]; ok {
+		t.check(n)
+	}
+}
+
+//
+// ClearExpects removes all expected neighbors.
+//
+func (t *LLDPNeighborTable) ClearExpects() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.expects = map[LLDPKey]*LLDPExpect{}
+	for _, n := range t.neighbors {
+		t.check(n)
+	}
+}
+
+func (t *LLDPNeighborTable) check(n *LLDPNeighbor) {
+	n.Expect = t.expects[n.Key]
+	n.Mismatch = (n.Expect != nil) && !n.Expect.Match(n.SystemName, n.PortID)
+}
+
+//
+// Update adds or replaces neighbor.
+// It returns true if neighbor is new or changed.
+//
+func (t *LLDPNeighborTable) Update(n *LLDPNeighbor) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.check(n)
+
+	old, ok := t.neighbors[n.Key]
+	t.neighbors[n.Key] = n
+
+	if !ok {
+		return true
+	}
+
+	return old.ChassisID != n.ChassisID || old.PortID != n.PortID || old.SystemName != n.SystemName
+}
+
+//
+// Delete removes neighbor of dpID/portID.
+//
+func (t *LLDPNeighborTable) Delete(dpID uint64, portID uint32) *LLDPNeighbor {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := LLDPKey{DpID: dpID, PortID: portID}
+	n, ok := t.neighbors[key]
+	if !ok {
+		return nil
+	}
+
+	delete(t.neighbors, key)
+	return n
+}
+
+//
+// DeleteByDP removes all neighbors of dpID.
+//
+func (t *LLDPNeighborTable) DeleteByDP(dpID uint64) []*LLDPNeighbor {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	deleted := []*LLDPNeighbor{}
+	for key, n := range t.neighbors {
+		if key.DpID == dpID {
+			delete(t.neighbors, key)
+			deleted = append(deleted, n)
+		}
+	}
+
+	return deleted
+}
+
+//
+// Expire removes neighbors which ttl is expired.
+//
+func (t *LLDPNeighborTable) Expire(now time.Time) []*LLDPNeighbor {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	expired := []*LLDPNeighbor{}
+	for key, n := range t.neighbors {
+		if n.IsExpired(now) {
+			delete(t.neighbors, key)
+			expired = append(expired, n)
+		}
+	}
+
+	return expired
+}
+
+//
+// Range calls f for each neighbor in order of dpID/portID.
+//
+func (t *LLDPNeighborTable) Range(f func(*LLDPNeighbor)) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	keys := make([]LLDPKey, 0, len(t.neighbors))
+	for key := range t.neighbors {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].DpID != keys[j].DpID {
+			return keys[i].DpID < keys[j].DpID
+		}
+		return keys[i].PortID < keys[j].PortID
+	})
+
+	for _, key := range keys {
+		f(t.neighbors[key])
+	}
+}
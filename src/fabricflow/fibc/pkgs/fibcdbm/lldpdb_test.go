@@ -0,0 +1,125 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestLLDPNeighbor(dpID uint64, portID uint32, sysname, port string, now time.Time) *LLDPNeighbor {
+	return &LLDPNeighbor{
+		Key:        LLDPKey{DpID: dpID, PortID: portID},
+		ChassisID:  sysname,
+		PortID:     port,
+		SystemName: sysname,
+		TTL:        120,
+		LastUpdate: now,
+	}
+}
+
+func TestLLDPNeighborTableUpdate(t *testing.T) {
+	tbl := NewLLDPNeighborTable()
+	now := time.Now()
+
+	if upd := tbl.Update(newTestLLDPNeighbor(1, 2, "sw1", "eth1", now)); !upd {
+		t.Errorf("Update must be true (new).")
+	}
+	if upd := tbl.Update(newTestLLDPNeighbor(1, 2, "sw1", "eth1", now)); upd {
+		t.Errorf("Update must be false (same).")
+	}
+	if upd := tbl.Update(newTestLLDPNeighbor(1, 2, "sw1", "eth2", now)); !upd {
+		t.Errorf("Update must be true (changed).")
+	}
+
+	tbl.Update(newTestLLDPNeighbor(2, 1, "sw2", "eth1", now))
+	tbl.Update(newTestLLDPNeighbor(1, 1, "sw3", "eth1", now))
+
+	keys := []LLDPKey{}
+	tbl.Range(func(n *LLDPNeighbor) {
+		keys = append(keys, n.Key)
+	})
+
+	exp := []LLDPKey{{1, 1}, {1, 2}, {2, 1}}
+	if len(keys) != len(exp) {
+		t.Fatalf("Range unmatch. %v", keys)
+	}
+	for i, key := range exp {
+		if keys[i] != key {
+			t.Errorf("Range unmatch. %v", keys)
+		}
+	}
+
+	if n := tbl.Delete(1, 2); n == nil || n.PortID != "eth2" {
+		t.Errorf("Delete unmatch. %v", n)
+	}
+	if n := tbl.Delete(1, 2); n != nil {
+		t.Errorf("Delete must be nil. %v", n)
+	}
+	if ns := tbl.DeleteByDP(1); len(ns) != 1 {
+		t.Errorf("DeleteByDP unmatch. %v", ns)
+	}
+}
+
+func TestLLDPNeighborTableExpire(t *testing.T) {
+	tbl := NewLLDPNeighborTable()
+	now := time.Now()
+
+	tbl.Update(newTestLLDPNeighbor(1, 1, "sw1", "eth1", now.Add(-121*time.Second)))
+	tbl.Update(newTestLLDPNeighbor(1, 2, "sw1", "eth2", now.Add(-119*time.Second)))
+
+	ns := tbl.Expire(now)
+	if len(ns) != 1 || ns[0].Key.PortID != 1 {
+		t.Errorf("Expire unmatch. %v", ns)
+	}
+}
+
+func TestLLDPNeighborTableMismatch(t *testing.T) {
+	tbl := NewLLDPNeighborTable()
+	now := time.Now()
+
+	tbl.SetExpect(1, 1, &LLDPExpect{SystemName: "sw1", PortID: "eth1"})
+	tbl.SetExpect(1, 2, &LLDPExpect{SystemName: "sw1"})
+
+	n1 := newTestLLDPNeighbor(1, 1, "sw1", "eth1", now)
+	n2 := newTestLLDPNeighbor(1, 2, "sw2", "eth2", now)
+	n3 := newTestLLDPNeighbor(1, 3, "sw3", "eth3", now)
+	tbl.Update(n1)
+	tbl.Update(n2)
+	tbl.Update(n3)
+
+	if n1.Mismatch || n1.Expect == nil {
+		t.Errorf("Mismatch unmatch. %s", n1)
+	}
+	if !n2.Mismatch {
+		t.Errorf("Mismatch unmatch. %s", n2)
+	}
+	if n3.Mismatch || n3.Expect != nil {
+		t.Errorf("Mismatch unmatch. %s", n3)
+	}
+
+	tbl.SetExpect(1, 2, &LLDPExpect{SystemName: "sw2", PortID: "eth2"})
+	if n2.Mismatch {
+		t.Errorf("Mismatch unmatch. %s", n2)
+	}
+
+	tbl.ClearExpects()
+	if n1.Expect != nil || n2.Expect != nil {
+		t.Errorf("ClearExpects unmatch. %s %s", n1, n2)
+	}
+}
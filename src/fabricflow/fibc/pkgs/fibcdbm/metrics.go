@@ -0,0 +1,245 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	"bytes"
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MetricsTypeCounter is type of counter metric.
+	MetricsTypeCounter = "counter"
+	// MetricsTypeGauge is type of gauge metric.
+	MetricsTypeGauge = "gauge"
+	// MetricsTypeUntyped is type of untyped metric.
+	MetricsTypeUntyped = "untyped"
+
+	// MetricsContentType is content type of text exposition format.
+	MetricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+//
+// MetricLabel is label of metric sample.
+//
+type MetricLabel struct {
+	Name  string
+	Value string
+}
+
+//
+// NewMetricLabel returns new MetricLabel.
+//
+func NewMetricLabel(name, value string) *MetricLabel {
+	return &MetricLabel{
+		Name:  name,
+		Value: value,
+	}
+}
+
+type metricSample struct {
+	labels []*MetricLabel
+	value  float64
+}
+
+type metricFamily struct {
+	name    string
+	mtype   string
+	help    string
+	samples []*metricSample
+}
+
+//
+// Metrics is set of metric families
+// written in prometheus text exposition format.
+//
+type Metrics struct {
+	families []*metricFamily
+	index    map[string]*metricFamily
+}
+
+//
+// NewMetrics returns new Metrics.
+//
+func NewMetrics() *Metrics {
+	return &Metrics{
+		families: []*metricFamily{},
+		index:    map[string]*metricFamily{},
+	}
+}
+
+//
+// MetricName replaces characters not allowed in metric name with '_'.
+//
+func MetricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+var metricLabelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+//
+// Add adds sample. Samples of same name are written together
+// in order of first Add.
+//
+func (m *Metrics) Add(name, mtype, help string, value float64, labels ...*MetricLabel) {
+	name = MetricName(name)
+
+	f, ok := m.index[name]
+	if !ok {
+		f = &metricFamily{
+			name:    name,
+			mtype:   mtype,
+			help:    help,
+			samples: []*metricSample{},
+		}
+		m.index[name] = f
+		m.families = append(m.families, f)
+	}
+
+	f.samples = append(f.samples, &metricSample{
+		labels: labels,
+		value:  value,
+	})
+}
+
+//
+// WriteTo writes metrics.
+//
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	for _, f := range m.families {
+		if len(f.help) != 0 {
+			fmt.Fprintf(&buf, "# HELP %s %s\n", f.name, f.help)
+		}
+		fmt.Fprintf(&buf, "# TYPE %s %s\n", f.name, f.mtype)
+
+		for _, s := range f.samples {
+			buf.WriteString(f.name)
+			if len(s.labels) != 0 {
+				buf.WriteByte('{')
+				for index, label := range s.labels {
+					if index != 0 {
+						buf.WriteByte(',')
+					}
+					fmt.Fprintf(&buf, `%s="%s"`, label.Name, metricLabelReplacer.Replace(label.Value))
+				}
+				buf.WriteByte('}')
+			}
+			fmt.Fprintf(&buf, " %s\n", strconv.FormatFloat(s.value, 'f', -1, 64))
+		}
+	}
+
+	return buf.WriteTo(w)
+}
+
+//
+// PortStatsCacheEntry is port stats of datapath.
+//
+type PortStatsCacheEntry struct {
+	DpID  uint64
+	Time  time.Time
+	Stats []*fibcapi.FFPortStats
+}
+
+//
+// PortStatsCache is port stats of datapaths fetched periodically.
+//
+type PortStatsCache struct {
+	mutex   sync.RWMutex
+	entries map[uint64]*PortStatsCacheEntry
+}
+
+//
+// NewPortStatsCache returns new PortStatsCache.
+//
+func NewPortStatsCache() *PortStatsCache {
+	return &PortStatsCache{
+		entries: map[uint64]*PortStatsCacheEntry{},
+	}
+}
+
+//
+// Set replaces port stats of datapath.
+//
+func (c *PortStatsCache) Set(dpID uint64, stats []*fibcapi.FFPortStats) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries[dpID] = &PortStatsCacheEntry{
+		DpID:  dpID,
+		Time:  time.Now(),
+		Stats: stats,
+	}
+}
+
+//
+// Delete deletes port stats of datapath.
+//
+func (c *PortStatsCache) Delete(dpID uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, dpID)
+}
+
+//
+// Retain deletes port stats of datapaths for which keep returns false.
+// keep must not call methods of PortStatsCache.
+//
+func (c *PortStatsCache) Retain(keep func(uint64) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for dpID := range c.entries {
+		if !keep(dpID) {
+			delete(c.entries, dpID)
+		}
+	}
+}
+
+//
+// Range calls f with entries in order of dpid.
+//
+func (c *PortStatsCache) Range(f func(*PortStatsCacheEntry)) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	dpIDs := make([]uint64, 0, len(c.entries))
+	for dpID := range c.entries {
+		dpIDs = append(dpIDs, dpID)
+	}
+	sort.Slice(dpIDs, func(i, j int) bool { return dpIDs[i] < dpIDs[j] })
+
+	for _, dpID := range dpIDs {
+		f(c.entries[dpID])
+	}
+}
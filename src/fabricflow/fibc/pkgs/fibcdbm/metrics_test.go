@@ -0,0 +1,115 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	"bytes"
+	fibcapi "fabricflow/fibc/api"
+	"testing"
+	"time"
+)
+
+func TestMetrics_WriteTo(t *testing.T) {
+	m := NewMetrics()
+	m.Add("fibc_dp_connected", MetricsTypeGauge, "datapath is connected.", 1,
+		NewMetricLabel("dp_id", "1"))
+	m.Add("fibc_port_ifInOctets", MetricsTypeCounter, "", 12345678901,
+		NewMetricLabel("dp_id", "1"), NewMetricLabel("ifname", `eth"1`))
+	m.Add("fibc_dp_connected", MetricsTypeGauge, "datapath is connected.", 0,
+		NewMetricLabel("dp_id", "2"))
+	m.Add("fibc/stats", MetricsTypeCounter, "", 3)
+
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo error. %s", err)
+	}
+
+	expected := `# HELP fibc_dp_connected datapath is connected.
+# TYPE fibc_dp_connected gauge
+fibc_dp_connected{dp_id="1"} 1
+fibc_dp_connected{dp_id="2"} 0
+# TYPE fibc_port_ifInOctets counter
+fibc_port_ifInOctets{dp_id="1",ifname="eth\"1"} 12345678901
+# TYPE fibc_stats counter
+fibc_stats 3
+`
+	if s := buf.String(); s != expected {
+		t.Errorf("WriteTo unmatch.\n%s", s)
+	}
+}
+
+func TestPortStatsCache(t *testing.T) {
+	c := NewPortStatsCache()
+	c.Set(2, []*fibcapi.FFPortStats{{PortNo: 1}})
+	c.Set(1, []*fibcapi.FFPortStats{{PortNo: 1}, {PortNo: 2}})
+
+	dpIDs := []uint64{}
+	c.Range(func(e *PortStatsCacheEntry) {
+		dpIDs = append(dpIDs, e.DpID)
+	})
+
+	if len(dpIDs) != 2 || dpIDs[0] != 1 || dpIDs[1] != 2 {
+		t.Errorf("Range unmatch. %v", dpIDs)
+	}
+
+	c.Delete(1)
+
+	n := 0
+	c.Range(func(e *PortStatsCacheEntry) { n++ })
+	if n != 1 {
+		t.Errorf("Delete unmatch. %d", n)
+	}
+}
+
+func TestPortStatsCache_Retain(t *testing.T) {
+	c := NewPortStatsCache()
+	c.Set(1, []*fibcapi.FFPortStats{{PortNo: 1}})
+	c.Set(2, []*fibcapi.FFPortStats{{PortNo: 1}})
+
+	collect := func() []uint64 {
+		dpIDs := []uint64{}
+		c.Range(func(e *PortStatsCacheEntry) {
+			dpIDs = append(dpIDs, e.DpID)
+		})
+		return dpIDs
+	}
+
+	if dpIDs := collect(); len(dpIDs) != 2 {
+		t.Errorf("Range unmatch. %v", dpIDs)
+	}
+
+	// dp 1 is disconnected between two collections.
+	connected := map[uint64]bool{2: true}
+
+	done := make(chan struct{})
+	go func() {
+		c.Retain(func(dpID uint64) bool { return connected[dpID] })
+		c.Set(2, []*fibcapi.FFPortStats{{PortNo: 2}})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Retain blocked.")
+	}
+
+	if dpIDs := collect(); len(dpIDs) != 1 || dpIDs[0] != 2 {
+		t.Errorf("Retain unmatch. %v", dpIDs)
+	}
+}
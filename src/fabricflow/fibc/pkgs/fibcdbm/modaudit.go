@@ -0,0 +1,144 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	fibcapi "fabricflow/fibc/api"
+
+	"github.com/golang/protobuf/proto"
+)
+
+//
+// FlowModDiff is unmatched flow entry between vm and dp.
+//
+type FlowModDiff struct {
+	Result fibcapi.ApAuditModsEntry_Result
+	VM     *fibcapi.FlowMod
+	DP     *fibcapi.FlowMod
+}
+
+//
+// GroupModDiff is unmatched group entry between vm and dp.
+//
+type GroupModDiff struct {
+	Result fibcapi.ApAuditModsEntry_Result
+	VM     *fibcapi.GroupMod
+	DP     *fibcapi.GroupMod
+}
+
+//
+// IsUnverifiedFlowMod returns true if dp can not read back flow entry from hardware.
+// dp returns such entry with cmd NOP.
+//
+func IsUnverifiedFlowMod(mod *fibcapi.FlowMod) bool {
+	return mod.Cmd == fibcapi.FlowMod_NOP
+}
+
+//
+// IsUnverifiedGroupMod returns true if dp can not read back group entry from hardware.
+// dp returns such entry with cmd NOP.
+//
+func IsUnverifiedGroupMod(mod *fibcapi.GroupMod) bool {
+	return mod.Cmd == fibcapi.GroupMod_NOP
+}
+
+func equalFlowMod(vm, dp *fibcapi.FlowMod) bool {
+	v := proto.Clone(vm).(*fibcapi.FlowMod)
+	d := proto.Clone(dp).(*fibcapi.FlowMod)
+	v.Cmd = fibcapi.FlowMod_NOP
+	d.Cmd = fibcapi.FlowMod_NOP
+	return proto.Equal(v, d)
+}
+
+func equalGroupMod(vm, dp *fibcapi.GroupMod) bool {
+	v := proto.Clone(vm).(*fibcapi.GroupMod)
+	d := proto.Clone(dp).(*fibcapi.GroupMod)
+	v.Cmd = fibcapi.GroupMod_NOP
+	d.Cmd = fibcapi.GroupMod_NOP
+	return proto.Equal(v, d)
+}
+
+//
+// DiffFlowMods compares flow entries of vm and dp.
+// MISSING and DIFFERENT entries are returned in order of vm,
+// and EXTRA entries are followed in order of dp.
+// vm entries are not compared if dp entries are unverified.
+//
+func DiffFlowMods(vms, dps []*fibcapi.FlowMod) []*FlowModDiff {
+	dpMap := make(map[string]*fibcapi.FlowMod, len(dps))
+	for _, dp := range dps {
+		dpMap[dp.MatchKey()] = dp
+	}
+
+	diffs := []*FlowModDiff{}
+	vmKeys := make(map[string]struct{}, len(vms))
+	for _, vm := range vms {
+		key := vm.MatchKey()
+		vmKeys[key] = struct{}{}
+
+		dp, ok := dpMap[key]
+		if !ok {
+			diffs = append(diffs, &FlowModDiff{Result: fibcapi.ApAuditModsEntry_MISSING, VM: vm})
+		} else if !IsUnverifiedFlowMod(dp) && !equalFlowMod(vm, dp) {
+			diffs = append(diffs, &FlowModDiff{Result: fibcapi.ApAuditModsEntry_DIFFERENT, VM: vm, DP: dp})
+		}
+	}
+
+	for _, dp := range dps {
+		if _, ok := vmKeys[dp.MatchKey()]; !ok {
+			diffs = append(diffs, &FlowModDiff{Result: fibcapi.ApAuditModsEntry_EXTRA, DP: dp})
+		}
+	}
+
+	return diffs
+}
+
+//
+// DiffGroupMods compares group entries of vm and dp.
+// MISSING and DIFFERENT entries are returned in order of vm,
+// and EXTRA entries are followed in order of dp.
+// vm entries are not compared if dp entries are unverified.
+//
+func DiffGroupMods(vms, dps []*fibcapi.GroupMod) []*GroupModDiff {
+	dpMap := make(map[uint32]*fibcapi.GroupMod, len(dps))
+	for _, dp := range dps {
+		dpMap[dp.GroupID()] = dp
+	}
+
+	diffs := []*GroupModDiff{}
+	vmKeys := make(map[uint32]struct{}, len(vms))
+	for _, vm := range vms {
+		gid := vm.GroupID()
+		vmKeys[gid] = struct{}{}
+
+		dp, ok := dpMap[gid]
+		if !ok {
+			diffs = append(diffs, &GroupModDiff{Result: fibcapi.ApAuditModsEntry_MISSING, VM: vm})
+		} else if !IsUnverifiedGroupMod(dp) && !equalGroupMod(vm, dp) {
+			diffs = append(diffs, &GroupModDiff{Result: fibcapi.ApAuditModsEntry_DIFFERENT, VM: vm, DP: dp})
+		}
+	}
+
+	for _, dp := range dps {
+		if _, ok := vmKeys[dp.GroupID()]; !ok {
+			diffs = append(diffs, &GroupModDiff{Result: fibcapi.ApAuditModsEntry_EXTRA, DP: dp})
+		}
+	}
+
+	return diffs
+}
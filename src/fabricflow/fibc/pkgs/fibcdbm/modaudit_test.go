@@ -0,0 +1,97 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"testing"
+)
+
+func TestDiffFlowMods(t *testing.T) {
+	vms := []*fibcapi.FlowMod{
+		newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.1.0/24", 1),
+		newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.2.0/24", 2),
+		newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.3.0/24", 3),
+	}
+	dps := []*fibcapi.FlowMod{
+		newTestRouteFlowMod(fibcapi.FlowMod_MODIFY, "10.0.1.0/24", 1),
+		newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.2.0/24", 20),
+		newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.4.0/24", 4),
+	}
+
+	diffs := DiffFlowMods(vms, dps)
+	if len(diffs) != 3 {
+		t.Fatalf("DiffFlowMods unmatch. %d", len(diffs))
+	}
+
+	if d := diffs[0]; d.Result != fibcapi.ApAuditModsEntry_DIFFERENT || d.VM != vms[1] || d.DP != dps[1] {
+		t.Errorf("DiffFlowMods unmatch. %v", d)
+	}
+
+	if d := diffs[1]; d.Result != fibcapi.ApAuditModsEntry_MISSING || d.VM != vms[2] || d.DP != nil {
+		t.Errorf("DiffFlowMods unmatch. %v", d)
+	}
+
+	if d := diffs[2]; d.Result != fibcapi.ApAuditModsEntry_EXTRA || d.VM != nil || d.DP != dps[2] {
+		t.Errorf("DiffFlowMods unmatch. %v", d)
+	}
+
+	if cmd := dps[0].Cmd; cmd != fibcapi.FlowMod_MODIFY {
+		t.Errorf("DiffFlowMods must not change entry. %s", cmd)
+	}
+
+	// unverified entry of dp is not compared.
+	dps[1].Cmd = fibcapi.FlowMod_NOP
+	if diffs := DiffFlowMods(vms, dps); len(diffs) != 2 || diffs[0].Result != fibcapi.ApAuditModsEntry_MISSING {
+		t.Errorf("DiffFlowMods unmatch. %v", diffs)
+	}
+}
+
+func TestDiffGroupMods(t *testing.T) {
+	vms := []*fibcapi.GroupMod{
+		newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 10, "11:22:33:44:55:66"),
+		newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 20, "11:22:33:44:55:66"),
+	}
+	dps := []*fibcapi.GroupMod{
+		newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 20, "11:22:33:44:55:66"),
+		newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 30, "11:22:33:44:55:66"),
+	}
+
+	diffs := DiffGroupMods(vms, dps)
+	if len(diffs) != 2 {
+		t.Fatalf("DiffGroupMods unmatch. %d", len(diffs))
+	}
+
+	if d := diffs[0]; d.Result != fibcapi.ApAuditModsEntry_MISSING || d.VM != vms[0] {
+		t.Errorf("DiffGroupMods unmatch. %v", d)
+	}
+
+	if d := diffs[1]; d.Result != fibcapi.ApAuditModsEntry_EXTRA || d.DP != dps[1] {
+		t.Errorf("DiffGroupMods unmatch. %v", d)
+	}
+
+	if diffs := DiffGroupMods(vms, vms); len(diffs) != 0 {
+		t.Errorf("DiffGroupMods unmatch. %v", diffs)
+	}
+
+	// unverified entry of dp is not compared.
+	dps[0] = newTestL3UnicastGroupMod(fibcapi.GroupMod_NOP, 20, "66:55:44:33:22:11")
+	if diffs := DiffGroupMods(vms, dps); len(diffs) != 2 {
+		t.Errorf("DiffGroupMods unmatch. %v", diffs)
+	}
+}
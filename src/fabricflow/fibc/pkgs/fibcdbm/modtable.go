@@ -0,0 +1,300 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+//
+// FlowModEntry is flow entry of ModTable.
+//
+type FlowModEntry struct {
+	Mod *fibcapi.FlowMod
+	seq uint64
+}
+
+//
+// GroupModEntry is group entry of ModTable.
+//
+type GroupModEntry struct {
+	Mod *fibcapi.GroupMod
+	seq uint64
+}
+
+//
+// DPModEntry is set of flow/group entries of a datapath.
+//
+type DPModEntry struct {
+	flows  map[string]*FlowModEntry
+	groups map[uint32]*GroupModEntry
+}
+
+//
+// NewDPModEntry returns new DPModEntry.
+//
+func NewDPModEntry() *DPModEntry {
+	return &DPModEntry{
+		flows:  map[string]*FlowModEntry{},
+		groups: map[uint32]*GroupModEntry{},
+	}
+}
+
+//
+// groupModTier returns replay order of group by its dependency.
+// lower groups must be installed before groups which refer them.
+//
+func groupModTier(mod *fibcapi.GroupMod) int {
+	switch mod.GType {
+	case fibcapi.GroupMod_L2_INTERFACE, fibcapi.GroupMod_L2_UF_INTERFACE, fibcapi.GroupMod_VXLAN_TUNNEL:
+		return 0
+	case fibcapi.GroupMod_L2_REWRITE, fibcapi.GroupMod_L3_UNICAST, fibcapi.GroupMod_L3_INTERFACE, fibcapi.GroupMod_MPLS_INTERFACE:
+		return 1
+	case fibcapi.GroupMod_MPLS_TUNNEL1:
+		return 2
+	case fibcapi.GroupMod_MPLS_TUNNEL2:
+		return 3
+	case fibcapi.GroupMod_MPLS_L2_VPN, fibcapi.GroupMod_MPLS_L3_VPN, fibcapi.GroupMod_MPLS_SWAP, fibcapi.GroupMod_SRV6_ENCAP:
+		return 4
+	default:
+		// ECMP, flood, multicast, overlay, ...
+		return 5
+	}
+}
+
+//
+// ModTable is shadow table of flow/group mod installed to datapath.
+//
+type ModTable struct {
+	mutex   sync.RWMutex
+	entries map[uint64]*DPModEntry
+
+	seq uint64
+}
+
+//
+// NewModTable returns new ModTable.
+//
+func NewModTable() *ModTable {
+	return &ModTable{
+		entries: map[uint64]*DPModEntry{},
+	}
+}
+
+func (t *ModTable) find(dpID uint64) *DPModEntry {
+	if e, ok := t.entries[dpID]; ok {
+		return e
+	}
+
+	return nil
+}
+
+func (t *ModTable) findOrNew(dpID uint64) *DPModEntry {
+	e := t.find(dpID)
+	if e == nil {
+		e = NewDPModEntry()
+		t.entries[dpID] = e
+	}
+
+	return e
+}
+
+func (t *ModTable) nextSeq() uint64 {
+	t.seq++
+	return t.seq
+}
+
+//
+// UpdateFlowMod adds, replaces or removes flow entry by cmd of mod.
+//
+func (t *ModTable) UpdateFlowMod(dpID uint64, mod *fibcapi.FlowMod) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	e := t.findOrNew(dpID)
+	key := mod.MatchKey()
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+		m := proto.Clone(mod).(*fibcapi.FlowMod)
+		m.Cmd = fibcapi.FlowMod_ADD
+
+		// modified entry is re-appended, because it may refer
+		// to groups added after the original entry.
+		e.flows[key] = &FlowModEntry{Mod: m, seq: t.nextSeq()}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		delete(e.flows, key)
+	}
+}
+
+//
+// UpdateGroupMod adds, replaces or removes group entry by cmd of mod.
+//
+func (t *ModTable) UpdateGroupMod(dpID uint64, mod *fibcapi.GroupMod) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	e := t.findOrNew(dpID)
+	gid := mod.GroupID()
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		m := proto.Clone(mod).(*fibcapi.GroupMod)
+		m.Cmd = fibcapi.GroupMod_ADD
+
+		// modified entry is re-appended, because it may refer
+		// to groups added after the original entry.
+		e.groups[gid] = &GroupModEntry{Mod: m, seq: t.nextSeq()}
+
+	case fibcapi.GroupMod_DELETE:
+		delete(e.groups, gid)
+	}
+}
+
+//
+// ListFlowMods returns copy of flow mods in order of last registration.
+//
+func (t *ModTable) ListFlowMods(dpID uint64) []*fibcapi.FlowMod {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	e := t.find(dpID)
+	if e == nil {
+		return []*fibcapi.FlowMod{}
+	}
+
+	entries := make([]*FlowModEntry, 0, len(e.flows))
+	for _, flow := range e.flows {
+		entries = append(entries, flow)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+
+	mods := make([]*fibcapi.FlowMod, len(entries))
+	for index, flow := range entries {
+		mods[index] = proto.Clone(flow.Mod).(*fibcapi.FlowMod)
+	}
+
+	return mods
+}
+
+//
+// ListGroupMods returns copy of group mods in order of dependency tier
+// (L2 interface, L3 unicast/MPLS interface, MPLS label, ECMP and others),
+// and in order of last registration in each tier.
+//
+func (t *ModTable) ListGroupMods(dpID uint64) []*fibcapi.GroupMod {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	e := t.find(dpID)
+	if e == nil {
+		return []*fibcapi.GroupMod{}
+	}
+
+	entries := make([]*GroupModEntry, 0, len(e.groups))
+	for _, group := range e.groups {
+		entries = append(entries, group)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		ti, tj := groupModTier(entries[i].Mod), groupModTier(entries[j].Mod)
+		if ti != tj {
+			return ti < tj
+		}
+		return entries[i].seq < entries[j].seq
+	})
+
+	mods := make([]*fibcapi.GroupMod, len(entries))
+	for index, group := range entries {
+		mods[index] = proto.Clone(group.Mod).(*fibcapi.GroupMod)
+	}
+
+	return mods
+}
+
+//
+// Count returns number of flow and group entries.
+//
+func (t *ModTable) Count(dpID uint64) (int, int) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if e := t.find(dpID); e != nil {
+		return len(e.flows), len(e.groups)
+	}
+
+	return 0, 0
+}
+
+//
+// DpIDs returns ids of datapath which has entries.
+//
+func (t *ModTable) DpIDs() []uint64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	dpIDs := make([]uint64, 0, len(t.entries))
+	for dpID := range t.entries {
+		dpIDs = append(dpIDs, dpID)
+	}
+	sort.Slice(dpIDs, func(i, j int) bool { return dpIDs[i] < dpIDs[j] })
+
+	return dpIDs
+}
+
+//
+// Reset replaces all entries of datapath by mods in order of them.
+// (used by standby to apply mods of active)
+//
+func (t *ModTable) Reset(dpID uint64, groups []*fibcapi.GroupMod, flows []*fibcapi.FlowMod) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	e := NewDPModEntry()
+	for _, mod := range groups {
+		m := proto.Clone(mod).(*fibcapi.GroupMod)
+		m.Cmd = fibcapi.GroupMod_ADD
+		e.groups[m.GroupID()] = &GroupModEntry{Mod: m, seq: t.nextSeq()}
+	}
+
+	for _, mod := range flows {
+		m := proto.Clone(mod).(*fibcapi.FlowMod)
+		m.Cmd = fibcapi.FlowMod_ADD
+		e.flows[m.MatchKey()] = &FlowModEntry{Mod: m, seq: t.nextSeq()}
+	}
+
+	t.entries[dpID] = e
+}
+
+//
+// Clear removes all entries of datapath.
+//
+func (t *ModTable) Clear(dpID uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.entries, dpID)
+}
@@ -0,0 +1,246 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcdbm
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"net"
+	"testing"
+)
+
+func newTestRouteFlowMod(cmd fibcapi.FlowMod_Cmd, dst string, gid uint32) *fibcapi.FlowMod {
+	_, ipnet, _ := net.ParseCIDR(dst)
+	match := fibcapi.NewUnicastRoutingMatchRoute(ipnet, 0)
+	flow := fibcapi.NewUnicastRoutingFlow(match, nil, fibcapi.GroupMod_L3_UNICAST, gid)
+	return flow.ToMod(cmd, "1.1.1.1")
+}
+
+func newTestL3UnicastGroupMod(cmd fibcapi.GroupMod_Cmd, neID uint32, ethDst string) *fibcapi.GroupMod {
+	hwaddr, _ := net.ParseMAC(ethDst)
+	g := fibcapi.NewL3UnicastGroup(neID, 1, 1, 0, hwaddr, hwaddr)
+	return g.ToMod(cmd, "1.1.1.1")
+}
+
+func TestModTableFlowMod(t *testing.T) {
+	m := NewModTable()
+
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.1.0/24", 1))
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.2.0/24", 2))
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_MODIFY, "10.0.1.0/24", 3))
+
+	if flows, groups := m.Count(1); flows != 2 || groups != 0 {
+		t.Errorf("Count unmatch. flows=%d groups=%d", flows, groups)
+	}
+
+	mods := m.ListFlowMods(1)
+	if len(mods) != 2 {
+		t.Fatalf("ListFlowMods unmatch. %d", len(mods))
+	}
+
+	// modified entry is moved to the last.
+	if ipdst := mods[1].GetUnicast().Match.IpDst; ipdst != "10.0.1.0/24" {
+		t.Errorf("ListFlowMods order unmatch. %s", ipdst)
+	}
+
+	if gid := mods[1].GetUnicast().GId; gid != 3 {
+		t.Errorf("ListFlowMods modify unmatch. gid=%d", gid)
+	}
+
+	if cmd := mods[1].Cmd; cmd != fibcapi.FlowMod_ADD {
+		t.Errorf("ListFlowMods cmd unmatch. %s", cmd)
+	}
+
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_DELETE, "10.0.1.0/24", 0))
+
+	if flows, _ := m.Count(1); flows != 1 {
+		t.Errorf("Count unmatch. flows=%d", flows)
+	}
+
+	if flows, _ := m.Count(2); flows != 0 {
+		t.Errorf("Count unmatch. flows=%d", flows)
+	}
+}
+
+func TestModTableGroupMod(t *testing.T) {
+	m := NewModTable()
+
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 10, "11:22:33:44:55:66"))
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 20, "11:22:33:44:55:66"))
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_MODIFY, 10, "66:55:44:33:22:11"))
+
+	mods := m.ListGroupMods(1)
+	if len(mods) != 2 {
+		t.Fatalf("ListGroupMods unmatch. %d", len(mods))
+	}
+
+	// modified entry is moved to the last.
+	if neID := mods[1].GetL3Unicast().NeId; neID != 10 {
+		t.Errorf("ListGroupMods order unmatch. neid=%d", neID)
+	}
+
+	if ethDst := mods[1].GetL3Unicast().EthDst; ethDst != "66:55:44:33:22:11" {
+		t.Errorf("ListGroupMods modify unmatch. %s", ethDst)
+	}
+
+	// returned mods must be copies.
+	mods[0].GetL3Unicast().PortId = 100
+	if portID := m.ListGroupMods(1)[0].GetL3Unicast().PortId; portID != 1 {
+		t.Errorf("ListGroupMods copy unmatch. port=%d", portID)
+	}
+
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_DELETE, 10, ""))
+
+	if _, groups := m.Count(1); groups != 1 {
+		t.Errorf("Count unmatch. groups=%d", groups)
+	}
+
+	m.Clear(1)
+
+	if flows, groups := m.Count(1); flows != 0 || groups != 0 {
+		t.Errorf("Clear unmatch. flows=%d groups=%d", flows, groups)
+	}
+}
+
+func TestModTableReplayOrder(t *testing.T) {
+	m := NewModTable()
+
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 10, "11:22:33:44:55:66"))
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.1.0/24", 10))
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.2.0/24", 10))
+
+	// new group is added, then existing flow is modified to refer it.
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 20, "66:55:44:33:22:11"))
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_MODIFY, "10.0.1.0/24", 20))
+
+	mods := m.ListFlowMods(1)
+	if len(mods) != 2 {
+		t.Fatalf("ListFlowMods unmatch. %d", len(mods))
+	}
+	if ipdst := mods[0].GetUnicast().Match.IpDst; ipdst != "10.0.2.0/24" {
+		t.Errorf("ListFlowMods order unmatch. %s", ipdst)
+	}
+	if ipdst := mods[1].GetUnicast().Match.IpDst; ipdst != "10.0.1.0/24" {
+		t.Errorf("ListFlowMods order unmatch. %s", ipdst)
+	}
+
+	// modified flow must be registered after the group it refers.
+	var flowSeq, groupSeq uint64
+	e := m.find(1)
+	for _, flow := range e.flows {
+		if flow.Mod.GetUnicast().GId == 20 {
+			flowSeq = flow.seq
+		}
+	}
+	for _, group := range e.groups {
+		if group.Mod.GetL3Unicast().NeId == 20 {
+			groupSeq = group.seq
+		}
+	}
+	if flowSeq <= groupSeq {
+		t.Errorf("replay order unmatch. flow seq=%d group seq=%d", flowSeq, groupSeq)
+	}
+}
+
+func TestModTableReplayTier(t *testing.T) {
+	m := NewModTable()
+
+	hwaddr, _ := net.ParseMAC("11:22:33:44:55:66")
+	l2iface := func(cmd fibcapi.GroupMod_Cmd, mtu int) *fibcapi.GroupMod {
+		return fibcapi.NewL2InterfaceGroup(1, 10, false, hwaddr, mtu, 0, 0).ToMod(cmd, "1.1.1.1")
+	}
+	label := func(gType fibcapi.GroupMod_GType, dstID uint32) *fibcapi.GroupMod {
+		return fibcapi.NewMPLSLabelGroup(gType, dstID, 100, 10, 0).ToMod(fibcapi.GroupMod_ADD, "1.1.1.1")
+	}
+
+	m.UpdateGroupMod(1, l2iface(fibcapi.GroupMod_ADD, 1500))
+	m.UpdateGroupMod(1, newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 10, "11:22:33:44:55:66"))
+	m.UpdateGroupMod(1, fibcapi.NewL3EcmpGroup(1, []uint32{10}).ToMod(fibcapi.GroupMod_ADD, "1.1.1.1"))
+	m.UpdateGroupMod(1, label(fibcapi.GroupMod_MPLS_L3_VPN, 3))
+	m.UpdateGroupMod(1, label(fibcapi.GroupMod_MPLS_TUNNEL2, 2))
+	m.UpdateGroupMod(1, label(fibcapi.GroupMod_MPLS_TUNNEL1, 1))
+	m.UpdateGroupMod(1, fibcapi.NewMPLSInterfaceGroup(10, 1, 10, hwaddr, hwaddr).ToMod(fibcapi.GroupMod_ADD, "1.1.1.1"))
+
+	// lower group is modified after upper groups.
+	m.UpdateGroupMod(1, l2iface(fibcapi.GroupMod_MODIFY, 9000))
+
+	gTypes := []fibcapi.GroupMod_GType{
+		fibcapi.GroupMod_L2_INTERFACE,
+		fibcapi.GroupMod_L3_UNICAST,
+		fibcapi.GroupMod_MPLS_INTERFACE,
+		fibcapi.GroupMod_MPLS_TUNNEL1,
+		fibcapi.GroupMod_MPLS_TUNNEL2,
+		fibcapi.GroupMod_MPLS_L3_VPN,
+		fibcapi.GroupMod_L3_ECMP,
+	}
+
+	mods := m.ListGroupMods(1)
+	if len(mods) != len(gTypes) {
+		t.Fatalf("ListGroupMods unmatch. %d", len(mods))
+	}
+
+	for index, gType := range gTypes {
+		if mods[index].GType != gType {
+			t.Errorf("ListGroupMods order unmatch. #%d %s", index, mods[index].GType)
+		}
+	}
+
+	if mtu := mods[0].GetL2Iface().Mtu; mtu != 9000 {
+		t.Errorf("ListGroupMods modify unmatch. mtu=%d", mtu)
+	}
+}
+
+func TestModTableReset(t *testing.T) {
+	m := NewModTable()
+
+	m.UpdateFlowMod(1, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.9.0/24", 9))
+	m.UpdateFlowMod(2, newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.9.0/24", 9))
+
+	m.Reset(1,
+		[]*fibcapi.GroupMod{
+			newTestL3UnicastGroupMod(fibcapi.GroupMod_MODIFY, 2, "00:11:22:33:44:02"),
+			newTestL3UnicastGroupMod(fibcapi.GroupMod_ADD, 1, "00:11:22:33:44:01"),
+		},
+		[]*fibcapi.FlowMod{
+			newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.2.0/24", 2),
+			newTestRouteFlowMod(fibcapi.FlowMod_ADD, "10.0.1.0/24", 1),
+		},
+	)
+
+	if dpIDs := m.DpIDs(); len(dpIDs) != 2 || dpIDs[0] != 1 || dpIDs[1] != 2 {
+		t.Errorf("DpIDs unmatch. %v", dpIDs)
+	}
+
+	// order of mods is kept.
+	groups := m.ListGroupMods(1)
+	if len(groups) != 2 || groups[0].GetL3Unicast().NeId != 2 || groups[1].GetL3Unicast().NeId != 1 {
+		t.Errorf("ListGroupMods unmatch. %v", groups)
+	}
+
+	if groups[0].Cmd != fibcapi.GroupMod_ADD {
+		t.Errorf("ListGroupMods unmatch. %v", groups[0])
+	}
+
+	flows := m.ListFlowMods(1)
+	if len(flows) != 2 || flows[0].GetUnicast().GId != 2 || flows[1].GetUnicast().GId != 1 {
+		t.Errorf("ListFlowMods unmatch. %v", flows)
+	}
+
+	if flows, groups := m.Count(2); flows != 1 || groups != 0 {
+		t.Errorf("Count unmatch. flows=%d groups=%d", flows, groups)
+	}
+}
@@ -722,6 +722,80 @@ func (m *PortMap) GC(f func(*PortEntry) bool) {
 	}
 }
 
+//
+// Reset replaces all entries. (used by standby to apply entries of active)
+//
+func (m *PortMap) Reset(entries []*PortEntry) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.entries = map[string]*PortEntry{}
+	m.vmKey = NewPortMapVMKey()
+	m.dpKey = NewPortMapDPKey()
+	m.vsKey = NewPortMapDPKey()
+
+	for _, e := range entries {
+		e.version = m.version
+		m.add(e)
+		m.dpKey.addPort(e.DPPort, e.Key)
+		m.vsKey.addPort(e.VSPort, e.Key)
+		if e.VMPort.IsValid() && len(e.VMPort.ReID) != 0 {
+			m.vmKey.addPort(e.VMPort, e.Key)
+		}
+	}
+}
+
+func (m *PortMap) addKeys(e *PortEntry) {
+	m.dpKey.addPort(e.DPPort, e.Key)
+	m.vsKey.addPort(e.VSPort, e.Key)
+	if e.VMPort.IsValid() && len(e.VMPort.ReID) != 0 {
+		m.vmKey.addPort(e.VMPort, e.Key)
+	}
+}
+
+func (m *PortMap) deleteKeys(e *PortEntry) {
+	m.dpKey.deletePort(e.DPPort)
+	m.vsKey.deletePort(e.VSPort)
+	if e.VMPort.IsValid() {
+		m.vmKey.deletePort(e.VMPort)
+	}
+}
+
+//
+// Merge updates entries and deletes entries of deleted keys.
+// entries not in both are kept. (used by standby to apply entries of active)
+// existing entries are updated in place.
+//
+func (m *PortMap) Merge(entries []*PortEntry, deleted []*PortKey) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, key := range deleted {
+		if e := m.find(key); e != nil {
+			m.delete(key)
+			m.deleteKeys(e)
+		}
+	}
+
+	for _, e := range entries {
+		if old := m.find(e.Key); old != nil {
+			m.deleteKeys(old)
+
+			old.ParentKey = e.ParentKey
+			old.MasterKey = e.MasterKey
+			old.VMPort = e.VMPort
+			old.DPPort = e.DPPort
+			old.VSPort = e.VSPort
+			e = old
+		} else {
+			m.add(e)
+		}
+
+		e.version = m.version
+		m.addKeys(e)
+	}
+}
+
 //
 // Range enumrate all entries.
 //
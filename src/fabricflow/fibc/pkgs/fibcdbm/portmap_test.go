@@ -155,3 +155,100 @@ func TestPortMapListByParentMulti(t *testing.T) {
 		t.Errorf("ListByParent unmath. cnt=%d", cnt)
 	}
 }
+
+func TestPortMapReset(t *testing.T) {
+	m := NewPortMap()
+
+	m.Register(&PortEntry{
+		Key:    NewPortKey("1.1.1.1", "eth1"),
+		DPPort: NewPortValue(10, 1, true),
+	})
+
+	m.Reset([]*PortEntry{
+		{
+			Key:    NewPortKey("2.2.2.2", "eth1"),
+			VMPort: NewPortValueR("2.2.2.2", 1, true),
+			DPPort: NewPortValue(20, 1, true),
+			VSPort: NewPortValue(30, 1, true),
+		},
+	})
+
+	if ok := m.Select(NewPortKey("1.1.1.1", "eth1"), func(*PortEntry) {}); ok {
+		t.Errorf("Reset old entry exists.")
+	}
+
+	if ok := m.SelectByDP(10, 1, func(*PortEntry) {}); ok {
+		t.Errorf("Reset old dp key exists.")
+	}
+
+	if ok := m.SelectByDP(20, 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Reset dp key not found.")
+	}
+
+	if ok := m.SelectByVM("2.2.2.2", 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Reset vm key not found.")
+	}
+
+	if ok := m.SelectByVS(30, 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Reset vs key not found.")
+	}
+}
+
+func TestPortMapMerge(t *testing.T) {
+	m := NewPortMap()
+
+	m.Register(&PortEntry{
+		Key:    NewPortKey("1.1.1.1", "eth1"),
+		DPPort: NewPortValue(10, 1, true),
+	})
+	m.Register(&PortEntry{
+		Key:    NewPortKey("2.2.2.2", "eth2"),
+		DPPort: NewPortValue(20, 2, true),
+	})
+
+	m.Merge([]*PortEntry{
+		{
+			Key:    NewPortKey("2.2.2.2", "eth1"),
+			VMPort: NewPortValueR("2.2.2.2", 1, true),
+			DPPort: NewPortValue(20, 1, true),
+			VSPort: NewPortValue(30, 1, true),
+		},
+		{
+			Key:    NewPortKey("1.1.1.1", "eth1"),
+			DPPort: NewPortValue(10, 11, false),
+		},
+	}, []*PortKey{NewPortKey("2.2.2.2", "eth2")})
+
+	if ok := m.Select(NewPortKey("2.2.2.2", "eth2"), func(*PortEntry) {}); ok {
+		t.Errorf("Merge deleted entry exists.")
+	}
+
+	if ok := m.SelectByDP(20, 2, func(*PortEntry) {}); ok {
+		t.Errorf("Merge deleted dp key exists.")
+	}
+
+	// existing entry is updated.
+	if ok := m.SelectByDP(10, 1, func(*PortEntry) {}); ok {
+		t.Errorf("Merge old dp key exists.")
+	}
+
+	if ok := m.SelectByDP(10, 11, func(e *PortEntry) {
+		if e.DPPort.Enter {
+			t.Errorf("Merge unmatch. %v", e.DPPort)
+		}
+	}); !ok {
+		t.Errorf("Merge dp key not found.")
+	}
+
+	if ok := m.SelectByDP(20, 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Merge dp key not found.")
+	}
+
+	if ok := m.SelectByVM("2.2.2.2", 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Merge vm key not found.")
+	}
+
+	if ok := m.SelectByVS(30, 1, func(*PortEntry) {}); !ok {
+		t.Errorf("Merge vs key not found.")
+	}
+}
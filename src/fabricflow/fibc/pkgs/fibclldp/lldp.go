@@ -0,0 +1,229 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibclldp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	fibcapi "fabricflow/fibc/api"
+)
+
+//
+// LLDP TLV types (IEEE 802.1AB)
+//
+const (
+	TLV_END         = 0
+	TLV_CHASSIS_ID  = 1
+	TLV_PORT_ID     = 2
+	TLV_TTL         = 3
+	TLV_PORT_DESC   = 4
+	TLV_SYSTEM_NAME = 5
+)
+
+//
+// Chassis ID subtypes
+//
+const (
+	CHASSIS_ID_MAC     = 4
+	CHASSIS_ID_NETADDR = 5
+	CHASSIS_ID_LOCAL   = 7
+)
+
+//
+// Port ID subtypes
+//
+const (
+	PORT_ID_MAC     = 3
+	PORT_ID_NETADDR = 4
+	PORT_ID_IFNAME  = 5
+	PORT_ID_LOCAL   = 7
+)
+
+const (
+	ethHeaderLen = 14
+	tlvHeaderLen = 2
+	tlvMaxLen    = 0x01ff
+)
+
+//
+// Frame is LLDP data unit.
+//
+type Frame struct {
+	SrcMAC     net.HardwareAddr
+	ChassisID  string
+	PortID     string
+	TTL        uint16
+	PortDesc   string
+	SystemName string
+}
+
+//
+// String returns string.
+//
+func (f *Frame) String() string {
+	return fmt.Sprintf("chassis='%s' port='%s' ttl=%d desc='%s' sysname='%s'",
+		f.ChassisID, f.PortID, f.TTL, f.PortDesc, f.SystemName)
+}
+
+//
+// NewSrcMAC returns locally administered source mac address
+// for the frames sent from dpID/portID.
+//
+func NewSrcMAC(dpID uint64, portID uint32) net.HardwareAddr {
+	return net.HardwareAddr{
+		0x02,
+		byte(dpID >> 16), byte(dpID >> 8), byte(dpID),
+		byte(portID >> 8), byte(portID),
+	}
+}
+
+//
+// IsLLDP returns true if data is LLDP ethernet frame.
+//
+func IsLLDP(data []byte) bool {
+	if len(data) < ethHeaderLen {
+		return false
+	}
+	return binary.BigEndian.Uint16(data[12:14]) == fibcapi.ETHTYPE_LLDP
+}
+
+func putTLV(b []byte, typ uint8, value []byte) []byte {
+	if len(value) > tlvMaxLen {
+		value = value[:tlvMaxLen]
+	}
+
+	hdr := uint16(typ)<<9 | uint16(len(value))
+	b = append(b, byte(hdr>>8), byte(hdr))
+	return append(b, value...)
+}
+
+func putStringTLV(b []byte, typ uint8, subtype uint8, s string) []byte {
+	value := make([]byte, 0, len(s)+1)
+	value = append(value, subtype)
+	value = append(value, s...)
+	return putTLV(b, typ, value)
+}
+
+//
+// Encode returns LLDP ethernet frame.
+// ChassisID is sent as locally assigned, PortID as interface name.
+//
+func (f *Frame) Encode() []byte {
+	b := make([]byte, 0, 128)
+	b = append(b, fibcapi.HardwareAddrLLDP...)
+	if len(f.SrcMAC) == 6 {
+		b = append(b, f.SrcMAC...)
+	} else {
+		b = append(b, 0, 0, 0, 0, 0, 0)
+	}
+	b = append(b, byte(fibcapi.ETHTYPE_LLDP>>8), byte(fibcapi.ETHTYPE_LLDP&0xff))
+
+	b = putStringTLV(b, TLV_CHASSIS_ID, CHASSIS_ID_LOCAL, f.ChassisID)
+	b = putStringTLV(b, TLV_PORT_ID, PORT_ID_IFNAME, f.PortID)
+	b = putTLV(b, TLV_TTL, []byte{byte(f.TTL >> 8), byte(f.TTL)})
+	if len(f.PortDesc) > 0 {
+		b = putTLV(b, TLV_PORT_DESC, []byte(f.PortDesc))
+	}
+	if len(f.SystemName) > 0 {
+		b = putTLV(b, TLV_SYSTEM_NAME, []byte(f.SystemName))
+	}
+	return putTLV(b, TLV_END, nil)
+}
+
+func decodeID(value []byte, macType, addrType uint8) string {
+	if len(value) < 2 {
+		return ""
+	}
+
+	subtype, id := value[0], value[1:]
+	switch subtype {
+	case macType:
+		if len(id) == 6 {
+			return net.HardwareAddr(id).String()
+		}
+	case addrType:
+		// id[0] is IANA address family.
+		if len(id) == net.IPv4len+1 || len(id) == net.IPv6len+1 {
+			return net.IP(id[1:]).String()
+		}
+	}
+
+	return strings.TrimRight(string(id), "\x00")
+}
+
+//
+// Decode parses LLDP ethernet frame.
+//
+func Decode(data []byte) (*Frame, error) {
+	if !IsLLDP(data) {
+		return nil, fmt.Errorf("Not LLDP frame.")
+	}
+
+	f := &Frame{
+		SrcMAC: net.HardwareAddr(append([]byte{}, data[6:12]...)),
+	}
+
+	seen := map[uint8]bool{}
+	b := data[ethHeaderLen:]
+	for len(b) >= tlvHeaderLen {
+		hdr := binary.BigEndian.Uint16(b)
+		typ, length := uint8(hdr>>9), int(hdr&tlvMaxLen)
+		if len(b) < tlvHeaderLen+length {
+			return nil, fmt.Errorf("Invalid TLV length. type=%d len=%d", typ, length)
+		}
+
+		value := b[tlvHeaderLen : tlvHeaderLen+length]
+		b = b[tlvHeaderLen+length:]
+
+		switch typ {
+		case TLV_END:
+			b = nil
+
+		case TLV_CHASSIS_ID:
+			f.ChassisID = decodeID(value, CHASSIS_ID_MAC, CHASSIS_ID_NETADDR)
+
+		case TLV_PORT_ID:
+			f.PortID = decodeID(value, PORT_ID_MAC, PORT_ID_NETADDR)
+
+		case TLV_TTL:
+			if length < 2 {
+				return nil, fmt.Errorf("Invalid TTL TLV. len=%d", length)
+			}
+			f.TTL = binary.BigEndian.Uint16(value)
+
+		case TLV_PORT_DESC:
+			f.PortDesc = string(value)
+
+		case TLV_SYSTEM_NAME:
+			f.SystemName = string(value)
+		}
+
+		seen[typ] = true
+	}
+
+	for _, typ := range []uint8{TLV_CHASSIS_ID, TLV_PORT_ID, TLV_TTL} {
+		if !seen[typ] {
+			return nil, fmt.Errorf("Mandatory TLV not found. type=%d", typ)
+		}
+	}
+
+	return f, nil
+}
@@ -0,0 +1,100 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibclldp
+
+import (
+	"testing"
+)
+
+func TestFrameEncodeDecode(t *testing.T) {
+	src := &Frame{
+		SrcMAC:     NewSrcMAC(0x123456, 3),
+		ChassisID:  "10.0.1.6",
+		PortID:     "eth3",
+		TTL:        120,
+		PortDesc:   "dp:1193046 port:3",
+		SystemName: "fibcd-1",
+	}
+
+	data := src.Encode()
+	if !IsLLDP(data) {
+		t.Errorf("IsLLDP unmatch. %v", data)
+	}
+
+	dst, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode error. %s", err)
+	}
+
+	if s := dst.SrcMAC.String(); s != "02:12:34:56:00:03" {
+		t.Errorf("Decode unmatch. src mac=%s", s)
+	}
+	if dst.ChassisID != src.ChassisID || dst.PortID != src.PortID || dst.TTL != src.TTL {
+		t.Errorf("Decode unmatch. %s", dst)
+	}
+	if dst.PortDesc != src.PortDesc || dst.SystemName != src.SystemName {
+		t.Errorf("Decode unmatch. %s", dst)
+	}
+}
+
+func TestDecodeMACSubtype(t *testing.T) {
+	data := []byte{
+		0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e, // dst
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, // src
+		0x88, 0xcc,
+		0x02, 0x07, 0x04, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55, // chassis (mac)
+		0x04, 0x07, 0x03, 0x00, 0x11, 0x22, 0x33, 0x44, 0x56, // port (mac)
+		0x06, 0x02, 0x00, 0x78, // ttl
+		0x00, 0x00, // end
+	}
+
+	f, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode error. %s", err)
+	}
+	if f.ChassisID != "00:11:22:33:44:55" || f.PortID != "00:11:22:33:44:56" || f.TTL != 120 {
+		t.Errorf("Decode unmatch. %s", f)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	if _, err := Decode([]byte{0x01, 0x02}); err == nil {
+		t.Errorf("Decode must be error (short).")
+	}
+
+	f := &Frame{ChassisID: "c", PortID: "p", TTL: 1}
+	data := f.Encode()
+
+	// truncated tlv
+	if _, err := Decode(data[:len(data)-3]); err == nil {
+		t.Errorf("Decode must be error (truncated).")
+	}
+
+	// no ttl tlv
+	data = []byte{
+		0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e,
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55,
+		0x88, 0xcc,
+		0x02, 0x02, 0x07, 'c',
+		0x04, 0x02, 0x05, 'p',
+		0x00, 0x00,
+	}
+	if _, err := Decode(data); err == nil {
+		t.Errorf("Decode must be error (no ttl).")
+	}
+}
@@ -148,3 +148,17 @@ func (s *APAPIServer) RunOAM(ctxt context.Context, req *fibcapi.OAM_Request) (*f
 	}
 	return &fibcapi.OAMReplyAck{}, nil
 }
+
+//
+// AuditMods process audit mods request.
+//
+func (s *APAPIServer) AuditMods(req *fibcapi.ApAuditModsRequest, stream fibcapi.FIBCApApi_AuditModsServer) error {
+	return s.ctl.AuditMods(req.ReId, req.Repair, stream)
+}
+
+//
+// GetLldpNeighbors process get lldp neighbors request.
+//
+func (s *APAPIServer) GetLldpNeighbors(req *fibcapi.ApGetLldpNeighborsRequest, stream fibcapi.FIBCApApi_GetLldpNeighborsServer) error {
+	return s.ctl.GetLLDPNeighbors(req.DpId, stream)
+}
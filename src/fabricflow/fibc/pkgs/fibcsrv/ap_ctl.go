@@ -23,12 +23,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
 	apAPIPortStatWaitMax = 3 * time.Second
 	apAPIOAMWaitMax      = 30 * time.Second
+	apAPIAuditWaitMax    = 10 * time.Second
 )
 
 var apCtlPortStatsNames = []string{
@@ -48,9 +50,10 @@ var apCtlPortStatsNames = []string{
 // APCtl is ap api controller
 //
 type APCtl struct {
-	db         *DBCtl
-	psTimeout  time.Duration
-	oamTimeout time.Duration
+	db           *DBCtl
+	psTimeout    time.Duration
+	oamTimeout   time.Duration
+	auditTimeout time.Duration
 
 	stats *fibcdbm.StatsGroup
 	log   *log.Entry
@@ -61,9 +64,10 @@ type APCtl struct {
 //
 func NewAPCtl(db *DBCtl) *APCtl {
 	return &APCtl{
-		db:         db,
-		psTimeout:  apAPIPortStatWaitMax,
-		oamTimeout: apAPIOAMWaitMax,
+		db:           db,
+		psTimeout:    apAPIPortStatWaitMax,
+		oamTimeout:   apAPIOAMWaitMax,
+		auditTimeout: apAPIAuditWaitMax,
 
 		stats: NewAPStats(db),
 		log:   log.WithFields(log.Fields{"module": "apctl"}),
@@ -140,87 +144,17 @@ func (c *APCtl) GetIDEntries(stream fibcapi.FIBCApApi_GetIDEntriesServer) error
 	return nil
 }
 
-func (c *APCtl) getApMonitorEntries(stream fibcapi.FIBCApApi_GetDpEntriesServer) {
-	c.db.APSet().Range(func(e fibcdbm.DPEntry) {
-		entry := e.(*APAPIMonitorEntry)
-		msg := fibcapi.DbDpEntry{
-			Type:   fibcapi.DbDpEntry_APMON,
-			Id:     entry.EntryID(),
-			Remote: entry.Remote(),
-		}
-
-		if err := stream.Send(&msg); err != nil {
-			c.log.Errorf("GetDpEntries: send ap entry error. %s", err)
-		}
-	})
-}
-
-func (c *APCtl) getVMMonitorEntries(stream fibcapi.FIBCApApi_GetDpEntriesServer) {
-	c.db.VMSet().Range(func(e fibcdbm.DPEntry) {
-		entry := e.(*VMAPIMonitorEntry)
-		msg := fibcapi.DbDpEntry{
-			Type:   fibcapi.DbDpEntry_VMMON,
-			Id:     entry.reID,
-			Remote: entry.Remote(),
-		}
-
-		if err := stream.Send(&msg); err != nil {
-			c.log.Errorf("GetDpEntries: send vm entry error. %s", err)
-		}
-	})
-}
-
-func (c *APCtl) getDpMonitorEntries(stream fibcapi.FIBCApApi_GetDpEntriesServer) {
-	c.db.DPSet().Range(func(e fibcdbm.DPEntry) {
-		entry := e.(*DPAPIMonitorEntry)
-		msg := fibcapi.DbDpEntry{
-			Type:   fibcapi.DbDpEntry_DPMON,
-			Id:     fmt.Sprintf("%d", entry.dpID),
-			Remote: entry.Remote(),
-		}
-
-		if err := stream.Send(&msg); err != nil {
-			c.log.Errorf("GetDpEntries: send dp entry error. %s", err)
-		}
-	})
-}
-
-func (c *APCtl) getVsMonitorEntries(stream fibcapi.FIBCApApi_GetDpEntriesServer) {
-	c.db.VSSet().Range(func(e fibcdbm.DPEntry) {
-		entry := e.(*VSAPIMonitorEntry)
-		msg := fibcapi.DbDpEntry{
-			Type:   fibcapi.DbDpEntry_VSMON,
-			Id:     fmt.Sprintf("%d", entry.vsID),
-			Remote: entry.Remote(),
-		}
-
-		if err := stream.Send(&msg); err != nil {
-			c.log.Errorf("GetDpEntries: send vs entry error. %s", err)
-		}
-	})
-}
-
 //
 // GetDpEntries process get dp entries request.
 //
 func (c *APCtl) GetDpEntries(t fibcapi.DbDpEntry_Type, stream fibcapi.FIBCApApi_GetDpEntriesServer) error {
 	c.stats.Inc(APStatsGetDPSet)
 
-	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_APMON {
-		c.getApMonitorEntries(stream)
-	}
-
-	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_VMMON {
-		c.getVMMonitorEntries(stream)
-	}
-
-	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_DPMON {
-		c.getDpMonitorEntries(stream)
-	}
-
-	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_VSMON {
-		c.getVsMonitorEntries(stream)
-	}
+	c.db.RangeDBDpEntries(t, func(msg *fibcapi.DbDpEntry) {
+		if err := stream.Send(msg); err != nil {
+			c.log.Errorf("GetDpEntries: send %s entry error. %s", msg.Type, err)
+		}
+	})
 
 	return nil
 }
@@ -400,6 +334,54 @@ func (c *APCtl) GetStats(stream fibcapi.FIBCApApi_GetStatsServer) error {
 	return nil
 }
 
+//
+// GetLLDPNeighbors returns neighbors learned by lldp.
+// All datapaths are selected if dpID is 0.
+//
+func (c *APCtl) GetLLDPNeighbors(dpID uint64, stream fibcapi.FIBCApApi_GetLldpNeighborsServer) error {
+	c.stats.Inc(APStatsGetLLDP)
+
+	neighbors := []*fibcapi.LldpNeighbor{}
+	c.db.LLDPNeighbors().Range(func(n *fibcdbm.LLDPNeighbor) {
+		if dpID != 0 && dpID != n.Key.DpID {
+			return
+		}
+
+		msg := &fibcapi.LldpNeighbor{
+			DpId:       n.Key.DpID,
+			PortNo:     n.Key.PortID,
+			ChassisId:  n.ChassisID,
+			PortId:     n.PortID,
+			PortDesc:   n.PortDesc,
+			SystemName: n.SystemName,
+			Ttl:        uint32(n.TTL),
+			LastUpdate: n.LastUpdate.Unix(),
+			Mismatch:   n.Mismatch,
+		}
+		if n.Expect != nil {
+			msg.Expected = n.Expect.String()
+		}
+
+		neighbors = append(neighbors, msg)
+	})
+
+	for _, msg := range neighbors {
+		c.db.PortMap().SelectByDP(msg.DpId, msg.PortNo, func(e *fibcdbm.PortEntry) {
+			msg.ReId = e.Key.ReID
+			msg.Ifname = e.Key.Ifname
+		})
+
+		if err := stream.Send(msg); err != nil {
+			c.stats.Inc(APStatsGetLLDPErr)
+
+			c.log.Errorf("GetLLDPNeighbors: send error. %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *APCtl) RunOAM(req *fibcapi.OAM_Request) error {
 	w := NewOAMWaiter(req)
 	c.db.VMSet().Range(func(e fibcdbm.DPEntry) {
@@ -475,3 +457,237 @@ func (c *APCtl) serveOAMReply(w *OAMWaiter, xid uint32) {
 
 	c.log.Debugf("OAMReply: end. xid:%d", xid)
 }
+
+//
+// AuditMods compares flow/group entries sent by vm with entries in dp.
+// if reID is empty, all datapaths in idmap are audited.
+// if repair is true, unmatched entries in dp are corrected.
+//
+func (c *APCtl) AuditMods(reID string, repair bool, stream fibcapi.FIBCApApi_AuditModsServer) error {
+	c.stats.Inc(APStatsAuditMods)
+
+	c.log.Debugf("AuditMods: re_id:'%s' repair:%t", reID, repair)
+
+	if len(reID) != 0 {
+		dpID, err := c.db.ConvertIDVMtoDP(reID)
+		if err != nil {
+			c.stats.Inc(APStatsAuditModsErr)
+
+			c.log.Errorf("AuditMods: convert error. %s", err)
+			return err
+		}
+
+		if err := c.auditMods(reID, dpID, repair, stream); err != nil {
+			c.stats.Inc(APStatsAuditModsErr)
+
+			c.log.Errorf("AuditMods: audit error. re_id:%s dpid:%d %s", reID, dpID, err)
+			return err
+		}
+
+		return nil
+	}
+
+	entries := []*fibcdbm.IDEntry{}
+	c.db.IDMap().Range(func(e *fibcdbm.IDEntry) {
+		entries = append(entries, e)
+	})
+
+	for _, e := range entries {
+		if err := c.auditMods(e.ReID, e.DpID, repair, stream); err != nil {
+			c.stats.Inc(APStatsAuditModsErr)
+
+			c.log.Warnf("AuditMods: audit error. re_id:%s dpid:%d %s", e.ReID, e.DpID, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *APCtl) auditMods(reID string, dpID uint64, repair bool, stream fibcapi.FIBCApApi_AuditModsServer) error {
+	dpFlows, dpGroups, err := c.getDPMods(dpID)
+	if err != nil {
+		return err
+	}
+
+	vmFlows, vmGroups := c.getVMMods(dpID)
+
+	flowDiffs := fibcdbm.DiffFlowMods(vmFlows, dpFlows)
+	groupDiffs := fibcdbm.DiffGroupMods(vmGroups, dpGroups)
+
+	c.log.Debugf("AuditMods: re_id:%s dpid:%d #flows:%d/%d #groups:%d/%d",
+		reID, dpID, len(flowDiffs), len(vmFlows), len(groupDiffs), len(vmGroups))
+
+	unverifiedFlows, unverifiedGroups := 0, 0
+	for _, mod := range dpFlows {
+		if fibcdbm.IsUnverifiedFlowMod(mod) {
+			unverifiedFlows++
+		}
+	}
+	for _, mod := range dpGroups {
+		if fibcdbm.IsUnverifiedGroupMod(mod) {
+			unverifiedGroups++
+		}
+	}
+	if unverifiedFlows != 0 || unverifiedGroups != 0 {
+		c.log.Infof("AuditMods: re_id:%s dpid:%d unverified #flows:%d #groups:%d",
+			reID, dpID, unverifiedFlows, unverifiedGroups)
+	}
+
+	repaired := map[interface{}]bool{}
+	if repair {
+		// groups must exist before flows refer them,
+		// and must be deleted after flows refer them are deleted.
+		for _, diff := range groupDiffs {
+			if diff.Result != fibcapi.ApAuditModsEntry_EXTRA {
+				repaired[diff] = c.repairGroupMod(dpID, diff)
+			}
+		}
+		for _, diff := range flowDiffs {
+			repaired[diff] = c.repairFlowMod(dpID, diff)
+		}
+		for _, diff := range groupDiffs {
+			if diff.Result == fibcapi.ApAuditModsEntry_EXTRA {
+				repaired[diff] = c.repairGroupMod(dpID, diff)
+			}
+		}
+	}
+
+	for _, diff := range groupDiffs {
+		entry := fibcapi.NewApAuditModsEntry(diff.Result, reID, dpID).SetGroups(diff.VM, diff.DP)
+		entry.Repaired = repaired[diff]
+		if err := stream.Send(entry); err != nil {
+			return err
+		}
+	}
+
+	for _, diff := range flowDiffs {
+		entry := fibcapi.NewApAuditModsEntry(diff.Result, reID, dpID).SetFlows(diff.VM, diff.DP)
+		entry.Repaired = repaired[diff]
+		if err := stream.Send(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *APCtl) getDPMods(dpID uint64) ([]*fibcapi.FlowMod, []*fibcapi.GroupMod, error) {
+	flowReply, err := c.requestDPMultipart(dpID, NewDPMonitorReplyMpFlow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flows := flowReply.GetFlow()
+	if flows == nil {
+		return nil, nil, fmt.Errorf("invalid flow reply from dp. dpid:%d", dpID)
+	}
+
+	groupReply, err := c.requestDPMultipart(dpID, NewDPMonitorReplyMpGroupDesc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := groupReply.GetGroupDesc()
+	if groups == nil {
+		return nil, nil, fmt.Errorf("invalid group desc reply from dp. dpid:%d", dpID)
+	}
+
+	return flows.Flows, groups.Groups, nil
+}
+
+func (c *APCtl) requestDPMultipart(dpID uint64, newMsg func(uint64, uint32) *fibcapi.DpMonitorReply) (*fibcapi.FFMultipart_Reply, error) {
+	w := NewDBMpWaiter()
+	xid := c.db.Waiters().Register(w)
+	defer c.db.Waiters().Unregister(xid)
+
+	if err := c.db.SendDPMonitorReply(dpID, newMsg(dpID, xid)); err != nil {
+		return nil, err
+	}
+
+	if err := w.Wait(c.auditTimeout); err != nil {
+		return nil, err
+	}
+
+	return w.Reply, nil
+}
+
+// getVMMods returns flow/group entries in the form of dp.
+func (c *APCtl) getVMMods(dpID uint64) ([]*fibcapi.FlowMod, []*fibcapi.GroupMod) {
+	flows := []*fibcapi.FlowMod{}
+	for _, mod := range c.db.ModTable().ListFlowMods(dpID) {
+		if err := c.db.ConvertFlowMod(mod); err != nil {
+			c.log.Debugf("AuditMods: ignore flow mod. %s", err)
+			continue
+		}
+		flows = append(flows, mod)
+	}
+
+	groups := []*fibcapi.GroupMod{}
+	for _, mod := range c.db.ModTable().ListGroupMods(dpID) {
+		if err := c.db.ConvertGroupMod(mod); err != nil {
+			c.log.Debugf("AuditMods: ignore group mod. %s", err)
+			continue
+		}
+		groups = append(groups, mod)
+	}
+
+	return flows, groups
+}
+
+func (c *APCtl) repairFlowMod(dpID uint64, diff *fibcdbm.FlowModDiff) bool {
+	mods := []*fibcapi.FlowMod{}
+	if diff.DP != nil {
+		mod := proto.Clone(diff.DP).(*fibcapi.FlowMod)
+		mod.Cmd = fibcapi.FlowMod_DELETE
+		mods = append(mods, mod)
+	}
+	if diff.VM != nil {
+		mod := proto.Clone(diff.VM).(*fibcapi.FlowMod)
+		mod.Cmd = fibcapi.FlowMod_ADD
+		mods = append(mods, mod)
+	}
+
+	for _, mod := range mods {
+		fibcapi.LogFlowMod(c.log, log.DebugLevel, mod)
+
+		if err := c.db.SendDPMonitorReply(dpID, NewDPMonitorReplyFlowMod(mod)); err != nil {
+			c.log.Errorf("AuditMods: send flow mod error. %s", err)
+			return false
+		}
+	}
+
+	c.stats.Inc(APStatsAuditModsRepair)
+	return true
+}
+
+// repairGroupMod corrects group in dp.
+// unmatched group is modified in place not to blackhole flows refer it.
+func (c *APCtl) repairGroupMod(dpID uint64, diff *fibcdbm.GroupModDiff) bool {
+	mods := []*fibcapi.GroupMod{}
+	switch {
+	case diff.VM != nil && diff.DP != nil:
+		mod := proto.Clone(diff.VM).(*fibcapi.GroupMod)
+		mod.Cmd = fibcapi.GroupMod_MODIFY
+		mods = append(mods, mod)
+	case diff.VM != nil:
+		mod := proto.Clone(diff.VM).(*fibcapi.GroupMod)
+		mod.Cmd = fibcapi.GroupMod_ADD
+		mods = append(mods, mod)
+	case diff.DP != nil:
+		mod := proto.Clone(diff.DP).(*fibcapi.GroupMod)
+		mod.Cmd = fibcapi.GroupMod_DELETE
+		mods = append(mods, mod)
+	}
+
+	for _, mod := range mods {
+		fibcapi.LogGroupMod(c.log, log.DebugLevel, mod)
+
+		if err := c.db.SendDPMonitorReply(dpID, NewDPMonitorReplyGroupMod(mod)); err != nil {
+			c.log.Errorf("AuditMods: send group mod error. %s", err)
+			return false
+		}
+	}
+
+	c.stats.Inc(APStatsAuditModsRepair)
+	return true
+}
@@ -0,0 +1,83 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fibcsrv
+
+import (
+	ffgrpc "fabricflow/util/grpc"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	AuthRoleAP = "ap"
+	AuthRoleVM = "vm"
+	AuthRoleVS = "vs"
+	AuthRoleDP = "dp"
+	AuthRoleHA = "ha"
+)
+
+var authRoleServices = map[string]string{
+	"fibcapi.FIBCApApi": AuthRoleAP,
+	"fibcapi.FIBCVmApi": AuthRoleVM,
+	"fibcapi.FIBCVsApi": AuthRoleVS,
+	"fibcapi.FIBCDpApi": AuthRoleDP,
+	"fibcapi.FIBCHaApi": AuthRoleHA,
+}
+
+//
+// AuthRoleOf returns role of grpc method. (/<service>/<method>)
+//
+func AuthRoleOf(method string) string {
+	items := strings.SplitN(strings.TrimPrefix(method, "/"), "/", 2)
+	return authRoleServices[items[0]]
+}
+
+//
+// NewServerAuth returns new ffgrpc.ServerAuth for fibcd.
+//
+func NewServerAuth() *ffgrpc.ServerAuth {
+	return ffgrpc.NewServerAuth(AuthRoleOf)
+}
+
+//
+// ReadAuthTokens reads token file.
+//
+// ap: <token of fibcctl, ffctl, ...>
+// vm: <token of ribcd>
+// vs: <token of govswd>
+// dp: <token of gonsld>
+// ha: <token of standby fibcd>
+//
+func ReadAuthTokens(path, ftype string) (map[string]string, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(ftype)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	tokens := map[string]string{}
+	for _, role := range authRoleServices {
+		if token := v.GetString(role); len(token) != 0 {
+			tokens[role] = token
+		}
+	}
+
+	return tokens, nil
+}
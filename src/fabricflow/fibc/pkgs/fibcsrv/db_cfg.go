@@ -31,6 +31,7 @@ func (c *DBCtl) RegisterConfig(cfg *fibccfg.Config) {
 
 	c.registerIDMap(cfg)
 	c.registerPortMap(cfg)
+	c.registerLLDPExpects(cfg)
 
 	c.PortMap().GC(func(e *fibcdbm.PortEntry) bool {
 		c.log.Debugf("PortMap: GC %s", e.Key)
@@ -89,3 +90,27 @@ func (c *DBCtl) registerPortMap(cfg *fibccfg.Config) {
 		}
 	}
 }
+
+func (c *DBCtl) registerLLDPExpects(cfg *fibccfg.Config) {
+	c.LLDPNeighbors().ClearExpects()
+
+	for _, router := range cfg.Routers {
+		dpID, ok := c.IDMap().SelectByReID(router.ReID)
+		if !ok {
+			continue
+		}
+
+		for _, port := range router.Ports {
+			if !port.HasPeer() {
+				continue
+			}
+
+			expect := &fibcdbm.LLDPExpect{
+				SystemName: port.PeerName,
+				PortID:     port.PeerPort,
+			}
+			c.LLDPNeighbors().SetExpect(dpID, uint32(port.PortID), expect)
+			c.log.Debugf("LLDP: expect dp:%d port:%d %s", dpID, port.PortID, expect)
+		}
+	}
+}
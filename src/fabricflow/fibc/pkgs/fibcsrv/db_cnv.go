@@ -198,6 +198,11 @@ func (c *DBCtl) ConvertTermMacFlow(reID string, flow *fibcapi.TerminationMacFlow
 // ConvertBridgingFlow converts bridge flow.
 //
 func (c *DBCtl) ConvertBridgingFlow(reID string, flow *fibcapi.BridgingFlow) error {
+	if flow.GetMatch().TunnelId != 0 {
+		// overlay entry outputs to VTEP tunnel port (dp port).
+		return nil
+	}
+
 	a := flow.GetAction()
 	if a.Name == fibcapi.BridgingFlow_Action_OUTPUT && a.Value != 0 {
 		_, dpPort, err := c.ConvertPortVMtoDP(reID, a.Value)
@@ -230,6 +235,20 @@ func (c *DBCtl) ConvertPolicyACLFlow(reID string, flow *fibcapi.PolicyACLFlow) e
 
 	m.InPort = dpPort
 
+	for _, a := range flow.AllActions() {
+		if a.Name != fibcapi.PolicyACLFlow_Action_MIRROR {
+			continue
+		}
+
+		_, dpMirror, err := c.ConvertPortVMtoDP(reID, a.Value)
+		if err != nil {
+			c.log.Debugf("ConvertPolicyACLFlow/mirror: %s", err)
+			return err
+		}
+
+		a.Value = dpMirror
+	}
+
 	return nil
 }
 
@@ -252,12 +271,18 @@ func (c *DBCtl) ConvertFlowMod(mod *fibcapi.FlowMod) error {
 	case *fibcapi.FlowMod_Unicast:
 		return nil
 
+	case *fibcapi.FlowMod_Mcast:
+		return nil
+
 	case *fibcapi.FlowMod_Bridging:
 		return c.ConvertBridgingFlow(reID, e.Bridging)
 
 	case *fibcapi.FlowMod_Acl:
 		return c.ConvertPolicyACLFlow(reID, e.Acl)
 
+	case *fibcapi.FlowMod_Srv6Local:
+		return nil
+
 	default:
 		return fmt.Errorf("Invalid flow mod. %s %v", reID, e)
 	}
@@ -307,6 +332,23 @@ func (c *DBCtl) ConvertL3UnicastGroup(reID string, g *fibcapi.L3UnicastGroup) er
 	return nil
 }
 
+//
+// ConvertL3MulticastGroup converts l3 multicast group.
+//
+func (c *DBCtl) ConvertL3MulticastGroup(reID string, g *fibcapi.L3MulticastGroup) error {
+	for _, port := range g.Ports {
+		_, dpPort, err := c.ConvertPortVMtoDP(reID, port.PortId)
+		if err != nil {
+			c.log.Debugf("ConvertL3MulticastGroup: %s", err)
+			return err
+		}
+
+		port.PortId = dpPort
+	}
+
+	return nil
+}
+
 //
 // ConvertMplsIfaceGroup converts mpls interface group.
 //
@@ -341,6 +383,21 @@ func (c *DBCtl) ConvertGroupMod(mod *fibcapi.GroupMod) error {
 	case *fibcapi.GroupMod_MplsLabel:
 		return nil
 
+	case *fibcapi.GroupMod_L3Ecmp:
+		return nil
+
+	case *fibcapi.GroupMod_L3Mcast:
+		return c.ConvertL3MulticastGroup(reID, e.L3Mcast)
+
+	case *fibcapi.GroupMod_L2Overlay:
+		return nil
+
+	case *fibcapi.GroupMod_VxlanTunnel:
+		return nil
+
+	case *fibcapi.GroupMod_Srv6Encap:
+		return nil
+
 	default:
 		return fmt.Errorf("Invalid flow mod. %s %v", reID, e)
 	}
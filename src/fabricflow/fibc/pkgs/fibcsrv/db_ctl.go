@@ -21,6 +21,7 @@ import (
 	fibcapi "fabricflow/fibc/api"
 	"fabricflow/fibc/pkgs/fibcdbm"
 	"fmt"
+	"sync/atomic"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -35,12 +36,16 @@ type DBCtl struct {
 	dpset *fibcdbm.DPSet
 	idmap *fibcdbm.IDMap
 	ptmap *fibcdbm.PortMap
+	mods  *fibcdbm.ModTable
 	stats *fibcdbm.StatsTable
+	lldp  *fibcdbm.LLDPNeighborTable
 
 	waits *fibcdbm.WaiterTable
 
 	nccfg *fibcdbm.NetconfConfig
 
+	fenced int32 // demoted by newer active. (atomic)
+
 	log *log.Entry
 }
 
@@ -55,7 +60,9 @@ func NewDBCtl() *DBCtl {
 		dpset: fibcdbm.NewDPSet(),
 		idmap: fibcdbm.NewIDMap(),
 		ptmap: fibcdbm.NewPortMap(),
+		mods:  fibcdbm.NewModTable(),
 		stats: fibcdbm.NewStatsTable(),
+		lldp:  fibcdbm.NewLLDPNeighborTable(),
 		waits: fibcdbm.NewWaiterTable(),
 		nccfg: fibcdbm.NewNetconfConfig(),
 
@@ -105,6 +112,13 @@ func (c *DBCtl) PortMap() *fibcdbm.PortMap {
 	return c.ptmap
 }
 
+//
+// ModTable returns shadow table of flow/group mod.
+//
+func (c *DBCtl) ModTable() *fibcdbm.ModTable {
+	return c.mods
+}
+
 //
 // Stats returns stats table.
 //
@@ -112,6 +126,13 @@ func (c *DBCtl) Stats() *fibcdbm.StatsTable {
 	return c.stats
 }
 
+//
+// LLDPNeighbors returns LLDP neighbor table.
+//
+func (c *DBCtl) LLDPNeighbors() *fibcdbm.LLDPNeighborTable {
+	return c.lldp
+}
+
 //
 // Waiters returns waiter table.
 //
@@ -126,6 +147,74 @@ func (c *DBCtl) NetconfConfig() *fibcdbm.NetconfConfig {
 	return c.nccfg
 }
 
+//
+// SetFenced sets fenced state.
+// datapaths are not programmed while fenced (demoted from active).
+//
+func (c *DBCtl) SetFenced(fenced bool) {
+	var v int32
+	if fenced {
+		v = 1
+	}
+	atomic.StoreInt32(&c.fenced, v)
+}
+
+//
+// IsFenced returns true if fenced.
+//
+func (c *DBCtl) IsFenced() bool {
+	return atomic.LoadInt32(&c.fenced) != 0
+}
+
+//
+// RangeDBDpEntries enumerates monitor entries of type t. (NOP: all types)
+//
+func (c *DBCtl) RangeDBDpEntries(t fibcapi.DbDpEntry_Type, f func(*fibcapi.DbDpEntry)) {
+	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_APMON {
+		c.APSet().Range(func(e fibcdbm.DPEntry) {
+			entry := e.(*APAPIMonitorEntry)
+			f(&fibcapi.DbDpEntry{
+				Type:   fibcapi.DbDpEntry_APMON,
+				Id:     entry.EntryID(),
+				Remote: entry.Remote(),
+			})
+		})
+	}
+
+	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_VMMON {
+		c.VMSet().Range(func(e fibcdbm.DPEntry) {
+			entry := e.(*VMAPIMonitorEntry)
+			f(&fibcapi.DbDpEntry{
+				Type:   fibcapi.DbDpEntry_VMMON,
+				Id:     entry.reID,
+				Remote: entry.Remote(),
+			})
+		})
+	}
+
+	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_DPMON {
+		c.DPSet().Range(func(e fibcdbm.DPEntry) {
+			entry := e.(*DPAPIMonitorEntry)
+			f(&fibcapi.DbDpEntry{
+				Type:   fibcapi.DbDpEntry_DPMON,
+				Id:     fmt.Sprintf("%d", entry.dpID),
+				Remote: entry.Remote(),
+			})
+		})
+	}
+
+	if t == fibcapi.DbDpEntry_NOP || t == fibcapi.DbDpEntry_VSMON {
+		c.VSSet().Range(func(e fibcdbm.DPEntry) {
+			entry := e.(*VSAPIMonitorEntry)
+			f(&fibcapi.DbDpEntry{
+				Type:   fibcapi.DbDpEntry_VSMON,
+				Id:     fmt.Sprintf("%d", entry.vsID),
+				Remote: entry.Remote(),
+			})
+		})
+	}
+}
+
 //
 // SendVMPortStatus sends PortStats message.
 //
@@ -173,6 +262,18 @@ func (c *DBCtl) SendAPMonitorReply(msg *fibcapi.ApMonitorReply) {
 	})
 }
 
+//
+// SendAPPortStatus sends port status of datapath port to ap monitors.
+//
+func (c *DBCtl) SendAPPortStatus(dpID uint64, portID uint32, status fibcapi.PortStatus_Status) {
+	var key *fibcdbm.PortKey
+	c.PortMap().SelectByDP(dpID, portID, func(e *fibcdbm.PortEntry) {
+		key = e.Key
+	})
+
+	c.SendAPMonitorReply(NewAPMonitorReplyPortStatus(dpID, portID, status, key))
+}
+
 //
 // SendAPMonitorReplyLog send ap monitor reply.
 //
@@ -206,6 +307,10 @@ func (c *DBCtl) SendVMMonitorReply(reID string, msg *fibcapi.VmMonitorReply) err
 // SendDPMonitorReply send dp monitor reply.
 //
 func (c *DBCtl) SendDPMonitorReply(dpID uint64, msg *fibcapi.DpMonitorReply) error {
+	if c.IsFenced() {
+		return fmt.Errorf("fenced. dpid=%d", dpID)
+	}
+
 	eid := NewDPAPIMonitorEntryID(dpID)
 	ok := c.DPSet().Select(eid, func(e fibcdbm.DPEntry) {
 		mon := e.(*DPAPIMonitorEntry)
@@ -223,6 +328,10 @@ func (c *DBCtl) SendDPMonitorReply(dpID uint64, msg *fibcapi.DpMonitorReply) err
 // SendDPMonitorMod sends dp monitor reply.
 //
 func (c *DBCtl) SendDPMonitorMod(dpID uint64, msg *fibcapi.DpMonitorReply) error {
+	if c.IsFenced() {
+		return fmt.Errorf("fenced. dpid=%d", dpID)
+	}
+
 	eid := NewDPAPIMonitorEntryID(dpID)
 	ok := c.DPSet().Select(eid, func(e fibcdbm.DPEntry) {
 		mon := e.(*DPAPIMonitorEntry)
@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	fibcapi "fabricflow/fibc/api"
 	"fabricflow/fibc/pkgs/fibcdbm"
+	"fabricflow/fibc/pkgs/fibclldp"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
@@ -30,7 +31,8 @@ import (
 // DPCtl is dp controller.
 //
 type DPCtl struct {
-	db *DBCtl
+	db   *DBCtl
+	lldp *LLDPCtl
 
 	stats *fibcdbm.StatsGroup
 	log   *log.Entry
@@ -48,6 +50,15 @@ func NewDPCtl(db *DBCtl) *DPCtl {
 	}
 }
 
+//
+// SetLLDPCtl sets lldp agent.
+// lldp frames received are not sent to vs if lldp agent is set.
+//
+func (c *DPCtl) SetLLDPCtl(lldp *LLDPCtl) *DPCtl {
+	c.lldp = lldp
+	return c
+}
+
 //
 // Hello process hello message.
 //
@@ -147,7 +158,48 @@ func (c *DPCtl) enterDP(dpID uint64) error {
 	c.log.Debugf("enterDP: dpid:%d", dpID)
 
 	pdesc := NewDPMonitorReplyMpPortDescInternal(dpID)
-	return c.db.SendDPMonitorReply(dpID, pdesc)
+	if err := c.db.SendDPMonitorReply(dpID, pdesc); err != nil {
+		return err
+	}
+
+	c.replayMods(dpID)
+
+	return nil
+}
+
+func (c *DPCtl) replayMods(dpID uint64) {
+	groups := c.db.ModTable().ListGroupMods(dpID)
+	flows := c.db.ModTable().ListFlowMods(dpID)
+
+	c.log.Infof("replayMods: dpid:%d #groups:%d #flows:%d", dpID, len(groups), len(flows))
+
+	for _, mod := range groups {
+		c.stats.Inc(DPStatsReplayGroupMod)
+
+		fibcapi.LogGroupMod(c.log, log.TraceLevel, mod)
+
+		msg := NewDPMonitorReplyGroupMod(mod)
+		if err := c.db.SendDPMonitorMod(dpID, msg); err != nil {
+			c.stats.Inc(DPStatsReplayGroupModErr)
+
+			c.log.Errorf("replayMods: send group mod error. %s", err)
+			return
+		}
+	}
+
+	for _, mod := range flows {
+		c.stats.Inc(DPStatsReplayFlowMod)
+
+		fibcapi.LogFlowMod(c.log, log.TraceLevel, mod)
+
+		msg := NewDPMonitorReplyFlowMod(mod)
+		if err := c.db.SendDPMonitorMod(dpID, msg); err != nil {
+			c.stats.Inc(DPStatsReplayFlowModErr)
+
+			c.log.Errorf("replayMods: send flow mod error. %s", err)
+			return
+		}
+	}
 }
 
 func (c *DPCtl) leaveDP(dpID uint64) {
@@ -167,6 +219,11 @@ func (c *DPCtl) leaveDP(dpID uint64) {
 
 		c.log.Debugf("leaveDP: DP leave dpid:%d port:%d", dpID, e.DPPort.PortID)
 		c.db.SendVMPortStatus(e.Key, e.VMPort.PortID, fibcapi.PortStatus_DOWN)
+		c.db.SendAPPortStatus(dpID, e.DPPort.PortID, fibcapi.PortStatus_DOWN)
+	}
+
+	if c.lldp != nil {
+		c.lldp.DeleteNeighbors(dpID)
 	}
 }
 
@@ -198,6 +255,11 @@ func (c *DPCtl) enterPort(dpID uint64, port *fibcapi.FFPort) {
 
 	c.log.Debugf("enterPort: DP enter dpid:%d port:%d", dpID, port.PortNo)
 	c.db.SendVMPortStatusAll(e, status)
+	c.db.SendAPPortStatus(dpID, port.PortNo, status)
+
+	if c.lldp != nil && status == fibcapi.PortStatus_UP {
+		c.lldp.Send(e)
+	}
 }
 
 //
@@ -211,6 +273,10 @@ func (c *DPCtl) PacketIn(dpID uint64, portID uint32, data []byte) error {
 		c.log.Tracef("PacketIn:\n%s", hex.Dump(data))
 	}
 
+	if c.lldp != nil && fibclldp.IsLLDP(data) {
+		return c.lldp.Recv(dpID, portID, data)
+	}
+
 	vsID, vsPort, err := c.db.ConvertPortDPtoVS(dpID, portID)
 	if err != nil {
 		c.stats.Inc(DPStatsPacketInErr)
@@ -245,6 +311,12 @@ func (c *DPCtl) PortStatus(dpID uint64, portID uint32, dpState uint32) error {
 		return fibcapi.PortStatus_UP
 	}()
 
+	c.db.SendAPPortStatus(dpID, portID, status)
+
+	if c.lldp != nil && status == fibcapi.PortStatus_DOWN {
+		c.lldp.DeleteNeighbor(dpID, portID)
+	}
+
 	vsID, vsPort, err := c.db.ConvertPortDPtoVS(dpID, portID)
 	if err != nil {
 		c.stats.Inc(DPStatsPortStatusErr)
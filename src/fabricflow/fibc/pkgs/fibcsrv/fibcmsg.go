@@ -89,6 +89,33 @@ func NewVMMonitorReplyOAM(request *fibcapi.OAM_Request, xid uint32) *fibcapi.VmM
 	)
 }
 
+//
+// NewAPMonitorReplyPortStatus returns new ApMonitorReply
+//
+// ApMonitorReply {
+//   oneof body {
+//     ApMonitorReplyPortStatus port_status
+//   }
+// }
+//
+func NewAPMonitorReplyPortStatus(dpID uint64, portID uint32, status fibcapi.PortStatus_Status, key *fibcdbm.PortKey) *fibcapi.ApMonitorReply {
+	ps := &fibcapi.ApMonitorReplyPortStatus{
+		DpId:   dpID,
+		PortNo: portID,
+		Status: status,
+	}
+	if key != nil {
+		ps.ReId = key.ReID
+		ps.Ifname = key.Ifname
+	}
+
+	return &fibcapi.ApMonitorReply{
+		Body: &fibcapi.ApMonitorReply_PortStatus{
+			PortStatus: ps,
+		},
+	}
+}
+
 //
 // NewDPMonitorReplyMpPort returns new DpMonitorReply
 //
@@ -176,6 +203,76 @@ func NewDPMonitorReplyMpPortDescInternal(dpID uint64) *fibcapi.DpMonitorReply {
 	return NewDPMonitorReplyMpPortDesc(dpID, true, 0)
 }
 
+//
+// NewDPMonitorReplyMpFlow returns new DpMonitorReply
+//
+// DpMonitorReply {
+//   oneof body {
+//     DpMultipartRequest multipart
+//   }
+// }
+//
+// DpMultipartRequest {
+//   uint32 xid
+//   FFMultipart.Request request
+// }
+//
+// FFMultipart {
+//   Request {
+//     uint64 dpID
+//     MpType mp_type
+//     oneof body {
+//       FlowRequest flow
+//     }
+//   }
+// }
+//
+func NewDPMonitorReplyMpFlow(dpID uint64, xid uint32) *fibcapi.DpMonitorReply {
+	return fibcapi.NewDpMonitorReply().SetMultipart(
+		fibcapi.NewDpMultipartRequest(
+			xid,
+			fibcapi.NewFFMultipartRequest(dpID).SetFlow(
+				fibcapi.NewFFMultipartFlowRequest(),
+			),
+		),
+	)
+}
+
+//
+// NewDPMonitorReplyMpGroupDesc returns new DpMonitorReply
+//
+// DpMonitorReply {
+//   oneof body {
+//     DpMultipartRequest multipart
+//   }
+// }
+//
+// DpMultipartRequest {
+//   uint32 xid
+//   FFMultipart.Request request
+// }
+//
+// FFMultipart {
+//   Request {
+//     uint64 dpID
+//     MpType mp_type
+//     oneof body {
+//       GroupDescRequest group_desc
+//     }
+//   }
+// }
+//
+func NewDPMonitorReplyMpGroupDesc(dpID uint64, xid uint32) *fibcapi.DpMonitorReply {
+	return fibcapi.NewDpMonitorReply().SetMultipart(
+		fibcapi.NewDpMultipartRequest(
+			xid,
+			fibcapi.NewFFMultipartRequest(dpID).SetGroupDesc(
+				fibcapi.NewFFMultipartGroupDescRequest(),
+			),
+		),
+	)
+}
+
 //
 // NewDPMonitorReplyFlowMod returns new DpMonitorReply
 //
@@ -21,6 +21,8 @@ import (
 	fibcapi "fabricflow/fibc/api"
 	"fabricflow/fibc/pkgs/fibccfg"
 	"net"
+	"net/http"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -33,6 +35,8 @@ import (
 type Server struct {
 	dbctl  *DBCtl
 	server *grpc.Server
+	hactl  *HACtl
+	lldp   *LLDPCtl
 
 	logMonitor bool
 
@@ -42,10 +46,12 @@ type Server struct {
 //
 // NewServer returns new server.
 //
-func NewServer() *Server {
+func NewServer(opts ...grpc.ServerOption) *Server {
+	db := NewDBCtl()
 	return &Server{
-		dbctl:  NewDBCtl(),
-		server: grpc.NewServer(),
+		dbctl:  db,
+		server: grpc.NewServer(opts...),
+		hactl:  NewHACtl(db),
 		log:    log.WithFields(log.Fields{"module": "server"}),
 	}
 }
@@ -70,7 +76,11 @@ func (s *Server) newVSAPIServer() *VSAPIServer {
 }
 
 func (s *Server) newDPAPIServer() *DPAPIServer {
-	return NewDPAPIServer(NewDPCtl(s.dbctl), s.server)
+	return NewDPAPIServer(NewDPCtl(s.dbctl).SetLLDPCtl(s.lldp), s.server)
+}
+
+func (s *Server) newHAAPIServer() *HAAPIServer {
+	return NewHAAPIServer(s.hactl, s.server)
 }
 
 func (s *Server) newLogHook() *FibcLogHook {
@@ -104,11 +114,73 @@ func (s *Server) Serve(lis net.Listener) {
 	s.newDPAPIServer()
 	s.newVSAPIServer()
 	s.newAPAPIServer()
+	s.newHAAPIServer()
 
 	s.log.Infof("started.")
 	s.server.Serve(lis)
 }
 
+//
+// ServeMetrics serves metrics on http://addr/metrics.
+// Port stats are fetched from datapaths every interval.
+//
+func (s *Server) ServeMetrics(addr string, interval time.Duration, done <-chan struct{}) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	ctl := NewMetricsCtl(s.dbctl, interval)
+	ctl.Start(done)
+
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, ctl)
+
+	go func() {
+		if err := http.Serve(lis, mux); err != nil {
+			s.log.Errorf("metrics server error. %s", err)
+		}
+	}()
+
+	s.log.Infof("metrics started. %s%s", addr, MetricsPath)
+	return nil
+}
+
+//
+// ServeLLDP starts lldp agent which sends lldp frames every interval.
+// It must be called before Serve.
+//
+func (s *Server) ServeLLDP(interval time.Duration, sysName string, done <-chan struct{}) {
+	s.lldp = NewLLDPCtl(s.dbctl, interval, sysName)
+	s.lldp.Start(done)
+
+	s.log.Infof("lldp started. interval:%s", interval)
+}
+
+//
+// Standby runs as standby of peer (active) until peer is lost. (blocking)
+// After promoted, old active is fenced in background when it comes back.
+// It must be called before Serve.
+//
+func (s *Server) Standby(peer, nodeID string, timeout time.Duration, opts []grpc.DialOption, done <-chan struct{}) error {
+	s.log.Infof("standby. peer:'%s'", peer)
+
+	standby := NewHAStandby(s.hactl, peer, nodeID, timeout, opts...)
+	if err := standby.Run(done); err != nil {
+		return err
+	}
+
+	s.log.Infof("promoted to active. epoch:%d", s.hactl.Epoch())
+
+	go func() {
+		if err := standby.Fence(done); err != nil {
+			s.log.Warnf("fence error. %s", err)
+		}
+	}()
+
+	return nil
+}
+
 //
 // FibcLogHook is hook for logrus.
 //
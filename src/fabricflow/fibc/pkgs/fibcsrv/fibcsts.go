@@ -42,6 +42,16 @@ const (
 	APStatsGetStats = "getstats"
 	// APStatsGetStatsErr is get stats error.
 	APStatsGetStatsErr = "getstats/err"
+	// APStatsAuditMods is audit mods message.
+	APStatsAuditMods = "auditmods"
+	// APStatsAuditModsErr is audit mods error.
+	APStatsAuditModsErr = "auditmods/err"
+	// APStatsAuditModsRepair is repaired entry by audit mods.
+	APStatsAuditModsRepair = "auditmods/repair"
+	// APStatsGetLLDP is get lldp neighbors message.
+	APStatsGetLLDP = "getlldpneighbors"
+	// APStatsGetLLDPErr is get lldp neighbors error.
+	APStatsGetLLDPErr = "getlldpneighbors/err"
 )
 
 var apStatsNames = []string{
@@ -56,6 +66,11 @@ var apStatsNames = []string{
 	APStatsModPortStatsErr,
 	APStatsGetStats,
 	APStatsGetStatsErr,
+	APStatsAuditMods,
+	APStatsAuditModsErr,
+	APStatsAuditModsRepair,
+	APStatsGetLLDP,
+	APStatsGetLLDPErr,
 }
 
 //
@@ -186,6 +201,14 @@ const (
 	DPStatsL2AddrStatus = "l2addrstatus"
 	// DPStatsL2AddrStatusErr is l2 addr status error.
 	DPStatsL2AddrStatusErr = "l2addrstatus/err"
+	// DPStatsReplayGroupMod is group mod replay.
+	DPStatsReplayGroupMod = "replay/groupmod"
+	// DPStatsReplayGroupModErr is group mod replay error.
+	DPStatsReplayGroupModErr = "replay/groupmod/err"
+	// DPStatsReplayFlowMod is flow mod replay.
+	DPStatsReplayFlowMod = "replay/flowmod"
+	// DPStatsReplayFlowModErr is flow mod replay error.
+	DPStatsReplayFlowModErr = "replay/flowmod/err"
 )
 
 var dpStatsNames = []string{
@@ -205,6 +228,10 @@ var dpStatsNames = []string{
 	DPStatsPortStatusErr,
 	DPStatsL2AddrStatus,
 	DPStatsL2AddrStatusErr,
+	DPStatsReplayGroupMod,
+	DPStatsReplayGroupModErr,
+	DPStatsReplayFlowMod,
+	DPStatsReplayFlowModErr,
 }
 
 //
@@ -262,3 +289,105 @@ func NewVSStats(db *DBCtl) *fibcdbm.StatsGroup {
 
 	return stats
 }
+
+const (
+	// HAStatsSync is sync request (active).
+	HAStatsSync = "sync"
+	// HAStatsSyncSend is sync reply sent (active).
+	HAStatsSyncSend = "sync/send"
+	// HAStatsSyncErr is sync error (active).
+	HAStatsSyncErr = "sync/err"
+	// HAStatsApply is sync reply applied (standby).
+	HAStatsApply = "apply"
+	// HAStatsApplyErr is sync error (standby).
+	HAStatsApplyErr = "apply/err"
+	// HAStatsPromote is promoted to active (standby).
+	HAStatsPromote = "promote"
+	// HAStatsDemote is demoted by newer active (active).
+	HAStatsDemote = "demote"
+)
+
+var haStatsNames = []string{
+	HAStatsSync,
+	HAStatsSyncSend,
+	HAStatsSyncErr,
+	HAStatsApply,
+	HAStatsApplyErr,
+	HAStatsPromote,
+	HAStatsDemote,
+}
+
+//
+// NewHAStats returns new StatsGroup.
+//
+func NewHAStats(db *DBCtl) *fibcdbm.StatsGroup {
+	stats := db.Stats().Register("hactl")
+	stats.RegisterList(haStatsNames)
+
+	return stats
+}
+
+const (
+	// MetricsStatsScrape is scrape request.
+	MetricsStatsScrape = "scrape"
+	// MetricsStatsPortStats is port stats request to dp.
+	MetricsStatsPortStats = "portstats"
+	// MetricsStatsPortStatsErr is port stats error.
+	MetricsStatsPortStatsErr = "portstats/err"
+)
+
+var metricsStatsNames = []string{
+	MetricsStatsScrape,
+	MetricsStatsPortStats,
+	MetricsStatsPortStatsErr,
+}
+
+//
+// NewMetricsStats returns new StatsGroup.
+//
+func NewMetricsStats(db *DBCtl) *fibcdbm.StatsGroup {
+	stats := db.Stats().Register("metrics")
+	stats.RegisterList(metricsStatsNames)
+
+	return stats
+}
+
+const (
+	// LLDPStatsTx is lldp frame sent.
+	LLDPStatsTx = "tx"
+	// LLDPStatsTxErr is lldp send error.
+	LLDPStatsTxErr = "tx/err"
+	// LLDPStatsRx is lldp frame received.
+	LLDPStatsRx = "rx"
+	// LLDPStatsRxErr is lldp receive error.
+	LLDPStatsRxErr = "rx/err"
+	// LLDPStatsNeighborUpd is neighbor added or changed.
+	LLDPStatsNeighborUpd = "neighbor/upd"
+	// LLDPStatsNeighborDel is neighbor deleted.
+	LLDPStatsNeighborDel = "neighbor/del"
+	// LLDPStatsNeighborExpire is neighbor expired.
+	LLDPStatsNeighborExpire = "neighbor/expire"
+	// LLDPStatsMismatch is neighbor mismatched with port map.
+	LLDPStatsMismatch = "mismatch"
+)
+
+var lldpStatsNames = []string{
+	LLDPStatsTx,
+	LLDPStatsTxErr,
+	LLDPStatsRx,
+	LLDPStatsRxErr,
+	LLDPStatsNeighborUpd,
+	LLDPStatsNeighborDel,
+	LLDPStatsNeighborExpire,
+	LLDPStatsMismatch,
+}
+
+//
+// NewLLDPStats returns new StatsGroup.
+//
+func NewLLDPStats(db *DBCtl) *fibcdbm.StatsGroup {
+	stats := db.Stats().Register("lldpctl")
+	stats.RegisterList(lldpStatsNames)
+
+	return stats
+}
@@ -0,0 +1,48 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fibcsrv
+
+import (
+	fibcapi "fabricflow/fibc/api"
+
+	"google.golang.org/grpc"
+)
+
+//
+// HAAPIServer is HAAPI server.
+//
+type HAAPIServer struct {
+	ctl *HACtl
+}
+
+//
+// NewHAAPIServer returns new HAAPIServer.
+//
+func NewHAAPIServer(ctl *HACtl, server *grpc.Server) *HAAPIServer {
+	s := &HAAPIServer{
+		ctl: ctl,
+	}
+	fibcapi.RegisterFIBCHaApiServer(server, s)
+	return s
+}
+
+//
+// Sync process sync request.
+//
+func (s *HAAPIServer) Sync(req *fibcapi.HaSyncRequest, stream fibcapi.FIBCHaApi_SyncServer) error {
+	return s.ctl.Sync(req, stream, stream.Context().Done())
+}
@@ -0,0 +1,191 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fibcsrv
+
+import (
+	"context"
+	fibcapi "fabricflow/fibc/api"
+	ffgrpc "fabricflow/util/grpc"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	haStandbyRetryInterval  = 1 * time.Second
+	HAStandbyTimeoutDefault = 5 * time.Second
+)
+
+//
+// HAStandby replicates db from active fibcd and waits for failure of it.
+//
+type HAStandby struct {
+	ctl     *HACtl
+	peer    string
+	nodeID  string
+	timeout time.Duration
+	opts    []grpc.DialOption
+
+	log *log.Entry
+}
+
+//
+// NewHAStandby returns new HAStandby.
+//
+func NewHAStandby(ctl *HACtl, peer, nodeID string, timeout time.Duration, opts ...grpc.DialOption) *HAStandby {
+	if timeout == 0 {
+		timeout = HAStandbyTimeoutDefault
+	}
+
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	return &HAStandby{
+		ctl:     ctl,
+		peer:    peer,
+		nodeID:  nodeID,
+		timeout: timeout,
+		opts:    opts,
+
+		log: log.WithFields(log.Fields{"module": "hastandby", "peer": peer}),
+	}
+}
+
+func (s *HAStandby) sync(client fibcapi.FIBCHaApiClient, lastRecv *time.Time) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Sync(ctx, &fibcapi.HaSyncRequest{
+		NodeId: s.nodeID,
+		Epoch:  s.ctl.PeerEpoch(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// cancel stream if no message is received from active.
+	watchdog := time.AfterFunc(s.timeout, cancel)
+	defer watchdog.Stop()
+
+	var seq uint64
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		watchdog.Reset(s.timeout)
+		*lastRecv = time.Now()
+
+		if msg.Seq == seq {
+			// keepalive
+			continue
+		}
+
+		seq = msg.Seq
+		s.ctl.Apply(msg)
+	}
+}
+
+//
+// Run replicates db from active until active is lost. (blocking)
+// It returns nil if active is lost and promoted, or error if done is closed.
+//
+func (s *HAStandby) Run(done <-chan struct{}) error {
+	conn, err := grpc.Dial(ffgrpc.DialTarget(s.peer), s.opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := fibcapi.NewFIBCHaApiClient(conn)
+	lastRecv := time.Now()
+
+	s.log.Infof("Run: standby started.")
+
+	for {
+		if err := s.sync(client, &lastRecv); err != nil {
+			s.ctl.stats.Inc(HAStatsApplyErr)
+			s.log.Warnf("Run: sync error. %s", err)
+		}
+
+		if elapsed := time.Since(lastRecv); elapsed > s.timeout {
+			s.log.Warnf("Run: active lost. %s", elapsed)
+
+			s.ctl.Promote()
+			return nil
+		}
+
+		select {
+		case <-time.After(haStandbyRetryInterval):
+		case <-done:
+			return fmt.Errorf("standby canceled.")
+		}
+	}
+}
+
+func (s *HAStandby) fence(client fibcapi.FIBCHaApiClient) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	stream, err := client.Sync(ctx, &fibcapi.HaSyncRequest{
+		NodeId: s.nodeID,
+		Epoch:  s.ctl.Epoch(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = stream.Recv()
+	return err
+}
+
+//
+// Fence notifies epoch to old active after promoted until
+// old active is demoted. (blocking)
+// It returns nil if old active is demoted, or error if done is closed.
+//
+func (s *HAStandby) Fence(done <-chan struct{}) error {
+	conn, err := grpc.Dial(ffgrpc.DialTarget(s.peer), s.opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := fibcapi.NewFIBCHaApiClient(conn)
+
+	for {
+		err := s.fence(client)
+		if status.Code(err) == codes.FailedPrecondition {
+			s.log.Infof("Fence: old active demoted. %s", err)
+			return nil
+		}
+
+		s.log.Debugf("Fence: %v", err)
+
+		select {
+		case <-time.After(haStandbyRetryInterval):
+		case <-done:
+			return fmt.Errorf("fence canceled.")
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fibcsrv
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fabricflow/fibc/pkgs/fibcdbm"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	haSyncInterval = 1 * time.Second
+)
+
+//
+// HACtl is ha api controller.
+// active sends snapshot of port-map, id-map, datapath membership and
+// flow/group mods to standby when they are changed, and standby applies
+// it to db. standby applies membership and mods when it is promoted.
+//
+// epoch is incremented when standby is promoted. active is demoted
+// (stops programming datapaths) when it knows newer epoch.
+//
+type HACtl struct {
+	db       *DBCtl
+	interval time.Duration
+
+	mutex     sync.RWMutex
+	shadow    []*fibcapi.DbDpEntry
+	mods      []*fibcapi.DbModEntry
+	idKeys    map[uint64]struct{}         // key: dp_id applied.
+	portKeys  map[string]*fibcdbm.PortKey // key: PortKey.String() applied.
+	epoch     uint64
+	peerEpoch uint64
+
+	stats *fibcdbm.StatsGroup
+	log   *log.Entry
+}
+
+//
+// NewHACtl returns new HACtl.
+//
+func NewHACtl(db *DBCtl) *HACtl {
+	return &HACtl{
+		db:       db,
+		interval: haSyncInterval,
+		shadow:   []*fibcapi.DbDpEntry{},
+		mods:     []*fibcapi.DbModEntry{},
+		idKeys:   map[uint64]struct{}{},
+		portKeys: map[string]*fibcdbm.PortKey{},
+
+		stats: NewHAStats(db),
+		log:   log.WithFields(log.Fields{"module": "hactl"}),
+	}
+}
+
+//
+// Epoch returns epoch of this node.
+//
+func (c *HACtl) Epoch() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.epoch
+}
+
+//
+// PeerEpoch returns epoch of active. (standby)
+//
+func (c *HACtl) PeerEpoch() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.peerEpoch
+}
+
+//
+// Snapshot returns current entries of db.
+//
+func (c *HACtl) Snapshot() *fibcapi.HaSyncReply {
+	msg := &fibcapi.HaSyncReply{
+		Epoch: c.Epoch(),
+	}
+
+	c.db.IDMap().Range(func(e *fibcdbm.IDEntry) {
+		msg.IdEntries = append(msg.IdEntries, NewDBIDEntryFromLocal(e))
+	})
+
+	c.db.PortMap().Range(func(e *fibcdbm.PortEntry) {
+		msg.PortEntries = append(msg.PortEntries, NewDBPortEntryFromLocal(e))
+	})
+
+	c.db.RangeDBDpEntries(fibcapi.DbDpEntry_NOP, func(e *fibcapi.DbDpEntry) {
+		if e.Type != fibcapi.DbDpEntry_APMON {
+			msg.DpEntries = append(msg.DpEntries, e)
+		}
+	})
+
+	// mods are listed in order of replay.
+	mods := c.db.ModTable()
+	for _, dpID := range mods.DpIDs() {
+		for _, mod := range mods.ListGroupMods(dpID) {
+			msg.ModEntries = append(msg.ModEntries, &fibcapi.DbModEntry{DpId: dpID, GroupMod: mod})
+		}
+		for _, mod := range mods.ListFlowMods(dpID) {
+			msg.ModEntries = append(msg.ModEntries, &fibcapi.DbModEntry{DpId: dpID, FlowMod: mod})
+		}
+	}
+
+	// sort entries to compare snapshots.
+	sort.Slice(msg.IdEntries, func(i, j int) bool {
+		return msg.IdEntries[i].DpId < msg.IdEntries[j].DpId
+	})
+	sort.Slice(msg.PortEntries, func(i, j int) bool {
+		ki, kj := msg.PortEntries[i].Key, msg.PortEntries[j].Key
+		if ki.ReId != kj.ReId {
+			return ki.ReId < kj.ReId
+		}
+		return ki.Ifname < kj.Ifname
+	})
+	sort.Slice(msg.DpEntries, func(i, j int) bool {
+		ei, ej := msg.DpEntries[i], msg.DpEntries[j]
+		if ei.Type != ej.Type {
+			return ei.Type < ej.Type
+		}
+		return ei.Id < ej.Id
+	})
+
+	return msg
+}
+
+//
+// checkEpoch demotes active if epoch of peer is newer than own.
+//
+func (c *HACtl) checkEpoch(epoch uint64) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.db.IsFenced() {
+		return status.Errorf(codes.FailedPrecondition, "fenced. epoch:%d", c.epoch)
+	}
+
+	if epoch <= c.epoch {
+		return nil
+	}
+
+	c.db.SetFenced(true)
+	c.stats.Inc(HAStatsDemote)
+	c.log.Warnf("Sync: demoted. epoch:%d peer epoch:%d. stop programming datapaths.", c.epoch, epoch)
+
+	return status.Errorf(codes.FailedPrecondition, "demoted. epoch:%d peer epoch:%d", c.epoch, epoch)
+}
+
+//
+// Sync sends snapshot to standby until done. (active)
+// It returns error if epoch of request is newer than own, and stops
+// programming datapaths.
+//
+func (c *HACtl) Sync(req *fibcapi.HaSyncRequest, stream fibcapi.FIBCHaApi_SyncServer, done <-chan struct{}) error {
+	nodeID := req.NodeId
+
+	c.stats.Inc(HAStatsSync)
+	c.log.Infof("Sync: START. node:'%s' epoch:%d", nodeID, req.Epoch)
+	defer c.log.Infof("Sync: EXIT. node:'%s'", nodeID)
+
+	if err := c.checkEpoch(req.Epoch); err != nil {
+		c.stats.Inc(HAStatsSyncErr)
+		return err
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	var (
+		seq  uint64
+		last *fibcapi.HaSyncReply
+	)
+
+	for {
+		msg := c.Snapshot()
+		if last == nil || !proto.Equal(msg, last) {
+			seq++
+			last = msg
+
+			reply := proto.Clone(msg).(*fibcapi.HaSyncReply)
+			reply.Seq = seq
+			if err := stream.Send(reply); err != nil {
+				c.stats.Inc(HAStatsSyncErr)
+				c.log.Errorf("Sync: send error. %s", err)
+				return err
+			}
+
+			c.stats.Inc(HAStatsSyncSend)
+			c.log.Debugf("Sync: sent. seq:%d id:%d port:%d dp:%d mod:%d",
+				seq, len(msg.IdEntries), len(msg.PortEntries), len(msg.DpEntries), len(msg.ModEntries))
+
+		} else if err := stream.Send(&fibcapi.HaSyncReply{Seq: seq, Epoch: msg.Epoch}); err != nil {
+			// keepalive
+			c.stats.Inc(HAStatsSyncErr)
+			c.log.Errorf("Sync: send keepalive error. %s", err)
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-done:
+			return nil
+		}
+	}
+}
+
+//
+// Apply merges entries of snapshot to db. (standby)
+// entries applied by previous snapshot and not in snapshot are deleted,
+// and entries registered locally (e.g. config) are kept.
+//
+func (c *HACtl) Apply(msg *fibcapi.HaSyncReply) {
+	c.stats.Inc(HAStatsApply)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	idKeys := map[uint64]struct{}{}
+	idEntries := make([]*fibcdbm.IDEntry, len(msg.IdEntries))
+	for index, e := range msg.IdEntries {
+		idEntries[index] = NewDBIDEntryFromAPI(e)
+		idKeys[e.DpId] = struct{}{}
+	}
+
+	idDeleted := []uint64{}
+	for dpID := range c.idKeys {
+		if _, ok := idKeys[dpID]; !ok {
+			idDeleted = append(idDeleted, dpID)
+		}
+	}
+
+	portKeys := map[string]*fibcdbm.PortKey{}
+	portEntries := make([]*fibcdbm.PortEntry, len(msg.PortEntries))
+	for index, e := range msg.PortEntries {
+		portEntries[index] = NewDBPortEntryFromAPI(e)
+		portKeys[portEntries[index].Key.String()] = portEntries[index].Key
+	}
+
+	portDeleted := []*fibcdbm.PortKey{}
+	for key, pkey := range c.portKeys {
+		if _, ok := portKeys[key]; !ok {
+			portDeleted = append(portDeleted, pkey)
+		}
+	}
+
+	c.db.IDMap().Merge(idEntries, idDeleted)
+	c.db.PortMap().Merge(portEntries, portDeleted)
+
+	c.idKeys = idKeys
+	c.portKeys = portKeys
+	c.shadow = msg.DpEntries
+	c.mods = msg.ModEntries
+	if msg.Epoch > c.peerEpoch {
+		c.peerEpoch = msg.Epoch
+	}
+
+	c.log.Debugf("Apply: seq:%d epoch:%d id:%d/%d port:%d/%d dp:%d mod:%d",
+		msg.Seq, msg.Epoch, len(idEntries), len(idDeleted), len(portEntries), len(portDeleted),
+		len(msg.DpEntries), len(msg.ModEntries))
+}
+
+//
+// Promote applies datapath membership and mods of active. (standby)
+// ports of vm/dp/vs connected to active are left, so that they enter
+// again when they connect to this node, and mods are replayed to them.
+// epoch is incremented to demote active.
+//
+func (c *HACtl) Promote() {
+	c.stats.Inc(HAStatsPromote)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.peerEpoch > c.epoch {
+		c.epoch = c.peerEpoch
+	}
+	c.epoch++
+	c.db.SetFenced(false)
+
+	for _, e := range c.shadow {
+		if err := c.leaveShadow(e); err != nil {
+			c.log.Warnf("Promote: %s '%s' error. %s", e.Type, e.Id, err)
+			continue
+		}
+
+		c.log.Infof("Promote: waiting for %s '%s' (%s)", e.Type, e.Id, e.Remote)
+	}
+
+	groups := map[uint64][]*fibcapi.GroupMod{}
+	flows := map[uint64][]*fibcapi.FlowMod{}
+	for _, e := range c.mods {
+		if mod := e.GroupMod; mod != nil {
+			groups[e.DpId] = append(groups[e.DpId], mod)
+		}
+		if mod := e.FlowMod; mod != nil {
+			flows[e.DpId] = append(flows[e.DpId], mod)
+		}
+	}
+
+	dpIDs := map[uint64]struct{}{}
+	for dpID := range groups {
+		dpIDs[dpID] = struct{}{}
+	}
+	for dpID := range flows {
+		dpIDs[dpID] = struct{}{}
+	}
+
+	for dpID := range dpIDs {
+		c.db.ModTable().Reset(dpID, groups[dpID], flows[dpID])
+		c.log.Infof("Promote: dpid:%d #groups:%d #flows:%d", dpID, len(groups[dpID]), len(flows[dpID]))
+	}
+
+	c.log.Infof("Promote: epoch:%d", c.epoch)
+}
+
+func (c *HACtl) leaveShadow(e *fibcapi.DbDpEntry) error {
+	keys := []*fibcdbm.PortKey{}
+	listKeys := func(e *fibcdbm.PortEntry) {
+		keys = append(keys, e.Key.Clone())
+	}
+
+	var leave func(*fibcdbm.PortEntry)
+
+	switch e.Type {
+	case fibcapi.DbDpEntry_VMMON:
+		c.db.PortMap().ListByVM(e.Id, listKeys)
+		leave = func(e *fibcdbm.PortEntry) {
+			if e.VMPort.IsValid() {
+				e.VMPort.UpdateEnter(false)
+			}
+		}
+
+	case fibcapi.DbDpEntry_DPMON:
+		dpID, err := strconv.ParseUint(e.Id, 0, 64)
+		if err != nil {
+			return err
+		}
+		c.db.PortMap().ListByDP(dpID, listKeys)
+		leave = func(e *fibcdbm.PortEntry) {
+			e.DPPort.UpdateEnter(false)
+		}
+
+	case fibcapi.DbDpEntry_VSMON:
+		vsID, err := strconv.ParseUint(e.Id, 0, 64)
+		if err != nil {
+			return err
+		}
+		c.db.PortMap().ListByVS(vsID, listKeys)
+		leave = func(e *fibcdbm.PortEntry) {
+			e.VSPort.UpdateEnter(false)
+		}
+
+	default:
+		return nil
+	}
+
+	for _, key := range keys {
+		c.db.PortMap().Update(key, leave)
+	}
+
+	return nil
+}
+
+//
+// Shadow returns datapath membership of active. (standby)
+//
+func (c *HACtl) Shadow() []*fibcapi.DbDpEntry {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.shadow
+}
@@ -0,0 +1,270 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcsrv
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fabricflow/fibc/pkgs/fibcdbm"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testHASyncServer struct {
+	grpc.ServerStream
+	ch chan *fibcapi.HaSyncReply
+}
+
+func (s *testHASyncServer) Send(msg *fibcapi.HaSyncReply) error {
+	s.ch <- msg
+	return nil
+}
+
+func newTestHAActiveDB() *DBCtl {
+	db := NewDBCtl()
+
+	db.IDMap().Register(fibcdbm.NewIDEntry(10, "1.1.1.1"))
+	db.PortMap().Register(&fibcdbm.PortEntry{
+		Key:    fibcdbm.NewPortKey("1.1.1.1", "eth1"),
+		VMPort: fibcdbm.NewPortValueR("1.1.1.1", 1, true),
+		DPPort: fibcdbm.NewPortValue(10, 1, true),
+	})
+
+	hwaddr, _ := net.ParseMAC("00:11:22:33:44:55")
+	_, ipnet, _ := net.ParseCIDR("10.0.1.0/24")
+	group := fibcapi.NewL3UnicastGroup(1, 1, 1, 0, hwaddr, hwaddr).ToMod(fibcapi.GroupMod_ADD, "1.1.1.1")
+	flow := fibcapi.NewUnicastRoutingFlow(
+		fibcapi.NewUnicastRoutingMatchRoute(ipnet, 0), nil, fibcapi.GroupMod_L3_UNICAST, 1,
+	).ToMod(fibcapi.FlowMod_ADD, "1.1.1.1")
+
+	db.ModTable().UpdateGroupMod(10, group)
+	db.ModTable().UpdateFlowMod(10, flow)
+
+	return db
+}
+
+func TestHACtlApply(t *testing.T) {
+	active := NewHACtl(newTestHAActiveDB())
+
+	db := NewDBCtl()
+	db.IDMap().Register(fibcdbm.NewIDEntry(99, "9.9.9.9")) // local (config) entry.
+	standby := NewHACtl(db)
+
+	msg := active.Snapshot()
+	if n := len(msg.ModEntries); n != 2 {
+		t.Errorf("Snapshot unmatch. mods:%d", n)
+	}
+
+	standby.Apply(msg)
+
+	if reID, ok := db.IDMap().SelectByDpID(10); !ok || reID != "1.1.1.1" {
+		t.Errorf("Apply unmatch. %s", reID)
+	}
+
+	if _, ok := db.IDMap().SelectByDpID(99); !ok {
+		t.Errorf("Apply local entry deleted.")
+	}
+
+	if ok := db.PortMap().SelectByDP(10, 1, func(*fibcdbm.PortEntry) {}); !ok {
+		t.Errorf("Apply port entry not found.")
+	}
+
+	// mods are not applied to db until promoted.
+	if flows, groups := db.ModTable().Count(10); flows != 0 || groups != 0 {
+		t.Errorf("Apply mods unmatch. flows:%d groups:%d", flows, groups)
+	}
+
+	// entry deleted by active.
+	active.db.IDMap().UnregisterByDpID(10)
+	active.db.PortMap().Unregister(fibcdbm.NewPortKey("1.1.1.1", "eth1"))
+	standby.Apply(active.Snapshot())
+
+	if _, ok := db.IDMap().SelectByDpID(10); ok {
+		t.Errorf("Apply deleted entry exists.")
+	}
+
+	if _, ok := db.IDMap().SelectByDpID(99); !ok {
+		t.Errorf("Apply local entry deleted.")
+	}
+
+	if ok := db.PortMap().SelectByDP(10, 1, func(*fibcdbm.PortEntry) {}); ok {
+		t.Errorf("Apply deleted port entry exists.")
+	}
+}
+
+func TestHACtlPromote(t *testing.T) {
+	active := NewHACtl(newTestHAActiveDB())
+
+	msg := active.Snapshot()
+	msg.Epoch = 3
+	msg.DpEntries = []*fibcapi.DbDpEntry{
+		{Type: fibcapi.DbDpEntry_VMMON, Id: "1.1.1.1"},
+		{Type: fibcapi.DbDpEntry_DPMON, Id: "10"},
+	}
+
+	db := NewDBCtl()
+	standby := NewHACtl(db)
+	standby.Apply(msg)
+
+	if epoch := standby.PeerEpoch(); epoch != 3 {
+		t.Errorf("Apply epoch unmatch. %d", epoch)
+	}
+
+	standby.Promote()
+
+	if epoch := standby.Epoch(); epoch != 4 {
+		t.Errorf("Promote epoch unmatch. %d", epoch)
+	}
+
+	// ports of vm/dp connected to old active wait to enter.
+	db.PortMap().Select(fibcdbm.NewPortKey("1.1.1.1", "eth1"), func(e *fibcdbm.PortEntry) {
+		if e.VMPort.Enter || e.DPPort.Enter {
+			t.Errorf("Promote port unmatch. vm:%v dp:%v", e.VMPort, e.DPPort)
+		}
+	})
+
+	if flows, groups := db.ModTable().Count(10); flows != 1 || groups != 1 {
+		t.Errorf("Promote mods unmatch. flows:%d groups:%d", flows, groups)
+	}
+
+	if db.IsFenced() {
+		t.Errorf("Promote must not be fenced.")
+	}
+}
+
+func TestHACtlSyncDemote(t *testing.T) {
+	ctl := NewHACtl(newTestHAActiveDB())
+	stream := &testHASyncServer{ch: make(chan *fibcapi.HaSyncReply, 1)}
+
+	done := make(chan struct{})
+	errCh := make(chan error)
+	go func() {
+		errCh <- ctl.Sync(&fibcapi.HaSyncRequest{NodeId: "standby"}, stream, done)
+	}()
+
+	select {
+	case msg := <-stream.ch:
+		if msg.Seq != 1 || len(msg.IdEntries) != 1 || msg.Epoch != 0 {
+			t.Errorf("Sync unmatch. %v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Sync timeout.")
+	}
+
+	close(done)
+	if err := <-errCh; err != nil {
+		t.Errorf("Sync error. %s", err)
+	}
+
+	// new active has newer epoch.
+	err := ctl.Sync(&fibcapi.HaSyncRequest{NodeId: "standby", Epoch: 1}, stream, nil)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Sync must be demoted. %v", err)
+	}
+
+	if !ctl.db.IsFenced() {
+		t.Errorf("Sync must be fenced.")
+	}
+
+	if err := ctl.db.SendDPMonitorMod(10, &fibcapi.DpMonitorReply{}); err == nil {
+		t.Errorf("SendDPMonitorMod must be error.")
+	}
+
+	// sync is rejected after demoted.
+	err = ctl.Sync(&fibcapi.HaSyncRequest{NodeId: "standby"}, stream, nil)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Sync must be rejected. %v", err)
+	}
+}
+
+func newTestHAServer(t *testing.T, ctl *HACtl) (*grpc.Server, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen error. %s", err)
+	}
+
+	server := grpc.NewServer()
+	NewHAAPIServer(ctl, server)
+	go server.Serve(lis)
+
+	return server, lis.Addr().String()
+}
+
+func TestHAStandby(t *testing.T) {
+	active := NewHACtl(newTestHAActiveDB())
+	active.interval = 10 * time.Millisecond
+
+	server, addr := newTestHAServer(t, active)
+
+	db := NewDBCtl()
+	ctl := NewHACtl(db)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- NewHAStandby(ctl, addr, "standby", 200*time.Millisecond).Run(nil)
+	}()
+
+	for retry := 0; ; retry++ {
+		if _, ok := db.IDMap().SelectByDpID(10); ok {
+			break
+		}
+		if retry > 100 {
+			t.Fatalf("Run: apply timeout.")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// active is lost.
+	server.Stop()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Errorf("Run error. %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Run: promote timeout.")
+	}
+
+	if epoch := ctl.Epoch(); epoch != 1 {
+		t.Errorf("Run epoch unmatch. %d", epoch)
+	}
+
+	if flows, groups := db.ModTable().Count(10); flows != 1 || groups != 1 {
+		t.Errorf("Run mods unmatch. flows:%d groups:%d", flows, groups)
+	}
+
+	// old active comes back, and it is demoted by new active.
+	server, addr = newTestHAServer(t, active)
+	defer server.Stop()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	if err := NewHAStandby(ctl, addr, "standby", 200*time.Millisecond).Fence(done); err != nil {
+		t.Errorf("Fence error. %s", err)
+	}
+
+	if !active.db.IsFenced() {
+		t.Errorf("Fence: old active must be fenced.")
+	}
+}
@@ -0,0 +1,232 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcsrv
+
+import (
+	"fabricflow/fibc/pkgs/fibcdbm"
+	"fabricflow/fibc/pkgs/fibclldp"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// LLDPIntervalDefault is default interval to send lldp frames.
+	LLDPIntervalDefault = 30 * time.Second
+	// LLDPHoldMultiplier is multiplier of interval to calcurate ttl.
+	LLDPHoldMultiplier = 4
+)
+
+//
+// LLDPCtl is lldp agent.
+// It sends lldp frames to datapath ports by packet out
+// and learns neighbors from lldp frames punted by packet in.
+//
+type LLDPCtl struct {
+	db       *DBCtl
+	interval time.Duration
+	sysName  string
+
+	stats *fibcdbm.StatsGroup
+	log   *log.Entry
+}
+
+//
+// NewLLDPCtl returns new LLDPCtl.
+// re_id of each router is used as system name if sysName is empty.
+//
+func NewLLDPCtl(db *DBCtl, interval time.Duration, sysName string) *LLDPCtl {
+	if interval <= 0 {
+		interval = LLDPIntervalDefault
+	}
+
+	return &LLDPCtl{
+		db:       db,
+		interval: interval,
+		sysName:  sysName,
+
+		stats: NewLLDPStats(db),
+		log:   log.WithFields(log.Fields{"module": "lldpctl"}),
+	}
+}
+
+//
+// TTL returns ttl of lldp frames sent.
+//
+func (c *LLDPCtl) TTL() uint16 {
+	ttl := uint64(c.interval/time.Second) * LLDPHoldMultiplier
+	if ttl > 0xffff {
+		return 0xffff
+	}
+	return uint16(ttl)
+}
+
+//
+// Start starts to send lldp frames.
+//
+func (c *LLDPCtl) Start(done <-chan struct{}) {
+	go c.serve(done)
+}
+
+func (c *LLDPCtl) serve(done <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.log.Infof("Serve: START. interval:%s ttl:%d", c.interval, c.TTL())
+
+FOR_LOOP:
+	for {
+		select {
+		case <-ticker.C:
+			c.sendAll()
+			c.expire(time.Now())
+
+		case <-done:
+			break FOR_LOOP
+		}
+	}
+
+	c.log.Infof("Serve: EXIT.")
+}
+
+func (c *LLDPCtl) newFrame(e *fibcdbm.PortEntry) *fibclldp.Frame {
+	sysName := c.sysName
+	if len(sysName) == 0 {
+		sysName = e.Key.ReID
+	}
+
+	return &fibclldp.Frame{
+		SrcMAC:     fibclldp.NewSrcMAC(e.DPPort.DpID, e.DPPort.PortID),
+		ChassisID:  e.Key.ReID,
+		PortID:     e.Key.Ifname,
+		TTL:        c.TTL(),
+		PortDesc:   fmt.Sprintf("dp:%d port:%d", e.DPPort.DpID, e.DPPort.PortID),
+		SystemName: sysName,
+	}
+}
+
+func (c *LLDPCtl) sendAll() {
+	entries := []*fibcdbm.PortEntry{}
+	c.db.PortMap().Range(func(e *fibcdbm.PortEntry) {
+		if e.ParentKey == nil && e.DPPort.IsAssociated() && e.DPPort.PortID != 0 {
+			entries = append(entries, e.Clone())
+		}
+	})
+
+	for _, e := range entries {
+		c.Send(e)
+	}
+}
+
+//
+// Send sends lldp frame to datapath port of e.
+//
+func (c *LLDPCtl) Send(e *fibcdbm.PortEntry) error {
+	c.stats.Inc(LLDPStatsTx)
+
+	dpID, portID := e.DPPort.DpID, e.DPPort.PortID
+	data := c.newFrame(e).Encode()
+
+	msg := NewDPMonitorReplyPacketOut(dpID, portID, data)
+	if err := c.db.SendDPMonitorReply(dpID, msg); err != nil {
+		c.stats.Inc(LLDPStatsTxErr)
+
+		c.log.Debugf("Send: dpid:%d port:%d %s", dpID, portID, err)
+		return err
+	}
+
+	return nil
+}
+
+//
+// Recv process lldp frame received from datapath port.
+//
+func (c *LLDPCtl) Recv(dpID uint64, portID uint32, data []byte) error {
+	c.stats.Inc(LLDPStatsRx)
+
+	f, err := fibclldp.Decode(data)
+	if err != nil {
+		c.stats.Inc(LLDPStatsRxErr)
+
+		c.log.Warnf("Recv: dpid:%d port:%d %s", dpID, portID, err)
+		return err
+	}
+
+	c.log.Tracef("Recv: dpid:%d port:%d %s", dpID, portID, f)
+
+	if f.TTL == 0 {
+		c.DeleteNeighbor(dpID, portID)
+		return nil
+	}
+
+	n := &fibcdbm.LLDPNeighbor{
+		Key:        fibcdbm.LLDPKey{DpID: dpID, PortID: portID},
+		ChassisID:  f.ChassisID,
+		PortID:     f.PortID,
+		PortDesc:   f.PortDesc,
+		SystemName: f.SystemName,
+		TTL:        f.TTL,
+		LastUpdate: time.Now(),
+	}
+
+	if upd := c.db.LLDPNeighbors().Update(n); upd {
+		c.stats.Inc(LLDPStatsNeighborUpd)
+
+		c.log.Infof("Neighbor: %s", n)
+
+		if n.Mismatch {
+			c.stats.Inc(LLDPStatsMismatch)
+
+			c.log.Warnf("Neighbor: mismatch. dpid:%d port:%d expected:%s received:%s/%s",
+				dpID, portID, n.Expect, n.SystemName, n.PortID)
+		}
+	}
+
+	return nil
+}
+
+//
+// DeleteNeighbor removes neighbor of datapath port.
+//
+func (c *LLDPCtl) DeleteNeighbor(dpID uint64, portID uint32) {
+	if n := c.db.LLDPNeighbors().Delete(dpID, portID); n != nil {
+		c.stats.Inc(LLDPStatsNeighborDel)
+
+		c.log.Infof("Neighbor: deleted. %s", n)
+	}
+}
+
+//
+// DeleteNeighbors removes all neighbors of datapath.
+//
+func (c *LLDPCtl) DeleteNeighbors(dpID uint64) {
+	for _, n := range c.db.LLDPNeighbors().DeleteByDP(dpID) {
+		c.stats.Inc(LLDPStatsNeighborDel)
+
+		c.log.Infof("Neighbor: deleted. %s", n)
+	}
+}
+
+func (c *LLDPCtl) expire(now time.Time) {
+	for _, n := range c.db.LLDPNeighbors().Expire(now) {
+		c.stats.Inc(LLDPStatsNeighborExpire)
+
+		c.log.Infof("Neighbor: expired. %s", n)
+	}
+}
@@ -0,0 +1,307 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fibcsrv
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fabricflow/fibc/pkgs/fibcdbm"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	metricsPrefix      = "fibc_"
+	metricsPortAll     = uint32(0xffffffff)
+	metricsPortWaitMax = 3 * time.Second
+
+	// MetricsPath is path of metrics endpoint.
+	MetricsPath = "/metrics"
+	// MetricsIntervalDefault is default interval to fetch port stats.
+	MetricsIntervalDefault = 15 * time.Second
+)
+
+func metricsBool(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func newMetricLabelDpID(dpID uint64) *fibcdbm.MetricLabel {
+	return fibcdbm.NewMetricLabel("dp_id", fmt.Sprintf("%d", dpID))
+}
+
+func newMetricLabelReID(reID string) *fibcdbm.MetricLabel {
+	return fibcdbm.NewMetricLabel("re_id", reID)
+}
+
+func newMetricLabelIfname(ifname string) *fibcdbm.MetricLabel {
+	return fibcdbm.NewMetricLabel("ifname", ifname)
+}
+
+//
+// MetricsCtl collects stats and states of fibcd
+// and serves them in prometheus text format.
+//
+type MetricsCtl struct {
+	db        *DBCtl
+	interval  time.Duration
+	psTimeout time.Duration
+	portStats *fibcdbm.PortStatsCache
+
+	stats *fibcdbm.StatsGroup
+	log   *log.Entry
+}
+
+//
+// NewMetricsCtl returns new MetricsCtl.
+// Port stats are fetched from datapaths every interval.
+//
+func NewMetricsCtl(db *DBCtl, interval time.Duration) *MetricsCtl {
+	if interval <= 0 {
+		interval = MetricsIntervalDefault
+	}
+
+	return &MetricsCtl{
+		db:        db,
+		interval:  interval,
+		psTimeout: metricsPortWaitMax,
+		portStats: fibcdbm.NewPortStatsCache(),
+
+		stats: NewMetricsStats(db),
+		log:   log.WithFields(log.Fields{"module": "metrics"}),
+	}
+}
+
+//
+// Start starts to fetch port stats.
+//
+func (c *MetricsCtl) Start(done <-chan struct{}) {
+	go c.serve(done)
+}
+
+func (c *MetricsCtl) serve(done <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.log.Infof("Serve: START. interval:%s", c.interval)
+
+	c.updatePortStats()
+
+FOR_LOOP:
+	for {
+		select {
+		case <-ticker.C:
+			c.updatePortStats()
+
+		case <-done:
+			break FOR_LOOP
+		}
+	}
+
+	c.log.Infof("Serve: EXIT.")
+}
+
+func (c *MetricsCtl) updatePortStats() {
+	dpIDs := map[uint64]struct{}{}
+	c.db.DPSet().Range(func(e fibcdbm.DPEntry) {
+		dpIDs[e.(*DPAPIMonitorEntry).dpID] = struct{}{}
+	})
+
+	c.portStats.Retain(func(dpID uint64) bool {
+		_, ok := dpIDs[dpID]
+		return ok
+	})
+
+	// fetch in parallel not to be stalled by slow datapath.
+	var wg sync.WaitGroup
+	for dpID := range dpIDs {
+		wg.Add(1)
+		go func(dpID uint64) {
+			defer wg.Done()
+
+			stats, err := c.getPortStats(dpID)
+			if err != nil {
+				c.stats.Inc(MetricsStatsPortStatsErr)
+				c.log.Warnf("PortStats: dpid:%d %s", dpID, err)
+				return
+			}
+
+			c.portStats.Set(dpID, stats)
+		}(dpID)
+	}
+
+	wg.Wait()
+}
+
+func (c *MetricsCtl) getPortStats(dpID uint64) ([]*fibcapi.FFPortStats, error) {
+	c.stats.Inc(MetricsStatsPortStats)
+
+	w := NewDBMpWaiter()
+	xid := c.db.Waiters().Register(w)
+	defer c.db.Waiters().Unregister(xid)
+
+	msg := NewDPMonitorReplyMpPort(dpID, metricsPortAll, apCtlPortStatsNames, fibcapi.FFPortStats_GET, xid)
+	if err := c.db.SendDPMonitorReply(dpID, msg); err != nil {
+		return nil, err
+	}
+
+	if err := w.Wait(c.psTimeout); err != nil {
+		return nil, err
+	}
+
+	portReply := w.Reply.GetPort()
+	if portReply == nil {
+		return nil, fmt.Errorf("invalid reply from dp. %d", xid)
+	}
+
+	return portReply.Stats, nil
+}
+
+func (c *MetricsCtl) collectStats(m *fibcdbm.Metrics) {
+	c.db.Stats().Range(func(group, name string, value uint64) {
+		m.Add(metricsPrefix+"stats_total", fibcdbm.MetricsTypeCounter,
+			"Number of messages and events processed by fibcd.",
+			float64(value),
+			fibcdbm.NewMetricLabel("group", group),
+			fibcdbm.NewMetricLabel("name", name),
+		)
+	})
+}
+
+func (c *MetricsCtl) collectConnections(m *fibcdbm.Metrics) {
+	vms := map[string]bool{}
+	dps := map[uint64]bool{}
+
+	c.db.IDMap().Range(func(e *fibcdbm.IDEntry) {
+		vms[e.ReID] = false
+		dps[e.DpID] = false
+	})
+	c.db.VMSet().Range(func(e fibcdbm.DPEntry) {
+		vms[e.(*VMAPIMonitorEntry).reID] = true
+	})
+	c.db.DPSet().Range(func(e fibcdbm.DPEntry) {
+		dps[e.(*DPAPIMonitorEntry).dpID] = true
+	})
+
+	c.db.IDMap().Range(func(e *fibcdbm.IDEntry) {
+		m.Add(metricsPrefix+"vm_connected", fibcdbm.MetricsTypeGauge,
+			"VM (ribcd) is connected.",
+			metricsBool(vms[e.ReID]),
+			newMetricLabelReID(e.ReID),
+			newMetricLabelDpID(e.DpID),
+		)
+		m.Add(metricsPrefix+"dp_connected", fibcdbm.MetricsTypeGauge,
+			"Datapath is connected.",
+			metricsBool(dps[e.DpID]),
+			newMetricLabelReID(e.ReID),
+			newMetricLabelDpID(e.DpID),
+		)
+	})
+
+	vsNum := 0
+	c.db.VSSet().Range(func(fibcdbm.DPEntry) { vsNum++ })
+	m.Add(metricsPrefix+"vs_connections", fibcdbm.MetricsTypeGauge,
+		"Number of virtual switches connected.", float64(vsNum))
+
+	apNum := 0
+	c.db.APSet().Range(func(fibcdbm.DPEntry) { apNum++ })
+	m.Add(metricsPrefix+"ap_connections", fibcdbm.MetricsTypeGauge,
+		"Number of applications monitoring fibcd.", float64(apNum))
+}
+
+func (c *MetricsCtl) collectPorts(m *fibcdbm.Metrics) {
+	c.db.PortMap().Range(func(e *fibcdbm.PortEntry) {
+		dpID := uint64(0)
+		if e.DPPort.IsValid() {
+			dpID = e.DPPort.DpID
+		}
+
+		m.Add(metricsPrefix+"port_associated", fibcdbm.MetricsTypeGauge,
+			"Port of VM is associated with datapath port.",
+			metricsBool(e.IsAssociated()),
+			newMetricLabelReID(e.Key.ReID),
+			newMetricLabelDpID(dpID),
+			newMetricLabelIfname(e.Key.Ifname),
+		)
+	})
+}
+
+func (c *MetricsCtl) collectPortStats(m *fibcdbm.Metrics) {
+	c.portStats.Range(func(e *fibcdbm.PortStatsCacheEntry) {
+		reID, _ := c.db.IDMap().SelectByDpID(e.DpID)
+
+		m.Add(metricsPrefix+"port_stats_updated_seconds", fibcdbm.MetricsTypeGauge,
+			"Time when port stats are fetched from datapath.",
+			float64(e.Time.Unix()),
+			newMetricLabelReID(reID),
+			newMetricLabelDpID(e.DpID),
+		)
+
+		for _, ps := range e.Stats {
+			ifname := ""
+			c.db.PortMap().SelectByDP(e.DpID, ps.PortNo, func(pe *fibcdbm.PortEntry) {
+				ifname = pe.Key.Ifname
+			})
+
+			for _, name := range apCtlPortStatsNames {
+				value, ok := ps.Values[name]
+				if !ok {
+					continue
+				}
+
+				m.Add(metricsPrefix+"port_"+name, fibcdbm.MetricsTypeCounter,
+					fmt.Sprintf("%s of datapath port.", name),
+					float64(value),
+					newMetricLabelReID(reID),
+					newMetricLabelDpID(e.DpID),
+					newMetricLabelIfname(ifname),
+					fibcdbm.NewMetricLabel("port", fmt.Sprintf("%d", ps.PortNo)),
+				)
+			}
+		}
+	})
+}
+
+//
+// Collect returns metrics.
+//
+func (c *MetricsCtl) Collect() *fibcdbm.Metrics {
+	m := fibcdbm.NewMetrics()
+	c.collectStats(m)
+	c.collectConnections(m)
+	c.collectPorts(m)
+	c.collectPortStats(m)
+	return m
+}
+
+//
+// ServeHTTP process scrape request.
+//
+func (c *MetricsCtl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.stats.Inc(MetricsStatsScrape)
+
+	w.Header().Set("Content-Type", fibcdbm.MetricsContentType)
+	if _, err := c.Collect().WriteTo(w); err != nil {
+		c.log.Errorf("ServeHTTP: write error. %s", err)
+	}
+}
@@ -55,13 +55,17 @@ func (c *VMCtl) Hello(hello *fibcapi.Hello) error {
 
 	fibcapi.LogHello(c.log, log.DebugLevel, hello)
 
-	if _, err := c.db.ConvertIDVMtoDP(hello.ReId); err != nil {
+	dpID, err := c.db.ConvertIDVMtoDP(hello.ReId)
+	if err != nil {
 		c.stats.Inc(VMStatsHelloErr)
 
 		c.log.Warnf("Hello: %s", err)
 		return err
 	}
 
+	// vm sends all flows/groups again after hello.
+	c.db.ModTable().Clear(dpID)
+
 	return nil
 }
 
@@ -277,6 +281,12 @@ func (c *VMCtl) leaveVM(reID string) {
 		// c.db.Ports().UnregisterVmKey(e.VmPort.ReId, e.VmPort.PortId) // done by Unregister()
 		c.log.Debugf("leaveVM: vm port deleted. %s", key)
 	}
+
+	if dpID, err := c.db.ConvertIDVMtoDP(reID); err == nil {
+		c.db.ModTable().Clear(dpID)
+
+		c.log.Debugf("leaveVM: mod table cleared. dpid:%d", dpID)
+	}
 }
 
 //
@@ -341,6 +351,8 @@ func (c *VMCtl) FlowMod(mod *fibcapi.FlowMod) error {
 		return err
 	}
 
+	c.db.ModTable().UpdateFlowMod(dpID, mod)
+
 	msg := NewDPMonitorReplyFlowMod(mod)
 	if err := c.db.SendDPMonitorMod(dpID, msg); err != nil {
 		c.stats.Inc(VMStatsFlowModErr)
@@ -368,6 +380,8 @@ func (c *VMCtl) GroupMod(mod *fibcapi.GroupMod) error {
 		return err
 	}
 
+	c.db.ModTable().UpdateGroupMod(dpID, mod)
+
 	msg := NewDPMonitorReplyGroupMod(mod)
 	if err := c.db.SendDPMonitorMod(dpID, msg); err != nil {
 		c.stats.Inc(VMStatsGroupModErr)
@@ -0,0 +1,171 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	fibcapi "fabricflow/fibc/api"
+	ffgrpc "fabricflow/util/grpc"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+//
+// FIBCClient is client of fibcd ap api.
+//
+type FIBCClient struct {
+	addr string
+	auth *ffgrpc.ClientAuth
+
+	log *log.Entry
+}
+
+//
+// NewFIBCClient returns new FIBCClient.
+//
+func NewFIBCClient(addr string, auth *ffgrpc.ClientAuth) *FIBCClient {
+	return &FIBCClient{
+		addr: addr,
+		auth: auth,
+
+		log: log.WithFields(log.Fields{"module": "fibcclient"}),
+	}
+}
+
+func (c *FIBCClient) connect(f func(client fibcapi.FIBCApApiClient) error) error {
+	opts, err := c.auth.DialOptions()
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.Dial(ffgrpc.DialTarget(c.addr), opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return f(fibcapi.NewFIBCApApiClient(conn))
+}
+
+//
+// PortEntries returns port entries of fibcd.
+//
+func (c *FIBCClient) PortEntries() ([]*fibcapi.DbPortEntry, error) {
+	entries := []*fibcapi.DbPortEntry{}
+	err := c.connect(func(client fibcapi.FIBCApApiClient) error {
+		stream, err := client.GetPortEntries(context.Background(), &fibcapi.ApGetPortEntriesRequest{})
+		if err != nil {
+			return err
+		}
+
+		for {
+			e, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, e)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+//
+// PortStats returns stats of all ports of dp.
+//
+func (c *FIBCClient) PortStats(dpID uint64, names []string) ([]*fibcapi.FFPortStats, error) {
+	stats := []*fibcapi.FFPortStats{}
+	err := c.connect(func(client fibcapi.FIBCApApiClient) error {
+		req := fibcapi.ApGetPortStatsRequest{
+			DpId:   dpID,
+			PortNo: 0xffffffff,
+			Names:  names,
+		}
+
+		stream, err := client.GetPortStats(context.Background(), &req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			ps, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			stats = append(stats, ps)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+//
+// Monitor receives port status from fibcd until done is closed
+// or stream is closed.
+//
+func (c *FIBCClient) Monitor(done <-chan struct{}, f func(*fibcapi.ApMonitorReplyPortStatus)) error {
+	return c.connect(func(client fibcapi.FIBCApApiClient) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := client.Monitor(ctx, &fibcapi.ApMonitorRequest{})
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+			cancel()
+		}()
+
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if ps := reply.GetPortStatus(); ps != nil {
+				f(ps)
+			}
+		}
+	})
+}
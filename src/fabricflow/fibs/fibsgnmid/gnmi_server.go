@@ -0,0 +1,159 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	GNMIVersion = "0.7.0"
+)
+
+//
+// GNMIServer is gnmi service for openconfig-interfaces.
+//
+type GNMIServer struct {
+	server *Server
+
+	log *log.Entry
+}
+
+//
+// NewGNMIServer returns new GNMIServer.
+//
+func NewGNMIServer(server *Server) *GNMIServer {
+	return &GNMIServer{
+		server: server,
+
+		log: log.WithFields(log.Fields{"module": "gnmi"}),
+	}
+}
+
+//
+// Capabilities returns supported models and encodings.
+//
+func (s *GNMIServer) Capabilities(ctx context.Context, req *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	return &gnmi.CapabilityResponse{
+		SupportedModels: []*gnmi.ModelData{
+			{
+				Name:         OCInterfacesModel,
+				Organization: OCInterfacesOrg,
+				Version:      OCInterfacesVersion,
+			},
+		},
+		SupportedEncodings: []gnmi.Encoding{
+			gnmi.Encoding_JSON,
+			gnmi.Encoding_JSON_IETF,
+			gnmi.Encoding_PROTO,
+		},
+		GNMIVersion: GNMIVersion,
+	}, nil
+}
+
+//
+// MatchLeaves returns leaves under paths.
+//
+func (s *GNMIServer) MatchLeaves(paths []*gnmi.Path) []*OCLeaf {
+	leaves := []*OCLeaf{}
+	s.server.IfDB().Leaves(func(leaf *OCLeaf) {
+		fullPath := leaf.FullPath()
+		for _, path := range paths {
+			if MatchPath(path, fullPath) {
+				leaves = append(leaves, leaf)
+				return
+			}
+		}
+	})
+
+	return leaves
+}
+
+//
+// Get returns current values of paths.
+//
+func (s *GNMIServer) Get(ctx context.Context, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	switch req.Type {
+	case gnmi.GetRequest_ALL, gnmi.GetRequest_STATE, gnmi.GetRequest_OPERATIONAL:
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "unsupported data type. %s", req.Type)
+	}
+
+	notifs := []*gnmi.Notification{}
+	for _, p := range req.Path {
+		path := JoinPath(req.Prefix, p)
+		leaves := s.MatchLeaves([]*gnmi.Path{path})
+		if len(leaves) == 0 {
+			return nil, status.Errorf(codes.NotFound, "path not found. %s", PathString(path))
+		}
+
+		notifs = append(notifs, NewOCNotifications(leaves)...)
+	}
+
+	s.log.Debugf("Get: paths:%d notifications:%d", len(req.Path), len(notifs))
+
+	return &gnmi.GetResponse{
+		Notification: notifs,
+	}, nil
+}
+
+//
+// Set is not supported.
+//
+func (s *GNMIServer) Set(ctx context.Context, req *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "Set is not supported.")
+}
+
+//
+// Subscribe process subscription.
+//
+func (s *GNMIServer) Subscribe(stream gnmi.GNMI_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	list := req.GetSubscribe()
+	if list == nil {
+		return status.Errorf(codes.InvalidArgument, "first request must be SubscriptionList.")
+	}
+
+	session := NewSubscribeSession(s, stream, list, s.server.UpdTime)
+
+	s.log.Infof("Subscribe: START. mode:%s subs:%d", list.Mode, len(session.subs))
+	defer s.log.Infof("Subscribe: EXIT.")
+
+	switch list.Mode {
+	case gnmi.SubscriptionList_ONCE:
+		return session.ServeOnce()
+
+	case gnmi.SubscriptionList_POLL:
+		return session.ServePoll()
+
+	case gnmi.SubscriptionList_STREAM:
+		return session.ServeStream()
+
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported mode. %s", list.Mode)
+	}
+}
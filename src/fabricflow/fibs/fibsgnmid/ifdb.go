@@ -0,0 +1,266 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// 1.3.6.1.2.1.2.2.1.8 (1-up, 2-down)
+	ifOperStatusUp = 1
+)
+
+type ifDBPortKey struct {
+	DpID   uint64
+	PortNo uint32
+}
+
+//
+// IfEntry is state of interface.
+//
+type IfEntry struct {
+	Name   string
+	ReID   string
+	DpID   uint64
+	PortNo uint32
+
+	OperUp     bool
+	StatusTime int64
+	Counters   map[string]uint64
+	StatsTime  int64
+}
+
+//
+// NewIfEntry returns new IfEntry.
+//
+func NewIfEntry(name, reID string, dpID uint64, portNo uint32) *IfEntry {
+	return &IfEntry{
+		Name:     name,
+		ReID:     reID,
+		DpID:     dpID,
+		PortNo:   portNo,
+		Counters: map[string]uint64{},
+	}
+}
+
+//
+// Leaves returns all leaves of interface.
+//
+func (e *IfEntry) Leaves() []*OCLeaf {
+	leaves := []*OCLeaf{
+		NewOCLeafString(e.Name, e.Name, e.StatusTime, "state", "name"),
+		NewOCLeafOperStatus(e.Name, e.OperUp, e.StatusTime),
+	}
+
+	names := []string{}
+	for name := range e.Counters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		leaves = append(leaves, NewOCLeafCounter(e.Name, name, e.Counters[name], e.StatsTime))
+	}
+
+	return leaves
+}
+
+func (e *IfEntry) updateOperStatus(up bool, t int64) []*OCLeaf {
+	if e.StatusTime != 0 && e.OperUp == up {
+		return nil
+	}
+
+	e.OperUp = up
+	e.StatusTime = t
+	return []*OCLeaf{NewOCLeafOperStatus(e.Name, up, t)}
+}
+
+//
+// IfDB is interface database.
+// interfaces are made from port entries of fibc and
+// identified by ifname.
+//
+type IfDB struct {
+	mutex   sync.RWMutex
+	entries map[string]*IfEntry
+	ports   map[ifDBPortKey]*IfEntry
+}
+
+//
+// NewIfDB returns new IfDB.
+//
+func NewIfDB() *IfDB {
+	return &IfDB{
+		entries: map[string]*IfEntry{},
+		ports:   map[ifDBPortKey]*IfEntry{},
+	}
+}
+
+//
+// SetPortEntries rebuilds interfaces from port entries.
+// state of interfaces which exist already are kept.
+//
+func (db *IfDB) SetPortEntries(portEntries []*fibcapi.DbPortEntry) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entries := map[string]*IfEntry{}
+	ports := map[ifDBPortKey]*IfEntry{}
+
+	for _, pe := range portEntries {
+		if pe.Key == nil || pe.DpPort == nil || pe.DpPort.DpId == 0 || pe.DpPort.PortId == 0 {
+			continue
+		}
+
+		name := pe.Key.Ifname
+		key := ifDBPortKey{DpID: pe.DpPort.DpId, PortNo: pe.DpPort.PortId}
+
+		e, ok := db.entries[name]
+		if !ok || e.DpID != key.DpID || e.PortNo != key.PortNo {
+			e = NewIfEntry(name, pe.Key.ReId, key.DpID, key.PortNo)
+		}
+
+		entries[name] = e
+		ports[key] = e
+	}
+
+	db.entries = entries
+	db.ports = ports
+}
+
+//
+// UpdateStats updates counters by port stats of dp.
+// It returns leaves which are changed.
+//
+func (db *IfDB) UpdateStats(dpID uint64, stats []*fibcapi.FFPortStats, t time.Time) []*OCLeaf {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	ts := t.UnixNano()
+	leaves := []*OCLeaf{}
+
+	for _, ps := range stats {
+		e, ok := db.ports[ifDBPortKey{DpID: dpID, PortNo: ps.PortNo}]
+		if !ok {
+			continue
+		}
+
+		e.StatsTime = ts
+
+		for statsName, value := range ps.Values {
+			name, ok := OCCounterNames[statsName]
+			if !ok {
+				continue
+			}
+
+			if old, ok := e.Counters[name]; ok && old == value {
+				continue
+			}
+
+			e.Counters[name] = value
+			leaves = append(leaves, NewOCLeafCounter(e.Name, name, value, ts))
+		}
+
+		// ifOperStatus of port stats is used until port status is notified.
+		if value, ok := ps.Values["ifOperStatus"]; ok && e.StatusTime == 0 {
+			leaves = append(leaves, e.updateOperStatus(value == ifOperStatusUp, ts)...)
+		}
+	}
+
+	return leaves
+}
+
+//
+// UpdateOperStatus updates oper-status of port.
+// It returns leaves which are changed.
+//
+func (db *IfDB) UpdateOperStatus(dpID uint64, portNo uint32, up bool, t time.Time) []*OCLeaf {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	e, ok := db.ports[ifDBPortKey{DpID: dpID, PortNo: portNo}]
+	if !ok {
+		return nil
+	}
+
+	return e.updateOperStatus(up, t.UnixNano())
+}
+
+//
+// Select returns copy of interface.
+//
+func (db *IfDB) Select(name string) (*IfEntry, bool) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	e, ok := db.entries[name]
+	if !ok {
+		return nil, false
+	}
+
+	c := *e
+	c.Counters = map[string]uint64{}
+	for k, v := range e.Counters {
+		c.Counters[k] = v
+	}
+	return &c, true
+}
+
+//
+// Leaves calls f with leaves of all interfaces in order of name.
+//
+func (db *IfDB) Leaves(f func(*OCLeaf)) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	names := []string{}
+	for name := range db.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, leaf := range db.entries[name].Leaves() {
+			f(leaf)
+		}
+	}
+}
+
+//
+// DpIDs returns dpids which have interfaces.
+//
+func (db *IfDB) DpIDs() []uint64 {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	dpIDs := []uint64{}
+	m := map[uint64]struct{}{}
+	for key := range db.ports {
+		if _, ok := m[key.DpID]; !ok {
+			m[key.DpID] = struct{}{}
+			dpIDs = append(dpIDs, key.DpID)
+		}
+	}
+
+	sort.Slice(dpIDs, func(i, j int) bool { return dpIDs[i] < dpIDs[j] })
+	return dpIDs
+}
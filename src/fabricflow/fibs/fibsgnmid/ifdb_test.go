@@ -0,0 +1,116 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"testing"
+	"time"
+)
+
+func testPortEntry(reID, ifname string, dpID uint64, portNo uint32) *fibcapi.DbPortEntry {
+	return &fibcapi.DbPortEntry{
+		Key:    &fibcapi.DbPortKey{ReId: reID, Ifname: ifname},
+		DpPort: &fibcapi.DbPortValue{DpId: dpID, PortId: portNo},
+	}
+}
+
+func testIfDB() *IfDB {
+	db := NewIfDB()
+	db.SetPortEntries([]*fibcapi.DbPortEntry{
+		testPortEntry("1.1.1.1", "eth1", 10, 1),
+		testPortEntry("1.1.1.1", "eth2", 10, 2),
+		testPortEntry("1.1.1.1", "eth2.10", 0, 0),
+	})
+	return db
+}
+
+func TestIfDB_SetPortEntries(t *testing.T) {
+	db := testIfDB()
+
+	if e, ok := db.Select("eth2"); !ok || e.DpID != 10 || e.PortNo != 2 {
+		t.Errorf("SetPortEntries unmatch. %v", e)
+	}
+
+	if _, ok := db.Select("eth2.10"); ok {
+		t.Errorf("SetPortEntries must skip port without dp.")
+	}
+
+	if dpIDs := db.DpIDs(); len(dpIDs) != 1 || dpIDs[0] != 10 {
+		t.Errorf("DpIDs unmatch. %v", dpIDs)
+	}
+}
+
+func TestIfDB_UpdateStats(t *testing.T) {
+	db := testIfDB()
+	now := time.Now()
+
+	stats := []*fibcapi.FFPortStats{
+		{PortNo: 1, Values: map[string]uint64{"ifHCInOctets": 100, "ifOperStatus": 1, "unknown": 1}},
+		{PortNo: 3, Values: map[string]uint64{"ifHCInOctets": 100}},
+	}
+
+	leaves := db.UpdateStats(10, stats, now)
+	if len(leaves) != 2 {
+		t.Errorf("UpdateStats unmatch. %v", leaves)
+	}
+
+	e, _ := db.Select("eth1")
+	if v := e.Counters["in-octets"]; v != 100 {
+		t.Errorf("UpdateStats counter unmatch. %d", v)
+	}
+	if !e.OperUp {
+		t.Errorf("UpdateStats oper-status unmatch. %v", e)
+	}
+
+	if leaves := db.UpdateStats(10, stats, now); len(leaves) != 0 {
+		t.Errorf("UpdateStats must not change. %v", leaves)
+	}
+
+	stats[0].Values["ifHCInOctets"] = 200
+	if leaves := db.UpdateStats(10, stats, now); len(leaves) != 1 || leaves[0].Value.GetUintVal() != 200 {
+		t.Errorf("UpdateStats unmatch. %v", leaves)
+	}
+}
+
+func TestIfDB_UpdateOperStatus(t *testing.T) {
+	db := testIfDB()
+	now := time.Now()
+
+	if leaves := db.UpdateOperStatus(10, 2, true, now); len(leaves) != 1 {
+		t.Errorf("UpdateOperStatus unmatch. %v", leaves)
+	}
+	if leaves := db.UpdateOperStatus(10, 2, true, now); len(leaves) != 0 {
+		t.Errorf("UpdateOperStatus must not change. %v", leaves)
+	}
+	if leaves := db.UpdateOperStatus(10, 2, false, now); len(leaves) != 1 || leaves[0].Value.GetStringVal() != OCOperStatusDown {
+		t.Errorf("UpdateOperStatus unmatch. %v", leaves)
+	}
+	if leaves := db.UpdateOperStatus(10, 9, false, now); len(leaves) != 0 {
+		t.Errorf("UpdateOperStatus must ignore unknown port. %v", leaves)
+	}
+
+	// port stats must not overwrite notified oper-status.
+	stats := []*fibcapi.FFPortStats{
+		{PortNo: 2, Values: map[string]uint64{"ifOperStatus": 1}},
+	}
+	db.UpdateStats(10, stats, now)
+	if e, _ := db.Select("eth2"); e.OperUp {
+		t.Errorf("UpdateStats must not overwrite oper-status. %v", e)
+	}
+}
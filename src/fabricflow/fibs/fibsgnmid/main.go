@@ -0,0 +1,138 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	ffgrpc "fabricflow/util/grpc"
+	"net"
+	"os"
+	"time"
+
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+	log "github.com/sirupsen/logrus"
+	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+)
+
+//
+// Args is arguments.
+//
+type Args struct {
+	Addr       string
+	FibcAddr   string
+	FibcCAFile string
+	FibcToken  string
+	UpdTime    time.Duration
+
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+	Token       string
+
+	Verbose bool
+	Trace   bool
+}
+
+//
+// NewArgs returns new argument.
+//
+func NewArgs() *Args {
+	args := Args{}
+	args.Init()
+	return &args
+}
+
+//
+// Init parse and get arguments.
+//
+func (a *Args) Init() {
+	flag.StringVarP(&a.Addr, "listen-addr", "l", "0.0.0.0:9339", "gnmi listen address.")
+	flag.StringVarP(&a.FibcAddr, "fibc-addr", "a", "localhost:50070", "fibc address.")
+	flag.StringVarP(&a.FibcCAFile, "fibc-tls-ca-file", "", "", "CA file to verify fibc certificate.")
+	flag.StringVarP(&a.FibcToken, "fibc-token", "", "", "token of ap role of fibc.")
+	flag.DurationVarP(&a.UpdTime, "update-time", "u", serverUpdateTimeDflt, "update stats interval time.")
+	flag.StringVarP(&a.TLSCertFile, "tls-cert-file", "", "", "server certificate file.")
+	flag.StringVarP(&a.TLSKeyFile, "tls-key-file", "", "", "server private key file.")
+	flag.StringVarP(&a.TLSCAFile, "tls-ca-file", "", "", "CA file to verify client certificate.")
+	flag.StringVarP(&a.Token, "token", "", "", "token required to gnmi clients.")
+	flag.BoolVarP(&a.Verbose, "verbose", "v", false, "show detail messages.")
+	flag.BoolVarP(&a.Trace, "trace", "", false, "show more detail messages.")
+
+	flag.Parse()
+}
+
+func run(args *Args) error {
+	fibcAuth := &ffgrpc.ClientAuth{
+		CAFile: args.FibcCAFile,
+		Token:  args.FibcToken,
+	}
+
+	auth := ffgrpc.NewServerAuth(func(string) string { return "gnmi" })
+	auth.CertFile = args.TLSCertFile
+	auth.KeyFile = args.TLSKeyFile
+	auth.CAFile = args.TLSCAFile
+	if len(args.Token) != 0 {
+		auth.Tokens["gnmi"] = args.Token
+	}
+
+	log.Infof("fibc auth: %s", fibcAuth)
+	log.Infof("gnmi auth: %s", auth)
+
+	opts, err := auth.ServerOptions()
+	if err != nil {
+		log.Errorf("Auth option error. %s", err)
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	s := NewServer(NewFIBCClient(args.FibcAddr, fibcAuth), args.UpdTime)
+	s.Start(done)
+
+	lis, err := net.Listen("tcp", args.Addr)
+	if err != nil {
+		log.Errorf("Listen error. %s %s", args.Addr, err)
+		return err
+	}
+
+	gs := grpc.NewServer(opts...)
+	gnmi.RegisterGNMIServer(gs, NewGNMIServer(s))
+
+	log.Infof("gnmi server started. %s", args.Addr)
+
+	return gs.Serve(lis)
+}
+
+func main() {
+	args := NewArgs()
+
+	if args.Trace {
+		log.SetLevel(log.TraceLevel)
+	} else if args.Verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	log.Infof("Args: listen = '%s'", args.Addr)
+	log.Infof("Args: fibc   = '%s'", args.FibcAddr)
+	log.Infof("Args: update = %s", args.UpdTime)
+
+	if err := run(args); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,270 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const (
+	OCInterfacesModel   = "openconfig-interfaces"
+	OCInterfacesOrg     = "OpenConfig working group"
+	OCInterfacesVersion = "2.4.1"
+
+	OCOperStatusUp   = "UP"
+	OCOperStatusDown = "DOWN"
+
+	ocPathInterfaces = "interfaces"
+	ocPathInterface  = "interface"
+	ocPathNameKey    = "name"
+	ocPathWildcard   = "*"
+	ocPathAnyDepth   = "..."
+)
+
+//
+// OCCounterNames is map of fibc port stats name and
+// openconfig-interfaces counter name.
+//
+var OCCounterNames = map[string]string{
+	"ifHCInOctets":         "in-octets",
+	"ifHCInUcastPkts":      "in-unicast-pkts",
+	"ifHCInMulticastPkts":  "in-multicast-pkts",
+	"ifHCInBroadcastPkts":  "in-broadcast-pkts",
+	"ifInDiscards":         "in-discards",
+	"ifInErrors":           "in-errors",
+	"ifInUnknownProtos":    "in-unknown-protos",
+	"ifHCOutOctets":        "out-octets",
+	"ifHCOutUcastPkts":     "out-unicast-pkts",
+	"ifHCOutMulticastPkts": "out-multicast-pkts",
+	"ifHCOutBroadcastPkts": "out-broadcast-pkts",
+	"ifOutDiscards":        "out-discards",
+	"ifOutErrors":          "out-errors",
+}
+
+//
+// OCPortStatsNames returns names of port stats requested to fibc.
+//
+func OCPortStatsNames() []string {
+	names := []string{}
+	for name := range OCCounterNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//
+// OCLeaf is leaf of /interfaces/interface[name=Ifname].
+//
+type OCLeaf struct {
+	Ifname  string
+	Elems   []string
+	Value   *gnmi.TypedValue
+	Counter bool
+	Time    int64
+}
+
+//
+// NewOCLeafCounter returns leaf of state/counters/<name>.
+//
+func NewOCLeafCounter(ifname string, name string, value uint64, t int64) *OCLeaf {
+	return &OCLeaf{
+		Ifname:  ifname,
+		Elems:   []string{"state", "counters", name},
+		Value:   &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: value}},
+		Counter: true,
+		Time:    t,
+	}
+}
+
+//
+// NewOCLeafString returns leaf of string value.
+//
+func NewOCLeafString(ifname string, value string, t int64, elems ...string) *OCLeaf {
+	return &OCLeaf{
+		Ifname: ifname,
+		Elems:  elems,
+		Value:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: value}},
+		Time:   t,
+	}
+}
+
+//
+// NewOCLeafOperStatus returns leaf of state/oper-status.
+//
+func NewOCLeafOperStatus(ifname string, up bool, t int64) *OCLeaf {
+	status := OCOperStatusDown
+	if up {
+		status = OCOperStatusUp
+	}
+
+	return NewOCLeafString(ifname, status, t, "state", "oper-status")
+}
+
+//
+// Path returns path relative to interface.
+//
+func (l *OCLeaf) Path() *gnmi.Path {
+	elems := make([]*gnmi.PathElem, len(l.Elems))
+	for index, name := range l.Elems {
+		elems[index] = &gnmi.PathElem{Name: name}
+	}
+	return &gnmi.Path{Elem: elems}
+}
+
+//
+// FullPath returns absolute path of leaf.
+//
+func (l *OCLeaf) FullPath() *gnmi.Path {
+	return JoinPath(NewOCInterfacePath(l.Ifname), l.Path())
+}
+
+//
+// String returns absolute path string.
+//
+func (l *OCLeaf) String() string {
+	return PathString(l.FullPath())
+}
+
+//
+// NewOCInterfacePath returns path of /interfaces/interface[name=ifname].
+//
+func NewOCInterfacePath(ifname string) *gnmi.Path {
+	return &gnmi.Path{
+		Elem: []*gnmi.PathElem{
+			{Name: ocPathInterfaces},
+			{Name: ocPathInterface, Key: map[string]string{ocPathNameKey: ifname}},
+		},
+	}
+}
+
+//
+// JoinPath returns prefix + path.
+//
+func JoinPath(prefix, path *gnmi.Path) *gnmi.Path {
+	p := &gnmi.Path{}
+	if prefix != nil {
+		p.Origin = prefix.Origin
+		p.Target = prefix.Target
+		p.Elem = append(p.Elem, prefix.Elem...)
+	}
+	if path != nil {
+		if len(path.Origin) != 0 {
+			p.Origin = path.Origin
+		}
+		p.Elem = append(p.Elem, path.Elem...)
+	}
+	return p
+}
+
+//
+// PathString returns string of path. (e.g. /a/b[k=v]/c)
+//
+func PathString(p *gnmi.Path) string {
+	if p == nil || len(p.Elem) == 0 {
+		return "/"
+	}
+
+	var b strings.Builder
+	for _, elem := range p.Elem {
+		b.WriteString("/")
+		b.WriteString(elem.Name)
+
+		keys := []string{}
+		for key := range elem.Key {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "[%s=%s]", key, elem.Key[key])
+		}
+	}
+	return b.String()
+}
+
+//
+// MatchPath returns true if path is under pattern.
+// pattern may contain wildcard name or key ("*")
+// and multi-level wildcard ("...").
+//
+func MatchPath(pattern, path *gnmi.Path) bool {
+	if pattern == nil {
+		return true
+	}
+
+	for index, pelem := range pattern.Elem {
+		if pelem.Name == ocPathAnyDepth {
+			return true
+		}
+
+		if index >= len(path.Elem) {
+			return false
+		}
+
+		elem := path.Elem[index]
+		if pelem.Name != ocPathWildcard && pelem.Name != elem.Name {
+			return false
+		}
+
+		for key, pval := range pelem.Key {
+			if pval == ocPathWildcard {
+				continue
+			}
+			if val, ok := elem.Key[key]; !ok || val != pval {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//
+// NewOCNotifications returns notifications grouped by interface.
+//
+func NewOCNotifications(leaves []*OCLeaf) []*gnmi.Notification {
+	notifs := []*gnmi.Notification{}
+	index := map[string]*gnmi.Notification{}
+
+	for _, leaf := range leaves {
+		n, ok := index[leaf.Ifname]
+		if !ok {
+			n = &gnmi.Notification{
+				Prefix: NewOCInterfacePath(leaf.Ifname),
+				Update: []*gnmi.Update{},
+			}
+			index[leaf.Ifname] = n
+			notifs = append(notifs, n)
+		}
+
+		if n.Timestamp < leaf.Time {
+			n.Timestamp = leaf.Time
+		}
+
+		n.Update = append(n.Update, &gnmi.Update{
+			Path: leaf.Path(),
+			Val:  leaf.Value,
+		})
+	}
+
+	return notifs
+}
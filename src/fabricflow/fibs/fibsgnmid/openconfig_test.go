@@ -0,0 +1,115 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func testPath(elems ...*gnmi.PathElem) *gnmi.Path {
+	return &gnmi.Path{Elem: elems}
+}
+
+func testElem(name string, kvs ...string) *gnmi.PathElem {
+	e := &gnmi.PathElem{Name: name}
+	if len(kvs) != 0 {
+		e.Key = map[string]string{}
+		for i := 0; i+1 < len(kvs); i += 2 {
+			e.Key[kvs[i]] = kvs[i+1]
+		}
+	}
+	return e
+}
+
+func TestPathString(t *testing.T) {
+	leaf := NewOCLeafCounter("eth1", "in-octets", 10, 0)
+	if s := leaf.String(); s != "/interfaces/interface[name=eth1]/state/counters/in-octets" {
+		t.Errorf("PathString unmatch. %s", s)
+	}
+
+	if s := PathString(nil); s != "/" {
+		t.Errorf("PathString unmatch. %s", s)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	leaf := NewOCLeafCounter("eth1", "in-octets", 10, 0).FullPath()
+
+	matches := []*gnmi.Path{
+		nil,
+		testPath(),
+		testPath(testElem("interfaces")),
+		testPath(testElem("interfaces"), testElem("interface")),
+		testPath(testElem("interfaces"), testElem("interface", "name", "eth1")),
+		testPath(testElem("interfaces"), testElem("interface", "name", "*")),
+		testPath(testElem("interfaces"), testElem("*"), testElem("state")),
+		testPath(testElem("interfaces"), testElem("..."), testElem("in-octets")),
+		leaf,
+	}
+	for _, p := range matches {
+		if !MatchPath(p, leaf) {
+			t.Errorf("MatchPath must match. %s", PathString(p))
+		}
+	}
+
+	unmatches := []*gnmi.Path{
+		testPath(testElem("network-instances")),
+		testPath(testElem("interfaces"), testElem("interface", "name", "eth2")),
+		testPath(testElem("interfaces"), testElem("interface"), testElem("config")),
+		JoinPath(leaf, testPath(testElem("value"))),
+	}
+	for _, p := range unmatches {
+		if MatchPath(p, leaf) {
+			t.Errorf("MatchPath must not match. %s", PathString(p))
+		}
+	}
+}
+
+func TestNewOCNotifications(t *testing.T) {
+	leaves := []*OCLeaf{
+		NewOCLeafOperStatus("eth1", true, 100),
+		NewOCLeafCounter("eth2", "in-octets", 10, 200),
+		NewOCLeafCounter("eth1", "in-octets", 20, 300),
+	}
+
+	notifs := NewOCNotifications(leaves)
+	if len(notifs) != 2 {
+		t.Fatalf("NewOCNotifications unmatch. %v", notifs)
+	}
+
+	if s := PathString(notifs[0].Prefix); s != "/interfaces/interface[name=eth1]" {
+		t.Errorf("NewOCNotifications prefix unmatch. %s", s)
+	}
+	if n := notifs[0].Timestamp; n != 300 {
+		t.Errorf("NewOCNotifications timestamp unmatch. %d", n)
+	}
+	if n := len(notifs[0].Update); n != 2 {
+		t.Errorf("NewOCNotifications updates unmatch. %d", n)
+	}
+	if s := PathString(notifs[0].Update[0].Path); s != "/state/oper-status" {
+		t.Errorf("NewOCNotifications path unmatch. %s", s)
+	}
+	if v := notifs[0].Update[0].Val.GetStringVal(); v != OCOperStatusUp {
+		t.Errorf("NewOCNotifications value unmatch. %s", v)
+	}
+	if v := notifs[1].Update[0].Val.GetUintVal(); v != 10 {
+		t.Errorf("NewOCNotifications value unmatch. %d", v)
+	}
+}
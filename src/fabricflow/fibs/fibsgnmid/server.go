@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	serverWatchChanSize  = 1024
+	serverMonitorRetry   = 5 * time.Second
+	serverUpdateTimeMin  = 1 * time.Second
+	serverUpdateTimeDflt = 10 * time.Second
+)
+
+//
+// Server collects port states from fibcd.
+//
+type Server struct {
+	fibc       *FIBCClient
+	db         *IfDB
+	UpdTime    time.Duration
+	StatsNames []string
+
+	mutex    sync.Mutex
+	watchID  uint64
+	watchers map[uint64]chan []*OCLeaf
+
+	log *log.Entry
+}
+
+//
+// NewServer returns new Server.
+//
+func NewServer(fibc *FIBCClient, updTime time.Duration) *Server {
+	if updTime < serverUpdateTimeMin {
+		updTime = serverUpdateTimeDflt
+	}
+
+	return &Server{
+		fibc:       fibc,
+		db:         NewIfDB(),
+		UpdTime:    updTime,
+		StatsNames: OCPortStatsNames(),
+		watchers:   map[uint64]chan []*OCLeaf{},
+
+		log: log.WithFields(log.Fields{"module": "server"}),
+	}
+}
+
+//
+// IfDB returns interface database.
+//
+func (s *Server) IfDB() *IfDB {
+	return s.db
+}
+
+//
+// Watch registers channel which receives changed leaves.
+//
+func (s *Server) Watch() (uint64, <-chan []*OCLeaf) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.watchID++
+	ch := make(chan []*OCLeaf, serverWatchChanSize)
+	s.watchers[s.watchID] = ch
+	return s.watchID, ch
+}
+
+//
+// Unwatch unregisters channel.
+//
+func (s *Server) Unwatch(id uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if ch, ok := s.watchers[id]; ok {
+		delete(s.watchers, id)
+		close(ch)
+	}
+}
+
+func (s *Server) publish(leaves []*OCLeaf) {
+	if len(leaves) == 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, ch := range s.watchers {
+		select {
+		case ch <- leaves:
+		default:
+			s.log.Warnf("publish: watcher busy. id:%d", id)
+		}
+	}
+}
+
+//
+// Start starts to collect port states.
+//
+func (s *Server) Start(done <-chan struct{}) {
+	s.Update()
+
+	go s.serveUpdate(done)
+	go s.serveMonitor(done)
+
+	s.log.Infof("Server: started.")
+}
+
+func (s *Server) serveUpdate(done <-chan struct{}) {
+	ticker := time.NewTicker(s.UpdTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Update()
+
+		case <-done:
+			s.log.Infof("Update: EXIT.")
+			return
+		}
+	}
+}
+
+//
+// Update gets port entries and port stats from fibcd.
+//
+func (s *Server) Update() {
+	entries, err := s.fibc.PortEntries()
+	if err != nil {
+		s.log.Errorf("Update: PortEntries error. %s", err)
+		return
+	}
+
+	s.db.SetPortEntries(entries)
+
+	for _, dpID := range s.db.DpIDs() {
+		stats, err := s.fibc.PortStats(dpID, s.StatsNames)
+		if err != nil {
+			s.log.Errorf("Update: PortStats error. dpid:%d %s", dpID, err)
+			continue
+		}
+
+		leaves := s.db.UpdateStats(dpID, stats, time.Now())
+
+		s.log.Debugf("Update: dpid:%d ports:%d changed:%d", dpID, len(stats), len(leaves))
+
+		s.publish(leaves)
+	}
+}
+
+func (s *Server) serveMonitor(done <-chan struct{}) {
+	for {
+		err := s.fibc.Monitor(done, func(ps *fibcapi.ApMonitorReplyPortStatus) {
+			s.log.Debugf("PortStatus: dpid:%d port:%d %s", ps.DpId, ps.PortNo, ps.Status)
+
+			up := ps.Status == fibcapi.PortStatus_UP
+			s.publish(s.db.UpdateOperStatus(ps.DpId, ps.PortNo, up, time.Now()))
+		})
+
+		select {
+		case <-done:
+			s.log.Infof("Monitor: EXIT.")
+			return
+		default:
+		}
+
+		s.log.Warnf("Monitor: disconnected. %v", err)
+
+		select {
+		case <-time.After(serverMonitorRetry):
+		case <-done:
+			s.log.Infof("Monitor: EXIT.")
+			return
+		}
+	}
+}
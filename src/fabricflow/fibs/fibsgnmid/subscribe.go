@@ -0,0 +1,353 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subscribeTickTime = 1 * time.Second
+)
+
+type ocLeafFilter int
+
+const (
+	ocLeafAny ocLeafFilter = iota
+	ocLeafCounter
+	ocLeafState
+)
+
+func (f ocLeafFilter) match(leaf *OCLeaf) bool {
+	switch f {
+	case ocLeafCounter:
+		return leaf.Counter
+	case ocLeafState:
+		return !leaf.Counter
+	default:
+		return true
+	}
+}
+
+//
+// subscription is ON_CHANGE or SAMPLE subscription.
+// TARGET_DEFINED is converted to SAMPLE for counters and
+// ON_CHANGE for others.
+//
+type subscription struct {
+	path      *gnmi.Path
+	mode      gnmi.SubscriptionMode
+	filter    ocLeafFilter
+	interval  time.Duration
+	suppress  bool
+	heartbeat time.Duration
+
+	next     time.Time
+	lastSent time.Time
+	sent     map[string]*gnmi.TypedValue
+}
+
+func newSubscriptions(list *gnmi.SubscriptionList, minInterval time.Duration) []*subscription {
+	durationOf := func(v uint64) time.Duration {
+		d := time.Duration(v)
+		if d != 0 && d < minInterval {
+			return minInterval
+		}
+		return d
+	}
+
+	subs := []*subscription{}
+	for _, sub := range list.Subscription {
+		path := JoinPath(list.Prefix, sub.Path)
+		interval := durationOf(sub.SampleInterval)
+		if interval == 0 {
+			interval = minInterval
+		}
+
+		sample := &subscription{
+			path:      path,
+			mode:      gnmi.SubscriptionMode_SAMPLE,
+			interval:  interval,
+			suppress:  sub.SuppressRedundant,
+			heartbeat: durationOf(sub.HeartbeatInterval),
+			sent:      map[string]*gnmi.TypedValue{},
+		}
+
+		onChange := &subscription{
+			path: path,
+			mode: gnmi.SubscriptionMode_ON_CHANGE,
+		}
+
+		switch sub.Mode {
+		case gnmi.SubscriptionMode_SAMPLE:
+			subs = append(subs, sample)
+
+		case gnmi.SubscriptionMode_ON_CHANGE:
+			subs = append(subs, onChange)
+
+		default:
+			sample.filter = ocLeafCounter
+			onChange.filter = ocLeafState
+			subs = append(subs, sample, onChange)
+		}
+	}
+
+	return subs
+}
+
+func (s *subscription) match(leaf *OCLeaf) bool {
+	return s.filter.match(leaf) && MatchPath(s.path, leaf.FullPath())
+}
+
+//
+// sample returns leaves to be sent at now.
+//
+func (s *subscription) sample(now time.Time, leaves []*OCLeaf) []*OCLeaf {
+	if s.mode != gnmi.SubscriptionMode_SAMPLE || now.Before(s.next) {
+		return nil
+	}
+
+	s.next = now.Add(s.interval)
+
+	heartbeat := s.heartbeat != 0 && now.Sub(s.lastSent) >= s.heartbeat
+	samples := []*OCLeaf{}
+	for _, leaf := range leaves {
+		if !s.match(leaf) {
+			continue
+		}
+
+		key := leaf.String()
+		if s.suppress && !heartbeat {
+			if val, ok := s.sent[key]; ok && proto.Equal(val, leaf.Value) {
+				continue
+			}
+		}
+
+		s.sent[key] = leaf.Value
+		samples = append(samples, leaf)
+	}
+
+	if len(samples) != 0 {
+		s.lastSent = now
+	}
+
+	return samples
+}
+
+//
+// SubscribeSession is session of Subscribe rpc.
+//
+type SubscribeSession struct {
+	gnmi   *GNMIServer
+	stream gnmi.GNMI_SubscribeServer
+	list   *gnmi.SubscriptionList
+	subs   []*subscription
+
+	log *log.Entry
+}
+
+//
+// NewSubscribeSession returns new SubscribeSession.
+// sample interval less than minInterval is rounded up to minInterval.
+//
+func NewSubscribeSession(s *GNMIServer, stream gnmi.GNMI_SubscribeServer, list *gnmi.SubscriptionList, minInterval time.Duration) *SubscribeSession {
+	return &SubscribeSession{
+		gnmi:   s,
+		stream: stream,
+		list:   list,
+		subs:   newSubscriptions(list, minInterval),
+
+		log: s.log,
+	}
+}
+
+func (s *SubscribeSession) leaves() []*OCLeaf {
+	leaves := []*OCLeaf{}
+	s.gnmi.server.IfDB().Leaves(func(leaf *OCLeaf) {
+		leaves = append(leaves, leaf)
+	})
+	return leaves
+}
+
+func (s *SubscribeSession) matchLeaves(leaves []*OCLeaf, mode gnmi.SubscriptionMode) []*OCLeaf {
+	matches := []*OCLeaf{}
+	for _, leaf := range leaves {
+		for _, sub := range s.subs {
+			if (mode == sub.mode || mode == gnmi.SubscriptionMode_TARGET_DEFINED) && sub.match(leaf) {
+				matches = append(matches, leaf)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func (s *SubscribeSession) send(leaves []*OCLeaf) error {
+	for _, n := range NewOCNotifications(leaves) {
+		resp := gnmi.SubscribeResponse{
+			Response: &gnmi.SubscribeResponse_Update{Update: n},
+		}
+		if err := s.stream.Send(&resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SubscribeSession) sendSync() error {
+	resp := gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true},
+	}
+	return s.stream.Send(&resp)
+}
+
+func (s *SubscribeSession) sendAll() error {
+	leaves := s.matchLeaves(s.leaves(), gnmi.SubscriptionMode_TARGET_DEFINED)
+	if err := s.send(leaves); err != nil {
+		return err
+	}
+
+	return s.sendSync()
+}
+
+//
+// ServeOnce sends all values and sync response.
+//
+func (s *SubscribeSession) ServeOnce() error {
+	return s.sendAll()
+}
+
+//
+// ServePoll sends all values each time poll request is received.
+//
+func (s *SubscribeSession) ServePoll() error {
+	if err := s.sendAll(); err != nil {
+		return err
+	}
+
+	for {
+		req, err := s.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.GetPoll() == nil {
+			return status.Errorf(codes.InvalidArgument, "poll request expected.")
+		}
+
+		if err := s.sendAll(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *SubscribeSession) sample(now time.Time) error {
+	leaves := s.leaves()
+	samples := []*OCLeaf{}
+	sampled := map[string]struct{}{}
+
+	for _, sub := range s.subs {
+		for _, leaf := range sub.sample(now, leaves) {
+			key := leaf.String()
+			if _, ok := sampled[key]; !ok {
+				sampled[key] = struct{}{}
+				samples = append(samples, leaf)
+			}
+		}
+	}
+
+	return s.send(samples)
+}
+
+//
+// ServeStream sends values by SAMPLE and ON_CHANGE subscriptions.
+//
+func (s *SubscribeSession) ServeStream() error {
+	watchID, watchCh := s.gnmi.server.Watch()
+	defer s.gnmi.server.Unwatch(watchID)
+
+	now := time.Now()
+	if s.list.UpdatesOnly {
+		for _, sub := range s.subs {
+			sub.next = now.Add(sub.interval)
+		}
+		if err := s.sendSync(); err != nil {
+			return err
+		}
+	} else {
+		if err := s.sample(now); err != nil {
+			return err
+		}
+		if err := s.send(s.matchLeaves(s.leaves(), gnmi.SubscriptionMode_ON_CHANGE)); err != nil {
+			return err
+		}
+		if err := s.sendSync(); err != nil {
+			return err
+		}
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			if _, err := s.stream.Recv(); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(subscribeTickTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case leaves, ok := <-watchCh:
+			if !ok {
+				return nil
+			}
+			if err := s.send(s.matchLeaves(leaves, gnmi.SubscriptionMode_ON_CHANGE)); err != nil {
+				return err
+			}
+
+		case now := <-ticker.C:
+			if err := s.sample(now); err != nil {
+				return err
+			}
+
+		case err := <-errCh:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case <-s.stream.Context().Done():
+			return s.stream.Context().Err()
+		}
+	}
+}
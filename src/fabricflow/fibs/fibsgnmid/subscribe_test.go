@@ -0,0 +1,101 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestNewSubscriptions_TargetDefined(t *testing.T) {
+	list := &gnmi.SubscriptionList{
+		Prefix: testPath(testElem("interfaces")),
+		Subscription: []*gnmi.Subscription{
+			{Path: testPath(testElem("interface")), Mode: gnmi.SubscriptionMode_TARGET_DEFINED},
+		},
+	}
+
+	subs := newSubscriptions(list, 10*time.Second)
+	if len(subs) != 2 {
+		t.Fatalf("newSubscriptions unmatch. %v", subs)
+	}
+
+	counter := NewOCLeafCounter("eth1", "in-octets", 10, 0)
+	status := NewOCLeafOperStatus("eth1", true, 0)
+
+	if sub := subs[0]; sub.mode != gnmi.SubscriptionMode_SAMPLE || !sub.match(counter) || sub.match(status) {
+		t.Errorf("newSubscriptions sample unmatch. %v", sub)
+	}
+	if sub := subs[1]; sub.mode != gnmi.SubscriptionMode_ON_CHANGE || sub.match(counter) || !sub.match(status) {
+		t.Errorf("newSubscriptions on_change unmatch. %v", sub)
+	}
+	if d := subs[0].interval; d != 10*time.Second {
+		t.Errorf("newSubscriptions interval unmatch. %s", d)
+	}
+}
+
+func TestSubscription_Sample(t *testing.T) {
+	list := &gnmi.SubscriptionList{
+		Subscription: []*gnmi.Subscription{
+			{
+				Mode:              gnmi.SubscriptionMode_SAMPLE,
+				SampleInterval:    uint64(time.Second),
+				SuppressRedundant: true,
+				HeartbeatInterval: uint64(time.Minute),
+			},
+		},
+	}
+
+	subs := newSubscriptions(list, 10*time.Second)
+	if len(subs) != 1 {
+		t.Fatalf("newSubscriptions unmatch. %v", subs)
+	}
+
+	sub := subs[0]
+	if sub.interval != 10*time.Second {
+		t.Errorf("interval must be rounded up. %s", sub.interval)
+	}
+
+	now := time.Now()
+	leaves := []*OCLeaf{
+		NewOCLeafCounter("eth1", "in-octets", 10, 0),
+		NewOCLeafCounter("eth1", "out-octets", 10, 0),
+	}
+
+	if samples := sub.sample(now, leaves); len(samples) != 2 {
+		t.Errorf("sample unmatch. %v", samples)
+	}
+
+	now = now.Add(5 * time.Second)
+	if samples := sub.sample(now, leaves); len(samples) != 0 {
+		t.Errorf("sample must wait interval. %v", samples)
+	}
+
+	now = now.Add(5 * time.Second)
+	leaves[0] = NewOCLeafCounter("eth1", "in-octets", 20, 0)
+	if samples := sub.sample(now, leaves); len(samples) != 1 {
+		t.Errorf("sample must suppress redundant. %v", samples)
+	}
+
+	now = now.Add(time.Minute)
+	if samples := sub.sample(now, leaves); len(samples) != 2 {
+		t.Errorf("sample must send at heartbeat. %v", samples)
+	}
+}
@@ -19,9 +19,11 @@ package main
 
 import (
 	"fabricflow/ribc/ribctl"
+	ffgrpc "fabricflow/util/grpc"
 	"fmt"
 
 	"github.com/BurntSushi/toml"
+	"golang.org/x/sys/unix"
 )
 
 const LABEL_BASE_DEFAULT = 0xfff00
@@ -51,12 +53,28 @@ type RibcConfig struct {
 	Fibc     string `toml:"fibc"`
 	FibcType string `toml:"fibc_type"`
 	Disable  bool   `toml:"disable"`
+
+	FibcTLSCA         string `toml:"fibc_tls_ca"`
+	FibcTLSCert       string `toml:"fibc_tls_cert"`
+	FibcTLSKey        string `toml:"fibc_tls_key"`
+	FibcTLSServerName string `toml:"fibc_tls_server_name"`
+	FibcToken         string `toml:"fibc_token"`
 }
 
 func (c *RibcConfig) String() string {
 	return fmt.Sprintf("fibc:'%s' type:'%s' disable:%t", c.Fibc, c.FibcType, c.Disable)
 }
 
+func (c *RibcConfig) GetFibcAuth() *ffgrpc.ClientAuth {
+	return &ffgrpc.ClientAuth{
+		CAFile:     c.FibcTLSCA,
+		CertFile:   c.FibcTLSCert,
+		KeyFile:    c.FibcTLSKey,
+		ServerName: c.FibcTLSServerName,
+		Token:      c.FibcToken,
+	}
+}
+
 func (c *RibcConfig) GetFibcType() string {
 	if len(c.FibcType) == 0 {
 		return ribctl.FIBCTypeDefault
@@ -64,14 +82,43 @@ func (c *RibcConfig) GetFibcType() string {
 	return c.FibcType
 }
 
+type BfdConfig struct {
+	Api     string `toml:"api"`
+	Disable bool   `toml:"disable"`
+}
+
+func (c *BfdConfig) String() string {
+	return fmt.Sprintf("api:'%s' disable:%t", c.Api, c.Disable)
+}
+
+func (c *BfdConfig) Enabled() bool {
+	return !c.Disable && len(c.Api) != 0
+}
+
+//
+// VrfTableConfig maps routing table (1-251) of node to VRF
+// used by routes in the table and policy routing rules to it.
+//
+type VrfTableConfig struct {
+	NId   uint8 `toml:"nid"`
+	Table int   `toml:"table"`
+	Vrf   uint8 `toml:"vrf"`
+}
+
+func (c *VrfTableConfig) String() string {
+	return fmt.Sprintf("nid:%d table:%d vrf:%d", c.NId, c.Table, c.Vrf)
+}
+
 type Config struct {
-	Node NodeConfig `toml:"node"`
-	NLA  NlaConfig  `toml:"nla"`
-	Ribc RibcConfig `toml:"ribc"`
+	Node      NodeConfig       `toml:"node"`
+	NLA       NlaConfig        `toml:"nla"`
+	Ribc      RibcConfig       `toml:"ribc"`
+	Bfd       BfdConfig        `toml:"bfd"`
+	VrfTables []VrfTableConfig `toml:"vrf_table"`
 }
 
 func (c *Config) String() string {
-	return fmt.Sprintf("node:{%s} nla:{%s} ribc:{%s}", &c.Node, &c.NLA, &c.Ribc)
+	return fmt.Sprintf("node:{%s} nla:{%s} ribc:{%s} bfd:{%s} vrf_table:%v", &c.Node, &c.NLA, &c.Ribc, &c.Bfd, c.VrfTables)
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -86,5 +133,11 @@ func LoadConfig(path string) (*Config, error) {
 		config.Node.Label = LABEL_BASE_DEFAULT
 	}
 
+	for _, vrfTable := range config.VrfTables {
+		if vrfTable.Table <= unix.RT_TABLE_UNSPEC || vrfTable.Table >= unix.RT_TABLE_COMPAT {
+			return nil, fmt.Errorf("Invalid vrf_table. %s", &vrfTable)
+		}
+	}
+
 	return config, nil
 }
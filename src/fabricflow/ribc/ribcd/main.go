@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -32,6 +33,9 @@ type Args struct {
 	FlowConfigPath string
 	FlowConfigName string
 	FlowConfigType string // yaml, toml, ...
+	ACLRulesPath   string
+	ACLRulesType   string // yaml, toml, ...
+	LLDPIntvl      time.Duration
 	Verbose        bool
 }
 
@@ -40,6 +44,9 @@ func (a *Args) Parse() {
 	flag.StringVar(&a.FlowConfigPath, "flow-config-path", "", "Flow config path.")
 	flag.StringVar(&a.FlowConfigName, "flow-config-name", ribctl.FLOWDB_BUILTIN_CONFIG, "Flow config name.")
 	flag.StringVar(&a.FlowConfigType, "flow-config-type", "yaml", "Flow config type.")
+	flag.StringVar(&a.ACLRulesPath, "acl-rules-path", "", "ACL rules file path.")
+	flag.StringVar(&a.ACLRulesType, "acl-rules-type", "yaml", "ACL rules file type.")
+	flag.DurationVar(&a.LLDPIntvl, "lldp-interval", 0, "LLDP interval of fibcd. LLDP frames are punted if not 0.")
 	flag.BoolVar(&a.Verbose, "verbose", false, "show detail log.")
 	flag.Parse()
 }
@@ -51,7 +58,7 @@ func NewArgs() *Args {
 }
 
 func getFlowConfig(args *Args) (*ribctl.FlowConfig, error) {
-	flowdb := ribctl.NewFlowDB()
+	flowdb := ribctl.NewFlowDB().SetLLDP(args.LLDPIntvl > 0)
 	if len(args.FlowConfigPath) != 0 {
 		flowdb.SetConfigFile(args.FlowConfigPath, args.FlowConfigType)
 		if err := flowdb.Load(); err != nil {
@@ -72,6 +79,9 @@ func dumpArgs(a *Args) {
 	log.Infof("Args: FlowConfigPath : `%s`", a.FlowConfigPath)
 	log.Infof("Args: FlowConfigType : `%s`", a.FlowConfigType)
 	log.Infof("Args: FlowConfigName : `%s`", a.FlowConfigName)
+	log.Infof("Args: ACLRulesPath   : `%s`", a.ACLRulesPath)
+	log.Infof("Args: ACLRulesType   : `%s`", a.ACLRulesType)
+	log.Infof("Args: LLDPIntvl      : %s", a.LLDPIntvl)
 	log.Infof("Args: Verbose        : %t", a.Verbose)
 }
 
@@ -85,6 +95,12 @@ func dumpConfig(c *Config) {
 	log.Infof("CONFIG: RIBC.FIBC       : '%s'", c.Ribc.Fibc)
 	log.Infof("CONFIG: RIBC.Type       : '%s'", c.Ribc.GetFibcType())
 	log.Infof("CONFIG: RIBC.Disable    : %t", c.Ribc.Disable)
+	log.Infof("CONFIG: RIBC.Auth       : %s", c.Ribc.GetFibcAuth())
+	log.Infof("CONFIG: BFD.Api         : '%s'", c.Bfd.Api)
+	log.Infof("CONFIG: BFD.Disable     : %t", c.Bfd.Disable)
+	for _, vrfTable := range c.VrfTables {
+		log.Infof("CONFIG: VrfTable        : %s", &vrfTable)
+	}
 }
 
 func main() {
@@ -121,9 +137,26 @@ func main() {
 	}
 
 	nla := ribctl.NewNLAController(config.NLA.Api)
-	fib := ribctl.NewFIBController(config.Ribc.GetFibcType(), config.Ribc.Fibc, config.Node.ReId)
+	fib := ribctl.NewFIBController(config.Ribc.GetFibcType(), config.Ribc.Fibc, config.Node.ReId, config.Ribc.GetFibcAuth())
 	rib := ribctl.NewRIBController(nid, config.Node.ReId, config.Node.Label, config.Node.DupIfname, nla, fib, flowcfg)
 
+	for _, vrfTable := range config.VrfTables {
+		rib.SetVrfTable(vrfTable.NId, vrfTable.Table, vrfTable.Vrf)
+	}
+
+	if len(args.ACLRulesPath) != 0 {
+		rib.SetACLRules(ribctl.NewACLRuleDB(args.ACLRulesPath, args.ACLRulesType))
+	}
+
+	if config.Bfd.Enabled() {
+		bfd := ribctl.NewBFDController(config.Bfd.Api)
+		if err := bfd.Start(); err != nil {
+			log.Errorf("BFDController Start error. %s", err)
+			os.Exit(1)
+		}
+		rib.SetBFD(bfd)
+	}
+
 	if err := nla.Start(); err != nil {
 		log.Errorf("NewNLAMonitor Start error. %s", err)
 		os.Exit(1)
@@ -0,0 +1,370 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ACLRULE_PRIORITY_BASE = 0x8000
+	ACLRULE_DSCP_MASK     = 0x3f
+)
+
+//
+// ACLRuleMatchConfig is match fields of acl rule.
+//
+type ACLRuleMatchConfig struct {
+	Vrf       uint8  `mapstructure:"vrf"`
+	EthType   uint16 `mapstructure:"eth_type"`
+	VlanVid   uint16 `mapstructure:"vlan_vid"`
+	IpSrc     string `mapstructure:"ip_src"`
+	IpDst     string `mapstructure:"ip_dst"`
+	IpProto   uint8  `mapstructure:"ip_proto"`
+	Dscp      uint8  `mapstructure:"dscp"`
+	DscpMask  uint8  `mapstructure:"dscp_mask"`
+	TpSrc     uint16 `mapstructure:"tp_src"`
+	TpSrcMask uint16 `mapstructure:"tp_src_mask"`
+	TpDst     uint16 `mapstructure:"tp_dst"`
+	TpDstMask uint16 `mapstructure:"tp_dst_mask"`
+}
+
+func parseACLRulePrefix(s string) (string, uint16, error) {
+	if len(s) == 0 {
+		return "", 0, nil
+	}
+
+	ip, ipnet, err := fibcapi.ParseMaskedIP(s)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if ip.To4() != nil {
+		return ipnet.String(), unix.ETH_P_IP, nil
+	}
+	return ipnet.String(), unix.ETH_P_IPV6, nil
+}
+
+func (c *ACLRuleMatchConfig) ToAPI() (*fibcapi.PolicyACLFlow_Match, error) {
+	ipSrc, srcType, err := parseACLRulePrefix(c.IpSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	ipDst, dstType, err := parseACLRulePrefix(c.IpDst)
+	if err != nil {
+		return nil, err
+	}
+
+	if srcType != 0 && dstType != 0 && srcType != dstType {
+		return nil, fmt.Errorf("address family mismatch. src:'%s' dst:'%s'", c.IpSrc, c.IpDst)
+	}
+
+	ethType := c.EthType
+	if ethType == 0 {
+		if ethType = srcType; ethType == 0 {
+			ethType = dstType
+		}
+	}
+
+	dscpMask := c.DscpMask
+	if c.Dscp != 0 && dscpMask == 0 {
+		dscpMask = ACLRULE_DSCP_MASK
+	}
+
+	return &fibcapi.PolicyACLFlow_Match{
+		Vrf:        uint32(c.Vrf),
+		EthType:    uint32(ethType),
+		VlanVid:    uint32(c.VlanVid),
+		IpSrc:      ipSrc,
+		IpDst:      ipDst,
+		IpProto:    uint32(c.IpProto),
+		IpDscp:     uint32(c.Dscp),
+		IpDscpMask: uint32(dscpMask),
+		TpSrc:      uint32(c.TpSrc),
+		TpSrcMask:  uint32(c.TpSrcMask),
+		TpDst:      uint32(c.TpDst),
+		TpDstMask:  uint32(c.TpDstMask),
+	}, nil
+}
+
+//
+// ACLRuleActionConfig is action of acl rule.
+// name: drop, permit, mirror, set_queue, set_dscp, output
+// port: interface name to mirror (mirror only)
+//
+type ACLRuleActionConfig struct {
+	Name  string `mapstructure:"name"`
+	Value uint32 `mapstructure:"value"`
+	Port  string `mapstructure:"port"`
+}
+
+//
+// unresolvedPort returns port name if action is mirror and the port is not found.
+//
+func (c *ACLRuleActionConfig) unresolvedPort(portId func(string) (uint32, bool)) (string, bool) {
+	name, err := fibcapi.ParsePolicyACLFlowActionName(strings.ToUpper(strings.Replace(c.Name, "-", "_", -1)))
+	if err != nil || name != fibcapi.PolicyACLFlow_Action_MIRROR {
+		return "", false
+	}
+
+	if _, ok := portId(c.Port); ok {
+		return "", false
+	}
+
+	return c.Port, true
+}
+
+func (c *ACLRuleActionConfig) ToAPI(portId func(string) (uint32, bool)) (*fibcapi.PolicyACLFlow_Action, error) {
+	name, err := fibcapi.ParsePolicyACLFlowActionName(strings.ToUpper(strings.Replace(c.Name, "-", "_", -1)))
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case fibcapi.PolicyACLFlow_Action_MIRROR:
+		port, ok := portId(c.Port)
+		if !ok {
+			return nil, fmt.Errorf("mirror port not found. '%s'", c.Port)
+		}
+		return fibcapi.NewPolicyACLFlowAction(name, port), nil
+
+	case fibcapi.PolicyACLFlow_Action_SET_VRF:
+		return nil, fmt.Errorf("action not supported. %s", name)
+
+	default:
+		return fibcapi.NewPolicyACLFlowAction(name, c.Value), nil
+	}
+}
+
+//
+// ACLRuleConfig is acl rule.
+// rules are evaluated in order of priority. if priority is 0,
+// it is assigned by order of rules in file.
+//
+type ACLRuleConfig struct {
+	Name     string                 `mapstructure:"name"`
+	Priority uint32                 `mapstructure:"priority"`
+	Match    ACLRuleMatchConfig     `mapstructure:"match"`
+	Actions  []*ACLRuleActionConfig `mapstructure:"actions"`
+}
+
+//
+// unresolvedPort returns the first mirror port not found.
+//
+func (c *ACLRuleConfig) unresolvedPort(portId func(string) (uint32, bool)) (string, bool) {
+	for _, ac := range c.Actions {
+		if port, ok := ac.unresolvedPort(portId); ok {
+			return port, true
+		}
+	}
+	return "", false
+}
+
+func (c *ACLRuleConfig) ToAPI(index int, portId func(string) (uint32, bool)) (*fibcapi.PolicyACLFlow, error) {
+	if len(c.Actions) == 0 {
+		return nil, fmt.Errorf("no actions.")
+	}
+
+	m, err := c.Match.ToAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]*fibcapi.PolicyACLFlow_Action, len(c.Actions))
+	for i, ac := range c.Actions {
+		a, err := ac.ToAPI(portId)
+		if err != nil {
+			return nil, err
+		}
+		actions[i] = a
+	}
+
+	priority := c.Priority
+	if priority == 0 {
+		priority = uint32(ACLRULE_PRIORITY_BASE - index)
+	}
+
+	return fibcapi.NewPolicyACLFlow(m, priority, actions...), nil
+}
+
+//
+// ACLRulesConfig is contents of acl rules file.
+//
+type ACLRulesConfig struct {
+	Rules []*ACLRuleConfig `mapstructure:"rules"`
+}
+
+//
+// ACLRuleDB has acl rules loaded from rules file.
+// flows are stored without in_port.
+// rules whose mirror port is not found are pending
+// and loaded again when ports are changed.
+//
+type ACLRuleDB struct {
+	Path string
+	Type string // yaml, toml, ...
+
+	mutex   sync.RWMutex
+	viper   *viper.Viper
+	flows   map[string]*fibcapi.PolicyACLFlow
+	pending []string
+}
+
+func NewACLRuleDB(path, ftype string) *ACLRuleDB {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(ftype)
+
+	return &ACLRuleDB{
+		Path:  path,
+		Type:  ftype,
+		viper: v,
+		flows: map[string]*fibcapi.PolicyACLFlow{},
+	}
+}
+
+func aclRuleKey(flow *fibcapi.PolicyACLFlow) string {
+	return proto.CompactTextString(flow)
+}
+
+//
+// Load reads rules file and returns rules added and deleted.
+// Rules are not replaced until Commit is called with the rules sent.
+// Rules whose mirror port is not found are skipped and kept as pending.
+//
+func (db *ACLRuleDB) Load(portId func(string) (uint32, bool)) ([]*fibcapi.PolicyACLFlow, []*fibcapi.PolicyACLFlow, error) {
+	if err := db.viper.ReadInConfig(); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := ACLRulesConfig{}
+	if err := db.viper.UnmarshalExact(&cfg); err != nil {
+		return nil, nil, err
+	}
+
+	flows := map[string]*fibcapi.PolicyACLFlow{}
+	pending := []string{}
+	for index, rule := range cfg.Rules {
+		if port, ok := rule.unresolvedPort(portId); ok {
+			pending = append(pending, fmt.Sprintf("rule#%d(%s): mirror port not found. '%s'", index, rule.Name, port))
+			continue
+		}
+
+		flow, err := rule.ToAPI(index, portId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("rule#%d(%s): %s", index, rule.Name, err)
+		}
+		flows[aclRuleKey(flow)] = flow
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	db.pending = pending
+
+	added := []*fibcapi.PolicyACLFlow{}
+	deleted := []*fibcapi.PolicyACLFlow{}
+
+	for key, flow := range db.flows {
+		if _, ok := flows[key]; !ok {
+			deleted = append(deleted, flow)
+		}
+	}
+
+	for key, flow := range flows {
+		if _, ok := db.flows[key]; !ok {
+			added = append(added, flow)
+		}
+	}
+
+	return added, deleted, nil
+}
+
+//
+// Commit adds and deletes rules which are sent.
+//
+func (db *ACLRuleDB) Commit(added, deleted []*fibcapi.PolicyACLFlow) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	for _, flow := range deleted {
+		delete(db.flows, aclRuleKey(flow))
+	}
+
+	for _, flow := range added {
+		db.flows[aclRuleKey(flow)] = flow
+	}
+}
+
+//
+// Pending returns rules skipped by last Load.
+//
+func (db *ACLRuleDB) Pending() []string {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	return db.pending
+}
+
+//
+// Watch calls f when rules file is changed.
+//
+func (db *ACLRuleDB) Watch(f func()) {
+	db.viper.WatchConfig()
+	db.viper.OnConfigChange(func(e fsnotify.Event) {
+		f()
+	})
+}
+
+//
+// Flows returns rules in order of priority.
+//
+func (db *ACLRuleDB) Flows() []*fibcapi.PolicyACLFlow {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	flows := make([]*fibcapi.PolicyACLFlow, 0, len(db.flows))
+	for _, flow := range db.flows {
+		flows = append(flows, flow)
+	}
+
+	sort.Slice(flows, func(i, j int) bool {
+		return flows[i].Priority > flows[j].Priority
+	})
+
+	return flows
+}
+
+//
+// NewACLRuleFlow returns copy of flow with in_port.
+//
+func NewACLRuleFlow(flow *fibcapi.PolicyACLFlow, inPort uint32) *fibcapi.PolicyACLFlow {
+	f := proto.Clone(flow).(*fibcapi.PolicyACLFlow)
+	f.Match.InPort = inPort
+	return f
+}
@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ribctl
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func testACLRulePortId(ifname string) (uint32, bool) {
+	if ifname == "eth9" {
+		return 9, true
+	}
+	return 0, false
+}
+
+func TestACLRuleDBLoad(t *testing.T) {
+	db := NewACLRuleDB("./aclrules_test.yml", "yaml")
+
+	added, deleted, err := db.Load(testACLRulePortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+	if len(added) != 3 || len(deleted) != 0 {
+		t.Errorf("Load unmatch. add:%d del:%d", len(added), len(deleted))
+	}
+	if flows := db.Flows(); len(flows) != 0 {
+		t.Errorf("Flows must be empty before commit. %d", len(flows))
+	}
+
+	db.Commit(added, deleted)
+
+	flows := db.Flows()
+	if len(flows) != 3 {
+		t.Fatalf("Flows unmatch. %d", len(flows))
+	}
+
+	// mirror-bgp
+	f := flows[0]
+	if v := f.Priority; v != 0x9000 {
+		t.Errorf("Flows[0] priority unmatch. %d", v)
+	}
+	if v := f.Match.EthType; v != unix.ETH_P_IPV6 {
+		t.Errorf("Flows[0] eth_type unmatch. %d", v)
+	}
+	if v := f.AllActions(); len(v) != 2 || v[0].Name != fibcapi.PolicyACLFlow_Action_MIRROR || v[0].Value != 9 {
+		t.Errorf("Flows[0] actions unmatch. %v", v)
+	}
+
+	// deny-telnet
+	f = flows[1]
+	if v := f.Priority; v != ACLRULE_PRIORITY_BASE {
+		t.Errorf("Flows[1] priority unmatch. %d", v)
+	}
+	if v := f.Match.EthType; v != unix.ETH_P_IP {
+		t.Errorf("Flows[1] eth_type unmatch. %d", v)
+	}
+	if v := f.Action.Name; v != fibcapi.PolicyACLFlow_Action_DROP {
+		t.Errorf("Flows[1] action unmatch. %s", v)
+	}
+
+	// voice
+	f = flows[2]
+	if v := f.Priority; v != ACLRULE_PRIORITY_BASE-2 {
+		t.Errorf("Flows[2] priority unmatch. %d", v)
+	}
+	if v := f.Match.IpDscpMask; v != ACLRULE_DSCP_MASK {
+		t.Errorf("Flows[2] dscp_mask unmatch. %d", v)
+	}
+	if v := f.AllActions(); len(v) != 2 || v[0].Name != fibcapi.PolicyACLFlow_Action_SET_QUEUE || v[1].Name != fibcapi.PolicyACLFlow_Action_SET_DSCP {
+		t.Errorf("Flows[2] actions unmatch. %v", v)
+	}
+
+	added, deleted, err = db.Load(testACLRulePortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+	if len(added) != 0 || len(deleted) != 0 {
+		t.Errorf("Reload unmatch. add:%d del:%d", len(added), len(deleted))
+	}
+}
+
+func TestACLRuleDBLoad_MirrorPortPending(t *testing.T) {
+	db := NewACLRuleDB("./aclrules_test.yml", "yaml")
+
+	noPortId := func(string) (uint32, bool) { return 0, false }
+
+	added, deleted, err := db.Load(noPortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+	if len(added) != 2 || len(deleted) != 0 {
+		t.Errorf("Load unmatch. add:%d del:%d", len(added), len(deleted))
+	}
+	if pending := db.Pending(); len(pending) != 1 {
+		t.Errorf("Pending unmatch. %v", pending)
+	}
+
+	db.Commit(added, deleted)
+
+	// mirror port is found.
+	added, deleted, err = db.Load(testACLRulePortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+	if len(added) != 1 || len(deleted) != 0 || added[0].Priority != 0x9000 {
+		t.Errorf("Load unmatch. add:%v del:%d", added, len(deleted))
+	}
+	if pending := db.Pending(); len(pending) != 0 {
+		t.Errorf("Pending unmatch. %v", pending)
+	}
+}
+
+func TestACLRuleDBCommit(t *testing.T) {
+	db := NewACLRuleDB("./aclrules_test.yml", "yaml")
+
+	added, _, err := db.Load(testACLRulePortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+
+	// only the first rule is sent.
+	db.Commit(added[:1], nil)
+
+	if flows := db.Flows(); len(flows) != 1 {
+		t.Errorf("Flows unmatch. %d", len(flows))
+	}
+
+	// rules not sent are added again.
+	added, deleted, err := db.Load(testACLRulePortId)
+	if err != nil {
+		t.Fatalf("Load error. %s", err)
+	}
+	if len(added) != 2 || len(deleted) != 0 {
+		t.Errorf("Load unmatch. add:%d del:%d", len(added), len(deleted))
+	}
+
+	db.Commit(added, nil)
+	if flows := db.Flows(); len(flows) != 3 {
+		t.Errorf("Flows unmatch. %d", len(flows))
+	}
+
+	// only rules deleted are removed.
+	db.Commit(nil, added[:1])
+	if flows := db.Flows(); len(flows) != 2 {
+		t.Errorf("Flows unmatch. %d", len(flows))
+	}
+}
+
+func TestACLRuleConfig_MirrorPortNotFound(t *testing.T) {
+	c := ACLRuleConfig{
+		Actions: []*ACLRuleActionConfig{
+			{Name: "mirror", Port: "eth1"},
+		},
+	}
+
+	if _, err := c.ToAPI(0, testACLRulePortId); err == nil {
+		t.Errorf("ToAPI must be error.")
+	}
+}
+
+func TestACLRuleMatchConfig_FamilyMismatch(t *testing.T) {
+	c := ACLRuleMatchConfig{
+		IpSrc: "10.0.0.0/8",
+		IpDst: "2001:db8::/32",
+	}
+
+	if _, err := c.ToAPI(); err == nil {
+		t.Errorf("ToAPI must be error.")
+	}
+}
+
+func TestNewACLRuleFlow(t *testing.T) {
+	m := &fibcapi.PolicyACLFlow_Match{EthType: unix.ETH_P_IP}
+	flow := fibcapi.NewPolicyACLFlow(m, 10, fibcapi.NewPolicyACLFlowAction(fibcapi.PolicyACLFlow_Action_DROP, 0))
+
+	f := NewACLRuleFlow(flow, 3)
+	if v := f.Match.InPort; v != 3 {
+		t.Errorf("NewACLRuleFlow in_port unmatch. %d", v)
+	}
+	if v := flow.Match.InPort; v != 0 {
+		t.Errorf("NewACLRuleFlow original modified. %d", v)
+	}
+}
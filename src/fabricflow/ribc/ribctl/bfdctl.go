@@ -0,0 +1,120 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fabricflow/bfd/api/bfdapi"
+	"gonla/nlalib"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+//
+// BFDController receives status of bfd sessions from bfdd.
+//
+type BFDController struct {
+	addr   string
+	connCh chan net.IP
+	recvCh chan *bfdapi.Session
+	client bfdapi.BFDApiClient
+	log    *log.Entry
+}
+
+//
+// NewBFDController returns new BFDController.
+//
+func NewBFDController(addr string) *BFDController {
+	return &BFDController{
+		addr:   addr,
+		connCh: make(chan net.IP),
+		recvCh: make(chan *bfdapi.Session),
+		client: nil,
+		log:    log.WithFields(log.Fields{"module": "BFDController"}),
+	}
+}
+
+//
+// Conn returns channel to receive connection event.
+// nil is sent when disconnected.
+//
+func (b *BFDController) Conn() <-chan net.IP {
+	return b.connCh
+}
+
+//
+// Recv returns channel to receive status of sessions.
+//
+func (b *BFDController) Recv() <-chan *bfdapi.Session {
+	return b.recvCh
+}
+
+//
+// Start connects to bfdd.
+//
+func (b *BFDController) Start() error {
+	b.log.Debugf("Start:")
+
+	ch := make(chan *nlalib.ConnInfo)
+	conn, err := nlalib.NewClientConn(b.addr, ch)
+	if err != nil {
+		b.log.Errorf("Start: connect error. %s", err)
+		close(ch)
+		return err
+	}
+	b.client = bfdapi.NewBFDApiClient(conn)
+
+	go func() {
+		for {
+			ci := <-ch
+			go b.Monitor(ci)
+		}
+	}()
+
+	return nil
+}
+
+//
+// Monitor receives status of sessions until stream is closed.
+//
+func (b *BFDController) Monitor(ci *nlalib.ConnInfo) {
+	b.connCh <- ci.LocalAddr
+	defer func() {
+		b.connCh <- nil
+	}()
+
+	stream, err := b.client.MonSessions(context.Background(), &bfdapi.MonSessionsRequest{})
+	if err != nil {
+		b.log.Errorf("Monitor: error. %s", err)
+		return
+	}
+
+	b.log.Infof("Monitor: START")
+
+	for {
+		session, err := stream.Recv()
+		if err != nil {
+			b.log.Infof("Monitor: EXIT. %s", err)
+			break
+		}
+
+		b.log.Debugf("Monitor: recv %v", session)
+		b.recvCh <- session
+	}
+}
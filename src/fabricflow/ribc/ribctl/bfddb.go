@@ -0,0 +1,90 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"sync"
+)
+
+//
+// BFDPeerDB is set of peers whose bfd sessions are down.
+//
+type BFDPeerDB struct {
+	mutex sync.RWMutex
+	downs map[string]net.IP
+}
+
+//
+// NewBFDPeerDB returns new BFDPeerDB.
+//
+func NewBFDPeerDB() *BFDPeerDB {
+	return &BFDPeerDB{
+		downs: map[string]net.IP{},
+	}
+}
+
+//
+// Update sets state of peer. It returns true if state is changed.
+//
+func (db *BFDPeerDB) Update(peer net.IP, down bool) bool {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	key := peer.String()
+	_, ok := db.downs[key]
+
+	if down == ok {
+		return false
+	}
+
+	if down {
+		db.downs[key] = peer
+	} else {
+		delete(db.downs, key)
+	}
+
+	return true
+}
+
+//
+// IsDown returns true if bfd session of peer is down.
+//
+func (db *BFDPeerDB) IsDown(peer net.IP) bool {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	_, ok := db.downs[peer.String()]
+	return ok
+}
+
+//
+// Clear deletes all peers and returns them.
+//
+func (db *BFDPeerDB) Clear() []net.IP {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	peers := make([]net.IP, 0, len(db.downs))
+	for _, peer := range db.downs {
+		peers = append(peers, peer)
+	}
+
+	db.downs = map[string]net.IP{}
+	return peers
+}
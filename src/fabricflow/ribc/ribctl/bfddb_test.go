@@ -0,0 +1,58 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+)
+
+func TestBFDPeerDB(t *testing.T) {
+	db := NewBFDPeerDB()
+
+	p1 := net.ParseIP("10.0.0.1")
+	p2 := net.ParseIP("2001:db8::1")
+
+	if db.Update(p1, false) {
+		t.Errorf("BFDPeerDB.Update must be false.")
+	}
+
+	if !db.Update(p1, true) || !db.Update(p2, true) {
+		t.Errorf("BFDPeerDB.Update must be true.")
+	}
+
+	if db.Update(net.IPv4(10, 0, 0, 1), true) {
+		t.Errorf("BFDPeerDB.Update must be false.")
+	}
+
+	if !db.IsDown(p1) || !db.IsDown(p2) || db.IsDown(net.ParseIP("10.0.0.2")) {
+		t.Errorf("BFDPeerDB.IsDown unmatch.")
+	}
+
+	if !db.Update(p1, false) || db.IsDown(p1) {
+		t.Errorf("BFDPeerDB.Update unmatch.")
+	}
+
+	if peers := db.Clear(); len(peers) != 1 || !peers[0].Equal(p2) {
+		t.Errorf("BFDPeerDB.Clear unmatch. %v", peers)
+	}
+
+	if db.IsDown(p2) {
+		t.Errorf("BFDPeerDB.IsDown must be false after Clear.")
+	}
+}
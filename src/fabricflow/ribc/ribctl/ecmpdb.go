@@ -0,0 +1,263 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fmt"
+	"gonla/nlamsg"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//
+// EcmpEntry is set of L3 unicast groups shared by multipath routes.
+//
+type EcmpEntry struct {
+	EcmpId uint32
+	NeIds  []uint32 // sorted
+	refCnt uint32
+}
+
+func (e *EcmpEntry) String() string {
+	return fmt.Sprintf("EcmpId:%d NeIds:%v Ref:%d", e.EcmpId, e.NeIds, e.refCnt)
+}
+
+//
+// HasNeId returns true if neId is member of entry.
+//
+func (e *EcmpEntry) HasNeId(neId uint32) bool {
+	i := sort.Search(len(e.NeIds), func(i int) bool { return e.NeIds[i] >= neId })
+	return i < len(e.NeIds) && e.NeIds[i] == neId
+}
+
+//
+// EcmpRouteKey is key of multipath route.
+//
+type EcmpRouteKey struct {
+	NId uint8
+	Dst string // ip/mask (net.IPNet.String())
+}
+
+func NewEcmpRouteKey(nid uint8, dst *net.IPNet) *EcmpRouteKey {
+	return &EcmpRouteKey{
+		NId: nid,
+		Dst: dst.String(),
+	}
+}
+
+func newEcmpMembersKey(neIds []uint32) string {
+	ss := make([]string, len(neIds))
+	for i, neId := range neIds {
+		ss[i] = fmt.Sprintf("%d", neId)
+	}
+	return strings.Join(ss, ",")
+}
+
+//
+// EcmpDB has ECMP groups and refer count by routes.
+// Routes which have the same nexthop set share one ECMP group.
+//
+type EcmpDB struct {
+	mutex   sync.Mutex
+	entries map[string]*EcmpEntry // key: members
+	routes  map[EcmpRouteKey]*EcmpEntry
+	nextId  uint32
+	freeIds []uint32
+}
+
+func NewEcmpDB() *EcmpDB {
+	return &EcmpDB{
+		entries: map[string]*EcmpEntry{},
+		routes:  map[EcmpRouteKey]*EcmpEntry{},
+		nextId:  1,
+		freeIds: []uint32{},
+	}
+}
+
+func (db *EcmpDB) newId() uint32 {
+	if n := len(db.freeIds); n > 0 {
+		id := db.freeIds[n-1]
+		db.freeIds = db.freeIds[:n-1]
+		return id
+	}
+
+	id := db.nextId
+	db.nextId++
+	return id
+}
+
+func (db *EcmpDB) release(e *EcmpEntry) bool {
+	e.refCnt--
+	if e.refCnt > 0 {
+		return false
+	}
+
+	delete(db.entries, newEcmpMembersKey(e.NeIds))
+	db.freeIds = append(db.freeIds, e.EcmpId)
+	return true
+}
+
+//
+// Add registers route and returns ECMP entry of neIds.
+// created is true if entry is newly created.
+// if route has been registered with other nexthops, old entry is unrefered
+// and returned as released if it is no longer used.
+//
+func (db *EcmpDB) Add(key *EcmpRouteKey, neIds []uint32) (entry *EcmpEntry, created bool, released *EcmpEntry) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	members := make([]uint32, len(neIds))
+	copy(members, neIds)
+	sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+	mkey := newEcmpMembersKey(members)
+
+	old, registered := db.routes[*key]
+	if registered && newEcmpMembersKey(old.NeIds) == mkey {
+		return old, false, nil
+	}
+
+	entry, ok := db.entries[mkey]
+	if !ok {
+		entry = &EcmpEntry{
+			EcmpId: db.newId(),
+			NeIds:  members,
+		}
+		db.entries[mkey] = entry
+		created = true
+	}
+
+	entry.refCnt++
+	db.routes[*key] = entry
+
+	// release old entry after new entry is allocated
+	// not to reuse ECMP id of old entry.
+	if registered && db.release(old) {
+		released = old
+	}
+
+	return
+}
+
+//
+// Delete unregisters route.
+// it returns ECMP entry refered by route (nil if not registered),
+// and released is true if entry is no longer used.
+//
+func (db *EcmpDB) Delete(key *EcmpRouteKey) (entry *EcmpEntry, released bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entry, ok := db.routes[*key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(db.routes, *key)
+	released = db.release(entry)
+
+	return
+}
+
+//
+// Select returns ECMP entry refered by route.
+//
+func (db *EcmpDB) Select(key *EcmpRouteKey) (*EcmpEntry, bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	e, ok := db.routes[*key]
+	return e, ok
+}
+
+type ecmpPendingEntry struct {
+	route *nlamsg.Route
+	gws   []net.IP
+}
+
+//
+// EcmpPendingDB has multipath routes installed without nexthops
+// whose neighbors are not resolved yet.
+// Routes are taken when the neighbor is resolved to be installed again.
+//
+type EcmpPendingDB struct {
+	mutex   sync.Mutex
+	entries map[EcmpRouteKey]*ecmpPendingEntry
+}
+
+func NewEcmpPendingDB() *EcmpPendingDB {
+	return &EcmpPendingDB{
+		entries: map[EcmpRouteKey]*ecmpPendingEntry{},
+	}
+}
+
+//
+// Set registers route with unresolved nexthops.
+// Route is unregistered if gws is empty.
+//
+func (db *EcmpPendingDB) Set(key *EcmpRouteKey, route *nlamsg.Route, gws []net.IP) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if len(gws) == 0 {
+		delete(db.entries, *key)
+		return
+	}
+
+	db.entries[*key] = &ecmpPendingEntry{
+		route: route.Copy(),
+		gws:   gws,
+	}
+}
+
+//
+// Delete unregisters route.
+//
+func (db *EcmpPendingDB) Delete(key *EcmpRouteKey) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	delete(db.entries, *key)
+}
+
+//
+// Take unregisters and returns routes which wait for gw to be resolved.
+//
+func (db *EcmpPendingDB) Take(nid uint8, gw net.IP) []*nlamsg.Route {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	routes := []*nlamsg.Route{}
+	for key, e := range db.entries {
+		if key.NId != nid {
+			continue
+		}
+
+		for _, pending := range e.gws {
+			if pending.Equal(gw) {
+				routes = append(routes, e.route)
+				delete(db.entries, key)
+				break
+			}
+		}
+	}
+
+	return routes
+}
@@ -0,0 +1,164 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"gonla/nlamsg"
+	"net"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+)
+
+func newTestEcmpRouteKey(dst string) *EcmpRouteKey {
+	_, ipnet, _ := net.ParseCIDR(dst)
+	return NewEcmpRouteKey(0, ipnet)
+}
+
+func TestEcmpDB_Share(t *testing.T) {
+	db := NewEcmpDB()
+
+	r1 := newTestEcmpRouteKey("10.0.1.0/24")
+	r2 := newTestEcmpRouteKey("10.0.2.0/24")
+
+	e1, created, released := db.Add(r1, []uint32{2, 1})
+	if !created || released != nil {
+		t.Errorf("EcmpDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e1.NeIds[0] != 1 || e1.NeIds[1] != 2 {
+		t.Errorf("EcmpDB.Add unmatch. neids=%v", e1.NeIds)
+	}
+
+	e2, created, _ := db.Add(r2, []uint32{1, 2})
+	if created || e2 != e1 {
+		t.Errorf("EcmpDB.Add unmatch. created=%t %v", created, e2)
+	}
+
+	if e, released := db.Delete(r1); e != e1 || released {
+		t.Errorf("EcmpDB.Delete unmatch. released=%t %v", released, e)
+	}
+
+	if e, released := db.Delete(r2); e != e1 || !released {
+		t.Errorf("EcmpDB.Delete unmatch. released=%t %v", released, e)
+	}
+
+	if e, _ := db.Delete(r2); e != nil {
+		t.Errorf("EcmpDB.Delete unmatch. %v", e)
+	}
+}
+
+func TestEcmpDB_Replace(t *testing.T) {
+	db := NewEcmpDB()
+
+	r1 := newTestEcmpRouteKey("10.0.1.0/24")
+
+	e1, _, _ := db.Add(r1, []uint32{1, 2})
+
+	if e, created, released := db.Add(r1, []uint32{1, 2}); e != e1 || created || released != nil {
+		t.Errorf("EcmpDB.Add unmatch. created=%t released=%v", created, released)
+	}
+
+	e2, created, released := db.Add(r1, []uint32{1, 3})
+	if !created || released != e1 {
+		t.Errorf("EcmpDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e2.EcmpId == e1.EcmpId {
+		t.Errorf("EcmpDB.Add must not reuse id. %d", e2.EcmpId)
+	}
+
+	if e, ok := db.Select(r1); !ok || e != e2 {
+		t.Errorf("EcmpDB.Select unmatch. %v", e)
+	}
+
+	// released id is reused.
+	r2 := newTestEcmpRouteKey("10.0.2.0/24")
+	if e, _, _ := db.Add(r2, []uint32{4, 5}); e.EcmpId != e1.EcmpId {
+		t.Errorf("EcmpDB.Add unmatch. id=%d", e.EcmpId)
+	}
+}
+
+func TestEcmpDB_NeighDeleted(t *testing.T) {
+	db := NewEcmpDB()
+
+	r1 := newTestEcmpRouteKey("10.0.1.0/24")
+
+	e1, _, _ := db.Add(r1, []uint32{3, 1, 2})
+	if !e1.HasNeId(2) || e1.HasNeId(4) {
+		t.Errorf("EcmpEntry.HasNeId unmatch. %v", e1)
+	}
+
+	// neigh 2 is deleted.
+	e2, created, released := db.Add(r1, []uint32{1, 3})
+	if !created || released != e1 {
+		t.Errorf("EcmpDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e2.HasNeId(2) || !e2.HasNeId(1) || !e2.HasNeId(3) {
+		t.Errorf("EcmpEntry.HasNeId unmatch. %v", e2)
+	}
+}
+
+func TestEcmpPendingDB_LateResolution(t *testing.T) {
+	db := NewEcmpDB()
+	pendb := NewEcmpPendingDB()
+
+	r1 := newTestEcmpRouteKey("10.0.1.0/24")
+	_, dst, _ := net.ParseCIDR("10.0.1.0/24")
+	route := nlamsg.NewRoute(&netlink.Route{Dst: dst}, 0, 0, nil, nil)
+	gw2 := net.ParseIP("10.0.0.2")
+	gw3 := net.ParseIP("10.0.0.3")
+
+	// nexthop gw2 (neid 2) is not resolved.
+	e1, _, _ := db.Add(r1, []uint32{1})
+	pendb.Set(r1, route, []net.IP{gw2})
+
+	if routes := pendb.Take(0, gw3); len(routes) != 0 {
+		t.Errorf("EcmpPendingDB.Take unmatch. %v", routes)
+	}
+
+	if routes := pendb.Take(1, gw2); len(routes) != 0 {
+		t.Errorf("EcmpPendingDB.Take unmatch. %v", routes)
+	}
+
+	// gw2 is resolved.
+	if routes := pendb.Take(0, gw2); len(routes) != 1 || routes[0].GetDst().String() != "10.0.1.0/24" {
+		t.Errorf("EcmpPendingDB.Take unmatch. %v", routes)
+	}
+
+	if routes := pendb.Take(0, gw2); len(routes) != 0 {
+		t.Errorf("EcmpPendingDB.Take not removed. %v", routes)
+	}
+
+	e2, created, released := db.Add(r1, []uint32{1, 2})
+	if !created || released != e1 || len(e2.NeIds) != 2 {
+		t.Errorf("EcmpDB.Add unmatch. created=%t released=%v %v", created, released, e2)
+	}
+
+	// route is deleted before resolved.
+	pendb.Set(r1, route, []net.IP{gw3})
+	pendb.Delete(r1)
+	if routes := pendb.Take(0, gw3); len(routes) != 0 {
+		t.Errorf("EcmpPendingDB.Delete unmatch. %v", routes)
+	}
+
+	// route is resolved completely.
+	pendb.Set(r1, route, []net.IP{gw3})
+	pendb.Set(r1, route, []net.IP{})
+	if routes := pendb.Take(0, gw3); len(routes) != 0 {
+		t.Errorf("EcmpPendingDB.Set unmatch. %v", routes)
+	}
+}
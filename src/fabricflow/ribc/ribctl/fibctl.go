@@ -17,7 +17,10 @@
 
 package ribctl
 
-import fibcapi "fabricflow/fibc/api"
+import (
+	fibcapi "fabricflow/fibc/api"
+	ffgrpc "fabricflow/util/grpc"
+)
 
 const (
 	FIBCTypeTCP     = "tcp"
@@ -38,13 +41,13 @@ type FIBController interface {
 	FIBCType() string
 }
 
-func NewFIBController(fibcType, addr, reId string) FIBController {
+func NewFIBController(fibcType, addr, reId string, auth *ffgrpc.ClientAuth) FIBController {
 	switch fibcType {
 	case FIBCTypeTCP:
 		return NewFIBTcpController(addr)
 
 	default:
-		return NewFIBGrpcController(addr, reId)
+		return NewFIBGrpcController(addr, reId, auth)
 
 	}
 }
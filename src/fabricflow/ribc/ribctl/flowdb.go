@@ -115,6 +115,7 @@ const FLOWDB_BUILTIN_CONFIG = "_builtin_"
 type FlowDB struct {
 	Flows map[string]*FlowConfig `mapstructure:"flows"`
 	viper *viper.Viper
+	lldp  bool
 }
 
 func NewFlowDB() *FlowDB {
@@ -130,6 +131,11 @@ func (c *FlowDB) SetConfigFile(path, format string) *FlowDB {
 	return c
 }
 
+func (c *FlowDB) SetLLDP(enable bool) *FlowDB {
+	c.lldp = enable
+	return c
+}
+
 func (c *FlowDB) Load() error {
 	if err := c.viper.ReadInConfig(); err != nil {
 		return err
@@ -140,7 +146,7 @@ func (c *FlowDB) Load() error {
 
 func (c *FlowDB) Config(name string) *FlowConfig {
 	if name == FLOWDB_BUILTIN_CONFIG {
-		return NewBuiltinFlowConfig()
+		return NewBuiltinFlowConfig(c.lldp)
 	}
 
 	if cfg, ok := c.Flows[name]; ok {
@@ -150,7 +156,7 @@ func (c *FlowDB) Config(name string) *FlowConfig {
 	return nil
 }
 
-func NewBuiltinFlowConfig() *FlowConfig {
+func NewBuiltinFlowConfig(lldp bool) *FlowConfig {
 	policyACL := []*PolicyACLFlowConfig{
 		&PolicyACLFlowConfig{
 			Match: PolicyACLMatchConfig{
@@ -249,6 +255,18 @@ func NewBuiltinFlowConfig() *FlowConfig {
 		},
 	}
 
+	if lldp {
+		// lldp frames are punted to lldp agent of fibcd.
+		policyACL = append(policyACL, &PolicyACLFlowConfig{
+			Match: PolicyACLMatchConfig{
+				EthType: fibcapi.ETHTYPE_LLDP,
+			},
+			Action: PolicyACLActionConfig{
+				Name: "OUTPUT",
+			},
+		})
+	}
+
 	return &FlowConfig{
 		PolicyACL: policyACL,
 	}
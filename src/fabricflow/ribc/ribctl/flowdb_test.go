@@ -18,6 +18,7 @@
 package ribctl
 
 import (
+	fibcapi "fabricflow/fibc/api"
 	"fmt"
 	"testing"
 )
@@ -38,7 +39,7 @@ func TestFlowDBLoad(t *testing.T) {
 }
 
 func TestBuiltinFlowConfig(t *testing.T) {
-	cfg := NewBuiltinFlowConfig()
+	cfg := NewBuiltinFlowConfig(true)
 
 	for _, c := range cfg.PolicyACL {
 		acl := c.ToAPI()
@@ -51,3 +52,26 @@ func TestBuiltinFlowConfig(t *testing.T) {
 		// fmt.Printf("%v\n", acl)
 	}
 }
+
+func TestBuiltinFlowConfigLLDP(t *testing.T) {
+	hasLLDP := func(cfg *FlowConfig) bool {
+		for _, c := range cfg.PolicyACL {
+			if c.Match.EthType == fibcapi.ETHTYPE_LLDP {
+				return true
+			}
+		}
+		return false
+	}
+
+	if cfg := NewFlowDB().SetLLDP(true).Config(FLOWDB_BUILTIN_CONFIG); !hasLLDP(cfg) {
+		t.Errorf("BuiltinFlowConfig must have lldp entry.")
+	}
+
+	if cfg := NewFlowDB().SetLLDP(false).Config(FLOWDB_BUILTIN_CONFIG); hasLLDP(cfg) {
+		t.Errorf("BuiltinFlowConfig must not have lldp entry.")
+	}
+
+	if cfg := NewFlowDB().Config(FLOWDB_BUILTIN_CONFIG); hasLLDP(cfg) {
+		t.Errorf("BuiltinFlowConfig must not have lldp entry by default.")
+	}
+}
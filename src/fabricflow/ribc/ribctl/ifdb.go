@@ -257,6 +257,26 @@ func (db *IfDB) ListSlaves(masterIndex int, f func(*IfDBEntry)) {
 	}
 }
 
+func (db *IfDB) List(f func(*IfDBEntry)) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	for _, e := range db.entries {
+		f(e)
+	}
+}
+
+func (db *IfDB) ListByNId(nid uint8, f func(*IfDBEntry)) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	for _, e := range db.entries {
+		if e.NId == nid {
+			f(e)
+		}
+	}
+}
+
 func (db *IfDB) Update(portId uint32, f func(e *IfDBEntry) IfDBField) IfDBField {
 	db.lock.Lock()
 	defer db.lock.Unlock()
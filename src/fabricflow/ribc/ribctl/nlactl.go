@@ -261,6 +261,46 @@ func (n *NLAController) GetBridgeVlanInfos(nid uint8, ifindex int, f func(*nlams
 	}
 }
 
+func (n *NLAController) GetRules(nid uint8, f func(*nlamsg.Rule) error) error {
+	stream, err := n.client.GetRules(context.Background(), nlaapi.NewGetRulesRequest(nid))
+	if err != nil {
+		return err
+	}
+
+	for {
+		rule, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := f(rule.ToNative()); err != nil {
+			return err
+		}
+	}
+}
+
+func (n *NLAController) GetMroutes(nid uint8, f func(*nlamsg.Mroute) error) error {
+	stream, err := n.client.GetMroutes(context.Background(), nlaapi.NewGetMroutesRequest(nid))
+	if err != nil {
+		return err
+	}
+
+	for {
+		mroute, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := f(mroute.ToNative()); err != nil {
+			return err
+		}
+	}
+}
+
 func (n *NLAController) ModLinkStatus(nid uint8, ifname string, operState string) error {
 	link := nlaapi.NewDeviceLink(nid, 0)
 	attr := link.GetDevice().GetLinkAttrs()
@@ -0,0 +1,122 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fabricflow/bfd/api/bfdapi"
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+	"net"
+)
+
+//
+// SetBFD sets bfd controller. it must be called before Start.
+//
+func (r *RIBController) SetBFD(bfd *BFDController) {
+	r.bfd = bfd
+}
+
+func (r *RIBController) bfdConn() <-chan net.IP {
+	if r.bfd == nil {
+		return nil
+	}
+	return r.bfd.Conn()
+}
+
+func (r *RIBController) bfdRecv() <-chan *bfdapi.Session {
+	if r.bfd == nil {
+		return nil
+	}
+	return r.bfd.Recv()
+}
+
+//
+// BFDDisconnected restores routes via failed peers
+// because state of sessions is unknown.
+//
+func (r *RIBController) BFDDisconnected() {
+	for _, peer := range r.bfddb.Clear() {
+		r.log.Infof("BFD: peer %s restored (disconnected)", peer)
+		r.SendBFDPeerRouteFlows(peer, false)
+	}
+}
+
+//
+// BFDSession updates routes via peer if forwarding path is changed.
+//
+func (r *RIBController) BFDSession(session *bfdapi.Session) {
+	peer := session.GetPeerIP()
+	if peer == nil {
+		r.log.Warnf("BFD: invalid peer. %v", session)
+		return
+	}
+
+	down := session.IsPathDown()
+	if !r.bfddb.Update(peer, down) {
+		return
+	}
+
+	r.log.Infof("BFD: peer %s %s down:%t", peer, session.GetState(), down)
+	r.SendBFDPeerRouteFlows(peer, down)
+}
+
+//
+// SendBFDPeerRouteFlows updates IP routes whose gateway is peer.
+// ECMP routes are modified to exclude (or include) nexthop of peer,
+// and single path routes are deleted (or added).
+//
+func (r *RIBController) SendBFDPeerRouteFlows(peer net.IP, down bool) {
+	routes := []*nlamsg.Route{}
+	r.nla.GetRoutes(r.nid, func(route *nlamsg.Route) error {
+		if route.GetDst() == nil || route.GetEncap() != nil {
+			return nil
+		}
+
+		for _, gw := range route.GetGws() {
+			if gw.Equal(peer) {
+				routes = append(routes, route)
+				break
+			}
+		}
+		return nil
+	})
+
+	for _, route := range routes {
+		if route.IsMultiPath() {
+			cmd := fibcapi.FlowMod_ADD
+			if _, ok := r.ecmpdb.Select(NewEcmpRouteKey(r.routeVrf(route), route.GetDst())); ok {
+				cmd = fibcapi.FlowMod_MODIFY
+			}
+
+			if err := r.SendEcmpRouteFlows(cmd, route); err != nil {
+				r.log.Errorf("BFD: Unicast Routing(ECMP) error. %s %s", route.GetDst(), err)
+			}
+
+			continue
+		}
+
+		cmd := fibcapi.FlowMod_ADD
+		if down {
+			cmd = fibcapi.FlowMod_DELETE
+		}
+
+		if err := r.SendUnicastRoutingFlow(cmd, route); err != nil {
+			r.log.Errorf("BFD: Unicast Routing(IP) error. %s %s", route.GetDst(), err)
+		}
+	}
+}
@@ -21,6 +21,8 @@ import (
 	fibcapi "fabricflow/fibc/api"
 	"gonla/nlamsg"
 	"net"
+
+	"golang.org/x/sys/unix"
 )
 
 func checkIfaceIPNet(ip *net.IPNet) bool {
@@ -210,6 +212,29 @@ func (r *RIBController) SendTermMACFlow(cmd fibcapi.FlowMod_Cmd, link *nlamsg.Li
 	return nil
 }
 
+//
+// Term MAC flow (VRRP / anycast gateway mac address of macvlan device)
+//
+func NewTermMACFlowsVirtualMAC(hwaddr net.HardwareAddr, parent *IfDBEntry) []*fibcapi.TerminationMacFlow {
+	a := []*fibcapi.TerminationMacFlow_Action{}
+	flows := []*fibcapi.TerminationMacFlow{}
+	for _, ethType := range []uint32{fibcapi.ETHTYPE_IPV4, fibcapi.ETHTYPE_IPV6} {
+		m := fibcapi.NewTermMACMatch(parent.PortId(), ethType, hwaddr.String(), parent.Vid)
+		flows = append(flows, fibcapi.NewTermMACFlow(m, a, uint32(fibcapi.FlowMod_UNICAST_ROUTING)))
+	}
+	return flows
+}
+
+func (r *RIBController) SendTermMACFlowVirtualMAC(cmd fibcapi.FlowMod_Cmd, hwaddr net.HardwareAddr, parent *IfDBEntry) error {
+	for _, f := range NewTermMACFlowsVirtualMAC(hwaddr, parent) {
+		if err := r.fib.FlowMod(f.ToMod(cmd, r.reId)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 //
 // MPLS Flow (POP single label for VRF)
 //
@@ -302,8 +327,8 @@ func (r *RIBController) SendUnicastRoutingFlowNeigh(cmd fibcapi.FlowMod_Cmd, nei
 //
 // Unicast Routing (for Route)
 //
-func NewUnicastRoutingFlow(neigh *nlamsg.Neigh, route *nlamsg.Route) *fibcapi.UnicastRoutingFlow {
-	m := fibcapi.NewUnicastRoutingMatchRoute(route.GetDst(), route.NId)
+func NewUnicastRoutingFlow(neigh *nlamsg.Neigh, route *nlamsg.Route, vrf uint8) *fibcapi.UnicastRoutingFlow {
+	m := fibcapi.NewUnicastRoutingMatchRoute(route.GetDst(), vrf)
 	return fibcapi.NewUnicastRoutingFlow(m, nil, fibcapi.GroupMod_L3_UNICAST, NewNeighId(neigh))
 }
 
@@ -322,7 +347,20 @@ func (r *RIBController) SendUnicastRoutingFlow(cmd fibcapi.FlowMod_Cmd, route *n
 		return err
 	}
 
-	f := NewUnicastRoutingFlow(neigh, route)
+	f := NewUnicastRoutingFlow(neigh, route, r.routeVrf(route))
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
+//
+// Unicast Routing (for ECMP Route)
+//
+func NewUnicastRoutingFlowECMP(route *nlamsg.Route, vrf uint8, ecmpId uint32) *fibcapi.UnicastRoutingFlow {
+	m := fibcapi.NewUnicastRoutingMatchRoute(route.GetDst(), vrf)
+	return fibcapi.NewUnicastRoutingFlow(m, nil, fibcapi.GroupMod_L3_ECMP, ecmpId)
+}
+
+func (r *RIBController) SendUnicastRoutingFlowECMP(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route, ecmpId uint32) error {
+	f := NewUnicastRoutingFlowECMP(route, r.routeVrf(route), ecmpId)
 	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
 }
 
@@ -356,6 +394,55 @@ func (r *RIBController) SendACLFlowByAddr(cmd fibcapi.FlowMod_Cmd, addr *nlamsg.
 	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
 }
 
+//
+// PolicyACL (match ip_src and set vrf)
+//
+// Only simple rules (ip rule from <prefix> table <table>) are supported.
+// table must be 1 - 251 and mapped to VRF by vrfs, which routes
+// in the table are installed to.
+//
+func ACLRuleVrf(rule *nlamsg.Rule, vrfs *VrfTableDB) (uint8, bool) {
+	if !rule.IsToTable() || rule.Invert {
+		return 0, false
+	}
+
+	if rule.Src == nil || rule.Dst != nil {
+		return 0, false
+	}
+
+	if rule.Mark > 0 || len(rule.IifName) != 0 || len(rule.OifName) != 0 {
+		return 0, false
+	}
+
+	if rule.Table <= 0 || rule.Table >= unix.RT_TABLE_COMPAT {
+		return 0, false
+	}
+
+	return vrfs.Vrf(rule.NId, rule.Table)
+}
+
+func NewACLFlowByRule(rule *nlamsg.Rule, vrf uint8, inPort uint32) *fibcapi.PolicyACLFlow {
+	return fibcapi.NewPolicyACLFlowBySrc(int32(rule.Family), rule.Src, rule.NId, inPort, vrf)
+}
+
+func (r *RIBController) SendACLFlowByRule(cmd fibcapi.FlowMod_Cmd, rule *nlamsg.Rule, inPort uint32) error {
+	vrf, ok := ACLRuleVrf(rule, r.vrfs)
+	if !ok {
+		return nil
+	}
+
+	f := NewACLFlowByRule(rule, vrf, inPort)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
+//
+// PolicyACL (acl rules file)
+//
+func (r *RIBController) SendACLRuleFlow(cmd fibcapi.FlowMod_Cmd, flow *fibcapi.PolicyACLFlow, inPort uint32) error {
+	f := NewACLRuleFlow(flow, inPort)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
 //
 // PolicyACL (default flows of port)
 //
@@ -374,6 +461,19 @@ func (r *RIBController) SendACLFlowByLink(cmd fibcapi.FlowMod_Cmd, link *nlamsg.
 	return nil
 }
 
+//
+// Multicast Routing
+//
+func NewMulticastRoutingFlow(mroute *nlamsg.Mroute, iif *IfDBEntry) *fibcapi.MulticastRoutingFlow {
+	m := fibcapi.NewMulticastRoutingMatch(mroute.Src, mroute.Group, mroute.NId, iif.Vid)
+	return fibcapi.NewMulticastRoutingFlow(m, fibcapi.GroupMod_L3_MULTICAST, mroute.MrId)
+}
+
+func (r *RIBController) SendMulticastRoutingFlow(cmd fibcapi.FlowMod_Cmd, mroute *nlamsg.Mroute, iif *IfDBEntry) error {
+	f := NewMulticastRoutingFlow(mroute, iif)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
 //
 // Bridging
 //
@@ -0,0 +1,117 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+func TestACLRuleVrf(t *testing.T) {
+	_, src, _ := net.ParseCIDR("10.1.0.0/16")
+	_, dst, _ := net.ParseCIDR("10.2.0.0/16")
+
+	newRule := func(table int) *nlamsg.Rule {
+		r := nlamsg.NewRule(nil, 1)
+		r.Family = unix.AF_INET
+		r.Src = src
+		r.Table = table
+		return r
+	}
+
+	vrfs := NewVrfTableDB()
+	vrfs.Set(1, 10, 20)
+	for _, table := range []int{0, unix.RT_TABLE_COMPAT, unix.RT_TABLE_MAIN, unix.RT_TABLE_LOCAL, 1000} {
+		vrfs.Set(1, table, 30)
+	}
+
+	// table(10) differs from nid(1) and vrf(20).
+	if vrf, ok := ACLRuleVrf(newRule(10), vrfs); !ok || vrf != 20 {
+		t.Errorf("ACLRuleVrf unmatch. vrf=%d %t", vrf, ok)
+	}
+
+	// table without vrf mapping.
+	if _, ok := ACLRuleVrf(newRule(11), vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. table=11")
+	}
+
+	for _, table := range []int{0, unix.RT_TABLE_COMPAT, unix.RT_TABLE_MAIN, unix.RT_TABLE_LOCAL, 1000} {
+		if _, ok := ACLRuleVrf(newRule(table), vrfs); ok {
+			t.Errorf("ACLRuleVrf unmatch. table=%d", table)
+		}
+	}
+
+	r := newRule(10)
+	r.Src = nil
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+
+	r = newRule(10)
+	r.Dst = dst
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+
+	r = newRule(10)
+	r.IifName = "eth1"
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+
+	r = newRule(10)
+	r.Mark = 1
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+
+	r = newRule(10)
+	r.Invert = true
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+
+	r = newRule(10)
+	r.Action = nl.FR_ACT_BLACKHOLE
+	if _, ok := ACLRuleVrf(r, vrfs); ok {
+		t.Errorf("ACLRuleVrf unmatch. %s", r)
+	}
+}
+
+func TestNewACLFlowByRule(t *testing.T) {
+	_, src, _ := net.ParseCIDR("10.1.0.0/16")
+
+	r := nlamsg.NewRule(nil, 1)
+	r.Family = unix.AF_INET
+	r.Src = src
+	r.Table = 10
+
+	f := NewACLFlowByRule(r, 10, 0x1234)
+	if m := f.Match; m.IpSrc != "10.1.0.0/16" || m.Vrf != 1 || m.InPort != 0x1234 || m.EthType != unix.ETH_P_IP {
+		t.Errorf("NewACLFlowByRule unmatch. %v", m)
+	}
+	if a := f.Action; a.Name != fibcapi.PolicyACLFlow_Action_SET_VRF || a.Value != 10 {
+		t.Errorf("NewACLFlowByRule unmatch. %v", a)
+	}
+}
@@ -37,8 +37,20 @@ type RIBController struct {
 	nla    *NLAController
 	fib    FIBController
 	flowdb *FlowConfig
+	ecmpdb *EcmpDB
+	pendb  *EcmpPendingDB
+	vtepdb *VtepDB
+	srv6db *SRv6EncapDB
+	bfddb  *BFDPeerDB
+	vmacdb *VirtualMACDB
+	vrfs   *VrfTableDB
 	useNId bool
 	log    *log.Entry
+
+	aclrules  *ACLRuleDB
+	aclReload chan struct{}
+
+	bfd *BFDController
 }
 
 func NewRIBController(nid uint8, reId string, label uint32, useNId bool, nla *NLAController, fib FIBController, flowdb *FlowConfig) *RIBController {
@@ -50,9 +62,42 @@ func NewRIBController(nid uint8, reId string, label uint32, useNId bool, nla *NL
 		fib:    fib,
 		ifdb:   NewIfDB(),
 		flowdb: flowdb,
+		ecmpdb: NewEcmpDB(),
+		pendb:  NewEcmpPendingDB(),
+		vtepdb: NewVtepDB(),
+		srv6db: NewSRv6EncapDB(),
+		bfddb:  NewBFDPeerDB(),
+		vmacdb: NewVirtualMACDB(),
+		vrfs:   NewVrfTableDB(),
 		useNId: useNId,
 		log:    log.WithFields(log.Fields{"module": "RIBController"}),
+
+		aclReload: make(chan struct{}, 1),
+	}
+}
+
+//
+// SetACLRules sets acl rules db. it must be called before Start.
+//
+func (r *RIBController) SetACLRules(db *ACLRuleDB) {
+	r.aclrules = db
+}
+
+//
+// SetVrfTable maps routing table of node to VRF. it must be called before Start.
+//
+func (r *RIBController) SetVrfTable(nid uint8, table int, vrf uint8) {
+	r.vrfs.Set(nid, table, vrf)
+}
+
+//
+// routeVrf returns VRF which route is installed to.
+//
+func (r *RIBController) routeVrf(route *nlamsg.Route) uint8 {
+	if vrf, ok := r.vrfs.Vrf(route.NId, route.Table); ok {
+		return vrf
 	}
+	return route.NId
 }
 
 func (r *RIBController) Serve(done <-chan struct{}) {
@@ -80,6 +125,17 @@ func (r *RIBController) Serve(done <-chan struct{}) {
 				r.log.Errorf("Serve: Dispatch error. %v %s", r, err)
 			}
 
+		case <-r.aclReload:
+			r.ReloadACLRules()
+
+		case conn := <-r.bfdConn():
+			if conn == nil {
+				r.BFDDisconnected()
+			}
+
+		case session := <-r.bfdRecv():
+			r.BFDSession(session)
+
 		case <-done:
 			r.log.Infof("Serve: Exit")
 			return
@@ -88,6 +144,15 @@ func (r *RIBController) Serve(done <-chan struct{}) {
 }
 
 func (r *RIBController) Start(done <-chan struct{}) {
+	if r.aclrules != nil {
+		r.aclrules.Watch(func() {
+			select {
+			case r.aclReload <- struct{}{}:
+			default:
+			}
+		})
+	}
+
 	go r.Serve(done)
 }
 
@@ -96,6 +161,7 @@ func (r *RIBController) FIBCConnected() {
 
 	r.ifdb.Clear()
 	r.SendHello()
+	r.ReloadACLRules()
 	nlmsg := nlamsg.NetlinkMessage{}
 	nlmsg.Header.Type = unix.RTM_NEWLINK
 	r.nla.GetLinks(nlamsg.NODE_ID_ALL, func(link *nlamsg.Link) error {
@@ -182,6 +248,43 @@ func (r *RIBController) FIBCPortStatus(hdr *fibcnet.Header, msg *fibcapi.PortSta
 			}
 			return nil
 		})
+
+		if r.fib.FIBCType() != FIBCTypeTCP {
+			r.nla.GetRules(nid, func(rule *nlamsg.Rule) error {
+				if err := r.SendACLFlowByRule(fibcapi.FlowMod_ADD, rule, ifentry.PortId()); err != nil {
+					r.log.Errorf("PortStatus: add ACL(Rule) error. %s", err)
+					return err
+				}
+				return nil
+			})
+		}
+
+		if r.fib.FIBCType() != FIBCTypeTCP && ifentry.LinkType == fibcapi.LinkType_DEVICE {
+			if err := r.SendACLRuleFlowsByPort(fibcapi.FlowMod_ADD, ifentry.PortId()); err != nil {
+				r.log.Errorf("PortStatus: add ACL(Rules) error. %s", err)
+			}
+
+			r.ReloadPendingACLRules()
+		}
+
+		r.VirtualMACParentUp(&ifentry)
+
+		r.nla.GetMroutes(nid, func(mroute *nlamsg.Mroute) error {
+			var cmd fibcapi.FlowMod_Cmd
+			switch {
+			case ifentry.Index == mroute.Iif:
+				cmd = fibcapi.FlowMod_ADD
+			case mroute.HasOif(ifentry.Index) && r.ifdb.Associated(nid, mroute.Iif):
+				cmd = fibcapi.FlowMod_MODIFY
+			default:
+				return nil
+			}
+
+			if err := r.SendMrouteFlows(cmd, mroute); err != nil {
+				r.log.Errorf("PortStatus: %s MROUTE error. %s", cmd, err)
+			}
+			return nil
+		})
 	}
 }
 
@@ -230,11 +333,18 @@ func (r *RIBController) NetlinkLink(nlmsg *nlamsg.NetlinkMessage, link *nlamsg.L
 
 	msgType := nlmsg.Type()
 
+	// macvlan device is not associated with dp port.
+	if ok := r.VirtualMACLink(GetFlowCmd(msgType), link); ok {
+		return
+	}
+
 	// RTM_NEWLINK
 	if msgType == unix.RTM_NEWLINK {
 		r.ifdb.Set(NewIfDBEntryFromLink(link))
 		r.log.Debugf("LINK: %d/'%s' registered to ifmap.", link.NId, link.Attrs().Name)
 
+		r.VxlanLink(fibcapi.FlowMod_ADD, link)
+
 		if err := r.SendPortConfig("ADD", link); err != nil {
 			r.log.Errorf("LINK: Add PortConfig error. %v %s", link, err)
 		}
@@ -248,6 +358,8 @@ func (r *RIBController) NetlinkLink(nlmsg *nlamsg.NetlinkMessage, link *nlamsg.L
 			r.log.Errorf("LINK: Link Flows error. %s", err)
 		}
 
+		r.VxlanLink(fibcapi.FlowMod_DELETE, link)
+
 		r.ifdb.Delete(NewPortId(link))
 		r.log.Debugf("LINK: %d/'%s' unregistered from ifmap.", link.NId, link.Attrs().Name)
 
@@ -259,6 +371,8 @@ func (r *RIBController) NetlinkLink(nlmsg *nlamsg.NetlinkMessage, link *nlamsg.L
 	}
 
 	// RTM_SETLINK
+	r.VxlanLink(fibcapi.FlowMod_MODIFY, link)
+
 	var (
 		ifeOld IfDBEntry
 		ifeNew IfDBEntry
@@ -320,6 +434,14 @@ func (r *RIBController) NetlinkLink(nlmsg *nlamsg.NetlinkMessage, link *nlamsg.L
 				}
 			}
 		}
+	} else {
+		switch ifeNew.LinkType {
+		case fibcapi.LinkType_BOND, fibcapi.LinkType_BOND_SLAVE:
+			// bonding mode or slave (LACP) state may be changed.
+			if err := r.SendBondAttrs(link, &ifeNew); err != nil {
+				r.log.Errorf("LINK: Bond attrs error. %v %s", link, err)
+			}
+		}
 	}
 
 	r.log.Debugf("LINK: OK (associated) %v", link)
@@ -329,6 +451,11 @@ func (r *RIBController) NetlinkAddr(nlmsg *nlamsg.NetlinkMessage, addr *nlamsg.A
 	r.log.Debugf("ADDR: NId:%d AdId:%d", addr.NId, addr.AdId)
 
 	cmd := GetFlowCmd(nlmsg.Type())
+	if ok := r.VirtualMACAddr(cmd, addr); ok {
+		r.log.Debugf("ADDR: OK (macvlan) %s %v", cmd, addr)
+		return
+	}
+
 	if err := r.SendAddrFlows(cmd, addr); err != nil {
 		r.log.Errorf("ADDR: %s error. %v %s", cmd, addr, err)
 	}
@@ -339,16 +466,27 @@ func (r *RIBController) NetlinkAddr(nlmsg *nlamsg.NetlinkMessage, addr *nlamsg.A
 func (r *RIBController) NetlinkNeigh(nlmsg *nlamsg.NetlinkMessage, neigh *nlamsg.Neigh) {
 	r.log.Debugf("NEIGH: NId;%d NeId:%d", neigh.NId, neigh.NeId)
 
-	if ok := r.ifdb.Associated(neigh.NId, neigh.LinkIndex); !ok {
+	// vxlan device is not associated with dp port.
+	if ok := neigh.IsVtepEntry() || r.ifdb.Associated(neigh.NId, neigh.LinkIndex); !ok {
 		r.log.Warnf("NEIGH: Ifindex not found. Neigh %s", neigh)
 		return
 	}
 
 	cmd := GetFlowCmd(nlmsg.Type())
+
+	// ECMP groups must not refer L3 unicast group of neigh to be deleted.
+	if cmd == fibcapi.FlowMod_DELETE && !neigh.IsFdbEntry() {
+		r.SendEcmpNeighDeletedRouteFlows(neigh)
+	}
+
 	if err := r.SendNeighFlows(cmd, neigh); err != nil {
 		r.log.Errorf("NEIGH: %s error. %v %s", cmd, neigh, err)
 	}
 
+	if cmd != fibcapi.FlowMod_DELETE && !neigh.IsFdbEntry() {
+		r.SendEcmpPendingRouteFlows(neigh)
+	}
+
 	r.log.Debugf("NEIGH: OK %s %v", cmd, neigh)
 }
 
@@ -360,6 +498,11 @@ func (r *RIBController) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg
 		return
 	}
 
+	if _, ok := r.vrfs.Vrf(route.NId, route.Table); !ok {
+		r.log.Debugf("ROUTE: VRF of table not found. Route %s", route)
+		return
+	}
+
 	cmd := GetFlowCmd(nlmsg.Type())
 	if err := r.SendRouteFlows(cmd, route); err != nil {
 		r.log.Errorf("ROUTE: %s error. %v %s", cmd, route, err)
@@ -368,10 +511,47 @@ func (r *RIBController) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg
 	r.log.Debugf("ROUTE: OK %s %v", cmd, route)
 }
 
+func (r *RIBController) NetlinkRule(nlmsg *nlamsg.NetlinkMessage, rule *nlamsg.Rule) {
+	r.log.Debugf("RULE: NId:%d Prio:%d", rule.NId, rule.Priority)
+
+	if _, ok := ACLRuleVrf(rule, r.vrfs); !ok {
+		r.log.Debugf("RULE: not supported. %s", rule)
+		return
+	}
+
+	cmd := GetFlowCmd(nlmsg.Type())
+	if err := r.SendRuleFlows(cmd, rule); err != nil {
+		r.log.Errorf("RULE: %s error. %v %s", cmd, rule, err)
+	}
+
+	r.log.Debugf("RULE: OK %s %v", cmd, rule)
+}
+
+func (r *RIBController) NetlinkMroute(nlmsg *nlamsg.NetlinkMessage, mroute *nlamsg.Mroute) {
+	r.log.Debugf("MROUTE: NId:%d MrId:%d", mroute.NId, mroute.MrId)
+
+	if ok := r.ifdb.Associated(mroute.NId, mroute.Iif); !ok {
+		r.log.Warnf("MROUTE: Ifindex not found. Mroute %s", mroute)
+		return
+	}
+
+	cmd := GetFlowCmd(nlmsg.Type())
+	if err := r.SendMrouteFlows(cmd, mroute); err != nil {
+		r.log.Errorf("MROUTE: %s error. %v %s", cmd, mroute, err)
+	}
+
+	r.log.Debugf("MROUTE: OK %s %v", cmd, mroute)
+}
+
 func (r *RIBController) NetlinkBridgeVlanInfo(nlmsg *nlamsg.NetlinkMessage, brvlan *nlamsg.BridgeVlanInfo) {
 	r.log.Debugf("BRVLAN: NId:%d BrId:%d", brvlan.NId, brvlan.BrId)
 
 	cmd := GetFlowCmd(nlmsg.Type())
+	if ok := r.VxlanBridgeVlanInfo(cmd, brvlan); ok {
+		r.log.Debugf("BRVLAN: OK %s vxlan %v", cmd, brvlan)
+		return
+	}
+
 	if err := r.SendBridgeVlanFlows(cmd, brvlan); err != nil {
 		r.log.Errorf("BRVLAN: %s error.", brvlan)
 	}
@@ -402,6 +582,23 @@ func (r *RIBController) SendBondSlaveFlows(cmd fibcapi.FlowMod_Cmd, link *nlamsg
 	return nil
 }
 
+//
+// SendBondAttrs sends L2 Interface Group (MODIFY) with bond or bond slave attributes.
+//
+func (r *RIBController) SendBondAttrs(link *nlamsg.Link, ife *IfDBEntry) error {
+	r.log.Debugf("BondAttrs: %v", link)
+
+	var ifeMaster IfDBEntry
+	if ife.LinkType == fibcapi.LinkType_BOND_SLAVE {
+		if ok := r.ifdb.SelectBy(&ifeMaster, ife.NId, ife.MasterIndex); !ok {
+			r.log.Errorf("BondAttrs: master not found. %d/%d", ife.NId, ife.MasterIndex)
+			return fmt.Errorf("master not found. %d/%d", ife.NId, ife.MasterIndex)
+		}
+	}
+
+	return r.SendL2InterfaceGroup(fibcapi.GroupMod_MODIFY, link, &ifeMaster)
+}
+
 func (r *RIBController) SendLinkFlows(cmd fibcapi.FlowMod_Cmd, link *nlamsg.Link) error {
 	r.log.Debugf("LinkFlows: %s %v", cmd, link)
 
@@ -492,6 +689,209 @@ func (r *RIBController) SendAddrFlows(cmd fibcapi.FlowMod_Cmd, addr *nlamsg.Addr
 	return nil
 }
 
+func (r *RIBController) SendRuleFlows(cmd fibcapi.FlowMod_Cmd, rule *nlamsg.Rule) error {
+	r.log.Debugf("RuleFlows: %s %s", cmd, rule)
+
+	if r.fib.FIBCType() == FIBCTypeTCP {
+		// for openflow mode.
+		return r.SendACLFlowByRule(cmd, rule, 0)
+	}
+
+	ports := []uint32{}
+	r.ifdb.ListByNId(rule.NId, func(e *IfDBEntry) {
+		if e.Associated && e.LinkType == fibcapi.LinkType_DEVICE {
+			ports = append(ports, e.PortId())
+		}
+	})
+
+	for _, port := range ports {
+		if err := r.SendACLFlowByRule(cmd, rule, port); err != nil {
+			r.log.Errorf("RuleFlows: ACL Flow(Rule) error. port:%d %s", port, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RIBController) SendMrouteFlows(cmd fibcapi.FlowMod_Cmd, mroute *nlamsg.Mroute) error {
+	r.log.Debugf("MrouteFlows: %s %s", cmd, mroute)
+
+	var iif IfDBEntry
+	if ok := r.ifdb.SelectBy(&iif, mroute.NId, mroute.Iif); !ok {
+		r.log.Errorf("MrouteFlows: iif not found. %s", mroute)
+		return fmt.Errorf("iif not found. %s", mroute)
+	}
+
+	oifs := []*IfDBEntry{}
+	for _, oif := range mroute.Oifs {
+		if oif.Index == mroute.Iif {
+			continue
+		}
+
+		var ife IfDBEntry
+		if ok := r.ifdb.SelectBy(&ife, mroute.NId, oif.Index); !ok {
+			r.log.Warnf("MrouteFlows: oif not found. ifindex:%d %s", oif.Index, mroute)
+			continue
+		}
+
+		oifs = append(oifs, &ife)
+	}
+
+	grpCmd := FlowCmdToGroupCmd(cmd)
+
+	switch cmd {
+	case fibcapi.FlowMod_ADD:
+		if err := r.SendL3MulticastGroup(grpCmd, mroute, &iif, oifs); err != nil {
+			r.log.Errorf("MrouteFlows: L3 Multicast group error. %s", err)
+			return err
+		}
+
+		if err := r.SendMulticastRoutingFlow(cmd, mroute, &iif); err != nil {
+			r.log.Errorf("MrouteFlows: Multicast routing flow error. %s", err)
+			return err
+		}
+
+	case fibcapi.FlowMod_MODIFY:
+		// only oifs are changed. (see NLAMasterService.NetlinkMroute)
+		if err := r.SendL3MulticastGroup(grpCmd, mroute, &iif, oifs); err != nil {
+			r.log.Errorf("MrouteFlows: L3 Multicast group error. %s", err)
+			return err
+		}
+
+	case fibcapi.FlowMod_DELETE:
+		if err := r.SendMulticastRoutingFlow(cmd, mroute, &iif); err != nil {
+			r.log.Errorf("MrouteFlows: Multicast routing flow error. %s", err)
+			return err
+		}
+
+		if err := r.SendL3MulticastGroup(grpCmd, mroute, &iif, oifs); err != nil {
+			r.log.Errorf("MrouteFlows: L3 Multicast group error. %s", err)
+			return err
+		}
+
+	default:
+		return fmt.Errorf("Invalid command. %s", cmd)
+	}
+
+	return nil
+}
+
+//
+// ReloadACLRules loads acl rules file and sends flows of rules changed.
+//
+func (r *RIBController) ReloadACLRules() {
+	if r.aclrules == nil {
+		return
+	}
+
+	added, deleted, err := r.aclrules.Load(r.aclRulePortId)
+	if err != nil {
+		r.log.Errorf("ACLRules: load error. %s %s", r.aclrules.Path, err)
+		return
+	}
+
+	pending := r.aclrules.Pending()
+	r.log.Infof("ACLRules: loaded. %s add:%d del:%d pending:%d", r.aclrules.Path, len(added), len(deleted), len(pending))
+
+	for _, msg := range pending {
+		r.log.Warnf("ACLRules: pending. %s", msg)
+	}
+
+	sentDeleted := []*fibcapi.PolicyACLFlow{}
+	for _, flow := range deleted {
+		if err := r.SendACLRuleFlows(fibcapi.FlowMod_DELETE, flow); err != nil {
+			r.log.Errorf("ACLRules: delete error. %s", err)
+			continue
+		}
+		sentDeleted = append(sentDeleted, flow)
+	}
+
+	sentAdded := []*fibcapi.PolicyACLFlow{}
+	for _, flow := range added {
+		if err := r.SendACLRuleFlows(fibcapi.FlowMod_ADD, flow); err != nil {
+			r.log.Errorf("ACLRules: add error. %s", err)
+			continue
+		}
+		sentAdded = append(sentAdded, flow)
+	}
+
+	r.aclrules.Commit(sentAdded, sentDeleted)
+}
+
+//
+// ReloadPendingACLRules loads acl rules file again
+// if some rules are pending (e.g. mirror port not found).
+//
+func (r *RIBController) ReloadPendingACLRules() {
+	if r.aclrules == nil || len(r.aclrules.Pending()) == 0 {
+		return
+	}
+
+	r.ReloadACLRules()
+}
+
+func (r *RIBController) aclRulePortId(ifname string) (uint32, bool) {
+	nid, name := ParseLinkName(ifname)
+	if nid == 0 {
+		nid = r.nid
+	}
+
+	var portId uint32
+	r.nla.GetLinks(nid, func(link *nlamsg.Link) error {
+		if link.Attrs().Name == name {
+			portId = NewPortId(link)
+		}
+		return nil
+	})
+
+	return portId, portId != 0
+}
+
+func (r *RIBController) SendACLRuleFlows(cmd fibcapi.FlowMod_Cmd, flow *fibcapi.PolicyACLFlow) error {
+	r.log.Debugf("ACLRuleFlows: %s %s", cmd, flow)
+
+	if r.fib.FIBCType() == FIBCTypeTCP {
+		// for openflow mode.
+		return r.SendACLRuleFlow(cmd, flow, 0)
+	}
+
+	ports := []uint32{}
+	r.ifdb.List(func(e *IfDBEntry) {
+		if e.Associated && e.LinkType == fibcapi.LinkType_DEVICE {
+			ports = append(ports, e.PortId())
+		}
+	})
+
+	failed := []uint32{}
+	for _, port := range ports {
+		if err := r.SendACLRuleFlow(cmd, flow, port); err != nil {
+			r.log.Errorf("ACLRuleFlows: ACL Flow(Rules) error. port:%d %s", port, err)
+			failed = append(failed, port)
+		}
+	}
+
+	if len(failed) != 0 {
+		return fmt.Errorf("ACL Flow(Rules) error. ports:%v", failed)
+	}
+
+	return nil
+}
+
+func (r *RIBController) SendACLRuleFlowsByPort(cmd fibcapi.FlowMod_Cmd, port uint32) error {
+	if r.aclrules == nil {
+		return nil
+	}
+
+	for _, flow := range r.aclrules.Flows() {
+		if err := r.SendACLRuleFlow(cmd, flow, port); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *RIBController) SendFdbFlows(cmd fibcapi.FlowMod_Cmd, neigh *nlamsg.Neigh) error {
 	r.log.Debugf("FdbFlows: %s %s", cmd, neigh)
 
@@ -516,6 +916,10 @@ func (r *RIBController) SendFdbFlows(cmd fibcapi.FlowMod_Cmd, neigh *nlamsg.Neig
 }
 
 func (r *RIBController) SendNeighFlows(cmd fibcapi.FlowMod_Cmd, neigh *nlamsg.Neigh) error {
+	if neigh.IsVtepEntry() {
+		return r.SendVtepFdbFlows(cmd, neigh)
+	}
+
 	if neigh.IsFdbEntry() {
 		return r.SendFdbFlows(cmd, neigh)
 	}
@@ -559,12 +963,58 @@ func (r *RIBController) SendNeighFlows(cmd fibcapi.FlowMod_Cmd, neigh *nlamsg.Ne
 func (r *RIBController) SendRouteFlows(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route) error {
 	r.log.Debugf("RouteFlows: %s %v", cmd, route)
 
+	if route.GetSEG6LocalEncap() != nil {
+		// SRv6 Local SID
+		//  SRv6 Local SID flow (End, End.T, End.DT4/DT6)
+		//  SRv6 Local SID flow (End.X, End.DX4/DX6)
+		//   -> L3 Unicast (0x20VVNNNN) V:VRF/N:NeId
+		if err := r.SendSRv6LocalSidFlow(cmd, route); err != nil {
+			r.log.Errorf("RouteFlows: SRv6 Local SID error. %s", err)
+			return err
+		}
+
+		return nil
+	}
+
+	if route.GetSEG6Encap() != nil {
+		// SRv6 Encap (H.Encaps/H.Insert)
+		//  Unicast Routing flow
+		//   -> SRv6 Encap (0xC1NNNNNN) N:EncapId
+		//    -> L3 Unicast (0x20VVNNNN) V:VRF/N:NeId
+		if err := r.SendSRv6EncapRouteFlows(cmd, route); err != nil {
+			r.log.Errorf("RouteFlows: Unicast Routing(SRv6) error. %s", err)
+			return err
+		}
+
+		return nil
+	}
+
 	if route.GetDst() != nil {
 		if route.GetMPLSEncap() == nil {
-			// IP Routing
-			if err := r.SendUnicastRoutingFlow(cmd, route); err != nil {
-				r.log.Errorf("RouteFlows: Unicast Routing(IP) error. %s", err)
-				return err
+			if route.IsMultiPath() {
+				// IP Routing (ECMP)
+				//  Unicast Routing flow
+				//   -> L3 ECMP (0x7NNNNNNN) N:EcmpId
+				//    -> L3 Unicast (0x20VVNNNN) V:VRF/N:NeId (per nexthop)
+				if err := r.SendEcmpRouteFlows(cmd, route); err != nil {
+					r.log.Errorf("RouteFlows: Unicast Routing(ECMP) error. %s", err)
+					return err
+				}
+			} else {
+				// IP Routing
+				if cmd != fibcapi.FlowMod_DELETE && r.bfddb.IsDown(route.GetGw()) {
+					r.log.Debugf("RouteFlows: bfd down. %s via %s", route.GetDst(), route.GetGw())
+					if cmd == fibcapi.FlowMod_MODIFY {
+						// route replaced by nexthop of bfd down is removed.
+						return r.SendUnicastRoutingFlow(fibcapi.FlowMod_DELETE, route)
+					}
+					return nil
+				}
+
+				if err := r.SendUnicastRoutingFlow(cmd, route); err != nil {
+					r.log.Errorf("RouteFlows: Unicast Routing(IP) error. %s", err)
+					return err
+				}
 			}
 		} else {
 			// PUSH (single label)
@@ -669,6 +1119,177 @@ func (r *RIBController) SendRouteFlows(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Ro
 	return nil
 }
 
+func (r *RIBController) SendEcmpRouteFlows(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route) error {
+	return r.sendEcmpRouteFlows(cmd, route, nil)
+}
+
+//
+// sendEcmpRouteFlows installs multipath route.
+// nexthop of deleted is regarded as unresolved.
+//
+func (r *RIBController) sendEcmpRouteFlows(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route, deleted net.IP) error {
+	if !checkRouteIPNet(route.GetDst()) {
+		return nil
+	}
+
+	key := NewEcmpRouteKey(r.routeVrf(route), route.GetDst())
+
+	if FlowCmdToGroupCmd(cmd) == fibcapi.GroupMod_DELETE {
+		r.pendb.Delete(key)
+
+		e, ok := r.ecmpdb.Select(key)
+		if !ok {
+			r.log.Debugf("EcmpRouteFlows: ecmp not found. %s", route.GetDst())
+			return nil
+		}
+
+		if err := r.SendUnicastRoutingFlowECMP(cmd, route, e.EcmpId); err != nil {
+			r.log.Errorf("EcmpRouteFlows: Unicast Routing(ECMP) error. %s", err)
+			return err
+		}
+
+		if _, released := r.ecmpdb.Delete(key); released {
+			if err := r.SendL3EcmpGroup(fibcapi.GroupMod_DELETE, e); err != nil {
+				r.log.Errorf("EcmpRouteFlows: L3 ECMP Group error. %s", err)
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	neIds := []uint32{}
+	unresolved := []net.IP{}
+	bfdDowns := 0
+	for _, gw := range route.GetGws() {
+		if r.bfddb.IsDown(gw) {
+			r.log.Debugf("EcmpRouteFlows: bfd down. %s", gw)
+			bfdDowns++
+			continue
+		}
+
+		if deleted != nil && gw.Equal(deleted) {
+			unresolved = append(unresolved, gw)
+			continue
+		}
+
+		neigh, err := r.nla.GetNeigh(route.NId, gw)
+		if err != nil || neigh.NeId == 0 {
+			r.log.Debugf("EcmpRouteFlows: neigh not found. %s", gw)
+			unresolved = append(unresolved, gw)
+			continue
+		}
+		neIds = append(neIds, NewNeighId(neigh))
+	}
+
+	if len(neIds) == 0 && bfdDowns != 0 {
+		// all nexthops are down.
+		return r.SendEcmpRouteFlows(fibcapi.FlowMod_DELETE, route)
+	}
+
+	if len(neIds) == 0 {
+		if _, ok := r.ecmpdb.Select(key); ok {
+			// all nexthops are removed.
+			if err := r.SendEcmpRouteFlows(fibcapi.FlowMod_DELETE, route); err != nil {
+				return err
+			}
+		}
+
+		r.pendb.Set(key, route, unresolved)
+		return fmt.Errorf("neighbors not found. %s", route.GetDst())
+	}
+
+	// route is installed again when unresolved neighbors are resolved.
+	r.pendb.Set(key, route, unresolved)
+
+	if len(unresolved) != 0 {
+		r.log.Warnf("EcmpRouteFlows: %s installed without unresolved nexthops %v", route.GetDst(), unresolved)
+	}
+
+	e, created, released := r.ecmpdb.Add(key, neIds)
+
+	r.log.Debugf("EcmpRouteFlows: %s %s created:%t", route.GetDst(), e, created)
+
+	if created {
+		if err := r.SendL3EcmpGroup(fibcapi.GroupMod_ADD, e); err != nil {
+			r.log.Errorf("EcmpRouteFlows: L3 ECMP Group error. %s", err)
+			r.ecmpdb.Delete(key)
+			return err
+		}
+	}
+
+	if err := r.SendUnicastRoutingFlowECMP(cmd, route, e.EcmpId); err != nil {
+		r.log.Errorf("EcmpRouteFlows: Unicast Routing(ECMP) error. %s", err)
+		return err
+	}
+
+	if released != nil {
+		if err := r.SendL3EcmpGroup(fibcapi.GroupMod_DELETE, released); err != nil {
+			r.log.Errorf("EcmpRouteFlows: L3 ECMP Group error. %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// SendEcmpPendingRouteFlows installs multipath routes again
+// which have been installed without nexthop of neigh
+// because neigh was not resolved.
+//
+func (r *RIBController) SendEcmpPendingRouteFlows(neigh *nlamsg.Neigh) {
+	if neigh.NeId == 0 {
+		return
+	}
+
+	for _, route := range r.pendb.Take(neigh.NId, neigh.IP) {
+		cmd := fibcapi.FlowMod_ADD
+		if _, ok := r.ecmpdb.Select(NewEcmpRouteKey(r.routeVrf(route), route.GetDst())); ok {
+			cmd = fibcapi.FlowMod_MODIFY
+		}
+
+		r.log.Infof("EcmpRouteFlows: %s nexthop %s resolved.", route.GetDst(), neigh.IP)
+
+		if err := r.SendEcmpRouteFlows(cmd, route); err != nil {
+			r.log.Errorf("EcmpRouteFlows: Unicast Routing(ECMP) error. %s %s", route.GetDst(), err)
+		}
+	}
+}
+
+//
+// SendEcmpNeighDeletedRouteFlows removes nexthop of neigh
+// from multipath routes which refer it.
+// Routes are installed again when neigh is resolved.
+//
+func (r *RIBController) SendEcmpNeighDeletedRouteFlows(neigh *nlamsg.Neigh) {
+	if neigh.NeId == 0 {
+		return
+	}
+
+	neId := NewNeighId(neigh)
+	routes := []*nlamsg.Route{}
+	r.nla.GetRoutes(neigh.NId, func(route *nlamsg.Route) error {
+		if !route.IsMultiPath() || route.GetDst() == nil || route.GetEncap() != nil {
+			return nil
+		}
+
+		e, ok := r.ecmpdb.Select(NewEcmpRouteKey(r.routeVrf(route), route.GetDst()))
+		if ok && e.HasNeId(neId) {
+			routes = append(routes, route)
+		}
+		return nil
+	})
+
+	for _, route := range routes {
+		r.log.Infof("EcmpRouteFlows: %s nexthop %s deleted.", route.GetDst(), neigh.IP)
+
+		if err := r.sendEcmpRouteFlows(fibcapi.FlowMod_MODIFY, route, neigh.IP); err != nil {
+			r.log.Errorf("EcmpRouteFlows: Unicast Routing(ECMP) error. %s %s", route.GetDst(), err)
+		}
+	}
+}
+
 func (r *RIBController) SendLoopbackFlows(cmd fibcapi.FlowMod_Cmd, nid uint8, inPort uint32) error {
 	r.log.Debugf("LoFlows: %s nid:%d in_port:%d", cmd, nid, inPort)
 
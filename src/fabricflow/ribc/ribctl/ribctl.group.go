@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"gonla/nlamsg"
 	"net"
+
+	"github.com/vishvananda/netlink"
 )
 
 //
@@ -34,7 +36,7 @@ func NewL2InterfaceGroup(link *nlamsg.Link, master *IfDBEntry) *fibcapi.L2Interf
 		}
 		return link.Attrs().HardwareAddr
 	}()
-	return fibcapi.NewL2InterfaceGroup(
+	g := fibcapi.NewL2InterfaceGroup(
 		NewPortId(link),
 		link.VlanId(),
 		false, // vlanTranslation
@@ -43,6 +45,62 @@ func NewL2InterfaceGroup(link *nlamsg.Link, master *IfDBEntry) *fibcapi.L2Interf
 		link.NId,
 		master.PortId(),
 	)
+	g.Bond = NewBondAttrs(link)
+	g.BondSlave = NewBondSlaveAttrs(link)
+	return g
+}
+
+//
+// LACP actor oper port state (IEEE 802.1AX)
+//
+const (
+	lacpStateCollecting   = 1 << 4
+	lacpStateDistributing = 1 << 5
+)
+
+//
+// NewBondAttrs returns bond attributes of bond master link.
+// It returns nil if link is not bond master.
+//
+func NewBondAttrs(link *nlamsg.Link) *fibcapi.BondAttrs {
+	bond := link.Bond()
+	if bond == nil {
+		return nil
+	}
+
+	attrs := &fibcapi.BondAttrs{
+		Mode:       fibcapi.BondAttrs_Mode(bond.Mode),
+		HashPolicy: fibcapi.BondAttrs_HashPolicy(bond.XmitHashPolicy),
+	}
+
+	if bond.MinLinks > 0 {
+		attrs.MinLinks = uint32(bond.MinLinks)
+	}
+
+	if adInfo := bond.AdInfo; adInfo != nil {
+		attrs.AggregatorId = uint32(adInfo.AggregatorId)
+	}
+
+	return attrs
+}
+
+//
+// NewBondSlaveAttrs returns bond slave attributes of bond slave link.
+// It returns nil if link is not bond slave.
+//
+func NewBondSlaveAttrs(link *nlamsg.Link) *fibcapi.BondSlaveAttrs {
+	slave, ok := link.GetSlaveInfo().(*netlink.BondSlave)
+	if !ok {
+		return nil
+	}
+
+	return &fibcapi.BondSlaveAttrs{
+		Active:       slave.State == netlink.BondStateActive,
+		LinkUp:       slave.MiiStatus == netlink.BondLinkUp,
+		AggregatorId: uint32(slave.AggregatorId),
+		Collecting:   (slave.AdActorOperPortState & lacpStateCollecting) != 0,
+		Distributing: (slave.AdActorOperPortState & lacpStateDistributing) != 0,
+	}
 }
 
 func (r *RIBController) SendL2InterfaceGroup(cmd fibcapi.GroupMod_Cmd, link *nlamsg.Link, master *IfDBEntry) error {
@@ -105,6 +163,35 @@ func (r *RIBController) SendL3UnicastGroup(cmd fibcapi.GroupMod_Cmd, neigh *nlam
 	return r.fib.GroupMod(g.ToMod(cmd, r.reId))
 }
 
+//
+// L3 ECMP Group
+//
+func NewL3EcmpGroup(e *EcmpEntry) *fibcapi.L3EcmpGroup {
+	return fibcapi.NewL3EcmpGroup(e.EcmpId, e.NeIds)
+}
+
+func (r *RIBController) SendL3EcmpGroup(cmd fibcapi.GroupMod_Cmd, e *EcmpEntry) error {
+	g := NewL3EcmpGroup(e)
+	return r.fib.GroupMod(g.ToMod(cmd, r.reId))
+}
+
+//
+// L3 Multicast Group
+//
+func NewL3MulticastGroup(mroute *nlamsg.Mroute, iif *IfDBEntry, oifs []*IfDBEntry) *fibcapi.L3MulticastGroup {
+	ports := make([]*fibcapi.L3MulticastGroup_Port, len(oifs))
+	for i, oif := range oifs {
+		ports[i] = fibcapi.NewL3MulticastGroupPort(oif.PortId(), oif.Vid, oif.HardwareAddr)
+	}
+
+	return fibcapi.NewL3MulticastGroup(mroute.MrId, iif.Vid, ports)
+}
+
+func (r *RIBController) SendL3MulticastGroup(cmd fibcapi.GroupMod_Cmd, mroute *nlamsg.Mroute, iif *IfDBEntry, oifs []*IfDBEntry) error {
+	g := NewL3MulticastGroup(mroute, iif, oifs)
+	return r.fib.GroupMod(g.ToMod(cmd, r.reId))
+}
+
 //
 // MPLS Interface Group
 //
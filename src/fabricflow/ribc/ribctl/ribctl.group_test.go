@@ -0,0 +1,79 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"testing"
+
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink"
+)
+
+func TestNewL2InterfaceGroup_Bond(t *testing.T) {
+	bond := netlink.NewLinkBond(netlink.LinkAttrs{Index: 10, Name: "bond0"})
+	bond.Mode = netlink.BOND_MODE_802_3AD
+	bond.XmitHashPolicy = netlink.BOND_XMIT_HASH_POLICY_LAYER3_4
+	bond.MinLinks = 2
+	bond.AdInfo = &netlink.BondAdInfo{AggregatorId: 3}
+
+	g := NewL2InterfaceGroup(&nlamsg.Link{Link: bond, NId: 1, LnId: 5}, &IfDBEntry{})
+	if g.BondSlave != nil {
+		t.Errorf("NewL2InterfaceGroup unmatch. %v", g.BondSlave)
+	}
+
+	if b := g.Bond; b == nil || b.Mode != fibcapi.BondAttrs_IEEE_802_3AD || b.HashPolicy != fibcapi.BondAttrs_LAYER3_4 || b.MinLinks != 2 || b.AggregatorId != 3 {
+		t.Errorf("NewL2InterfaceGroup unmatch. %v", b)
+	}
+}
+
+func TestNewL2InterfaceGroup_BondSlave(t *testing.T) {
+	dev := &netlink.Device{
+		LinkAttrs: netlink.LinkAttrs{
+			Index:       2,
+			Name:        "eth1",
+			MasterIndex: 10,
+			Slave: &netlink.BondSlave{
+				State:                netlink.BondStateActive,
+				MiiStatus:            netlink.BondLinkUp,
+				AggregatorId:         3,
+				AdActorOperPortState: 0x3d, // activity, aggregation, sync, collecting, distributing
+			},
+		},
+	}
+
+	g := NewL2InterfaceGroup(&nlamsg.Link{Link: dev, NId: 1, LnId: 2}, &IfDBEntry{})
+	if g.Bond != nil {
+		t.Errorf("NewL2InterfaceGroup unmatch. %v", g.Bond)
+	}
+
+	if b := g.BondSlave; b == nil || !b.Active || !b.LinkUp || b.AggregatorId != 3 || !b.Collecting || !b.Distributing {
+		t.Errorf("NewL2InterfaceGroup unmatch. %v", b)
+	}
+
+	dev.Slave = &netlink.BondSlave{
+		State:                netlink.BondStateBackup,
+		MiiStatus:            netlink.BondLinkDown,
+		AdActorOperPortState: 0x1d, // collecting only
+	}
+
+	if b := NewBondSlaveAttrs(&nlamsg.Link{Link: dev}); b.Active || b.LinkUp || !b.Collecting || b.Distributing {
+		t.Errorf("NewBondSlaveAttrs unmatch. %v", b)
+	}
+}
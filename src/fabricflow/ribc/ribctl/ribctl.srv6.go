@@ -0,0 +1,188 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"gonla/nlamsg"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+//
+// SRv6 Encap Group
+//
+func NewSRv6EncapGroup(e *SRv6EncapEntry) *fibcapi.SRv6EncapGroup {
+	return fibcapi.NewSRv6EncapGroup(e.EncapId, fibcapi.SRv6EncapGroup_Mode(e.Mode), e.Segments, e.NeId)
+}
+
+func (r *RIBController) SendSRv6EncapGroup(cmd fibcapi.GroupMod_Cmd, e *SRv6EncapEntry) error {
+	g := NewSRv6EncapGroup(e)
+	return r.fib.GroupMod(g.ToMod(cmd, r.reId))
+}
+
+//
+// Unicast Routing (for SRv6 encap)
+//
+func NewUnicastRoutingFlowSRv6(route *nlamsg.Route, encapId uint32) *fibcapi.UnicastRoutingFlow {
+	m := fibcapi.NewUnicastRoutingMatchRoute(route.GetDst(), route.NId)
+	return fibcapi.NewUnicastRoutingFlow(m, nil, fibcapi.GroupMod_SRV6_ENCAP, encapId)
+}
+
+func (r *RIBController) SendUnicastRoutingFlowSRv6(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route, encapId uint32) error {
+	f := NewUnicastRoutingFlowSRv6(route, encapId)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
+//
+// GetSRv6UnderlayNeigh returns neighbor to forward encapsulated packets.
+// it is gateway of route if exists, otherwise it is resolved by first SID.
+//
+func (r *RIBController) GetSRv6UnderlayNeigh(route *nlamsg.Route, seg6 *netlink.SEG6Encap) (*nlamsg.Neigh, error) {
+	if gw := route.GetGw(); gw != nil {
+		return r.nla.GetNeigh(route.NId, gw)
+	}
+
+	n := len(seg6.Segments)
+	if n == 0 {
+		return nil, fmt.Errorf("segments not found. %s", route.GetDst())
+	}
+
+	return r.GetUnderlayNeigh(route.NId, seg6.Segments[n-1])
+}
+
+func (r *RIBController) SendSRv6EncapRouteFlows(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route) error {
+	if !checkRouteIPNet(route.GetDst()) {
+		return nil
+	}
+
+	key := NewSRv6RouteKey(route.NId, route.GetDst())
+
+	if FlowCmdToGroupCmd(cmd) == fibcapi.GroupMod_DELETE {
+		e, ok := r.srv6db.Select(key)
+		if !ok {
+			r.log.Debugf("SRv6EncapRouteFlows: encap not found. %s", route.GetDst())
+			return nil
+		}
+
+		if err := r.SendUnicastRoutingFlowSRv6(cmd, route, e.EncapId); err != nil {
+			r.log.Errorf("SRv6EncapRouteFlows: Unicast Routing(SRv6) error. %s", err)
+			return err
+		}
+
+		if _, released := r.srv6db.Delete(key); released {
+			if err := r.SendSRv6EncapGroup(fibcapi.GroupMod_DELETE, e); err != nil {
+				r.log.Errorf("SRv6EncapRouteFlows: SRv6 Encap Group error. %s", err)
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	seg6 := route.GetSEG6Encap()
+	neigh, err := r.GetSRv6UnderlayNeigh(route, seg6)
+	if err != nil || neigh.NeId == 0 {
+		return fmt.Errorf("underlay neighbor not found. %s", route.GetDst())
+	}
+
+	e, created, released := r.srv6db.Add(key, seg6.Mode, seg6.Segments, NewNeighId(neigh))
+
+	r.log.Debugf("SRv6EncapRouteFlows: %s %s created:%t", route.GetDst(), e, created)
+
+	if created {
+		if err := r.SendSRv6EncapGroup(fibcapi.GroupMod_ADD, e); err != nil {
+			r.log.Errorf("SRv6EncapRouteFlows: SRv6 Encap Group error. %s", err)
+			r.srv6db.Delete(key)
+			return err
+		}
+	}
+
+	if err := r.SendUnicastRoutingFlowSRv6(cmd, route, e.EncapId); err != nil {
+		r.log.Errorf("SRv6EncapRouteFlows: Unicast Routing(SRv6) error. %s", err)
+		return err
+	}
+
+	if released != nil {
+		if err := r.SendSRv6EncapGroup(fibcapi.GroupMod_DELETE, released); err != nil {
+			r.log.Errorf("SRv6EncapRouteFlows: SRv6 Encap Group error. %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// SRv6 Local SID Flow
+//
+func NewSRv6LocalSidAction(route *nlamsg.Route, seg6local *netlink.SEG6LocalEncap) (*fibcapi.SRv6LocalSidFlow_Action, net.IP, error) {
+	switch seg6local.Action {
+	case nl.SEG6_LOCAL_ACTION_END:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END, 0), nil, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_X:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_X, 0), seg6local.In6Addr, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_T:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_T, uint32(route.NId)), nil, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_DX6:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_DX6, 0), seg6local.In6Addr, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_DX4:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_DX4, 0), seg6local.InAddr, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_DT6:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_DT6, uint32(route.NId)), nil, nil
+
+	case nl.SEG6_LOCAL_ACTION_END_DT4:
+		return fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_DT4, uint32(route.NId)), nil, nil
+
+	default:
+		return nil, nil, fmt.Errorf("seg6local action not supported. %d", seg6local.Action)
+	}
+}
+
+func NewSRv6LocalSidFlow(route *nlamsg.Route, action *fibcapi.SRv6LocalSidFlow_Action, neigh *nlamsg.Neigh) *fibcapi.SRv6LocalSidFlow {
+	m := fibcapi.NewSRv6LocalSidMatch(route.GetDst(), route.NId)
+	if neigh == nil {
+		return fibcapi.NewSRv6LocalSidFlow(m, action, fibcapi.GroupMod_UNSPEC, 0)
+	}
+	return fibcapi.NewSRv6LocalSidFlow(m, action, fibcapi.GroupMod_L3_UNICAST, NewNeighId(neigh))
+}
+
+func (r *RIBController) SendSRv6LocalSidFlow(cmd fibcapi.FlowMod_Cmd, route *nlamsg.Route) error {
+	action, nexthop, err := NewSRv6LocalSidAction(route, route.GetSEG6LocalEncap())
+	if err != nil {
+		return err
+	}
+
+	var neigh *nlamsg.Neigh
+	if nexthop != nil {
+		if neigh, err = r.nla.GetNeigh_FlowMod(cmd, route.NId, nexthop); err != nil {
+			return err
+		}
+	}
+
+	f := NewSRv6LocalSidFlow(route, action, neigh)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
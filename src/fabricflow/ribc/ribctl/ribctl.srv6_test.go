@@ -0,0 +1,129 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+func newTestSRv6Route(dst string, encap netlink.Encap) *nlamsg.Route {
+	_, ipnet, _ := net.ParseCIDR(dst)
+	return &nlamsg.Route{
+		Route: &netlink.Route{Dst: ipnet, Encap: encap},
+		NId:   1,
+	}
+}
+
+func TestNewSRv6EncapGroup(t *testing.T) {
+	e := &SRv6EncapEntry{
+		EncapId:  5,
+		Mode:     nl.SEG6_IPTUN_MODE_ENCAP,
+		Segments: []net.IP{net.ParseIP("fc00:2::1"), net.ParseIP("fc00:1::1")},
+		NeId:     0x10002,
+	}
+
+	g := NewSRv6EncapGroup(e)
+
+	if g.EncapId != 5 || g.Mode != fibcapi.SRv6EncapGroup_ENCAP || g.NeId != 0x10002 {
+		t.Errorf("NewSRv6EncapGroup unmatch. %v", g)
+	}
+	if len(g.Segments) != 2 || g.Segments[0] != "fc00:2::1" || g.Segments[1] != "fc00:1::1" {
+		t.Errorf("NewSRv6EncapGroup unmatch. %v", g.Segments)
+	}
+}
+
+func TestNewUnicastRoutingFlowSRv6(t *testing.T) {
+	route := newTestSRv6Route("10.0.1.0/24", &netlink.SEG6Encap{})
+
+	f := NewUnicastRoutingFlowSRv6(route, 5)
+
+	if f.GType != fibcapi.GroupMod_SRV6_ENCAP || f.GId != 5 {
+		t.Errorf("NewUnicastRoutingFlowSRv6 unmatch. %v", f)
+	}
+	if m := f.Match; m.IpDst != "10.0.1.0/24" || m.Vrf != 1 {
+		t.Errorf("NewUnicastRoutingFlowSRv6 unmatch. %v", m)
+	}
+}
+
+func TestNewSRv6LocalSidAction(t *testing.T) {
+	in6 := net.ParseIP("fc00::2")
+	in4 := net.ParseIP("10.0.0.2")
+
+	tests := []struct {
+		action  int
+		name    fibcapi.SRv6LocalSidFlow_Action_Name
+		value   uint32
+		nexthop net.IP
+	}{
+		{nl.SEG6_LOCAL_ACTION_END, fibcapi.SRv6LocalSidFlow_Action_END, 0, nil},
+		{nl.SEG6_LOCAL_ACTION_END_X, fibcapi.SRv6LocalSidFlow_Action_END_X, 0, in6},
+		{nl.SEG6_LOCAL_ACTION_END_T, fibcapi.SRv6LocalSidFlow_Action_END_T, 1, nil},
+		{nl.SEG6_LOCAL_ACTION_END_DX6, fibcapi.SRv6LocalSidFlow_Action_END_DX6, 0, in6},
+		{nl.SEG6_LOCAL_ACTION_END_DX4, fibcapi.SRv6LocalSidFlow_Action_END_DX4, 0, in4},
+		{nl.SEG6_LOCAL_ACTION_END_DT6, fibcapi.SRv6LocalSidFlow_Action_END_DT6, 1, nil},
+		{nl.SEG6_LOCAL_ACTION_END_DT4, fibcapi.SRv6LocalSidFlow_Action_END_DT4, 1, nil},
+	}
+
+	for _, test := range tests {
+		seg6local := &netlink.SEG6LocalEncap{Action: test.action, InAddr: in4, In6Addr: in6}
+		route := newTestSRv6Route("fc00:1::100/128", seg6local)
+
+		a, nexthop, err := NewSRv6LocalSidAction(route, seg6local)
+		if err != nil {
+			t.Errorf("NewSRv6LocalSidAction error. %d %s", test.action, err)
+			continue
+		}
+		if a.Name != test.name || a.Value != test.value {
+			t.Errorf("NewSRv6LocalSidAction unmatch. %d %v", test.action, a)
+		}
+		if !nexthop.Equal(test.nexthop) {
+			t.Errorf("NewSRv6LocalSidAction unmatch. %d %s", test.action, nexthop)
+		}
+	}
+
+	seg6local := &netlink.SEG6LocalEncap{Action: nl.SEG6_LOCAL_ACTION_END_DX2}
+	if _, _, err := NewSRv6LocalSidAction(newTestSRv6Route("fc00:1::100/128", seg6local), seg6local); err == nil {
+		t.Errorf("NewSRv6LocalSidAction must be error.")
+	}
+}
+
+func TestNewSRv6LocalSidFlow(t *testing.T) {
+	route := newTestSRv6Route("fc00:1::100/128", &netlink.SEG6LocalEncap{})
+	action := fibcapi.NewSRv6LocalSidAction(fibcapi.SRv6LocalSidFlow_Action_END_DX4, 0)
+
+	f := NewSRv6LocalSidFlow(route, action, &nlamsg.Neigh{NId: 1, NeId: 2})
+
+	if m := f.Match; m.Sid != "fc00:1::100/128" || m.Vrf != 1 {
+		t.Errorf("NewSRv6LocalSidFlow unmatch. %v", m)
+	}
+	if f.GType != fibcapi.GroupMod_L3_UNICAST || f.GId != 0x10002 {
+		t.Errorf("NewSRv6LocalSidFlow unmatch. %v", f)
+	}
+
+	f = NewSRv6LocalSidFlow(route, action, nil)
+	if f.GType != fibcapi.GroupMod_UNSPEC || f.GId != 0 {
+		t.Errorf("NewSRv6LocalSidFlow unmatch. %v", f)
+	}
+}
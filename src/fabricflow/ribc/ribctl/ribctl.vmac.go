@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+)
+
+//
+// VirtualMACLink registers or unregisters macvlan device (VRRP vmac, anycast gateway).
+// macvlan device has no dp port, so flows are sent to parent port.
+// it returns false if link is not macvlan device.
+//
+func (r *RIBController) VirtualMACLink(cmd fibcapi.FlowMod_Cmd, link *nlamsg.Link) bool {
+	e := NewVirtualMACEntry(link)
+	if e == nil {
+		return false
+	}
+
+	if cmd == fibcapi.FlowMod_DELETE {
+		r.deleteVirtualMAC(e)
+		return true
+	}
+
+	if old := r.vmacdb.Set(e); old != nil {
+		r.deleteVirtualMACFlows(old, e)
+	}
+	r.log.Debugf("VirtualMACLink: %s registered.", e)
+
+	if cmd == fibcapi.FlowMod_ADD {
+		// addresses may be assigned before ribcd starts.
+		r.nla.GetAddrs(e.NId, func(addr *nlamsg.Addr) error {
+			if int(addr.Index) == e.Index && checkIfaceIPNet(addr.IPNet) {
+				r.vmacdb.AddAddr(addr)
+			}
+			return nil
+		})
+	}
+
+	r.syncVirtualMAC(e.NId, e.Index)
+	return true
+}
+
+func (r *RIBController) deleteVirtualMAC(dev *VirtualMACEntry) {
+	e := r.vmacdb.Delete(dev.NId, dev.Index)
+	if e == nil {
+		return
+	}
+
+	r.deleteVirtualMACFlows(e, nil)
+	r.log.Debugf("VirtualMACLink: %s unregistered.", e)
+}
+
+//
+// deleteVirtualMACFlows deletes flows of old entry.
+// If new entry is given (mac address or parent changed), ACL flows are
+// moved to new parent and termination mac flows are resent by syncVirtualMAC.
+//
+func (r *RIBController) deleteVirtualMACFlows(old, e *VirtualMACEntry) {
+	moveParent := (e == nil) || (e.ParentIndex != old.ParentIndex)
+
+	var parent IfDBEntry
+	if ok := r.ifdb.SelectBy(&parent, old.NId, old.ParentIndex); ok {
+		if moveParent {
+			for _, addr := range old.Addrs() {
+				if err := r.SendACLFlowByAddr(fibcapi.FlowMod_DELETE, addr, r.virtualMACInPort(&parent)); err != nil {
+					r.log.Errorf("VirtualMACLink: ACL(Addr) error. %s", err)
+				}
+			}
+		}
+
+		if old.Installed {
+			if err := r.SendTermMACFlowVirtualMAC(fibcapi.FlowMod_DELETE, old.HwAddr, &parent); err != nil {
+				r.log.Errorf("VirtualMACLink: TermMAC Flow error. %s", err)
+			}
+		}
+	}
+
+	if e == nil || !moveParent {
+		return
+	}
+
+	if ok := r.ifdb.SelectBy(&parent, e.NId, e.ParentIndex); ok && parent.Associated {
+		for _, addr := range e.Addrs() {
+			if err := r.SendACLFlowByAddr(fibcapi.FlowMod_ADD, addr, r.virtualMACInPort(&parent)); err != nil {
+				r.log.Errorf("VirtualMACLink: ACL(Addr) error. %s", err)
+			}
+		}
+	}
+}
+
+func (r *RIBController) virtualMACInPort(parent *IfDBEntry) uint32 {
+	if r.fib.FIBCType() == FIBCTypeTCP {
+		// for openflow mode.
+		return 0
+	}
+	return parent.PortId()
+}
+
+//
+// VirtualMACAddr updates address of macvlan device.
+// Virtual addresses are punted at parent port, and termination mac flows
+// are installed while macvlan device has addresses (VRRP master).
+// it returns false if addr is not of macvlan device.
+//
+func (r *RIBController) VirtualMACAddr(cmd fibcapi.FlowMod_Cmd, addr *nlamsg.Addr) bool {
+	e, ok := r.vmacdb.Select(addr.NId, int(addr.Index))
+	if !ok {
+		return false
+	}
+
+	if !checkIfaceIPNet(addr.IPNet) {
+		return true
+	}
+
+	if cmd == fibcapi.FlowMod_DELETE {
+		r.vmacdb.DeleteAddr(addr)
+	} else {
+		r.vmacdb.AddAddr(addr)
+	}
+
+	var parent IfDBEntry
+	if ok := r.ifdb.SelectBy(&parent, e.NId, e.ParentIndex); ok && parent.Associated {
+		if err := r.SendACLFlowByAddr(cmd, addr, r.virtualMACInPort(&parent)); err != nil {
+			r.log.Errorf("VirtualMACAddr: ACL(Addr) error. %s", err)
+		}
+	}
+
+	r.syncVirtualMAC(e.NId, e.Index)
+	return true
+}
+
+//
+// VirtualMACParentUp sends flows of macvlan devices on parent port.
+//
+func (r *RIBController) VirtualMACParentUp(parent *IfDBEntry) {
+	for _, e := range r.vmacdb.ListByParent(parent.NId, parent.Index) {
+		for _, addr := range e.Addrs() {
+			if err := r.SendACLFlowByAddr(fibcapi.FlowMod_ADD, addr, r.virtualMACInPort(parent)); err != nil {
+				r.log.Errorf("VirtualMACParentUp: ACL(Addr) error. %s", err)
+			}
+		}
+
+		// resend termination mac flows.
+		r.vmacdb.SetInstalled(e.NId, e.Index, false)
+		r.syncVirtualMAC(e.NId, e.Index)
+	}
+}
+
+//
+// syncVirtualMAC adds or deletes termination mac flows
+// when macvlan device becomes active (master) or inactive (backup).
+//
+func (r *RIBController) syncVirtualMAC(nid uint8, index int) {
+	e, ok := r.vmacdb.Select(nid, index)
+	if !ok {
+		return
+	}
+
+	var parent IfDBEntry
+	if ok := r.ifdb.SelectBy(&parent, e.NId, e.ParentIndex); !ok {
+		r.log.Warnf("VirtualMAC: parent not found. %s", e)
+		r.vmacdb.SetInstalled(nid, index, false)
+		return
+	}
+
+	active := e.IsActive() && parent.Associated
+	if active == e.Installed {
+		return
+	}
+
+	cmd := fibcapi.FlowMod_DELETE
+	if active {
+		cmd = fibcapi.FlowMod_ADD
+	}
+
+	if err := r.SendTermMACFlowVirtualMAC(cmd, e.HwAddr, &parent); err != nil {
+		r.log.Errorf("VirtualMAC: %s TermMAC Flow error. %s %s", cmd, e, err)
+		return
+	}
+
+	r.vmacdb.SetInstalled(nid, index, active)
+	r.log.Infof("VirtualMAC: %s %s parent:%d vid:%d", cmd, e, parent.Index, parent.Vid)
+}
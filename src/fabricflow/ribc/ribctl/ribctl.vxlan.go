@@ -0,0 +1,300 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"gonla/nlamsg"
+	"net"
+)
+
+//
+// VXLAN Tunnel
+//
+func NewVxlanTunnel(dev *VxlanDevEntry, vtep *VtepEntry, neId uint32) *fibcapi.VxlanTunnel {
+	t := fibcapi.NewVxlanTunnel(
+		vtep.TunPortId,
+		dev.Vni,
+		dev.Vid,
+		dev.Local,
+		vtep.Remote,
+		dev.UdpPort,
+		neId,
+		dev.PortId,
+	)
+	t.Ttl = uint32(dev.Ttl)
+	return t
+}
+
+func (r *RIBController) SendVxlanTunnel(cmd fibcapi.GroupMod_Cmd, dev *VxlanDevEntry, vtep *VtepEntry) error {
+	var neId uint32
+	if cmd != fibcapi.GroupMod_DELETE {
+		neigh, err := r.GetUnderlayNeigh(dev.NId, vtep.Remote)
+		if err != nil {
+			// tunnel is sent without nexthop, and datapath rejects it.
+			r.log.Warnf("VxlanTunnel: underlay nexthop not found. %s %s", vtep.Remote, err)
+		}
+		neId = NewNeighId(neigh)
+	}
+
+	t := NewVxlanTunnel(dev, vtep, neId)
+	return r.fib.GroupMod(t.ToMod(cmd, r.reId))
+}
+
+//
+// GetUnderlayNeigh returns neighbor to forward packets to remote VTEP.
+// remote is neighbor or gateway of the longest match route.
+//
+func (r *RIBController) GetUnderlayNeigh(nid uint8, remote net.IP) (*nlamsg.Neigh, error) {
+	if neigh, err := r.nla.GetNeigh(nid, remote); err == nil {
+		return neigh, nil
+	}
+
+	var (
+		gw     net.IP
+		maxLen = -1
+	)
+
+	r.nla.GetRoutes(nid, func(route *nlamsg.Route) error {
+		dst := route.GetDst()
+		if dst.IP != nil && !dst.Contains(remote) {
+			return nil
+		}
+
+		ones, _ := dst.Mask.Size()
+		if gws := route.GetGws(); len(gws) > 0 && ones > maxLen {
+			gw = gws[0]
+			maxLen = ones
+		}
+		return nil
+	})
+
+	if gw == nil {
+		return nil, fmt.Errorf("route not found. %s", remote)
+	}
+
+	return r.nla.GetNeigh(nid, gw)
+}
+
+//
+// L2 Overlay Group (Flood over unicast tunnels)
+//
+func NewL2OverlayGroup(dev *VxlanDevEntry, vteps []*VtepEntry) *fibcapi.L2OverlayGroup {
+	ports := make([]uint32, len(vteps))
+	for i, vtep := range vteps {
+		ports[i] = fibcapi.NewVxlanTunnelDPPort(vtep.TunPortId)
+	}
+
+	return fibcapi.NewL2OverlayGroup(dev.Vni, 0, dev.Vid, ports)
+}
+
+func (r *RIBController) SendL2OverlayGroup(cmd fibcapi.GroupMod_Cmd, dev *VxlanDevEntry, vteps []*VtepEntry) error {
+	g := NewL2OverlayGroup(dev, vteps)
+	return r.fib.GroupMod(g.ToMod(cmd, r.reId))
+}
+
+//
+// Bridging (remote VTEP)
+//
+func NewOverlayBridgingFlow(hwaddr net.HardwareAddr, dev *VxlanDevEntry, vtep *VtepEntry) *fibcapi.BridgingFlow {
+	m := fibcapi.NewBridgingFlowMatch(hwaddr.String(), 0, dev.Vni)
+	a := fibcapi.NewBridgingFlowAction("OUTPUT", fibcapi.NewVxlanTunnelDPPort(vtep.TunPortId))
+	return fibcapi.NewBridgingFlow(m, a)
+}
+
+func (r *RIBController) SendOverlayBridgingFlow(cmd fibcapi.FlowMod_Cmd, hwaddr net.HardwareAddr, dev *VxlanDevEntry, vtep *VtepEntry) error {
+	f := NewOverlayBridgingFlow(hwaddr, dev, vtep)
+	return r.fib.FlowMod(f.ToMod(cmd, r.reId))
+}
+
+//
+// SendVtepFdbFlows sends flows and groups of FDB entry of remote VTEP.
+//
+func (r *RIBController) SendVtepFdbFlows(cmd fibcapi.FlowMod_Cmd, neigh *nlamsg.Neigh) error {
+	r.log.Debugf("VtepFdbFlows: %s %s %s", cmd, neigh.HardwareAddr, neigh.IP)
+
+	dev, ok := r.vtepdb.SelectDev(neigh.NId, neigh.LinkIndex)
+	if !ok {
+		r.log.Debugf("VtepFdbFlows: vxlan device not found. %s", neigh)
+		return nil
+	}
+
+	key := NewVtepKey(neigh.NId, dev.Vni, neigh.HardwareAddr, neigh.IP)
+
+	if cmd == fibcapi.FlowMod_DELETE {
+		return r.deleteVtepFdb(dev, key)
+	}
+
+	return r.addVtepFdb(dev, key, neigh.HardwareAddr, neigh.IP)
+}
+
+func (r *RIBController) addVtepFdb(dev *VxlanDevEntry, key *VtepKey, hwaddr net.HardwareAddr, remote net.IP) error {
+	floods := len(r.vtepdb.FloodTunnels(dev.NId, dev.Vni))
+
+	vtep, created, released := r.vtepdb.Add(key, remote)
+
+	if created {
+		if err := r.SendVxlanTunnel(fibcapi.GroupMod_ADD, dev, vtep); err != nil {
+			r.log.Errorf("VtepFdbFlows: VxlanTunnel error. %s", err)
+			return err
+		}
+	}
+
+	if key.IsFlood() {
+		if err := r.sendFloodGroup(dev, floods); err != nil {
+			return err
+		}
+	} else {
+		if err := r.SendOverlayBridgingFlow(fibcapi.FlowMod_ADD, hwaddr, dev, vtep); err != nil {
+			r.log.Errorf("VtepFdbFlows: Bridging flow error. %s", err)
+			return err
+		}
+	}
+
+	if released != nil {
+		if err := r.SendVxlanTunnel(fibcapi.GroupMod_DELETE, dev, released); err != nil {
+			r.log.Errorf("VtepFdbFlows: VxlanTunnel error. %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RIBController) deleteVtepFdb(dev *VxlanDevEntry, key *VtepKey) error {
+	floods := len(r.vtepdb.FloodTunnels(dev.NId, dev.Vni))
+
+	vtep, released := r.vtepdb.Delete(key)
+	if vtep == nil {
+		r.log.Debugf("VtepFdbFlows: fdb not found. %v", key)
+		return nil
+	}
+
+	if key.IsFlood() {
+		if err := r.sendFloodGroup(dev, floods); err != nil {
+			return err
+		}
+	} else {
+		hwaddr, _ := net.ParseMAC(key.HwAddr)
+		if err := r.SendOverlayBridgingFlow(fibcapi.FlowMod_DELETE, hwaddr, dev, vtep); err != nil {
+			r.log.Errorf("VtepFdbFlows: Bridging flow error. %s", err)
+			return err
+		}
+	}
+
+	if released {
+		if err := r.SendVxlanTunnel(fibcapi.GroupMod_DELETE, dev, vtep); err != nil {
+			r.log.Errorf("VtepFdbFlows: VxlanTunnel error. %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// sendFloodGroup sends flood group of vxlan device.
+// floods is number of members before update.
+//
+func (r *RIBController) sendFloodGroup(dev *VxlanDevEntry, floods int) error {
+	vteps := r.vtepdb.FloodTunnels(dev.NId, dev.Vni)
+
+	cmd := fibcapi.GroupMod_MODIFY
+	switch {
+	case floods == len(vteps):
+		return nil
+	case floods == 0:
+		cmd = fibcapi.GroupMod_ADD
+	case len(vteps) == 0:
+		cmd = fibcapi.GroupMod_DELETE
+	}
+
+	if err := r.SendL2OverlayGroup(cmd, dev, vteps); err != nil {
+		r.log.Errorf("VtepFdbFlows: L2 Overlay Group error. %s", err)
+		return err
+	}
+
+	return nil
+}
+
+//
+// VxlanLink registers or unregisters vxlan device.
+//
+func (r *RIBController) VxlanLink(cmd fibcapi.FlowMod_Cmd, link *nlamsg.Link) {
+	dev := NewVxlanDevEntry(link)
+	if dev == nil {
+		return
+	}
+
+	if cmd != fibcapi.FlowMod_DELETE {
+		r.vtepdb.SetDev(dev)
+		r.log.Debugf("VxlanLink: %s registered.", dev)
+		return
+	}
+
+	// kernel removes fdb entries of vxlan device silently.
+	for _, key := range r.vtepdb.Keys(dev.NId, dev.Vni) {
+		if err := r.deleteVtepFdb(dev, key); err != nil {
+			r.log.Errorf("VxlanLink: delete fdb error. %v %s", key, err)
+		}
+	}
+
+	r.vtepdb.DeleteDev(dev.NId, dev.Index)
+	r.log.Debugf("VxlanLink: %s unregistered.", dev)
+}
+
+//
+// VxlanBridgeVlanInfo updates vlan bridged to vni by PVID of vxlan device.
+// it returns false if brvlan is not of vxlan device.
+//
+func (r *RIBController) VxlanBridgeVlanInfo(cmd fibcapi.FlowMod_Cmd, brvlan *nlamsg.BridgeVlanInfo) bool {
+	if _, ok := r.vtepdb.SelectDev(brvlan.NId, brvlan.Index); !ok {
+		return false
+	}
+
+	if !brvlan.PortVID() {
+		return true
+	}
+
+	vid := brvlan.Vid
+	if cmd == fibcapi.FlowMod_DELETE {
+		vid = 0
+	}
+
+	if ok := r.vtepdb.SetDevVid(brvlan.NId, brvlan.Index, vid); !ok {
+		return true
+	}
+
+	dev, _ := r.vtepdb.SelectDev(brvlan.NId, brvlan.Index)
+	r.log.Debugf("VxlanBridgeVlanInfo: %s", dev)
+
+	for _, vtep := range r.vtepdb.Tunnels(dev.NId, dev.Vni) {
+		if err := r.SendVxlanTunnel(fibcapi.GroupMod_MODIFY, dev, vtep); err != nil {
+			r.log.Errorf("VxlanBridgeVlanInfo: VxlanTunnel error. %s", err)
+		}
+	}
+
+	if vteps := r.vtepdb.FloodTunnels(dev.NId, dev.Vni); len(vteps) > 0 {
+		if err := r.SendL2OverlayGroup(fibcapi.GroupMod_MODIFY, dev, vteps); err != nil {
+			r.log.Errorf("VxlanBridgeVlanInfo: L2 Overlay Group error. %s", err)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,104 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink"
+)
+
+func newTestVxlanDev() *VxlanDevEntry {
+	vxlan := &netlink.Vxlan{
+		LinkAttrs: netlink.LinkAttrs{Index: 10, Name: "vxlan100"},
+		VxlanId:   100,
+		SrcAddr:   net.ParseIP("10.0.0.1"),
+		Port:      4789,
+		TTL:       64,
+	}
+
+	return NewVxlanDevEntry(&nlamsg.Link{Link: vxlan, NId: 1, LnId: 5})
+}
+
+func TestNewVxlanDevEntry(t *testing.T) {
+	dev := newTestVxlanDev()
+
+	if dev.NId != 1 || dev.Index != 10 || dev.PortId != 0x10005 {
+		t.Errorf("NewVxlanDevEntry unmatch. %v", dev)
+	}
+	if dev.Vni != 100 || !dev.Local.Equal(net.ParseIP("10.0.0.1")) || dev.UdpPort != 4789 || dev.Ttl != 64 {
+		t.Errorf("NewVxlanDevEntry unmatch. %v", dev)
+	}
+
+	dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Index: 11}}
+	if v := NewVxlanDevEntry(&nlamsg.Link{Link: dummy}); v != nil {
+		t.Errorf("NewVxlanDevEntry unmatch. %v", v)
+	}
+}
+
+func TestNewVxlanTunnel(t *testing.T) {
+	dev := newTestVxlanDev()
+	dev.Vid = 10
+	vtep := &VtepEntry{TunPortId: 3, Vni: 100, Remote: net.ParseIP("10.0.0.2")}
+
+	g := NewVxlanTunnel(dev, vtep, 0x10002)
+
+	if g.TunPortId != 3 || g.Vni != 100 || g.VlanVid != 10 || g.NeId != 0x10002 || g.PortId != 0x10005 {
+		t.Errorf("NewVxlanTunnel unmatch. %v", g)
+	}
+	if g.Local != "10.0.0.1" || g.Remote != "10.0.0.2" || g.UdpPort != 4789 || g.Ttl != 64 {
+		t.Errorf("NewVxlanTunnel unmatch. %v", g)
+	}
+}
+
+func TestNewL2OverlayGroup(t *testing.T) {
+	dev := newTestVxlanDev()
+	dev.Vid = 10
+	vteps := []*VtepEntry{
+		{TunPortId: 1},
+		{TunPortId: 2},
+	}
+
+	g := NewL2OverlayGroup(dev, vteps)
+
+	if g.TunnelId != 100 || g.VlanVid != 10 {
+		t.Errorf("NewL2OverlayGroup unmatch. %v", g)
+	}
+	if len(g.TunPorts) != 2 || g.TunPorts[0] != 0x06000001 || g.TunPorts[1] != 0x06000002 {
+		t.Errorf("NewL2OverlayGroup unmatch. %v", g.TunPorts)
+	}
+}
+
+func TestNewOverlayBridgingFlow(t *testing.T) {
+	dev := newTestVxlanDev()
+	vtep := &VtepEntry{TunPortId: 3}
+	hwaddr, _ := net.ParseMAC("00:11:22:33:44:55")
+
+	f := NewOverlayBridgingFlow(hwaddr, dev, vtep)
+
+	if m := f.Match; m.EthDst != "00:11:22:33:44:55" || m.VlanVid != 0 || m.TunnelId != 100 {
+		t.Errorf("NewOverlayBridgingFlow unmatch. %v", m)
+	}
+	if a := f.Action; a.Name != fibcapi.BridgingFlow_Action_OUTPUT || a.Value != 0x06000003 {
+		t.Errorf("NewOverlayBridgingFlow unmatch. %v", a)
+	}
+}
@@ -54,4 +54,12 @@ func TestRIBController_HandlerImpl(t *testing.T) {
 	if _, ok := c.(nlamsg.NetlinkRouteHandler); !ok {
 		t.Errorf("RIBController has no handler. (NetlinkRoute)")
 	}
+
+	if _, ok := c.(nlamsg.NetlinkRuleHandler); !ok {
+		t.Errorf("RIBController has no handler. (NetlinkRule)")
+	}
+
+	if _, ok := c.(nlamsg.NetlinkMrouteHandler); !ok {
+		t.Errorf("RIBController has no handler. (NetlinkMroute)")
+	}
 }
@@ -120,13 +120,13 @@ func GetPortConfigCmd(t uint16) string {
 
 func GetGroupCmd(t uint16) fibcapi.GroupMod_Cmd {
 	switch t {
-	case syscall.RTM_NEWLINK, syscall.RTM_NEWADDR, syscall.RTM_NEWNEIGH, syscall.RTM_NEWROUTE:
+	case syscall.RTM_NEWLINK, syscall.RTM_NEWADDR, syscall.RTM_NEWNEIGH, syscall.RTM_NEWROUTE, nlalink.RTM_NEWMROUTE:
 		return fibcapi.GroupMod_ADD
 
-	case syscall.RTM_SETLINK, nlalink.RTM_SETADDR, nlalink.RTM_SETNEIGH, nlalink.RTM_SETROUTE:
+	case syscall.RTM_SETLINK, nlalink.RTM_SETADDR, nlalink.RTM_SETNEIGH, nlalink.RTM_SETROUTE, nlalink.RTM_SETMROUTE:
 		return fibcapi.GroupMod_MODIFY
 
-	case syscall.RTM_DELLINK, syscall.RTM_DELADDR, syscall.RTM_DELNEIGH, syscall.RTM_DELROUTE:
+	case syscall.RTM_DELLINK, syscall.RTM_DELADDR, syscall.RTM_DELNEIGH, syscall.RTM_DELROUTE, nlalink.RTM_DELMROUTE:
 		return fibcapi.GroupMod_DELETE
 
 	default:
@@ -136,13 +136,13 @@ func GetGroupCmd(t uint16) fibcapi.GroupMod_Cmd {
 
 func GetFlowCmd(t uint16) fibcapi.FlowMod_Cmd {
 	switch t {
-	case syscall.RTM_NEWLINK, syscall.RTM_NEWADDR, syscall.RTM_NEWNEIGH, syscall.RTM_NEWROUTE, nlalink.RTM_NEWBRIDGE:
+	case syscall.RTM_NEWLINK, syscall.RTM_NEWADDR, syscall.RTM_NEWNEIGH, syscall.RTM_NEWROUTE, nlalink.RTM_NEWBRIDGE, syscall.RTM_NEWRULE, nlalink.RTM_NEWMROUTE:
 		return fibcapi.FlowMod_ADD
 
-	case syscall.RTM_SETLINK, nlalink.RTM_SETADDR, nlalink.RTM_SETNEIGH, nlalink.RTM_SETROUTE, nlalink.RTM_SETBRIDGE:
+	case syscall.RTM_SETLINK, nlalink.RTM_SETADDR, nlalink.RTM_SETNEIGH, nlalink.RTM_SETROUTE, nlalink.RTM_SETBRIDGE, nlalink.RTM_SETMROUTE:
 		return fibcapi.FlowMod_MODIFY
 
-	case syscall.RTM_DELLINK, syscall.RTM_DELADDR, syscall.RTM_DELNEIGH, syscall.RTM_DELROUTE, nlalink.RTM_DELBRIDGE:
+	case syscall.RTM_DELLINK, syscall.RTM_DELADDR, syscall.RTM_DELNEIGH, syscall.RTM_DELROUTE, nlalink.RTM_DELBRIDGE, syscall.RTM_DELRULE, nlalink.RTM_DELMROUTE:
 		return fibcapi.FlowMod_DELETE
 
 	default:
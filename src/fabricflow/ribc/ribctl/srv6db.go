@@ -0,0 +1,179 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+//
+// SRv6EncapEntry is SRv6 encap group shared by routes
+// which have the same segments and underlay nexthop.
+//
+type SRv6EncapEntry struct {
+	EncapId  uint32
+	Mode     int
+	Segments []net.IP // SRH order
+	NeId     uint32
+	refCnt   uint32
+}
+
+func (e *SRv6EncapEntry) String() string {
+	return fmt.Sprintf("EncapId:%d Mode:%d Segs:%v NeId:%d Ref:%d", e.EncapId, e.Mode, e.Segments, e.NeId, e.refCnt)
+}
+
+func newSRv6EncapKey(mode int, segs []net.IP, neId uint32) string {
+	ss := make([]string, len(segs))
+	for i, seg := range segs {
+		ss[i] = seg.String()
+	}
+	return fmt.Sprintf("%d/%s/%d", mode, strings.Join(ss, ","), neId)
+}
+
+//
+// SRv6RouteKey is key of route with seg6 encap.
+//
+type SRv6RouteKey struct {
+	NId uint8
+	Dst string // ip/mask (net.IPNet.String())
+}
+
+func NewSRv6RouteKey(nid uint8, dst *net.IPNet) *SRv6RouteKey {
+	return &SRv6RouteKey{
+		NId: nid,
+		Dst: dst.String(),
+	}
+}
+
+//
+// SRv6EncapDB has SRv6 encap groups and refer count by routes.
+//
+type SRv6EncapDB struct {
+	mutex   sync.Mutex
+	entries map[string]*SRv6EncapEntry // key: mode/segments/neid
+	routes  map[SRv6RouteKey]*SRv6EncapEntry
+	nextId  uint32
+	freeIds []uint32
+}
+
+func NewSRv6EncapDB() *SRv6EncapDB {
+	return &SRv6EncapDB{
+		entries: map[string]*SRv6EncapEntry{},
+		routes:  map[SRv6RouteKey]*SRv6EncapEntry{},
+		nextId:  1,
+		freeIds: []uint32{},
+	}
+}
+
+func (db *SRv6EncapDB) newId() uint32 {
+	if n := len(db.freeIds); n > 0 {
+		id := db.freeIds[n-1]
+		db.freeIds = db.freeIds[:n-1]
+		return id
+	}
+
+	id := db.nextId
+	db.nextId++
+	return id
+}
+
+func (db *SRv6EncapDB) release(e *SRv6EncapEntry) bool {
+	e.refCnt--
+	if e.refCnt > 0 {
+		return false
+	}
+
+	delete(db.entries, newSRv6EncapKey(e.Mode, e.Segments, e.NeId))
+	db.freeIds = append(db.freeIds, e.EncapId)
+	return true
+}
+
+//
+// Add registers route and returns encap entry of segments and neId.
+// created is true if entry is newly created.
+// if route has been registered with other encap, old entry is unrefered
+// and returned as released if it is no longer used.
+//
+func (db *SRv6EncapDB) Add(key *SRv6RouteKey, mode int, segs []net.IP, neId uint32) (entry *SRv6EncapEntry, created bool, released *SRv6EncapEntry) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	ekey := newSRv6EncapKey(mode, segs, neId)
+
+	old, registered := db.routes[*key]
+	if registered && newSRv6EncapKey(old.Mode, old.Segments, old.NeId) == ekey {
+		return old, false, nil
+	}
+
+	entry, ok := db.entries[ekey]
+	if !ok {
+		entry = &SRv6EncapEntry{
+			EncapId:  db.newId(),
+			Mode:     mode,
+			Segments: append([]net.IP{}, segs...),
+			NeId:     neId,
+		}
+		db.entries[ekey] = entry
+		created = true
+	}
+
+	entry.refCnt++
+	db.routes[*key] = entry
+
+	// release old entry after new entry is allocated
+	// not to reuse encap id of old entry.
+	if registered && db.release(old) {
+		released = old
+	}
+
+	return
+}
+
+//
+// Delete unregisters route.
+// it returns encap entry refered by route (nil if not registered),
+// and released is true if entry is no longer used.
+//
+func (db *SRv6EncapDB) Delete(key *SRv6RouteKey) (entry *SRv6EncapEntry, released bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entry, ok := db.routes[*key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(db.routes, *key)
+	released = db.release(entry)
+
+	return
+}
+
+//
+// Select returns encap entry refered by route.
+//
+func (db *SRv6EncapDB) Select(key *SRv6RouteKey) (*SRv6EncapEntry, bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	e, ok := db.routes[*key]
+	return e, ok
+}
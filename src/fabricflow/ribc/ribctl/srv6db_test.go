@@ -0,0 +1,84 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+)
+
+func newTestSRv6RouteKey(dst string) *SRv6RouteKey {
+	_, ipnet, _ := net.ParseCIDR(dst)
+	return NewSRv6RouteKey(0, ipnet)
+}
+
+func TestSRv6EncapDB_Share(t *testing.T) {
+	db := NewSRv6EncapDB()
+
+	r1 := newTestSRv6RouteKey("10.0.1.0/24")
+	r2 := newTestSRv6RouteKey("10.0.2.0/24")
+	segs := []net.IP{net.ParseIP("fc00::2"), net.ParseIP("fc00::1")}
+
+	e1, created, released := db.Add(r1, 1, segs, 10)
+	if !created || released != nil {
+		t.Errorf("SRv6EncapDB.Add unmatch. created=%t released=%v", created, released)
+	}
+
+	e2, created, _ := db.Add(r2, 1, []net.IP{net.ParseIP("fc00::2"), net.ParseIP("fc00::1")}, 10)
+	if created || e2 != e1 {
+		t.Errorf("SRv6EncapDB.Add unmatch. created=%t %v", created, e2)
+	}
+
+	e3, created, _ := db.Add(newTestSRv6RouteKey("10.0.3.0/24"), 1, segs, 11)
+	if !created || e3.EncapId == e1.EncapId {
+		t.Errorf("SRv6EncapDB.Add unmatch. created=%t %v", created, e3)
+	}
+
+	if e, released := db.Delete(r1); e != e1 || released {
+		t.Errorf("SRv6EncapDB.Delete unmatch. released=%t %v", released, e)
+	}
+
+	if e, released := db.Delete(r2); e != e1 || !released {
+		t.Errorf("SRv6EncapDB.Delete unmatch. released=%t %v", released, e)
+	}
+}
+
+func TestSRv6EncapDB_Replace(t *testing.T) {
+	db := NewSRv6EncapDB()
+
+	r1 := newTestSRv6RouteKey("10.0.1.0/24")
+	segs := []net.IP{net.ParseIP("fc00::1")}
+
+	e1, _, _ := db.Add(r1, 1, segs, 10)
+
+	if e, created, released := db.Add(r1, 1, segs, 10); e != e1 || created || released != nil {
+		t.Errorf("SRv6EncapDB.Add unmatch. created=%t released=%v", created, released)
+	}
+
+	e2, created, released := db.Add(r1, 0, segs, 10)
+	if !created || released != e1 {
+		t.Errorf("SRv6EncapDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e2.EncapId == e1.EncapId {
+		t.Errorf("SRv6EncapDB.Add must not reuse id. %d", e2.EncapId)
+	}
+
+	if e, ok := db.Select(r1); !ok || e != e2 {
+		t.Errorf("SRv6EncapDB.Select unmatch. %v", e)
+	}
+}
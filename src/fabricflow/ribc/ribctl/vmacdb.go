@@ -0,0 +1,264 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"bytes"
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	"gonla/nlamsg"
+	"net"
+	"sort"
+	"sync"
+)
+
+var (
+	vrrpHardwareAddrPrefix4 = []byte{0x00, 0x00, 0x5e, 0x00, 0x01}
+	vrrpHardwareAddrPrefix6 = []byte{0x00, 0x00, 0x5e, 0x00, 0x02}
+)
+
+//
+// IsVRRPHardwareAddr returns true if hwaddr is VRRP virtual router mac address.
+// (00:00:5e:00:01:{vrid} for IPv4, 00:00:5e:00:02:{vrid} for IPv6)
+//
+func IsVRRPHardwareAddr(hwaddr net.HardwareAddr) bool {
+	if len(hwaddr) != 6 {
+		return false
+	}
+	return bytes.HasPrefix(hwaddr, vrrpHardwareAddrPrefix4) || bytes.HasPrefix(hwaddr, vrrpHardwareAddrPrefix6)
+}
+
+//
+// VirtualMACEntry is macvlan device which has VRRP or anycast gateway mac address.
+// Termination mac flows are installed to parent port while it is active.
+//
+type VirtualMACEntry struct {
+	NId         uint8
+	Index       int
+	ParentIndex int
+	Name        string
+	HwAddr      net.HardwareAddr
+	Up          bool
+	Installed   bool
+	addrs       map[string]*nlamsg.Addr
+}
+
+//
+// NewVirtualMACEntry returns new VirtualMACEntry.
+// It returns nil if link is not macvlan device.
+//
+func NewVirtualMACEntry(link *nlamsg.Link) *VirtualMACEntry {
+	macvlan := link.Macvlan()
+	if macvlan == nil {
+		return nil
+	}
+
+	return &VirtualMACEntry{
+		NId:         link.NId,
+		Index:       macvlan.Index,
+		ParentIndex: macvlan.ParentIndex,
+		Name:        macvlan.Name,
+		HwAddr:      macvlan.HardwareAddr,
+		Up:          NewPortStatus(link) == fibcapi.PortStatus_UP,
+		addrs:       map[string]*nlamsg.Addr{},
+	}
+}
+
+//
+// IsVRRP returns true if mac address is VRRP virtual router mac address.
+//
+func (e *VirtualMACEntry) IsVRRP() bool {
+	return IsVRRPHardwareAddr(e.HwAddr)
+}
+
+//
+// IsActive returns true if device is up and has addresses.
+// keepalived adds virtual addresses to the device only when it is master.
+//
+func (e *VirtualMACEntry) IsActive() bool {
+	return e.Up && len(e.addrs) > 0
+}
+
+//
+// Addrs returns addresses of device.
+//
+func (e *VirtualMACEntry) Addrs() []*nlamsg.Addr {
+	keys := make([]string, 0, len(e.addrs))
+	for key := range e.addrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	addrs := make([]*nlamsg.Addr, 0, len(keys))
+	for _, key := range keys {
+		addrs = append(addrs, e.addrs[key])
+	}
+	return addrs
+}
+
+//
+// Copy returns copy of entry.
+//
+func (e *VirtualMACEntry) Copy() *VirtualMACEntry {
+	dst := *e
+	dst.addrs = map[string]*nlamsg.Addr{}
+	for key, addr := range e.addrs {
+		dst.addrs[key] = addr
+	}
+	return &dst
+}
+
+func (e *VirtualMACEntry) String() string {
+	return fmt.Sprintf("%d@%d '%s' parent:%d %s vrrp:%t up:%t addrs:%d installed:%t",
+		e.NId, e.Index, e.Name, e.ParentIndex, e.HwAddr, e.IsVRRP(), e.Up, len(e.addrs), e.Installed)
+}
+
+//
+// VirtualMACDB has macvlan devices.
+//
+type VirtualMACDB struct {
+	mutex   sync.RWMutex
+	entries map[string]*VirtualMACEntry // key: NewIfDBKey
+}
+
+//
+// NewVirtualMACDB returns new VirtualMACDB.
+//
+func NewVirtualMACDB() *VirtualMACDB {
+	return &VirtualMACDB{
+		entries: map[string]*VirtualMACEntry{},
+	}
+}
+
+//
+// Set adds or updates entry. Addresses and installed state are kept if entry exists.
+// If mac address or parent device is changed, installed state is reset and
+// it returns copy of old entry to delete flows of it. Otherwise it returns nil.
+//
+func (db *VirtualMACDB) Set(e *VirtualMACEntry) *VirtualMACEntry {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	key := NewIfDBKey(e.NId, e.Index)
+	old, ok := db.entries[key]
+	db.entries[key] = e
+
+	if !ok {
+		return nil
+	}
+
+	e.addrs = old.addrs
+	if bytes.Equal(e.HwAddr, old.HwAddr) && e.ParentIndex == old.ParentIndex {
+		e.Installed = old.Installed
+		return nil
+	}
+
+	e.Installed = false
+	return old.Copy()
+}
+
+//
+// Delete removes entry.
+//
+func (db *VirtualMACDB) Delete(nid uint8, index int) *VirtualMACEntry {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	key := NewIfDBKey(nid, index)
+	e, ok := db.entries[key]
+	if !ok {
+		return nil
+	}
+
+	delete(db.entries, key)
+	return e
+}
+
+//
+// Select returns copy of entry.
+//
+func (db *VirtualMACDB) Select(nid uint8, index int) (*VirtualMACEntry, bool) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if e, ok := db.entries[NewIfDBKey(nid, index)]; ok {
+		return e.Copy(), true
+	}
+	return nil, false
+}
+
+//
+// AddAddr adds address to entry. It returns false if entry not found.
+//
+func (db *VirtualMACDB) AddAddr(addr *nlamsg.Addr) bool {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	e, ok := db.entries[NewIfDBKey(addr.NId, int(addr.Index))]
+	if ok {
+		e.addrs[addr.IPNet.String()] = addr
+	}
+	return ok
+}
+
+//
+// DeleteAddr removes address from entry. It returns false if entry not found.
+//
+func (db *VirtualMACDB) DeleteAddr(addr *nlamsg.Addr) bool {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	e, ok := db.entries[NewIfDBKey(addr.NId, int(addr.Index))]
+	if ok {
+		delete(e.addrs, addr.IPNet.String())
+	}
+	return ok
+}
+
+//
+// SetInstalled sets state of termination mac flows.
+//
+func (db *VirtualMACDB) SetInstalled(nid uint8, index int, installed bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if e, ok := db.entries[NewIfDBKey(nid, index)]; ok {
+		e.Installed = installed
+	}
+}
+
+//
+// ListByParent returns copy of entries of parent device.
+//
+func (db *VirtualMACDB) ListByParent(nid uint8, parentIndex int) []*VirtualMACEntry {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	entries := []*VirtualMACEntry{}
+	for _, e := range db.entries {
+		if e.NId == nid && e.ParentIndex == parentIndex {
+			entries = append(entries, e.Copy())
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Index < entries[j].Index
+	})
+
+	return entries
+}
@@ -0,0 +1,173 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+
+	fibcapi "fabricflow/fibc/api"
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink"
+)
+
+func newTestMacvlan(index, parent int, hwaddr string, up bool) *nlamsg.Link {
+	mac, _ := net.ParseMAC(hwaddr)
+	macvlan := &netlink.Macvlan{
+		LinkAttrs: netlink.LinkAttrs{
+			Index:        index,
+			ParentIndex:  parent,
+			Name:         "vrrp.1",
+			HardwareAddr: mac,
+			OperState:    netlink.OperDown,
+		},
+		Mode: netlink.MACVLAN_MODE_BRIDGE,
+	}
+	if up {
+		macvlan.OperState = netlink.OperUp
+	}
+
+	return &nlamsg.Link{Link: macvlan, NId: 0, LnId: 10}
+}
+
+func newTestVMacAddr(index int, cidr string) *nlamsg.Addr {
+	ip, ipnet, _ := net.ParseCIDR(cidr)
+	ipnet.IP = ip
+	return &nlamsg.Addr{
+		Addr:  &netlink.Addr{IPNet: ipnet},
+		Index: int32(index),
+	}
+}
+
+func TestIsVRRPHardwareAddr(t *testing.T) {
+	for s, exp := range map[string]bool{
+		"00:00:5e:00:01:01": true,
+		"00:00:5e:00:02:ff": true,
+		"00:00:5e:00:03:01": false,
+		"00:11:22:33:44:55": false,
+	} {
+		mac, _ := net.ParseMAC(s)
+		if v := IsVRRPHardwareAddr(mac); v != exp {
+			t.Errorf("IsVRRPHardwareAddr unmatch. %s %t", s, v)
+		}
+	}
+
+	if IsVRRPHardwareAddr(nil) {
+		t.Errorf("IsVRRPHardwareAddr unmatch. nil")
+	}
+}
+
+func TestNewVirtualMACEntry(t *testing.T) {
+	e := NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:01", true))
+	if e == nil {
+		t.Fatalf("NewVirtualMACEntry must not be nil.")
+	}
+	if e.Index != 20 || e.ParentIndex != 2 || !e.Up || !e.IsVRRP() || e.IsActive() {
+		t.Errorf("NewVirtualMACEntry unmatch. %s", e)
+	}
+
+	dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Index: 11}}
+	if v := NewVirtualMACEntry(&nlamsg.Link{Link: dummy}); v != nil {
+		t.Errorf("NewVirtualMACEntry unmatch. %v", v)
+	}
+}
+
+func TestVirtualMACDB(t *testing.T) {
+	db := NewVirtualMACDB()
+
+	db.Set(NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:01", true)))
+	db.Set(NewVirtualMACEntry(newTestMacvlan(21, 2, "00:00:5e:00:02:01", true)))
+	db.Set(NewVirtualMACEntry(newTestMacvlan(22, 3, "02:00:00:00:00:01", true)))
+
+	if ok := db.AddAddr(newTestVMacAddr(20, "10.0.0.254/24")); !ok {
+		t.Errorf("VirtualMACDB.AddAddr must be true.")
+	}
+	if ok := db.AddAddr(newTestVMacAddr(99, "10.0.0.254/24")); ok {
+		t.Errorf("VirtualMACDB.AddAddr must be false.")
+	}
+
+	e, ok := db.Select(0, 20)
+	if !ok || !e.IsActive() || len(e.Addrs()) != 1 {
+		t.Errorf("VirtualMACDB.Select unmatch. %s", e)
+	}
+
+	// mastership changed to backup (device down), addresses and installed are kept.
+	db.SetInstalled(0, 20, true)
+	db.Set(NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:01", false)))
+	if e, _ = db.Select(0, 20); e.IsActive() || !e.Installed || len(e.Addrs()) != 1 {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", e)
+	}
+
+	if old := db.Set(NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:01", true))); old != nil {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", old)
+	}
+
+	// mac address changed, old entry is returned and installed is reset.
+	old := db.Set(NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:02", true)))
+	if old == nil || old.HwAddr.String() != "00:00:5e:00:01:01" || !old.Installed || len(old.Addrs()) != 1 {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", old)
+	}
+	if e, _ = db.Select(0, 20); e.HwAddr.String() != "00:00:5e:00:01:02" || e.Installed || !e.IsActive() {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", e)
+	}
+
+	// parent changed.
+	db.SetInstalled(0, 20, true)
+	old = db.Set(NewVirtualMACEntry(newTestMacvlan(20, 3, "00:00:5e:00:01:02", true)))
+	if old == nil || old.ParentIndex != 2 || !old.Installed {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", old)
+	}
+	if e, _ = db.Select(0, 20); e.ParentIndex != 3 || e.Installed {
+		t.Errorf("VirtualMACDB.Set unmatch. %s", e)
+	}
+	db.Set(NewVirtualMACEntry(newTestMacvlan(20, 2, "00:00:5e:00:01:01", true)))
+
+	db.DeleteAddr(newTestVMacAddr(20, "10.0.0.254/24"))
+	if e, _ = db.Select(0, 20); e.IsActive() {
+		t.Errorf("VirtualMACDB.DeleteAddr unmatch. %s", e)
+	}
+
+	if v := db.ListByParent(0, 2); len(v) != 2 || v[0].Index != 20 || v[1].Index != 21 {
+		t.Errorf("VirtualMACDB.ListByParent unmatch. %v", v)
+	}
+
+	if e := db.Delete(0, 22); e == nil || e.Index != 22 {
+		t.Errorf("VirtualMACDB.Delete unmatch. %v", e)
+	}
+	if e := db.Delete(0, 22); e != nil {
+		t.Errorf("VirtualMACDB.Delete unmatch. %v", e)
+	}
+}
+
+func TestNewTermMACFlowsVirtualMAC(t *testing.T) {
+	mac, _ := net.ParseMAC("00:00:5e:00:01:01")
+	parent := &IfDBEntry{NId: 0, LnId: 3, Index: 2, Vid: 100}
+
+	flows := NewTermMACFlowsVirtualMAC(mac, parent)
+	if len(flows) != 2 {
+		t.Fatalf("NewTermMACFlowsVirtualMAC unmatch. %v", flows)
+	}
+
+	for i, ethType := range []uint32{fibcapi.ETHTYPE_IPV4, fibcapi.ETHTYPE_IPV6} {
+		m := flows[i].Match
+		if m.InPort != 3 || m.EthType != ethType || m.EthDst != "00:00:5e:00:01:01" || m.VlanVid != 100 {
+			t.Errorf("NewTermMACFlowsVirtualMAC unmatch. %v", flows[i])
+		}
+	}
+}
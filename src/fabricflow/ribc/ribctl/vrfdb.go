@@ -0,0 +1,81 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+//
+// VrfTableKey is key of VrfTableDB.
+//
+type VrfTableKey struct {
+	NId   uint8
+	Table int
+}
+
+func (k *VrfTableKey) String() string {
+	return fmt.Sprintf("nid:%d table:%d", k.NId, k.Table)
+}
+
+//
+// VrfTableDB maps routing table of node to VRF.
+// Main table of node is mapped to VRF of its nid as well as routes.
+// Other tables must be registered to be used by routes and rules.
+//
+type VrfTableDB struct {
+	mutex sync.RWMutex
+	vrfs  map[VrfTableKey]uint8
+}
+
+//
+// NewVrfTableDB returns new VrfTableDB.
+//
+func NewVrfTableDB() *VrfTableDB {
+	return &VrfTableDB{
+		vrfs: map[VrfTableKey]uint8{},
+	}
+}
+
+//
+// Set registers VRF of table of node.
+//
+func (db *VrfTableDB) Set(nid uint8, table int, vrf uint8) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	db.vrfs[VrfTableKey{NId: nid, Table: table}] = vrf
+}
+
+//
+// Vrf returns VRF of table of node.
+//
+func (db *VrfTableDB) Vrf(nid uint8, table int) (uint8, bool) {
+	if table == unix.RT_TABLE_UNSPEC || table == unix.RT_TABLE_MAIN {
+		return nid, true
+	}
+
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	vrf, ok := db.vrfs[VrfTableKey{NId: nid, Table: table}]
+	return vrf, ok
+}
@@ -0,0 +1,70 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+
+	"gonla/nlamsg"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func TestVrfTableDB(t *testing.T) {
+	db := NewVrfTableDB()
+	db.Set(1, 10, 20)
+
+	tests := []struct {
+		nid   uint8
+		table int
+		vrf   uint8
+		ok    bool
+	}{
+		{nid: 1, table: unix.RT_TABLE_MAIN, vrf: 1, ok: true},
+		{nid: 1, table: unix.RT_TABLE_UNSPEC, vrf: 1, ok: true},
+		{nid: 1, table: 10, vrf: 20, ok: true},
+		{nid: 2, table: 10, ok: false},
+		{nid: 1, table: 11, ok: false},
+	}
+
+	for _, test := range tests {
+		if vrf, ok := db.Vrf(test.nid, test.table); ok != test.ok || vrf != test.vrf {
+			t.Errorf("VrfTableDB.Vrf unmatch. %v vrf=%d %t", test, vrf, ok)
+		}
+	}
+}
+
+func TestRIBController_RouteVrf(t *testing.T) {
+	r := NewRIBController(0, "1.1.1.1", 0, false, nil, nil, nil)
+	r.SetVrfTable(1, 10, 20)
+
+	_, dst, _ := net.ParseCIDR("0.0.0.0/0")
+	neigh := nlamsg.NewNeigh(&netlink.Neigh{IP: net.ParseIP("10.0.0.1")}, 1, 3)
+
+	route := nlamsg.NewRoute(&netlink.Route{Dst: dst, Table: 10}, 1, 0, nil, nil)
+	if f := NewUnicastRoutingFlow(neigh, route, r.routeVrf(route)); f.Match.Vrf != 20 {
+		t.Errorf("NewUnicastRoutingFlow unmatch. %v", f.Match)
+	}
+
+	route = nlamsg.NewRoute(&netlink.Route{Dst: dst, Table: unix.RT_TABLE_MAIN}, 1, 0, nil, nil)
+	if f := NewUnicastRoutingFlow(neigh, route, r.routeVrf(route)); f.Match.Vrf != 1 {
+		t.Errorf("NewUnicastRoutingFlow unmatch. %v", f.Match)
+	}
+}
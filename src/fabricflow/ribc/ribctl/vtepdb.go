@@ -0,0 +1,345 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"fmt"
+	"gonla/nlalib"
+	"gonla/nlamsg"
+	"net"
+	"sort"
+	"sync"
+)
+
+//
+// VxlanDevEntry is vxlan device (VTEP) in container.
+//
+type VxlanDevEntry struct {
+	NId     uint8
+	Index   int
+	PortId  uint32
+	Vni     uint32
+	Local   net.IP
+	UdpPort uint16
+	Ttl     uint8
+	Vid     uint16 // PVID of bridge port.
+}
+
+func NewVxlanDevEntry(link *nlamsg.Link) *VxlanDevEntry {
+	vxlan := link.Vxlan()
+	if vxlan == nil {
+		return nil
+	}
+
+	return &VxlanDevEntry{
+		NId:     link.NId,
+		Index:   vxlan.Index,
+		PortId:  NewPortId(link),
+		Vni:     uint32(vxlan.VxlanId),
+		Local:   vxlan.SrcAddr,
+		UdpPort: uint16(vxlan.Port),
+		Ttl:     uint8(vxlan.TTL),
+	}
+}
+
+func (e *VxlanDevEntry) String() string {
+	return fmt.Sprintf("%d@%d Vni:%d Local:%s Port:%d Vid:%d", e.NId, e.Index, e.Vni, e.Local, e.UdpPort, e.Vid)
+}
+
+//
+// VtepEntry is tunnel to remote VTEP.
+//
+type VtepEntry struct {
+	TunPortId uint32
+	NId       uint8
+	Vni       uint32
+	Remote    net.IP
+	Flood     bool // BUM entry exists.
+	refCnt    uint32
+}
+
+func (e *VtepEntry) String() string {
+	return fmt.Sprintf("TunPortId:%d NId:%d Vni:%d Remote:%s Flood:%t Ref:%d",
+		e.TunPortId, e.NId, e.Vni, e.Remote, e.Flood, e.refCnt)
+}
+
+//
+// VtepKey is key of FDB entry of remote VTEP.
+//
+type VtepKey struct {
+	NId    uint8
+	Vni    uint32
+	HwAddr string // 00:00:00:00:00:00 is BUM entry.
+	Remote string // BUM entry only.
+}
+
+func NewVtepKey(nid uint8, vni uint32, hwaddr net.HardwareAddr, remote net.IP) *VtepKey {
+	key := &VtepKey{
+		NId:    nid,
+		Vni:    vni,
+		HwAddr: hwaddr.String(),
+	}
+
+	// vxlan device has BUM entries for each remote VTEP.
+	if nlalib.IsInvalidHardwareAddr(hwaddr) {
+		key.Remote = remote.String()
+	}
+
+	return key
+}
+
+func (k *VtepKey) IsFlood() bool {
+	return k.HwAddr == nlalib.INVALID_HARDWAREADDR || k.HwAddr == nlalib.EMPTY_HARDWAREADDR
+}
+
+type vtepTunnelKey struct {
+	NId    uint8
+	Vni    uint32
+	Remote string
+}
+
+//
+// VtepDB has vxlan devices and tunnels refered by FDB entries of remote VTEP.
+// FDB entries which have the same remote VTEP share one tunnel.
+//
+type VtepDB struct {
+	mutex   sync.Mutex
+	devs    map[string]*VxlanDevEntry // key: NewIfDBKey
+	tunnels map[vtepTunnelKey]*VtepEntry
+	fdbs    map[VtepKey]*VtepEntry
+	nextId  uint32
+	freeIds []uint32
+}
+
+func NewVtepDB() *VtepDB {
+	return &VtepDB{
+		devs:    map[string]*VxlanDevEntry{},
+		tunnels: map[vtepTunnelKey]*VtepEntry{},
+		fdbs:    map[VtepKey]*VtepEntry{},
+		nextId:  1,
+		freeIds: []uint32{},
+	}
+}
+
+//
+// SetDev registers vxlan device. Vid of registered device is kept.
+//
+func (db *VtepDB) SetDev(dev *VxlanDevEntry) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	key := NewIfDBKey(dev.NId, dev.Index)
+	if old, ok := db.devs[key]; ok {
+		dev.Vid = old.Vid
+	}
+
+	db.devs[key] = dev
+}
+
+//
+// SetDevVid sets vid of vxlan device. it returns false if not changed.
+//
+func (db *VtepDB) SetDevVid(nid uint8, index int, vid uint16) bool {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	dev, ok := db.devs[NewIfDBKey(nid, index)]
+	if !ok || dev.Vid == vid {
+		return false
+	}
+
+	dev.Vid = vid
+	return true
+}
+
+//
+// DeleteDev unregisters vxlan device.
+//
+func (db *VtepDB) DeleteDev(nid uint8, index int) (*VxlanDevEntry, bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	key := NewIfDBKey(nid, index)
+	dev, ok := db.devs[key]
+	if ok {
+		delete(db.devs, key)
+	}
+
+	return dev, ok
+}
+
+//
+// SelectDev returns copy of vxlan device.
+//
+func (db *VtepDB) SelectDev(nid uint8, index int) (*VxlanDevEntry, bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	dev, ok := db.devs[NewIfDBKey(nid, index)]
+	if !ok {
+		return nil, false
+	}
+
+	d := *dev
+	return &d, true
+}
+
+func (db *VtepDB) newId() uint32 {
+	if n := len(db.freeIds); n > 0 {
+		id := db.freeIds[n-1]
+		db.freeIds = db.freeIds[:n-1]
+		return id
+	}
+
+	id := db.nextId
+	db.nextId++
+	return id
+}
+
+func (db *VtepDB) release(key *VtepKey, e *VtepEntry) bool {
+	if key.IsFlood() {
+		e.Flood = false
+	}
+
+	e.refCnt--
+	if e.refCnt > 0 {
+		return false
+	}
+
+	delete(db.tunnels, vtepTunnelKey{NId: e.NId, Vni: e.Vni, Remote: e.Remote.String()})
+	db.freeIds = append(db.freeIds, e.TunPortId)
+	return true
+}
+
+//
+// Add registers FDB entry and returns tunnel to remote.
+// created is true if tunnel is newly created.
+// if FDB entry has been registered with other remote, old tunnel is unrefered
+// and returned as released if it is no longer used.
+//
+func (db *VtepDB) Add(key *VtepKey, remote net.IP) (entry *VtepEntry, created bool, released *VtepEntry) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	tkey := vtepTunnelKey{NId: key.NId, Vni: key.Vni, Remote: remote.String()}
+
+	old, registered := db.fdbs[*key]
+	if registered && old.Remote.Equal(remote) {
+		return old, false, nil
+	}
+
+	entry, ok := db.tunnels[tkey]
+	if !ok {
+		entry = &VtepEntry{
+			TunPortId: db.newId(),
+			NId:       key.NId,
+			Vni:       key.Vni,
+			Remote:    remote,
+		}
+		db.tunnels[tkey] = entry
+		created = true
+	}
+
+	entry.refCnt++
+	if key.IsFlood() {
+		entry.Flood = true
+	}
+	db.fdbs[*key] = entry
+
+	// release old tunnel after new tunnel is allocated
+	// not to reuse tunnel port id of old tunnel.
+	if registered && db.release(key, old) {
+		released = old
+	}
+
+	return
+}
+
+//
+// Delete unregisters FDB entry.
+// it returns tunnel refered by FDB entry (nil if not registered),
+// and released is true if tunnel is no longer used.
+//
+func (db *VtepDB) Delete(key *VtepKey) (entry *VtepEntry, released bool) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entry, ok := db.fdbs[*key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(db.fdbs, *key)
+	released = db.release(key, entry)
+
+	return
+}
+
+//
+// FloodTunnels returns tunnels which have BUM entry in order of tunnel port id.
+//
+func (db *VtepDB) FloodTunnels(nid uint8, vni uint32) []*VtepEntry {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entries := []*VtepEntry{}
+	for _, e := range db.tunnels {
+		if e.NId == nid && e.Vni == vni && e.Flood {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].TunPortId < entries[j].TunPortId })
+	return entries
+}
+
+//
+// Tunnels returns tunnels of vni in order of tunnel port id.
+//
+func (db *VtepDB) Tunnels(nid uint8, vni uint32) []*VtepEntry {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	entries := []*VtepEntry{}
+	for _, e := range db.tunnels {
+		if e.NId == nid && e.Vni == vni {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].TunPortId < entries[j].TunPortId })
+	return entries
+}
+
+//
+// Keys returns keys of FDB entries of vni.
+//
+func (db *VtepDB) Keys(nid uint8, vni uint32) []*VtepKey {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	keys := []*VtepKey{}
+	for key := range db.fdbs {
+		if key.NId == nid && key.Vni == vni {
+			k := key
+			keys = append(keys, &k)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,151 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribctl
+
+import (
+	"net"
+	"testing"
+)
+
+func newTestVtepKey(hwaddr string, remote string) (*VtepKey, net.IP) {
+	mac, _ := net.ParseMAC(hwaddr)
+	ip := net.ParseIP(remote)
+	return NewVtepKey(0, 100, mac, ip), ip
+}
+
+func TestVtepDB_Share(t *testing.T) {
+	db := NewVtepDB()
+
+	k1, ip := newTestVtepKey("00:00:00:00:00:00", "10.0.0.2")
+	k2, _ := newTestVtepKey("00:11:22:33:44:55", "10.0.0.2")
+
+	e1, created, released := db.Add(k1, ip)
+	if !created || released != nil {
+		t.Errorf("VtepDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e1.TunPortId != 1 || !e1.Flood {
+		t.Errorf("VtepDB.Add unmatch. %v", e1)
+	}
+
+	e2, created, _ := db.Add(k2, ip)
+	if created || e2 != e1 {
+		t.Errorf("VtepDB.Add unmatch. created=%t %v", created, e2)
+	}
+
+	if v := db.FloodTunnels(0, 100); len(v) != 1 || v[0] != e1 {
+		t.Errorf("VtepDB.FloodTunnels unmatch. %v", v)
+	}
+
+	if e, released := db.Delete(k1); e != e1 || released {
+		t.Errorf("VtepDB.Delete unmatch. released=%t %v", released, e)
+	}
+
+	if v := db.FloodTunnels(0, 100); len(v) != 0 {
+		t.Errorf("VtepDB.FloodTunnels unmatch. %v", v)
+	}
+
+	if e, released := db.Delete(k2); e != e1 || !released {
+		t.Errorf("VtepDB.Delete unmatch. released=%t %v", released, e)
+	}
+
+	if e, _ := db.Delete(k2); e != nil {
+		t.Errorf("VtepDB.Delete unmatch. %v", e)
+	}
+}
+
+func TestVtepDB_Flood(t *testing.T) {
+	db := NewVtepDB()
+
+	k1, ip1 := newTestVtepKey("00:00:00:00:00:00", "10.0.0.2")
+	k2, ip2 := newTestVtepKey("00:00:00:00:00:00", "10.0.0.3")
+
+	if *k1 == *k2 {
+		t.Errorf("NewVtepKey unmatch. %v %v", k1, k2)
+	}
+
+	e1, _, _ := db.Add(k1, ip1)
+	e2, _, _ := db.Add(k2, ip2)
+
+	if v := db.FloodTunnels(0, 100); len(v) != 2 || v[0] != e1 || v[1] != e2 {
+		t.Errorf("VtepDB.FloodTunnels unmatch. %v", v)
+	}
+
+	if v := db.FloodTunnels(0, 200); len(v) != 0 {
+		t.Errorf("VtepDB.FloodTunnels unmatch. %v", v)
+	}
+
+	if v := db.Keys(0, 100); len(v) != 2 {
+		t.Errorf("VtepDB.Keys unmatch. %v", v)
+	}
+}
+
+func TestVtepDB_Move(t *testing.T) {
+	db := NewVtepDB()
+
+	k1, ip1 := newTestVtepKey("00:11:22:33:44:55", "10.0.0.2")
+	_, ip2 := newTestVtepKey("00:11:22:33:44:55", "10.0.0.3")
+
+	e1, _, _ := db.Add(k1, ip1)
+
+	if e, created, released := db.Add(k1, ip1); e != e1 || created || released != nil {
+		t.Errorf("VtepDB.Add unmatch. created=%t released=%v", created, released)
+	}
+
+	e2, created, released := db.Add(k1, ip2)
+	if !created || released != e1 {
+		t.Errorf("VtepDB.Add unmatch. created=%t released=%v", created, released)
+	}
+	if e2.TunPortId == e1.TunPortId {
+		t.Errorf("VtepDB.Add must not reuse id. %d", e2.TunPortId)
+	}
+
+	if v := db.Tunnels(0, 100); len(v) != 1 || v[0] != e2 {
+		t.Errorf("VtepDB.Tunnels unmatch. %v", v)
+	}
+}
+
+func TestVtepDB_Dev(t *testing.T) {
+	db := NewVtepDB()
+
+	db.SetDev(&VxlanDevEntry{NId: 0, Index: 10, Vni: 100})
+
+	if ok := db.SetDevVid(0, 10, 20); !ok {
+		t.Errorf("VtepDB.SetDevVid unmatch.")
+	}
+	if ok := db.SetDevVid(0, 10, 20); ok {
+		t.Errorf("VtepDB.SetDevVid unmatch.")
+	}
+	if ok := db.SetDevVid(0, 11, 20); ok {
+		t.Errorf("VtepDB.SetDevVid unmatch.")
+	}
+
+	// vid is kept on update.
+	db.SetDev(&VxlanDevEntry{NId: 0, Index: 10, Vni: 100})
+
+	if dev, ok := db.SelectDev(0, 10); !ok || dev.Vid != 20 {
+		t.Errorf("VtepDB.SelectDev unmatch. %v", dev)
+	}
+
+	if _, ok := db.DeleteDev(0, 10); !ok {
+		t.Errorf("VtepDB.DeleteDev unmatch.")
+	}
+
+	if _, ok := db.SelectDev(0, 10); ok {
+		t.Errorf("VtepDB.SelectDev unmatch.")
+	}
+}
@@ -0,0 +1,322 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ffgrpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AuthTokenMetadataKey = "authorization"
+	AuthTokenPrefix      = "Bearer "
+)
+
+func newCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(pem); !ok {
+		return nil, fmt.Errorf("invalid ca file. %s", caFile)
+	}
+
+	return pool, nil
+}
+
+//
+// ClientAuth is tls and token settings of grpc client.
+// TLS is enabled if CAFile is set. CertFile and KeyFile are
+// client certificate for mutual-TLS. ServerName should be set
+// when multiple addresses are used (see DialTarget).
+//
+type ClientAuth struct {
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+	Token      string
+}
+
+//
+// String returns string.
+//
+func (c *ClientAuth) String() string {
+	return fmt.Sprintf("ca:'%s' cert:'%s' key:'%s' server:'%s' token:%t",
+		c.CAFile, c.CertFile, c.KeyFile, c.ServerName, len(c.Token) != 0)
+}
+
+//
+// UseTLS returns tls is enabled or not.
+//
+func (c *ClientAuth) UseTLS() bool {
+	return len(c.CAFile) != 0
+}
+
+func (c *ClientAuth) tlsConfig() (*tls.Config, error) {
+	pool, err := newCertPool(c.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		RootCAs:    pool,
+		ServerName: c.ServerName,
+	}
+
+	if len(c.CertFile) != 0 || len(c.KeyFile) != 0 {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+//
+// DialOptions returns grpc.DialOption.
+//
+func (c *ClientAuth) DialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{}
+
+	if c == nil {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+
+	if c.UseTLS() {
+		config, err := c.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if len(c.Token) != 0 {
+		opts = append(opts, grpc.WithPerRPCCredentials(NewTokenCredentials(c.Token, c.UseTLS())))
+	}
+
+	return opts, nil
+}
+
+//
+// TokenCredentials is grpc.PerRPCCredentials sending token.
+//
+type TokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+//
+// NewTokenCredentials returns new TokenCredentials.
+//
+func NewTokenCredentials(token string, requireTLS bool) *TokenCredentials {
+	return &TokenCredentials{
+		token:      token,
+		requireTLS: requireTLS,
+	}
+}
+
+//
+// GetRequestMetadata is implementation of grpc.PerRPCCredentials.
+//
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		AuthTokenMetadataKey: AuthTokenPrefix + c.token,
+	}, nil
+}
+
+//
+// RequireTransportSecurity is implementation of grpc.PerRPCCredentials.
+//
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+//
+// ServerAuth is tls and token settings of grpc server.
+// TLS is enabled if CertFile and KeyFile are set. client certificate
+// is required if CAFile is set (mutual-TLS).
+// Tokens is map of role and token. RoleOf returns role of grpc method.
+// If Tokens is not empty, rpcs of role which has no token are rejected.
+//
+type ServerAuth struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	Tokens   map[string]string
+	RoleOf   func(string) string
+
+	log *log.Entry
+}
+
+//
+// NewServerAuth returns new ServerAuth.
+//
+func NewServerAuth(roleOf func(string) string) *ServerAuth {
+	return &ServerAuth{
+		Tokens: map[string]string{},
+		RoleOf: roleOf,
+		log:    log.WithFields(log.Fields{"module": "grpc.auth"}),
+	}
+}
+
+//
+// String returns string.
+//
+func (s *ServerAuth) String() string {
+	roles := []string{}
+	for role := range s.Tokens {
+		roles = append(roles, role)
+	}
+	return fmt.Sprintf("cert:'%s' key:'%s' ca:'%s' tokens:%v", s.CertFile, s.KeyFile, s.CAFile, roles)
+}
+
+//
+// UseTLS returns tls is enabled or not.
+//
+func (s *ServerAuth) UseTLS() bool {
+	return len(s.CertFile) != 0 && len(s.KeyFile) != 0
+}
+
+//
+// UseToken returns token authentication is enabled or not.
+//
+func (s *ServerAuth) UseToken() bool {
+	return len(s.Tokens) != 0
+}
+
+func (s *ServerAuth) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(s.CertFile, s.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if len(s.CAFile) != 0 {
+		pool, err := newCertPool(s.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+//
+// ServerOptions returns grpc.ServerOption.
+//
+func (s *ServerAuth) ServerOptions() ([]grpc.ServerOption, error) {
+	opts := []grpc.ServerOption{}
+
+	if s == nil {
+		return opts, nil
+	}
+
+	if s.UseTLS() {
+		config, err := s.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	}
+
+	if s.UseToken() {
+		opts = append(
+			opts,
+			grpc.UnaryInterceptor(s.UnaryInterceptor),
+			grpc.StreamInterceptor(s.StreamInterceptor),
+		)
+	}
+
+	return opts, nil
+}
+
+//
+// Authenticate checks token in metadata of ctx.
+//
+func (s *ServerAuth) Authenticate(ctx context.Context, method string) error {
+	role := s.RoleOf(method)
+	token, ok := s.Tokens[role]
+	if !ok || len(token) == 0 {
+		s.log.Warnf("Authenticate: no token for role. method:%s role:'%s'", method, role)
+		return status.Errorf(codes.PermissionDenied, "permission denied. %s", method)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "no metadata.")
+	}
+
+	for _, value := range md.Get(AuthTokenMetadataKey) {
+		if !strings.HasPrefix(value, AuthTokenPrefix) {
+			continue
+		}
+
+		value = strings.TrimPrefix(value, AuthTokenPrefix)
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	s.log.Warnf("Authenticate: invalid token. method:%s role:'%s'", method, role)
+	return status.Errorf(codes.Unauthenticated, "invalid token.")
+}
+
+//
+// UnaryInterceptor is grpc.UnaryServerInterceptor.
+//
+func (s *ServerAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.Authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+//
+// StreamInterceptor is grpc.StreamServerInterceptor.
+//
+func (s *ServerAuth) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.Authenticate(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
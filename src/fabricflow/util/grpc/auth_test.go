@@ -0,0 +1,106 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ffgrpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func testRoleOf(method string) string {
+	switch method {
+	case "/test.Api/Read":
+		return "ro"
+	case "/test.Api/Write":
+		return "rw"
+	default:
+		return ""
+	}
+}
+
+func testAuthContext(token string) context.Context {
+	md := metadata.Pairs(AuthTokenMetadataKey, AuthTokenPrefix+token)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func testAuthCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return status.Code(err)
+}
+
+func TestServerAuthenticate(t *testing.T) {
+	auth := NewServerAuth(testRoleOf)
+	auth.Tokens["ro"] = "token-ro"
+
+	tests := []struct {
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{testAuthContext("token-ro"), "/test.Api/Read", codes.OK},
+		{testAuthContext("token-xx"), "/test.Api/Read", codes.Unauthenticated},
+		{context.Background(), "/test.Api/Read", codes.Unauthenticated},
+		{testAuthContext("token-ro"), "/test.Api/Write", codes.PermissionDenied},
+		{testAuthContext("token-ro"), "/test.Api/Other", codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		if code := testAuthCode(auth.Authenticate(test.ctx, test.method)); code != test.code {
+			t.Errorf("Authenticate unmatch. %s %s", test.method, code)
+		}
+	}
+}
+
+func TestTokenCredentials(t *testing.T) {
+	creds := NewTokenCredentials("token-ro", false)
+
+	md, err := creds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Errorf("GetRequestMetadata error. %s", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	auth := NewServerAuth(testRoleOf)
+	auth.Tokens["ro"] = "token-ro"
+
+	if err := auth.Authenticate(ctx, "/test.Api/Read"); err != nil {
+		t.Errorf("Authenticate error. %s", err)
+	}
+}
+
+func TestClientAuthDialOptions(t *testing.T) {
+	var auth *ClientAuth
+	if opts, err := auth.DialOptions(); err != nil || len(opts) != 1 {
+		t.Errorf("DialOptions(nil) unmatch. %d %v", len(opts), err)
+	}
+
+	auth = &ClientAuth{Token: "token"}
+	if opts, err := auth.DialOptions(); err != nil || len(opts) != 2 {
+		t.Errorf("DialOptions(token) unmatch. %d %v", len(opts), err)
+	}
+
+	auth = &ClientAuth{CAFile: "/nonexistent/ca.pem"}
+	if _, err := auth.DialOptions(); err == nil {
+		t.Errorf("DialOptions(tls) must be error.")
+	}
+}
@@ -78,15 +78,22 @@ func (h *ClientConnHandler) HandleConn(ctxt context.Context, st stats.ConnStats)
 	// h.log.Debugf("HandleConn %v", st)
 }
 
-func NewClientConn(addr string) (*grpc.ClientConn, chan *ClientConnInfo, error) {
-	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
+//
+// NewClientConn creates new client connection.
+// addr is host:port or comma separated list of host:port (see DialTarget).
+// If opts is empty, insecure connection is used.
+//
+func NewClientConn(addr string, opts ...grpc.DialOption) (*grpc.ClientConn, chan *ClientConnInfo, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{
+			grpc.WithInsecure(),
+		}
 	}
 
 	ch := NewClientConnChan()
 	opts = append(opts, grpc.WithStatsHandler(NewClientConnHandler(ch)))
 
-	conn, err := grpc.Dial(addr, opts...)
+	conn, err := grpc.Dial(DialTarget(addr), opts...)
 	if err != nil {
 		close(ch)
 		return nil, nil, err
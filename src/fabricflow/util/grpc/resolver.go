@@ -0,0 +1,82 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ffgrpc
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/resolver"
+)
+
+//
+// MultiAddrScheme is scheme of target which has multiple addresses.
+// (ffmulti:///<host:port>,<host:port>,...)
+// The connection is established to the first available address
+// and moves to the next address when it is lost (pick_first).
+//
+const MultiAddrScheme = "ffmulti"
+
+func init() {
+	resolver.Register(&multiAddrBuilder{})
+}
+
+//
+// DialTarget returns grpc target of addr.
+// addr is host:port or comma separated list of host:port.
+//
+func DialTarget(addr string) string {
+	if strings.Contains(addr, ",") {
+		return MultiAddrScheme + ":///" + addr
+	}
+	return addr
+}
+
+//
+// SplitAddrs returns list of host:port.
+//
+func SplitAddrs(addr string) []string {
+	addrs := []string{}
+	for _, a := range strings.Split(addr, ",") {
+		if a = strings.TrimSpace(a); len(a) != 0 {
+			addrs = append(addrs, a)
+		}
+	}
+	return addrs
+}
+
+type multiAddrBuilder struct{}
+
+func (b *multiAddrBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOption) (resolver.Resolver, error) {
+	addrs := []resolver.Address{}
+	for _, addr := range SplitAddrs(target.Endpoint) {
+		addrs = append(addrs, resolver.Address{Addr: addr})
+	}
+
+	cc.UpdateState(resolver.State{Addresses: addrs})
+
+	return &multiAddrResolver{}, nil
+}
+
+func (b *multiAddrBuilder) Scheme() string {
+	return MultiAddrScheme
+}
+
+type multiAddrResolver struct{}
+
+func (r *multiAddrResolver) ResolveNow(resolver.ResolveNowOption) {}
+
+func (r *multiAddrResolver) Close() {}
@@ -0,0 +1,112 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"gonla/nladbm"
+	"gonla/nlamsg"
+	"net"
+)
+
+//
+// Mroute
+//
+func (m *Mroute) NetSrc() net.IP {
+	if len(m.Src) == 0 {
+		return nil
+	}
+	return net.IP(m.Src)
+}
+
+func (m *Mroute) NetGroup() net.IP {
+	return net.IP(m.Group)
+}
+
+func (m *Mroute) ToNative() *nlamsg.Mroute {
+	oifs := make([]*nlamsg.MrouteOif, len(m.Oifs))
+	for i, oif := range m.Oifs {
+		oifs[i] = &nlamsg.MrouteOif{
+			Index: int(oif.Index),
+			Ttl:   uint8(oif.Ttl),
+		}
+	}
+
+	return &nlamsg.Mroute{
+		Family: int(m.Family),
+		Table:  int(m.Table),
+		Src:    m.NetSrc(),
+		Group:  m.NetGroup(),
+		Iif:    int(m.Iif),
+		Oifs:   oifs,
+		NId:    uint8(m.NId),
+		MrId:   m.MrId,
+	}
+}
+
+func NewMrouteFromNative(m *nlamsg.Mroute) *Mroute {
+	oifs := make([]*MrouteOif, len(m.Oifs))
+	for i, oif := range m.Oifs {
+		oifs[i] = &MrouteOif{
+			Index: int32(oif.Index),
+			Ttl:   uint32(oif.Ttl),
+		}
+	}
+
+	return &Mroute{
+		Family: int32(m.Family),
+		Table:  int32(m.Table),
+		Src:    m.Src,
+		Group:  m.Group,
+		Iif:    int32(m.Iif),
+		Oifs:   oifs,
+		NId:    uint32(m.NId),
+		MrId:   m.MrId,
+	}
+}
+
+//
+// Mroute (Key)
+//
+func (k *MrouteKey) ToNative() *nladbm.MrouteKey {
+	return &nladbm.MrouteKey{
+		NId:    uint8(k.NId),
+		Family: int(k.Family),
+		Table:  int(k.Table),
+		Src:    k.Src,
+		Group:  k.Group,
+	}
+}
+
+func NewMrouteKeyFromNative(k *nladbm.MrouteKey) *MrouteKey {
+	return &MrouteKey{
+		NId:    uint32(k.NId),
+		Family: int32(k.Family),
+		Table:  int32(k.Table),
+		Src:    k.Src,
+		Group:  k.Group,
+	}
+}
+
+//
+// Mroutes
+//
+func NewGetMroutesRequest(nid uint8) *GetMroutesRequest {
+	return &GetMroutesRequest{
+		NId: uint32(nid),
+	}
+}
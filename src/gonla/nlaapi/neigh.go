@@ -140,6 +140,7 @@ func (k *NeighKey) ToNative() *nladbm.NeighKey {
 		Addr:    k.Addr,
 		Ifindex: int(k.Ifindex),
 		Vid:     int(k.VlanId),
+		Dst:     k.Dst,
 	}
 }
 
@@ -149,6 +150,7 @@ func NewNeighKeyFromNative(n *nladbm.NeighKey) *NeighKey {
 		Addr:    n.Addr,
 		Ifindex: int32(n.Ifindex),
 		VlanId:  int32(n.Vid),
+		Dst:     n.Dst,
 	}
 }
 
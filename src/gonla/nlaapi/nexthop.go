@@ -0,0 +1,118 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"gonla/nladbm"
+	"gonla/nlamsg"
+	"net"
+)
+
+//
+// NexthopGroupEntry
+//
+func (e *NexthopGroupEntry) ToNative() nlamsg.NexthopGroupEntry {
+	return nlamsg.NexthopGroupEntry{
+		Id:     e.Id,
+		Weight: uint8(e.Weight),
+	}
+}
+
+func NewNexthopGroupEntryFromNative(e *nlamsg.NexthopGroupEntry) *NexthopGroupEntry {
+	return &NexthopGroupEntry{
+		Id:     e.Id,
+		Weight: uint32(e.Weight),
+	}
+}
+
+//
+// Nexthop
+//
+func (n *Nexthop) NetGw() net.IP {
+	return net.IP(n.Gw)
+}
+
+func (n *Nexthop) ToNative() *nlamsg.Nexthop {
+	group := func() []nlamsg.NexthopGroupEntry {
+		if len(n.Group) == 0 {
+			return nil
+		}
+		entries := make([]nlamsg.NexthopGroupEntry, len(n.Group))
+		for i, e := range n.Group {
+			entries[i] = e.ToNative()
+		}
+		return entries
+	}()
+
+	return &nlamsg.Nexthop{
+		Id:        n.Id,
+		Family:    uint8(n.Family),
+		Protocol:  uint8(n.Protocol),
+		Flags:     n.Flags,
+		LinkIndex: int(n.LinkIndex),
+		Gw:        n.NetGw(),
+		Blackhole: n.Blackhole,
+		Group:     group,
+		NId:       uint8(n.NId),
+	}
+}
+
+func NewNexthopFromNative(n *nlamsg.Nexthop) *Nexthop {
+	group := make([]*NexthopGroupEntry, len(n.Group))
+	for i, e := range n.Group {
+		group[i] = NewNexthopGroupEntryFromNative(&e)
+	}
+
+	return &Nexthop{
+		Id:        n.Id,
+		Family:    int32(n.Family),
+		Protocol:  int32(n.Protocol),
+		Flags:     n.Flags,
+		LinkIndex: int32(n.LinkIndex),
+		Gw:        n.Gw,
+		Blackhole: n.Blackhole,
+		Group:     group,
+		NId:       uint32(n.NId),
+	}
+}
+
+//
+// Nexthop (Key)
+//
+func (k *NexthopKey) ToNative() *nladbm.NexthopKey {
+	return &nladbm.NexthopKey{
+		NId: uint8(k.NId),
+		Id:  k.Id,
+	}
+}
+
+func NewNexthopKeyFromNative(n *nladbm.NexthopKey) *NexthopKey {
+	return &NexthopKey{
+		NId: uint32(n.NId),
+		Id:  n.Id,
+	}
+}
+
+//
+// Nexthops
+//
+func NewGetNexthopsRequest(nid uint8) *GetNexthopsRequest {
+	return &GetNexthopsRequest{
+		NId: uint32(nid),
+	}
+}
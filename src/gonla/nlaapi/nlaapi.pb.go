@@ -439,7 +439,7 @@ func (x BridgeVlanInfo_Flags) String() string {
 }
 
 func (BridgeVlanInfo_Flags) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{33, 0}
+	return fileDescriptor_0d5eb4a10391811b, []int{39, 0}
 }
 
 type BridgeVlanInfo_PortType int32
@@ -470,7 +470,7 @@ func (x BridgeVlanInfo_PortType) String() string {
 }
 
 func (BridgeVlanInfo_PortType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{33, 1}
+	return fileDescriptor_0d5eb4a10391811b, []int{39, 1}
 }
 
 //
@@ -681,6 +681,9 @@ type NlMsgUni struct {
 	//	*NlMsgUni_Node
 	//	*NlMsgUni_Vpn
 	//	*NlMsgUni_BrVlanInfo
+	//	*NlMsgUni_Nexthop
+	//	*NlMsgUni_Rule
+	//	*NlMsgUni_Mroute
 	Msg                  isNlMsgUni_Msg `protobuf_oneof:"msg"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
@@ -744,6 +747,18 @@ type NlMsgUni_BrVlanInfo struct {
 	BrVlanInfo *BridgeVlanInfo `protobuf:"bytes,7,opt,name=br_vlan_info,json=brVlanInfo,proto3,oneof"`
 }
 
+type NlMsgUni_Nexthop struct {
+	Nexthop *Nexthop `protobuf:"bytes,8,opt,name=nexthop,proto3,oneof"`
+}
+
+type NlMsgUni_Rule struct {
+	Rule *Rule `protobuf:"bytes,9,opt,name=rule,proto3,oneof"`
+}
+
+type NlMsgUni_Mroute struct {
+	Mroute *Mroute `protobuf:"bytes,10,opt,name=mroute,proto3,oneof"`
+}
+
 func (*NlMsgUni_Link) isNlMsgUni_Msg() {}
 
 func (*NlMsgUni_Addr) isNlMsgUni_Msg() {}
@@ -758,6 +773,12 @@ func (*NlMsgUni_Vpn) isNlMsgUni_Msg() {}
 
 func (*NlMsgUni_BrVlanInfo) isNlMsgUni_Msg() {}
 
+func (*NlMsgUni_Nexthop) isNlMsgUni_Msg() {}
+
+func (*NlMsgUni_Rule) isNlMsgUni_Msg() {}
+
+func (*NlMsgUni_Mroute) isNlMsgUni_Msg() {}
+
 func (m *NlMsgUni) GetMsg() isNlMsgUni_Msg {
 	if m != nil {
 		return m.Msg
@@ -814,6 +835,27 @@ func (m *NlMsgUni) GetBrVlanInfo() *BridgeVlanInfo {
 	return nil
 }
 
+func (m *NlMsgUni) GetNexthop() *Nexthop {
+	if x, ok := m.GetMsg().(*NlMsgUni_Nexthop); ok {
+		return x.Nexthop
+	}
+	return nil
+}
+
+func (m *NlMsgUni) GetRule() *Rule {
+	if x, ok := m.GetMsg().(*NlMsgUni_Rule); ok {
+		return x.Rule
+	}
+	return nil
+}
+
+func (m *NlMsgUni) GetMroute() *Mroute {
+	if x, ok := m.GetMsg().(*NlMsgUni_Mroute); ok {
+		return x.Mroute
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*NlMsgUni) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -824,6 +866,9 @@ func (*NlMsgUni) XXX_OneofWrappers() []interface{} {
 		(*NlMsgUni_Node)(nil),
 		(*NlMsgUni_Vpn)(nil),
 		(*NlMsgUni_BrVlanInfo)(nil),
+		(*NlMsgUni_Nexthop)(nil),
+		(*NlMsgUni_Rule)(nil),
+		(*NlMsgUni_Mroute)(nil),
 	}
 }
 
@@ -1233,6 +1278,123 @@ func (m *GetBridgeVlanInfosRequest) GetNId() uint32 {
 	return 0
 }
 
+type GetNexthopsRequest struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNexthopsRequest) Reset()         { *m = GetNexthopsRequest{} }
+func (m *GetNexthopsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNexthopsRequest) ProtoMessage()    {}
+func (*GetNexthopsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{15}
+}
+
+func (m *GetNexthopsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNexthopsRequest.Unmarshal(m, b)
+}
+func (m *GetNexthopsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNexthopsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNexthopsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNexthopsRequest.Merge(m, src)
+}
+func (m *GetNexthopsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNexthopsRequest.Size(m)
+}
+func (m *GetNexthopsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNexthopsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNexthopsRequest proto.InternalMessageInfo
+
+func (m *GetNexthopsRequest) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+type GetRulesRequest struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRulesRequest) Reset()         { *m = GetRulesRequest{} }
+func (m *GetRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRulesRequest) ProtoMessage()    {}
+func (*GetRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{16}
+}
+
+func (m *GetRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRulesRequest.Unmarshal(m, b)
+}
+func (m *GetRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRulesRequest.Merge(m, src)
+}
+func (m *GetRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRulesRequest.Size(m)
+}
+func (m *GetRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRulesRequest proto.InternalMessageInfo
+
+func (m *GetRulesRequest) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+type GetMroutesRequest struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMroutesRequest) Reset()         { *m = GetMroutesRequest{} }
+func (m *GetMroutesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMroutesRequest) ProtoMessage()    {}
+func (*GetMroutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{17}
+}
+
+func (m *GetMroutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMroutesRequest.Unmarshal(m, b)
+}
+func (m *GetMroutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMroutesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMroutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMroutesRequest.Merge(m, src)
+}
+func (m *GetMroutesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMroutesRequest.Size(m)
+}
+func (m *GetMroutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMroutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMroutesRequest proto.InternalMessageInfo
+
+func (m *GetMroutesRequest) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
 type GetNodesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1243,7 +1405,7 @@ func (m *GetNodesRequest) Reset()         { *m = GetNodesRequest{} }
 func (m *GetNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNodesRequest) ProtoMessage()    {}
 func (*GetNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{15}
+	return fileDescriptor_0d5eb4a10391811b, []int{18}
 }
 
 func (m *GetNodesRequest) XXX_Unmarshal(b []byte) error {
@@ -1274,7 +1436,7 @@ func (m *GetVpnsRequest) Reset()         { *m = GetVpnsRequest{} }
 func (m *GetVpnsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVpnsRequest) ProtoMessage()    {}
 func (*GetVpnsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{16}
+	return fileDescriptor_0d5eb4a10391811b, []int{19}
 }
 
 func (m *GetVpnsRequest) XXX_Unmarshal(b []byte) error {
@@ -1305,7 +1467,7 @@ func (m *GetEncapInfosRequest) Reset()         { *m = GetEncapInfosRequest{} }
 func (m *GetEncapInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*GetEncapInfosRequest) ProtoMessage()    {}
 func (*GetEncapInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{17}
+	return fileDescriptor_0d5eb4a10391811b, []int{20}
 }
 
 func (m *GetEncapInfosRequest) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +1498,7 @@ func (m *GetIptunsRequest) Reset()         { *m = GetIptunsRequest{} }
 func (m *GetIptunsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIptunsRequest) ProtoMessage()    {}
 func (*GetIptunsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{18}
+	return fileDescriptor_0d5eb4a10391811b, []int{21}
 }
 
 func (m *GetIptunsRequest) XXX_Unmarshal(b []byte) error {
@@ -1367,7 +1529,7 @@ func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
 func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsRequest) ProtoMessage()    {}
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{19}
+	return fileDescriptor_0d5eb4a10391811b, []int{22}
 }
 
 func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -1400,7 +1562,7 @@ func (m *LinkKey) Reset()         { *m = LinkKey{} }
 func (m *LinkKey) String() string { return proto.CompactTextString(m) }
 func (*LinkKey) ProtoMessage()    {}
 func (*LinkKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{20}
+	return fileDescriptor_0d5eb4a10391811b, []int{23}
 }
 
 func (m *LinkKey) XXX_Unmarshal(b []byte) error {
@@ -1447,7 +1609,7 @@ func (m *AddrKey) Reset()         { *m = AddrKey{} }
 func (m *AddrKey) String() string { return proto.CompactTextString(m) }
 func (*AddrKey) ProtoMessage()    {}
 func (*AddrKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{21}
+	return fileDescriptor_0d5eb4a10391811b, []int{24}
 }
 
 func (m *AddrKey) XXX_Unmarshal(b []byte) error {
@@ -1487,6 +1649,7 @@ type NeighKey struct {
 	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
 	Ifindex              int32    `protobuf:"varint,3,opt,name=ifindex,proto3" json:"ifindex,omitempty"`
 	VlanId               int32    `protobuf:"varint,4,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	Dst                  string   `protobuf:"bytes,5,opt,name=dst,proto3" json:"dst,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1496,7 +1659,7 @@ func (m *NeighKey) Reset()         { *m = NeighKey{} }
 func (m *NeighKey) String() string { return proto.CompactTextString(m) }
 func (*NeighKey) ProtoMessage()    {}
 func (*NeighKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{22}
+	return fileDescriptor_0d5eb4a10391811b, []int{25}
 }
 
 func (m *NeighKey) XXX_Unmarshal(b []byte) error {
@@ -1545,6 +1708,13 @@ func (m *NeighKey) GetVlanId() int32 {
 	return 0
 }
 
+func (m *NeighKey) GetDst() string {
+	if m != nil {
+		return m.Dst
+	}
+	return ""
+}
+
 type RouteKey struct {
 	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
 	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -1557,7 +1727,7 @@ func (m *RouteKey) Reset()         { *m = RouteKey{} }
 func (m *RouteKey) String() string { return proto.CompactTextString(m) }
 func (*RouteKey) ProtoMessage()    {}
 func (*RouteKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{23}
+	return fileDescriptor_0d5eb4a10391811b, []int{26}
 }
 
 func (m *RouteKey) XXX_Unmarshal(b []byte) error {
@@ -1604,7 +1774,7 @@ func (m *MplsKey) Reset()         { *m = MplsKey{} }
 func (m *MplsKey) String() string { return proto.CompactTextString(m) }
 func (*MplsKey) ProtoMessage()    {}
 func (*MplsKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{24}
+	return fileDescriptor_0d5eb4a10391811b, []int{27}
 }
 
 func (m *MplsKey) XXX_Unmarshal(b []byte) error {
@@ -1650,7 +1820,7 @@ func (m *NodeKey) Reset()         { *m = NodeKey{} }
 func (m *NodeKey) String() string { return proto.CompactTextString(m) }
 func (*NodeKey) ProtoMessage()    {}
 func (*NodeKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{25}
+	return fileDescriptor_0d5eb4a10391811b, []int{28}
 }
 
 func (m *NodeKey) XXX_Unmarshal(b []byte) error {
@@ -1691,7 +1861,7 @@ func (m *VpnKey) Reset()         { *m = VpnKey{} }
 func (m *VpnKey) String() string { return proto.CompactTextString(m) }
 func (*VpnKey) ProtoMessage()    {}
 func (*VpnKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{26}
+	return fileDescriptor_0d5eb4a10391811b, []int{29}
 }
 
 func (m *VpnKey) XXX_Unmarshal(b []byte) error {
@@ -1745,7 +1915,7 @@ func (m *IptunKey) Reset()         { *m = IptunKey{} }
 func (m *IptunKey) String() string { return proto.CompactTextString(m) }
 func (*IptunKey) ProtoMessage()    {}
 func (*IptunKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{27}
+	return fileDescriptor_0d5eb4a10391811b, []int{30}
 }
 
 func (m *IptunKey) XXX_Unmarshal(b []byte) error {
@@ -1793,7 +1963,7 @@ func (m *BridgeVlanInfoKey) Reset()         { *m = BridgeVlanInfoKey{} }
 func (m *BridgeVlanInfoKey) String() string { return proto.CompactTextString(m) }
 func (*BridgeVlanInfoKey) ProtoMessage()    {}
 func (*BridgeVlanInfoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{28}
+	return fileDescriptor_0d5eb4a10391811b, []int{31}
 }
 
 func (m *BridgeVlanInfoKey) XXX_Unmarshal(b []byte) error {
@@ -1835,205 +2005,426 @@ func (m *BridgeVlanInfoKey) GetVid() uint32 {
 	return 0
 }
 
-//
-// Messages
-//
-type Stat struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Val                  uint64   `protobuf:"varint,2,opt,name=val,proto3" json:"val,omitempty"`
+type NexthopKey struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	Id                   uint32   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Stat) Reset()         { *m = Stat{} }
-func (m *Stat) String() string { return proto.CompactTextString(m) }
-func (*Stat) ProtoMessage()    {}
-func (*Stat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{29}
+func (m *NexthopKey) Reset()         { *m = NexthopKey{} }
+func (m *NexthopKey) String() string { return proto.CompactTextString(m) }
+func (*NexthopKey) ProtoMessage()    {}
+func (*NexthopKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{32}
 }
 
-func (m *Stat) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Stat.Unmarshal(m, b)
+func (m *NexthopKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NexthopKey.Unmarshal(m, b)
 }
-func (m *Stat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Stat.Marshal(b, m, deterministic)
+func (m *NexthopKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NexthopKey.Marshal(b, m, deterministic)
 }
-func (m *Stat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stat.Merge(m, src)
+func (m *NexthopKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NexthopKey.Merge(m, src)
 }
-func (m *Stat) XXX_Size() int {
-	return xxx_messageInfo_Stat.Size(m)
+func (m *NexthopKey) XXX_Size() int {
+	return xxx_messageInfo_NexthopKey.Size(m)
 }
-func (m *Stat) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stat.DiscardUnknown(m)
+func (m *NexthopKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NexthopKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stat proto.InternalMessageInfo
+var xxx_messageInfo_NexthopKey proto.InternalMessageInfo
 
-func (m *Stat) GetKey() string {
+func (m *NexthopKey) GetNId() uint32 {
 	if m != nil {
-		return m.Key
+		return m.NId
 	}
-	return ""
+	return 0
 }
 
-func (m *Stat) GetVal() uint64 {
+func (m *NexthopKey) GetId() uint32 {
 	if m != nil {
-		return m.Val
+		return m.Id
 	}
 	return 0
 }
 
-type Node struct {
-	Ip                   []byte   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	NId                  uint32   `protobuf:"varint,2,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+type RuleKey struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	Family               int32    `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Priority             int32    `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Src                  string   `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
+	Dst                  string   `protobuf:"bytes,5,opt,name=dst,proto3" json:"dst,omitempty"`
+	IifName              string   `protobuf:"bytes,6,opt,name=iif_name,json=iifName,proto3" json:"iif_name,omitempty"`
+	OifName              string   `protobuf:"bytes,7,opt,name=oif_name,json=oifName,proto3" json:"oif_name,omitempty"`
+	Mark                 int32    `protobuf:"varint,8,opt,name=mark,proto3" json:"mark,omitempty"`
+	Table                int32    `protobuf:"varint,9,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Node) Reset()         { *m = Node{} }
-func (m *Node) String() string { return proto.CompactTextString(m) }
-func (*Node) ProtoMessage()    {}
-func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{30}
+func (m *RuleKey) Reset()         { *m = RuleKey{} }
+func (m *RuleKey) String() string { return proto.CompactTextString(m) }
+func (*RuleKey) ProtoMessage()    {}
+func (*RuleKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{33}
 }
 
-func (m *Node) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Node.Unmarshal(m, b)
+func (m *RuleKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleKey.Unmarshal(m, b)
 }
-func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+func (m *RuleKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleKey.Marshal(b, m, deterministic)
 }
-func (m *Node) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Node.Merge(m, src)
+func (m *RuleKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleKey.Merge(m, src)
 }
-func (m *Node) XXX_Size() int {
-	return xxx_messageInfo_Node.Size(m)
+func (m *RuleKey) XXX_Size() int {
+	return xxx_messageInfo_RuleKey.Size(m)
 }
-func (m *Node) XXX_DiscardUnknown() {
-	xxx_messageInfo_Node.DiscardUnknown(m)
+func (m *RuleKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Node proto.InternalMessageInfo
+var xxx_messageInfo_RuleKey proto.InternalMessageInfo
 
-func (m *Node) GetIp() []byte {
+func (m *RuleKey) GetNId() uint32 {
 	if m != nil {
-		return m.Ip
+		return m.NId
 	}
-	return nil
+	return 0
 }
 
-func (m *Node) GetNId() uint32 {
+func (m *RuleKey) GetFamily() int32 {
 	if m != nil {
-		return m.NId
+		return m.Family
 	}
 	return 0
 }
 
-type Vpn struct {
-	Ip                   []byte   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	Mask                 []byte   `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
-	Gw                   []byte   `protobuf:"bytes,3,opt,name=gw,proto3" json:"gw,omitempty"`
-	Label                uint32   `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
-	VpnGw                []byte   `protobuf:"bytes,5,opt,name=vpn_gw,json=vpnGw,proto3" json:"vpn_gw,omitempty"`
-	NId                  uint32   `protobuf:"varint,6,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
-	VpnId                uint32   `protobuf:"varint,7,opt,name=vpn_id,json=vpnId,proto3" json:"vpn_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Vpn) Reset()         { *m = Vpn{} }
-func (m *Vpn) String() string { return proto.CompactTextString(m) }
-func (*Vpn) ProtoMessage()    {}
-func (*Vpn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{31}
-}
-
-func (m *Vpn) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Vpn.Unmarshal(m, b)
-}
-func (m *Vpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Vpn.Marshal(b, m, deterministic)
-}
-func (m *Vpn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vpn.Merge(m, src)
-}
-func (m *Vpn) XXX_Size() int {
-	return xxx_messageInfo_Vpn.Size(m)
-}
-func (m *Vpn) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vpn.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Vpn proto.InternalMessageInfo
-
-func (m *Vpn) GetIp() []byte {
+func (m *RuleKey) GetPriority() int32 {
 	if m != nil {
-		return m.Ip
+		return m.Priority
 	}
-	return nil
+	return 0
 }
 
-func (m *Vpn) GetMask() []byte {
+func (m *RuleKey) GetSrc() string {
 	if m != nil {
-		return m.Mask
+		return m.Src
 	}
-	return nil
+	return ""
 }
 
-func (m *Vpn) GetGw() []byte {
+func (m *RuleKey) GetDst() string {
 	if m != nil {
-		return m.Gw
+		return m.Dst
 	}
-	return nil
+	return ""
 }
 
-func (m *Vpn) GetLabel() uint32 {
+func (m *RuleKey) GetIifName() string {
 	if m != nil {
-		return m.Label
+		return m.IifName
 	}
-	return 0
+	return ""
 }
 
-func (m *Vpn) GetVpnGw() []byte {
+func (m *RuleKey) GetOifName() string {
 	if m != nil {
-		return m.VpnGw
+		return m.OifName
 	}
-	return nil
+	return ""
 }
 
-func (m *Vpn) GetNId() uint32 {
+func (m *RuleKey) GetMark() int32 {
 	if m != nil {
-		return m.NId
+		return m.Mark
 	}
 	return 0
 }
 
-func (m *Vpn) GetVpnId() uint32 {
+func (m *RuleKey) GetTable() int32 {
 	if m != nil {
-		return m.VpnId
+		return m.Table
 	}
 	return 0
 }
 
-type Iptun struct {
-	Link                 *Link    `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
-	LocalMac             []byte   `protobuf:"bytes,2,opt,name=local_mac,json=localMac,proto3" json:"local_mac,omitempty"`
-	NId                  uint32   `protobuf:"varint,3,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
-	TnlId                uint32   `protobuf:"varint,4,opt,name=tnl_id,json=tnlId,proto3" json:"tnl_id,omitempty"`
+type MrouteKey struct {
+	NId                  uint32   `protobuf:"varint,1,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	Family               int32    `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Table                int32    `protobuf:"varint,3,opt,name=table,proto3" json:"table,omitempty"`
+	Src                  string   `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
+	Group                string   `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Iptun) Reset()         { *m = Iptun{} }
-func (m *Iptun) String() string { return proto.CompactTextString(m) }
-func (*Iptun) ProtoMessage()    {}
+func (m *MrouteKey) Reset()         { *m = MrouteKey{} }
+func (m *MrouteKey) String() string { return proto.CompactTextString(m) }
+func (*MrouteKey) ProtoMessage()    {}
+func (*MrouteKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{34}
+}
+
+func (m *MrouteKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MrouteKey.Unmarshal(m, b)
+}
+func (m *MrouteKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MrouteKey.Marshal(b, m, deterministic)
+}
+func (m *MrouteKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MrouteKey.Merge(m, src)
+}
+func (m *MrouteKey) XXX_Size() int {
+	return xxx_messageInfo_MrouteKey.Size(m)
+}
+func (m *MrouteKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MrouteKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MrouteKey proto.InternalMessageInfo
+
+func (m *MrouteKey) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+func (m *MrouteKey) GetFamily() int32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+func (m *MrouteKey) GetTable() int32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *MrouteKey) GetSrc() string {
+	if m != nil {
+		return m.Src
+	}
+	return ""
+}
+
+func (m *MrouteKey) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+//
+// Messages
+//
+type Stat struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Val                  uint64   `protobuf:"varint,2,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stat) Reset()         { *m = Stat{} }
+func (m *Stat) String() string { return proto.CompactTextString(m) }
+func (*Stat) ProtoMessage()    {}
+func (*Stat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{35}
+}
+
+func (m *Stat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stat.Unmarshal(m, b)
+}
+func (m *Stat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stat.Marshal(b, m, deterministic)
+}
+func (m *Stat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stat.Merge(m, src)
+}
+func (m *Stat) XXX_Size() int {
+	return xxx_messageInfo_Stat.Size(m)
+}
+func (m *Stat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stat proto.InternalMessageInfo
+
+func (m *Stat) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Stat) GetVal() uint64 {
+	if m != nil {
+		return m.Val
+	}
+	return 0
+}
+
+type Node struct {
+	Ip                   []byte   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	NId                  uint32   `protobuf:"varint,2,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{36}
+}
+
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Node.Unmarshal(m, b)
+}
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+}
+func (m *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(m, src)
+}
+func (m *Node) XXX_Size() int {
+	return xxx_messageInfo_Node.Size(m)
+}
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Node proto.InternalMessageInfo
+
+func (m *Node) GetIp() []byte {
+	if m != nil {
+		return m.Ip
+	}
+	return nil
+}
+
+func (m *Node) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+type Vpn struct {
+	Ip                   []byte   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Mask                 []byte   `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
+	Gw                   []byte   `protobuf:"bytes,3,opt,name=gw,proto3" json:"gw,omitempty"`
+	Label                uint32   `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
+	VpnGw                []byte   `protobuf:"bytes,5,opt,name=vpn_gw,json=vpnGw,proto3" json:"vpn_gw,omitempty"`
+	NId                  uint32   `protobuf:"varint,6,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	VpnId                uint32   `protobuf:"varint,7,opt,name=vpn_id,json=vpnId,proto3" json:"vpn_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vpn) Reset()         { *m = Vpn{} }
+func (m *Vpn) String() string { return proto.CompactTextString(m) }
+func (*Vpn) ProtoMessage()    {}
+func (*Vpn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{37}
+}
+
+func (m *Vpn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vpn.Unmarshal(m, b)
+}
+func (m *Vpn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vpn.Marshal(b, m, deterministic)
+}
+func (m *Vpn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vpn.Merge(m, src)
+}
+func (m *Vpn) XXX_Size() int {
+	return xxx_messageInfo_Vpn.Size(m)
+}
+func (m *Vpn) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vpn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vpn proto.InternalMessageInfo
+
+func (m *Vpn) GetIp() []byte {
+	if m != nil {
+		return m.Ip
+	}
+	return nil
+}
+
+func (m *Vpn) GetMask() []byte {
+	if m != nil {
+		return m.Mask
+	}
+	return nil
+}
+
+func (m *Vpn) GetGw() []byte {
+	if m != nil {
+		return m.Gw
+	}
+	return nil
+}
+
+func (m *Vpn) GetLabel() uint32 {
+	if m != nil {
+		return m.Label
+	}
+	return 0
+}
+
+func (m *Vpn) GetVpnGw() []byte {
+	if m != nil {
+		return m.VpnGw
+	}
+	return nil
+}
+
+func (m *Vpn) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+func (m *Vpn) GetVpnId() uint32 {
+	if m != nil {
+		return m.VpnId
+	}
+	return 0
+}
+
+type Iptun struct {
+	Link                 *Link    `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	LocalMac             []byte   `protobuf:"bytes,2,opt,name=local_mac,json=localMac,proto3" json:"local_mac,omitempty"`
+	NId                  uint32   `protobuf:"varint,3,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	TnlId                uint32   `protobuf:"varint,4,opt,name=tnl_id,json=tnlId,proto3" json:"tnl_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Iptun) Reset()         { *m = Iptun{} }
+func (m *Iptun) String() string { return proto.CompactTextString(m) }
+func (*Iptun) ProtoMessage()    {}
 func (*Iptun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{32}
+	return fileDescriptor_0d5eb4a10391811b, []int{38}
 }
 
 func (m *Iptun) XXX_Unmarshal(b []byte) error {
@@ -2100,7 +2491,7 @@ func (m *BridgeVlanInfo) Reset()         { *m = BridgeVlanInfo{} }
 func (m *BridgeVlanInfo) String() string { return proto.CompactTextString(m) }
 func (*BridgeVlanInfo) ProtoMessage()    {}
 func (*BridgeVlanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{33}
+	return fileDescriptor_0d5eb4a10391811b, []int{39}
 }
 
 func (m *BridgeVlanInfo) XXX_Unmarshal(b []byte) error {
@@ -2195,7 +2586,7 @@ func (m *BondSlaveInfo) Reset()         { *m = BondSlaveInfo{} }
 func (m *BondSlaveInfo) String() string { return proto.CompactTextString(m) }
 func (*BondSlaveInfo) ProtoMessage()    {}
 func (*BondSlaveInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{34}
+	return fileDescriptor_0d5eb4a10391811b, []int{40}
 }
 
 func (m *BondSlaveInfo) XXX_Unmarshal(b []byte) error {
@@ -2298,7 +2689,7 @@ func (m *LinkAttrs) Reset()         { *m = LinkAttrs{} }
 func (m *LinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*LinkAttrs) ProtoMessage()    {}
 func (*LinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{35}
+	return fileDescriptor_0d5eb4a10391811b, []int{41}
 }
 
 func (m *LinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2452,7 +2843,7 @@ func (m *GenericLinkAttrs) Reset()         { *m = GenericLinkAttrs{} }
 func (m *GenericLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*GenericLinkAttrs) ProtoMessage()    {}
 func (*GenericLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{36}
+	return fileDescriptor_0d5eb4a10391811b, []int{42}
 }
 
 func (m *GenericLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2491,7 +2882,7 @@ func (m *DeviceLinkAttrs) Reset()         { *m = DeviceLinkAttrs{} }
 func (m *DeviceLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*DeviceLinkAttrs) ProtoMessage()    {}
 func (*DeviceLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{37}
+	return fileDescriptor_0d5eb4a10391811b, []int{43}
 }
 
 func (m *DeviceLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2533,7 +2924,7 @@ func (m *BridgeLinkAttrs) Reset()         { *m = BridgeLinkAttrs{} }
 func (m *BridgeLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*BridgeLinkAttrs) ProtoMessage()    {}
 func (*BridgeLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{38}
+	return fileDescriptor_0d5eb4a10391811b, []int{44}
 }
 
 func (m *BridgeLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2594,7 +2985,7 @@ func (m *VlanLinkAttrs) Reset()         { *m = VlanLinkAttrs{} }
 func (m *VlanLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*VlanLinkAttrs) ProtoMessage()    {}
 func (*VlanLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{39}
+	return fileDescriptor_0d5eb4a10391811b, []int{45}
 }
 
 func (m *VlanLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2659,7 +3050,7 @@ func (m *VxlanLinkAttrs) Reset()         { *m = VxlanLinkAttrs{} }
 func (m *VxlanLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*VxlanLinkAttrs) ProtoMessage()    {}
 func (*VxlanLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{40}
+	return fileDescriptor_0d5eb4a10391811b, []int{46}
 }
 
 func (m *VxlanLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2836,7 +3227,7 @@ func (m *VtiLinkAttrs) Reset()         { *m = VtiLinkAttrs{} }
 func (m *VtiLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*VtiLinkAttrs) ProtoMessage()    {}
 func (*VtiLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{41}
+	return fileDescriptor_0d5eb4a10391811b, []int{47}
 }
 
 func (m *VtiLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2911,7 +3302,7 @@ func (m *VethLinkAttrs) Reset()         { *m = VethLinkAttrs{} }
 func (m *VethLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*VethLinkAttrs) ProtoMessage()    {}
 func (*VethLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{42}
+	return fileDescriptor_0d5eb4a10391811b, []int{48}
 }
 
 func (m *VethLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -2961,7 +3352,7 @@ func (m *BondAdInfo) Reset()         { *m = BondAdInfo{} }
 func (m *BondAdInfo) String() string { return proto.CompactTextString(m) }
 func (*BondAdInfo) ProtoMessage()    {}
 func (*BondAdInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{43}
+	return fileDescriptor_0d5eb4a10391811b, []int{49}
 }
 
 func (m *BondAdInfo) XXX_Unmarshal(b []byte) error {
@@ -3051,7 +3442,7 @@ func (m *BondLinkAttrs) Reset()         { *m = BondLinkAttrs{} }
 func (m *BondLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*BondLinkAttrs) ProtoMessage()    {}
 func (*BondLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{44}
+	return fileDescriptor_0d5eb4a10391811b, []int{50}
 }
 
 func (m *BondLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -3262,7 +3653,7 @@ func (m *IptunLinkAttrs) Reset()         { *m = IptunLinkAttrs{} }
 func (m *IptunLinkAttrs) String() string { return proto.CompactTextString(m) }
 func (*IptunLinkAttrs) ProtoMessage()    {}
 func (*IptunLinkAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{45}
+	return fileDescriptor_0d5eb4a10391811b, []int{51}
 }
 
 func (m *IptunLinkAttrs) XXX_Unmarshal(b []byte) error {
@@ -3391,7 +3782,7 @@ func (m *Link) Reset()         { *m = Link{} }
 func (m *Link) String() string { return proto.CompactTextString(m) }
 func (*Link) ProtoMessage()    {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{46}
+	return fileDescriptor_0d5eb4a10391811b, []int{52}
 }
 
 func (m *Link) XXX_Unmarshal(b []byte) error {
@@ -3598,7 +3989,7 @@ func (m *Addr) Reset()         { *m = Addr{} }
 func (m *Addr) String() string { return proto.CompactTextString(m) }
 func (*Addr) ProtoMessage()    {}
 func (*Addr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{47}
+	return fileDescriptor_0d5eb4a10391811b, []int{53}
 }
 
 func (m *Addr) XXX_Unmarshal(b []byte) error {
@@ -3713,7 +4104,7 @@ func (m *NeighNotun) Reset()         { *m = NeighNotun{} }
 func (m *NeighNotun) String() string { return proto.CompactTextString(m) }
 func (*NeighNotun) ProtoMessage()    {}
 func (*NeighNotun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{48}
+	return fileDescriptor_0d5eb4a10391811b, []int{54}
 }
 
 func (m *NeighNotun) XXX_Unmarshal(b []byte) error {
@@ -3746,7 +4137,7 @@ func (m *NeighIptun) Reset()         { *m = NeighIptun{} }
 func (m *NeighIptun) String() string { return proto.CompactTextString(m) }
 func (*NeighIptun) ProtoMessage()    {}
 func (*NeighIptun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{49}
+	return fileDescriptor_0d5eb4a10391811b, []int{55}
 }
 
 func (m *NeighIptun) XXX_Unmarshal(b []byte) error {
@@ -3808,7 +4199,7 @@ func (m *Neigh) Reset()         { *m = Neigh{} }
 func (m *Neigh) String() string { return proto.CompactTextString(m) }
 func (*Neigh) ProtoMessage()    {}
 func (*Neigh) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{50}
+	return fileDescriptor_0d5eb4a10391811b, []int{56}
 }
 
 func (m *Neigh) XXX_Unmarshal(b []byte) error {
@@ -3981,7 +4372,7 @@ func (m *NexthopInfo) Reset()         { *m = NexthopInfo{} }
 func (m *NexthopInfo) String() string { return proto.CompactTextString(m) }
 func (*NexthopInfo) ProtoMessage()    {}
 func (*NexthopInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{51}
+	return fileDescriptor_0d5eb4a10391811b, []int{57}
 }
 
 func (m *NexthopInfo) XXX_Unmarshal(b []byte) error {
@@ -4055,7 +4446,7 @@ func (m *MPLSDestination) Reset()         { *m = MPLSDestination{} }
 func (m *MPLSDestination) String() string { return proto.CompactTextString(m) }
 func (*MPLSDestination) ProtoMessage()    {}
 func (*MPLSDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{52}
+	return fileDescriptor_0d5eb4a10391811b, []int{58}
 }
 
 func (m *MPLSDestination) XXX_Unmarshal(b []byte) error {
@@ -4097,7 +4488,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{53}
+	return fileDescriptor_0d5eb4a10391811b, []int{59}
 }
 
 func (m *Destination) XXX_Unmarshal(b []byte) error {
@@ -4167,7 +4558,7 @@ func (m *MPLSEncap) Reset()         { *m = MPLSEncap{} }
 func (m *MPLSEncap) String() string { return proto.CompactTextString(m) }
 func (*MPLSEncap) ProtoMessage()    {}
 func (*MPLSEncap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{54}
+	return fileDescriptor_0d5eb4a10391811b, []int{60}
 }
 
 func (m *MPLSEncap) XXX_Unmarshal(b []byte) error {
@@ -4195,10 +4586,146 @@ func (m *MPLSEncap) GetLabels() []uint32 {
 	return nil
 }
 
+type SEG6Encap struct {
+	Mode                 int32    `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Segments             [][]byte `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SEG6Encap) Reset()         { *m = SEG6Encap{} }
+func (m *SEG6Encap) String() string { return proto.CompactTextString(m) }
+func (*SEG6Encap) ProtoMessage()    {}
+func (*SEG6Encap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{61}
+}
+
+func (m *SEG6Encap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SEG6Encap.Unmarshal(m, b)
+}
+func (m *SEG6Encap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SEG6Encap.Marshal(b, m, deterministic)
+}
+func (m *SEG6Encap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SEG6Encap.Merge(m, src)
+}
+func (m *SEG6Encap) XXX_Size() int {
+	return xxx_messageInfo_SEG6Encap.Size(m)
+}
+func (m *SEG6Encap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SEG6Encap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SEG6Encap proto.InternalMessageInfo
+
+func (m *SEG6Encap) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *SEG6Encap) GetSegments() [][]byte {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type SEG6LocalEncap struct {
+	Action               int32    `protobuf:"varint,1,opt,name=action,proto3" json:"action,omitempty"`
+	Segments             [][]byte `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	Table                int32    `protobuf:"varint,3,opt,name=table,proto3" json:"table,omitempty"`
+	InAddr               []byte   `protobuf:"bytes,4,opt,name=in_addr,json=inAddr,proto3" json:"in_addr,omitempty"`
+	In6Addr              []byte   `protobuf:"bytes,5,opt,name=in6_addr,json=in6Addr,proto3" json:"in6_addr,omitempty"`
+	Iif                  int32    `protobuf:"varint,6,opt,name=iif,proto3" json:"iif,omitempty"`
+	Oif                  int32    `protobuf:"varint,7,opt,name=oif,proto3" json:"oif,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SEG6LocalEncap) Reset()         { *m = SEG6LocalEncap{} }
+func (m *SEG6LocalEncap) String() string { return proto.CompactTextString(m) }
+func (*SEG6LocalEncap) ProtoMessage()    {}
+func (*SEG6LocalEncap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{62}
+}
+
+func (m *SEG6LocalEncap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SEG6LocalEncap.Unmarshal(m, b)
+}
+func (m *SEG6LocalEncap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SEG6LocalEncap.Marshal(b, m, deterministic)
+}
+func (m *SEG6LocalEncap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SEG6LocalEncap.Merge(m, src)
+}
+func (m *SEG6LocalEncap) XXX_Size() int {
+	return xxx_messageInfo_SEG6LocalEncap.Size(m)
+}
+func (m *SEG6LocalEncap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SEG6LocalEncap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SEG6LocalEncap proto.InternalMessageInfo
+
+func (m *SEG6LocalEncap) GetAction() int32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *SEG6LocalEncap) GetSegments() [][]byte {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *SEG6LocalEncap) GetTable() int32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *SEG6LocalEncap) GetInAddr() []byte {
+	if m != nil {
+		return m.InAddr
+	}
+	return nil
+}
+
+func (m *SEG6LocalEncap) GetIn6Addr() []byte {
+	if m != nil {
+		return m.In6Addr
+	}
+	return nil
+}
+
+func (m *SEG6LocalEncap) GetIif() int32 {
+	if m != nil {
+		return m.Iif
+	}
+	return 0
+}
+
+func (m *SEG6LocalEncap) GetOif() int32 {
+	if m != nil {
+		return m.Oif
+	}
+	return 0
+}
+
 type Encap struct {
 	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
 	// Types that are valid to be assigned to Encap:
 	//	*Encap_Mpls
+	//	*Encap_Seg6
+	//	*Encap_Seg6Local
 	Encap                isEncap_Encap `protobuf_oneof:"encap"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -4209,7 +4736,7 @@ func (m *Encap) Reset()         { *m = Encap{} }
 func (m *Encap) String() string { return proto.CompactTextString(m) }
 func (*Encap) ProtoMessage()    {}
 func (*Encap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{55}
+	return fileDescriptor_0d5eb4a10391811b, []int{63}
 }
 
 func (m *Encap) XXX_Unmarshal(b []byte) error {
@@ -4245,8 +4772,20 @@ type Encap_Mpls struct {
 	Mpls *MPLSEncap `protobuf:"bytes,2,opt,name=mpls,proto3,oneof"`
 }
 
+type Encap_Seg6 struct {
+	Seg6 *SEG6Encap `protobuf:"bytes,3,opt,name=seg6,proto3,oneof"`
+}
+
+type Encap_Seg6Local struct {
+	Seg6Local *SEG6LocalEncap `protobuf:"bytes,4,opt,name=seg6local,proto3,oneof"`
+}
+
 func (*Encap_Mpls) isEncap_Encap() {}
 
+func (*Encap_Seg6) isEncap_Encap() {}
+
+func (*Encap_Seg6Local) isEncap_Encap() {}
+
 func (m *Encap) GetEncap() isEncap_Encap {
 	if m != nil {
 		return m.Encap
@@ -4261,11 +4800,470 @@ func (m *Encap) GetMpls() *MPLSEncap {
 	return nil
 }
 
+func (m *Encap) GetSeg6() *SEG6Encap {
+	if x, ok := m.GetEncap().(*Encap_Seg6); ok {
+		return x.Seg6
+	}
+	return nil
+}
+
+func (m *Encap) GetSeg6Local() *SEG6LocalEncap {
+	if x, ok := m.GetEncap().(*Encap_Seg6Local); ok {
+		return x.Seg6Local
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Encap) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Encap_Mpls)(nil),
+		(*Encap_Seg6)(nil),
+		(*Encap_Seg6Local)(nil),
+	}
+}
+
+type NexthopGroupEntry struct {
+	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weight               uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NexthopGroupEntry) Reset()         { *m = NexthopGroupEntry{} }
+func (m *NexthopGroupEntry) String() string { return proto.CompactTextString(m) }
+func (*NexthopGroupEntry) ProtoMessage()    {}
+func (*NexthopGroupEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{64}
+}
+
+func (m *NexthopGroupEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NexthopGroupEntry.Unmarshal(m, b)
+}
+func (m *NexthopGroupEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NexthopGroupEntry.Marshal(b, m, deterministic)
+}
+func (m *NexthopGroupEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NexthopGroupEntry.Merge(m, src)
+}
+func (m *NexthopGroupEntry) XXX_Size() int {
+	return xxx_messageInfo_NexthopGroupEntry.Size(m)
+}
+func (m *NexthopGroupEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NexthopGroupEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NexthopGroupEntry proto.InternalMessageInfo
+
+func (m *NexthopGroupEntry) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NexthopGroupEntry) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type Nexthop struct {
+	Id                   uint32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Family               int32                `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Protocol             int32                `protobuf:"varint,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Flags                uint32               `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	LinkIndex            int32                `protobuf:"varint,5,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	Gw                   []byte               `protobuf:"bytes,6,opt,name=gw,proto3" json:"gw,omitempty"`
+	Blackhole            bool                 `protobuf:"varint,7,opt,name=blackhole,proto3" json:"blackhole,omitempty"`
+	Group                []*NexthopGroupEntry `protobuf:"bytes,8,rep,name=group,proto3" json:"group,omitempty"`
+	NId                  uint32               `protobuf:"varint,9,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Nexthop) Reset()         { *m = Nexthop{} }
+func (m *Nexthop) String() string { return proto.CompactTextString(m) }
+func (*Nexthop) ProtoMessage()    {}
+func (*Nexthop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{65}
+}
+
+func (m *Nexthop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Nexthop.Unmarshal(m, b)
+}
+func (m *Nexthop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Nexthop.Marshal(b, m, deterministic)
+}
+func (m *Nexthop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nexthop.Merge(m, src)
+}
+func (m *Nexthop) XXX_Size() int {
+	return xxx_messageInfo_Nexthop.Size(m)
+}
+func (m *Nexthop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nexthop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nexthop proto.InternalMessageInfo
+
+func (m *Nexthop) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Nexthop) GetFamily() int32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+func (m *Nexthop) GetProtocol() int32 {
+	if m != nil {
+		return m.Protocol
+	}
+	return 0
+}
+
+func (m *Nexthop) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *Nexthop) GetLinkIndex() int32 {
+	if m != nil {
+		return m.LinkIndex
+	}
+	return 0
+}
+
+func (m *Nexthop) GetGw() []byte {
+	if m != nil {
+		return m.Gw
+	}
+	return nil
+}
+
+func (m *Nexthop) GetBlackhole() bool {
+	if m != nil {
+		return m.Blackhole
+	}
+	return false
+}
+
+func (m *Nexthop) GetGroup() []*NexthopGroupEntry {
+	if m != nil {
+		return m.Group
 	}
+	return nil
+}
+
+func (m *Nexthop) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+type Rule struct {
+	Priority             int32    `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Family               int32    `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Table                int32    `protobuf:"varint,3,opt,name=table,proto3" json:"table,omitempty"`
+	Mark                 int32    `protobuf:"varint,4,opt,name=mark,proto3" json:"mark,omitempty"`
+	Mask                 int32    `protobuf:"varint,5,opt,name=mask,proto3" json:"mask,omitempty"`
+	Goto                 int32    `protobuf:"varint,6,opt,name=goto,proto3" json:"goto,omitempty"`
+	Src                  []byte   `protobuf:"bytes,7,opt,name=src,proto3" json:"src,omitempty"`
+	SrcMask              []byte   `protobuf:"bytes,8,opt,name=src_mask,json=srcMask,proto3" json:"src_mask,omitempty"`
+	Dst                  []byte   `protobuf:"bytes,9,opt,name=dst,proto3" json:"dst,omitempty"`
+	DstMask              []byte   `protobuf:"bytes,10,opt,name=dst_mask,json=dstMask,proto3" json:"dst_mask,omitempty"`
+	IifName              string   `protobuf:"bytes,11,opt,name=iif_name,json=iifName,proto3" json:"iif_name,omitempty"`
+	OifName              string   `protobuf:"bytes,12,opt,name=oif_name,json=oifName,proto3" json:"oif_name,omitempty"`
+	Invert               bool     `protobuf:"varint,13,opt,name=invert,proto3" json:"invert,omitempty"`
+	Action               uint32   `protobuf:"varint,14,opt,name=action,proto3" json:"action,omitempty"`
+	NId                  uint32   `protobuf:"varint,15,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rule) Reset()         { *m = Rule{} }
+func (m *Rule) String() string { return proto.CompactTextString(m) }
+func (*Rule) ProtoMessage()    {}
+func (*Rule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{66}
+}
+
+func (m *Rule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rule.Unmarshal(m, b)
+}
+func (m *Rule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rule.Marshal(b, m, deterministic)
+}
+func (m *Rule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rule.Merge(m, src)
+}
+func (m *Rule) XXX_Size() int {
+	return xxx_messageInfo_Rule.Size(m)
+}
+func (m *Rule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rule proto.InternalMessageInfo
+
+func (m *Rule) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Rule) GetFamily() int32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+func (m *Rule) GetTable() int32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *Rule) GetMark() int32 {
+	if m != nil {
+		return m.Mark
+	}
+	return 0
+}
+
+func (m *Rule) GetMask() int32 {
+	if m != nil {
+		return m.Mask
+	}
+	return 0
+}
+
+func (m *Rule) GetGoto() int32 {
+	if m != nil {
+		return m.Goto
+	}
+	return 0
+}
+
+func (m *Rule) GetSrc() []byte {
+	if m != nil {
+		return m.Src
+	}
+	return nil
+}
+
+func (m *Rule) GetSrcMask() []byte {
+	if m != nil {
+		return m.SrcMask
+	}
+	return nil
+}
+
+func (m *Rule) GetDst() []byte {
+	if m != nil {
+		return m.Dst
+	}
+	return nil
+}
+
+func (m *Rule) GetDstMask() []byte {
+	if m != nil {
+		return m.DstMask
+	}
+	return nil
+}
+
+func (m *Rule) GetIifName() string {
+	if m != nil {
+		return m.IifName
+	}
+	return ""
+}
+
+func (m *Rule) GetOifName() string {
+	if m != nil {
+		return m.OifName
+	}
+	return ""
+}
+
+func (m *Rule) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+func (m *Rule) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *Rule) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+type MrouteOif struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Ttl                  uint32   `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MrouteOif) Reset()         { *m = MrouteOif{} }
+func (m *MrouteOif) String() string { return proto.CompactTextString(m) }
+func (*MrouteOif) ProtoMessage()    {}
+func (*MrouteOif) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{67}
+}
+
+func (m *MrouteOif) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MrouteOif.Unmarshal(m, b)
+}
+func (m *MrouteOif) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MrouteOif.Marshal(b, m, deterministic)
+}
+func (m *MrouteOif) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MrouteOif.Merge(m, src)
+}
+func (m *MrouteOif) XXX_Size() int {
+	return xxx_messageInfo_MrouteOif.Size(m)
+}
+func (m *MrouteOif) XXX_DiscardUnknown() {
+	xxx_messageInfo_MrouteOif.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MrouteOif proto.InternalMessageInfo
+
+func (m *MrouteOif) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MrouteOif) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type Mroute struct {
+	Family               int32        `protobuf:"varint,1,opt,name=family,proto3" json:"family,omitempty"`
+	Table                int32        `protobuf:"varint,2,opt,name=table,proto3" json:"table,omitempty"`
+	Src                  []byte       `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Group                []byte       `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Iif                  int32        `protobuf:"varint,5,opt,name=iif,proto3" json:"iif,omitempty"`
+	Oifs                 []*MrouteOif `protobuf:"bytes,6,rep,name=oifs,proto3" json:"oifs,omitempty"`
+	NId                  uint32       `protobuf:"varint,7,opt,name=n_id,json=nId,proto3" json:"n_id,omitempty"`
+	MrId                 uint32       `protobuf:"varint,8,opt,name=mr_id,json=mrId,proto3" json:"mr_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Mroute) Reset()         { *m = Mroute{} }
+func (m *Mroute) String() string { return proto.CompactTextString(m) }
+func (*Mroute) ProtoMessage()    {}
+func (*Mroute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{68}
+}
+
+func (m *Mroute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mroute.Unmarshal(m, b)
+}
+func (m *Mroute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mroute.Marshal(b, m, deterministic)
+}
+func (m *Mroute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mroute.Merge(m, src)
+}
+func (m *Mroute) XXX_Size() int {
+	return xxx_messageInfo_Mroute.Size(m)
+}
+func (m *Mroute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mroute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mroute proto.InternalMessageInfo
+
+func (m *Mroute) GetFamily() int32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+func (m *Mroute) GetTable() int32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *Mroute) GetSrc() []byte {
+	if m != nil {
+		return m.Src
+	}
+	return nil
+}
+
+func (m *Mroute) GetGroup() []byte {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *Mroute) GetIif() int32 {
+	if m != nil {
+		return m.Iif
+	}
+	return 0
+}
+
+func (m *Mroute) GetOifs() []*MrouteOif {
+	if m != nil {
+		return m.Oifs
+	}
+	return nil
+}
+
+func (m *Mroute) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+func (m *Mroute) GetMrId() uint32 {
+	if m != nil {
+		return m.MrId
+	}
+	return 0
 }
 
 type EncapInfoKey struct {
@@ -4280,7 +5278,7 @@ func (m *EncapInfoKey) Reset()         { *m = EncapInfoKey{} }
 func (m *EncapInfoKey) String() string { return proto.CompactTextString(m) }
 func (*EncapInfoKey) ProtoMessage()    {}
 func (*EncapInfoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{56}
+	return fileDescriptor_0d5eb4a10391811b, []int{69}
 }
 
 func (m *EncapInfoKey) XXX_Unmarshal(b []byte) error {
@@ -4329,7 +5327,7 @@ func (m *EncapInfo) Reset()         { *m = EncapInfo{} }
 func (m *EncapInfo) String() string { return proto.CompactTextString(m) }
 func (*EncapInfo) ProtoMessage()    {}
 func (*EncapInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{57}
+	return fileDescriptor_0d5eb4a10391811b, []int{70}
 }
 
 func (m *EncapInfo) XXX_Unmarshal(b []byte) error {
@@ -4400,6 +5398,7 @@ type Route struct {
 	RtId                 uint32         `protobuf:"varint,19,opt,name=rt_id,json=rtId,proto3" json:"rt_id,omitempty"`
 	VpnGw                []byte         `protobuf:"bytes,20,opt,name=vpn_gw,json=vpnGw,proto3" json:"vpn_gw,omitempty"`
 	EnIds                []uint32       `protobuf:"varint,21,rep,packed,name=en_ids,json=enIds,proto3" json:"en_ids,omitempty"`
+	NhId                 uint32         `protobuf:"varint,22,opt,name=nh_id,json=nhId,proto3" json:"nh_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4409,7 +5408,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d5eb4a10391811b, []int{58}
+	return fileDescriptor_0d5eb4a10391811b, []int{71}
 }
 
 func (m *Route) XXX_Unmarshal(b []byte) error {
@@ -4472,107 +5471,252 @@ func (m *Route) GetSrc() []byte {
 	return nil
 }
 
-func (m *Route) GetGw() []byte {
+func (m *Route) GetGw() []byte {
+	if m != nil {
+		return m.Gw
+	}
+	return nil
+}
+
+func (m *Route) GetMultiPath() []*NexthopInfo {
+	if m != nil {
+		return m.MultiPath
+	}
+	return nil
+}
+
+func (m *Route) GetProtocol() int32 {
+	if m != nil {
+		return m.Protocol
+	}
+	return 0
+}
+
+func (m *Route) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Route) GetTable() int32 {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *Route) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Route) GetTos() int32 {
+	if m != nil {
+		return m.Tos
+	}
+	return 0
+}
+
+func (m *Route) GetFlags() int32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *Route) GetMplsDst() int32 {
+	if m != nil {
+		return m.MplsDst
+	}
+	return 0
+}
+
+func (m *Route) GetNewDst() *Destination {
+	if m != nil {
+		return m.NewDst
+	}
+	return nil
+}
+
+func (m *Route) GetEncap() *Encap {
+	if m != nil {
+		return m.Encap
+	}
+	return nil
+}
+
+func (m *Route) GetNId() uint32 {
+	if m != nil {
+		return m.NId
+	}
+	return 0
+}
+
+func (m *Route) GetRtId() uint32 {
+	if m != nil {
+		return m.RtId
+	}
+	return 0
+}
+
+func (m *Route) GetVpnGw() []byte {
+	if m != nil {
+		return m.VpnGw
+	}
+	return nil
+}
+
+func (m *Route) GetEnIds() []uint32 {
 	if m != nil {
-		return m.Gw
+		return m.EnIds
 	}
 	return nil
 }
 
-func (m *Route) GetMultiPath() []*NexthopInfo {
+func (m *Route) GetNhId() uint32 {
 	if m != nil {
-		return m.MultiPath
+		return m.NhId
 	}
-	return nil
+	return 0
 }
 
-func (m *Route) GetProtocol() int32 {
+//
+// Snapshot of nlad master tables (saved to file).
+//
+type Snapshot struct {
+	Timestamp            int64             `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Links                []*Link           `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Iptuns               []*Iptun          `protobuf:"bytes,3,rep,name=iptuns,proto3" json:"iptuns,omitempty"`
+	Addrs                []*Addr           `protobuf:"bytes,4,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	Neighs               []*Neigh          `protobuf:"bytes,5,rep,name=neighs,proto3" json:"neighs,omitempty"`
+	Routes               []*Route          `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+	Mplss                []*Route          `protobuf:"bytes,7,rep,name=mplss,proto3" json:"mplss,omitempty"`
+	Vpns                 []*Vpn            `protobuf:"bytes,8,rep,name=vpns,proto3" json:"vpns,omitempty"`
+	EncapInfos           []*EncapInfo      `protobuf:"bytes,9,rep,name=encap_infos,json=encapInfos,proto3" json:"encap_infos,omitempty"`
+	BrVlans              []*BridgeVlanInfo `protobuf:"bytes,10,rep,name=br_vlans,json=brVlans,proto3" json:"br_vlans,omitempty"`
+	Nexthops             []*Nexthop        `protobuf:"bytes,11,rep,name=nexthops,proto3" json:"nexthops,omitempty"`
+	Rules                []*Rule           `protobuf:"bytes,12,rep,name=rules,proto3" json:"rules,omitempty"`
+	Mroutes              []*Mroute         `protobuf:"bytes,13,rep,name=mroutes,proto3" json:"mroutes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5eb4a10391811b, []int{72}
+}
+
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Snapshot.Unmarshal(m, b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return xxx_messageInfo_Snapshot.Size(m)
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetTimestamp() int64 {
 	if m != nil {
-		return m.Protocol
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *Route) GetPriority() int32 {
+func (m *Snapshot) GetLinks() []*Link {
 	if m != nil {
-		return m.Priority
+		return m.Links
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetTable() int32 {
+func (m *Snapshot) GetIptuns() []*Iptun {
 	if m != nil {
-		return m.Table
+		return m.Iptuns
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetType() int32 {
+func (m *Snapshot) GetAddrs() []*Addr {
 	if m != nil {
-		return m.Type
+		return m.Addrs
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetTos() int32 {
+func (m *Snapshot) GetNeighs() []*Neigh {
 	if m != nil {
-		return m.Tos
+		return m.Neighs
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetFlags() int32 {
+func (m *Snapshot) GetRoutes() []*Route {
 	if m != nil {
-		return m.Flags
+		return m.Routes
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetMplsDst() int32 {
+func (m *Snapshot) GetMplss() []*Route {
 	if m != nil {
-		return m.MplsDst
+		return m.Mplss
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetNewDst() *Destination {
+func (m *Snapshot) GetVpns() []*Vpn {
 	if m != nil {
-		return m.NewDst
+		return m.Vpns
 	}
 	return nil
 }
 
-func (m *Route) GetEncap() *Encap {
+func (m *Snapshot) GetEncapInfos() []*EncapInfo {
 	if m != nil {
-		return m.Encap
+		return m.EncapInfos
 	}
 	return nil
 }
 
-func (m *Route) GetNId() uint32 {
+func (m *Snapshot) GetBrVlans() []*BridgeVlanInfo {
 	if m != nil {
-		return m.NId
+		return m.BrVlans
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetRtId() uint32 {
+func (m *Snapshot) GetNexthops() []*Nexthop {
 	if m != nil {
-		return m.RtId
+		return m.Nexthops
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetVpnGw() []byte {
+func (m *Snapshot) GetRules() []*Rule {
 	if m != nil {
-		return m.VpnGw
+		return m.Rules
 	}
 	return nil
 }
 
-func (m *Route) GetEnIds() []uint32 {
+func (m *Snapshot) GetMroutes() []*Mroute {
 	if m != nil {
-		return m.EnIds
+		return m.Mroutes
 	}
 	return nil
 }
@@ -4607,6 +5751,9 @@ func init() {
 	proto.RegisterType((*GetRoutesRequest)(nil), "nlaapi.GetRoutesRequest")
 	proto.RegisterType((*GetMplssRequest)(nil), "nlaapi.GetMplssRequest")
 	proto.RegisterType((*GetBridgeVlanInfosRequest)(nil), "nlaapi.GetBridgeVlanInfosRequest")
+	proto.RegisterType((*GetNexthopsRequest)(nil), "nlaapi.GetNexthopsRequest")
+	proto.RegisterType((*GetRulesRequest)(nil), "nlaapi.GetRulesRequest")
+	proto.RegisterType((*GetMroutesRequest)(nil), "nlaapi.GetMroutesRequest")
 	proto.RegisterType((*GetNodesRequest)(nil), "nlaapi.GetNodesRequest")
 	proto.RegisterType((*GetVpnsRequest)(nil), "nlaapi.GetVpnsRequest")
 	proto.RegisterType((*GetEncapInfosRequest)(nil), "nlaapi.GetEncapInfosRequest")
@@ -4621,6 +5768,9 @@ func init() {
 	proto.RegisterType((*VpnKey)(nil), "nlaapi.VpnKey")
 	proto.RegisterType((*IptunKey)(nil), "nlaapi.IptunKey")
 	proto.RegisterType((*BridgeVlanInfoKey)(nil), "nlaapi.BridgeVlanInfoKey")
+	proto.RegisterType((*NexthopKey)(nil), "nlaapi.NexthopKey")
+	proto.RegisterType((*RuleKey)(nil), "nlaapi.RuleKey")
+	proto.RegisterType((*MrouteKey)(nil), "nlaapi.MrouteKey")
 	proto.RegisterType((*Stat)(nil), "nlaapi.Stat")
 	proto.RegisterType((*Node)(nil), "nlaapi.Node")
 	proto.RegisterType((*Vpn)(nil), "nlaapi.Vpn")
@@ -4647,293 +5797,355 @@ func init() {
 	proto.RegisterType((*MPLSDestination)(nil), "nlaapi.MPLSDestination")
 	proto.RegisterType((*Destination)(nil), "nlaapi.Destination")
 	proto.RegisterType((*MPLSEncap)(nil), "nlaapi.MPLSEncap")
+	proto.RegisterType((*SEG6Encap)(nil), "nlaapi.SEG6Encap")
+	proto.RegisterType((*SEG6LocalEncap)(nil), "nlaapi.SEG6LocalEncap")
 	proto.RegisterType((*Encap)(nil), "nlaapi.Encap")
+	proto.RegisterType((*NexthopGroupEntry)(nil), "nlaapi.NexthopGroupEntry")
+	proto.RegisterType((*Nexthop)(nil), "nlaapi.Nexthop")
+	proto.RegisterType((*Rule)(nil), "nlaapi.Rule")
+	proto.RegisterType((*MrouteOif)(nil), "nlaapi.MrouteOif")
+	proto.RegisterType((*Mroute)(nil), "nlaapi.Mroute")
 	proto.RegisterType((*EncapInfoKey)(nil), "nlaapi.EncapInfoKey")
 	proto.RegisterType((*EncapInfo)(nil), "nlaapi.EncapInfo")
 	proto.RegisterType((*Route)(nil), "nlaapi.Route")
+	proto.RegisterType((*Snapshot)(nil), "nlaapi.Snapshot")
 }
 
 func init() { proto.RegisterFile("nlaapi.proto", fileDescriptor_0d5eb4a10391811b) }
 
 var fileDescriptor_0d5eb4a10391811b = []byte{
-	// 4428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7a, 0x4f, 0x73, 0xe3, 0xc6,
-	0x72, 0x38, 0xff, 0x13, 0x6c, 0x92, 0x12, 0x34, 0x5a, 0xed, 0x52, 0xda, 0xf5, 0xf3, 0x1a, 0xb6,
-	0xcb, 0x7e, 0xf2, 0xb3, 0x6b, 0x2d, 0xdb, 0xaf, 0xde, 0xcf, 0xbf, 0x4a, 0x52, 0x90, 0xa8, 0x95,
-	0x58, 0x4b, 0x51, 0x0a, 0xc8, 0x95, 0xed, 0x57, 0xaf, 0x82, 0x82, 0x88, 0x11, 0x89, 0x2c, 0x08,
-	0xc0, 0x00, 0x28, 0xad, 0xaa, 0x92, 0xaa, 0x1c, 0xf2, 0xaa, 0x72, 0xcc, 0x29, 0x87, 0x57, 0x95,
-	0x43, 0x6e, 0x39, 0x24, 0x95, 0x6b, 0xf2, 0x21, 0x72, 0xca, 0x29, 0x9f, 0x20, 0x95, 0x4f, 0x90,
-	0x53, 0x2a, 0xa9, 0xee, 0x19, 0x80, 0x00, 0x49, 0xed, 0x3e, 0x67, 0x75, 0x11, 0xa6, 0xbb, 0x67,
-	0xa6, 0xa7, 0xff, 0x4d, 0x4f, 0xb3, 0xa1, 0xe5, 0xb9, 0x96, 0x15, 0x38, 0x5f, 0x04, 0xa1, 0x1f,
-	0xfb, 0xac, 0x26, 0x46, 0xda, 0x9f, 0x82, 0x32, 0x70, 0xcf, 0xa2, 0xc9, 0xd4, 0x0e, 0x99, 0x0a,
-	0x65, 0x97, 0x7b, 0x9d, 0xe2, 0xd3, 0xe2, 0xa7, 0x6d, 0x03, 0x3f, 0x19, 0x83, 0x4a, 0x7c, 0x17,
-	0xf0, 0x4e, 0x89, 0x40, 0xf4, 0xcd, 0x1e, 0x40, 0xf5, 0xda, 0xb5, 0x26, 0x51, 0xa7, 0x4c, 0x40,
-	0x31, 0xc0, 0xb9, 0x11, 0xff, 0xb1, 0x53, 0x11, 0x73, 0x23, 0xfe, 0x23, 0x42, 0x02, 0xc7, 0xee,
-	0x54, 0x05, 0x24, 0x70, 0x6c, 0xed, 0xb7, 0x45, 0xd8, 0x18, 0xf0, 0xd8, 0x75, 0xbc, 0x57, 0x67,
-	0x3c, 0x8a, 0xac, 0x09, 0x67, 0x9f, 0x42, 0x6d, 0xca, 0x2d, 0x9b, 0x87, 0xb4, 0x6b, 0xf3, 0x40,
-	0xfd, 0x42, 0x72, 0x99, 0x30, 0x65, 0x48, 0x3c, 0xb2, 0x62, 0x5b, 0xb1, 0x45, 0xac, 0xb4, 0x0c,
-	0xfa, 0x66, 0x5b, 0x50, 0xf1, 0x4c, 0xc7, 0x96, 0x9c, 0x94, 0xbd, 0x9e, 0xcd, 0x34, 0x28, 0x47,
-	0xe1, 0x98, 0xf8, 0xd8, 0x58, 0x5a, 0x6d, 0x18, 0x8e, 0x0d, 0x44, 0x6a, 0x3b, 0xb0, 0x9d, 0x67,
-	0xc3, 0xe0, 0x81, 0x7b, 0xa7, 0x6d, 0xc3, 0xd6, 0x99, 0xef, 0x49, 0x8c, 0xc1, 0x7f, 0x9c, 0xf3,
-	0x28, 0xd6, 0xfe, 0xbe, 0x24, 0x05, 0xf4, 0xd2, 0x73, 0x98, 0x06, 0x15, 0xc4, 0x49, 0x5e, 0x5b,
-	0xc9, 0xea, 0x7d, 0xc7, 0x7b, 0x75, 0x5a, 0x30, 0x08, 0x87, 0x34, 0x96, 0x6d, 0x87, 0xc4, 0x67,
-	0x86, 0x46, 0xb7, 0xed, 0x10, 0x69, 0x10, 0xc7, 0x3e, 0x86, 0xaa, 0xc7, 0x9d, 0xc9, 0x94, 0x18,
-	0x6f, 0x1e, 0xb4, 0x53, 0x36, 0x11, 0x78, 0x5a, 0x30, 0x04, 0x16, 0xc9, 0x42, 0x7f, 0x1e, 0x73,
-	0x3a, 0x4d, 0x86, 0xcc, 0x40, 0x20, 0x92, 0x11, 0x16, 0x77, 0xf4, 0x7c, 0x9b, 0x93, 0xa4, 0x33,
-	0x3b, 0x0e, 0x7c, 0x1b, 0x89, 0x08, 0xc7, 0xde, 0x87, 0xf2, 0x4d, 0xe0, 0x75, 0x6a, 0x44, 0xd2,
-	0x4c, 0x48, 0x2e, 0x03, 0xef, 0xb4, 0x60, 0x20, 0x86, 0x7d, 0x0b, 0xad, 0xab, 0xd0, 0xbc, 0x71,
-	0x2d, 0xcf, 0x74, 0xbc, 0x6b, 0xbf, 0x53, 0x27, 0xca, 0x87, 0x09, 0xe5, 0x61, 0xe8, 0xd8, 0x13,
-	0x7e, 0xe9, 0x5a, 0x5e, 0xcf, 0xbb, 0xf6, 0x4f, 0x0b, 0x06, 0x5c, 0x85, 0xc9, 0xe8, 0xb0, 0x0a,
-	0xe5, 0x59, 0x34, 0xd1, 0x7e, 0x57, 0x5c, 0x96, 0xeb, 0x4b, 0xcf, 0xf1, 0xbd, 0x9f, 0xa0, 0x63,
-	0x8d, 0x16, 0x92, 0xa2, 0xcb, 0x93, 0xbd, 0xf4, 0x1c, 0x03, 0x91, 0xff, 0x57, 0x9d, 0x1f, 0x42,
-	0xfb, 0xcc, 0xb7, 0x2f, 0x03, 0x4f, 0x2a, 0x36, 0x35, 0xed, 0x62, 0xc6, 0xb4, 0xdf, 0x13, 0x52,
-	0x2a, 0xad, 0x48, 0x89, 0x64, 0xa4, 0xb5, 0xa1, 0x99, 0xac, 0x81, 0xf6, 0xb2, 0x05, 0x9b, 0x67,
-	0xbe, 0x9d, 0xda, 0x0b, 0x82, 0x3e, 0x82, 0xcd, 0x13, 0x1e, 0xa3, 0x3d, 0x44, 0xc9, 0x3e, 0x09,
-	0xbf, 0xc5, 0x94, 0x5f, 0x49, 0x85, 0x16, 0xf1, 0x26, 0xaa, 0x8f, 0x41, 0x3d, 0xe1, 0x31, 0x99,
-	0xc4, 0xdb, 0xc9, 0xc8, 0x24, 0xde, 0xbe, 0xe7, 0x59, 0xe0, 0x46, 0x6f, 0xa2, 0xfa, 0x02, 0x76,
-	0x4f, 0x78, 0x9c, 0x57, 0xf6, 0x9b, 0xe8, 0xb7, 0x68, 0x55, 0xb4, 0xb4, 0x84, 0x4a, 0x53, 0x61,
-	0xe3, 0x84, 0xc7, 0x97, 0x81, 0x97, 0x42, 0x1e, 0xc2, 0x83, 0x13, 0x1e, 0x1f, 0x7b, 0x63, 0x2b,
-	0xc8, 0xae, 0xa7, 0x31, 0xe2, 0xbc, 0x17, 0xc4, 0xf3, 0x05, 0xad, 0x58, 0x70, 0x18, 0x5b, 0x71,
-	0x0a, 0x3a, 0x80, 0x3a, 0x0a, 0xf4, 0x05, 0xbf, 0x5b, 0xc3, 0x01, 0x46, 0x23, 0xc7, 0xb3, 0xf9,
-	0x6b, 0x52, 0x5a, 0xd5, 0x10, 0x03, 0xed, 0x19, 0xd4, 0x51, 0xbc, 0xf7, 0xcc, 0x61, 0x19, 0x17,
-	0x6d, 0x08, 0x97, 0xd4, 0x6c, 0x50, 0x48, 0xd4, 0xbf, 0xff, 0x14, 0xd6, 0x81, 0xba, 0x73, 0x2d,
-	0x36, 0x2f, 0xd3, 0xe6, 0xc9, 0x90, 0x3d, 0x82, 0xba, 0xf0, 0x24, 0x9b, 0x8c, 0xb2, 0x6a, 0xd4,
-	0x70, 0xd8, 0xb3, 0xb5, 0x2f, 0x41, 0x21, 0x4d, 0xfd, 0x04, 0xc6, 0xbe, 0x81, 0x3a, 0x6a, 0xed,
-	0x9e, 0x19, 0x8f, 0xa0, 0xee, 0x9a, 0xae, 0x75, 0xc5, 0x5d, 0x19, 0xa3, 0x6b, 0x6e, 0x1f, 0x47,
-	0xda, 0x13, 0xa8, 0xa3, 0x5a, 0xd6, 0x4f, 0xd3, 0xfe, 0x00, 0x6a, 0x97, 0x81, 0x77, 0xcf, 0x9a,
-	0x2a, 0x94, 0xed, 0x28, 0x96, 0x4c, 0xe0, 0x27, 0xdb, 0x80, 0xd2, 0xe4, 0x96, 0x0e, 0xd9, 0x30,
-	0x4a, 0x93, 0x5b, 0xed, 0x1b, 0x50, 0x48, 0x6d, 0xf7, 0x2c, 0xf0, 0x10, 0x6a, 0x21, 0x9f, 0xf9,
-	0x31, 0x97, 0xc1, 0x5a, 0x8e, 0xb4, 0x01, 0x6c, 0xe5, 0x4d, 0xeb, 0xa7, 0xe8, 0x14, 0xd9, 0xba,
-	0x59, 0xf8, 0xfd, 0x8d, 0x63, 0x6b, 0xfb, 0x50, 0x41, 0x4b, 0x41, 0xcc, 0x2b, 0x7e, 0x47, 0x2b,
-	0x34, 0x0c, 0xfc, 0x24, 0x5a, 0x4b, 0x88, 0xa4, 0x62, 0xe0, 0xa7, 0xf6, 0x73, 0xa8, 0xa0, 0x3c,
-	0xf0, 0x28, 0x4e, 0x40, 0xa4, 0x2d, 0xa3, 0xe4, 0x04, 0xe9, 0xf6, 0xa5, 0x85, 0x70, 0xfe, 0xba,
-	0x08, 0xe5, 0xcb, 0xc0, 0x5b, 0x21, 0x65, 0x50, 0x99, 0x59, 0xd1, 0xab, 0xe4, 0x06, 0xc2, 0xef,
-	0x8c, 0x64, 0x5a, 0x28, 0x19, 0x64, 0x5d, 0x68, 0x43, 0x5c, 0x84, 0x62, 0xc0, 0x76, 0xa0, 0x76,
-	0x13, 0x78, 0xe6, 0xe4, 0x96, 0x62, 0x74, 0xcb, 0xa8, 0xde, 0x04, 0xde, 0xc9, 0x6d, 0xba, 0x77,
-	0x6d, 0x71, 0x74, 0x49, 0xe9, 0xd8, 0x14, 0x80, 0xdb, 0x44, 0xd9, 0xb3, 0xb5, 0x00, 0xaa, 0x24,
-	0x70, 0xf6, 0xf4, 0xfe, 0x1b, 0x48, 0xde, 0x3f, 0x8f, 0xa1, 0xe1, 0xfa, 0x63, 0xcb, 0x35, 0x67,
-	0xd6, 0x58, 0xb2, 0xaa, 0x10, 0xe0, 0xcc, 0x1a, 0xaf, 0x0b, 0x9e, 0x3b, 0x50, 0x8b, 0x3d, 0x37,
-	0x31, 0xd5, 0xb6, 0x51, 0x8d, 0x3d, 0xb7, 0x67, 0x6b, 0x7f, 0x51, 0x86, 0x8d, 0xbc, 0xb2, 0xd8,
-	0x41, 0x72, 0xf1, 0x17, 0x29, 0xd0, 0x3e, 0x59, 0x7f, 0x37, 0x7c, 0xf1, 0x1c, 0x69, 0x32, 0x69,
-	0xc1, 0xcd, 0x42, 0xba, 0x37, 0x4e, 0x46, 0xb9, 0xe5, 0xac, 0x72, 0x19, 0x54, 0x3c, 0x6b, 0x26,
-	0x6e, 0xba, 0x86, 0x41, 0xdf, 0xec, 0x03, 0x68, 0xcd, 0xac, 0x28, 0xe6, 0xa1, 0x29, 0x26, 0x54,
-	0x69, 0x42, 0x53, 0xc0, 0x7a, 0x89, 0x4d, 0xcc, 0xe2, 0x79, 0x22, 0xc0, 0x59, 0x3c, 0x4f, 0x4f,
-	0x58, 0x5f, 0x9c, 0x70, 0x1b, 0xaa, 0x57, 0x21, 0xc2, 0x14, 0x11, 0xea, 0xaf, 0xc2, 0x9e, 0xad,
-	0x8d, 0xa0, 0x4a, 0x8c, 0xb2, 0x3a, 0x94, 0x07, 0xe7, 0x17, 0x6a, 0x81, 0x01, 0xd4, 0xce, 0xf4,
-	0xe1, 0xe8, 0xd8, 0x50, 0x8b, 0x4c, 0x81, 0xca, 0xc5, 0x65, 0xaf, 0xab, 0x96, 0x58, 0x0b, 0x94,
-	0x97, 0x83, 0x91, 0x7e, 0x72, 0x72, 0xdc, 0x55, 0x2b, 0x6c, 0x13, 0x9a, 0x86, 0x3e, 0x38, 0x39,
-	0x36, 0x0f, 0x8f, 0x4f, 0x7a, 0x03, 0x55, 0x61, 0x6d, 0x68, 0x08, 0xc0, 0xf1, 0xa0, 0xab, 0xaa,
-	0xda, 0x0b, 0x50, 0x2e, 0xfc, 0x30, 0x1e, 0xe1, 0x65, 0xd2, 0x86, 0xc6, 0xe0, 0x7c, 0x70, 0x6c,
-	0x5e, 0x9c, 0x1b, 0x23, 0xb5, 0x80, 0x53, 0xf5, 0xa3, 0xa3, 0xe3, 0xe1, 0x50, 0x00, 0x8a, 0x6c,
-	0x03, 0x60, 0x64, 0xbc, 0x1c, 0xbc, 0x10, 0xe3, 0x12, 0x12, 0x88, 0xfd, 0x05, 0xa0, 0xac, 0xfd,
-	0x57, 0x09, 0xda, 0x87, 0xbe, 0x67, 0x0f, 0x5d, 0xeb, 0x86, 0x93, 0x06, 0x3e, 0x81, 0x6a, 0x14,
-	0x5b, 0x31, 0x97, 0x1a, 0xd8, 0x4a, 0x35, 0x80, 0x54, 0x88, 0x30, 0x04, 0x9e, 0x7d, 0x0d, 0x30,
-	0x73, 0x1c, 0x13, 0x07, 0xf3, 0x88, 0xa4, 0xbf, 0x71, 0xb0, 0x93, 0xa5, 0x46, 0x83, 0x11, 0x33,
-	0x1a, 0x33, 0xc7, 0x19, 0x12, 0x1d, 0xfb, 0x05, 0x30, 0x34, 0x21, 0xf3, 0xda, 0x72, 0xdc, 0x79,
-	0xc8, 0xcd, 0xb1, 0x3f, 0xf7, 0x62, 0x69, 0x2b, 0x2a, 0x62, 0x9e, 0x0b, 0xc4, 0x11, 0xc2, 0xd9,
-	0x3e, 0x6c, 0x05, 0x3c, 0x9c, 0x59, 0x1e, 0xf7, 0x62, 0x73, 0x7a, 0x6b, 0x52, 0xe4, 0xaa, 0x90,
-	0xc1, 0x6d, 0xa6, 0x88, 0xd3, 0x5b, 0x8c, 0xc3, 0x6c, 0x17, 0x94, 0x1f, 0xe7, 0x7c, 0xce, 0x4d,
-	0x99, 0x10, 0x56, 0x8d, 0x3a, 0x8d, 0x7b, 0x36, 0xfb, 0x10, 0xda, 0xd6, 0x64, 0x12, 0xf2, 0x89,
-	0x15, 0xfb, 0x61, 0xe2, 0x0d, 0x55, 0xa3, 0xb5, 0x00, 0xf6, 0x6c, 0xf6, 0x25, 0xec, 0x58, 0x63,
-	0xc4, 0xfb, 0x01, 0x0f, 0xcd, 0xc0, 0x0f, 0x63, 0x53, 0x08, 0xa2, 0x4e, 0xc4, 0x8c, 0x90, 0xe7,
-	0x01, 0x0f, 0x51, 0xfa, 0x74, 0x2e, 0xf6, 0x2d, 0xec, 0x59, 0xb6, 0x19, 0x58, 0x61, 0xec, 0xf1,
-	0xd5, 0x79, 0x0a, 0xcd, 0x7b, 0x68, 0xd9, 0x17, 0x82, 0x20, 0x37, 0x57, 0xfb, 0xb7, 0x32, 0x34,
-	0x50, 0x42, 0x7a, 0x1c, 0x87, 0xd1, 0xc2, 0x62, 0x8b, 0x4b, 0xe1, 0x08, 0x4d, 0x4f, 0x84, 0x28,
-	0x32, 0xbd, 0x0e, 0x28, 0xf1, 0x6b, 0xf3, 0x47, 0x13, 0x73, 0x68, 0x61, 0xdc, 0xb5, 0xf8, 0xf5,
-	0x1f, 0xf7, 0x45, 0x1a, 0xbd, 0x62, 0xdd, 0x1f, 0x42, 0x7b, 0x6a, 0x85, 0xf6, 0xad, 0x15, 0x72,
-	0x21, 0x3a, 0x11, 0x1a, 0x5a, 0x09, 0x90, 0xe4, 0x96, 0xe6, 0xda, 0xb5, 0x6c, 0xae, 0xfd, 0x18,
-	0x1a, 0xa1, 0x75, 0x6b, 0x0a, 0x8c, 0x30, 0x74, 0x25, 0xb4, 0x6e, 0x85, 0x3d, 0x7f, 0x00, 0xad,
-	0xc0, 0x0a, 0x51, 0x27, 0x82, 0x69, 0x71, 0xd2, 0xa6, 0x80, 0x09, 0xaf, 0x59, 0x76, 0xac, 0xc6,
-	0xaa, 0x63, 0x3d, 0x80, 0xaa, 0xe5, 0x3a, 0x56, 0xd4, 0x01, 0x62, 0x59, 0x0c, 0xf0, 0xc6, 0x0b,
-	0x42, 0x7f, 0xe6, 0x44, 0xe3, 0x4e, 0x53, 0x68, 0x51, 0x0e, 0xd9, 0x7b, 0x00, 0x1c, 0x2f, 0x78,
-	0x93, 0x72, 0xaa, 0x16, 0x4d, 0x6a, 0x10, 0x84, 0x7c, 0xe1, 0x6b, 0x00, 0xd2, 0x80, 0x10, 0x7e,
-	0x3b, 0x6f, 0x8f, 0x28, 0x69, 0x94, 0xbf, 0xb4, 0x47, 0x3f, 0xf9, 0x64, 0x7f, 0x04, 0x9b, 0x57,
-	0xbe, 0x67, 0x9b, 0x11, 0x3a, 0x80, 0x48, 0x4b, 0x37, 0x28, 0xee, 0xe5, 0x4c, 0x39, 0x75, 0x8f,
-	0xd3, 0x82, 0xd1, 0xbe, 0xca, 0x02, 0x0e, 0x5b, 0x00, 0x8b, 0xb9, 0x5a, 0x17, 0xf3, 0x0d, 0x8f,
-	0x87, 0xce, 0x78, 0xa1, 0xdb, 0x67, 0x00, 0x64, 0xf2, 0x16, 0x8e, 0x64, 0x54, 0xdd, 0xca, 0x32,
-	0x46, 0x64, 0x46, 0xc3, 0x4d, 0x3e, 0xb5, 0x23, 0xd8, 0xec, 0xf2, 0x1b, 0x67, 0xcc, 0xdf, 0x65,
-	0x91, 0x7f, 0x2e, 0xc2, 0xa6, 0x08, 0x9b, 0xef, 0xb0, 0x0a, 0xfb, 0x1c, 0xd8, 0x6c, 0xee, 0xc6,
-	0xce, 0xd8, 0x8a, 0x62, 0x33, 0xf2, 0x7c, 0x3f, 0x70, 0x3c, 0x91, 0x3d, 0x2b, 0xc6, 0x56, 0x8a,
-	0x19, 0x4a, 0x04, 0xea, 0x68, 0xca, 0x5d, 0xd7, 0x37, 0x63, 0x67, 0xc6, 0xa5, 0x5b, 0x37, 0x08,
-	0x32, 0x72, 0x66, 0x9c, 0x7d, 0x0c, 0x1b, 0x94, 0xb4, 0x5c, 0x3b, 0x6e, 0xcc, 0x43, 0x5c, 0xa9,
-	0x42, 0x2b, 0xb5, 0x11, 0xfa, 0x3c, 0x01, 0x6a, 0xbf, 0x86, 0x36, 0x86, 0xfa, 0x77, 0xe1, 0x3b,
-	0x93, 0x1e, 0x95, 0x72, 0xe9, 0xd1, 0x6f, 0x2b, 0xb0, 0x71, 0xf9, 0xfa, 0x1d, 0x57, 0xdf, 0x05,
-	0xe5, 0xe6, 0x75, 0x6e, 0xf9, 0x3a, 0x8d, 0x7b, 0x36, 0xfb, 0x08, 0x36, 0x6e, 0x62, 0x1e, 0x98,
-	0x36, 0xbf, 0x31, 0xb3, 0x97, 0x50, 0x0b, 0xa1, 0x5d, 0x7e, 0x23, 0x6c, 0x7f, 0x17, 0x94, 0x28,
-	0x1c, 0x67, 0xe3, 0x59, 0x3d, 0x0a, 0xc7, 0x89, 0x3f, 0x4e, 0x42, 0x7f, 0x1e, 0x24, 0xf7, 0x38,
-	0x0d, 0x30, 0x14, 0xc4, 0xb1, 0x2b, 0x03, 0x17, 0x7e, 0x12, 0xc4, 0x8f, 0x64, 0x74, 0xc2, 0x4f,
-	0xb6, 0x07, 0x8a, 0xcb, 0xad, 0xd0, 0x43, 0xb9, 0x2a, 0x24, 0xd7, 0x74, 0x8c, 0xab, 0x06, 0xa1,
-	0xff, 0xfa, 0x8e, 0x1c, 0x51, 0x31, 0xc4, 0x00, 0xd7, 0x08, 0xa3, 0x31, 0x39, 0xa0, 0x62, 0xe0,
-	0x27, 0xe6, 0x55, 0xee, 0xc1, 0xcc, 0x89, 0x22, 0xf2, 0x3e, 0xc5, 0x90, 0x23, 0x82, 0x7f, 0x45,
-	0xf0, 0x96, 0x84, 0xd3, 0x88, 0xed, 0x41, 0x63, 0x6e, 0x07, 0xe6, 0xd8, 0x8c, 0xe6, 0x33, 0x72,
-	0x3a, 0xc5, 0xa8, 0xcf, 0xed, 0xe0, 0x68, 0x38, 0x9f, 0xe1, 0xb5, 0xef, 0xf9, 0xa6, 0x35, 0xe1,
-	0xe4, 0x52, 0x8a, 0x51, 0xf5, 0x7c, 0x7d, 0xc2, 0x71, 0xd3, 0xc9, 0x55, 0xd0, 0xd9, 0x14, 0x9b,
-	0x4e, 0xae, 0xe8, 0x70, 0x48, 0xa5, 0x8a, 0xa3, 0xe0, 0x9b, 0x1d, 0x73, 0x1c, 0x67, 0xe6, 0xc4,
-	0x9d, 0x2d, 0x11, 0x0f, 0x69, 0x80, 0x31, 0x0e, 0xe3, 0x6b, 0x87, 0x11, 0x90, 0xbe, 0x51, 0x92,
-	0x14, 0x73, 0x5d, 0xff, 0xb6, 0xb3, 0x2d, 0x03, 0x86, 0x1f, 0xc6, 0x7d, 0xff, 0x16, 0x63, 0x18,
-	0xa1, 0xa6, 0xf8, 0x0c, 0x7e, 0x40, 0x38, 0xa2, 0x3d, 0x75, 0x26, 0x53, 0xed, 0x1f, 0x8a, 0xd0,
-	0xba, 0x8c, 0x9d, 0x77, 0xb1, 0x82, 0x6d, 0xa8, 0x3a, 0x26, 0x66, 0x85, 0xb2, 0x74, 0xe1, 0x60,
-	0xae, 0xb9, 0x0d, 0x55, 0x9f, 0x80, 0xc2, 0xf8, 0x2b, 0x94, 0x80, 0x32, 0x99, 0x52, 0x89, 0xf4,
-	0x47, 0x24, 0x51, 0x78, 0x44, 0xcc, 0x99, 0x12, 0x3d, 0xd3, 0x20, 0x93, 0xd7, 0xd6, 0x72, 0x79,
-	0xed, 0x9f, 0x40, 0xfb, 0x92, 0xc7, 0xd3, 0x77, 0x61, 0x17, 0xc5, 0xc1, 0x79, 0x68, 0xd2, 0x35,
-	0x21, 0x32, 0x6f, 0x05, 0x01, 0x03, 0x6b, 0xc6, 0xb5, 0x7f, 0x2c, 0x02, 0x60, 0xa4, 0xd3, 0x6d,
-	0xca, 0x02, 0x56, 0x6e, 0xcc, 0xe2, 0x9a, 0x1b, 0xf3, 0x31, 0x34, 0xbc, 0xf9, 0x8c, 0xae, 0xbc,
-	0x48, 0xba, 0x81, 0xe2, 0xcd, 0x67, 0x78, 0xc7, 0xd1, 0x6e, 0xe2, 0x3a, 0x4d, 0x64, 0x51, 0x35,
-	0x14, 0x02, 0xa0, 0x3c, 0xde, 0x87, 0x66, 0x72, 0x6b, 0x22, 0x5a, 0x3c, 0x60, 0x40, 0x82, 0x96,
-	0x08, 0x30, 0xc7, 0x14, 0x22, 0x4a, 0x08, 0xce, 0xac, 0xb1, 0xf6, 0x3b, 0x45, 0x24, 0x2e, 0xef,
-	0x22, 0x90, 0x8f, 0xa0, 0x32, 0xf3, 0x6d, 0x2e, 0x73, 0x17, 0x35, 0x1b, 0xf0, 0xcf, 0x7c, 0x9b,
-	0x1b, 0x84, 0xc5, 0x9b, 0xcc, 0x1a, 0xc7, 0xce, 0x0d, 0x17, 0x77, 0x84, 0x3c, 0x4b, 0x53, 0xc0,
-	0xe8, 0x1e, 0x40, 0xa5, 0xcd, 0x1c, 0x67, 0xe6, 0x7b, 0xc9, 0x53, 0x4c, 0x8c, 0xd0, 0x36, 0xe7,
-	0x18, 0x09, 0x5c, 0xeb, 0x2e, 0x49, 0x49, 0xe6, 0x41, 0x17, 0x87, 0x18, 0x28, 0x6d, 0xff, 0xd6,
-	0x93, 0x48, 0xe1, 0xd6, 0x0d, 0x84, 0x08, 0xf4, 0xfb, 0xd0, 0x9c, 0x47, 0xdc, 0x1c, 0x5b, 0x61,
-	0xe8, 0xf0, 0x50, 0x3a, 0x39, 0xcc, 0x23, 0x7e, 0x24, 0x20, 0xc4, 0x55, 0x18, 0x98, 0x8e, 0x17,
-	0xf3, 0x10, 0x9f, 0x21, 0xf2, 0x0a, 0xb6, 0xc2, 0xa0, 0x27, 0x41, 0x18, 0x89, 0x88, 0x24, 0x30,
-	0x63, 0x2b, 0x9c, 0xf0, 0x38, 0xea, 0x34, 0x9e, 0x96, 0xf1, 0xfa, 0x47, 0xa2, 0x60, 0x24, 0x60,
-	0xec, 0x5b, 0xb1, 0xd0, 0x8d, 0xe5, 0x3a, 0x36, 0x5e, 0x9c, 0x40, 0xc2, 0x78, 0x94, 0x15, 0x86,
-	0x1e, 0x06, 0x97, 0x12, 0x4d, 0x3b, 0x24, 0x03, 0xa6, 0xc3, 0x26, 0xce, 0xb5, 0x5c, 0x37, 0xdd,
-	0xa2, 0x49, 0xd3, 0x77, 0x97, 0xa6, 0xeb, 0xae, 0x2b, 0xf7, 0x33, 0xda, 0x56, 0x76, 0x28, 0xae,
-	0x7b, 0x67, 0x66, 0x85, 0x77, 0x14, 0x58, 0xe8, 0xba, 0xa7, 0x21, 0x7b, 0x0e, 0xaa, 0xfc, 0x34,
-	0x43, 0x1e, 0x71, 0x97, 0x8f, 0x63, 0x79, 0xab, 0x3f, 0xce, 0xae, 0x7e, 0x21, 0x68, 0x0c, 0x49,
-	0x62, 0x6c, 0x06, 0x79, 0x00, 0xfb, 0xff, 0xd0, 0xc6, 0x64, 0xd3, 0xf4, 0x6f, 0xa4, 0x31, 0x6d,
-	0xac, 0x9e, 0x10, 0x93, 0xce, 0xf3, 0x1b, 0xb2, 0x2c, 0xa3, 0x79, 0xbd, 0x18, 0xb0, 0x2e, 0xa8,
-	0xaf, 0x67, 0x4e, 0x6c, 0x4e, 0xad, 0x68, 0x6a, 0x06, 0xbe, 0xeb, 0x8c, 0xef, 0x28, 0x70, 0x6d,
-	0x1c, 0xec, 0x65, 0xe7, 0x7f, 0x3f, 0x73, 0xe2, 0x53, 0x2b, 0x9a, 0x5e, 0x10, 0x85, 0xb1, 0xf1,
-	0x3a, 0x37, 0x46, 0x6d, 0xe2, 0x11, 0x3c, 0xdb, 0x74, 0x26, 0xb3, 0x40, 0xc6, 0x39, 0x10, 0xa0,
-	0xde, 0x64, 0x16, 0xa0, 0xaa, 0xc8, 0x93, 0xc8, 0x3d, 0xfd, 0xd8, 0xb9, 0x96, 0x71, 0xaf, 0x85,
-	0xee, 0x84, 0x2e, 0x8a, 0x30, 0xcc, 0x86, 0x51, 0xd4, 0x64, 0x86, 0x91, 0x29, 0x0c, 0x50, 0xc6,
-	0xc2, 0x4d, 0xcb, 0x75, 0xc9, 0x16, 0x23, 0x9d, 0xc0, 0xe8, 0x7e, 0x33, 0xc7, 0x33, 0xd1, 0xd8,
-	0x23, 0x19, 0x17, 0x95, 0x99, 0x43, 0x97, 0x5e, 0x84, 0xfc, 0xb8, 0x19, 0xdb, 0x11, 0xa1, 0x11,
-	0xdc, 0x85, 0xe9, 0x60, 0xde, 0x6d, 0x8d, 0x5f, 0xf1, 0x30, 0x32, 0x29, 0xa5, 0x22, 0xc3, 0xdf,
-	0x11, 0x3b, 0x49, 0xc4, 0x05, 0x0f, 0x85, 0xf1, 0x7f, 0x09, 0x0d, 0xd7, 0x1a, 0x07, 0x66, 0x88,
-	0xd6, 0xf3, 0x90, 0x64, 0xf3, 0x20, 0xf7, 0x0c, 0xb0, 0xc6, 0x81, 0x81, 0xa6, 0xa3, 0xb8, 0xf2,
-	0x0b, 0xa7, 0x58, 0xb6, 0x29, 0x75, 0xfa, 0x68, 0x75, 0x8a, 0x6e, 0x0f, 0x85, 0x32, 0x15, 0x4b,
-	0x7e, 0xb1, 0xcf, 0xa0, 0x6e, 0xd9, 0x22, 0x3f, 0xeb, 0x90, 0x6b, 0xb3, 0xfc, 0x04, 0x8c, 0x5a,
-	0x46, 0xcd, 0xa2, 0xff, 0xda, 0x7f, 0x94, 0x60, 0x83, 0xde, 0xb2, 0xef, 0x12, 0x1d, 0xe4, 0x8d,
-	0x2b, 0x9f, 0x95, 0x99, 0x1b, 0x57, 0x3e, 0x6c, 0xf1, 0xc6, 0x7d, 0x02, 0x10, 0x98, 0xb3, 0x78,
-	0x6e, 0xda, 0x98, 0xaf, 0x8a, 0xe8, 0xae, 0x04, 0x67, 0xf1, 0xbc, 0x8b, 0x09, 0x6b, 0x12, 0xf5,
-	0xab, 0xeb, 0xa2, 0x7e, 0x6d, 0x7d, 0xd4, 0xaf, 0x67, 0xa3, 0x3e, 0x2a, 0x4a, 0xa4, 0xbc, 0x11,
-	0xdd, 0x7b, 0xe2, 0x71, 0x29, 0xb2, 0xe0, 0x21, 0xdd, 0x7e, 0x29, 0x81, 0x4d, 0x04, 0x8d, 0x0c,
-	0x41, 0x97, 0x08, 0xf2, 0x49, 0x33, 0x88, 0x84, 0x6c, 0x91, 0x34, 0xa7, 0xf3, 0x45, 0xa2, 0xdf,
-	0xcc, 0xcc, 0x17, 0xa9, 0xfe, 0x7b, 0x00, 0xd7, 0xae, 0x7f, 0x6b, 0x5e, 0x59, 0x11, 0xb7, 0xe5,
-	0xdd, 0xdf, 0x40, 0xc8, 0x21, 0x02, 0xb4, 0xff, 0x2e, 0x43, 0x05, 0xa5, 0x97, 0x2b, 0x75, 0x36,
-	0x64, 0xa9, 0xf3, 0x4b, 0xa8, 0xd9, 0x94, 0xc6, 0xca, 0xe2, 0x72, 0xea, 0x72, 0x4b, 0xc9, 0xed,
-	0x69, 0xc1, 0x90, 0x84, 0x38, 0xe5, 0x8a, 0x72, 0x56, 0x59, 0x69, 0x7e, 0x94, 0x2f, 0x00, 0xe4,
-	0xa6, 0x08, 0x42, 0xf6, 0x19, 0x54, 0x30, 0xb5, 0x93, 0x75, 0xe7, 0x34, 0x6d, 0xcf, 0x25, 0x90,
-	0xa7, 0x05, 0x83, 0x88, 0xd8, 0x17, 0x50, 0xa5, 0x44, 0x6d, 0xb9, 0xf6, 0x9c, 0xcf, 0x08, 0x4f,
-	0x0b, 0x86, 0x20, 0x63, 0x9f, 0x42, 0xf9, 0x26, 0x76, 0x48, 0xf0, 0xcd, 0x85, 0x8d, 0x66, 0xf3,
-	0x06, 0x2a, 0x6e, 0xc7, 0x0e, 0xb1, 0xc1, 0xe3, 0x29, 0xa9, 0x20, 0xcb, 0x46, 0xf6, 0xd2, 0x26,
-	0x36, 0x78, 0x3c, 0x45, 0x62, 0x7c, 0x45, 0x90, 0x3e, 0x9a, 0xab, 0xaf, 0xe6, 0x94, 0x18, 0x89,
-	0xd8, 0xd7, 0x50, 0x9f, 0x88, 0x37, 0x05, 0xe9, 0xa7, 0x79, 0xd0, 0x49, 0xe8, 0x97, 0x9f, 0x1a,
-	0xa7, 0x05, 0x23, 0x21, 0xc5, 0x93, 0x3a, 0xe8, 0x02, 0xa4, 0xb3, 0xcc, 0x49, 0xf3, 0x7e, 0x81,
-	0x27, 0x25, 0xb2, 0x35, 0x45, 0x2a, 0x4c, 0x65, 0xdc, 0x4c, 0x29, 0xa7, 0xe2, 0x7a, 0x3d, 0x1b,
-	0xdf, 0x3b, 0x0b, 0x47, 0xd2, 0xfe, 0xa6, 0x04, 0x15, 0xca, 0x5a, 0x97, 0x0b, 0x59, 0x8f, 0xa0,
-	0xee, 0x04, 0x66, 0xa6, 0x96, 0x55, 0x73, 0x82, 0x33, 0x2b, 0x7a, 0xb5, 0xa8, 0x5e, 0x89, 0x52,
-	0x9f, 0xac, 0x5e, 0xa5, 0x8f, 0x50, 0x71, 0x81, 0xca, 0x47, 0xe8, 0x03, 0xa8, 0x46, 0x63, 0x3f,
-	0xe0, 0xf2, 0xf2, 0x14, 0x03, 0xca, 0x02, 0x39, 0x0f, 0xa5, 0x07, 0xd1, 0x77, 0x9a, 0xdb, 0xd0,
-	0x86, 0xc2, 0x87, 0x28, 0xb7, 0xa1, 0x2d, 0x9f, 0x40, 0xe3, 0x2a, 0xf4, 0x2d, 0x1b, 0x5f, 0x2a,
-	0xa4, 0xca, 0x96, 0xb1, 0x00, 0x2c, 0x9e, 0xde, 0x8d, 0xec, 0xd3, 0xfb, 0x21, 0xd4, 0xae, 0xad,
-	0x99, 0xe3, 0xde, 0x91, 0x8e, 0xaa, 0x86, 0x1c, 0xa5, 0x62, 0x6a, 0xe6, 0xc4, 0x84, 0xa1, 0x49,
-	0x78, 0x47, 0xdb, 0xa8, 0x58, 0x76, 0xcf, 0xd6, 0x5a, 0x00, 0x54, 0xeb, 0x1d, 0xf8, 0xf1, 0xdc,
-	0xd3, 0xfe, 0x50, 0x8e, 0x44, 0x81, 0x6d, 0x17, 0x94, 0x78, 0xee, 0x99, 0x19, 0x7f, 0xa9, 0xc7,
-	0x73, 0x8f, 0xfc, 0x71, 0x07, 0x6a, 0xf8, 0x2e, 0x70, 0x02, 0x29, 0xb5, 0x6a, 0x14, 0x8e, 0x7b,
-	0x81, 0xf6, 0x77, 0x65, 0xa8, 0xd2, 0x02, 0xe8, 0x8f, 0x24, 0xfe, 0x6c, 0xb5, 0x80, 0x82, 0x56,
-	0x6f, 0x89, 0xed, 0x52, 0x8e, 0xed, 0x07, 0x49, 0x55, 0x47, 0x56, 0xc4, 0x44, 0x09, 0x27, 0x71,
-	0x5a, 0x21, 0xf4, 0xa5, 0x9f, 0xde, 0xaa, 0x59, 0x4d, 0x08, 0xf5, 0xd6, 0x52, 0xf5, 0xae, 0x54,
-	0x16, 0xea, 0x6b, 0x2a, 0x0b, 0x4f, 0x00, 0x5c, 0x17, 0xf3, 0x0f, 0xa2, 0x50, 0x64, 0x9d, 0xd0,
-	0xed, 0x05, 0x84, 0xcd, 0xbc, 0xd0, 0x1a, 0xd9, 0x17, 0x1a, 0xd5, 0xf3, 0x3c, 0x47, 0xca, 0x1d,
-	0x3f, 0xef, 0x11, 0xba, 0xc7, 0x33, 0x42, 0xf7, 0x78, 0xcf, 0xa6, 0xb7, 0xc0, 0xf4, 0x8e, 0x2e,
-	0x3d, 0x4a, 0x15, 0x30, 0x9b, 0x98, 0xde, 0x51, 0x7c, 0xda, 0x87, 0xaa, 0x87, 0xaa, 0x90, 0xaf,
-	0x7b, 0x96, 0xfb, 0x39, 0x8c, 0x94, 0x44, 0xbf, 0x89, 0xe1, 0x07, 0xd2, 0x0a, 0xd7, 0xd9, 0x5c,
-	0x43, 0x4b, 0x2a, 0x4c, 0xdd, 0xe6, 0x50, 0x81, 0x5a, 0x3c, 0xf7, 0x3c, 0xee, 0x6a, 0xff, 0x52,
-	0x84, 0xe6, 0x80, 0xbf, 0x8e, 0xa7, 0x3e, 0xfd, 0x04, 0xf1, 0x36, 0x4d, 0x31, 0xa8, 0x4c, 0xfd,
-	0x20, 0xc9, 0x9b, 0xe9, 0x7b, 0x5d, 0xa5, 0x77, 0x8d, 0x57, 0xfc, 0x02, 0xea, 0x1e, 0xbf, 0x35,
-	0xed, 0x28, 0x96, 0x41, 0x72, 0x7b, 0x11, 0x57, 0xa3, 0xd8, 0xf1, 0xac, 0xd8, 0xf1, 0x3d, 0xa3,
-	0xe6, 0xf1, 0xdb, 0x6e, 0x14, 0xb3, 0x0f, 0xa1, 0x4a, 0xe1, 0x5c, 0xc6, 0xc7, 0xf4, 0x07, 0x3e,
-	0xfa, 0xad, 0xc4, 0x10, 0x38, 0xed, 0xe7, 0xb0, 0x79, 0x76, 0xd1, 0x1f, 0x66, 0xe6, 0xd3, 0x83,
-	0x0f, 0x5d, 0x13, 0x2f, 0xcb, 0x32, 0x15, 0xfd, 0x69, 0xa4, 0xfd, 0x06, 0x9a, 0x4b, 0x64, 0xd2,
-	0xe0, 0x8a, 0x39, 0x83, 0xfb, 0x1c, 0x2a, 0xb3, 0xc0, 0x8d, 0xe4, 0xef, 0x5c, 0x69, 0x18, 0x5f,
-	0xda, 0x05, 0x63, 0x1c, 0x92, 0x1d, 0xd6, 0xa0, 0x62, 0xf3, 0x28, 0xd6, 0x3e, 0x84, 0x06, 0x92,
-	0x10, 0x73, 0xf7, 0xb2, 0x70, 0x06, 0x55, 0x41, 0xb0, 0xee, 0xf7, 0xb5, 0x4f, 0x72, 0x1b, 0x6f,
-	0x65, 0x37, 0xa6, 0x49, 0xe9, 0x96, 0x75, 0x29, 0x18, 0xed, 0x00, 0x5a, 0xe9, 0x0f, 0x47, 0x2f,
-	0x44, 0x61, 0x1f, 0x65, 0x5b, 0x5c, 0xfc, 0x36, 0x81, 0x16, 0x19, 0x5e, 0xa7, 0x15, 0xe6, 0xf0,
-	0x5a, 0xbb, 0x84, 0x46, 0x3a, 0xe7, 0xf7, 0x2a, 0xe2, 0xcb, 0x25, 0xca, 0xe9, 0x12, 0x68, 0xc1,
-	0xdc, 0x5b, 0xd4, 0xc4, 0x2b, 0xdc, 0xeb, 0xd9, 0xda, 0x3f, 0x55, 0xa0, 0x4a, 0xbf, 0xde, 0xbc,
-	0xcd, 0x7c, 0x9e, 0x42, 0xcb, 0x31, 0x33, 0x04, 0xc2, 0x8c, 0x80, 0xee, 0xa5, 0xb4, 0xbc, 0x26,
-	0x82, 0x67, 0x39, 0x1b, 0x3c, 0xe5, 0xe1, 0x44, 0xcd, 0x81, 0x0e, 0xb7, 0x0b, 0x8a, 0x1d, 0xc5,
-	0x22, 0x72, 0x8a, 0x77, 0x56, 0xdd, 0x8e, 0xe2, 0x33, 0xc9, 0x74, 0x14, 0x8e, 0xa5, 0xdb, 0xe3,
-	0xa7, 0xb4, 0xd0, 0x7a, 0x6a, 0xa1, 0x07, 0x00, 0x54, 0x04, 0x32, 0x03, 0x2b, 0x9e, 0x76, 0x94,
-	0xa7, 0xe5, 0xac, 0x39, 0x66, 0xbc, 0xc1, 0x68, 0x10, 0xd9, 0x85, 0x15, 0x4f, 0xd9, 0x1e, 0x28,
-	0xd4, 0x1f, 0x30, 0xf6, 0x5d, 0xe9, 0xf9, 0xe9, 0x58, 0xe0, 0x1c, 0x3f, 0x74, 0xe2, 0x24, 0xf0,
-	0xa6, 0x63, 0x3c, 0x50, 0x6c, 0x5d, 0xb9, 0x5c, 0xd6, 0x05, 0xc5, 0x20, 0xb5, 0x81, 0x56, 0x26,
-	0x86, 0xc9, 0x44, 0xad, 0xbd, 0x28, 0x8d, 0xa4, 0x9e, 0xb4, 0x91, 0xf5, 0xa4, 0x5d, 0x50, 0xd0,
-	0x14, 0xc8, 0x95, 0x36, 0x45, 0xbc, 0xc0, 0x31, 0xba, 0x4d, 0xc6, 0xc9, 0xd4, 0x9f, 0xe0, 0x64,
-	0x5b, 0xf7, 0x3b, 0x59, 0x1a, 0xc5, 0x58, 0x2e, 0x8a, 0x85, 0x31, 0xc2, 0xb6, 0x85, 0x0d, 0x84,
-	0xf1, 0xe2, 0xf7, 0x99, 0xc9, 0x2d, 0x25, 0xe6, 0xe9, 0x2f, 0x39, 0x3b, 0x50, 0x23, 0x7b, 0x89,
-	0x3a, 0x3b, 0xe4, 0x0d, 0x55, 0x34, 0x98, 0x68, 0xff, 0x63, 0xd9, 0x3b, 0x30, 0x0c, 0xc7, 0x8b,
-	0xdf, 0x19, 0xea, 0x50, 0x7e, 0x31, 0xe8, 0xab, 0x45, 0xfc, 0xd0, 0x2f, 0x7a, 0x6a, 0x69, 0xff,
-	0xaf, 0x8a, 0xd0, 0xce, 0x15, 0x41, 0xd9, 0x26, 0x34, 0x71, 0xf0, 0xd2, 0x7b, 0xe5, 0xf9, 0xb7,
-	0x9e, 0x5a, 0x60, 0x0c, 0x36, 0x10, 0x30, 0xf0, 0xe3, 0x0b, 0x7a, 0x9a, 0xc4, 0x6a, 0x91, 0xb5,
-	0x40, 0x41, 0x58, 0x17, 0x29, 0x4a, 0xec, 0x21, 0x30, 0x1c, 0xf5, 0xfd, 0x5b, 0x1e, 0xf6, 0xad,
-	0x3b, 0x09, 0x2f, 0x27, 0x4b, 0x8d, 0x48, 0x32, 0x13, 0xf1, 0x1b, 0x86, 0x98, 0x16, 0xce, 0x2c,
-	0x2f, 0x56, 0xab, 0x0c, 0xa0, 0x46, 0x9b, 0x05, 0x6a, 0x6d, 0xff, 0xcf, 0x17, 0x2f, 0x77, 0xc1,
-	0x89, 0x0a, 0xad, 0xc3, 0xf3, 0x41, 0xd7, 0xec, 0xf7, 0x06, 0x2f, 0xcc, 0x97, 0x17, 0x82, 0x95,
-	0x05, 0xe4, 0xb9, 0xde, 0xc3, 0xa3, 0xe4, 0x60, 0xdd, 0xf3, 0xef, 0x06, 0x6a, 0x29, 0x0f, 0x3b,
-	0xd4, 0x8f, 0x5e, 0xa8, 0x65, 0xf6, 0x33, 0xd8, 0xca, 0xac, 0x36, 0x78, 0x31, 0x40, 0xd2, 0xff,
-	0x49, 0xfe, 0x8a, 0xfb, 0xbf, 0x86, 0x46, 0xfa, 0x5b, 0x06, 0xdb, 0x91, 0xc4, 0xc3, 0x91, 0x3e,
-	0x3a, 0x36, 0xf5, 0xa3, 0x51, 0xef, 0xf2, 0x58, 0x2d, 0x2c, 0x81, 0x71, 0xe1, 0x97, 0x17, 0x6a,
-	0x91, 0xbd, 0x0f, 0x2c, 0x03, 0x5e, 0xb3, 0xf6, 0xbf, 0x17, 0x41, 0x49, 0xca, 0x07, 0xac, 0x03,
-	0x0f, 0x88, 0xfa, 0xec, 0xbc, 0x8b, 0x6b, 0xf4, 0xf5, 0xc1, 0xd1, 0xb1, 0x69, 0x18, 0x6a, 0x81,
-	0x3d, 0x86, 0x47, 0x0b, 0x8c, 0xd8, 0x74, 0xb1, 0xc9, 0x2e, 0xec, 0xac, 0x4e, 0xfb, 0xfe, 0xdc,
-	0x50, 0x4b, 0xec, 0x11, 0x6c, 0x67, 0x50, 0xc6, 0xb9, 0xde, 0x3d, 0xd2, 0x87, 0x23, 0xb5, 0x9c,
-	0xf2, 0x4b, 0x88, 0x5f, 0x3d, 0x3b, 0x30, 0xbf, 0xd2, 0xbb, 0x6a, 0x65, 0xfd, 0x52, 0xa3, 0xfe,
-	0xa1, 0x5a, 0x5d, 0x8f, 0xd2, 0xfb, 0x87, 0x6a, 0x2d, 0xbf, 0x58, 0x72, 0xc8, 0xfa, 0xfe, 0x5f,
-	0x16, 0x61, 0x73, 0xa9, 0x1a, 0xc0, 0xf6, 0xe0, 0x21, 0x91, 0xea, 0xc6, 0x85, 0x79, 0xa9, 0xf7,
-	0x7b, 0x5d, 0x94, 0xcb, 0xe0, 0x7c, 0x80, 0x32, 0x7c, 0x02, 0x9d, 0x55, 0x9c, 0x94, 0x70, 0x71,
-	0x3d, 0x56, 0xca, 0xa0, 0x94, 0x72, 0x97, 0x9f, 0xdb, 0xef, 0xab, 0xe5, 0xfd, 0x73, 0xd8, 0x5a,
-	0x29, 0x2a, 0xe4, 0x56, 0xd3, 0xfb, 0x7d, 0x73, 0xa4, 0x1b, 0x27, 0xc7, 0xa3, 0xa1, 0xa9, 0x0f,
-	0x7e, 0x58, 0xe2, 0x24, 0x87, 0xed, 0xf7, 0xd5, 0xe2, 0xfe, 0x9f, 0xc1, 0xf6, 0x9a, 0x3a, 0x02,
-	0x7b, 0x0a, 0x4f, 0x68, 0xd2, 0x85, 0xd1, 0x3b, 0xd3, 0x8d, 0x1f, 0x4c, 0xe3, 0x78, 0x78, 0xdc,
-	0x3f, 0x3e, 0x1a, 0x99, 0x7a, 0xff, 0x3b, 0xfd, 0x87, 0xa1, 0x5a, 0xb8, 0x9f, 0xe2, 0xf0, 0x78,
-	0x24, 0x7e, 0xe2, 0xfb, 0x00, 0xde, 0x5b, 0x4f, 0x81, 0x26, 0xfd, 0xd2, 0x38, 0x56, 0x4b, 0xfb,
-	0xae, 0x10, 0x6a, 0xa6, 0x00, 0x91, 0x5a, 0x07, 0x12, 0x99, 0xe7, 0x97, 0xc7, 0x86, 0x79, 0xa6,
-	0x1f, 0x25, 0x52, 0x7d, 0x0f, 0x76, 0xd7, 0x20, 0x53, 0xb1, 0xae, 0x47, 0x3f, 0x3f, 0xef, 0xf7,
-	0xcf, 0xbf, 0x53, 0x4b, 0xfb, 0xff, 0x59, 0x04, 0xb6, 0x5a, 0xaf, 0x48, 0x4f, 0xf2, 0xfd, 0x59,
-	0x6f, 0x64, 0x9e, 0xea, 0xc3, 0x53, 0xf3, 0xe2, 0xbc, 0xdf, 0x3b, 0xfa, 0xc1, 0xec, 0xeb, 0x3f,
-	0x1c, 0x1b, 0x07, 0x6a, 0x81, 0x69, 0xf0, 0xb3, 0x37, 0x50, 0x7c, 0x65, 0x7e, 0xad, 0x16, 0xdf,
-	0x42, 0x73, 0x60, 0x7e, 0xa5, 0x96, 0xee, 0xa7, 0x39, 0x1e, 0x1c, 0xe9, 0x17, 0x48, 0x53, 0x7e,
-	0x0b, 0x0d, 0xee, 0x55, 0x49, 0x25, 0xbb, 0x42, 0x93, 0xd8, 0x6b, 0x75, 0xff, 0x37, 0xd0, 0xca,
-	0x96, 0x1f, 0x52, 0xe7, 0xe9, 0xeb, 0x47, 0x17, 0xa6, 0x81, 0x06, 0x35, 0x44, 0xa1, 0x14, 0xd6,
-	0x20, 0x9e, 0xa3, 0x57, 0x15, 0x53, 0xeb, 0x5e, 0x20, 0x92, 0xd5, 0x4b, 0xfb, 0x96, 0x58, 0x3d,
-	0xa9, 0x54, 0x2c, 0x2c, 0xb6, 0x6b, 0x4a, 0x1d, 0x0f, 0x47, 0xfa, 0x61, 0x3f, 0xe7, 0x08, 0x29,
-	0xea, 0x50, 0x1f, 0x74, 0xbf, 0xeb, 0x75, 0x47, 0xa7, 0x6a, 0x31, 0x8d, 0x12, 0x0b, 0xec, 0xd1,
-	0xf9, 0xcb, 0xc1, 0x48, 0x2d, 0x1d, 0xfc, 0x6d, 0x11, 0x60, 0xd0, 0xd7, 0x8f, 0xfc, 0x90, 0xeb,
-	0x81, 0xc3, 0x5e, 0x00, 0x1b, 0x72, 0xcf, 0x5e, 0x6a, 0x6e, 0x7b, 0xb8, 0xb8, 0x6c, 0xb3, 0xf0,
-	0xbd, 0xc7, 0xeb, 0xe1, 0xa2, 0x85, 0xa8, 0xc0, 0x0e, 0xb3, 0x7d, 0x68, 0xc9, 0x5a, 0xb9, 0xb6,
-	0xae, 0xfb, 0x56, 0xa0, 0x7e, 0x2b, 0xad, 0xf0, 0xac, 0x78, 0xf0, 0xaf, 0x00, 0xb5, 0x41, 0x5f,
-	0x47, 0xde, 0x7e, 0x09, 0x35, 0xd1, 0xb5, 0xc4, 0xd2, 0xb7, 0x6c, 0xae, 0x13, 0x6a, 0x6f, 0x7b,
-	0x19, 0x2c, 0xd8, 0xe8, 0x02, 0x2c, 0xda, 0x9b, 0xd8, 0x9b, 0x76, 0xdc, 0x7b, 0x94, 0x59, 0x21,
-	0xd7, 0x0f, 0x55, 0x60, 0xa7, 0xb8, 0x4a, 0x72, 0x18, 0xb6, 0xbb, 0x20, 0x5c, 0x6a, 0xb4, 0x7b,
-	0xeb, 0x91, 0xd8, 0x3e, 0xd4, 0x65, 0x6f, 0x15, 0xdb, 0xcc, 0x16, 0x87, 0x5e, 0xf0, 0xbb, 0xbd,
-	0x5c, 0x23, 0x84, 0x56, 0x90, 0xb4, 0xf4, 0x94, 0xd9, 0xcc, 0x76, 0xe0, 0xe5, 0x68, 0x11, 0xa0,
-	0x15, 0xd8, 0xe7, 0xa0, 0x24, 0x7d, 0x56, 0x4c, 0xcd, 0x3d, 0x27, 0x90, 0x3a, 0xdf, 0x9b, 0x97,
-	0x92, 0x8b, 0x3c, 0x50, 0xcd, 0x75, 0xe4, 0xe5, 0xc8, 0x09, 0xa2, 0x15, 0xd8, 0x67, 0xc4, 0xc9,
-	0x59, 0xe0, 0x46, 0x0b, 0x4e, 0x64, 0x3f, 0xcf, 0x2a, 0xb1, 0x60, 0x9b, 0xfa, 0x54, 0x36, 0xb3,
-	0xfa, 0xce, 0xb1, 0x8d, 0x00, 0xad, 0xc0, 0x3e, 0x81, 0x9a, 0xe8, 0xb3, 0x62, 0x1b, 0x99, 0x46,
-	0x35, 0xa4, 0xcc, 0x36, 0xae, 0x69, 0x05, 0xf6, 0xff, 0xa0, 0x95, 0x6d, 0xbf, 0x62, 0x0f, 0x72,
-	0x09, 0x90, 0x4c, 0xac, 0xf7, 0xb6, 0x56, 0xa0, 0xe9, 0x59, 0xc5, 0xc3, 0x58, 0xcd, 0x15, 0x29,
-	0x72, 0xec, 0x13, 0x84, 0x74, 0xbd, 0xb5, 0xd2, 0x3d, 0xb6, 0x50, 0xf9, 0x4a, 0xeb, 0xcf, 0xde,
-	0x3d, 0xdd, 0x85, 0x5a, 0x81, 0x7d, 0x43, 0x1b, 0x8b, 0x82, 0xe8, 0xa3, 0x45, 0x45, 0x25, 0xd7,
-	0x59, 0xb7, 0xac, 0xf4, 0x67, 0x45, 0x39, 0x8d, 0x1a, 0xeb, 0x72, 0xd3, 0xb2, 0xad, 0x76, 0xcb,
-	0xfa, 0x7f, 0x56, 0x64, 0xbf, 0x82, 0x46, 0xda, 0x69, 0xc7, 0x32, 0x05, 0x9c, 0x7c, 0xf3, 0xdd,
-	0x8a, 0x29, 0xa4, 0x33, 0x45, 0xf3, 0x5d, 0x6e, 0x66, 0xae, 0x1f, 0x6f, 0x45, 0xd1, 0xcf, 0x8a,
-	0xec, 0x97, 0xc4, 0x2a, 0xf5, 0xe3, 0xe5, 0x58, 0xcd, 0x76, 0xe8, 0xad, 0x9b, 0x27, 0x8e, 0x48,
-	0x1d, 0x77, 0xb9, 0x79, 0xd9, 0x1e, 0xbc, 0x65, 0x5b, 0x79, 0x56, 0x64, 0x07, 0x64, 0x59, 0x97,
-	0x81, 0x17, 0x2d, 0xa2, 0x52, 0xbe, 0x4d, 0x6f, 0xc9, 0x6c, 0x9e, 0x15, 0x59, 0x17, 0xda, 0xb9,
-	0xbe, 0x3d, 0xf6, 0x24, 0x33, 0x73, 0xa5, 0x9d, 0x6f, 0xad, 0x05, 0xa5, 0x22, 0x12, 0x5d, 0x7e,
-	0x39, 0x11, 0xe5, 0x1a, 0xff, 0x56, 0x8c, 0xe9, 0x59, 0x91, 0x0d, 0x81, 0xad, 0x36, 0x23, 0xb2,
-	0x0f, 0x32, 0x4b, 0xac, 0x6f, 0x54, 0xbc, 0xdf, 0xae, 0x52, 0xf9, 0x51, 0x83, 0x61, 0x4e, 0x7e,
-	0xd9, 0x96, 0xc3, 0x85, 0xfc, 0x10, 0x8a, 0xd3, 0xae, 0x6a, 0xf4, 0x0a, 0xfa, 0xea, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xef, 0x8d, 0x80, 0x34, 0x45, 0x2d, 0x00, 0x00,
+	// 5281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7b, 0x4b, 0x6f, 0x23, 0x49,
+	0x72, 0x30, 0x8b, 0x6f, 0x86, 0x48, 0xaa, 0x94, 0xea, 0x07, 0x5b, 0xdd, 0xbd, 0xd3, 0x53, 0xb3,
+	0xfd, 0xcd, 0xac, 0x76, 0x77, 0x56, 0xa3, 0x9e, 0x6d, 0xec, 0xb7, 0x03, 0xaf, 0x41, 0x3d, 0x5a,
+	0x22, 0x9a, 0xa2, 0xe4, 0xa2, 0x5a, 0xb3, 0xb3, 0x58, 0xb8, 0x50, 0x62, 0xa5, 0xc8, 0x72, 0x17,
+	0xab, 0x6a, 0xaa, 0x8a, 0x52, 0x0b, 0xb0, 0x01, 0x1f, 0xbc, 0x80, 0x2f, 0x06, 0x7c, 0x30, 0x7c,
+	0x58, 0xc0, 0x07, 0xc3, 0x57, 0xdb, 0x07, 0x9f, 0xec, 0x93, 0x2f, 0xfe, 0x07, 0xf6, 0xc5, 0x27,
+	0x1f, 0x0d, 0xdf, 0x0d, 0xf8, 0x64, 0xd8, 0x88, 0xc8, 0xac, 0x17, 0x1f, 0xdd, 0xdb, 0x6e, 0x5e,
+	0x58, 0x19, 0x11, 0xf9, 0x8a, 0x8c, 0x88, 0x8c, 0x8c, 0xc8, 0x84, 0xa6, 0xeb, 0x98, 0xa6, 0x6f,
+	0x7f, 0xee, 0x07, 0x5e, 0xe4, 0xb1, 0xaa, 0x28, 0x69, 0xbf, 0x07, 0xf5, 0x81, 0x73, 0x12, 0x8e,
+	0x27, 0x56, 0xc0, 0x54, 0x28, 0x39, 0xdc, 0xed, 0x28, 0x4f, 0x94, 0xcf, 0x5a, 0x3a, 0x7e, 0x32,
+	0x06, 0xe5, 0xe8, 0xd6, 0xe7, 0x9d, 0x22, 0x81, 0xe8, 0x9b, 0xdd, 0x81, 0xca, 0x95, 0x63, 0x8e,
+	0xc3, 0x4e, 0x89, 0x80, 0xa2, 0x80, 0x75, 0x43, 0xfe, 0x6d, 0xa7, 0x2c, 0xea, 0x86, 0xfc, 0x5b,
+	0x84, 0xf8, 0xb6, 0xd5, 0xa9, 0x08, 0x88, 0x6f, 0x5b, 0xda, 0xaf, 0x14, 0x68, 0x0f, 0x78, 0xe4,
+	0xd8, 0xee, 0xeb, 0x13, 0x1e, 0x86, 0xe6, 0x98, 0xb3, 0xcf, 0xa0, 0x3a, 0xe1, 0xa6, 0xc5, 0x03,
+	0xea, 0x75, 0x6d, 0x57, 0xfd, 0x5c, 0x8e, 0x32, 0x1e, 0x94, 0x2e, 0xf1, 0x38, 0x14, 0xcb, 0x8c,
+	0x4c, 0x1a, 0x4a, 0x53, 0xa7, 0x6f, 0xb6, 0x01, 0x65, 0xd7, 0xb0, 0x2d, 0x39, 0x92, 0x92, 0xdb,
+	0xb3, 0x98, 0x06, 0xa5, 0x30, 0x18, 0xd1, 0x38, 0xda, 0x73, 0xad, 0x0d, 0x83, 0x91, 0x8e, 0x48,
+	0xed, 0x2e, 0x6c, 0xe6, 0x87, 0xa1, 0x73, 0xdf, 0xb9, 0xd5, 0x36, 0x61, 0xe3, 0xc4, 0x73, 0x25,
+	0x46, 0xe7, 0xdf, 0xce, 0x78, 0x18, 0x69, 0x7f, 0x55, 0x92, 0x0c, 0x7a, 0xe5, 0xda, 0x4c, 0x83,
+	0x32, 0xe2, 0xe4, 0x58, 0x9b, 0x71, 0xeb, 0x7d, 0xdb, 0x7d, 0x7d, 0x5c, 0xd0, 0x09, 0x87, 0x34,
+	0xa6, 0x65, 0x05, 0x9d, 0x62, 0x9e, 0xa6, 0x6b, 0x59, 0x01, 0xd2, 0x20, 0x8e, 0x3d, 0x85, 0x8a,
+	0xcb, 0xed, 0xf1, 0x84, 0x06, 0xbe, 0xb6, 0xdb, 0x4a, 0x86, 0x89, 0xc0, 0xe3, 0x82, 0x2e, 0xb0,
+	0x48, 0x16, 0x78, 0xb3, 0x88, 0x77, 0xca, 0x79, 0x32, 0x1d, 0x81, 0x48, 0x46, 0x58, 0xec, 0xd1,
+	0xf5, 0x2c, 0xde, 0xa9, 0xe4, 0x7b, 0x1c, 0x78, 0x16, 0x12, 0x11, 0x8e, 0x7d, 0x04, 0xa5, 0x6b,
+	0xdf, 0xed, 0x54, 0x89, 0x64, 0x2d, 0x26, 0xb9, 0xf0, 0xdd, 0xe3, 0x82, 0x8e, 0x18, 0xf6, 0x53,
+	0x68, 0x5e, 0x06, 0xc6, 0xb5, 0x63, 0xba, 0x86, 0xed, 0x5e, 0x79, 0x9d, 0x1a, 0x51, 0xde, 0x8b,
+	0x29, 0xf7, 0x02, 0xdb, 0x1a, 0xf3, 0x0b, 0xc7, 0x74, 0x7b, 0xee, 0x95, 0x77, 0x5c, 0xd0, 0xe1,
+	0x32, 0x88, 0x4b, 0xec, 0xfb, 0x50, 0x73, 0xf9, 0x9b, 0x68, 0xe2, 0xf9, 0x9d, 0x3a, 0x55, 0x5b,
+	0x4f, 0x27, 0x44, 0xe0, 0xe3, 0x82, 0x1e, 0x53, 0xe0, 0x68, 0x83, 0x99, 0xc3, 0x3b, 0x8d, 0xfc,
+	0x68, 0xf5, 0x99, 0x43, 0xa3, 0x45, 0x1c, 0x4a, 0xc5, 0x54, 0xcc, 0x1c, 0x88, 0xaa, 0x1d, 0x53,
+	0x9d, 0x04, 0x72, 0xea, 0x12, 0xbf, 0x57, 0x81, 0xd2, 0x34, 0x1c, 0x6b, 0xbf, 0x56, 0xe6, 0x97,
+	0xf4, 0x95, 0x6b, 0x7b, 0xee, 0x7b, 0x88, 0x97, 0x46, 0x0d, 0x75, 0x8a, 0x4b, 0xc8, 0x5e, 0xb9,
+	0xb6, 0x8e, 0xc8, 0xff, 0xab, 0xb8, 0xed, 0x41, 0xeb, 0xc4, 0xb3, 0x2e, 0x7c, 0x57, 0xca, 0x54,
+	0xa2, 0x55, 0x4a, 0x46, 0xab, 0x1e, 0x8b, 0x05, 0x2a, 0x2e, 0x2c, 0x10, 0x2d, 0x8f, 0xd6, 0x82,
+	0xb5, 0xb8, 0x0d, 0x14, 0xd5, 0x0d, 0x58, 0x3f, 0xf1, 0xac, 0x44, 0x54, 0x11, 0xf4, 0x5d, 0x58,
+	0x3f, 0xe2, 0x11, 0x8a, 0x62, 0x18, 0xf7, 0x13, 0x8f, 0x57, 0x49, 0xc6, 0x2b, 0xa9, 0x50, 0x18,
+	0xdf, 0x46, 0xf5, 0x14, 0xd4, 0x23, 0x1e, 0x91, 0x34, 0xbe, 0x9b, 0x8c, 0xa4, 0xf1, 0xdd, 0x7d,
+	0x9e, 0xf8, 0x4e, 0xf8, 0x36, 0xaa, 0xcf, 0xe1, 0xc1, 0x11, 0x8f, 0xf2, 0x72, 0xf6, 0x36, 0xfa,
+	0x4f, 0x81, 0xd1, 0x18, 0x49, 0xaa, 0xde, 0xdd, 0x3d, 0xca, 0xd7, 0xdb, 0xa8, 0xfe, 0x1f, 0x6c,
+	0xe0, 0x20, 0x83, 0x77, 0x4d, 0x66, 0x83, 0x5a, 0x43, 0xdd, 0x8a, 0xa9, 0x34, 0x15, 0xda, 0x47,
+	0x3c, 0xba, 0xf0, 0xdd, 0x04, 0x72, 0x0f, 0xee, 0x1c, 0xf1, 0xe8, 0xd0, 0x1d, 0x99, 0x7e, 0x76,
+	0x1a, 0x1a, 0x23, 0x86, 0xf5, 0xfc, 0x68, 0x96, 0xd2, 0x8a, 0x06, 0x87, 0x91, 0x19, 0x25, 0xa0,
+	0x5d, 0xa8, 0xe1, 0x3a, 0xbe, 0xe4, 0xb7, 0x4b, 0x46, 0x80, 0xf6, 0xd7, 0x76, 0x2d, 0xfe, 0x86,
+	0x64, 0xa5, 0xa2, 0x8b, 0x82, 0xb6, 0x03, 0x35, 0x5c, 0xd5, 0x15, 0x75, 0x58, 0xc6, 0x28, 0x35,
+	0x84, 0x11, 0xd2, 0xae, 0xa1, 0x4e, 0x2b, 0xfc, 0x9b, 0x57, 0x61, 0x1d, 0xa8, 0xd9, 0x57, 0xa2,
+	0xf3, 0x12, 0x75, 0x1e, 0x17, 0xd9, 0x7d, 0xa8, 0x09, 0xdb, 0x61, 0x91, 0x2e, 0x54, 0xf4, 0x2a,
+	0x16, 0x7b, 0x16, 0xee, 0x02, 0x56, 0x18, 0x91, 0x6d, 0x6a, 0xe8, 0xf8, 0xa9, 0x7d, 0x01, 0x75,
+	0x12, 0x99, 0xf7, 0x18, 0xea, 0x8f, 0xa1, 0x86, 0xe2, 0xb3, 0xa2, 0xc6, 0x7d, 0xa8, 0x39, 0x86,
+	0x63, 0x5e, 0x72, 0x47, 0xee, 0x53, 0x55, 0xa7, 0x8f, 0x25, 0xed, 0x11, 0xd4, 0x70, 0xa1, 0x96,
+	0x57, 0xd3, 0x7e, 0x0b, 0xaa, 0x17, 0xbe, 0xbb, 0xa2, 0x4d, 0x39, 0xec, 0x62, 0x32, 0x6c, 0xd6,
+	0x86, 0xe2, 0xf8, 0x86, 0xa6, 0xdd, 0xd0, 0x8b, 0xe3, 0x1b, 0xed, 0xc7, 0x50, 0xa7, 0x85, 0x5c,
+	0xd1, 0xc0, 0x3d, 0xa8, 0x06, 0x7c, 0xea, 0x45, 0x5c, 0x6e, 0x58, 0xb2, 0xa4, 0x0d, 0x60, 0x23,
+	0x2f, 0xe3, 0xef, 0xb3, 0xca, 0x38, 0xac, 0xeb, 0xd4, 0x00, 0x5d, 0xdb, 0x96, 0xf6, 0x23, 0x00,
+	0xa9, 0x03, 0x2b, 0x1a, 0x6a, 0x43, 0xd1, 0xb6, 0x24, 0x63, 0x8a, 0xb6, 0xa5, 0xfd, 0x8b, 0x02,
+	0x35, 0x54, 0x86, 0xd5, 0xe3, 0xbe, 0x32, 0xa7, 0xb6, 0x73, 0x2b, 0x3b, 0x96, 0x25, 0xb6, 0x05,
+	0x75, 0x3f, 0xb0, 0xbd, 0xc0, 0x8e, 0x6e, 0xe5, 0xda, 0x27, 0x65, 0xa6, 0xa6, 0x46, 0xb0, 0x41,
+	0x26, 0x6f, 0x71, 0xd5, 0xd9, 0x03, 0xa8, 0xdb, 0xf6, 0x95, 0xe1, 0x9a, 0x53, 0x4e, 0xbb, 0x50,
+	0x43, 0xaf, 0xd9, 0xf6, 0xd5, 0xc0, 0x9c, 0x72, 0x44, 0x79, 0x31, 0xaa, 0x26, 0x50, 0x9e, 0x44,
+	0x31, 0x28, 0x4f, 0xcd, 0xe0, 0x35, 0x6d, 0x2b, 0x15, 0x9d, 0xbe, 0x91, 0x33, 0x91, 0x79, 0x29,
+	0x77, 0x90, 0x8a, 0x2e, 0x0a, 0x5a, 0x04, 0x0d, 0xa1, 0xbc, 0xef, 0x39, 0xaf, 0xa4, 0xb5, 0x52,
+	0xa6, 0xb5, 0x25, 0x33, 0xba, 0x03, 0x95, 0x71, 0xe0, 0xcd, 0x7c, 0x39, 0x27, 0x51, 0xd0, 0xb6,
+	0xa1, 0x8c, 0x9a, 0x8b, 0xf4, 0xaf, 0xf9, 0x2d, 0xf5, 0xd7, 0xd0, 0xf1, 0x93, 0x56, 0xca, 0x14,
+	0x02, 0x59, 0xd6, 0xf1, 0x53, 0xfb, 0x1e, 0x94, 0x51, 0x1a, 0x69, 0x41, 0x7c, 0x22, 0x6d, 0xea,
+	0x45, 0xdb, 0x4f, 0x06, 0x5b, 0x4c, 0x45, 0xf3, 0x4f, 0x15, 0x28, 0x5d, 0xf8, 0xee, 0x02, 0x29,
+	0xb1, 0x23, 0x7c, 0x1d, 0xfb, 0x40, 0xf8, 0x9d, 0x91, 0xcb, 0x26, 0xca, 0x25, 0x0e, 0x54, 0xe8,
+	0x82, 0x70, 0xc5, 0x44, 0x81, 0xdd, 0x85, 0xea, 0xb5, 0xef, 0x1a, 0xe3, 0x1b, 0x1a, 0x7f, 0x53,
+	0xaf, 0x5c, 0xfb, 0xee, 0xd1, 0x4d, 0xd2, 0x77, 0x35, 0x65, 0x94, 0xa4, 0xb4, 0x2d, 0x5a, 0x8b,
+	0x16, 0x51, 0xf6, 0x2c, 0xcd, 0x87, 0x0a, 0x89, 0x3b, 0x7b, 0xb2, 0xda, 0x07, 0x92, 0x1e, 0xd0,
+	0x43, 0x68, 0x38, 0xde, 0xc8, 0x74, 0x8c, 0xa9, 0x39, 0x92, 0x43, 0xad, 0x13, 0xe0, 0xc4, 0x1c,
+	0x2d, 0xdb, 0x43, 0xef, 0x42, 0x35, 0x72, 0x9d, 0xd8, 0x74, 0xb4, 0xf4, 0x4a, 0xe4, 0x3a, 0x3d,
+	0x4b, 0xfb, 0xc3, 0x12, 0xb4, 0xf3, 0xaa, 0xc2, 0x76, 0x63, 0xd7, 0x53, 0xa1, 0xfd, 0xf6, 0xd1,
+	0x72, 0xef, 0xe4, 0xf3, 0x17, 0x48, 0x93, 0x71, 0x4c, 0xaf, 0x53, 0xee, 0x5e, 0xdb, 0x19, 0xd5,
+	0x2a, 0x65, 0x55, 0x8b, 0x41, 0x99, 0x24, 0x50, 0xac, 0x39, 0x7d, 0xb3, 0x8f, 0xa1, 0x39, 0x35,
+	0xc3, 0x88, 0x07, 0x86, 0xa8, 0x50, 0xa1, 0x0a, 0x6b, 0x02, 0xd6, 0x8b, 0x35, 0x72, 0x1a, 0xcd,
+	0x62, 0x06, 0x4e, 0xa3, 0x59, 0x32, 0xc3, 0x5a, 0x3a, 0xc3, 0x4d, 0xa8, 0x5c, 0x06, 0x08, 0xab,
+	0x8b, 0x1d, 0xff, 0x32, 0xe8, 0x59, 0xda, 0x39, 0x54, 0x68, 0xa0, 0xac, 0x06, 0xa5, 0xc1, 0xe9,
+	0x99, 0x5a, 0x60, 0x00, 0xd5, 0x93, 0xee, 0xf0, 0xfc, 0x50, 0x57, 0x15, 0x56, 0x87, 0xf2, 0xd9,
+	0x45, 0xef, 0x40, 0x2d, 0xb2, 0x26, 0xd4, 0x5f, 0x0d, 0xce, 0xbb, 0x47, 0x47, 0x87, 0x07, 0x6a,
+	0x99, 0xad, 0xc3, 0x9a, 0xde, 0x1d, 0x1c, 0x1d, 0x1a, 0x7b, 0x87, 0x47, 0xbd, 0x81, 0x5a, 0x67,
+	0x2d, 0x68, 0x08, 0xc0, 0xe1, 0xe0, 0x40, 0x55, 0xb5, 0x97, 0x50, 0x3f, 0xf3, 0x82, 0xe8, 0x1c,
+	0x7d, 0x8a, 0x16, 0x34, 0x06, 0xa7, 0x83, 0x43, 0xe3, 0xec, 0x54, 0x3f, 0x57, 0x0b, 0x58, 0xb5,
+	0xbb, 0xbf, 0x7f, 0x38, 0x1c, 0x0a, 0x80, 0xc2, 0xda, 0x00, 0xe7, 0xfa, 0xab, 0xc1, 0x4b, 0x51,
+	0x2e, 0x22, 0x81, 0xe8, 0x5f, 0x00, 0x4a, 0xda, 0x7f, 0x15, 0xa1, 0xb5, 0xe7, 0xb9, 0xd6, 0xd0,
+	0x31, 0xaf, 0x39, 0xad, 0xc0, 0xa7, 0x50, 0x09, 0x23, 0x33, 0xe2, 0x72, 0x05, 0x36, 0x92, 0x15,
+	0x40, 0x2a, 0x44, 0xe8, 0x02, 0xcf, 0xbe, 0x04, 0x98, 0xda, 0xb6, 0x81, 0x85, 0x59, 0x48, 0xdc,
+	0x6f, 0xef, 0xde, 0xcd, 0x52, 0xa3, 0xc0, 0x88, 0x1a, 0x8d, 0xa9, 0x6d, 0x0f, 0x89, 0x8e, 0xfd,
+	0x00, 0x18, 0x8a, 0x90, 0x71, 0x65, 0xda, 0xce, 0x2c, 0xe0, 0xc6, 0xc8, 0x9b, 0xb9, 0x91, 0x94,
+	0x15, 0x15, 0x31, 0x2f, 0x04, 0x62, 0x1f, 0xe1, 0x6c, 0x1b, 0x36, 0x7c, 0x1e, 0x4c, 0x4d, 0x97,
+	0xbb, 0x91, 0x31, 0xb9, 0x31, 0x68, 0xdf, 0x28, 0x93, 0xc0, 0xad, 0x27, 0x88, 0xe3, 0x1b, 0xdc,
+	0x17, 0xd1, 0xc8, 0x7c, 0x3b, 0xe3, 0x33, 0x6e, 0xc8, 0x23, 0x49, 0x45, 0xaf, 0x51, 0xb9, 0x67,
+	0xb1, 0x4f, 0xa0, 0x65, 0x8e, 0xc7, 0x01, 0x1f, 0x9b, 0x91, 0x17, 0xc4, 0xda, 0x50, 0xd1, 0x9b,
+	0x29, 0xb0, 0x67, 0xb1, 0x2f, 0xe0, 0xae, 0x39, 0x42, 0xbc, 0xe7, 0xf3, 0xc0, 0xf0, 0xbd, 0x20,
+	0x32, 0x04, 0x23, 0x6a, 0x44, 0xcc, 0x08, 0x79, 0xea, 0xf3, 0x00, 0xb9, 0x4f, 0xf3, 0x62, 0x3f,
+	0x85, 0x2d, 0xd3, 0x32, 0x7c, 0x33, 0x88, 0x5c, 0xbe, 0x58, 0x4f, 0x98, 0xb4, 0x7b, 0xa6, 0x75,
+	0x26, 0x08, 0x72, 0x75, 0xb5, 0x7f, 0x2e, 0x41, 0x03, 0x39, 0xd4, 0x8d, 0xa2, 0x20, 0x4c, 0x25,
+	0x56, 0x99, 0xdb, 0x0c, 0x50, 0xf4, 0x84, 0x3d, 0xc3, 0x4f, 0xd6, 0x81, 0x7a, 0xf4, 0xc6, 0xf8,
+	0xd6, 0xc0, 0x53, 0x9c, 0x10, 0xee, 0x6a, 0xf4, 0xe6, 0x77, 0xfa, 0xe2, 0x20, 0xb7, 0x20, 0xdd,
+	0x9f, 0x40, 0x6b, 0x62, 0x06, 0xd6, 0x8d, 0x19, 0x70, 0xc1, 0x3a, 0x61, 0x1a, 0x9a, 0x31, 0x90,
+	0xf8, 0x96, 0x9c, 0xf6, 0xaa, 0xd9, 0xd3, 0xde, 0x43, 0x68, 0x04, 0xe6, 0x8d, 0x21, 0x30, 0x42,
+	0xd0, 0xeb, 0x81, 0x79, 0x23, 0xe4, 0xf9, 0x63, 0x68, 0xfa, 0x66, 0x80, 0x6b, 0x22, 0x06, 0x2d,
+	0x66, 0xba, 0x26, 0x60, 0x42, 0x6b, 0xe6, 0x15, 0xab, 0xb1, 0xa8, 0x58, 0x77, 0xa0, 0x62, 0x3a,
+	0xb6, 0x19, 0xd2, 0x11, 0xa0, 0xa1, 0x8b, 0x02, 0x7a, 0x20, 0x7e, 0xe0, 0x4d, 0xed, 0x70, 0xd4,
+	0x59, 0x13, 0xab, 0x28, 0x8b, 0xec, 0x31, 0x00, 0x47, 0x87, 0xcb, 0x20, 0xd7, 0xba, 0x49, 0x95,
+	0x1a, 0x04, 0x21, 0x5d, 0xf8, 0x12, 0x80, 0x56, 0x40, 0x30, 0xbf, 0x95, 0x97, 0x47, 0xe4, 0x34,
+	0xf2, 0x5f, 0xca, 0xa3, 0x17, 0x7f, 0xb2, 0xdf, 0x86, 0xf5, 0x4b, 0xcf, 0xb5, 0x8c, 0x10, 0x15,
+	0x40, 0x1c, 0x8c, 0xda, 0x64, 0xf7, 0x72, 0xa2, 0x9c, 0xa8, 0xc7, 0x71, 0x41, 0x6f, 0x5d, 0x66,
+	0x01, 0x7b, 0x4d, 0x80, 0xb4, 0xae, 0x76, 0x80, 0xfe, 0x9f, 0xcb, 0x03, 0x7b, 0x94, 0xae, 0xed,
+	0x0e, 0x00, 0x89, 0xbc, 0x89, 0x25, 0x69, 0x55, 0x37, 0xb2, 0x03, 0x23, 0x32, 0xbd, 0xe1, 0xc4,
+	0x9f, 0xda, 0x3e, 0xac, 0x1f, 0xf0, 0x6b, 0x7b, 0xc4, 0x3f, 0xa4, 0x91, 0xbf, 0x57, 0x60, 0x5d,
+	0x98, 0xcd, 0x0f, 0x68, 0x85, 0xfd, 0x10, 0xd8, 0x74, 0xe6, 0x44, 0xf6, 0xc8, 0x0c, 0x23, 0x23,
+	0x74, 0x3d, 0xcf, 0xb7, 0x5d, 0x71, 0x88, 0xaa, 0xeb, 0x1b, 0x09, 0x66, 0x28, 0x11, 0xb8, 0x46,
+	0x13, 0xee, 0x38, 0x9e, 0x11, 0xd9, 0x53, 0x2e, 0xd5, 0xba, 0x41, 0x90, 0x73, 0x7b, 0xca, 0xd9,
+	0x53, 0x68, 0x93, 0x13, 0x79, 0x65, 0x3b, 0x11, 0x0f, 0xb0, 0xa5, 0x32, 0xb5, 0xd4, 0x42, 0xe8,
+	0x8b, 0x18, 0xa8, 0xfd, 0x02, 0x5a, 0x68, 0xea, 0x3f, 0x64, 0xdc, 0x19, 0x77, 0xb5, 0x98, 0x75,
+	0x57, 0xb5, 0x5f, 0x95, 0xa1, 0x7d, 0xf1, 0xe6, 0x03, 0x5b, 0x7f, 0x00, 0xf5, 0xeb, 0x37, 0xb9,
+	0xe6, 0x6b, 0x54, 0xee, 0x59, 0xec, 0xbb, 0xd0, 0xbe, 0x8e, 0xb8, 0x6f, 0x58, 0xfc, 0xda, 0xc8,
+	0x6e, 0x42, 0x4d, 0x84, 0x1e, 0xf0, 0x6b, 0x21, 0xfb, 0x0f, 0xa0, 0x1e, 0x06, 0xa3, 0xac, 0x3d,
+	0xab, 0x85, 0xc1, 0x28, 0xd6, 0xc7, 0xd4, 0x0f, 0x69, 0x4a, 0x3f, 0x04, 0x4d, 0x41, 0x14, 0x39,
+	0xd2, 0x70, 0xe1, 0x27, 0x41, 0xbc, 0x50, 0x5a, 0x27, 0xfc, 0x44, 0x0f, 0xce, 0xe1, 0x66, 0xe0,
+	0x22, 0x5f, 0xeb, 0xc4, 0xd7, 0xa4, 0x8c, 0xad, 0xfa, 0x81, 0xf7, 0xe6, 0x96, 0x14, 0xb1, 0xae,
+	0x8b, 0x02, 0xb6, 0x11, 0x84, 0x23, 0x52, 0xc0, 0xba, 0x8e, 0x9f, 0xe8, 0x45, 0x39, 0xbb, 0x53,
+	0x3b, 0x0c, 0x49, 0xfb, 0xea, 0xba, 0x2c, 0x11, 0xfc, 0x19, 0xc1, 0x9b, 0x12, 0x4e, 0x25, 0xb6,
+	0x05, 0x8d, 0x99, 0xe5, 0x1b, 0x23, 0x23, 0x9c, 0x4d, 0x49, 0xe9, 0xea, 0x7a, 0x6d, 0x66, 0xf9,
+	0xfb, 0xc3, 0xd9, 0x14, 0xb7, 0x7d, 0xd7, 0x33, 0xcc, 0x31, 0x27, 0x95, 0xaa, 0xeb, 0x15, 0xd7,
+	0xeb, 0x8e, 0xc9, 0xf5, 0x1a, 0x5f, 0xfa, 0x9d, 0x75, 0xd1, 0xe9, 0xf8, 0x92, 0x26, 0x87, 0x54,
+	0xaa, 0x98, 0x0a, 0x46, 0x8d, 0xd0, 0xc7, 0xb1, 0xa7, 0x76, 0xd4, 0xd9, 0x20, 0x98, 0x28, 0xa0,
+	0x8d, 0x43, 0xfb, 0xda, 0x61, 0x04, 0xa4, 0x6f, 0xe4, 0x24, 0xfe, 0x1b, 0x8e, 0x77, 0xd3, 0xd9,
+	0x94, 0x06, 0xc3, 0x0b, 0xa2, 0xbe, 0x77, 0x83, 0x36, 0x8c, 0x50, 0x13, 0x0c, 0xc4, 0xdc, 0x91,
+	0x2e, 0xad, 0x17, 0x44, 0xc7, 0xf6, 0x78, 0xa2, 0xfd, 0xb5, 0x02, 0xcd, 0x8b, 0xc8, 0xfe, 0x10,
+	0x29, 0xd8, 0x84, 0x8a, 0x6d, 0xa0, 0x57, 0x28, 0x83, 0x67, 0x36, 0x7a, 0xa6, 0x9b, 0x50, 0xf1,
+	0x08, 0x28, 0x84, 0xbf, 0x4c, 0xee, 0x3f, 0x93, 0x2e, 0x95, 0x70, 0x7f, 0xe8, 0x9b, 0xa6, 0x88,
+	0x3e, 0x53, 0xbc, 0xce, 0x54, 0xc8, 0x9c, 0x2a, 0xaa, 0xb9, 0x53, 0xc5, 0xef, 0x42, 0xeb, 0x82,
+	0x47, 0x93, 0x0f, 0x19, 0x2e, 0xb2, 0x83, 0xf3, 0x40, 0xb8, 0xe1, 0xe2, 0xdc, 0x53, 0x47, 0x00,
+	0xfa, 0xe1, 0xda, 0xdf, 0x28, 0x00, 0x68, 0xe9, 0xba, 0x16, 0x79, 0x01, 0x0b, 0x3b, 0xa6, 0xb2,
+	0x64, 0xc7, 0x7c, 0x08, 0x0d, 0x77, 0x36, 0xa5, 0x2d, 0x2f, 0x94, 0x6a, 0x50, 0x77, 0x67, 0x53,
+	0xdc, 0xe3, 0xa8, 0x37, 0xb1, 0x9d, 0xc6, 0xbc, 0xa8, 0xe8, 0x75, 0x02, 0x20, 0x3f, 0x3e, 0x82,
+	0xb5, 0x78, 0xd7, 0x44, 0xb4, 0x38, 0x50, 0x82, 0x04, 0xcd, 0x11, 0xa0, 0x8f, 0x29, 0x58, 0x14,
+	0x13, 0x9c, 0x98, 0x23, 0xed, 0xd7, 0x75, 0xe1, 0xb8, 0x7c, 0x08, 0x43, 0xbe, 0x0b, 0xe5, 0x29,
+	0x86, 0xd5, 0x8a, 0xf9, 0xd8, 0x0e, 0x36, 0x7b, 0xe2, 0x59, 0x5c, 0x27, 0x2c, 0xee, 0x64, 0xe6,
+	0x28, 0xb2, 0xaf, 0xb9, 0xd8, 0x23, 0xe4, 0x5c, 0xd6, 0x04, 0x8c, 0xf6, 0x01, 0x5c, 0xb4, 0xa9,
+	0x6d, 0x4f, 0x3d, 0x37, 0x3e, 0x1a, 0x8b, 0x12, 0xca, 0xe6, 0x0c, 0x2d, 0x81, 0x63, 0xde, 0xc6,
+	0x2e, 0xc9, 0xcc, 0x3f, 0xc0, 0x22, 0x1a, 0x4a, 0xcb, 0xbb, 0x71, 0x25, 0x52, 0xa8, 0x75, 0x03,
+	0x21, 0x02, 0xfd, 0x11, 0xac, 0xcd, 0x42, 0x6e, 0x8c, 0xcc, 0x20, 0xb0, 0x79, 0x20, 0x95, 0x1c,
+	0x66, 0x21, 0xdf, 0x17, 0x10, 0x1a, 0x55, 0xe0, 0x1b, 0xb6, 0x1b, 0xf1, 0x00, 0x8f, 0x21, 0x72,
+	0x0b, 0x36, 0x03, 0xbf, 0x27, 0x41, 0x68, 0x89, 0x88, 0xc4, 0x37, 0x22, 0x33, 0x18, 0xf3, 0x28,
+	0xec, 0x34, 0x9e, 0x94, 0x70, 0xfb, 0x47, 0x22, 0xff, 0x5c, 0xc0, 0x30, 0x2c, 0x88, 0x54, 0xd7,
+	0xa6, 0x63, 0x5b, 0xa6, 0x8c, 0xc7, 0xb5, 0x77, 0xef, 0x67, 0x99, 0xd1, 0x0d, 0xfc, 0x0b, 0x89,
+	0xa6, 0x1e, 0xe2, 0x02, 0xeb, 0xc2, 0x3a, 0xd6, 0x35, 0x1d, 0x27, 0xe9, 0x62, 0x8d, 0xaa, 0x3f,
+	0x98, 0xab, 0xde, 0x75, 0x1c, 0xd9, 0x9f, 0xde, 0x32, 0xb3, 0x45, 0xb1, 0xdd, 0xdb, 0x53, 0x33,
+	0xb8, 0xed, 0x34, 0xa5, 0xf6, 0x8a, 0x22, 0x7b, 0x01, 0xaa, 0xfc, 0x34, 0x02, 0x1e, 0x72, 0x87,
+	0x8f, 0x22, 0xb9, 0xab, 0x3f, 0xcc, 0xb6, 0x7e, 0x26, 0x68, 0x74, 0x49, 0xa2, 0xaf, 0xfb, 0x79,
+	0x00, 0xfb, 0x0a, 0x5a, 0xe8, 0x6c, 0x1a, 0xde, 0xb5, 0x14, 0xa6, 0xf6, 0xe2, 0x0c, 0xd1, 0xe9,
+	0x3c, 0xbd, 0x26, 0xc9, 0xd2, 0xd7, 0xae, 0xd2, 0x02, 0x3b, 0x00, 0xf5, 0xcd, 0xd4, 0x8e, 0x8c,
+	0x89, 0x19, 0x4e, 0x0c, 0xdf, 0x73, 0xec, 0xd1, 0x2d, 0x19, 0xae, 0xf6, 0xee, 0x56, 0xb6, 0xfe,
+	0xcf, 0xa7, 0x76, 0x74, 0x6c, 0x86, 0x93, 0x33, 0xa2, 0xd0, 0xdb, 0x6f, 0x72, 0x65, 0x5c, 0x4d,
+	0x9c, 0x82, 0x6b, 0x19, 0xf6, 0x78, 0xea, 0x4b, 0x3b, 0x07, 0x02, 0xd4, 0x1b, 0x4f, 0x7d, 0x5c,
+	0x2a, 0xd2, 0x24, 0x52, 0x4f, 0x2f, 0xb2, 0xaf, 0xa4, 0xdd, 0x6b, 0xa2, 0x3a, 0xa1, 0x8a, 0x22,
+	0x0c, 0xbd, 0x61, 0x64, 0x35, 0x89, 0x61, 0x68, 0x08, 0x01, 0x94, 0xb6, 0x70, 0xdd, 0x74, 0x1c,
+	0x92, 0xc5, 0xb0, 0x4b, 0x60, 0x54, 0xbf, 0xa9, 0xed, 0x1a, 0x28, 0xec, 0xa1, 0xb4, 0x8b, 0xf5,
+	0xa9, 0x4d, 0x9b, 0x5e, 0x88, 0xe3, 0x71, 0x32, 0xb2, 0x23, 0x4c, 0x23, 0x38, 0xa9, 0xe8, 0xa0,
+	0xdf, 0x6d, 0x8e, 0x5e, 0xf3, 0x20, 0x34, 0xc8, 0xa5, 0x22, 0xc1, 0xbf, 0x2b, 0x7a, 0x92, 0x88,
+	0x33, 0x1e, 0x08, 0xe1, 0xff, 0x02, 0x1a, 0x8e, 0x39, 0xf2, 0x8d, 0x00, 0xa5, 0xe7, 0x1e, 0xf1,
+	0xe6, 0x4e, 0xee, 0x18, 0x60, 0x8e, 0x7c, 0x1d, 0x45, 0xa7, 0xee, 0xc8, 0x2f, 0xac, 0x62, 0x5a,
+	0x86, 0x5c, 0xd3, 0xfb, 0x8b, 0x55, 0xba, 0xd6, 0x50, 0x2c, 0x66, 0xdd, 0x94, 0x5f, 0x18, 0x81,
+	0x36, 0x2d, 0xe1, 0x9f, 0x75, 0x48, 0xb5, 0x59, 0xbe, 0x02, 0x5a, 0x2d, 0xbd, 0x6a, 0xd2, 0xbf,
+	0xf6, 0xef, 0x45, 0x68, 0xd3, 0x59, 0xf6, 0x43, 0xac, 0x83, 0xdc, 0x71, 0xe5, 0xb1, 0x32, 0xb3,
+	0xe3, 0xca, 0x83, 0x2d, 0xee, 0xb8, 0x8f, 0x00, 0x7c, 0x63, 0x1a, 0xcd, 0x0c, 0x0b, 0xfd, 0x55,
+	0x61, 0xdd, 0xeb, 0xfe, 0x49, 0x34, 0x3b, 0x40, 0x87, 0x35, 0xb6, 0xfa, 0x95, 0x65, 0x56, 0xbf,
+	0xba, 0xdc, 0xea, 0xd7, 0xb2, 0x56, 0x1f, 0x17, 0x4a, 0xb8, 0xbc, 0x21, 0xed, 0x7b, 0xe2, 0x70,
+	0x29, 0xbc, 0xe0, 0x21, 0x42, 0x52, 0x02, 0x8b, 0x08, 0x1a, 0x19, 0x82, 0x03, 0x22, 0xc8, 0x3b,
+	0xcd, 0x20, 0x1c, 0xb2, 0xd4, 0x69, 0x4e, 0xea, 0x0b, 0x47, 0x7f, 0x2d, 0x53, 0x5f, 0xb8, 0xfa,
+	0x8f, 0x01, 0xae, 0x1c, 0xef, 0xc6, 0xb8, 0x34, 0x43, 0x6e, 0xc9, 0xbd, 0xbf, 0x81, 0x90, 0x3d,
+	0x04, 0x68, 0xff, 0x5d, 0x82, 0x32, 0x72, 0x2f, 0x17, 0xf1, 0x6e, 0xc8, 0x88, 0xf7, 0x17, 0x50,
+	0xb5, 0xc8, 0x8d, 0x95, 0xe9, 0x8d, 0x44, 0xe5, 0xe6, 0x9c, 0x5b, 0x8c, 0xf6, 0x0b, 0x42, 0xac,
+	0x72, 0x49, 0x3e, 0x6b, 0xa7, 0x92, 0xaf, 0x32, 0xe7, 0xc9, 0x62, 0x15, 0x41, 0xc8, 0xbe, 0x0f,
+	0x65, 0x74, 0xed, 0x3a, 0xd5, 0xbc, 0xdb, 0x9e, 0x73, 0x20, 0x31, 0xef, 0x80, 0x44, 0xec, 0x73,
+	0xa8, 0x90, 0xa3, 0x36, 0x9f, 0xfd, 0xc8, 0x7b, 0x84, 0x98, 0x79, 0x21, 0x32, 0xf6, 0x19, 0x94,
+	0xae, 0x23, 0x5b, 0x26, 0x3d, 0x12, 0x19, 0xcd, 0xfa, 0x0d, 0x94, 0x5e, 0x89, 0x6c, 0x1a, 0x06,
+	0x8f, 0x26, 0x9d, 0xc6, 0xdc, 0x30, 0xb2, 0x9b, 0x36, 0x0d, 0x83, 0x47, 0x13, 0x24, 0xc6, 0x53,
+	0x44, 0x07, 0xf2, 0xc4, 0xb9, 0x0d, 0x0d, 0x89, 0x91, 0x88, 0x7d, 0x09, 0xb5, 0xb1, 0x38, 0x53,
+	0xd0, 0xfa, 0xac, 0xed, 0x76, 0x62, 0xfa, 0xf9, 0xa3, 0x06, 0x66, 0x61, 0x24, 0x29, 0xce, 0xd4,
+	0x46, 0x15, 0xe8, 0x34, 0xf3, 0x33, 0xcd, 0xeb, 0x05, 0xce, 0x94, 0xc8, 0x96, 0x04, 0xa9, 0xd0,
+	0x95, 0x71, 0x32, 0xa1, 0x9c, 0xb2, 0xe3, 0xf6, 0x2c, 0x3c, 0xef, 0xa4, 0x8a, 0xa4, 0xfd, 0x79,
+	0x11, 0xca, 0xe4, 0xb5, 0xce, 0x07, 0xb2, 0xee, 0x43, 0xcd, 0xf6, 0x8d, 0x4c, 0x2c, 0xab, 0x6a,
+	0xfb, 0x27, 0x66, 0x28, 0x14, 0x80, 0xa2, 0x57, 0x22, 0xd0, 0x2a, 0x0a, 0xe9, 0x21, 0x54, 0x6c,
+	0xa0, 0xa2, 0x80, 0xd0, 0x70, 0xe4, 0xf9, 0x5c, 0x6e, 0x9e, 0xa2, 0x40, 0x5e, 0x20, 0xe7, 0x81,
+	0xd4, 0x20, 0xfa, 0x4e, 0x7c, 0x1b, 0xea, 0x50, 0xe8, 0x10, 0xf9, 0x36, 0xd4, 0xe5, 0x23, 0x68,
+	0x5c, 0x06, 0x9e, 0x69, 0xe1, 0x49, 0x85, 0x96, 0xb2, 0xa9, 0xa7, 0x80, 0xf4, 0xe8, 0xdd, 0xc8,
+	0x1e, 0xbd, 0xd3, 0x68, 0x22, 0xe4, 0xa2, 0x89, 0x31, 0x9b, 0xd6, 0x72, 0x6c, 0x42, 0xd3, 0x24,
+	0xb4, 0xa3, 0x85, 0x01, 0xed, 0x9e, 0xa5, 0x35, 0x31, 0x6a, 0x6b, 0x8f, 0x27, 0x03, 0x2f, 0x9a,
+	0xb9, 0xda, 0xcf, 0x64, 0x49, 0x04, 0xd8, 0x1e, 0x40, 0x3d, 0x9a, 0xb9, 0x46, 0x46, 0x5f, 0x6a,
+	0xd1, 0xcc, 0x25, 0x7d, 0xbc, 0x0b, 0x55, 0x3c, 0x17, 0xd8, 0xbe, 0xe4, 0x5a, 0x25, 0x0c, 0x46,
+	0x3d, 0x5f, 0xfb, 0xcb, 0x12, 0x54, 0xa8, 0x01, 0xf6, 0x58, 0xb2, 0x3f, 0x1b, 0x2d, 0x20, 0xa3,
+	0xd5, 0x9b, 0x1b, 0xf6, 0x42, 0x10, 0x54, 0x9c, 0x8b, 0x65, 0x44, 0x8c, 0x0a, 0x89, 0xd2, 0x0a,
+	0xa6, 0xcf, 0x25, 0x7f, 0x2b, 0xd9, 0x95, 0x10, 0xcb, 0x5b, 0x4d, 0x96, 0x77, 0x21, 0xb2, 0x50,
+	0x5b, 0x12, 0x59, 0x78, 0x04, 0xe0, 0x38, 0xe8, 0x7f, 0x10, 0x45, 0x5d, 0xc6, 0x09, 0x9d, 0x9e,
+	0x4f, 0xd8, 0xcc, 0x09, 0xad, 0x31, 0x9f, 0x50, 0xb8, 0x76, 0x6d, 0xc9, 0x77, 0xfc, 0x5c, 0xc1,
+	0x74, 0x97, 0x67, 0x98, 0xee, 0x62, 0x9c, 0x07, 0xcf, 0x02, 0x93, 0x5b, 0xda, 0xf4, 0x3a, 0x2d,
+	0xe9, 0x4d, 0x4c, 0x6e, 0xc9, 0x3e, 0x6d, 0x43, 0xc5, 0xc5, 0xa5, 0xe8, 0xb4, 0xf3, 0xbb, 0x47,
+	0xba, 0x48, 0x94, 0x95, 0xc5, 0x0f, 0xa4, 0x15, 0xaa, 0xb3, 0xbe, 0x84, 0xb6, 0xe7, 0x4b, 0x5a,
+	0x22, 0xd9, 0xab, 0x43, 0x35, 0x9a, 0xb9, 0x2e, 0x77, 0xb4, 0x7f, 0x50, 0x60, 0x4d, 0x06, 0xea,
+	0xc9, 0x85, 0x7e, 0xc7, 0x4a, 0x31, 0x28, 0x63, 0x5a, 0x4b, 0xae, 0x13, 0x7d, 0x2f, 0x8b, 0xf4,
+	0x2e, 0xd1, 0x8a, 0x1f, 0x60, 0x32, 0xf6, 0xc6, 0x88, 0xc3, 0xef, 0x6b, 0xbb, 0x9b, 0xa9, 0x5d,
+	0x0d, 0x23, 0xdb, 0x35, 0x23, 0xdb, 0x73, 0xf5, 0xaa, 0xcb, 0x6f, 0x0e, 0xc2, 0x88, 0x7d, 0x02,
+	0x15, 0x32, 0xe7, 0x9d, 0x6a, 0x3e, 0xc5, 0x4c, 0xb9, 0x2b, 0x5d, 0xe0, 0xb4, 0xef, 0xc1, 0xfa,
+	0xc9, 0x59, 0x7f, 0x98, 0xa9, 0x4f, 0x07, 0x3e, 0x54, 0x4d, 0xdc, 0x2c, 0x4b, 0x94, 0x72, 0xa1,
+	0x92, 0xf6, 0x4b, 0x58, 0x9b, 0x23, 0x93, 0x02, 0xa7, 0xe4, 0x04, 0xee, 0x87, 0x50, 0x9e, 0xfa,
+	0x4e, 0xd8, 0x29, 0xe6, 0xcd, 0xf8, 0x5c, 0x2f, 0x68, 0xe3, 0x90, 0x6c, 0xaf, 0x0a, 0x65, 0x0b,
+	0x13, 0x63, 0x9f, 0x40, 0x03, 0x49, 0x68, 0x70, 0x2b, 0x87, 0xf0, 0x15, 0x34, 0x86, 0x87, 0x47,
+	0xcf, 0x05, 0x11, 0x93, 0x4e, 0xbc, 0x22, 0x13, 0x08, 0xe8, 0xb2, 0x6f, 0x41, 0x3d, 0xe4, 0xe3,
+	0x29, 0x77, 0xe9, 0x5c, 0x82, 0x3e, 0x6f, 0x52, 0xd6, 0xfe, 0x4e, 0x81, 0x36, 0xd6, 0xee, 0xe3,
+	0xc6, 0x9b, 0xf4, 0x83, 0xce, 0x94, 0xe7, 0xc6, 0x73, 0x10, 0xa5, 0xb7, 0x35, 0xb3, 0x22, 0xab,
+	0x80, 0x56, 0xcf, 0xcd, 0x9e, 0xea, 0xab, 0xb6, 0x1b, 0x07, 0x27, 0x6d, 0xf7, 0x79, 0x36, 0x08,
+	0x57, 0xb3, 0xdd, 0xe7, 0x84, 0x52, 0xa1, 0x64, 0xdb, 0x57, 0xf1, 0xc9, 0xde, 0xb6, 0xaf, 0x10,
+	0xe2, 0xd9, 0x57, 0xf1, 0xc9, 0xde, 0xb3, 0xaf, 0xb4, 0xbf, 0x55, 0xa0, 0x92, 0x4c, 0x77, 0x21,
+	0xb3, 0xfc, 0x69, 0x8e, 0xd7, 0x1b, 0x59, 0x5e, 0x53, 0xa5, 0x98, 0xcb, 0x48, 0x18, 0xf2, 0xf1,
+	0xf3, 0x4e, 0x29, 0x4f, 0x98, 0x30, 0x13, 0x09, 0x91, 0x80, 0x3d, 0x87, 0x06, 0xfe, 0x0b, 0x4f,
+	0xa5, 0x9c, 0xdf, 0x40, 0xf2, 0xcc, 0x3b, 0x2e, 0xe8, 0x29, 0xe9, 0x5e, 0x4d, 0x0a, 0x9b, 0xf6,
+	0x15, 0x6c, 0x48, 0x5d, 0x38, 0xc2, 0xf0, 0xc5, 0xa1, 0x1b, 0x05, 0xb7, 0x32, 0x51, 0xa5, 0xc4,
+	0x89, 0x2a, 0xe4, 0xfb, 0x0d, 0xaa, 0x54, 0x14, 0x67, 0xf5, 0x44, 0x49, 0xfb, 0x4f, 0x05, 0x6a,
+	0xb2, 0xf6, 0xb2, 0x3a, 0xab, 0xb3, 0x57, 0x5e, 0xe4, 0x8d, 0x3c, 0x27, 0xcd, 0x5e, 0x89, 0x72,
+	0x5e, 0x8d, 0x92, 0x08, 0x67, 0x5e, 0x3f, 0x2b, 0xf3, 0xfa, 0x29, 0x74, 0xb1, 0x9a, 0xe8, 0x22,
+	0x6e, 0x22, 0x8e, 0x39, 0x7a, 0x3d, 0xf1, 0x1c, 0xe1, 0xa5, 0xd5, 0xf5, 0x14, 0xc0, 0x7e, 0x14,
+	0x07, 0x6d, 0xea, 0x4f, 0x4a, 0x9f, 0xad, 0xa5, 0xe7, 0x9f, 0x05, 0x26, 0xc4, 0xf1, 0x9c, 0xd8,
+	0xa4, 0x35, 0xd2, 0x9c, 0xd0, 0xbf, 0x15, 0xa1, 0x8c, 0x79, 0xbb, 0x5c, 0x26, 0x4e, 0x99, 0xcb,
+	0xc4, 0xbd, 0x5f, 0x96, 0x2b, 0xce, 0xae, 0x95, 0x33, 0xd9, 0xb5, 0x38, 0xc5, 0x54, 0x89, 0x61,
+	0x21, 0xc1, 0xc6, 0x5e, 0xe4, 0x49, 0x21, 0xa4, 0xef, 0x38, 0x43, 0x26, 0x0c, 0x3b, 0x7e, 0xc6,
+	0x41, 0x2b, 0xaa, 0x5d, 0x4f, 0x82, 0x56, 0xb4, 0xc5, 0xca, 0x74, 0x60, 0x43, 0x10, 0xcb, 0x74,
+	0xa0, 0x15, 0x46, 0x82, 0x18, 0x04, 0xb1, 0x15, 0x46, 0x44, 0x9c, 0xcd, 0x14, 0xae, 0xad, 0xce,
+	0x14, 0x36, 0xf3, 0x99, 0xc2, 0x7b, 0x50, 0xb5, 0xdd, 0x6b, 0x1e, 0x44, 0x32, 0xcc, 0x24, 0x4b,
+	0x19, 0xed, 0x6d, 0x0b, 0x29, 0x12, 0xa5, 0x84, 0xc3, 0xeb, 0x29, 0x87, 0x9f, 0xc5, 0x29, 0xc4,
+	0x53, 0xfb, 0x6a, 0x75, 0xc8, 0x3d, 0xef, 0xf5, 0x6b, 0xff, 0xa8, 0x40, 0x55, 0xd4, 0x5a, 0x69,
+	0xec, 0x12, 0xe6, 0x17, 0x97, 0xa4, 0x18, 0x4b, 0x29, 0x03, 0x93, 0xd0, 0x5e, 0x79, 0x2e, 0xb4,
+	0x87, 0x06, 0xa0, 0x92, 0x1a, 0x80, 0xa7, 0x50, 0xf6, 0xec, 0x2b, 0x8c, 0xc8, 0x97, 0x72, 0x0a,
+	0x1d, 0x8f, 0x5d, 0x27, 0xf4, 0x8a, 0x3c, 0xd4, 0x34, 0x9b, 0x87, 0x9a, 0x62, 0x1e, 0x6a, 0x17,
+	0x9a, 0xc9, 0x4d, 0x85, 0x97, 0xfc, 0x36, 0x5e, 0x2c, 0x25, 0xcd, 0xdd, 0xe2, 0x96, 0x1b, 0x5c,
+	0x25, 0x29, 0xb4, 0xe0, 0x4a, 0xbb, 0x80, 0x46, 0x52, 0xe7, 0x37, 0xca, 0x52, 0xca, 0x26, 0x4a,
+	0x49, 0x13, 0x38, 0x16, 0xee, 0xa6, 0x49, 0xbf, 0x32, 0xc7, 0x25, 0xf8, 0xa7, 0x32, 0x54, 0xe8,
+	0x72, 0xc0, 0xbb, 0xf6, 0xc7, 0x27, 0xd0, 0xb4, 0x8d, 0x0c, 0x81, 0x60, 0x2d, 0xd8, 0xfd, 0x84,
+	0x22, 0xf1, 0x0e, 0x4b, 0x59, 0xef, 0x50, 0x4e, 0xae, 0xbc, 0x5c, 0x12, 0x2b, 0x79, 0x49, 0x94,
+	0x4b, 0x54, 0x4d, 0x97, 0x48, 0xa8, 0x7d, 0x2d, 0x51, 0xfb, 0x5d, 0x00, 0x8a, 0x72, 0x1b, 0xbe,
+	0x19, 0x4d, 0xa4, 0x76, 0x6f, 0xce, 0x69, 0x37, 0x9d, 0x3d, 0x1b, 0x44, 0x76, 0x66, 0x46, 0x93,
+	0x9c, 0x2d, 0x6a, 0xcc, 0xd9, 0xa2, 0xac, 0x6e, 0xc3, 0x9c, 0x6e, 0x27, 0x62, 0xb4, 0x36, 0xa7,
+	0xc3, 0x49, 0xc2, 0x23, 0x76, 0xd2, 0xe4, 0x49, 0xb4, 0x95, 0xc6, 0x7e, 0x13, 0x1b, 0xd7, 0xce,
+	0xba, 0x0a, 0x0f, 0xa0, 0x8e, 0x86, 0x9f, 0x7c, 0x85, 0x75, 0xe1, 0x10, 0x61, 0x19, 0xfd, 0x82,
+	0x8c, 0x17, 0xa1, 0xbe, 0x87, 0x17, 0xb1, 0xb1, 0xda, 0x8b, 0x48, 0xe4, 0x91, 0xe5, 0xe4, 0x31,
+	0x88, 0x10, 0xb6, 0x29, 0x64, 0x20, 0x88, 0xd2, 0x04, 0xf4, 0xf8, 0xa6, 0x73, 0x27, 0x9b, 0xaa,
+	0xbe, 0x0b, 0x55, 0x92, 0x97, 0xb0, 0x73, 0x97, 0xb6, 0xfb, 0x0a, 0x0a, 0x0c, 0x45, 0x59, 0xdd,
+	0x09, 0x36, 0x71, 0x4f, 0x34, 0xe1, 0x4e, 0x7a, 0x96, 0xf6, 0x27, 0x65, 0xa8, 0x0f, 0x5d, 0xd3,
+	0x0f, 0x27, 0x5e, 0x84, 0xa6, 0x19, 0xd3, 0x0d, 0x61, 0x64, 0x4e, 0x85, 0x94, 0x96, 0xf4, 0x14,
+	0xc0, 0x34, 0xa8, 0x88, 0x28, 0x48, 0xf1, 0x49, 0x69, 0x21, 0x9f, 0x2d, 0x50, 0xec, 0x29, 0x54,
+	0xc9, 0x9d, 0xc3, 0xc3, 0x7d, 0x29, 0x3b, 0x3f, 0xf2, 0xf6, 0x74, 0x89, 0xc4, 0xa6, 0x70, 0x07,
+	0xc7, 0x8d, 0xa4, 0x34, 0x7f, 0xf5, 0x4f, 0x17, 0x28, 0x6c, 0x8a, 0xee, 0xf6, 0xa1, 0x03, 0x5d,
+	0x5a, 0xb8, 0xfa, 0xa7, 0x4b, 0x24, 0x92, 0x89, 0x9b, 0x48, 0x9d, 0x6a, 0x9e, 0x8c, 0x94, 0x43,
+	0x97, 0x48, 0xe4, 0x3b, 0x2e, 0x18, 0x86, 0xf9, 0x97, 0x50, 0x09, 0x1c, 0xfb, 0x08, 0xca, 0xd7,
+	0xbe, 0x1b, 0x4a, 0xe9, 0xcc, 0x5d, 0x2d, 0x23, 0x04, 0xdb, 0x8d, 0x4f, 0xf9, 0x18, 0x3f, 0x11,
+	0x61, 0xc0, 0x8c, 0x59, 0x49, 0xf4, 0x5c, 0x1e, 0xfc, 0xf1, 0x33, 0x64, 0x5f, 0x40, 0x5d, 0x5e,
+	0x17, 0xc4, 0x04, 0x5d, 0x69, 0xf5, 0x55, 0x41, 0xbd, 0x26, 0x2e, 0x0a, 0x86, 0xec, 0xfb, 0x50,
+	0x97, 0x77, 0x00, 0x31, 0x92, 0x50, 0x5a, 0x72, 0x4d, 0x50, 0x4f, 0x08, 0x90, 0x97, 0x78, 0x13,
+	0x10, 0xf3, 0x09, 0xa5, 0xf9, 0x6b, 0x82, 0xba, 0x40, 0xb1, 0xcf, 0xa0, 0x36, 0x95, 0x5c, 0x6a,
+	0x3d, 0x29, 0x2d, 0x5e, 0x13, 0xd4, 0x63, 0xf4, 0xf6, 0x53, 0x79, 0x87, 0x73, 0x18, 0x8c, 0xd2,
+	0x6c, 0x7b, 0x0d, 0x4a, 0x2f, 0x07, 0x7d, 0x55, 0xc1, 0x8f, 0xee, 0x59, 0x4f, 0x2d, 0x6e, 0xff,
+	0xb1, 0x02, 0xad, 0x5c, 0x2a, 0x10, 0x33, 0xe2, 0x58, 0x78, 0xe5, 0xbe, 0x76, 0xbd, 0x1b, 0x57,
+	0x2d, 0x30, 0x06, 0x6d, 0x04, 0x0c, 0xbc, 0xe8, 0x8c, 0x02, 0x74, 0x91, 0xaa, 0x60, 0x82, 0x1e,
+	0x61, 0x07, 0x48, 0x51, 0x64, 0xf7, 0x80, 0x61, 0xa9, 0xef, 0xdd, 0xf0, 0xa0, 0x6f, 0xde, 0x4a,
+	0x78, 0x29, 0x6e, 0xea, 0x9c, 0xd4, 0x67, 0xac, 0x96, 0x63, 0xc0, 0x81, 0x87, 0x89, 0xea, 0x48,
+	0xad, 0x60, 0xfa, 0x9f, 0x3a, 0xf3, 0xd5, 0xea, 0xf6, 0x1f, 0xa4, 0xf1, 0x6b, 0x31, 0x12, 0x15,
+	0x9a, 0x7b, 0xa7, 0x83, 0x03, 0xa3, 0xdf, 0x1b, 0xbc, 0x34, 0x5e, 0x9d, 0x89, 0xa1, 0xa4, 0x90,
+	0x17, 0xdd, 0x1e, 0x4e, 0x25, 0x07, 0x3b, 0x38, 0xfd, 0x7a, 0xa0, 0x16, 0xf3, 0xb0, 0xbd, 0xee,
+	0xfe, 0x4b, 0xb5, 0xc4, 0xbe, 0x03, 0x1b, 0x99, 0xd6, 0x06, 0x2f, 0x07, 0x48, 0xfa, 0x3f, 0xf1,
+	0x4f, 0xd9, 0xfe, 0x05, 0x34, 0x92, 0x8c, 0x3e, 0xbb, 0x2b, 0x89, 0x87, 0xe7, 0xdd, 0xf3, 0x43,
+	0xa3, 0xbb, 0x7f, 0xde, 0xbb, 0x38, 0x54, 0x0b, 0x73, 0x60, 0x6c, 0xf8, 0xd5, 0x99, 0xaa, 0xb0,
+	0x8f, 0x80, 0x65, 0xc0, 0x4b, 0xda, 0xfe, 0x57, 0x05, 0xea, 0x71, 0x10, 0x9d, 0x75, 0xe0, 0x0e,
+	0x51, 0x9f, 0x9c, 0x1e, 0x60, 0x1b, 0xfd, 0xee, 0x60, 0xff, 0xd0, 0xd0, 0x75, 0xb5, 0xc0, 0x1e,
+	0xc2, 0xfd, 0x14, 0x23, 0x3a, 0x4d, 0x3b, 0x79, 0x00, 0x77, 0x17, 0xab, 0xfd, 0xfc, 0x54, 0x57,
+	0x8b, 0xec, 0x3e, 0x6c, 0x66, 0x50, 0xfa, 0x69, 0xf7, 0x60, 0xbf, 0x3b, 0x3c, 0x57, 0x4b, 0xc9,
+	0x78, 0x09, 0xf1, 0x93, 0x9d, 0x5d, 0xe3, 0x59, 0x17, 0x2f, 0x54, 0x2c, 0x6d, 0xea, 0xbc, 0xbf,
+	0xa7, 0x56, 0x96, 0xa3, 0xba, 0xfd, 0x3d, 0xb5, 0x9a, 0x6f, 0x2c, 0x9e, 0x64, 0x6d, 0xfb, 0x8f,
+	0x30, 0xab, 0x9a, 0x8f, 0x89, 0xb3, 0x2d, 0xb8, 0x47, 0xa4, 0x5d, 0xfd, 0xcc, 0xb8, 0xe8, 0xf6,
+	0x7b, 0x07, 0xc8, 0x17, 0xbc, 0x97, 0xa1, 0x16, 0xd8, 0x23, 0xe8, 0x2c, 0xe2, 0x24, 0x87, 0x95,
+	0xe5, 0x58, 0xc9, 0x83, 0x62, 0x32, 0xba, 0x7c, 0xdd, 0x7e, 0x5f, 0x2d, 0x6d, 0x9f, 0xc2, 0xc6,
+	0x42, 0x68, 0x3d, 0xd7, 0x5a, 0xb7, 0xdf, 0x37, 0xce, 0xbb, 0xfa, 0xd1, 0xe1, 0xf9, 0xd0, 0xe8,
+	0x0e, 0xbe, 0x51, 0x0b, 0xab, 0xb1, 0xfd, 0xbe, 0xaa, 0x6c, 0xff, 0x3e, 0x6c, 0x2e, 0x89, 0xa6,
+	0xb3, 0x27, 0xf0, 0x88, 0x2a, 0x9d, 0xe9, 0xbd, 0x93, 0xae, 0xfe, 0x8d, 0xa1, 0x1f, 0x0e, 0x0f,
+	0xfb, 0x87, 0xfb, 0xe7, 0x46, 0xb7, 0xff, 0x75, 0xf7, 0x9b, 0xa1, 0x5a, 0x58, 0x4d, 0xb1, 0x77,
+	0x78, 0x2e, 0x2e, 0xba, 0x7c, 0x0c, 0x8f, 0x97, 0x53, 0xa0, 0x48, 0xbf, 0xd2, 0x0f, 0xd5, 0xe2,
+	0xb6, 0x03, 0xeb, 0x73, 0x61, 0xf8, 0x44, 0x3a, 0x90, 0xc8, 0x38, 0xbd, 0x38, 0xd4, 0x8d, 0x93,
+	0xee, 0x7e, 0xcc, 0xd5, 0xc7, 0xf0, 0x60, 0x09, 0x32, 0x61, 0xeb, 0x72, 0xf4, 0x8b, 0xd3, 0x7e,
+	0xff, 0xf4, 0x6b, 0xb5, 0xb8, 0xfd, 0x1f, 0x0a, 0xb0, 0xc5, 0xa8, 0x7d, 0x32, 0x93, 0x9f, 0x9f,
+	0xf4, 0xce, 0x8d, 0xe3, 0xee, 0xf0, 0xd8, 0x38, 0x3b, 0xed, 0xf7, 0xf6, 0xbf, 0x31, 0xfa, 0xdd,
+	0x6f, 0x0e, 0xf5, 0x5d, 0xb5, 0xc0, 0x34, 0xf8, 0xce, 0x5b, 0x28, 0x9e, 0x19, 0x5f, 0xaa, 0xca,
+	0x3b, 0x68, 0x76, 0x8d, 0x67, 0x6a, 0x71, 0x35, 0xcd, 0xe1, 0x60, 0xbf, 0x7b, 0x86, 0x34, 0xa5,
+	0x77, 0xd0, 0x60, 0x5f, 0xe5, 0x84, 0xb3, 0x0b, 0x34, 0xb1, 0xbc, 0x56, 0xb6, 0x7f, 0x09, 0xcd,
+	0x6c, 0x10, 0x3e, 0x51, 0x9e, 0x7e, 0x77, 0xff, 0xcc, 0xd0, 0x51, 0xa0, 0x86, 0xc8, 0x94, 0xc2,
+	0x12, 0xc4, 0x0b, 0xd4, 0x2a, 0x25, 0x91, 0xee, 0x14, 0x11, 0xb7, 0x5e, 0xdc, 0x36, 0xa1, 0x99,
+	0x8d, 0xd7, 0xa7, 0x12, 0x7b, 0x60, 0xc8, 0x35, 0x1e, 0x9e, 0x77, 0xf7, 0xfa, 0x39, 0x45, 0x48,
+	0x50, 0x7b, 0xdd, 0xc1, 0xc1, 0xd7, 0xbd, 0x83, 0xf3, 0x63, 0x55, 0x49, 0xac, 0x44, 0x8a, 0xdd,
+	0x3f, 0x7d, 0x35, 0x38, 0x57, 0x8b, 0xbb, 0x7f, 0xa1, 0x00, 0x0c, 0xfa, 0xdd, 0x7d, 0x2f, 0xe0,
+	0x5d, 0xdf, 0x66, 0x2f, 0x81, 0x0d, 0xb9, 0x6b, 0xcd, 0x3d, 0x32, 0xb8, 0x97, 0xee, 0x33, 0x59,
+	0xf8, 0xd6, 0xc3, 0xe5, 0x70, 0x71, 0x9f, 0xba, 0xc0, 0xf6, 0xb2, 0xef, 0x01, 0xe2, 0xb6, 0x72,
+	0xd7, 0xeb, 0x57, 0xb5, 0x40, 0x97, 0xcf, 0xb5, 0xc2, 0x8e, 0xb2, 0xfb, 0x67, 0x2d, 0xa8, 0x0e,
+	0xfa, 0x5d, 0x1c, 0xdb, 0x73, 0xa8, 0x8a, 0x2b, 0xdc, 0x2c, 0x89, 0xe8, 0xe6, 0xae, 0x85, 0x6f,
+	0x6d, 0xce, 0x83, 0xc5, 0x30, 0x0e, 0x00, 0xd2, 0xbb, 0xde, 0xec, 0x6d, 0x3d, 0x6e, 0xdd, 0xcf,
+	0xb4, 0x90, 0xbb, 0x1c, 0x5e, 0x60, 0xc7, 0x00, 0xe9, 0x64, 0xd8, 0x83, 0x94, 0x70, 0xee, 0xc1,
+	0xc3, 0x3b, 0xa7, 0xc4, 0xb6, 0xa1, 0x26, 0x2f, 0x9a, 0xb3, 0xf5, 0xac, 0xb7, 0xf4, 0x92, 0xdf,
+	0x6e, 0xe5, 0xdc, 0x27, 0xad, 0x20, 0x69, 0x29, 0x90, 0xb1, 0x9e, 0x75, 0x87, 0x72, 0xb4, 0x08,
+	0xd0, 0x0a, 0xec, 0x87, 0x50, 0x8f, 0x2f, 0x9d, 0x33, 0x35, 0xe7, 0x16, 0x21, 0x75, 0xde, 0x51,
+	0x4a, 0xc8, 0xc5, 0x61, 0x41, 0xcd, 0x39, 0x3e, 0x39, 0x72, 0x82, 0x68, 0x05, 0xcc, 0x10, 0xc9,
+	0x4b, 0xe8, 0xe9, 0x48, 0xe4, 0x9d, 0xe2, 0x45, 0x62, 0x31, 0x6c, 0x5c, 0xe1, 0x94, 0x58, 0xde,
+	0x24, 0xde, 0xca, 0x09, 0x80, 0x56, 0x60, 0x9f, 0x42, 0x55, 0xdc, 0xfe, 0x66, 0xed, 0x8c, 0x6b,
+	0x85, 0x94, 0x59, 0x57, 0x4b, 0x2b, 0xb0, 0xff, 0x0f, 0xcd, 0xec, 0xa5, 0x70, 0x76, 0x67, 0xc1,
+	0xc3, 0xc2, 0x4a, 0x8b, 0x7e, 0x57, 0x32, 0x57, 0x11, 0x1e, 0x56, 0x73, 0xce, 0x67, 0x6e, 0xf8,
+	0x04, 0xa1, 0xb5, 0xde, 0x58, 0xb8, 0x4a, 0x9f, 0x2e, 0xf9, 0xc2, 0xf5, 0xe3, 0xad, 0x15, 0xae,
+	0x9b, 0x56, 0x60, 0xcf, 0x00, 0xd2, 0x4b, 0xf6, 0x8c, 0xcd, 0xf9, 0x6b, 0x58, 0x77, 0xde, 0x87,
+	0x4b, 0xb8, 0x47, 0xb1, 0x8a, 0xf5, 0xac, 0xdf, 0x96, 0xe3, 0x1e, 0x02, 0xb4, 0x02, 0xdb, 0x81,
+	0x46, 0x72, 0xed, 0x9e, 0xcd, 0x1d, 0x65, 0x91, 0x7e, 0xce, 0xa5, 0xd3, 0x0a, 0xec, 0xc7, 0xc4,
+	0x0b, 0x91, 0xa9, 0xbc, 0x9f, 0xa6, 0x3a, 0x72, 0x2f, 0x1f, 0xe6, 0xe5, 0x70, 0x47, 0x91, 0xd5,
+	0xe8, 0xe1, 0x43, 0xae, 0x5a, 0xf6, 0x29, 0xc4, 0xbc, 0x48, 0xee, 0x28, 0xec, 0x27, 0x34, 0x3e,
+	0xf1, 0x12, 0x82, 0x65, 0x32, 0x2b, 0xf9, 0xc7, 0x11, 0x0b, 0xd2, 0x99, 0xd4, 0x14, 0x8f, 0x23,
+	0x72, 0x35, 0x73, 0xef, 0x25, 0x16, 0x64, 0x6f, 0x47, 0x61, 0xcf, 0x69, 0xa8, 0xf4, 0x5e, 0x22,
+	0x37, 0xd4, 0xec, 0x0b, 0x8a, 0x65, 0xf5, 0xc4, 0x14, 0xe9, 0x69, 0x42, 0xae, 0x5e, 0xf6, 0xb1,
+	0xc2, 0xbc, 0xf8, 0xee, 0x28, 0x6c, 0x17, 0x6a, 0xf2, 0xf9, 0x42, 0x6a, 0x28, 0xf3, 0xef, 0x19,
+	0xe6, 0x24, 0x79, 0x47, 0x61, 0x07, 0xd0, 0xca, 0x3d, 0x70, 0x60, 0x8f, 0x32, 0x35, 0x17, 0xde,
+	0x3d, 0x2c, 0x15, 0xea, 0x84, 0x45, 0xe2, 0x39, 0x44, 0x8e, 0x45, 0xb9, 0x17, 0x12, 0x0b, 0xf2,
+	0xbd, 0xa3, 0xb0, 0x21, 0x3d, 0xfe, 0x98, 0x7b, 0x2c, 0xc2, 0x3e, 0xce, 0x34, 0xb1, 0xfc, 0x21,
+	0xc9, 0x6a, 0x51, 0xdf, 0x51, 0xd8, 0xcf, 0x60, 0x2d, 0x15, 0xf6, 0x90, 0x6d, 0xe5, 0x56, 0x3b,
+	0xf7, 0xcc, 0x64, 0x89, 0xd4, 0x27, 0xfc, 0xa7, 0x87, 0x26, 0x39, 0xfe, 0x67, 0x9f, 0x9e, 0xcc,
+	0x2b, 0xc0, 0x8e, 0xc2, 0xbe, 0x22, 0x1d, 0x93, 0x2f, 0x4f, 0x52, 0x35, 0x5d, 0x78, 0x8d, 0xb2,
+	0xa8, 0x0b, 0x49, 0x9f, 0xf4, 0x7a, 0x24, 0xd7, 0x67, 0xf6, 0x3d, 0x49, 0xda, 0x27, 0x42, 0xb1,
+	0xda, 0x65, 0x95, 0x22, 0x0e, 0xcf, 0xfe, 0x77, 0x00, 0x7c, 0x26, 0xaa, 0x5f, 0x14, 0x38, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5097,6 +6309,9 @@ type NLAApiClient interface {
 	GetEncapInfo(ctx context.Context, in *EncapInfoKey, opts ...grpc.CallOption) (*EncapInfo, error)
 	GetIptun(ctx context.Context, in *IptunKey, opts ...grpc.CallOption) (*Iptun, error)
 	GetBridgeVlanInfo(ctx context.Context, in *BridgeVlanInfoKey, opts ...grpc.CallOption) (*BridgeVlanInfo, error)
+	GetNexthop(ctx context.Context, in *NexthopKey, opts ...grpc.CallOption) (*Nexthop, error)
+	GetRule(ctx context.Context, in *RuleKey, opts ...grpc.CallOption) (*Rule, error)
+	GetMroute(ctx context.Context, in *MrouteKey, opts ...grpc.CallOption) (*Mroute, error)
 	GetLinks(ctx context.Context, in *GetLinksRequest, opts ...grpc.CallOption) (NLAApi_GetLinksClient, error)
 	GetAddrs(ctx context.Context, in *GetAddrsRequest, opts ...grpc.CallOption) (NLAApi_GetAddrsClient, error)
 	GetNeighs(ctx context.Context, in *GetNeighsRequest, opts ...grpc.CallOption) (NLAApi_GetNeighsClient, error)
@@ -5107,6 +6322,9 @@ type NLAApiClient interface {
 	GetEncapInfos(ctx context.Context, in *GetEncapInfosRequest, opts ...grpc.CallOption) (NLAApi_GetEncapInfosClient, error)
 	GetIptuns(ctx context.Context, in *GetIptunsRequest, opts ...grpc.CallOption) (NLAApi_GetIptunsClient, error)
 	GetBridgeVlanInfos(ctx context.Context, in *GetBridgeVlanInfosRequest, opts ...grpc.CallOption) (NLAApi_GetBridgeVlanInfosClient, error)
+	GetNexthops(ctx context.Context, in *GetNexthopsRequest, opts ...grpc.CallOption) (NLAApi_GetNexthopsClient, error)
+	GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (NLAApi_GetRulesClient, error)
+	GetMroutes(ctx context.Context, in *GetMroutesRequest, opts ...grpc.CallOption) (NLAApi_GetMroutesClient, error)
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (NLAApi_GetStatsClient, error)
 }
 
@@ -5258,6 +6476,33 @@ func (c *nLAApiClient) GetBridgeVlanInfo(ctx context.Context, in *BridgeVlanInfo
 	return out, nil
 }
 
+func (c *nLAApiClient) GetNexthop(ctx context.Context, in *NexthopKey, opts ...grpc.CallOption) (*Nexthop, error) {
+	out := new(Nexthop)
+	err := c.cc.Invoke(ctx, "/nlaapi.NLAApi/GetNexthop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLAApiClient) GetRule(ctx context.Context, in *RuleKey, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, "/nlaapi.NLAApi/GetRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLAApiClient) GetMroute(ctx context.Context, in *MrouteKey, opts ...grpc.CallOption) (*Mroute, error) {
+	out := new(Mroute)
+	err := c.cc.Invoke(ctx, "/nlaapi.NLAApi/GetMroute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nLAApiClient) GetLinks(ctx context.Context, in *GetLinksRequest, opts ...grpc.CallOption) (NLAApi_GetLinksClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[1], "/nlaapi.NLAApi/GetLinks", opts...)
 	if err != nil {
@@ -5578,8 +6823,104 @@ func (x *nLAApiGetBridgeVlanInfosClient) Recv() (*BridgeVlanInfo, error) {
 	return m, nil
 }
 
+func (c *nLAApiClient) GetNexthops(ctx context.Context, in *GetNexthopsRequest, opts ...grpc.CallOption) (NLAApi_GetNexthopsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[11], "/nlaapi.NLAApi/GetNexthops", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nLAApiGetNexthopsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NLAApi_GetNexthopsClient interface {
+	Recv() (*Nexthop, error)
+	grpc.ClientStream
+}
+
+type nLAApiGetNexthopsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nLAApiGetNexthopsClient) Recv() (*Nexthop, error) {
+	m := new(Nexthop)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nLAApiClient) GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (NLAApi_GetRulesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[12], "/nlaapi.NLAApi/GetRules", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nLAApiGetRulesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NLAApi_GetRulesClient interface {
+	Recv() (*Rule, error)
+	grpc.ClientStream
+}
+
+type nLAApiGetRulesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nLAApiGetRulesClient) Recv() (*Rule, error) {
+	m := new(Rule)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nLAApiClient) GetMroutes(ctx context.Context, in *GetMroutesRequest, opts ...grpc.CallOption) (NLAApi_GetMroutesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[13], "/nlaapi.NLAApi/GetMroutes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nLAApiGetMroutesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NLAApi_GetMroutesClient interface {
+	Recv() (*Mroute, error)
+	grpc.ClientStream
+}
+
+type nLAApiGetMroutesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nLAApiGetMroutesClient) Recv() (*Mroute, error) {
+	m := new(Mroute)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *nLAApiClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (NLAApi_GetStatsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[11], "/nlaapi.NLAApi/GetStats", opts...)
+	stream, err := c.cc.NewStream(ctx, &_NLAApi_serviceDesc.Streams[14], "/nlaapi.NLAApi/GetStats", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5625,6 +6966,9 @@ type NLAApiServer interface {
 	GetEncapInfo(context.Context, *EncapInfoKey) (*EncapInfo, error)
 	GetIptun(context.Context, *IptunKey) (*Iptun, error)
 	GetBridgeVlanInfo(context.Context, *BridgeVlanInfoKey) (*BridgeVlanInfo, error)
+	GetNexthop(context.Context, *NexthopKey) (*Nexthop, error)
+	GetRule(context.Context, *RuleKey) (*Rule, error)
+	GetMroute(context.Context, *MrouteKey) (*Mroute, error)
 	GetLinks(*GetLinksRequest, NLAApi_GetLinksServer) error
 	GetAddrs(*GetAddrsRequest, NLAApi_GetAddrsServer) error
 	GetNeighs(*GetNeighsRequest, NLAApi_GetNeighsServer) error
@@ -5635,6 +6979,9 @@ type NLAApiServer interface {
 	GetEncapInfos(*GetEncapInfosRequest, NLAApi_GetEncapInfosServer) error
 	GetIptuns(*GetIptunsRequest, NLAApi_GetIptunsServer) error
 	GetBridgeVlanInfos(*GetBridgeVlanInfosRequest, NLAApi_GetBridgeVlanInfosServer) error
+	GetNexthops(*GetNexthopsRequest, NLAApi_GetNexthopsServer) error
+	GetRules(*GetRulesRequest, NLAApi_GetRulesServer) error
+	GetMroutes(*GetMroutesRequest, NLAApi_GetMroutesServer) error
 	GetStats(*GetStatsRequest, NLAApi_GetStatsServer) error
 }
 
@@ -5681,6 +7028,15 @@ func (*UnimplementedNLAApiServer) GetIptun(ctx context.Context, req *IptunKey) (
 func (*UnimplementedNLAApiServer) GetBridgeVlanInfo(ctx context.Context, req *BridgeVlanInfoKey) (*BridgeVlanInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeVlanInfo not implemented")
 }
+func (*UnimplementedNLAApiServer) GetNexthop(ctx context.Context, req *NexthopKey) (*Nexthop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNexthop not implemented")
+}
+func (*UnimplementedNLAApiServer) GetRule(ctx context.Context, req *RuleKey) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (*UnimplementedNLAApiServer) GetMroute(ctx context.Context, req *MrouteKey) (*Mroute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMroute not implemented")
+}
 func (*UnimplementedNLAApiServer) GetLinks(req *GetLinksRequest, srv NLAApi_GetLinksServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLinks not implemented")
 }
@@ -5711,6 +7067,15 @@ func (*UnimplementedNLAApiServer) GetIptuns(req *GetIptunsRequest, srv NLAApi_Ge
 func (*UnimplementedNLAApiServer) GetBridgeVlanInfos(req *GetBridgeVlanInfosRequest, srv NLAApi_GetBridgeVlanInfosServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetBridgeVlanInfos not implemented")
 }
+func (*UnimplementedNLAApiServer) GetNexthops(req *GetNexthopsRequest, srv NLAApi_GetNexthopsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetNexthops not implemented")
+}
+func (*UnimplementedNLAApiServer) GetRules(req *GetRulesRequest, srv NLAApi_GetRulesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRules not implemented")
+}
+func (*UnimplementedNLAApiServer) GetMroutes(req *GetMroutesRequest, srv NLAApi_GetMroutesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetMroutes not implemented")
+}
 func (*UnimplementedNLAApiServer) GetStats(req *GetStatsRequest, srv NLAApi_GetStatsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
@@ -5956,6 +7321,60 @@ func _NLAApi_GetBridgeVlanInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NLAApi_GetNexthop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NexthopKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLAApiServer).GetNexthop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nlaapi.NLAApi/GetNexthop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLAApiServer).GetNexthop(ctx, req.(*NexthopKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLAApi_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLAApiServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nlaapi.NLAApi/GetRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLAApiServer).GetRule(ctx, req.(*RuleKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLAApi_GetMroute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MrouteKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLAApiServer).GetMroute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nlaapi.NLAApi/GetMroute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLAApiServer).GetMroute(ctx, req.(*MrouteKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NLAApi_GetLinks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetLinksRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6166,6 +7585,69 @@ func (x *nLAApiGetBridgeVlanInfosServer) Send(m *BridgeVlanInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _NLAApi_GetNexthops_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetNexthopsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NLAApiServer).GetNexthops(m, &nLAApiGetNexthopsServer{stream})
+}
+
+type NLAApi_GetNexthopsServer interface {
+	Send(*Nexthop) error
+	grpc.ServerStream
+}
+
+type nLAApiGetNexthopsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nLAApiGetNexthopsServer) Send(m *Nexthop) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NLAApi_GetRules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NLAApiServer).GetRules(m, &nLAApiGetRulesServer{stream})
+}
+
+type NLAApi_GetRulesServer interface {
+	Send(*Rule) error
+	grpc.ServerStream
+}
+
+type nLAApiGetRulesServer struct {
+	grpc.ServerStream
+}
+
+func (x *nLAApiGetRulesServer) Send(m *Rule) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NLAApi_GetMroutes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMroutesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NLAApiServer).GetMroutes(m, &nLAApiGetMroutesServer{stream})
+}
+
+type NLAApi_GetMroutesServer interface {
+	Send(*Mroute) error
+	grpc.ServerStream
+}
+
+type nLAApiGetMroutesServer struct {
+	grpc.ServerStream
+}
+
+func (x *nLAApiGetMroutesServer) Send(m *Mroute) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _NLAApi_GetStats_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetStatsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6239,6 +7721,18 @@ var _NLAApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBridgeVlanInfo",
 			Handler:    _NLAApi_GetBridgeVlanInfo_Handler,
 		},
+		{
+			MethodName: "GetNexthop",
+			Handler:    _NLAApi_GetNexthop_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _NLAApi_GetRule_Handler,
+		},
+		{
+			MethodName: "GetMroute",
+			Handler:    _NLAApi_GetMroute_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6296,6 +7790,21 @@ var _NLAApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _NLAApi_GetBridgeVlanInfos_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetNexthops",
+			Handler:       _NLAApi_GetNexthops_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRules",
+			Handler:       _NLAApi_GetRules_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetMroutes",
+			Handler:       _NLAApi_GetMroutes_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetStats",
 			Handler:       _NLAApi_GetStats_Handler,
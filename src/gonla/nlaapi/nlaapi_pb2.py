@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='nlaapi',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\x0cnlaapi.proto\x12\x06nlaapi\"N\n\x08NlMsghdr\x12\x0b\n\x03len\x18\x01 \x01(\r\x12\x0c\n\x04type\x18\x02 \x01(\r\x12\r\n\x05\x66lags\x18\x03 \x01(\r\x12\x0b\n\x03seq\x18\x04 \x01(\r\x12\x0b\n\x03pid\x18\x05 \x01(\r\"m\n\x0eNetlinkMessage\x12 \n\x06header\x18\x01 \x01(\x0b\x32\x10.nlaapi.NlMsghdr\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x1d\n\x03src\x18\x04 \x01(\x0e\x32\x10.nlaapi.NlMsgSrc\"\x15\n\x13NetlinkMessageReply\"\x13\n\x11MonNetlinkRequest\"\xf7\x01\n\x08NlMsgUni\x12\x1c\n\x04link\x18\x01 \x01(\x0b\x32\x0c.nlaapi.LinkH\x00\x12\x1c\n\x04\x61\x64\x64r\x18\x02 \x01(\x0b\x32\x0c.nlaapi.AddrH\x00\x12\x1e\n\x05neigh\x18\x03 \x01(\x0b\x32\r.nlaapi.NeighH\x00\x12\x1e\n\x05route\x18\x04 \x01(\x0b\x32\r.nlaapi.RouteH\x00\x12\x1c\n\x04node\x18\x05 \x01(\x0b\x32\x0c.nlaapi.NodeH\x00\x12\x1a\n\x03vpn\x18\x06 \x01(\x0b\x32\x0b.nlaapi.VpnH\x00\x12.\n\x0c\x62r_vlan_info\x18\x07 \x01(\x0b\x32\x16.nlaapi.BridgeVlanInfoH\x00\x42\x05\n\x03msg\"\x83\x01\n\x13NetlinkMessageUnion\x12 \n\x06header\x18\x01 \x01(\x0b\x32\x10.nlaapi.NlMsghdr\x12\x1d\n\x03msg\x18\x02 \x01(\x0b\x32\x10.nlaapi.NlMsgUni\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x1d\n\x03src\x18\x04 \x01(\x0e\x32\x10.nlaapi.NlMsgSrc\"7\n\rModVpnRequest\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x18\n\x03vpn\x18\x02 \x01(\x0b\x32\x0b.nlaapi.Vpn\"\r\n\x0bModVpnReply\"\x11\n\x0fModNetlinkReply\"\x1f\n\x0fGetLinksRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x1f\n\x0fGetAddrsRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\" \n\x10GetNeighsRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\" \n\x10GetRoutesRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x1f\n\x0fGetMplssRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\")\n\x19GetBridgeVlanInfosRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x11\n\x0fGetNodesRequest\"\x10\n\x0eGetVpnsRequest\"\x16\n\x14GetEncapInfosRequest\"\x12\n\x10GetIptunsRequest\"\x11\n\x0fGetStatsRequest\"&\n\x07LinkKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\r\n\x05index\x18\x02 \x01(\x05\"%\n\x07\x41\x64\x64rKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\"H\n\x08NeighKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\x12\x0f\n\x07ifindex\x18\x03 \x01(\x05\x12\x0f\n\x07vlan_id\x18\x04 \x01(\x05\"&\n\x08RouteKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\"(\n\x07MplsKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0f\n\x07l_label\x18\x02 \x01(\r\"\x17\n\x07NodeKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"/\n\x06VpnKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0b\n\x03\x64st\x18\x02 \x01(\t\x12\n\n\x02gw\x18\x03 \x01(\t\"(\n\x08IptunKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0e\n\x06remote\x18\x02 \x01(\x0c\"=\n\x11\x42ridgeVlanInfoKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\r\n\x05index\x18\x02 \x01(\x05\x12\x0b\n\x03vid\x18\x03 \x01(\r\" \n\x04Stat\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x04\" \n\x04Node\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04n_id\x18\x02 \x01(\r\"h\n\x03Vpn\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04mask\x18\x02 \x01(\x0c\x12\n\n\x02gw\x18\x03 \x01(\x0c\x12\r\n\x05label\x18\x04 \x01(\r\x12\x0e\n\x06vpn_gw\x18\x05 \x01(\x0c\x12\x0c\n\x04n_id\x18\x06 \x01(\r\x12\x0e\n\x06vpn_id\x18\x07 \x01(\r\"T\n\x05Iptun\x12\x1a\n\x04link\x18\x01 \x01(\x0b\x32\x0c.nlaapi.Link\x12\x11\n\tlocal_mac\x18\x02 \x01(\x0c\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x0e\n\x06tnl_id\x18\x04 \x01(\r\"\xca\x02\n\x0e\x42ridgeVlanInfo\x12+\n\x05\x66lags\x18\x01 \x01(\x0e\x32\x1c.nlaapi.BridgeVlanInfo.Flags\x12\x0b\n\x03vid\x18\x02 \x01(\r\x12\r\n\x05index\x18\x03 \x01(\x05\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x14\n\x0cmaster_index\x18\x05 \x01(\x05\x12\x0b\n\x03mtu\x18\x06 \x01(\r\x12\x0c\n\x04n_id\x18\x07 \x01(\r\x12\r\n\x05\x62r_id\x18\x08 \x01(\r\"T\n\x05\x46lags\x12\x07\n\x03NOP\x10\x00\x12\n\n\x06MASTER\x10\x01\x12\x08\n\x04PVID\x10\x02\x12\x0c\n\x08UNTAGGED\x10\x04\x12\x0f\n\x0bRANGE_BEGIN\x10\x08\x12\r\n\tRANGE_END\x10\x10\"K\n\x08PortType\x12\r\n\tNONE_PORT\x10\x00\x12\x0f\n\x0b\x41\x43\x43\x45SS_PORT\x10\x01\x12\x0e\n\nTRUNK_PORT\x10\x02\x12\x0f\n\x0bMASTER_PORT\x10\x03\"\xff\x01\n\rBondSlaveInfo\x12 \n\x05state\x18\x01 \x01(\x0e\x32\x11.nlaapi.BondState\x12)\n\nmii_status\x18\x02 \x01(\x0e\x32\x15.nlaapi.BondLinkState\x12\x1a\n\x12link_failure_count\x18\x03 \x01(\r\x12\x19\n\x11permanent_hw_addr\x18\x04 \x01(\x0c\x12\x10\n\x08queue_id\x18\x05 \x01(\x05\x12\x15\n\raggregator_id\x18\x06 \x01(\x05\x12\x1d\n\x15\x61\x63tor_oper_port_state\x18\x07 \x01(\x05\x12\"\n\x1a\x61\x64_partner_oper_port_state\x18\x08 \x01(\x05\"\xcb\x02\n\tLinkAttrs\x12\r\n\x05index\x18\x01 \x01(\x05\x12\x0b\n\x03mtu\x18\x02 \x01(\x05\x12\x10\n\x08tx_q_len\x18\x03 \x01(\x05\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x15\n\rhardware_addr\x18\x05 \x01(\x0c\x12\r\n\x05\x66lags\x18\x06 \x01(\r\x12\x11\n\traw_flags\x18\x07 \x01(\r\x12\x14\n\x0cparent_index\x18\x08 \x01(\x05\x12\x14\n\x0cmaster_index\x18\t \x01(\x05\x12\r\n\x05\x61lias\x18\n \x01(\t\x12\x0f\n\x07promisc\x18\x0b \x01(\x05\x12\x12\n\nencap_type\x18\x0c \x01(\t\x12)\n\noper_state\x18\r \x01(\x0e\x32\x15.nlaapi.LinkOperState\x12\x30\n\x0f\x62ond_slave_info\x18\x0e \x01(\x0b\x32\x15.nlaapi.BondSlaveInfoH\x00\x42\x0c\n\nslave_info\"9\n\x10GenericLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\"8\n\x0f\x44\x65viceLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\"\x80\x01\n\x0f\x42ridgeLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x1a\n\x12multicast_snooping\x18\x02 \x01(\x08\x12\x12\n\nhello_time\x18\x03 \x01(\r\x12\x16\n\x0evlan_filtering\x18\x04 \x01(\x08\"G\n\rVlanLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x0f\n\x07vlan_id\x18\x02 \x01(\x05\"\xe9\x02\n\x0eVxlanLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x10\n\x08vxlan_id\x18\x02 \x01(\x05\x12\x16\n\x0evtep_dev_index\x18\x03 \x01(\x05\x12\x10\n\x08src_addr\x18\x04 \x01(\x0c\x12\r\n\x05group\x18\x05 \x01(\x0c\x12\x0b\n\x03ttl\x18\x06 \x01(\x05\x12\x0b\n\x03tos\x18\x07 \x01(\x05\x12\x10\n\x08learning\x18\x08 \x01(\x08\x12\r\n\x05proxy\x18\t \x01(\x08\x12\x0b\n\x03rsc\x18\n \x01(\x08\x12\x0e\n\x06l2miss\x18\x0b \x01(\x08\x12\x0e\n\x06l3miss\x18\x0c \x01(\x08\x12\x11\n\tudp_c_sum\x18\r \x01(\x08\x12\x0e\n\x06no_age\x18\x0e \x01(\x08\x12\x0b\n\x03gbp\x18\x0f \x01(\x08\x12\x0b\n\x03\x61ge\x18\x10 \x01(\x05\x12\r\n\x05limit\x18\x11 \x01(\x05\x12\x0c\n\x04port\x18\x12 \x01(\x05\x12\x10\n\x08port_low\x18\x13 \x01(\x05\x12\x11\n\tport_high\x18\x14 \x01(\x05\"\x80\x01\n\x0cVtiLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\r\n\x05i_key\x18\x02 \x01(\r\x12\r\n\x05o_key\x18\x03 \x01(\r\x12\x0c\n\x04link\x18\x04 \x01(\r\x12\r\n\x05local\x18\x05 \x01(\x0c\x12\x0e\n\x06remote\x18\x06 \x01(\x0c\"I\n\rVethLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x11\n\tpeer_name\x18\x02 \x01(\t\"s\n\nBondAdInfo\x12\x15\n\raggregator_id\x18\x01 \x01(\x05\x12\x11\n\tnum_ports\x18\x02 \x01(\x05\x12\x11\n\tactor_key\x18\x03 \x01(\x05\x12\x13\n\x0bpartner_key\x18\x04 \x01(\x05\x12\x13\n\x0bpartner_mac\x18\x05 \x01(\x0c\"\xf8\x05\n\rBondLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x1e\n\x04mode\x18\x02 \x01(\x0e\x32\x10.nlaapi.BondMode\x12\x14\n\x0c\x61\x63tive_slave\x18\x03 \x01(\x05\x12\x0e\n\x06miimon\x18\x04 \x01(\x05\x12\x10\n\x08up_delay\x18\x05 \x01(\x05\x12\x12\n\ndown_delay\x18\x06 \x01(\x05\x12\x13\n\x0buse_carrier\x18\x07 \x01(\x05\x12\x14\n\x0c\x61rp_interval\x18\x08 \x01(\x05\x12\x16\n\x0e\x61rp_ip_targets\x18\t \x03(\x0c\x12-\n\x0c\x61rp_validate\x18\n \x01(\x0e\x32\x17.nlaapi.BondArpValidate\x12\x32\n\x0f\x61rp_all_targets\x18\x0b \x01(\x0e\x32\x19.nlaapi.BondArpAllTargets\x12\x0f\n\x07primary\x18\x0c \x01(\x05\x12\x35\n\x10primary_reselect\x18\r \x01(\x0e\x32\x1b.nlaapi.BondPrimaryReselect\x12.\n\rfail_over_mac\x18\x0e \x01(\x0e\x32\x17.nlaapi.BondFailOverMac\x12\x34\n\x10xmit_hash_policy\x18\x0f \x01(\x0e\x32\x1a.nlaapi.BondXmitHashPolicy\x12\x13\n\x0bresend_igmp\x18\x10 \x01(\x05\x12\x16\n\x0enum_peer_notif\x18\x11 \x01(\x05\x12\x19\n\x11\x61ll_slaves_active\x18\x12 \x01(\x05\x12\x11\n\tmin_links\x18\x13 \x01(\x05\x12\x13\n\x0blp_interval\x18\x14 \x01(\x05\x12\x19\n\x11packers_per_slave\x18\x15 \x01(\x05\x12\'\n\tlacp_rate\x18\x16 \x01(\x0e\x32\x14.nlaapi.BondLacpRate\x12\'\n\tad_select\x18\x17 \x01(\x0e\x32\x14.nlaapi.BondAdSelect\x12#\n\x07\x61\x64_info\x18\x18 \x01(\x0b\x32\x12.nlaapi.BondAdInfo\"\xf9\x01\n\x0eIptunLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x0b\n\x03ttl\x18\x02 \x01(\r\x12\x0b\n\x03tos\x18\x03 \x01(\r\x12\x12\n\np_mtu_disc\x18\x04 \x01(\r\x12\x0c\n\x04link\x18\x05 \x01(\r\x12\r\n\x05local\x18\x06 \x01(\x0c\x12\x0e\n\x06remote\x18\x07 \x01(\x0c\x12\x13\n\x0b\x65ncap_sport\x18\x08 \x01(\r\x12\x13\n\x0b\x65ncap_dport\x18\t \x01(\r\x12\x12\n\nencap_type\x18\n \x01(\r\x12\x13\n\x0b\x65ncap_flags\x18\x0b \x01(\r\x12\x12\n\nflow_based\x18\x0c \x01(\x08\"\xae\x03\n\x04Link\x12\x0c\n\x04type\x18\x01 \x01(\t\x12)\n\x06\x64\x65vice\x18\x04 \x01(\x0b\x32\x17.nlaapi.DeviceLinkAttrsH\x00\x12)\n\x06\x62ridge\x18\x05 \x01(\x0b\x32\x17.nlaapi.BridgeLinkAttrsH\x00\x12%\n\x04vlan\x18\x06 \x01(\x0b\x32\x15.nlaapi.VlanLinkAttrsH\x00\x12\'\n\x05vxlan\x18\x07 \x01(\x0b\x32\x16.nlaapi.VxlanLinkAttrsH\x00\x12#\n\x03vti\x18\x08 \x01(\x0b\x32\x14.nlaapi.VtiLinkAttrsH\x00\x12%\n\x04veth\x18\t \x01(\x0b\x32\x15.nlaapi.VethLinkAttrsH\x00\x12%\n\x04\x62ond\x18\n \x01(\x0b\x32\x15.nlaapi.BondLinkAttrsH\x00\x12+\n\x07generic\x18\x0b \x01(\x0b\x32\x18.nlaapi.GenericLinkAttrsH\x00\x12\'\n\x05iptun\x18\x0c \x01(\x0b\x32\x16.nlaapi.IptunLinkAttrsH\x00\x12\x0c\n\x04n_id\x18\x02 \x01(\r\x12\r\n\x05ln_id\x18\x03 \x01(\rB\x0c\n\nlink_attrs\"\xc0\x01\n\x04\x41\x64\x64r\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0f\n\x07ip_mask\x18\x02 \x01(\x0c\x12\r\n\x05label\x18\x03 \x01(\t\x12\r\n\x05\x66lags\x18\x04 \x01(\x05\x12\r\n\x05scope\x18\x05 \x01(\x05\x12\x0c\n\x04peer\x18\x06 \x01(\x0c\x12\x11\n\tpeer_mask\x18\x07 \x01(\x0c\x12\x11\n\tbroadcast\x18\x08 \x01(\x0c\x12\r\n\x05index\x18\t \x01(\x05\x12\x0e\n\x06\x66\x61mily\x18\n \x01(\x05\x12\x0c\n\x04n_id\x18\x0b \x01(\r\x12\r\n\x05\x61\x64_id\x18\x0c \x01(\r\"\x0c\n\nNeighNotun\".\n\nNeighIptun\x12\x10\n\x08tun_type\x18\x01 \x01(\t\x12\x0e\n\x06src_ip\x18\x02 \x01(\x0c\"\xaf\x02\n\x05Neigh\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\r\n\x05state\x18\x03 \x01(\x05\x12\x0c\n\x04type\x18\x04 \x01(\x05\x12\r\n\x05\x66lags\x18\x05 \x01(\x05\x12\n\n\x02ip\x18\x06 \x01(\x0c\x12\x15\n\rhardware_addr\x18\x07 \x01(\x0c\x12\x12\n\nll_ip_addr\x18\x08 \x01(\x0c\x12\x0f\n\x07vlan_id\x18\t \x01(\x05\x12\x0b\n\x03vni\x18\n \x01(\x05\x12\x0c\n\x04n_id\x18\x0b \x01(\r\x12\r\n\x05ne_id\x18\x0c \x01(\r\x12\x10\n\x08phy_link\x18\r \x01(\x05\x12#\n\x05notun\x18\x0e \x01(\x0b\x32\x12.nlaapi.NeighNotunH\x00\x12#\n\x05iptun\x18\x0f \x01(\x0b\x32\x12.nlaapi.NeighIptunH\x00\x42\x08\n\x06tunnel\"\x8e\x01\n\x0bNexthopInfo\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x0c\n\x04hops\x18\x02 \x01(\x05\x12\n\n\x02gw\x18\x03 \x01(\x0c\x12\r\n\x05\x66lags\x18\x04 \x01(\x05\x12$\n\x07new_dst\x18\x05 \x01(\x0b\x32\x13.nlaapi.Destination\x12\x1c\n\x05\x65ncap\x18\x06 \x01(\x0b\x32\r.nlaapi.Encap\"!\n\x0fMPLSDestination\x12\x0e\n\x06labels\x18\x01 \x03(\r\"N\n\x0b\x44\x65stination\x12\x0e\n\x06\x66\x61mily\x18\x01 \x01(\x05\x12\'\n\x04mpls\x18\x02 \x01(\x0b\x32\x17.nlaapi.MPLSDestinationH\x00\x42\x06\n\x04\x64\x65st\"\x1b\n\tMPLSEncap\x12\x0e\n\x06labels\x18\x01 \x03(\r\"A\n\x05\x45ncap\x12\x0c\n\x04type\x18\x01 \x01(\r\x12!\n\x04mpls\x18\x02 \x01(\x0b\x32\x11.nlaapi.MPLSEncapH\x00\x42\x07\n\x05\x65ncap\"(\n\x0c\x45ncapInfoKey\x12\x0b\n\x03\x64st\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\"A\n\tEncapInfo\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04mask\x18\x02 \x01(\x0c\x12\x0b\n\x03vrf\x18\x03 \x01(\r\x12\r\n\x05\x65n_id\x18\x04 \x01(\r\"\x91\x03\n\x05Route\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x14\n\x0ci_link_index\x18\x02 \x01(\x05\x12\r\n\x05scope\x18\x03 \x01(\x05\x12\x0b\n\x03\x64st\x18\x04 \x01(\x0c\x12\x10\n\x08\x64st_mask\x18\x05 \x01(\x0c\x12\x0b\n\x03src\x18\x06 \x01(\x0c\x12\n\n\x02gw\x18\x07 \x01(\x0c\x12\'\n\nmulti_path\x18\x08 \x03(\x0b\x32\x13.nlaapi.NexthopInfo\x12\x10\n\x08protocol\x18\t \x01(\x05\x12\x10\n\x08priority\x18\n \x01(\x05\x12\r\n\x05table\x18\x0b \x01(\x05\x12\x0c\n\x04type\x18\x0c \x01(\x05\x12\x0b\n\x03tos\x18\r \x01(\x05\x12\r\n\x05\x66lags\x18\x0e \x01(\x05\x12\x10\n\x08mpls_dst\x18\x0f \x01(\x05\x12$\n\x07new_dst\x18\x10 \x01(\x0b\x32\x13.nlaapi.Destination\x12\x1c\n\x05\x65ncap\x18\x11 \x01(\x0b\x32\r.nlaapi.Encap\x12\x0c\n\x04n_id\x18\x12 \x01(\r\x12\r\n\x05rt_id\x18\x13 \x01(\r\x12\x0e\n\x06vpn_gw\x18\x14 \x01(\x0c\x12\x0e\n\x06\x65n_ids\x18\x15 \x03(\r*%\n\x08NlMsgSrc\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03KNL\x10\x01\x12\x07\n\x03\x41PI\x10\x02*\x88\x01\n\rLinkOperState\x12\x0f\n\x0bOperUnknown\x10\x00\x12\x12\n\x0eOperNotPresent\x10\x01\x12\x0c\n\x08OperDown\x10\x02\x12\x16\n\x12OperLowerLayerDown\x10\x03\x12\x0f\n\x0bOperTesting\x10\x04\x12\x0f\n\x0bOperDormant\x10\x05\x12\n\n\x06OperUp\x10\x06*}\n\rBondLinkState\x12\x10\n\x0c\x42OND_LINK_UP\x10\x00\x12\x12\n\x0e\x42OND_LINK_FAIL\x10\x01\x12\x12\n\x0e\x42OND_LINK_DOWN\x10\x02\x12\x12\n\x0e\x42OND_LINK_BACK\x10\x03\x12\x1e\n\x11\x42OND_LINK_UNKNOWN\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01*Z\n\tBondState\x12\x15\n\x11\x42OND_STATE_ACTIVE\x10\x00\x12\x15\n\x11\x42OND_STATE_BACKUP\x10\x01\x12\x1f\n\x12\x42OND_STATE_UNKNOWN\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01*\xd9\x01\n\x08\x42ondMode\x12\x18\n\x14\x42OND_MODE_BALANCE_RR\x10\x00\x12\x1b\n\x17\x42OND_MODE_ACTIVE_BACKUP\x10\x01\x12\x19\n\x15\x42OND_MODE_BALANCE_XOR\x10\x02\x12\x17\n\x13\x42OND_MODE_BROADCAST\x10\x03\x12\x15\n\x11\x42OND_MODE_802_3AD\x10\x04\x12\x19\n\x15\x42OND_MODE_BALANCE_TLB\x10\x05\x12\x19\n\x15\x42OND_MODE_BALANCE_ALB\x10\x06\x12\x15\n\x11\x42OND_MODE_UNKNOWN\x10\x07*\x84\x01\n\x0f\x42ondArpValidate\x12\x1a\n\x16\x42OND_ARP_VALIDATE_NONE\x10\x00\x12\x1c\n\x18\x42OND_ARP_VALIDATE_ACTIVE\x10\x01\x12\x1c\n\x18\x42OND_ARP_VALIDATE_BACKUP\x10\x02\x12\x19\n\x15\x42OND_ARP_VALIDATE_ALL\x10\x03*O\n\x11\x42ondArpAllTargets\x12\x1c\n\x18\x42OND_ARP_ALL_TARGETS_ANY\x10\x00\x12\x1c\n\x18\x42OND_ARP_ALL_TARGETS_ALL\x10\x01*|\n\x13\x42ondPrimaryReselect\x12 \n\x1c\x42OND_PRIMARY_RESELECT_ALWAYS\x10\x00\x12 \n\x1c\x42OND_PRIMARY_RESELECT_BETTER\x10\x01\x12!\n\x1d\x42OND_PRIMARY_RESELECT_FAILURE\x10\x02*l\n\x0f\x42ondFailOverMac\x12\x1b\n\x17\x42OND_FAIL_OVER_MAC_NONE\x10\x00\x12\x1d\n\x19\x42OND_FAIL_OVER_MAC_ACTIVE\x10\x01\x12\x1d\n\x19\x42OND_FAIL_OVER_MAC_FOLLOW\x10\x02*\xe9\x01\n\x12\x42ondXmitHashPolicy\x12 \n\x1c\x42OND_XMIT_HASH_POLICY_LAYER2\x10\x00\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_LAYER3_4\x10\x01\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_LAYER2_3\x10\x02\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_ENCAP2_3\x10\x03\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_ENCAP3_4\x10\x04\x12!\n\x1d\x42OND_XMIT_HASH_POLICY_UNKNOWN\x10\x05*\\\n\x0c\x42ondLacpRate\x12\x17\n\x13\x42OND_LACP_RATE_SLOW\x10\x00\x12\x17\n\x13\x42OND_LACP_RATE_FAST\x10\x01\x12\x1a\n\x16\x42OND_LACP_RATE_UNKNOWN\x10\x02*a\n\x0c\x42ondAdSelect\x12\x19\n\x15\x42OND_AD_SELECT_STABLE\x10\x00\x12\x1c\n\x18\x42OND_AD_SELECT_BANDWIDTH\x10\x01\x12\x18\n\x14\x42OND_AD_SELECT_COUNT\x10\x02\x32\x9d\x01\n\nNLACoreApi\x12K\n\x12SendNetlinkMessage\x12\x16.nlaapi.NetlinkMessage\x1a\x1b.nlaapi.NetlinkMessageReply\"\x00\x12\x42\n\x11MonNetlinkMessage\x12\x0c.nlaapi.Node\x1a\x1b.nlaapi.NetlinkMessageUnion\"\x00\x30\x01\x32\xcd\n\n\x06NLAApi\x12\x36\n\x06ModVpn\x12\x15.nlaapi.ModVpnRequest\x1a\x13.nlaapi.ModVpnReply\"\x00\x12\x44\n\nModNetlink\x12\x1b.nlaapi.NetlinkMessageUnion\x1a\x17.nlaapi.ModNetlinkReply\"\x00\x12H\n\nMonNetlink\x12\x19.nlaapi.MonNetlinkRequest\x1a\x1b.nlaapi.NetlinkMessageUnion\"\x00\x30\x01\x12*\n\x07GetLink\x12\x0f.nlaapi.LinkKey\x1a\x0c.nlaapi.Link\"\x00\x12*\n\x07GetAddr\x12\x0f.nlaapi.AddrKey\x1a\x0c.nlaapi.Addr\"\x00\x12-\n\x08GetNeigh\x12\x10.nlaapi.NeighKey\x1a\r.nlaapi.Neigh\"\x00\x12-\n\x08GetRoute\x12\x10.nlaapi.RouteKey\x1a\r.nlaapi.Route\"\x00\x12+\n\x07GetMpls\x12\x0f.nlaapi.MplsKey\x1a\r.nlaapi.Route\"\x00\x12*\n\x07GetNode\x12\x0f.nlaapi.NodeKey\x1a\x0c.nlaapi.Node\"\x00\x12\'\n\x06GetVpn\x12\x0e.nlaapi.VpnKey\x1a\x0b.nlaapi.Vpn\"\x00\x12\x39\n\x0cGetEncapInfo\x12\x14.nlaapi.EncapInfoKey\x1a\x11.nlaapi.EncapInfo\"\x00\x12-\n\x08GetIptun\x12\x10.nlaapi.IptunKey\x1a\r.nlaapi.Iptun\"\x00\x12H\n\x11GetBridgeVlanInfo\x12\x19.nlaapi.BridgeVlanInfoKey\x1a\x16.nlaapi.BridgeVlanInfo\"\x00\x12\x35\n\x08GetLinks\x12\x17.nlaapi.GetLinksRequest\x1a\x0c.nlaapi.Link\"\x00\x30\x01\x12\x35\n\x08GetAddrs\x12\x17.nlaapi.GetAddrsRequest\x1a\x0c.nlaapi.Addr\"\x00\x30\x01\x12\x38\n\tGetNeighs\x12\x18.nlaapi.GetNeighsRequest\x1a\r.nlaapi.Neigh\"\x00\x30\x01\x12\x38\n\tGetRoutes\x12\x18.nlaapi.GetRoutesRequest\x1a\r.nlaapi.Route\"\x00\x30\x01\x12\x36\n\x08GetMplss\x12\x17.nlaapi.GetMplssRequest\x1a\r.nlaapi.Route\"\x00\x30\x01\x12\x35\n\x08GetNodes\x12\x17.nlaapi.GetNodesRequest\x1a\x0c.nlaapi.Node\"\x00\x30\x01\x12\x32\n\x07GetVpns\x12\x16.nlaapi.GetVpnsRequest\x1a\x0b.nlaapi.Vpn\"\x00\x30\x01\x12\x44\n\rGetEncapInfos\x12\x1c.nlaapi.GetEncapInfosRequest\x1a\x11.nlaapi.EncapInfo\"\x00\x30\x01\x12\x38\n\tGetIptuns\x12\x18.nlaapi.GetIptunsRequest\x1a\r.nlaapi.Iptun\"\x00\x30\x01\x12S\n\x12GetBridgeVlanInfos\x12!.nlaapi.GetBridgeVlanInfosRequest\x1a\x16.nlaapi.BridgeVlanInfo\"\x00\x30\x01\x12\x35\n\x08GetStats\x12\x17.nlaapi.GetStatsRequest\x1a\x0c.nlaapi.Stat\"\x00\x30\x01\x62\x06proto3')
+  serialized_pb=_b('\n\x0cnlaapi.proto\x12\x06nlaapi\"N\n\x08NlMsghdr\x12\x0b\n\x03len\x18\x01 \x01(\r\x12\x0c\n\x04type\x18\x02 \x01(\r\x12\r\n\x05\x66lags\x18\x03 \x01(\r\x12\x0b\n\x03seq\x18\x04 \x01(\r\x12\x0b\n\x03pid\x18\x05 \x01(\r\"m\n\x0eNetlinkMessage\x12 \n\x06header\x18\x01 \x01(\x0b\x32\x10.nlaapi.NlMsghdr\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x1d\n\x03src\x18\x04 \x01(\x0e\x32\x10.nlaapi.NlMsgSrc\"\x15\n\x13NetlinkMessageReply\"\x13\n\x11MonNetlinkRequest\"\xdb\x02\n\x08NlMsgUni\x12\x1c\n\x04link\x18\x01 \x01(\x0b\x32\x0c.nlaapi.LinkH\x00\x12\x1c\n\x04\x61\x64\x64r\x18\x02 \x01(\x0b\x32\x0c.nlaapi.AddrH\x00\x12\x1e\n\x05neigh\x18\x03 \x01(\x0b\x32\r.nlaapi.NeighH\x00\x12\x1e\n\x05route\x18\x04 \x01(\x0b\x32\r.nlaapi.RouteH\x00\x12\x1c\n\x04node\x18\x05 \x01(\x0b\x32\x0c.nlaapi.NodeH\x00\x12\x1a\n\x03vpn\x18\x06 \x01(\x0b\x32\x0b.nlaapi.VpnH\x00\x12.\n\x0c\x62r_vlan_info\x18\x07 \x01(\x0b\x32\x16.nlaapi.BridgeVlanInfoH\x00\x12\"\n\x07nexthop\x18\x08 \x01(\x0b\x32\x0f.nlaapi.NexthopH\x00\x12\x1c\n\x04rule\x18\t \x01(\x0b\x32\x0c.nlaapi.RuleH\x00\x12 \n\x06mroute\x18\n \x01(\x0b\x32\x0e.nlaapi.MrouteH\x00\x42\x05\n\x03msg\"\x83\x01\n\x13NetlinkMessageUnion\x12 \n\x06header\x18\x01 \x01(\x0b\x32\x10.nlaapi.NlMsghdr\x12\x1d\n\x03msg\x18\x02 \x01(\x0b\x32\x10.nlaapi.NlMsgUni\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x1d\n\x03src\x18\x04 \x01(\x0e\x32\x10.nlaapi.NlMsgSrc\"7\n\rModVpnRequest\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x18\n\x03vpn\x18\x02 \x01(\x0b\x32\x0b.nlaapi.Vpn\"\r\n\x0bModVpnReply\"\x11\n\x0fModNetlinkReply\"\x1f\n\x0fGetLinksRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x1f\n\x0fGetAddrsRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\" \n\x10GetNeighsRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\" \n\x10GetRoutesRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x1f\n\x0fGetMplssRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\")\n\x19GetBridgeVlanInfosRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\"\n\x12GetNexthopsRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x1f\n\x0fGetRulesRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"!\n\x11GetMroutesRequest\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"\x11\n\x0fGetNodesRequest\"\x10\n\x0eGetVpnsRequest\"\x16\n\x14GetEncapInfosRequest\"\x12\n\x10GetIptunsRequest\"\x11\n\x0fGetStatsRequest\"&\n\x07LinkKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\r\n\x05index\x18\x02 \x01(\x05\"%\n\x07\x41\x64\x64rKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\"U\n\x08NeighKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\x12\x0f\n\x07ifindex\x18\x03 \x01(\x05\x12\x0f\n\x07vlan_id\x18\x04 \x01(\x05\x12\x0b\n\x03\x64st\x18\x05 \x01(\t\"&\n\x08RouteKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\"(\n\x07MplsKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0f\n\x07l_label\x18\x02 \x01(\r\"\x17\n\x07NodeKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\"/\n\x06VpnKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0b\n\x03\x64st\x18\x02 \x01(\t\x12\n\n\x02gw\x18\x03 \x01(\t\"(\n\x08IptunKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0e\n\x06remote\x18\x02 \x01(\x0c\"=\n\x11\x42ridgeVlanInfoKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\r\n\x05index\x18\x02 \x01(\x05\x12\x0b\n\x03vid\x18\x03 \x01(\r\"&\n\nNexthopKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\n\n\x02id\x18\x02 \x01(\r\"\x94\x01\n\x07RuleKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\x10\n\x08priority\x18\x03 \x01(\x05\x12\x0b\n\x03src\x18\x04 \x01(\t\x12\x0b\n\x03\x64st\x18\x05 \x01(\t\x12\x10\n\x08iif_name\x18\x06 \x01(\t\x12\x10\n\x08oif_name\x18\x07 \x01(\t\x12\x0c\n\x04mark\x18\x08 \x01(\x05\x12\r\n\x05table\x18\t \x01(\x05\"T\n\tMrouteKey\x12\x0c\n\x04n_id\x18\x01 \x01(\r\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\r\n\x05table\x18\x03 \x01(\x05\x12\x0b\n\x03src\x18\x04 \x01(\t\x12\r\n\x05group\x18\x05 \x01(\t\" \n\x04Stat\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x0b\n\x03val\x18\x02 \x01(\x04\" \n\x04Node\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04n_id\x18\x02 \x01(\r\"h\n\x03Vpn\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04mask\x18\x02 \x01(\x0c\x12\n\n\x02gw\x18\x03 \x01(\x0c\x12\r\n\x05label\x18\x04 \x01(\r\x12\x0e\n\x06vpn_gw\x18\x05 \x01(\x0c\x12\x0c\n\x04n_id\x18\x06 \x01(\r\x12\x0e\n\x06vpn_id\x18\x07 \x01(\r\"T\n\x05Iptun\x12\x1a\n\x04link\x18\x01 \x01(\x0b\x32\x0c.nlaapi.Link\x12\x11\n\tlocal_mac\x18\x02 \x01(\x0c\x12\x0c\n\x04n_id\x18\x03 \x01(\r\x12\x0e\n\x06tnl_id\x18\x04 \x01(\r\"\xca\x02\n\x0e\x42ridgeVlanInfo\x12+\n\x05\x66lags\x18\x01 \x01(\x0e\x32\x1c.nlaapi.BridgeVlanInfo.Flags\x12\x0b\n\x03vid\x18\x02 \x01(\r\x12\r\n\x05index\x18\x03 \x01(\x05\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x14\n\x0cmaster_index\x18\x05 \x01(\x05\x12\x0b\n\x03mtu\x18\x06 \x01(\r\x12\x0c\n\x04n_id\x18\x07 \x01(\r\x12\r\n\x05\x62r_id\x18\x08 \x01(\r\"T\n\x05\x46lags\x12\x07\n\x03NOP\x10\x00\x12\n\n\x06MASTER\x10\x01\x12\x08\n\x04PVID\x10\x02\x12\x0c\n\x08UNTAGGED\x10\x04\x12\x0f\n\x0bRANGE_BEGIN\x10\x08\x12\r\n\tRANGE_END\x10\x10\"K\n\x08PortType\x12\r\n\tNONE_PORT\x10\x00\x12\x0f\n\x0b\x41\x43\x43\x45SS_PORT\x10\x01\x12\x0e\n\nTRUNK_PORT\x10\x02\x12\x0f\n\x0bMASTER_PORT\x10\x03\"\xff\x01\n\rBondSlaveInfo\x12 \n\x05state\x18\x01 \x01(\x0e\x32\x11.nlaapi.BondState\x12)\n\nmii_status\x18\x02 \x01(\x0e\x32\x15.nlaapi.BondLinkState\x12\x1a\n\x12link_failure_count\x18\x03 \x01(\r\x12\x19\n\x11permanent_hw_addr\x18\x04 \x01(\x0c\x12\x10\n\x08queue_id\x18\x05 \x01(\x05\x12\x15\n\raggregator_id\x18\x06 \x01(\x05\x12\x1d\n\x15\x61\x63tor_oper_port_state\x18\x07 \x01(\x05\x12\"\n\x1a\x61\x64_partner_oper_port_state\x18\x08 \x01(\x05\"\xcb\x02\n\tLinkAttrs\x12\r\n\x05index\x18\x01 \x01(\x05\x12\x0b\n\x03mtu\x18\x02 \x01(\x05\x12\x10\n\x08tx_q_len\x18\x03 \x01(\x05\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x15\n\rhardware_addr\x18\x05 \x01(\x0c\x12\r\n\x05\x66lags\x18\x06 \x01(\r\x12\x11\n\traw_flags\x18\x07 \x01(\r\x12\x14\n\x0cparent_index\x18\x08 \x01(\x05\x12\x14\n\x0cmaster_index\x18\t \x01(\x05\x12\r\n\x05\x61lias\x18\n \x01(\t\x12\x0f\n\x07promisc\x18\x0b \x01(\x05\x12\x12\n\nencap_type\x18\x0c \x01(\t\x12)\n\noper_state\x18\r \x01(\x0e\x32\x15.nlaapi.LinkOperState\x12\x30\n\x0f\x62ond_slave_info\x18\x0e \x01(\x0b\x32\x15.nlaapi.BondSlaveInfoH\x00\x42\x0c\n\nslave_info\"9\n\x10GenericLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\"8\n\x0f\x44\x65viceLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\"\x80\x01\n\x0f\x42ridgeLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x1a\n\x12multicast_snooping\x18\x02 \x01(\x08\x12\x12\n\nhello_time\x18\x03 \x01(\r\x12\x16\n\x0evlan_filtering\x18\x04 \x01(\x08\"G\n\rVlanLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x0f\n\x07vlan_id\x18\x02 \x01(\x05\"\xe9\x02\n\x0eVxlanLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x10\n\x08vxlan_id\x18\x02 \x01(\x05\x12\x16\n\x0evtep_dev_index\x18\x03 \x01(\x05\x12\x10\n\x08src_addr\x18\x04 \x01(\x0c\x12\r\n\x05group\x18\x05 \x01(\x0c\x12\x0b\n\x03ttl\x18\x06 \x01(\x05\x12\x0b\n\x03tos\x18\x07 \x01(\x05\x12\x10\n\x08learning\x18\x08 \x01(\x08\x12\r\n\x05proxy\x18\t \x01(\x08\x12\x0b\n\x03rsc\x18\n \x01(\x08\x12\x0e\n\x06l2miss\x18\x0b \x01(\x08\x12\x0e\n\x06l3miss\x18\x0c \x01(\x08\x12\x11\n\tudp_c_sum\x18\r \x01(\x08\x12\x0e\n\x06no_age\x18\x0e \x01(\x08\x12\x0b\n\x03gbp\x18\x0f \x01(\x08\x12\x0b\n\x03\x61ge\x18\x10 \x01(\x05\x12\r\n\x05limit\x18\x11 \x01(\x05\x12\x0c\n\x04port\x18\x12 \x01(\x05\x12\x10\n\x08port_low\x18\x13 \x01(\x05\x12\x11\n\tport_high\x18\x14 \x01(\x05\"\x80\x01\n\x0cVtiLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\r\n\x05i_key\x18\x02 \x01(\r\x12\r\n\x05o_key\x18\x03 \x01(\r\x12\x0c\n\x04link\x18\x04 \x01(\r\x12\r\n\x05local\x18\x05 \x01(\x0c\x12\x0e\n\x06remote\x18\x06 \x01(\x0c\"I\n\rVethLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x11\n\tpeer_name\x18\x02 \x01(\t\"s\n\nBondAdInfo\x12\x15\n\raggregator_id\x18\x01 \x01(\x05\x12\x11\n\tnum_ports\x18\x02 \x01(\x05\x12\x11\n\tactor_key\x18\x03 \x01(\x05\x12\x13\n\x0bpartner_key\x18\x04 \x01(\x05\x12\x13\n\x0bpartner_mac\x18\x05 \x01(\x0c\"\xf8\x05\n\rBondLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x1e\n\x04mode\x18\x02 \x01(\x0e\x32\x10.nlaapi.BondMode\x12\x14\n\x0c\x61\x63tive_slave\x18\x03 \x01(\x05\x12\x0e\n\x06miimon\x18\x04 \x01(\x05\x12\x10\n\x08up_delay\x18\x05 \x01(\x05\x12\x12\n\ndown_delay\x18\x06 \x01(\x05\x12\x13\n\x0buse_carrier\x18\x07 \x01(\x05\x12\x14\n\x0c\x61rp_interval\x18\x08 \x01(\x05\x12\x16\n\x0e\x61rp_ip_targets\x18\t \x03(\x0c\x12-\n\x0c\x61rp_validate\x18\n \x01(\x0e\x32\x17.nlaapi.BondArpValidate\x12\x32\n\x0f\x61rp_all_targets\x18\x0b \x01(\x0e\x32\x19.nlaapi.BondArpAllTargets\x12\x0f\n\x07primary\x18\x0c \x01(\x05\x12\x35\n\x10primary_reselect\x18\r \x01(\x0e\x32\x1b.nlaapi.BondPrimaryReselect\x12.\n\rfail_over_mac\x18\x0e \x01(\x0e\x32\x17.nlaapi.BondFailOverMac\x12\x34\n\x10xmit_hash_policy\x18\x0f \x01(\x0e\x32\x1a.nlaapi.BondXmitHashPolicy\x12\x13\n\x0bresend_igmp\x18\x10 \x01(\x05\x12\x16\n\x0enum_peer_notif\x18\x11 \x01(\x05\x12\x19\n\x11\x61ll_slaves_active\x18\x12 \x01(\x05\x12\x11\n\tmin_links\x18\x13 \x01(\x05\x12\x13\n\x0blp_interval\x18\x14 \x01(\x05\x12\x19\n\x11packers_per_slave\x18\x15 \x01(\x05\x12\'\n\tlacp_rate\x18\x16 \x01(\x0e\x32\x14.nlaapi.BondLacpRate\x12\'\n\tad_select\x18\x17 \x01(\x0e\x32\x14.nlaapi.BondAdSelect\x12#\n\x07\x61\x64_info\x18\x18 \x01(\x0b\x32\x12.nlaapi.BondAdInfo\"\xf9\x01\n\x0eIptunLinkAttrs\x12%\n\nlink_attrs\x18\x01 \x01(\x0b\x32\x11.nlaapi.LinkAttrs\x12\x0b\n\x03ttl\x18\x02 \x01(\r\x12\x0b\n\x03tos\x18\x03 \x01(\r\x12\x12\n\np_mtu_disc\x18\x04 \x01(\r\x12\x0c\n\x04link\x18\x05 \x01(\r\x12\r\n\x05local\x18\x06 \x01(\x0c\x12\x0e\n\x06remote\x18\x07 \x01(\x0c\x12\x13\n\x0b\x65ncap_sport\x18\x08 \x01(\r\x12\x13\n\x0b\x65ncap_dport\x18\t \x01(\r\x12\x12\n\nencap_type\x18\n \x01(\r\x12\x13\n\x0b\x65ncap_flags\x18\x0b \x01(\r\x12\x12\n\nflow_based\x18\x0c \x01(\x08\"\xae\x03\n\x04Link\x12\x0c\n\x04type\x18\x01 \x01(\t\x12)\n\x06\x64\x65vice\x18\x04 \x01(\x0b\x32\x17.nlaapi.DeviceLinkAttrsH\x00\x12)\n\x06\x62ridge\x18\x05 \x01(\x0b\x32\x17.nlaapi.BridgeLinkAttrsH\x00\x12%\n\x04vlan\x18\x06 \x01(\x0b\x32\x15.nlaapi.VlanLinkAttrsH\x00\x12\'\n\x05vxlan\x18\x07 \x01(\x0b\x32\x16.nlaapi.VxlanLinkAttrsH\x00\x12#\n\x03vti\x18\x08 \x01(\x0b\x32\x14.nlaapi.VtiLinkAttrsH\x00\x12%\n\x04veth\x18\t \x01(\x0b\x32\x15.nlaapi.VethLinkAttrsH\x00\x12%\n\x04\x62ond\x18\n \x01(\x0b\x32\x15.nlaapi.BondLinkAttrsH\x00\x12+\n\x07generic\x18\x0b \x01(\x0b\x32\x18.nlaapi.GenericLinkAttrsH\x00\x12\'\n\x05iptun\x18\x0c \x01(\x0b\x32\x16.nlaapi.IptunLinkAttrsH\x00\x12\x0c\n\x04n_id\x18\x02 \x01(\r\x12\r\n\x05ln_id\x18\x03 \x01(\rB\x0c\n\nlink_attrs\"\xc0\x01\n\x04\x41\x64\x64r\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0f\n\x07ip_mask\x18\x02 \x01(\x0c\x12\r\n\x05label\x18\x03 \x01(\t\x12\r\n\x05\x66lags\x18\x04 \x01(\x05\x12\r\n\x05scope\x18\x05 \x01(\x05\x12\x0c\n\x04peer\x18\x06 \x01(\x0c\x12\x11\n\tpeer_mask\x18\x07 \x01(\x0c\x12\x11\n\tbroadcast\x18\x08 \x01(\x0c\x12\r\n\x05index\x18\t \x01(\x05\x12\x0e\n\x06\x66\x61mily\x18\n \x01(\x05\x12\x0c\n\x04n_id\x18\x0b \x01(\r\x12\r\n\x05\x61\x64_id\x18\x0c \x01(\r\"\x0c\n\nNeighNotun\".\n\nNeighIptun\x12\x10\n\x08tun_type\x18\x01 \x01(\t\x12\x0e\n\x06src_ip\x18\x02 \x01(\x0c\"\xaf\x02\n\x05Neigh\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\r\n\x05state\x18\x03 \x01(\x05\x12\x0c\n\x04type\x18\x04 \x01(\x05\x12\r\n\x05\x66lags\x18\x05 \x01(\x05\x12\n\n\x02ip\x18\x06 \x01(\x0c\x12\x15\n\rhardware_addr\x18\x07 \x01(\x0c\x12\x12\n\nll_ip_addr\x18\x08 \x01(\x0c\x12\x0f\n\x07vlan_id\x18\t \x01(\x05\x12\x0b\n\x03vni\x18\n \x01(\x05\x12\x0c\n\x04n_id\x18\x0b \x01(\r\x12\r\n\x05ne_id\x18\x0c \x01(\r\x12\x10\n\x08phy_link\x18\r \x01(\x05\x12#\n\x05notun\x18\x0e \x01(\x0b\x32\x12.nlaapi.NeighNotunH\x00\x12#\n\x05iptun\x18\x0f \x01(\x0b\x32\x12.nlaapi.NeighIptunH\x00\x42\x08\n\x06tunnel\"\x8e\x01\n\x0bNexthopInfo\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x0c\n\x04hops\x18\x02 \x01(\x05\x12\n\n\x02gw\x18\x03 \x01(\x0c\x12\r\n\x05\x66lags\x18\x04 \x01(\x05\x12$\n\x07new_dst\x18\x05 \x01(\x0b\x32\x13.nlaapi.Destination\x12\x1c\n\x05\x65ncap\x18\x06 \x01(\x0b\x32\r.nlaapi.Encap\"!\n\x0fMPLSDestination\x12\x0e\n\x06labels\x18\x01 \x03(\r\"N\n\x0b\x44\x65stination\x12\x0e\n\x06\x66\x61mily\x18\x01 \x01(\x05\x12\'\n\x04mpls\x18\x02 \x01(\x0b\x32\x17.nlaapi.MPLSDestinationH\x00\x42\x06\n\x04\x64\x65st\"\x1b\n\tMPLSEncap\x12\x0e\n\x06labels\x18\x01 \x03(\r\"+\n\tSEG6Encap\x12\x0c\n\x04mode\x18\x01 \x01(\x05\x12\x10\n\x08segments\x18\x02 \x03(\x0c\"~\n\x0eSEG6LocalEncap\x12\x0e\n\x06\x61\x63tion\x18\x01 \x01(\x05\x12\x10\n\x08segments\x18\x02 \x03(\x0c\x12\r\n\x05table\x18\x03 \x01(\x05\x12\x0f\n\x07in_addr\x18\x04 \x01(\x0c\x12\x10\n\x08in6_addr\x18\x05 \x01(\x0c\x12\x0b\n\x03iif\x18\x06 \x01(\x05\x12\x0b\n\x03oif\x18\x07 \x01(\x05\"\x91\x01\n\x05\x45ncap\x12\x0c\n\x04type\x18\x01 \x01(\r\x12!\n\x04mpls\x18\x02 \x01(\x0b\x32\x11.nlaapi.MPLSEncapH\x00\x12!\n\x04seg6\x18\x03 \x01(\x0b\x32\x11.nlaapi.SEG6EncapH\x00\x12+\n\tseg6local\x18\x04 \x01(\x0b\x32\x16.nlaapi.SEG6LocalEncapH\x00\x42\x07\n\x05\x65ncap\"/\n\x11NexthopGroupEntry\x12\n\n\x02id\x18\x01 \x01(\r\x12\x0e\n\x06weight\x18\x02 \x01(\r\"\xb1\x01\n\x07Nexthop\x12\n\n\x02id\x18\x01 \x01(\r\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\x10\n\x08protocol\x18\x03 \x01(\x05\x12\r\n\x05\x66lags\x18\x04 \x01(\r\x12\x12\n\nlink_index\x18\x05 \x01(\x05\x12\n\n\x02gw\x18\x06 \x01(\x0c\x12\x11\n\tblackhole\x18\x07 \x01(\x08\x12(\n\x05group\x18\x08 \x03(\x0b\x32\x19.nlaapi.NexthopGroupEntry\x12\x0c\n\x04n_id\x18\t \x01(\r\"\xf1\x01\n\x04Rule\x12\x10\n\x08priority\x18\x01 \x01(\x05\x12\x0e\n\x06\x66\x61mily\x18\x02 \x01(\x05\x12\r\n\x05table\x18\x03 \x01(\x05\x12\x0c\n\x04mark\x18\x04 \x01(\x05\x12\x0c\n\x04mask\x18\x05 \x01(\x05\x12\x0c\n\x04goto\x18\x06 \x01(\x05\x12\x0b\n\x03src\x18\x07 \x01(\x0c\x12\x10\n\x08src_mask\x18\x08 \x01(\x0c\x12\x0b\n\x03\x64st\x18\t \x01(\x0c\x12\x10\n\x08\x64st_mask\x18\n \x01(\x0c\x12\x10\n\x08iif_name\x18\x0b \x01(\t\x12\x10\n\x08oif_name\x18\x0c \x01(\t\x12\x0e\n\x06invert\x18\r \x01(\x08\x12\x0e\n\x06\x61\x63tion\x18\x0e \x01(\r\x12\x0c\n\x04n_id\x18\x0f \x01(\r\"\'\n\tMrouteOif\x12\r\n\x05index\x18\x01 \x01(\x05\x12\x0b\n\x03ttl\x18\x02 \x01(\r\"\x8e\x01\n\x06Mroute\x12\x0e\n\x06\x66\x61mily\x18\x01 \x01(\x05\x12\r\n\x05table\x18\x02 \x01(\x05\x12\x0b\n\x03src\x18\x03 \x01(\x0c\x12\r\n\x05group\x18\x04 \x01(\x0c\x12\x0b\n\x03iif\x18\x05 \x01(\x05\x12\x1f\n\x04oifs\x18\x06 \x03(\x0b\x32\x11.nlaapi.MrouteOif\x12\x0c\n\x04n_id\x18\x07 \x01(\r\x12\r\n\x05mr_id\x18\x08 \x01(\r\"(\n\x0c\x45ncapInfoKey\x12\x0b\n\x03\x64st\x18\x01 \x01(\t\x12\x0b\n\x03vrf\x18\x02 \x01(\r\"A\n\tEncapInfo\x12\n\n\x02ip\x18\x01 \x01(\x0c\x12\x0c\n\x04mask\x18\x02 \x01(\x0c\x12\x0b\n\x03vrf\x18\x03 \x01(\r\x12\r\n\x05\x65n_id\x18\x04 \x01(\r\"\xa0\x03\n\x05Route\x12\x12\n\nlink_index\x18\x01 \x01(\x05\x12\x14\n\x0ci_link_index\x18\x02 \x01(\x05\x12\r\n\x05scope\x18\x03 \x01(\x05\x12\x0b\n\x03\x64st\x18\x04 \x01(\x0c\x12\x10\n\x08\x64st_mask\x18\x05 \x01(\x0c\x12\x0b\n\x03src\x18\x06 \x01(\x0c\x12\n\n\x02gw\x18\x07 \x01(\x0c\x12\'\n\nmulti_path\x18\x08 \x03(\x0b\x32\x13.nlaapi.NexthopInfo\x12\x10\n\x08protocol\x18\t \x01(\x05\x12\x10\n\x08priority\x18\n \x01(\x05\x12\r\n\x05table\x18\x0b \x01(\x05\x12\x0c\n\x04type\x18\x0c \x01(\x05\x12\x0b\n\x03tos\x18\r \x01(\x05\x12\r\n\x05\x66lags\x18\x0e \x01(\x05\x12\x10\n\x08mpls_dst\x18\x0f \x01(\x05\x12$\n\x07new_dst\x18\x10 \x01(\x0b\x32\x13.nlaapi.Destination\x12\x1c\n\x05\x65ncap\x18\x11 \x01(\x0b\x32\r.nlaapi.Encap\x12\x0c\n\x04n_id\x18\x12 \x01(\r\x12\r\n\x05rt_id\x18\x13 \x01(\r\x12\x0e\n\x06vpn_gw\x18\x14 \x01(\x0c\x12\x0e\n\x06\x65n_ids\x18\x15 \x03(\r\x12\r\n\x05nh_id\x18\x16 \x01(\r\"\xa0\x03\n\x08Snapshot\x12\x11\n\ttimestamp\x18\x01 \x01(\x03\x12\x1b\n\x05links\x18\x02 \x03(\x0b\x32\x0c.nlaapi.Link\x12\x1d\n\x06iptuns\x18\x03 \x03(\x0b\x32\r.nlaapi.Iptun\x12\x1b\n\x05\x61\x64\x64rs\x18\x04 \x03(\x0b\x32\x0c.nlaapi.Addr\x12\x1d\n\x06neighs\x18\x05 \x03(\x0b\x32\r.nlaapi.Neigh\x12\x1d\n\x06routes\x18\x06 \x03(\x0b\x32\r.nlaapi.Route\x12\x1c\n\x05mplss\x18\x07 \x03(\x0b\x32\r.nlaapi.Route\x12\x19\n\x04vpns\x18\x08 \x03(\x0b\x32\x0b.nlaapi.Vpn\x12&\n\x0b\x65ncap_infos\x18\t \x03(\x0b\x32\x11.nlaapi.EncapInfo\x12(\n\x08\x62r_vlans\x18\n \x03(\x0b\x32\x16.nlaapi.BridgeVlanInfo\x12!\n\x08nexthops\x18\x0b \x03(\x0b\x32\x0f.nlaapi.Nexthop\x12\x1b\n\x05rules\x18\x0c \x03(\x0b\x32\x0c.nlaapi.Rule\x12\x1f\n\x07mroutes\x18\r \x03(\x0b\x32\x0e.nlaapi.Mroute*%\n\x08NlMsgSrc\x12\x07\n\x03NOP\x10\x00\x12\x07\n\x03KNL\x10\x01\x12\x07\n\x03\x41PI\x10\x02*\x88\x01\n\rLinkOperState\x12\x0f\n\x0bOperUnknown\x10\x00\x12\x12\n\x0eOperNotPresent\x10\x01\x12\x0c\n\x08OperDown\x10\x02\x12\x16\n\x12OperLowerLayerDown\x10\x03\x12\x0f\n\x0bOperTesting\x10\x04\x12\x0f\n\x0bOperDormant\x10\x05\x12\n\n\x06OperUp\x10\x06*}\n\rBondLinkState\x12\x10\n\x0c\x42OND_LINK_UP\x10\x00\x12\x12\n\x0e\x42OND_LINK_FAIL\x10\x01\x12\x12\n\x0e\x42OND_LINK_DOWN\x10\x02\x12\x12\n\x0e\x42OND_LINK_BACK\x10\x03\x12\x1e\n\x11\x42OND_LINK_UNKNOWN\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01*Z\n\tBondState\x12\x15\n\x11\x42OND_STATE_ACTIVE\x10\x00\x12\x15\n\x11\x42OND_STATE_BACKUP\x10\x01\x12\x1f\n\x12\x42OND_STATE_UNKNOWN\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01*\xd9\x01\n\x08\x42ondMode\x12\x18\n\x14\x42OND_MODE_BALANCE_RR\x10\x00\x12\x1b\n\x17\x42OND_MODE_ACTIVE_BACKUP\x10\x01\x12\x19\n\x15\x42OND_MODE_BALANCE_XOR\x10\x02\x12\x17\n\x13\x42OND_MODE_BROADCAST\x10\x03\x12\x15\n\x11\x42OND_MODE_802_3AD\x10\x04\x12\x19\n\x15\x42OND_MODE_BALANCE_TLB\x10\x05\x12\x19\n\x15\x42OND_MODE_BALANCE_ALB\x10\x06\x12\x15\n\x11\x42OND_MODE_UNKNOWN\x10\x07*\x84\x01\n\x0f\x42ondArpValidate\x12\x1a\n\x16\x42OND_ARP_VALIDATE_NONE\x10\x00\x12\x1c\n\x18\x42OND_ARP_VALIDATE_ACTIVE\x10\x01\x12\x1c\n\x18\x42OND_ARP_VALIDATE_BACKUP\x10\x02\x12\x19\n\x15\x42OND_ARP_VALIDATE_ALL\x10\x03*O\n\x11\x42ondArpAllTargets\x12\x1c\n\x18\x42OND_ARP_ALL_TARGETS_ANY\x10\x00\x12\x1c\n\x18\x42OND_ARP_ALL_TARGETS_ALL\x10\x01*|\n\x13\x42ondPrimaryReselect\x12 \n\x1c\x42OND_PRIMARY_RESELECT_ALWAYS\x10\x00\x12 \n\x1c\x42OND_PRIMARY_RESELECT_BETTER\x10\x01\x12!\n\x1d\x42OND_PRIMARY_RESELECT_FAILURE\x10\x02*l\n\x0f\x42ondFailOverMac\x12\x1b\n\x17\x42OND_FAIL_OVER_MAC_NONE\x10\x00\x12\x1d\n\x19\x42OND_FAIL_OVER_MAC_ACTIVE\x10\x01\x12\x1d\n\x19\x42OND_FAIL_OVER_MAC_FOLLOW\x10\x02*\xe9\x01\n\x12\x42ondXmitHashPolicy\x12 \n\x1c\x42OND_XMIT_HASH_POLICY_LAYER2\x10\x00\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_LAYER3_4\x10\x01\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_LAYER2_3\x10\x02\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_ENCAP2_3\x10\x03\x12\"\n\x1e\x42OND_XMIT_HASH_POLICY_ENCAP3_4\x10\x04\x12!\n\x1d\x42OND_XMIT_HASH_POLICY_UNKNOWN\x10\x05*\\\n\x0c\x42ondLacpRate\x12\x17\n\x13\x42OND_LACP_RATE_SLOW\x10\x00\x12\x17\n\x13\x42OND_LACP_RATE_FAST\x10\x01\x12\x1a\n\x16\x42OND_LACP_RATE_UNKNOWN\x10\x02*a\n\x0c\x42ondAdSelect\x12\x19\n\x15\x42OND_AD_SELECT_STABLE\x10\x00\x12\x1c\n\x18\x42OND_AD_SELECT_BANDWIDTH\x10\x01\x12\x18\n\x14\x42OND_AD_SELECT_COUNT\x10\x02\x32\x9d\x01\n\nNLACoreApi\x12K\n\x12SendNetlinkMessage\x12\x16.nlaapi.NetlinkMessage\x1a\x1b.nlaapi.NetlinkMessageReply\"\x00\x12\x42\n\x11MonNetlinkMessage\x12\x0c.nlaapi.Node\x1a\x1b.nlaapi.NetlinkMessageUnion\"\x00\x30\x01\x32\x94\r\n\x06NLAApi\x12\x36\n\x06ModVpn\x12\x15.nlaapi.ModVpnRequest\x1a\x13.nlaapi.ModVpnReply\"\x00\x12\x44\n\nModNetlink\x12\x1b.nlaapi.NetlinkMessageUnion\x1a\x17.nlaapi.ModNetlinkReply\"\x00\x12H\n\nMonNetlink\x12\x19.nlaapi.MonNetlinkRequest\x1a\x1b.nlaapi.NetlinkMessageUnion\"\x00\x30\x01\x12*\n\x07GetLink\x12\x0f.nlaapi.LinkKey\x1a\x0c.nlaapi.Link\"\x00\x12*\n\x07GetAddr\x12\x0f.nlaapi.AddrKey\x1a\x0c.nlaapi.Addr\"\x00\x12-\n\x08GetNeigh\x12\x10.nlaapi.NeighKey\x1a\r.nlaapi.Neigh\"\x00\x12-\n\x08GetRoute\x12\x10.nlaapi.RouteKey\x1a\r.nlaapi.Route\"\x00\x12+\n\x07GetMpls\x12\x0f.nlaapi.MplsKey\x1a\r.nlaapi.Route\"\x00\x12*\n\x07GetNode\x12\x0f.nlaapi.NodeKey\x1a\x0c.nlaapi.Node\"\x00\x12\'\n\x06GetVpn\x12\x0e.nlaapi.VpnKey\x1a\x0b.nlaapi.Vpn\"\x00\x12\x39\n\x0cGetEncapInfo\x12\x14.nlaapi.EncapInfoKey\x1a\x11.nlaapi.EncapInfo\"\x00\x12-\n\x08GetIptun\x12\x10.nlaapi.IptunKey\x1a\r.nlaapi.Iptun\"\x00\x12H\n\x11GetBridgeVlanInfo\x12\x19.nlaapi.BridgeVlanInfoKey\x1a\x16.nlaapi.BridgeVlanInfo\"\x00\x12\x33\n\nGetNexthop\x12\x12.nlaapi.NexthopKey\x1a\x0f.nlaapi.Nexthop\"\x00\x12*\n\x07GetRule\x12\x0f.nlaapi.RuleKey\x1a\x0c.nlaapi.Rule\"\x00\x12\x30\n\tGetMroute\x12\x11.nlaapi.MrouteKey\x1a\x0e.nlaapi.Mroute\"\x00\x12\x35\n\x08GetLinks\x12\x17.nlaapi.GetLinksRequest\x1a\x0c.nlaapi.Link\"\x00\x30\x01\x12\x35\n\x08GetAddrs\x12\x17.nlaapi.GetAddrsRequest\x1a\x0c.nlaapi.Addr\"\x00\x30\x01\x12\x38\n\tGetNeighs\x12\x18.nlaapi.GetNeighsRequest\x1a\r.nlaapi.Neigh\"\x00\x30\x01\x12\x38\n\tGetRoutes\x12\x18.nlaapi.GetRoutesRequest\x1a\r.nlaapi.Route\"\x00\x30\x01\x12\x36\n\x08GetMplss\x12\x17.nlaapi.GetMplssRequest\x1a\r.nlaapi.Route\"\x00\x30\x01\x12\x35\n\x08GetNodes\x12\x17.nlaapi.GetNodesRequest\x1a\x0c.nlaapi.Node\"\x00\x30\x01\x12\x32\n\x07GetVpns\x12\x16.nlaapi.GetVpnsRequest\x1a\x0b.nlaapi.Vpn\"\x00\x30\x01\x12\x44\n\rGetEncapInfos\x12\x1c.nlaapi.GetEncapInfosRequest\x1a\x11.nlaapi.EncapInfo\"\x00\x30\x01\x12\x38\n\tGetIptuns\x12\x18.nlaapi.GetIptunsRequest\x1a\r.nlaapi.Iptun\"\x00\x30\x01\x12S\n\x12GetBridgeVlanInfos\x12!.nlaapi.GetBridgeVlanInfosRequest\x1a\x16.nlaapi.BridgeVlanInfo\"\x00\x30\x01\x12>\n\x0bGetNexthops\x12\x1a.nlaapi.GetNexthopsRequest\x1a\x0f.nlaapi.Nexthop\"\x00\x30\x01\x12\x35\n\x08GetRules\x12\x17.nlaapi.GetRulesRequest\x1a\x0c.nlaapi.Rule\"\x00\x30\x01\x12;\n\nGetMroutes\x12\x19.nlaapi.GetMroutesRequest\x1a\x0e.nlaapi.Mroute\"\x00\x30\x01\x12\x35\n\x08GetStats\x12\x17.nlaapi.GetStatsRequest\x1a\x0c.nlaapi.Stat\"\x00\x30\x01\x62\x06proto3')
 )
 
 _NLMSGSRC = _descriptor.EnumDescriptor(
@@ -44,8 +44,8 @@ _NLMSGSRC = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6531,
-  serialized_end=6568,
+  serialized_start=8372,
+  serialized_end=8409,
 )
 _sym_db.RegisterEnumDescriptor(_NLMSGSRC)
 
@@ -87,8 +87,8 @@ _LINKOPERSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6571,
-  serialized_end=6707,
+  serialized_start=8412,
+  serialized_end=8548,
 )
 _sym_db.RegisterEnumDescriptor(_LINKOPERSTATE)
 
@@ -122,8 +122,8 @@ _BONDLINKSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6709,
-  serialized_end=6834,
+  serialized_start=8550,
+  serialized_end=8675,
 )
 _sym_db.RegisterEnumDescriptor(_BONDLINKSTATE)
 
@@ -149,8 +149,8 @@ _BONDSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6836,
-  serialized_end=6926,
+  serialized_start=8677,
+  serialized_end=8767,
 )
 _sym_db.RegisterEnumDescriptor(_BONDSTATE)
 
@@ -196,8 +196,8 @@ _BONDMODE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=6929,
-  serialized_end=7146,
+  serialized_start=8770,
+  serialized_end=8987,
 )
 _sym_db.RegisterEnumDescriptor(_BONDMODE)
 
@@ -227,8 +227,8 @@ _BONDARPVALIDATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7149,
-  serialized_end=7281,
+  serialized_start=8990,
+  serialized_end=9122,
 )
 _sym_db.RegisterEnumDescriptor(_BONDARPVALIDATE)
 
@@ -250,8 +250,8 @@ _BONDARPALLTARGETS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7283,
-  serialized_end=7362,
+  serialized_start=9124,
+  serialized_end=9203,
 )
 _sym_db.RegisterEnumDescriptor(_BONDARPALLTARGETS)
 
@@ -277,8 +277,8 @@ _BONDPRIMARYRESELECT = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7364,
-  serialized_end=7488,
+  serialized_start=9205,
+  serialized_end=9329,
 )
 _sym_db.RegisterEnumDescriptor(_BONDPRIMARYRESELECT)
 
@@ -304,8 +304,8 @@ _BONDFAILOVERMAC = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7490,
-  serialized_end=7598,
+  serialized_start=9331,
+  serialized_end=9439,
 )
 _sym_db.RegisterEnumDescriptor(_BONDFAILOVERMAC)
 
@@ -343,8 +343,8 @@ _BONDXMITHASHPOLICY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7601,
-  serialized_end=7834,
+  serialized_start=9442,
+  serialized_end=9675,
 )
 _sym_db.RegisterEnumDescriptor(_BONDXMITHASHPOLICY)
 
@@ -370,8 +370,8 @@ _BONDLACPRATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7836,
-  serialized_end=7928,
+  serialized_start=9677,
+  serialized_end=9769,
 )
 _sym_db.RegisterEnumDescriptor(_BONDLACPRATE)
 
@@ -397,8 +397,8 @@ _BONDADSELECT = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=7930,
-  serialized_end=8027,
+  serialized_start=9771,
+  serialized_end=9868,
 )
 _sym_db.RegisterEnumDescriptor(_BONDADSELECT)
 
@@ -488,8 +488,8 @@ _BRIDGEVLANINFO_FLAGS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1888,
-  serialized_end=1972,
+  serialized_start=2382,
+  serialized_end=2466,
 )
 _sym_db.RegisterEnumDescriptor(_BRIDGEVLANINFO_FLAGS)
 
@@ -518,8 +518,8 @@ _BRIDGEVLANINFO_PORTTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1974,
-  serialized_end=2049,
+  serialized_start=2468,
+  serialized_end=2543,
 )
 _sym_db.RegisterEnumDescriptor(_BRIDGEVLANINFO_PORTTYPE)
 
@@ -739,6 +739,27 @@ _NLMSGUNI = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nexthop', full_name='nlaapi.NlMsgUni.nexthop', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rule', full_name='nlaapi.NlMsgUni.rule', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mroute', full_name='nlaapi.NlMsgUni.mroute', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -755,7 +776,7 @@ _NLMSGUNI = _descriptor.Descriptor(
       index=0, containing_type=None, fields=[]),
   ],
   serialized_start=260,
-  serialized_end=507,
+  serialized_end=607,
 )
 
 
@@ -806,8 +827,8 @@ _NETLINKMESSAGEUNION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=510,
-  serialized_end=641,
+  serialized_start=610,
+  serialized_end=741,
 )
 
 
@@ -844,8 +865,8 @@ _MODVPNREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=643,
-  serialized_end=698,
+  serialized_start=743,
+  serialized_end=798,
 )
 
 
@@ -868,8 +889,8 @@ _MODVPNREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=700,
-  serialized_end=713,
+  serialized_start=800,
+  serialized_end=813,
 )
 
 
@@ -892,8 +913,8 @@ _MODNETLINKREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=715,
-  serialized_end=732,
+  serialized_start=815,
+  serialized_end=832,
 )
 
 
@@ -923,8 +944,8 @@ _GETLINKSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=734,
-  serialized_end=765,
+  serialized_start=834,
+  serialized_end=865,
 )
 
 
@@ -954,8 +975,8 @@ _GETADDRSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=767,
-  serialized_end=798,
+  serialized_start=867,
+  serialized_end=898,
 )
 
 
@@ -985,8 +1006,8 @@ _GETNEIGHSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=800,
-  serialized_end=832,
+  serialized_start=900,
+  serialized_end=932,
 )
 
 
@@ -1016,8 +1037,8 @@ _GETROUTESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=834,
-  serialized_end=866,
+  serialized_start=934,
+  serialized_end=966,
 )
 
 
@@ -1047,8 +1068,8 @@ _GETMPLSSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=868,
-  serialized_end=899,
+  serialized_start=968,
+  serialized_end=999,
 )
 
 
@@ -1078,8 +1099,101 @@ _GETBRIDGEVLANINFOSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=901,
-  serialized_end=942,
+  serialized_start=1001,
+  serialized_end=1042,
+)
+
+
+_GETNEXTHOPSREQUEST = _descriptor.Descriptor(
+  name='GetNexthopsRequest',
+  full_name='nlaapi.GetNexthopsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.GetNexthopsRequest.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1044,
+  serialized_end=1078,
+)
+
+
+_GETRULESREQUEST = _descriptor.Descriptor(
+  name='GetRulesRequest',
+  full_name='nlaapi.GetRulesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.GetRulesRequest.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1080,
+  serialized_end=1111,
+)
+
+
+_GETMROUTESREQUEST = _descriptor.Descriptor(
+  name='GetMroutesRequest',
+  full_name='nlaapi.GetMroutesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.GetMroutesRequest.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1113,
+  serialized_end=1146,
 )
 
 
@@ -1102,8 +1216,8 @@ _GETNODESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=944,
-  serialized_end=961,
+  serialized_start=1148,
+  serialized_end=1165,
 )
 
 
@@ -1126,8 +1240,8 @@ _GETVPNSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=963,
-  serialized_end=979,
+  serialized_start=1167,
+  serialized_end=1183,
 )
 
 
@@ -1150,8 +1264,8 @@ _GETENCAPINFOSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=981,
-  serialized_end=1003,
+  serialized_start=1185,
+  serialized_end=1207,
 )
 
 
@@ -1174,8 +1288,8 @@ _GETIPTUNSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1005,
-  serialized_end=1023,
+  serialized_start=1209,
+  serialized_end=1227,
 )
 
 
@@ -1198,8 +1312,8 @@ _GETSTATSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1025,
-  serialized_end=1042,
+  serialized_start=1229,
+  serialized_end=1246,
 )
 
 
@@ -1236,8 +1350,8 @@ _LINKKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1044,
-  serialized_end=1082,
+  serialized_start=1248,
+  serialized_end=1286,
 )
 
 
@@ -1274,8 +1388,8 @@ _ADDRKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1084,
-  serialized_end=1121,
+  serialized_start=1288,
+  serialized_end=1325,
 )
 
 
@@ -1314,6 +1428,13 @@ _NEIGHKEY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst', full_name='nlaapi.NeighKey.dst', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1326,8 +1447,8 @@ _NEIGHKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1123,
-  serialized_end=1195,
+  serialized_start=1327,
+  serialized_end=1412,
 )
 
 
@@ -1364,8 +1485,8 @@ _ROUTEKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1197,
-  serialized_end=1235,
+  serialized_start=1414,
+  serialized_end=1452,
 )
 
 
@@ -1402,8 +1523,8 @@ _MPLSKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1237,
-  serialized_end=1277,
+  serialized_start=1454,
+  serialized_end=1494,
 )
 
 
@@ -1433,8 +1554,8 @@ _NODEKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1279,
-  serialized_end=1302,
+  serialized_start=1496,
+  serialized_end=1519,
 )
 
 
@@ -1478,8 +1599,8 @@ _VPNKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1304,
-  serialized_end=1351,
+  serialized_start=1521,
+  serialized_end=1568,
 )
 
 
@@ -1516,8 +1637,8 @@ _IPTUNKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1353,
-  serialized_end=1393,
+  serialized_start=1570,
+  serialized_end=1610,
 )
 
 
@@ -1561,28 +1682,28 @@ _BRIDGEVLANINFOKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1395,
-  serialized_end=1456,
+  serialized_start=1612,
+  serialized_end=1673,
 )
 
 
-_STAT = _descriptor.Descriptor(
-  name='Stat',
-  full_name='nlaapi.Stat',
+_NEXTHOPKEY = _descriptor.Descriptor(
+  name='NexthopKey',
+  full_name='nlaapi.NexthopKey',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='nlaapi.Stat.key', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='n_id', full_name='nlaapi.NexthopKey.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='val', full_name='nlaapi.Stat.val', index=1,
-      number=2, type=4, cpp_type=4, label=1,
+      name='id', full_name='nlaapi.NexthopKey.id', index=1,
+      number=2, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1599,101 +1720,77 @@ _STAT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1458,
-  serialized_end=1490,
+  serialized_start=1675,
+  serialized_end=1713,
 )
 
 
-_NODE = _descriptor.Descriptor(
-  name='Node',
-  full_name='nlaapi.Node',
+_RULEKEY = _descriptor.Descriptor(
+  name='RuleKey',
+  full_name='nlaapi.RuleKey',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='ip', full_name='nlaapi.Node.ip', index=0,
-      number=1, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='n_id', full_name='nlaapi.RuleKey.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='n_id', full_name='nlaapi.Node.n_id', index=1,
-      number=2, type=13, cpp_type=3, label=1,
+      name='family', full_name='nlaapi.RuleKey.family', index=1,
+      number=2, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  serialized_options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=1492,
-  serialized_end=1524,
-)
-
-
-_VPN = _descriptor.Descriptor(
-  name='Vpn',
-  full_name='nlaapi.Vpn',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
     _descriptor.FieldDescriptor(
-      name='ip', full_name='nlaapi.Vpn.ip', index=0,
-      number=1, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='priority', full_name='nlaapi.RuleKey.priority', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='mask', full_name='nlaapi.Vpn.mask', index=1,
-      number=2, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='src', full_name='nlaapi.RuleKey.src', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='gw', full_name='nlaapi.Vpn.gw', index=2,
-      number=3, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='dst', full_name='nlaapi.RuleKey.dst', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='label', full_name='nlaapi.Vpn.label', index=3,
-      number=4, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
+      name='iif_name', full_name='nlaapi.RuleKey.iif_name', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vpn_gw', full_name='nlaapi.Vpn.vpn_gw', index=4,
-      number=5, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='oif_name', full_name='nlaapi.RuleKey.oif_name', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='n_id', full_name='nlaapi.Vpn.n_id', index=5,
-      number=6, type=13, cpp_type=3, label=1,
+      name='mark', full_name='nlaapi.RuleKey.mark', index=7,
+      number=8, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vpn_id', full_name='nlaapi.Vpn.vpn_id', index=6,
-      number=7, type=13, cpp_type=3, label=1,
+      name='table', full_name='nlaapi.RuleKey.table', index=8,
+      number=9, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1710,43 +1807,50 @@ _VPN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1526,
-  serialized_end=1630,
+  serialized_start=1716,
+  serialized_end=1864,
 )
 
 
-_IPTUN = _descriptor.Descriptor(
-  name='Iptun',
-  full_name='nlaapi.Iptun',
+_MROUTEKEY = _descriptor.Descriptor(
+  name='MrouteKey',
+  full_name='nlaapi.MrouteKey',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='link', full_name='nlaapi.Iptun.link', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='n_id', full_name='nlaapi.MrouteKey.n_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='local_mac', full_name='nlaapi.Iptun.local_mac', index=1,
-      number=2, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='family', full_name='nlaapi.MrouteKey.family', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='n_id', full_name='nlaapi.Iptun.n_id', index=2,
-      number=3, type=13, cpp_type=3, label=1,
+      name='table', full_name='nlaapi.MrouteKey.table', index=2,
+      number=3, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='tnl_id', full_name='nlaapi.Iptun.tnl_id', index=3,
-      number=4, type=13, cpp_type=3, label=1,
-      has_default_value=False, default_value=0,
+      name='src', full_name='nlaapi.MrouteKey.src', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='nlaapi.MrouteKey.group', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -1762,44 +1866,245 @@ _IPTUN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1632,
-  serialized_end=1716,
+  serialized_start=1866,
+  serialized_end=1950,
 )
 
 
-_BRIDGEVLANINFO = _descriptor.Descriptor(
-  name='BridgeVlanInfo',
-  full_name='nlaapi.BridgeVlanInfo',
+_STAT = _descriptor.Descriptor(
+  name='Stat',
+  full_name='nlaapi.Stat',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='flags', full_name='nlaapi.BridgeVlanInfo.flags', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='key', full_name='nlaapi.Stat.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='vid', full_name='nlaapi.BridgeVlanInfo.vid', index=1,
-      number=2, type=13, cpp_type=3, label=1,
+      name='val', full_name='nlaapi.Stat.val', index=1,
+      number=2, type=4, cpp_type=4, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1952,
+  serialized_end=1984,
+)
+
+
+_NODE = _descriptor.Descriptor(
+  name='Node',
+  full_name='nlaapi.Node',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='index', full_name='nlaapi.BridgeVlanInfo.index', index=2,
-      number=3, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='ip', full_name='nlaapi.Node.ip', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='name', full_name='nlaapi.BridgeVlanInfo.name', index=3,
-      number=4, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
+      name='n_id', full_name='nlaapi.Node.n_id', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1986,
+  serialized_end=2018,
+)
+
+
+_VPN = _descriptor.Descriptor(
+  name='Vpn',
+  full_name='nlaapi.Vpn',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ip', full_name='nlaapi.Vpn.ip', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mask', full_name='nlaapi.Vpn.mask', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='gw', full_name='nlaapi.Vpn.gw', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='label', full_name='nlaapi.Vpn.label', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vpn_gw', full_name='nlaapi.Vpn.vpn_gw', index=4,
+      number=5, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.Vpn.n_id', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vpn_id', full_name='nlaapi.Vpn.vpn_id', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2020,
+  serialized_end=2124,
+)
+
+
+_IPTUN = _descriptor.Descriptor(
+  name='Iptun',
+  full_name='nlaapi.Iptun',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='link', full_name='nlaapi.Iptun.link', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='local_mac', full_name='nlaapi.Iptun.local_mac', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.Iptun.n_id', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tnl_id', full_name='nlaapi.Iptun.tnl_id', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2126,
+  serialized_end=2210,
+)
+
+
+_BRIDGEVLANINFO = _descriptor.Descriptor(
+  name='BridgeVlanInfo',
+  full_name='nlaapi.BridgeVlanInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='flags', full_name='nlaapi.BridgeVlanInfo.flags', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vid', full_name='nlaapi.BridgeVlanInfo.vid', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='index', full_name='nlaapi.BridgeVlanInfo.index', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='nlaapi.BridgeVlanInfo.name', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
@@ -1844,8 +2149,8 @@ _BRIDGEVLANINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1719,
-  serialized_end=2049,
+  serialized_start=2213,
+  serialized_end=2543,
 )
 
 
@@ -1924,8 +2229,8 @@ _BONDSLAVEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2052,
-  serialized_end=2307,
+  serialized_start=2546,
+  serialized_end=2801,
 )
 
 
@@ -2049,8 +2354,8 @@ _LINKATTRS = _descriptor.Descriptor(
       name='slave_info', full_name='nlaapi.LinkAttrs.slave_info',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=2310,
-  serialized_end=2641,
+  serialized_start=2804,
+  serialized_end=3135,
 )
 
 
@@ -2080,8 +2385,8 @@ _GENERICLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2643,
-  serialized_end=2700,
+  serialized_start=3137,
+  serialized_end=3194,
 )
 
 
@@ -2111,8 +2416,8 @@ _DEVICELINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2702,
-  serialized_end=2758,
+  serialized_start=3196,
+  serialized_end=3252,
 )
 
 
@@ -2163,8 +2468,8 @@ _BRIDGELINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2761,
-  serialized_end=2889,
+  serialized_start=3255,
+  serialized_end=3383,
 )
 
 
@@ -2201,8 +2506,8 @@ _VLANLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2891,
-  serialized_end=2962,
+  serialized_start=3385,
+  serialized_end=3456,
 )
 
 
@@ -2365,8 +2670,8 @@ _VXLANLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2965,
-  serialized_end=3326,
+  serialized_start=3459,
+  serialized_end=3820,
 )
 
 
@@ -2431,8 +2736,8 @@ _VTILINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3329,
-  serialized_end=3457,
+  serialized_start=3823,
+  serialized_end=3951,
 )
 
 
@@ -2469,8 +2774,8 @@ _VETHLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3459,
-  serialized_end=3532,
+  serialized_start=3953,
+  serialized_end=4026,
 )
 
 
@@ -2528,8 +2833,8 @@ _BONDADINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3534,
-  serialized_end=3649,
+  serialized_start=4028,
+  serialized_end=4143,
 )
 
 
@@ -2720,8 +3025,8 @@ _BONDLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3652,
-  serialized_end=4412,
+  serialized_start=4146,
+  serialized_end=4906,
 )
 
 
@@ -2828,8 +3133,8 @@ _IPTUNLINKATTRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4415,
-  serialized_end=4664,
+  serialized_start=4909,
+  serialized_end=5158,
 )
 
 
@@ -2939,8 +3244,8 @@ _LINK = _descriptor.Descriptor(
       name='link_attrs', full_name='nlaapi.Link.link_attrs',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=4667,
-  serialized_end=5097,
+  serialized_start=5161,
+  serialized_end=5591,
 )
 
 
@@ -3047,8 +3352,8 @@ _ADDR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5100,
-  serialized_end=5292,
+  serialized_start=5594,
+  serialized_end=5786,
 )
 
 
@@ -3071,8 +3376,8 @@ _NEIGHNOTUN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5294,
-  serialized_end=5306,
+  serialized_start=5788,
+  serialized_end=5800,
 )
 
 
@@ -3109,8 +3414,8 @@ _NEIGHIPTUN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5308,
-  serialized_end=5354,
+  serialized_start=5802,
+  serialized_end=5848,
 )
 
 
@@ -3241,8 +3546,8 @@ _NEIGH = _descriptor.Descriptor(
       name='tunnel', full_name='nlaapi.Neigh.tunnel',
       index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=5357,
-  serialized_end=5660,
+  serialized_start=5851,
+  serialized_end=6154,
 )
 
 
@@ -3307,8 +3612,8 @@ _NEXTHOPINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5663,
-  serialized_end=5805,
+  serialized_start=6157,
+  serialized_end=6299,
 )
 
 
@@ -3338,8 +3643,8 @@ _MPLSDESTINATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5807,
-  serialized_end=5840,
+  serialized_start=6301,
+  serialized_end=6334,
 )
 
 
@@ -3351,16 +3656,470 @@ _DESTINATION = _descriptor.Descriptor(
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='family', full_name='nlaapi.Destination.family', index=0,
-      number=1, type=5, cpp_type=1, label=1,
+      name='family', full_name='nlaapi.Destination.family', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mpls', full_name='nlaapi.Destination.mpls', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='dest', full_name='nlaapi.Destination.dest',
+      index=0, containing_type=None, fields=[]),
+  ],
+  serialized_start=6336,
+  serialized_end=6414,
+)
+
+
+_MPLSENCAP = _descriptor.Descriptor(
+  name='MPLSEncap',
+  full_name='nlaapi.MPLSEncap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='nlaapi.MPLSEncap.labels', index=0,
+      number=1, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6416,
+  serialized_end=6443,
+)
+
+
+_SEG6ENCAP = _descriptor.Descriptor(
+  name='SEG6Encap',
+  full_name='nlaapi.SEG6Encap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='nlaapi.SEG6Encap.mode', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='segments', full_name='nlaapi.SEG6Encap.segments', index=1,
+      number=2, type=12, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6445,
+  serialized_end=6488,
+)
+
+
+_SEG6LOCALENCAP = _descriptor.Descriptor(
+  name='SEG6LocalEncap',
+  full_name='nlaapi.SEG6LocalEncap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='action', full_name='nlaapi.SEG6LocalEncap.action', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='segments', full_name='nlaapi.SEG6LocalEncap.segments', index=1,
+      number=2, type=12, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='nlaapi.SEG6LocalEncap.table', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='in_addr', full_name='nlaapi.SEG6LocalEncap.in_addr', index=3,
+      number=4, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='in6_addr', full_name='nlaapi.SEG6LocalEncap.in6_addr', index=4,
+      number=5, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='iif', full_name='nlaapi.SEG6LocalEncap.iif', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='oif', full_name='nlaapi.SEG6LocalEncap.oif', index=6,
+      number=7, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6490,
+  serialized_end=6616,
+)
+
+
+_ENCAP = _descriptor.Descriptor(
+  name='Encap',
+  full_name='nlaapi.Encap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='nlaapi.Encap.type', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mpls', full_name='nlaapi.Encap.mpls', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='seg6', full_name='nlaapi.Encap.seg6', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='seg6local', full_name='nlaapi.Encap.seg6local', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='encap', full_name='nlaapi.Encap.encap',
+      index=0, containing_type=None, fields=[]),
+  ],
+  serialized_start=6619,
+  serialized_end=6764,
+)
+
+
+_NEXTHOPGROUPENTRY = _descriptor.Descriptor(
+  name='NexthopGroupEntry',
+  full_name='nlaapi.NexthopGroupEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='nlaapi.NexthopGroupEntry.id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='weight', full_name='nlaapi.NexthopGroupEntry.weight', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6766,
+  serialized_end=6813,
+)
+
+
+_NEXTHOP = _descriptor.Descriptor(
+  name='Nexthop',
+  full_name='nlaapi.Nexthop',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='nlaapi.Nexthop.id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='family', full_name='nlaapi.Nexthop.family', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='protocol', full_name='nlaapi.Nexthop.protocol', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flags', full_name='nlaapi.Nexthop.flags', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='link_index', full_name='nlaapi.Nexthop.link_index', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='gw', full_name='nlaapi.Nexthop.gw', index=5,
+      number=6, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='blackhole', full_name='nlaapi.Nexthop.blackhole', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='nlaapi.Nexthop.group', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.Nexthop.n_id', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6816,
+  serialized_end=6993,
+)
+
+
+_RULE = _descriptor.Descriptor(
+  name='Rule',
+  full_name='nlaapi.Rule',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='priority', full_name='nlaapi.Rule.priority', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='family', full_name='nlaapi.Rule.family', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='nlaapi.Rule.table', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mark', full_name='nlaapi.Rule.mark', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mask', full_name='nlaapi.Rule.mask', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='goto', full_name='nlaapi.Rule.goto', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src', full_name='nlaapi.Rule.src', index=6,
+      number=7, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src_mask', full_name='nlaapi.Rule.src_mask', index=7,
+      number=8, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst', full_name='nlaapi.Rule.dst', index=8,
+      number=9, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst_mask', full_name='nlaapi.Rule.dst_mask', index=9,
+      number=10, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='iif_name', full_name='nlaapi.Rule.iif_name', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='oif_name', full_name='nlaapi.Rule.oif_name', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='invert', full_name='nlaapi.Rule.invert', index=12,
+      number=13, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='action', full_name='nlaapi.Rule.action', index=13,
+      number=14, type=13, cpp_type=3, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='mpls', full_name='nlaapi.Destination.mpls', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='n_id', full_name='nlaapi.Rule.n_id', index=14,
+      number=15, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -3375,26 +4134,30 @@ _DESTINATION = _descriptor.Descriptor(
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
-    _descriptor.OneofDescriptor(
-      name='dest', full_name='nlaapi.Destination.dest',
-      index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=5842,
-  serialized_end=5920,
+  serialized_start=6996,
+  serialized_end=7237,
 )
 
 
-_MPLSENCAP = _descriptor.Descriptor(
-  name='MPLSEncap',
-  full_name='nlaapi.MPLSEncap',
+_MROUTEOIF = _descriptor.Descriptor(
+  name='MrouteOif',
+  full_name='nlaapi.MrouteOif',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='labels', full_name='nlaapi.MPLSEncap.labels', index=0,
-      number=1, type=13, cpp_type=3, label=3,
-      has_default_value=False, default_value=[],
+      name='index', full_name='nlaapi.MrouteOif.index', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ttl', full_name='nlaapi.MrouteOif.ttl', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -3410,29 +4173,71 @@ _MPLSENCAP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5922,
-  serialized_end=5949,
+  serialized_start=7239,
+  serialized_end=7278,
 )
 
 
-_ENCAP = _descriptor.Descriptor(
-  name='Encap',
-  full_name='nlaapi.Encap',
+_MROUTE = _descriptor.Descriptor(
+  name='Mroute',
+  full_name='nlaapi.Mroute',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='type', full_name='nlaapi.Encap.type', index=0,
-      number=1, type=13, cpp_type=3, label=1,
+      name='family', full_name='nlaapi.Mroute.family', index=0,
+      number=1, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='mpls', full_name='nlaapi.Encap.mpls', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='table', full_name='nlaapi.Mroute.table', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src', full_name='nlaapi.Mroute.src', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='nlaapi.Mroute.group', index=3,
+      number=4, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='iif', full_name='nlaapi.Mroute.iif', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='oifs', full_name='nlaapi.Mroute.oifs', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='n_id', full_name='nlaapi.Mroute.n_id', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mr_id', full_name='nlaapi.Mroute.mr_id', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
@@ -3447,12 +4252,9 @@ _ENCAP = _descriptor.Descriptor(
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
-    _descriptor.OneofDescriptor(
-      name='encap', full_name='nlaapi.Encap.encap',
-      index=0, containing_type=None, fields=[]),
   ],
-  serialized_start=5951,
-  serialized_end=6016,
+  serialized_start=7281,
+  serialized_end=7423,
 )
 
 
@@ -3489,8 +4291,8 @@ _ENCAPINFOKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6018,
-  serialized_end=6058,
+  serialized_start=7425,
+  serialized_end=7465,
 )
 
 
@@ -3541,8 +4343,8 @@ _ENCAPINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6060,
-  serialized_end=6125,
+  serialized_start=7467,
+  serialized_end=7532,
 )
 
 
@@ -3700,6 +4502,128 @@ _ROUTE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nh_id', full_name='nlaapi.Route.nh_id', index=21,
+      number=22, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7535,
+  serialized_end=7951,
+)
+
+
+_SNAPSHOT = _descriptor.Descriptor(
+  name='Snapshot',
+  full_name='nlaapi.Snapshot',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='nlaapi.Snapshot.timestamp', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='links', full_name='nlaapi.Snapshot.links', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='iptuns', full_name='nlaapi.Snapshot.iptuns', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='addrs', full_name='nlaapi.Snapshot.addrs', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='neighs', full_name='nlaapi.Snapshot.neighs', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='routes', full_name='nlaapi.Snapshot.routes', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mplss', full_name='nlaapi.Snapshot.mplss', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vpns', full_name='nlaapi.Snapshot.vpns', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='encap_infos', full_name='nlaapi.Snapshot.encap_infos', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='br_vlans', full_name='nlaapi.Snapshot.br_vlans', index=9,
+      number=10, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nexthops', full_name='nlaapi.Snapshot.nexthops', index=10,
+      number=11, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rules', full_name='nlaapi.Snapshot.rules', index=11,
+      number=12, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mroutes', full_name='nlaapi.Snapshot.mroutes', index=12,
+      number=13, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -3712,8 +4636,8 @@ _ROUTE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6128,
-  serialized_end=6529,
+  serialized_start=7954,
+  serialized_end=8370,
 )
 
 _NETLINKMESSAGE.fields_by_name['header'].message_type = _NLMSGHDR
@@ -3725,6 +4649,9 @@ _NLMSGUNI.fields_by_name['route'].message_type = _ROUTE
 _NLMSGUNI.fields_by_name['node'].message_type = _NODE
 _NLMSGUNI.fields_by_name['vpn'].message_type = _VPN
 _NLMSGUNI.fields_by_name['br_vlan_info'].message_type = _BRIDGEVLANINFO
+_NLMSGUNI.fields_by_name['nexthop'].message_type = _NEXTHOP
+_NLMSGUNI.fields_by_name['rule'].message_type = _RULE
+_NLMSGUNI.fields_by_name['mroute'].message_type = _MROUTE
 _NLMSGUNI.oneofs_by_name['msg'].fields.append(
   _NLMSGUNI.fields_by_name['link'])
 _NLMSGUNI.fields_by_name['link'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg']
@@ -3746,6 +4673,15 @@ _NLMSGUNI.fields_by_name['vpn'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg
 _NLMSGUNI.oneofs_by_name['msg'].fields.append(
   _NLMSGUNI.fields_by_name['br_vlan_info'])
 _NLMSGUNI.fields_by_name['br_vlan_info'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg']
+_NLMSGUNI.oneofs_by_name['msg'].fields.append(
+  _NLMSGUNI.fields_by_name['nexthop'])
+_NLMSGUNI.fields_by_name['nexthop'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg']
+_NLMSGUNI.oneofs_by_name['msg'].fields.append(
+  _NLMSGUNI.fields_by_name['rule'])
+_NLMSGUNI.fields_by_name['rule'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg']
+_NLMSGUNI.oneofs_by_name['msg'].fields.append(
+  _NLMSGUNI.fields_by_name['mroute'])
+_NLMSGUNI.fields_by_name['mroute'].containing_oneof = _NLMSGUNI.oneofs_by_name['msg']
 _NETLINKMESSAGEUNION.fields_by_name['header'].message_type = _NLMSGHDR
 _NETLINKMESSAGEUNION.fields_by_name['msg'].message_type = _NLMSGUNI
 _NETLINKMESSAGEUNION.fields_by_name['src'].enum_type = _NLMSGSRC
@@ -3830,12 +4766,34 @@ _DESTINATION.oneofs_by_name['dest'].fields.append(
   _DESTINATION.fields_by_name['mpls'])
 _DESTINATION.fields_by_name['mpls'].containing_oneof = _DESTINATION.oneofs_by_name['dest']
 _ENCAP.fields_by_name['mpls'].message_type = _MPLSENCAP
+_ENCAP.fields_by_name['seg6'].message_type = _SEG6ENCAP
+_ENCAP.fields_by_name['seg6local'].message_type = _SEG6LOCALENCAP
 _ENCAP.oneofs_by_name['encap'].fields.append(
   _ENCAP.fields_by_name['mpls'])
 _ENCAP.fields_by_name['mpls'].containing_oneof = _ENCAP.oneofs_by_name['encap']
+_ENCAP.oneofs_by_name['encap'].fields.append(
+  _ENCAP.fields_by_name['seg6'])
+_ENCAP.fields_by_name['seg6'].containing_oneof = _ENCAP.oneofs_by_name['encap']
+_ENCAP.oneofs_by_name['encap'].fields.append(
+  _ENCAP.fields_by_name['seg6local'])
+_ENCAP.fields_by_name['seg6local'].containing_oneof = _ENCAP.oneofs_by_name['encap']
+_NEXTHOP.fields_by_name['group'].message_type = _NEXTHOPGROUPENTRY
+_MROUTE.fields_by_name['oifs'].message_type = _MROUTEOIF
 _ROUTE.fields_by_name['multi_path'].message_type = _NEXTHOPINFO
 _ROUTE.fields_by_name['new_dst'].message_type = _DESTINATION
 _ROUTE.fields_by_name['encap'].message_type = _ENCAP
+_SNAPSHOT.fields_by_name['links'].message_type = _LINK
+_SNAPSHOT.fields_by_name['iptuns'].message_type = _IPTUN
+_SNAPSHOT.fields_by_name['addrs'].message_type = _ADDR
+_SNAPSHOT.fields_by_name['neighs'].message_type = _NEIGH
+_SNAPSHOT.fields_by_name['routes'].message_type = _ROUTE
+_SNAPSHOT.fields_by_name['mplss'].message_type = _ROUTE
+_SNAPSHOT.fields_by_name['vpns'].message_type = _VPN
+_SNAPSHOT.fields_by_name['encap_infos'].message_type = _ENCAPINFO
+_SNAPSHOT.fields_by_name['br_vlans'].message_type = _BRIDGEVLANINFO
+_SNAPSHOT.fields_by_name['nexthops'].message_type = _NEXTHOP
+_SNAPSHOT.fields_by_name['rules'].message_type = _RULE
+_SNAPSHOT.fields_by_name['mroutes'].message_type = _MROUTE
 DESCRIPTOR.message_types_by_name['NlMsghdr'] = _NLMSGHDR
 DESCRIPTOR.message_types_by_name['NetlinkMessage'] = _NETLINKMESSAGE
 DESCRIPTOR.message_types_by_name['NetlinkMessageReply'] = _NETLINKMESSAGEREPLY
@@ -3851,6 +4809,9 @@ DESCRIPTOR.message_types_by_name['GetNeighsRequest'] = _GETNEIGHSREQUEST
 DESCRIPTOR.message_types_by_name['GetRoutesRequest'] = _GETROUTESREQUEST
 DESCRIPTOR.message_types_by_name['GetMplssRequest'] = _GETMPLSSREQUEST
 DESCRIPTOR.message_types_by_name['GetBridgeVlanInfosRequest'] = _GETBRIDGEVLANINFOSREQUEST
+DESCRIPTOR.message_types_by_name['GetNexthopsRequest'] = _GETNEXTHOPSREQUEST
+DESCRIPTOR.message_types_by_name['GetRulesRequest'] = _GETRULESREQUEST
+DESCRIPTOR.message_types_by_name['GetMroutesRequest'] = _GETMROUTESREQUEST
 DESCRIPTOR.message_types_by_name['GetNodesRequest'] = _GETNODESREQUEST
 DESCRIPTOR.message_types_by_name['GetVpnsRequest'] = _GETVPNSREQUEST
 DESCRIPTOR.message_types_by_name['GetEncapInfosRequest'] = _GETENCAPINFOSREQUEST
@@ -3865,6 +4826,9 @@ DESCRIPTOR.message_types_by_name['NodeKey'] = _NODEKEY
 DESCRIPTOR.message_types_by_name['VpnKey'] = _VPNKEY
 DESCRIPTOR.message_types_by_name['IptunKey'] = _IPTUNKEY
 DESCRIPTOR.message_types_by_name['BridgeVlanInfoKey'] = _BRIDGEVLANINFOKEY
+DESCRIPTOR.message_types_by_name['NexthopKey'] = _NEXTHOPKEY
+DESCRIPTOR.message_types_by_name['RuleKey'] = _RULEKEY
+DESCRIPTOR.message_types_by_name['MrouteKey'] = _MROUTEKEY
 DESCRIPTOR.message_types_by_name['Stat'] = _STAT
 DESCRIPTOR.message_types_by_name['Node'] = _NODE
 DESCRIPTOR.message_types_by_name['Vpn'] = _VPN
@@ -3891,10 +4855,18 @@ DESCRIPTOR.message_types_by_name['NexthopInfo'] = _NEXTHOPINFO
 DESCRIPTOR.message_types_by_name['MPLSDestination'] = _MPLSDESTINATION
 DESCRIPTOR.message_types_by_name['Destination'] = _DESTINATION
 DESCRIPTOR.message_types_by_name['MPLSEncap'] = _MPLSENCAP
+DESCRIPTOR.message_types_by_name['SEG6Encap'] = _SEG6ENCAP
+DESCRIPTOR.message_types_by_name['SEG6LocalEncap'] = _SEG6LOCALENCAP
 DESCRIPTOR.message_types_by_name['Encap'] = _ENCAP
+DESCRIPTOR.message_types_by_name['NexthopGroupEntry'] = _NEXTHOPGROUPENTRY
+DESCRIPTOR.message_types_by_name['Nexthop'] = _NEXTHOP
+DESCRIPTOR.message_types_by_name['Rule'] = _RULE
+DESCRIPTOR.message_types_by_name['MrouteOif'] = _MROUTEOIF
+DESCRIPTOR.message_types_by_name['Mroute'] = _MROUTE
 DESCRIPTOR.message_types_by_name['EncapInfoKey'] = _ENCAPINFOKEY
 DESCRIPTOR.message_types_by_name['EncapInfo'] = _ENCAPINFO
 DESCRIPTOR.message_types_by_name['Route'] = _ROUTE
+DESCRIPTOR.message_types_by_name['Snapshot'] = _SNAPSHOT
 DESCRIPTOR.enum_types_by_name['NlMsgSrc'] = _NLMSGSRC
 DESCRIPTOR.enum_types_by_name['LinkOperState'] = _LINKOPERSTATE
 DESCRIPTOR.enum_types_by_name['BondLinkState'] = _BONDLINKSTATE
@@ -4014,6 +4986,27 @@ GetBridgeVlanInfosRequest = _reflection.GeneratedProtocolMessageType('GetBridgeV
   ))
 _sym_db.RegisterMessage(GetBridgeVlanInfosRequest)
 
+GetNexthopsRequest = _reflection.GeneratedProtocolMessageType('GetNexthopsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETNEXTHOPSREQUEST,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.GetNexthopsRequest)
+  ))
+_sym_db.RegisterMessage(GetNexthopsRequest)
+
+GetRulesRequest = _reflection.GeneratedProtocolMessageType('GetRulesRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETRULESREQUEST,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.GetRulesRequest)
+  ))
+_sym_db.RegisterMessage(GetRulesRequest)
+
+GetMroutesRequest = _reflection.GeneratedProtocolMessageType('GetMroutesRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETMROUTESREQUEST,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.GetMroutesRequest)
+  ))
+_sym_db.RegisterMessage(GetMroutesRequest)
+
 GetNodesRequest = _reflection.GeneratedProtocolMessageType('GetNodesRequest', (_message.Message,), dict(
   DESCRIPTOR = _GETNODESREQUEST,
   __module__ = 'nlaapi_pb2'
@@ -4112,6 +5105,27 @@ BridgeVlanInfoKey = _reflection.GeneratedProtocolMessageType('BridgeVlanInfoKey'
   ))
 _sym_db.RegisterMessage(BridgeVlanInfoKey)
 
+NexthopKey = _reflection.GeneratedProtocolMessageType('NexthopKey', (_message.Message,), dict(
+  DESCRIPTOR = _NEXTHOPKEY,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.NexthopKey)
+  ))
+_sym_db.RegisterMessage(NexthopKey)
+
+RuleKey = _reflection.GeneratedProtocolMessageType('RuleKey', (_message.Message,), dict(
+  DESCRIPTOR = _RULEKEY,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.RuleKey)
+  ))
+_sym_db.RegisterMessage(RuleKey)
+
+MrouteKey = _reflection.GeneratedProtocolMessageType('MrouteKey', (_message.Message,), dict(
+  DESCRIPTOR = _MROUTEKEY,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.MrouteKey)
+  ))
+_sym_db.RegisterMessage(MrouteKey)
+
 Stat = _reflection.GeneratedProtocolMessageType('Stat', (_message.Message,), dict(
   DESCRIPTOR = _STAT,
   __module__ = 'nlaapi_pb2'
@@ -4294,6 +5308,20 @@ MPLSEncap = _reflection.GeneratedProtocolMessageType('MPLSEncap', (_message.Mess
   ))
 _sym_db.RegisterMessage(MPLSEncap)
 
+SEG6Encap = _reflection.GeneratedProtocolMessageType('SEG6Encap', (_message.Message,), dict(
+  DESCRIPTOR = _SEG6ENCAP,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.SEG6Encap)
+  ))
+_sym_db.RegisterMessage(SEG6Encap)
+
+SEG6LocalEncap = _reflection.GeneratedProtocolMessageType('SEG6LocalEncap', (_message.Message,), dict(
+  DESCRIPTOR = _SEG6LOCALENCAP,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.SEG6LocalEncap)
+  ))
+_sym_db.RegisterMessage(SEG6LocalEncap)
+
 Encap = _reflection.GeneratedProtocolMessageType('Encap', (_message.Message,), dict(
   DESCRIPTOR = _ENCAP,
   __module__ = 'nlaapi_pb2'
@@ -4301,6 +5329,41 @@ Encap = _reflection.GeneratedProtocolMessageType('Encap', (_message.Message,), d
   ))
 _sym_db.RegisterMessage(Encap)
 
+NexthopGroupEntry = _reflection.GeneratedProtocolMessageType('NexthopGroupEntry', (_message.Message,), dict(
+  DESCRIPTOR = _NEXTHOPGROUPENTRY,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.NexthopGroupEntry)
+  ))
+_sym_db.RegisterMessage(NexthopGroupEntry)
+
+Nexthop = _reflection.GeneratedProtocolMessageType('Nexthop', (_message.Message,), dict(
+  DESCRIPTOR = _NEXTHOP,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.Nexthop)
+  ))
+_sym_db.RegisterMessage(Nexthop)
+
+Rule = _reflection.GeneratedProtocolMessageType('Rule', (_message.Message,), dict(
+  DESCRIPTOR = _RULE,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.Rule)
+  ))
+_sym_db.RegisterMessage(Rule)
+
+MrouteOif = _reflection.GeneratedProtocolMessageType('MrouteOif', (_message.Message,), dict(
+  DESCRIPTOR = _MROUTEOIF,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.MrouteOif)
+  ))
+_sym_db.RegisterMessage(MrouteOif)
+
+Mroute = _reflection.GeneratedProtocolMessageType('Mroute', (_message.Message,), dict(
+  DESCRIPTOR = _MROUTE,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.Mroute)
+  ))
+_sym_db.RegisterMessage(Mroute)
+
 EncapInfoKey = _reflection.GeneratedProtocolMessageType('EncapInfoKey', (_message.Message,), dict(
   DESCRIPTOR = _ENCAPINFOKEY,
   __module__ = 'nlaapi_pb2'
@@ -4322,6 +5385,13 @@ Route = _reflection.GeneratedProtocolMessageType('Route', (_message.Message,), d
   ))
 _sym_db.RegisterMessage(Route)
 
+Snapshot = _reflection.GeneratedProtocolMessageType('Snapshot', (_message.Message,), dict(
+  DESCRIPTOR = _SNAPSHOT,
+  __module__ = 'nlaapi_pb2'
+  # @@protoc_insertion_point(class_scope:nlaapi.Snapshot)
+  ))
+_sym_db.RegisterMessage(Snapshot)
+
 
 
 _NLACOREAPI = _descriptor.ServiceDescriptor(
@@ -4330,8 +5400,8 @@ _NLACOREAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=8030,
-  serialized_end=8187,
+  serialized_start=9871,
+  serialized_end=10028,
   methods=[
   _descriptor.MethodDescriptor(
     name='SendNetlinkMessage',
@@ -4363,8 +5433,8 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   serialized_options=None,
-  serialized_start=8190,
-  serialized_end=9547,
+  serialized_start=10031,
+  serialized_end=11715,
   methods=[
   _descriptor.MethodDescriptor(
     name='ModVpn',
@@ -4483,10 +5553,37 @@ _NLAAPI = _descriptor.ServiceDescriptor(
     output_type=_BRIDGEVLANINFO,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetNexthop',
+    full_name='nlaapi.NLAApi.GetNexthop',
+    index=13,
+    containing_service=None,
+    input_type=_NEXTHOPKEY,
+    output_type=_NEXTHOP,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetRule',
+    full_name='nlaapi.NLAApi.GetRule',
+    index=14,
+    containing_service=None,
+    input_type=_RULEKEY,
+    output_type=_RULE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetMroute',
+    full_name='nlaapi.NLAApi.GetMroute',
+    index=15,
+    containing_service=None,
+    input_type=_MROUTEKEY,
+    output_type=_MROUTE,
+    serialized_options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='GetLinks',
     full_name='nlaapi.NLAApi.GetLinks',
-    index=13,
+    index=16,
     containing_service=None,
     input_type=_GETLINKSREQUEST,
     output_type=_LINK,
@@ -4495,7 +5592,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetAddrs',
     full_name='nlaapi.NLAApi.GetAddrs',
-    index=14,
+    index=17,
     containing_service=None,
     input_type=_GETADDRSREQUEST,
     output_type=_ADDR,
@@ -4504,7 +5601,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetNeighs',
     full_name='nlaapi.NLAApi.GetNeighs',
-    index=15,
+    index=18,
     containing_service=None,
     input_type=_GETNEIGHSREQUEST,
     output_type=_NEIGH,
@@ -4513,7 +5610,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetRoutes',
     full_name='nlaapi.NLAApi.GetRoutes',
-    index=16,
+    index=19,
     containing_service=None,
     input_type=_GETROUTESREQUEST,
     output_type=_ROUTE,
@@ -4522,7 +5619,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetMplss',
     full_name='nlaapi.NLAApi.GetMplss',
-    index=17,
+    index=20,
     containing_service=None,
     input_type=_GETMPLSSREQUEST,
     output_type=_ROUTE,
@@ -4531,7 +5628,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetNodes',
     full_name='nlaapi.NLAApi.GetNodes',
-    index=18,
+    index=21,
     containing_service=None,
     input_type=_GETNODESREQUEST,
     output_type=_NODE,
@@ -4540,7 +5637,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetVpns',
     full_name='nlaapi.NLAApi.GetVpns',
-    index=19,
+    index=22,
     containing_service=None,
     input_type=_GETVPNSREQUEST,
     output_type=_VPN,
@@ -4549,7 +5646,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetEncapInfos',
     full_name='nlaapi.NLAApi.GetEncapInfos',
-    index=20,
+    index=23,
     containing_service=None,
     input_type=_GETENCAPINFOSREQUEST,
     output_type=_ENCAPINFO,
@@ -4558,7 +5655,7 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetIptuns',
     full_name='nlaapi.NLAApi.GetIptuns',
-    index=21,
+    index=24,
     containing_service=None,
     input_type=_GETIPTUNSREQUEST,
     output_type=_IPTUN,
@@ -4567,16 +5664,43 @@ _NLAAPI = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetBridgeVlanInfos',
     full_name='nlaapi.NLAApi.GetBridgeVlanInfos',
-    index=22,
+    index=25,
     containing_service=None,
     input_type=_GETBRIDGEVLANINFOSREQUEST,
     output_type=_BRIDGEVLANINFO,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetNexthops',
+    full_name='nlaapi.NLAApi.GetNexthops',
+    index=26,
+    containing_service=None,
+    input_type=_GETNEXTHOPSREQUEST,
+    output_type=_NEXTHOP,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetRules',
+    full_name='nlaapi.NLAApi.GetRules',
+    index=27,
+    containing_service=None,
+    input_type=_GETRULESREQUEST,
+    output_type=_RULE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetMroutes',
+    full_name='nlaapi.NLAApi.GetMroutes',
+    index=28,
+    containing_service=None,
+    input_type=_GETMROUTESREQUEST,
+    output_type=_MROUTE,
+    serialized_options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='GetStats',
     full_name='nlaapi.NLAApi.GetStats',
-    index=23,
+    index=29,
     containing_service=None,
     input_type=_GETSTATSREQUEST,
     output_type=_STAT,
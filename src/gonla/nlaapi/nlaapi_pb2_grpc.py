@@ -144,6 +144,21 @@ class NLAApiStub(object):
         request_serializer=nlaapi__pb2.BridgeVlanInfoKey.SerializeToString,
         response_deserializer=nlaapi__pb2.BridgeVlanInfo.FromString,
         )
+    self.GetNexthop = channel.unary_unary(
+        '/nlaapi.NLAApi/GetNexthop',
+        request_serializer=nlaapi__pb2.NexthopKey.SerializeToString,
+        response_deserializer=nlaapi__pb2.Nexthop.FromString,
+        )
+    self.GetRule = channel.unary_unary(
+        '/nlaapi.NLAApi/GetRule',
+        request_serializer=nlaapi__pb2.RuleKey.SerializeToString,
+        response_deserializer=nlaapi__pb2.Rule.FromString,
+        )
+    self.GetMroute = channel.unary_unary(
+        '/nlaapi.NLAApi/GetMroute',
+        request_serializer=nlaapi__pb2.MrouteKey.SerializeToString,
+        response_deserializer=nlaapi__pb2.Mroute.FromString,
+        )
     self.GetLinks = channel.unary_stream(
         '/nlaapi.NLAApi/GetLinks',
         request_serializer=nlaapi__pb2.GetLinksRequest.SerializeToString,
@@ -194,6 +209,21 @@ class NLAApiStub(object):
         request_serializer=nlaapi__pb2.GetBridgeVlanInfosRequest.SerializeToString,
         response_deserializer=nlaapi__pb2.BridgeVlanInfo.FromString,
         )
+    self.GetNexthops = channel.unary_stream(
+        '/nlaapi.NLAApi/GetNexthops',
+        request_serializer=nlaapi__pb2.GetNexthopsRequest.SerializeToString,
+        response_deserializer=nlaapi__pb2.Nexthop.FromString,
+        )
+    self.GetRules = channel.unary_stream(
+        '/nlaapi.NLAApi/GetRules',
+        request_serializer=nlaapi__pb2.GetRulesRequest.SerializeToString,
+        response_deserializer=nlaapi__pb2.Rule.FromString,
+        )
+    self.GetMroutes = channel.unary_stream(
+        '/nlaapi.NLAApi/GetMroutes',
+        request_serializer=nlaapi__pb2.GetMroutesRequest.SerializeToString,
+        response_deserializer=nlaapi__pb2.Mroute.FromString,
+        )
     self.GetStats = channel.unary_stream(
         '/nlaapi.NLAApi/GetStats',
         request_serializer=nlaapi__pb2.GetStatsRequest.SerializeToString,
@@ -298,6 +328,27 @@ class NLAApiServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetNexthop(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetRule(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetMroute(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetLinks(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -368,6 +419,27 @@ class NLAApiServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetNexthops(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetRules(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetMroutes(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetStats(self, request, context):
     # missing associated documentation comment in .proto file
     pass
@@ -443,6 +515,21 @@ def add_NLAApiServicer_to_server(servicer, server):
           request_deserializer=nlaapi__pb2.BridgeVlanInfoKey.FromString,
           response_serializer=nlaapi__pb2.BridgeVlanInfo.SerializeToString,
       ),
+      'GetNexthop': grpc.unary_unary_rpc_method_handler(
+          servicer.GetNexthop,
+          request_deserializer=nlaapi__pb2.NexthopKey.FromString,
+          response_serializer=nlaapi__pb2.Nexthop.SerializeToString,
+      ),
+      'GetRule': grpc.unary_unary_rpc_method_handler(
+          servicer.GetRule,
+          request_deserializer=nlaapi__pb2.RuleKey.FromString,
+          response_serializer=nlaapi__pb2.Rule.SerializeToString,
+      ),
+      'GetMroute': grpc.unary_unary_rpc_method_handler(
+          servicer.GetMroute,
+          request_deserializer=nlaapi__pb2.MrouteKey.FromString,
+          response_serializer=nlaapi__pb2.Mroute.SerializeToString,
+      ),
       'GetLinks': grpc.unary_stream_rpc_method_handler(
           servicer.GetLinks,
           request_deserializer=nlaapi__pb2.GetLinksRequest.FromString,
@@ -493,6 +580,21 @@ def add_NLAApiServicer_to_server(servicer, server):
           request_deserializer=nlaapi__pb2.GetBridgeVlanInfosRequest.FromString,
           response_serializer=nlaapi__pb2.BridgeVlanInfo.SerializeToString,
       ),
+      'GetNexthops': grpc.unary_stream_rpc_method_handler(
+          servicer.GetNexthops,
+          request_deserializer=nlaapi__pb2.GetNexthopsRequest.FromString,
+          response_serializer=nlaapi__pb2.Nexthop.SerializeToString,
+      ),
+      'GetRules': grpc.unary_stream_rpc_method_handler(
+          servicer.GetRules,
+          request_deserializer=nlaapi__pb2.GetRulesRequest.FromString,
+          response_serializer=nlaapi__pb2.Rule.SerializeToString,
+      ),
+      'GetMroutes': grpc.unary_stream_rpc_method_handler(
+          servicer.GetMroutes,
+          request_deserializer=nlaapi__pb2.GetMroutesRequest.FromString,
+          response_serializer=nlaapi__pb2.Mroute.SerializeToString,
+      ),
       'GetStats': grpc.unary_stream_rpc_method_handler(
           servicer.GetStats,
           request_deserializer=nlaapi__pb2.GetStatsRequest.FromString,
@@ -113,6 +113,12 @@ func (n *NlMsgUni) ToNative(g uint16) interface{} {
 		return n.GetVpn().ToNative()
 	case nlalink.RTMGRP_BRIDGE:
 		return n.GetBrVlanInfo().ToNative()
+	case nlalink.RTMGRP_NEXTHOP:
+		return n.GetNexthop().ToNative()
+	case nlalink.RTMGRP_RULE:
+		return n.GetRule().ToNative()
+	case nlalink.RTMGRP_MROUTE:
+		return n.GetMroute().ToNative()
 	default:
 		return nil
 	}
@@ -143,6 +149,15 @@ func NewNlMsgUni(n interface{}, g uint16) *NlMsgUni {
 	case nlalink.RTMGRP_BRIDGE:
 		msg = &NlMsgUni_BrVlanInfo{BrVlanInfo: n.(*BridgeVlanInfo)}
 
+	case nlalink.RTMGRP_NEXTHOP:
+		msg = &NlMsgUni_Nexthop{Nexthop: n.(*Nexthop)}
+
+	case nlalink.RTMGRP_RULE:
+		msg = &NlMsgUni_Rule{Rule: n.(*Rule)}
+
+	case nlalink.RTMGRP_MROUTE:
+		msg = &NlMsgUni_Mroute{Mroute: n.(*Mroute)}
+
 	default:
 		return nil
 	}
@@ -183,6 +198,15 @@ func NewNlMsgUniFromNative(n interface{}, g uint16) *NlMsgUni {
 	case nlalink.RTMGRP_BRIDGE:
 		return NewNlMsgUni(NewBridgeVlanInfoFromNative(n.(*nlamsg.BridgeVlanInfo)), g)
 
+	case nlalink.RTMGRP_NEXTHOP:
+		return NewNlMsgUni(NewNexthopFromNative(n.(*nlamsg.Nexthop)), g)
+
+	case nlalink.RTMGRP_RULE:
+		return NewNlMsgUni(NewRuleFromNative(n.(*nlamsg.Rule)), g)
+
+	case nlalink.RTMGRP_MROUTE:
+		return NewNlMsgUni(NewMrouteFromNative(n.(*nlamsg.Mroute)), g)
+
 	default:
 		return nil
 	}
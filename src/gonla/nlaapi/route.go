@@ -165,6 +165,87 @@ func NewMPLSEncapFromNatives(n *netlink.MPLSEncap) *MPLSEncap {
 	}
 }
 
+//
+// SEG6Encap
+//
+func (e *SEG6Encap) ToNative() *netlink.SEG6Encap {
+	if e == nil {
+		return nil
+	}
+	segs := make([]net.IP, len(e.Segments))
+	for i, seg := range e.Segments {
+		segs[i] = net.IP(seg)
+	}
+	return &netlink.SEG6Encap{
+		Mode:     int(e.Mode),
+		Segments: segs,
+	}
+}
+
+func NewSEG6EncapFromNative(n *netlink.SEG6Encap) *SEG6Encap {
+	if n == nil {
+		return nil
+	}
+	segs := make([][]byte, len(n.Segments))
+	for i, seg := range n.Segments {
+		segs[i] = seg
+	}
+	return &SEG6Encap{
+		Mode:     int32(n.Mode),
+		Segments: segs,
+	}
+}
+
+//
+// SEG6LocalEncap
+//
+func (e *SEG6LocalEncap) ToNative() *netlink.SEG6LocalEncap {
+	if e == nil {
+		return nil
+	}
+
+	n := &netlink.SEG6LocalEncap{
+		Action:  int(e.Action),
+		Table:   int(e.Table),
+		InAddr:  net.IP(e.InAddr),
+		In6Addr: net.IP(e.In6Addr),
+		Iif:     int(e.Iif),
+		Oif:     int(e.Oif),
+	}
+	for _, seg := range e.Segments {
+		n.Segments = append(n.Segments, net.IP(seg))
+	}
+
+	n.Flags[nl.SEG6_LOCAL_ACTION] = true
+	n.Flags[nl.SEG6_LOCAL_SRH] = len(n.Segments) != 0
+	n.Flags[nl.SEG6_LOCAL_TABLE] = n.Table != 0
+	n.Flags[nl.SEG6_LOCAL_NH4] = len(n.InAddr) != 0
+	n.Flags[nl.SEG6_LOCAL_NH6] = len(n.In6Addr) != 0
+	n.Flags[nl.SEG6_LOCAL_IIF] = n.Iif != 0
+	n.Flags[nl.SEG6_LOCAL_OIF] = n.Oif != 0
+
+	return n
+}
+
+func NewSEG6LocalEncapFromNative(n *netlink.SEG6LocalEncap) *SEG6LocalEncap {
+	if n == nil {
+		return nil
+	}
+	segs := make([][]byte, len(n.Segments))
+	for i, seg := range n.Segments {
+		segs[i] = seg
+	}
+	return &SEG6LocalEncap{
+		Action:   int32(n.Action),
+		Segments: segs,
+		Table:    int32(n.Table),
+		InAddr:   n.InAddr,
+		In6Addr:  n.In6Addr,
+		Iif:      int32(n.Iif),
+		Oif:      int32(n.Oif),
+	}
+}
+
 //
 // Encap
 //
@@ -177,6 +258,12 @@ func (e *Encap) ToNative() netlink.Encap {
 	case nl.LWTUNNEL_ENCAP_MPLS:
 		encap := e.Encap.(*Encap_Mpls)
 		return encap.Mpls.ToNative()
+	case nl.LWTUNNEL_ENCAP_SEG6:
+		encap := e.Encap.(*Encap_Seg6)
+		return encap.Seg6.ToNative()
+	case nl.LWTUNNEL_ENCAP_SEG6_LOCAL:
+		encap := e.Encap.(*Encap_Seg6Local)
+		return encap.Seg6Local.ToNative()
 	default:
 		return nil
 	}
@@ -196,6 +283,22 @@ func NewEncapFromNative(n netlink.Encap) *Encap {
 				Mpls: NewMPLSEncapFromNatives(mpls),
 			},
 		}
+	case nl.LWTUNNEL_ENCAP_SEG6:
+		seg6 := n.(*netlink.SEG6Encap)
+		return &Encap{
+			Type: nl.LWTUNNEL_ENCAP_SEG6,
+			Encap: &Encap_Seg6{
+				Seg6: NewSEG6EncapFromNative(seg6),
+			},
+		}
+	case nl.LWTUNNEL_ENCAP_SEG6_LOCAL:
+		seg6local := n.(*netlink.SEG6LocalEncap)
+		return &Encap{
+			Type: nl.LWTUNNEL_ENCAP_SEG6_LOCAL,
+			Encap: &Encap_Seg6Local{
+				Seg6Local: NewSEG6LocalEncapFromNative(seg6local),
+			},
+		}
 	default:
 		return nil
 	}
@@ -259,6 +362,7 @@ func (r *Route) ToNative() *nlamsg.Route {
 		RtId:  r.RtId,
 		VpnGw: r.NetVpnGw(),
 		EnIds: r.EnIds,
+		NhId:  r.NhId,
 	}
 }
 
@@ -292,6 +396,7 @@ func NewRouteFromNative(r *nlamsg.Route) *Route {
 		RtId:       r.RtId,
 		VpnGw:      r.VpnGw,
 		EnIds:      r.EnIds,
+		NhId:       r.NhId,
 	}
 }
 
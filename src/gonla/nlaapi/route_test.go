@@ -0,0 +1,70 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"net"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+func TestEncap_SEG6(t *testing.T) {
+	n := &netlink.SEG6Encap{
+		Mode:     nl.SEG6_IPTUN_MODE_ENCAP,
+		Segments: []net.IP{net.ParseIP("fc00::1"), net.ParseIP("fc00::2")},
+	}
+
+	e := NewEncapFromNative(n)
+	if e.Type != nl.LWTUNNEL_ENCAP_SEG6 {
+		t.Errorf("NewEncapFromNative type unmatch. %d", e.Type)
+	}
+
+	v, ok := e.ToNative().(*netlink.SEG6Encap)
+	if !ok {
+		t.Fatalf("Encap.ToNative type unmatch. %v", e.ToNative())
+	}
+
+	if !v.Equal(n) {
+		t.Errorf("Encap.ToNative unmatch. %s", v)
+	}
+}
+
+func TestEncap_SEG6Local(t *testing.T) {
+	n := &netlink.SEG6LocalEncap{
+		Action: nl.SEG6_LOCAL_ACTION_END_DX4,
+		InAddr: net.ParseIP("10.0.0.1").To4(),
+	}
+	n.Flags[nl.SEG6_LOCAL_ACTION] = true
+	n.Flags[nl.SEG6_LOCAL_NH4] = true
+
+	e := NewEncapFromNative(n)
+	if e.Type != nl.LWTUNNEL_ENCAP_SEG6_LOCAL {
+		t.Errorf("NewEncapFromNative type unmatch. %d", e.Type)
+	}
+
+	v, ok := e.ToNative().(*netlink.SEG6LocalEncap)
+	if !ok {
+		t.Fatalf("Encap.ToNative type unmatch. %v", e.ToNative())
+	}
+
+	if !v.Equal(n) {
+		t.Errorf("Encap.ToNative unmatch. %s", v)
+	}
+}
@@ -0,0 +1,121 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"gonla/nladbm"
+	"gonla/nlamsg"
+	"net"
+
+	"github.com/vishvananda/netlink"
+)
+
+//
+// Rule
+//
+func (r *Rule) NetSrc() *net.IPNet {
+	return BytesToIPNet(r.Src, r.SrcMask)
+}
+
+func (r *Rule) NetDst() *net.IPNet {
+	return BytesToIPNet(r.Dst, r.DstMask)
+}
+
+func (r *Rule) ToNative() *nlamsg.Rule {
+	rule := netlink.NewRule()
+	rule.Priority = int(r.Priority)
+	rule.Family = int(r.Family)
+	rule.Table = int(r.Table)
+	rule.Mark = int(r.Mark)
+	rule.Mask = int(r.Mask)
+	rule.Goto = int(r.Goto)
+	rule.Src = r.NetSrc()
+	rule.Dst = r.NetDst()
+	rule.IifName = r.IifName
+	rule.OifName = r.OifName
+	rule.Invert = r.Invert
+
+	return &nlamsg.Rule{
+		Rule:   rule,
+		Action: uint8(r.Action),
+		NId:    uint8(r.NId),
+	}
+}
+
+func NewRuleFromNative(r *nlamsg.Rule) *Rule {
+	src, srcMask := IPNetToBytes(r.Src)
+	dst, dstMask := IPNetToBytes(r.Dst)
+
+	return &Rule{
+		Priority: int32(r.Priority),
+		Family:   int32(r.Family),
+		Table:    int32(r.Table),
+		Mark:     int32(r.Mark),
+		Mask:     int32(r.Mask),
+		Goto:     int32(r.Goto),
+		Src:      src,
+		SrcMask:  srcMask,
+		Dst:      dst,
+		DstMask:  dstMask,
+		IifName:  r.IifName,
+		OifName:  r.OifName,
+		Invert:   r.Invert,
+		Action:   uint32(r.Action),
+		NId:      uint32(r.NId),
+	}
+}
+
+//
+// Rule (Key)
+//
+func (k *RuleKey) ToNative() *nladbm.RuleKey {
+	return &nladbm.RuleKey{
+		NId:      uint8(k.NId),
+		Family:   int(k.Family),
+		Priority: int(k.Priority),
+		Src:      k.Src,
+		Dst:      k.Dst,
+		IifName:  k.IifName,
+		OifName:  k.OifName,
+		Mark:     int(k.Mark),
+		Table:    int(k.Table),
+	}
+}
+
+func NewRuleKeyFromNative(k *nladbm.RuleKey) *RuleKey {
+	return &RuleKey{
+		NId:      uint32(k.NId),
+		Family:   int32(k.Family),
+		Priority: int32(k.Priority),
+		Src:      k.Src,
+		Dst:      k.Dst,
+		IifName:  k.IifName,
+		OifName:  k.OifName,
+		Mark:     int32(k.Mark),
+		Table:    int32(k.Table),
+	}
+}
+
+//
+// Rules
+//
+func NewGetRulesRequest(nid uint8) *GetRulesRequest {
+	return &GetRulesRequest{
+		NId: uint32(nid),
+	}
+}
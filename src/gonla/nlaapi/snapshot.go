@@ -0,0 +1,182 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"gonla/nladbm"
+	"gonla/nlamsg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+//
+// NewSnapshotFromTables creates snapshot of nladbm tables.
+// Nodes and clients are not included because they are rebuilt by
+// connections from slaves and clients.
+//
+func NewSnapshotFromTables() *Snapshot {
+	s := &Snapshot{
+		Timestamp: time.Now().Unix(),
+	}
+
+	nladbm.Links().Walk(func(ln *nlamsg.Link) error {
+		s.Links = append(s.Links, NewLinkFromNative(ln))
+		return nil
+	})
+	nladbm.Links().WalkTun(func(iptun *nlamsg.Iptun) error {
+		s.Iptuns = append(s.Iptuns, NewIptunFromNative(iptun))
+		return nil
+	})
+	nladbm.Addrs().Walk(func(addr *nlamsg.Addr) error {
+		s.Addrs = append(s.Addrs, NewAddrFromNative(addr))
+		return nil
+	})
+	nladbm.Neighs().Walk(func(neigh *nlamsg.Neigh) error {
+		s.Neighs = append(s.Neighs, NewNeighFromNative(neigh))
+		return nil
+	})
+	nladbm.Routes().Walk(func(route *nlamsg.Route) error {
+		s.Routes = append(s.Routes, NewRouteFromNative(route))
+		return nil
+	})
+	nladbm.Mplss().Walk(func(route *nlamsg.Route) error {
+		s.Mplss = append(s.Mplss, NewRouteFromNative(route))
+		return nil
+	})
+	nladbm.Vpns().Walk(func(vpn *nlamsg.Vpn) error {
+		s.Vpns = append(s.Vpns, NewVpnFromNative(vpn))
+		return nil
+	})
+	nladbm.Encaps().Walk(func(e *nlamsg.EncapInfo) error {
+		s.EncapInfos = append(s.EncapInfos, NewEncapInfoFromNative(e))
+		return nil
+	})
+	nladbm.BrVlans().Walk(func(brvlan *nlamsg.BridgeVlanInfo) error {
+		s.BrVlans = append(s.BrVlans, NewBridgeVlanInfoFromNative(brvlan))
+		return nil
+	})
+	nladbm.Nexthops().Walk(func(nh *nlamsg.Nexthop) error {
+		s.Nexthops = append(s.Nexthops, NewNexthopFromNative(nh))
+		return nil
+	})
+	nladbm.Rules().Walk(func(rule *nlamsg.Rule) error {
+		s.Rules = append(s.Rules, NewRuleFromNative(rule))
+		return nil
+	})
+	nladbm.Mroutes().Walk(func(mroute *nlamsg.Mroute) error {
+		s.Mroutes = append(s.Mroutes, NewMrouteFromNative(mroute))
+		return nil
+	})
+
+	return s
+}
+
+//
+// RestoreTables inserts all entries of snapshot to nladbm tables.
+// Ids (LnId, RtId, EnId, ...) are kept and id counters are advanced
+// past the restored ids.
+//
+func (s *Snapshot) RestoreTables() {
+	for _, ln := range s.Links {
+		nladbm.Links().Restore(ln.ToNative())
+	}
+	for _, iptun := range s.Iptuns {
+		nladbm.Links().RestoreTun(iptun.ToNative())
+	}
+	for _, addr := range s.Addrs {
+		nladbm.Addrs().Restore(addr.ToNative())
+	}
+	for _, neigh := range s.Neighs {
+		nladbm.Neighs().Restore(neigh.ToNative())
+	}
+	for _, nh := range s.Nexthops {
+		nladbm.Nexthops().Insert(nh.ToNative())
+	}
+	for _, route := range s.Routes {
+		nladbm.Routes().Restore(route.ToNative())
+	}
+	for _, route := range s.Mplss {
+		nladbm.Mplss().Insert(route.ToNative())
+	}
+	for _, vpn := range s.Vpns {
+		nladbm.Vpns().Restore(vpn.ToNative())
+	}
+	for _, e := range s.EncapInfos {
+		nladbm.Encaps().Restore(e.ToNative())
+	}
+	for _, brvlan := range s.BrVlans {
+		nladbm.BrVlans().Restore(brvlan.ToNative())
+	}
+	for _, rule := range s.Rules {
+		nladbm.Rules().Insert(rule.ToNative())
+	}
+	for _, mroute := range s.Mroutes {
+		nladbm.Mroutes().Restore(mroute.ToNative())
+	}
+}
+
+//
+// WriteSnapshot writes snapshot to file.
+// it writes to temporary file and renames it to keep previous file
+// if an error occurs.
+//
+func WriteSnapshot(path string, s *Snapshot) error {
+	b, err := proto.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+//
+// ReadSnapshot reads snapshot from file.
+//
+func ReadSnapshot(path string) (*Snapshot, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{}
+	if err := proto.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,94 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlaapi
+
+import (
+	"gonla/nladbm"
+	"gonla/nlamsg"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+)
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nlaapi")
+	if err != nil {
+		t.Fatalf("TempDir error. %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snapshot")
+
+	_, dst, _ := net.ParseCIDR("10.0.1.0/24")
+
+	nladbm.Create()
+
+	link := nlamsg.NewLink(&netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 10, Name: "eth1"}}, 1, 0)
+	nladbm.Links().Insert(link)
+
+	_, dst0, _ := net.ParseCIDR("10.0.0.0/24")
+	route := nlamsg.NewRoute(&netlink.Route{LinkIndex: 10, Dst: dst, Table: 254}, 1, 0, nil, nil)
+	nladbm.Routes().Insert(nlamsg.NewRoute(&netlink.Route{LinkIndex: 10, Dst: dst0, Table: 254}, 1, 0, nil, nil))
+	nladbm.Routes().Insert(route)
+
+	enId := nladbm.Encaps().EncapId(dst, 100)
+
+	if err := WriteSnapshot(path, NewSnapshotFromTables()); err != nil {
+		t.Fatalf("WriteSnapshot error. %s", err)
+	}
+
+	s, err := ReadSnapshot(path)
+	if err != nil {
+		t.Fatalf("ReadSnapshot error. %s", err)
+	}
+
+	if len(s.Links) != 1 || len(s.Routes) != 2 || len(s.EncapInfos) != 1 {
+		t.Errorf("ReadSnapshot unmatch. %v", s)
+	}
+
+	nladbm.Create()
+	s.RestoreTables()
+
+	if ln := nladbm.Links().Select(nladbm.LinkToKey(link)); ln == nil || ln.LnId != link.LnId {
+		t.Errorf("RestoreTables link unmatch. %v", ln)
+	}
+
+	if rt := nladbm.Routes().Select(nladbm.RouteToKey(route)); rt == nil || rt.RtId != route.RtId {
+		t.Errorf("RestoreTables route unmatch. %v", rt)
+	}
+
+	if v := nladbm.Encaps().EncapId(dst, 100); v != enId {
+		t.Errorf("RestoreTables encap unmatch. %d %d", v, enId)
+	}
+
+	// new ids are allocated after restored ids.
+	_, dst2, _ := net.ParseCIDR("10.0.2.0/24")
+	route2 := nlamsg.NewRoute(&netlink.Route{LinkIndex: 10, Dst: dst2, Table: 254}, 1, 0, nil, nil)
+	nladbm.Routes().Insert(route2)
+	if route2.RtId <= route.RtId {
+		t.Errorf("RestoreTables counter unmatch. %d %d", route2.RtId, route.RtId)
+	}
+
+	if v := nladbm.Encaps().EncapId(dst2, 100); v <= enId {
+		t.Errorf("RestoreTables encap counter unmatch. %d %d", v, enId)
+	}
+}
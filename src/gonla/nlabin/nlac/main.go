@@ -68,7 +68,7 @@ func (c *Command) Init() {
 	rootCmd.AddCommand(monCmd)
 
 	targets := []string{
-		"link", "addr", "neigh", "route", "mpls", "node", "vpn", "encap", "stat", "iptun", "all",
+		"link", "addr", "neigh", "route", "mpls", "node", "vpn", "encap", "stat", "iptun", "nexthop", "rule", "mroute", "all",
 	}
 	showCmd := &cobra.Command{
 		Use:       "show [name...]",
@@ -100,6 +100,12 @@ func (c *Command) Init() {
 					printIptuns(c.client)
 				case "brvlan":
 					printBrVlanInfo(c.client)
+				case "nexthop":
+					printNexthops(c.client)
+				case "rule":
+					printRules(c.client)
+				case "mroute":
+					printMroutes(c.client)
 				case "all":
 					printAll(c.client)
 				default:
@@ -397,7 +403,7 @@ func strNexthopInfos(ns []*nlaapi.NexthopInfo) string {
 }
 
 func strRoute(route *nlaapi.Route) string {
-	return fmt.Sprintf("ROUTE:%03d:%04d %-32s src %-32s via %-32s %v %s %s i=%d nh=[%s] en=%v",
+	return fmt.Sprintf("ROUTE:%03d:%04d %-32s src %-32s via %-32s %v %s %s i=%d nh=[%s] en=%v nhid=%d",
 		route.NId,
 		route.RtId,
 		strRouteDest(route),
@@ -409,6 +415,7 @@ func strRoute(route *nlaapi.Route) string {
 		route.LinkIndex,
 		strNexthopInfos(route.MultiPath),
 		route.EnIds,
+		route.NhId,
 	)
 }
 
@@ -500,6 +507,136 @@ func printBrVlanInfo(c nlaapi.NLAApiClient) {
 	}
 }
 
+func strNexthopGroup(group []*nlaapi.NexthopGroupEntry) string {
+	ss := make([]string, len(group))
+	for i, e := range group {
+		ss[i] = fmt.Sprintf("%d,%d", e.Id, e.Weight)
+	}
+	return strings.Join(ss, "/")
+}
+
+func strNexthop(nh *nlaapi.Nexthop) string {
+	if len(nh.Group) > 0 {
+		return fmt.Sprintf("NH   :%03d:%04d group %s", nh.NId, nh.Id, strNexthopGroup(nh.Group))
+	}
+	if nh.Blackhole {
+		return fmt.Sprintf("NH   :%03d:%04d blackhole", nh.NId, nh.Id)
+	}
+	return fmt.Sprintf("NH   :%03d:%04d via %-32s i=%d", nh.NId, nh.Id, nh.NetGw(), nh.LinkIndex)
+}
+
+func printNexthops(c nlaapi.NLAApiClient) {
+	stream, err := c.GetNexthops(context.Background(), nlaapi.NewGetNexthopsRequest(nlaapi.NODE_ID_ALL))
+	if err != nil {
+		log.Errorf("GetNexthops error. %v", err)
+		return
+	}
+	for {
+		nh, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Errorf("stream.Recv error. %v", err)
+			break
+		}
+		fmt.Println(strNexthop(nh))
+	}
+}
+
+func strRuleSelector(rule *nlaapi.Rule) string {
+	ss := []string{}
+	if rule.Invert {
+		ss = append(ss, "not")
+	}
+	if src := rule.NetSrc(); src != nil {
+		ss = append(ss, fmt.Sprintf("from %s", src))
+	} else {
+		ss = append(ss, "from all")
+	}
+	if dst := rule.NetDst(); dst != nil {
+		ss = append(ss, fmt.Sprintf("to %s", dst))
+	}
+	if rule.Mark >= 0 {
+		ss = append(ss, fmt.Sprintf("fwmark 0x%x/0x%x", rule.Mark, uint32(rule.Mask)))
+	}
+	if len(rule.IifName) > 0 {
+		ss = append(ss, fmt.Sprintf("iif %s", rule.IifName))
+	}
+	if len(rule.OifName) > 0 {
+		ss = append(ss, fmt.Sprintf("oif %s", rule.OifName))
+	}
+	return strings.Join(ss, " ")
+}
+
+func strRule(rule *nlaapi.Rule) string {
+	return fmt.Sprintf("RULE :%03d:%5d %-48s table %d act %d",
+		rule.NId,
+		rule.Priority,
+		strRuleSelector(rule),
+		rule.Table,
+		rule.Action,
+	)
+}
+
+func printRules(c nlaapi.NLAApiClient) {
+	stream, err := c.GetRules(context.Background(), nlaapi.NewGetRulesRequest(nlaapi.NODE_ID_ALL))
+	if err != nil {
+		log.Errorf("GetRules error. %v", err)
+		return
+	}
+	for {
+		rule, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Errorf("stream.Recv error. %v", err)
+			break
+		}
+		fmt.Println(strRule(rule))
+	}
+}
+
+func strMroute(mroute *nlaapi.Mroute) string {
+	src := "*"
+	if ip := mroute.NetSrc(); ip != nil {
+		src = ip.String()
+	}
+	oifs := make([]string, len(mroute.Oifs))
+	for i, oif := range mroute.Oifs {
+		oifs[i] = fmt.Sprintf("%d(ttl:%d)", oif.Index, oif.Ttl)
+	}
+	return fmt.Sprintf("MRT  :%03d:%04d (%s, %s) table %d iif %d oifs [%s]",
+		mroute.NId,
+		mroute.MrId,
+		src,
+		mroute.NetGroup(),
+		mroute.Table,
+		mroute.Iif,
+		strings.Join(oifs, " "),
+	)
+}
+
+func printMroutes(c nlaapi.NLAApiClient) {
+	stream, err := c.GetMroutes(context.Background(), nlaapi.NewGetMroutesRequest(nlaapi.NODE_ID_ALL))
+	if err != nil {
+		log.Errorf("GetMroutes error. %v", err)
+		return
+	}
+	for {
+		mroute, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Errorf("stream.Recv error. %v", err)
+			break
+		}
+		fmt.Println(strMroute(mroute))
+	}
+}
+
 func strVpn(vpn *nlaapi.Vpn) string {
 	return fmt.Sprintf("VPN  :%03d:%04d %-32s %-15s(%-15s) %5d",
 		vpn.NId,
@@ -625,6 +762,9 @@ func printAll(c nlaapi.NLAApiClient) {
 	printStats(c)
 	printIptuns(c)
 	printBrVlanInfo(c)
+	printNexthops(c)
+	printRules(c)
+	printMroutes(c)
 }
 
 func strNlMsgUion(m *nlaapi.NetlinkMessageUnion) string {
@@ -643,6 +783,12 @@ func strNlMsgUion(m *nlaapi.NetlinkMessageUnion) string {
 		return strVpn(m.Msg.GetVpn())
 	case nlalink.RTMGRP_BRIDGE:
 		return strBrVlanInfo(m.Msg.GetBrVlanInfo())
+	case nlalink.RTMGRP_NEXTHOP:
+		return strNexthop(m.Msg.GetNexthop())
+	case nlalink.RTMGRP_RULE:
+		return strRule(m.Msg.GetRule())
+	case nlalink.RTMGRP_MROUTE:
+		return strMroute(m.Msg.GetMroute())
 	default:
 		return fmt.Sprintf("%v", m)
 	}
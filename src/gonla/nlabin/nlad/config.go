@@ -30,6 +30,9 @@ const (
 	AUTO_NID             uint8  = 255
 	BRVLAN_UPDATE_SECOND uint32 = 1800
 	BRVLAN_CHAN_SIZE     int    = 4096 * 4
+	SNAPSHOT_INTERVAL    uint32 = 60
+	SNAPSHOT_STALE       uint32 = 120
+	SNAPSHOT_MAX_AGE     uint32 = 600
 )
 
 type NodeConfig struct {
@@ -83,6 +86,33 @@ func (c *BridgeVlanConfig) String() string {
 	return fmt.Sprintf("update_sec:%d, chan_size:%d", c.UpdateSec, c.ChanSize)
 }
 
+type SnapshotConfig struct {
+	Path        string `toml:"path"`
+	IntervalSec uint32 `toml:"interval_sec"`
+	StaleSec    uint32 `toml:"stale_sec"`
+	MaxAgeSec   uint32 `toml:"max_age_sec"`
+}
+
+func (c *SnapshotConfig) Enabled() bool {
+	return len(c.Path) != 0
+}
+
+func (c *SnapshotConfig) Interval() time.Duration {
+	return time.Duration(c.IntervalSec) * time.Second
+}
+
+func (c *SnapshotConfig) StaleTime() time.Duration {
+	return time.Duration(c.StaleSec) * time.Second
+}
+
+func (c *SnapshotConfig) MaxAge() time.Duration {
+	return time.Duration(c.MaxAgeSec) * time.Second
+}
+
+func (c *SnapshotConfig) String() string {
+	return fmt.Sprintf("path:'%s', interval_sec:%d, stale_sec:%d, max_age_sec:%d", c.Path, c.IntervalSec, c.StaleSec, c.MaxAgeSec)
+}
+
 type NLAConfig struct {
 	Core            string `toml:"core"`
 	Api             string `toml:"api"`
@@ -91,6 +121,7 @@ type NLAConfig struct {
 
 	Iptun      []IptunConfig    `toml:"iptun"`
 	BridgeVlan BridgeVlanConfig `toml:"bridge_vlan"`
+	Snapshot   SnapshotConfig   `toml:"snapshot"`
 }
 
 func (c *NLAConfig) Adjust() {
@@ -109,10 +140,22 @@ func (c *NLAConfig) Adjust() {
 	if c.BridgeVlan.ChanSize == 0 {
 		c.BridgeVlan.ChanSize = BRVLAN_CHAN_SIZE
 	}
+
+	if c.Snapshot.IntervalSec == 0 {
+		c.Snapshot.IntervalSec = SNAPSHOT_INTERVAL
+	}
+
+	if c.Snapshot.StaleSec == 0 {
+		c.Snapshot.StaleSec = SNAPSHOT_STALE
+	}
+
+	if c.Snapshot.MaxAgeSec == 0 {
+		c.Snapshot.MaxAgeSec = SNAPSHOT_MAX_AGE
+	}
 }
 
 func (c *NLAConfig) String() string {
-	return fmt.Sprintf("Core:'%s', Api:'%s', RecvChan:%d, RecvSock:%d, iptun:{%v}, brvlan:{%s}", c.Core, c.Api, c.RecvChanSize, c.RecvSockBufSize, &c.Iptun, &c.BridgeVlan)
+	return fmt.Sprintf("Core:'%s', Api:'%s', RecvChan:%d, RecvSock:%d, iptun:{%v}, brvlan:{%s}, snapshot:{%s}", c.Core, c.Api, c.RecvChanSize, c.RecvSockBufSize, &c.Iptun, &c.BridgeVlan, &c.Snapshot)
 }
 
 type LogConfig struct {
@@ -61,9 +61,14 @@ func services(c *Config) []nlactl.NLAService {
 	if c.IsMaster() {
 		brvlan := c.NLA.BridgeVlan
 		nlaapi := nlasvc.NewNLAApiService(c.NLA.Api)
+		master := nlasvc.NewNLAMasterService(nlaapi)
+		masterSvc := nlactl.NLAService(master)
+		if snapshot := &c.NLA.Snapshot; snapshot.Enabled() {
+			masterSvc = nlasvc.NewNLARestoreService(master, snapshot.Path, snapshot.Interval(), snapshot.StaleTime(), snapshot.MaxAge())
+		}
 		return []nlactl.NLAService{
 			nlasvc.NewNLALogService(c.Log.Dump),
-			nlasvc.NewNLAMasterService(nlaapi),
+			masterSvc,
 			nlasvc.NewNLACoreApiService(c.NLA.Core),
 			nlasvc.NewNLANetlinkService(),
 			nlasvc.NewNLABridgeVlanService(nlaapi, brvlan.UpdateTime(), brvlan.ChanSize),
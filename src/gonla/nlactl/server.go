@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"gonla/nladbm"
 	"gonla/nlamsg"
+	"gonla/nlamsg/nlalink"
 	"syscall"
 
 	log "github.com/sirupsen/logrus"
@@ -36,6 +37,11 @@ var RTNLGRPLIST = []uint{
 	syscall.RTNLGRP_IPV6_IFADDR,
 	syscall.RTNLGRP_IPV6_ROUTE,
 	nl.RTNLGRP_MPLS_ROUTE,
+	nlalink.RTNLGRP_NEXTHOP,
+	syscall.RTNLGRP_IPV4_RULE,
+	syscall.RTNLGRP_IPV6_RULE,
+	syscall.RTNLGRP_IPV4_MROUTE,
+	syscall.RTNLGRP_IPV6_MROUTE,
 }
 
 const (
@@ -113,6 +119,8 @@ func (s *NLAServer) parseNlMsgs(recvCh <-chan *NLARecvBuffer) {
 		}
 
 		for _, nlmsg := range nlmsgs {
+			// mroute is notified as RTM_{NEW,DEL}ROUTE.
+			nlamsg.ConvertMrouteMessage(&nlmsg)
 			s.nlmsgs <- nlamsg.NewNetlinkMessage(&nlmsg, s.Nid, nlamsg.SRC_KNL)
 			statCount.Inc()
 		}
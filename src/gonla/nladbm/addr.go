@@ -49,6 +49,7 @@ func AddrToKey(a *nlamsg.Addr) *AddrKey {
 //
 type AddrTable interface {
 	Insert(*nlamsg.Addr) *nlamsg.Addr
+	Restore(*nlamsg.Addr)
 	Select(*AddrKey) *nlamsg.Addr
 	Delete(*AddrKey) *nlamsg.Addr
 	Walk(f func(*nlamsg.Addr) error) error
@@ -91,6 +92,17 @@ func (t *addrTable) Insert(a *nlamsg.Addr) (old *nlamsg.Addr) {
 	return
 }
 
+//
+// Restore inserts addr keeping AdId (e.g. loaded from snapshot).
+//
+func (t *addrTable) Restore(a *nlamsg.Addr) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	t.Counter.Update(a.NId, a.AdId)
+	t.Addrs[*AddrToKey(a)] = a.Copy()
+}
+
 func (t *addrTable) Select(key *AddrKey) *nlamsg.Addr {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
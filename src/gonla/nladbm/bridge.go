@@ -49,6 +49,7 @@ func (k *BridgeVlanInfoKey) String() string {
 type BridgeVlanInfoTable interface {
 	Count() uint32
 	Insert(*nlamsg.BridgeVlanInfo) *nlamsg.BridgeVlanInfo
+	Restore(*nlamsg.BridgeVlanInfo)
 	Select(*BridgeVlanInfoKey) *nlamsg.BridgeVlanInfo
 	ListByIndex(int, func(*nlamsg.BridgeVlanInfo) bool) bool
 	Update(*BridgeVlanInfoKey, func(*nlamsg.BridgeVlanInfo) error) error
@@ -139,6 +140,24 @@ func (t *bridgeVlanInfoTable) Insert(br *nlamsg.BridgeVlanInfo) (old *nlamsg.Bri
 	return
 }
 
+//
+// Restore inserts bridge vlan info keeping BrId (e.g. loaded from snapshot).
+//
+func (t *bridgeVlanInfoTable) Restore(br *nlamsg.BridgeVlanInfo) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := BridgeVlanInfoToKey(br)
+	if old := t.find(key); old != nil {
+		t.indexIdx.Delete(old)
+	}
+
+	t.counter.Update(br.NId, br.BrId)
+	t.bridges[*key] = br.Copy()
+	t.gc.Using(*key)
+	t.indexIdx.Insert(br)
+}
+
 func (t *bridgeVlanInfoTable) Select(key *BridgeVlanInfoKey) *nlamsg.BridgeVlanInfo {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
@@ -49,6 +49,7 @@ func EncapInfoToKey(e *nlamsg.EncapInfo) *EncapInfoKey {
 type EncapInfoTable interface {
 	EncapId(*net.IPNet, uint32) uint32
 	Insert(*nlamsg.EncapInfo) *nlamsg.EncapInfo
+	Restore(*nlamsg.EncapInfo)
 	Select(*EncapInfoKey) *nlamsg.EncapInfo
 	Delete(*EncapInfoKey) *nlamsg.EncapInfo
 	Walk(func(*nlamsg.EncapInfo) error) error
@@ -104,6 +105,17 @@ func (t *encapTnfoTable) Insert(e *nlamsg.EncapInfo) (old *nlamsg.EncapInfo) {
 	return
 }
 
+//
+// Restore inserts encap info keeping EnId (e.g. loaded from snapshot).
+//
+func (t *encapTnfoTable) Restore(e *nlamsg.EncapInfo) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.counter.Update(e.EnId)
+	t.infos[*EncapInfoToKey(e)] = e.Copy()
+}
+
 func (t *encapTnfoTable) Select(key *EncapInfoKey) *nlamsg.EncapInfo {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
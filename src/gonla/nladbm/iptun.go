@@ -57,6 +57,7 @@ func (k *IptunKey) String() string {
 //
 type IptunTable interface {
 	Insert(*nlamsg.Iptun) *nlamsg.Iptun
+	Restore(*nlamsg.Iptun)
 	Select(*IptunKey) *nlamsg.Iptun
 	Delete(*IptunKey) *nlamsg.Iptun
 	Update(*IptunKey, func(*nlamsg.Iptun) error) error
@@ -113,6 +114,22 @@ func (t *iptunTable) Insert(iptun *nlamsg.Iptun) (old *nlamsg.Iptun) {
 	return
 }
 
+//
+// Restore inserts iptun keeping TnlId (e.g. loaded from snapshot).
+//
+func (t *iptunTable) Restore(iptun *nlamsg.Iptun) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := IptunToKey(iptun)
+	if key == nil {
+		return
+	}
+
+	t.counter.Update(iptun.NId, iptun.TnlId)
+	t.iptuns[*key] = iptun.Copy()
+}
+
 func (t *iptunTable) Select(key *IptunKey) *nlamsg.Iptun {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
@@ -49,12 +49,14 @@ func LinkToKey(ln *nlamsg.Link) *LinkKey {
 //
 type LinkTable interface {
 	Insert(*nlamsg.Link) *nlamsg.Link
+	Restore(*nlamsg.Link)
 	Select(*LinkKey) *nlamsg.Link
 	Delete(*LinkKey) *nlamsg.Link
 	Walk(f func(*nlamsg.Link) error) error
 	WalkFree(f func(*nlamsg.Link) error) error
 	SelectTun(*IptunKey) *nlamsg.Iptun
 	UpdateTun(*IptunKey, func(*nlamsg.Iptun) error) error
+	RestoreTun(*nlamsg.Iptun)
 	WalkTun(func(*nlamsg.Iptun) error) error
 
 	WalkTunByRemote(uint8, *net.IPNet, func(*nlamsg.Iptun) error) error
@@ -105,6 +107,18 @@ func (t *linkTable) Insert(ln *nlamsg.Link) (old *nlamsg.Link) {
 	return
 }
 
+//
+// Restore inserts link keeping LnId (e.g. loaded from snapshot).
+//
+func (t *linkTable) Restore(ln *nlamsg.Link) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	t.Counter.Update(ln.NId, ln.LnId)
+	t.Links[*LinkToKey(ln)] = ln.Copy()
+	t.TunIdx.Insert(nlamsg.NewIptun(ln))
+}
+
 func (t *linkTable) Select(key *LinkKey) *nlamsg.Link {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
@@ -148,6 +162,10 @@ func (t *linkTable) UpdateTun(key *IptunKey, f func(*nlamsg.Iptun) error) error
 	return t.TunIdx.Update(key, f)
 }
 
+func (t *linkTable) RestoreTun(iptun *nlamsg.Iptun) {
+	t.TunIdx.Restore(iptun)
+}
+
 func (t *linkTable) WalkTun(f func(*nlamsg.Iptun) error) error {
 	return t.TunIdx.Walk(f)
 }
@@ -0,0 +1,154 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"gonla/nlalib"
+	"gonla/nlamsg"
+	"net"
+	"sync"
+)
+
+//
+// Key
+//
+type MrouteKey struct {
+	NId    uint8
+	Family int
+	Table  int
+	Src    string // net.IP ("" if (*,G))
+	Group  string // net.IP
+}
+
+func NewMrouteKey(nid uint8, family int, table int, src, group net.IP) *MrouteKey {
+	srcStr := ""
+	if src != nil && !src.IsUnspecified() {
+		srcStr = src.String()
+	}
+
+	return &MrouteKey{
+		NId:    nid,
+		Family: family,
+		Table:  table,
+		Src:    srcStr,
+		Group:  group.String(),
+	}
+}
+
+func MrouteToKey(m *nlamsg.Mroute) *MrouteKey {
+	return NewMrouteKey(m.NId, m.Family, m.Table, m.Src, m.Group)
+}
+
+//
+// Table interface
+//
+type MrouteTable interface {
+	Insert(*nlamsg.Mroute) *nlamsg.Mroute
+	Restore(*nlamsg.Mroute)
+	Select(*MrouteKey) *nlamsg.Mroute
+	Delete(*MrouteKey) *nlamsg.Mroute
+	Walk(f func(*nlamsg.Mroute) error) error
+	WalkFree(f func(*nlamsg.Mroute) error) error
+}
+
+func NewMrouteTable() MrouteTable {
+	return &mrouteTable{
+		Mroutes: make(map[MrouteKey]*nlamsg.Mroute),
+		Counter: nlalib.NewCounters32(),
+	}
+}
+
+//
+// Table
+//
+type mrouteTable struct {
+	Mutex   sync.RWMutex
+	Mroutes map[MrouteKey]*nlamsg.Mroute
+	Counter *nlalib.Counters32
+}
+
+func (t *mrouteTable) find(key *MrouteKey) *nlamsg.Mroute {
+	m, _ := t.Mroutes[*key]
+	return m
+}
+
+//
+// Insert registers mroute. if mroute already exists, it is replaced
+// (MrId is kept) and old one is returned.
+//
+func (t *mrouteTable) Insert(m *nlamsg.Mroute) (old *nlamsg.Mroute) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := MrouteToKey(m)
+	if old = t.find(key); old == nil {
+		m.MrId = t.Counter.Next(m.NId)
+	} else {
+		m.MrId = old.MrId
+	}
+
+	t.Mroutes[*key] = m.Copy()
+
+	return
+}
+
+//
+// Restore inserts mroute keeping MrId (e.g. loaded from snapshot).
+//
+func (t *mrouteTable) Restore(m *nlamsg.Mroute) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	t.Counter.Update(m.NId, m.MrId)
+	t.Mroutes[*MrouteToKey(m)] = m.Copy()
+}
+
+func (t *mrouteTable) Select(key *MrouteKey) *nlamsg.Mroute {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.find(key)
+}
+
+func (t *mrouteTable) Delete(key *MrouteKey) (old *nlamsg.Mroute) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	if old = t.find(key); old != nil {
+		delete(t.Mroutes, *key)
+	}
+
+	return
+}
+
+func (t *mrouteTable) Walk(f func(*nlamsg.Mroute) error) error {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.WalkFree(f)
+}
+
+func (t *mrouteTable) WalkFree(f func(*nlamsg.Mroute) error) error {
+	for _, m := range t.Mroutes {
+		if err := f(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"net"
+	"syscall"
+	"testing"
+
+	"gonla/nlamsg"
+)
+
+func TestMrouteTable(t *testing.T) {
+	tbl := NewMrouteTable()
+
+	newMroute := func(nid uint8, src, group string, iif int) *nlamsg.Mroute {
+		m := nlamsg.NewMroute(nid, 0)
+		m.Family = syscall.AF_INET
+		m.Table = syscall.RT_TABLE_DEFAULT
+		m.Src = net.ParseIP(src)
+		m.Group = net.ParseIP(group)
+		m.Iif = iif
+		return m
+	}
+
+	m1 := newMroute(1, "10.0.0.1", "239.1.1.1", 1)
+	m2 := newMroute(1, "", "239.1.1.1", 1)
+	m3 := newMroute(2, "10.0.0.1", "239.1.1.1", 1)
+
+	for _, m := range []*nlamsg.Mroute{m1, m2, m3} {
+		if old := tbl.Insert(m); old != nil {
+			t.Errorf("mrouteTable.Insert unmatch. old=%s", old)
+		}
+	}
+
+	if m1.MrId != 1 || m2.MrId != 2 || m3.MrId != 1 {
+		t.Errorf("mrouteTable.Insert unmatch. id=%d/%d/%d", m1.MrId, m2.MrId, m3.MrId)
+	}
+
+	m := newMroute(1, "10.0.0.1", "239.1.1.1", 2)
+	if old := tbl.Insert(m); old == nil || old.Iif != 1 || m.MrId != 1 {
+		t.Errorf("mrouteTable.Insert unmatch. old=%s new=%s", old, m)
+	}
+
+	key := NewMrouteKey(1, syscall.AF_INET, syscall.RT_TABLE_DEFAULT, nil, net.ParseIP("239.1.1.1"))
+	if m := tbl.Select(key); m == nil || m.MrId != 2 || !m.IsStarG() {
+		t.Errorf("mrouteTable.Select unmatch. %s", m)
+	}
+
+	if old := tbl.Delete(MrouteToKey(m3)); old == nil || old.NId != 2 {
+		t.Errorf("mrouteTable.Delete unmatch. old=%s", old)
+	}
+	if old := tbl.Delete(MrouteToKey(m3)); old != nil {
+		t.Errorf("mrouteTable.Delete unmatch. old=%s", old)
+	}
+
+	r := newMroute(1, "10.0.0.2", "239.1.1.1", 1)
+	r.MrId = 10
+	tbl.Restore(r)
+
+	m4 := newMroute(1, "10.0.0.3", "239.1.1.1", 1)
+	if tbl.Insert(m4); m4.MrId != 11 {
+		t.Errorf("mrouteTable.Restore unmatch. id=%d", m4.MrId)
+	}
+
+	cnt := 0
+	tbl.Walk(func(m *nlamsg.Mroute) error {
+		cnt++
+		return nil
+	})
+	if cnt != 4 {
+		t.Errorf("mrouteTable.Walk unmatch. %d", cnt)
+	}
+}
@@ -33,6 +33,7 @@ type NeighKey struct {
 	Addr    string // ip (net.IP.String()) or neigh.HardwareAddr(FDB only)
 	Ifindex int    // neigh.LinkIndex (FDB only)
 	Vid     int    // neigh.Vlan (FDB only)
+	Dst     string // neigh.IP (BUM entry of remote VTEP only)
 }
 
 func NewNeighKey(nid uint8, ip net.IP) *NeighKey {
@@ -61,12 +62,19 @@ func NeighToKey(n *nlamsg.Neigh) *NeighKey {
 }
 
 func NeighToFdbKey(n *nlamsg.Neigh) *NeighKey {
-	return NewNeighFdbKey(
+	key := NewNeighFdbKey(
 		n.NId,
 		n.HardwareAddr.String(),
 		n.LinkIndex,
 		n.Vlan,
 	)
+
+	// vxlan device has BUM entries (00:00:00:00:00:00) for each remote VTEP.
+	if n.IsVtepEntry() && nlalib.IsInvalidHardwareAddr(n.HardwareAddr) {
+		key.Dst = nlalib.IpToString(n.IP)
+	}
+
+	return key
 }
 
 //
@@ -74,6 +82,8 @@ func NeighToFdbKey(n *nlamsg.Neigh) *NeighKey {
 //
 type NeighTable interface {
 	Insert(*nlamsg.Neigh) *nlamsg.Neigh
+	Restore(*nlamsg.Neigh)
+	Update(*nlamsg.Neigh) *nlamsg.Neigh
 	Select(*NeighKey) *nlamsg.Neigh
 	Delete(*NeighKey) *nlamsg.Neigh
 	Walk(f func(*nlamsg.Neigh) error) error
@@ -116,6 +126,34 @@ func (t *neighTable) Insert(n *nlamsg.Neigh) (old *nlamsg.Neigh) {
 	return
 }
 
+//
+// Restore inserts neigh keeping NeId (e.g. loaded from snapshot).
+//
+func (t *neighTable) Restore(n *nlamsg.Neigh) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	t.Counter.Update(n.NId, n.NeId)
+	t.Neighs[*NeighToKey(n)] = n.Copy()
+}
+
+//
+// Update replaces existing neigh keeping NeId (e.g. hwaddr is changed).
+// It returns old neigh, or nil and does nothing if neigh does not exist.
+//
+func (t *neighTable) Update(n *nlamsg.Neigh) (old *nlamsg.Neigh) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := NeighToKey(n)
+	if old = t.find(key); old != nil {
+		n.NeId = old.NeId
+		t.Neighs[*key] = n.Copy()
+	}
+
+	return
+}
+
 func (t *neighTable) Select(key *NeighKey) *nlamsg.Neigh {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
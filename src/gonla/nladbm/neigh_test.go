@@ -0,0 +1,114 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"gonla/nlamsg"
+	"net"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func newTestVtepNeigh(hwaddr string, remote string) *nlamsg.Neigh {
+	mac, _ := net.ParseMAC(hwaddr)
+	n := &netlink.Neigh{
+		LinkIndex:    10,
+		Family:       unix.AF_BRIDGE,
+		HardwareAddr: mac,
+		IP:           net.ParseIP(remote),
+	}
+	return nlamsg.NewNeigh(n, 1, 0)
+}
+
+func TestNeighToKey_Vtep(t *testing.T) {
+	n1 := newTestVtepNeigh("00:00:00:00:00:00", "10.0.0.2")
+	n2 := newTestVtepNeigh("00:00:00:00:00:00", "10.0.0.3")
+	n3 := newTestVtepNeigh("00:11:22:33:44:55", "10.0.0.2")
+	n4 := newTestVtepNeigh("00:11:22:33:44:55", "10.0.0.3")
+
+	if !n1.IsFdbEntry() || !n1.IsVtepEntry() {
+		t.Errorf("IsVtepEntry unmatch. %v", n1)
+	}
+
+	if k1, k2 := NeighToKey(n1), NeighToKey(n2); *k1 == *k2 {
+		t.Errorf("NeighToKey unmatch. %v %v", k1, k2)
+	}
+
+	// remote of unicast entry may be changed.
+	if k3, k4 := NeighToKey(n3), NeighToKey(n4); *k3 != *k4 || k3.Dst != "" {
+		t.Errorf("NeighToKey unmatch. %v %v", k3, k4)
+	}
+
+	tbl := NewNeighTable()
+	tbl.Insert(n1)
+	tbl.Insert(n2)
+	if old := tbl.Insert(n3); old != nil {
+		t.Errorf("NeighTable Insert unmatch. %v", old)
+	}
+	if old := tbl.Insert(n4); old == nil {
+		t.Errorf("NeighTable Insert unmatch.")
+	}
+}
+
+func TestNeighToKey_IP(t *testing.T) {
+	n := nlamsg.NewNeigh(&netlink.Neigh{
+		LinkIndex: 10,
+		Family:    unix.AF_INET,
+		IP:        net.ParseIP("10.0.0.2"),
+	}, 1, 0)
+
+	if n.IsFdbEntry() || n.IsVtepEntry() {
+		t.Errorf("IsVtepEntry unmatch. %v", n)
+	}
+}
+
+func TestNeighTable_Update(t *testing.T) {
+	newNeigh := func(hwaddr string) *nlamsg.Neigh {
+		mac, _ := net.ParseMAC(hwaddr)
+		return nlamsg.NewNeigh(&netlink.Neigh{
+			LinkIndex:    10,
+			Family:       unix.AF_INET,
+			IP:           net.ParseIP("10.0.0.2"),
+			HardwareAddr: mac,
+		}, 1, 0)
+	}
+
+	tbl := NewNeighTable()
+
+	if old := tbl.Update(newNeigh("00:11:22:33:44:55")); old != nil {
+		t.Errorf("NeighTable Update unmatch. %v", old)
+	}
+
+	n1 := newNeigh("00:11:22:33:44:55")
+	tbl.Insert(n1)
+
+	n2 := newNeigh("00:11:22:33:44:66")
+	if old := tbl.Update(n2); old == nil || old.HardwareAddr.String() != "00:11:22:33:44:55" {
+		t.Errorf("NeighTable Update unmatch. %v", old)
+	}
+
+	if n2.NeId != n1.NeId {
+		t.Errorf("NeighTable Update NeId unmatch. %d %d", n2.NeId, n1.NeId)
+	}
+
+	if n := tbl.Select(NeighToKey(n1)); n == nil || n.HardwareAddr.String() != "00:11:22:33:44:66" {
+		t.Errorf("NeighTable Select unmatch. %v", n)
+	}
+}
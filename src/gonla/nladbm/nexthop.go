@@ -0,0 +1,139 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"gonla/nlamsg"
+	"sync"
+)
+
+//
+// Key
+//
+type NexthopKey struct {
+	NId uint8
+	Id  uint32 // kernel nexthop id
+}
+
+func NewNexthopKey(nid uint8, id uint32) *NexthopKey {
+	return &NexthopKey{
+		NId: nid,
+		Id:  id,
+	}
+}
+
+func NexthopToKey(n *nlamsg.Nexthop) *NexthopKey {
+	return NewNexthopKey(n.NId, n.Id)
+}
+
+//
+// Table interface
+//
+type NexthopTable interface {
+	Insert(*nlamsg.Nexthop) *nlamsg.Nexthop
+	Select(*NexthopKey) *nlamsg.Nexthop
+	Delete(*NexthopKey) *nlamsg.Nexthop
+	Walk(f func(*nlamsg.Nexthop) error) error
+	WalkFree(f func(*nlamsg.Nexthop) error) error
+	WalkByMember(uint8, uint32, func(*nlamsg.Nexthop) error) error
+}
+
+func NewNexthopTable() NexthopTable {
+	return &nexthopTable{
+		Nexthops: make(map[NexthopKey]*nlamsg.Nexthop),
+	}
+}
+
+//
+// Table
+//
+type nexthopTable struct {
+	Mutex    sync.RWMutex
+	Nexthops map[NexthopKey]*nlamsg.Nexthop
+}
+
+func (t *nexthopTable) find(key *NexthopKey) *nlamsg.Nexthop {
+	n, _ := t.Nexthops[*key]
+	return n
+}
+
+//
+// Insert registers nexthop. if nexthop already exists, it is replaced
+// and old one is returned.
+//
+func (t *nexthopTable) Insert(n *nlamsg.Nexthop) (old *nlamsg.Nexthop) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := NexthopToKey(n)
+	old = t.find(key)
+	t.Nexthops[*key] = n.Copy()
+
+	return
+}
+
+func (t *nexthopTable) Select(key *NexthopKey) *nlamsg.Nexthop {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.find(key)
+}
+
+func (t *nexthopTable) Delete(key *NexthopKey) (old *nlamsg.Nexthop) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	if old = t.find(key); old != nil {
+		delete(t.Nexthops, *key)
+	}
+
+	return
+}
+
+func (t *nexthopTable) Walk(f func(*nlamsg.Nexthop) error) error {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.WalkFree(f)
+}
+
+func (t *nexthopTable) WalkFree(f func(*nlamsg.Nexthop) error) error {
+	for _, n := range t.Nexthops {
+		if err := f(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// WalkByMember calls f with nexthop groups which have nexthop(id).
+//
+func (t *nexthopTable) WalkByMember(nid uint8, id uint32, f func(*nlamsg.Nexthop) error) error {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	for _, n := range t.Nexthops {
+		if n.NId == nid && n.HasMember(id) {
+			if err := f(n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
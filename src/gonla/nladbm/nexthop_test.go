@@ -0,0 +1,82 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"net"
+	"testing"
+
+	"gonla/nlamsg"
+)
+
+func TestNexthopTable(t *testing.T) {
+	tbl := NewNexthopTable()
+
+	nh1 := &nlamsg.Nexthop{Id: 1, LinkIndex: 10, Gw: net.ParseIP("10.0.1.1"), NId: 1}
+	nh2 := &nlamsg.Nexthop{Id: 2, LinkIndex: 11, Gw: net.ParseIP("10.0.2.1"), NId: 1}
+	grp := &nlamsg.Nexthop{Id: 3, Group: []nlamsg.NexthopGroupEntry{{Id: 1}, {Id: 2}}, NId: 1}
+	other := &nlamsg.Nexthop{Id: 4, Group: []nlamsg.NexthopGroupEntry{{Id: 1}}, NId: 2}
+
+	for _, nh := range []*nlamsg.Nexthop{nh1, nh2, grp, other} {
+		if old := tbl.Insert(nh); old != nil {
+			t.Errorf("nexthopTable.Insert unmatch. old=%s", old)
+		}
+	}
+
+	if nh := tbl.Select(NewNexthopKey(1, 2)); nh == nil || !nh.Gw.Equal(nh2.Gw) {
+		t.Errorf("nexthopTable.Select unmatch. %s", nh)
+	}
+
+	if nh := tbl.Select(NewNexthopKey(2, 2)); nh != nil {
+		t.Errorf("nexthopTable.Select unmatch. %s", nh)
+	}
+
+	// replace
+	nh1new := &nlamsg.Nexthop{Id: 1, LinkIndex: 12, Gw: net.ParseIP("10.0.3.1"), NId: 1}
+	if old := tbl.Insert(nh1new); old == nil || old.LinkIndex != 10 {
+		t.Errorf("nexthopTable.Insert unmatch. old=%s", old)
+	}
+	if nh := tbl.Select(NewNexthopKey(1, 1)); nh == nil || nh.LinkIndex != 12 {
+		t.Errorf("nexthopTable.Select unmatch. %s", nh)
+	}
+
+	groups := []uint32{}
+	tbl.WalkByMember(1, 1, func(nh *nlamsg.Nexthop) error {
+		groups = append(groups, nh.Id)
+		return nil
+	})
+	if len(groups) != 1 || groups[0] != 3 {
+		t.Errorf("nexthopTable.WalkByMember unmatch. %v", groups)
+	}
+
+	if old := tbl.Delete(NewNexthopKey(1, 3)); old == nil || !old.IsGroup() {
+		t.Errorf("nexthopTable.Delete unmatch. old=%s", old)
+	}
+	if old := tbl.Delete(NewNexthopKey(1, 3)); old != nil {
+		t.Errorf("nexthopTable.Delete unmatch. old=%s", old)
+	}
+
+	groups = []uint32{}
+	tbl.WalkByMember(1, 1, func(nh *nlamsg.Nexthop) error {
+		groups = append(groups, nh.Id)
+		return nil
+	})
+	if len(groups) != 0 {
+		t.Errorf("nexthopTable.WalkByMember unmatch. %v", groups)
+	}
+}
@@ -29,6 +29,9 @@ var (
 	encaps  EncapInfoTable
 	stats   StatTable
 	brvlans BridgeVlanInfoTable
+	nhs     NexthopTable
+	rules   RuleTable
+	mroutes MrouteTable
 )
 
 func Create() {
@@ -43,6 +46,9 @@ func Create() {
 	encaps = NewEncapInfoTable()
 	stats = NewStatTable()
 	brvlans = NewBridgeVlanInfoTable()
+	nhs = NewNexthopTable()
+	rules = NewRuleTable()
+	mroutes = NewMrouteTable()
 }
 
 func Clients() ClientTable {
@@ -88,3 +94,15 @@ func Stats() StatTable {
 func BrVlans() BridgeVlanInfoTable {
 	return brvlans
 }
+
+func Nexthops() NexthopTable {
+	return nhs
+}
+
+func Rules() RuleTable {
+	return rules
+}
+
+func Mroutes() MrouteTable {
+	return mroutes
+}
@@ -22,6 +22,8 @@ import (
 	"gonla/nlamsg"
 	"net"
 	"sync"
+
+	"golang.org/x/sys/unix"
 )
 
 //
@@ -29,8 +31,9 @@ import (
 //
 type RouteKey struct {
 	// note: do not use AdId field.
-	NId  uint8
-	Addr string // ip/mask (net.IPNet.String())
+	NId   uint8
+	Addr  string // ip/mask (net.IPNet.String())
+	Table int    // 0: main table
 }
 
 func NewRouteKey(nid uint8, addr *net.IPNet) *RouteKey {
@@ -41,7 +44,19 @@ func NewRouteKey(nid uint8, addr *net.IPNet) *RouteKey {
 }
 
 func RouteToKey(r *nlamsg.Route) *RouteKey {
-	return NewRouteKey(r.NId, r.GetDst())
+	key := NewRouteKey(r.NId, r.GetDst())
+	if r.Table != unix.RT_TABLE_MAIN {
+		key.Table = r.Table
+	}
+	return key
+}
+
+//
+// IsVrfRouteTable returns true if table is not main table
+// but can be used as VRF (1 - 251).
+//
+func IsVrfRouteTable(table int) bool {
+	return table > unix.RT_TABLE_UNSPEC && table < unix.RT_TABLE_COMPAT
 }
 
 //
@@ -49,6 +64,7 @@ func RouteToKey(r *nlamsg.Route) *RouteKey {
 //
 type RouteTable interface {
 	Insert(*nlamsg.Route) *nlamsg.Route
+	Restore(*nlamsg.Route)
 	Select(*RouteKey) *nlamsg.Route
 	Delete(*RouteKey) *nlamsg.Route
 	Walk(f func(*nlamsg.Route) error) error
@@ -114,6 +130,25 @@ func (t *routeTable) Insert(r *nlamsg.Route) (old *nlamsg.Route) {
 	return
 }
 
+//
+// Restore inserts route keeping RtId (e.g. loaded from snapshot).
+//
+func (t *routeTable) Restore(r *nlamsg.Route) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := RouteToKey(r)
+	if old := t.find(key); old != nil {
+		t.GwIdx.Delete(old)
+		t.LinkIdx.Delete(old)
+	}
+
+	t.Counter.Update(r.NId, r.RtId)
+	t.Routes[*key] = r.Copy()
+	t.GwIdx.Insert(r)
+	t.LinkIdx.Insert(r)
+}
+
 func (t *routeTable) Select(key *RouteKey) *nlamsg.Route {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
@@ -256,37 +291,39 @@ func NewRouteLinkIndex() *RouteLinkIndex {
 }
 
 func (r *RouteLinkIndex) Insert(route *nlamsg.Route) {
-	ifindex := route.LinkIndex
-	if ifindex <= 0 {
-		return
-	}
+	for _, ifindex := range route.GetLinkIndexes() {
+		if ifindex <= 0 {
+			continue
+		}
 
-	key := NewLinkKey(route.NId, ifindex)
-	e, ok := r.Entry[*key]
-	if !ok {
-		e = NewRouteGwIndexEntry()
-		r.Entry[*key] = e
-	}
+		key := NewLinkKey(route.NId, ifindex)
+		e, ok := r.Entry[*key]
+		if !ok {
+			e = NewRouteGwIndexEntry()
+			r.Entry[*key] = e
+		}
 
-	e.Insert(RouteToKey(route))
+		e.Insert(RouteToKey(route))
+	}
 }
 
 func (r *RouteLinkIndex) Delete(route *nlamsg.Route) {
-	ifindex := route.LinkIndex
-	if ifindex <= 0 {
-		return
-	}
+	for _, ifindex := range route.GetLinkIndexes() {
+		if ifindex <= 0 {
+			continue
+		}
 
-	key := NewLinkKey(route.NId, ifindex)
-	e, ok := r.Entry[*key]
-	if !ok {
-		return
-	}
+		key := NewLinkKey(route.NId, ifindex)
+		e, ok := r.Entry[*key]
+		if !ok {
+			continue
+		}
 
-	e.Delete(RouteToKey(route))
+		e.Delete(RouteToKey(route))
 
-	if e.Len() == 0 {
-		delete(r.Entry, *key)
+		if e.Len() == 0 {
+			delete(r.Entry, *key)
+		}
 	}
 }
 
@@ -309,37 +346,31 @@ func NewRouteGwIndex() *RouteGwIndex {
 }
 
 func (r *RouteGwIndex) Insert(route *nlamsg.Route) {
-	gw := route.GetGw()
-	if gw == nil {
-		return
-	}
+	for _, gw := range route.GetGws() {
+		key := NewNeighKey(route.NId, gw)
+		e, ok := r.Entry[*key]
+		if !ok {
+			e = NewRouteGwIndexEntry()
+			r.Entry[*key] = e
+		}
 
-	key := NewNeighKey(route.NId, gw)
-	e, ok := r.Entry[*key]
-	if !ok {
-		e = NewRouteGwIndexEntry()
-		r.Entry[*key] = e
+		e.Insert(RouteToKey(route))
 	}
-
-	e.Insert(RouteToKey(route))
 }
 
 func (r *RouteGwIndex) Delete(route *nlamsg.Route) {
-	gw := route.GetGw()
-	if gw == nil {
-		return
-	}
-
-	key := NewNeighKey(route.NId, gw)
-	e, ok := r.Entry[*key]
-	if !ok {
-		return
-	}
+	for _, gw := range route.GetGws() {
+		key := NewNeighKey(route.NId, gw)
+		e, ok := r.Entry[*key]
+		if !ok {
+			continue
+		}
 
-	e.Delete(RouteToKey(route))
+		e.Delete(RouteToKey(route))
 
-	if e.Len() == 0 {
-		delete(r.Entry, *key)
+		if e.Len() == 0 {
+			delete(r.Entry, *key)
+		}
 	}
 }
 
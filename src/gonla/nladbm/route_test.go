@@ -95,3 +95,97 @@ func TestTouteTableIptunRemote(t *testing.T) {
 		t.Errorf("routeTable.SelectByTunRemote unmatch. dst=%s", v)
 	}
 }
+
+func TestRouteTableMultiPath(t *testing.T) {
+	nid := uint8(0)
+	tbl := NewRouteTable().(*routeTable)
+
+	_, dst, _ := net.ParseCIDR("10.0.0.0/24")
+	route := &netlink.Route{
+		Dst: dst,
+		MultiPath: []*netlink.NexthopInfo{
+			{LinkIndex: 1, Gw: net.ParseIP("10.1.0.1")},
+			{LinkIndex: 2, Gw: net.ParseIP("10.2.0.1")},
+		},
+	}
+	tbl.Insert(nlamsg.NewRoute(route, nid, 0, nil, []uint32{}))
+
+	for _, gw := range []string{"10.1.0.1", "10.2.0.1"} {
+		num := 0
+		tbl.WalkByGw(nid, net.ParseIP(gw), func(r *nlamsg.Route) error {
+			if n := len(r.MultiPath); n != 2 {
+				t.Errorf("routeTable.WalkByGw unmatch. multipath=%d", n)
+			}
+			num++
+			return nil
+		})
+		if num != 1 {
+			t.Errorf("routeTable.WalkByGw unmatch. gw=%s num=%d", gw, num)
+		}
+	}
+
+	for _, ifindex := range []int{1, 2} {
+		num := 0
+		tbl.WalkByLink(nid, ifindex, func(r *nlamsg.Route) error {
+			num++
+			return nil
+		})
+		if num != 1 {
+			t.Errorf("routeTable.WalkByLink unmatch. ifindex=%d num=%d", ifindex, num)
+		}
+	}
+
+	// stored route must not share nexthops with original.
+	route.MultiPath[0].Gw = net.ParseIP("10.3.0.1")
+	stored := tbl.Select(NewRouteKey(nid, dst))
+	if gw := stored.MultiPath[0].Gw; !gw.Equal(net.ParseIP("10.1.0.1")) {
+		t.Errorf("routeTable.Insert unmatch. gw=%s", gw)
+	}
+
+	tbl.Delete(NewRouteKey(nid, dst))
+	if n := len(tbl.GwIdx.Entry); n != 0 {
+		t.Errorf("routeTable.Delete unmatch. gw index=%d", n)
+	}
+	if n := len(tbl.LinkIdx.Entry); n != 0 {
+		t.Errorf("routeTable.Delete unmatch. link index=%d", n)
+	}
+}
+
+func TestRouteTableVrfTable(t *testing.T) {
+	nid := uint8(1)
+	_, dst, _ := net.ParseCIDR("0.0.0.0/0")
+
+	tbl := NewRouteTable()
+	for _, table := range []int{254, 10} {
+		route := &netlink.Route{
+			Dst:   dst,
+			Gw:    net.ParseIP("10.1.0.1"),
+			Table: table,
+		}
+		if old := tbl.Insert(nlamsg.NewRoute(route, nid, 0, nil, nil)); old != nil {
+			t.Errorf("routeTable.Insert unmatch. table=%d old=%v", table, old)
+		}
+	}
+
+	if r := tbl.Select(NewRouteKey(nid, dst)); r == nil || r.Table != 254 {
+		t.Errorf("routeTable.Select unmatch. %v", r)
+	}
+
+	key := NewRouteKey(nid, dst)
+	key.Table = 10
+	if r := tbl.Select(key); r == nil || r.Table != 10 {
+		t.Errorf("routeTable.Select unmatch. %v", r)
+	}
+
+	for _, table := range []int{1, 10, 251} {
+		if !IsVrfRouteTable(table) {
+			t.Errorf("IsVrfRouteTable unmatch. %d", table)
+		}
+	}
+
+	for _, table := range []int{0, 252, 253, 254, 255} {
+		if IsVrfRouteTable(table) {
+			t.Errorf("IsVrfRouteTable unmatch. %d", table)
+		}
+	}
+}
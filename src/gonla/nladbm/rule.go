@@ -0,0 +1,143 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"gonla/nlamsg"
+	"net"
+	"sync"
+)
+
+//
+// Key
+//
+type RuleKey struct {
+	NId      uint8
+	Family   int
+	Priority int
+	Src      string // net.IPNet
+	Dst      string // net.IPNet
+	IifName  string
+	OifName  string
+	Mark     int
+	Table    int
+}
+
+func NewRuleKey(nid uint8, family int, prio int, src, dst *net.IPNet, iif, oif string, mark int, table int) *RuleKey {
+	ipnetStr := func(n *net.IPNet) string {
+		if n == nil {
+			return ""
+		}
+		return n.String()
+	}
+
+	return &RuleKey{
+		NId:      nid,
+		Family:   family,
+		Priority: prio,
+		Src:      ipnetStr(src),
+		Dst:      ipnetStr(dst),
+		IifName:  iif,
+		OifName:  oif,
+		Mark:     mark,
+		Table:    table,
+	}
+}
+
+func RuleToKey(r *nlamsg.Rule) *RuleKey {
+	return NewRuleKey(r.NId, r.Family, r.Priority, r.Src, r.Dst, r.IifName, r.OifName, r.Mark, r.Table)
+}
+
+//
+// Table interface
+//
+type RuleTable interface {
+	Insert(*nlamsg.Rule) *nlamsg.Rule
+	Select(*RuleKey) *nlamsg.Rule
+	Delete(*RuleKey) *nlamsg.Rule
+	Walk(f func(*nlamsg.Rule) error) error
+	WalkFree(f func(*nlamsg.Rule) error) error
+}
+
+func NewRuleTable() RuleTable {
+	return &ruleTable{
+		Rules: make(map[RuleKey]*nlamsg.Rule),
+	}
+}
+
+//
+// Table
+//
+type ruleTable struct {
+	Mutex sync.RWMutex
+	Rules map[RuleKey]*nlamsg.Rule
+}
+
+func (t *ruleTable) find(key *RuleKey) *nlamsg.Rule {
+	r, _ := t.Rules[*key]
+	return r
+}
+
+//
+// Insert registers rule. if rule already exists, it is replaced
+// and old one is returned.
+//
+func (t *ruleTable) Insert(r *nlamsg.Rule) (old *nlamsg.Rule) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := RuleToKey(r)
+	old = t.find(key)
+	t.Rules[*key] = r.Copy()
+
+	return
+}
+
+func (t *ruleTable) Select(key *RuleKey) *nlamsg.Rule {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.find(key)
+}
+
+func (t *ruleTable) Delete(key *RuleKey) (old *nlamsg.Rule) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	if old = t.find(key); old != nil {
+		delete(t.Rules, *key)
+	}
+
+	return
+}
+
+func (t *ruleTable) Walk(f func(*nlamsg.Rule) error) error {
+	t.Mutex.RLock()
+	defer t.Mutex.RUnlock()
+
+	return t.WalkFree(f)
+}
+
+func (t *ruleTable) WalkFree(f func(*nlamsg.Rule) error) error {
+	for _, r := range t.Rules {
+		if err := f(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
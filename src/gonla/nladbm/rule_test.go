@@ -0,0 +1,79 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nladbm
+
+import (
+	"net"
+	"testing"
+
+	"gonla/nlamsg"
+)
+
+func TestRuleTable(t *testing.T) {
+	tbl := NewRuleTable()
+
+	_, src1, _ := net.ParseCIDR("10.1.0.0/16")
+	_, src2, _ := net.ParseCIDR("10.2.0.0/16")
+
+	newRule := func(nid uint8, prio int, src *net.IPNet, table int) *nlamsg.Rule {
+		r := nlamsg.NewRule(nil, nid)
+		r.Priority = prio
+		r.Src = src
+		r.Table = table
+		return r
+	}
+
+	r1 := newRule(1, 100, src1, 10)
+	r2 := newRule(1, 100, src2, 20)
+	r3 := newRule(2, 100, src1, 10)
+
+	for _, r := range []*nlamsg.Rule{r1, r2, r3} {
+		if old := tbl.Insert(r); old != nil {
+			t.Errorf("ruleTable.Insert unmatch. old=%s", old)
+		}
+	}
+
+	if old := tbl.Insert(newRule(1, 100, src1, 10)); old == nil {
+		t.Errorf("ruleTable.Insert unmatch. old=%s", old)
+	}
+
+	_, src, _ := net.ParseCIDR("10.2.0.0/16")
+	if r := tbl.Select(NewRuleKey(1, 0, 100, src, nil, "", "", -1, 20)); r == nil || r.Table != 20 {
+		t.Errorf("ruleTable.Select unmatch. %s", r)
+	}
+
+	if r := tbl.Select(NewRuleKey(1, 0, 200, src, nil, "", "", -1, 20)); r != nil {
+		t.Errorf("ruleTable.Select unmatch. %s", r)
+	}
+
+	if old := tbl.Delete(RuleToKey(r3)); old == nil || old.NId != 2 {
+		t.Errorf("ruleTable.Delete unmatch. old=%s", old)
+	}
+	if old := tbl.Delete(RuleToKey(r3)); old != nil {
+		t.Errorf("ruleTable.Delete unmatch. old=%s", old)
+	}
+
+	cnt := 0
+	tbl.Walk(func(r *nlamsg.Rule) error {
+		cnt++
+		return nil
+	})
+	if cnt != 2 {
+		t.Errorf("ruleTable.Walk unmatch. %d", cnt)
+	}
+}
@@ -50,6 +50,7 @@ func VpnToKey(v *nlamsg.Vpn) *VpnKey {
 //
 type VpnTable interface {
 	Insert(*nlamsg.Vpn) *nlamsg.Vpn
+	Restore(*nlamsg.Vpn)
 	Select(*VpnKey) *nlamsg.Vpn
 	Delete(*VpnKey) *nlamsg.Vpn
 	Walk(f func(*nlamsg.Vpn) error) error
@@ -112,6 +113,25 @@ func (t *vpnTable) Insert(v *nlamsg.Vpn) (old *nlamsg.Vpn) {
 	return
 }
 
+//
+// Restore inserts vpn keeping VpnId (e.g. loaded from snapshot).
+//
+func (t *vpnTable) Restore(v *nlamsg.Vpn) {
+	t.Mutex.Lock()
+	defer t.Mutex.Unlock()
+
+	key := VpnToKey(v)
+	if old := t.find(key); old != nil {
+		t.GwIdx.Delete(old)
+		t.VpnGwIdx.Delete(old)
+	}
+
+	t.Counter.Update(v.VpnId)
+	t.Vpns[*key] = v.Copy()
+	t.GwIdx.Insert(v)
+	t.VpnGwIdx.Insert(v)
+}
+
 func (t *vpnTable) Select(key *VpnKey) *nlamsg.Vpn {
 	t.Mutex.RLock()
 	defer t.Mutex.RUnlock()
@@ -35,6 +35,18 @@ func (c *Counter16) Next() uint16 {
 	return uint16(n & 0xffff)
 }
 
+//
+// Update advances counter to v if it is less than v.
+//
+func (c *Counter16) Update(v uint16) {
+	for {
+		n := atomic.LoadUint32(&c.Count)
+		if n >= uint32(v) || atomic.CompareAndSwapUint32(&c.Count, n, uint32(v)) {
+			return
+		}
+	}
+}
+
 type Counters16 struct {
 	Counters [COUNTERS_MAX]Counter16
 }
@@ -47,6 +59,10 @@ func (c *Counters16) Next(index uint8) uint16 {
 	return c.Counters[index].Next()
 }
 
+func (c *Counters16) Update(index uint8, v uint16) {
+	c.Counters[index].Update(v)
+}
+
 //
 // Counter(uint32)
 //
@@ -58,6 +74,18 @@ func (c *Counter32) Next() uint32 {
 	return atomic.AddUint32(&c.Count, 1)
 }
 
+//
+// Update advances counter to v if it is less than v.
+//
+func (c *Counter32) Update(v uint32) {
+	for {
+		n := atomic.LoadUint32(&c.Count)
+		if n >= v || atomic.CompareAndSwapUint32(&c.Count, n, v) {
+			return
+		}
+	}
+}
+
 type Counters32 struct {
 	Counters [COUNTERS_MAX]Counter32
 }
@@ -69,3 +97,7 @@ func NewCounters32() *Counters32 {
 func (c *Counters32) Next(index uint8) uint32 {
 	return c.Counters[index].Next()
 }
+
+func (c *Counters32) Update(index uint8, v uint32) {
+	c.Counters[index].Update(v)
+}
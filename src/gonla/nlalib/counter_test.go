@@ -0,0 +1,49 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlalib
+
+import (
+	"testing"
+)
+
+func TestCounter16Update(t *testing.T) {
+	c := Counter16{}
+
+	c.Update(10)
+	if v := c.Next(); v != 11 {
+		t.Errorf("Counter16.Update unmatch. %d", v)
+	}
+
+	c.Update(5)
+	if v := c.Next(); v != 12 {
+		t.Errorf("Counter16.Update unmatch. %d", v)
+	}
+}
+
+func TestCounters32Update(t *testing.T) {
+	c := NewCounters32()
+
+	c.Update(1, 100)
+	if v := c.Next(1); v != 101 {
+		t.Errorf("Counters32.Update unmatch. %d", v)
+	}
+
+	if v := c.Next(2); v != 1 {
+		t.Errorf("Counters32.Update unmatch. %d", v)
+	}
+}
@@ -19,6 +19,7 @@ package nlalib
 
 import (
 	"fmt"
+	"gonla/nlamsg/nlalink"
 	"net"
 	"strings"
 	"syscall"
@@ -57,6 +58,59 @@ func GetNetlinkRoutes() ([][]byte, error) {
 	return req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWROUTE)
 }
 
+//
+// nlNhmsg is request data of RTM_GETNEXTHOP. (struct nhmsg)
+//
+type nlNhmsg struct {
+	Family uint8
+}
+
+func (m *nlNhmsg) Len() int {
+	return 8
+}
+
+func (m *nlNhmsg) Serialize() []byte {
+	b := make([]byte, m.Len())
+	b[0] = m.Family
+	return b
+}
+
+func GetNetlinkNexthops() ([][]byte, error) {
+	req := nl.NewNetlinkRequest(nlalink.RTM_GETNEXTHOP, unix.NLM_F_DUMP)
+	req.AddData(&nlNhmsg{Family: unix.AF_UNSPEC})
+	return req.Execute(unix.NETLINK_ROUTE, nlalink.RTM_NEWNEXTHOP)
+}
+
+func GetNetlinkRules() ([][]byte, error) {
+	req := nl.NewNetlinkRequest(unix.RTM_GETRULE, unix.NLM_F_DUMP)
+	msg := nl.NewRtMsg()
+	msg.Family = unix.AF_UNSPEC
+	msg.Table = unix.RT_TABLE_UNSPEC
+	req.AddData(msg)
+	return req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWRULE)
+}
+
+//
+// GetNetlinkMroutes dumps multicast forwarding cache (ipmr and ip6mr).
+// message type of result is RTM_NEWROUTE.
+//
+func GetNetlinkMroutes() ([][]byte, error) {
+	datas := [][]byte{}
+	for _, family := range []uint8{nlalink.RTNL_FAMILY_IPMR, nlalink.RTNL_FAMILY_IP6MR} {
+		req := nl.NewNetlinkRequest(unix.RTM_GETROUTE, unix.NLM_F_DUMP)
+		msg := nl.NewRtMsg()
+		msg.Family = family
+		msg.Table = unix.RT_TABLE_UNSPEC
+		req.AddData(msg)
+		res, err := req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWROUTE)
+		if err != nil {
+			return nil, err
+		}
+		datas = append(datas, res...)
+	}
+	return datas, nil
+}
+
 func NewNlMsghdr(t uint16, length uint32) syscall.NlMsghdr {
 	return syscall.NlMsghdr{
 		Type: t,
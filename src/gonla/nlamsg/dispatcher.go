@@ -149,6 +149,63 @@ func DispatchToBridgeVlanInfo(nlmsg *NetlinkMessage, app interface{}) error {
 	return nil
 }
 
+func DispatchNexthop(nlmsg *NetlinkMessage, nh *Nexthop, app interface{}) {
+	if h, ok := app.(NetlinkNexthopHandler); ok {
+		h.NetlinkNexthop(nlmsg, nh)
+	}
+}
+
+func DispatchToNexthop(nlmsg *NetlinkMessage, app interface{}) error {
+	if h, ok := app.(NetlinkNexthopHandler); ok {
+		nh, err := NexthopDeserialize(nlmsg)
+		if err != nil {
+			return err
+		}
+
+		h.NetlinkNexthop(nlmsg, nh)
+	}
+
+	return nil
+}
+
+func DispatchRule(nlmsg *NetlinkMessage, rule *Rule, app interface{}) {
+	if h, ok := app.(NetlinkRuleHandler); ok {
+		h.NetlinkRule(nlmsg, rule)
+	}
+}
+
+func DispatchToRule(nlmsg *NetlinkMessage, app interface{}) error {
+	if h, ok := app.(NetlinkRuleHandler); ok {
+		rule, err := RuleDeserialize(nlmsg)
+		if err != nil {
+			return err
+		}
+
+		h.NetlinkRule(nlmsg, rule)
+	}
+
+	return nil
+}
+
+func DispatchMroute(nlmsg *NetlinkMessage, mroute *Mroute, app interface{}) {
+	if h, ok := app.(NetlinkMrouteHandler); ok {
+		h.NetlinkMroute(nlmsg, mroute)
+	}
+}
+
+func DispatchToMroute(nlmsg *NetlinkMessage, app interface{}) error {
+	if h, ok := app.(NetlinkMrouteHandler); ok {
+		mroute, err := MrouteDeserialize(nlmsg)
+		if err != nil {
+			return err
+		}
+
+		h.NetlinkMroute(nlmsg, mroute)
+	}
+
+	return nil
+}
+
 func DispatchNetlinkMessage(nlmsg *NetlinkMessage, app interface{}) {
 	if h, ok := app.(NetlinkMessageHandler); ok {
 		h.NetlinkMessage(nlmsg)
@@ -181,6 +238,15 @@ func Dispatch(nlmsg *NetlinkMessage, app interface{}) error {
 	case nlalink.RTMGRP_BRIDGE:
 		return DispatchToBridgeVlanInfo(nlmsg, app)
 
+	case nlalink.RTMGRP_NEXTHOP:
+		return DispatchToNexthop(nlmsg, app)
+
+	case nlalink.RTMGRP_RULE:
+		return DispatchToRule(nlmsg, app)
+
+	case nlalink.RTMGRP_MROUTE:
+		return DispatchToMroute(nlmsg, app)
+
 	default:
 		return fmt.Errorf("Dispatcher: unsupported nlmsg. %d", nlmsg.Type())
 	}
@@ -216,6 +282,15 @@ func DispatchUnion(nlmsg *NetlinkMessageUnion, app interface{}) error {
 	case nlalink.RTMGRP_BRIDGE:
 		DispatchBridgeVlanInfo(m, nlmsg.GetBridgeVlanInfo(), app)
 
+	case nlalink.RTMGRP_NEXTHOP:
+		DispatchNexthop(m, nlmsg.GetNexthop(), app)
+
+	case nlalink.RTMGRP_RULE:
+		DispatchRule(m, nlmsg.GetRule(), app)
+
+	case nlalink.RTMGRP_MROUTE:
+		DispatchMroute(m, nlmsg.GetMroute(), app)
+
 	default:
 		return fmt.Errorf("Dispatcher: unsupported nlmsg. %d", nlmsg.Type())
 	}
@@ -48,3 +48,15 @@ type NetlinkVpnHandler interface {
 type NetlinkBridgeVlanInfoHandler interface {
 	NetlinkBridgeVlanInfo(*NetlinkMessage, *BridgeVlanInfo)
 }
+
+type NetlinkNexthopHandler interface {
+	NetlinkNexthop(*NetlinkMessage, *Nexthop)
+}
+
+type NetlinkRuleHandler interface {
+	NetlinkRule(*NetlinkMessage, *Rule)
+}
+
+type NetlinkMrouteHandler interface {
+	NetlinkMroute(*NetlinkMessage, *Mroute)
+}
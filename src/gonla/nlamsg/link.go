@@ -68,6 +68,20 @@ func (ln *Link) Iptun() *netlink.Iptun {
 	return nil
 }
 
+func (ln *Link) Vxlan() *netlink.Vxlan {
+	if vxlan, ok := ln.Link.(*netlink.Vxlan); ok {
+		return vxlan
+	}
+	return nil
+}
+
+func (ln *Link) Macvlan() *netlink.Macvlan {
+	if macvlan, ok := ln.Link.(*netlink.Macvlan); ok {
+		return macvlan
+	}
+	return nil
+}
+
 func (ln *Link) Bridge() *netlink.Bridge {
 	if br, ok := ln.Link.(*netlink.Bridge); ok {
 		return br
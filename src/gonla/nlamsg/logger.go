@@ -173,6 +173,7 @@ func LogRoute(logger LogLogger, level log.Level, m *Route) {
 	logger.Logf(level, "Route: nid      : %d", m.NId)
 	logger.Logf(level, "Route: rtid     : %d", m.RtId)
 	logger.Logf(level, "Route: vpn-gw   : %s", m.VpnGw)
+	logger.Logf(level, "Route: nh-id    : %d", m.NhId)
 	if enids := m.EnIds; enids != nil {
 		for _, enid := range enids {
 			logger.Logf(level, "Route: encap-id : %d", enid)
@@ -244,6 +245,59 @@ func LogBridgeVlanInfo(logger LogLogger, level log.Level, m *BridgeVlanInfo) {
 	LogNetlinkBridgeVlanInfo(logger, level, &m.BridgeVlanInfo)
 }
 
+func LogNexthop(logger LogLogger, level log.Level, m *Nexthop) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "Nexthop: nid      : %d", m.NId)
+	logger.Logf(level, "Nexthop: id       : %d", m.Id)
+	logger.Logf(level, "Nexthop: family   : %d", m.Family)
+	logger.Logf(level, "Nexthop: protocol : %d", m.Protocol)
+	logger.Logf(level, "Nexthop: flags    : 0x%x", m.Flags)
+	logger.Logf(level, "Nexthop: link     : %d", m.LinkIndex)
+	logger.Logf(level, "Nexthop: gw       : %s", m.Gw)
+	logger.Logf(level, "Nexthop: blackhole: %t", m.Blackhole)
+	for _, e := range m.Group {
+		logger.Logf(level, "Nexthop: group    : %d weight %d", e.Id, e.Weight)
+	}
+}
+
+func LogRule(logger LogLogger, level log.Level, m *Rule) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "Rule: nid      : %d", m.NId)
+	logger.Logf(level, "Rule: priority : %d", m.Priority)
+	logger.Logf(level, "Rule: family   : %d", m.Family)
+	logger.Logf(level, "Rule: src      : %s", m.Src)
+	logger.Logf(level, "Rule: dst      : %s", m.Dst)
+	logger.Logf(level, "Rule: iif      : '%s'", m.IifName)
+	logger.Logf(level, "Rule: oif      : '%s'", m.OifName)
+	logger.Logf(level, "Rule: mark     : %d/%d", m.Mark, m.Mask)
+	logger.Logf(level, "Rule: table    : %d", m.Table)
+	logger.Logf(level, "Rule: action   : %d", m.Action)
+	logger.Logf(level, "Rule: invert   : %t", m.Invert)
+}
+
+func LogMroute(logger LogLogger, level log.Level, m *Mroute) {
+	if isSkipLog(level) {
+		return
+	}
+
+	logger.Logf(level, "Mroute: nid      : %d", m.NId)
+	logger.Logf(level, "Mroute: id       : %d", m.MrId)
+	logger.Logf(level, "Mroute: family   : %d", m.Family)
+	logger.Logf(level, "Mroute: table    : %d", m.Table)
+	logger.Logf(level, "Mroute: src      : %s", m.Src)
+	logger.Logf(level, "Mroute: group    : %s", m.Group)
+	logger.Logf(level, "Mroute: iif      : %d", m.Iif)
+	for _, oif := range m.Oifs {
+		logger.Logf(level, "Mroute: oif      : %d ttl %d", oif.Index, oif.Ttl)
+	}
+}
+
 type logNetlinkMessageUnion struct {
 	level  log.Level
 	logger LogLogger
@@ -277,6 +331,18 @@ func (m *logNetlinkMessageUnion) NetlinkBridgeVlanInfo(nlmsg *NetlinkMessage, br
 	LogBridgeVlanInfo(m.logger, m.level, brvlan)
 }
 
+func (m *logNetlinkMessageUnion) NetlinkNexthop(nlmsg *NetlinkMessage, nh *Nexthop) {
+	LogNexthop(m.logger, m.level, nh)
+}
+
+func (m *logNetlinkMessageUnion) NetlinkRule(nlmsg *NetlinkMessage, rule *Rule) {
+	LogRule(m.logger, m.level, rule)
+}
+
+func (m *logNetlinkMessageUnion) NetlinkMroute(nlmsg *NetlinkMessage, mroute *Mroute) {
+	LogMroute(m.logger, m.level, mroute)
+}
+
 func LogNetlinkMesssage(logger LogLogger, level log.Level, m *NetlinkMessage) {
 	if isSkipLog(level) {
 		return
@@ -0,0 +1,245 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"fmt"
+	"gonla/nlamsg/nlalink"
+	"net"
+	"syscall"
+
+	"github.com/vishvananda/netlink/nl"
+)
+
+//
+// MrouteOif is output interface of multicast route.
+// Ttl is ttl threshold (rtnexthop.hops).
+//
+type MrouteOif struct {
+	Index int
+	Ttl   uint8
+}
+
+func (o *MrouteOif) String() string {
+	return fmt.Sprintf("%d(ttl:%d)", o.Index, o.Ttl)
+}
+
+//
+// Mroute is multicast forwarding cache (MFC) entry.
+// Src is nil for (*,G) entry.
+//
+type Mroute struct {
+	Family int // AF_INET or AF_INET6
+	Table  int
+	Src    net.IP
+	Group  net.IP
+	Iif    int
+	Oifs   []*MrouteOif
+	NId    uint8
+	MrId   uint32
+}
+
+func (m *Mroute) Copy() *Mroute {
+	oifs := make([]*MrouteOif, len(m.Oifs))
+	for i, oif := range m.Oifs {
+		o := *oif
+		oifs[i] = &o
+	}
+
+	return &Mroute{
+		Family: m.Family,
+		Table:  m.Table,
+		Src:    m.Src,
+		Group:  m.Group,
+		Iif:    m.Iif,
+		Oifs:   oifs,
+		NId:    m.NId,
+		MrId:   m.MrId,
+	}
+}
+
+//
+// SameForwarding returns true if iif and oifs are same as o.
+//
+func (m *Mroute) SameForwarding(o *Mroute) bool {
+	if m.Iif != o.Iif || len(m.Oifs) != len(o.Oifs) {
+		return false
+	}
+
+	for i, oif := range m.Oifs {
+		if *oif != *o.Oifs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// HasOif returns true if index is one of oifs.
+//
+func (m *Mroute) HasOif(index int) bool {
+	for _, oif := range m.Oifs {
+		if oif.Index == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+//
+// IsStarG returns true if mroute is (*,G) entry.
+//
+func (m *Mroute) IsStarG() bool {
+	return m.Src == nil || m.Src.IsUnspecified()
+}
+
+func (m *Mroute) String() string {
+	return fmt.Sprintf("{Mroute: Family:%d Table:%d (%s,%s) Iif:%d Oifs:%v NId:%d MrId:%d}",
+		m.Family, m.Table, m.Src, m.Group, m.Iif, m.Oifs, m.NId, m.MrId)
+}
+
+func NewMroute(nid uint8, id uint32) *Mroute {
+	return &Mroute{
+		Oifs: []*MrouteOif{},
+		NId:  nid,
+		MrId: id,
+	}
+}
+
+//
+// IsMrouteMessage returns true if msg is RTM_{NEW,DEL}ROUTE of ipmr/ip6mr.
+//
+func IsMrouteMessage(msg *syscall.NetlinkMessage) bool {
+	switch msg.Header.Type {
+	case syscall.RTM_NEWROUTE, syscall.RTM_DELROUTE:
+		if len(msg.Data) < syscall.SizeofRtMsg {
+			return false
+		}
+
+		switch msg.Data[0] { // rtmsg.rtm_family
+		case nlalink.RTNL_FAMILY_IPMR, nlalink.RTNL_FAMILY_IP6MR:
+			return true
+		}
+	}
+
+	return false
+}
+
+//
+// ConvertMrouteMessage converts RTM_{NEW,DEL}ROUTE of ipmr/ip6mr
+// to RTM_{NEW,DEL}MROUTE. Other messages are not changed.
+//
+func ConvertMrouteMessage(msg *syscall.NetlinkMessage) {
+	if !IsMrouteMessage(msg) {
+		return
+	}
+
+	if msg.Header.Type == syscall.RTM_NEWROUTE {
+		msg.Header.Type = nlalink.RTM_NEWMROUTE
+	} else {
+		msg.Header.Type = nlalink.RTM_DELMROUTE
+	}
+}
+
+//
+// ParseMroute parses RTM_{NEW,DEL}ROUTE message data of ipmr/ip6mr.
+//
+func ParseMroute(b []byte) (*Mroute, error) {
+	if len(b) < syscall.SizeofRtMsg {
+		return nil, fmt.Errorf("Invalid rtmsg length. %d", len(b))
+	}
+
+	msg := nl.DeserializeRtMsg(b)
+	attrs, err := nl.ParseRouteAttr(b[syscall.SizeofRtMsg:])
+	if err != nil {
+		return nil, err
+	}
+
+	m := NewMroute(0, 0)
+	m.Table = int(msg.Table)
+
+	switch msg.Family {
+	case nlalink.RTNL_FAMILY_IPMR:
+		m.Family = syscall.AF_INET
+	case nlalink.RTNL_FAMILY_IP6MR:
+		m.Family = syscall.AF_INET6
+	default:
+		return nil, fmt.Errorf("Invalid mroute family. %d", msg.Family)
+	}
+
+	native := nl.NativeEndian()
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case syscall.RTA_TABLE:
+			m.Table = int(native.Uint32(attr.Value[0:4]))
+		case syscall.RTA_SRC:
+			m.Src = net.IP(attr.Value)
+		case syscall.RTA_DST:
+			m.Group = net.IP(attr.Value)
+		case syscall.RTA_IIF:
+			m.Iif = int(native.Uint32(attr.Value[0:4]))
+		case syscall.RTA_MULTIPATH:
+			oifs, err := parseMrouteOifs(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			m.Oifs = oifs
+		}
+	}
+
+	if m.Group == nil {
+		return nil, fmt.Errorf("Invalid mroute. group not found.")
+	}
+
+	return m, nil
+}
+
+func parseMrouteOifs(b []byte) ([]*MrouteOif, error) {
+	oifs := []*MrouteOif{}
+	for len(b) >= syscall.SizeofRtNexthop {
+		nh := nl.DeserializeRtNexthop(b)
+		if int(nh.RtNexthop.Len) < syscall.SizeofRtNexthop || int(nh.RtNexthop.Len) > len(b) {
+			return nil, fmt.Errorf("Invalid rtnexthop length. %d", nh.RtNexthop.Len)
+		}
+
+		oifs = append(oifs, &MrouteOif{
+			Index: int(nh.RtNexthop.Ifindex),
+			Ttl:   nh.RtNexthop.Hops,
+		})
+
+		next := (int(nh.RtNexthop.Len) + syscall.RTA_ALIGNTO - 1) & ^(syscall.RTA_ALIGNTO - 1)
+		if next >= len(b) {
+			break
+		}
+		b = b[next:]
+	}
+
+	return oifs, nil
+}
+
+func MrouteDeserialize(nlmsg *NetlinkMessage) (*Mroute, error) {
+	m, err := ParseMroute(nlmsg.Data)
+	if err != nil {
+		return nil, err
+	}
+	m.NId = nlmsg.NId
+
+	return m, nil
+}
@@ -0,0 +1,153 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"gonla/nlalib"
+	"gonla/nlamsg/nlalink"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/vishvananda/netlink/nl"
+)
+
+func testMrouteMessage(t uint16, family uint8, src, group net.IP, iif uint32, oifs map[int32]uint8) *syscall.NetlinkMessage {
+	msg := nl.RtMsg{}
+	msg.Family = family
+	msg.Table = syscall.RT_TABLE_DEFAULT
+	b := msg.Serialize()
+
+	native := nl.NativeEndian()
+	if src != nil {
+		b = append(b, nl.NewRtAttr(syscall.RTA_SRC, src).Serialize()...)
+	}
+	b = append(b, nl.NewRtAttr(syscall.RTA_DST, group).Serialize()...)
+
+	iifb := make([]byte, 4)
+	native.PutUint32(iifb, iif)
+	b = append(b, nl.NewRtAttr(syscall.RTA_IIF, iifb).Serialize()...)
+
+	mp := []byte{}
+	for index, ttl := range oifs {
+		nh := nl.RtNexthop{}
+		nh.RtNexthop.Hops = ttl
+		nh.RtNexthop.Ifindex = index
+		mp = append(mp, nh.Serialize()...)
+	}
+	b = append(b, nl.NewRtAttr(syscall.RTA_MULTIPATH, mp).Serialize()...)
+
+	return nlalib.NewNetlinkMessage(t, b)
+}
+
+func TestConvertMrouteMessage(t *testing.T) {
+	group := net.ParseIP("239.1.1.1").To4()
+
+	msg := testMrouteMessage(syscall.RTM_NEWROUTE, nlalink.RTNL_FAMILY_IPMR, nil, group, 1, nil)
+	ConvertMrouteMessage(msg)
+	if v := msg.Header.Type; v != nlalink.RTM_NEWMROUTE {
+		t.Errorf("ConvertMrouteMessage unmatch. type=%d", v)
+	}
+
+	msg = testMrouteMessage(syscall.RTM_DELROUTE, nlalink.RTNL_FAMILY_IP6MR, nil, net.ParseIP("ff0e::1"), 1, nil)
+	ConvertMrouteMessage(msg)
+	if v := msg.Header.Type; v != nlalink.RTM_DELMROUTE {
+		t.Errorf("ConvertMrouteMessage unmatch. type=%d", v)
+	}
+
+	msg = testMrouteMessage(syscall.RTM_NEWROUTE, syscall.AF_INET, nil, group, 1, nil)
+	ConvertMrouteMessage(msg)
+	if v := msg.Header.Type; v != syscall.RTM_NEWROUTE {
+		t.Errorf("ConvertMrouteMessage unmatch. type=%d", v)
+	}
+}
+
+func TestMrouteDeserialize(t *testing.T) {
+	src := net.ParseIP("10.0.0.1").To4()
+	group := net.ParseIP("239.1.1.1").To4()
+	msg := testMrouteMessage(nlalink.RTM_NEWMROUTE, nlalink.RTNL_FAMILY_IPMR, src, group, 3, map[int32]uint8{4: 1})
+
+	m, err := MrouteDeserialize(NewNetlinkMessage(msg, 2, SRC_KNL))
+	if err != nil {
+		t.Errorf("MrouteDeserialize error. %s", err)
+		return
+	}
+	if v := m.Family; v != syscall.AF_INET {
+		t.Errorf("MrouteDeserialize unmatch. family=%d", v)
+	}
+	if v := m.Table; v != syscall.RT_TABLE_DEFAULT {
+		t.Errorf("MrouteDeserialize unmatch. table=%d", v)
+	}
+	if !m.Src.Equal(src) || !m.Group.Equal(group) || m.IsStarG() {
+		t.Errorf("MrouteDeserialize unmatch. %s", m)
+	}
+	if v := m.Iif; v != 3 {
+		t.Errorf("MrouteDeserialize unmatch. iif=%d", v)
+	}
+	if len(m.Oifs) != 1 || m.Oifs[0].Index != 4 || m.Oifs[0].Ttl != 1 {
+		t.Errorf("MrouteDeserialize unmatch. oifs=%v", m.Oifs)
+	}
+	if !m.HasOif(4) || m.HasOif(3) {
+		t.Errorf("MrouteDeserialize unmatch. %s", m)
+	}
+	if v := m.NId; v != 2 {
+		t.Errorf("MrouteDeserialize unmatch. nid=%d", v)
+	}
+}
+
+func TestMrouteDeserializeStarG(t *testing.T) {
+	group := net.ParseIP("ff0e::1")
+	msg := testMrouteMessage(nlalink.RTM_NEWMROUTE, nlalink.RTNL_FAMILY_IP6MR, nil, group, 3, nil)
+
+	m, err := MrouteDeserialize(NewNetlinkMessage(msg, 0, SRC_KNL))
+	if err != nil {
+		t.Errorf("MrouteDeserialize error. %s", err)
+		return
+	}
+	if v := m.Family; v != syscall.AF_INET6 {
+		t.Errorf("MrouteDeserialize unmatch. family=%d", v)
+	}
+	if !m.IsStarG() || !m.Group.Equal(group) {
+		t.Errorf("MrouteDeserialize unmatch. %s", m)
+	}
+	if v := len(m.Oifs); v != 0 {
+		t.Errorf("MrouteDeserialize unmatch. oifs=%v", m.Oifs)
+	}
+}
+
+func TestMroute_SameForwarding(t *testing.T) {
+	m1 := NewMroute(0, 0)
+	m1.Iif = 1
+	m1.Oifs = []*MrouteOif{{Index: 2, Ttl: 1}}
+
+	m2 := m1.Copy()
+	if !m1.SameForwarding(m2) {
+		t.Errorf("Mroute.SameForwarding unmatch. %s %s", m1, m2)
+	}
+
+	m2.Oifs[0].Ttl = 2
+	if m1.SameForwarding(m2) {
+		t.Errorf("Mroute.SameForwarding unmatch. %s %s", m1, m2)
+	}
+
+	m2 = m1.Copy()
+	m2.Iif = 3
+	if m1.SameForwarding(m2) {
+		t.Errorf("Mroute.SameForwarding unmatch. %s %s", m1, m2)
+	}
+}
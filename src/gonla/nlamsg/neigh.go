@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
 //
@@ -69,7 +70,15 @@ func (n *Neigh) IsTunnelRemote() bool {
 }
 
 func (n *Neigh) IsFdbEntry() bool {
-	return n.IP == nil || len(n.IP) == 0
+	return n.IP == nil || len(n.IP) == 0 || n.IsVtepEntry()
+}
+
+//
+// IsVtepEntry returns true if neigh is FDB entry of vxlan device
+// which has remote VTEP (NDA_DST). IP is address of remote VTEP.
+//
+func (n *Neigh) IsVtepEntry() bool {
+	return n.Family == unix.AF_BRIDGE && len(n.IP) != 0
 }
 
 func (n *Neigh) SetTunnel(phyLink int, tunnel NeighTunnel) {
@@ -0,0 +1,258 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"gonla/nlalib"
+	"net"
+
+	"github.com/vishvananda/netlink/nl"
+)
+
+//
+// struct nhmsg (linux/nexthop.h)
+//
+const SizeofNhmsg = 8
+
+type Nhmsg struct {
+	Family   uint8
+	Scope    uint8
+	Protocol uint8
+	Resvd    uint8
+	Flags    uint32
+}
+
+//
+// nexthop attributes (linux/nexthop.h)
+//
+const (
+	NHA_UNSPEC = iota
+	NHA_ID
+	NHA_GROUP
+	NHA_GROUP_TYPE
+	NHA_BLACKHOLE
+	NHA_OIF
+	NHA_GATEWAY
+	NHA_ENCAP_TYPE
+	NHA_ENCAP
+	NHA_GROUPS
+	NHA_MASTER
+)
+
+//
+// struct nexthop_grp (linux/nexthop.h)
+//
+const SizeofNexthopGrp = 8
+
+//
+// NexthopGroupEntry is member of nexthop group.
+// Weight is the value of kernel (weight - 1).
+//
+type NexthopGroupEntry struct {
+	Id     uint32
+	Weight uint8
+}
+
+func (e *NexthopGroupEntry) String() string {
+	return fmt.Sprintf("%d,%d", e.Id, e.Weight)
+}
+
+//
+// Nexthop is kernel nexthop object.
+//
+type Nexthop struct {
+	Id        uint32
+	Family    uint8
+	Protocol  uint8
+	Flags     uint32
+	LinkIndex int
+	Gw        net.IP
+	Blackhole bool
+	Group     []NexthopGroupEntry
+	NId       uint8
+}
+
+func (n *Nexthop) Copy() *Nexthop {
+	nh := *n
+	if n.Group != nil {
+		nh.Group = make([]NexthopGroupEntry, len(n.Group))
+		copy(nh.Group, n.Group)
+	}
+	return &nh
+}
+
+func (n *Nexthop) IsGroup() bool {
+	return len(n.Group) > 0
+}
+
+//
+// HasMember returns true if nexthop group has nexthop(id).
+//
+func (n *Nexthop) HasMember(id uint32) bool {
+	for _, e := range n.Group {
+		if e.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *Nexthop) String() string {
+	return fmt.Sprintf("{Nexthop: Id:%d Family:%d Proto:%d Flags:0x%x Link:%d Gw:%s Blackhole:%t Group:%v NId:%d}",
+		n.Id, n.Family, n.Protocol, n.Flags, n.LinkIndex, n.Gw, n.Blackhole, n.Group, n.NId)
+}
+
+func parseNexthopGroup(b []byte) ([]NexthopGroupEntry, error) {
+	if len(b)%SizeofNexthopGrp != 0 {
+		return nil, fmt.Errorf("Invalid NHA_GROUP length. %d", len(b))
+	}
+
+	native := nl.NativeEndian()
+	entries := make([]NexthopGroupEntry, len(b)/SizeofNexthopGrp)
+	for i := range entries {
+		d := b[i*SizeofNexthopGrp:]
+		entries[i] = NexthopGroupEntry{
+			Id:     native.Uint32(d[0:4]),
+			Weight: d[4],
+		}
+	}
+
+	return entries, nil
+}
+
+//
+// ParseNexthop parses RTM_{NEW,DEL}NEXTHOP message data.
+//
+func ParseNexthop(b []byte) (*Nexthop, error) {
+	if len(b) < SizeofNhmsg {
+		return nil, fmt.Errorf("Invalid nhmsg length. %d", len(b))
+	}
+
+	msg := Nhmsg{}
+	if err := binary.Read(bytes.NewReader(b[:SizeofNhmsg]), nl.NativeEndian(), &msg); err != nil {
+		return nil, err
+	}
+
+	attrs, err := nl.ParseRouteAttr(b[SizeofNhmsg:])
+	if err != nil {
+		return nil, err
+	}
+
+	nh := &Nexthop{
+		Family:   msg.Family,
+		Protocol: msg.Protocol,
+		Flags:    msg.Flags,
+	}
+
+	native := nl.NativeEndian()
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case NHA_ID:
+			nh.Id = native.Uint32(attr.Value[0:4])
+
+		case NHA_OIF:
+			nh.LinkIndex = int(native.Uint32(attr.Value[0:4]))
+
+		case NHA_GATEWAY:
+			nh.Gw = net.IP(attr.Value)
+
+		case NHA_BLACKHOLE:
+			nh.Blackhole = true
+
+		case NHA_GROUP:
+			group, err := parseNexthopGroup(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			nh.Group = group
+		}
+	}
+
+	return nh, nil
+}
+
+func NexthopDeserialize(nlmsg *NetlinkMessage) (*Nexthop, error) {
+	nh, err := ParseNexthop(nlmsg.Data)
+	if err != nil {
+		return nil, err
+	}
+	nh.NId = nlmsg.NId
+
+	return nh, nil
+}
+
+//
+// Bytes serializes nexthop to RTM_{NEW,DEL}NEXTHOP message data.
+//
+func (n *Nexthop) Bytes() []byte {
+	native := nl.NativeEndian()
+
+	var buf bytes.Buffer
+	binary.Write(&buf, native, &Nhmsg{
+		Family:   n.Family,
+		Protocol: n.Protocol,
+		Flags:    n.Flags,
+	})
+
+	u32 := func(v uint32) []byte {
+		b := make([]byte, 4)
+		native.PutUint32(b, v)
+		return b
+	}
+
+	buf.Write(nl.NewRtAttr(NHA_ID, u32(n.Id)).Serialize())
+
+	if n.IsGroup() {
+		group := make([]byte, len(n.Group)*SizeofNexthopGrp)
+		for i, e := range n.Group {
+			native.PutUint32(group[i*SizeofNexthopGrp:], e.Id)
+			group[i*SizeofNexthopGrp+4] = e.Weight
+		}
+		buf.Write(nl.NewRtAttr(NHA_GROUP, group).Serialize())
+	}
+
+	if n.Blackhole {
+		buf.Write(nl.NewRtAttr(NHA_BLACKHOLE, nil).Serialize())
+	}
+
+	if n.LinkIndex != 0 {
+		buf.Write(nl.NewRtAttr(NHA_OIF, u32(uint32(n.LinkIndex))).Serialize())
+	}
+
+	if n.Gw != nil {
+		gw := []byte(n.Gw.To4())
+		if gw == nil {
+			gw = []byte(n.Gw.To16())
+		}
+		buf.Write(nl.NewRtAttr(NHA_GATEWAY, gw).Serialize())
+	}
+
+	return buf.Bytes()
+}
+
+//
+// NexthopSerialize coverts Nexthop struct to netlink message.
+// msgType is RTM_NEWNEXTHOP or RTM_DELNEXTHOP (gonla/nlamsg/nlalink package)
+//
+func NexthopSerialize(nh *Nexthop, msgType uint16) *NetlinkMessage {
+	nlmsg := nlalib.NewNetlinkMessage(msgType, nh.Bytes())
+	return NewNetlinkMessage(nlmsg, nh.NId, SRC_NOP)
+}
@@ -0,0 +1,183 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"gonla/nlalib"
+	"gonla/nlamsg/nlalink"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+func TestNexthopDeserialize(t *testing.T) {
+	nh := &Nexthop{
+		Id:        10,
+		Family:    syscall.AF_INET,
+		Protocol:  186,
+		LinkIndex: 3,
+		Gw:        net.ParseIP("10.0.0.1"),
+		NId:       5,
+	}
+
+	m, err := NexthopDeserialize(NexthopSerialize(nh, nlalink.RTM_NEWNEXTHOP))
+	if err != nil {
+		t.Errorf("NexthopDeserialize error. %s", err)
+	}
+	if v := m.Id; v != 10 {
+		t.Errorf("NexthopDeserialize unmatch. id=%d", v)
+	}
+	if v := m.Family; v != syscall.AF_INET {
+		t.Errorf("NexthopDeserialize unmatch. family=%d", v)
+	}
+	if v := m.Protocol; v != 186 {
+		t.Errorf("NexthopDeserialize unmatch. protocol=%d", v)
+	}
+	if v := m.LinkIndex; v != 3 {
+		t.Errorf("NexthopDeserialize unmatch. link=%d", v)
+	}
+	if v := m.Gw; !v.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("NexthopDeserialize unmatch. gw=%s", v)
+	}
+	if v := m.NId; v != 5 {
+		t.Errorf("NexthopDeserialize unmatch. nid=%d", v)
+	}
+	if m.IsGroup() || m.Blackhole {
+		t.Errorf("NexthopDeserialize unmatch. %s", m)
+	}
+}
+
+func TestNexthopDeserializeGroup(t *testing.T) {
+	nh := &Nexthop{
+		Id:    20,
+		Group: []NexthopGroupEntry{{Id: 1, Weight: 0}, {Id: 2, Weight: 3}},
+	}
+
+	m, err := NexthopDeserialize(NexthopSerialize(nh, nlalink.RTM_NEWNEXTHOP))
+	if err != nil {
+		t.Errorf("NexthopDeserialize error. %s", err)
+	}
+	if !m.IsGroup() || len(m.Group) != 2 {
+		t.Errorf("NexthopDeserialize unmatch. group=%v", m.Group)
+	}
+	if v := m.Group[1]; v.Id != 2 || v.Weight != 3 {
+		t.Errorf("NexthopDeserialize unmatch. group=%v", m.Group)
+	}
+	if !m.HasMember(1) || m.HasMember(20) {
+		t.Errorf("Nexthop.HasMember unmatch. group=%v", m.Group)
+	}
+}
+
+func TestNexthopDeserializeError(t *testing.T) {
+	nlmsg := NewNetlinkMessage(nlalib.NewNetlinkMessage(nlalink.RTM_NEWNEXTHOP, []byte{2, 0, 0}), 0, SRC_KNL)
+	if _, err := NexthopDeserialize(nlmsg); err == nil {
+		t.Errorf("NexthopDeserialize must be error.")
+	}
+}
+
+func TestRouteDeserializeNhId(t *testing.T) {
+	rtmsg := nl.NewRtMsg()
+	rtmsg.Family = syscall.AF_INET
+	rtmsg.Dst_len = 24
+
+	nhid := make([]byte, 4)
+	nl.NativeEndian().PutUint32(nhid, 30)
+
+	data := rtmsg.Serialize()
+	data = append(data, nl.NewRtAttr(syscall.RTA_DST, []byte{10, 1, 0, 0}).Serialize()...)
+	data = append(data, nl.NewRtAttr(nlalink.RTA_NH_ID, nhid).Serialize()...)
+
+	nlmsg := NewNetlinkMessage(nlalib.NewNetlinkMessage(syscall.RTM_NEWROUTE, data), 1, SRC_KNL)
+	route, err := RouteDeserialize(nlmsg)
+	if err != nil {
+		t.Errorf("RouteDeserialize error. %s", err)
+	}
+	if v := route.NhId; v != 30 {
+		t.Errorf("RouteDeserialize unmatch. nhid=%d", v)
+	}
+	if v := route.Dst.String(); v != "10.1.0.0/24" {
+		t.Errorf("RouteDeserialize unmatch. dst=%s", v)
+	}
+}
+
+func TestRouteResolveNexthop(t *testing.T) {
+	nhs := map[uint32]*Nexthop{
+		1: {Id: 1, LinkIndex: 10, Gw: net.ParseIP("10.0.1.1")},
+		2: {Id: 2, LinkIndex: 11, Gw: net.ParseIP("10.0.2.1")},
+		3: {Id: 3, Group: []NexthopGroupEntry{{Id: 1}, {Id: 2, Weight: 1}}},
+		4: {Id: 4, Group: []NexthopGroupEntry{{Id: 1}, {Id: 9}}},
+		5: {Id: 5, Blackhole: true},
+	}
+	f := func(id uint32) *Nexthop {
+		nh, _ := nhs[id]
+		return nh
+	}
+
+	_, dst, _ := net.ParseCIDR("10.1.0.0/24")
+	newRoute := func(nhid uint32) *Route {
+		r := NewRoute(&netlink.Route{Dst: dst}, 0, 0, nil, []uint32{})
+		r.NhId = nhid
+		return r
+	}
+
+	// single nexthop
+	r := newRoute(1)
+	if err := r.ResolveNexthop(f); err != nil {
+		t.Errorf("Route.ResolveNexthop error. %s", err)
+	}
+	if !r.GetGw().Equal(net.ParseIP("10.0.1.1")) || r.GetLinkIndex() != 10 || r.IsMultiPath() {
+		t.Errorf("Route.ResolveNexthop unmatch. %s", r)
+	}
+
+	// group
+	r = newRoute(3)
+	if err := r.ResolveNexthop(f); err != nil {
+		t.Errorf("Route.ResolveNexthop error. %s", err)
+	}
+	if !r.IsMultiPath() || r.Gw != nil {
+		t.Errorf("Route.ResolveNexthop unmatch. %s", r)
+	}
+	if gws := r.GetGws(); len(gws) != 2 || !gws[1].Equal(net.ParseIP("10.0.2.1")) {
+		t.Errorf("Route.ResolveNexthop unmatch. gws=%v", gws)
+	}
+	if v := r.MultiPath[1]; v.LinkIndex != 11 || v.Hops != 1 {
+		t.Errorf("Route.ResolveNexthop unmatch. %s", v)
+	}
+
+	// blackhole
+	r = newRoute(5)
+	if err := r.ResolveNexthop(f); err != nil {
+		t.Errorf("Route.ResolveNexthop error. %s", err)
+	}
+	if r.Type != syscall.RTN_BLACKHOLE || r.GetGw() != nil {
+		t.Errorf("Route.ResolveNexthop unmatch. %s", r)
+	}
+
+	// not found
+	if err := newRoute(8).ResolveNexthop(f); err == nil {
+		t.Errorf("Route.ResolveNexthop must be error.")
+	}
+
+	// group member not found
+	if err := newRoute(4).ResolveNexthop(f); err == nil {
+		t.Errorf("Route.ResolveNexthop must be error.")
+	}
+}
@@ -35,6 +35,19 @@ const (
 	RTM_NEWBRIDGE
 	RTM_DELBRIDGE
 	RTM_SETBRIDGE
+	RTM_NEWMROUTE
+	RTM_DELMROUTE
+	RTM_SETMROUTE
+)
+
+// kernel nexthop objects (linux 5.3 or later)
+const (
+	RTM_NEWNEXTHOP = 0x68
+	RTM_DELNEXTHOP = 0x69
+	RTM_GETNEXTHOP = 0x6a
+
+	RTNLGRP_NEXTHOP = 0x20
+	RTA_NH_ID       = 0x1e
 )
 
 const (
@@ -46,4 +59,13 @@ const (
 	RTMGRP_NEIGH
 	RTMGRP_ROUTE
 	RTMGRP_BRIDGE
+	RTMGRP_NEXTHOP
+	RTMGRP_RULE
+	RTMGRP_MROUTE
+)
+
+// multicast routing (ipmr/ip6mr) rtmsg family.
+const (
+	RTNL_FAMILY_IPMR  = 128
+	RTNL_FAMILY_IP6MR = 129
 )
@@ -42,6 +42,12 @@ func NlMsgGroupFromType(nlmsgType uint16) uint16 {
 		return nlalink.RTMGRP_VPN
 	case nlalink.RTM_NEWBRIDGE, nlalink.RTM_DELBRIDGE, nlalink.RTM_SETBRIDGE:
 		return nlalink.RTMGRP_BRIDGE
+	case nlalink.RTM_NEWNEXTHOP, nlalink.RTM_DELNEXTHOP:
+		return nlalink.RTMGRP_NEXTHOP
+	case syscall.RTM_NEWRULE, syscall.RTM_DELRULE:
+		return nlalink.RTMGRP_RULE
+	case nlalink.RTM_NEWMROUTE, nlalink.RTM_DELMROUTE, nlalink.RTM_SETMROUTE:
+		return nlalink.RTMGRP_MROUTE
 	default:
 		return nlalink.RTMGRP_UNSPEC
 	}
@@ -177,3 +183,24 @@ func (n *NetlinkMessageUnion) GetBridgeVlanInfo() *BridgeVlanInfo {
 	}
 	return nil
 }
+
+func (n *NetlinkMessageUnion) GetNexthop() *Nexthop {
+	if v, ok := n.Msg.(*Nexthop); ok {
+		return v
+	}
+	return nil
+}
+
+func (n *NetlinkMessageUnion) GetRule() *Rule {
+	if v, ok := n.Msg.(*Rule); ok {
+		return v
+	}
+	return nil
+}
+
+func (n *NetlinkMessageUnion) GetMroute() *Mroute {
+	if v, ok := n.Msg.(*Mroute); ok {
+		return v
+	}
+	return nil
+}
@@ -20,7 +20,10 @@ package nlamsg
 import (
 	"fmt"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"gonla/nlamsg/nlalink"
 	"net"
+	"syscall"
 )
 
 //
@@ -28,6 +31,13 @@ import (
 //
 func CopyRoute(src *netlink.Route) *netlink.Route {
 	dst := *src
+	if src.MultiPath != nil {
+		dst.MultiPath = make([]*netlink.NexthopInfo, len(src.MultiPath))
+		for i, nh := range src.MultiPath {
+			n := *nh
+			dst.MultiPath[i] = &n
+		}
+	}
 	return &dst
 }
 
@@ -40,6 +50,7 @@ type Route struct {
 	NId   uint8
 	VpnGw net.IP
 	EnIds []uint32
+	NhId  uint32 // kernel nexthop object (RTA_NH_ID)
 }
 
 func (r *Route) Copy() *Route {
@@ -49,11 +60,12 @@ func (r *Route) Copy() *Route {
 		NId:   r.NId,
 		VpnGw: r.VpnGw,
 		EnIds: r.EnIds,
+		NhId:  r.NhId,
 	}
 }
 
 func (r *Route) String() string {
-	return fmt.Sprintf("%s RtId: %d NId: %d VpnGw: %s EnId: %v", r.Route, r.RtId, r.NId, r.VpnGw, r.EnIds)
+	return fmt.Sprintf("%s RtId: %d NId: %d VpnGw: %s EnId: %v NhId: %d", r.Route, r.RtId, r.NId, r.VpnGw, r.EnIds, r.NhId)
 }
 
 func (r *Route) GetDst() *net.IPNet {
@@ -67,6 +79,39 @@ func (r *Route) MultiPathIndex() int {
 	return len(r.MultiPath) - 1
 }
 
+func (r *Route) IsMultiPath() bool {
+	return len(r.MultiPath) > 1
+}
+
+func (r *Route) GetLinkIndexes() []int {
+	if len(r.MultiPath) == 0 {
+		return []int{r.LinkIndex}
+	}
+
+	ifindexes := make([]int, len(r.MultiPath))
+	for i, nh := range r.MultiPath {
+		ifindexes[i] = nh.LinkIndex
+	}
+	return ifindexes
+}
+
+func (r *Route) GetGws() []net.IP {
+	if len(r.MultiPath) == 0 {
+		if r.Gw == nil {
+			return []net.IP{}
+		}
+		return []net.IP{r.Gw}
+	}
+
+	gws := []net.IP{}
+	for _, nh := range r.MultiPath {
+		if nh.Gw != nil {
+			gws = append(gws, nh.Gw)
+		}
+	}
+	return gws
+}
+
 func (r *Route) GetLinkIndex() int {
 	if i := r.MultiPathIndex(); i >= 0 {
 		return r.MultiPath[i].LinkIndex
@@ -98,6 +143,26 @@ func (r *Route) GetMPLSEncap() *netlink.MPLSEncap {
 	return nil
 }
 
+func (r *Route) GetSEG6Encap() *netlink.SEG6Encap {
+	if en := r.GetEncap(); en != nil {
+		if sen, ok := en.(*netlink.SEG6Encap); ok {
+			return sen
+		}
+	}
+
+	return nil
+}
+
+func (r *Route) GetSEG6LocalEncap() *netlink.SEG6LocalEncap {
+	if en := r.GetEncap(); en != nil {
+		if slen, ok := en.(*netlink.SEG6LocalEncap); ok {
+			return slen
+		}
+	}
+
+	return nil
+}
+
 func (r *Route) GetMPLSNewDst() *netlink.MPLSDestination {
 	d := func() netlink.Destination {
 		if i := r.MultiPathIndex(); i >= 0 {
@@ -121,11 +186,82 @@ func NewRoute(route *netlink.Route, nid uint8, id uint32, vpnGw net.IP, enIds []
 	}
 }
 
+//
+// ResolveNexthop sets gateway and output link (or multipath)
+// of kernel nexthop object(NhId) to route.
+// f returns nexthop object by id. (nil if not found)
+//
+func (r *Route) ResolveNexthop(f func(uint32) *Nexthop) error {
+	nh := f(r.NhId)
+	if nh == nil {
+		return fmt.Errorf("Nexthop not found. id=%d", r.NhId)
+	}
+
+	if nh.Blackhole {
+		r.Type = syscall.RTN_BLACKHOLE
+		r.Gw = nil
+		r.LinkIndex = 0
+		r.MultiPath = nil
+		return nil
+	}
+
+	if !nh.IsGroup() {
+		r.Gw = nh.Gw
+		r.LinkIndex = nh.LinkIndex
+		r.MultiPath = nil
+		return nil
+	}
+
+	mpaths := make([]*netlink.NexthopInfo, len(nh.Group))
+	for i, e := range nh.Group {
+		m := f(e.Id)
+		if m == nil {
+			return fmt.Errorf("Nexthop not found. id=%d group=%d", e.Id, r.NhId)
+		}
+
+		mpaths[i] = &netlink.NexthopInfo{
+			LinkIndex: m.LinkIndex,
+			Gw:        m.Gw,
+			Hops:      int(e.Weight),
+		}
+	}
+
+	r.Gw = nil
+	r.LinkIndex = 0
+	r.MultiPath = mpaths
+	return nil
+}
+
+//
+// parseRouteNhId returns RTA_NH_ID of RTM_{NEW,DEL}ROUTE message data.
+//
+func parseRouteNhId(b []byte) uint32 {
+	if len(b) < syscall.SizeofRtMsg {
+		return 0
+	}
+
+	attrs, err := nl.ParseRouteAttr(b[syscall.SizeofRtMsg:])
+	if err != nil {
+		return 0
+	}
+
+	for _, attr := range attrs {
+		if attr.Attr.Type == nlalink.RTA_NH_ID && len(attr.Value) >= 4 {
+			return nl.NativeEndian().Uint32(attr.Value[0:4])
+		}
+	}
+
+	return 0
+}
+
 func RouteDeserialize(nlmsg *NetlinkMessage) (*Route, error) {
 	route, err := netlink.RouteDeserialize(nlmsg.Data)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewRoute(route, nlmsg.NId, 0, nil, []uint32{}), nil
+	r := NewRoute(route, nlmsg.NId, 0, nil, []uint32{})
+	r.NhId = parseRouteNhId(nlmsg.Data)
+
+	return r, nil
 }
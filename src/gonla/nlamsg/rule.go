@@ -0,0 +1,231 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"bytes"
+	"fmt"
+	"gonla/nlalib"
+	"net"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+//
+// struct fib_rule_hdr (linux/fib_rules.h)
+// it has same layout as struct rtmsg.
+//
+const SizeofFibRuleHdr = syscall.SizeofRtMsg
+
+//
+// netlink.Rule
+//
+func CopyRule(src *netlink.Rule) *netlink.Rule {
+	dst := *src
+	return &dst
+}
+
+//
+// Rule is fib rule (ip rule).
+// Action is FR_ACT_XXX (fib_rule_hdr.action).
+//
+type Rule struct {
+	*netlink.Rule
+	Action uint8
+	NId    uint8
+}
+
+func (r *Rule) Copy() *Rule {
+	return &Rule{
+		Rule:   CopyRule(r.Rule),
+		Action: r.Action,
+		NId:    r.NId,
+	}
+}
+
+//
+// IsToTable returns true if rule passes packets to table.
+//
+func (r *Rule) IsToTable() bool {
+	return r.Action == nl.FR_ACT_TO_TBL
+}
+
+func (r *Rule) String() string {
+	return fmt.Sprintf("{Rule: Prio:%d Family:%d Src:%s Dst:%s Iif:'%s' Oif:'%s' Mark:%d/%d Table:%d Action:%d Invert:%t NId:%d}",
+		r.Priority, r.Family, r.Src, r.Dst, r.IifName, r.OifName, r.Mark, r.Mask, r.Table, r.Action, r.Invert, r.NId)
+}
+
+func NewRule(rule *netlink.Rule, nid uint8) *Rule {
+	if rule == nil {
+		rule = netlink.NewRule()
+	}
+
+	return &Rule{
+		Rule:   rule,
+		Action: nl.FR_ACT_TO_TBL,
+		NId:    nid,
+	}
+}
+
+//
+// ParseRule parses RTM_{NEW,DEL}RULE message data.
+//
+func ParseRule(b []byte) (*Rule, error) {
+	if len(b) < SizeofFibRuleHdr {
+		return nil, fmt.Errorf("Invalid fib_rule_hdr length. %d", len(b))
+	}
+
+	msg := nl.DeserializeRtMsg(b)
+	attrs, err := nl.ParseRouteAttr(b[SizeofFibRuleHdr:])
+	if err != nil {
+		return nil, err
+	}
+
+	rule := netlink.NewRule()
+	rule.Family = int(msg.Family)
+	rule.Table = int(msg.Table)
+	rule.Invert = msg.Flags&netlink.FibRuleInvert != 0
+
+	native := nl.NativeEndian()
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case nl.FRA_TABLE:
+			rule.Table = int(native.Uint32(attr.Value[0:4]))
+		case nl.FRA_SRC:
+			rule.Src = &net.IPNet{
+				IP:   net.IP(attr.Value),
+				Mask: net.CIDRMask(int(msg.Src_len), 8*len(attr.Value)),
+			}
+		case nl.FRA_DST:
+			rule.Dst = &net.IPNet{
+				IP:   net.IP(attr.Value),
+				Mask: net.CIDRMask(int(msg.Dst_len), 8*len(attr.Value)),
+			}
+		case nl.FRA_FWMARK:
+			rule.Mark = int(native.Uint32(attr.Value[0:4]))
+		case nl.FRA_FWMASK:
+			rule.Mask = int(native.Uint32(attr.Value[0:4]))
+		case nl.FRA_IIFNAME:
+			rule.IifName = string(bytes.TrimRight(attr.Value, "\x00"))
+		case nl.FRA_OIFNAME:
+			rule.OifName = string(bytes.TrimRight(attr.Value, "\x00"))
+		case nl.FRA_GOTO:
+			rule.Goto = int(native.Uint32(attr.Value[0:4]))
+		case nl.FRA_PRIORITY:
+			rule.Priority = int(native.Uint32(attr.Value[0:4]))
+		}
+	}
+
+	return &Rule{
+		Rule:   rule,
+		Action: msg.Type,
+	}, nil
+}
+
+func RuleDeserialize(nlmsg *NetlinkMessage) (*Rule, error) {
+	rule, err := ParseRule(nlmsg.Data)
+	if err != nil {
+		return nil, err
+	}
+	rule.NId = nlmsg.NId
+
+	return rule, nil
+}
+
+//
+// Bytes serializes rule to RTM_{NEW,DEL}RULE message data.
+//
+func (r *Rule) Bytes() []byte {
+	native := nl.NativeEndian()
+
+	u32 := func(v int) []byte {
+		b := make([]byte, 4)
+		native.PutUint32(b, uint32(v))
+		return b
+	}
+
+	ipBytes := func(ip net.IP) []byte {
+		if ip4 := ip.To4(); ip4 != nil {
+			return []byte(ip4)
+		}
+		return []byte(ip.To16())
+	}
+
+	msg := nl.NewRtMsg()
+	msg.Family = uint8(r.Family)
+	msg.Type = r.Action
+	msg.Table = syscall.RT_TABLE_UNSPEC
+	if r.Table > 0 && r.Table < 256 {
+		msg.Table = uint8(r.Table)
+	}
+	if r.Invert {
+		msg.Flags |= netlink.FibRuleInvert
+	}
+
+	attrs := [][]byte{}
+	if r.Src != nil {
+		ones, _ := r.Src.Mask.Size()
+		msg.Src_len = uint8(ones)
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_SRC, ipBytes(r.Src.IP)).Serialize())
+	}
+	if r.Dst != nil {
+		ones, _ := r.Dst.Mask.Size()
+		msg.Dst_len = uint8(ones)
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_DST, ipBytes(r.Dst.IP)).Serialize())
+	}
+	if r.Priority >= 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_PRIORITY, u32(r.Priority)).Serialize())
+	}
+	if r.Mark >= 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_FWMARK, u32(r.Mark)).Serialize())
+	}
+	if r.Mask >= 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_FWMASK, u32(r.Mask)).Serialize())
+	}
+	if r.Table > 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_TABLE, u32(r.Table)).Serialize())
+	}
+	if len(r.IifName) > 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_IIFNAME, nl.ZeroTerminated(r.IifName)).Serialize())
+	}
+	if len(r.OifName) > 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_OIFNAME, nl.ZeroTerminated(r.OifName)).Serialize())
+	}
+	if r.Goto >= 0 {
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_GOTO, u32(r.Goto)).Serialize())
+	}
+
+	var buf bytes.Buffer
+	buf.Write(msg.Serialize())
+	for _, attr := range attrs {
+		buf.Write(attr)
+	}
+
+	return buf.Bytes()
+}
+
+//
+// RuleSerialize coverts Rule struct to netlink message.
+// msgType is RTM_NEWRULE or RTM_DELRULE.
+//
+func RuleSerialize(rule *Rule, msgType uint16) *NetlinkMessage {
+	nlmsg := nlalib.NewNetlinkMessage(msgType, rule.Bytes())
+	return NewNetlinkMessage(nlmsg, rule.NId, SRC_NOP)
+}
@@ -0,0 +1,102 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlamsg
+
+import (
+	"gonla/nlalib"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+)
+
+func TestRuleDeserialize(t *testing.T) {
+	_, src, _ := net.ParseCIDR("10.1.0.0/16")
+
+	r := netlink.NewRule()
+	r.Family = syscall.AF_INET
+	r.Priority = 100
+	r.Table = 10
+	r.Src = src
+	r.IifName = "eth1"
+
+	m, err := RuleDeserialize(RuleSerialize(NewRule(r, 3), syscall.RTM_NEWRULE))
+	if err != nil {
+		t.Errorf("RuleDeserialize error. %s", err)
+	}
+	if v := m.Family; v != syscall.AF_INET {
+		t.Errorf("RuleDeserialize unmatch. family=%d", v)
+	}
+	if v := m.Priority; v != 100 {
+		t.Errorf("RuleDeserialize unmatch. prio=%d", v)
+	}
+	if v := m.Table; v != 10 {
+		t.Errorf("RuleDeserialize unmatch. table=%d", v)
+	}
+	if v := m.Src; v == nil || v.String() != "10.1.0.0/16" {
+		t.Errorf("RuleDeserialize unmatch. src=%s", v)
+	}
+	if v := m.Dst; v != nil {
+		t.Errorf("RuleDeserialize unmatch. dst=%s", v)
+	}
+	if v := m.IifName; v != "eth1" {
+		t.Errorf("RuleDeserialize unmatch. iif=%s", v)
+	}
+	if v := m.Mark; v != -1 {
+		t.Errorf("RuleDeserialize unmatch. mark=%d", v)
+	}
+	if v := m.NId; v != 3 {
+		t.Errorf("RuleDeserialize unmatch. nid=%d", v)
+	}
+	if !m.IsToTable() || m.Invert {
+		t.Errorf("RuleDeserialize unmatch. %s", m)
+	}
+}
+
+func TestRuleDeserializeExtTable(t *testing.T) {
+	r := NewRule(nil, 0)
+	r.Family = syscall.AF_INET6
+	r.Table = 1000
+	r.Mark = 0x10
+	r.Mask = 0xff
+	r.Invert = true
+	r.Action = nl.FR_ACT_PROHIBIT
+
+	m, err := RuleDeserialize(RuleSerialize(r, syscall.RTM_NEWRULE))
+	if err != nil {
+		t.Errorf("RuleDeserialize error. %s", err)
+	}
+	if v := m.Table; v != 1000 {
+		t.Errorf("RuleDeserialize unmatch. table=%d", v)
+	}
+	if m.Mark != 0x10 || m.Mask != 0xff {
+		t.Errorf("RuleDeserialize unmatch. mark=%d/%d", m.Mark, m.Mask)
+	}
+	if m.IsToTable() || !m.Invert {
+		t.Errorf("RuleDeserialize unmatch. %s", m)
+	}
+}
+
+func TestRuleDeserializeError(t *testing.T) {
+	nlmsg := NewNetlinkMessage(nlalib.NewNetlinkMessage(syscall.RTM_NEWRULE, []byte{2, 0, 0}), 0, SRC_KNL)
+	if _, err := RuleDeserialize(nlmsg); err == nil {
+		t.Errorf("RuleDeserialize must be error.")
+	}
+}
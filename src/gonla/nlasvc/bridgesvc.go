@@ -233,7 +233,8 @@ func (n *NLABridgeVlanService) NetlinkNeigh(nlmsg *nlamsg.NetlinkMessage, neigh
 		return
 	}
 
-	if nlalib.IsInvalidHardwareAddr(neigh.HardwareAddr) {
+	// BUM entry (00:00:00:00:00:00) of remote VTEP is valid.
+	if nlalib.IsInvalidHardwareAddr(neigh.HardwareAddr) && !neigh.IsVtepEntry() {
 		n.log.Debugf("NEIG skip. mac '%s'", neigh.HardwareAddr)
 		return
 	}
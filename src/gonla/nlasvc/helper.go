@@ -20,6 +20,7 @@ package nlasvc
 import (
 	"gonla/nlalib"
 	"gonla/nlamsg"
+	"gonla/nlamsg/nlalink"
 	"net"
 	"syscall"
 
@@ -86,12 +87,46 @@ func GetNetlinkMessageRoutes(nid uint8) ([]*nlamsg.NetlinkMessage, error) {
 	return DatasToNetlnkMessages(nid, syscall.RTM_NEWROUTE, datas), nil
 }
 
+func GetNetlinkMessageNexthops(nid uint8) ([]*nlamsg.NetlinkMessage, error) {
+	datas, err := nlalib.GetNetlinkNexthops()
+	if err != nil {
+		// kernel does not support nexthop objects.
+		log.Warnf("GetNetlinkMessageNexthops: not supported. %s", err)
+		return []*nlamsg.NetlinkMessage{}, nil
+	}
+
+	return DatasToNetlnkMessages(nid, nlalink.RTM_NEWNEXTHOP, datas), nil
+}
+
+func GetNetlinkMessageRules(nid uint8) ([]*nlamsg.NetlinkMessage, error) {
+	datas, err := nlalib.GetNetlinkRules()
+	if err != nil {
+		return nil, err
+	}
+
+	return DatasToNetlnkMessages(nid, syscall.RTM_NEWRULE, datas), nil
+}
+
+func GetNetlinkMessageMroutes(nid uint8) ([]*nlamsg.NetlinkMessage, error) {
+	datas, err := nlalib.GetNetlinkMroutes()
+	if err != nil {
+		// kernel does not support multicast routing.
+		log.Warnf("GetNetlinkMessageMroutes: not supported. %s", err)
+		return []*nlamsg.NetlinkMessage{}, nil
+	}
+
+	return DatasToNetlnkMessages(nid, nlalink.RTM_NEWMROUTE, datas), nil
+}
+
 func SubscribeNetlinkResources(ch chan<- *nlamsg.NetlinkMessage, nid uint8) error {
 	funcs := []func(uint8) ([]*nlamsg.NetlinkMessage, error){
 		GetNetlinkMessageLinks,
 		GetNetlinkMessageAddrs,
 		GetNetlinkMessageNeighs,
+		GetNetlinkMessageNexthops, // must be before routes.
 		GetNetlinkMessageRoutes,
+		GetNetlinkMessageRules,
+		GetNetlinkMessageMroutes,
 	}
 	for _, f := range funcs {
 		msgs, err := f(nid)
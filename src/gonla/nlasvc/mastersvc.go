@@ -18,6 +18,7 @@
 package nlasvc
 
 import (
+	"bytes"
 	"gonla/nlactl"
 	"gonla/nladbm"
 	"gonla/nlalib"
@@ -169,6 +170,17 @@ func (n *NLAMasterService) NetlinkNeigh(nlmsg *nlamsg.NetlinkMessage, neigh *nla
 	switch nlmsg.Type() {
 	case syscall.RTM_NEWNEIGH:
 		if delneigh := nladbm.Neighs().Insert(neigh); delneigh != nil {
+			if isNeighReplaced(delneigh, neigh) {
+				// replace neigh in place not to make traffic hole by delete and add.
+				nladbm.Neighs().Update(neigh)
+
+				n.log.Debugf("NEIGH RTM_SETNEIGH %v", neigh)
+				setNlMsg := *nlmsg
+				setNlMsg.Header.Type = nlalink.RTM_SETNEIGH
+				nlamsg.DispatchNeigh(&setNlMsg, neigh, n.Service)
+				return
+			}
+
 			n.log.Warnf("NEIGH duplicate. %v", neigh)
 			return
 		}
@@ -219,6 +231,7 @@ func (n *NLAMasterService) NetlinkIPRouteOnMIC(nlmsg *nlamsg.NetlinkMessage, rou
 
 	switch nlmsg.Type() {
 	case syscall.RTM_NEWROUTE:
+		routeNlMsg := *nlmsg
 		if old := nladbm.Routes().Insert(route); old != nil {
 			// if same route already exists, create DELROUTE message.
 			delNlMsg := *nlmsg
@@ -231,8 +244,13 @@ func (n *NLAMasterService) NetlinkIPRouteOnMIC(nlmsg *nlamsg.NetlinkMessage, rou
 				return nil
 			})
 
-			n.log.Debugf("ROUTE(IP/MIC) RTM_DELROUTE(OLD) %v", old)
-			nlamsg.DispatchRoute(&delNlMsg, old, n.Service)
+			if isRouteReplaced(old, route) {
+				// replace route in place not to make traffic hole by delete and add.
+				routeNlMsg.Header.Type = nlalink.RTM_SETROUTE
+			} else {
+				n.log.Debugf("ROUTE(IP/MIC) RTM_DELROUTE(OLD) %v", old)
+				nlamsg.DispatchRoute(&delNlMsg, old, n.Service)
+			}
 
 			// if dst is tunnel-remote, generate DELNEIGH
 			n.iptun.RemoteRouteDown(old)
@@ -244,8 +262,8 @@ func (n *NLAMasterService) NetlinkIPRouteOnMIC(nlmsg *nlamsg.NetlinkMessage, rou
 			}
 		}
 
-		n.log.Debugf("ROUTE(IP/MIC) RTM_NEWROUTE %v", route)
-		nlamsg.DispatchRoute(nlmsg, route, n.Service)
+		n.log.Debugf("ROUTE(IP/MIC) %s %v", nlamsg.NlMsgTypeStr(routeNlMsg.Type()), route)
+		nlamsg.DispatchRoute(&routeNlMsg, route, n.Service)
 
 		// if nexthop is used by vpns, create the vpns NEWROUTE messages.
 		n.NewVpnRoutes(route, func(vpnRoute *nlamsg.Route) error {
@@ -325,6 +343,46 @@ func (n *NLAMasterService) NetlinkIPRouteOnRIC(nlmsg *nlamsg.NetlinkMessage, rou
 	nlamsg.DispatchRoute(nlmsg, route, n.Service)
 }
 
+//
+// NetlinkIPRouteOnTable processes ip route of non-main table.
+// It is installed in VRF mapped to the table by ribcd (policy routing).
+//
+func (n *NLAMasterService) NetlinkIPRouteOnTable(nlmsg *nlamsg.NetlinkMessage, route *nlamsg.Route) {
+	n.log.Debugf("ROUTE(IP/TABLE) nid=%d table=%d", route.NId, route.Table)
+
+	if route.Dst == nil || route.GetEncap() != nil {
+		n.log.Debugf("ROUTE(IP/TABLE) not supported. %v", route)
+		return
+	}
+
+	routeNlMsg := *nlmsg
+
+	switch nlmsg.Type() {
+	case syscall.RTM_NEWROUTE:
+		if old := nladbm.Routes().Insert(route); old != nil {
+			if isRouteReplaced(old, route) {
+				routeNlMsg.Header.Type = nlalink.RTM_SETROUTE
+			} else {
+				delNlMsg := *nlmsg
+				delNlMsg.Header.Type = syscall.RTM_DELROUTE
+
+				n.log.Debugf("ROUTE(IP/TABLE) RTM_DELROUTE(OLD) %v", old)
+				nlamsg.DispatchRoute(&delNlMsg, old, n.Service)
+			}
+		}
+
+	case syscall.RTM_DELROUTE:
+		nladbm.Routes().Delete(nladbm.RouteToKey(route))
+
+	default:
+		n.log.Errorf("ROUTE(IP/TABLE) Invalid message. %v", nlmsg)
+		return
+	}
+
+	n.log.Debugf("ROUTE(IP/TABLE) %s %v", nlamsg.NlMsgTypeStr(routeNlMsg.Type()), route)
+	nlamsg.DispatchRoute(&routeNlMsg, route, n.Service)
+}
+
 func (n *NLAMasterService) NetlinkMplsRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg.Route) {
 	n.log.Debugf("ROUTE(MPLS)")
 
@@ -347,15 +405,17 @@ func (n *NLAMasterService) NetlinkMplsRoute(nlmsg *nlamsg.NetlinkMessage, route
 	nlamsg.DispatchRoute(nlmsg, route, n.Service)
 }
 
-func (n *NLAMasterService) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg.Route) {
-	if nlmsg.Src != nlamsg.SRC_KNL {
-		n.log.Debugf("ROUTE skip. %s", nlmsg)
-		return
-	}
-
-	if route.Table != 254 {
-		n.log.Debugf("ROUTE(bad table) %v", route)
-		return
+//
+// normalizeRoute resolves kernel nexthop object and
+// sets default dst to route received from kernel.
+//
+func (n *NLAMasterService) normalizeRoute(route *nlamsg.Route) {
+	if route.NhId != 0 {
+		// route refers kernel nexthop object.
+		// if it is not found (e.g. DELROUTE after DELNEXTHOP), use route as it is.
+		if err := route.ResolveNexthop(n.selectNexthop(route.NId)); err != nil {
+			n.log.Warnf("ROUTE resolve nexthop error. %s", err)
+		}
 	}
 
 	if route.Dst == nil && route.MPLSDst == nil {
@@ -369,6 +429,26 @@ func (n *NLAMasterService) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nla
 			}
 		}
 	}
+}
+
+func (n *NLAMasterService) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg.Route) {
+	if nlmsg.Src != nlamsg.SRC_KNL {
+		n.log.Debugf("ROUTE skip. %s", nlmsg)
+		return
+	}
+
+	if route.Table != 254 {
+		if !nladbm.IsVrfRouteTable(route.Table) {
+			n.log.Debugf("ROUTE(bad table) %v", route)
+			return
+		}
+
+		n.normalizeRoute(route)
+		n.NetlinkIPRouteOnTable(nlmsg, route)
+		return
+	}
+
+	n.normalizeRoute(route)
 
 	switch {
 	case route.Dst != nil:
@@ -393,6 +473,22 @@ func (n *NLAMasterService) NetlinkNode(nlmsg *nlamsg.NetlinkMessage, node *nlams
 
 		nid := nlmsg.NId
 
+		nlmsg.Header.Type = nlalink.RTM_DELMROUTE
+		nladbm.Mroutes().WalkFree(func(mroute *nlamsg.Mroute) error {
+			if mroute.NId == nid {
+				n.NetlinkMroute(nlmsg, mroute)
+			}
+			return nil
+		})
+
+		nlmsg.Header.Type = syscall.RTM_DELRULE
+		nladbm.Rules().WalkFree(func(rule *nlamsg.Rule) error {
+			if rule.NId == nid {
+				n.NetlinkRule(nlmsg, rule)
+			}
+			return nil
+		})
+
 		nlmsg.Header.Type = syscall.RTM_DELROUTE
 		nladbm.Routes().WalkFree(func(route *nlamsg.Route) error {
 			if route.NId == nid {
@@ -401,6 +497,14 @@ func (n *NLAMasterService) NetlinkNode(nlmsg *nlamsg.NetlinkMessage, node *nlams
 			return nil
 		})
 
+		nlmsg.Header.Type = nlalink.RTM_DELNEXTHOP
+		nladbm.Nexthops().WalkFree(func(nh *nlamsg.Nexthop) error {
+			if nh.NId == nid {
+				n.NetlinkNexthop(nlmsg, nh)
+			}
+			return nil
+		})
+
 		nlmsg.Header.Type = syscall.RTM_DELNEIGH
 		nladbm.Neighs().WalkFree(func(neigh *nlamsg.Neigh) error {
 			if neigh.NId == nid {
@@ -481,3 +585,178 @@ func (n *NLAMasterService) NetlinkBridgeVlanInfo(nlmsg *nlamsg.NetlinkMessage, b
 	nlamsg.DispatchBridgeVlanInfo(nlmsg, brvlan, n.Service)
 
 }
+
+func (n *NLAMasterService) selectNexthop(nid uint8) func(uint32) *nlamsg.Nexthop {
+	return func(id uint32) *nlamsg.Nexthop {
+		return nladbm.Nexthops().Select(nladbm.NewNexthopKey(nid, id))
+	}
+}
+
+//
+// nexthopRoutes returns routes which refer nexthop(id) directly,
+// and if withGroup is true, routes which refer nexthop group including nexthop(id).
+//
+func (n *NLAMasterService) nexthopRoutes(nid uint8, id uint32, withGroup bool) []*nlamsg.Route {
+	ids := map[uint32]struct{}{id: {}}
+	if withGroup {
+		nladbm.Nexthops().WalkByMember(nid, id, func(nh *nlamsg.Nexthop) error {
+			ids[nh.Id] = struct{}{}
+			return nil
+		})
+	}
+
+	routes := []*nlamsg.Route{}
+	nladbm.Routes().Walk(func(route *nlamsg.Route) error {
+		if _, ok := ids[route.NhId]; ok && route.NId == nid {
+			routes = append(routes, route.Copy())
+		}
+		return nil
+	})
+
+	return routes
+}
+
+func (n *NLAMasterService) NetlinkNexthop(nlmsg *nlamsg.NetlinkMessage, nh *nlamsg.Nexthop) {
+	if nlmsg.Src != nlamsg.SRC_KNL {
+		n.log.Debugf("NEXTHOP skip. %s", nlmsg)
+		return
+	}
+
+	n.log.Debugf("NEXTHOP")
+
+	switch nlmsg.Type() {
+	case nlalink.RTM_NEWNEXTHOP:
+		old := nladbm.Nexthops().Insert(nh)
+
+		n.log.Debugf("NEXTHOP %s %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), nh)
+		nlamsg.DispatchNexthop(nlmsg, nh, n.Service)
+
+		if old != nil {
+			// nexthop is replaced. resolve routes refer it again.
+			newRtNlMsg := *nlmsg
+			newRtNlMsg.Header.Type = syscall.RTM_NEWROUTE
+			for _, route := range n.nexthopRoutes(nh.NId, nh.Id, true) {
+				n.NetlinkRoute(&newRtNlMsg, route)
+			}
+		}
+
+	case nlalink.RTM_DELNEXTHOP:
+		// kernel deletes routes refer nexthop without notification.
+		// routes refer nexthop group are updated by NEWNEXTHOP of group.
+		delRtNlMsg := *nlmsg
+		delRtNlMsg.Header.Type = syscall.RTM_DELROUTE
+		for _, route := range n.nexthopRoutes(nh.NId, nh.Id, false) {
+			n.NetlinkRoute(&delRtNlMsg, route)
+		}
+
+		if old := nladbm.Nexthops().Delete(nladbm.NexthopToKey(nh)); old != nil {
+			nh = old
+		}
+
+		n.log.Debugf("NEXTHOP %s %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), nh)
+		nlamsg.DispatchNexthop(nlmsg, nh, n.Service)
+
+	default:
+		n.log.Errorf("NEXTHOP Invalid message. %v", nlmsg)
+	}
+}
+
+func (n *NLAMasterService) NetlinkRule(nlmsg *nlamsg.NetlinkMessage, rule *nlamsg.Rule) {
+	if nlmsg.Src != nlamsg.SRC_KNL {
+		n.log.Debugf("RULE skip. %s", nlmsg)
+		return
+	}
+
+	n.log.Debugf("RULE")
+
+	switch nlmsg.Type() {
+	case syscall.RTM_NEWRULE:
+		if old := nladbm.Rules().Insert(rule); old != nil {
+			n.log.Debugf("RULE %s already exists. %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), old)
+			return
+		}
+
+	case syscall.RTM_DELRULE:
+		old := nladbm.Rules().Delete(nladbm.RuleToKey(rule))
+		if old == nil {
+			n.log.Debugf("RULE %s not found. %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), rule)
+			return
+		}
+		rule = old
+
+	default:
+		n.log.Errorf("RULE Invalid message. %v", nlmsg)
+		return
+	}
+
+	n.log.Debugf("RULE %s %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), rule)
+	nlamsg.DispatchRule(nlmsg, rule, n.Service)
+}
+
+func (n *NLAMasterService) NetlinkMroute(nlmsg *nlamsg.NetlinkMessage, mroute *nlamsg.Mroute) {
+	if nlmsg.Src != nlamsg.SRC_KNL {
+		n.log.Debugf("MROUTE skip. %s", nlmsg)
+		return
+	}
+
+	n.log.Debugf("MROUTE")
+
+	switch nlmsg.Type() {
+	case nlalink.RTM_NEWMROUTE:
+		if old := nladbm.Mroutes().Insert(mroute); old != nil {
+			if old.SameForwarding(mroute) {
+				n.log.Debugf("MROUTE not changed. %v", mroute)
+				return
+			}
+
+			if old.Iif == mroute.Iif {
+				nlmsg.Header.Type = nlalink.RTM_SETMROUTE
+			} else {
+				// iif is a part of the key of multicast routing flow.
+				// old entry is deleted before new entry is added.
+				nlmsg.Header.Type = nlalink.RTM_DELMROUTE
+				nlamsg.DispatchMroute(nlmsg, old, n.Service)
+				nlmsg.Header.Type = nlalink.RTM_NEWMROUTE
+			}
+		}
+
+	case nlalink.RTM_DELMROUTE:
+		old := nladbm.Mroutes().Delete(nladbm.MrouteToKey(mroute))
+		if old == nil {
+			n.log.Debugf("MROUTE %s not found. %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), mroute)
+			return
+		}
+		mroute = old
+
+	default:
+		n.log.Errorf("MROUTE Invalid message. %v", nlmsg)
+		return
+	}
+
+	n.log.Debugf("MROUTE %s %v", nlamsg.NlMsgTypeStr(nlmsg.Type()), mroute)
+	nlamsg.DispatchMroute(nlmsg, mroute, n.Service)
+}
+
+//
+// isNeighReplaced returns true if neigh is replaced by new one
+// which has same key but different hwaddr or link.
+//
+func isNeighReplaced(old, neigh *nlamsg.Neigh) bool {
+	return !bytes.Equal(old.HardwareAddr, neigh.HardwareAddr) || old.LinkIndex != neigh.LinkIndex
+}
+
+//
+// isRouteReplaced returns true if route can be replaced by new one in place.
+// Only ip routes via gateway(s) without encap are replaced,
+// because other routes are installed by groups or flows of different type.
+//
+func isRouteReplaced(old, route *nlamsg.Route) bool {
+	isIPRouteViaGw := func(r *nlamsg.Route) bool {
+		if r.GetDst() == nil || r.GetEncap() != nil || r.MPLSDst != nil {
+			return false
+		}
+		return r.IsMultiPath() || r.GetGw() != nil
+	}
+
+	return isIPRouteViaGw(old) && isIPRouteViaGw(route) && old.IsMultiPath() == route.IsMultiPath()
+}
@@ -44,8 +44,8 @@ func (n *NLAMasterService) NewVpnRoute(ricRoute *nlamsg.Route) *nlamsg.Route {
 	labels := []int{}
 	enIds := []uint32{}
 	if encap := gwRoute.GetMPLSEncap(); encap != nil {
-		labels = encap.Labels
-		enIds = gwRoute.EnIds
+		labels = append(labels, encap.Labels...)
+		enIds = append(enIds, gwRoute.EnIds...)
 	}
 	labels = append(labels, int(vpn.Label))
 	enIds = append(enIds, nladbm.Encaps().EncapId(gwDst, vpn.Label))
@@ -59,7 +59,7 @@ func (n *NLAMasterService) NewVpnRoute(ricRoute *nlamsg.Route) *nlamsg.Route {
 	vpnRoute.LinkIndex = 0
 	vpnRoute.Encap = &netlink.MPLSEncap{Labels: labels}
 	vpnRoute.EnIds = enIds
-	vpnRoute.MultiPath = []*netlink.NexthopInfo{}
+	NewVpnMultiPath(vpnRoute, vpn.Label)
 
 	n.log.Debugf("NewVpnRoute %v", vpnRoute)
 	return vpnRoute
@@ -79,8 +79,8 @@ func (n *NLAMasterService) NewVpnRoutes(gwRoute *nlamsg.Route, f func(*nlamsg.Ro
 	err := nladbm.Vpns().WalkByVpnGw(gwRoute.Dst.IP, func(vpn *nlamsg.Vpn) error {
 		// Create new VPN Route instance.
 		// *** Do not change original Route ***
-		vpnLabels := append(labels, int(vpn.Label))
-		vpnEnIds := append(enIds, nladbm.Encaps().EncapId(gwRoute.GetDst(), vpn.Label))
+		vpnLabels := append(append([]int{}, labels...), int(vpn.Label))
+		vpnEnIds := append(append([]uint32{}, enIds...), nladbm.Encaps().EncapId(gwRoute.GetDst(), vpn.Label))
 
 		vpnRoute := gwRoute.Copy()
 		vpnRoute.Dst = vpn.GetIPNet()
@@ -89,7 +89,7 @@ func (n *NLAMasterService) NewVpnRoutes(gwRoute *nlamsg.Route, f func(*nlamsg.Ro
 		vpnRoute.LinkIndex = 0
 		vpnRoute.Encap = &netlink.MPLSEncap{Labels: vpnLabels}
 		vpnRoute.EnIds = vpnEnIds
-		vpnRoute.MultiPath = []*netlink.NexthopInfo{}
+		NewVpnMultiPath(vpnRoute, vpn.Label)
 
 		n.log.Debugf("NewVpnRoutes %v", vpnRoute)
 		return f(vpnRoute)
@@ -99,3 +99,17 @@ func (n *NLAMasterService) NewVpnRoutes(gwRoute *nlamsg.Route, f func(*nlamsg.Ro
 		n.log.Errorf("NewVpnRoutes Walk error. %s", err)
 	}
 }
+
+//
+// NewVpnMultiPath pushes VPN label to labels of each nexthop
+// of VPN route copied from multipath route to VPN gateway.
+//
+func NewVpnMultiPath(vpnRoute *nlamsg.Route, label uint32) {
+	for _, nh := range vpnRoute.MultiPath {
+		labels := []int{}
+		if mpls, ok := nh.Encap.(*netlink.MPLSEncap); ok {
+			labels = append(labels, mpls.Labels...)
+		}
+		nh.Encap = &netlink.MPLSEncap{Labels: append(labels, int(label))}
+	}
+}
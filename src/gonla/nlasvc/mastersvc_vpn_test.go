@@ -0,0 +1,63 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlasvc
+
+import (
+	"gonla/nlamsg"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+)
+
+func TestNewVpnMultiPath(t *testing.T) {
+	gwRoute := nlamsg.NewRoute(&netlink.Route{
+		MultiPath: []*netlink.NexthopInfo{
+			{Gw: net.ParseIP("10.0.1.1"), Encap: &netlink.MPLSEncap{Labels: []int{100}}},
+			{Gw: net.ParseIP("10.0.2.1")},
+		},
+	}, 0, 0, nil, nil)
+
+	vpnRoute := gwRoute.Copy()
+	NewVpnMultiPath(vpnRoute, 2000)
+
+	if n := len(vpnRoute.MultiPath); n != 2 {
+		t.Fatalf("NewVpnMultiPath unmatch. multipath=%d", n)
+	}
+
+	for i, labels := range [][]int{{100, 2000}, {2000}} {
+		mpls, ok := vpnRoute.MultiPath[i].Encap.(*netlink.MPLSEncap)
+		if !ok {
+			t.Fatalf("NewVpnMultiPath unmatch. encap[%d]=%v", i, vpnRoute.MultiPath[i].Encap)
+		}
+		if !reflect.DeepEqual(mpls.Labels, labels) {
+			t.Errorf("NewVpnMultiPath unmatch. labels[%d]=%v", i, mpls.Labels)
+		}
+	}
+
+	if encap := gwRoute.MultiPath[0].Encap.(*netlink.MPLSEncap); len(encap.Labels) != 1 {
+		t.Errorf("NewVpnMultiPath must not change gw route. %v", encap.Labels)
+	}
+	if gwRoute.MultiPath[1].Encap != nil {
+		t.Errorf("NewVpnMultiPath must not change gw route. %v", gwRoute.MultiPath[1].Encap)
+	}
+	if gw := vpnRoute.GetGw(); !gw.Equal(net.ParseIP("10.0.2.1")) {
+		t.Errorf("NewVpnMultiPath unmatch. gw=%s", gw)
+	}
+}
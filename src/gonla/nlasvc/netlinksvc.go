@@ -103,6 +103,12 @@ func (s *NLANetlinkService) NetlinkNeigh(nlmsg *nlamsg.NetlinkMessage, neigh *nl
 		return
 	}
 
+	if neigh.IsVtepEntry() {
+		// FDB entry of remote VTEP is managed by routing daemon.
+		s.log.Debugf("NEIGH: skip. vtep entry %s", neigh)
+		return
+	}
+
 	if neigh.IsFdbEntry() {
 		// FDB entry.
 		fdb := nlalib.NewFDBEntry(
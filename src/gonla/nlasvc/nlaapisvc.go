@@ -102,3 +102,18 @@ func (n *NLAApiService) NetlinkBridgeVlanInfo(nlmsg *nlamsg.NetlinkMessage, brvl
 	// n.log.Debugf("BRVLAN")
 	n.sendToClients(nlmsg, brvlan)
 }
+
+func (n *NLAApiService) NetlinkNexthop(nlmsg *nlamsg.NetlinkMessage, nh *nlamsg.Nexthop) {
+	// n.log.Debugf("NEXTHOP")
+	n.sendToClients(nlmsg, nh)
+}
+
+func (n *NLAApiService) NetlinkRule(nlmsg *nlamsg.NetlinkMessage, rule *nlamsg.Rule) {
+	// n.log.Debugf("RULE")
+	n.sendToClients(nlmsg, rule)
+}
+
+func (n *NLAApiService) NetlinkMroute(nlmsg *nlamsg.NetlinkMessage, mroute *nlamsg.Mroute) {
+	// n.log.Debugf("MROUTE")
+	n.sendToClients(nlmsg, mroute)
+}
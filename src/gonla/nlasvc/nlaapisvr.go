@@ -212,6 +212,30 @@ func (n *NLAApiServer) GetBridgeVlanInfo(ctxt context.Context, req *nlaapi.Bridg
 	return nil, fmt.Errorf("Bridge vlan info not found. %s", req)
 }
 
+func (n *NLAApiServer) GetNexthop(ctxt context.Context, req *nlaapi.NexthopKey) (*nlaapi.Nexthop, error) {
+	if nh := nladbm.Nexthops().Select(req.ToNative()); nh != nil {
+		return nlaapi.NewNexthopFromNative(nh), nil
+	}
+
+	return nil, fmt.Errorf("Nexthop not found. %v", req)
+}
+
+func (n *NLAApiServer) GetRule(ctxt context.Context, req *nlaapi.RuleKey) (*nlaapi.Rule, error) {
+	if rule := nladbm.Rules().Select(req.ToNative()); rule != nil {
+		return nlaapi.NewRuleFromNative(rule), nil
+	}
+
+	return nil, fmt.Errorf("Rule not found. %v", req)
+}
+
+func (n *NLAApiServer) GetMroute(ctxt context.Context, req *nlaapi.MrouteKey) (*nlaapi.Mroute, error) {
+	if mroute := nladbm.Mroutes().Select(req.ToNative()); mroute != nil {
+		return nlaapi.NewMrouteFromNative(mroute), nil
+	}
+
+	return nil, fmt.Errorf("Mroute not found. %v", req)
+}
+
 func (n *NLAApiServer) GetLinks(req *nlaapi.GetLinksRequest, stream nlaapi.NLAApi_GetLinksServer) error {
 	nid := uint8(req.NId)
 
@@ -357,3 +381,39 @@ func (n *NLAApiServer) GetBridgeVlanInfos(req *nlaapi.GetBridgeVlanInfosRequest,
 	})
 	return nil
 }
+
+func (n *NLAApiServer) GetNexthops(req *nlaapi.GetNexthopsRequest, stream nlaapi.NLAApi_GetNexthopsServer) error {
+	nid := uint8(req.NId)
+	nladbm.Nexthops().Walk(func(nh *nlamsg.Nexthop) error {
+		if nid == nh.NId || nid == nlamsg.NODE_ID_ALL {
+			m := nlaapi.NewNexthopFromNative(nh)
+			return stream.Send(m)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (n *NLAApiServer) GetRules(req *nlaapi.GetRulesRequest, stream nlaapi.NLAApi_GetRulesServer) error {
+	nid := uint8(req.NId)
+	nladbm.Rules().Walk(func(rule *nlamsg.Rule) error {
+		if nid == rule.NId || nid == nlamsg.NODE_ID_ALL {
+			m := nlaapi.NewRuleFromNative(rule)
+			return stream.Send(m)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (n *NLAApiServer) GetMroutes(req *nlaapi.GetMroutesRequest, stream nlaapi.NLAApi_GetMroutesServer) error {
+	nid := uint8(req.NId)
+	nladbm.Mroutes().Walk(func(mroute *nlamsg.Mroute) error {
+		if nid == mroute.NId || nid == nlamsg.NODE_ID_ALL {
+			m := nlaapi.NewMrouteFromNative(mroute)
+			return stream.Send(m)
+		}
+		return nil
+	})
+	return nil
+}
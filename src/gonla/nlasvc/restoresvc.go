@@ -0,0 +1,510 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlasvc
+
+import (
+	"fmt"
+	"gonla/nlaapi"
+	"gonla/nlactl"
+	"gonla/nladbm"
+	"gonla/nlalib"
+	"gonla/nlamsg"
+	"gonla/nlamsg/nlalink"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+//
+// restored entry kinds in order of deletion.
+//
+const (
+	restoreRule = iota
+	restoreMroute
+	restoreRoute
+	restoreMpls
+	restoreNexthop
+	restoreNeigh
+	restoreAddr
+	restoreBrVlan
+	restoreLink
+	restoreKindMax
+)
+
+//
+// NLARestoreEntry is an entry restored from snapshot
+// and not reconciled with netlink yet.
+// msg is compared with netlink message (ids are cleared).
+// del is used to delete entry if it is stale or replaced.
+// exists returns false if entry is already deleted from nladbm
+// (e.g. by DELNODE or DELNEIGH of nexthop.)
+//
+type NLARestoreEntry struct {
+	msg     proto.Message
+	del     *nlamsg.NetlinkMessageUnion
+	replace bool
+	exists  func() bool
+}
+
+func NewNLARestoreEntry(msg proto.Message, msgType uint16, m interface{}, nid uint8, replace bool) *NLARestoreEntry {
+	return &NLARestoreEntry{
+		msg:     msg,
+		del:     nlamsg.NewNetlinkMessageUnion(&syscall.NlMsghdr{Type: msgType}, m, nid, nlamsg.SRC_KNL),
+		replace: replace,
+	}
+}
+
+//
+// NLARestoreService wraps master service.
+// It restores nladbm tables from snapshot file on start and saves them periodically.
+// Restored entries are reconciled with netlink messages (dump from kernel and slaves),
+// messages same as restored entries are not sent to clients,
+// and entries not received in staleTime are deleted.
+// Snapshot older than maxAge is not restored.
+//
+type NLARestoreService struct {
+	*NLAMasterService
+	Path      string
+	Interval  time.Duration
+	StaleTime time.Duration
+	MaxAge    time.Duration
+
+	mutex     sync.Mutex
+	saveMutex sync.Mutex
+	entries   [restoreKindMax]map[string]*NLARestoreEntry
+	chans     *nlactl.NLAChannels
+	done      chan struct{}
+	log       *log.Entry
+}
+
+func NewNLARestoreService(master *NLAMasterService, path string, interval, staleTime, maxAge time.Duration) *NLARestoreService {
+	s := &NLARestoreService{
+		NLAMasterService: master,
+		Path:             path,
+		Interval:         interval,
+		StaleTime:        staleTime,
+		MaxAge:           maxAge,
+		done:             make(chan struct{}),
+		log:              NewLogger("NLARestoreService"),
+	}
+	for kind := range s.entries {
+		s.entries[kind] = map[string]*NLARestoreEntry{}
+	}
+	return s
+}
+
+func (n *NLARestoreService) Start(nid uint8, chans *nlactl.NLAChannels) error {
+	n.chans = chans
+
+	if err := n.Restore(); err != nil {
+		if os.IsNotExist(err) {
+			n.log.Infof("Start: snapshot not found. %s", n.Path)
+		} else {
+			n.log.Warnf("Start: restore error. %s", err)
+		}
+	}
+
+	if err := n.NLAMasterService.Start(nid, chans); err != nil {
+		return err
+	}
+
+	go n.Serve()
+
+	n.log.Infof("START")
+	return nil
+}
+
+func (n *NLARestoreService) Stop() {
+	close(n.done)
+
+	if err := n.Save(); err != nil {
+		n.log.Errorf("Stop: save error. %s", err)
+	}
+
+	n.NLAMasterService.Stop()
+	n.log.Infof("STOP")
+}
+
+func (n *NLARestoreService) Serve() {
+	staleTimer := time.NewTimer(n.StaleTime)
+	defer staleTimer.Stop()
+
+	ticker := time.NewTicker(n.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-staleTimer.C:
+			n.Sweep()
+
+		case <-ticker.C:
+			if err := n.Save(); err != nil {
+				n.log.Errorf("Serve: save error. %s", err)
+			}
+
+		case <-n.done:
+			n.log.Infof("Serve: EXIT")
+			return
+		}
+	}
+}
+
+//
+// Save writes snapshot of nladbm tables to file.
+// it is serialized because it is called by Serve and Stop.
+//
+func (n *NLARestoreService) Save() error {
+	n.saveMutex.Lock()
+	defer n.saveMutex.Unlock()
+
+	if err := nlaapi.WriteSnapshot(n.Path, nlaapi.NewSnapshotFromTables()); err != nil {
+		return err
+	}
+
+	n.log.Debugf("Save: %s", n.Path)
+	return nil
+}
+
+//
+// Restore reads snapshot from file, restores nladbm tables
+// and registers restored entries to be reconciled.
+// it returns error if snapshot is older than MaxAge.
+//
+func (n *NLARestoreService) Restore() error {
+	snapshot, err := nlaapi.ReadSnapshot(n.Path)
+	if err != nil {
+		return err
+	}
+
+	ts := time.Unix(snapshot.Timestamp, 0)
+	if age := time.Since(ts); n.MaxAge > 0 && age > n.MaxAge {
+		return fmt.Errorf("snapshot too old. %s (%s > %s)", ts, age, n.MaxAge)
+	}
+
+	snapshot.RestoreTables()
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for _, ln := range snapshot.Links {
+		link := ln.ToNative()
+		key := nladbm.LinkToKey(link)
+		n.register(restoreLink, key, func() bool { return nladbm.Links().Select(key) != nil }, NewNLARestoreEntry(
+			restoreLinkMsg(ln), syscall.RTM_DELLINK, link, link.NId, false))
+	}
+
+	for _, a := range snapshot.Addrs {
+		addr := a.ToNative()
+		key := nladbm.AddrToKey(addr)
+		n.register(restoreAddr, key, func() bool { return nladbm.Addrs().Select(key) != nil }, NewNLARestoreEntry(
+			restoreAddrMsg(a), syscall.RTM_DELADDR, addr, addr.NId, true))
+	}
+
+	for _, ne := range snapshot.Neighs {
+		neigh := ne.ToNative()
+		if neigh.IsTunnelRemote() {
+			// generated by master (not received from kernel).
+			continue
+		}
+		key := nladbm.NeighToKey(neigh)
+		n.register(restoreNeigh, key, func() bool { return nladbm.Neighs().Select(key) != nil }, NewNLARestoreEntry(
+			restoreNeighMsg(ne), syscall.RTM_DELNEIGH, neigh, neigh.NId, true))
+	}
+
+	for _, nh := range snapshot.Nexthops {
+		nexthop := nh.ToNative()
+		key := nladbm.NexthopToKey(nexthop)
+		n.register(restoreNexthop, key, func() bool { return nladbm.Nexthops().Select(key) != nil }, NewNLARestoreEntry(
+			nh, nlalink.RTM_DELNEXTHOP, nexthop, nexthop.NId, false))
+	}
+
+	for _, rt := range snapshot.Routes {
+		route := rt.ToNative()
+		key := nladbm.RouteToKey(route)
+		n.register(restoreRoute, key, func() bool { return nladbm.Routes().Select(key) != nil }, NewNLARestoreEntry(
+			restoreRouteMsg(rt), syscall.RTM_DELROUTE, route, route.NId, false))
+	}
+
+	for _, rt := range snapshot.Mplss {
+		route := rt.ToNative()
+		key := nladbm.MplsToKey(route)
+		n.register(restoreMpls, key, func() bool { return nladbm.Mplss().Select(key) != nil }, NewNLARestoreEntry(
+			restoreRouteMsg(rt), syscall.RTM_DELROUTE, route, route.NId, true))
+	}
+
+	for _, br := range snapshot.BrVlans {
+		brvlan := br.ToNative()
+		key := nladbm.BridgeVlanInfoToKey(brvlan)
+		n.register(restoreBrVlan, key, func() bool { return nladbm.BrVlans().Select(key) != nil }, NewNLARestoreEntry(
+			restoreBrVlanMsg(br), nlalink.RTM_DELBRIDGE, brvlan, brvlan.NId, false))
+	}
+
+	for _, r := range snapshot.Rules {
+		rule := r.ToNative()
+		key := nladbm.RuleToKey(rule)
+		n.register(restoreRule, key, func() bool { return nladbm.Rules().Select(key) != nil }, NewNLARestoreEntry(
+			r, syscall.RTM_DELRULE, rule, rule.NId, true))
+	}
+
+	for _, mr := range snapshot.Mroutes {
+		mroute := mr.ToNative()
+		key := nladbm.MrouteToKey(mroute)
+		n.register(restoreMroute, key, func() bool { return nladbm.Mroutes().Select(key) != nil }, NewNLARestoreEntry(
+			restoreMrouteMsg(mr), nlalink.RTM_DELMROUTE, mroute, mroute.NId, false))
+	}
+
+	n.log.Infof("Restore: %s (%s)", n.Path, ts)
+	return nil
+}
+
+func (n *NLARestoreService) register(kind int, key interface{}, exists func() bool, e *NLARestoreEntry) {
+	e.exists = exists
+	n.entries[kind][restoreKeyStr(key)] = e
+}
+
+//
+// reconcile checks netlink message with restored entry.
+// it returns true if nlmsg is same as restored entry (not to be sent to clients.)
+// if nlmsg is changed and restored entry is not replaced by master service,
+// the entry is deleted before nlmsg is processed.
+//
+func (n *NLARestoreService) reconcile(nlmsg *nlamsg.NetlinkMessage, kind int, key interface{}, isNew bool, msg func() proto.Message) bool {
+	if nlmsg.Src != nlamsg.SRC_KNL {
+		return false
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	keyStr := restoreKeyStr(key)
+	e, ok := n.entries[kind][keyStr]
+	if !ok {
+		return false
+	}
+
+	delete(n.entries[kind], keyStr)
+
+	if !isNew || msg == nil {
+		return false
+	}
+
+	if proto.Equal(e.msg, msg()) {
+		n.log.Debugf("reconcile: not changed. %s", keyStr)
+		return true
+	}
+
+	n.log.Debugf("reconcile: changed. %s", keyStr)
+
+	if e.replace {
+		if err := nlamsg.DispatchUnion(e.del, n.NLAMasterService); err != nil {
+			n.log.Warnf("reconcile: delete error. %s", err)
+		}
+	}
+
+	return false
+}
+
+//
+// Sweep deletes restored entries not reconciled.
+// DEL messages are processed by all services via NLAManager.
+//
+func (n *NLARestoreService) Sweep() {
+	dels := func() []*nlamsg.NetlinkMessageUnion {
+		n.mutex.Lock()
+		defer n.mutex.Unlock()
+
+		dels := []*nlamsg.NetlinkMessageUnion{}
+		for kind, entries := range n.entries {
+			for _, e := range entries {
+				if e.exists() {
+					dels = append(dels, e.del)
+				}
+			}
+			n.entries[kind] = map[string]*NLARestoreEntry{}
+		}
+		return dels
+	}()
+
+	n.log.Infof("Sweep: %d stale entries.", len(dels))
+
+	for _, del := range dels {
+		n.log.Debugf("Sweep: %s", del)
+		select {
+		case n.chans.Api <- del:
+		case <-n.done:
+			return
+		}
+	}
+}
+
+func (n *NLARestoreService) NetlinkLink(nlmsg *nlamsg.NetlinkMessage, link *nlamsg.Link) {
+	isNew := nlmsg.Type() == syscall.RTM_NEWLINK
+	if n.reconcile(nlmsg, restoreLink, nladbm.LinkToKey(link), isNew, func() proto.Message {
+		return restoreLinkMsg(nlaapi.NewLinkFromNative(link))
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkLink(nlmsg, link)
+}
+
+func (n *NLARestoreService) NetlinkAddr(nlmsg *nlamsg.NetlinkMessage, addr *nlamsg.Addr) {
+	isNew := nlmsg.Type() == syscall.RTM_NEWADDR
+	if n.reconcile(nlmsg, restoreAddr, nladbm.AddrToKey(addr), isNew, func() proto.Message {
+		return restoreAddrMsg(nlaapi.NewAddrFromNative(addr))
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkAddr(nlmsg, addr)
+}
+
+func (n *NLARestoreService) NetlinkNeigh(nlmsg *nlamsg.NetlinkMessage, neigh *nlamsg.Neigh) {
+	var msg func() proto.Message
+	if !neigh.IsFdbEntry() {
+		// fdb entries are processed by bridge vlan service.
+		msg = func() proto.Message {
+			return restoreNeighMsg(nlaapi.NewNeighFromNative(neigh))
+		}
+	}
+
+	isNew := nlmsg.Type() == syscall.RTM_NEWNEIGH && (!nlalib.IsInvalidHardwareAddr(neigh.HardwareAddr) || neigh.IsVtepEntry())
+	if n.reconcile(nlmsg, restoreNeigh, nladbm.NeighToKey(neigh), isNew, msg) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkNeigh(nlmsg, neigh)
+}
+
+func (n *NLARestoreService) NetlinkRoute(nlmsg *nlamsg.NetlinkMessage, route *nlamsg.Route) {
+	if route.Table == 254 || nladbm.IsVrfRouteTable(route.Table) {
+		r := route.Copy()
+		n.normalizeRoute(r)
+
+		isNew := nlmsg.Type() == syscall.RTM_NEWROUTE
+		msg := func() proto.Message {
+			return restoreRouteMsg(nlaapi.NewRouteFromNative(r))
+		}
+
+		switch {
+		case r.Dst != nil:
+			if n.reconcile(nlmsg, restoreRoute, nladbm.RouteToKey(r), isNew, msg) {
+				return
+			}
+
+		case r.MPLSDst != nil:
+			if n.reconcile(nlmsg, restoreMpls, nladbm.MplsToKey(r), isNew, msg) {
+				return
+			}
+		}
+	}
+
+	n.NLAMasterService.NetlinkRoute(nlmsg, route)
+}
+
+func (n *NLARestoreService) NetlinkBridgeVlanInfo(nlmsg *nlamsg.NetlinkMessage, brvlan *nlamsg.BridgeVlanInfo) {
+	isNew := nlmsg.Type() == nlalink.RTM_NEWBRIDGE
+	if n.reconcile(nlmsg, restoreBrVlan, nladbm.BridgeVlanInfoToKey(brvlan), isNew, func() proto.Message {
+		return restoreBrVlanMsg(nlaapi.NewBridgeVlanInfoFromNative(brvlan))
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkBridgeVlanInfo(nlmsg, brvlan)
+}
+
+func (n *NLARestoreService) NetlinkNexthop(nlmsg *nlamsg.NetlinkMessage, nh *nlamsg.Nexthop) {
+	isNew := nlmsg.Type() == nlalink.RTM_NEWNEXTHOP
+	if n.reconcile(nlmsg, restoreNexthop, nladbm.NexthopToKey(nh), isNew, func() proto.Message {
+		return nlaapi.NewNexthopFromNative(nh)
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkNexthop(nlmsg, nh)
+}
+
+func (n *NLARestoreService) NetlinkRule(nlmsg *nlamsg.NetlinkMessage, rule *nlamsg.Rule) {
+	isNew := nlmsg.Type() == syscall.RTM_NEWRULE
+	if n.reconcile(nlmsg, restoreRule, nladbm.RuleToKey(rule), isNew, func() proto.Message {
+		return nlaapi.NewRuleFromNative(rule)
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkRule(nlmsg, rule)
+}
+
+func (n *NLARestoreService) NetlinkMroute(nlmsg *nlamsg.NetlinkMessage, mroute *nlamsg.Mroute) {
+	isNew := nlmsg.Type() == nlalink.RTM_NEWMROUTE
+	if n.reconcile(nlmsg, restoreMroute, nladbm.MrouteToKey(mroute), isNew, func() proto.Message {
+		return restoreMrouteMsg(nlaapi.NewMrouteFromNative(mroute))
+	}) {
+		return
+	}
+
+	n.NLAMasterService.NetlinkMroute(nlmsg, mroute)
+}
+
+//
+// helpers to compare restored entries and netlink messages without ids.
+//
+func restoreKeyStr(key interface{}) string {
+	return fmt.Sprintf("%v", key)
+}
+
+func restoreLinkMsg(ln *nlaapi.Link) proto.Message {
+	m := proto.Clone(ln).(*nlaapi.Link)
+	m.LnId = 0
+	return m
+}
+
+func restoreAddrMsg(a *nlaapi.Addr) proto.Message {
+	m := proto.Clone(a).(*nlaapi.Addr)
+	m.AdId = 0
+	return m
+}
+
+func restoreNeighMsg(ne *nlaapi.Neigh) proto.Message {
+	m := proto.Clone(ne).(*nlaapi.Neigh)
+	m.NeId = 0
+	return m
+}
+
+func restoreRouteMsg(rt *nlaapi.Route) proto.Message {
+	m := proto.Clone(rt).(*nlaapi.Route)
+	m.RtId = 0
+	m.EnIds = nil
+	return m
+}
+
+func restoreBrVlanMsg(br *nlaapi.BridgeVlanInfo) proto.Message {
+	m := proto.Clone(br).(*nlaapi.BridgeVlanInfo)
+	m.BrId = 0
+	return m
+}
+
+func restoreMrouteMsg(mr *nlaapi.Mroute) proto.Message {
+	m := proto.Clone(mr).(*nlaapi.Mroute)
+	m.MrId = 0
+	return m
+}
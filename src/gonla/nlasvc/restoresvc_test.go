@@ -0,0 +1,216 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlasvc
+
+import (
+	"gonla/nlaapi"
+	"gonla/nlactl"
+	"gonla/nlamsg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/vishvananda/netlink"
+)
+
+func newTestRestoreService() *NLARestoreService {
+	s := NewNLARestoreService(nil, "", time.Minute, time.Minute, time.Minute)
+	s.chans = &nlactl.NLAChannels{
+		Api: make(chan *nlamsg.NetlinkMessageUnion, 16),
+	}
+	return s
+}
+
+func newTestRestoreEntry(name string, index int, exists bool) *NLARestoreEntry {
+	link := nlamsg.NewLink(&netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: name, Index: index}}, 0, 0)
+	e := NewNLARestoreEntry(restoreLinkMsg(nlaapi.NewLinkFromNative(link)), syscall.RTM_DELLINK, link, 0, false)
+	e.exists = func() bool { return exists }
+	return e
+}
+
+func TestNLARestoreServiceReconcile(t *testing.T) {
+	restored := newTestRestoreEntry("eth1", 1, true)
+
+	tests := []struct {
+		name      string
+		key       string
+		src       nlamsg.NlMsgSrc
+		isNew     bool
+		msg       proto.Message
+		result    bool
+		remaining int
+	}{
+		{
+			name:      "kept",
+			key:       "eth1",
+			src:       nlamsg.SRC_KNL,
+			isNew:     true,
+			msg:       restored.msg,
+			result:    true,
+			remaining: 0,
+		},
+		{
+			name:      "changed",
+			key:       "eth1",
+			src:       nlamsg.SRC_KNL,
+			isNew:     true,
+			msg:       newTestRestoreEntry("eth1-new", 1, true).msg,
+			result:    false,
+			remaining: 0,
+		},
+		{
+			name:      "deleted",
+			key:       "eth1",
+			src:       nlamsg.SRC_KNL,
+			isNew:     false,
+			msg:       restored.msg,
+			result:    false,
+			remaining: 0,
+		},
+		{
+			name:      "new entry",
+			key:       "eth2",
+			src:       nlamsg.SRC_KNL,
+			isNew:     true,
+			msg:       newTestRestoreEntry("eth2", 2, true).msg,
+			result:    false,
+			remaining: 1,
+		},
+		{
+			name:      "not from kernel",
+			key:       "eth1",
+			src:       nlamsg.SRC_API,
+			isNew:     true,
+			msg:       restored.msg,
+			result:    false,
+			remaining: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestRestoreService()
+			s.register(restoreLink, "eth1", restored.exists, restored)
+
+			nlmsg := nlamsg.NewNetlinkMessage(&syscall.NetlinkMessage{
+				Header: syscall.NlMsghdr{Type: syscall.RTM_NEWLINK},
+			}, 0, test.src)
+
+			result := s.reconcile(nlmsg, restoreLink, test.key, test.isNew, func() proto.Message {
+				return test.msg
+			})
+			if result != test.result {
+				t.Errorf("reconcile unmatch. result=%t", result)
+			}
+			if n := len(s.entries[restoreLink]); n != test.remaining {
+				t.Errorf("reconcile unmatch. remaining=%d", n)
+			}
+		})
+	}
+}
+
+func TestNLARestoreServiceSweep(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries map[string]bool
+		dels    int
+	}{
+		{
+			name:    "no entries",
+			entries: map[string]bool{},
+			dels:    0,
+		},
+		{
+			name:    "stale entries",
+			entries: map[string]bool{"eth1": true, "eth2": true},
+			dels:    2,
+		},
+		{
+			name:    "already deleted",
+			entries: map[string]bool{"eth1": true, "eth2": false},
+			dels:    1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestRestoreService()
+			index := 0
+			for name, exists := range test.entries {
+				index++
+				e := newTestRestoreEntry(name, index, exists)
+				s.register(restoreLink, name, e.exists, e)
+			}
+
+			s.Sweep()
+
+			if n := len(s.chans.Api); n != test.dels {
+				t.Errorf("Sweep unmatch. dels=%d", n)
+			}
+			for len(s.chans.Api) > 0 {
+				if del := <-s.chans.Api; del.Type() != syscall.RTM_DELLINK {
+					t.Errorf("Sweep unmatch. type=%d", del.Type())
+				}
+			}
+			for kind, entries := range s.entries {
+				if n := len(entries); n != 0 {
+					t.Errorf("Sweep unmatch. kind=%d entries=%d", kind, n)
+				}
+			}
+		})
+	}
+}
+
+func TestNLARestoreServiceRestoreMaxAge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nlasvc")
+	if err != nil {
+		t.Fatalf("TempDir error. %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name  string
+		age   time.Duration
+		isErr bool
+	}{
+		{name: "fresh", age: 0, isErr: false},
+		{name: "too old", age: 2 * time.Minute, isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestRestoreService()
+			s.Path = filepath.Join(dir, "nlad.snapshot")
+
+			snapshot := &nlaapi.Snapshot{
+				Timestamp: time.Now().Add(-test.age).Unix(),
+			}
+			if err := nlaapi.WriteSnapshot(s.Path, snapshot); err != nil {
+				t.Fatalf("WriteSnapshot error. %s", err)
+			}
+
+			if err := s.Restore(); (err != nil) != test.isErr {
+				t.Errorf("Restore unmatch. err=%v", err)
+			}
+		})
+	}
+}
@@ -194,6 +194,10 @@ const (
 	IDMapNameL3Iface = "L3Iface"
 	// IDMapNameTrunk is Trunk entry name.
 	IDMapNameTrunk = "Trunk"
+	// IDMapNameMPLSEgress is MPLS Egress entry name.
+	IDMapNameMPLSEgress = "MPLSEgr"
+	// IDMapNameMPLSIface is MPLS Tunnel L3Iface entry name.
+	IDMapNameMPLSIface = "MPLSIf"
 )
 
 //
@@ -255,6 +259,38 @@ func NewGetTunnelTerminatorsReply(entries []*TunnelTerminator) *GetTunnelTermina
 	}
 }
 
+//
+// NewGetMPLSTunnelInitiatorsRequest returns new instance.
+//
+func NewGetMPLSTunnelInitiatorsRequest() *GetMPLSTunnelInitiatorsRequest {
+	return &GetMPLSTunnelInitiatorsRequest{}
+}
+
+//
+// NewGetMPLSTunnelInitiatorsReply returns new instance.
+//
+func NewGetMPLSTunnelInitiatorsReply(entries []*MPLSTunnelInitiator) *GetMPLSTunnelInitiatorsReply {
+	return &GetMPLSTunnelInitiatorsReply{
+		Tunnels: entries,
+	}
+}
+
+//
+// NewGetMPLSTunnelSwitchesRequest returns new instance.
+//
+func NewGetMPLSTunnelSwitchesRequest() *GetMPLSTunnelSwitchesRequest {
+	return &GetMPLSTunnelSwitchesRequest{}
+}
+
+//
+// NewGetMPLSTunnelSwitchesReply returns new instance.
+//
+func NewGetMPLSTunnelSwitchesReply(entries []*MPLSTunnelSwitch) *GetMPLSTunnelSwitchesReply {
+	return &GetMPLSTunnelSwitchesReply{
+		Switches: entries,
+	}
+}
+
 //
 // PortInfo
 //
@@ -267,3 +303,69 @@ func NewGetPortInfosReply(pinfos []*PortInfo) *GetPortInfosReply {
 func NewGetPortInfosRequest() *GetPortInfosRequest {
 	return &GetPortInfosRequest{}
 }
+
+//
+// NewAddMirrorSessionRequest returns new instance.
+//
+func NewAddMirrorSessionRequest(session *MirrorSession) *AddMirrorSessionRequest {
+	return &AddMirrorSessionRequest{
+		Session: session,
+	}
+}
+
+//
+// NewDelMirrorSessionRequest returns new instance.
+//
+func NewDelMirrorSessionRequest(name string) *DelMirrorSessionRequest {
+	return &DelMirrorSessionRequest{
+		Name: name,
+	}
+}
+
+//
+// NewGetMirrorSessionsRequest returns new instance.
+//
+func NewGetMirrorSessionsRequest() *GetMirrorSessionsRequest {
+	return &GetMirrorSessionsRequest{}
+}
+
+//
+// NewGetMirrorSessionsReply returns new instance.
+//
+func NewGetMirrorSessionsReply(sessions []*MirrorSession) *GetMirrorSessionsReply {
+	return &GetMirrorSessionsReply{
+		Sessions: sessions,
+	}
+}
+
+//
+// NewGetCoppStatsRequest returns new instance.
+//
+func NewGetCoppStatsRequest() *GetCoppStatsRequest {
+	return &GetCoppStatsRequest{}
+}
+
+//
+// NewGetCoppStatsReply returns new instance.
+//
+func NewGetCoppStatsReply(stats []*CoppClassStats) *GetCoppStatsReply {
+	return &GetCoppStatsReply{
+		Stats: stats,
+	}
+}
+
+//
+// NewGetTrunksRequest returns new instance.
+//
+func NewGetTrunksRequest() *GetTrunksRequest {
+	return &GetTrunksRequest{}
+}
+
+//
+// NewGetTrunksReply returns new instance.
+//
+func NewGetTrunksReply(trunks []*Trunk) *GetTrunksReply {
+	return &GetTrunksReply{
+		Trunks: trunks,
+	}
+}
@@ -58,6 +58,59 @@ func (FieldEntry_EntryType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a6347f14b9114d11, []int{0, 0}
 }
 
+type MirrorSession_Type int32
+
+const (
+	MirrorSession_SPAN   MirrorSession_Type = 0
+	MirrorSession_ERSPAN MirrorSession_Type = 1
+)
+
+var MirrorSession_Type_name = map[int32]string{
+	0: "SPAN",
+	1: "ERSPAN",
+}
+
+var MirrorSession_Type_value = map[string]int32{
+	"SPAN":   0,
+	"ERSPAN": 1,
+}
+
+func (x MirrorSession_Type) String() string {
+	return proto.EnumName(MirrorSession_Type_name, int32(x))
+}
+
+func (MirrorSession_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{50, 0}
+}
+
+type MirrorSession_Direction int32
+
+const (
+	MirrorSession_BOTH    MirrorSession_Direction = 0
+	MirrorSession_INGRESS MirrorSession_Direction = 1
+	MirrorSession_EGRESS  MirrorSession_Direction = 2
+)
+
+var MirrorSession_Direction_name = map[int32]string{
+	0: "BOTH",
+	1: "INGRESS",
+	2: "EGRESS",
+}
+
+var MirrorSession_Direction_value = map[string]int32{
+	"BOTH":    0,
+	"INGRESS": 1,
+	"EGRESS":  2,
+}
+
+func (x MirrorSession_Direction) String() string {
+	return proto.EnumName(MirrorSession_Direction_name, int32(x))
+}
+
+func (MirrorSession_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{50, 1}
+}
+
 //
 // FieldEntry
 //
@@ -1356,6 +1409,7 @@ type L3Egress struct {
 	Mac                  string   `protobuf:"bytes,5,opt,name=mac,proto3" json:"mac,omitempty"`
 	Vid                  uint32   `protobuf:"varint,6,opt,name=vid,proto3" json:"vid,omitempty"`
 	Port                 uint32   `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	MplsLabel            uint32   `protobuf:"varint,8,opt,name=mpls_label,json=mplsLabel,proto3" json:"mpls_label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1435,6 +1489,13 @@ func (m *L3Egress) GetPort() uint32 {
 	return 0
 }
 
+func (m *L3Egress) GetMplsLabel() uint32 {
+	if m != nil {
+		return m.MplsLabel
+	}
+	return 0
+}
+
 type GetL3EgressesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2198,6 +2259,283 @@ func (m *GetTunnelTerminatorsReply) GetTunnels() []*TunnelTerminator {
 	return nil
 }
 
+//
+// MPLS
+//
+type MPLSTunnelInitiator struct {
+	L3IfaceId            uint32   `protobuf:"varint,1,opt,name=l3_iface_id,json=l3IfaceId,proto3" json:"l3_iface_id,omitempty"`
+	Labels               []uint32 `protobuf:"varint,2,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MPLSTunnelInitiator) Reset()         { *m = MPLSTunnelInitiator{} }
+func (m *MPLSTunnelInitiator) String() string { return proto.CompactTextString(m) }
+func (*MPLSTunnelInitiator) ProtoMessage()    {}
+func (*MPLSTunnelInitiator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{41}
+}
+
+func (m *MPLSTunnelInitiator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MPLSTunnelInitiator.Unmarshal(m, b)
+}
+func (m *MPLSTunnelInitiator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MPLSTunnelInitiator.Marshal(b, m, deterministic)
+}
+func (m *MPLSTunnelInitiator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MPLSTunnelInitiator.Merge(m, src)
+}
+func (m *MPLSTunnelInitiator) XXX_Size() int {
+	return xxx_messageInfo_MPLSTunnelInitiator.Size(m)
+}
+func (m *MPLSTunnelInitiator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MPLSTunnelInitiator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MPLSTunnelInitiator proto.InternalMessageInfo
+
+func (m *MPLSTunnelInitiator) GetL3IfaceId() uint32 {
+	if m != nil {
+		return m.L3IfaceId
+	}
+	return 0
+}
+
+func (m *MPLSTunnelInitiator) GetLabels() []uint32 {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type MPLSTunnelSwitch struct {
+	Flags                uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Label                uint32   `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Port                 uint32   `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Vrf                  uint32   `protobuf:"varint,5,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	EgressId             uint32   `protobuf:"varint,6,opt,name=egress_id,json=egressId,proto3" json:"egress_id,omitempty"`
+	EgressLabel          uint32   `protobuf:"varint,7,opt,name=egress_label,json=egressLabel,proto3" json:"egress_label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MPLSTunnelSwitch) Reset()         { *m = MPLSTunnelSwitch{} }
+func (m *MPLSTunnelSwitch) String() string { return proto.CompactTextString(m) }
+func (*MPLSTunnelSwitch) ProtoMessage()    {}
+func (*MPLSTunnelSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{42}
+}
+
+func (m *MPLSTunnelSwitch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MPLSTunnelSwitch.Unmarshal(m, b)
+}
+func (m *MPLSTunnelSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MPLSTunnelSwitch.Marshal(b, m, deterministic)
+}
+func (m *MPLSTunnelSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MPLSTunnelSwitch.Merge(m, src)
+}
+func (m *MPLSTunnelSwitch) XXX_Size() int {
+	return xxx_messageInfo_MPLSTunnelSwitch.Size(m)
+}
+func (m *MPLSTunnelSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MPLSTunnelSwitch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MPLSTunnelSwitch proto.InternalMessageInfo
+
+func (m *MPLSTunnelSwitch) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *MPLSTunnelSwitch) GetLabel() uint32 {
+	if m != nil {
+		return m.Label
+	}
+	return 0
+}
+
+func (m *MPLSTunnelSwitch) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *MPLSTunnelSwitch) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *MPLSTunnelSwitch) GetVrf() uint32 {
+	if m != nil {
+		return m.Vrf
+	}
+	return 0
+}
+
+func (m *MPLSTunnelSwitch) GetEgressId() uint32 {
+	if m != nil {
+		return m.EgressId
+	}
+	return 0
+}
+
+func (m *MPLSTunnelSwitch) GetEgressLabel() uint32 {
+	if m != nil {
+		return m.EgressLabel
+	}
+	return 0
+}
+
+type GetMPLSTunnelInitiatorsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMPLSTunnelInitiatorsRequest) Reset()         { *m = GetMPLSTunnelInitiatorsRequest{} }
+func (m *GetMPLSTunnelInitiatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMPLSTunnelInitiatorsRequest) ProtoMessage()    {}
+func (*GetMPLSTunnelInitiatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{43}
+}
+
+func (m *GetMPLSTunnelInitiatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsRequest.Unmarshal(m, b)
+}
+func (m *GetMPLSTunnelInitiatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMPLSTunnelInitiatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMPLSTunnelInitiatorsRequest.Merge(m, src)
+}
+func (m *GetMPLSTunnelInitiatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsRequest.Size(m)
+}
+func (m *GetMPLSTunnelInitiatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMPLSTunnelInitiatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMPLSTunnelInitiatorsRequest proto.InternalMessageInfo
+
+type GetMPLSTunnelInitiatorsReply struct {
+	Tunnels              []*MPLSTunnelInitiator `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetMPLSTunnelInitiatorsReply) Reset()         { *m = GetMPLSTunnelInitiatorsReply{} }
+func (m *GetMPLSTunnelInitiatorsReply) String() string { return proto.CompactTextString(m) }
+func (*GetMPLSTunnelInitiatorsReply) ProtoMessage()    {}
+func (*GetMPLSTunnelInitiatorsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{44}
+}
+
+func (m *GetMPLSTunnelInitiatorsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsReply.Unmarshal(m, b)
+}
+func (m *GetMPLSTunnelInitiatorsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsReply.Marshal(b, m, deterministic)
+}
+func (m *GetMPLSTunnelInitiatorsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMPLSTunnelInitiatorsReply.Merge(m, src)
+}
+func (m *GetMPLSTunnelInitiatorsReply) XXX_Size() int {
+	return xxx_messageInfo_GetMPLSTunnelInitiatorsReply.Size(m)
+}
+func (m *GetMPLSTunnelInitiatorsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMPLSTunnelInitiatorsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMPLSTunnelInitiatorsReply proto.InternalMessageInfo
+
+func (m *GetMPLSTunnelInitiatorsReply) GetTunnels() []*MPLSTunnelInitiator {
+	if m != nil {
+		return m.Tunnels
+	}
+	return nil
+}
+
+type GetMPLSTunnelSwitchesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMPLSTunnelSwitchesRequest) Reset()         { *m = GetMPLSTunnelSwitchesRequest{} }
+func (m *GetMPLSTunnelSwitchesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMPLSTunnelSwitchesRequest) ProtoMessage()    {}
+func (*GetMPLSTunnelSwitchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{45}
+}
+
+func (m *GetMPLSTunnelSwitchesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesRequest.Unmarshal(m, b)
+}
+func (m *GetMPLSTunnelSwitchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMPLSTunnelSwitchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMPLSTunnelSwitchesRequest.Merge(m, src)
+}
+func (m *GetMPLSTunnelSwitchesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesRequest.Size(m)
+}
+func (m *GetMPLSTunnelSwitchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMPLSTunnelSwitchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMPLSTunnelSwitchesRequest proto.InternalMessageInfo
+
+type GetMPLSTunnelSwitchesReply struct {
+	Switches             []*MPLSTunnelSwitch `protobuf:"bytes,1,rep,name=switches,proto3" json:"switches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetMPLSTunnelSwitchesReply) Reset()         { *m = GetMPLSTunnelSwitchesReply{} }
+func (m *GetMPLSTunnelSwitchesReply) String() string { return proto.CompactTextString(m) }
+func (*GetMPLSTunnelSwitchesReply) ProtoMessage()    {}
+func (*GetMPLSTunnelSwitchesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{46}
+}
+
+func (m *GetMPLSTunnelSwitchesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesReply.Unmarshal(m, b)
+}
+func (m *GetMPLSTunnelSwitchesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesReply.Marshal(b, m, deterministic)
+}
+func (m *GetMPLSTunnelSwitchesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMPLSTunnelSwitchesReply.Merge(m, src)
+}
+func (m *GetMPLSTunnelSwitchesReply) XXX_Size() int {
+	return xxx_messageInfo_GetMPLSTunnelSwitchesReply.Size(m)
+}
+func (m *GetMPLSTunnelSwitchesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMPLSTunnelSwitchesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMPLSTunnelSwitchesReply proto.InternalMessageInfo
+
+func (m *GetMPLSTunnelSwitchesReply) GetSwitches() []*MPLSTunnelSwitch {
+	if m != nil {
+		return m.Switches
+	}
+	return nil
+}
+
 //
 // ID map
 //
@@ -2214,7 +2552,7 @@ func (m *IDMapEntry) Reset()         { *m = IDMapEntry{} }
 func (m *IDMapEntry) String() string { return proto.CompactTextString(m) }
 func (*IDMapEntry) ProtoMessage()    {}
 func (*IDMapEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6347f14b9114d11, []int{41}
+	return fileDescriptor_a6347f14b9114d11, []int{47}
 }
 
 func (m *IDMapEntry) XXX_Unmarshal(b []byte) error {
@@ -2266,7 +2604,7 @@ func (m *GetIDMapEntriesRequest) Reset()         { *m = GetIDMapEntriesRequest{}
 func (m *GetIDMapEntriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIDMapEntriesRequest) ProtoMessage()    {}
 func (*GetIDMapEntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6347f14b9114d11, []int{42}
+	return fileDescriptor_a6347f14b9114d11, []int{48}
 }
 
 func (m *GetIDMapEntriesRequest) XXX_Unmarshal(b []byte) error {
@@ -2298,7 +2636,7 @@ func (m *GetIDMapEntriesReply) Reset()         { *m = GetIDMapEntriesReply{} }
 func (m *GetIDMapEntriesReply) String() string { return proto.CompactTextString(m) }
 func (*GetIDMapEntriesReply) ProtoMessage()    {}
 func (*GetIDMapEntriesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6347f14b9114d11, []int{43}
+	return fileDescriptor_a6347f14b9114d11, []int{49}
 }
 
 func (m *GetIDMapEntriesReply) XXX_Unmarshal(b []byte) error {
@@ -2326,176 +2664,1052 @@ func (m *GetIDMapEntriesReply) GetEntries() []*IDMapEntry {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("gonslapi.FieldEntry_EntryType", FieldEntry_EntryType_name, FieldEntry_EntryType_value)
-	proto.RegisterType((*FieldEntry)(nil), "gonslapi.FieldEntry")
-	proto.RegisterType((*EthDstFieldEntry)(nil), "gonslapi.EthDstFieldEntry")
-	proto.RegisterType((*EthTypeFieldEntry)(nil), "gonslapi.EthTypeFieldEntry")
-	proto.RegisterType((*DstIpFieldEntry)(nil), "gonslapi.DstIpFieldEntry")
-	proto.RegisterType((*IpProtoFieldEntry)(nil), "gonslapi.IpProtoFieldEntry")
-	proto.RegisterType((*GetFieldEntriesRequest)(nil), "gonslapi.GetFieldEntriesRequest")
-	proto.RegisterType((*GetFieldEntriesReply)(nil), "gonslapi.GetFieldEntriesReply")
-	proto.RegisterType((*PortInfo)(nil), "gonslapi.PortInfo")
-	proto.RegisterType((*GetPortInfosRequest)(nil), "gonslapi.GetPortInfosRequest")
-	proto.RegisterType((*GetPortInfosReply)(nil), "gonslapi.GetPortInfosReply")
-	proto.RegisterType((*VlanEntry)(nil), "gonslapi.VlanEntry")
-	proto.RegisterType((*GetVlansRequest)(nil), "gonslapi.GetVlansRequest")
-	proto.RegisterType((*GetVlansReply)(nil), "gonslapi.GetVlansReply")
-	proto.RegisterType((*L2Addr)(nil), "gonslapi.L2Addr")
-	proto.RegisterType((*GetL2AddrsRequest)(nil), "gonslapi.GetL2AddrsRequest")
-	proto.RegisterType((*GetL2AddrsReply)(nil), "gonslapi.GetL2AddrsReply")
-	proto.RegisterType((*L2Station)(nil), "gonslapi.L2Station")
-	proto.RegisterType((*GetL2StationsRequest)(nil), "gonslapi.GetL2StationsRequest")
-	proto.RegisterType((*GetL2StationsReply)(nil), "gonslapi.GetL2StationsReply")
-	proto.RegisterType((*L3Iface)(nil), "gonslapi.L3Iface")
-	proto.RegisterType((*FindL3IfaceRequest)(nil), "gonslapi.FindL3IfaceRequest")
-	proto.RegisterType((*FindL3IfaceReply)(nil), "gonslapi.FindL3IfaceReply")
-	proto.RegisterType((*GetL3IfaceRequest)(nil), "gonslapi.GetL3IfaceRequest")
-	proto.RegisterType((*GetL3IfaceReply)(nil), "gonslapi.GetL3IfaceReply")
-	proto.RegisterType((*GetL3IfacesRequest)(nil), "gonslapi.GetL3IfacesRequest")
-	proto.RegisterType((*GetL3IfacesReply)(nil), "gonslapi.GetL3IfacesReply")
-	proto.RegisterType((*L3Egress)(nil), "gonslapi.L3Egress")
-	proto.RegisterType((*GetL3EgressesRequest)(nil), "gonslapi.GetL3EgressesRequest")
-	proto.RegisterType((*GetL3EgressesReply)(nil), "gonslapi.GetL3EgressesReply")
-	proto.RegisterType((*L3Host)(nil), "gonslapi.L3Host")
-	proto.RegisterType((*GetL3HostsRequest)(nil), "gonslapi.GetL3HostsRequest")
-	proto.RegisterType((*GetL3HostsReply)(nil), "gonslapi.GetL3HostsReply")
-	proto.RegisterType((*L3Route)(nil), "gonslapi.L3Route")
-	proto.RegisterType((*GetL3RoutesRequest)(nil), "gonslapi.GetL3RoutesRequest")
-	proto.RegisterType((*GetL3RoutesReply)(nil), "gonslapi.GetL3RoutesReply")
-	proto.RegisterType((*TunnelInitiator)(nil), "gonslapi.TunnelInitiator")
-	proto.RegisterType((*TunnelTerminator)(nil), "gonslapi.TunnelTerminator")
-	proto.RegisterType((*GetTunnelInitiatorsRequest)(nil), "gonslapi.GetTunnelInitiatorsRequest")
-	proto.RegisterType((*GetTunnelInitiatorsReply)(nil), "gonslapi.GetTunnelInitiatorsReply")
-	proto.RegisterType((*GetTunnelTerminatorsRequest)(nil), "gonslapi.GetTunnelTerminatorsRequest")
-	proto.RegisterType((*GetTunnelTerminatorsReply)(nil), "gonslapi.GetTunnelTerminatorsReply")
-	proto.RegisterType((*IDMapEntry)(nil), "gonslapi.IDMapEntry")
-	proto.RegisterType((*GetIDMapEntriesRequest)(nil), "gonslapi.GetIDMapEntriesRequest")
-	proto.RegisterType((*GetIDMapEntriesReply)(nil), "gonslapi.GetIDMapEntriesReply")
+//
+// Mirror
+//
+type MirrorSession struct {
+	Name      string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type      MirrorSession_Type      `protobuf:"varint,2,opt,name=type,proto3,enum=gonslapi.MirrorSession_Type" json:"type,omitempty"`
+	Direction MirrorSession_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=gonslapi.MirrorSession_Direction" json:"direction,omitempty"`
+	SrcPorts  []uint32                `protobuf:"varint,4,rep,packed,name=src_ports,json=srcPorts,proto3" json:"src_ports,omitempty"`
+	DstPort   uint32                  `protobuf:"varint,5,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"`
+	// ERSPAN only
+	SrcIp  string `protobuf:"bytes,6,opt,name=src_ip,json=srcIp,proto3" json:"src_ip,omitempty"`
+	DstIp  string `protobuf:"bytes,7,opt,name=dst_ip,json=dstIp,proto3" json:"dst_ip,omitempty"`
+	SrcMac string `protobuf:"bytes,8,opt,name=src_mac,json=srcMac,proto3" json:"src_mac,omitempty"`
+	DstMac string `protobuf:"bytes,9,opt,name=dst_mac,json=dstMac,proto3" json:"dst_mac,omitempty"`
+	Vlan   uint32 `protobuf:"varint,10,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Ttl    uint32 `protobuf:"varint,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Tos    uint32 `protobuf:"varint,12,opt,name=tos,proto3" json:"tos,omitempty"`
+	// set by gonsld
+	DestId               uint32   `protobuf:"varint,13,opt,name=dest_id,json=destId,proto3" json:"dest_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("gonslapi.proto", fileDescriptor_a6347f14b9114d11) }
+func (m *MirrorSession) Reset()         { *m = MirrorSession{} }
+func (m *MirrorSession) String() string { return proto.CompactTextString(m) }
+func (*MirrorSession) ProtoMessage()    {}
+func (*MirrorSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{50}
+}
 
-var fileDescriptor_a6347f14b9114d11 = []byte{
-	// 1639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6e, 0xdb, 0x46,
-	0x13, 0xb6, 0x4e, 0x14, 0x35, 0xb2, 0x13, 0x79, 0x63, 0xc7, 0x92, 0xec, 0x38, 0xc6, 0xe6, 0xff,
-	0x5b, 0xf7, 0xc6, 0x45, 0xe5, 0x36, 0x48, 0x53, 0x04, 0x68, 0x0a, 0x9f, 0x04, 0x38, 0xb1, 0x4b,
-	0xab, 0x01, 0x0a, 0x14, 0x20, 0x18, 0x73, 0xe5, 0x10, 0x96, 0x28, 0x96, 0xa4, 0x12, 0xe8, 0xb6,
-	0xaf, 0xd0, 0x67, 0x28, 0xd0, 0x9b, 0xbe, 0x49, 0xef, 0xfa, 0x1a, 0x7d, 0x86, 0xa2, 0x98, 0x3d,
-	0x70, 0x79, 0x52, 0x02, 0x14, 0x68, 0x6f, 0x92, 0xe5, 0x1c, 0x76, 0x66, 0xbe, 0x39, 0xec, 0xc8,
-	0x70, 0xe7, 0x66, 0xe6, 0x47, 0x13, 0x27, 0xf0, 0x0e, 0x82, 0x70, 0x16, 0xcf, 0x88, 0xa9, 0xbe,
-	0xe9, 0x5f, 0x55, 0x80, 0x13, 0x8f, 0x4d, 0xdc, 0x63, 0x3f, 0x0e, 0x17, 0xe4, 0x19, 0x00, 0xc3,
-	0x83, 0x1d, 0x2f, 0x02, 0xd6, 0xad, 0xec, 0x55, 0xf6, 0xef, 0x0c, 0x76, 0x0f, 0x12, 0x6d, 0x2d,
-	0x79, 0xc0, 0xff, 0x1d, 0x2d, 0x02, 0x66, 0xb5, 0x98, 0x3a, 0x92, 0x27, 0x60, 0xb2, 0xf8, 0x8d,
-	0x50, 0xae, 0xee, 0x55, 0xf6, 0xdb, 0x83, 0x6d, 0xad, 0x7c, 0x1c, 0xbf, 0x41, 0x21, 0x7d, 0xc7,
-	0xd9, 0x8a, 0xd5, 0x64, 0x82, 0x48, 0x06, 0x60, 0xb8, 0x51, 0x6c, 0x7b, 0x41, 0xb7, 0xc6, 0xf5,
-	0x7a, 0x5a, 0xef, 0x28, 0x8a, 0x87, 0x41, 0x46, 0xab, 0xe1, 0x22, 0x09, 0xad, 0x79, 0x81, 0xcd,
-	0x23, 0xea, 0xd6, 0xf3, 0xd6, 0x86, 0xc1, 0x25, 0x32, 0xb2, 0xd6, 0x3c, 0x41, 0x24, 0x5f, 0x00,
-	0x1a, 0xb6, 0xdd, 0x28, 0xee, 0x36, 0xb8, 0x62, 0x3f, 0xe3, 0xe6, 0x51, 0x14, 0x67, 0xf4, 0x0c,
-	0xc6, 0x69, 0x74, 0x08, 0xad, 0x24, 0x6c, 0xd2, 0x84, 0xda, 0xcb, 0x8b, 0xcb, 0xce, 0x0a, 0x59,
-	0x05, 0xf3, 0x78, 0x74, 0x66, 0x8f, 0xbe, 0xbf, 0x3c, 0xee, 0x54, 0x08, 0x80, 0x71, 0x74, 0x35,
-	0xb2, 0x87, 0x97, 0x9d, 0x2a, 0x72, 0x86, 0x97, 0xf6, 0xa5, 0x75, 0x31, 0xba, 0xe8, 0xd4, 0x48,
-	0x1b, 0x9a, 0x28, 0x77, 0x74, 0x35, 0xea, 0xd4, 0xbf, 0x69, 0x42, 0x83, 0xc3, 0x46, 0x6d, 0xe8,
-	0xe4, 0x2d, 0x92, 0x2d, 0xed, 0x1e, 0xa6, 0xa0, 0xa5, 0x1c, 0x20, 0x3d, 0x81, 0xef, 0xd4, 0x89,
-	0x6e, 0x39, 0xbe, 0x2d, 0x0e, 0xe0, 0x0b, 0x27, 0xba, 0x45, 0x1d, 0xcf, 0xb7, 0x83, 0x59, 0x18,
-	0x73, 0x04, 0xd7, 0x2c, 0xc3, 0xf3, 0x2f, 0x67, 0x61, 0x4c, 0x4f, 0x61, 0xbd, 0x80, 0xbc, 0xba,
-	0x28, 0xc9, 0xf2, 0x9a, 0xce, 0x44, 0xea, 0xa2, 0x6a, 0xe6, 0xa2, 0x1f, 0xe0, 0x6e, 0x2e, 0x15,
-	0xef, 0xbb, 0x66, 0x13, 0x0c, 0x2f, 0xe0, 0x21, 0x08, 0x47, 0x1b, 0x5e, 0x80, 0x11, 0x2c, 0x75,
-	0xf3, 0x35, 0xac, 0x17, 0x52, 0xf6, 0xbe, 0xfb, 0x7b, 0xa9, 0xe4, 0x0b, 0x3f, 0x93, 0xec, 0x2e,
-	0xb5, 0xd1, 0x85, 0xfb, 0xa7, 0x4c, 0x03, 0xed, 0xb1, 0xc8, 0x62, 0x3f, 0xce, 0x59, 0x14, 0xd3,
-	0x13, 0xd8, 0x28, 0x70, 0x82, 0xc9, 0x82, 0x1c, 0x40, 0x93, 0x89, 0xef, 0x6e, 0x65, 0xaf, 0xb6,
-	0xdf, 0x1e, 0x6c, 0x94, 0x35, 0x83, 0xa5, 0x84, 0xa8, 0x0b, 0x26, 0x5a, 0x1a, 0xfa, 0xe3, 0x19,
-	0x21, 0x50, 0xe7, 0x3e, 0x08, 0xc7, 0xf9, 0x99, 0x3c, 0x84, 0xf6, 0xc4, 0xf3, 0x6f, 0xed, 0x28,
-	0x76, 0xe2, 0x79, 0xc4, 0x1d, 0x6f, 0x58, 0x80, 0xa4, 0x2b, 0x4e, 0x21, 0x8f, 0x60, 0x6d, 0xee,
-	0xc7, 0xce, 0xcd, 0x0d, 0x73, 0xed, 0xb7, 0x13, 0xc7, 0x97, 0x11, 0xac, 0x2a, 0xe2, 0xab, 0x89,
-	0xe3, 0xd3, 0x4d, 0xb8, 0x77, 0xca, 0x62, 0x65, 0x28, 0x15, 0xc4, 0x7a, 0x96, 0x8c, 0x11, 0x7c,
-	0x06, 0x80, 0x96, 0x6d, 0x0f, 0x49, 0x32, 0x08, 0xa2, 0x83, 0x50, 0xd2, 0x56, 0x2b, 0x50, 0x7a,
-	0x74, 0x04, 0x2d, 0x34, 0x23, 0x52, 0xd0, 0x81, 0xda, 0x5b, 0xcf, 0x95, 0x41, 0xe0, 0x91, 0x6c,
-	0x40, 0x03, 0x65, 0xd1, 0xfb, 0xda, 0xfe, 0x9a, 0x25, 0x3e, 0x30, 0x32, 0xee, 0xa3, 0x2d, 0x78,
-	0x35, 0xce, 0x03, 0x4e, 0x42, 0x1b, 0x11, 0x5d, 0x87, 0xbb, 0xa7, 0x2c, 0xc6, 0x8b, 0x13, 0x87,
-	0x9f, 0xc2, 0x9a, 0x26, 0xa1, 0xb3, 0x9f, 0x40, 0x03, 0x83, 0x56, 0x7e, 0xde, 0xd3, 0x7e, 0x26,
-	0x0e, 0x59, 0x42, 0x82, 0xbe, 0x02, 0xe3, 0x7c, 0xf0, 0xdc, 0x75, 0x43, 0xf4, 0x67, 0x3c, 0x71,
-	0x6e, 0x22, 0xe9, 0xa3, 0xf8, 0x40, 0xbf, 0xa7, 0xce, 0xb5, 0x2c, 0x3e, 0x3c, 0xaa, 0x48, 0x6a,
-	0x3a, 0x12, 0x95, 0xa1, 0xba, 0xce, 0x10, 0xbd, 0xc7, 0x41, 0x14, 0x57, 0x27, 0x8e, 0x7e, 0xc9,
-	0x7d, 0x4f, 0x88, 0xe8, 0xea, 0x47, 0xd0, 0x70, 0xf0, 0x4b, 0xba, 0xda, 0xd1, 0xae, 0x0a, 0x31,
-	0x4b, 0xb0, 0xe9, 0xef, 0x15, 0x68, 0x9d, 0x0f, 0x30, 0xbb, 0xde, 0xcc, 0x5f, 0xe2, 0xeb, 0x16,
-	0x34, 0x71, 0xf8, 0x69, 0x7f, 0x71, 0x16, 0xbe, 0x70, 0xae, 0xc9, 0x1e, 0xac, 0x4a, 0x86, 0xe8,
-	0xf9, 0x1a, 0xe7, 0x82, 0xe0, 0xf2, 0xb6, 0x27, 0x50, 0xe7, 0x65, 0x22, 0x43, 0xc0, 0x33, 0xd9,
-	0x86, 0x16, 0xfe, 0x2f, 0x54, 0x1a, 0x9c, 0x61, 0x22, 0x81, 0x2b, 0xf4, 0xc0, 0x8c, 0xc2, 0x6b,
-	0xd1, 0x1d, 0x86, 0xe8, 0x9b, 0x28, 0xbc, 0xc6, 0x14, 0x11, 0x0a, 0x6b, 0x8a, 0x25, 0x74, 0x9b,
-	0x9c, 0xdf, 0x96, 0x7c, 0x54, 0xa7, 0xf7, 0x79, 0xa3, 0x24, 0x01, 0x25, 0x08, 0x1d, 0x03, 0xc9,
-	0xd1, 0x11, 0xa4, 0x4f, 0xc1, 0x8c, 0x24, 0xa1, 0x98, 0xd2, 0x44, 0xd8, 0x4a, 0x84, 0xe8, 0x6f,
-	0x15, 0x68, 0x9e, 0x1f, 0x0e, 0xc7, 0xce, 0x35, 0x5b, 0x82, 0x15, 0xf6, 0x3d, 0xb2, 0x6d, 0xcf,
-	0x4d, 0xfa, 0x1e, 0xbf, 0x87, 0xae, 0x4a, 0x79, 0x2d, 0x93, 0xf2, 0x69, 0x3c, 0x97, 0xe0, 0xe0,
-	0x11, 0xa1, 0x9e, 0xc6, 0x73, 0x7b, 0xfc, 0xce, 0x95, 0xc8, 0x18, 0xd3, 0x78, 0x7e, 0xf2, 0x8e,
-	0x2b, 0xc7, 0xf1, 0x44, 0x42, 0x82, 0x47, 0x55, 0x2f, 0x4d, 0x5d, 0x2f, 0x48, 0x09, 0xc7, 0x5d,
-	0x53, 0x52, 0xc2, 0x31, 0x7d, 0x02, 0xe4, 0xc4, 0xf3, 0x5d, 0xe9, 0xb2, 0x04, 0x43, 0x39, 0x52,
-	0x29, 0xd4, 0x5e, 0x35, 0xb9, 0x8b, 0x7e, 0x05, 0x9d, 0x8c, 0x26, 0xc2, 0xf5, 0x31, 0x34, 0x78,
-	0x2c, 0x5c, 0xb3, 0x3d, 0x58, 0x4f, 0x61, 0x25, 0xc5, 0x04, 0x9f, 0x1e, 0x88, 0x22, 0xcd, 0x5a,
-	0x4d, 0x23, 0x53, 0xc9, 0x20, 0x43, 0x9f, 0x8a, 0xfa, 0xfd, 0x47, 0xb6, 0x36, 0x44, 0x66, 0x05,
-	0x31, 0xc9, 0xf7, 0x33, 0xe8, 0x64, 0xa8, 0xa2, 0x7b, 0x0d, 0xae, 0xa2, 0x72, 0x5d, 0x72, 0xa7,
-	0x14, 0xa0, 0xbf, 0x56, 0xc0, 0x3c, 0x3f, 0x3c, 0xbe, 0x09, 0x59, 0x14, 0x2d, 0x49, 0xf4, 0x7d,
-	0x30, 0xf8, 0x61, 0xa0, 0x9e, 0x21, 0xf1, 0x85, 0xd5, 0xcd, 0xb8, 0x9e, 0x9d, 0x34, 0xb3, 0x29,
-	0x08, 0x43, 0x37, 0x83, 0x41, 0xbd, 0xb4, 0x3a, 0x1a, 0x85, 0xa4, 0x18, 0xc5, 0x81, 0xd0, 0x4c,
-	0x0d, 0x04, 0x59, 0xf1, 0xd2, 0x59, 0x8d, 0xc0, 0x91, 0xc4, 0x45, 0xd3, 0xc5, 0x83, 0x21, 0x9d,
-	0x61, 0x25, 0xc3, 0x56, 0x09, 0x5b, 0x89, 0x0c, 0xfd, 0xb9, 0x02, 0xc6, 0xf9, 0xe1, 0xd9, 0x2c,
-	0x8a, 0x97, 0xc0, 0x90, 0x09, 0xb7, 0x9a, 0x0b, 0x17, 0x5f, 0xba, 0xc0, 0xc6, 0x41, 0x23, 0xab,
-	0xde, 0xf0, 0x02, 0x3e, 0x13, 0xf9, 0xeb, 0xf8, 0x58, 0x70, 0xea, 0x62, 0x51, 0xf0, 0x82, 0xc7,
-	0x9c, 0x55, 0x8e, 0x43, 0x38, 0x4e, 0x70, 0x08, 0xc7, 0x6a, 0x08, 0x72, 0xbf, 0xf2, 0x43, 0x50,
-	0x11, 0xe5, 0x10, 0x7c, 0x83, 0x5f, 0x25, 0x43, 0x90, 0x8b, 0x59, 0x82, 0x4d, 0x7f, 0xe2, 0x6d,
-	0x6d, 0xcd, 0xe6, 0x31, 0xfb, 0x0f, 0xc3, 0xc4, 0xa0, 0x1a, 0x3a, 0x28, 0x55, 0xc8, 0xdc, 0x8d,
-	0x42, 0x21, 0x2b, 0xaa, 0x2c, 0xe4, 0x90, 0x7f, 0x96, 0x15, 0x32, 0x17, 0xb4, 0xa4, 0x00, 0xfd,
-	0xa3, 0x0a, 0x77, 0x47, 0x73, 0xdf, 0x67, 0x93, 0xa1, 0xef, 0xc5, 0x9e, 0x13, 0xcf, 0xc2, 0xe5,
-	0x11, 0xc6, 0x5c, 0x30, 0x15, 0xa1, 0x20, 0x0c, 0x5d, 0x7c, 0x3d, 0x25, 0x93, 0xef, 0x3a, 0x72,
-	0xce, 0x0b, 0x12, 0x5f, 0x77, 0x76, 0xa1, 0x3d, 0x39, 0xb4, 0x73, 0xb5, 0xdd, 0x9a, 0x88, 0xb6,
-	0x12, 0x10, 0xa9, 0x27, 0xa4, 0x91, 0x79, 0x42, 0xb6, 0x00, 0xe7, 0x3b, 0x67, 0x18, 0x82, 0x11,
-	0x85, 0xd7, 0xc8, 0xd8, 0x4c, 0x36, 0xee, 0xa6, 0x58, 0xd0, 0xc4, 0x52, 0xbd, 0x09, 0x28, 0x80,
-	0x64, 0x53, 0x90, 0xa3, 0xf0, 0x7a, 0x18, 0x20, 0xd2, 0x28, 0xcd, 0xbb, 0xa3, 0x25, 0x1a, 0xcb,
-	0x8d, 0xf8, 0xae, 0x91, 0x79, 0x51, 0x20, 0xfb, 0xa2, 0xc8, 0xa1, 0xda, 0xce, 0x0c, 0x55, 0x9c,
-	0xc8, 0xab, 0x7a, 0x22, 0xab, 0x17, 0x6c, 0x4d, 0xbf, 0x60, 0xf4, 0x97, 0x2a, 0x74, 0x04, 0xaa,
-	0x23, 0x16, 0x4e, 0x3d, 0xff, 0x5f, 0x83, 0xf5, 0x21, 0xb4, 0x43, 0x36, 0x9d, 0xc5, 0xcc, 0x4e,
-	0x2d, 0x02, 0x20, 0x48, 0x3c, 0x02, 0x8d, 0x52, 0xa3, 0x1c, 0x25, 0x63, 0x19, 0x4a, 0xcd, 0xe5,
-	0x28, 0x99, 0x59, 0x94, 0x14, 0x02, 0xad, 0xd4, 0x1b, 0x2e, 0xcb, 0x17, 0x92, 0xf2, 0xe5, 0xb3,
-	0xe9, 0xf5, 0x34, 0xe8, 0xb6, 0xf9, 0x66, 0xc5, 0xcf, 0x74, 0x07, 0xfa, 0xa7, 0x2c, 0xce, 0xd5,
-	0x5f, 0x52, 0xda, 0x17, 0xd0, 0x2d, 0xe5, 0x62, 0x89, 0x1f, 0x42, 0x53, 0xc0, 0xa0, 0x6a, 0x3c,
-	0xf5, 0x83, 0x2b, 0xa7, 0x61, 0x29, 0x49, 0xfa, 0x00, 0xb6, 0x93, 0x0b, 0x75, 0x62, 0x12, 0x7b,
-	0xdf, 0x42, 0xaf, 0x9c, 0x8d, 0x06, 0x3f, 0xcf, 0x1b, 0xec, 0xe7, 0x0d, 0x6a, 0x15, 0x6d, 0xf1,
-	0x0c, 0x60, 0x78, 0xf4, 0xc2, 0x09, 0xc4, 0x2e, 0x4a, 0xa0, 0xee, 0x3b, 0x53, 0x26, 0x1f, 0x56,
-	0x7e, 0x46, 0xa0, 0x6e, 0xd9, 0x42, 0xed, 0x79, 0xb7, 0x6c, 0x81, 0x75, 0xf2, 0xd6, 0x99, 0xcc,
-	0x99, 0x7c, 0x1c, 0xc4, 0x87, 0xdc, 0xfd, 0x93, 0xcb, 0x0a, 0xbb, 0x7f, 0x96, 0xf3, 0xa1, 0xdd,
-	0x5f, 0x3b, 0x95, 0xec, 0xfe, 0x83, 0x3f, 0x4d, 0x30, 0x4f, 0x67, 0x2f, 0xaf, 0xce, 0x9f, 0x07,
-	0x1e, 0xf9, 0x8e, 0x0f, 0xcb, 0xf4, 0x0f, 0x0a, 0xb2, 0xa7, 0xd5, 0xcb, 0x7f, 0x85, 0xf4, 0x77,
-	0xdf, 0x23, 0x11, 0x4c, 0x16, 0x74, 0x85, 0x9c, 0xc3, 0x6a, 0x7a, 0xc5, 0x27, 0x0f, 0x32, 0x1a,
-	0xf9, 0x5f, 0x04, 0xfd, 0xed, 0x65, 0x6c, 0x71, 0xdb, 0xd7, 0x60, 0xaa, 0xfd, 0x9b, 0xf4, 0x32,
-	0xa2, 0xe9, 0x35, 0xbd, 0xbf, 0x55, 0xc6, 0x12, 0x37, 0x9c, 0x00, 0xe8, 0xc5, 0x98, 0x64, 0xcd,
-	0x65, 0x77, 0xe8, 0x7e, 0xaf, 0x9c, 0x29, 0xee, 0xb9, 0xe0, 0xbf, 0x04, 0xf4, 0xfa, 0x48, 0x76,
-	0x73, 0xd2, 0xb9, 0x7d, 0xb3, 0xbf, 0xb3, 0x94, 0x2f, 0x2e, 0x1c, 0x42, 0x3b, 0xb5, 0x5e, 0x91,
-	0x9d, 0xf4, 0xcf, 0xb6, 0xfc, 0xbe, 0xd6, 0xef, 0x2f, 0xe1, 0x66, 0x62, 0x94, 0x37, 0xe5, 0x62,
-	0xcc, 0x5e, 0xd4, 0x2b, 0x67, 0x26, 0x2e, 0xa5, 0x56, 0x26, 0xb2, 0x53, 0x26, 0x1b, 0x95, 0xb8,
-	0x94, 0xdf, 0xb3, 0x34, 0x5c, 0xc9, 0xee, 0x91, 0x87, 0x2b, 0xbf, 0xac, 0xe4, 0xe1, 0xca, 0x2e,
-	0x2d, 0xa9, 0x18, 0xf9, 0xdb, 0x5e, 0x88, 0x31, 0xbd, 0x06, 0x14, 0x62, 0xd4, 0xeb, 0x40, 0x2a,
-	0x46, 0xf1, 0x9a, 0x16, 0x62, 0xcc, 0x3c, 0xbd, 0x85, 0x18, 0x53, 0x4f, 0x30, 0x5d, 0x91, 0x1d,
-	0x94, 0x6e, 0xcb, 0x5c, 0x07, 0x95, 0xf4, 0x72, 0xae, 0x83, 0x0a, 0x3d, 0x4d, 0x57, 0x88, 0xc3,
-	0x7f, 0x3b, 0xe7, 0x87, 0x22, 0xf9, 0x5f, 0x46, 0x71, 0xc9, 0x44, 0xed, 0xd3, 0x0f, 0x48, 0x09,
-	0x13, 0x2e, 0x1f, 0x28, 0x85, 0x39, 0x48, 0xfe, 0x5f, 0xa2, 0x5d, 0x1c, 0xa3, 0xfd, 0x47, 0x1f,
-	0x12, 0xe3, 0x56, 0x5e, 0x1b, 0xfc, 0x6f, 0x1f, 0x87, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xca,
-	0xeb, 0x22, 0x52, 0xdc, 0x13, 0x00, 0x00,
+func (m *MirrorSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorSession.Unmarshal(m, b)
+}
+func (m *MirrorSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorSession.Marshal(b, m, deterministic)
+}
+func (m *MirrorSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSession.Merge(m, src)
+}
+func (m *MirrorSession) XXX_Size() int {
+	return xxx_messageInfo_MirrorSession.Size(m)
+}
+func (m *MirrorSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSession.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MirrorSession proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MirrorSession) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-// GoNSLApiClient is the client API for GoNSLApi service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GoNSLApiClient interface {
-	GetFieldEntries(ctx context.Context, in *GetFieldEntriesRequest, opts ...grpc.CallOption) (*GetFieldEntriesReply, error)
+func (m *MirrorSession) GetType() MirrorSession_Type {
+	if m != nil {
+		return m.Type
+	}
+	return MirrorSession_SPAN
+}
+
+func (m *MirrorSession) GetDirection() MirrorSession_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return MirrorSession_BOTH
+}
+
+func (m *MirrorSession) GetSrcPorts() []uint32 {
+	if m != nil {
+		return m.SrcPorts
+	}
+	return nil
+}
+
+func (m *MirrorSession) GetDstPort() uint32 {
+	if m != nil {
+		return m.DstPort
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetSrcIp() string {
+	if m != nil {
+		return m.SrcIp
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetDstIp() string {
+	if m != nil {
+		return m.DstIp
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetSrcMac() string {
+	if m != nil {
+		return m.SrcMac
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetDstMac() string {
+	if m != nil {
+		return m.DstMac
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetTos() uint32 {
+	if m != nil {
+		return m.Tos
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetDestId() uint32 {
+	if m != nil {
+		return m.DestId
+	}
+	return 0
+}
+
+type AddMirrorSessionRequest struct {
+	Session              *MirrorSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddMirrorSessionRequest) Reset()         { *m = AddMirrorSessionRequest{} }
+func (m *AddMirrorSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMirrorSessionRequest) ProtoMessage()    {}
+func (*AddMirrorSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{51}
+}
+
+func (m *AddMirrorSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMirrorSessionRequest.Unmarshal(m, b)
+}
+func (m *AddMirrorSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMirrorSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *AddMirrorSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMirrorSessionRequest.Merge(m, src)
+}
+func (m *AddMirrorSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_AddMirrorSessionRequest.Size(m)
+}
+func (m *AddMirrorSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMirrorSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMirrorSessionRequest proto.InternalMessageInfo
+
+func (m *AddMirrorSessionRequest) GetSession() *MirrorSession {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+type AddMirrorSessionReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddMirrorSessionReply) Reset()         { *m = AddMirrorSessionReply{} }
+func (m *AddMirrorSessionReply) String() string { return proto.CompactTextString(m) }
+func (*AddMirrorSessionReply) ProtoMessage()    {}
+func (*AddMirrorSessionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{52}
+}
+
+func (m *AddMirrorSessionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMirrorSessionReply.Unmarshal(m, b)
+}
+func (m *AddMirrorSessionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMirrorSessionReply.Marshal(b, m, deterministic)
+}
+func (m *AddMirrorSessionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMirrorSessionReply.Merge(m, src)
+}
+func (m *AddMirrorSessionReply) XXX_Size() int {
+	return xxx_messageInfo_AddMirrorSessionReply.Size(m)
+}
+func (m *AddMirrorSessionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMirrorSessionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMirrorSessionReply proto.InternalMessageInfo
+
+type DelMirrorSessionRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelMirrorSessionRequest) Reset()         { *m = DelMirrorSessionRequest{} }
+func (m *DelMirrorSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*DelMirrorSessionRequest) ProtoMessage()    {}
+func (*DelMirrorSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{53}
+}
+
+func (m *DelMirrorSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelMirrorSessionRequest.Unmarshal(m, b)
+}
+func (m *DelMirrorSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelMirrorSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *DelMirrorSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelMirrorSessionRequest.Merge(m, src)
+}
+func (m *DelMirrorSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_DelMirrorSessionRequest.Size(m)
+}
+func (m *DelMirrorSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelMirrorSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelMirrorSessionRequest proto.InternalMessageInfo
+
+func (m *DelMirrorSessionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DelMirrorSessionReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelMirrorSessionReply) Reset()         { *m = DelMirrorSessionReply{} }
+func (m *DelMirrorSessionReply) String() string { return proto.CompactTextString(m) }
+func (*DelMirrorSessionReply) ProtoMessage()    {}
+func (*DelMirrorSessionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{54}
+}
+
+func (m *DelMirrorSessionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelMirrorSessionReply.Unmarshal(m, b)
+}
+func (m *DelMirrorSessionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelMirrorSessionReply.Marshal(b, m, deterministic)
+}
+func (m *DelMirrorSessionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelMirrorSessionReply.Merge(m, src)
+}
+func (m *DelMirrorSessionReply) XXX_Size() int {
+	return xxx_messageInfo_DelMirrorSessionReply.Size(m)
+}
+func (m *DelMirrorSessionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelMirrorSessionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelMirrorSessionReply proto.InternalMessageInfo
+
+type GetMirrorSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMirrorSessionsRequest) Reset()         { *m = GetMirrorSessionsRequest{} }
+func (m *GetMirrorSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMirrorSessionsRequest) ProtoMessage()    {}
+func (*GetMirrorSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{55}
+}
+
+func (m *GetMirrorSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMirrorSessionsRequest.Unmarshal(m, b)
+}
+func (m *GetMirrorSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMirrorSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMirrorSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMirrorSessionsRequest.Merge(m, src)
+}
+func (m *GetMirrorSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMirrorSessionsRequest.Size(m)
+}
+func (m *GetMirrorSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMirrorSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMirrorSessionsRequest proto.InternalMessageInfo
+
+type GetMirrorSessionsReply struct {
+	Sessions             []*MirrorSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetMirrorSessionsReply) Reset()         { *m = GetMirrorSessionsReply{} }
+func (m *GetMirrorSessionsReply) String() string { return proto.CompactTextString(m) }
+func (*GetMirrorSessionsReply) ProtoMessage()    {}
+func (*GetMirrorSessionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{56}
+}
+
+func (m *GetMirrorSessionsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMirrorSessionsReply.Unmarshal(m, b)
+}
+func (m *GetMirrorSessionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMirrorSessionsReply.Marshal(b, m, deterministic)
+}
+func (m *GetMirrorSessionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMirrorSessionsReply.Merge(m, src)
+}
+func (m *GetMirrorSessionsReply) XXX_Size() int {
+	return xxx_messageInfo_GetMirrorSessionsReply.Size(m)
+}
+func (m *GetMirrorSessionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMirrorSessionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMirrorSessionsReply proto.InternalMessageInfo
+
+func (m *GetMirrorSessionsReply) GetSessions() []*MirrorSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+//
+// CoPP
+//
+type CoppClassStats struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Queue                uint32   `protobuf:"varint,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Pps                  uint32   `protobuf:"varint,3,opt,name=pps,proto3" json:"pps,omitempty"`
+	Burst                uint32   `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	AcceptPackets        uint64   `protobuf:"varint,5,opt,name=accept_packets,json=acceptPackets,proto3" json:"accept_packets,omitempty"`
+	DropPackets          uint64   `protobuf:"varint,6,opt,name=drop_packets,json=dropPackets,proto3" json:"drop_packets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoppClassStats) Reset()         { *m = CoppClassStats{} }
+func (m *CoppClassStats) String() string { return proto.CompactTextString(m) }
+func (*CoppClassStats) ProtoMessage()    {}
+func (*CoppClassStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{57}
+}
+
+func (m *CoppClassStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoppClassStats.Unmarshal(m, b)
+}
+func (m *CoppClassStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoppClassStats.Marshal(b, m, deterministic)
+}
+func (m *CoppClassStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoppClassStats.Merge(m, src)
+}
+func (m *CoppClassStats) XXX_Size() int {
+	return xxx_messageInfo_CoppClassStats.Size(m)
+}
+func (m *CoppClassStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoppClassStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoppClassStats proto.InternalMessageInfo
+
+func (m *CoppClassStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CoppClassStats) GetQueue() uint32 {
+	if m != nil {
+		return m.Queue
+	}
+	return 0
+}
+
+func (m *CoppClassStats) GetPps() uint32 {
+	if m != nil {
+		return m.Pps
+	}
+	return 0
+}
+
+func (m *CoppClassStats) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+func (m *CoppClassStats) GetAcceptPackets() uint64 {
+	if m != nil {
+		return m.AcceptPackets
+	}
+	return 0
+}
+
+func (m *CoppClassStats) GetDropPackets() uint64 {
+	if m != nil {
+		return m.DropPackets
+	}
+	return 0
+}
+
+type GetCoppStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCoppStatsRequest) Reset()         { *m = GetCoppStatsRequest{} }
+func (m *GetCoppStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCoppStatsRequest) ProtoMessage()    {}
+func (*GetCoppStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{58}
+}
+
+func (m *GetCoppStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCoppStatsRequest.Unmarshal(m, b)
+}
+func (m *GetCoppStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCoppStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCoppStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCoppStatsRequest.Merge(m, src)
+}
+func (m *GetCoppStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCoppStatsRequest.Size(m)
+}
+func (m *GetCoppStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCoppStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCoppStatsRequest proto.InternalMessageInfo
+
+type GetCoppStatsReply struct {
+	Stats                []*CoppClassStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCoppStatsReply) Reset()         { *m = GetCoppStatsReply{} }
+func (m *GetCoppStatsReply) String() string { return proto.CompactTextString(m) }
+func (*GetCoppStatsReply) ProtoMessage()    {}
+func (*GetCoppStatsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{59}
+}
+
+func (m *GetCoppStatsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCoppStatsReply.Unmarshal(m, b)
+}
+func (m *GetCoppStatsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCoppStatsReply.Marshal(b, m, deterministic)
+}
+func (m *GetCoppStatsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCoppStatsReply.Merge(m, src)
+}
+func (m *GetCoppStatsReply) XXX_Size() int {
+	return xxx_messageInfo_GetCoppStatsReply.Size(m)
+}
+func (m *GetCoppStatsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCoppStatsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCoppStatsReply proto.InternalMessageInfo
+
+func (m *GetCoppStatsReply) GetStats() []*CoppClassStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+//
+// Trunk
+//
+type TrunkMember struct {
+	Port                 uint32   `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LinkUp               bool     `protobuf:"varint,3,opt,name=link_up,json=linkUp,proto3" json:"link_up,omitempty"`
+	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Collecting           bool     `protobuf:"varint,5,opt,name=collecting,proto3" json:"collecting,omitempty"`
+	Distributing         bool     `protobuf:"varint,6,opt,name=distributing,proto3" json:"distributing,omitempty"`
+	AggregatorId         uint32   `protobuf:"varint,7,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	InOctets             uint64   `protobuf:"varint,8,opt,name=in_octets,json=inOctets,proto3" json:"in_octets,omitempty"`
+	InUcastPackets       uint64   `protobuf:"varint,9,opt,name=in_ucast_packets,json=inUcastPackets,proto3" json:"in_ucast_packets,omitempty"`
+	OutOctets            uint64   `protobuf:"varint,10,opt,name=out_octets,json=outOctets,proto3" json:"out_octets,omitempty"`
+	OutUcastPackets      uint64   `protobuf:"varint,11,opt,name=out_ucast_packets,json=outUcastPackets,proto3" json:"out_ucast_packets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrunkMember) Reset()         { *m = TrunkMember{} }
+func (m *TrunkMember) String() string { return proto.CompactTextString(m) }
+func (*TrunkMember) ProtoMessage()    {}
+func (*TrunkMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{60}
+}
+
+func (m *TrunkMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrunkMember.Unmarshal(m, b)
+}
+func (m *TrunkMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrunkMember.Marshal(b, m, deterministic)
+}
+func (m *TrunkMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrunkMember.Merge(m, src)
+}
+func (m *TrunkMember) XXX_Size() int {
+	return xxx_messageInfo_TrunkMember.Size(m)
+}
+func (m *TrunkMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrunkMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrunkMember proto.InternalMessageInfo
+
+func (m *TrunkMember) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *TrunkMember) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TrunkMember) GetLinkUp() bool {
+	if m != nil {
+		return m.LinkUp
+	}
+	return false
+}
+
+func (m *TrunkMember) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *TrunkMember) GetCollecting() bool {
+	if m != nil {
+		return m.Collecting
+	}
+	return false
+}
+
+func (m *TrunkMember) GetDistributing() bool {
+	if m != nil {
+		return m.Distributing
+	}
+	return false
+}
+
+func (m *TrunkMember) GetAggregatorId() uint32 {
+	if m != nil {
+		return m.AggregatorId
+	}
+	return 0
+}
+
+func (m *TrunkMember) GetInOctets() uint64 {
+	if m != nil {
+		return m.InOctets
+	}
+	return 0
+}
+
+func (m *TrunkMember) GetInUcastPackets() uint64 {
+	if m != nil {
+		return m.InUcastPackets
+	}
+	return 0
+}
+
+func (m *TrunkMember) GetOutOctets() uint64 {
+	if m != nil {
+		return m.OutOctets
+	}
+	return 0
+}
+
+func (m *TrunkMember) GetOutUcastPackets() uint64 {
+	if m != nil {
+		return m.OutUcastPackets
+	}
+	return 0
+}
+
+type Trunk struct {
+	TrunkId              uint32         `protobuf:"varint,1,opt,name=trunk_id,json=trunkId,proto3" json:"trunk_id,omitempty"`
+	PortId               uint32         `protobuf:"varint,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	VlanVid              uint32         `protobuf:"varint,3,opt,name=vlan_vid,json=vlanVid,proto3" json:"vlan_vid,omitempty"`
+	Mode                 string         `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	HashPolicy           string         `protobuf:"bytes,5,opt,name=hash_policy,json=hashPolicy,proto3" json:"hash_policy,omitempty"`
+	Psc                  string         `protobuf:"bytes,6,opt,name=psc,proto3" json:"psc,omitempty"`
+	MinLinks             uint32         `protobuf:"varint,7,opt,name=min_links,json=minLinks,proto3" json:"min_links,omitempty"`
+	AggregatorId         uint32         `protobuf:"varint,8,opt,name=aggregator_id,json=aggregatorId,proto3" json:"aggregator_id,omitempty"`
+	Members              []*TrunkMember `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Trunk) Reset()         { *m = Trunk{} }
+func (m *Trunk) String() string { return proto.CompactTextString(m) }
+func (*Trunk) ProtoMessage()    {}
+func (*Trunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{61}
+}
+
+func (m *Trunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Trunk.Unmarshal(m, b)
+}
+func (m *Trunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Trunk.Marshal(b, m, deterministic)
+}
+func (m *Trunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trunk.Merge(m, src)
+}
+func (m *Trunk) XXX_Size() int {
+	return xxx_messageInfo_Trunk.Size(m)
+}
+func (m *Trunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trunk proto.InternalMessageInfo
+
+func (m *Trunk) GetTrunkId() uint32 {
+	if m != nil {
+		return m.TrunkId
+	}
+	return 0
+}
+
+func (m *Trunk) GetPortId() uint32 {
+	if m != nil {
+		return m.PortId
+	}
+	return 0
+}
+
+func (m *Trunk) GetVlanVid() uint32 {
+	if m != nil {
+		return m.VlanVid
+	}
+	return 0
+}
+
+func (m *Trunk) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *Trunk) GetHashPolicy() string {
+	if m != nil {
+		return m.HashPolicy
+	}
+	return ""
+}
+
+func (m *Trunk) GetPsc() string {
+	if m != nil {
+		return m.Psc
+	}
+	return ""
+}
+
+func (m *Trunk) GetMinLinks() uint32 {
+	if m != nil {
+		return m.MinLinks
+	}
+	return 0
+}
+
+func (m *Trunk) GetAggregatorId() uint32 {
+	if m != nil {
+		return m.AggregatorId
+	}
+	return 0
+}
+
+func (m *Trunk) GetMembers() []*TrunkMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type GetTrunksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTrunksRequest) Reset()         { *m = GetTrunksRequest{} }
+func (m *GetTrunksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrunksRequest) ProtoMessage()    {}
+func (*GetTrunksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{62}
+}
+
+func (m *GetTrunksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrunksRequest.Unmarshal(m, b)
+}
+func (m *GetTrunksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrunksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTrunksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrunksRequest.Merge(m, src)
+}
+func (m *GetTrunksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTrunksRequest.Size(m)
+}
+func (m *GetTrunksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrunksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrunksRequest proto.InternalMessageInfo
+
+type GetTrunksReply struct {
+	Trunks               []*Trunk `protobuf:"bytes,1,rep,name=trunks,proto3" json:"trunks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTrunksReply) Reset()         { *m = GetTrunksReply{} }
+func (m *GetTrunksReply) String() string { return proto.CompactTextString(m) }
+func (*GetTrunksReply) ProtoMessage()    {}
+func (*GetTrunksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6347f14b9114d11, []int{63}
+}
+
+func (m *GetTrunksReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrunksReply.Unmarshal(m, b)
+}
+func (m *GetTrunksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrunksReply.Marshal(b, m, deterministic)
+}
+func (m *GetTrunksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrunksReply.Merge(m, src)
+}
+func (m *GetTrunksReply) XXX_Size() int {
+	return xxx_messageInfo_GetTrunksReply.Size(m)
+}
+func (m *GetTrunksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrunksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrunksReply proto.InternalMessageInfo
+
+func (m *GetTrunksReply) GetTrunks() []*Trunk {
+	if m != nil {
+		return m.Trunks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gonslapi.FieldEntry_EntryType", FieldEntry_EntryType_name, FieldEntry_EntryType_value)
+	proto.RegisterEnum("gonslapi.MirrorSession_Type", MirrorSession_Type_name, MirrorSession_Type_value)
+	proto.RegisterEnum("gonslapi.MirrorSession_Direction", MirrorSession_Direction_name, MirrorSession_Direction_value)
+	proto.RegisterType((*FieldEntry)(nil), "gonslapi.FieldEntry")
+	proto.RegisterType((*EthDstFieldEntry)(nil), "gonslapi.EthDstFieldEntry")
+	proto.RegisterType((*EthTypeFieldEntry)(nil), "gonslapi.EthTypeFieldEntry")
+	proto.RegisterType((*DstIpFieldEntry)(nil), "gonslapi.DstIpFieldEntry")
+	proto.RegisterType((*IpProtoFieldEntry)(nil), "gonslapi.IpProtoFieldEntry")
+	proto.RegisterType((*GetFieldEntriesRequest)(nil), "gonslapi.GetFieldEntriesRequest")
+	proto.RegisterType((*GetFieldEntriesReply)(nil), "gonslapi.GetFieldEntriesReply")
+	proto.RegisterType((*PortInfo)(nil), "gonslapi.PortInfo")
+	proto.RegisterType((*GetPortInfosRequest)(nil), "gonslapi.GetPortInfosRequest")
+	proto.RegisterType((*GetPortInfosReply)(nil), "gonslapi.GetPortInfosReply")
+	proto.RegisterType((*VlanEntry)(nil), "gonslapi.VlanEntry")
+	proto.RegisterType((*GetVlansRequest)(nil), "gonslapi.GetVlansRequest")
+	proto.RegisterType((*GetVlansReply)(nil), "gonslapi.GetVlansReply")
+	proto.RegisterType((*L2Addr)(nil), "gonslapi.L2Addr")
+	proto.RegisterType((*GetL2AddrsRequest)(nil), "gonslapi.GetL2AddrsRequest")
+	proto.RegisterType((*GetL2AddrsReply)(nil), "gonslapi.GetL2AddrsReply")
+	proto.RegisterType((*L2Station)(nil), "gonslapi.L2Station")
+	proto.RegisterType((*GetL2StationsRequest)(nil), "gonslapi.GetL2StationsRequest")
+	proto.RegisterType((*GetL2StationsReply)(nil), "gonslapi.GetL2StationsReply")
+	proto.RegisterType((*L3Iface)(nil), "gonslapi.L3Iface")
+	proto.RegisterType((*FindL3IfaceRequest)(nil), "gonslapi.FindL3IfaceRequest")
+	proto.RegisterType((*FindL3IfaceReply)(nil), "gonslapi.FindL3IfaceReply")
+	proto.RegisterType((*GetL3IfaceRequest)(nil), "gonslapi.GetL3IfaceRequest")
+	proto.RegisterType((*GetL3IfaceReply)(nil), "gonslapi.GetL3IfaceReply")
+	proto.RegisterType((*GetL3IfacesRequest)(nil), "gonslapi.GetL3IfacesRequest")
+	proto.RegisterType((*GetL3IfacesReply)(nil), "gonslapi.GetL3IfacesReply")
+	proto.RegisterType((*L3Egress)(nil), "gonslapi.L3Egress")
+	proto.RegisterType((*GetL3EgressesRequest)(nil), "gonslapi.GetL3EgressesRequest")
+	proto.RegisterType((*GetL3EgressesReply)(nil), "gonslapi.GetL3EgressesReply")
+	proto.RegisterType((*L3Host)(nil), "gonslapi.L3Host")
+	proto.RegisterType((*GetL3HostsRequest)(nil), "gonslapi.GetL3HostsRequest")
+	proto.RegisterType((*GetL3HostsReply)(nil), "gonslapi.GetL3HostsReply")
+	proto.RegisterType((*L3Route)(nil), "gonslapi.L3Route")
+	proto.RegisterType((*GetL3RoutesRequest)(nil), "gonslapi.GetL3RoutesRequest")
+	proto.RegisterType((*GetL3RoutesReply)(nil), "gonslapi.GetL3RoutesReply")
+	proto.RegisterType((*TunnelInitiator)(nil), "gonslapi.TunnelInitiator")
+	proto.RegisterType((*TunnelTerminator)(nil), "gonslapi.TunnelTerminator")
+	proto.RegisterType((*GetTunnelInitiatorsRequest)(nil), "gonslapi.GetTunnelInitiatorsRequest")
+	proto.RegisterType((*GetTunnelInitiatorsReply)(nil), "gonslapi.GetTunnelInitiatorsReply")
+	proto.RegisterType((*GetTunnelTerminatorsRequest)(nil), "gonslapi.GetTunnelTerminatorsRequest")
+	proto.RegisterType((*GetTunnelTerminatorsReply)(nil), "gonslapi.GetTunnelTerminatorsReply")
+	proto.RegisterType((*MPLSTunnelInitiator)(nil), "gonslapi.MPLSTunnelInitiator")
+	proto.RegisterType((*MPLSTunnelSwitch)(nil), "gonslapi.MPLSTunnelSwitch")
+	proto.RegisterType((*GetMPLSTunnelInitiatorsRequest)(nil), "gonslapi.GetMPLSTunnelInitiatorsRequest")
+	proto.RegisterType((*GetMPLSTunnelInitiatorsReply)(nil), "gonslapi.GetMPLSTunnelInitiatorsReply")
+	proto.RegisterType((*GetMPLSTunnelSwitchesRequest)(nil), "gonslapi.GetMPLSTunnelSwitchesRequest")
+	proto.RegisterType((*GetMPLSTunnelSwitchesReply)(nil), "gonslapi.GetMPLSTunnelSwitchesReply")
+	proto.RegisterType((*IDMapEntry)(nil), "gonslapi.IDMapEntry")
+	proto.RegisterType((*GetIDMapEntriesRequest)(nil), "gonslapi.GetIDMapEntriesRequest")
+	proto.RegisterType((*GetIDMapEntriesReply)(nil), "gonslapi.GetIDMapEntriesReply")
+	proto.RegisterType((*MirrorSession)(nil), "gonslapi.MirrorSession")
+	proto.RegisterType((*AddMirrorSessionRequest)(nil), "gonslapi.AddMirrorSessionRequest")
+	proto.RegisterType((*AddMirrorSessionReply)(nil), "gonslapi.AddMirrorSessionReply")
+	proto.RegisterType((*DelMirrorSessionRequest)(nil), "gonslapi.DelMirrorSessionRequest")
+	proto.RegisterType((*DelMirrorSessionReply)(nil), "gonslapi.DelMirrorSessionReply")
+	proto.RegisterType((*GetMirrorSessionsRequest)(nil), "gonslapi.GetMirrorSessionsRequest")
+	proto.RegisterType((*GetMirrorSessionsReply)(nil), "gonslapi.GetMirrorSessionsReply")
+	proto.RegisterType((*CoppClassStats)(nil), "gonslapi.CoppClassStats")
+	proto.RegisterType((*GetCoppStatsRequest)(nil), "gonslapi.GetCoppStatsRequest")
+	proto.RegisterType((*GetCoppStatsReply)(nil), "gonslapi.GetCoppStatsReply")
+	proto.RegisterType((*TrunkMember)(nil), "gonslapi.TrunkMember")
+	proto.RegisterType((*Trunk)(nil), "gonslapi.Trunk")
+	proto.RegisterType((*GetTrunksRequest)(nil), "gonslapi.GetTrunksRequest")
+	proto.RegisterType((*GetTrunksReply)(nil), "gonslapi.GetTrunksReply")
+}
+
+func init() { proto.RegisterFile("gonslapi.proto", fileDescriptor_a6347f14b9114d11) }
+
+var fileDescriptor_a6347f14b9114d11 = []byte{
+	// 2548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0xdd, 0x72, 0xdc, 0x48,
+	0xd5, 0x19, 0x7b, 0x46, 0xa3, 0x39, 0xe3, 0x9f, 0x71, 0xc7, 0x8e, 0xc7, 0x8a, 0xe3, 0x78, 0xb5,
+	0x3f, 0x9f, 0x3f, 0xaa, 0xf0, 0xb2, 0x36, 0x84, 0x65, 0xa9, 0x2d, 0xc8, 0xc6, 0x8e, 0x33, 0x55,
+	0x76, 0x3c, 0xc8, 0x93, 0x00, 0x05, 0x55, 0x2a, 0x79, 0x24, 0xdb, 0x2a, 0xcf, 0x48, 0x5a, 0x49,
+	0x93, 0x94, 0x6f, 0x79, 0x02, 0xaa, 0x78, 0x06, 0xee, 0xe0, 0x0d, 0xb8, 0xe7, 0x06, 0xae, 0x78,
+	0x08, 0x78, 0x0a, 0x8a, 0x3a, 0xa7, 0xbb, 0x25, 0xb5, 0x46, 0x72, 0xe0, 0x02, 0x6e, 0x6c, 0xf5,
+	0xf9, 0xeb, 0xf3, 0xd7, 0xa7, 0x4f, 0x9f, 0x81, 0x95, 0xeb, 0x30, 0x48, 0x26, 0x4e, 0xe4, 0xef,
+	0x47, 0x71, 0x98, 0x86, 0x4c, 0x97, 0x6b, 0xf3, 0x9f, 0x0b, 0x00, 0x2f, 0x7d, 0x6f, 0xe2, 0x1e,
+	0x07, 0x69, 0x7c, 0xc7, 0xbe, 0x06, 0xf0, 0xf0, 0xc3, 0x4e, 0xef, 0x22, 0xaf, 0xdf, 0xd8, 0x6d,
+	0xec, 0xad, 0x1c, 0xec, 0xec, 0x67, 0xdc, 0x39, 0xe5, 0x3e, 0xfd, 0x1d, 0xdd, 0x45, 0x9e, 0xd5,
+	0xf1, 0xe4, 0x27, 0xfb, 0x12, 0x74, 0x2f, 0xbd, 0xe1, 0xcc, 0x0b, 0xbb, 0x8d, 0xbd, 0xee, 0xc1,
+	0xe3, 0x9c, 0xf9, 0x38, 0xbd, 0x41, 0xa2, 0x5c, 0xc6, 0xab, 0x07, 0x56, 0xdb, 0xe3, 0x40, 0x76,
+	0x00, 0x9a, 0x9b, 0xa4, 0xb6, 0x1f, 0xf5, 0x17, 0x89, 0x6f, 0x2b, 0xe7, 0x3b, 0x4a, 0xd2, 0x41,
+	0xa4, 0x70, 0xb5, 0x5c, 0x04, 0xe1, 0x6e, 0x7e, 0x64, 0x93, 0x45, 0xfd, 0x66, 0x79, 0xb7, 0x41,
+	0x34, 0x44, 0x84, 0xba, 0x9b, 0xcf, 0x81, 0xec, 0x07, 0x80, 0x1b, 0xdb, 0x6e, 0x92, 0xf6, 0x5b,
+	0xc4, 0x68, 0x28, 0x6a, 0x1e, 0x25, 0xa9, 0xc2, 0xa7, 0x79, 0x04, 0x33, 0x07, 0xd0, 0xc9, 0xcc,
+	0x66, 0x6d, 0x58, 0x7c, 0x7d, 0x3e, 0xec, 0x3d, 0x60, 0x4b, 0xa0, 0x1f, 0x8f, 0x5e, 0xd9, 0xa3,
+	0x5f, 0x0e, 0x8f, 0x7b, 0x0d, 0x06, 0xa0, 0x1d, 0x5d, 0x8c, 0xec, 0xc1, 0xb0, 0xb7, 0x80, 0x98,
+	0xc1, 0xd0, 0x1e, 0x5a, 0xe7, 0xa3, 0xf3, 0xde, 0x22, 0xeb, 0x42, 0x1b, 0xe9, 0x8e, 0x2e, 0x46,
+	0xbd, 0xe6, 0x37, 0x6d, 0x68, 0x91, 0xdb, 0x4c, 0x1b, 0x7a, 0xe5, 0x1d, 0xd9, 0x66, 0xae, 0x1e,
+	0x86, 0xa0, 0x23, 0x15, 0x60, 0x5b, 0xdc, 0xbf, 0x53, 0x27, 0xb9, 0x25, 0xff, 0x76, 0xc8, 0x81,
+	0x67, 0x4e, 0x72, 0x8b, 0x3c, 0x7e, 0x60, 0x47, 0x61, 0x9c, 0x92, 0x07, 0x97, 0x2d, 0xcd, 0x0f,
+	0x86, 0x61, 0x9c, 0x9a, 0x27, 0xb0, 0x36, 0xe7, 0x79, 0xb6, 0x55, 0x08, 0x54, 0x83, 0xc8, 0xb3,
+	0x48, 0x14, 0x04, 0x2d, 0x28, 0x82, 0x7e, 0x0d, 0xab, 0xa5, 0x50, 0xdc, 0x27, 0x66, 0x03, 0x34,
+	0x3f, 0x22, 0x13, 0xb8, 0xa2, 0x2d, 0x3f, 0x42, 0x0b, 0x6a, 0xd5, 0xbc, 0x84, 0xb5, 0xb9, 0x90,
+	0xdd, 0x27, 0x7f, 0xab, 0x10, 0x7c, 0xae, 0x67, 0x16, 0xdd, 0xda, 0x3d, 0xfa, 0xf0, 0xe8, 0xc4,
+	0xcb, 0x1d, 0xed, 0x7b, 0x89, 0xe5, 0x7d, 0x3b, 0xf3, 0x92, 0xd4, 0x7c, 0x09, 0xeb, 0x73, 0x98,
+	0x68, 0x72, 0xc7, 0xf6, 0xa1, 0xed, 0xf1, 0x75, 0xbf, 0xb1, 0xbb, 0xb8, 0xd7, 0x3d, 0x58, 0xaf,
+	0x3a, 0x0c, 0x96, 0x24, 0x32, 0x5d, 0xd0, 0x71, 0xa7, 0x41, 0x70, 0x15, 0x32, 0x06, 0x4d, 0xd2,
+	0x81, 0x2b, 0x4e, 0xdf, 0xec, 0x29, 0x74, 0x27, 0x7e, 0x70, 0x6b, 0x27, 0xa9, 0x93, 0xce, 0x12,
+	0x52, 0xbc, 0x65, 0x01, 0x82, 0x2e, 0x08, 0xc2, 0x3e, 0x86, 0xe5, 0x59, 0x90, 0x3a, 0xd7, 0xd7,
+	0x9e, 0x6b, 0xbf, 0x9b, 0x38, 0x81, 0xb0, 0x60, 0x49, 0x02, 0xdf, 0x4e, 0x9c, 0xc0, 0xdc, 0x80,
+	0x87, 0x27, 0x5e, 0x2a, 0x37, 0x2a, 0x18, 0xb1, 0xa6, 0x82, 0xd1, 0x82, 0x2f, 0x00, 0x70, 0x67,
+	0xdb, 0x47, 0x90, 0x30, 0x82, 0xe5, 0x46, 0x48, 0x6a, 0xab, 0x13, 0x49, 0x3e, 0x73, 0x04, 0x1d,
+	0xdc, 0x86, 0x87, 0xa0, 0x07, 0x8b, 0xef, 0x7c, 0x57, 0x18, 0x81, 0x9f, 0x6c, 0x1d, 0x5a, 0x48,
+	0x8b, 0xda, 0x2f, 0xee, 0x2d, 0x5b, 0x7c, 0x81, 0x96, 0x91, 0x8e, 0x36, 0xc7, 0x2d, 0x12, 0x0e,
+	0x08, 0x84, 0x7b, 0x24, 0xe6, 0x1a, 0xac, 0x9e, 0x78, 0x29, 0x0a, 0xce, 0x14, 0xfe, 0x0a, 0x96,
+	0x73, 0x10, 0x2a, 0xfb, 0xff, 0xd0, 0x42, 0xa3, 0xa5, 0x9e, 0x0f, 0x73, 0x3d, 0x33, 0x85, 0x2c,
+	0x4e, 0x61, 0xbe, 0x05, 0xed, 0xf4, 0xe0, 0xb9, 0xeb, 0xc6, 0xa8, 0xcf, 0xd5, 0xc4, 0xb9, 0x4e,
+	0x84, 0x8e, 0x7c, 0x81, 0x7a, 0x4f, 0x9d, 0xb1, 0x48, 0x3e, 0xfc, 0x94, 0x96, 0x2c, 0xe6, 0x96,
+	0xc8, 0x08, 0x35, 0xf3, 0x08, 0x99, 0x0f, 0xc9, 0x89, 0x5c, 0x74, 0xa6, 0xe8, 0x8f, 0x60, 0xb5,
+	0x08, 0x44, 0x55, 0x3f, 0x83, 0x96, 0x83, 0x2b, 0xa1, 0x6a, 0x2f, 0x57, 0x95, 0x93, 0x59, 0x1c,
+	0x6d, 0xfe, 0xa5, 0x01, 0x9d, 0xd3, 0x03, 0x8c, 0xae, 0x1f, 0x06, 0x35, 0xba, 0x6e, 0x42, 0x1b,
+	0x8b, 0x5f, 0xae, 0x2f, 0xd6, 0xc2, 0x33, 0x67, 0xcc, 0x76, 0x61, 0x49, 0x20, 0xf8, 0x99, 0x5f,
+	0x24, 0x2c, 0x70, 0x2c, 0x1d, 0x7b, 0x06, 0x4d, 0x4a, 0x13, 0x61, 0x02, 0x7e, 0xb3, 0xc7, 0xd0,
+	0xc1, 0xff, 0x9c, 0xa5, 0x45, 0x08, 0x1d, 0x01, 0xc4, 0xb0, 0x05, 0x7a, 0x12, 0x8f, 0xf9, 0xe9,
+	0xd0, 0xf8, 0xb9, 0x49, 0xe2, 0x31, 0x86, 0x88, 0x99, 0xb0, 0x2c, 0x51, 0x9c, 0xb7, 0x4d, 0xf8,
+	0xae, 0xc0, 0x23, 0xbb, 0xf9, 0x88, 0x0e, 0x4a, 0x66, 0x50, 0xe6, 0xa1, 0x63, 0x60, 0x25, 0x38,
+	0x3a, 0xe9, 0x73, 0xd0, 0x13, 0x01, 0x98, 0x0f, 0x69, 0x46, 0x6c, 0x65, 0x44, 0xe6, 0x1f, 0x1b,
+	0xd0, 0x3e, 0x3d, 0x1c, 0x5c, 0x39, 0x63, 0xaf, 0xc6, 0x57, 0x78, 0xee, 0x11, 0x6d, 0xfb, 0x6e,
+	0x76, 0xee, 0x71, 0x3d, 0x70, 0x65, 0xc8, 0x17, 0x95, 0x90, 0x4f, 0xd3, 0x99, 0x70, 0x0e, 0x7e,
+	0xa2, 0xab, 0xa7, 0xe9, 0xcc, 0xbe, 0x7a, 0xef, 0x0a, 0xcf, 0x68, 0xd3, 0x74, 0xf6, 0xf2, 0x3d,
+	0x31, 0xa7, 0xe9, 0x44, 0xb8, 0x04, 0x3f, 0x65, 0xbe, 0xb4, 0xf3, 0x7c, 0x41, 0x48, 0x7c, 0xd5,
+	0xd7, 0x05, 0x24, 0xbe, 0x32, 0xbf, 0x04, 0xf6, 0xd2, 0x0f, 0x5c, 0xa1, 0xb2, 0x70, 0x86, 0x54,
+	0xa4, 0x31, 0x97, 0x7b, 0x0b, 0x99, 0x2c, 0xf3, 0xc7, 0xd0, 0x53, 0x38, 0xd1, 0x5d, 0xff, 0x07,
+	0x2d, 0xb2, 0x85, 0x38, 0xbb, 0x07, 0x6b, 0x05, 0x5f, 0x09, 0x32, 0x8e, 0x37, 0xf7, 0x79, 0x92,
+	0xaa, 0xbb, 0x16, 0x3d, 0xd3, 0x50, 0x3c, 0x63, 0x7e, 0x05, 0xab, 0x45, 0xfa, 0xff, 0x68, 0xaf,
+	0x75, 0x1e, 0x59, 0x0e, 0xcc, 0xe2, 0xfd, 0x35, 0xf4, 0x14, 0x28, 0x3f, 0xbd, 0x1a, 0xb1, 0xc8,
+	0x58, 0x57, 0xc8, 0x14, 0x04, 0xe6, 0x9f, 0x1b, 0xa0, 0x9f, 0x1e, 0x1e, 0x5f, 0xc7, 0x5e, 0x92,
+	0xd4, 0x04, 0xfa, 0x11, 0x68, 0xf4, 0x71, 0x20, 0xaf, 0x21, 0xbe, 0xc2, 0xec, 0xf6, 0x88, 0xcf,
+	0xce, 0x0e, 0xb3, 0xce, 0x01, 0x03, 0x57, 0xf1, 0x41, 0xb3, 0x32, 0x3b, 0x5a, 0x73, 0x41, 0xd1,
+	0xe6, 0x0b, 0x42, 0xbb, 0x50, 0xb2, 0x9f, 0x00, 0x4c, 0xa3, 0x49, 0x62, 0x4f, 0x9c, 0x4b, 0x6f,
+	0x22, 0x62, 0xdf, 0x41, 0xc8, 0x29, 0x02, 0xe4, 0x81, 0x10, 0xb6, 0xe4, 0x0e, 0x3a, 0x02, 0x56,
+	0x82, 0xf3, 0xfb, 0x44, 0xe8, 0xea, 0x55, 0xd4, 0x62, 0x49, 0x6c, 0x65, 0x34, 0xe6, 0xef, 0x1a,
+	0xa0, 0x9d, 0x1e, 0xbe, 0x0a, 0x93, 0xb4, 0xc6, 0x4b, 0x8a, 0x37, 0x16, 0x4a, 0xde, 0xc0, 0x8b,
+	0x30, 0xb2, 0xb1, 0x0e, 0x89, 0x43, 0xa1, 0xf9, 0x11, 0x95, 0x4c, 0xba, 0x3c, 0x9f, 0x71, 0x4c,
+	0x93, 0x30, 0x6d, 0x3f, 0x7a, 0x46, 0xa8, 0x6a, 0x37, 0xc5, 0x57, 0x99, 0x9b, 0xe2, 0x2b, 0x59,
+	0x23, 0x49, 0xaf, 0x72, 0x8d, 0x94, 0x40, 0x51, 0x23, 0x6f, 0x70, 0x55, 0x51, 0x23, 0x89, 0xcc,
+	0xe2, 0x68, 0xf3, 0x37, 0x74, 0xea, 0xad, 0x70, 0x96, 0x7a, 0xff, 0x43, 0x33, 0xd1, 0xa8, 0x56,
+	0x6e, 0x94, 0xcc, 0x73, 0x52, 0x63, 0x2e, 0xcf, 0x25, 0x54, 0xe4, 0x79, 0x4c, 0xcb, 0xaa, 0x3c,
+	0x27, 0x42, 0x4b, 0x10, 0x98, 0x7f, 0x5b, 0x80, 0xd5, 0xd1, 0x2c, 0x08, 0xbc, 0xc9, 0x20, 0xf0,
+	0x53, 0xdf, 0x49, 0xc3, 0xb8, 0xde, 0xc2, 0x94, 0x08, 0x0b, 0x16, 0x72, 0xc0, 0xc0, 0xc5, 0xcb,
+	0x55, 0x20, 0xa9, 0x15, 0x12, 0xd7, 0x00, 0x07, 0x51, 0x37, 0xb4, 0x03, 0xdd, 0xc9, 0xa1, 0x5d,
+	0x4a, 0xfd, 0xce, 0x84, 0x9f, 0x3a, 0xee, 0x22, 0x79, 0xc3, 0xb4, 0x94, 0x1b, 0x66, 0x13, 0xb0,
+	0xfc, 0x13, 0x42, 0xe3, 0x88, 0x24, 0x1e, 0x23, 0x62, 0x23, 0x6b, 0xc8, 0xdb, 0x04, 0x17, 0x3d,
+	0xf7, 0x06, 0x20, 0x01, 0x82, 0x75, 0x0e, 0x4e, 0xe2, 0xf1, 0x20, 0x42, 0x4f, 0x23, 0x35, 0x1d,
+	0x9e, 0x0e, 0x3f, 0x77, 0x6e, 0x42, 0xad, 0x88, 0x72, 0xe1, 0x80, 0x7a, 0xe1, 0x88, 0x9a, 0xdb,
+	0x55, 0x6a, 0x2e, 0x16, 0xec, 0xa5, 0xbc, 0x60, 0xcb, 0x0b, 0x6e, 0x39, 0xbf, 0xe0, 0xcc, 0xdf,
+	0x2f, 0x40, 0x8f, 0x7b, 0x75, 0xe4, 0xc5, 0x53, 0x3f, 0xf8, 0xaf, 0xb9, 0xf5, 0x29, 0x74, 0x63,
+	0x6f, 0x1a, 0xa6, 0x9e, 0x5d, 0xe8, 0x13, 0x80, 0x83, 0xc8, 0x82, 0xdc, 0x4b, 0xad, 0x6a, 0x2f,
+	0x69, 0x75, 0x5e, 0x6a, 0xd7, 0x7b, 0x49, 0x57, 0xbd, 0x24, 0x3d, 0xd0, 0x29, 0x5c, 0xf1, 0x22,
+	0x7d, 0x21, 0x4b, 0x5f, 0xa4, 0x8a, 0x2e, 0xa7, 0x51, 0xbf, 0x4b, 0x8d, 0x17, 0x7d, 0x9b, 0xdb,
+	0x60, 0x9c, 0x78, 0x69, 0x29, 0xff, 0xb2, 0xd4, 0x3e, 0x87, 0x7e, 0x25, 0x16, 0x53, 0xfc, 0x10,
+	0xda, 0xdc, 0x0d, 0x32, 0xc7, 0x0b, 0xef, 0xb1, 0x12, 0x87, 0x25, 0x29, 0xcd, 0x27, 0xf0, 0x38,
+	0x13, 0x98, 0x07, 0x26, 0xdb, 0xef, 0x67, 0xb0, 0x55, 0x8d, 0xc6, 0x0d, 0xbf, 0x5f, 0xde, 0xd0,
+	0x28, 0x6f, 0x98, 0xb3, 0xe4, 0x3b, 0x9e, 0xc1, 0xc3, 0xb3, 0xe1, 0xe9, 0x45, 0xf9, 0x84, 0x95,
+	0x4e, 0x43, 0xa3, 0x7c, 0x1a, 0x1e, 0x81, 0x46, 0xd5, 0x5c, 0xb6, 0xb0, 0x62, 0x65, 0xfe, 0xa9,
+	0x01, 0xbd, 0x5c, 0xde, 0xc5, 0x7b, 0x3f, 0x1d, 0xdf, 0xd4, 0xe4, 0xd5, 0x3a, 0xb4, 0xf8, 0x85,
+	0xc0, 0x73, 0x8a, 0x2f, 0x50, 0xb0, 0x33, 0xc6, 0x4e, 0x46, 0x16, 0x22, 0xbe, 0xaa, 0x6a, 0x34,
+	0xe7, 0x2b, 0x90, 0x5a, 0xe4, 0xb4, 0x52, 0x91, 0xfb, 0x08, 0x96, 0x04, 0x92, 0xef, 0x2b, 0x7a,
+	0x33, 0x0e, 0xe3, 0x57, 0xd1, 0x2e, 0xec, 0x9c, 0x78, 0x69, 0x85, 0x43, 0xb2, 0x10, 0xfc, 0x1c,
+	0xb6, 0x6b, 0x29, 0x30, 0x0a, 0x3f, 0x2c, 0x47, 0xe1, 0x49, 0x1e, 0x85, 0x0a, 0xae, 0x3c, 0x10,
+	0x3b, 0x25, 0xc1, 0xdc, 0x77, 0x79, 0x19, 0x1d, 0x81, 0x51, 0x83, 0xc7, 0x6d, 0x9f, 0x81, 0x9e,
+	0x08, 0xc0, 0x7c, 0xf4, 0xcb, 0x4c, 0x56, 0x46, 0x6b, 0xbe, 0x02, 0x18, 0x1c, 0x9d, 0x39, 0x11,
+	0x7f, 0xa9, 0x30, 0x68, 0x06, 0xce, 0xd4, 0x13, 0x6d, 0x17, 0x7d, 0xa3, 0x93, 0x6f, 0xbd, 0x3b,
+	0xf9, 0x0a, 0xb8, 0xf5, 0xee, 0x30, 0x70, 0xef, 0x9c, 0xc9, 0xcc, 0x13, 0xad, 0x03, 0x5f, 0x88,
+	0x97, 0x61, 0x26, 0x6c, 0xee, 0x65, 0xa8, 0x62, 0x3e, 0xf4, 0x32, 0xcc, 0x95, 0xca, 0x5f, 0x86,
+	0x7f, 0x5d, 0x84, 0xe5, 0x33, 0x3f, 0x8e, 0xc3, 0xf8, 0xc2, 0x4b, 0x12, 0x91, 0x14, 0x73, 0xfa,
+	0x7e, 0x0f, 0x9a, 0xd9, 0xf0, 0x64, 0xe5, 0x60, 0xbb, 0xe0, 0x85, 0x22, 0xeb, 0x3e, 0xcd, 0x5d,
+	0x88, 0x92, 0xfd, 0x04, 0x3a, 0xae, 0x1f, 0x7b, 0x79, 0xd6, 0xad, 0x1c, 0x7c, 0x54, 0xc7, 0x76,
+	0x24, 0x09, 0xad, 0x9c, 0x07, 0xb3, 0x4e, 0x56, 0x9e, 0xa4, 0xdf, 0xa4, 0xf3, 0xa0, 0x8b, 0xd2,
+	0x93, 0x28, 0x15, 0xab, 0xa5, 0x56, 0xac, 0x9a, 0x1a, 0x57, 0x73, 0x6f, 0x14, 0xee, 0x19, 0x5d,
+	0xb9, 0x67, 0x0a, 0x37, 0x53, 0x47, 0xb9, 0x99, 0x64, 0xd9, 0x03, 0xb5, 0xec, 0xcd, 0x5f, 0x18,
+	0x69, 0x98, 0xc8, 0x0b, 0x23, 0x0d, 0xf9, 0x63, 0xca, 0x43, 0x0d, 0x5c, 0x71, 0x67, 0x68, 0xb8,
+	0x1c, 0xb8, 0xe6, 0x36, 0x34, 0xa9, 0xa8, 0xeb, 0xd0, 0xbc, 0x18, 0x3e, 0x7f, 0xdd, 0x7b, 0x80,
+	0xb3, 0x9a, 0x63, 0x8b, 0xbe, 0x1b, 0xe6, 0x3e, 0x74, 0x32, 0xf7, 0x20, 0xc9, 0x37, 0xe7, 0xa3,
+	0x57, 0xbd, 0x07, 0x38, 0xb4, 0x19, 0xbc, 0x3e, 0xb1, 0x8e, 0x2f, 0x2e, 0xf8, 0x6c, 0xe7, 0x98,
+	0x7f, 0x2f, 0x98, 0xa7, 0xb0, 0xf9, 0xdc, 0x75, 0x15, 0xff, 0xca, 0x46, 0xfc, 0x0b, 0x68, 0x27,
+	0x1c, 0x22, 0x9a, 0xeb, 0xcd, 0x9a, 0x80, 0x58, 0x92, 0xce, 0xdc, 0x84, 0x8d, 0x79, 0x69, 0xd1,
+	0xe4, 0xce, 0xfc, 0x2e, 0x6c, 0x1e, 0x79, 0x93, 0xca, 0x6d, 0x2a, 0xf2, 0x07, 0xe5, 0xcc, 0x93,
+	0xa3, 0x1c, 0x83, 0x8a, 0xbd, 0x82, 0xc8, 0x52, 0xfc, 0x0c, 0x1e, 0x55, 0xe0, 0xf8, 0x35, 0xa0,
+	0x0b, 0x0d, 0x65, 0x96, 0xd7, 0x9a, 0x92, 0x11, 0x9a, 0x7f, 0x68, 0xc0, 0xca, 0x8b, 0x30, 0x8a,
+	0x5e, 0x4c, 0x9c, 0x24, 0xc1, 0x27, 0x5e, 0x52, 0x99, 0xea, 0xeb, 0xd0, 0xfa, 0x76, 0xe6, 0xcd,
+	0x3c, 0x59, 0x41, 0x69, 0x81, 0xf1, 0x8c, 0xa2, 0x44, 0x3e, 0xd2, 0xa3, 0x88, 0x2a, 0xed, 0xe5,
+	0x2c, 0x4e, 0x64, 0xf1, 0xe4, 0x0b, 0xf6, 0x29, 0xac, 0x38, 0xe3, 0xb1, 0x17, 0xa5, 0x76, 0xe4,
+	0x8c, 0x6f, 0xbd, 0x34, 0xa1, 0xf4, 0x6c, 0x5a, 0xcb, 0x1c, 0x3a, 0xe4, 0x40, 0xac, 0x9a, 0x6e,
+	0x1c, 0x46, 0x19, 0x91, 0x46, 0x44, 0x5d, 0x84, 0x09, 0x12, 0x31, 0x4c, 0x41, 0x85, 0x49, 0x57,
+	0xe9, 0x94, 0x17, 0xb0, 0xa6, 0x82, 0xf9, 0xa1, 0x6f, 0x25, 0xb8, 0x12, 0xce, 0xe8, 0xe7, 0xce,
+	0x50, 0x0d, 0xb6, 0x38, 0x99, 0xf9, 0xf7, 0x05, 0xe8, 0x8e, 0xe2, 0x59, 0x70, 0x7b, 0xe6, 0x4d,
+	0x2f, 0xbd, 0xb8, 0x72, 0x24, 0xd4, 0xc7, 0x42, 0xe2, 0x5c, 0x4e, 0x3c, 0xde, 0x9f, 0xe8, 0x96,
+	0x5c, 0x62, 0x26, 0xd3, 0xb0, 0x68, 0xc6, 0x87, 0xa2, 0xba, 0xa5, 0xe1, 0xf2, 0x4d, 0x24, 0xaf,
+	0x99, 0x77, 0x1e, 0xf9, 0x44, 0xb7, 0xc4, 0x8a, 0xed, 0x00, 0x8c, 0xc3, 0xc9, 0x04, 0x93, 0x38,
+	0xb8, 0x26, 0x87, 0xe8, 0x56, 0x01, 0xc2, 0x4c, 0x58, 0x72, 0xfd, 0x24, 0x8d, 0xfd, 0xcb, 0x19,
+	0x51, 0x68, 0x44, 0xa1, 0xc0, 0x70, 0x00, 0xe5, 0x5c, 0x5f, 0xc7, 0xde, 0x35, 0x16, 0x78, 0x3b,
+	0x7b, 0xff, 0x2e, 0xe5, 0xc0, 0x81, 0x8b, 0x35, 0xc3, 0x0f, 0xec, 0x70, 0x9c, 0xa2, 0x4f, 0x75,
+	0xf2, 0xa9, 0xee, 0x07, 0xe7, 0xb4, 0x66, 0x7b, 0xd0, 0xf3, 0x03, 0x7b, 0x36, 0x76, 0x92, 0x3c,
+	0x38, 0x1d, 0xa2, 0x59, 0xf1, 0x83, 0x37, 0x08, 0x96, 0xd1, 0x79, 0x02, 0x10, 0xce, 0x52, 0x29,
+	0x07, 0x88, 0xa6, 0x13, 0xce, 0x52, 0x21, 0xe8, 0x3b, 0xb0, 0x86, 0x68, 0x55, 0x52, 0x97, 0xa8,
+	0x56, 0xc3, 0x59, 0x5a, 0x14, 0x65, 0xfe, 0x76, 0x01, 0x5a, 0xe4, 0x69, 0x2c, 0x59, 0x29, 0x7e,
+	0x14, 0x9e, 0xc1, 0xb4, 0xe6, 0x5d, 0x30, 0x9f, 0x85, 0xc9, 0x56, 0x50, 0xc3, 0x25, 0x7f, 0x36,
+	0xd2, 0xc4, 0x24, 0x9f, 0x0f, 0xb5, 0x71, 0xfd, 0x96, 0x3f, 0x09, 0xa7, 0xa1, 0xeb, 0x89, 0xf7,
+	0x03, 0x7d, 0x63, 0x5b, 0x78, 0xe3, 0x24, 0x37, 0x76, 0x14, 0x4e, 0xfc, 0xf1, 0x9d, 0x68, 0xfd,
+	0x00, 0x41, 0x43, 0x82, 0x50, 0x16, 0x27, 0xb2, 0xa3, 0xc6, 0x4f, 0xf4, 0xd8, 0xd4, 0x0f, 0x6c,
+	0x0c, 0x60, 0x22, 0x5c, 0xaa, 0x4f, 0xfd, 0xe0, 0x14, 0xd7, 0xf3, 0x3e, 0xd7, 0x2b, 0x7c, 0xfe,
+	0x39, 0xb4, 0xa7, 0x94, 0x45, 0xe8, 0x4d, 0xcc, 0xbe, 0x8d, 0x42, 0x87, 0x94, 0xe7, 0x98, 0x25,
+	0xa9, 0x4c, 0x46, 0x4f, 0x17, 0x42, 0x15, 0x1e, 0x69, 0x2b, 0x05, 0x18, 0x9f, 0x03, 0x68, 0xe4,
+	0x1e, 0x99, 0xd3, 0xab, 0x25, 0xa9, 0x96, 0x40, 0x1f, 0xfc, 0x63, 0x19, 0xf4, 0x93, 0xf0, 0xf5,
+	0xc5, 0xe9, 0xf3, 0xc8, 0x67, 0x6f, 0xe8, 0xb1, 0x57, 0x9c, 0x97, 0xb2, 0xdd, 0x9c, 0xb1, 0x7a,
+	0xc8, 0x6a, 0xec, 0xdc, 0x43, 0x81, 0x35, 0xea, 0x01, 0x3b, 0x85, 0xa5, 0xe2, 0x04, 0x93, 0x3d,
+	0x51, 0x38, 0xca, 0x03, 0x4f, 0xe3, 0x71, 0x1d, 0x9a, 0x4b, 0xfb, 0x29, 0xe8, 0x72, 0xbc, 0xc8,
+	0xb6, 0x14, 0xd2, 0xe2, 0x14, 0xd2, 0xd8, 0xac, 0x42, 0x71, 0x09, 0x2f, 0x01, 0xf2, 0xb9, 0x1f,
+	0x53, 0xb7, 0x53, 0x47, 0x84, 0xc6, 0x56, 0x35, 0x92, 0xcb, 0x39, 0xa7, 0x41, 0x67, 0x3e, 0x1d,
+	0x63, 0x3b, 0x25, 0xea, 0xd2, 0x38, 0xcd, 0xd8, 0xae, 0xc5, 0x73, 0x81, 0x03, 0xe8, 0x16, 0xa6,
+	0x47, 0x6c, 0xbb, 0x38, 0x95, 0x2e, 0x8f, 0xa3, 0x0c, 0xa3, 0x06, 0xab, 0xd8, 0x28, 0x24, 0x95,
+	0x6c, 0x54, 0x05, 0x6d, 0x55, 0x23, 0x33, 0x95, 0x72, 0x60, 0xc2, 0xb6, 0xab, 0x68, 0x93, 0x0a,
+	0x95, 0xca, 0x63, 0xa4, 0xdc, 0x5d, 0xd9, 0xec, 0xa4, 0xec, 0xae, 0xf2, 0xb0, 0xc5, 0xd8, 0xae,
+	0xc5, 0xab, 0x36, 0xd2, 0x6c, 0x62, 0xce, 0xc6, 0xe2, 0x18, 0xc3, 0xd8, 0xaa, 0x46, 0xaa, 0x36,
+	0xf2, 0x69, 0xc0, 0x9c, 0x8d, 0xca, 0xe8, 0xc0, 0x30, 0x6a, 0xb0, 0x5c, 0x14, 0x3f, 0x41, 0xc5,
+	0xbe, 0xb2, 0x74, 0x82, 0x2a, 0x9a, 0x51, 0x63, 0xe7, 0x1e, 0x0a, 0x2e, 0xd6, 0xa1, 0xdb, 0xac,
+	0xdc, 0xdd, 0xb3, 0x4f, 0x14, 0xc6, 0x9a, 0xe7, 0x81, 0x61, 0x7e, 0x80, 0x8a, 0x6f, 0xe1, 0xc2,
+	0x7a, 0x86, 0x2d, 0xbc, 0xe3, 0xd8, 0xa7, 0x15, 0xdc, 0xf3, 0xcf, 0x40, 0xe3, 0xe3, 0x0f, 0x91,
+	0xf1, 0x5d, 0xa6, 0xb0, 0x59, 0xf3, 0x54, 0x61, 0x7b, 0x8a, 0x84, 0x7b, 0xde, 0x3b, 0xc6, 0x67,
+	0xff, 0x06, 0x25, 0xdf, 0xee, 0x1a, 0x36, 0x2a, 0x1f, 0x28, 0xac, 0x4e, 0x44, 0xe9, 0x85, 0x63,
+	0x7c, 0xf2, 0x41, 0x3a, 0xbe, 0xd1, 0x2f, 0xa0, 0x57, 0xee, 0xf4, 0x58, 0xa1, 0x61, 0xaf, 0xe9,
+	0x29, 0x8d, 0xa7, 0xf7, 0x91, 0x64, 0x92, 0xcb, 0xbd, 0x5f, 0x51, 0x72, 0x4d, 0x1b, 0x69, 0x3c,
+	0xbd, 0x8f, 0x84, 0x4b, 0xfe, 0x15, 0xf5, 0x42, 0x0a, 0x2a, 0x61, 0x6a, 0xb2, 0x54, 0x76, 0x96,
+	0xc6, 0xee, 0xbd, 0x34, 0xc5, 0x9a, 0x9f, 0x35, 0x5a, 0xa5, 0x9a, 0x5f, 0xee, 0xcb, 0x8c, 0xc7,
+	0x75, 0x68, 0x2e, 0xed, 0x05, 0x74, 0xb2, 0x0b, 0x8e, 0xa9, 0x27, 0x50, 0xb9, 0x09, 0x8d, 0x7e,
+	0x25, 0x8e, 0x84, 0x5c, 0x6a, 0xf4, 0xb3, 0xe2, 0xe1, 0xbf, 0x06, 0x00, 0x1c, 0x13, 0x6d, 0x27,
+	0x37, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoNSLApiClient is the client API for GoNSLApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoNSLApiClient interface {
+	GetFieldEntries(ctx context.Context, in *GetFieldEntriesRequest, opts ...grpc.CallOption) (*GetFieldEntriesReply, error)
 	GetPortInfos(ctx context.Context, in *GetPortInfosRequest, opts ...grpc.CallOption) (*GetPortInfosReply, error)
 	GetVlans(ctx context.Context, in *GetVlansRequest, opts ...grpc.CallOption) (*GetVlansReply, error)
 	GetL2Addrs(ctx context.Context, in *GetL2AddrsRequest, opts ...grpc.CallOption) (*GetL2AddrsReply, error)
@@ -2509,6 +3723,13 @@ type GoNSLApiClient interface {
 	GetIDMapEntries(ctx context.Context, in *GetIDMapEntriesRequest, opts ...grpc.CallOption) (*GetIDMapEntriesReply, error)
 	GetTunnelInitiators(ctx context.Context, in *GetTunnelInitiatorsRequest, opts ...grpc.CallOption) (*GetTunnelInitiatorsReply, error)
 	GetTunnelTerminators(ctx context.Context, in *GetTunnelTerminatorsRequest, opts ...grpc.CallOption) (*GetTunnelTerminatorsReply, error)
+	GetMPLSTunnelInitiators(ctx context.Context, in *GetMPLSTunnelInitiatorsRequest, opts ...grpc.CallOption) (*GetMPLSTunnelInitiatorsReply, error)
+	GetMPLSTunnelSwitches(ctx context.Context, in *GetMPLSTunnelSwitchesRequest, opts ...grpc.CallOption) (*GetMPLSTunnelSwitchesReply, error)
+	AddMirrorSession(ctx context.Context, in *AddMirrorSessionRequest, opts ...grpc.CallOption) (*AddMirrorSessionReply, error)
+	DelMirrorSession(ctx context.Context, in *DelMirrorSessionRequest, opts ...grpc.CallOption) (*DelMirrorSessionReply, error)
+	GetMirrorSessions(ctx context.Context, in *GetMirrorSessionsRequest, opts ...grpc.CallOption) (*GetMirrorSessionsReply, error)
+	GetCoppStats(ctx context.Context, in *GetCoppStatsRequest, opts ...grpc.CallOption) (*GetCoppStatsReply, error)
+	GetTrunks(ctx context.Context, in *GetTrunksRequest, opts ...grpc.CallOption) (*GetTrunksReply, error)
 }
 
 type goNSLApiClient struct {
@@ -2645,6 +3866,69 @@ func (c *goNSLApiClient) GetTunnelTerminators(ctx context.Context, in *GetTunnel
 	return out, nil
 }
 
+func (c *goNSLApiClient) GetMPLSTunnelInitiators(ctx context.Context, in *GetMPLSTunnelInitiatorsRequest, opts ...grpc.CallOption) (*GetMPLSTunnelInitiatorsReply, error) {
+	out := new(GetMPLSTunnelInitiatorsReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/GetMPLSTunnelInitiators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) GetMPLSTunnelSwitches(ctx context.Context, in *GetMPLSTunnelSwitchesRequest, opts ...grpc.CallOption) (*GetMPLSTunnelSwitchesReply, error) {
+	out := new(GetMPLSTunnelSwitchesReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/GetMPLSTunnelSwitches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) AddMirrorSession(ctx context.Context, in *AddMirrorSessionRequest, opts ...grpc.CallOption) (*AddMirrorSessionReply, error) {
+	out := new(AddMirrorSessionReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/AddMirrorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) DelMirrorSession(ctx context.Context, in *DelMirrorSessionRequest, opts ...grpc.CallOption) (*DelMirrorSessionReply, error) {
+	out := new(DelMirrorSessionReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/DelMirrorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) GetMirrorSessions(ctx context.Context, in *GetMirrorSessionsRequest, opts ...grpc.CallOption) (*GetMirrorSessionsReply, error) {
+	out := new(GetMirrorSessionsReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/GetMirrorSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) GetCoppStats(ctx context.Context, in *GetCoppStatsRequest, opts ...grpc.CallOption) (*GetCoppStatsReply, error) {
+	out := new(GetCoppStatsReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/GetCoppStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goNSLApiClient) GetTrunks(ctx context.Context, in *GetTrunksRequest, opts ...grpc.CallOption) (*GetTrunksReply, error) {
+	out := new(GetTrunksReply)
+	err := c.cc.Invoke(ctx, "/gonslapi.GoNSLApi/GetTrunks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoNSLApiServer is the server API for GoNSLApi service.
 type GoNSLApiServer interface {
 	GetFieldEntries(context.Context, *GetFieldEntriesRequest) (*GetFieldEntriesReply, error)
@@ -2661,6 +3945,13 @@ type GoNSLApiServer interface {
 	GetIDMapEntries(context.Context, *GetIDMapEntriesRequest) (*GetIDMapEntriesReply, error)
 	GetTunnelInitiators(context.Context, *GetTunnelInitiatorsRequest) (*GetTunnelInitiatorsReply, error)
 	GetTunnelTerminators(context.Context, *GetTunnelTerminatorsRequest) (*GetTunnelTerminatorsReply, error)
+	GetMPLSTunnelInitiators(context.Context, *GetMPLSTunnelInitiatorsRequest) (*GetMPLSTunnelInitiatorsReply, error)
+	GetMPLSTunnelSwitches(context.Context, *GetMPLSTunnelSwitchesRequest) (*GetMPLSTunnelSwitchesReply, error)
+	AddMirrorSession(context.Context, *AddMirrorSessionRequest) (*AddMirrorSessionReply, error)
+	DelMirrorSession(context.Context, *DelMirrorSessionRequest) (*DelMirrorSessionReply, error)
+	GetMirrorSessions(context.Context, *GetMirrorSessionsRequest) (*GetMirrorSessionsReply, error)
+	GetCoppStats(context.Context, *GetCoppStatsRequest) (*GetCoppStatsReply, error)
+	GetTrunks(context.Context, *GetTrunksRequest) (*GetTrunksReply, error)
 }
 
 // UnimplementedGoNSLApiServer can be embedded to have forward compatible implementations.
@@ -2709,6 +4000,27 @@ func (*UnimplementedGoNSLApiServer) GetTunnelInitiators(ctx context.Context, req
 func (*UnimplementedGoNSLApiServer) GetTunnelTerminators(ctx context.Context, req *GetTunnelTerminatorsRequest) (*GetTunnelTerminatorsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTunnelTerminators not implemented")
 }
+func (*UnimplementedGoNSLApiServer) GetMPLSTunnelInitiators(ctx context.Context, req *GetMPLSTunnelInitiatorsRequest) (*GetMPLSTunnelInitiatorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMPLSTunnelInitiators not implemented")
+}
+func (*UnimplementedGoNSLApiServer) GetMPLSTunnelSwitches(ctx context.Context, req *GetMPLSTunnelSwitchesRequest) (*GetMPLSTunnelSwitchesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMPLSTunnelSwitches not implemented")
+}
+func (*UnimplementedGoNSLApiServer) AddMirrorSession(ctx context.Context, req *AddMirrorSessionRequest) (*AddMirrorSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMirrorSession not implemented")
+}
+func (*UnimplementedGoNSLApiServer) DelMirrorSession(ctx context.Context, req *DelMirrorSessionRequest) (*DelMirrorSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelMirrorSession not implemented")
+}
+func (*UnimplementedGoNSLApiServer) GetMirrorSessions(ctx context.Context, req *GetMirrorSessionsRequest) (*GetMirrorSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMirrorSessions not implemented")
+}
+func (*UnimplementedGoNSLApiServer) GetCoppStats(ctx context.Context, req *GetCoppStatsRequest) (*GetCoppStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoppStats not implemented")
+}
+func (*UnimplementedGoNSLApiServer) GetTrunks(ctx context.Context, req *GetTrunksRequest) (*GetTrunksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrunks not implemented")
+}
 
 func RegisterGoNSLApiServer(s *grpc.Server, srv GoNSLApiServer) {
 	s.RegisterService(&_GoNSLApi_serviceDesc, srv)
@@ -2966,6 +4278,132 @@ func _GoNSLApi_GetTunnelTerminators_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoNSLApi_GetMPLSTunnelInitiators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMPLSTunnelInitiatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).GetMPLSTunnelInitiators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/GetMPLSTunnelInitiators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).GetMPLSTunnelInitiators(ctx, req.(*GetMPLSTunnelInitiatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_GetMPLSTunnelSwitches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMPLSTunnelSwitchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).GetMPLSTunnelSwitches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/GetMPLSTunnelSwitches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).GetMPLSTunnelSwitches(ctx, req.(*GetMPLSTunnelSwitchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_AddMirrorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMirrorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).AddMirrorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/AddMirrorSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).AddMirrorSession(ctx, req.(*AddMirrorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_DelMirrorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelMirrorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).DelMirrorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/DelMirrorSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).DelMirrorSession(ctx, req.(*DelMirrorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_GetMirrorSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMirrorSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).GetMirrorSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/GetMirrorSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).GetMirrorSessions(ctx, req.(*GetMirrorSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_GetCoppStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoppStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).GetCoppStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/GetCoppStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).GetCoppStats(ctx, req.(*GetCoppStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoNSLApi_GetTrunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoNSLApiServer).GetTrunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gonslapi.GoNSLApi/GetTrunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoNSLApiServer).GetTrunks(ctx, req.(*GetTrunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GoNSLApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gonslapi.GoNSLApi",
 	HandlerType: (*GoNSLApiServer)(nil),
@@ -3026,6 +4464,34 @@ var _GoNSLApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTunnelTerminators",
 			Handler:    _GoNSLApi_GetTunnelTerminators_Handler,
 		},
+		{
+			MethodName: "GetMPLSTunnelInitiators",
+			Handler:    _GoNSLApi_GetMPLSTunnelInitiators_Handler,
+		},
+		{
+			MethodName: "GetMPLSTunnelSwitches",
+			Handler:    _GoNSLApi_GetMPLSTunnelSwitches_Handler,
+		},
+		{
+			MethodName: "AddMirrorSession",
+			Handler:    _GoNSLApi_AddMirrorSession_Handler,
+		},
+		{
+			MethodName: "DelMirrorSession",
+			Handler:    _GoNSLApi_DelMirrorSession_Handler,
+		},
+		{
+			MethodName: "GetMirrorSessions",
+			Handler:    _GoNSLApi_GetMirrorSessions_Handler,
+		},
+		{
+			MethodName: "GetCoppStats",
+			Handler:    _GoNSLApi_GetCoppStats_Handler,
+		},
+		{
+			MethodName: "GetTrunks",
+			Handler:    _GoNSLApi_GetTrunks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gonslapi.proto",
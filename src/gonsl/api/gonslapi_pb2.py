@@ -19,7 +19,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='gonslapi',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\x0egonslapi.proto\x12\x08gonslapi\"\xd2\x02\n\nFieldEntry\x12\x32\n\nentry_type\x18\x01 \x01(\x0e\x32\x1e.gonslapi.FieldEntry.EntryType\x12/\n\x08\x65th_type\x18\x02 \x01(\x0b\x32\x1b.gonslapi.EthTypeFieldEntryH\x00\x12+\n\x06\x64st_ip\x18\x03 \x01(\x0b\x32\x19.gonslapi.DstIpFieldEntryH\x00\x12/\n\x08ip_proto\x18\x04 \x01(\x0b\x32\x1b.gonslapi.IpProtoFieldEntryH\x00\x12-\n\x07\x65th_dst\x18\x05 \x01(\x0b\x32\x1a.gonslapi.EthDstFieldEntryH\x00\"I\n\tEntryType\x12\x07\n\x03NOP\x10\x00\x12\x0c\n\x08\x45TH_TYPE\x10\x01\x12\n\n\x06\x44ST_IP\x10\x02\x12\x0c\n\x08IP_PROTO\x10\x03\x12\x0b\n\x07\x45TH_DST\x10\x04\x42\x07\n\x05\x65ntry\"F\n\x10\x45thDstFieldEntry\x12\x0f\n\x07\x65th_dst\x18\x01 \x01(\t\x12\x10\n\x08\x65th_mask\x18\x02 \x01(\t\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"6\n\x11\x45thTypeFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x0f\n\x07in_port\x18\x02 \x01(\r\"D\n\x0f\x44stIpFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x0e\n\x06ip_dst\x18\x02 \x01(\t\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"H\n\x11IpProtoFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x10\n\x08ip_proto\x18\x02 \x01(\r\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"\x18\n\x16GetFieldEntriesRequest\"=\n\x14GetFieldEntriesReply\x12%\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x14.gonslapi.FieldEntry\"D\n\x08PortInfo\x12\x0c\n\x04port\x18\x01 \x01(\r\x12\x13\n\x0blink_status\x18\x02 \x01(\x05\x12\x15\n\runtagged_vlan\x18\x03 \x01(\r\"\x15\n\x13GetPortInfosRequest\";\n\x11GetPortInfosReply\x12&\n\nport_infos\x18\x01 \x03(\x0b\x32\x12.gonslapi.PortInfo\"<\n\tVlanEntry\x12\x0b\n\x03vid\x18\x01 \x01(\r\x12\r\n\x05ports\x18\x02 \x03(\r\x12\x13\n\x0buntag_ports\x18\x03 \x03(\r\"\x11\n\x0fGetVlansRequest\"3\n\rGetVlansReply\x12\"\n\x05vlans\x18\x01 \x03(\x0b\x32\x13.gonslapi.VlanEntry\"?\n\x06L2Addr\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0b\n\x03mac\x18\x02 \x01(\t\x12\x0b\n\x03vid\x18\x03 \x01(\r\x12\x0c\n\x04port\x18\x04 \x01(\r\"\x13\n\x11GetL2AddrsRequest\"2\n\x0fGetL2AddrsReply\x12\x1f\n\x05\x61\x64\x64rs\x18\x01 \x03(\x0b\x32\x10.gonslapi.L2Addr\"\x8b\x01\n\tL2Station\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0f\n\x07\x64st_mac\x18\x02 \x01(\t\x12\x14\n\x0c\x64st_mac_mask\x18\x03 \x01(\t\x12\x0c\n\x04vlan\x18\x04 \x01(\r\x12\x11\n\tvlan_mask\x18\x05 \x01(\r\x12\x10\n\x08src_port\x18\x06 \x01(\r\x12\x15\n\rsrc_port_mask\x18\x07 \x01(\r\"\x16\n\x14GetL2StationsRequest\";\n\x12GetL2StationsReply\x12%\n\x08stations\x18\x01 \x03(\x0b\x32\x13.gonslapi.L2Station\"|\n\x07L3Iface\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x10\n\x08iface_id\x18\x02 \x01(\r\x12\x0b\n\x03mac\x18\x03 \x01(\t\x12\x0b\n\x03mtu\x18\x04 \x01(\r\x12\x0f\n\x07mtu_fwd\x18\x05 \x01(\r\x12\x0b\n\x03ttl\x18\x06 \x01(\r\x12\x0b\n\x03vid\x18\x07 \x01(\r\x12\x0b\n\x03vrf\x18\x08 \x01(\r\".\n\x12\x46indL3IfaceRequest\x12\x0b\n\x03mac\x18\x01 \x01(\t\x12\x0b\n\x03vid\x18\x02 \x01(\r\"4\n\x10\x46indL3IfaceReply\x12 \n\x05iface\x18\x01 \x01(\x0b\x32\x11.gonslapi.L3Iface\"%\n\x11GetL3IfaceRequest\x12\x10\n\x08iface_id\x18\x01 \x01(\r\"3\n\x0fGetL3IfaceReply\x12 \n\x05iface\x18\x01 \x01(\x0b\x32\x11.gonslapi.L3Iface\"\x14\n\x12GetL3IfacesRequest\"5\n\x10GetL3IfacesReply\x12!\n\x06ifaces\x18\x01 \x03(\x0b\x32\x11.gonslapi.L3Iface\"v\n\x08L3Egress\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0e\n\x06\x66lags2\x18\x02 \x01(\r\x12\x11\n\tegress_id\x18\x03 \x01(\r\x12\x10\n\x08iface_id\x18\x04 \x01(\r\x12\x0b\n\x03mac\x18\x05 \x01(\t\x12\x0b\n\x03vid\x18\x06 \x01(\r\x12\x0c\n\x04port\x18\x07 \x01(\r\"\x16\n\x14GetL3EgressesRequest\":\n\x12GetL3EgressesReply\x12$\n\x08\x65gresses\x18\x01 \x03(\x0b\x32\x12.gonslapi.L3Egress\"g\n\x06L3Host\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\tegress_id\x18\x02 \x01(\r\x12\x0f\n\x07ip_addr\x18\x03 \x01(\t\x12\x10\n\x08ip6_addr\x18\x04 \x01(\t\x12\x0b\n\x03mac\x18\x05 \x01(\t\x12\x0b\n\x03vrf\x18\x06 \x01(\r\"\x13\n\x11GetL3HostsRequest\"2\n\x0fGetL3HostsReply\x12\x1f\n\x05hosts\x18\x01 \x03(\x0b\x32\x10.gonslapi.L3Host\"[\n\x07L3Route\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\tegress_id\x18\x02 \x01(\r\x12\x0f\n\x07ip_addr\x18\x03 \x01(\t\x12\x10\n\x08ip6_addr\x18\x04 \x01(\t\x12\x0b\n\x03vrf\x18\x05 \x01(\r\"\x14\n\x12GetL3RoutesRequest\"5\n\x10GetL3RoutesReply\x12!\n\x06routes\x18\x01 \x03(\x0b\x32\x11.gonslapi.L3Route\"\xeb\x01\n\x0fTunnelInitiator\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\ttunnel_id\x18\x02 \x01(\r\x12\x13\n\x0btunnel_type\x18\x03 \x01(\t\x12\x13\n\x0bl3_iface_id\x18\x04 \x01(\r\x12\x0f\n\x07\x64st_mac\x18\x05 \x01(\t\x12\x0f\n\x07src_mac\x18\x06 \x01(\t\x12\x0e\n\x06\x64st_ip\x18\x07 \x01(\t\x12\x0e\n\x06src_ip\x18\x08 \x01(\t\x12\x10\n\x08\x64st_port\x18\t \x01(\r\x12\x10\n\x08src_port\x18\n \x01(\r\x12\x0b\n\x03ttl\x18\x0b \x01(\r\x12\x0b\n\x03mtu\x18\x0c \x01(\r\x12\x0c\n\x04vlan\x18\r \x01(\r\"\xcb\x01\n\x10TunnelTerminator\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\ttunnel_id\x18\x02 \x01(\r\x12\x13\n\x0btunnel_type\x18\x03 \x01(\t\x12\x13\n\x0bremote_port\x18\x04 \x01(\r\x12\x0e\n\x06\x64st_ip\x18\x05 \x01(\t\x12\x0e\n\x06src_ip\x18\x06 \x01(\t\x12\x10\n\x08\x64st_port\x18\x07 \x01(\r\x12\x10\n\x08src_port\x18\x08 \x01(\r\x12\x0c\n\x04vlan\x18\t \x01(\r\x12\x0b\n\x03vrf\x18\n \x01(\r\x12\x0c\n\x04pbmp\x18\x0b \x03(\r\"\x1c\n\x1aGetTunnelInitiatorsRequest\"F\n\x18GetTunnelInitiatorsReply\x12*\n\x07tunnels\x18\x01 \x03(\x0b\x32\x19.gonslapi.TunnelInitiator\"\x1d\n\x1bGetTunnelTerminatorsRequest\"H\n\x19GetTunnelTerminatorsReply\x12+\n\x07tunnels\x18\x01 \x03(\x0b\x32\x1a.gonslapi.TunnelTerminator\"6\n\nIDMapEntry\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\r\"\x18\n\x16GetIDMapEntriesRequest\"=\n\x14GetIDMapEntriesReply\x12%\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x14.gonslapi.IDMapEntry2\xec\x08\n\x08GoNSLApi\x12U\n\x0fGetFieldEntries\x12 .gonslapi.GetFieldEntriesRequest\x1a\x1e.gonslapi.GetFieldEntriesReply\"\x00\x12L\n\x0cGetPortInfos\x12\x1d.gonslapi.GetPortInfosRequest\x1a\x1b.gonslapi.GetPortInfosReply\"\x00\x12@\n\x08GetVlans\x12\x19.gonslapi.GetVlansRequest\x1a\x17.gonslapi.GetVlansReply\"\x00\x12\x46\n\nGetL2Addrs\x12\x1b.gonslapi.GetL2AddrsRequest\x1a\x19.gonslapi.GetL2AddrsReply\"\x00\x12O\n\rGetL2Stations\x12\x1e.gonslapi.GetL2StationsRequest\x1a\x1c.gonslapi.GetL2StationsReply\"\x00\x12I\n\x0b\x46indL3Iface\x12\x1c.gonslapi.FindL3IfaceRequest\x1a\x1a.gonslapi.FindL3IfaceReply\"\x00\x12\x46\n\nGetL3Iface\x12\x1b.gonslapi.GetL3IfaceRequest\x1a\x19.gonslapi.GetL3IfaceReply\"\x00\x12I\n\x0bGetL3Ifaces\x12\x1c.gonslapi.GetL3IfacesRequest\x1a\x1a.gonslapi.GetL3IfacesReply\"\x00\x12O\n\rGetL3Egresses\x12\x1e.gonslapi.GetL3EgressesRequest\x1a\x1c.gonslapi.GetL3EgressesReply\"\x00\x12\x46\n\nGetL3Hosts\x12\x1b.gonslapi.GetL3HostsRequest\x1a\x19.gonslapi.GetL3HostsReply\"\x00\x12I\n\x0bGetL3Routes\x12\x1c.gonslapi.GetL3RoutesRequest\x1a\x1a.gonslapi.GetL3RoutesReply\"\x00\x12U\n\x0fGetIDMapEntries\x12 .gonslapi.GetIDMapEntriesRequest\x1a\x1e.gonslapi.GetIDMapEntriesReply\"\x00\x12\x61\n\x13GetTunnelInitiators\x12$.gonslapi.GetTunnelInitiatorsRequest\x1a\".gonslapi.GetTunnelInitiatorsReply\"\x00\x12\x64\n\x14GetTunnelTerminators\x12%.gonslapi.GetTunnelTerminatorsRequest\x1a#.gonslapi.GetTunnelTerminatorsReply\"\x00\x62\x06proto3')
+  serialized_pb=_b('\n\x0egonslapi.proto\x12\x08gonslapi\"\xd2\x02\n\nFieldEntry\x12\x32\n\nentry_type\x18\x01 \x01(\x0e\x32\x1e.gonslapi.FieldEntry.EntryType\x12/\n\x08\x65th_type\x18\x02 \x01(\x0b\x32\x1b.gonslapi.EthTypeFieldEntryH\x00\x12+\n\x06\x64st_ip\x18\x03 \x01(\x0b\x32\x19.gonslapi.DstIpFieldEntryH\x00\x12/\n\x08ip_proto\x18\x04 \x01(\x0b\x32\x1b.gonslapi.IpProtoFieldEntryH\x00\x12-\n\x07\x65th_dst\x18\x05 \x01(\x0b\x32\x1a.gonslapi.EthDstFieldEntryH\x00\"I\n\tEntryType\x12\x07\n\x03NOP\x10\x00\x12\x0c\n\x08\x45TH_TYPE\x10\x01\x12\n\n\x06\x44ST_IP\x10\x02\x12\x0c\n\x08IP_PROTO\x10\x03\x12\x0b\n\x07\x45TH_DST\x10\x04\x42\x07\n\x05\x65ntry\"F\n\x10\x45thDstFieldEntry\x12\x0f\n\x07\x65th_dst\x18\x01 \x01(\t\x12\x10\n\x08\x65th_mask\x18\x02 \x01(\t\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"6\n\x11\x45thTypeFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x0f\n\x07in_port\x18\x02 \x01(\r\"D\n\x0f\x44stIpFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x0e\n\x06ip_dst\x18\x02 \x01(\t\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"H\n\x11IpProtoFieldEntry\x12\x10\n\x08\x65th_type\x18\x01 \x01(\r\x12\x10\n\x08ip_proto\x18\x02 \x01(\r\x12\x0f\n\x07in_port\x18\x03 \x01(\r\"\x18\n\x16GetFieldEntriesRequest\"=\n\x14GetFieldEntriesReply\x12%\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x14.gonslapi.FieldEntry\"D\n\x08PortInfo\x12\x0c\n\x04port\x18\x01 \x01(\r\x12\x13\n\x0blink_status\x18\x02 \x01(\x05\x12\x15\n\runtagged_vlan\x18\x03 \x01(\r\"\x15\n\x13GetPortInfosRequest\";\n\x11GetPortInfosReply\x12&\n\nport_infos\x18\x01 \x03(\x0b\x32\x12.gonslapi.PortInfo\"<\n\tVlanEntry\x12\x0b\n\x03vid\x18\x01 \x01(\r\x12\r\n\x05ports\x18\x02 \x03(\r\x12\x13\n\x0buntag_ports\x18\x03 \x03(\r\"\x11\n\x0fGetVlansRequest\"3\n\rGetVlansReply\x12\"\n\x05vlans\x18\x01 \x03(\x0b\x32\x13.gonslapi.VlanEntry\"?\n\x06L2Addr\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0b\n\x03mac\x18\x02 \x01(\t\x12\x0b\n\x03vid\x18\x03 \x01(\r\x12\x0c\n\x04port\x18\x04 \x01(\r\"\x13\n\x11GetL2AddrsRequest\"2\n\x0fGetL2AddrsReply\x12\x1f\n\x05\x61\x64\x64rs\x18\x01 \x03(\x0b\x32\x10.gonslapi.L2Addr\"\x8b\x01\n\tL2Station\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0f\n\x07\x64st_mac\x18\x02 \x01(\t\x12\x14\n\x0c\x64st_mac_mask\x18\x03 \x01(\t\x12\x0c\n\x04vlan\x18\x04 \x01(\r\x12\x11\n\tvlan_mask\x18\x05 \x01(\r\x12\x10\n\x08src_port\x18\x06 \x01(\r\x12\x15\n\rsrc_port_mask\x18\x07 \x01(\r\"\x16\n\x14GetL2StationsRequest\";\n\x12GetL2StationsReply\x12%\n\x08stations\x18\x01 \x03(\x0b\x32\x13.gonslapi.L2Station\"|\n\x07L3Iface\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x10\n\x08iface_id\x18\x02 \x01(\r\x12\x0b\n\x03mac\x18\x03 \x01(\t\x12\x0b\n\x03mtu\x18\x04 \x01(\r\x12\x0f\n\x07mtu_fwd\x18\x05 \x01(\r\x12\x0b\n\x03ttl\x18\x06 \x01(\r\x12\x0b\n\x03vid\x18\x07 \x01(\r\x12\x0b\n\x03vrf\x18\x08 \x01(\r\".\n\x12\x46indL3IfaceRequest\x12\x0b\n\x03mac\x18\x01 \x01(\t\x12\x0b\n\x03vid\x18\x02 \x01(\r\"4\n\x10\x46indL3IfaceReply\x12 \n\x05iface\x18\x01 \x01(\x0b\x32\x11.gonslapi.L3Iface\"%\n\x11GetL3IfaceRequest\x12\x10\n\x08iface_id\x18\x01 \x01(\r\"3\n\x0fGetL3IfaceReply\x12 \n\x05iface\x18\x01 \x01(\x0b\x32\x11.gonslapi.L3Iface\"\x14\n\x12GetL3IfacesRequest\"5\n\x10GetL3IfacesReply\x12!\n\x06ifaces\x18\x01 \x03(\x0b\x32\x11.gonslapi.L3Iface\"\x8a\x01\n\x08L3Egress\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x0e\n\x06\x66lags2\x18\x02 \x01(\r\x12\x11\n\tegress_id\x18\x03 \x01(\r\x12\x10\n\x08iface_id\x18\x04 \x01(\r\x12\x0b\n\x03mac\x18\x05 \x01(\t\x12\x0b\n\x03vid\x18\x06 \x01(\r\x12\x0c\n\x04port\x18\x07 \x01(\r\x12\x12\n\nmpls_label\x18\x08 \x01(\r\"\x16\n\x14GetL3EgressesRequest\":\n\x12GetL3EgressesReply\x12$\n\x08\x65gresses\x18\x01 \x03(\x0b\x32\x12.gonslapi.L3Egress\"g\n\x06L3Host\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\tegress_id\x18\x02 \x01(\r\x12\x0f\n\x07ip_addr\x18\x03 \x01(\t\x12\x10\n\x08ip6_addr\x18\x04 \x01(\t\x12\x0b\n\x03mac\x18\x05 \x01(\t\x12\x0b\n\x03vrf\x18\x06 \x01(\r\"\x13\n\x11GetL3HostsRequest\"2\n\x0fGetL3HostsReply\x12\x1f\n\x05hosts\x18\x01 \x03(\x0b\x32\x10.gonslapi.L3Host\"[\n\x07L3Route\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\tegress_id\x18\x02 \x01(\r\x12\x0f\n\x07ip_addr\x18\x03 \x01(\t\x12\x10\n\x08ip6_addr\x18\x04 \x01(\t\x12\x0b\n\x03vrf\x18\x05 \x01(\r\"\x14\n\x12GetL3RoutesRequest\"5\n\x10GetL3RoutesReply\x12!\n\x06routes\x18\x01 \x03(\x0b\x32\x11.gonslapi.L3Route\"\xeb\x01\n\x0fTunnelInitiator\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\ttunnel_id\x18\x02 \x01(\r\x12\x13\n\x0btunnel_type\x18\x03 \x01(\t\x12\x13\n\x0bl3_iface_id\x18\x04 \x01(\r\x12\x0f\n\x07\x64st_mac\x18\x05 \x01(\t\x12\x0f\n\x07src_mac\x18\x06 \x01(\t\x12\x0e\n\x06\x64st_ip\x18\x07 \x01(\t\x12\x0e\n\x06src_ip\x18\x08 \x01(\t\x12\x10\n\x08\x64st_port\x18\t \x01(\r\x12\x10\n\x08src_port\x18\n \x01(\r\x12\x0b\n\x03ttl\x18\x0b \x01(\r\x12\x0b\n\x03mtu\x18\x0c \x01(\r\x12\x0c\n\x04vlan\x18\r \x01(\r\"\xcb\x01\n\x10TunnelTerminator\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\x11\n\ttunnel_id\x18\x02 \x01(\r\x12\x13\n\x0btunnel_type\x18\x03 \x01(\t\x12\x13\n\x0bremote_port\x18\x04 \x01(\r\x12\x0e\n\x06\x64st_ip\x18\x05 \x01(\t\x12\x0e\n\x06src_ip\x18\x06 \x01(\t\x12\x10\n\x08\x64st_port\x18\x07 \x01(\r\x12\x10\n\x08src_port\x18\x08 \x01(\r\x12\x0c\n\x04vlan\x18\t \x01(\r\x12\x0b\n\x03vrf\x18\n \x01(\r\x12\x0c\n\x04pbmp\x18\x0b \x03(\r\"\x1c\n\x1aGetTunnelInitiatorsRequest\"F\n\x18GetTunnelInitiatorsReply\x12*\n\x07tunnels\x18\x01 \x03(\x0b\x32\x19.gonslapi.TunnelInitiator\"\x1d\n\x1bGetTunnelTerminatorsRequest\"H\n\x19GetTunnelTerminatorsReply\x12+\n\x07tunnels\x18\x01 \x03(\x0b\x32\x1a.gonslapi.TunnelTerminator\":\n\x13MPLSTunnelInitiator\x12\x13\n\x0bl3_iface_id\x18\x01 \x01(\r\x12\x0e\n\x06labels\x18\x02 \x03(\r\"\x84\x01\n\x10MPLSTunnelSwitch\x12\r\n\x05\x66lags\x18\x01 \x01(\r\x12\r\n\x05label\x18\x02 \x01(\r\x12\x0e\n\x06\x61\x63tion\x18\x03 \x01(\t\x12\x0c\n\x04port\x18\x04 \x01(\r\x12\x0b\n\x03vrf\x18\x05 \x01(\r\x12\x11\n\tegress_id\x18\x06 \x01(\r\x12\x14\n\x0c\x65gress_label\x18\x07 \x01(\r\" \n\x1eGetMPLSTunnelInitiatorsRequest\"N\n\x1cGetMPLSTunnelInitiatorsReply\x12.\n\x07tunnels\x18\x01 \x03(\x0b\x32\x1d.gonslapi.MPLSTunnelInitiator\"\x1e\n\x1cGetMPLSTunnelSwitchesRequest\"J\n\x1aGetMPLSTunnelSwitchesReply\x12,\n\x08switches\x18\x01 \x03(\x0b\x32\x1a.gonslapi.MPLSTunnelSwitch\"6\n\nIDMapEntry\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\r\"\x18\n\x16GetIDMapEntriesRequest\"=\n\x14GetIDMapEntriesReply\x12%\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x14.gonslapi.IDMapEntry\"\xed\x02\n\rMirrorSession\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04type\x18\x02 \x01(\x0e\x32\x1c.gonslapi.MirrorSession.Type\x12\x34\n\tdirection\x18\x03 \x01(\x0e\x32!.gonslapi.MirrorSession.Direction\x12\x11\n\tsrc_ports\x18\x04 \x03(\r\x12\x10\n\x08\x64st_port\x18\x05 \x01(\r\x12\x0e\n\x06src_ip\x18\x06 \x01(\t\x12\x0e\n\x06\x64st_ip\x18\x07 \x01(\t\x12\x0f\n\x07src_mac\x18\x08 \x01(\t\x12\x0f\n\x07\x64st_mac\x18\t \x01(\t\x12\x0c\n\x04vlan\x18\n \x01(\r\x12\x0b\n\x03ttl\x18\x0b \x01(\r\x12\x0b\n\x03tos\x18\x0c \x01(\r\x12\x0f\n\x07\x64\x65st_id\x18\r \x01(\r\"\x1c\n\x04Type\x12\x08\n\x04SPAN\x10\x00\x12\n\n\x06\x45RSPAN\x10\x01\".\n\tDirection\x12\x08\n\x04\x42OTH\x10\x00\x12\x0b\n\x07INGRESS\x10\x01\x12\n\n\x06\x45GRESS\x10\x02\"C\n\x17\x41\x64\x64MirrorSessionRequest\x12(\n\x07session\x18\x01 \x01(\x0b\x32\x17.gonslapi.MirrorSession\"\x17\n\x15\x41\x64\x64MirrorSessionReply\"\'\n\x17\x44\x65lMirrorSessionRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x17\n\x15\x44\x65lMirrorSessionReply\"\x1a\n\x18GetMirrorSessionsRequest\"C\n\x16GetMirrorSessionsReply\x12)\n\x08sessions\x18\x01 \x03(\x0b\x32\x17.gonslapi.MirrorSession\"w\n\x0e\x43oppClassStats\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05queue\x18\x02 \x01(\r\x12\x0b\n\x03pps\x18\x03 \x01(\r\x12\r\n\x05\x62urst\x18\x04 \x01(\r\x12\x16\n\x0e\x61\x63\x63\x65pt_packets\x18\x05 \x01(\x04\x12\x14\n\x0c\x64rop_packets\x18\x06 \x01(\x04\"\x15\n\x13GetCoppStatsRequest\"<\n\x11GetCoppStatsReply\x12\'\n\x05stats\x18\x01 \x03(\x0b\x32\x18.gonslapi.CoppClassStats\"\xea\x01\n\x0bTrunkMember\x12\x0c\n\x04port\x18\x01 \x01(\r\x12\x0f\n\x07\x65nabled\x18\x02 \x01(\x08\x12\x0f\n\x07link_up\x18\x03 \x01(\x08\x12\x0e\n\x06\x61\x63tive\x18\x04 \x01(\x08\x12\x12\n\ncollecting\x18\x05 \x01(\x08\x12\x14\n\x0c\x64istributing\x18\x06 \x01(\x08\x12\x15\n\raggregator_id\x18\x07 \x01(\r\x12\x11\n\tin_octets\x18\x08 \x01(\x04\x12\x18\n\x10in_ucast_packets\x18\t \x01(\x04\x12\x12\n\nout_octets\x18\n \x01(\x04\x12\x19\n\x11out_ucast_packets\x18\x0b \x01(\x04\"\xbe\x01\n\x05Trunk\x12\x10\n\x08trunk_id\x18\x01 \x01(\r\x12\x0f\n\x07port_id\x18\x02 \x01(\r\x12\x10\n\x08vlan_vid\x18\x03 \x01(\r\x12\x0c\n\x04mode\x18\x04 \x01(\t\x12\x13\n\x0bhash_policy\x18\x05 \x01(\t\x12\x0b\n\x03psc\x18\x06 \x01(\t\x12\x11\n\tmin_links\x18\x07 \x01(\r\x12\x15\n\raggregator_id\x18\x08 \x01(\r\x12&\n\x07members\x18\t \x03(\x0b\x32\x15.gonslapi.TrunkMember\"\x12\n\x10GetTrunksRequest\"1\n\x0eGetTrunksReply\x12\x1f\n\x06trunks\x18\x01 \x03(\x0b\x32\x0f.gonslapi.Trunk2\xe8\r\n\x08GoNSLApi\x12U\n\x0fGetFieldEntries\x12 .gonslapi.GetFieldEntriesRequest\x1a\x1e.gonslapi.GetFieldEntriesReply\"\x00\x12L\n\x0cGetPortInfos\x12\x1d.gonslapi.GetPortInfosRequest\x1a\x1b.gonslapi.GetPortInfosReply\"\x00\x12@\n\x08GetVlans\x12\x19.gonslapi.GetVlansRequest\x1a\x17.gonslapi.GetVlansReply\"\x00\x12\x46\n\nGetL2Addrs\x12\x1b.gonslapi.GetL2AddrsRequest\x1a\x19.gonslapi.GetL2AddrsReply\"\x00\x12O\n\rGetL2Stations\x12\x1e.gonslapi.GetL2StationsRequest\x1a\x1c.gonslapi.GetL2StationsReply\"\x00\x12I\n\x0b\x46indL3Iface\x12\x1c.gonslapi.FindL3IfaceRequest\x1a\x1a.gonslapi.FindL3IfaceReply\"\x00\x12\x46\n\nGetL3Iface\x12\x1b.gonslapi.GetL3IfaceRequest\x1a\x19.gonslapi.GetL3IfaceReply\"\x00\x12I\n\x0bGetL3Ifaces\x12\x1c.gonslapi.GetL3IfacesRequest\x1a\x1a.gonslapi.GetL3IfacesReply\"\x00\x12O\n\rGetL3Egresses\x12\x1e.gonslapi.GetL3EgressesRequest\x1a\x1c.gonslapi.GetL3EgressesReply\"\x00\x12\x46\n\nGetL3Hosts\x12\x1b.gonslapi.GetL3HostsRequest\x1a\x19.gonslapi.GetL3HostsReply\"\x00\x12I\n\x0bGetL3Routes\x12\x1c.gonslapi.GetL3RoutesRequest\x1a\x1a.gonslapi.GetL3RoutesReply\"\x00\x12U\n\x0fGetIDMapEntries\x12 .gonslapi.GetIDMapEntriesRequest\x1a\x1e.gonslapi.GetIDMapEntriesReply\"\x00\x12\x61\n\x13GetTunnelInitiators\x12$.gonslapi.GetTunnelInitiatorsRequest\x1a\".gonslapi.GetTunnelInitiatorsReply\"\x00\x12\x64\n\x14GetTunnelTerminators\x12%.gonslapi.GetTunnelTerminatorsRequest\x1a#.gonslapi.GetTunnelTerminatorsReply\"\x00\x12m\n\x17GetMPLSTunnelInitiators\x12(.gonslapi.GetMPLSTunnelInitiatorsRequest\x1a&.gonslapi.GetMPLSTunnelInitiatorsReply\"\x00\x12g\n\x15GetMPLSTunnelSwitches\x12&.gonslapi.GetMPLSTunnelSwitchesRequest\x1a$.gonslapi.GetMPLSTunnelSwitchesReply\"\x00\x12X\n\x10\x41\x64\x64MirrorSession\x12!.gonslapi.AddMirrorSessionRequest\x1a\x1f.gonslapi.AddMirrorSessionReply\"\x00\x12X\n\x10\x44\x65lMirrorSession\x12!.gonslapi.DelMirrorSessionRequest\x1a\x1f.gonslapi.DelMirrorSessionReply\"\x00\x12[\n\x11GetMirrorSessions\x12\".gonslapi.GetMirrorSessionsRequest\x1a .gonslapi.GetMirrorSessionsReply\"\x00\x12L\n\x0cGetCoppStats\x12\x1d.gonslapi.GetCoppStatsRequest\x1a\x1b.gonslapi.GetCoppStatsReply\"\x00\x12\x43\n\tGetTrunks\x12\x1a.gonslapi.GetTrunksRequest\x1a\x18.gonslapi.GetTrunksReply\"\x00\x62\x06proto3')
 )
 
 
@@ -58,6 +58,54 @@ _FIELDENTRY_ENTRYTYPE = _descriptor.EnumDescriptor(
 )
 _sym_db.RegisterEnumDescriptor(_FIELDENTRY_ENTRYTYPE)
 
+_MIRRORSESSION_TYPE = _descriptor.EnumDescriptor(
+  name='Type',
+  full_name='gonslapi.MirrorSession.Type',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='SPAN', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ERSPAN', index=1, number=1,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3856,
+  serialized_end=3884,
+)
+_sym_db.RegisterEnumDescriptor(_MIRRORSESSION_TYPE)
+
+_MIRRORSESSION_DIRECTION = _descriptor.EnumDescriptor(
+  name='Direction',
+  full_name='gonslapi.MirrorSession.Direction',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='BOTH', index=0, number=0,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='INGRESS', index=1, number=1,
+      serialized_options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='EGRESS', index=2, number=2,
+      serialized_options=None,
+      type=None),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3886,
+  serialized_end=3932,
+)
+_sym_db.RegisterEnumDescriptor(_MIRRORSESSION_DIRECTION)
+
 
 _FIELDENTRY = _descriptor.Descriptor(
   name='FieldEntry',
@@ -1107,6 +1155,13 @@ _L3EGRESS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mpls_label', full_name='gonslapi.L3Egress.mpls_label', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1119,8 +1174,8 @@ _L3EGRESS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1780,
-  serialized_end=1898,
+  serialized_start=1781,
+  serialized_end=1919,
 )
 
 
@@ -1143,8 +1198,8 @@ _GETL3EGRESSESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1900,
-  serialized_end=1922,
+  serialized_start=1921,
+  serialized_end=1943,
 )
 
 
@@ -1174,8 +1229,8 @@ _GETL3EGRESSESREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1924,
-  serialized_end=1982,
+  serialized_start=1945,
+  serialized_end=2003,
 )
 
 
@@ -1240,8 +1295,8 @@ _L3HOST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1984,
-  serialized_end=2087,
+  serialized_start=2005,
+  serialized_end=2108,
 )
 
 
@@ -1264,8 +1319,8 @@ _GETL3HOSTSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2089,
-  serialized_end=2108,
+  serialized_start=2110,
+  serialized_end=2129,
 )
 
 
@@ -1295,8 +1350,8 @@ _GETL3HOSTSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2110,
-  serialized_end=2160,
+  serialized_start=2131,
+  serialized_end=2181,
 )
 
 
@@ -1354,8 +1409,8 @@ _L3ROUTE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2162,
-  serialized_end=2253,
+  serialized_start=2183,
+  serialized_end=2274,
 )
 
 
@@ -1378,8 +1433,8 @@ _GETL3ROUTESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2255,
-  serialized_end=2275,
+  serialized_start=2276,
+  serialized_end=2296,
 )
 
 
@@ -1409,8 +1464,8 @@ _GETL3ROUTESREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2277,
-  serialized_end=2330,
+  serialized_start=2298,
+  serialized_end=2351,
 )
 
 
@@ -1524,8 +1579,8 @@ _TUNNELINITIATOR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2333,
-  serialized_end=2568,
+  serialized_start=2354,
+  serialized_end=2589,
 )
 
 
@@ -1625,8 +1680,8 @@ _TUNNELTERMINATOR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2571,
-  serialized_end=2774,
+  serialized_start=2592,
+  serialized_end=2795,
 )
 
 
@@ -1649,8 +1704,8 @@ _GETTUNNELINITIATORSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2776,
-  serialized_end=2804,
+  serialized_start=2797,
+  serialized_end=2825,
 )
 
 
@@ -1680,8 +1735,8 @@ _GETTUNNELINITIATORSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2806,
-  serialized_end=2876,
+  serialized_start=2827,
+  serialized_end=2897,
 )
 
 
@@ -1704,8 +1759,8 @@ _GETTUNNELTERMINATORSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2878,
-  serialized_end=2907,
+  serialized_start=2899,
+  serialized_end=2928,
 )
 
 
@@ -1735,8 +1790,229 @@ _GETTUNNELTERMINATORSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2909,
-  serialized_end=2981,
+  serialized_start=2930,
+  serialized_end=3002,
+)
+
+
+_MPLSTUNNELINITIATOR = _descriptor.Descriptor(
+  name='MPLSTunnelInitiator',
+  full_name='gonslapi.MPLSTunnelInitiator',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='l3_iface_id', full_name='gonslapi.MPLSTunnelInitiator.l3_iface_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='gonslapi.MPLSTunnelInitiator.labels', index=1,
+      number=2, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3004,
+  serialized_end=3062,
+)
+
+
+_MPLSTUNNELSWITCH = _descriptor.Descriptor(
+  name='MPLSTunnelSwitch',
+  full_name='gonslapi.MPLSTunnelSwitch',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='flags', full_name='gonslapi.MPLSTunnelSwitch.flags', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='label', full_name='gonslapi.MPLSTunnelSwitch.label', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='action', full_name='gonslapi.MPLSTunnelSwitch.action', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='gonslapi.MPLSTunnelSwitch.port', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vrf', full_name='gonslapi.MPLSTunnelSwitch.vrf', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='egress_id', full_name='gonslapi.MPLSTunnelSwitch.egress_id', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='egress_label', full_name='gonslapi.MPLSTunnelSwitch.egress_label', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3065,
+  serialized_end=3197,
+)
+
+
+_GETMPLSTUNNELINITIATORSREQUEST = _descriptor.Descriptor(
+  name='GetMPLSTunnelInitiatorsRequest',
+  full_name='gonslapi.GetMPLSTunnelInitiatorsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3199,
+  serialized_end=3231,
+)
+
+
+_GETMPLSTUNNELINITIATORSREPLY = _descriptor.Descriptor(
+  name='GetMPLSTunnelInitiatorsReply',
+  full_name='gonslapi.GetMPLSTunnelInitiatorsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tunnels', full_name='gonslapi.GetMPLSTunnelInitiatorsReply.tunnels', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3233,
+  serialized_end=3311,
+)
+
+
+_GETMPLSTUNNELSWITCHESREQUEST = _descriptor.Descriptor(
+  name='GetMPLSTunnelSwitchesRequest',
+  full_name='gonslapi.GetMPLSTunnelSwitchesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3313,
+  serialized_end=3343,
+)
+
+
+_GETMPLSTUNNELSWITCHESREPLY = _descriptor.Descriptor(
+  name='GetMPLSTunnelSwitchesReply',
+  full_name='gonslapi.GetMPLSTunnelSwitchesReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='switches', full_name='gonslapi.GetMPLSTunnelSwitchesReply.switches', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3345,
+  serialized_end=3419,
 )
 
 
@@ -1780,8 +2056,8 @@ _IDMAPENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2983,
-  serialized_end=3037,
+  serialized_start=3421,
+  serialized_end=3475,
 )
 
 
@@ -1804,8 +2080,8 @@ _GETIDMAPENTRIESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3039,
-  serialized_end=3063,
+  serialized_start=3477,
+  serialized_end=3501,
 )
 
 
@@ -1835,43 +2111,700 @@ _GETIDMAPENTRIESREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3065,
-  serialized_end=3126,
+  serialized_start=3503,
+  serialized_end=3564,
 )
 
-_FIELDENTRY.fields_by_name['entry_type'].enum_type = _FIELDENTRY_ENTRYTYPE
-_FIELDENTRY.fields_by_name['eth_type'].message_type = _ETHTYPEFIELDENTRY
-_FIELDENTRY.fields_by_name['dst_ip'].message_type = _DSTIPFIELDENTRY
-_FIELDENTRY.fields_by_name['ip_proto'].message_type = _IPPROTOFIELDENTRY
-_FIELDENTRY.fields_by_name['eth_dst'].message_type = _ETHDSTFIELDENTRY
-_FIELDENTRY_ENTRYTYPE.containing_type = _FIELDENTRY
-_FIELDENTRY.oneofs_by_name['entry'].fields.append(
-  _FIELDENTRY.fields_by_name['eth_type'])
-_FIELDENTRY.fields_by_name['eth_type'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
-_FIELDENTRY.oneofs_by_name['entry'].fields.append(
-  _FIELDENTRY.fields_by_name['dst_ip'])
-_FIELDENTRY.fields_by_name['dst_ip'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
-_FIELDENTRY.oneofs_by_name['entry'].fields.append(
-  _FIELDENTRY.fields_by_name['ip_proto'])
-_FIELDENTRY.fields_by_name['ip_proto'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
-_FIELDENTRY.oneofs_by_name['entry'].fields.append(
-  _FIELDENTRY.fields_by_name['eth_dst'])
-_FIELDENTRY.fields_by_name['eth_dst'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
-_GETFIELDENTRIESREPLY.fields_by_name['entries'].message_type = _FIELDENTRY
-_GETPORTINFOSREPLY.fields_by_name['port_infos'].message_type = _PORTINFO
-_GETVLANSREPLY.fields_by_name['vlans'].message_type = _VLANENTRY
-_GETL2ADDRSREPLY.fields_by_name['addrs'].message_type = _L2ADDR
-_GETL2STATIONSREPLY.fields_by_name['stations'].message_type = _L2STATION
-_FINDL3IFACEREPLY.fields_by_name['iface'].message_type = _L3IFACE
-_GETL3IFACEREPLY.fields_by_name['iface'].message_type = _L3IFACE
-_GETL3IFACESREPLY.fields_by_name['ifaces'].message_type = _L3IFACE
-_GETL3EGRESSESREPLY.fields_by_name['egresses'].message_type = _L3EGRESS
-_GETL3HOSTSREPLY.fields_by_name['hosts'].message_type = _L3HOST
-_GETL3ROUTESREPLY.fields_by_name['routes'].message_type = _L3ROUTE
-_GETTUNNELINITIATORSREPLY.fields_by_name['tunnels'].message_type = _TUNNELINITIATOR
-_GETTUNNELTERMINATORSREPLY.fields_by_name['tunnels'].message_type = _TUNNELTERMINATOR
-_GETIDMAPENTRIESREPLY.fields_by_name['entries'].message_type = _IDMAPENTRY
-DESCRIPTOR.message_types_by_name['FieldEntry'] = _FIELDENTRY
+
+_MIRRORSESSION = _descriptor.Descriptor(
+  name='MirrorSession',
+  full_name='gonslapi.MirrorSession',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='gonslapi.MirrorSession.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='gonslapi.MirrorSession.type', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='direction', full_name='gonslapi.MirrorSession.direction', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src_ports', full_name='gonslapi.MirrorSession.src_ports', index=3,
+      number=4, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst_port', full_name='gonslapi.MirrorSession.dst_port', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src_ip', full_name='gonslapi.MirrorSession.src_ip', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst_ip', full_name='gonslapi.MirrorSession.dst_ip', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='src_mac', full_name='gonslapi.MirrorSession.src_mac', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dst_mac', full_name='gonslapi.MirrorSession.dst_mac', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan', full_name='gonslapi.MirrorSession.vlan', index=9,
+      number=10, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ttl', full_name='gonslapi.MirrorSession.ttl', index=10,
+      number=11, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='tos', full_name='gonslapi.MirrorSession.tos', index=11,
+      number=12, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dest_id', full_name='gonslapi.MirrorSession.dest_id', index=12,
+      number=13, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _MIRRORSESSION_TYPE,
+    _MIRRORSESSION_DIRECTION,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3567,
+  serialized_end=3932,
+)
+
+
+_ADDMIRRORSESSIONREQUEST = _descriptor.Descriptor(
+  name='AddMirrorSessionRequest',
+  full_name='gonslapi.AddMirrorSessionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='session', full_name='gonslapi.AddMirrorSessionRequest.session', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3934,
+  serialized_end=4001,
+)
+
+
+_ADDMIRRORSESSIONREPLY = _descriptor.Descriptor(
+  name='AddMirrorSessionReply',
+  full_name='gonslapi.AddMirrorSessionReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4003,
+  serialized_end=4026,
+)
+
+
+_DELMIRRORSESSIONREQUEST = _descriptor.Descriptor(
+  name='DelMirrorSessionRequest',
+  full_name='gonslapi.DelMirrorSessionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='gonslapi.DelMirrorSessionRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4028,
+  serialized_end=4067,
+)
+
+
+_DELMIRRORSESSIONREPLY = _descriptor.Descriptor(
+  name='DelMirrorSessionReply',
+  full_name='gonslapi.DelMirrorSessionReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4069,
+  serialized_end=4092,
+)
+
+
+_GETMIRRORSESSIONSREQUEST = _descriptor.Descriptor(
+  name='GetMirrorSessionsRequest',
+  full_name='gonslapi.GetMirrorSessionsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4094,
+  serialized_end=4120,
+)
+
+
+_GETMIRRORSESSIONSREPLY = _descriptor.Descriptor(
+  name='GetMirrorSessionsReply',
+  full_name='gonslapi.GetMirrorSessionsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sessions', full_name='gonslapi.GetMirrorSessionsReply.sessions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4122,
+  serialized_end=4189,
+)
+
+
+_COPPCLASSSTATS = _descriptor.Descriptor(
+  name='CoppClassStats',
+  full_name='gonslapi.CoppClassStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='gonslapi.CoppClassStats.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='queue', full_name='gonslapi.CoppClassStats.queue', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pps', full_name='gonslapi.CoppClassStats.pps', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='burst', full_name='gonslapi.CoppClassStats.burst', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='accept_packets', full_name='gonslapi.CoppClassStats.accept_packets', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='drop_packets', full_name='gonslapi.CoppClassStats.drop_packets', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4191,
+  serialized_end=4310,
+)
+
+
+_GETCOPPSTATSREQUEST = _descriptor.Descriptor(
+  name='GetCoppStatsRequest',
+  full_name='gonslapi.GetCoppStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4312,
+  serialized_end=4333,
+)
+
+
+_GETCOPPSTATSREPLY = _descriptor.Descriptor(
+  name='GetCoppStatsReply',
+  full_name='gonslapi.GetCoppStatsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='stats', full_name='gonslapi.GetCoppStatsReply.stats', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4335,
+  serialized_end=4395,
+)
+
+
+_TRUNKMEMBER = _descriptor.Descriptor(
+  name='TrunkMember',
+  full_name='gonslapi.TrunkMember',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='port', full_name='gonslapi.TrunkMember.port', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='enabled', full_name='gonslapi.TrunkMember.enabled', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='link_up', full_name='gonslapi.TrunkMember.link_up', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='active', full_name='gonslapi.TrunkMember.active', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='collecting', full_name='gonslapi.TrunkMember.collecting', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='distributing', full_name='gonslapi.TrunkMember.distributing', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='aggregator_id', full_name='gonslapi.TrunkMember.aggregator_id', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='in_octets', full_name='gonslapi.TrunkMember.in_octets', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='in_ucast_packets', full_name='gonslapi.TrunkMember.in_ucast_packets', index=8,
+      number=9, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='out_octets', full_name='gonslapi.TrunkMember.out_octets', index=9,
+      number=10, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='out_ucast_packets', full_name='gonslapi.TrunkMember.out_ucast_packets', index=10,
+      number=11, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4398,
+  serialized_end=4632,
+)
+
+
+_TRUNK = _descriptor.Descriptor(
+  name='Trunk',
+  full_name='gonslapi.Trunk',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trunk_id', full_name='gonslapi.Trunk.trunk_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port_id', full_name='gonslapi.Trunk.port_id', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_vid', full_name='gonslapi.Trunk.vlan_vid', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='gonslapi.Trunk.mode', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='hash_policy', full_name='gonslapi.Trunk.hash_policy', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='psc', full_name='gonslapi.Trunk.psc', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='min_links', full_name='gonslapi.Trunk.min_links', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='aggregator_id', full_name='gonslapi.Trunk.aggregator_id', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='members', full_name='gonslapi.Trunk.members', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4635,
+  serialized_end=4825,
+)
+
+
+_GETTRUNKSREQUEST = _descriptor.Descriptor(
+  name='GetTrunksRequest',
+  full_name='gonslapi.GetTrunksRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4827,
+  serialized_end=4845,
+)
+
+
+_GETTRUNKSREPLY = _descriptor.Descriptor(
+  name='GetTrunksReply',
+  full_name='gonslapi.GetTrunksReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trunks', full_name='gonslapi.GetTrunksReply.trunks', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4847,
+  serialized_end=4896,
+)
+
+_FIELDENTRY.fields_by_name['entry_type'].enum_type = _FIELDENTRY_ENTRYTYPE
+_FIELDENTRY.fields_by_name['eth_type'].message_type = _ETHTYPEFIELDENTRY
+_FIELDENTRY.fields_by_name['dst_ip'].message_type = _DSTIPFIELDENTRY
+_FIELDENTRY.fields_by_name['ip_proto'].message_type = _IPPROTOFIELDENTRY
+_FIELDENTRY.fields_by_name['eth_dst'].message_type = _ETHDSTFIELDENTRY
+_FIELDENTRY_ENTRYTYPE.containing_type = _FIELDENTRY
+_FIELDENTRY.oneofs_by_name['entry'].fields.append(
+  _FIELDENTRY.fields_by_name['eth_type'])
+_FIELDENTRY.fields_by_name['eth_type'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
+_FIELDENTRY.oneofs_by_name['entry'].fields.append(
+  _FIELDENTRY.fields_by_name['dst_ip'])
+_FIELDENTRY.fields_by_name['dst_ip'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
+_FIELDENTRY.oneofs_by_name['entry'].fields.append(
+  _FIELDENTRY.fields_by_name['ip_proto'])
+_FIELDENTRY.fields_by_name['ip_proto'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
+_FIELDENTRY.oneofs_by_name['entry'].fields.append(
+  _FIELDENTRY.fields_by_name['eth_dst'])
+_FIELDENTRY.fields_by_name['eth_dst'].containing_oneof = _FIELDENTRY.oneofs_by_name['entry']
+_GETFIELDENTRIESREPLY.fields_by_name['entries'].message_type = _FIELDENTRY
+_GETPORTINFOSREPLY.fields_by_name['port_infos'].message_type = _PORTINFO
+_GETVLANSREPLY.fields_by_name['vlans'].message_type = _VLANENTRY
+_GETL2ADDRSREPLY.fields_by_name['addrs'].message_type = _L2ADDR
+_GETL2STATIONSREPLY.fields_by_name['stations'].message_type = _L2STATION
+_FINDL3IFACEREPLY.fields_by_name['iface'].message_type = _L3IFACE
+_GETL3IFACEREPLY.fields_by_name['iface'].message_type = _L3IFACE
+_GETL3IFACESREPLY.fields_by_name['ifaces'].message_type = _L3IFACE
+_GETL3EGRESSESREPLY.fields_by_name['egresses'].message_type = _L3EGRESS
+_GETL3HOSTSREPLY.fields_by_name['hosts'].message_type = _L3HOST
+_GETL3ROUTESREPLY.fields_by_name['routes'].message_type = _L3ROUTE
+_GETTUNNELINITIATORSREPLY.fields_by_name['tunnels'].message_type = _TUNNELINITIATOR
+_GETTUNNELTERMINATORSREPLY.fields_by_name['tunnels'].message_type = _TUNNELTERMINATOR
+_GETMPLSTUNNELINITIATORSREPLY.fields_by_name['tunnels'].message_type = _MPLSTUNNELINITIATOR
+_GETMPLSTUNNELSWITCHESREPLY.fields_by_name['switches'].message_type = _MPLSTUNNELSWITCH
+_GETIDMAPENTRIESREPLY.fields_by_name['entries'].message_type = _IDMAPENTRY
+_MIRRORSESSION.fields_by_name['type'].enum_type = _MIRRORSESSION_TYPE
+_MIRRORSESSION.fields_by_name['direction'].enum_type = _MIRRORSESSION_DIRECTION
+_MIRRORSESSION_TYPE.containing_type = _MIRRORSESSION
+_MIRRORSESSION_DIRECTION.containing_type = _MIRRORSESSION
+_ADDMIRRORSESSIONREQUEST.fields_by_name['session'].message_type = _MIRRORSESSION
+_GETMIRRORSESSIONSREPLY.fields_by_name['sessions'].message_type = _MIRRORSESSION
+_GETCOPPSTATSREPLY.fields_by_name['stats'].message_type = _COPPCLASSSTATS
+_TRUNK.fields_by_name['members'].message_type = _TRUNKMEMBER
+_GETTRUNKSREPLY.fields_by_name['trunks'].message_type = _TRUNK
+DESCRIPTOR.message_types_by_name['FieldEntry'] = _FIELDENTRY
 DESCRIPTOR.message_types_by_name['EthDstFieldEntry'] = _ETHDSTFIELDENTRY
 DESCRIPTOR.message_types_by_name['EthTypeFieldEntry'] = _ETHTYPEFIELDENTRY
 DESCRIPTOR.message_types_by_name['DstIpFieldEntry'] = _DSTIPFIELDENTRY
@@ -1912,9 +2845,29 @@ DESCRIPTOR.message_types_by_name['GetTunnelInitiatorsRequest'] = _GETTUNNELINITI
 DESCRIPTOR.message_types_by_name['GetTunnelInitiatorsReply'] = _GETTUNNELINITIATORSREPLY
 DESCRIPTOR.message_types_by_name['GetTunnelTerminatorsRequest'] = _GETTUNNELTERMINATORSREQUEST
 DESCRIPTOR.message_types_by_name['GetTunnelTerminatorsReply'] = _GETTUNNELTERMINATORSREPLY
+DESCRIPTOR.message_types_by_name['MPLSTunnelInitiator'] = _MPLSTUNNELINITIATOR
+DESCRIPTOR.message_types_by_name['MPLSTunnelSwitch'] = _MPLSTUNNELSWITCH
+DESCRIPTOR.message_types_by_name['GetMPLSTunnelInitiatorsRequest'] = _GETMPLSTUNNELINITIATORSREQUEST
+DESCRIPTOR.message_types_by_name['GetMPLSTunnelInitiatorsReply'] = _GETMPLSTUNNELINITIATORSREPLY
+DESCRIPTOR.message_types_by_name['GetMPLSTunnelSwitchesRequest'] = _GETMPLSTUNNELSWITCHESREQUEST
+DESCRIPTOR.message_types_by_name['GetMPLSTunnelSwitchesReply'] = _GETMPLSTUNNELSWITCHESREPLY
 DESCRIPTOR.message_types_by_name['IDMapEntry'] = _IDMAPENTRY
 DESCRIPTOR.message_types_by_name['GetIDMapEntriesRequest'] = _GETIDMAPENTRIESREQUEST
 DESCRIPTOR.message_types_by_name['GetIDMapEntriesReply'] = _GETIDMAPENTRIESREPLY
+DESCRIPTOR.message_types_by_name['MirrorSession'] = _MIRRORSESSION
+DESCRIPTOR.message_types_by_name['AddMirrorSessionRequest'] = _ADDMIRRORSESSIONREQUEST
+DESCRIPTOR.message_types_by_name['AddMirrorSessionReply'] = _ADDMIRRORSESSIONREPLY
+DESCRIPTOR.message_types_by_name['DelMirrorSessionRequest'] = _DELMIRRORSESSIONREQUEST
+DESCRIPTOR.message_types_by_name['DelMirrorSessionReply'] = _DELMIRRORSESSIONREPLY
+DESCRIPTOR.message_types_by_name['GetMirrorSessionsRequest'] = _GETMIRRORSESSIONSREQUEST
+DESCRIPTOR.message_types_by_name['GetMirrorSessionsReply'] = _GETMIRRORSESSIONSREPLY
+DESCRIPTOR.message_types_by_name['CoppClassStats'] = _COPPCLASSSTATS
+DESCRIPTOR.message_types_by_name['GetCoppStatsRequest'] = _GETCOPPSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetCoppStatsReply'] = _GETCOPPSTATSREPLY
+DESCRIPTOR.message_types_by_name['TrunkMember'] = _TRUNKMEMBER
+DESCRIPTOR.message_types_by_name['Trunk'] = _TRUNK
+DESCRIPTOR.message_types_by_name['GetTrunksRequest'] = _GETTRUNKSREQUEST
+DESCRIPTOR.message_types_by_name['GetTrunksReply'] = _GETTRUNKSREPLY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 FieldEntry = _reflection.GeneratedProtocolMessageType('FieldEntry', (_message.Message,), dict(
@@ -2204,6 +3157,48 @@ GetTunnelTerminatorsReply = _reflection.GeneratedProtocolMessageType('GetTunnelT
   ))
 _sym_db.RegisterMessage(GetTunnelTerminatorsReply)
 
+MPLSTunnelInitiator = _reflection.GeneratedProtocolMessageType('MPLSTunnelInitiator', (_message.Message,), dict(
+  DESCRIPTOR = _MPLSTUNNELINITIATOR,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.MPLSTunnelInitiator)
+  ))
+_sym_db.RegisterMessage(MPLSTunnelInitiator)
+
+MPLSTunnelSwitch = _reflection.GeneratedProtocolMessageType('MPLSTunnelSwitch', (_message.Message,), dict(
+  DESCRIPTOR = _MPLSTUNNELSWITCH,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.MPLSTunnelSwitch)
+  ))
+_sym_db.RegisterMessage(MPLSTunnelSwitch)
+
+GetMPLSTunnelInitiatorsRequest = _reflection.GeneratedProtocolMessageType('GetMPLSTunnelInitiatorsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETMPLSTUNNELINITIATORSREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMPLSTunnelInitiatorsRequest)
+  ))
+_sym_db.RegisterMessage(GetMPLSTunnelInitiatorsRequest)
+
+GetMPLSTunnelInitiatorsReply = _reflection.GeneratedProtocolMessageType('GetMPLSTunnelInitiatorsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETMPLSTUNNELINITIATORSREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMPLSTunnelInitiatorsReply)
+  ))
+_sym_db.RegisterMessage(GetMPLSTunnelInitiatorsReply)
+
+GetMPLSTunnelSwitchesRequest = _reflection.GeneratedProtocolMessageType('GetMPLSTunnelSwitchesRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETMPLSTUNNELSWITCHESREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMPLSTunnelSwitchesRequest)
+  ))
+_sym_db.RegisterMessage(GetMPLSTunnelSwitchesRequest)
+
+GetMPLSTunnelSwitchesReply = _reflection.GeneratedProtocolMessageType('GetMPLSTunnelSwitchesReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETMPLSTUNNELSWITCHESREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMPLSTunnelSwitchesReply)
+  ))
+_sym_db.RegisterMessage(GetMPLSTunnelSwitchesReply)
+
 IDMapEntry = _reflection.GeneratedProtocolMessageType('IDMapEntry', (_message.Message,), dict(
   DESCRIPTOR = _IDMAPENTRY,
   __module__ = 'gonslapi_pb2'
@@ -2225,6 +3220,104 @@ GetIDMapEntriesReply = _reflection.GeneratedProtocolMessageType('GetIDMapEntries
   ))
 _sym_db.RegisterMessage(GetIDMapEntriesReply)
 
+MirrorSession = _reflection.GeneratedProtocolMessageType('MirrorSession', (_message.Message,), dict(
+  DESCRIPTOR = _MIRRORSESSION,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.MirrorSession)
+  ))
+_sym_db.RegisterMessage(MirrorSession)
+
+AddMirrorSessionRequest = _reflection.GeneratedProtocolMessageType('AddMirrorSessionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADDMIRRORSESSIONREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.AddMirrorSessionRequest)
+  ))
+_sym_db.RegisterMessage(AddMirrorSessionRequest)
+
+AddMirrorSessionReply = _reflection.GeneratedProtocolMessageType('AddMirrorSessionReply', (_message.Message,), dict(
+  DESCRIPTOR = _ADDMIRRORSESSIONREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.AddMirrorSessionReply)
+  ))
+_sym_db.RegisterMessage(AddMirrorSessionReply)
+
+DelMirrorSessionRequest = _reflection.GeneratedProtocolMessageType('DelMirrorSessionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DELMIRRORSESSIONREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.DelMirrorSessionRequest)
+  ))
+_sym_db.RegisterMessage(DelMirrorSessionRequest)
+
+DelMirrorSessionReply = _reflection.GeneratedProtocolMessageType('DelMirrorSessionReply', (_message.Message,), dict(
+  DESCRIPTOR = _DELMIRRORSESSIONREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.DelMirrorSessionReply)
+  ))
+_sym_db.RegisterMessage(DelMirrorSessionReply)
+
+GetMirrorSessionsRequest = _reflection.GeneratedProtocolMessageType('GetMirrorSessionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETMIRRORSESSIONSREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMirrorSessionsRequest)
+  ))
+_sym_db.RegisterMessage(GetMirrorSessionsRequest)
+
+GetMirrorSessionsReply = _reflection.GeneratedProtocolMessageType('GetMirrorSessionsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETMIRRORSESSIONSREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetMirrorSessionsReply)
+  ))
+_sym_db.RegisterMessage(GetMirrorSessionsReply)
+
+CoppClassStats = _reflection.GeneratedProtocolMessageType('CoppClassStats', (_message.Message,), dict(
+  DESCRIPTOR = _COPPCLASSSTATS,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.CoppClassStats)
+  ))
+_sym_db.RegisterMessage(CoppClassStats)
+
+GetCoppStatsRequest = _reflection.GeneratedProtocolMessageType('GetCoppStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCOPPSTATSREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetCoppStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetCoppStatsRequest)
+
+GetCoppStatsReply = _reflection.GeneratedProtocolMessageType('GetCoppStatsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETCOPPSTATSREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetCoppStatsReply)
+  ))
+_sym_db.RegisterMessage(GetCoppStatsReply)
+
+TrunkMember = _reflection.GeneratedProtocolMessageType('TrunkMember', (_message.Message,), dict(
+  DESCRIPTOR = _TRUNKMEMBER,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.TrunkMember)
+  ))
+_sym_db.RegisterMessage(TrunkMember)
+
+Trunk = _reflection.GeneratedProtocolMessageType('Trunk', (_message.Message,), dict(
+  DESCRIPTOR = _TRUNK,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.Trunk)
+  ))
+_sym_db.RegisterMessage(Trunk)
+
+GetTrunksRequest = _reflection.GeneratedProtocolMessageType('GetTrunksRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETTRUNKSREQUEST,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetTrunksRequest)
+  ))
+_sym_db.RegisterMessage(GetTrunksRequest)
+
+GetTrunksReply = _reflection.GeneratedProtocolMessageType('GetTrunksReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETTRUNKSREPLY,
+  __module__ = 'gonslapi_pb2'
+  # @@protoc_insertion_point(class_scope:gonslapi.GetTrunksReply)
+  ))
+_sym_db.RegisterMessage(GetTrunksReply)
+
 
 
 _GONSLAPI = _descriptor.ServiceDescriptor(
@@ -2233,8 +3326,8 @@ _GONSLAPI = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=3129,
-  serialized_end=4261,
+  serialized_start=4899,
+  serialized_end=6667,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetFieldEntries',
@@ -2362,6 +3455,69 @@ _GONSLAPI = _descriptor.ServiceDescriptor(
     output_type=_GETTUNNELTERMINATORSREPLY,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetMPLSTunnelInitiators',
+    full_name='gonslapi.GoNSLApi.GetMPLSTunnelInitiators',
+    index=14,
+    containing_service=None,
+    input_type=_GETMPLSTUNNELINITIATORSREQUEST,
+    output_type=_GETMPLSTUNNELINITIATORSREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetMPLSTunnelSwitches',
+    full_name='gonslapi.GoNSLApi.GetMPLSTunnelSwitches',
+    index=15,
+    containing_service=None,
+    input_type=_GETMPLSTUNNELSWITCHESREQUEST,
+    output_type=_GETMPLSTUNNELSWITCHESREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddMirrorSession',
+    full_name='gonslapi.GoNSLApi.AddMirrorSession',
+    index=16,
+    containing_service=None,
+    input_type=_ADDMIRRORSESSIONREQUEST,
+    output_type=_ADDMIRRORSESSIONREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DelMirrorSession',
+    full_name='gonslapi.GoNSLApi.DelMirrorSession',
+    index=17,
+    containing_service=None,
+    input_type=_DELMIRRORSESSIONREQUEST,
+    output_type=_DELMIRRORSESSIONREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetMirrorSessions',
+    full_name='gonslapi.GoNSLApi.GetMirrorSessions',
+    index=18,
+    containing_service=None,
+    input_type=_GETMIRRORSESSIONSREQUEST,
+    output_type=_GETMIRRORSESSIONSREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetCoppStats',
+    full_name='gonslapi.GoNSLApi.GetCoppStats',
+    index=19,
+    containing_service=None,
+    input_type=_GETCOPPSTATSREQUEST,
+    output_type=_GETCOPPSTATSREPLY,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetTrunks',
+    full_name='gonslapi.GoNSLApi.GetTrunks',
+    index=20,
+    containing_service=None,
+    input_type=_GETTRUNKSREQUEST,
+    output_type=_GETTRUNKSREPLY,
+    serialized_options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_GONSLAPI)
 
@@ -86,6 +86,41 @@ class GoNSLApiStub(object):
         request_serializer=gonslapi__pb2.GetTunnelTerminatorsRequest.SerializeToString,
         response_deserializer=gonslapi__pb2.GetTunnelTerminatorsReply.FromString,
         )
+    self.GetMPLSTunnelInitiators = channel.unary_unary(
+        '/gonslapi.GoNSLApi/GetMPLSTunnelInitiators',
+        request_serializer=gonslapi__pb2.GetMPLSTunnelInitiatorsRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.GetMPLSTunnelInitiatorsReply.FromString,
+        )
+    self.GetMPLSTunnelSwitches = channel.unary_unary(
+        '/gonslapi.GoNSLApi/GetMPLSTunnelSwitches',
+        request_serializer=gonslapi__pb2.GetMPLSTunnelSwitchesRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.GetMPLSTunnelSwitchesReply.FromString,
+        )
+    self.AddMirrorSession = channel.unary_unary(
+        '/gonslapi.GoNSLApi/AddMirrorSession',
+        request_serializer=gonslapi__pb2.AddMirrorSessionRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.AddMirrorSessionReply.FromString,
+        )
+    self.DelMirrorSession = channel.unary_unary(
+        '/gonslapi.GoNSLApi/DelMirrorSession',
+        request_serializer=gonslapi__pb2.DelMirrorSessionRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.DelMirrorSessionReply.FromString,
+        )
+    self.GetMirrorSessions = channel.unary_unary(
+        '/gonslapi.GoNSLApi/GetMirrorSessions',
+        request_serializer=gonslapi__pb2.GetMirrorSessionsRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.GetMirrorSessionsReply.FromString,
+        )
+    self.GetCoppStats = channel.unary_unary(
+        '/gonslapi.GoNSLApi/GetCoppStats',
+        request_serializer=gonslapi__pb2.GetCoppStatsRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.GetCoppStatsReply.FromString,
+        )
+    self.GetTrunks = channel.unary_unary(
+        '/gonslapi.GoNSLApi/GetTrunks',
+        request_serializer=gonslapi__pb2.GetTrunksRequest.SerializeToString,
+        response_deserializer=gonslapi__pb2.GetTrunksReply.FromString,
+        )
 
 
 class GoNSLApiServicer(object):
@@ -192,6 +227,55 @@ class GoNSLApiServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetMPLSTunnelInitiators(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetMPLSTunnelSwitches(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AddMirrorSession(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DelMirrorSession(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetMirrorSessions(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetCoppStats(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetTrunks(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_GoNSLApiServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -265,6 +349,41 @@ def add_GoNSLApiServicer_to_server(servicer, server):
           request_deserializer=gonslapi__pb2.GetTunnelTerminatorsRequest.FromString,
           response_serializer=gonslapi__pb2.GetTunnelTerminatorsReply.SerializeToString,
       ),
+      'GetMPLSTunnelInitiators': grpc.unary_unary_rpc_method_handler(
+          servicer.GetMPLSTunnelInitiators,
+          request_deserializer=gonslapi__pb2.GetMPLSTunnelInitiatorsRequest.FromString,
+          response_serializer=gonslapi__pb2.GetMPLSTunnelInitiatorsReply.SerializeToString,
+      ),
+      'GetMPLSTunnelSwitches': grpc.unary_unary_rpc_method_handler(
+          servicer.GetMPLSTunnelSwitches,
+          request_deserializer=gonslapi__pb2.GetMPLSTunnelSwitchesRequest.FromString,
+          response_serializer=gonslapi__pb2.GetMPLSTunnelSwitchesReply.SerializeToString,
+      ),
+      'AddMirrorSession': grpc.unary_unary_rpc_method_handler(
+          servicer.AddMirrorSession,
+          request_deserializer=gonslapi__pb2.AddMirrorSessionRequest.FromString,
+          response_serializer=gonslapi__pb2.AddMirrorSessionReply.SerializeToString,
+      ),
+      'DelMirrorSession': grpc.unary_unary_rpc_method_handler(
+          servicer.DelMirrorSession,
+          request_deserializer=gonslapi__pb2.DelMirrorSessionRequest.FromString,
+          response_serializer=gonslapi__pb2.DelMirrorSessionReply.SerializeToString,
+      ),
+      'GetMirrorSessions': grpc.unary_unary_rpc_method_handler(
+          servicer.GetMirrorSessions,
+          request_deserializer=gonslapi__pb2.GetMirrorSessionsRequest.FromString,
+          response_serializer=gonslapi__pb2.GetMirrorSessionsReply.SerializeToString,
+      ),
+      'GetCoppStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetCoppStats,
+          request_deserializer=gonslapi__pb2.GetCoppStatsRequest.FromString,
+          response_serializer=gonslapi__pb2.GetCoppStatsReply.SerializeToString,
+      ),
+      'GetTrunks': grpc.unary_unary_rpc_method_handler(
+          servicer.GetTrunks,
+          request_deserializer=gonslapi__pb2.GetTrunksRequest.FromString,
+          response_serializer=gonslapi__pb2.GetTrunksReply.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'gonslapi.GoNSLApi', rpc_method_handlers)
@@ -26,7 +26,7 @@ import (
 )
 
 func printL3Egress(l3egr *api.L3Egress) {
-	fmt.Printf("L3Egress: flags:%08x/%08x l3egrId:%d ifaceId:%d mac:%s vid:%d port:%d\n",
+	fmt.Printf("L3Egress: flags:%08x/%08x l3egrId:%d ifaceId:%d mac:%s vid:%d port:%d label:%d\n",
 		l3egr.GetFlags(),
 		l3egr.GetFlags2(),
 		l3egr.GetEgressId(),
@@ -34,6 +34,7 @@ func printL3Egress(l3egr *api.L3Egress) {
 		l3egr.GetMac(),
 		l3egr.GetVid(),
 		l3egr.GetPort(),
+		l3egr.GetMplsLabel(),
 	)
 }
 
@@ -69,6 +69,8 @@ func main() {
 	dumpL3Routes(client)
 	dumpTunnelInitiators(client)
 	dumpTunnelTerminators(client)
+	dumpMPLSTunnelInitiators(client)
+	dumpMPLSTunnelSwitches(client)
 	dumpIDMapEntries(client)
 	// dumpPortInfos(client)
 }
@@ -0,0 +1,69 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	api "gonsl/api"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func printMPLSTunnelInitiator(tun *api.MPLSTunnelInitiator) {
+	fmt.Printf("MPLS(Init): ifaceId:%d labels:%v\n",
+		tun.GetL3IfaceId(),
+		tun.GetLabels(),
+	)
+}
+
+func dumpMPLSTunnelInitiators(client api.GoNSLApiClient) {
+	reply, err := client.GetMPLSTunnelInitiators(context.Background(), api.NewGetMPLSTunnelInitiatorsRequest())
+	if err != nil {
+		log.Errorf("GetMPLSTunnelInitiators error. %s", err)
+		return
+	}
+
+	for _, tun := range reply.Tunnels {
+		printMPLSTunnelInitiator(tun)
+	}
+}
+
+func printMPLSTunnelSwitch(tsw *api.MPLSTunnelSwitch) {
+	fmt.Printf("MPLS(Switch): flags:%08x label:%d action:%s port:%d vrf:%d l3egrId:%d egrLabel:%d\n",
+		tsw.GetFlags(),
+		tsw.GetLabel(),
+		tsw.GetAction(),
+		tsw.GetPort(),
+		tsw.GetVrf(),
+		tsw.GetEgressId(),
+		tsw.GetEgressLabel(),
+	)
+}
+
+func dumpMPLSTunnelSwitches(client api.GoNSLApiClient) {
+	reply, err := client.GetMPLSTunnelSwitches(context.Background(), api.NewGetMPLSTunnelSwitchesRequest())
+	if err != nil {
+		log.Errorf("GetMPLSTunnelSwitches error. %s", err)
+		return
+	}
+
+	for _, tsw := range reply.Switches {
+		printMPLSTunnelSwitch(tsw)
+	}
+}
@@ -0,0 +1,53 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	halsim "gonsl/hal/sim"
+	gonslib "gonsl/lib"
+)
+
+func newSimHAL(cfg *gonslib.SimConfig) hal.HAL {
+	if len(cfg.Ports) == 0 {
+		return halsim.NewSimWithPortRange(hal.Port(cfg.Min), hal.Port(cfg.Max))
+	}
+
+	ports := make([]hal.Port, len(cfg.Ports))
+	for index, port := range cfg.Ports {
+		ports[index] = hal.Port(port)
+	}
+	return halsim.NewSim(ports...)
+}
+
+//
+// newHAL returns hal backend specified by config.
+//
+func newHAL(dpcfg *gonslib.DpConfig, args *gonslib.Args) (hal.HAL, error) {
+	switch backend := dpcfg.GetBackend(); backend {
+	case gonslib.BackendOpenNSL:
+		return newONSLHAL(dpcfg, args)
+
+	case gonslib.BackendSim:
+		return newSimHAL(&dpcfg.Sim), nil
+
+	default:
+		return nil, fmt.Errorf("Invalid backend. %s", backend)
+	}
+}
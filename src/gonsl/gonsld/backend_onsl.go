@@ -0,0 +1,37 @@
+// -*- coding: utf-8 -*-
+// +build !nosdk
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	hal "gonsl/hal"
+	halonsl "gonsl/hal/onsl"
+	gonslib "gonsl/lib"
+)
+
+//
+// newONSLHAL returns OpenNSL backend.
+//
+func newONSLHAL(dpcfg *gonslib.DpConfig, args *gonslib.Args) (hal.HAL, error) {
+	cfgFname := ""
+	if dpcfg.OpenNSL != nil {
+		cfgFname = dpcfg.OpenNSL.Config
+	}
+
+	return halonsl.NewONSL(dpcfg.Unit, cfgFname, args.UseSim), nil
+}
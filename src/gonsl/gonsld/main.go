@@ -22,8 +22,10 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/sevlyar/go-daemon"
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 func watchSignal(done chan struct{}) {
@@ -39,6 +41,36 @@ func watchSignal(done chan struct{}) {
 	close(done)
 }
 
+//
+// watchConfig reloads qos config when config file is changed.
+// Other settings take effect after restart.
+//
+func watchConfig(v *viper.Viper, dpName string, s *gonslib.Server) {
+	v.OnConfigChange(func(e fsnotify.Event) {
+		log.Infof("Config changed. %s", e.Name)
+
+		cfg := gonslib.NewConfig()
+		if err := v.UnmarshalExact(cfg); err != nil {
+			log.Errorf("Config reload error. %s", err)
+			return
+		}
+
+		dpcfg, err := cfg.GetDpConfig(dpName)
+		if err != nil {
+			log.Errorf("Config reload error. %s", err)
+			return
+		}
+
+		if err := s.QosConfigure(&dpcfg.Qos); err != nil {
+			log.Errorf("Qos reload error. %s", err)
+			return
+		}
+
+		log.Infof("Qos reloaded.")
+	})
+	v.WatchConfig()
+}
+
 func startDaemon(args *gonslib.Args) *daemon.Context {
 	ctx := &daemon.Context{
 		PidFileName: args.PidFile,
@@ -77,7 +109,8 @@ func main() {
 	}
 
 	cfg := gonslib.NewConfig()
-	if _, err := cfg.ReadFile(args); err != nil {
+	v, err := cfg.ReadFile(args)
+	if err != nil {
 		log.Errorf("Config read error. %s", err)
 		os.Exit(1)
 	}
@@ -93,27 +126,34 @@ func main() {
 	log.Debugf("DpConfig  : %s", dpcfg)
 	log.Debugf("BlockBcast: %s", &dpcfg.BlockBcast)
 	log.Debugf("L2SW      : %s", &dpcfg.L2SW)
+	log.Debugf("Sim       : %s", &dpcfg.Sim)
+	log.Debugf("Qos       : %s", &dpcfg.Qos)
+	log.Debugf("SFlow     : %s", &dpcfg.SFlow)
+	log.Debugf("Copp      : %s", &dpcfg.Copp)
+	log.Debugf("Lag       : %s", &dpcfg.Lag)
 	for _, bp := range dpcfg.BlockBcast.Ports {
 		log.Debugf("BlockBcast: %s", bp)
 	}
 	log.Debugf("LogConfig : %s", &cfg.Logging)
 
-	if args.UseSim {
-		gonslib.SimInit(dpcfg.Unit)
+	h, err := newHAL(dpcfg, args)
+	if err != nil {
+		log.Errorf("%s", err)
+		os.Exit(1)
 	}
 
-	if err := gonslib.DriverInit(dpcfg.Unit, dpcfg.OpenNSL); err != nil {
+	if err := h.Init(); err != nil {
 		log.Errorf("DriverInit error. %s", err)
 		os.Exit(1)
 	}
-	defer gonslib.DriverExit()
+	defer h.Exit()
 
-	log.Infof("OpenNSL Driver initialized. unit=%d config=%v", dpcfg.Unit, dpcfg.OpenNSL)
+	log.Infof("Driver initialized. backend=%s unit=%d", dpcfg.GetBackend(), dpcfg.Unit)
 
-	gonslib.DriverInfo(dpcfg.Unit)
+	gonslib.DriverInfo(h)
 
 	done := make(chan struct{})
-	s := gonslib.NewServer(dpcfg, &cfg.Logging)
+	s := gonslib.NewServer(h, dpcfg, &cfg.Logging)
 	if err := s.Start(done); err != nil {
 		log.Errorf("Server start error. %s", err)
 		os.Exit(1)
@@ -125,6 +165,8 @@ func main() {
 		os.Exit(1)
 	}
 
+	watchConfig(v, args.DpName, s)
+
 	go watchSignal(done)
 	log.Debugf("Initialize ok: %v", dpcfg)
 
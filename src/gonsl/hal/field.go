@@ -0,0 +1,259 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// FieldGroupID is field processor group id.
+//
+type FieldGroupID int
+
+//
+// FieldEntryID is field processor entry id.
+//
+type FieldEntryID int
+
+//
+// FieldQualify is qualifier of field group.
+//
+type FieldQualify int
+
+const (
+	FieldQualifyInPort FieldQualify = iota
+	FieldQualifyEtherType
+	FieldQualifyOuterVlanId
+	FieldQualifyDstMac
+	FieldQualifySrcIp
+	FieldQualifyDstIp
+	FieldQualifySrcIp6
+	FieldQualifyDstIp6
+	FieldQualifyIpProtocol
+	FieldQualifyDSCP
+	FieldQualifyL4SrcPort
+	FieldQualifyL4DstPort
+	FieldQualifyTtl
+	FieldQualifyDstIpLocal
+)
+
+var fieldQualifyNames = map[FieldQualify]string{
+	FieldQualifyInPort:      "InPort",
+	FieldQualifyEtherType:   "EtherType",
+	FieldQualifyOuterVlanId: "OuterVlanId",
+	FieldQualifyDstMac:      "DstMac",
+	FieldQualifySrcIp:       "SrcIp",
+	FieldQualifyDstIp:       "DstIp",
+	FieldQualifySrcIp6:      "SrcIp6",
+	FieldQualifyDstIp6:      "DstIp6",
+	FieldQualifyIpProtocol:  "IpProtocol",
+	FieldQualifyDSCP:        "DSCP",
+	FieldQualifyL4SrcPort:   "L4SrcPort",
+	FieldQualifyL4DstPort:   "L4DstPort",
+	FieldQualifyTtl:         "Ttl",
+	FieldQualifyDstIpLocal:  "DstIpLocal",
+}
+
+func (v FieldQualify) String() string {
+	if s, ok := fieldQualifyNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("FieldQualify(%d)", v)
+}
+
+//
+// FieldActionType is type of field action.
+//
+type FieldActionType int
+
+const (
+	FieldActionCopyToCpu FieldActionType = iota
+	FieldActionCosQCpuNew
+	FieldActionDrop
+	FieldActionMirrorIngress
+	FieldActionCosQNew
+	FieldActionDscpNew
+	FieldActionVrfSet
+	FieldActionRpCopyToCpuCancel
+)
+
+var fieldActionTypeNames = map[FieldActionType]string{
+	FieldActionCopyToCpu:         "CopyToCpu",
+	FieldActionCosQCpuNew:        "CosQCpuNew",
+	FieldActionDrop:              "Drop",
+	FieldActionMirrorIngress:     "MirrorIngress",
+	FieldActionCosQNew:           "CosQNew",
+	FieldActionDscpNew:           "DscpNew",
+	FieldActionVrfSet:            "VrfSet",
+	FieldActionRpCopyToCpuCancel: "RpCopyToCpuCancel",
+}
+
+func (v FieldActionType) String() string {
+	if s, ok := fieldActionTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("FieldActionType(%d)", v)
+}
+
+//
+// FieldAction is action of field entry.
+// - CosQCpuNew   : Param0 = cos
+// - MirrorIngress: Param0 = port
+// - CosQNew      : Param0 = queue
+// - DscpNew      : Param0 = dscp
+// - VrfSet       : Param0 = vrf
+// - RpCopyToCpuCancel: red packets of policer are not copied to cpu.
+//
+type FieldAction struct {
+	Type   FieldActionType
+	Param0 uint32
+	Param1 uint32
+}
+
+//
+// NewFieldAction returns new instance.
+//
+func NewFieldAction(actionType FieldActionType, params ...uint32) *FieldAction {
+	a := &FieldAction{Type: actionType}
+	if len(params) > 0 {
+		a.Param0 = params[0]
+	}
+	if len(params) > 1 {
+		a.Param1 = params[1]
+	}
+	return a
+}
+
+func (a *FieldAction) String() string {
+	return fmt.Sprintf("%s(%d,%d)", a.Type, a.Param0, a.Param1)
+}
+
+//
+// FieldEntry is field processor entry.
+// Qualifier is not used if its mask is zero (or nil).
+// SrcIP and DstIP are IPv4 or IPv6 prefix according to EthType.
+// Ttl is ttl of IPv4 or hop limit of IPv6.
+// DstIPLocal matches packets to local address (to cpu) if true.
+// Policer meters packets matched if not zero.
+//
+type FieldEntry struct {
+	Priority      int
+	InPort        Port
+	InPortMask    Port
+	EthType       uint16
+	EthTypeMask   uint16
+	OuterVlan     Vlan
+	OuterVlanMask Vlan
+	DstMAC        net.HardwareAddr
+	DstMACMask    net.HardwareAddr
+	SrcIP         *net.IPNet
+	DstIP         *net.IPNet
+	IPProto       uint8
+	IPProtoMask   uint8
+	Dscp          uint8
+	DscpMask      uint8
+	L4SrcPort     L4Port
+	L4SrcPortMask L4Port
+	L4DstPort     L4Port
+	L4DstPortMask L4Port
+	Ttl           uint8
+	TtlMask       uint8
+	DstIPLocal    bool
+	Policer       PolicerID
+	Actions       []*FieldAction
+}
+
+//
+// AddAction adds action.
+//
+func (e *FieldEntry) AddAction(actionType FieldActionType, params ...uint32) {
+	e.Actions = append(e.Actions, NewFieldAction(actionType, params...))
+}
+
+//
+// Action returns action of specified type.
+//
+func (e *FieldEntry) Action(actionType FieldActionType) (*FieldAction, bool) {
+	for _, action := range e.Actions {
+		if action.Type == actionType {
+			return action, true
+		}
+	}
+	return nil, false
+}
+
+//
+// Qualifiers returns qualifiers used by entry.
+//
+func (e *FieldEntry) Qualifiers() []FieldQualify {
+	qs := []FieldQualify{}
+	if e.InPortMask != 0 {
+		qs = append(qs, FieldQualifyInPort)
+	}
+	if e.EthTypeMask != 0 {
+		qs = append(qs, FieldQualifyEtherType)
+	}
+	if e.OuterVlanMask != 0 {
+		qs = append(qs, FieldQualifyOuterVlanId)
+	}
+	if len(e.DstMACMask) != 0 {
+		qs = append(qs, FieldQualifyDstMac)
+	}
+	if e.SrcIP != nil {
+		if e.SrcIP.IP.To4() != nil {
+			qs = append(qs, FieldQualifySrcIp)
+		} else {
+			qs = append(qs, FieldQualifySrcIp6)
+		}
+	}
+	if e.DstIP != nil {
+		if e.DstIP.IP.To4() != nil {
+			qs = append(qs, FieldQualifyDstIp)
+		} else {
+			qs = append(qs, FieldQualifyDstIp6)
+		}
+	}
+	if e.IPProtoMask != 0 {
+		qs = append(qs, FieldQualifyIpProtocol)
+	}
+	if e.DscpMask != 0 {
+		qs = append(qs, FieldQualifyDSCP)
+	}
+	if e.L4SrcPortMask != 0 {
+		qs = append(qs, FieldQualifyL4SrcPort)
+	}
+	if e.L4DstPortMask != 0 {
+		qs = append(qs, FieldQualifyL4DstPort)
+	}
+	if e.TtlMask != 0 {
+		qs = append(qs, FieldQualifyTtl)
+	}
+	if e.DstIPLocal {
+		qs = append(qs, FieldQualifyDstIpLocal)
+	}
+	return qs
+}
+
+func (e *FieldEntry) String() string {
+	return fmt.Sprintf("pri:%d in_port:%d/%x eth_type:%04x/%x vid:%d/%x dmac:%s/%s src:%s dst:%s proto:%d/%x dscp:%d/%x sport:%d/%x dport:%d/%x ttl:%d/%x local:%t policer:%d actions:%v",
+		e.Priority, e.InPort, e.InPortMask, e.EthType, e.EthTypeMask, e.OuterVlan, e.OuterVlanMask,
+		e.DstMAC, e.DstMACMask, e.SrcIP, e.DstIP, e.IPProto, e.IPProtoMask, e.Dscp, e.DscpMask,
+		e.L4SrcPort, e.L4SrcPortMask, e.L4DstPort, e.L4DstPortMask, e.Ttl, e.TtlMask, e.DstIPLocal, e.Policer, e.Actions)
+}
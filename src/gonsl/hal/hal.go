@@ -0,0 +1,216 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"net"
+)
+
+//
+// HAL is hardware abstraction layer of switch ASIC.
+// All flow/group handlers of gonsld program the switch through this interface.
+//
+type HAL interface {
+	//
+	// Driver
+	//
+	Init() error
+	Exit()
+	Info() (*DriverInfo, error)
+
+	//
+	// Port
+	//
+	PortBmp() (*PBmp, error)
+	PortInfoGet(Port) (*PortInfo, error)
+	PortEnableSet(Port, bool) error
+	PortUntaggedVlanSet(Port, Vlan) error
+	PortVlanTranslationSet(Port, bool) error
+	PortStatGet(Port, []string) (map[string]uint64, error)
+	PortStatClear(Port) error
+	LinkscanRegister(string, LinkscanCallback) error
+	LinkscanUnregister(string)
+
+	//
+	// Vlan
+	//
+	VlanDefault() Vlan
+	VlanCreate(Vlan) error
+	VlanDestroy(Vlan) error
+	VlanPortAdd(vid Vlan, pbmp *PBmp, ubmp *PBmp) error
+	VlanPortRemove(vid Vlan, pbmp *PBmp) error
+	VlanPortGet(Vlan) (pbmp *PBmp, ubmp *PBmp, err error)
+	VlanTraverse(func(vid Vlan, pbmp *PBmp, ubmp *PBmp) error) error
+
+	//
+	// L2
+	//
+	L2AddrAdd(*L2Addr) error
+	L2AddrDelete(net.HardwareAddr, Vlan) error
+	L2AddrDeleteByVlan(Vlan) error
+	L2AddrTraverse(func(*L2Addr) error) error
+	L2AddrAgeTimerSet(int) error
+	L2AddrRegister(L2AddrCallback) error
+	L2AddrUnregister()
+	L2StationGet(L2StationID) (*L2Station, error)
+
+	//
+	// Trunk
+	// TrunkSet replaces port selection criteria and members of trunk.
+	// TrunkHashFieldsSet sets fields used by TRUNK_PSC_PORTFLOW.
+	//
+	TrunkCreate() (Trunk, error)
+	TrunkDestroy(Trunk) error
+	TrunkMemberAdd(Trunk, Port) error
+	TrunkMemberDelete(Trunk, Port) error
+	TrunkSet(Trunk, *TrunkInfo) error
+	TrunkGet(Trunk) (*TrunkInfo, error)
+	TrunkHashFieldsSet(TrunkHashFields) error
+
+	//
+	// L3 Interface
+	// L3IfaceCreate replaces the interface specified by IfaceID
+	// if L3_WITH_ID and L3_REPLACE are set.
+	//
+	L3IfaceCreate(*L3Iface) (L3IfaceID, error)
+	L3IfaceDelete(L3IfaceID) error
+	L3IfaceGet(L3IfaceID) (*L3Iface, error)
+	L3IfaceFind(net.HardwareAddr, Vlan) (*L3Iface, error)
+
+	//
+	// L3 Egress
+	// L3EgressCreate replaces the egress specified by id
+	// if L3_WITH_ID and L3_REPLACE are set in flags.
+	//
+	L3EgressCreate(L3Flags, *L3Egress, L3EgressID) (L3EgressID, error)
+	L3EgressDestroy(L3EgressID) error
+	L3EgressGet(L3EgressID) (*L3Egress, error)
+	L3EgressTraverse(func(L3EgressID, *L3Egress) error) error
+	L3EgressEcmpCreate(*L3EgressEcmp) (L3EgressID, error)
+	L3EgressEcmpDestroy(L3EgressID) error
+	L3EgressEcmpGet(L3EgressID) (*L3EgressEcmp, error)
+
+	//
+	// L3 Host / Route
+	// Add replaces existing entry in place if L3_REPLACE is set.
+	// Traverse enumerates IPv4 entries, or IPv6 entries if L3_IP6 is set.
+	//
+	L3HostAdd(*L3Host) error
+	L3HostDelete(*L3Host) error
+	L3HostTraverse(L3Flags, func(*L3Host) error) error
+	L3RouteAdd(*L3Route) error
+	L3RouteDelete(*L3Route) error
+	L3RouteTraverse(L3Flags, func(*L3Route) error) error
+
+	//
+	// Field
+	//
+	FieldGroupCreate(pri int, qset ...FieldQualify) (FieldGroupID, error)
+	FieldEntryCreate(FieldGroupID, *FieldEntry) (FieldEntryID, error)
+	FieldEntryDestroy(FieldEntryID) error
+	FieldEntryGet(FieldEntryID) (*FieldEntry, error)
+	FieldEntryTraverse(FieldGroupID, func(FieldEntryID, *FieldEntry) error) error
+	FieldEntryStatGet(FieldEntryID) (*FieldEntryStat, error)
+
+	//
+	// Policer
+	// PolicerDestroy fails if policer is used by field entries.
+	//
+	PolicerCreate(*PolicerConfig) (PolicerID, error)
+	PolicerSet(PolicerID, *PolicerConfig) error
+	PolicerDestroy(PolicerID) error
+
+	//
+	// Tunnel
+	//
+	TunnelInitiatorCreate(L3IfaceID, *TunnelInitiator) error
+	TunnelInitiatorClear(L3IfaceID) error
+	TunnelInitiatorTraverse(func(*TunnelInitiator) error) error
+	TunnelTerminatorCreate(*TunnelTerminator) error
+	TunnelTerminatorDelete(*TunnelTerminator) error
+	TunnelTerminatorTraverse(func(*TunnelTerminator) error) error
+
+	//
+	// VXLAN
+	// VxlanFloodSet replaces network ports flooded BUM traffic of vpn.
+	// VxlanVpnDestroy fails if network ports remain in vpn.
+	//
+	VxlanVpnCreate(*VxlanVpn) (VxlanVpnID, error)
+	VxlanVpnDestroy(VxlanVpnID) error
+	VxlanPortAdd(*VxlanPort) (VxlanPortID, error)
+	VxlanPortDelete(VxlanVpnID, VxlanPortID) error
+	VxlanFloodSet(VxlanVpnID, []VxlanPortID) error
+	VxlanL2AddrAdd(*VxlanL2Addr) error
+	VxlanL2AddrDelete(VxlanVpnID, net.HardwareAddr) error
+
+	//
+	// SRv6
+	// SRv6EncapCreate returns egress which can be used by L3Route.
+	// Backends which do not support srv6 return error.
+	//
+	SRv6EncapCreate(*SRv6Encap) (L3EgressID, error)
+	SRv6EncapDestroy(L3EgressID) error
+	SRv6LocalSidAdd(*SRv6LocalSid) error
+	SRv6LocalSidDelete(*SRv6LocalSid) error
+
+	//
+	// MPLS
+	//
+	MplsTunnelInitiatorSet(L3IfaceID, []*MplsEgressLabel) error
+	MplsTunnelInitiatorClear(L3IfaceID) error
+	MplsTunnelInitiatorGet(L3IfaceID, int) ([]*MplsEgressLabel, error)
+	MplsTunnelSwitchAdd(*MplsTunnelSwitch) error
+	MplsTunnelSwitchDelete(*MplsTunnelSwitch) error
+	MplsTunnelSwitchTraverse(func(*MplsTunnelSwitch) error) error
+
+	//
+	// QoS
+	// QosPortMapSet sets classification of ingress packets to egress queue.
+	// CosqPortSchedSet sets scheduling and shaping of egress queues.
+	//
+	QosPortMapSet(Port, *QosMap) error
+	CosqPortSchedSet(Port, *CosqPortSched) error
+	CosqStatGet(Port, CosQueue, []CosqStat) (map[CosqStat]uint64, error)
+
+	//
+	// Mirror
+	// MirrorPortDestAdd mirrors traffic of port to destination.
+	//
+	MirrorDestCreate(*MirrorDestination) (MirrorDestID, error)
+	MirrorDestDestroy(MirrorDestID) error
+	MirrorPortDestAdd(Port, MirrorPortFlags, MirrorDestID) error
+	MirrorPortDestDelete(Port, MirrorPortFlags, MirrorDestID) error
+
+	//
+	// Sampling
+	// PortSampleRateSet sets rate of packet sampling of port.
+	// SampleCosSet sets cos of sampled packets copied to cpu.
+	//
+	PortSampleRateSet(Port, *PortSampleRate) error
+	PortSampleRateGet(Port) (*PortSampleRate, error)
+	SampleCosSet(cos uint32) error
+
+	//
+	// Packet
+	// RxRegister starts receiving packets copied to cpu with cos.
+	// RxUnregister stops receiving when last callback is unregistered.
+	//
+	RxRegister(pri int, cos uint32, f RxCallback) error
+	RxUnregister(pri int)
+	Tx(*Packet) error
+}
@@ -0,0 +1,91 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// L2Addr is l2 address (FDB) entry.
+//
+type L2Addr struct {
+	Flags L2Flags
+	MAC   net.HardwareAddr
+	Vlan  Vlan
+	Port  Port
+}
+
+//
+// NewL2Addr returns new instance.
+//
+func NewL2Addr(mac net.HardwareAddr, vid Vlan) *L2Addr {
+	return &L2Addr{
+		MAC:  mac,
+		Vlan: vid,
+	}
+}
+
+//
+// Key returns key of l2 table.
+//
+func (a *L2Addr) Key() string {
+	return fmt.Sprintf("%s_%d", a.MAC, a.Vlan)
+}
+
+func (a *L2Addr) String() string {
+	return fmt.Sprintf("%s vid:%d port:%d flags:%s", a.MAC, a.Vlan, a.Port, a.Flags)
+}
+
+//
+// L2CallbackOper is operation of l2 address notification.
+//
+type L2CallbackOper int
+
+const (
+	L2_CALLBACK_NONE L2CallbackOper = iota
+	L2_CALLBACK_ADD
+	L2_CALLBACK_DELETE
+)
+
+var l2CallbackOperNames = map[L2CallbackOper]string{
+	L2_CALLBACK_NONE:   "NONE",
+	L2_CALLBACK_ADD:    "ADD",
+	L2_CALLBACK_DELETE: "DELETE",
+}
+
+func (v L2CallbackOper) String() string {
+	if s, ok := l2CallbackOperNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("L2CallbackOper(%d)", v)
+}
+
+//
+// L2Station is my station entry.
+//
+type L2Station struct {
+	Flags       uint32
+	DstMAC      net.HardwareAddr
+	DstMACMask  net.HardwareAddr
+	Vlan        Vlan
+	VlanMask    Vlan
+	SrcPort     Port
+	SrcPortMask Port
+}
@@ -0,0 +1,138 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// L3Iface is l3 interface entry.
+//
+type L3Iface struct {
+	Flags   L3Flags
+	IfaceID L3IfaceID
+	MAC     net.HardwareAddr
+	Vlan    Vlan
+	Vrf     Vrf
+	MTU     int
+	MTUFwd  int
+	TTL     int
+}
+
+func (i *L3Iface) String() string {
+	return fmt.Sprintf("id:%d mac:%s vid:%d vrf:%d mtu:%d flags:%s", i.IfaceID, i.MAC, i.Vlan, i.Vrf, i.MTU, i.Flags)
+}
+
+//
+// L3Egress is l3 egress (nexthop) entry.
+// Port is used if L3_TGID is not set in Flags, Trunk is used otherwise.
+//
+type L3Egress struct {
+	Flags     L3Flags
+	Flags2    uint32
+	IfaceID   L3IfaceID
+	MAC       net.HardwareAddr
+	Vlan      Vlan
+	Port      Port
+	Trunk     Trunk
+	MplsLabel *MplsEgressLabel
+}
+
+//
+// IsTrunk returns true if egress port is trunk.
+//
+func (e *L3Egress) IsTrunk() bool {
+	return (e.Flags & L3_TGID) != 0
+}
+
+//
+// Label returns mpls label or 0.
+//
+func (e *L3Egress) Label() MplsLabel {
+	if e.MplsLabel == nil {
+		return 0
+	}
+	return e.MplsLabel.Label
+}
+
+func (e *L3Egress) String() string {
+	return fmt.Sprintf("iface:%d mac:%s vid:%d port:%d trunk:%d label:%d flags:%s",
+		e.IfaceID, e.MAC, e.Vlan, e.Port, e.Trunk, e.Label(), e.Flags)
+}
+
+//
+// L3EgressEcmp is l3 egress ecmp entry.
+//
+type L3EgressEcmp struct {
+	Flags    L3Flags
+	EgressID L3EgressID
+	MaxPaths int
+	Members  []L3EgressID
+}
+
+func (e *L3EgressEcmp) String() string {
+	return fmt.Sprintf("id:%d members:%v flags:%s", e.EgressID, e.Members, e.Flags)
+}
+
+//
+// L3Host is l3 host entry.
+// L3_IP6 flag is set if IP is IPv6 address.
+//
+type L3Host struct {
+	Flags      L3Flags
+	IP         net.IP
+	Vrf        Vrf
+	EgressID   L3EgressID
+	NexthopMAC net.HardwareAddr
+}
+
+//
+// Key returns key of l3 host table.
+//
+func (h *L3Host) Key() string {
+	return fmt.Sprintf("%d_%s", h.Vrf, h.IP)
+}
+
+func (h *L3Host) String() string {
+	return fmt.Sprintf("%s vrf:%d egr:%d flags:%s", h.IP, h.Vrf, h.EgressID, h.Flags)
+}
+
+//
+// L3Route is l3 route entry.
+// L3_IP6 flag is set if Dst is IPv6 prefix.
+// L3_MULTIPATH flag is set if EgressID is l3 egress ecmp.
+//
+type L3Route struct {
+	Flags    L3Flags
+	Dst      *net.IPNet
+	Vrf      Vrf
+	EgressID L3EgressID
+}
+
+//
+// Key returns key of l3 route table.
+//
+func (r *L3Route) Key() string {
+	return fmt.Sprintf("%d_%s", r.Vrf, r.Dst)
+}
+
+func (r *L3Route) String() string {
+	return fmt.Sprintf("%s vrf:%d egr:%d flags:%s", r.Dst, r.Vrf, r.EgressID, r.Flags)
+}
@@ -0,0 +1,110 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// MirrorDestID is id of mirror destination.
+//
+type MirrorDestID uint32
+
+//
+// MirrorPortFlags is direction of mirrored traffic.
+//
+type MirrorPortFlags uint32
+
+const (
+	MIRROR_PORT_NONE    MirrorPortFlags = 0
+	MIRROR_PORT_INGRESS MirrorPortFlags = 1 << 0
+	MIRROR_PORT_EGRESS  MirrorPortFlags = 1 << 1
+	MIRROR_PORT_BOTH                    = MIRROR_PORT_INGRESS | MIRROR_PORT_EGRESS
+)
+
+var mirrorPortFlagsNames = map[MirrorPortFlags]string{
+	MIRROR_PORT_INGRESS: "INGRESS",
+	MIRROR_PORT_EGRESS:  "EGRESS",
+}
+
+func (f MirrorPortFlags) String() string {
+	return flagsString(uint32(f), func(bit uint32) (string, bool) {
+		name, ok := mirrorPortFlagsNames[MirrorPortFlags(bit)]
+		return name, ok
+	})
+}
+
+//
+// MirrorDestFlags is flags of mirror destination.
+//
+type MirrorDestFlags uint32
+
+const (
+	MIRROR_DEST_NONE          MirrorDestFlags = 0
+	MIRROR_DEST_TUNNEL_IP_GRE MirrorDestFlags = 1 << 0
+)
+
+var mirrorDestFlagsNames = map[MirrorDestFlags]string{
+	MIRROR_DEST_TUNNEL_IP_GRE: "TUNNEL_IP_GRE",
+}
+
+func (f MirrorDestFlags) String() string {
+	return flagsString(uint32(f), func(bit uint32) (string, bool) {
+		name, ok := mirrorDestFlagsNames[MirrorDestFlags(bit)]
+		return name, ok
+	})
+}
+
+const (
+	// MirrorGreProtocolERSPAN is protocol type of GRE header (ERSPAN type II).
+	MirrorGreProtocolERSPAN uint16 = 0x88be
+)
+
+//
+// MirrorDestination is mirror destination.
+// Mirrored packets are sent to Port,
+// encapsulated in GRE (ERSPAN) if MIRROR_DEST_TUNNEL_IP_GRE is set.
+//
+type MirrorDestination struct {
+	Flags       MirrorDestFlags
+	Port        Port
+	SrcIP       net.IP
+	DstIP       net.IP
+	SrcMAC      net.HardwareAddr
+	DstMAC      net.HardwareAddr
+	Vlan        Vlan
+	TTL         uint8
+	TOS         uint8
+	GreProtocol uint16
+}
+
+//
+// IsTunnel returns true if destination is ERSPAN.
+//
+func (d *MirrorDestination) IsTunnel() bool {
+	return (d.Flags & MIRROR_DEST_TUNNEL_IP_GRE) != 0
+}
+
+func (d *MirrorDestination) String() string {
+	if d.IsTunnel() {
+		return fmt.Sprintf("port:%d gre ip:%s-%s mac:%s-%s vid:%d ttl:%d tos:%d", d.Port, d.SrcIP, d.DstIP, d.SrcMAC, d.DstMAC, d.Vlan, d.TTL, d.TOS)
+	}
+	return fmt.Sprintf("port:%d", d.Port)
+}
@@ -0,0 +1,127 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// MplsEgressLabelFlags is flags of MplsEgressLabel.
+//
+type MplsEgressLabelFlags uint32
+
+const (
+	MPLS_EGRESS_LABEL_NONE          MplsEgressLabelFlags = 0
+	MPLS_EGRESS_LABEL_TTL_DECREMENT MplsEgressLabelFlags = 1 << 0
+	MPLS_EGRESS_LABEL_TTL_SET       MplsEgressLabelFlags = 1 << 1
+)
+
+//
+// MplsEgressLabel is label pushed (or swapped) at egress.
+//
+type MplsEgressLabel struct {
+	Flags MplsEgressLabelFlags
+	Label MplsLabel
+	TTL   uint8
+	Exp   uint8
+}
+
+func (l *MplsEgressLabel) String() string {
+	return fmt.Sprintf("label:%d ttl:%d exp:%d flags:%x", l.Label, l.TTL, l.Exp, l.Flags)
+}
+
+//
+// MplsSwitchFlags is flags of MplsTunnelSwitch.
+//
+type MplsSwitchFlags uint32
+
+const (
+	MPLS_SWITCH_NONE          MplsSwitchFlags = 0
+	MPLS_SWITCH_TTL_DECREMENT MplsSwitchFlags = 1 << 0
+	MPLS_SWITCH_REPLACE       MplsSwitchFlags = 1 << 1
+)
+
+//
+// MplsSwitchAction is action of MplsTunnelSwitch.
+//
+type MplsSwitchAction int
+
+const (
+	MPLS_SWITCH_ACTION_SWAP MplsSwitchAction = iota
+	MPLS_SWITCH_ACTION_PHP
+	MPLS_SWITCH_ACTION_POP
+)
+
+var mplsSwitchActionNames = map[MplsSwitchAction]string{
+	MPLS_SWITCH_ACTION_SWAP: "SWAP",
+	MPLS_SWITCH_ACTION_PHP:  "PHP",
+	MPLS_SWITCH_ACTION_POP:  "POP",
+}
+
+func (v MplsSwitchAction) String() string {
+	if s, ok := mplsSwitchActionNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("MplsSwitchAction(%d)", v)
+}
+
+//
+// MplsTunnelSwitch is mpls tunnel switch (ILM) entry.
+//
+type MplsTunnelSwitch struct {
+	Flags       MplsSwitchFlags
+	Label       MplsLabel
+	Port        Port
+	Action      MplsSwitchAction
+	Vrf         Vrf
+	EgressIf    L3EgressID
+	EgressLabel *MplsEgressLabel
+}
+
+//
+// NewMplsTunnelSwitch returns new instance.
+//
+func NewMplsTunnelSwitch(label MplsLabel) *MplsTunnelSwitch {
+	return &MplsTunnelSwitch{
+		Label: label,
+		Port:  PORT_ANY,
+	}
+}
+
+//
+// Key returns key of mpls tunnel switch table.
+//
+func (t *MplsTunnelSwitch) Key() string {
+	return fmt.Sprintf("%d_%d", t.Label, t.Port)
+}
+
+//
+// EgressLabelValue returns egress label or 0.
+//
+func (t *MplsTunnelSwitch) EgressLabelValue() MplsLabel {
+	if t.EgressLabel == nil {
+		return 0
+	}
+	return t.EgressLabel.Label
+}
+
+func (t *MplsTunnelSwitch) String() string {
+	return fmt.Sprintf("label:%d port:%d %s vrf:%d egr:%d egr_label:%d",
+		t.Label, t.Port, t.Action, t.Vrf, t.EgressIf, t.EgressLabelValue())
+}
@@ -0,0 +1,238 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+	"net"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	"golang.org/x/sys/unix"
+)
+
+var fieldQualifyTable = map[hal.FieldQualify]opennsl.FieldQualify{
+	hal.FieldQualifyInPort:      opennsl.FieldQualifyInPort,
+	hal.FieldQualifyEtherType:   opennsl.FieldQualifyEtherType,
+	hal.FieldQualifyOuterVlanId: opennsl.FieldQualifyOuterVlanId,
+	hal.FieldQualifyDstMac:      opennsl.FieldQualifyDstMac,
+	hal.FieldQualifySrcIp:       opennsl.FieldQualifySrcIp,
+	hal.FieldQualifyDstIp:       opennsl.FieldQualifyDstIp,
+	hal.FieldQualifySrcIp6:      opennsl.FieldQualifySrcIp6,
+	hal.FieldQualifyDstIp6:      opennsl.FieldQualifyDstIp6,
+	hal.FieldQualifyIpProtocol:  opennsl.FieldQualifyIpProtocol,
+	hal.FieldQualifyDSCP:        opennsl.FieldQualifyDSCP,
+	hal.FieldQualifyL4SrcPort:   opennsl.FieldQualifyL4SrcPort,
+	hal.FieldQualifyL4DstPort:   opennsl.FieldQualifyL4DstPort,
+	hal.FieldQualifyTtl:         opennsl.FieldQualifyTtl,
+	hal.FieldQualifyDstIpLocal:  opennsl.FieldQualifyDstIpLocal,
+}
+
+//
+// FieldGroupCreate creates field group.
+//
+func (h *ONSL) FieldGroupCreate(pri int, qset ...hal.FieldQualify) (hal.FieldGroupID, error) {
+	qs := make([]opennsl.FieldQualify, len(qset))
+	for index, q := range qset {
+		qs[index] = fieldQualifyTable[q]
+	}
+
+	onslQSet := opennsl.NewFieldQSet()
+	onslQSet.Add(qs...)
+	group, err := opennsl.FieldGroupCreate(h.unit, onslQSet, pri)
+	if err != nil {
+		return 0, err
+	}
+
+	return hal.FieldGroupID(group), nil
+}
+
+func (h *ONSL) fieldEntrySet(entry opennsl.FieldEntry, e *hal.FieldEntry) {
+	if e.Priority != 0 {
+		entry.PrioritySet(h.unit, e.Priority)
+	}
+
+	if e.InPortMask != 0 {
+		entry.Qualify().InPort(h.unit, opennsl.Port(e.InPort), opennsl.Port(e.InPortMask))
+	}
+	if e.EthTypeMask != 0 {
+		entry.Qualify().EtherType(h.unit, opennsl.Ethertype(e.EthType), opennsl.Ethertype(e.EthTypeMask))
+	}
+	if e.OuterVlanMask != 0 {
+		entry.Qualify().OuterVlanId(h.unit, opennsl.Vlan(e.OuterVlan), opennsl.Vlan(e.OuterVlanMask))
+	}
+	if len(e.DstMACMask) != 0 {
+		entry.Qualify().DstMAC(h.unit, e.DstMAC, e.DstMACMask)
+	}
+	if e.SrcIP != nil {
+		if e.SrcIP.IP.To4() != nil {
+			entry.Qualify().SrcIp(h.unit, e.SrcIP.IP, e.SrcIP.Mask)
+		} else {
+			entry.Qualify().SrcIp6(h.unit, e.SrcIP.IP, e.SrcIP.Mask)
+		}
+	}
+	if e.DstIP != nil {
+		if e.DstIP.IP.To4() != nil {
+			entry.Qualify().DstIp(h.unit, e.DstIP.IP, e.DstIP.Mask)
+		} else {
+			entry.Qualify().DstIp6(h.unit, e.DstIP.IP, e.DstIP.Mask)
+		}
+	}
+	if e.IPProtoMask != 0 {
+		entry.Qualify().IpProtocol(h.unit, e.IPProto, e.IPProtoMask)
+	}
+	if e.DscpMask != 0 {
+		entry.Qualify().DSCP(h.unit, e.Dscp, e.DscpMask)
+	}
+	if e.L4SrcPortMask != 0 {
+		entry.Qualify().L4SrcPort(h.unit, opennsl.L4Port(e.L4SrcPort), opennsl.L4Port(e.L4SrcPortMask))
+	}
+	if e.L4DstPortMask != 0 {
+		entry.Qualify().L4DstPort(h.unit, opennsl.L4Port(e.L4DstPort), opennsl.L4Port(e.L4DstPortMask))
+	}
+	if e.TtlMask != 0 {
+		entry.Qualify().Ttl(h.unit, e.Ttl, e.TtlMask)
+	}
+	if e.DstIPLocal {
+		entry.Qualify().DstIpLocal(h.unit, 1, 1)
+	}
+
+	for _, action := range e.Actions {
+		switch action.Type {
+		case hal.FieldActionCopyToCpu:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionCopyToCpu())
+		case hal.FieldActionCosQCpuNew:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionCosQCpuNew(action.Param0))
+		case hal.FieldActionDrop:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionDrop())
+		case hal.FieldActionMirrorIngress:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionMirrorIngress(0, opennsl.Port(action.Param0)))
+		case hal.FieldActionCosQNew:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionCosQNew(action.Param0))
+		case hal.FieldActionDscpNew:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionDscpNew(uint8(action.Param0)))
+		case hal.FieldActionVrfSet:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionVrfSet(opennsl.Vrf(action.Param0)))
+		case hal.FieldActionRpCopyToCpuCancel:
+			entry.Action().AddP(h.unit, opennsl.NewFieldActionRpCopyToCpuCancel())
+		default:
+			h.log.Warnf("FieldEntry: unsupported action. %s", action)
+		}
+	}
+}
+
+//
+// FieldEntryCreate creates and installs field entry.
+//
+func (h *ONSL) FieldEntryCreate(groupID hal.FieldGroupID, e *hal.FieldEntry) (hal.FieldEntryID, error) {
+	entry, err := opennsl.FieldGroup(groupID).EntryCreate(h.unit)
+	if err != nil {
+		return 0, err
+	}
+
+	h.fieldEntrySet(entry, e)
+
+	if e.Policer != 0 {
+		if err := h.fieldEntryPolicerAttach(groupID, entry, e.Policer); err != nil {
+			entry.Destroy(h.unit)
+			return 0, err
+		}
+	}
+
+	if err := entry.Install(h.unit); err != nil {
+		h.fieldEntryPolicerDetach(entry)
+		entry.Destroy(h.unit)
+		return 0, err
+	}
+
+	return hal.FieldEntryID(entry), nil
+}
+
+//
+// FieldEntryDestroy destroys field entry.
+//
+func (h *ONSL) FieldEntryDestroy(entryID hal.FieldEntryID) error {
+	entry := opennsl.FieldEntry(entryID)
+	h.fieldEntryPolicerDetach(entry)
+	return entry.Destroy(h.unit)
+}
+
+//
+// FieldEntryGet returns qualifiers of field entry read from H.W.
+// Qualifiers not in the group are left zero. Actions are not read.
+//
+func (h *ONSL) FieldEntryGet(entryID hal.FieldEntryID) (*hal.FieldEntry, error) {
+	entry := opennsl.FieldEntry(entryID)
+	e := &hal.FieldEntry{}
+
+	if inPort, mask, err := entry.Qualify().InPortGet(h.unit); err == nil {
+		e.InPort = hal.Port(inPort)
+		e.InPortMask = hal.Port(mask)
+	}
+	if ethType, mask, err := entry.Qualify().EtherTypeGet(h.unit); err == nil {
+		e.EthType = uint16(ethType)
+		e.EthTypeMask = uint16(mask)
+	}
+	if mac, mask, err := entry.Qualify().DstMACGet(h.unit); err == nil {
+		e.DstMAC = mac
+		e.DstMACMask = mask
+	}
+	if proto, mask, err := entry.Qualify().IpProtocolGet(h.unit); err == nil {
+		e.IPProto = proto
+		e.IPProtoMask = mask
+	}
+
+	getIP := func(get func(int) (net.IP, net.IPMask, error)) *net.IPNet {
+		if ip, mask, err := get(h.unit); err == nil {
+			return &net.IPNet{IP: ip, Mask: mask}
+		}
+		return nil
+	}
+
+	if e.EthType == unix.ETH_P_IPV6 {
+		e.SrcIP = getIP(entry.Qualify().SrcIp6Get)
+		e.DstIP = getIP(entry.Qualify().DstIp6Get)
+	} else {
+		e.SrcIP = getIP(entry.Qualify().SrcIpGet)
+		e.DstIP = getIP(entry.Qualify().DstIpGet)
+	}
+
+	return e, nil
+}
+
+//
+// FieldEntryTraverse calls f for each entry in field group.
+//
+func (h *ONSL) FieldEntryTraverse(groupID hal.FieldGroupID, f func(hal.FieldEntryID, *hal.FieldEntry) error) error {
+	entries, err := opennsl.FieldGroup(groupID).EntryMultiGet(h.unit, -1)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		e, err := h.FieldEntryGet(hal.FieldEntryID(entry))
+		if err != nil {
+			return err
+		}
+
+		if err := f(hal.FieldEntryID(entry), e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+func newL2Addr(src *opennsl.L2Addr) *hal.L2Addr {
+	flags := hal.L2_NONE
+	if (src.Flags() & opennsl.L2_STATIC) != 0 {
+		flags |= hal.L2_STATIC
+	}
+	if (src.Flags() & opennsl.L2_L3LOOKUP) != 0 {
+		flags |= hal.L2_L3LOOKUP
+	}
+
+	return &hal.L2Addr{
+		Flags: flags,
+		MAC:   src.MAC(),
+		Vlan:  hal.Vlan(src.VID()),
+		Port:  hal.Port(src.Port()),
+	}
+}
+
+func newL2CallbackOper(oper opennsl.L2CallbackOper) hal.L2CallbackOper {
+	switch oper {
+	case opennsl.L2_CALLBACK_ADD:
+		return hal.L2_CALLBACK_ADD
+	case opennsl.L2_CALLBACK_DELETE:
+		return hal.L2_CALLBACK_DELETE
+	default:
+		return hal.L2_CALLBACK_NONE
+	}
+}
+
+//
+// L2AddrAdd adds l2 address.
+//
+func (h *ONSL) L2AddrAdd(addr *hal.L2Addr) error {
+	l2addr := opennsl.NewL2Addr(addr.MAC, opennsl.Vlan(addr.Vlan))
+	if (addr.Flags & hal.L2_STATIC) != 0 {
+		l2addr.SetFlags(l2addr.Flags() | opennsl.L2_STATIC)
+	}
+	if (addr.Flags & hal.L2_L3LOOKUP) != 0 {
+		l2addr.SetFlags(l2addr.Flags() | opennsl.L2_L3LOOKUP)
+	}
+	if addr.Port != 0 {
+		l2addr.SetPort(opennsl.Port(addr.Port))
+	}
+
+	return l2addr.Add(h.unit)
+}
+
+//
+// L2AddrDelete deletes l2 address.
+//
+func (h *ONSL) L2AddrDelete(mac net.HardwareAddr, vid hal.Vlan) error {
+	return opennsl.NewL2Addr(mac, opennsl.Vlan(vid)).Delete(h.unit)
+}
+
+//
+// L2AddrDeleteByVlan deletes all l2 addresses on vlan without callbacks.
+//
+func (h *ONSL) L2AddrDeleteByVlan(vid hal.Vlan) error {
+	return opennsl.L2AddrDeleteByVID(h.unit, opennsl.Vlan(vid), opennsl.L2_DELETE_NO_CALLBACKS)
+}
+
+//
+// L2AddrTraverse calls f for each l2 address.
+//
+func (h *ONSL) L2AddrTraverse(f func(*hal.L2Addr) error) error {
+	var cbErr error
+	err := opennsl.L2Traverse(h.unit, func(unit int, l2addr *opennsl.L2Addr) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(newL2Addr(l2addr))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
+
+//
+// L2AddrAgeTimerSet sets aging time (seconds).
+//
+func (h *ONSL) L2AddrAgeTimerSet(ageSeconds int) error {
+	return opennsl.L2AddrAgeTimerSet(h.unit, ageSeconds)
+}
+
+//
+// L2AddrRegister registers callback of l2 address learning and aging.
+//
+func (h *ONSL) L2AddrRegister(f hal.L2AddrCallback) error {
+	return opennsl.L2AddrRegister(h.unit, func(unit int, l2addr *opennsl.L2Addr, oper opennsl.L2CallbackOper) {
+		f(newL2Addr(l2addr), newL2CallbackOper(oper))
+	})
+}
+
+//
+// L2AddrUnregister unregisters callback of l2 address.
+//
+func (h *ONSL) L2AddrUnregister() {
+	opennsl.L2AddrUnregister(h.unit)
+}
+
+//
+// L2StationGet returns l2 station entry.
+//
+func (h *ONSL) L2StationGet(id hal.L2StationID) (*hal.L2Station, error) {
+	l2st, err := opennsl.L2StationID(id).Get(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hal.L2Station{
+		Flags:       uint32(l2st.Flags()),
+		DstMAC:      l2st.DstMAC(),
+		DstMACMask:  l2st.DstMACMask(),
+		Vlan:        hal.Vlan(l2st.VID()),
+		VlanMask:    hal.Vlan(l2st.VIDMask()),
+		SrcPort:     hal.Port(l2st.SrcPort()),
+		SrcPortMask: hal.Port(l2st.SrcPortMask()),
+	}, nil
+}
+
+//
+// TrunkCreate creates trunk.
+//
+func (h *ONSL) TrunkCreate() (hal.Trunk, error) {
+	trunk, err := opennsl.TrunkCreate(h.unit, opennsl.TRUNK_FLAG_NONE)
+	if err != nil {
+		return 0, err
+	}
+
+	return hal.Trunk(trunk), nil
+}
+
+//
+// TrunkDestroy destroys trunk.
+//
+func (h *ONSL) TrunkDestroy(trunk hal.Trunk) error {
+	return opennsl.Trunk(trunk).Destroy(h.unit)
+}
+
+func (h *ONSL) newTrunkMember(port hal.Port) (*opennsl.TrunkMember, error) {
+	gport, err := opennsl.Port(port).GPortGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	member := opennsl.NewTrunkMember()
+	member.SetGPort(gport)
+	return member, nil
+}
+
+//
+// TrunkMemberAdd adds port to trunk.
+//
+func (h *ONSL) TrunkMemberAdd(trunk hal.Trunk, port hal.Port) error {
+	member, err := h.newTrunkMember(port)
+	if err != nil {
+		return err
+	}
+
+	return opennsl.Trunk(trunk).MemberAdd(h.unit, member)
+}
+
+//
+// TrunkMemberDelete deletes port from trunk.
+//
+func (h *ONSL) TrunkMemberDelete(trunk hal.Trunk, port hal.Port) error {
+	member, err := h.newTrunkMember(port)
+	if err != nil {
+		return err
+	}
+
+	return opennsl.Trunk(trunk).MemberDelete(h.unit, member)
+}
+
+var trunkPscTable = map[hal.TrunkPsc]opennsl.TrunkPsc{
+	hal.TRUNK_PSC_SRCDSTMAC: opennsl.TRUNK_PSC_SRCDSTMAC,
+	hal.TRUNK_PSC_SRCDSTIP:  opennsl.TRUNK_PSC_SRCDSTIP,
+	hal.TRUNK_PSC_PORTFLOW:  opennsl.TRUNK_PSC_PORTFLOW,
+}
+
+//
+// TrunkSet replaces port selection criteria and members of trunk.
+//
+func (h *ONSL) TrunkSet(trunk hal.Trunk, info *hal.TrunkInfo) error {
+	psc, ok := trunkPscTable[info.Psc]
+	if !ok {
+		return fmt.Errorf("Invalid TrunkPsc. %s", info.Psc)
+	}
+
+	tinfo := opennsl.NewTrunkInfo()
+	tinfo.SetPsc(psc)
+
+	members := make([]opennsl.TrunkMember, len(info.Members))
+	for index, m := range info.Members {
+		member, err := h.newTrunkMember(m.Port)
+		if err != nil {
+			return err
+		}
+
+		if m.Disabled {
+			member.SetFlags(opennsl.TRUNK_MEMBER_EGRESS_DISABLE)
+		}
+
+		members[index] = *member
+	}
+
+	return opennsl.Trunk(trunk).Set(h.unit, tinfo, members...)
+}
+
+//
+// TrunkGet returns port selection criteria and members of trunk.
+//
+func (h *ONSL) TrunkGet(trunk hal.Trunk) (*hal.TrunkInfo, error) {
+	tinfo, members, err := opennsl.Trunk(trunk).Get(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &hal.TrunkInfo{
+		Psc:     hal.TRUNK_PSC_SRCDSTMAC,
+		Members: make([]*hal.TrunkMember, len(members)),
+	}
+
+	for psc, onslPsc := range trunkPscTable {
+		if tinfo.Psc() == onslPsc {
+			info.Psc = psc
+		}
+	}
+
+	for index, member := range members {
+		port, err := opennsl.PortLocalGet(h.unit, member.GPort())
+		if err != nil {
+			return nil, err
+		}
+
+		info.Members[index] = &hal.TrunkMember{
+			Port:     hal.Port(port),
+			Disabled: (member.Flags() & opennsl.TRUNK_MEMBER_EGRESS_DISABLE) != 0,
+		}
+	}
+
+	return info, nil
+}
+
+var trunkHashL2Fields = map[hal.TrunkHashFields]opennsl.HashField{
+	hal.TRUNK_HASH_SRC_MAC:  opennsl.HASH_FIELD_MACSA_LO | opennsl.HASH_FIELD_MACSA_MI | opennsl.HASH_FIELD_MACSA_HI,
+	hal.TRUNK_HASH_DST_MAC:  opennsl.HASH_FIELD_MACDA_LO | opennsl.HASH_FIELD_MACDA_MI | opennsl.HASH_FIELD_MACDA_HI,
+	hal.TRUNK_HASH_ETH_TYPE: opennsl.HASH_FIELD_ETHER_TYPE,
+	hal.TRUNK_HASH_VLAN:     opennsl.HASH_FIELD_VLAN,
+	hal.TRUNK_HASH_SRC_PORT: opennsl.HASH_FIELD_SRCMOD | opennsl.HASH_FIELD_SRCPORT,
+}
+
+var trunkHashIP4Fields = map[hal.TrunkHashFields]opennsl.HashField{
+	hal.TRUNK_HASH_VLAN:        opennsl.HASH_FIELD_VLAN,
+	hal.TRUNK_HASH_SRC_IP:      opennsl.HASH_FIELD_IP4SRC_LO | opennsl.HASH_FIELD_IP4SRC_HI,
+	hal.TRUNK_HASH_DST_IP:      opennsl.HASH_FIELD_IP4DST_LO | opennsl.HASH_FIELD_IP4DST_HI,
+	hal.TRUNK_HASH_IP_PROTO:    opennsl.HASH_FIELD_PROTOCOL,
+	hal.TRUNK_HASH_L4_SRC_PORT: opennsl.HASH_FIELD_SRCL4,
+	hal.TRUNK_HASH_L4_DST_PORT: opennsl.HASH_FIELD_DSTL4,
+	hal.TRUNK_HASH_SRC_PORT:    opennsl.HASH_FIELD_SRCMOD | opennsl.HASH_FIELD_SRCPORT,
+}
+
+var trunkHashIP6Fields = map[hal.TrunkHashFields]opennsl.HashField{
+	hal.TRUNK_HASH_VLAN:        opennsl.HASH_FIELD_VLAN,
+	hal.TRUNK_HASH_SRC_IP:      opennsl.HASH_FIELD_IP6SRC_LO | opennsl.HASH_FIELD_IP6SRC_HI,
+	hal.TRUNK_HASH_DST_IP:      opennsl.HASH_FIELD_IP6DST_LO | opennsl.HASH_FIELD_IP6DST_HI,
+	hal.TRUNK_HASH_IP_PROTO:    opennsl.HASH_FIELD_NXT_HDR,
+	hal.TRUNK_HASH_L4_SRC_PORT: opennsl.HASH_FIELD_SRCL4,
+	hal.TRUNK_HASH_L4_DST_PORT: opennsl.HASH_FIELD_DSTL4,
+	hal.TRUNK_HASH_SRC_PORT:    opennsl.HASH_FIELD_SRCMOD | opennsl.HASH_FIELD_SRCPORT,
+}
+
+func newONSLHashField(fields hal.TrunkHashFields, values map[hal.TrunkHashFields]opennsl.HashField) int {
+	var hashField opennsl.HashField
+	for field, value := range values {
+		if (fields & field) != 0 {
+			hashField |= value
+		}
+	}
+	return int(hashField)
+}
+
+//
+// TrunkHashFieldsSet sets fields used by TRUNK_PSC_PORTFLOW (RTAG7).
+// L2 fields are used for non-IP packets, L3/L4 fields for IP packets.
+//
+func (h *ONSL) TrunkHashFieldsSet(fields hal.TrunkHashFields) error {
+	if err := opennsl.SwitchHashL2Field0.Set(h.unit, newONSLHashField(fields, trunkHashL2Fields)); err != nil {
+		return err
+	}
+
+	if err := opennsl.SwitchHashIP4Field0.Set(h.unit, newONSLHashField(fields, trunkHashIP4Fields)); err != nil {
+		return err
+	}
+
+	if err := opennsl.SwitchHashIP6Field0.Set(h.unit, newONSLHashField(fields, trunkHashIP6Fields)); err != nil {
+		return err
+	}
+
+	return opennsl.SwitchHashControl.Set(h.unit, int(opennsl.HASH_CONTROL_TRUNK_NUC_ENHANCE))
+}
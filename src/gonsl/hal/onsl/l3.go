@@ -0,0 +1,437 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+	"net"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+const (
+	l3TraverseNum      = 1024
+	onslL3EcmpMaxPaths = 64
+)
+
+var l3FlagsTable = map[hal.L3Flags]opennsl.L3Flags{
+	hal.L3_WITH_ID:   opennsl.L3_WITH_ID,
+	hal.L3_REPLACE:   opennsl.L3_REPLACE,
+	hal.L3_IP6:       opennsl.L3_IP6,
+	hal.L3_TGID:      opennsl.L3_TGID,
+	hal.L3_MULTIPATH: opennsl.L3_MULTIPATH,
+}
+
+func newONSLL3Flags(flags hal.L3Flags) opennsl.L3Flags {
+	f := opennsl.L3_NONE
+	for halFlag, onslFlag := range l3FlagsTable {
+		if (flags & halFlag) != 0 {
+			f |= onslFlag
+		}
+	}
+	return f
+}
+
+func newL3Flags(flags opennsl.L3Flags) hal.L3Flags {
+	f := hal.L3_NONE
+	for halFlag, onslFlag := range l3FlagsTable {
+		if (flags & onslFlag) != 0 {
+			f |= halFlag
+		}
+	}
+	return f
+}
+
+func newL3Iface(src *opennsl.L3Iface) *hal.L3Iface {
+	return &hal.L3Iface{
+		Flags:   newL3Flags(src.Flags()),
+		IfaceID: hal.L3IfaceID(src.IfaceID()),
+		MAC:     src.MAC(),
+		Vlan:    hal.Vlan(src.VID()),
+		Vrf:     hal.Vrf(src.VRF()),
+		MTU:     int(src.MTU()),
+		MTUFwd:  int(src.MTUFwd()),
+		TTL:     int(src.TTL()),
+	}
+}
+
+func newL3Egress(src *opennsl.L3Egress) *hal.L3Egress {
+	egr := &hal.L3Egress{
+		Flags:   newL3Flags(src.Flags()),
+		Flags2:  uint32(src.Flags2()),
+		IfaceID: hal.L3IfaceID(src.IfaceID()),
+		MAC:     src.MAC(),
+		Vlan:    hal.Vlan(src.VID()),
+		Port:    hal.Port(src.Port()),
+		Trunk:   hal.Trunk(src.Trunk()),
+	}
+
+	if label := src.MplsLabel(); label.Label() != 0 {
+		egr.MplsLabel = newMplsEgressLabel(label)
+	}
+
+	return egr
+}
+
+func newL3Host(src *opennsl.L3Host) *hal.L3Host {
+	host := &hal.L3Host{
+		Flags:      newL3Flags(src.Flags()),
+		Vrf:        hal.Vrf(src.VRF()),
+		EgressID:   hal.L3EgressID(src.EgressID()),
+		NexthopMAC: src.NexthopMAC(),
+	}
+
+	if (host.Flags & hal.L3_IP6) != 0 {
+		host.IP = src.IP6Addr()
+	} else {
+		host.IP = src.IPAddr()
+	}
+
+	return host
+}
+
+func newL3Route(src *opennsl.L3Route) *hal.L3Route {
+	route := &hal.L3Route{
+		Flags:    newL3Flags(src.Flags()),
+		Vrf:      hal.Vrf(src.VRF()),
+		EgressID: hal.L3EgressID(src.EgressID()),
+	}
+
+	if (route.Flags & hal.L3_IP6) != 0 {
+		route.Dst = src.IP6Net()
+	} else {
+		route.Dst = src.IP4Net()
+	}
+
+	return route
+}
+
+//
+// L3IfaceCreate creates l3 interface.
+//
+func (h *ONSL) L3IfaceCreate(iface *hal.L3Iface) (hal.L3IfaceID, error) {
+	l3iface := opennsl.NewL3Iface()
+	if iface.Flags != hal.L3_NONE {
+		l3iface.SetFlags(newONSLL3Flags(iface.Flags))
+	}
+	if (iface.Flags & hal.L3_WITH_ID) != 0 {
+		l3iface.SetIfaceID(opennsl.L3IfaceID(iface.IfaceID))
+	}
+	l3iface.SetMAC(iface.MAC)
+	l3iface.SetVID(opennsl.Vlan(iface.Vlan))
+	if iface.Vrf != 0 {
+		l3iface.SetVRF(opennsl.Vrf(iface.Vrf))
+	}
+
+	if err := l3iface.Create(h.unit); err != nil {
+		return 0, err
+	}
+
+	return hal.L3IfaceID(l3iface.IfaceID()), nil
+}
+
+//
+// L3IfaceDelete deletes l3 interface.
+//
+func (h *ONSL) L3IfaceDelete(ifaceID hal.L3IfaceID) error {
+	l3iface := opennsl.NewL3Iface()
+	l3iface.SetIfaceID(opennsl.L3IfaceID(ifaceID))
+	return l3iface.Delete(h.unit)
+}
+
+//
+// L3IfaceGet returns l3 interface.
+//
+func (h *ONSL) L3IfaceGet(ifaceID hal.L3IfaceID) (*hal.L3Iface, error) {
+	l3iface, err := opennsl.L3IfaceGet(h.unit, opennsl.L3IfaceID(ifaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	return newL3Iface(l3iface), nil
+}
+
+//
+// L3IfaceFind returns l3 interface which has mac and vid.
+//
+func (h *ONSL) L3IfaceFind(mac net.HardwareAddr, vid hal.Vlan) (*hal.L3Iface, error) {
+	l3iface, err := opennsl.L3IfaceFind(h.unit, mac, opennsl.Vlan(vid))
+	if err != nil {
+		return nil, err
+	}
+
+	return newL3Iface(l3iface), nil
+}
+
+//
+// L3EgressCreate creates l3 egress.
+//
+func (h *ONSL) L3EgressCreate(flags hal.L3Flags, egr *hal.L3Egress, egrID hal.L3EgressID) (hal.L3EgressID, error) {
+	l3egr := opennsl.NewL3Egress()
+	l3egr.SetIfaceID(opennsl.L3IfaceID(egr.IfaceID))
+	l3egr.SetMAC(egr.MAC)
+	l3egr.SetVID(opennsl.Vlan(egr.Vlan))
+	if egr.IsTrunk() {
+		flags |= hal.L3_TGID
+		l3egr.SetTrunk(opennsl.Trunk(egr.Trunk))
+	} else {
+		l3egr.SetPort(opennsl.Port(egr.Port))
+	}
+	if egr.MplsLabel != nil {
+		l3egr.SetMplsLabel(newONSLMplsEgressLabel(egr.MplsLabel))
+	}
+
+	id, err := l3egr.Create(h.unit, newONSLL3Flags(flags), opennsl.L3EgressID(egrID))
+	if err != nil {
+		return 0, err
+	}
+
+	return hal.L3EgressID(id), nil
+}
+
+//
+// L3EgressDestroy destroys l3 egress.
+//
+func (h *ONSL) L3EgressDestroy(egrID hal.L3EgressID) error {
+	return opennsl.L3EgressID(egrID).Destroy(h.unit)
+}
+
+//
+// L3EgressGet returns l3 egress.
+//
+func (h *ONSL) L3EgressGet(egrID hal.L3EgressID) (*hal.L3Egress, error) {
+	l3egr, err := opennsl.L3EgressGet(h.unit, opennsl.L3EgressID(egrID))
+	if err != nil {
+		return nil, err
+	}
+
+	return newL3Egress(l3egr), nil
+}
+
+//
+// L3EgressTraverse calls f for each l3 egress.
+//
+func (h *ONSL) L3EgressTraverse(f func(hal.L3EgressID, *hal.L3Egress) error) error {
+	var cbErr error
+	err := opennsl.L3EgressTraverse(h.unit, func(unit int, egrID opennsl.L3EgressID, l3egr *opennsl.L3Egress) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(hal.L3EgressID(egrID), newL3Egress(l3egr))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
+
+//
+// L3EgressEcmpCreate creates l3 egress ecmp.
+//
+func (h *ONSL) L3EgressEcmpCreate(ecmp *hal.L3EgressEcmp) (hal.L3EgressID, error) {
+	members := make([]opennsl.L3EgressID, len(ecmp.Members))
+	for index, member := range ecmp.Members {
+		members[index] = opennsl.L3EgressID(member)
+	}
+
+	l3ecmp := opennsl.NewL3EgressEcmp()
+	l3ecmp.SetMaxPaths(ecmp.MaxPaths)
+	if ecmp.Flags != hal.L3_NONE {
+		l3ecmp.SetFlags(newONSLL3Flags(ecmp.Flags))
+	}
+	if (ecmp.Flags & hal.L3_WITH_ID) != 0 {
+		l3ecmp.SetEgressID(opennsl.L3EgressID(ecmp.EgressID))
+	}
+
+	if err := l3ecmp.Create(h.unit, members); err != nil {
+		return 0, err
+	}
+
+	return hal.L3EgressID(l3ecmp.EgressID()), nil
+}
+
+//
+// L3EgressEcmpDestroy destroys l3 egress ecmp.
+//
+func (h *ONSL) L3EgressEcmpDestroy(egrID hal.L3EgressID) error {
+	l3ecmp := opennsl.NewL3EgressEcmp()
+	l3ecmp.SetEgressID(opennsl.L3EgressID(egrID))
+	return l3ecmp.Destroy(h.unit)
+}
+
+//
+// L3EgressEcmpGet returns l3 egress ecmp.
+//
+func (h *ONSL) L3EgressEcmpGet(egrID hal.L3EgressID) (*hal.L3EgressEcmp, error) {
+	l3ecmp := opennsl.NewL3EgressEcmp()
+	l3ecmp.SetEgressID(opennsl.L3EgressID(egrID))
+
+	members, err := l3ecmp.Get(h.unit, onslL3EcmpMaxPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	ecmp := &hal.L3EgressEcmp{
+		EgressID: egrID,
+		MaxPaths: int(l3ecmp.MaxPaths()),
+		Members:  make([]hal.L3EgressID, len(members)),
+	}
+	for index, member := range members {
+		ecmp.Members[index] = hal.L3EgressID(member)
+	}
+
+	return ecmp, nil
+}
+
+func newONSLL3Host(host *hal.L3Host) *opennsl.L3Host {
+	l3host := opennsl.NewL3Host()
+	if (host.Flags & hal.L3_IP6) != 0 {
+		l3host.SetIP6Addr(host.IP)
+	} else {
+		l3host.SetIPAddr(host.IP)
+	}
+	if host.Flags != hal.L3_NONE {
+		l3host.SetFlags(newONSLL3Flags(host.Flags))
+	}
+	if host.Vrf != 0 {
+		l3host.SetVRF(opennsl.Vrf(host.Vrf))
+	}
+	if host.EgressID != 0 {
+		l3host.SetEgressID(opennsl.L3EgressID(host.EgressID))
+	}
+	return l3host
+}
+
+//
+// L3HostAdd adds l3 host.
+// It replaces existing entry if L3_REPLACE is set.
+//
+func (h *ONSL) L3HostAdd(host *hal.L3Host) error {
+	return newONSLL3Host(host).Add(h.unit)
+}
+
+//
+// L3HostDelete deletes l3 host.
+//
+func (h *ONSL) L3HostDelete(host *hal.L3Host) error {
+	return newONSLL3Host(host).Delete(h.unit)
+}
+
+//
+// l3Traverse calls traverse repeatedly with index range of l3TraverseNum
+// until no entry is found.
+//
+func l3Traverse(traverse func(uint32, uint32) (int, error)) error {
+	var startIndex uint32
+	for {
+		endIndex := startIndex + l3TraverseNum
+		count, err := traverse(startIndex, endIndex)
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return nil
+		}
+
+		startIndex += (l3TraverseNum + 1)
+	}
+}
+
+//
+// L3HostTraverse calls f for each l3 host.
+//
+func (h *ONSL) L3HostTraverse(flags hal.L3Flags, f func(*hal.L3Host) error) error {
+	var cbErr error
+	onslFlags := uint32(newONSLL3Flags(flags & hal.L3_IP6))
+	err := l3Traverse(func(startIndex, endIndex uint32) (int, error) {
+		count := 0
+		err := opennsl.L3HostTraverse(h.unit, onslFlags, startIndex, endIndex, func(unit int, index int, host *opennsl.L3Host) opennsl.OpenNSLError {
+			count++
+			if cbErr == nil {
+				cbErr = f(newL3Host(host))
+			}
+			return opennsl.E_NONE
+		})
+		return count, err
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
+
+func newONSLL3Route(route *hal.L3Route) *opennsl.L3Route {
+	l3route := opennsl.NewL3Route()
+	if (route.Flags & hal.L3_IP6) != 0 {
+		l3route.SetIP6Net(route.Dst)
+	} else {
+		l3route.SetIP4Net(route.Dst)
+	}
+	if route.Flags != hal.L3_NONE {
+		l3route.SetFlags(newONSLL3Flags(route.Flags))
+	}
+	if route.Vrf != 0 {
+		l3route.SetVRF(opennsl.Vrf(route.Vrf))
+	}
+	if route.EgressID != 0 {
+		l3route.SetEgressID(opennsl.L3EgressID(route.EgressID))
+	}
+	return l3route
+}
+
+//
+// L3RouteAdd adds l3 route.
+// It replaces existing entry if L3_REPLACE is set.
+//
+func (h *ONSL) L3RouteAdd(route *hal.L3Route) error {
+	return newONSLL3Route(route).Add(h.unit)
+}
+
+//
+// L3RouteDelete deletes l3 route.
+//
+func (h *ONSL) L3RouteDelete(route *hal.L3Route) error {
+	return newONSLL3Route(route).Delete(h.unit)
+}
+
+//
+// L3RouteTraverse calls f for each l3 route.
+//
+func (h *ONSL) L3RouteTraverse(flags hal.L3Flags, f func(*hal.L3Route) error) error {
+	var cbErr error
+	onslFlags := uint32(newONSLL3Flags(flags & hal.L3_IP6))
+	err := l3Traverse(func(startIndex, endIndex uint32) (int, error) {
+		count := 0
+		err := opennsl.L3RouteTraverse(h.unit, onslFlags, startIndex, endIndex, func(unit int, index int, route *opennsl.L3Route) opennsl.OpenNSLError {
+			count++
+			if cbErr == nil {
+				cbErr = f(newL3Route(route))
+			}
+			return opennsl.E_NONE
+		})
+		return count, err
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
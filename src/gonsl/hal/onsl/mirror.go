@@ -0,0 +1,109 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+const (
+	mirrorTPID       = 0x8100
+	mirrorIPVersion4 = 4
+)
+
+var mirrorPortFlagsTable = map[hal.MirrorPortFlags]opennsl.MirrorPortFlags{
+	hal.MIRROR_PORT_INGRESS: opennsl.MIRROR_PORT_INGRESS,
+	hal.MIRROR_PORT_EGRESS:  opennsl.MIRROR_PORT_EGRESS,
+}
+
+func newONSLMirrorPortFlags(flags hal.MirrorPortFlags) opennsl.MirrorPortFlags {
+	f := opennsl.MirrorPortFlags(0)
+	for halFlag, onslFlag := range mirrorPortFlagsTable {
+		if (flags & halFlag) != 0 {
+			f |= onslFlag
+		}
+	}
+	return f
+}
+
+//
+// mirrorInit enables mirroring to destinations.
+//
+func (h *ONSL) mirrorInit() error {
+	if err := opennsl.MirrorInit(h.unit); err != nil {
+		return err
+	}
+
+	return opennsl.SwitchDirectedMirroring.Set(h.unit, 1)
+}
+
+//
+// MirrorDestCreate creates mirror destination.
+//
+func (h *ONSL) MirrorDestCreate(dest *hal.MirrorDestination) (hal.MirrorDestID, error) {
+	gport, err := opennsl.PortGportGet(h.unit, opennsl.Port(dest.Port))
+	if err != nil {
+		return 0, err
+	}
+
+	md := opennsl.NewMirrorDestination()
+	md.SetGPort(gport)
+
+	if dest.IsTunnel() {
+		md.SetFlags(opennsl.MIRROR_DEST_TUNNEL_IP_GRE)
+		md.SetVersion(mirrorIPVersion4)
+		md.SetSrcAddr(dest.SrcIP)
+		md.SetDstAddr(dest.DstIP)
+		md.SetSrcMAC(dest.SrcMAC)
+		md.SetDstMAC(dest.DstMAC)
+		md.SetTPID(mirrorTPID)
+		md.SetVID(opennsl.Vlan(dest.Vlan))
+		md.SetTTL(dest.TTL)
+		md.SetTOS(dest.TOS)
+		md.SetGreProtocol(dest.GreProtocol)
+	}
+
+	if err := md.Create(h.unit); err != nil {
+		return 0, err
+	}
+
+	return hal.MirrorDestID(md.MirrorDestID()), nil
+}
+
+//
+// MirrorDestDestroy destroys mirror destination.
+//
+func (h *ONSL) MirrorDestDestroy(destID hal.MirrorDestID) error {
+	return opennsl.MirrorDestinationDestroy(h.unit, opennsl.GPort(destID))
+}
+
+//
+// MirrorPortDestAdd mirrors traffic of port to destination.
+//
+func (h *ONSL) MirrorPortDestAdd(port hal.Port, flags hal.MirrorPortFlags, destID hal.MirrorDestID) error {
+	return opennsl.MirrorPortDestAdd(h.unit, opennsl.Port(port), newONSLMirrorPortFlags(flags), opennsl.GPort(destID))
+}
+
+//
+// MirrorPortDestDelete stops mirroring traffic of port to destination.
+//
+func (h *ONSL) MirrorPortDestDelete(port hal.Port, flags hal.MirrorPortFlags, destID hal.MirrorDestID) error {
+	return opennsl.MirrorPortDestDelete(h.unit, opennsl.Port(port), newONSLMirrorPortFlags(flags), opennsl.GPort(destID))
+}
@@ -0,0 +1,184 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+var mplsSwitchActionTable = map[hal.MplsSwitchAction]opennsl.MplsSwitchAction{
+	hal.MPLS_SWITCH_ACTION_SWAP: opennsl.MPLS_SWITCH_ACTION_SWAP,
+	hal.MPLS_SWITCH_ACTION_PHP:  opennsl.MPLS_SWITCH_ACTION_PHP,
+	hal.MPLS_SWITCH_ACTION_POP:  opennsl.MPLS_SWITCH_ACTION_POP,
+}
+
+func newMplsSwitchAction(action opennsl.MplsSwitchAction) hal.MplsSwitchAction {
+	for halAction, onslAction := range mplsSwitchActionTable {
+		if onslAction == action {
+			return halAction
+		}
+	}
+	return hal.MPLS_SWITCH_ACTION_SWAP
+}
+
+func newMplsEgressLabel(src *opennsl.MplsEgressLabel) *hal.MplsEgressLabel {
+	flags := hal.MPLS_EGRESS_LABEL_NONE
+	if (src.Flags() & opennsl.MPLS_EGRESS_LABEL_TTL_DECREMENT) != 0 {
+		flags |= hal.MPLS_EGRESS_LABEL_TTL_DECREMENT
+	}
+	if (src.Flags() & opennsl.MPLS_EGRESS_LABEL_TTL_SET) != 0 {
+		flags |= hal.MPLS_EGRESS_LABEL_TTL_SET
+	}
+
+	return &hal.MplsEgressLabel{
+		Flags: flags,
+		Label: hal.MplsLabel(src.Label()),
+		TTL:   uint8(src.TTL()),
+	}
+}
+
+func newONSLMplsEgressLabel(label *hal.MplsEgressLabel) *opennsl.MplsEgressLabel {
+	l := opennsl.NewMplsEgressLabel()
+	l.SetLabel(opennsl.MplsLabel(label.Label))
+	l.SetTTL(label.TTL)
+	if (label.Flags & hal.MPLS_EGRESS_LABEL_TTL_DECREMENT) != 0 {
+		l.SetFlags(l.Flags() | opennsl.MPLS_EGRESS_LABEL_TTL_DECREMENT)
+	}
+	if (label.Flags & hal.MPLS_EGRESS_LABEL_TTL_SET) != 0 {
+		l.SetFlags(l.Flags() | opennsl.MPLS_EGRESS_LABEL_TTL_SET)
+	}
+	return l
+}
+
+func newMplsTunnelSwitch(src *opennsl.MplsTunnelSwitch) *hal.MplsTunnelSwitch {
+	flags := hal.MPLS_SWITCH_NONE
+	if (src.Flags() & opennsl.MPLS_SWITCH_TTL_DECREMENT) != 0 {
+		flags |= hal.MPLS_SWITCH_TTL_DECREMENT
+	}
+
+	tsw := &hal.MplsTunnelSwitch{
+		Flags:    flags,
+		Label:    hal.MplsLabel(src.Label()),
+		Port:     hal.Port(src.Port()),
+		Action:   newMplsSwitchAction(src.Action()),
+		Vrf:      hal.Vrf(src.VRF()),
+		EgressIf: hal.L3EgressID(src.EgressIf()),
+	}
+
+	if label := src.EgressLabel(); label.Label() != 0 {
+		tsw.EgressLabel = newMplsEgressLabel(label)
+	}
+
+	return tsw
+}
+
+func newONSLMplsTunnelSwitch(t *hal.MplsTunnelSwitch) *opennsl.MplsTunnelSwitch {
+	tsw := opennsl.NewMplsTunnelSwitch()
+	tsw.SetLabel(opennsl.MplsLabel(t.Label))
+	tsw.SetPort(opennsl.Port(t.Port))
+	if (t.Flags & hal.MPLS_SWITCH_TTL_DECREMENT) != 0 {
+		tsw.SetFlags(opennsl.MPLS_SWITCH_TTL_DECREMENT)
+	}
+	tsw.SetAction(mplsSwitchActionTable[t.Action])
+	if t.Vrf != 0 {
+		tsw.SetVRF(opennsl.Vrf(t.Vrf))
+	}
+	if t.EgressIf != 0 {
+		tsw.SetEgressIf(opennsl.L3EgressID(t.EgressIf))
+	}
+	if t.EgressLabel != nil {
+		tsw.SetEgressLabel(newONSLMplsEgressLabel(t.EgressLabel))
+	}
+	return tsw
+}
+
+//
+// MplsTunnelInitiatorSet sets labels pushed on l3 interface.
+//
+func (h *ONSL) MplsTunnelInitiatorSet(ifaceID hal.L3IfaceID, labels []*hal.MplsEgressLabel) error {
+	ls := make([]*opennsl.MplsEgressLabel, len(labels))
+	for index, label := range labels {
+		ls[index] = newONSLMplsEgressLabel(label)
+	}
+
+	return opennsl.MplsTunnelInitiatorSet(h.unit, opennsl.L3IfaceID(ifaceID), ls)
+}
+
+//
+// MplsTunnelInitiatorClear clears labels on l3 interface.
+//
+func (h *ONSL) MplsTunnelInitiatorClear(ifaceID hal.L3IfaceID) error {
+	return opennsl.MplsTunnelInitiatorClear(h.unit, opennsl.L3IfaceID(ifaceID))
+}
+
+//
+// MplsTunnelInitiatorGet returns labels on l3 interface (max labels).
+//
+func (h *ONSL) MplsTunnelInitiatorGet(ifaceID hal.L3IfaceID, max int) ([]*hal.MplsEgressLabel, error) {
+	ls, err := opennsl.MplsTunnelInitiatorGet(h.unit, opennsl.L3IfaceID(ifaceID), max)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]*hal.MplsEgressLabel, len(ls))
+	for index, l := range ls {
+		labels[index] = newMplsEgressLabel(l)
+	}
+
+	return labels, nil
+}
+
+//
+// MplsTunnelSwitchAdd adds mpls tunnel switch.
+// It replaces existing entry (delete and add) if MPLS_SWITCH_REPLACE is set.
+//
+func (h *ONSL) MplsTunnelSwitchAdd(tsw *hal.MplsTunnelSwitch) error {
+	if (tsw.Flags & hal.MPLS_SWITCH_REPLACE) != 0 {
+		if err := newONSLMplsTunnelSwitch(tsw).Delete(h.unit); err != nil {
+			h.log.Debugf("MplsTunnelSwitchAdd: delete error. %s %s", tsw, err)
+		}
+	}
+	return newONSLMplsTunnelSwitch(tsw).Add(h.unit)
+}
+
+//
+// MplsTunnelSwitchDelete deletes mpls tunnel switch.
+//
+func (h *ONSL) MplsTunnelSwitchDelete(tsw *hal.MplsTunnelSwitch) error {
+	return newONSLMplsTunnelSwitch(tsw).Delete(h.unit)
+}
+
+//
+// MplsTunnelSwitchTraverse calls f for each mpls tunnel switch.
+//
+func (h *ONSL) MplsTunnelSwitchTraverse(f func(*hal.MplsTunnelSwitch) error) error {
+	var cbErr error
+	err := opennsl.MplsTunnelSwitchTraverse(h.unit, func(unit int, tsw *opennsl.MplsTunnelSwitch) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(newMplsTunnelSwitch(tsw))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
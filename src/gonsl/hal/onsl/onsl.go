@@ -0,0 +1,172 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	"github.com/beluganos/go-opennsl/sal"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ONSLName is name of OpenNSL backend.
+	ONSLName = "opennsl"
+)
+
+var _ hal.HAL = (*ONSL)(nil)
+
+//
+// ONSL is OpenNSL backend of gonslhal.HAL.
+//
+type ONSL struct {
+	unit     int
+	cfgFname string
+	useSim   bool
+	rxMutex  sync.Mutex
+	rxCfg    *opennsl.RxCfg
+	rxRefs   int
+
+	log *log.Entry
+}
+
+//
+// NewONSL returns new instance.
+// cfgFname is config file of OpenNSL (empty if not used).
+// useSim must be true when running on BCM simulator.
+//
+func NewONSL(unit int, cfgFname string, useSim bool) *ONSL {
+	return &ONSL{
+		unit:     unit,
+		cfgFname: cfgFname,
+		useSim:   useSim,
+
+		log: log.WithFields(log.Fields{"module": "halonsl"}),
+	}
+}
+
+//
+// Unit returns unit number.
+//
+func (h *ONSL) Unit() int {
+	return h.unit
+}
+
+func (h *ONSL) simInit() {
+	// FOR DEBUG ONLY
+	h.log.Infof("Initialize for simulator.")
+	if err := opennsl.PortInit(h.unit); err != nil {
+		h.log.Debugf("opennsl.PortInit error. %s", err)
+	}
+	if _, err := opennsl.VlanDefaultMustGet(h.unit).Create(h.unit); err != nil {
+		h.log.Debugf("opennsl.VlanCreate error. %s", err)
+	}
+}
+
+//
+// Init initializes opennsl driver.
+//
+func (h *ONSL) Init() error {
+	if h.useSim {
+		h.simInit()
+	}
+
+	var init *sal.Init
+	if len(h.cfgFname) != 0 {
+		init = &sal.Init{}
+		init.Free()
+		init.SetCfgFname(h.cfgFname)
+
+		h.log.Infof("Driver init uses config %s", h.cfgFname)
+	}
+
+	if err := init.Init(); err != nil {
+		h.log.Errorf("Initializer error. %s", err)
+		return err
+	}
+
+	if err := h.portDefaultConfig(); err != nil {
+		h.log.Errorf("Port default config error. %s", err)
+		return err
+	}
+
+	if err := opennsl.StatInit(h.unit); err != nil {
+		h.log.Errorf("Stat init error. %s", err)
+		return err
+	}
+
+	if err := h.cosqInit(); err != nil {
+		h.log.Errorf("Cosq init error. %s", err)
+		return err
+	}
+
+	if err := h.mirrorInit(); err != nil {
+		h.log.Errorf("Mirror init error. %s", err)
+		return err
+	}
+
+	if err := h.rxInit(); err != nil {
+		h.log.Errorf("Rx Init error. %s", err)
+		return err
+	}
+
+	if err := opennsl.SwitchL3EgressMode.Set(h.unit, 1); err != nil {
+		h.log.Errorf(".SwitchL3EgressMode.Set %s", err)
+		return err
+	}
+
+	h.log.Infof("DriverInit ok. unit=%d", h.unit)
+	return nil
+}
+
+//
+// Exit terminates opennsl driver.
+//
+func (h *ONSL) Exit() {
+	h.log.Infof("DriverExit()")
+	sal.DriverExit()
+}
+
+//
+// Info returns driver information.
+//
+func (h *ONSL) Info() (*hal.DriverInfo, error) {
+	info, err := opennsl.InfoGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	l3info, err := opennsl.L3InfoGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hal.DriverInfo{
+		Name:      ONSLName,
+		Version:   sal.VersionGet(),
+		Device:    uint32(info.Device()),
+		Revision:  uint32(info.Revision()),
+		UsedIface: int(l3info.UsedIface()),
+		MaxIface:  int(l3info.MaxIface()),
+		MaxHost:   int(l3info.MaxHost()),
+		MaxRoute:  int(l3info.MaxRoute()),
+	}, nil
+}
@@ -0,0 +1,156 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	"bytes"
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+const (
+	packetRxSize    = 16 * 1024
+	packetRxPerChan = 10
+	packerRxChans   = 4
+	packetRxPps     = 30000
+	packetTxPadsize = 4
+)
+
+func (h *ONSL) rxInit() error {
+	pcfg, err := opennsl.PortConfigGet(h.unit)
+	if err != nil {
+		h.log.Errorf("RxInit: PortConfigGet error. %s", err)
+		return err
+	}
+
+	bmp, _ := pcfg.PBmp(opennsl.PORT_CONFIG_CPU)
+	if err := opennsl.VlanDefaultMustGet(h.unit).PortAdd(h.unit, bmp, bmp); err != nil {
+		h.log.Errorf("DEFAULT_VLAN.PortAdd. %s", err)
+		return err
+	}
+
+	h.log.Infof("Rx init ok.")
+	return nil
+}
+
+func newPacket(pkt *opennsl.Pkt) (*hal.Packet, error) {
+	var buf bytes.Buffer
+	if _, err := pkt.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return &hal.Packet{
+		Flags:      uint32(pkt.Flags()),
+		Cos:        int(pkt.Cos()),
+		Vlan:       hal.Vlan(pkt.VID()),
+		SrcPort:    hal.Port(pkt.SrcPort()),
+		DstPort:    hal.Port(pkt.DstPort()),
+		RxPort:     hal.Port(pkt.RxPort()),
+		RxUntagged: pkt.RxUntagged() != 0,
+		Reasons:    newPacketReasons(pkt),
+		Data:       buf.Bytes(),
+	}, nil
+}
+
+//
+// RxRegister registers callback of packets copied to cpu with cos,
+// and starts rx if not active.
+//
+func (h *ONSL) RxRegister(pri int, cos uint32, f hal.RxCallback) error {
+	h.rxMutex.Lock()
+	defer h.rxMutex.Unlock()
+
+	flg := opennsl.NewRxCallbackFlags(cos)
+	err := opennsl.RxRegister(h.unit, pri, flg, func(unit int, pkt *opennsl.Pkt) {
+		p, err := newPacket(pkt)
+		if err != nil {
+			h.log.Errorf("RxPacket: pkt.WriteTo error. %s", err)
+			return
+		}
+
+		f(p)
+	})
+	if err != nil {
+		return err
+	}
+
+	if active := opennsl.RxActive(h.unit); !active {
+		cfg := opennsl.NewRxCfg()
+		cfg.SetPktSize(packetRxSize)
+		cfg.SetPktsPerChain(packetRxPerChan)
+		cfg.SetGlobalPps(packetRxPps)
+		cfg.ChanCfg(1).SetChains(packerRxChans)
+		// cfg.ChanCfg(1).SetCosBmp(0xffffffff)
+
+		if err := opennsl.RxStart(h.unit, cfg); err != nil {
+			opennsl.RxUnregister(h.unit, pri)
+			return err
+		}
+
+		h.rxCfg = cfg
+
+		h.log.Infof("RxPacket: activated.")
+	}
+
+	h.rxRefs++
+	return nil
+}
+
+//
+// RxUnregister unregisters callback and stops rx started by RxRegister
+// when last callback is unregistered.
+//
+func (h *ONSL) RxUnregister(pri int) {
+	h.rxMutex.Lock()
+	defer h.rxMutex.Unlock()
+
+	opennsl.RxUnregister(h.unit, pri)
+
+	if h.rxRefs > 0 {
+		h.rxRefs--
+	}
+
+	if h.rxRefs == 0 && h.rxCfg != nil {
+		h.rxCfg.Stop(h.unit)
+		h.rxCfg = nil
+
+		h.log.Infof("RxPacket: deactivated.")
+	}
+}
+
+//
+// Tx sends packet to DstPort.
+//
+func (h *ONSL) Tx(p *hal.Packet) error {
+	pkt, err := opennsl.PktAlloc(h.unit, len(p.Data)+packetTxPadsize, opennsl.PKT_F_NONE)
+	if err != nil {
+		return err
+	}
+
+	defer pkt.Free(h.unit)
+
+	if err := pkt.Memcpy(0, p.Data); err != nil {
+		return err
+	}
+
+	pkt.TxPBmp().Clear()
+	pkt.TxPBmp().Add(opennsl.Port(p.DstPort))
+
+	return pkt.Tx(h.unit)
+}
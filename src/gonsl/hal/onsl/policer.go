@@ -0,0 +1,128 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+var fieldEntryStatTypes = []opennsl.FieldStat{
+	opennsl.FieldStatGreenPackets,
+	opennsl.FieldStatRedPackets,
+}
+
+func newONSLPolicerConfig(cfg *hal.PolicerConfig) *opennsl.PolicerConfig {
+	c := opennsl.NewPolicerConfig()
+	c.SetMode(opennsl.POLICER_MODE_SR_TCM)
+	c.SetCkbitsSec(cfg.Rate)
+	c.SetCkbitsBurst(cfg.Burst)
+	if cfg.Packets {
+		c.SetFlags(opennsl.POLICER_MODE_PACKETS)
+	}
+	return c
+}
+
+//
+// PolicerCreate creates policer.
+//
+func (h *ONSL) PolicerCreate(cfg *hal.PolicerConfig) (hal.PolicerID, error) {
+	policer, err := opennsl.PolicerCreate(h.unit, newONSLPolicerConfig(cfg))
+	if err != nil {
+		return 0, err
+	}
+
+	return hal.PolicerID(policer), nil
+}
+
+//
+// PolicerSet changes rate and burst of policer.
+//
+func (h *ONSL) PolicerSet(policerID hal.PolicerID, cfg *hal.PolicerConfig) error {
+	return opennsl.Policer(policerID).Set(h.unit, newONSLPolicerConfig(cfg))
+}
+
+//
+// PolicerDestroy destroys policer.
+//
+func (h *ONSL) PolicerDestroy(policerID hal.PolicerID) error {
+	return opennsl.Policer(policerID).Destroy(h.unit)
+}
+
+//
+// fieldEntryPolicerAttach attaches policer and counters of green/red packets to entry.
+//
+func (h *ONSL) fieldEntryPolicerAttach(groupID hal.FieldGroupID, entry opennsl.FieldEntry, policerID hal.PolicerID) error {
+	if err := entry.PolicerAttach(h.unit, 0, opennsl.Policer(policerID)); err != nil {
+		return err
+	}
+
+	stat, err := opennsl.FieldStatCreate(h.unit, opennsl.FieldGroup(groupID), fieldEntryStatTypes...)
+	if err != nil {
+		entry.PolicerDetach(h.unit, 0)
+		return err
+	}
+
+	if err := entry.StatAttach(h.unit, stat); err != nil {
+		stat.Destroy(h.unit)
+		entry.PolicerDetach(h.unit, 0)
+		return err
+	}
+
+	return nil
+}
+
+//
+// fieldEntryPolicerDetach detaches policer and counters from entry if attached.
+//
+func (h *ONSL) fieldEntryPolicerDetach(entry opennsl.FieldEntry) {
+	if stat, err := entry.StatGet(h.unit); err == nil {
+		entry.StatDetach(h.unit, stat)
+		stat.Destroy(h.unit)
+	}
+
+	if _, err := entry.PolicerGet(h.unit, 0); err == nil {
+		entry.PolicerDetach(h.unit, 0)
+	}
+}
+
+//
+// FieldEntryStatGet returns counters of field entry with policer.
+//
+func (h *ONSL) FieldEntryStatGet(entryID hal.FieldEntryID) (*hal.FieldEntryStat, error) {
+	stat, err := opennsl.FieldEntry(entryID).StatGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	green, err := stat.Get(h.unit, opennsl.FieldStatGreenPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	red, err := stat.Get(h.unit, opennsl.FieldStatRedPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hal.FieldEntryStat{
+		GreenPackets: green,
+		RedPackets:   red,
+	}, nil
+}
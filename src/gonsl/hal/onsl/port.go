@@ -0,0 +1,254 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+func newPBmp(src *opennsl.PBmp) *hal.PBmp {
+	pbmp := hal.NewPBmp()
+	src.Each(func(port opennsl.Port) error {
+		pbmp.Add(hal.Port(port))
+		return nil
+	})
+	return pbmp
+}
+
+func newONSLPBmp(src *hal.PBmp) *opennsl.PBmp {
+	pbmp := opennsl.NewPBmp()
+	if src != nil {
+		src.Each(func(port hal.Port) error {
+			pbmp.Add(opennsl.Port(port))
+			return nil
+		})
+	}
+	return pbmp
+}
+
+func newPortInfo(info *opennsl.PortInfo) *hal.PortInfo {
+	return &hal.PortInfo{
+		LinkUp:       info.LinkStatus().IsUp(),
+		Enable:       info.Enable(),
+		UntaggedVlan: hal.Vlan(info.UntaggedVlan()),
+	}
+}
+
+func (h *ONSL) portBmp() (*opennsl.PBmp, error) {
+	portcfg, err := opennsl.PortConfigGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return portcfg.PBmp(opennsl.PORT_CONFIG_E)
+}
+
+func (h *ONSL) portDefaultConfig() error {
+	pbmp, err := h.portBmp()
+	if err != nil {
+		return err
+	}
+
+	portInfo := opennsl.NewPortInfo()
+	portInfo.SetSpeed(0)
+	portInfo.SetDuplex(opennsl.PORT_DUPLEX_FULL)
+	portInfo.SetPauseRX(opennsl.PORT_ABILITY_PAUSE_RX)
+	portInfo.SetPauseTX(opennsl.PORT_ABILITY_PAUSE_TX)
+	portInfo.SetLinkscan(opennsl.LINKSCAN_MODE_SW)
+	portInfo.SetAutoNeg(false)
+	portInfo.SetEnable(true)
+	portInfo.SetActionMask(opennsl.NewPortAttr(
+		opennsl.PORT_ATTR_AUTONEG_MASK,
+		opennsl.PORT_ATTR_DUPLEX_MASK,
+		opennsl.PORT_ATTR_PAUSE_TX_MASK,
+		opennsl.PORT_ATTR_PAUSE_RX_MASK,
+		opennsl.PORT_ATTR_LINKSCAN_MASK,
+		opennsl.PORT_ATTR_ENABLE_MASK,
+		opennsl.PORT_ATTR_SPEED_MASK,
+	))
+	stg := opennsl.Stg(1)
+
+	return pbmp.Each(func(port opennsl.Port) error {
+		if err := stg.StpSet(h.unit, port, opennsl.STG_STP_FORWARD); err != nil {
+			h.log.Errorf("StgSet error. %d %s", port, err)
+			return err
+		}
+
+		if err := port.SelectiveSet(h.unit, portInfo); err != nil {
+			h.log.Errorf("SelectiveSet error. %d %s", port, err)
+			return err
+		}
+
+		h.log.Infof("PortDefaultConfig %d ok.", port)
+		return nil
+	})
+}
+
+//
+// PortBmp returns front panel ports.
+//
+func (h *ONSL) PortBmp() (*hal.PBmp, error) {
+	pbmp, err := h.portBmp()
+	if err != nil {
+		return nil, err
+	}
+
+	return newPBmp(pbmp), nil
+}
+
+//
+// PortInfoGet returns port status.
+//
+func (h *ONSL) PortInfoGet(port hal.Port) (*hal.PortInfo, error) {
+	pinfo := &hal.PortInfo{}
+
+	info := opennsl.NewPortInfo()
+	if err := info.PortSelectiveGet(h.unit, opennsl.Port(port)); err != nil {
+		h.log.Debugf("PortSelectiveGet error. port=%d %s", port, err)
+	} else {
+		pinfo = newPortInfo(info)
+	}
+
+	p := opennsl.Port(port)
+	if linkStatus, err := p.LinkStatusGet(h.unit); err == nil {
+		pinfo.LinkUp = (linkStatus != 0)
+	}
+	if speed, err := p.SpeedGet(h.unit); err == nil {
+		pinfo.Speed = int(speed)
+	}
+	if speedMax, err := p.SpeedMax(h.unit); err == nil {
+		pinfo.SpeedMax = int(speedMax)
+	}
+	if hwaddr, err := p.PauseAddrGet(h.unit); err == nil {
+		pinfo.HwAddr = hwaddr
+	}
+
+	return pinfo, nil
+}
+
+//
+// PortEnableSet enables or disables port.
+//
+func (h *ONSL) PortEnableSet(port hal.Port, enable bool) error {
+	if enable {
+		return opennsl.Port(port).EnableSet(h.unit, opennsl.PORT_ENABLE_TRUE)
+	}
+	return opennsl.Port(port).EnableSet(h.unit, opennsl.PORT_ENABLE_FALSE)
+}
+
+//
+// PortUntaggedVlanSet sets native vlan of port.
+//
+func (h *ONSL) PortUntaggedVlanSet(port hal.Port, vid hal.Vlan) error {
+	return opennsl.Port(port).UntaggedVlanSet(h.unit, opennsl.Vlan(vid))
+}
+
+//
+// PortVlanTranslationSet enables or disables vlan translation
+// (and miss-drop) of port for both ingress and egress.
+//
+func (h *ONSL) PortVlanTranslationSet(halPort hal.Port, enable bool) error {
+	port := opennsl.Port(halPort)
+	value := func() int {
+		if enable {
+			return opennsl.TRUE
+		}
+		return opennsl.FALSE
+	}()
+
+	ctrls := []opennsl.VlanControlPort{
+		opennsl.VlanTranslateIngressEnable,
+		opennsl.VlanTranslateIngressMissDrop,
+		opennsl.VlanTranslateEgressEnable,
+		opennsl.VlanTranslateEgressMissDrop,
+	}
+
+	for _, ctrl := range ctrls {
+		if err := ctrl.Set(h.unit, port, value); err != nil {
+			h.log.Errorf("VlanTranslate%s  error. port=%d enable=%d %s", ctrl, port, value, err)
+			return err
+		}
+
+		h.log.Debugf("VlanTranslate%s ok. port=%d enable=%d", ctrl, port, value)
+	}
+
+	if enable {
+		// Set up port's double tagging mode.
+		if err := port.DtagModeSet(h.unit, opennsl.PORT_DTAG_MODE_INTERNAL); err != nil {
+			h.log.Errorf("PortDtagModeSet port=%d mode=%s error. %s", port, opennsl.PORT_DTAG_MODE_INTERNAL, err)
+			return err
+		}
+
+		h.log.Debugf("DtagModeSet ok port=%d, mode=%s", port, opennsl.PORT_DTAG_MODE_INTERNAL)
+	}
+
+	return nil
+}
+
+//
+// PortStatGet returns counters of port.
+// Invalid names are ignored.
+//
+func (h *ONSL) PortStatGet(port hal.Port, names []string) (map[string]uint64, error) {
+	statVals := []opennsl.StatVal{}
+	for _, name := range names {
+		statVal, err := opennsl.ParseStatVal(name)
+		if err != nil {
+			h.log.Errorf("ParsePortStats error. %s", err)
+		} else {
+			statVals = append(statVals, statVal)
+		}
+	}
+
+	values, err := opennsl.StatValMultiGet(h.unit, opennsl.Port(port), statVals...)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := map[string]uint64{}
+	for index, statVal := range statVals {
+		stats[statVal.String()] = values[index]
+	}
+
+	return stats, nil
+}
+
+//
+// PortStatClear clears counters of port.
+//
+func (h *ONSL) PortStatClear(port hal.Port) error {
+	return opennsl.StatClear(h.unit, opennsl.Port(port))
+}
+
+//
+// LinkscanRegister registers callback of link status.
+//
+func (h *ONSL) LinkscanRegister(name string, f hal.LinkscanCallback) error {
+	return opennsl.LinkscanRegister(h.unit, name, func(unit int, key string, port opennsl.Port, info *opennsl.PortInfo) {
+		f(hal.Port(port), newPortInfo(info))
+	})
+}
+
+//
+// LinkscanUnregister unregisters callback of link status.
+//
+func (h *ONSL) LinkscanUnregister(name string) {
+	opennsl.LinkscanUnregister(h.unit, name)
+}
@@ -0,0 +1,148 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+var cosqSchedModeTable = map[hal.CosqSchedMode]opennsl.CosqSchedMode{
+	hal.COSQ_SCHED_STRICT: opennsl.COSQ_STRICT,
+	hal.COSQ_SCHED_WRR:    opennsl.COSQ_ROUND_ROBIN,
+	hal.COSQ_SCHED_WDRR:   opennsl.COSQ_DEFICIT_ROUND_ROBIN,
+}
+
+var cosqStatTable = map[hal.CosqStat]opennsl.CosqStat{
+	hal.COSQ_STAT_OUT_PACKETS:  opennsl.CosqStatOutPackets,
+	hal.COSQ_STAT_OUT_BYTES:    opennsl.CosqStatOutBytes,
+	hal.COSQ_STAT_DROP_PACKETS: opennsl.CosqStatDroppedPackets,
+	hal.COSQ_STAT_DROP_BYTES:   opennsl.CosqStatDroppedBytes,
+}
+
+//
+// cosqInit maps internal priority to egress queue of same number.
+// Classification of ingress packets sets internal priority.
+//
+func (h *ONSL) cosqInit() error {
+	for queue := 0; queue < hal.COSQ_NUM; queue++ {
+		if err := opennsl.CosqMappingSet(h.unit, opennsl.CosPri(queue), opennsl.CosQueue(queue)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// QosPortMapSet sets classification of ingress packets to egress queue.
+//
+func (h *ONSL) QosPortMapSet(port hal.Port, m *hal.QosMap) error {
+	p := opennsl.Port(port)
+
+	mode := opennsl.PORT_DSCP_MAP_NONE
+	if m.Trust == hal.QOS_TRUST_DSCP {
+		mode = opennsl.PORT_DSCP_MAP_ALL
+	}
+
+	if err := opennsl.PortDscpMapModeSet(h.unit, p, mode); err != nil {
+		return err
+	}
+
+	if m.Trust == hal.QOS_TRUST_DSCP {
+		for dscp := 0; dscp < hal.DSCP_NUM; dscp++ {
+			prio := int(m.DscpQueue(uint8(dscp)))
+			if err := opennsl.PortDscpMapSet(h.unit, p, dscp, dscp, prio); err != nil {
+				return err
+			}
+		}
+	}
+
+	for pcp := 0; pcp < hal.PCP_NUM; pcp++ {
+		prio := 0
+		if m.Trust == hal.QOS_TRUST_PCP {
+			prio = int(m.PcpQueue(uint8(pcp)))
+		}
+		for cfi := 0; cfi < 2; cfi++ {
+			if err := opennsl.PortVlanPriorityMapSet(h.unit, p, pcp, cfi, prio, opennsl.COLOR_GREEN); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+//
+// CosqPortSchedSet sets scheduling and shaping of egress queues.
+//
+func (h *ONSL) CosqPortSchedSet(port hal.Port, sched *hal.CosqPortSched) error {
+	gport, err := opennsl.PortGportGet(h.unit, opennsl.Port(port))
+	if err != nil {
+		return err
+	}
+
+	mode := cosqSchedModeTable[sched.Mode]
+
+	for _, q := range sched.Queues {
+		cosq := opennsl.CosQueue(q.Queue)
+
+		weight := q.Weight
+		if sched.Mode == hal.COSQ_SCHED_STRICT {
+			weight = 0
+		}
+
+		if err := opennsl.CosqGportSchedSet(h.unit, gport, cosq, mode, weight); err != nil {
+			return err
+		}
+
+		if err := opennsl.CosqGportBandwidthSet(h.unit, gport, cosq, q.KbitsMin, q.KbitsMax, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// CosqStatGet returns counters of egress queue of port.
+//
+func (h *ONSL) CosqStatGet(port hal.Port, queue hal.CosQueue, stats []hal.CosqStat) (map[hal.CosqStat]uint64, error) {
+	gport, err := opennsl.PortGportGet(h.unit, opennsl.Port(port))
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[hal.CosqStat]uint64{}
+	for _, stat := range stats {
+		onslStat, ok := cosqStatTable[stat]
+		if !ok {
+			continue
+		}
+
+		value, err := opennsl.CosqStatGet(h.unit, gport, opennsl.CosQueue(queue), onslStat)
+		if err != nil {
+			return nil, err
+		}
+
+		values[stat] = value
+	}
+
+	return values, nil
+}
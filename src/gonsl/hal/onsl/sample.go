@@ -0,0 +1,88 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+const (
+	sampleCosqMapIndex = 0
+)
+
+func newPacketReasons(pkt *opennsl.Pkt) hal.PacketReason {
+	reasons := hal.PACKET_REASON_NONE
+	rxReasons := pkt.RxReasons()
+	if rxReasons.Has(opennsl.RxReasonSampleSource) {
+		reasons |= hal.PACKET_REASON_SAMPLE_INGRESS
+	}
+	if rxReasons.Has(opennsl.RxReasonSampleDest) {
+		reasons |= hal.PACKET_REASON_SAMPLE_EGRESS
+	}
+	return reasons
+}
+
+//
+// PortSampleRateSet sets rate of packet sampling of port,
+// and copies sampled packets to cpu.
+//
+func (h *ONSL) PortSampleRateSet(port hal.Port, rate *hal.PortSampleRate) error {
+	p := opennsl.Port(port)
+	if err := p.SampleRateSet(h.unit, int(rate.Ingress), int(rate.Egress)); err != nil {
+		return err
+	}
+
+	if err := opennsl.PortControlSampleIngressDest.Set(h.unit, p, opennsl.PORT_CONTROL_SAMPLE_DEST_CPU); err != nil {
+		return err
+	}
+
+	return opennsl.PortControlSampleEgressDest.Set(h.unit, p, opennsl.PORT_CONTROL_SAMPLE_DEST_CPU)
+}
+
+//
+// PortSampleRateGet returns rate of packet sampling of port.
+//
+func (h *ONSL) PortSampleRateGet(port hal.Port) (*hal.PortSampleRate, error) {
+	ingress, egress, err := opennsl.Port(port).SampleRateGet(h.unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hal.PortSampleRate{
+		Ingress: uint32(ingress),
+		Egress:  uint32(egress),
+	}, nil
+}
+
+//
+// SampleCosSet maps packets sampled on ingress or egress to cpu cos.
+//
+func (h *ONSL) SampleCosSet(cos uint32) error {
+	reasons := opennsl.NewRxReasons(
+		opennsl.RxReasonSampleSource,
+		opennsl.RxReasonSampleDest,
+	)
+
+	mapping := opennsl.NewRxCosqMapping(sampleCosqMapIndex)
+	mapping.SetReasons(reasons, reasons)
+	mapping.SetCosq(opennsl.CosQueue(cos))
+
+	return mapping.Set(h.unit)
+}
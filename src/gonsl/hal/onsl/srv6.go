@@ -0,0 +1,57 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+)
+
+//
+// OpenNSL does not provide srv6 apis,
+// so srv6 encaps and local sids are not offloaded.
+//
+var errSRv6NotSupported = fmt.Errorf("srv6 not supported by opennsl.")
+
+//
+// SRv6EncapCreate is not supported.
+//
+func (h *ONSL) SRv6EncapCreate(encap *hal.SRv6Encap) (hal.L3EgressID, error) {
+	return 0, errSRv6NotSupported
+}
+
+//
+// SRv6EncapDestroy is not supported.
+//
+func (h *ONSL) SRv6EncapDestroy(egrID hal.L3EgressID) error {
+	return errSRv6NotSupported
+}
+
+//
+// SRv6LocalSidAdd is not supported.
+//
+func (h *ONSL) SRv6LocalSidAdd(sid *hal.SRv6LocalSid) error {
+	return errSRv6NotSupported
+}
+
+//
+// SRv6LocalSidDelete is not supported.
+//
+func (h *ONSL) SRv6LocalSidDelete(sid *hal.SRv6LocalSid) error {
+	return errSRv6NotSupported
+}
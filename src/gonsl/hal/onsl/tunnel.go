@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+var tunnelTypeTable = map[hal.TunnelType]opennsl.TunnelType{
+	hal.TunnelTypeIPIP4encap: opennsl.TunnelTypeIPIP4encap,
+	hal.TunnelTypeIPIP6encap: opennsl.TunnelTypeIPIP6encap,
+	hal.TunnelTypeIPIP4toIP4: opennsl.TunnelTypeIPIP4toIP4,
+	hal.TunnelTypeIPIP4toIP6: opennsl.TunnelTypeIPIP4toIP6,
+	hal.TunnelTypeIPIP6toIP4: opennsl.TunnelTypeIPIP6toIP4,
+	hal.TunnelTypeIPIP6toIP6: opennsl.TunnelTypeIPIP6toIP6,
+}
+
+func newTunnelType(tunType opennsl.TunnelType) hal.TunnelType {
+	for halType, onslType := range tunnelTypeTable {
+		if onslType == tunType {
+			return halType
+		}
+	}
+	return hal.TunnelTypeNone
+}
+
+func newTunnelInitiator(src *opennsl.TunnelInitiator) *hal.TunnelInitiator {
+	tun := &hal.TunnelInitiator{
+		Flags:      uint32(src.Flags()),
+		TunnelID:   uint32(src.TunnelID()),
+		Type:       newTunnelType(src.Type()),
+		L3IfaceID:  hal.L3IfaceID(src.L3IfaceID()),
+		DstMAC:     src.DstMAC(),
+		SrcMAC:     src.SrcMAC(),
+		UdpDstPort: hal.L4Port(src.UdpDstPort()),
+		UdpSrcPort: hal.L4Port(src.UdpSrcPort()),
+		TTL:        int(src.TTL()),
+		MTU:        int(src.MTU()),
+		Vlan:       hal.Vlan(src.VID()),
+	}
+
+	switch tun.Type {
+	case hal.TunnelTypeIPIP4encap:
+		tun.DstIP, tun.SrcIP = src.DstIP4(), src.SrcIP4()
+	case hal.TunnelTypeIPIP6encap:
+		tun.DstIP, tun.SrcIP = src.DstIP6(), src.SrcIP6()
+	}
+
+	return tun
+}
+
+func newTunnelTerminator(src *opennsl.TunnelTerminator) *hal.TunnelTerminator {
+	tun := &hal.TunnelTerminator{
+		Flags:      uint32(src.Flags()),
+		TunnelID:   uint32(src.TunnelID()),
+		Type:       newTunnelType(src.Type()),
+		RemotePort: hal.Port(src.RemotePort()),
+		UdpDstPort: hal.L4Port(src.UdpDstPort()),
+		UdpSrcPort: hal.L4Port(src.UdpSrcPort()),
+		Vlan:       hal.Vlan(src.VID()),
+		Vrf:        hal.Vrf(src.VRF()),
+		PBmp:       newPBmp(src.PBmp()),
+	}
+
+	switch tun.Type {
+	case hal.TunnelTypeIPIP4toIP4, hal.TunnelTypeIPIP4toIP6:
+		tun.DstIP, tun.SrcIP = src.DstIPNet4(), src.SrcIPNet4()
+	case hal.TunnelTypeIPIP6toIP4, hal.TunnelTypeIPIP6toIP6:
+		tun.DstIP, tun.SrcIP = src.DstIPNet6(), src.SrcIPNet6()
+	}
+
+	return tun
+}
+
+//
+// TunnelInitiatorCreate creates tunnel initiator on l3 interface.
+//
+func (h *ONSL) TunnelInitiatorCreate(ifaceID hal.L3IfaceID, t *hal.TunnelInitiator) error {
+	tun := &opennsl.TunnelInitiator{}
+	tun.Init()
+	tun.SetTTL(t.TTL)
+	tun.SetVID(opennsl.Vlan(t.Vlan))
+	tun.SetL3IfaceID(opennsl.L3IfaceID(ifaceID))
+	tun.SetType(tunnelTypeTable[t.Type])
+
+	switch t.Type {
+	case hal.TunnelTypeIPIP4encap:
+		tun.SetDstIP4(t.DstIP)
+		tun.SetSrcIP4(t.SrcIP)
+	case hal.TunnelTypeIPIP6encap:
+		tun.SetDstIP6(t.DstIP)
+		tun.SetSrcIP6(t.SrcIP)
+	}
+
+	iface := opennsl.NewL3Iface()
+	iface.SetIfaceID(opennsl.L3IfaceID(ifaceID))
+	return tun.Create(h.unit, iface)
+}
+
+//
+// TunnelInitiatorClear clears tunnel initiator on l3 interface.
+//
+func (h *ONSL) TunnelInitiatorClear(ifaceID hal.L3IfaceID) error {
+	iface := opennsl.NewL3Iface()
+	iface.SetIfaceID(opennsl.L3IfaceID(ifaceID))
+	return iface.TunnelInitiatorClear(h.unit)
+}
+
+//
+// TunnelInitiatorTraverse calls f for each tunnel initiator.
+//
+func (h *ONSL) TunnelInitiatorTraverse(f func(*hal.TunnelInitiator) error) error {
+	var cbErr error
+	err := opennsl.TunnelInitiatorTraverse(h.unit, func(unit int, tun *opennsl.TunnelInitiator) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(newTunnelInitiator(tun))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
+
+func newONSLTunnelTerminator(t *hal.TunnelTerminator) *opennsl.TunnelTerminator {
+	tun := opennsl.NewTunnelTerminator(tunnelTypeTable[t.Type])
+
+	switch t.Type {
+	case hal.TunnelTypeIPIP4toIP4, hal.TunnelTypeIPIP4toIP6:
+		tun.SetDstIP4(t.DstIP.IP)
+		tun.SetDstIPMask4(t.DstIP.Mask)
+		tun.SetSrcIP4(t.SrcIP.IP)
+		tun.SetSrcIPMask4(t.SrcIP.Mask)
+	case hal.TunnelTypeIPIP6toIP4, hal.TunnelTypeIPIP6toIP6:
+		tun.SetDstIP6(t.DstIP.IP)
+		tun.SetDstIPMask6(t.DstIP.Mask)
+		tun.SetSrcIP6(t.SrcIP.IP)
+		tun.SetSrcIPMask6(t.SrcIP.Mask)
+	}
+
+	if t.PBmp != nil {
+		t.PBmp.Each(func(port hal.Port) error {
+			tun.PBmp().Add(opennsl.Port(port))
+			return nil
+		})
+	}
+
+	return tun
+}
+
+//
+// TunnelTerminatorCreate creates tunnel terminator.
+//
+func (h *ONSL) TunnelTerminatorCreate(t *hal.TunnelTerminator) error {
+	return newONSLTunnelTerminator(t).Create(h.unit)
+}
+
+//
+// TunnelTerminatorDelete deletes tunnel terminator.
+//
+func (h *ONSL) TunnelTerminatorDelete(t *hal.TunnelTerminator) error {
+	return newONSLTunnelTerminator(t).Delete(h.unit)
+}
+
+//
+// TunnelTerminatorTraverse calls f for each tunnel terminator.
+//
+func (h *ONSL) TunnelTerminatorTraverse(f func(*hal.TunnelTerminator) error) error {
+	var cbErr error
+	err := opennsl.TunnelTerminatorTraverse(h.unit, func(unit int, tun *opennsl.TunnelTerminator) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(newTunnelTerminator(tun))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
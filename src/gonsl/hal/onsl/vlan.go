@@ -0,0 +1,91 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+//
+// VlanDefault returns default vlan.
+//
+func (h *ONSL) VlanDefault() hal.Vlan {
+	return hal.Vlan(opennsl.VlanDefaultMustGet(h.unit))
+}
+
+//
+// VlanCreate creates vlan.
+//
+func (h *ONSL) VlanCreate(vid hal.Vlan) error {
+	_, err := opennsl.Vlan(vid).Create(h.unit)
+	return err
+}
+
+//
+// VlanDestroy destroys vlan.
+//
+func (h *ONSL) VlanDestroy(vid hal.Vlan) error {
+	return opennsl.Vlan(vid).Destroy(h.unit)
+}
+
+//
+// VlanPortAdd adds ports to vlan.
+//
+func (h *ONSL) VlanPortAdd(vid hal.Vlan, pbmp *hal.PBmp, ubmp *hal.PBmp) error {
+	return opennsl.Vlan(vid).PortAdd(h.unit, newONSLPBmp(pbmp), newONSLPBmp(ubmp))
+}
+
+//
+// VlanPortRemove removes ports from vlan.
+//
+func (h *ONSL) VlanPortRemove(vid hal.Vlan, pbmp *hal.PBmp) error {
+	_, err := opennsl.Vlan(vid).PortRemove(h.unit, newONSLPBmp(pbmp))
+	return err
+}
+
+//
+// VlanPortGet returns member ports and untagged ports of vlan.
+//
+func (h *ONSL) VlanPortGet(vid hal.Vlan) (*hal.PBmp, *hal.PBmp, error) {
+	pbmp, ubmp, err := opennsl.Vlan(vid).PortGet(h.unit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newPBmp(pbmp), newPBmp(ubmp), nil
+}
+
+//
+// VlanTraverse calls f for each vlan.
+//
+func (h *ONSL) VlanTraverse(f func(hal.Vlan, *hal.PBmp, *hal.PBmp) error) error {
+	var cbErr error
+	err := opennsl.VlanTraverse(h.unit, func(unit int, vid opennsl.Vlan, pbmp *opennsl.PBmp, ubmp *opennsl.PBmp) opennsl.OpenNSLError {
+		if cbErr == nil {
+			cbErr = f(hal.Vlan(vid), newPBmp(pbmp), newPBmp(ubmp))
+		}
+		return opennsl.E_NONE
+	})
+	if err != nil {
+		return err
+	}
+
+	return cbErr
+}
@@ -0,0 +1,175 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halonsl
+
+import (
+	hal "gonsl/hal"
+	"net"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+//
+// VxlanVpnCreate creates vlan based vxlan vpn.
+// vpn id is same as vlan, so members of vlan are access ports of vpn.
+// BUM traffic is flooded to multicast group created with vpn.
+//
+func (h *ONSL) VxlanVpnCreate(vpn *hal.VxlanVpn) (hal.VxlanVpnID, error) {
+	mc, err := opennsl.MulticastCreate(h.unit, opennsl.MULTICAST_TYPE_VXLAN)
+	if err != nil {
+		return 0, err
+	}
+
+	cfg := opennsl.NewVxlanVpnConfig()
+	cfg.SetFlags(opennsl.VXLAN_VPN_ELAN | opennsl.VXLAN_VPN_WITH_ID | opennsl.VXLAN_VPN_WITH_VPNID)
+	cfg.SetVpn(opennsl.Vpn(vpn.Vlan))
+	cfg.SetVnid(vpn.Vni)
+	cfg.SetBroadcastGroup(mc)
+	cfg.SetUnknownUnicastGroup(mc)
+	cfg.SetUnknownMulticastGroup(mc)
+
+	if err := cfg.Create(h.unit); err != nil {
+		mc.Destroy(h.unit)
+		return 0, err
+	}
+
+	return hal.VxlanVpnID(vpn.Vlan), nil
+}
+
+//
+// VxlanVpnDestroy destroys vxlan vpn and its flood group.
+//
+func (h *ONSL) VxlanVpnDestroy(vpnID hal.VxlanVpnID) error {
+	cfg, err := opennsl.VxlanVpnGet(h.unit, opennsl.Vpn(vpnID))
+	if err != nil {
+		return err
+	}
+
+	if err := opennsl.VxlanVpnDestroy(h.unit, opennsl.Vpn(vpnID)); err != nil {
+		return err
+	}
+
+	return cfg.BroadcastGroup().Destroy(h.unit)
+}
+
+//
+// VxlanPortAdd creates tunnel initiator/terminator to remote vtep
+// and adds network port to vxlan vpn.
+//
+func (h *ONSL) VxlanPortAdd(port *hal.VxlanPort) (hal.VxlanPortID, error) {
+	init := &opennsl.TunnelInitiator{}
+	init.Init()
+	init.SetType(opennsl.TunnelTypeVxlan)
+	init.SetTTL(port.TTL)
+	init.SetDstIP4(port.DstIP)
+	init.SetSrcIP4(port.SrcIP)
+	init.SetUdpDstPort(opennsl.L4Port(port.UdpDstPort))
+	if err := init.VxlanCreate(h.unit); err != nil {
+		return 0, err
+	}
+
+	term := &opennsl.TunnelTerminator{}
+	term.Init()
+	term.SetType(opennsl.TunnelTypeVxlan)
+	term.SetDstIP4(port.SrcIP)
+	term.SetSrcIP4(port.DstIP)
+	term.SetRemotePort(opennsl.Port(init.TunnelID()))
+	term.SetFlags(opennsl.TUNNEL_TERM_TUNNEL_WITH_ID)
+	if err := term.VxlanCreate(h.unit); err != nil {
+		opennsl.TunnelInitiatorDestroy(h.unit, init.TunnelID())
+		return 0, err
+	}
+
+	vp := opennsl.NewVxlanPort()
+	vp.SetFlags(opennsl.VXLAN_PORT_NETWORK | opennsl.VXLAN_PORT_EGRESS_TUNNEL)
+	vp.SetCriteria(opennsl.VXLAN_PORT_MATCH_VN_ID)
+	vp.SetMatchTunnelID(term.TunnelID())
+	vp.SetEgressTunnelID(init.TunnelID())
+	vp.SetEgressIfID(opennsl.L3EgressID(port.Egress))
+
+	if err := vp.Add(h.unit, opennsl.Vpn(port.VpnID)); err != nil {
+		opennsl.TunnelTerminatorDestroy(h.unit, term.TunnelID())
+		opennsl.TunnelInitiatorDestroy(h.unit, init.TunnelID())
+		return 0, err
+	}
+
+	return hal.VxlanPortID(vp.VxlanPortID()), nil
+}
+
+//
+// VxlanPortDelete deletes network port and its tunnel initiator/terminator.
+//
+func (h *ONSL) VxlanPortDelete(vpnID hal.VxlanVpnID, portID hal.VxlanPortID) error {
+	vp, err := opennsl.VxlanPortGet(h.unit, opennsl.Vpn(vpnID), opennsl.GPort(portID))
+	if err != nil {
+		return err
+	}
+
+	if err := opennsl.VxlanPortDelete(h.unit, opennsl.Vpn(vpnID), opennsl.GPort(portID)); err != nil {
+		return err
+	}
+
+	if err := opennsl.TunnelTerminatorDestroy(h.unit, vp.MatchTunnelID()); err != nil {
+		h.log.Warnf("VxlanPortDelete: tunnel terminator destroy error. %s", err)
+	}
+
+	return opennsl.TunnelInitiatorDestroy(h.unit, vp.EgressTunnelID())
+}
+
+//
+// VxlanFloodSet replaces network ports of flood group of vxlan vpn.
+//
+func (h *ONSL) VxlanFloodSet(vpnID hal.VxlanVpnID, portIDs []hal.VxlanPortID) error {
+	cfg, err := opennsl.VxlanVpnGet(h.unit, opennsl.Vpn(vpnID))
+	if err != nil {
+		return err
+	}
+
+	mc := cfg.BroadcastGroup()
+	gports := make([]opennsl.GPort, len(portIDs))
+	encaps := make([]opennsl.IfEncap, len(portIDs))
+	for index, portID := range portIDs {
+		encap, err := mc.VxlanEncapGet(h.unit, opennsl.GPort(portID))
+		if err != nil {
+			return err
+		}
+
+		gports[index] = opennsl.GPort(portID)
+		encaps[index] = encap
+	}
+
+	return mc.EgressSet(h.unit, gports, encaps)
+}
+
+//
+// VxlanL2AddrAdd adds static l2 address via network port.
+//
+func (h *ONSL) VxlanL2AddrAdd(addr *hal.VxlanL2Addr) error {
+	l2addr := opennsl.NewL2Addr(addr.MAC, opennsl.Vlan(addr.VpnID))
+	l2addr.SetFlags(opennsl.L2_STATIC)
+	l2addr.SetPort(opennsl.Port(addr.PortID))
+
+	return l2addr.Add(h.unit)
+}
+
+//
+// VxlanL2AddrDelete deletes l2 address of vxlan vpn.
+//
+func (h *ONSL) VxlanL2AddrDelete(vpnID hal.VxlanVpnID, mac net.HardwareAddr) error {
+	return opennsl.NewL2Addr(mac, opennsl.Vlan(vpnID)).Delete(h.unit)
+}
@@ -0,0 +1,56 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// Packet is packet received from or transmitted to switch.
+//
+type Packet struct {
+	Flags      uint32
+	Cos        int
+	Vlan       Vlan
+	SrcPort    Port
+	DstPort    Port
+	RxPort     Port
+	RxUntagged bool
+	Reasons    PacketReason
+	Data       []byte
+}
+
+func (p *Packet) String() string {
+	return fmt.Sprintf("cos:%d port:%d/%d vid:%d reason:%s len:%d", p.Cos, p.SrcPort, p.DstPort, p.Vlan, p.Reasons, len(p.Data))
+}
+
+//
+// RxCallback is called when packet is received.
+//
+type RxCallback func(*Packet)
+
+//
+// LinkscanCallback is called when port status is changed.
+//
+type LinkscanCallback func(Port, *PortInfo)
+
+//
+// L2AddrCallback is called when l2 address is learned or aged.
+//
+type L2AddrCallback func(*L2Addr, L2CallbackOper)
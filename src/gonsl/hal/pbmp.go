@@ -0,0 +1,155 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//
+// PBmp is port bitmap.
+//
+type PBmp struct {
+	ports map[Port]struct{}
+}
+
+//
+// NewPBmp returns new instance.
+//
+func NewPBmp(ports ...Port) *PBmp {
+	pbmp := &PBmp{
+		ports: map[Port]struct{}{},
+	}
+	for _, port := range ports {
+		pbmp.Add(port)
+	}
+	return pbmp
+}
+
+//
+// Add adds port.
+//
+func (p *PBmp) Add(port Port) {
+	p.ports[port] = struct{}{}
+}
+
+//
+// Remove removes port.
+//
+func (p *PBmp) Remove(port Port) {
+	delete(p.ports, port)
+}
+
+//
+// Clear removes all ports.
+//
+func (p *PBmp) Clear() {
+	p.ports = map[Port]struct{}{}
+}
+
+//
+// Has returns true if port is member.
+//
+func (p *PBmp) Has(port Port) bool {
+	_, ok := p.ports[port]
+	return ok
+}
+
+//
+// Count returns number of ports.
+//
+func (p *PBmp) Count() int {
+	return len(p.ports)
+}
+
+//
+// IsNull returns true if no port is member.
+//
+func (p *PBmp) IsNull() bool {
+	return len(p.ports) == 0
+}
+
+//
+// IsNotNull returns true if any port is member.
+//
+func (p *PBmp) IsNotNull() bool {
+	return !p.IsNull()
+}
+
+//
+// Copy returns copy of pbmp.
+//
+func (p *PBmp) Copy() *PBmp {
+	return NewPBmp(p.PortList()...)
+}
+
+//
+// Merge adds all ports of other pbmp.
+//
+func (p *PBmp) Merge(other *PBmp) {
+	for port := range other.ports {
+		p.Add(port)
+	}
+}
+
+//
+// Subtract removes all ports of other pbmp.
+//
+func (p *PBmp) Subtract(other *PBmp) {
+	for port := range other.ports {
+		p.Remove(port)
+	}
+}
+
+//
+// PortList returns sorted list of ports.
+//
+func (p *PBmp) PortList() []Port {
+	ports := make([]Port, 0, len(p.ports))
+	for port := range p.ports {
+		ports = append(ports, port)
+	}
+
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+//
+// Each calls f for each port in ascending order.
+//
+func (p *PBmp) Each(f func(Port) error) error {
+	for _, port := range p.PortList() {
+		if err := f(port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// String returns string.
+//
+func (p *PBmp) String() string {
+	ss := make([]string, 0, len(p.ports))
+	for _, port := range p.PortList() {
+		ss = append(ss, fmt.Sprintf("%d", port))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(ss, ","))
+}
@@ -0,0 +1,80 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPBmp_AddRemove(t *testing.T) {
+	pbmp := NewPBmp(3, 1)
+
+	if !pbmp.Has(1) || !pbmp.Has(3) || pbmp.Has(2) {
+		t.Errorf("PBmp.Has unmatch. %s", pbmp)
+	}
+
+	pbmp.Add(2)
+	pbmp.Remove(3)
+
+	if ports := pbmp.PortList(); !reflect.DeepEqual(ports, []Port{1, 2}) {
+		t.Errorf("PBmp.PortList unmatch. %v", ports)
+	}
+
+	if n := pbmp.Count(); n != 2 {
+		t.Errorf("PBmp.Count unmatch. %d", n)
+	}
+}
+
+func TestPBmp_Null(t *testing.T) {
+	pbmp := NewPBmp()
+
+	if !pbmp.IsNull() || pbmp.IsNotNull() {
+		t.Errorf("PBmp.IsNull unmatch. %s", pbmp)
+	}
+
+	pbmp.Add(10)
+	if pbmp.IsNull() || !pbmp.IsNotNull() {
+		t.Errorf("PBmp.IsNull unmatch. %s", pbmp)
+	}
+
+	pbmp.Clear()
+	if !pbmp.IsNull() {
+		t.Errorf("PBmp.Clear unmatch. %s", pbmp)
+	}
+}
+
+func TestPBmp_MergeSubtract(t *testing.T) {
+	pbmp := NewPBmp(1, 2)
+	other := NewPBmp(2, 3)
+
+	merged := pbmp.Copy()
+	merged.Merge(other)
+	if ports := merged.PortList(); !reflect.DeepEqual(ports, []Port{1, 2, 3}) {
+		t.Errorf("PBmp.Merge unmatch. %v", ports)
+	}
+
+	pbmp.Subtract(other)
+	if ports := pbmp.PortList(); !reflect.DeepEqual(ports, []Port{1}) {
+		t.Errorf("PBmp.Subtract unmatch. %v", ports)
+	}
+
+	if ports := other.PortList(); !reflect.DeepEqual(ports, []Port{2, 3}) {
+		t.Errorf("PBmp.Subtract changes other. %v", ports)
+	}
+}
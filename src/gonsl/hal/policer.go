@@ -0,0 +1,57 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// PolicerID is id of policer (meter).
+//
+type PolicerID int
+
+//
+// PolicerConfig is single rate policer config.
+// Packets exceeding rate and burst are marked red.
+//
+type PolicerConfig struct {
+	Packets bool   // rate and burst are in packets.
+	Rate    uint32 // kbps or pps
+	Burst   uint32 // kbits or packets
+}
+
+func (c *PolicerConfig) String() string {
+	if c.Packets {
+		return fmt.Sprintf("rate:%dpps burst:%dpkts", c.Rate, c.Burst)
+	}
+	return fmt.Sprintf("rate:%dkbps burst:%dkbits", c.Rate, c.Burst)
+}
+
+//
+// FieldEntryStat is counters of field entry with policer.
+// Green packets conform to policer and red packets exceed it.
+//
+type FieldEntryStat struct {
+	GreenPackets uint64
+	RedPackets   uint64
+}
+
+func (s *FieldEntryStat) String() string {
+	return fmt.Sprintf("green:%d red:%d", s.GreenPackets, s.RedPackets)
+}
@@ -0,0 +1,69 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// PortInfo is status of port.
+//
+type PortInfo struct {
+	LinkUp       bool
+	Enable       bool
+	Speed        int // Mbps
+	SpeedMax     int // Mbps
+	HwAddr       net.HardwareAddr
+	UntaggedVlan Vlan
+}
+
+//
+// LinkStatus returns 1 if link is up, 0 if down.
+//
+func (p *PortInfo) LinkStatus() int {
+	if p.LinkUp {
+		return 1
+	}
+	return 0
+}
+
+func (p *PortInfo) String() string {
+	return fmt.Sprintf("link:%t enable:%t speed:%d/%d hwaddr:%s untag:%d",
+		p.LinkUp, p.Enable, p.Speed, p.SpeedMax, p.HwAddr, p.UntaggedVlan)
+}
+
+//
+// DriverInfo is information of driver and device.
+//
+type DriverInfo struct {
+	Name      string
+	Version   string
+	Device    uint32
+	Revision  uint32
+	UsedIface int
+	MaxIface  int
+	MaxHost   int
+	MaxRoute  int
+}
+
+func (i *DriverInfo) String() string {
+	return fmt.Sprintf("%s %s device=%d rev=%d iface=%d/%d host=%d route=%d",
+		i.Name, i.Version, i.Device, i.Revision, i.UsedIface, i.MaxIface, i.MaxHost, i.MaxRoute)
+}
@@ -0,0 +1,240 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// CosQueue is index of egress queue (traffic class).
+//
+type CosQueue int
+
+const (
+	// COSQ_NUM is number of egress queues per port.
+	COSQ_NUM = 8
+	// DSCP_NUM is number of dscp values.
+	DSCP_NUM = 64
+	// PCP_NUM is number of 802.1p priority values.
+	PCP_NUM = 8
+)
+
+//
+// QosTrust is packet field used to classify ingress packets.
+//
+type QosTrust int
+
+const (
+	QOS_TRUST_NONE QosTrust = iota
+	QOS_TRUST_DSCP
+	QOS_TRUST_PCP
+)
+
+var qosTrustNames = map[QosTrust]string{
+	QOS_TRUST_NONE: "none",
+	QOS_TRUST_DSCP: "dscp",
+	QOS_TRUST_PCP:  "pcp",
+}
+
+var qosTrustValues = map[string]QosTrust{
+	"none": QOS_TRUST_NONE,
+	"dscp": QOS_TRUST_DSCP,
+	"pcp":  QOS_TRUST_PCP,
+}
+
+func (v QosTrust) String() string {
+	if s, ok := qosTrustNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("QosTrust(%d)", v)
+}
+
+//
+// ParseQosTrust parses string.
+//
+func ParseQosTrust(s string) (QosTrust, error) {
+	if v, ok := qosTrustValues[s]; ok {
+		return v, nil
+	}
+	return QOS_TRUST_NONE, fmt.Errorf("Invalid QosTrust. %s", s)
+}
+
+//
+// QosMap is map of dscp/pcp to egress queue.
+// Values not in map are classified to queue 0.
+//
+type QosMap struct {
+	Trust QosTrust
+	Dscp  map[uint8]CosQueue
+	Pcp   map[uint8]CosQueue
+}
+
+//
+// NewQosMap returns new instance.
+//
+func NewQosMap(trust QosTrust) *QosMap {
+	return &QosMap{
+		Trust: trust,
+		Dscp:  map[uint8]CosQueue{},
+		Pcp:   map[uint8]CosQueue{},
+	}
+}
+
+//
+// DscpQueue returns queue of dscp.
+//
+func (m *QosMap) DscpQueue(dscp uint8) CosQueue {
+	return m.Dscp[dscp]
+}
+
+//
+// PcpQueue returns queue of pcp.
+//
+func (m *QosMap) PcpQueue(pcp uint8) CosQueue {
+	return m.Pcp[pcp]
+}
+
+func (m *QosMap) String() string {
+	return fmt.Sprintf("trust:%s dscp:%v pcp:%v", m.Trust, m.Dscp, m.Pcp)
+}
+
+//
+// CosqSchedMode is scheduling mode of egress queues.
+//
+type CosqSchedMode int
+
+const (
+	COSQ_SCHED_STRICT CosqSchedMode = iota
+	COSQ_SCHED_WRR
+	COSQ_SCHED_WDRR
+)
+
+var cosqSchedModeNames = map[CosqSchedMode]string{
+	COSQ_SCHED_STRICT: "strict",
+	COSQ_SCHED_WRR:    "wrr",
+	COSQ_SCHED_WDRR:   "wdrr",
+}
+
+var cosqSchedModeValues = map[string]CosqSchedMode{
+	"strict": COSQ_SCHED_STRICT,
+	"wrr":    COSQ_SCHED_WRR,
+	"wdrr":   COSQ_SCHED_WDRR,
+}
+
+func (v CosqSchedMode) String() string {
+	if s, ok := cosqSchedModeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("CosqSchedMode(%d)", v)
+}
+
+//
+// ParseCosqSchedMode parses string.
+//
+func ParseCosqSchedMode(s string) (CosqSchedMode, error) {
+	if v, ok := cosqSchedModeValues[s]; ok {
+		return v, nil
+	}
+	return COSQ_SCHED_STRICT, fmt.Errorf("Invalid CosqSchedMode. %s", s)
+}
+
+//
+// CosqQueueConfig is scheduling weight and shaping rate of egress queue.
+// Weight is ignored in strict mode. Zero rate means unlimited.
+//
+type CosqQueueConfig struct {
+	Queue    CosQueue
+	Weight   int
+	KbitsMin uint32
+	KbitsMax uint32
+}
+
+func (c *CosqQueueConfig) String() string {
+	return fmt.Sprintf("queue:%d weight:%d kbps:%d-%d", c.Queue, c.Weight, c.KbitsMin, c.KbitsMax)
+}
+
+//
+// CosqPortSched is scheduler of egress queues of port.
+//
+type CosqPortSched struct {
+	Mode   CosqSchedMode
+	Queues []*CosqQueueConfig
+}
+
+func (s *CosqPortSched) String() string {
+	return fmt.Sprintf("mode:%s queues:%v", s.Mode, s.Queues)
+}
+
+//
+// CosqStat is counter type of egress queue.
+//
+type CosqStat int
+
+const (
+	COSQ_STAT_OUT_PACKETS CosqStat = iota
+	COSQ_STAT_OUT_BYTES
+	COSQ_STAT_DROP_PACKETS
+	COSQ_STAT_DROP_BYTES
+)
+
+var cosqStatNames = map[CosqStat]string{
+	COSQ_STAT_OUT_PACKETS:  "OutPkts",
+	COSQ_STAT_OUT_BYTES:    "OutOctets",
+	COSQ_STAT_DROP_PACKETS: "DropPkts",
+	COSQ_STAT_DROP_BYTES:   "DropOctets",
+}
+
+var cosqStatValues = map[string]CosqStat{
+	"OutPkts":    COSQ_STAT_OUT_PACKETS,
+	"OutOctets":  COSQ_STAT_OUT_BYTES,
+	"DropPkts":   COSQ_STAT_DROP_PACKETS,
+	"DropOctets": COSQ_STAT_DROP_BYTES,
+}
+
+func (v CosqStat) String() string {
+	if s, ok := cosqStatNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("CosqStat(%d)", v)
+}
+
+//
+// CosqStatName returns name of queue counter. (e.g. cosq3OutPkts)
+//
+func CosqStatName(queue CosQueue, stat CosqStat) string {
+	return fmt.Sprintf("cosq%d%s", queue, stat)
+}
+
+//
+// ParseCosqStatName parses name of queue counter.
+//
+func ParseCosqStatName(name string) (CosQueue, CosqStat, error) {
+	var queue CosQueue
+	var statName string
+	if _, err := fmt.Sscanf(name, "cosq%1d%s", &queue, &statName); err != nil {
+		return 0, 0, fmt.Errorf("Invalid CosqStat name. %s", name)
+	}
+
+	stat, ok := cosqStatValues[statName]
+	if !ok || queue < 0 || queue >= COSQ_NUM {
+		return 0, 0, fmt.Errorf("Invalid CosqStat name. %s", name)
+	}
+
+	return queue, stat, nil
+}
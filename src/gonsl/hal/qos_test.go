@@ -0,0 +1,46 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"testing"
+)
+
+func TestCosqStatName(t *testing.T) {
+	name := CosqStatName(3, COSQ_STAT_DROP_BYTES)
+	if name != "cosq3DropOctets" {
+		t.Errorf("CosqStatName unmatch. %s", name)
+	}
+
+	queue, stat, err := ParseCosqStatName(name)
+	if err != nil {
+		t.Fatalf("ParseCosqStatName error. %s", err)
+	}
+
+	if queue != 3 || stat != COSQ_STAT_DROP_BYTES {
+		t.Errorf("ParseCosqStatName unmatch. %d %s", queue, stat)
+	}
+}
+
+func TestParseCosqStatName_Invalid(t *testing.T) {
+	for _, name := range []string{"ifInOctets", "cosq8OutPkts", "cosq1Octets", "cosqOutPkts"} {
+		if _, _, err := ParseCosqStatName(name); err == nil {
+			t.Errorf("ParseCosqStatName must be error. %s", name)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// PacketReason is reason why packet is copied to cpu.
+//
+type PacketReason uint32
+
+const (
+	PACKET_REASON_NONE           PacketReason = 0
+	PACKET_REASON_SAMPLE_INGRESS PacketReason = 1 << 0
+	PACKET_REASON_SAMPLE_EGRESS  PacketReason = 1 << 1
+	PACKET_REASON_SAMPLE                      = PACKET_REASON_SAMPLE_INGRESS | PACKET_REASON_SAMPLE_EGRESS
+)
+
+var packetReasonNames = map[PacketReason]string{
+	PACKET_REASON_SAMPLE_INGRESS: "SAMPLE_INGRESS",
+	PACKET_REASON_SAMPLE_EGRESS:  "SAMPLE_EGRESS",
+}
+
+func (r PacketReason) String() string {
+	return flagsString(uint32(r), func(bit uint32) (string, bool) {
+		name, ok := packetReasonNames[PacketReason(bit)]
+		return name, ok
+	})
+}
+
+//
+// Sampled returns true if packet is sampled by sflow sampler.
+//
+func (r PacketReason) Sampled() bool {
+	return (r & PACKET_REASON_SAMPLE) != 0
+}
+
+//
+// PortSampleRate is rate of packet sampling of port.
+// 1 out of Ingress(Egress) packets is copied to cpu. 0 disables sampling.
+//
+type PortSampleRate struct {
+	Ingress uint32
+	Egress  uint32
+}
+
+//
+// Enabled returns true if sampling is enabled on ingress or egress.
+//
+func (r *PortSampleRate) Enabled() bool {
+	return r.Ingress != 0 || r.Egress != 0
+}
+
+func (r *PortSampleRate) String() string {
+	return fmt.Sprintf("ingress:%d egress:%d", r.Ingress, r.Egress)
+}
@@ -0,0 +1,210 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+type simFieldGroup struct {
+	pri  int
+	qset map[hal.FieldQualify]struct{}
+}
+
+type simFieldEntry struct {
+	group hal.FieldGroupID
+	entry *hal.FieldEntry
+}
+
+func copyFieldEntry(src *hal.FieldEntry) *hal.FieldEntry {
+	entry := *src
+	entry.DstMAC = append(net.HardwareAddr{}, src.DstMAC...)
+	entry.DstMACMask = append(net.HardwareAddr{}, src.DstMACMask...)
+	entry.SrcIP = copyIPNet(src.SrcIP)
+	entry.DstIP = copyIPNet(src.DstIP)
+	entry.Actions = make([]*hal.FieldAction, len(src.Actions))
+	for index, action := range src.Actions {
+		a := *action
+		entry.Actions[index] = &a
+	}
+	return &entry
+}
+
+//
+// FieldGroupCreate creates field group.
+//
+func (s *Sim) FieldGroupCreate(pri int, qset ...hal.FieldQualify) (hal.FieldGroupID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, group := range s.fgroups {
+		if group.pri == pri {
+			return 0, errExists("field group", fmt.Sprintf("priority %d", pri))
+		}
+	}
+
+	group := &simFieldGroup{
+		pri:  pri,
+		qset: map[hal.FieldQualify]struct{}{},
+	}
+	for _, q := range qset {
+		group.qset[q] = struct{}{}
+	}
+
+	groupID := s.nextFGroup
+	s.nextFGroup++
+	s.fgroups[groupID] = group
+
+	return groupID, nil
+}
+
+//
+// FieldEntryCreate creates and installs field entry.
+// It fails if entry uses qualifiers which are not in qset of group.
+//
+func (s *Sim) FieldEntryCreate(groupID hal.FieldGroupID, entry *hal.FieldEntry) (hal.FieldEntryID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	group, ok := s.fgroups[groupID]
+	if !ok {
+		return 0, errNotFound("field group", groupID)
+	}
+
+	for _, q := range entry.Qualifiers() {
+		if _, ok := group.qset[q]; !ok {
+			return 0, fmt.Errorf("qualifier %s not in group %d.", q, groupID)
+		}
+	}
+
+	if entry.Policer != 0 {
+		if _, ok := s.policers[entry.Policer]; !ok {
+			return 0, errNotFound("policer", entry.Policer)
+		}
+	}
+
+	entryID := s.nextFEntry
+	s.nextFEntry++
+	s.fentries[entryID] = &simFieldEntry{
+		group: groupID,
+		entry: copyFieldEntry(entry),
+	}
+
+	if entry.Policer != 0 {
+		s.fstats[entryID] = &hal.FieldEntryStat{}
+	}
+
+	return entryID, nil
+}
+
+//
+// FieldEntryDestroy uninstalls and destroys field entry.
+//
+func (s *Sim) FieldEntryDestroy(entryID hal.FieldEntryID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.fentries[entryID]; !ok {
+		return errNotFound("field entry", entryID)
+	}
+
+	delete(s.fentries, entryID)
+	delete(s.fstats, entryID)
+	return nil
+}
+
+//
+// FieldEntryGet returns field entry.
+//
+func (s *Sim) FieldEntryGet(entryID hal.FieldEntryID) (*hal.FieldEntry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok := s.fentries[entryID]
+	if !ok {
+		return nil, errNotFound("field entry", entryID)
+	}
+
+	return copyFieldEntry(e.entry), nil
+}
+
+//
+// FieldEntryTraverse enumerates field entries in group.
+//
+func (s *Sim) FieldEntryTraverse(groupID hal.FieldGroupID, f func(hal.FieldEntryID, *hal.FieldEntry) error) error {
+	s.mutex.Lock()
+	if _, ok := s.fgroups[groupID]; !ok {
+		s.mutex.Unlock()
+		return errNotFound("field group", groupID)
+	}
+
+	ids := []hal.FieldEntryID{}
+	entries := map[hal.FieldEntryID]*hal.FieldEntry{}
+	for entryID, e := range s.fentries {
+		if e.group == groupID {
+			ids = append(ids, entryID)
+			entries[entryID] = copyFieldEntry(e.entry)
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, entryID := range ids {
+		if err := f(entryID, entries[entryID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// FieldEntryStatGet returns counters of field entry with policer.
+//
+func (s *Sim) FieldEntryStatGet(entryID hal.FieldEntryID) (*hal.FieldEntryStat, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stat, ok := s.fstats[entryID]
+	if !ok {
+		return nil, errNotFound("field entry stat", entryID)
+	}
+
+	st := *stat
+	return &st, nil
+}
+
+//
+// SetFieldEntryStat sets counters of field entry with policer. (simulator only)
+//
+func (s *Sim) SetFieldEntryStat(entryID hal.FieldEntryID, stat *hal.FieldEntryStat) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.fstats[entryID]; !ok {
+		return errNotFound("field entry stat", entryID)
+	}
+
+	st := *stat
+	s.fstats[entryID] = &st
+	return nil
+}
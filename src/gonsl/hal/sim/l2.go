@@ -0,0 +1,349 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+func copyL2Addr(src *hal.L2Addr) *hal.L2Addr {
+	l2addr := *src
+	l2addr.MAC = append(net.HardwareAddr{}, src.MAC...)
+	return &l2addr
+}
+
+//
+// L2AddrAdd adds or replaces l2 address.
+//
+func (s *Sim) L2AddrAdd(l2addr *hal.L2Addr) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.l2addrs[l2addr.Key()] = copyL2Addr(l2addr)
+	return nil
+}
+
+//
+// L2AddrDelete deletes l2 address.
+//
+func (s *Sim) L2AddrDelete(mac net.HardwareAddr, vid hal.Vlan) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := hal.NewL2Addr(mac, vid).Key()
+	if _, ok := s.l2addrs[key]; !ok {
+		return errNotFound("l2addr", key)
+	}
+
+	delete(s.l2addrs, key)
+	return nil
+}
+
+//
+// L2AddrDeleteByVlan deletes all l2 addresses in vlan without callbacks.
+//
+func (s *Sim) L2AddrDeleteByVlan(vid hal.Vlan) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, l2addr := range s.l2addrs {
+		if l2addr.Vlan == vid {
+			delete(s.l2addrs, key)
+		}
+	}
+
+	return nil
+}
+
+//
+// L2AddrTraverse enumerates all l2 addresses.
+//
+func (s *Sim) L2AddrTraverse(f func(*hal.L2Addr) error) error {
+	s.mutex.Lock()
+	l2addrs := make([]*hal.L2Addr, 0, len(s.l2addrs))
+	for _, l2addr := range s.l2addrs {
+		l2addrs = append(l2addrs, copyL2Addr(l2addr))
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(l2addrs, func(i, j int) bool { return l2addrs[i].Key() < l2addrs[j].Key() })
+
+	for _, l2addr := range l2addrs {
+		if err := f(l2addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// L2AddrAgeTimerSet sets aging time.
+//
+func (s *Sim) L2AddrAgeTimerSet(sec int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.l2age = sec
+	return nil
+}
+
+//
+// L2AddrRegister registers callback of learning and aging.
+//
+func (s *Sim) L2AddrRegister(f hal.L2AddrCallback) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.l2addrCb = f
+	return nil
+}
+
+//
+// L2AddrUnregister unregisters callback.
+//
+func (s *Sim) L2AddrUnregister() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.l2addrCb = nil
+}
+
+func (s *Sim) notifyL2Addr(l2addr *hal.L2Addr, oper hal.L2CallbackOper) {
+	s.mutex.Lock()
+	cb := s.l2addrCb
+	s.mutex.Unlock()
+
+	if cb != nil {
+		cb(l2addr, oper)
+	}
+}
+
+//
+// L2AddrLearn learns l2 address and calls callback. (simulator only)
+//
+func (s *Sim) L2AddrLearn(l2addr *hal.L2Addr) {
+	s.mutex.Lock()
+	s.l2addrs[l2addr.Key()] = copyL2Addr(l2addr)
+	s.mutex.Unlock()
+
+	s.notifyL2Addr(copyL2Addr(l2addr), hal.L2_CALLBACK_ADD)
+}
+
+//
+// L2AddrAge removes l2 address and calls callback. (simulator only)
+//
+func (s *Sim) L2AddrAge(mac net.HardwareAddr, vid hal.Vlan) error {
+	key := hal.NewL2Addr(mac, vid).Key()
+
+	s.mutex.Lock()
+	l2addr, ok := s.l2addrs[key]
+	if !ok {
+		s.mutex.Unlock()
+		return errNotFound("l2addr", key)
+	}
+	delete(s.l2addrs, key)
+	s.mutex.Unlock()
+
+	s.notifyL2Addr(l2addr, hal.L2_CALLBACK_DELETE)
+	return nil
+}
+
+//
+// L2StationGet returns l2 station.
+// Simulated switch has no l2 station.
+//
+func (s *Sim) L2StationGet(id hal.L2StationID) (*hal.L2Station, error) {
+	return nil, errNotFound("l2station", id)
+}
+
+//
+// TrunkCreate creates trunk.
+//
+func (s *Sim) TrunkCreate() (hal.Trunk, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	trunk := s.nextTrunk
+	s.nextTrunk++
+	s.trunks[trunk] = &hal.TrunkInfo{
+		Psc:     hal.TRUNK_PSC_SRCDSTMAC,
+		Members: []*hal.TrunkMember{},
+	}
+
+	return trunk, nil
+}
+
+//
+// TrunkDestroy destroys trunk.
+//
+func (s *Sim) TrunkDestroy(trunk hal.Trunk) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.trunks[trunk]; !ok {
+		return errNotFound("trunk", trunk)
+	}
+
+	delete(s.trunks, trunk)
+	return nil
+}
+
+func simTrunkMemberIndex(info *hal.TrunkInfo, port hal.Port) int {
+	for index, m := range info.Members {
+		if m.Port == port {
+			return index
+		}
+	}
+	return -1
+}
+
+//
+// TrunkMemberAdd adds port to trunk.
+//
+func (s *Sim) TrunkMemberAdd(trunk hal.Trunk, port hal.Port) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, ok := s.trunks[trunk]
+	if !ok {
+		return errNotFound("trunk", trunk)
+	}
+
+	if _, err := s.portInfo(port); err != nil {
+		return err
+	}
+
+	if simTrunkMemberIndex(info, port) < 0 {
+		info.Members = append(info.Members, &hal.TrunkMember{Port: port})
+	}
+	return nil
+}
+
+//
+// TrunkMemberDelete removes port from trunk.
+//
+func (s *Sim) TrunkMemberDelete(trunk hal.Trunk, port hal.Port) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, ok := s.trunks[trunk]
+	if !ok {
+		return errNotFound("trunk", trunk)
+	}
+
+	index := simTrunkMemberIndex(info, port)
+	if index < 0 {
+		return errNotFound("trunk member", port)
+	}
+
+	info.Members = append(info.Members[:index], info.Members[index+1:]...)
+	return nil
+}
+
+func simTrunkInfoCopy(src *hal.TrunkInfo) *hal.TrunkInfo {
+	members := make([]*hal.TrunkMember, len(src.Members))
+	for index, m := range src.Members {
+		member := *m
+		members[index] = &member
+	}
+
+	return &hal.TrunkInfo{
+		Psc:     src.Psc,
+		Members: members,
+	}
+}
+
+//
+// TrunkSet replaces port selection criteria and members of trunk.
+//
+func (s *Sim) TrunkSet(trunk hal.Trunk, info *hal.TrunkInfo) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.trunks[trunk]; !ok {
+		return errNotFound("trunk", trunk)
+	}
+
+	for _, m := range info.Members {
+		if _, err := s.portInfo(m.Port); err != nil {
+			return err
+		}
+	}
+
+	s.trunks[trunk] = simTrunkInfoCopy(info)
+	return nil
+}
+
+//
+// TrunkGet returns port selection criteria and members of trunk.
+//
+func (s *Sim) TrunkGet(trunk hal.Trunk) (*hal.TrunkInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, ok := s.trunks[trunk]
+	if !ok {
+		return nil, errNotFound("trunk", trunk)
+	}
+
+	return simTrunkInfoCopy(info), nil
+}
+
+//
+// TrunkHashFieldsSet sets fields used by TRUNK_PSC_PORTFLOW.
+//
+func (s *Sim) TrunkHashFieldsSet(fields hal.TrunkHashFields) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.trunkHash = fields
+	return nil
+}
+
+//
+// TrunkHashFields returns fields set by TrunkHashFieldsSet. (simulator only)
+//
+func (s *Sim) TrunkHashFields() hal.TrunkHashFields {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.trunkHash
+}
+
+//
+// TrunkMembers returns members of trunk. (simulator only)
+//
+func (s *Sim) TrunkMembers(trunk hal.Trunk) (*hal.PBmp, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, ok := s.trunks[trunk]
+	if !ok {
+		return nil, errNotFound("trunk", trunk)
+	}
+
+	members := hal.NewPBmp()
+	for _, m := range info.Members {
+		members.Add(m.Port)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,594 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+const (
+	l3CreateFlags = hal.L3_WITH_ID | hal.L3_REPLACE
+)
+
+func isReplace(flags hal.L3Flags) bool {
+	return (flags & l3CreateFlags) == l3CreateFlags
+}
+
+func copyL3Iface(src *hal.L3Iface) *hal.L3Iface {
+	iface := *src
+	iface.MAC = append(net.HardwareAddr{}, src.MAC...)
+	return &iface
+}
+
+func copyL3Egress(src *hal.L3Egress) *hal.L3Egress {
+	egr := *src
+	egr.MAC = append(net.HardwareAddr{}, src.MAC...)
+	if src.MplsLabel != nil {
+		label := *src.MplsLabel
+		egr.MplsLabel = &label
+	}
+	return &egr
+}
+
+func copyL3EgressEcmp(src *hal.L3EgressEcmp) *hal.L3EgressEcmp {
+	ecmp := *src
+	ecmp.Members = append([]hal.L3EgressID{}, src.Members...)
+	return &ecmp
+}
+
+func copyL3Host(src *hal.L3Host) *hal.L3Host {
+	host := *src
+	host.IP = append(net.IP{}, src.IP...)
+	host.NexthopMAC = append(net.HardwareAddr{}, src.NexthopMAC...)
+	return &host
+}
+
+func copyIPNet(src *net.IPNet) *net.IPNet {
+	if src == nil {
+		return nil
+	}
+	return &net.IPNet{
+		IP:   append(net.IP{}, src.IP...),
+		Mask: append(net.IPMask{}, src.Mask...),
+	}
+}
+
+func copyL3Route(src *hal.L3Route) *hal.L3Route {
+	route := *src
+	route.Dst = copyIPNet(src.Dst)
+	return &route
+}
+
+//
+// L3IfaceCreate creates or replaces l3 interface.
+//
+func (s *Sim) L3IfaceCreate(iface *hal.L3Iface) (hal.L3IfaceID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ifaceID := iface.IfaceID
+
+	switch {
+	case isReplace(iface.Flags):
+		if _, ok := s.ifaces[ifaceID]; !ok {
+			return 0, errNotFound("l3iface", ifaceID)
+		}
+
+	case (iface.Flags & hal.L3_WITH_ID) != 0:
+		if _, ok := s.ifaces[ifaceID]; ok {
+			return 0, errExists("l3iface", ifaceID)
+		}
+
+	default:
+		for {
+			ifaceID = s.nextIface
+			s.nextIface++
+			if _, ok := s.ifaces[ifaceID]; !ok {
+				break
+			}
+		}
+	}
+
+	newIface := copyL3Iface(iface)
+	newIface.IfaceID = ifaceID
+	newIface.Flags &^= l3CreateFlags
+	s.ifaces[ifaceID] = newIface
+
+	return ifaceID, nil
+}
+
+//
+// L3IfaceDelete deletes l3 interface.
+//
+func (s *Sim) L3IfaceDelete(ifaceID hal.L3IfaceID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ifaces[ifaceID]; !ok {
+		return errNotFound("l3iface", ifaceID)
+	}
+
+	delete(s.ifaces, ifaceID)
+	delete(s.tunInits, ifaceID)
+	delete(s.mplsInit, ifaceID)
+	return nil
+}
+
+//
+// L3IfaceGet returns l3 interface.
+//
+func (s *Sim) L3IfaceGet(ifaceID hal.L3IfaceID) (*hal.L3Iface, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	iface, ok := s.ifaces[ifaceID]
+	if !ok {
+		return nil, errNotFound("l3iface", ifaceID)
+	}
+
+	return copyL3Iface(iface), nil
+}
+
+//
+// L3IfaceFind returns l3 interface which has mac and vid.
+//
+func (s *Sim) L3IfaceFind(mac net.HardwareAddr, vid hal.Vlan) (*hal.L3Iface, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var found *hal.L3Iface
+	for _, iface := range s.ifaces {
+		if iface.Vlan != vid || iface.MAC.String() != mac.String() {
+			continue
+		}
+
+		if found == nil || iface.IfaceID < found.IfaceID {
+			found = iface
+		}
+	}
+
+	if found == nil {
+		return nil, errNotFound("l3iface", fmt.Sprintf("%s vid:%d", mac, vid))
+	}
+
+	return copyL3Iface(found), nil
+}
+
+//
+// L3Ifaces returns all l3 interfaces. (simulator only)
+//
+func (s *Sim) L3Ifaces() []*hal.L3Iface {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ifaces := make([]*hal.L3Iface, 0, len(s.ifaces))
+	for _, iface := range s.ifaces {
+		ifaces = append(ifaces, copyL3Iface(iface))
+	}
+
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].IfaceID < ifaces[j].IfaceID })
+	return ifaces
+}
+
+func (s *Sim) checkL3Egress(egr *hal.L3Egress) error {
+	if _, ok := s.ifaces[egr.IfaceID]; !ok {
+		return errNotFound("l3iface", egr.IfaceID)
+	}
+
+	if egr.IsTrunk() {
+		if _, ok := s.trunks[egr.Trunk]; !ok {
+			return errNotFound("trunk", egr.Trunk)
+		}
+	}
+
+	return nil
+}
+
+//
+// L3EgressCreate creates or replaces l3 egress.
+//
+func (s *Sim) L3EgressCreate(flags hal.L3Flags, egr *hal.L3Egress, egrID hal.L3EgressID) (hal.L3EgressID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.checkL3Egress(egr); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case isReplace(flags):
+		if _, ok := s.egrs[egrID]; !ok {
+			return 0, errNotFound("l3egress", egrID)
+		}
+
+	case (flags & hal.L3_WITH_ID) != 0:
+		if _, ok := s.egrs[egrID]; ok {
+			return 0, errExists("l3egress", egrID)
+		}
+
+	default:
+		for {
+			egrID = s.nextEgr
+			s.nextEgr++
+			if _, ok := s.egrs[egrID]; !ok {
+				break
+			}
+		}
+	}
+
+	newEgr := copyL3Egress(egr)
+	newEgr.Flags = (newEgr.Flags | flags) &^ l3CreateFlags
+	s.egrs[egrID] = newEgr
+
+	return egrID, nil
+}
+
+func (s *Sim) l3EgressRefs(egrID hal.L3EgressID) int {
+	refs := 0
+	for _, ecmp := range s.ecmps {
+		for _, member := range ecmp.Members {
+			if member == egrID {
+				refs++
+			}
+		}
+	}
+
+	for _, host := range s.hosts {
+		if host.EgressID == egrID {
+			refs++
+		}
+	}
+
+	for _, route := range s.routes {
+		if route.EgressID == egrID {
+			refs++
+		}
+	}
+
+	for _, tsw := range s.mplsSws {
+		if tsw.EgressIf == egrID {
+			refs++
+		}
+	}
+
+	for _, encap := range s.srv6Encaps {
+		if encap.Egress == egrID {
+			refs++
+		}
+	}
+
+	for _, sid := range s.localSids {
+		if srv6LocalSidHasEgress(sid.Action) && sid.Egress == egrID {
+			refs++
+		}
+	}
+
+	return refs
+}
+
+//
+// L3EgressDestroy destroys l3 egress.
+// It fails if egress is used by ecmp, host, route or mpls entries.
+//
+func (s *Sim) L3EgressDestroy(egrID hal.L3EgressID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.egrs[egrID]; !ok {
+		return errNotFound("l3egress", egrID)
+	}
+
+	if refs := s.l3EgressRefs(egrID); refs > 0 {
+		return errBusy("l3egress", fmt.Sprintf("%d refs:%d", egrID, refs))
+	}
+
+	delete(s.egrs, egrID)
+	return nil
+}
+
+//
+// L3EgressGet returns l3 egress.
+//
+func (s *Sim) L3EgressGet(egrID hal.L3EgressID) (*hal.L3Egress, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	egr, ok := s.egrs[egrID]
+	if !ok {
+		return nil, errNotFound("l3egress", egrID)
+	}
+
+	return copyL3Egress(egr), nil
+}
+
+//
+// L3EgressTraverse enumerates all l3 egresses.
+//
+func (s *Sim) L3EgressTraverse(f func(hal.L3EgressID, *hal.L3Egress) error) error {
+	s.mutex.Lock()
+	ids := make([]hal.L3EgressID, 0, len(s.egrs))
+	egrs := map[hal.L3EgressID]*hal.L3Egress{}
+	for egrID, egr := range s.egrs {
+		ids = append(ids, egrID)
+		egrs[egrID] = copyL3Egress(egr)
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, egrID := range ids {
+		if err := f(egrID, egrs[egrID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// L3EgressEcmpCreate creates or replaces l3 egress ecmp.
+//
+func (s *Sim) L3EgressEcmpCreate(ecmp *hal.L3EgressEcmp) (hal.L3EgressID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if ecmp.MaxPaths > 0 && len(ecmp.Members) > ecmp.MaxPaths {
+		return 0, fmt.Errorf("too many ecmp members. %d > %d", len(ecmp.Members), ecmp.MaxPaths)
+	}
+
+	for _, member := range ecmp.Members {
+		if _, ok := s.egrs[member]; !ok {
+			return 0, errNotFound("l3egress", member)
+		}
+	}
+
+	ecmpID := ecmp.EgressID
+
+	switch {
+	case isReplace(ecmp.Flags):
+		if _, ok := s.ecmps[ecmpID]; !ok {
+			return 0, errNotFound("l3ecmp", ecmpID)
+		}
+
+	case (ecmp.Flags & hal.L3_WITH_ID) != 0:
+		if _, ok := s.ecmps[ecmpID]; ok {
+			return 0, errExists("l3ecmp", ecmpID)
+		}
+
+	default:
+		for {
+			ecmpID = s.nextEcmp
+			s.nextEcmp++
+			if _, ok := s.ecmps[ecmpID]; !ok {
+				break
+			}
+		}
+	}
+
+	newEcmp := copyL3EgressEcmp(ecmp)
+	newEcmp.EgressID = ecmpID
+	newEcmp.Flags &^= l3CreateFlags
+	s.ecmps[ecmpID] = newEcmp
+
+	return ecmpID, nil
+}
+
+//
+// L3EgressEcmpDestroy destroys l3 egress ecmp.
+// It fails if ecmp is used by routes.
+//
+func (s *Sim) L3EgressEcmpDestroy(ecmpID hal.L3EgressID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ecmps[ecmpID]; !ok {
+		return errNotFound("l3ecmp", ecmpID)
+	}
+
+	for _, route := range s.routes {
+		if route.EgressID == ecmpID {
+			return errBusy("l3ecmp", ecmpID)
+		}
+	}
+
+	delete(s.ecmps, ecmpID)
+	return nil
+}
+
+//
+// L3EgressEcmpGet returns l3 egress ecmp.
+//
+func (s *Sim) L3EgressEcmpGet(ecmpID hal.L3EgressID) (*hal.L3EgressEcmp, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ecmp, ok := s.ecmps[ecmpID]
+	if !ok {
+		return nil, errNotFound("l3ecmp", ecmpID)
+	}
+
+	return copyL3EgressEcmp(ecmp), nil
+}
+
+func (s *Sim) checkEgressID(flags hal.L3Flags, egrID hal.L3EgressID) error {
+	if (flags & hal.L3_MULTIPATH) != 0 {
+		if _, ok := s.ecmps[egrID]; !ok {
+			return errNotFound("l3ecmp", egrID)
+		}
+		return nil
+	}
+
+	if _, ok := s.egrs[egrID]; ok {
+		return nil
+	}
+	if _, ok := s.srv6Encaps[egrID]; ok {
+		return nil
+	}
+	return errNotFound("l3egress", egrID)
+}
+
+func checkAddrFamily(flags hal.L3Flags, ip net.IP) error {
+	isV6 := ip.To4() == nil
+	if isV6 != ((flags & hal.L3_IP6) != 0) {
+		return fmt.Errorf("address family mismatch. %s flags:%s", ip, flags)
+	}
+	return nil
+}
+
+//
+// L3HostAdd adds l3 host.
+// It replaces existing entry if L3_REPLACE is set.
+//
+func (s *Sim) L3HostAdd(host *hal.L3Host) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := checkAddrFamily(host.Flags, host.IP); err != nil {
+		return err
+	}
+
+	key := host.Key()
+	if _, ok := s.hosts[key]; ok && (host.Flags&hal.L3_REPLACE) == 0 {
+		return errExists("l3host", key)
+	}
+
+	if err := s.checkEgressID(host.Flags, host.EgressID); err != nil {
+		return err
+	}
+
+	newHost := copyL3Host(host)
+	newHost.Flags &^= l3CreateFlags
+	s.hosts[key] = newHost
+
+	return nil
+}
+
+//
+// L3HostDelete deletes l3 host.
+//
+func (s *Sim) L3HostDelete(host *hal.L3Host) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := host.Key()
+	if _, ok := s.hosts[key]; !ok {
+		return errNotFound("l3host", key)
+	}
+
+	delete(s.hosts, key)
+	return nil
+}
+
+//
+// L3HostTraverse enumerates IPv4 (or IPv6 if L3_IP6 is set) hosts.
+//
+func (s *Sim) L3HostTraverse(flags hal.L3Flags, f func(*hal.L3Host) error) error {
+	s.mutex.Lock()
+	hosts := []*hal.L3Host{}
+	for _, host := range s.hosts {
+		if (host.Flags & hal.L3_IP6) == (flags & hal.L3_IP6) {
+			hosts = append(hosts, copyL3Host(host))
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Key() < hosts[j].Key() })
+
+	for _, host := range hosts {
+		if err := f(host); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// L3RouteAdd adds l3 route.
+// It replaces existing entry if L3_REPLACE is set.
+//
+func (s *Sim) L3RouteAdd(route *hal.L3Route) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if route.Dst == nil {
+		return fmt.Errorf("l3route has no destination.")
+	}
+
+	if err := checkAddrFamily(route.Flags, route.Dst.IP); err != nil {
+		return err
+	}
+
+	key := route.Key()
+	if _, ok := s.routes[key]; ok && (route.Flags&hal.L3_REPLACE) == 0 {
+		return errExists("l3route", key)
+	}
+
+	if err := s.checkEgressID(route.Flags, route.EgressID); err != nil {
+		return err
+	}
+
+	newRoute := copyL3Route(route)
+	newRoute.Flags &^= l3CreateFlags
+	s.routes[key] = newRoute
+
+	return nil
+}
+
+//
+// L3RouteDelete deletes l3 route.
+//
+func (s *Sim) L3RouteDelete(route *hal.L3Route) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := route.Key()
+	if _, ok := s.routes[key]; !ok {
+		return errNotFound("l3route", key)
+	}
+
+	delete(s.routes, key)
+	return nil
+}
+
+//
+// L3RouteTraverse enumerates IPv4 (or IPv6 if L3_IP6 is set) routes.
+//
+func (s *Sim) L3RouteTraverse(flags hal.L3Flags, f func(*hal.L3Route) error) error {
+	s.mutex.Lock()
+	routes := []*hal.L3Route{}
+	for _, route := range s.routes {
+		if (route.Flags & hal.L3_IP6) == (flags & hal.L3_IP6) {
+			routes = append(routes, copyL3Route(route))
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Key() < routes[j].Key() })
+
+	for _, route := range routes {
+		if err := f(route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
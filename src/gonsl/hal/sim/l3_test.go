@@ -0,0 +1,149 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"testing"
+)
+
+func newTestL3Egress(t *testing.T, s *Sim, port hal.Port) (hal.L3IfaceID, hal.L3EgressID) {
+	mac, _ := net.ParseMAC("11:22:33:44:55:66")
+	ifaceID, err := s.L3IfaceCreate(&hal.L3Iface{MAC: mac, Vlan: 10})
+	if err != nil {
+		t.Fatalf("L3IfaceCreate error. %s", err)
+	}
+
+	nhMAC, _ := net.ParseMAC("aa:bb:cc:dd:ee:ff")
+	egrID, err := s.L3EgressCreate(hal.L3_NONE, &hal.L3Egress{IfaceID: ifaceID, MAC: nhMAC, Vlan: 10, Port: port}, 0)
+	if err != nil {
+		t.Fatalf("L3EgressCreate error. %s", err)
+	}
+
+	return ifaceID, egrID
+}
+
+func TestL3Egress_ifaceNotFound(t *testing.T) {
+	s := NewSim(1, 2)
+
+	if _, err := s.L3EgressCreate(hal.L3_NONE, &hal.L3Egress{IfaceID: 100, Port: 1}, 0); err == nil {
+		t.Errorf("L3EgressCreate must be error.")
+	}
+}
+
+func TestL3Egress_replace(t *testing.T) {
+	s := NewSim(1, 2)
+	ifaceID, egrID := newTestL3Egress(t, s, 1)
+
+	egr := &hal.L3Egress{IfaceID: ifaceID, Vlan: 10, Port: 2}
+	newID, err := s.L3EgressCreate(hal.L3_WITH_ID|hal.L3_REPLACE, egr, egrID)
+	if err != nil {
+		t.Fatalf("L3EgressCreate(replace) error. %s", err)
+	}
+
+	if newID != egrID {
+		t.Errorf("L3EgressCreate(replace) id unmatch. %d %d", newID, egrID)
+	}
+
+	e, err := s.L3EgressGet(egrID)
+	if err != nil {
+		t.Fatalf("L3EgressGet error. %s", err)
+	}
+
+	if e.Port != 2 {
+		t.Errorf("L3EgressGet port unmatch. %d", e.Port)
+	}
+
+	if (e.Flags & (hal.L3_WITH_ID | hal.L3_REPLACE)) != 0 {
+		t.Errorf("L3EgressGet flags unmatch. %s", e.Flags)
+	}
+}
+
+func TestL3HostRoute(t *testing.T) {
+	s := NewSim(1, 2)
+	_, egrID := newTestL3Egress(t, s, 1)
+
+	host := &hal.L3Host{IP: net.ParseIP("10.0.0.1"), EgressID: egrID}
+	if err := s.L3HostAdd(host); err != nil {
+		t.Fatalf("L3HostAdd error. %s", err)
+	}
+
+	if err := s.L3HostAdd(host); err == nil {
+		t.Errorf("L3HostAdd(dup) must be error.")
+	}
+
+	_, dst, _ := net.ParseCIDR("2001:db8::/32")
+	if err := s.L3RouteAdd(&hal.L3Route{Dst: dst, EgressID: egrID}); err == nil {
+		t.Errorf("L3RouteAdd(v6 without L3_IP6) must be error.")
+	}
+
+	route := &hal.L3Route{Flags: hal.L3_IP6, Dst: dst, EgressID: egrID}
+	if err := s.L3RouteAdd(route); err != nil {
+		t.Fatalf("L3RouteAdd error. %s", err)
+	}
+
+	routes := 0
+	s.L3RouteTraverse(hal.L3_NONE, func(*hal.L3Route) error { routes++; return nil })
+	if routes != 0 {
+		t.Errorf("L3RouteTraverse(v4) unmatch. %d", routes)
+	}
+
+	s.L3RouteTraverse(hal.L3_IP6, func(*hal.L3Route) error { routes++; return nil })
+	if routes != 1 {
+		t.Errorf("L3RouteTraverse(v6) unmatch. %d", routes)
+	}
+
+	if err := s.L3EgressDestroy(egrID); err == nil {
+		t.Errorf("L3EgressDestroy(in use) must be error.")
+	}
+
+	s.L3HostDelete(host)
+	s.L3RouteDelete(route)
+
+	if err := s.L3EgressDestroy(egrID); err != nil {
+		t.Errorf("L3EgressDestroy error. %s", err)
+	}
+}
+
+func TestL3EgressEcmp(t *testing.T) {
+	s := NewSim(1, 2)
+	_, egrID := newTestL3Egress(t, s, 1)
+
+	if _, err := s.L3EgressEcmpCreate(&hal.L3EgressEcmp{Members: []hal.L3EgressID{egrID, 999}}); err == nil {
+		t.Errorf("L3EgressEcmpCreate(unknown member) must be error.")
+	}
+
+	ecmpID, err := s.L3EgressEcmpCreate(&hal.L3EgressEcmp{Members: []hal.L3EgressID{egrID}})
+	if err != nil {
+		t.Fatalf("L3EgressEcmpCreate error. %s", err)
+	}
+
+	_, dst, _ := net.ParseCIDR("10.1.0.0/16")
+	if err := s.L3RouteAdd(&hal.L3Route{Dst: dst, EgressID: egrID, Flags: hal.L3_MULTIPATH}); err == nil {
+		t.Errorf("L3RouteAdd(multipath to egress) must be error.")
+	}
+
+	if err := s.L3RouteAdd(&hal.L3Route{Dst: dst, EgressID: ecmpID, Flags: hal.L3_MULTIPATH}); err != nil {
+		t.Errorf("L3RouteAdd(multipath) error. %s", err)
+	}
+
+	if err := s.L3EgressDestroy(egrID); err == nil {
+		t.Errorf("L3EgressDestroy(ecmp member) must be error.")
+	}
+}
@@ -0,0 +1,169 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+)
+
+func copyMirrorDestination(src *hal.MirrorDestination) *hal.MirrorDestination {
+	d := *src
+	return &d
+}
+
+//
+// MirrorDestCreate creates mirror destination.
+//
+func (s *Sim) MirrorDestCreate(dest *hal.MirrorDestination) (hal.MirrorDestID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[dest.Port]; !ok {
+		return 0, errNotFound("port", dest.Port)
+	}
+
+	if dest.IsTunnel() {
+		if dest.SrcIP.To4() == nil || dest.DstIP.To4() == nil {
+			return 0, fmt.Errorf("Invalid mirror tunnel ip. %s", dest)
+		}
+		if len(dest.SrcMAC) == 0 || len(dest.DstMAC) == 0 {
+			return 0, fmt.Errorf("Invalid mirror tunnel mac. %s", dest)
+		}
+	}
+
+	destID := s.nextMirror
+	s.nextMirror++
+
+	s.mirrorDests[destID] = copyMirrorDestination(dest)
+	return destID, nil
+}
+
+//
+// MirrorDestDestroy destroys mirror destination.
+// It fails if ports are mirrored to destination.
+//
+func (s *Sim) MirrorDestDestroy(destID hal.MirrorDestID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.mirrorDests[destID]; !ok {
+		return errNotFound("mirror dest", destID)
+	}
+
+	for _, dests := range s.mirrorPorts {
+		if _, ok := dests[destID]; ok {
+			return errBusy("mirror dest", destID)
+		}
+	}
+
+	delete(s.mirrorDests, destID)
+	return nil
+}
+
+//
+// MirrorDestGet returns mirror destination. (simulator only)
+//
+func (s *Sim) MirrorDestGet(destID hal.MirrorDestID) (*hal.MirrorDestination, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dest, ok := s.mirrorDests[destID]
+	if !ok {
+		return nil, errNotFound("mirror dest", destID)
+	}
+
+	return copyMirrorDestination(dest), nil
+}
+
+//
+// MirrorPortDestAdd mirrors traffic of port to destination.
+//
+func (s *Sim) MirrorPortDestAdd(port hal.Port, flags hal.MirrorPortFlags, destID hal.MirrorDestID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	dest, ok := s.mirrorDests[destID]
+	if !ok {
+		return errNotFound("mirror dest", destID)
+	}
+
+	if dest.Port == port {
+		return fmt.Errorf("Mirror port is same as destination. %d", port)
+	}
+
+	if (flags & hal.MIRROR_PORT_BOTH) == 0 {
+		return fmt.Errorf("Invalid mirror flags. %s", flags)
+	}
+
+	dests, ok := s.mirrorPorts[port]
+	if !ok {
+		dests = map[hal.MirrorDestID]hal.MirrorPortFlags{}
+		s.mirrorPorts[port] = dests
+	}
+
+	dests[destID] |= flags
+	return nil
+}
+
+//
+// MirrorPortDestDelete stops mirroring traffic of port to destination.
+//
+func (s *Sim) MirrorPortDestDelete(port hal.Port, flags hal.MirrorPortFlags, destID hal.MirrorDestID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dests, ok := s.mirrorPorts[port]
+	if !ok {
+		return errNotFound("mirror port", port)
+	}
+
+	if _, ok := dests[destID]; !ok {
+		return errNotFound("mirror port", fmt.Sprintf("%d_%d", port, destID))
+	}
+
+	dests[destID] &^= flags
+	if dests[destID] == hal.MIRROR_PORT_NONE {
+		delete(dests, destID)
+	}
+
+	if len(dests) == 0 {
+		delete(s.mirrorPorts, port)
+	}
+
+	return nil
+}
+
+//
+// MirrorPortDestGet returns destinations and directions of port. (simulator only)
+//
+func (s *Sim) MirrorPortDestGet(port hal.Port) map[hal.MirrorDestID]hal.MirrorPortFlags {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dests := map[hal.MirrorDestID]hal.MirrorPortFlags{}
+	for destID, flags := range s.mirrorPorts[port] {
+		dests[destID] = flags
+	}
+
+	return dests
+}
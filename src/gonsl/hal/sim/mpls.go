@@ -0,0 +1,162 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"sort"
+)
+
+func copyMplsEgressLabels(src []*hal.MplsEgressLabel) []*hal.MplsEgressLabel {
+	labels := make([]*hal.MplsEgressLabel, len(src))
+	for index, label := range src {
+		l := *label
+		labels[index] = &l
+	}
+	return labels
+}
+
+func copyMplsTunnelSwitch(src *hal.MplsTunnelSwitch) *hal.MplsTunnelSwitch {
+	tsw := *src
+	if src.EgressLabel != nil {
+		label := *src.EgressLabel
+		tsw.EgressLabel = &label
+	}
+	return &tsw
+}
+
+//
+// MplsTunnelInitiatorSet sets labels pushed at l3 interface.
+//
+func (s *Sim) MplsTunnelInitiatorSet(ifaceID hal.L3IfaceID, labels []*hal.MplsEgressLabel) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ifaces[ifaceID]; !ok {
+		return errNotFound("l3iface", ifaceID)
+	}
+
+	s.mplsInit[ifaceID] = copyMplsEgressLabels(labels)
+	return nil
+}
+
+//
+// MplsTunnelInitiatorClear clears labels of l3 interface.
+//
+func (s *Sim) MplsTunnelInitiatorClear(ifaceID hal.L3IfaceID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.mplsInit[ifaceID]; !ok {
+		return errNotFound("mpls tunnel initiator", ifaceID)
+	}
+
+	delete(s.mplsInit, ifaceID)
+	return nil
+}
+
+//
+// MplsTunnelInitiatorGet returns labels of l3 interface.
+//
+func (s *Sim) MplsTunnelInitiatorGet(ifaceID hal.L3IfaceID, max int) ([]*hal.MplsEgressLabel, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	labels, ok := s.mplsInit[ifaceID]
+	if !ok {
+		return nil, errNotFound("mpls tunnel initiator", ifaceID)
+	}
+
+	if len(labels) > max {
+		labels = labels[:max]
+	}
+
+	return copyMplsEgressLabels(labels), nil
+}
+
+//
+// MplsTunnelSwitchAdd adds mpls tunnel switch entry.
+// It replaces existing entry if MPLS_SWITCH_REPLACE is set.
+//
+func (s *Sim) MplsTunnelSwitchAdd(tsw *hal.MplsTunnelSwitch) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := tsw.Key()
+	if _, ok := s.mplsSws[key]; ok && (tsw.Flags&hal.MPLS_SWITCH_REPLACE) == 0 {
+		return errExists("mpls tunnel switch", key)
+	}
+
+	switch tsw.Action {
+	case hal.MPLS_SWITCH_ACTION_SWAP, hal.MPLS_SWITCH_ACTION_PHP:
+		if _, ok := s.egrs[tsw.EgressIf]; !ok {
+			return errNotFound("l3egress", tsw.EgressIf)
+		}
+
+	case hal.MPLS_SWITCH_ACTION_POP:
+		// pass
+
+	default:
+		return fmt.Errorf("invalid mpls switch action. %s", tsw.Action)
+	}
+
+	newTsw := copyMplsTunnelSwitch(tsw)
+	newTsw.Flags &^= hal.MPLS_SWITCH_REPLACE
+	s.mplsSws[key] = newTsw
+
+	return nil
+}
+
+//
+// MplsTunnelSwitchDelete deletes mpls tunnel switch entry.
+//
+func (s *Sim) MplsTunnelSwitchDelete(tsw *hal.MplsTunnelSwitch) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := tsw.Key()
+	if _, ok := s.mplsSws[key]; !ok {
+		return errNotFound("mpls tunnel switch", key)
+	}
+
+	delete(s.mplsSws, key)
+	return nil
+}
+
+//
+// MplsTunnelSwitchTraverse enumerates all mpls tunnel switch entries.
+//
+func (s *Sim) MplsTunnelSwitchTraverse(f func(*hal.MplsTunnelSwitch) error) error {
+	s.mutex.Lock()
+	tsws := make([]*hal.MplsTunnelSwitch, 0, len(s.mplsSws))
+	for _, tsw := range s.mplsSws {
+		tsws = append(tsws, copyMplsTunnelSwitch(tsw))
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(tsws, func(i, j int) bool { return tsws[i].Label < tsws[j].Label })
+
+	for _, tsw := range tsws {
+		if err := f(tsw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
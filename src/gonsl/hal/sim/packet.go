@@ -0,0 +1,116 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"sort"
+)
+
+type simRxCallback struct {
+	pri int
+	cos uint32
+	f   hal.RxCallback
+}
+
+func copyPacket(src *hal.Packet) *hal.Packet {
+	pkt := *src
+	pkt.Data = append([]byte{}, src.Data...)
+	return &pkt
+}
+
+//
+// RxRegister registers callback of packets copied to cpu with cos.
+//
+func (s *Sim) RxRegister(pri int, cos uint32, f hal.RxCallback) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.rxCbs[pri]; ok {
+		return errExists("rx callback", pri)
+	}
+
+	s.rxCbs[pri] = &simRxCallback{
+		pri: pri,
+		cos: cos,
+		f:   f,
+	}
+
+	return nil
+}
+
+//
+// RxUnregister unregisters callback.
+//
+func (s *Sim) RxUnregister(pri int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.rxCbs, pri)
+}
+
+//
+// Tx sends packet to DstPort.
+// Simulated switch records sent packets.
+//
+func (s *Sim) Tx(pkt *hal.Packet) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.portInfo(pkt.DstPort); err != nil {
+		return err
+	}
+
+	s.txPkts = append(s.txPkts, copyPacket(pkt))
+	return nil
+}
+
+//
+// TxPackets returns sent packets and clears them. (simulator only)
+//
+func (s *Sim) TxPackets() []*hal.Packet {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pkts := s.txPkts
+	s.txPkts = []*hal.Packet{}
+	return pkts
+}
+
+//
+// InjectRx delivers packet to callbacks registered with pkt.Cos
+// in descending order of priority. (simulator only)
+//
+func (s *Sim) InjectRx(pkt *hal.Packet) int {
+	s.mutex.Lock()
+	cbs := []*simRxCallback{}
+	for _, cb := range s.rxCbs {
+		if cb.cos == uint32(pkt.Cos) {
+			cbs = append(cbs, cb)
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(cbs, func(i, j int) bool { return cbs[i].pri > cbs[j].pri })
+
+	for _, cb := range cbs {
+		cb.f(copyPacket(pkt))
+	}
+
+	return len(cbs)
+}
@@ -0,0 +1,109 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+)
+
+func checkPolicerConfig(cfg *hal.PolicerConfig) error {
+	if cfg.Rate == 0 || cfg.Burst == 0 {
+		return fmt.Errorf("Invalid policer. %s", cfg)
+	}
+	return nil
+}
+
+//
+// PolicerCreate creates policer.
+//
+func (s *Sim) PolicerCreate(cfg *hal.PolicerConfig) (hal.PolicerID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := checkPolicerConfig(cfg); err != nil {
+		return 0, err
+	}
+
+	policerID := s.nextPolicer
+	s.nextPolicer++
+
+	c := *cfg
+	s.policers[policerID] = &c
+
+	return policerID, nil
+}
+
+//
+// PolicerSet changes rate and burst of policer.
+//
+func (s *Sim) PolicerSet(policerID hal.PolicerID, cfg *hal.PolicerConfig) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.policers[policerID]; !ok {
+		return errNotFound("policer", policerID)
+	}
+
+	if err := checkPolicerConfig(cfg); err != nil {
+		return err
+	}
+
+	c := *cfg
+	s.policers[policerID] = &c
+
+	return nil
+}
+
+//
+// PolicerDestroy destroys policer.
+// It fails if policer is used by field entries.
+//
+func (s *Sim) PolicerDestroy(policerID hal.PolicerID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.policers[policerID]; !ok {
+		return errNotFound("policer", policerID)
+	}
+
+	for _, e := range s.fentries {
+		if e.entry.Policer == policerID {
+			return errBusy("policer", policerID)
+		}
+	}
+
+	delete(s.policers, policerID)
+	return nil
+}
+
+//
+// PolicerGet returns policer config. (simulator only)
+//
+func (s *Sim) PolicerGet(policerID hal.PolicerID) (*hal.PolicerConfig, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cfg, ok := s.policers[policerID]
+	if !ok {
+		return nil, errNotFound("policer", policerID)
+	}
+
+	c := *cfg
+	return &c, nil
+}
@@ -0,0 +1,226 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+)
+
+func newSimPortInfo(port hal.Port) *hal.PortInfo {
+	return &hal.PortInfo{
+		LinkUp:       true,
+		Enable:       true,
+		Speed:        simPortSpeed,
+		SpeedMax:     simPortSpeed,
+		HwAddr:       net.HardwareAddr{0x02, 0, 0, 0, byte(port >> 8), byte(port)},
+		UntaggedVlan: hal.VLAN_ID_DEFAULT,
+	}
+}
+
+func (s *Sim) portInfo(port hal.Port) (*hal.PortInfo, error) {
+	if info, ok := s.ports[port]; ok {
+		return info, nil
+	}
+	return nil, errNotFound("port", port)
+}
+
+//
+// PortBmp returns front panel ports.
+//
+func (s *Sim) PortBmp() (*hal.PBmp, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pbmp := hal.NewPBmp()
+	for port := range s.ports {
+		pbmp.Add(port)
+	}
+	return pbmp, nil
+}
+
+//
+// PortInfoGet returns port status.
+//
+func (s *Sim) PortInfoGet(port hal.Port) (*hal.PortInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, err := s.portInfo(port)
+	if err != nil {
+		return nil, err
+	}
+
+	pinfo := *info
+	return &pinfo, nil
+}
+
+//
+// PortEnableSet enables or disables port.
+//
+func (s *Sim) PortEnableSet(port hal.Port, enable bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, err := s.portInfo(port)
+	if err != nil {
+		return err
+	}
+
+	info.Enable = enable
+	return nil
+}
+
+//
+// PortUntaggedVlanSet sets default vlan of port.
+//
+func (s *Sim) PortUntaggedVlanSet(port hal.Port, vid hal.Vlan) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	info, err := s.portInfo(port)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.vlans[vid]; !ok {
+		return errNotFound("vlan", vid)
+	}
+
+	info.UntaggedVlan = vid
+	return nil
+}
+
+//
+// PortVlanTranslationSet enables or disables vlan translation of port.
+// Simulated switch does not translate vlan.
+//
+func (s *Sim) PortVlanTranslationSet(port hal.Port, enable bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, err := s.portInfo(port)
+	return err
+}
+
+//
+// PortStatGet returns counters of port.
+//
+func (s *Sim) PortStatGet(port hal.Port, names []string) (map[string]uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats, ok := s.stats[port]
+	if !ok {
+		return nil, errNotFound("port", port)
+	}
+
+	values := map[string]uint64{}
+	for _, name := range names {
+		values[name] = stats[name]
+	}
+
+	return values, nil
+}
+
+//
+// PortStatClear clears counters of port.
+//
+func (s *Sim) PortStatClear(port hal.Port) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.stats[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	s.stats[port] = map[string]uint64{}
+	delete(s.cosqStats, port)
+	return nil
+}
+
+//
+// SetPortStat sets counter of port. (simulator only)
+//
+func (s *Sim) SetPortStat(port hal.Port, name string, value uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats, ok := s.stats[port]
+	if !ok {
+		return errNotFound("port", port)
+	}
+
+	stats[name] = value
+	return nil
+}
+
+//
+// LinkscanRegister registers callback of port status.
+//
+func (s *Sim) LinkscanRegister(name string, f hal.LinkscanCallback) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.linkCbs[name]; ok {
+		return errExists("linkscan", name)
+	}
+
+	s.linkCbs[name] = f
+	return nil
+}
+
+//
+// LinkscanUnregister unregisters callback of port status.
+//
+func (s *Sim) LinkscanUnregister(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.linkCbs, name)
+}
+
+//
+// SetPortLinkStatus changes link status of port
+// and calls linkscan callbacks. (simulator only)
+//
+func (s *Sim) SetPortLinkStatus(port hal.Port, up bool) error {
+	s.mutex.Lock()
+
+	info, err := s.portInfo(port)
+	if err != nil {
+		s.mutex.Unlock()
+		return err
+	}
+
+	info.LinkUp = up
+	pinfo := *info
+
+	cbs := make([]hal.LinkscanCallback, 0, len(s.linkCbs))
+	for _, cb := range s.linkCbs {
+		cbs = append(cbs, cb)
+	}
+
+	s.mutex.Unlock()
+
+	for _, cb := range cbs {
+		cb(port, &pinfo)
+	}
+
+	return nil
+}
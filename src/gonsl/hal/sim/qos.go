@@ -0,0 +1,192 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+)
+
+func checkCosQueue(queue hal.CosQueue) error {
+	if queue < 0 || queue >= hal.COSQ_NUM {
+		return fmt.Errorf("Invalid cos queue. %d", queue)
+	}
+	return nil
+}
+
+func copyQosMap(src *hal.QosMap) *hal.QosMap {
+	m := hal.NewQosMap(src.Trust)
+	for dscp, queue := range src.Dscp {
+		m.Dscp[dscp] = queue
+	}
+	for pcp, queue := range src.Pcp {
+		m.Pcp[pcp] = queue
+	}
+	return m
+}
+
+func copyCosqPortSched(src *hal.CosqPortSched) *hal.CosqPortSched {
+	sched := &hal.CosqPortSched{
+		Mode:   src.Mode,
+		Queues: make([]*hal.CosqQueueConfig, len(src.Queues)),
+	}
+	for index, q := range src.Queues {
+		c := *q
+		sched.Queues[index] = &c
+	}
+	return sched
+}
+
+//
+// QosPortMapSet sets classification of ingress packets to egress queue.
+//
+func (s *Sim) QosPortMapSet(port hal.Port, m *hal.QosMap) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	for dscp, queue := range m.Dscp {
+		if dscp >= hal.DSCP_NUM {
+			return fmt.Errorf("Invalid dscp. %d", dscp)
+		}
+		if err := checkCosQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	for pcp, queue := range m.Pcp {
+		if pcp >= hal.PCP_NUM {
+			return fmt.Errorf("Invalid pcp. %d", pcp)
+		}
+		if err := checkCosQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	s.qosMaps[port] = copyQosMap(m)
+	return nil
+}
+
+//
+// QosPortMap returns classification of port. (simulator only)
+//
+func (s *Sim) QosPortMap(port hal.Port) (*hal.QosMap, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	m, ok := s.qosMaps[port]
+	if !ok {
+		return nil, errNotFound("qos map", port)
+	}
+
+	return copyQosMap(m), nil
+}
+
+//
+// CosqPortSchedSet sets scheduling and shaping of egress queues.
+//
+func (s *Sim) CosqPortSchedSet(port hal.Port, sched *hal.CosqPortSched) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	for _, q := range sched.Queues {
+		if err := checkCosQueue(q.Queue); err != nil {
+			return err
+		}
+		if q.Weight < 0 {
+			return fmt.Errorf("Invalid weight. queue:%d weight:%d", q.Queue, q.Weight)
+		}
+		if q.KbitsMax != 0 && q.KbitsMin > q.KbitsMax {
+			return fmt.Errorf("Invalid rate. queue:%d min:%d max:%d", q.Queue, q.KbitsMin, q.KbitsMax)
+		}
+	}
+
+	s.cosqScheds[port] = copyCosqPortSched(sched)
+	return nil
+}
+
+//
+// CosqPortSched returns scheduler of port. (simulator only)
+//
+func (s *Sim) CosqPortSched(port hal.Port) (*hal.CosqPortSched, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sched, ok := s.cosqScheds[port]
+	if !ok {
+		return nil, errNotFound("cosq sched", port)
+	}
+
+	return copyCosqPortSched(sched), nil
+}
+
+//
+// CosqStatGet returns counters of egress queue of port.
+//
+func (s *Sim) CosqStatGet(port hal.Port, queue hal.CosQueue, stats []hal.CosqStat) (map[hal.CosqStat]uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return nil, errNotFound("port", port)
+	}
+
+	if err := checkCosQueue(queue); err != nil {
+		return nil, err
+	}
+
+	values := map[hal.CosqStat]uint64{}
+	for _, stat := range stats {
+		values[stat] = s.cosqStats[port][queue][stat]
+	}
+
+	return values, nil
+}
+
+//
+// SetCosqStat sets counter of egress queue. (simulator only)
+//
+func (s *Sim) SetCosqStat(port hal.Port, queue hal.CosQueue, stat hal.CosqStat, value uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	if err := checkCosQueue(queue); err != nil {
+		return err
+	}
+
+	if _, ok := s.cosqStats[port]; !ok {
+		s.cosqStats[port] = map[hal.CosQueue]map[hal.CosqStat]uint64{}
+	}
+	if _, ok := s.cosqStats[port][queue]; !ok {
+		s.cosqStats[port][queue] = map[hal.CosqStat]uint64{}
+	}
+
+	s.cosqStats[port][queue][stat] = value
+	return nil
+}
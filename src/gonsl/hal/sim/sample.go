@@ -0,0 +1,114 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+)
+
+//
+// PortSampleRateSet sets rate of packet sampling of port.
+//
+func (s *Sim) PortSampleRateSet(port hal.Port, rate *hal.PortSampleRate) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return errNotFound("port", port)
+	}
+
+	if !rate.Enabled() {
+		delete(s.sampleRates, port)
+		return nil
+	}
+
+	r := *rate
+	s.sampleRates[port] = &r
+	return nil
+}
+
+//
+// PortSampleRateGet returns rate of packet sampling of port.
+//
+func (s *Sim) PortSampleRateGet(port hal.Port) (*hal.PortSampleRate, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.ports[port]; !ok {
+		return nil, errNotFound("port", port)
+	}
+
+	r := hal.PortSampleRate{}
+	if rate, ok := s.sampleRates[port]; ok {
+		r = *rate
+	}
+
+	return &r, nil
+}
+
+//
+// SampleCosSet sets cos of sampled packets copied to cpu.
+//
+func (s *Sim) SampleCosSet(cos uint32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sampleCos = cos
+	return nil
+}
+
+//
+// SampleCos returns cos of sampled packets. (simulator only)
+//
+func (s *Sim) SampleCos() uint32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.sampleCos
+}
+
+//
+// InjectSample delivers packet sampled on ingress of SrcPort
+// or egress of DstPort to callbacks. (simulator only)
+//
+func (s *Sim) InjectSample(pkt *hal.Packet, reason hal.PacketReason) (int, error) {
+	s.mutex.Lock()
+	port, rate := pkt.SrcPort, uint32(0)
+	if reason == hal.PACKET_REASON_SAMPLE_EGRESS {
+		port = pkt.DstPort
+	}
+	if r, ok := s.sampleRates[port]; ok {
+		rate = r.Ingress
+		if reason == hal.PACKET_REASON_SAMPLE_EGRESS {
+			rate = r.Egress
+		}
+	}
+	cos := s.sampleCos
+	s.mutex.Unlock()
+
+	if rate == 0 {
+		return 0, fmt.Errorf("Sampling disabled. port:%d reason:%s", port, reason)
+	}
+
+	p := copyPacket(pkt)
+	p.Cos = int(cos)
+	p.Reasons |= reason
+
+	return s.InjectRx(p), nil
+}
@@ -0,0 +1,246 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SimName is name of simulated backend.
+	SimName = "sim"
+
+	simVersion       = "1.0"
+	simCpuPort       = hal.Port(0)
+	simPortSpeed     = 10000
+	simMaxIface      = 4096
+	simMaxHost       = 8192
+	simMaxRoute      = 16384
+	simL3IfaceBase   = 1
+	simL3EgrBase     = 100000
+	simL3EcmpBase    = 200000
+	simTrunkBase     = 1
+	simFieldBase     = 1
+	simMirrorBase    = 1
+	simPolicerBase   = 1
+	simVxlanVpnBase  = 0x7000
+	simVxlanPortBase = 0x80000001
+	simSRv6EncapBase = 300000
+)
+
+var _ hal.HAL = (*Sim)(nil)
+
+//
+// Sim is pure-Go simulated switch.
+// It stores tables in memory and implements gonslhal.HAL.
+//
+type Sim struct {
+	mutex sync.Mutex
+
+	ports    map[hal.Port]*hal.PortInfo
+	stats    map[hal.Port]map[string]uint64
+	vlans    map[hal.Vlan]*simVlan
+	l2addrs  map[string]*hal.L2Addr
+	l2age    int
+	trunks   map[hal.Trunk]*hal.TrunkInfo
+	ifaces   map[hal.L3IfaceID]*hal.L3Iface
+	egrs     map[hal.L3EgressID]*hal.L3Egress
+	ecmps    map[hal.L3EgressID]*hal.L3EgressEcmp
+	hosts    map[string]*hal.L3Host
+	routes   map[string]*hal.L3Route
+	fgroups  map[hal.FieldGroupID]*simFieldGroup
+	fentries map[hal.FieldEntryID]*simFieldEntry
+	tunInits map[hal.L3IfaceID]*hal.TunnelInitiator
+	tunTerms map[string]*hal.TunnelTerminator
+	mplsInit map[hal.L3IfaceID][]*hal.MplsEgressLabel
+	mplsSws  map[string]*hal.MplsTunnelSwitch
+
+	vxlanVpns  map[hal.VxlanVpnID]*simVxlanVpn
+	vxlanPorts map[hal.VxlanPortID]*hal.VxlanPort
+	srv6Encaps map[hal.L3EgressID]*hal.SRv6Encap
+	localSids  map[string]*hal.SRv6LocalSid
+	txPkts     []*hal.Packet
+
+	qosMaps    map[hal.Port]*hal.QosMap
+	cosqScheds map[hal.Port]*hal.CosqPortSched
+	cosqStats  map[hal.Port]map[hal.CosQueue]map[hal.CosqStat]uint64
+
+	mirrorDests map[hal.MirrorDestID]*hal.MirrorDestination
+	mirrorPorts map[hal.Port]map[hal.MirrorDestID]hal.MirrorPortFlags
+
+	sampleRates map[hal.Port]*hal.PortSampleRate
+	sampleCos   uint32
+
+	policers map[hal.PolicerID]*hal.PolicerConfig
+	fstats   map[hal.FieldEntryID]*hal.FieldEntryStat
+
+	trunkHash hal.TrunkHashFields
+
+	nextTrunk   hal.Trunk
+	nextIface   hal.L3IfaceID
+	nextEgr     hal.L3EgressID
+	nextEcmp    hal.L3EgressID
+	nextFGroup  hal.FieldGroupID
+	nextFEntry  hal.FieldEntryID
+	nextMirror  hal.MirrorDestID
+	nextPolicer hal.PolicerID
+
+	nextVxlanVpn  hal.VxlanVpnID
+	nextVxlanPort hal.VxlanPortID
+	nextSRv6Encap hal.L3EgressID
+
+	rxCbs    map[int]*simRxCallback
+	linkCbs  map[string]hal.LinkscanCallback
+	l2addrCb hal.L2AddrCallback
+
+	log *log.Entry
+}
+
+//
+// NewSim returns new instance with front panel ports.
+//
+func NewSim(ports ...hal.Port) *Sim {
+	s := &Sim{
+		ports:    map[hal.Port]*hal.PortInfo{},
+		stats:    map[hal.Port]map[string]uint64{},
+		vlans:    map[hal.Vlan]*simVlan{},
+		l2addrs:  map[string]*hal.L2Addr{},
+		trunks:   map[hal.Trunk]*hal.TrunkInfo{},
+		ifaces:   map[hal.L3IfaceID]*hal.L3Iface{},
+		egrs:     map[hal.L3EgressID]*hal.L3Egress{},
+		ecmps:    map[hal.L3EgressID]*hal.L3EgressEcmp{},
+		hosts:    map[string]*hal.L3Host{},
+		routes:   map[string]*hal.L3Route{},
+		fgroups:  map[hal.FieldGroupID]*simFieldGroup{},
+		fentries: map[hal.FieldEntryID]*simFieldEntry{},
+		tunInits: map[hal.L3IfaceID]*hal.TunnelInitiator{},
+		tunTerms: map[string]*hal.TunnelTerminator{},
+		mplsInit: map[hal.L3IfaceID][]*hal.MplsEgressLabel{},
+		mplsSws:  map[string]*hal.MplsTunnelSwitch{},
+
+		vxlanVpns:  map[hal.VxlanVpnID]*simVxlanVpn{},
+		vxlanPorts: map[hal.VxlanPortID]*hal.VxlanPort{},
+		srv6Encaps: map[hal.L3EgressID]*hal.SRv6Encap{},
+		localSids:  map[string]*hal.SRv6LocalSid{},
+		txPkts:     []*hal.Packet{},
+
+		qosMaps:    map[hal.Port]*hal.QosMap{},
+		cosqScheds: map[hal.Port]*hal.CosqPortSched{},
+		cosqStats:  map[hal.Port]map[hal.CosQueue]map[hal.CosqStat]uint64{},
+
+		mirrorDests: map[hal.MirrorDestID]*hal.MirrorDestination{},
+		mirrorPorts: map[hal.Port]map[hal.MirrorDestID]hal.MirrorPortFlags{},
+
+		sampleRates: map[hal.Port]*hal.PortSampleRate{},
+
+		policers: map[hal.PolicerID]*hal.PolicerConfig{},
+		fstats:   map[hal.FieldEntryID]*hal.FieldEntryStat{},
+
+		nextTrunk:   simTrunkBase,
+		nextIface:   simL3IfaceBase,
+		nextEgr:     simL3EgrBase,
+		nextEcmp:    simL3EcmpBase,
+		nextFGroup:  simFieldBase,
+		nextFEntry:  simFieldBase,
+		nextMirror:  simMirrorBase,
+		nextPolicer: simPolicerBase,
+
+		nextVxlanVpn:  simVxlanVpnBase,
+		nextVxlanPort: simVxlanPortBase,
+		nextSRv6Encap: simSRv6EncapBase,
+
+		rxCbs:   map[int]*simRxCallback{},
+		linkCbs: map[string]hal.LinkscanCallback{},
+
+		log: log.WithFields(log.Fields{"module": "halsim"}),
+	}
+
+	for _, port := range ports {
+		s.ports[port] = newSimPortInfo(port)
+		s.stats[port] = map[string]uint64{}
+	}
+
+	return s
+}
+
+//
+// NewSimWithPortRange returns new instance with ports min..max.
+//
+func NewSimWithPortRange(min, max hal.Port) *Sim {
+	ports := []hal.Port{}
+	for port := min; port <= max; port++ {
+		ports = append(ports, port)
+	}
+	return NewSim(ports...)
+}
+
+//
+// Init initializes simulated switch.
+// It creates default vlan and adds cpu port to it.
+//
+func (s *Sim) Init() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	vlan := s.getOrCreateVlan(hal.VLAN_ID_DEFAULT)
+	vlan.pbmp.Add(simCpuPort)
+	vlan.ubmp.Add(simCpuPort)
+
+	s.log.Infof("Init ok. ports=%d", len(s.ports))
+	return nil
+}
+
+//
+// Exit terminates simulated switch.
+//
+func (s *Sim) Exit() {
+	s.log.Infof("Exit")
+}
+
+//
+// Info returns driver information.
+//
+func (s *Sim) Info() (*hal.DriverInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return &hal.DriverInfo{
+		Name:      SimName,
+		Version:   simVersion,
+		UsedIface: len(s.ifaces),
+		MaxIface:  simMaxIface,
+		MaxHost:   simMaxHost,
+		MaxRoute:  simMaxRoute,
+	}, nil
+}
+
+func errNotFound(name string, key interface{}) error {
+	return fmt.Errorf("%s not found. %v", name, key)
+}
+
+func errExists(name string, key interface{}) error {
+	return fmt.Errorf("%s already exists. %v", name, key)
+}
+
+func errBusy(name string, key interface{}) error {
+	return fmt.Errorf("%s is in use. %v", name, key)
+}
@@ -0,0 +1,167 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+)
+
+func copySRv6Encap(src *hal.SRv6Encap) *hal.SRv6Encap {
+	encap := *src
+	encap.Segments = make([]net.IP, len(src.Segments))
+	for i, seg := range src.Segments {
+		encap.Segments[i] = append(net.IP{}, seg...)
+	}
+	return &encap
+}
+
+func copySRv6LocalSid(src *hal.SRv6LocalSid) *hal.SRv6LocalSid {
+	sid := *src
+	sid.Sid = &net.IPNet{
+		IP:   append(net.IP{}, src.Sid.IP...),
+		Mask: append(net.IPMask{}, src.Sid.Mask...),
+	}
+	return &sid
+}
+
+func srv6LocalSidHasEgress(action hal.SRv6LocalSidAction) bool {
+	switch action {
+	case hal.SRV6_LOCAL_SID_END_X, hal.SRV6_LOCAL_SID_END_DX6, hal.SRV6_LOCAL_SID_END_DX4:
+		return true
+	default:
+		return false
+	}
+}
+
+//
+// SRv6EncapCreate creates srv6 encap and returns it's egress id.
+//
+func (s *Sim) SRv6EncapCreate(encap *hal.SRv6Encap) (hal.L3EgressID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(encap.Segments) == 0 {
+		return 0, fmt.Errorf("srv6 encap has no segment.")
+	}
+
+	if _, ok := s.egrs[encap.Egress]; !ok {
+		return 0, errNotFound("l3egress", encap.Egress)
+	}
+
+	egrID := s.nextSRv6Encap
+	s.nextSRv6Encap++
+
+	s.srv6Encaps[egrID] = copySRv6Encap(encap)
+
+	return egrID, nil
+}
+
+//
+// SRv6EncapDestroy destroys srv6 encap.
+// It fails if encap is used by routes.
+//
+func (s *Sim) SRv6EncapDestroy(egrID hal.L3EgressID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.srv6Encaps[egrID]; !ok {
+		return errNotFound("srv6 encap", egrID)
+	}
+
+	if refs := s.l3EgressRefs(egrID); refs > 0 {
+		return errBusy("srv6 encap", fmt.Sprintf("%d refs:%d", egrID, refs))
+	}
+
+	delete(s.srv6Encaps, egrID)
+	return nil
+}
+
+//
+// SRv6EncapGet returns srv6 encap.
+//
+func (s *Sim) SRv6EncapGet(egrID hal.L3EgressID) (*hal.SRv6Encap, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	encap, ok := s.srv6Encaps[egrID]
+	if !ok {
+		return nil, errNotFound("srv6 encap", egrID)
+	}
+
+	return copySRv6Encap(encap), nil
+}
+
+//
+// SRv6LocalSidAdd adds or replaces local sid.
+//
+func (s *Sim) SRv6LocalSidAdd(sid *hal.SRv6LocalSid) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if sid.Sid == nil || sid.Sid.IP.To4() != nil {
+		return fmt.Errorf("local sid must be ipv6 prefix. %v", sid.Sid)
+	}
+
+	if sid.Action < hal.SRV6_LOCAL_SID_END || sid.Action > hal.SRV6_LOCAL_SID_END_DT4 {
+		return fmt.Errorf("invalid local sid action. %s", sid.Action)
+	}
+
+	if srv6LocalSidHasEgress(sid.Action) {
+		if _, ok := s.egrs[sid.Egress]; !ok {
+			return errNotFound("l3egress", sid.Egress)
+		}
+	}
+
+	s.localSids[sid.Key()] = copySRv6LocalSid(sid)
+
+	return nil
+}
+
+//
+// SRv6LocalSidDelete deletes local sid.
+//
+func (s *Sim) SRv6LocalSidDelete(sid *hal.SRv6LocalSid) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := sid.Key()
+	if _, ok := s.localSids[key]; !ok {
+		return errNotFound("local sid", key)
+	}
+
+	delete(s.localSids, key)
+	return nil
+}
+
+//
+// SRv6LocalSidGet returns local sid.
+//
+func (s *Sim) SRv6LocalSidGet(vrf hal.Vrf, sid *net.IPNet) (*hal.SRv6LocalSid, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := (&hal.SRv6LocalSid{Sid: sid, Vrf: vrf}).Key()
+	entry, ok := s.localSids[key]
+	if !ok {
+		return nil, errNotFound("local sid", key)
+	}
+
+	return copySRv6LocalSid(entry), nil
+}
@@ -0,0 +1,106 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"testing"
+)
+
+func TestSRv6Encap(t *testing.T) {
+	s := NewSim(1, 2)
+	s.Init()
+
+	_, egrID := newTestL3Egress(t, s, 1)
+	segs := []net.IP{net.ParseIP("fc00:2::1"), net.ParseIP("fc00:1::1")}
+
+	if _, err := s.SRv6EncapCreate(&hal.SRv6Encap{Segments: segs, Egress: egrID + 1}); err == nil {
+		t.Errorf("SRv6EncapCreate(unknown egress) must be error.")
+	}
+
+	if _, err := s.SRv6EncapCreate(&hal.SRv6Encap{Egress: egrID}); err == nil {
+		t.Errorf("SRv6EncapCreate(no segment) must be error.")
+	}
+
+	encapID, err := s.SRv6EncapCreate(&hal.SRv6Encap{Mode: hal.SRV6_ENCAP_ENCAP, Segments: segs, Egress: egrID})
+	if err != nil {
+		t.Fatalf("SRv6EncapCreate error. %s", err)
+	}
+
+	_, dst, _ := net.ParseCIDR("10.1.0.0/24")
+	route := &hal.L3Route{Dst: dst, EgressID: encapID}
+	if err := s.L3RouteAdd(route); err != nil {
+		t.Errorf("L3RouteAdd(srv6 encap) error. %s", err)
+	}
+
+	if err := s.L3EgressDestroy(egrID); err == nil {
+		t.Errorf("L3EgressDestroy(referred by encap) must be error.")
+	}
+
+	if err := s.SRv6EncapDestroy(encapID); err == nil {
+		t.Errorf("SRv6EncapDestroy(referred by route) must be error.")
+	}
+
+	s.L3RouteDelete(route)
+
+	if err := s.SRv6EncapDestroy(encapID); err != nil {
+		t.Errorf("SRv6EncapDestroy error. %s", err)
+	}
+
+	if _, err := s.SRv6EncapGet(encapID); err == nil {
+		t.Errorf("SRv6EncapGet(destroyed) must be error.")
+	}
+}
+
+func TestSRv6LocalSid(t *testing.T) {
+	s := NewSim(1, 2)
+	s.Init()
+
+	_, egrID := newTestL3Egress(t, s, 1)
+	_, sid, _ := net.ParseCIDR("fc00:1::100/128")
+	_, sid4, _ := net.ParseCIDR("10.0.0.1/32")
+
+	if err := s.SRv6LocalSidAdd(&hal.SRv6LocalSid{Sid: sid4, Action: hal.SRV6_LOCAL_SID_END}); err == nil {
+		t.Errorf("SRv6LocalSidAdd(ipv4) must be error.")
+	}
+
+	if err := s.SRv6LocalSidAdd(&hal.SRv6LocalSid{Sid: sid, Action: hal.SRV6_LOCAL_SID_END_DX4, Egress: egrID + 1}); err == nil {
+		t.Errorf("SRv6LocalSidAdd(unknown egress) must be error.")
+	}
+
+	if err := s.SRv6LocalSidAdd(&hal.SRv6LocalSid{Sid: sid, Vrf: 1, Action: hal.SRV6_LOCAL_SID_END_DX4, Egress: egrID}); err != nil {
+		t.Fatalf("SRv6LocalSidAdd error. %s", err)
+	}
+
+	if e, err := s.SRv6LocalSidGet(1, sid); err != nil || e.Egress != egrID || e.Action != hal.SRV6_LOCAL_SID_END_DX4 {
+		t.Errorf("SRv6LocalSidGet unmatch. %v %v", e, err)
+	}
+
+	if err := s.L3EgressDestroy(egrID); err == nil {
+		t.Errorf("L3EgressDestroy(referred by local sid) must be error.")
+	}
+
+	if err := s.SRv6LocalSidDelete(&hal.SRv6LocalSid{Sid: sid, Vrf: 1}); err != nil {
+		t.Errorf("SRv6LocalSidDelete error. %s", err)
+	}
+
+	if err := s.SRv6LocalSidDelete(&hal.SRv6LocalSid{Sid: sid, Vrf: 1}); err == nil {
+		t.Errorf("SRv6LocalSidDelete(not found) must be error.")
+	}
+}
@@ -0,0 +1,154 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+func copyTunnelInitiator(src *hal.TunnelInitiator) *hal.TunnelInitiator {
+	tun := *src
+	tun.DstMAC = append(net.HardwareAddr{}, src.DstMAC...)
+	tun.SrcMAC = append(net.HardwareAddr{}, src.SrcMAC...)
+	tun.DstIP = append(net.IP{}, src.DstIP...)
+	tun.SrcIP = append(net.IP{}, src.SrcIP...)
+	return &tun
+}
+
+func copyTunnelTerminator(src *hal.TunnelTerminator) *hal.TunnelTerminator {
+	tun := *src
+	tun.DstIP = copyIPNet(src.DstIP)
+	tun.SrcIP = copyIPNet(src.SrcIP)
+	if src.PBmp != nil {
+		tun.PBmp = src.PBmp.Copy()
+	}
+	return &tun
+}
+
+//
+// TunnelInitiatorCreate sets tunnel initiator to l3 interface.
+//
+func (s *Sim) TunnelInitiatorCreate(ifaceID hal.L3IfaceID, tun *hal.TunnelInitiator) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	iface, ok := s.ifaces[ifaceID]
+	if !ok {
+		return errNotFound("l3iface", ifaceID)
+	}
+
+	newTun := copyTunnelInitiator(tun)
+	newTun.L3IfaceID = ifaceID
+	newTun.SrcMAC = append(net.HardwareAddr{}, iface.MAC...)
+	s.tunInits[ifaceID] = newTun
+
+	return nil
+}
+
+//
+// TunnelInitiatorClear clears tunnel initiator of l3 interface.
+//
+func (s *Sim) TunnelInitiatorClear(ifaceID hal.L3IfaceID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.tunInits[ifaceID]; !ok {
+		return errNotFound("tunnel initiator", ifaceID)
+	}
+
+	delete(s.tunInits, ifaceID)
+	return nil
+}
+
+//
+// TunnelInitiatorTraverse enumerates all tunnel initiators.
+//
+func (s *Sim) TunnelInitiatorTraverse(f func(*hal.TunnelInitiator) error) error {
+	s.mutex.Lock()
+	tuns := make([]*hal.TunnelInitiator, 0, len(s.tunInits))
+	for _, tun := range s.tunInits {
+		tuns = append(tuns, copyTunnelInitiator(tun))
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(tuns, func(i, j int) bool { return tuns[i].L3IfaceID < tuns[j].L3IfaceID })
+
+	for _, tun := range tuns {
+		if err := f(tun); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// TunnelTerminatorCreate creates tunnel terminator.
+//
+func (s *Sim) TunnelTerminatorCreate(tun *hal.TunnelTerminator) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := tun.Key()
+	if _, ok := s.tunTerms[key]; ok {
+		return errExists("tunnel terminator", key)
+	}
+
+	s.tunTerms[key] = copyTunnelTerminator(tun)
+	return nil
+}
+
+//
+// TunnelTerminatorDelete deletes tunnel terminator.
+//
+func (s *Sim) TunnelTerminatorDelete(tun *hal.TunnelTerminator) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := tun.Key()
+	if _, ok := s.tunTerms[key]; !ok {
+		return errNotFound("tunnel terminator", key)
+	}
+
+	delete(s.tunTerms, key)
+	return nil
+}
+
+//
+// TunnelTerminatorTraverse enumerates all tunnel terminators.
+//
+func (s *Sim) TunnelTerminatorTraverse(f func(*hal.TunnelTerminator) error) error {
+	s.mutex.Lock()
+	tuns := make([]*hal.TunnelTerminator, 0, len(s.tunTerms))
+	for _, tun := range s.tunTerms {
+		tuns = append(tuns, copyTunnelTerminator(tun))
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(tuns, func(i, j int) bool { return tuns[i].Key() < tuns[j].Key() })
+
+	for _, tun := range tuns {
+		if err := f(tun); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
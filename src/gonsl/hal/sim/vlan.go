@@ -0,0 +1,185 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"sort"
+)
+
+type simVlan struct {
+	pbmp *hal.PBmp
+	ubmp *hal.PBmp
+}
+
+func newSimVlan() *simVlan {
+	return &simVlan{
+		pbmp: hal.NewPBmp(),
+		ubmp: hal.NewPBmp(),
+	}
+}
+
+func (s *Sim) getOrCreateVlan(vid hal.Vlan) *simVlan {
+	vlan, ok := s.vlans[vid]
+	if !ok {
+		vlan = newSimVlan()
+		s.vlans[vid] = vlan
+	}
+	return vlan
+}
+
+func (s *Sim) checkPorts(pbmp *hal.PBmp) error {
+	return pbmp.Each(func(port hal.Port) error {
+		if port == simCpuPort {
+			return nil
+		}
+		_, err := s.portInfo(port)
+		return err
+	})
+}
+
+//
+// VlanDefault returns default vlan.
+//
+func (s *Sim) VlanDefault() hal.Vlan {
+	return hal.VLAN_ID_DEFAULT
+}
+
+//
+// VlanCreate creates vlan.
+// Creating existing vlan is not an error.
+//
+func (s *Sim) VlanCreate(vid hal.Vlan) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if vid == hal.VLAN_ID_NONE || vid > hal.VLAN_ID_MAX {
+		return errNotFound("vlan", vid)
+	}
+
+	s.getOrCreateVlan(vid)
+	return nil
+}
+
+//
+// VlanDestroy destroys vlan.
+//
+func (s *Sim) VlanDestroy(vid hal.Vlan) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if vid == hal.VLAN_ID_DEFAULT {
+		return errBusy("vlan", vid)
+	}
+
+	if _, ok := s.vlans[vid]; !ok {
+		return errNotFound("vlan", vid)
+	}
+
+	delete(s.vlans, vid)
+	return nil
+}
+
+//
+// VlanPortAdd adds ports to vlan.
+//
+func (s *Sim) VlanPortAdd(vid hal.Vlan, pbmp *hal.PBmp, ubmp *hal.PBmp) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	vlan, ok := s.vlans[vid]
+	if !ok {
+		return errNotFound("vlan", vid)
+	}
+
+	if err := s.checkPorts(pbmp); err != nil {
+		return err
+	}
+
+	vlan.pbmp.Merge(pbmp)
+	pbmp.Each(func(port hal.Port) error {
+		if ubmp.Has(port) {
+			vlan.ubmp.Add(port)
+		} else {
+			vlan.ubmp.Remove(port)
+		}
+		return nil
+	})
+
+	return nil
+}
+
+//
+// VlanPortRemove removes ports from vlan.
+//
+func (s *Sim) VlanPortRemove(vid hal.Vlan, pbmp *hal.PBmp) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	vlan, ok := s.vlans[vid]
+	if !ok {
+		return errNotFound("vlan", vid)
+	}
+
+	vlan.pbmp.Subtract(pbmp)
+	vlan.ubmp.Subtract(pbmp)
+	return nil
+}
+
+//
+// VlanPortGet returns member ports and untagged ports of vlan.
+//
+func (s *Sim) VlanPortGet(vid hal.Vlan) (*hal.PBmp, *hal.PBmp, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	vlan, ok := s.vlans[vid]
+	if !ok {
+		return nil, nil, errNotFound("vlan", vid)
+	}
+
+	return vlan.pbmp.Copy(), vlan.ubmp.Copy(), nil
+}
+
+//
+// VlanTraverse enumerates all vlans.
+//
+func (s *Sim) VlanTraverse(f func(hal.Vlan, *hal.PBmp, *hal.PBmp) error) error {
+	type entry struct {
+		vid  hal.Vlan
+		pbmp *hal.PBmp
+		ubmp *hal.PBmp
+	}
+
+	s.mutex.Lock()
+	entries := make([]*entry, 0, len(s.vlans))
+	for vid, vlan := range s.vlans {
+		entries = append(entries, &entry{vid: vid, pbmp: vlan.pbmp.Copy(), ubmp: vlan.ubmp.Copy()})
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].vid < entries[j].vid })
+
+	for _, e := range entries {
+		if err := f(e.vid, e.pbmp, e.ubmp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"testing"
+)
+
+func TestVlan_portAddRemove(t *testing.T) {
+	s := NewSim(1, 2, 3)
+	s.Init()
+
+	if err := s.VlanCreate(10); err != nil {
+		t.Fatalf("VlanCreate error. %s", err)
+	}
+
+	if err := s.VlanPortAdd(10, hal.NewPBmp(1, 2), hal.NewPBmp(2)); err != nil {
+		t.Fatalf("VlanPortAdd error. %s", err)
+	}
+
+	if err := s.VlanPortAdd(10, hal.NewPBmp(9), hal.NewPBmp()); err == nil {
+		t.Errorf("VlanPortAdd(unknown port) must be error.")
+	}
+
+	pbmp, ubmp, err := s.VlanPortGet(10)
+	if err != nil {
+		t.Fatalf("VlanPortGet error. %s", err)
+	}
+
+	if !pbmp.Has(1) || !pbmp.Has(2) || pbmp.Has(3) {
+		t.Errorf("VlanPortGet pbmp unmatch. %s", pbmp)
+	}
+
+	if ubmp.Has(1) || !ubmp.Has(2) {
+		t.Errorf("VlanPortGet ubmp unmatch. %s", ubmp)
+	}
+
+	s.VlanPortRemove(10, hal.NewPBmp(2))
+
+	pbmp, ubmp, _ = s.VlanPortGet(10)
+	if pbmp.Has(2) || ubmp.Has(2) {
+		t.Errorf("VlanPortRemove unmatch. %s %s", pbmp, ubmp)
+	}
+}
+
+func TestVlan_default(t *testing.T) {
+	s := NewSim(1)
+	s.Init()
+
+	if vid := s.VlanDefault(); vid != hal.VLAN_ID_DEFAULT {
+		t.Errorf("VlanDefault unmatch. %d", vid)
+	}
+
+	if err := s.VlanDestroy(hal.VLAN_ID_DEFAULT); err == nil {
+		t.Errorf("VlanDestroy(default) must be error.")
+	}
+
+	if err := s.VlanDestroy(100); err == nil {
+		t.Errorf("VlanDestroy(not exist) must be error.")
+	}
+}
@@ -0,0 +1,289 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+type simVxlanVpn struct {
+	vpn     hal.VxlanVpn
+	ports   map[hal.VxlanPortID]struct{}
+	flood   []hal.VxlanPortID
+	l2addrs map[string]*hal.VxlanL2Addr
+}
+
+func copyVxlanPort(src *hal.VxlanPort) *hal.VxlanPort {
+	port := *src
+	port.SrcIP = append(net.IP{}, src.SrcIP...)
+	port.DstIP = append(net.IP{}, src.DstIP...)
+	return &port
+}
+
+//
+// VxlanVpnCreate creates vxlan vpn.
+//
+func (s *Sim) VxlanVpnCreate(vpn *hal.VxlanVpn) (hal.VxlanVpnID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.vlans[vpn.Vlan]; !ok {
+		return 0, errNotFound("vlan", vpn.Vlan)
+	}
+
+	for vpnID, v := range s.vxlanVpns {
+		if v.vpn.Vni == vpn.Vni {
+			return 0, errExists("vxlan vpn", vpnID)
+		}
+	}
+
+	vpnID := s.nextVxlanVpn
+	s.nextVxlanVpn++
+
+	s.vxlanVpns[vpnID] = &simVxlanVpn{
+		vpn:     *vpn,
+		ports:   map[hal.VxlanPortID]struct{}{},
+		flood:   []hal.VxlanPortID{},
+		l2addrs: map[string]*hal.VxlanL2Addr{},
+	}
+
+	return vpnID, nil
+}
+
+//
+// VxlanVpnDestroy destroys vxlan vpn.
+//
+func (s *Sim) VxlanVpnDestroy(vpnID hal.VxlanVpnID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return errNotFound("vxlan vpn", vpnID)
+	}
+
+	if len(v.ports) != 0 {
+		return errBusy("vxlan vpn", vpnID)
+	}
+
+	delete(s.vxlanVpns, vpnID)
+	return nil
+}
+
+//
+// VxlanVpnGet returns vxlan vpn.
+//
+func (s *Sim) VxlanVpnGet(vpnID hal.VxlanVpnID) (*hal.VxlanVpn, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return nil, errNotFound("vxlan vpn", vpnID)
+	}
+
+	vpn := v.vpn
+	return &vpn, nil
+}
+
+//
+// VxlanPortAdd adds network port to vxlan vpn.
+//
+func (s *Sim) VxlanPortAdd(port *hal.VxlanPort) (hal.VxlanPortID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[port.VpnID]
+	if !ok {
+		return 0, errNotFound("vxlan vpn", port.VpnID)
+	}
+
+	if _, ok := s.egrs[port.Egress]; !ok {
+		return 0, errNotFound("l3egress", port.Egress)
+	}
+
+	portID := s.nextVxlanPort
+	s.nextVxlanPort++
+
+	s.vxlanPorts[portID] = copyVxlanPort(port)
+	v.ports[portID] = struct{}{}
+
+	return portID, nil
+}
+
+//
+// VxlanPortDelete deletes network port from vxlan vpn.
+// It also removes port from flood list and l2 addresses via port.
+//
+func (s *Sim) VxlanPortDelete(vpnID hal.VxlanVpnID, portID hal.VxlanPortID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return errNotFound("vxlan vpn", vpnID)
+	}
+
+	if _, ok := v.ports[portID]; !ok {
+		return errNotFound("vxlan port", portID)
+	}
+
+	flood := []hal.VxlanPortID{}
+	for _, id := range v.flood {
+		if id != portID {
+			flood = append(flood, id)
+		}
+	}
+	v.flood = flood
+
+	for key, l2addr := range v.l2addrs {
+		if l2addr.PortID == portID {
+			delete(v.l2addrs, key)
+		}
+	}
+
+	delete(v.ports, portID)
+	delete(s.vxlanPorts, portID)
+	return nil
+}
+
+//
+// VxlanPortGet returns network port.
+//
+func (s *Sim) VxlanPortGet(portID hal.VxlanPortID) (*hal.VxlanPort, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	port, ok := s.vxlanPorts[portID]
+	if !ok {
+		return nil, errNotFound("vxlan port", portID)
+	}
+
+	return copyVxlanPort(port), nil
+}
+
+//
+// VxlanFloodSet replaces flood list of vxlan vpn.
+//
+func (s *Sim) VxlanFloodSet(vpnID hal.VxlanVpnID, portIDs []hal.VxlanPortID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return errNotFound("vxlan vpn", vpnID)
+	}
+
+	for _, portID := range portIDs {
+		if _, ok := v.ports[portID]; !ok {
+			return errNotFound("vxlan port", portID)
+		}
+	}
+
+	v.flood = append([]hal.VxlanPortID{}, portIDs...)
+	return nil
+}
+
+//
+// VxlanFloodGet returns flood list of vxlan vpn.
+//
+func (s *Sim) VxlanFloodGet(vpnID hal.VxlanVpnID) ([]hal.VxlanPortID, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return nil, errNotFound("vxlan vpn", vpnID)
+	}
+
+	portIDs := append([]hal.VxlanPortID{}, v.flood...)
+	sort.Slice(portIDs, func(i, j int) bool { return portIDs[i] < portIDs[j] })
+	return portIDs, nil
+}
+
+//
+// VxlanL2AddrAdd adds (or replaces) l2 address via network port.
+//
+func (s *Sim) VxlanL2AddrAdd(l2addr *hal.VxlanL2Addr) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[l2addr.VpnID]
+	if !ok {
+		return errNotFound("vxlan vpn", l2addr.VpnID)
+	}
+
+	if _, ok := v.ports[l2addr.PortID]; !ok {
+		return errNotFound("vxlan port", l2addr.PortID)
+	}
+
+	v.l2addrs[l2addr.MAC.String()] = &hal.VxlanL2Addr{
+		VpnID:  l2addr.VpnID,
+		MAC:    append(net.HardwareAddr{}, l2addr.MAC...),
+		PortID: l2addr.PortID,
+	}
+
+	return nil
+}
+
+//
+// VxlanL2AddrDelete deletes l2 address of vxlan vpn.
+//
+func (s *Sim) VxlanL2AddrDelete(vpnID hal.VxlanVpnID, mac net.HardwareAddr) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return errNotFound("vxlan vpn", vpnID)
+	}
+
+	key := mac.String()
+	if _, ok := v.l2addrs[key]; !ok {
+		return errNotFound("vxlan l2addr", key)
+	}
+
+	delete(v.l2addrs, key)
+	return nil
+}
+
+//
+// VxlanL2AddrGet returns l2 address of vxlan vpn.
+//
+func (s *Sim) VxlanL2AddrGet(vpnID hal.VxlanVpnID, mac net.HardwareAddr) (*hal.VxlanL2Addr, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, ok := s.vxlanVpns[vpnID]
+	if !ok {
+		return nil, errNotFound("vxlan vpn", vpnID)
+	}
+
+	l2addr, ok := v.l2addrs[mac.String()]
+	if !ok {
+		return nil, errNotFound("vxlan l2addr", mac)
+	}
+
+	return &hal.VxlanL2Addr{
+		VpnID:  l2addr.VpnID,
+		MAC:    append(net.HardwareAddr{}, l2addr.MAC...),
+		PortID: l2addr.PortID,
+	}, nil
+}
@@ -0,0 +1,143 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package halsim
+
+import (
+	hal "gonsl/hal"
+	"net"
+	"testing"
+)
+
+func newTestVxlanPort(t *testing.T, s *Sim, vpnID hal.VxlanVpnID, egrID hal.L3EgressID, dst string) hal.VxlanPortID {
+	portID, err := s.VxlanPortAdd(&hal.VxlanPort{
+		VpnID:      vpnID,
+		SrcIP:      net.ParseIP("10.0.0.1"),
+		DstIP:      net.ParseIP(dst),
+		UdpDstPort: hal.VXLAN_UDP_PORT_DEFAULT,
+		TTL:        64,
+		Egress:     egrID,
+	})
+	if err != nil {
+		t.Fatalf("VxlanPortAdd error. %s", err)
+	}
+	return portID
+}
+
+func TestVxlanVpn_create(t *testing.T) {
+	s := NewSim(1, 2)
+	s.Init()
+
+	if _, err := s.VxlanVpnCreate(&hal.VxlanVpn{Vni: 100, Vlan: 10}); err == nil {
+		t.Errorf("VxlanVpnCreate(unknown vlan) must be error.")
+	}
+
+	s.VlanCreate(10)
+
+	vpnID, err := s.VxlanVpnCreate(&hal.VxlanVpn{Vni: 100, Vlan: 10})
+	if err != nil {
+		t.Fatalf("VxlanVpnCreate error. %s", err)
+	}
+
+	if _, err := s.VxlanVpnCreate(&hal.VxlanVpn{Vni: 100, Vlan: 10}); err == nil {
+		t.Errorf("VxlanVpnCreate(same vni) must be error.")
+	}
+
+	vpn, err := s.VxlanVpnGet(vpnID)
+	if err != nil {
+		t.Fatalf("VxlanVpnGet error. %s", err)
+	}
+
+	if vpn.Vni != 100 || vpn.Vlan != 10 {
+		t.Errorf("VxlanVpnGet unmatch. %s", vpn)
+	}
+
+	if err := s.VxlanVpnDestroy(vpnID); err != nil {
+		t.Errorf("VxlanVpnDestroy error. %s", err)
+	}
+}
+
+func TestVxlanPort_flood(t *testing.T) {
+	s := NewSim(1, 2)
+	s.Init()
+	s.VlanCreate(10)
+	_, egrID := newTestL3Egress(t, s, 1)
+
+	vpnID, _ := s.VxlanVpnCreate(&hal.VxlanVpn{Vni: 100, Vlan: 10})
+
+	if _, err := s.VxlanPortAdd(&hal.VxlanPort{VpnID: vpnID, Egress: 999}); err == nil {
+		t.Errorf("VxlanPortAdd(unknown egress) must be error.")
+	}
+
+	port1 := newTestVxlanPort(t, s, vpnID, egrID, "10.0.0.2")
+	port2 := newTestVxlanPort(t, s, vpnID, egrID, "10.0.0.3")
+
+	if err := s.VxlanFloodSet(vpnID, []hal.VxlanPortID{port2, port1}); err != nil {
+		t.Fatalf("VxlanFloodSet error. %s", err)
+	}
+
+	if err := s.VxlanFloodSet(vpnID, []hal.VxlanPortID{999}); err == nil {
+		t.Errorf("VxlanFloodSet(unknown port) must be error.")
+	}
+
+	if err := s.VxlanVpnDestroy(vpnID); err == nil {
+		t.Errorf("VxlanVpnDestroy(busy) must be error.")
+	}
+
+	if err := s.VxlanPortDelete(vpnID, port1); err != nil {
+		t.Fatalf("VxlanPortDelete error. %s", err)
+	}
+
+	flood, _ := s.VxlanFloodGet(vpnID)
+	if len(flood) != 1 || flood[0] != port2 {
+		t.Errorf("VxlanFloodGet unmatch. %v", flood)
+	}
+}
+
+func TestVxlanL2Addr(t *testing.T) {
+	s := NewSim(1, 2)
+	s.Init()
+	s.VlanCreate(10)
+	_, egrID := newTestL3Egress(t, s, 1)
+
+	vpnID, _ := s.VxlanVpnCreate(&hal.VxlanVpn{Vni: 100, Vlan: 10})
+	portID := newTestVxlanPort(t, s, vpnID, egrID, "10.0.0.2")
+
+	mac, _ := net.ParseMAC("00:11:22:33:44:55")
+	if err := s.VxlanL2AddrAdd(&hal.VxlanL2Addr{VpnID: vpnID, MAC: mac, PortID: portID}); err != nil {
+		t.Fatalf("VxlanL2AddrAdd error. %s", err)
+	}
+
+	l2addr, err := s.VxlanL2AddrGet(vpnID, mac)
+	if err != nil {
+		t.Fatalf("VxlanL2AddrGet error. %s", err)
+	}
+
+	if l2addr.PortID != portID {
+		t.Errorf("VxlanL2AddrGet unmatch. %s", l2addr)
+	}
+
+	s.VxlanPortDelete(vpnID, portID)
+
+	if _, err := s.VxlanL2AddrGet(vpnID, mac); err == nil {
+		t.Errorf("VxlanL2AddrGet must be error after port deleted.")
+	}
+
+	if err := s.VxlanL2AddrDelete(vpnID, mac); err == nil {
+		t.Errorf("VxlanL2AddrDelete must be error.")
+	}
+}
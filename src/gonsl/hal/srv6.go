@@ -0,0 +1,114 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// SRv6EncapMode is mode of srv6 encapsulation.
+//
+type SRv6EncapMode int
+
+const (
+	SRV6_ENCAP_INLINE SRv6EncapMode = iota // insert SRH to original ipv6 header.
+	SRV6_ENCAP_ENCAP                       // encapsulate with outer ipv6 header and SRH.
+)
+
+var srv6EncapModeNames = map[SRv6EncapMode]string{
+	SRV6_ENCAP_INLINE: "INLINE",
+	SRV6_ENCAP_ENCAP:  "ENCAP",
+}
+
+func (m SRv6EncapMode) String() string {
+	if s, ok := srv6EncapModeNames[m]; ok {
+		return s
+	}
+	return fmt.Sprintf("SRv6EncapMode(%d)", m)
+}
+
+//
+// SRv6Encap is srv6 encapsulation (H.Encaps/H.Insert).
+// Segments are in SRH order (last element is the first SID).
+// Encapsulated packets are sent via Egress (underlay nexthop).
+//
+type SRv6Encap struct {
+	Mode     SRv6EncapMode
+	Segments []net.IP
+	Egress   L3EgressID
+}
+
+func (e *SRv6Encap) String() string {
+	return fmt.Sprintf("mode:%s segs:%v egress:%d", e.Mode, e.Segments, e.Egress)
+}
+
+//
+// SRv6LocalSidAction is action of local sid.
+//
+type SRv6LocalSidAction int
+
+const (
+	SRV6_LOCAL_SID_END     SRv6LocalSidAction = iota + 1
+	SRV6_LOCAL_SID_END_X                      // Egress: nexthop
+	SRV6_LOCAL_SID_END_T                      // Table: vrf to lookup
+	SRV6_LOCAL_SID_END_DX6                    // Egress: nexthop
+	SRV6_LOCAL_SID_END_DX4                    // Egress: nexthop
+	SRV6_LOCAL_SID_END_DT6                    // Table: vrf to lookup
+	SRV6_LOCAL_SID_END_DT4                    // Table: vrf to lookup
+)
+
+var srv6LocalSidActionNames = map[SRv6LocalSidAction]string{
+	SRV6_LOCAL_SID_END:     "End",
+	SRV6_LOCAL_SID_END_X:   "End.X",
+	SRV6_LOCAL_SID_END_T:   "End.T",
+	SRV6_LOCAL_SID_END_DX6: "End.DX6",
+	SRV6_LOCAL_SID_END_DX4: "End.DX4",
+	SRV6_LOCAL_SID_END_DT6: "End.DT6",
+	SRV6_LOCAL_SID_END_DT4: "End.DT4",
+}
+
+func (a SRv6LocalSidAction) String() string {
+	if s, ok := srv6LocalSidActionNames[a]; ok {
+		return s
+	}
+	return fmt.Sprintf("SRv6LocalSidAction(%d)", a)
+}
+
+//
+// SRv6LocalSid is local sid entry.
+//
+type SRv6LocalSid struct {
+	Sid    *net.IPNet
+	Vrf    Vrf
+	Action SRv6LocalSidAction
+	Table  Vrf
+	Egress L3EgressID
+}
+
+//
+// Key returns key of local sid table.
+//
+func (s *SRv6LocalSid) Key() string {
+	return fmt.Sprintf("%d_%s", s.Vrf, s.Sid)
+}
+
+func (s *SRv6LocalSid) String() string {
+	return fmt.Sprintf("%s vrf:%d action:%s table:%d egress:%d", s.Sid, s.Vrf, s.Action, s.Table, s.Egress)
+}
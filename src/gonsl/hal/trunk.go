@@ -0,0 +1,163 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// TrunkPsc is port selection criteria of trunk.
+// TRUNK_PSC_PORTFLOW selects member by fields set by TrunkHashFieldsSet.
+//
+type TrunkPsc int
+
+const (
+	TRUNK_PSC_SRCDSTMAC TrunkPsc = iota
+	TRUNK_PSC_SRCDSTIP
+	TRUNK_PSC_PORTFLOW
+)
+
+var trunkPscNames = map[TrunkPsc]string{
+	TRUNK_PSC_SRCDSTMAC: "srcdstmac",
+	TRUNK_PSC_SRCDSTIP:  "srcdstip",
+	TRUNK_PSC_PORTFLOW:  "portflow",
+}
+
+func (v TrunkPsc) String() string {
+	if s, ok := trunkPscNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("TrunkPsc(%d)", v)
+}
+
+//
+// TrunkHashFields is packet fields used to select trunk member.
+//
+type TrunkHashFields uint32
+
+const (
+	TRUNK_HASH_NONE        TrunkHashFields = 0
+	TRUNK_HASH_SRC_MAC     TrunkHashFields = 1 << 0
+	TRUNK_HASH_DST_MAC     TrunkHashFields = 1 << 1
+	TRUNK_HASH_ETH_TYPE    TrunkHashFields = 1 << 2
+	TRUNK_HASH_VLAN        TrunkHashFields = 1 << 3
+	TRUNK_HASH_SRC_IP      TrunkHashFields = 1 << 4
+	TRUNK_HASH_DST_IP      TrunkHashFields = 1 << 5
+	TRUNK_HASH_IP_PROTO    TrunkHashFields = 1 << 6
+	TRUNK_HASH_L4_SRC_PORT TrunkHashFields = 1 << 7
+	TRUNK_HASH_L4_DST_PORT TrunkHashFields = 1 << 8
+	TRUNK_HASH_SRC_PORT    TrunkHashFields = 1 << 9
+
+	// TRUNK_HASH_L2 is fields of layer2 header.
+	TRUNK_HASH_L2 = TRUNK_HASH_SRC_MAC | TRUNK_HASH_DST_MAC | TRUNK_HASH_ETH_TYPE | TRUNK_HASH_VLAN
+	// TRUNK_HASH_L3 is fields of layer3 header.
+	TRUNK_HASH_L3 = TRUNK_HASH_SRC_IP | TRUNK_HASH_DST_IP | TRUNK_HASH_IP_PROTO
+	// TRUNK_HASH_L4 is fields of layer4 header.
+	TRUNK_HASH_L4 = TRUNK_HASH_L4_SRC_PORT | TRUNK_HASH_L4_DST_PORT
+)
+
+var trunkHashFieldsNames = map[TrunkHashFields]string{
+	TRUNK_HASH_SRC_MAC:     "src_mac",
+	TRUNK_HASH_DST_MAC:     "dst_mac",
+	TRUNK_HASH_ETH_TYPE:    "eth_type",
+	TRUNK_HASH_VLAN:        "vlan",
+	TRUNK_HASH_SRC_IP:      "src_ip",
+	TRUNK_HASH_DST_IP:      "dst_ip",
+	TRUNK_HASH_IP_PROTO:    "ip_proto",
+	TRUNK_HASH_L4_SRC_PORT: "l4_src_port",
+	TRUNK_HASH_L4_DST_PORT: "l4_dst_port",
+	TRUNK_HASH_SRC_PORT:    "src_port",
+}
+
+var trunkHashFieldsValues = map[string]TrunkHashFields{
+	"src_mac":     TRUNK_HASH_SRC_MAC,
+	"dst_mac":     TRUNK_HASH_DST_MAC,
+	"eth_type":    TRUNK_HASH_ETH_TYPE,
+	"vlan":        TRUNK_HASH_VLAN,
+	"src_ip":      TRUNK_HASH_SRC_IP,
+	"dst_ip":      TRUNK_HASH_DST_IP,
+	"ip_proto":    TRUNK_HASH_IP_PROTO,
+	"l4_src_port": TRUNK_HASH_L4_SRC_PORT,
+	"l4_dst_port": TRUNK_HASH_L4_DST_PORT,
+	"src_port":    TRUNK_HASH_SRC_PORT,
+	"l2":          TRUNK_HASH_L2,
+	"l3":          TRUNK_HASH_L3,
+	"l4":          TRUNK_HASH_L4,
+}
+
+func (f TrunkHashFields) String() string {
+	return flagsString(uint32(f), func(bit uint32) (string, bool) {
+		name, ok := trunkHashFieldsNames[TrunkHashFields(bit)]
+		return name, ok
+	})
+}
+
+//
+// ParseTrunkHashFields parses list of field names.
+// "l2", "l3" and "l4" mean all fields of each layer.
+//
+func ParseTrunkHashFields(names []string) (TrunkHashFields, error) {
+	fields := TRUNK_HASH_NONE
+	for _, name := range names {
+		v, ok := trunkHashFieldsValues[name]
+		if !ok {
+			return TRUNK_HASH_NONE, fmt.Errorf("Invalid TrunkHashField. %s", name)
+		}
+		fields |= v
+	}
+	return fields, nil
+}
+
+//
+// TrunkMember is member port of trunk.
+// Disabled member receives packets but is not used to send packets.
+//
+type TrunkMember struct {
+	Port     Port
+	Disabled bool
+}
+
+func (m *TrunkMember) String() string {
+	return fmt.Sprintf("port:%d disabled:%t", m.Port, m.Disabled)
+}
+
+//
+// TrunkInfo is port selection criteria and members of trunk.
+//
+type TrunkInfo struct {
+	Psc     TrunkPsc
+	Members []*TrunkMember
+}
+
+//
+// EnabledPorts returns ports of enabled members.
+//
+func (t *TrunkInfo) EnabledPorts() []Port {
+	ports := []Port{}
+	for _, m := range t.Members {
+		if !m.Disabled {
+			ports = append(ports, m.Port)
+		}
+	}
+	return ports
+}
+
+func (t *TrunkInfo) String() string {
+	return fmt.Sprintf("psc:%s members:%v", t.Psc, t.Members)
+}
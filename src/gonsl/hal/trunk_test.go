@@ -0,0 +1,58 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"testing"
+)
+
+func TestParseTrunkHashFields(t *testing.T) {
+	fields, err := ParseTrunkHashFields([]string{"l3", "l4_dst_port"})
+	if err != nil {
+		t.Fatalf("ParseTrunkHashFields error. %s", err)
+	}
+
+	if fields != TRUNK_HASH_SRC_IP|TRUNK_HASH_DST_IP|TRUNK_HASH_IP_PROTO|TRUNK_HASH_L4_DST_PORT {
+		t.Errorf("ParseTrunkHashFields unmatch. %s", fields)
+	}
+
+	if s := fields.String(); s != "src_ip|dst_ip|ip_proto|l4_dst_port" {
+		t.Errorf("TrunkHashFields.String unmatch. %s", s)
+	}
+}
+
+func TestParseTrunkHashFields_Invalid(t *testing.T) {
+	if _, err := ParseTrunkHashFields([]string{"src_mac", "tcp_flags"}); err == nil {
+		t.Errorf("ParseTrunkHashFields must be error.")
+	}
+}
+
+func TestTrunkInfo_EnabledPorts(t *testing.T) {
+	info := &TrunkInfo{
+		Members: []*TrunkMember{
+			{Port: 1},
+			{Port: 2, Disabled: true},
+			{Port: 3},
+		},
+	}
+
+	ports := info.EnabledPorts()
+	if len(ports) != 2 || ports[0] != 1 || ports[1] != 3 {
+		t.Errorf("EnabledPorts unmatch. %v", ports)
+	}
+}
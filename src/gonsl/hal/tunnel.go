@@ -0,0 +1,106 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// TunnelType is type of ip tunnel.
+//
+type TunnelType int
+
+const (
+	TunnelTypeNone TunnelType = iota
+	TunnelTypeIPIP4encap
+	TunnelTypeIPIP6encap
+	TunnelTypeIPIP4toIP4
+	TunnelTypeIPIP4toIP6
+	TunnelTypeIPIP6toIP4
+	TunnelTypeIPIP6toIP6
+)
+
+var tunnelTypeNames = map[TunnelType]string{
+	TunnelTypeNone:       "None",
+	TunnelTypeIPIP4encap: "IPIP4encap",
+	TunnelTypeIPIP6encap: "IPIP6encap",
+	TunnelTypeIPIP4toIP4: "IPIP4toIP4",
+	TunnelTypeIPIP4toIP6: "IPIP4toIP6",
+	TunnelTypeIPIP6toIP4: "IPIP6toIP4",
+	TunnelTypeIPIP6toIP6: "IPIP6toIP6",
+}
+
+func (v TunnelType) String() string {
+	if s, ok := tunnelTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("TunnelType(%d)", v)
+}
+
+//
+// TunnelInitiator is ip tunnel initiator (encap) bound to l3 interface.
+//
+type TunnelInitiator struct {
+	Flags      uint32
+	TunnelID   uint32
+	Type       TunnelType
+	L3IfaceID  L3IfaceID
+	DstMAC     net.HardwareAddr
+	SrcMAC     net.HardwareAddr
+	DstIP      net.IP
+	SrcIP      net.IP
+	UdpDstPort L4Port
+	UdpSrcPort L4Port
+	TTL        int
+	MTU        int
+	Vlan       Vlan
+}
+
+func (t *TunnelInitiator) String() string {
+	return fmt.Sprintf("type:%s iface:%d vid:%d dst:%s src:%s ttl:%d", t.Type, t.L3IfaceID, t.Vlan, t.DstIP, t.SrcIP, t.TTL)
+}
+
+//
+// TunnelTerminator is ip tunnel terminator (decap).
+//
+type TunnelTerminator struct {
+	Flags      uint32
+	TunnelID   uint32
+	Type       TunnelType
+	RemotePort Port
+	DstIP      *net.IPNet
+	SrcIP      *net.IPNet
+	UdpDstPort L4Port
+	UdpSrcPort L4Port
+	Vlan       Vlan
+	Vrf        Vrf
+	PBmp       *PBmp
+}
+
+//
+// Key returns key of tunnel terminator table.
+//
+func (t *TunnelTerminator) Key() string {
+	return fmt.Sprintf("%s_%s_%s_%d", t.Type, t.DstIP, t.SrcIP, t.Vrf)
+}
+
+func (t *TunnelTerminator) String() string {
+	return fmt.Sprintf("type:%s dst:%s src:%s vid:%d vrf:%d ports:%s", t.Type, t.DstIP, t.SrcIP, t.Vlan, t.Vrf, t.PBmp)
+}
@@ -0,0 +1,155 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+)
+
+//
+// Port is port number of switch.
+//
+type Port int
+
+const (
+	// PORT_ANY is wildcard port.
+	PORT_ANY Port = -1
+)
+
+//
+// Vlan is vlan id.
+//
+type Vlan uint16
+
+const (
+	VLAN_ID_NONE    Vlan = 0
+	VLAN_ID_DEFAULT Vlan = 1
+	VLAN_ID_MAX     Vlan = 4095
+)
+
+//
+// Vrf is vrf id.
+//
+type Vrf uint32
+
+//
+// Trunk is trunk (LAG) id.
+//
+type Trunk int32
+
+//
+// L2StationID is l2 station id.
+//
+type L2StationID int
+
+//
+// L3IfaceID is l3 interface id.
+//
+type L3IfaceID int
+
+//
+// L3EgressID is l3 egress (or egress ecmp) id.
+//
+type L3EgressID int
+
+//
+// L4Port is tcp/udp port number.
+//
+type L4Port uint16
+
+//
+// MplsLabel is mpls label.
+//
+type MplsLabel uint32
+
+//
+// L2Flags is flags of L2Addr.
+//
+type L2Flags uint32
+
+const (
+	L2_NONE     L2Flags = 0
+	L2_STATIC   L2Flags = 1 << 0
+	L2_L3LOOKUP L2Flags = 1 << 1
+)
+
+var l2FlagsNames = map[L2Flags]string{
+	L2_STATIC:   "STATIC",
+	L2_L3LOOKUP: "L3LOOKUP",
+}
+
+func (f L2Flags) String() string {
+	return flagsString(uint32(f), func(bit uint32) (string, bool) {
+		name, ok := l2FlagsNames[L2Flags(bit)]
+		return name, ok
+	})
+}
+
+//
+// L3Flags is flags of L3 objects.
+//
+type L3Flags uint32
+
+const (
+	L3_NONE      L3Flags = 0
+	L3_WITH_ID   L3Flags = 1 << 0
+	L3_REPLACE   L3Flags = 1 << 1
+	L3_IP6       L3Flags = 1 << 2
+	L3_TGID      L3Flags = 1 << 3
+	L3_MULTIPATH L3Flags = 1 << 4
+)
+
+var l3FlagsNames = map[L3Flags]string{
+	L3_WITH_ID:   "WITH_ID",
+	L3_REPLACE:   "REPLACE",
+	L3_IP6:       "IP6",
+	L3_TGID:      "TGID",
+	L3_MULTIPATH: "MULTIPATH",
+}
+
+func (f L3Flags) String() string {
+	return flagsString(uint32(f), func(bit uint32) (string, bool) {
+		name, ok := l3FlagsNames[L3Flags(bit)]
+		return name, ok
+	})
+}
+
+func flagsString(flags uint32, nameOf func(uint32) (string, bool)) string {
+	if flags == 0 {
+		return "NONE"
+	}
+
+	s := ""
+	for bit := uint32(1); bit != 0; bit <<= 1 {
+		if (flags & bit) == 0 {
+			continue
+		}
+
+		if len(s) > 0 {
+			s += "|"
+		}
+
+		if name, ok := nameOf(bit); ok {
+			s += name
+		} else {
+			s += fmt.Sprintf("0x%x", bit)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,81 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslhal
+
+import (
+	"fmt"
+	"net"
+)
+
+//
+// VxlanVpnID is id of vxlan vpn (L2 segment bound to vni).
+//
+type VxlanVpnID uint32
+
+//
+// VxlanPortID is id of vxlan network port (tunnel to remote vtep).
+//
+type VxlanPortID uint32
+
+//
+// VXLAN_UDP_PORT_DEFAULT is IANA assigned udp port of vxlan.
+//
+const VXLAN_UDP_PORT_DEFAULT L4Port = 4789
+
+//
+// VxlanVpn is vxlan vpn.
+// Vlan is access vlan whose members are bridged to vni.
+//
+type VxlanVpn struct {
+	Vni  uint32
+	Vlan Vlan
+}
+
+func (v *VxlanVpn) String() string {
+	return fmt.Sprintf("vni:%d vid:%d", v.Vni, v.Vlan)
+}
+
+//
+// VxlanPort is network port of vxlan vpn.
+// Encapsulated packets are sent via Egress (underlay nexthop).
+//
+type VxlanPort struct {
+	VpnID      VxlanVpnID
+	SrcIP      net.IP
+	DstIP      net.IP
+	UdpDstPort L4Port
+	TTL        int
+	Egress     L3EgressID
+}
+
+func (v *VxlanPort) String() string {
+	return fmt.Sprintf("vpn:%d src:%s dst:%s udp:%d ttl:%d egress:%d", v.VpnID, v.SrcIP, v.DstIP, v.UdpDstPort, v.TTL, v.Egress)
+}
+
+//
+// VxlanL2Addr is l2 address learned via remote vtep.
+//
+type VxlanL2Addr struct {
+	VpnID  VxlanVpnID
+	MAC    net.HardwareAddr
+	PortID VxlanPortID
+}
+
+func (v *VxlanL2Addr) String() string {
+	return fmt.Sprintf("vpn:%d mac:%s port:%d", v.VpnID, v.MAC, v.PortID)
+}
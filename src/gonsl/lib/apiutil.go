@@ -18,15 +18,16 @@
 package gonslib
 
 import (
+	"fmt"
 	api "gonsl/api"
-
-	"github.com/beluganos/go-opennsl/opennsl"
+	hal "gonsl/hal"
+	"strings"
 )
 
 //
 // NewEthTypeFieldEntryAPI returns new instance
 //
-func NewEthTypeFieldEntryAPI(ethType uint16, inPort opennsl.Port) *api.EthTypeFieldEntry {
+func NewEthTypeFieldEntryAPI(ethType uint16, inPort hal.Port) *api.EthTypeFieldEntry {
 	return &api.EthTypeFieldEntry{
 		EthType: uint32(ethType),
 		InPort:  uint32(inPort),
@@ -36,7 +37,7 @@ func NewEthTypeFieldEntryAPI(ethType uint16, inPort opennsl.Port) *api.EthTypeFi
 //
 // NewFieldEntryEthTypeAPI returns new instance
 //
-func NewFieldEntryEthTypeAPI(ethType uint16, inPort opennsl.Port) *api.FieldEntry {
+func NewFieldEntryEthTypeAPI(ethType uint16, inPort hal.Port) *api.FieldEntry {
 	return &api.FieldEntry{
 		EntryType: api.FieldEntry_ETH_TYPE,
 		Entry: &api.FieldEntry_EthType{
@@ -48,7 +49,7 @@ func NewFieldEntryEthTypeAPI(ethType uint16, inPort opennsl.Port) *api.FieldEntr
 //
 // NewDstIPFieldEntryAPI returns new instance
 //
-func NewDstIPFieldEntryAPI(ethType uint16, dstIP string, inPort opennsl.Port) *api.DstIpFieldEntry {
+func NewDstIPFieldEntryAPI(ethType uint16, dstIP string, inPort hal.Port) *api.DstIpFieldEntry {
 	return &api.DstIpFieldEntry{
 		EthType: uint32(ethType),
 		IpDst:   dstIP,
@@ -59,7 +60,7 @@ func NewDstIPFieldEntryAPI(ethType uint16, dstIP string, inPort opennsl.Port) *a
 //
 // NewFieldEntryDstIPAPI returns new instance
 //
-func NewFieldEntryDstIPAPI(ethType uint16, dstIP string, inPort opennsl.Port) *api.FieldEntry {
+func NewFieldEntryDstIPAPI(ethType uint16, dstIP string, inPort hal.Port) *api.FieldEntry {
 	return &api.FieldEntry{
 		EntryType: api.FieldEntry_DST_IP,
 		Entry: &api.FieldEntry_DstIp{
@@ -71,7 +72,7 @@ func NewFieldEntryDstIPAPI(ethType uint16, dstIP string, inPort opennsl.Port) *a
 //
 // NewIPProtoFieldEntryAPI returns new instance
 //
-func NewIPProtoFieldEntryAPI(ethType uint16, ipProto uint8, inPort opennsl.Port) *api.IpProtoFieldEntry {
+func NewIPProtoFieldEntryAPI(ethType uint16, ipProto uint8, inPort hal.Port) *api.IpProtoFieldEntry {
 	return &api.IpProtoFieldEntry{
 		EthType: uint32(ethType),
 		IpProto: uint32(ipProto),
@@ -82,7 +83,7 @@ func NewIPProtoFieldEntryAPI(ethType uint16, ipProto uint8, inPort opennsl.Port)
 //
 // NewFieldEntryIPProtoAPI returns new instance
 //
-func NewFieldEntryIPProtoAPI(ethType uint16, ipProto uint8, inPort opennsl.Port) *api.FieldEntry {
+func NewFieldEntryIPProtoAPI(ethType uint16, ipProto uint8, inPort hal.Port) *api.FieldEntry {
 	return &api.FieldEntry{
 		EntryType: api.FieldEntry_IP_PROTO,
 		Entry: &api.FieldEntry_IpProto{
@@ -94,15 +95,15 @@ func NewFieldEntryIPProtoAPI(ethType uint16, ipProto uint8, inPort opennsl.Port)
 //
 // NewVlanEntryAPI returns new instance
 //
-func NewVlanEntryAPI(vid opennsl.Vlan, pbmp *opennsl.PBmp, upbmp *opennsl.PBmp) *api.VlanEntry {
+func NewVlanEntryAPI(vid hal.Vlan, pbmp *hal.PBmp, upbmp *hal.PBmp) *api.VlanEntry {
 	ports := []uint32{}
-	pbmp.Each(func(port opennsl.Port) error {
+	pbmp.Each(func(port hal.Port) error {
 		ports = append(ports, uint32(port))
 		return nil
 	})
 
 	utPorts := []uint32{}
-	upbmp.Each(func(port opennsl.Port) error {
+	upbmp.Each(func(port hal.Port) error {
 		utPorts = append(utPorts, uint32(port))
 		return nil
 	})
@@ -117,150 +118,330 @@ func NewVlanEntryAPI(vid opennsl.Vlan, pbmp *opennsl.PBmp, upbmp *opennsl.PBmp)
 //
 // NewL2AddrAPI returns new instance.
 //
-func NewL2AddrAPI(l2addr *opennsl.L2Addr) *api.L2Addr {
+func NewL2AddrAPI(l2addr *hal.L2Addr) *api.L2Addr {
 	return &api.L2Addr{
-		Flags: uint32(l2addr.Flags()),
-		Mac:   l2addr.MAC().String(),
-		Vid:   uint32(l2addr.VID()),
-		Port:  uint32(l2addr.Port()),
+		Flags: uint32(l2addr.Flags),
+		Mac:   l2addr.MAC.String(),
+		Vid:   uint32(l2addr.Vlan),
+		Port:  uint32(l2addr.Port),
 	}
 }
 
 //
 // NewL2StationAPI returns new instance.
 //
-func NewL2StationAPI(l2st *opennsl.L2Station) *api.L2Station {
+func NewL2StationAPI(l2st *hal.L2Station) *api.L2Station {
 	return &api.L2Station{
-		Flags:       uint32(l2st.Flags()),
-		DstMac:      l2st.DstMAC().String(),
-		DstMacMask:  l2st.DstMACMask().String(),
-		Vlan:        uint32(l2st.VID()),
-		VlanMask:    uint32(l2st.VIDMask()),
-		SrcPort:     uint32(l2st.SrcPort()),
-		SrcPortMask: uint32(l2st.SrcPortMask()),
+		Flags:       l2st.Flags,
+		DstMac:      l2st.DstMAC.String(),
+		DstMacMask:  l2st.DstMACMask.String(),
+		Vlan:        uint32(l2st.Vlan),
+		VlanMask:    uint32(l2st.VlanMask),
+		SrcPort:     uint32(l2st.SrcPort),
+		SrcPortMask: uint32(l2st.SrcPortMask),
 	}
 }
 
 //
 // NewL3IfaceAPI returns new instance.
 //
-func NewL3IfaceAPI(l3iface *opennsl.L3Iface) *api.L3Iface {
+func NewL3IfaceAPI(l3iface *hal.L3Iface) *api.L3Iface {
 	return &api.L3Iface{
-		Flags:   uint32(l3iface.Flags()),
-		IfaceId: uint32(l3iface.IfaceID()),
-		Mac:     l3iface.MAC().String(),
-		Mtu:     uint32(l3iface.MTU()),
-		MtuFwd:  uint32(l3iface.MTUFwd()),
-		Ttl:     uint32(l3iface.TTL()),
-		Vid:     uint32(l3iface.VID()),
-		Vrf:     uint32(l3iface.VRF()),
+		Flags:   uint32(l3iface.Flags),
+		IfaceId: uint32(l3iface.IfaceID),
+		Mac:     l3iface.MAC.String(),
+		Mtu:     uint32(l3iface.MTU),
+		MtuFwd:  uint32(l3iface.MTUFwd),
+		Ttl:     uint32(l3iface.TTL),
+		Vid:     uint32(l3iface.Vlan),
+		Vrf:     uint32(l3iface.Vrf),
 	}
 }
 
 //
 // NewL3EgressAPI returns new instance.
 //
-func NewL3EgressAPI(l3egrID opennsl.L3EgressID, l3egr *opennsl.L3Egress) *api.L3Egress {
+func NewL3EgressAPI(l3egrID hal.L3EgressID, l3egr *hal.L3Egress) *api.L3Egress {
 	return &api.L3Egress{
-		Flags:    uint32(l3egr.Flags()),
-		Flags2:   uint32(l3egr.Flags2()),
-		EgressId: uint32(l3egrID),
-		IfaceId:  uint32(l3egr.IfaceID()),
-		Mac:      l3egr.MAC().String(),
-		Vid:      uint32(l3egr.VID()),
-		Port:     uint32(l3egr.Port()),
+		Flags:     uint32(l3egr.Flags),
+		Flags2:    l3egr.Flags2,
+		EgressId:  uint32(l3egrID),
+		IfaceId:   uint32(l3egr.IfaceID),
+		Mac:       l3egr.MAC.String(),
+		Vid:       uint32(l3egr.Vlan),
+		Port:      uint32(l3egr.Port),
+		MplsLabel: uint32(l3egr.Label()),
 	}
 }
 
 //
 // NewL3HostAPI returns new instance.
 //
-func NewL3HostAPI(host *opennsl.L3Host) *api.L3Host {
-	return &api.L3Host{
-		Flags:    uint32(host.Flags()),
-		EgressId: uint32(host.EgressID()),
-		IpAddr:   host.IPAddr().String(),
-		Ip6Addr:  host.IP6Addr().String(),
-		Mac:      host.NexthopMAC().String(),
-		Vrf:      uint32(host.VRF()),
+func NewL3HostAPI(host *hal.L3Host) *api.L3Host {
+	h := &api.L3Host{
+		Flags:    uint32(host.Flags),
+		EgressId: uint32(host.EgressID),
+		Mac:      host.NexthopMAC.String(),
+		Vrf:      uint32(host.Vrf),
+	}
+
+	if (host.Flags & hal.L3_IP6) != 0 {
+		h.Ip6Addr = host.IP.String()
+	} else {
+		h.IpAddr = host.IP.String()
 	}
+
+	return h
 }
 
 //
 // NewL3RouteAPI returns new instance.
 //
-func NewL3RouteAPI(route *opennsl.L3Route) *api.L3Route {
-	return &api.L3Route{
-		Flags:    uint32(route.Flags()),
-		EgressId: uint32(route.EgressID()),
-		IpAddr:   route.IP4Net().String(),
-		Ip6Addr:  route.IP6Net().String(),
-		Vrf:      uint32(route.VRF()),
+func NewL3RouteAPI(route *hal.L3Route) *api.L3Route {
+	r := &api.L3Route{
+		Flags:    uint32(route.Flags),
+		EgressId: uint32(route.EgressID),
+		Vrf:      uint32(route.Vrf),
 	}
+
+	if (route.Flags & hal.L3_IP6) != 0 {
+		r.Ip6Addr = route.Dst.String()
+	} else {
+		r.IpAddr = route.Dst.String()
+	}
+
+	return r
 }
 
-func NewTunnelInitiatorAPI(tunnel *opennsl.TunnelInitiator) *api.TunnelInitiator {
+func NewTunnelInitiatorAPI(tunnel *hal.TunnelInitiator) *api.TunnelInitiator {
 	dstIp, srcIp := func() (string, string) {
-		switch tunnel.Type() {
-		case opennsl.TunnelTypeIPIP4encap:
-			return tunnel.DstIP4().String(), tunnel.SrcIP4().String()
-		case opennsl.TunnelTypeIPIP6encap:
-			return tunnel.DstIP6().String(), tunnel.SrcIP6().String()
+		switch tunnel.Type {
+		case hal.TunnelTypeIPIP4encap, hal.TunnelTypeIPIP6encap:
+			return tunnel.DstIP.String(), tunnel.SrcIP.String()
 		default:
-			return tunnel.Type().String(), tunnel.Type().String()
+			return tunnel.Type.String(), tunnel.Type.String()
 		}
 	}()
 
 	return &api.TunnelInitiator{
-		Flags:      uint32(tunnel.Flags()),
-		TunnelId:   uint32(tunnel.TunnelID()),
-		TunnelType: tunnel.Type().String(),
-		L3IfaceId:  uint32(tunnel.L3IfaceID()),
-		DstMac:     tunnel.DstMAC().String(),
-		SrcMac:     tunnel.SrcMAC().String(),
+		Flags:      tunnel.Flags,
+		TunnelId:   tunnel.TunnelID,
+		TunnelType: tunnel.Type.String(),
+		L3IfaceId:  uint32(tunnel.L3IfaceID),
+		DstMac:     tunnel.DstMAC.String(),
+		SrcMac:     tunnel.SrcMAC.String(),
 		DstIp:      dstIp,
 		SrcIp:      srcIp,
-		DstPort:    uint32(tunnel.UdpDstPort()),
-		SrcPort:    uint32(tunnel.UdpSrcPort()),
-		Ttl:        uint32(tunnel.TTL()),
-		Mtu:        uint32(tunnel.MTU()),
-		Vlan:       uint32(tunnel.VID()),
+		DstPort:    uint32(tunnel.UdpDstPort),
+		SrcPort:    uint32(tunnel.UdpSrcPort),
+		Ttl:        uint32(tunnel.TTL),
+		Mtu:        uint32(tunnel.MTU),
+		Vlan:       uint32(tunnel.Vlan),
 	}
 }
 
-func NewTunnelTerminatorAPI(tunnel *opennsl.TunnelTerminator) *api.TunnelTerminator {
+func NewTunnelTerminatorAPI(tunnel *hal.TunnelTerminator) *api.TunnelTerminator {
 	dstIp, srcIp := func() (string, string) {
-		switch tunnel.Type() {
-		case opennsl.TunnelTypeIPIP4toIP4, opennsl.TunnelTypeIPIP4toIP6:
-			return tunnel.DstIPNet4().String(), tunnel.SrcIPNet4().String()
-		case opennsl.TunnelTypeIPIP6toIP4, opennsl.TunnelTypeIPIP6toIP6:
-			return tunnel.DstIPNet6().String(), tunnel.SrcIPNet6().String()
+		switch tunnel.Type {
+		case hal.TunnelTypeIPIP4toIP4, hal.TunnelTypeIPIP4toIP6, hal.TunnelTypeIPIP6toIP4, hal.TunnelTypeIPIP6toIP6:
+			return tunnel.DstIP.String(), tunnel.SrcIP.String()
 		default:
-			return tunnel.Type().String(), tunnel.Type().String()
+			return tunnel.Type.String(), tunnel.Type.String()
 		}
 	}()
 
 	return &api.TunnelTerminator{
-		Flags:      uint32(tunnel.Flags()),
-		TunnelId:   uint32(tunnel.TunnelID()),
-		TunnelType: tunnel.Type().String(),
-		RemotePort: uint32(tunnel.RemotePort()),
+		Flags:      tunnel.Flags,
+		TunnelId:   tunnel.TunnelID,
+		TunnelType: tunnel.Type.String(),
+		RemotePort: uint32(tunnel.RemotePort),
 		DstIp:      dstIp,
 		SrcIp:      srcIp,
-		DstPort:    uint32(tunnel.UdpDstPort()),
-		SrcPort:    uint32(tunnel.UdpSrcPort()),
-		Vlan:       uint32(tunnel.VID()),
-		Vrf:        uint32(tunnel.VRF()),
+		DstPort:    uint32(tunnel.UdpDstPort),
+		SrcPort:    uint32(tunnel.UdpSrcPort),
+		Vlan:       uint32(tunnel.Vlan),
+		Vrf:        uint32(tunnel.Vrf),
+	}
+}
+
+//
+// NewMPLSTunnelInitiatorAPI returns new instance.
+//
+func NewMPLSTunnelInitiatorAPI(ifaceID hal.L3IfaceID, labels []*hal.MplsEgressLabel) *api.MPLSTunnelInitiator {
+	ls := make([]uint32, len(labels))
+	for index, label := range labels {
+		ls[index] = uint32(label.Label)
+	}
+
+	return &api.MPLSTunnelInitiator{
+		L3IfaceId: uint32(ifaceID),
+		Labels:    ls,
+	}
+}
+
+//
+// NewMPLSTunnelSwitchAPI returns new instance.
+//
+func NewMPLSTunnelSwitchAPI(tsw *hal.MplsTunnelSwitch) *api.MPLSTunnelSwitch {
+	return &api.MPLSTunnelSwitch{
+		Flags:       uint32(tsw.Flags),
+		Label:       uint32(tsw.Label),
+		Action:      tsw.Action.String(),
+		Port:        uint32(tsw.Port),
+		Vrf:         uint32(tsw.Vrf),
+		EgressId:    uint32(tsw.EgressIf),
+		EgressLabel: uint32(tsw.EgressLabelValue()),
 	}
 }
 
 //
 // PortInfoAPI
 //
-func NewPortInfoAPI(port opennsl.Port, pinfo *opennsl.PortInfo) *api.PortInfo {
+func NewPortInfoAPI(port hal.Port, pinfo *hal.PortInfo) *api.PortInfo {
+	linkStatus := int32(0)
+	if pinfo.LinkUp {
+		linkStatus = 1
+	}
+
 	return &api.PortInfo{
 		Port:         uint32(port),
-		LinkStatus:   int32(pinfo.LinkStatus()),
-		UntaggedVlan: uint32(pinfo.UntaggedVlan()),
+		LinkStatus:   linkStatus,
+		UntaggedVlan: uint32(pinfo.UntaggedVlan),
+	}
+}
+
+var mirrorDirectionAPIs = map[hal.MirrorPortFlags]api.MirrorSession_Direction{
+	hal.MIRROR_PORT_BOTH:    api.MirrorSession_BOTH,
+	hal.MIRROR_PORT_INGRESS: api.MirrorSession_INGRESS,
+	hal.MIRROR_PORT_EGRESS:  api.MirrorSession_EGRESS,
+}
+
+//
+// NewMirrorSessionAPI returns new instance.
+//
+func NewMirrorSessionAPI(m *MirrorSession) *api.MirrorSession {
+	srcPorts := make([]uint32, len(m.SrcPorts))
+	for index, port := range m.SrcPorts {
+		srcPorts[index] = uint32(port)
+	}
+
+	session := &api.MirrorSession{
+		Name:      m.Name,
+		Type:      api.MirrorSession_SPAN,
+		Direction: mirrorDirectionAPIs[m.Flags],
+		SrcPorts:  srcPorts,
+		DstPort:   uint32(m.Dest.Port),
+		DestId:    uint32(m.DestID),
+	}
+
+	if m.Dest.IsTunnel() {
+		session.Type = api.MirrorSession_ERSPAN
+		session.SrcIp = m.Dest.SrcIP.String()
+		session.DstIp = m.Dest.DstIP.String()
+		session.SrcMac = m.Dest.SrcMAC.String()
+		session.DstMac = m.Dest.DstMAC.String()
+		session.Vlan = uint32(m.Dest.Vlan)
+		session.Ttl = uint32(m.Dest.TTL)
+		session.Tos = uint32(m.Dest.TOS)
+	}
+
+	return session
+}
+
+//
+// NewMirrorSessionFromAPI returns new instance.
+//
+func NewMirrorSessionFromAPI(session *api.MirrorSession) (*MirrorSession, error) {
+	if session == nil {
+		return nil, fmt.Errorf("Mirror session is empty.")
+	}
+
+	srcPorts := make([]int, len(session.SrcPorts))
+	for index, port := range session.SrcPorts {
+		srcPorts[index] = int(port)
+	}
+
+	cfg := &MirrorSessionConfig{
+		Name:      session.Name,
+		Type:      strings.ToLower(session.Type.String()),
+		Direction: strings.ToLower(session.Direction.String()),
+		SrcPorts:  srcPorts,
+		DstPort:   int(session.DstPort),
+	}
+
+	if session.Type == api.MirrorSession_ERSPAN {
+		cfg.SrcIP = session.SrcIp
+		cfg.DstIP = session.DstIp
+		cfg.SrcMAC = session.SrcMac
+		cfg.DstMAC = session.DstMac
+		cfg.Vid = uint16(session.Vlan)
+		cfg.TTL = uint8(session.Ttl)
+		cfg.TOS = uint8(session.Tos)
+	}
+
+	return NewMirrorSessionFromConfig(cfg)
+}
+
+//
+// NewCoppClassStatsAPI returns new instance.
+//
+func NewCoppClassStatsAPI(s *CoppClassStats) *api.CoppClassStats {
+	return &api.CoppClassStats{
+		Name:          s.Name,
+		Queue:         s.Queue,
+		Pps:           s.Pps,
+		Burst:         s.Burst,
+		AcceptPackets: s.AcceptPkts,
+		DropPackets:   s.DropPkts,
+	}
+}
+
+//
+// NewTrunkMemberAPI returns new instance.
+//
+func NewTrunkMemberAPI(m *LagMemberStats) *api.TrunkMember {
+	member := &api.TrunkMember{
+		Port:            uint32(m.Port),
+		Enabled:         m.Enabled,
+		InOctets:        m.Stats["ifHCInOctets"],
+		InUcastPackets:  m.Stats["ifHCInUcastPkts"],
+		OutOctets:       m.Stats["ifHCOutOctets"],
+		OutUcastPackets: m.Stats["ifHCOutUcastPkts"],
 	}
+
+	if a := m.Attrs; a != nil {
+		member.LinkUp = a.LinkUp
+		member.Active = a.Active
+		member.Collecting = a.Collecting
+		member.Distributing = a.Distributing
+		member.AggregatorId = a.AggregatorId
+	}
+
+	return member
+}
+
+//
+// NewTrunkAPI returns new instance.
+//
+func NewTrunkAPI(s *LagStats) *api.Trunk {
+	trunk := &api.Trunk{
+		TrunkId: uint32(s.Lag.Trunk),
+		PortId:  s.Lag.PortID,
+		VlanVid: uint32(s.Lag.Vid),
+		Psc:     s.Psc.String(),
+		Members: make([]*api.TrunkMember, len(s.Members)),
+	}
+
+	if a := s.Lag.Attrs; a != nil {
+		trunk.Mode = a.Mode.String()
+		trunk.HashPolicy = a.HashPolicy.String()
+		trunk.MinLinks = a.MinLinks
+		trunk.AggregatorId = a.AggregatorId
+	}
+
+	for index, m := range s.Members {
+		trunk.Members[index] = NewTrunkMemberAPI(m)
+	}
+
+	return trunk
 }
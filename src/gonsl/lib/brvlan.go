@@ -19,60 +19,21 @@ package gonslib
 
 import (
 	"fmt"
+	hal "gonsl/hal"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
-func SetPortVlanTranslation(unit int, port opennsl.Port, enable int) error {
-	log.Infof("Set vlan translation. port=%d, enable=%d", port, enable)
-
-	ctrls := []opennsl.VlanControlPort{
-		opennsl.VlanTranslateIngressEnable,
-		opennsl.VlanTranslateIngressMissDrop,
-		opennsl.VlanTranslateEgressEnable,
-		opennsl.VlanTranslateEgressMissDrop,
-	}
-
-	// Enable VLAN translations for both ingress and egress.
-	for _, ctrl := range ctrls {
-		if err := ctrl.Set(unit, port, enable); err != nil {
-			log.Errorf("VlanTranslate%s  error. port=%d enable=%d %s", ctrl, port, enable, err)
-			return err
-		}
-
-		log.Debugf("VlanTranslate%s ok. port=%d enable=%d", ctrl, port, enable)
-	}
-
-	if enable == opennsl.TRUE {
-		// Set up port's double tagging mode.
-		if err := port.DtagModeSet(unit, opennsl.PORT_DTAG_MODE_INTERNAL); err != nil {
-			log.Errorf("PortDtagModeSet port=%d mode=%s error. %s", port, opennsl.PORT_DTAG_MODE_INTERNAL, err)
-			return err
-		}
-
-		log.Debugf("DtagModeSet ok port=%d, mode=%s", port, opennsl.PORT_DTAG_MODE_INTERNAL)
-	}
-
-	return nil
-}
-
-func SetNativeVlan(unit int, vid opennsl.Vlan, pbmp *opennsl.PBmp, strictlyUntagged bool) error {
+func SetNativeVlan(h hal.HAL, vid hal.Vlan, pbmp *hal.PBmp, strictlyUntagged bool) error {
 	log.Debugf("Set native vlan. vid=%d pbmp=%s strict=%t", vid, pbmp, strictlyUntagged)
 
-	defaultVid, err := opennsl.VlanDefaultGet(unit)
-	if err != nil {
-		log.Errorf("SetNativeVlan VlanDefaultGet error. unit=%d %s", unit, err)
-		return err
-	}
-
-	if defaultVid == vid {
+	if defaultVid := h.VlanDefault(); defaultVid == vid {
 		log.Debugf("SetNativeVlan skip. vid=%d", vid)
 		return nil
 	}
 
-	return pbmp.Each(func(port opennsl.Port) error {
-		if err := port.UntaggedVlanSet(unit, vid); err != nil {
+	return pbmp.Each(func(port hal.Port) error {
+		if err := h.PortUntaggedVlanSet(port, vid); err != nil {
 			log.Errorf("SetNativeVlan PortUntaggedVlanSet error. port=%d vid=%d %s", port, vid, err)
 			// Don't exit.  Keep setting the other ports.
 		}
@@ -85,28 +46,23 @@ func SetNativeVlan(unit int, vid opennsl.Vlan, pbmp *opennsl.PBmp, strictlyUntag
 			// the internal VID to be accepted.  In other words, if a port
 			// is strictly untagged, only untagged frame will be allowed.
 			log.Debugf("SetNativeVlan with strictry untagged enabld. port=%d", port)
-			return SetPortVlanTranslation(unit, port, opennsl.TRUE)
+			return h.PortVlanTranslationSet(port, true)
 		}
 
 		return nil
 	})
 }
 
-func ClearNativeVlan(unit int, vid opennsl.Vlan, pbmp *opennsl.PBmp, strictlyUntagged bool) {
+func ClearNativeVlan(h hal.HAL, vid hal.Vlan, pbmp *hal.PBmp, strictlyUntagged bool) {
 	// Get the switch's default vid.
-	defaultVid, err := opennsl.VlanDefaultGet(unit)
-	if err != nil {
-		log.Errorf("ClearNativeVlan DefaultGet error. unit=%d %s", unit, err)
-		return
-	}
-
+	defaultVid := h.VlanDefault()
 	if defaultVid == vid {
 		log.Debugf("ClearNativeVlan skip. vid=%d", vid)
 		return
 	}
 
-	pbmp.Each(func(port opennsl.Port) error {
-		if err := port.UntaggedVlanSet(unit, defaultVid); err != nil {
+	pbmp.Each(func(port hal.Port) error {
+		if err := h.PortUntaggedVlanSet(port, defaultVid); err != nil {
 			log.Errorf("ClearNativeVlan PortUntaggedVlanSet error. port=%d vid=%d %s", port, defaultVid, err)
 		}
 
@@ -114,17 +70,17 @@ func ClearNativeVlan(unit int, vid opennsl.Vlan, pbmp *opennsl.PBmp, strictlyUnt
 			// Also clear translation settings if this port
 			// was strictly untagged.
 			log.Debugf("ClearNativeVlan with strictry untagged disabld. port=%d", port)
-			SetPortVlanTranslation(unit, port, opennsl.FALSE)
+			h.PortVlanTranslationSet(port, false)
 		}
 
 		return nil
 	})
 }
 
-func CreateVlan(unit int, vid opennsl.Vlan) error {
+func CreateVlan(h hal.HAL, vid hal.Vlan) error {
 	log.Debugf("Create vlan. vid=%d", vid)
 
-	if _, err := vid.Create(unit); err != nil {
+	if err := h.VlanCreate(vid); err != nil {
 		log.Errorf("Create vlan error. vid=%d %s", vid, err)
 		return err
 	}
@@ -132,38 +88,38 @@ func CreateVlan(unit int, vid opennsl.Vlan) error {
 	return nil
 }
 
-func DestroyVlan(unit int, vid opennsl.Vlan) {
+func DestroyVlan(h hal.HAL, vid hal.Vlan) {
 	log.Debugf("Destroy vlan. vid=%d", vid)
 
-	if err := vid.Destroy(unit); err != nil {
+	if err := h.VlanDestroy(vid); err != nil {
 		log.Errorf("Destroy vlan error. vid=%d %s", vid, err)
 	}
 }
 
-func DeleteL2Addrs(unit int, vid opennsl.Vlan) {
-	if err := opennsl.L2AddrDeleteByVID(unit, vid, opennsl.L2_DELETE_NO_CALLBACKS); err != nil {
+func DeleteL2Addrs(h hal.HAL, vid hal.Vlan) {
+	if err := h.L2AddrDeleteByVlan(vid); err != nil {
 		log.Errorf("Delete l2addrs by vlan %d", vid)
 	}
 }
 
-func DestroyVlanIfEmpty(unit int, vid opennsl.Vlan) {
-	pbmp, _, err := vid.PortGet(unit)
+func DestroyVlanIfEmpty(h hal.HAL, vid hal.Vlan) {
+	pbmp, _, err := h.VlanPortGet(vid)
 	if err != nil {
 		log.Errorf("Delete vlan PortGet error. %d %s", vid, err)
 		return
 	}
 
 	if pbmp.IsNull() {
-		DeleteL2Addrs(unit, vid)
-		DestroyVlan(unit, vid)
+		DeleteL2Addrs(h, vid)
+		DestroyVlan(h, vid)
 	}
 }
 
-func AddPortsToVlan(unit int, allBmp *opennsl.PBmp, untagBmp *opennsl.PBmp, vid opennsl.Vlan, strictlyUntagged bool) error {
+func AddPortsToVlan(h hal.HAL, allBmp *hal.PBmp, untagBmp *hal.PBmp, vid hal.Vlan, strictlyUntagged bool) error {
 
 	if untagBmp.IsNotNull() {
 		// Update default VLAN ID of the ports if untagged.
-		if err := SetNativeVlan(unit, vid, untagBmp, strictlyUntagged); err != nil {
+		if err := SetNativeVlan(h, vid, untagBmp, strictlyUntagged); err != nil {
 			log.Errorf("setNativeVlan error. vid=%d untags=%s %s", vid, untagBmp, err)
 			return err
 		}
@@ -171,7 +127,7 @@ func AddPortsToVlan(unit int, allBmp *opennsl.PBmp, untagBmp *opennsl.PBmp, vid
 
 	if allBmp.IsNotNull() {
 		// Finally, add ports to VLAN.
-		if err := vid.PortAdd(unit, allBmp, untagBmp); err != nil {
+		if err := h.VlanPortAdd(vid, allBmp, untagBmp); err != nil {
 			log.Errorf("VlanPortAdd error. vid=%d ports=%s untags=%s", vid, allBmp, untagBmp)
 			return err
 		}
@@ -180,41 +136,41 @@ func AddPortsToVlan(unit int, allBmp *opennsl.PBmp, untagBmp *opennsl.PBmp, vid
 	return nil
 }
 
-func DelPortsFromVlan(unit int, allBmp *opennsl.PBmp, untagBmp *opennsl.PBmp, vid opennsl.Vlan, strictlyUntagged bool) {
+func DelPortsFromVlan(h hal.HAL, allBmp *hal.PBmp, untagBmp *hal.PBmp, vid hal.Vlan, strictlyUntagged bool) {
 
 	if allBmp.IsNotNull() {
 		// Remove ports from VLAN.
-		if _, err := vid.PortRemove(unit, allBmp); err != nil {
+		if err := h.VlanPortRemove(vid, allBmp); err != nil {
 			log.Errorf("VlanPortRemove error. vid=%d ports=%s", vid, allBmp)
 		}
 	}
 
 	if untagBmp.IsNotNull() {
 		// Update default VLAN ID of the ports if untagged.
-		ClearNativeVlan(unit, vid, untagBmp, strictlyUntagged)
+		ClearNativeVlan(h, vid, untagBmp, strictlyUntagged)
 	}
 
-	DestroyVlanIfEmpty(unit, vid)
+	DestroyVlanIfEmpty(h, vid)
 }
 
-func adjustVlan(unit int, vid opennsl.Vlan) opennsl.Vlan {
-	if vid == opennsl.VLAN_ID_NONE {
-		vid = opennsl.VlanDefaultMustGet(unit)
+func adjustVlan(h hal.HAL, vid hal.Vlan) hal.Vlan {
+	if vid == hal.VLAN_ID_NONE {
+		vid = h.VlanDefault()
 	}
 	return vid
 }
 
 type L3Vlan struct {
-	Vlan opennsl.Vlan
-	Vid  opennsl.Vlan
-	Pbmp *opennsl.PBmp
+	Vlan hal.Vlan
+	Vid  hal.Vlan
+	Pbmp *hal.PBmp
 }
 
-func NewL3Vlan(unit int, vid opennsl.Vlan) *L3Vlan {
+func NewL3Vlan(h hal.HAL, vid hal.Vlan) *L3Vlan {
 	return &L3Vlan{
-		Vlan: adjustVlan(unit, 0),
-		Vid:  adjustVlan(unit, vid),
-		Pbmp: opennsl.NewPBmp(),
+		Vlan: adjustVlan(h, 0),
+		Vid:  adjustVlan(h, vid),
+		Pbmp: hal.NewPBmp(),
 	}
 }
 
@@ -222,40 +178,40 @@ func (b *L3Vlan) String() string {
 	return fmt.Sprintf("vlan:%d vid:%d ports:%s", b.Vlan, b.Vid, b.Pbmp)
 }
 
-func (b *L3Vlan) Create(unit int) error {
-	if err := CreateVlan(unit, b.Vlan); err != nil {
+func (b *L3Vlan) Create(h hal.HAL) error {
+	if err := CreateVlan(h, b.Vlan); err != nil {
 		return err
 	}
 
-	ubmp := func() *opennsl.PBmp {
-		if b.Vid == opennsl.VlanDefaultMustGet(unit) {
+	ubmp := func() *hal.PBmp {
+		if b.Vid == h.VlanDefault() {
 			return b.Pbmp
 		}
-		return opennsl.NewPBmp()
+		return hal.NewPBmp()
 	}()
 
-	return b.Vlan.PortAdd(unit, b.Pbmp, ubmp)
+	return h.VlanPortAdd(b.Vlan, b.Pbmp, ubmp)
 }
 
-func (b *L3Vlan) Delete(unit int) error {
-	_, err := b.Vlan.PortRemove(unit, b.Pbmp)
-	DestroyVlan(unit, b.Vlan)
+func (b *L3Vlan) Delete(h hal.HAL) error {
+	err := h.VlanPortRemove(b.Vlan, b.Pbmp)
+	DestroyVlan(h, b.Vlan)
 
 	return err
 }
 
 type BrVlan struct {
-	Vid              opennsl.Vlan
-	Pbmp             *opennsl.PBmp
-	UntagBmp         *opennsl.PBmp
+	Vid              hal.Vlan
+	Pbmp             *hal.PBmp
+	UntagBmp         *hal.PBmp
 	StrictlyUntagged bool
 }
 
-func NewBrVlan(unit int, vid opennsl.Vlan) *BrVlan {
+func NewBrVlan(h hal.HAL, vid hal.Vlan) *BrVlan {
 	return &BrVlan{
-		Vid:      adjustVlan(unit, vid),
-		Pbmp:     opennsl.NewPBmp(),
-		UntagBmp: opennsl.NewPBmp(),
+		Vid:      adjustVlan(h, vid),
+		Pbmp:     hal.NewPBmp(),
+		UntagBmp: hal.NewPBmp(),
 	}
 }
 
@@ -263,14 +219,14 @@ func (b *BrVlan) String() string {
 	return fmt.Sprintf("vid:%d strict:%t %s/%s", b.Vid, b.StrictlyUntagged, b.Pbmp, b.UntagBmp)
 }
 
-func (b *BrVlan) Create(unit int) error {
-	if err := CreateVlan(unit, b.Vid); err != nil {
+func (b *BrVlan) Create(h hal.HAL) error {
+	if err := CreateVlan(h, b.Vid); err != nil {
 		return err
 	}
 
-	return AddPortsToVlan(unit, b.Pbmp, b.UntagBmp, b.Vid, b.StrictlyUntagged)
+	return AddPortsToVlan(h, b.Pbmp, b.UntagBmp, b.Vid, b.StrictlyUntagged)
 }
 
-func (b *BrVlan) Delete(unit int) {
-	DelPortsFromVlan(unit, b.Pbmp, b.UntagBmp, b.Vid, b.StrictlyUntagged)
+func (b *BrVlan) Delete(h hal.HAL) {
+	DelPortsFromVlan(h, b.Pbmp, b.UntagBmp, b.Vid, b.StrictlyUntagged)
 }
@@ -18,7 +18,10 @@
 package gonslib
 
 import (
+	ffgrpc "fabricflow/util/grpc"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -30,6 +33,31 @@ const (
 	configDefaultBaseVid uint16 = 1
 )
 
+const (
+	// BackendOpenNSL is hal backend using OpenNSL.
+	BackendOpenNSL = "opennsl"
+	// BackendSim is hal backend simulating ASIC in memory.
+	BackendSim = "sim"
+
+	configDefaultBackend = BackendOpenNSL
+)
+
+const (
+	configDefaultQosTrust = "dscp"
+	configDefaultQosSched = "strict"
+)
+
+const (
+	configDefaultSFlowCollectorPort uint16 = 6343
+	configDefaultSFlowPollingSec    uint32 = 20
+	configDefaultSFlowHeaderSize    uint32 = 128
+)
+
+const (
+	configDefaultMirrorType = "span"
+	configDefaultMirrorDir  = "both"
+)
+
 //
 // ONSLConfig is opennsl config file.
 //
@@ -41,6 +69,20 @@ type ONSLConfig struct {
 	WbConfig   string `mapstructure:"wb_fname"`
 }
 
+//
+// SimConfig is config of simulated backend.
+// ports are used if set, otherwise min to max.
+//
+type SimConfig struct {
+	Ports []int `mapstructure:"ports"`
+	Min   int   `mapstructure:"min"`
+	Max   int   `mapstructure:"max"`
+}
+
+func (c *SimConfig) String() string {
+	return fmt.Sprintf("ports:%v min:%d max:%d", c.Ports, c.Min, c.Max)
+}
+
 //
 // BlockBcastRangeConfig is port entry of BlockBcastConfig.
 //
@@ -107,18 +149,305 @@ func (c *L2SWConfig) String() string {
 	return fmt.Sprintf("aging: %d sweep:%d limit:%d", c.AgingSec, c.SweepSec, c.NotifyLimit)
 }
 
+//
+// QosClassConfig maps dscp and pcp values to traffic class (egress queue).
+//
+type QosClassConfig struct {
+	TC   int   `mapstructure:"tc"`
+	Dscp []int `mapstructure:"dscp"`
+	Pcp  []int `mapstructure:"pcp"`
+}
+
+func (c *QosClassConfig) String() string {
+	return fmt.Sprintf("tc:%d dscp:%v pcp:%v", c.TC, c.Dscp, c.Pcp)
+}
+
+//
+// QosQueueConfig is scheduling weight and shaping rate of traffic class.
+// Zero rate means unlimited.
+//
+type QosQueueConfig struct {
+	TC      int    `mapstructure:"tc"`
+	Weight  int    `mapstructure:"weight"`
+	MinKbps uint32 `mapstructure:"min_kbps"`
+	MaxKbps uint32 `mapstructure:"max_kbps"`
+}
+
+func (c *QosQueueConfig) String() string {
+	return fmt.Sprintf("tc:%d weight:%d kbps:%d-%d", c.TC, c.Weight, c.MinKbps, c.MaxKbps)
+}
+
+//
+// QosPortConfig is qos settings of ports.
+// All ports are configured if ports is empty.
+//
+type QosPortConfig struct {
+	Ports  []int             `mapstructure:"ports"`
+	Trust  string            `mapstructure:"trust"` // dscp(default), pcp or none.
+	Sched  string            `mapstructure:"sched"` // strict(default), wrr or wdrr.
+	Queues []*QosQueueConfig `mapstructure:"queues"`
+}
+
+func (c *QosPortConfig) String() string {
+	return fmt.Sprintf("ports:%v trust:%s sched:%s queues:%v", c.Ports, c.GetTrust(), c.GetSched(), c.Queues)
+}
+
+//
+// GetTrust returns packet field used to classify.
+//
+func (c *QosPortConfig) GetTrust() string {
+	if len(c.Trust) == 0 {
+		return configDefaultQosTrust
+	}
+	return c.Trust
+}
+
+//
+// GetSched returns scheduling mode.
+//
+func (c *QosPortConfig) GetSched() string {
+	if len(c.Sched) == 0 {
+		return configDefaultQosSched
+	}
+	return c.Sched
+}
+
+//
+// QosConfig is qos config.
+//
+type QosConfig struct {
+	Classes []*QosClassConfig `mapstructure:"classes"`
+	Ports   []*QosPortConfig  `mapstructure:"ports"`
+}
+
+func (c *QosConfig) String() string {
+	return fmt.Sprintf("classes:%v ports:%v", c.Classes, c.Ports)
+}
+
+//
+// MirrorSessionConfig is mirror session config.
+// src_ip, dst_ip, src_mac and dst_mac are used by erspan only.
+//
+type MirrorSessionConfig struct {
+	Name      string `mapstructure:"name"`
+	Type      string `mapstructure:"type"`      // span(default) or erspan
+	Direction string `mapstructure:"direction"` // both(default), ingress or egress
+	SrcPorts  []int  `mapstructure:"src_ports"`
+	DstPort   int    `mapstructure:"dst_port"`
+	SrcIP     string `mapstructure:"src_ip"`
+	DstIP     string `mapstructure:"dst_ip"`
+	SrcMAC    string `mapstructure:"src_mac"`
+	DstMAC    string `mapstructure:"dst_mac"`
+	Vid       uint16 `mapstructure:"vid"`
+	TTL       uint8  `mapstructure:"ttl"`
+	TOS       uint8  `mapstructure:"tos"`
+}
+
+func (c *MirrorSessionConfig) String() string {
+	return fmt.Sprintf("name:%s type:%s dir:%s src:%v dst:%d", c.Name, c.GetType(), c.GetDirection(), c.SrcPorts, c.DstPort)
+}
+
+//
+// GetType returns type of mirror session.
+//
+func (c *MirrorSessionConfig) GetType() string {
+	if len(c.Type) == 0 {
+		return configDefaultMirrorType
+	}
+	return c.Type
+}
+
+//
+// GetDirection returns direction of mirrored traffic.
+//
+func (c *MirrorSessionConfig) GetDirection() string {
+	if len(c.Direction) == 0 {
+		return configDefaultMirrorDir
+	}
+	return c.Direction
+}
+
+//
+// SFlowPortConfig is sampling rates of ports.
+// All ports are configured if ports is empty. Zero rate disables sampling.
+//
+type SFlowPortConfig struct {
+	Ports   []int  `mapstructure:"ports"`
+	Ingress uint32 `mapstructure:"ingress"`
+	Egress  uint32 `mapstructure:"egress"`
+}
+
+func (c *SFlowPortConfig) String() string {
+	return fmt.Sprintf("ports:%v ingress:%d egress:%d", c.Ports, c.Ingress, c.Egress)
+}
+
+//
+// SFlowConfig is sflow agent config.
+//
+type SFlowConfig struct {
+	Agent      string             `mapstructure:"agent"` // agent address (ipv4 or ipv6)
+	SubAgentID uint32             `mapstructure:"sub_agent_id"`
+	Collectors []string           `mapstructure:"collectors"` // host[:port] of collectors.
+	PollingSec uint32             `mapstructure:"polling_sec"`
+	HeaderSize uint32             `mapstructure:"header_size"`
+	Ports      []*SFlowPortConfig `mapstructure:"ports"`
+}
+
+func (c *SFlowConfig) String() string {
+	return fmt.Sprintf("agent:%s collectors:%v polling:%ds header:%d ports:%v",
+		c.Agent, c.GetCollectors(), c.GetPollingSec(), c.GetHeaderSize(), c.Ports)
+}
+
+//
+// Enabled returns true if any collectors are configured.
+//
+func (c *SFlowConfig) Enabled() bool {
+	return len(c.Collectors) != 0
+}
+
+//
+// GetCollectors returns host:port of collectors.
+//
+func (c *SFlowConfig) GetCollectors() []string {
+	collectors := make([]string, len(c.Collectors))
+	for index, collector := range c.Collectors {
+		if _, _, err := net.SplitHostPort(collector); err != nil {
+			collector = net.JoinHostPort(collector, fmt.Sprintf("%d", configDefaultSFlowCollectorPort))
+		}
+		collectors[index] = collector
+	}
+	return collectors
+}
+
+//
+// GetPollingSec returns interval of counter polling.
+//
+func (c *SFlowConfig) GetPollingSec() uint32 {
+	if c.PollingSec == 0 {
+		return configDefaultSFlowPollingSec
+	}
+	return c.PollingSec
+}
+
+//
+// GetHeaderSize returns max bytes of packet header in flow samples.
+//
+func (c *SFlowConfig) GetHeaderSize() uint32 {
+	if c.HeaderSize == 0 {
+		return configDefaultSFlowHeaderSize
+	}
+	return c.HeaderSize
+}
+
+//
+// CoppMatchConfig is match of copp class.
+// Zero value of each field is not matched.
+// IPv4/IPv6 matches are limited to packets to local address.
+//
+type CoppMatchConfig struct {
+	EthType   uint16 `mapstructure:"eth_type"`
+	IPProto   uint8  `mapstructure:"ip_proto"`
+	L4SrcPort uint16 `mapstructure:"l4_src_port"`
+	L4DstPort uint16 `mapstructure:"l4_dst_port"`
+}
+
+func (c *CoppMatchConfig) String() string {
+	return fmt.Sprintf("eth_type:%04x proto:%d sport:%d dport:%d", c.EthType, c.IPProto, c.L4SrcPort, c.L4DstPort)
+}
+
+//
+// CoppClassConfig is policer and cpu queue of punted traffic.
+// Builtin class (arp, nd, icmp, bgp, ospf, ldp, lacp or ttl_exceeded) is used
+// if matches is empty.
+//
+type CoppClassConfig struct {
+	Name    string             `mapstructure:"name"`
+	Queue   uint32             `mapstructure:"queue"` // cpu queue (1-7, default 1).
+	Pps     uint32             `mapstructure:"pps"`
+	Burst   uint32             `mapstructure:"burst"` // packets (default pps).
+	Matches []*CoppMatchConfig `mapstructure:"matches"`
+}
+
+func (c *CoppClassConfig) String() string {
+	return fmt.Sprintf("name:%s queue:%d pps:%d burst:%d matches:%v", c.Name, c.GetQueue(), c.Pps, c.GetBurst(), c.Matches)
+}
+
+//
+// GetQueue returns cpu queue.
+//
+func (c *CoppClassConfig) GetQueue() uint32 {
+	if c.Queue == 0 {
+		return fieldCosDefault
+	}
+	return c.Queue
+}
+
+//
+// GetBurst returns burst size in packets.
+//
+func (c *CoppClassConfig) GetBurst() uint32 {
+	if c.Burst == 0 {
+		return c.Pps
+	}
+	return c.Burst
+}
+
+//
+// CoppConfig is control-plane policing config.
+//
+type CoppConfig struct {
+	Classes []*CoppClassConfig `mapstructure:"classes"`
+}
+
+func (c *CoppConfig) String() string {
+	return fmt.Sprintf("classes:%v", c.Classes)
+}
+
+//
+// LagConfig is hashing config of trunks.
+// If HashFields is set, all trunks select members by these fields,
+// otherwise by xmit_hash_policy of each bond.
+//
+type LagConfig struct {
+	HashFields []string `mapstructure:"hash_fields"`
+}
+
+func (c *LagConfig) String() string {
+	return fmt.Sprintf("hash_fields:%v", c.HashFields)
+}
+
+//
+// FIBCAuthConfig is tls and token settings to connect fibcd.
+//
+type FIBCAuthConfig struct {
+	CAFile     string `mapstructure:"tls_ca"`
+	CertFile   string `mapstructure:"tls_cert"`
+	KeyFile    string `mapstructure:"tls_key"`
+	ServerName string `mapstructure:"tls_server_name"`
+	Token      string `mapstructure:"token"`
+}
+
 //
 // DpConfig is part of gonsl config.
 //
 type DpConfig struct {
-	DpID       uint64           `mapstructure:"dpid"`
-	Addr       string           `mapstructure:"addr"`
-	Port       uint16           `mapstructure:"port"`
-	Unit       int              `mapstructure:"unit"`
-	FIBCType   string           `mapstructure:"fibc_type"`
-	BlockBcast BlockBcastConfig `mapstructure:"block_bcast"`
-	OpenNSL    *ONSLConfig      `mapstructure:"opennsl"`
-	L2SW       L2SWConfig       `mapstructure:"l2sw"`
+	DpID       uint64                 `mapstructure:"dpid"`
+	Addr       string                 `mapstructure:"addr"`
+	Port       uint16                 `mapstructure:"port"`
+	Unit       int                    `mapstructure:"unit"`
+	FIBCType   string                 `mapstructure:"fibc_type"`
+	FIBCAuth   FIBCAuthConfig         `mapstructure:"fibc_auth"`
+	FIBCAddrs  []string               `mapstructure:"fibc_addrs"` // host:port of fibcd (HA).
+	BlockBcast BlockBcastConfig       `mapstructure:"block_bcast"`
+	Backend    string                 `mapstructure:"backend"` // opennsl(default) or sim.
+	OpenNSL    *ONSLConfig            `mapstructure:"opennsl"`
+	Sim        SimConfig              `mapstructure:"sim"`
+	L2SW       L2SWConfig             `mapstructure:"l2sw"`
+	Qos        QosConfig              `mapstructure:"qos"`
+	Mirrors    []*MirrorSessionConfig `mapstructure:"mirrors"`
+	SFlow      SFlowConfig            `mapstructure:"sflow"`
+	Copp       CoppConfig             `mapstructure:"copp"`
+	Lag        LagConfig              `mapstructure:"lag"`
 }
 
 //
@@ -150,8 +479,12 @@ func (c *DpConfig) GetPort() uint16 {
 
 //
 // GetHost returns ipaddr:port
+// or comma separated list of ipaddr:port if fibc_addrs is set.
 //
 func (c *DpConfig) GetHost() string {
+	if len(c.FIBCAddrs) != 0 {
+		return strings.Join(c.FIBCAddrs, ",")
+	}
 	return fmt.Sprintf("%s:%d", c.GetAddr(), c.GetPort())
 }
 
@@ -183,6 +516,16 @@ func (c *DpConfig) GetL2NotifyLimit() uint32 {
 	return c.L2SW.NotifyLimit
 }
 
+//
+// GetBackend returns name of hal backend.
+//
+func (c *DpConfig) GetBackend() string {
+	if len(c.Backend) == 0 {
+		return configDefaultBackend
+	}
+	return c.Backend
+}
+
 //
 //
 //
@@ -194,6 +537,19 @@ func (c *DpConfig) GetFIBCType() string {
 	return c.FIBCType
 }
 
+//
+// GetFIBCAuth returns tls and token settings to connect fibcd.
+//
+func (c *DpConfig) GetFIBCAuth() *ffgrpc.ClientAuth {
+	return &ffgrpc.ClientAuth{
+		CAFile:     c.FIBCAuth.CAFile,
+		CertFile:   c.FIBCAuth.CertFile,
+		KeyFile:    c.FIBCAuth.KeyFile,
+		ServerName: c.FIBCAuth.ServerName,
+		Token:      c.FIBCAuth.Token,
+	}
+}
+
 //
 // LogConfig is logging config.
 //
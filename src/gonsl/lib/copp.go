@@ -0,0 +1,418 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	coppQueueMin = 1 // cpu queue 0 is used by sampled packets.
+	coppQueueMax = hal.COSQ_NUM - 1
+
+	coppIPProtoOSPF = 89
+	coppTtlExceeded = 1
+	coppTtlMask     = 0xfe // ttl 0 and 1
+)
+
+//
+// link-local scope multicast is not routed and always punted to cpu.
+//
+var (
+	coppMcastIPv4 = &net.IPNet{IP: net.IPv4(224, 0, 0, 0).To4(), Mask: net.CIDRMask(24, 32)}
+	coppMcastIPv6 = &net.IPNet{IP: net.ParseIP("ff02::"), Mask: net.CIDRMask(16, 128)}
+)
+
+//
+// CoppMatch is match of copp class.
+// zero value fields are not matched.
+// Local matches packets to local address (to cpu) so that
+// transit packets are not metered by policer of class.
+//
+type CoppMatch struct {
+	EthType   uint16
+	IPProto   uint8
+	L4SrcPort hal.L4Port
+	L4DstPort hal.L4Port
+	Ttl       uint8
+	TtlMask   uint8
+	DstIP     *net.IPNet
+	Local     bool
+}
+
+func (m *CoppMatch) String() string {
+	return fmt.Sprintf("eth_type:%04x proto:%d sport:%d dport:%d ttl:%d/%x dst:%s local:%t",
+		m.EthType, m.IPProto, m.L4SrcPort, m.L4DstPort, m.Ttl, m.TtlMask, m.DstIP, m.Local)
+}
+
+//
+// newCoppL4Matches returns matches of ipv4/ipv6 packets to local address
+// from or to l4 port.
+//
+func newCoppL4Matches(proto uint8, port hal.L4Port) []*CoppMatch {
+	matches := []*CoppMatch{}
+	for _, ethType := range []uint16{unix.ETH_P_IP, unix.ETH_P_IPV6} {
+		matches = append(matches,
+			&CoppMatch{EthType: ethType, IPProto: proto, L4SrcPort: port, Local: true},
+			&CoppMatch{EthType: ethType, IPProto: proto, L4DstPort: port, Local: true},
+		)
+	}
+	return matches
+}
+
+//
+// newCoppMcastMatches returns matches of ipv4/ipv6 packets to local address
+// or link-local scope multicast.
+//
+func newCoppMcastMatches(proto uint8) []*CoppMatch {
+	return []*CoppMatch{
+		{EthType: unix.ETH_P_IP, IPProto: proto, DstIP: coppMcastIPv4},
+		{EthType: unix.ETH_P_IP, IPProto: proto, Local: true},
+		{EthType: unix.ETH_P_IPV6, IPProto: proto, DstIP: coppMcastIPv6},
+		{EthType: unix.ETH_P_IPV6, IPProto: proto, Local: true},
+	}
+}
+
+var coppBuiltinMatches = map[string][]*CoppMatch{
+	"arp": {{EthType: unix.ETH_P_ARP}},
+	"nd": { // icmpv6 including nd.
+		{EthType: unix.ETH_P_IPV6, IPProto: unix.IPPROTO_ICMPV6, DstIP: coppMcastIPv6},
+		{EthType: unix.ETH_P_IPV6, IPProto: unix.IPPROTO_ICMPV6, Local: true},
+	},
+	"icmp": {{EthType: unix.ETH_P_IP, IPProto: unix.IPPROTO_ICMP, Local: true}},
+	"bgp":  newCoppL4Matches(unix.IPPROTO_TCP, 179),
+	"ldp": append(
+		append(newCoppL4Matches(unix.IPPROTO_TCP, 646), newCoppL4Matches(unix.IPPROTO_UDP, 646)...),
+		&CoppMatch{EthType: unix.ETH_P_IP, IPProto: unix.IPPROTO_UDP, L4DstPort: 646, DstIP: coppMcastIPv4}, // hello
+		&CoppMatch{EthType: unix.ETH_P_IPV6, IPProto: unix.IPPROTO_UDP, L4DstPort: 646, DstIP: coppMcastIPv6},
+	),
+	"ospf": newCoppMcastMatches(coppIPProtoOSPF),
+	"lacp": {{EthType: unix.ETH_P_SLOW}},
+	"ttl_exceeded": {
+		{EthType: unix.ETH_P_IP, Ttl: coppTtlExceeded, TtlMask: coppTtlMask},
+		{EthType: unix.ETH_P_IPV6, Ttl: coppTtlExceeded, TtlMask: coppTtlMask},
+	},
+}
+
+//
+// CoppBuiltinClasses returns names of builtin classes.
+//
+func CoppBuiltinClasses() []string {
+	names := make([]string, 0, len(coppBuiltinMatches))
+	for name := range coppBuiltinMatches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//
+// CoppClass is class of punted traffic.
+//
+type CoppClass struct {
+	Name    string
+	Queue   uint32
+	Policer *hal.PolicerConfig
+	Matches []*CoppMatch
+
+	policerID hal.PolicerID
+	entries   []*FieldEntryCopp
+}
+
+//
+// NewCoppClass returns new instance.
+//
+func NewCoppClass(cfg *CoppClassConfig) (*CoppClass, error) {
+	if len(cfg.Name) == 0 {
+		return nil, fmt.Errorf("Invalid copp class. name is empty.")
+	}
+
+	queue := cfg.GetQueue()
+	if queue < coppQueueMin || queue > coppQueueMax {
+		return nil, fmt.Errorf("Invalid copp queue. %s %d", cfg.Name, queue)
+	}
+
+	if cfg.Pps == 0 {
+		return nil, fmt.Errorf("Invalid copp pps. %s %d", cfg.Name, cfg.Pps)
+	}
+
+	matches := []*CoppMatch{}
+	for _, m := range cfg.Matches {
+		matches = append(matches, &CoppMatch{
+			EthType:   m.EthType,
+			IPProto:   m.IPProto,
+			L4SrcPort: hal.L4Port(m.L4SrcPort),
+			L4DstPort: hal.L4Port(m.L4DstPort),
+			Local:     m.EthType == unix.ETH_P_IP || m.EthType == unix.ETH_P_IPV6,
+		})
+	}
+
+	if len(matches) == 0 {
+		builtin, ok := coppBuiltinMatches[cfg.Name]
+		if !ok {
+			return nil, fmt.Errorf("Invalid copp class. %s has no matches.", cfg.Name)
+		}
+		matches = builtin
+	}
+
+	return &CoppClass{
+		Name:  cfg.Name,
+		Queue: queue,
+		Policer: &hal.PolicerConfig{
+			Packets: true,
+			Rate:    cfg.Pps,
+			Burst:   cfg.GetBurst(),
+		},
+		Matches: matches,
+	}, nil
+}
+
+func (c *CoppClass) String() string {
+	return fmt.Sprintf("name:%s queue:%d %s matches:%d", c.Name, c.Queue, c.Policer, len(c.Matches))
+}
+
+//
+// FieldEntries returns field entries of matches.
+//
+func (c *CoppClass) FieldEntries() []*FieldEntryCopp {
+	entries := make([]*FieldEntryCopp, len(c.Matches))
+	for index, m := range c.Matches {
+		entries[index] = &FieldEntryCopp{
+			Class:   c.Name,
+			Index:   index,
+			EthType: m.EthType,
+			IPProto: m.IPProto,
+			TpSrc:   m.L4SrcPort,
+			TpDst:   m.L4DstPort,
+			Ttl:     m.Ttl,
+			TtlMask: m.TtlMask,
+			DstIP:   m.DstIP,
+			Local:   m.Local,
+			Queue:   c.Queue,
+			Policer: c.policerID,
+		}
+	}
+	return entries
+}
+
+//
+// CoppClassStats is counters of copp class.
+// Counters include packets matched but not copied to cpu.
+//
+type CoppClassStats struct {
+	Name       string
+	Queue      uint32
+	Pps        uint32
+	Burst      uint32
+	AcceptPkts uint64
+	DropPkts   uint64
+}
+
+func (s *CoppClassStats) String() string {
+	return fmt.Sprintf("name:%s queue:%d pps:%d burst:%d accept:%d drop:%d",
+		s.Name, s.Queue, s.Pps, s.Burst, s.AcceptPkts, s.DropPkts)
+}
+
+//
+// CoppTable has copp classes installed.
+//
+type CoppTable struct {
+	mutex   sync.Mutex
+	classes []*CoppClass
+}
+
+//
+// NewCoppTable returns new instance.
+//
+func NewCoppTable() *CoppTable {
+	return &CoppTable{
+		classes: []*CoppClass{},
+	}
+}
+
+//
+// Update replaces classes and returns old classes.
+//
+func (t *CoppTable) Update(classes []*CoppClass) []*CoppClass {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	old := t.classes
+	t.classes = classes
+	return old
+}
+
+//
+// List returns classes in order of config.
+//
+func (t *CoppTable) List() []*CoppClass {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return append([]*CoppClass{}, t.classes...)
+}
+
+//
+// Queues returns cpu queues of punted traffic
+// including default queue.
+//
+func (t *CoppTable) Queues() []uint32 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	queueSet := map[uint32]struct{}{fieldCosDefault: {}}
+	for _, c := range t.classes {
+		queueSet[c.Queue] = struct{}{}
+	}
+
+	queues := make([]uint32, 0, len(queueSet))
+	for queue := range queueSet {
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i] < queues[j] })
+
+	return queues
+}
+
+//
+// NewCoppClasses returns classes of copp config.
+//
+func NewCoppClasses(cfg *CoppConfig) ([]*CoppClass, error) {
+	names := map[string]struct{}{}
+	classes := make([]*CoppClass, len(cfg.Classes))
+	for index, ccfg := range cfg.Classes {
+		if _, ok := names[ccfg.Name]; ok {
+			return nil, fmt.Errorf("copp class already exists. %s", ccfg.Name)
+		}
+		names[ccfg.Name] = struct{}{}
+
+		c, err := NewCoppClass(ccfg)
+		if err != nil {
+			return nil, err
+		}
+		classes[index] = c
+	}
+
+	return classes, nil
+}
+
+func (s *Server) coppInstall(c *CoppClass) error {
+	policerID, err := s.hal.PolicerCreate(c.Policer)
+	if err != nil {
+		return fmt.Errorf("PolicerCreate error. %s %s", c.Name, err)
+	}
+
+	c.policerID = policerID
+	c.entries = []*FieldEntryCopp{}
+
+	for _, e := range c.FieldEntries() {
+		if err := s.fields.Copp.AddEntry(e); err != nil {
+			s.coppUninstall(c)
+			return err
+		}
+
+		c.entries = append(c.entries, e)
+	}
+
+	return nil
+}
+
+func (s *Server) coppUninstall(c *CoppClass) {
+	for _, e := range c.entries {
+		s.fields.Copp.DeleteEntry(e)
+	}
+	c.entries = nil
+
+	if err := s.hal.PolicerDestroy(c.policerID); err != nil {
+		s.log.Warnf("Copp: PolicerDestroy error. %s %s", c.Name, err)
+	}
+}
+
+//
+// CoppConfigure installs copp classes and uninstalls old classes.
+// Queues of rx are updated if rx is started.
+//
+func (s *Server) CoppConfigure(cfg *CoppConfig) error {
+	classes, err := NewCoppClasses(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range s.copp.Update([]*CoppClass{}) {
+		s.coppUninstall(c)
+	}
+
+	installed := []*CoppClass{}
+	for _, c := range classes {
+		if err := s.coppInstall(c); err != nil {
+			for _, c := range installed {
+				s.coppUninstall(c)
+			}
+			return err
+		}
+
+		installed = append(installed, c)
+
+		s.log.Debugf("Copp: %s", c)
+	}
+
+	s.copp.Update(installed)
+	s.rxQueues.Update(s.copp.Queues())
+	return nil
+}
+
+//
+// CoppStats returns counters of copp classes.
+//
+func (s *Server) CoppStats() ([]*CoppClassStats, error) {
+	classes := s.copp.List()
+	statsList := make([]*CoppClassStats, len(classes))
+	for index, c := range classes {
+		stats := &CoppClassStats{
+			Name:  c.Name,
+			Queue: c.Queue,
+			Pps:   c.Policer.Rate,
+			Burst: c.Policer.Burst,
+		}
+
+		for _, e := range c.entries {
+			entryID, ok := s.fields.Copp.EntryID(e)
+			if !ok {
+				continue
+			}
+
+			stat, err := s.hal.FieldEntryStatGet(entryID)
+			if err != nil {
+				return nil, err
+			}
+
+			stats.AcceptPkts += stat.GreenPackets
+			stats.DropPkts += stat.RedPackets
+		}
+
+		statsList[index] = stats
+	}
+
+	return statsList, nil
+}
@@ -19,57 +19,44 @@ package gonslib
 
 import (
 	"encoding/hex"
-
-	"github.com/beluganos/go-opennsl/opennsl"
+	hal "gonsl/hal"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func dumpPktDesc(pkt *opennsl.Pkt) {
-	log.Debugf("rx: cos:%d port:%d vid:%d len:%d tot:%d",
-		pkt.Cos(), pkt.RxPort(), pkt.VID(), pkt.PktLen(), pkt.TotLen())
+const (
+	pktDumpMax = 256
+)
+
+func dumpPktDesc(pkt *hal.Packet) {
+	log.Debugf("rx: cos:%d port:%d vid:%d len:%d",
+		pkt.Cos, pkt.RxPort, pkt.Vlan, len(pkt.Data))
 }
 
-func dumpPktDetail(pkt *opennsl.Pkt) {
-	log.Debugf("pkt  : %p len:%d tot:%d", pkt, pkt.PktLen(), pkt.TotLen())
-	log.Debugf("unit : %d", pkt.Unit())
-	log.Debugf("flags: %d", pkt.Flags())
-	log.Debugf("cos  : %d", pkt.Cos())
-	log.Debugf("vid  : %d", pkt.VID())
-	log.Debugf("port : src:%d dst:%d", pkt.SrcPort(), pkt.DstPort())
-	log.Debugf("rx   : port    : %d", pkt.RxPort())
-	log.Debugf("rx   : untagged: %d", pkt.RxUntagged())
-	log.Debugf("rx   : matched : %d", pkt.RxMatched())
-	log.Debugf("rx   : reasons : %d", pkt.RxReasons())
-	log.Debugf("blk  : #%d", pkt.BlkCount())
+func dumpPktDetail(pkt *hal.Packet) {
+	log.Debugf("pkt  : %p len:%d", pkt, len(pkt.Data))
+	log.Debugf("flags: %d", pkt.Flags)
+	log.Debugf("cos  : %d", pkt.Cos)
+	log.Debugf("vid  : %d", pkt.Vlan)
+	log.Debugf("port : src:%d dst:%d", pkt.SrcPort, pkt.DstPort)
+	log.Debugf("rx   : port    : %d", pkt.RxPort)
+	log.Debugf("rx   : untagged: %t", pkt.RxUntagged)
 }
 
-func dumpPktData(pkt *opennsl.Pkt) {
-	total := int(pkt.PktLen())
-	if total > 256 {
-		total = 256
+func dumpPktData(pkt *hal.Packet) {
+	dumpLen := len(pkt.Data)
+	if dumpLen > pktDumpMax {
+		dumpLen = pktDumpMax
 	}
 
-	for index, blk := range pkt.Blks() {
-		dumpLen := func() int {
-			if blkLen := blk.Len(); blkLen < total {
-				return blkLen
-			}
-			return total
-		}()
-
-		total -= dumpLen
-
-		log.Debugf("blk[%d] len=%d", index, dumpLen)
-		b := blk.Data()
-		log.Debugf("\n%s", hex.Dump(b[:dumpLen]))
-	}
+	log.Debugf("data len=%d", dumpLen)
+	log.Debugf("\n%s", hex.Dump(pkt.Data[:dumpLen]))
 }
 
 //
 // dumpRxPkt output packet.
 //
-func (s *Server) dumpRxPkt(pkt *opennsl.Pkt) {
+func (s *Server) dumpRxPkt(pkt *hal.Packet) {
 	if s.LogConfig().RxSilent {
 		return
 	}
@@ -88,7 +75,7 @@ func (s *Server) dumpRxPkt(pkt *opennsl.Pkt) {
 //
 // dumpTxPkt output packet.
 //
-func (s *Server) dumpTxPkt(pkt *opennsl.Pkt) {
+func (s *Server) dumpTxPkt(pkt *hal.Packet) {
 	if s.LogConfig().TxSilent {
 		return
 	}
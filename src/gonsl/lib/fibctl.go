@@ -19,6 +19,7 @@ package gonslib
 
 import (
 	fibcapi "fabricflow/fibc/api"
+	ffgrpc "fabricflow/util/grpc"
 	"fmt"
 )
 
@@ -43,12 +44,12 @@ type FIBController interface {
 	fmt.Stringer
 }
 
-func NewFIBController(fibcType, addr string, dpId uint64) FIBController {
+func NewFIBController(fibcType, addr string, dpId uint64, auth *ffgrpc.ClientAuth) FIBController {
 	switch fibcType {
 	case FIBCTypeTCP:
 		return NewFIBTcpController(addr, dpId)
 
 	default:
-		return NewFIBGrpcController(addr, dpId)
+		return NewFIBGrpcController(addr, dpId, auth)
 	}
 }
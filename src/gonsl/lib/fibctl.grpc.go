@@ -31,6 +31,7 @@ import (
 type FIBGrpcController struct {
 	addr   string
 	dpId   uint64
+	auth   *ffgrpc.ClientAuth
 	conn   *grpc.ClientConn
 	connCh chan bool
 	recvCh chan *fibcapi.DpMonitorReply
@@ -40,10 +41,11 @@ type FIBGrpcController struct {
 	log  *log.Entry
 }
 
-func NewFIBGrpcController(addr string, dpId uint64) *FIBGrpcController {
+func NewFIBGrpcController(addr string, dpId uint64, auth *ffgrpc.ClientAuth) *FIBGrpcController {
 	return &FIBGrpcController{
 		addr:   addr,
 		dpId:   dpId,
+		auth:   auth,
 		connCh: make(chan bool),
 		recvCh: make(chan *fibcapi.DpMonitorReply),
 
@@ -117,7 +119,13 @@ FOR_LOOP:
 }
 
 func (c *FIBGrpcController) Start() error {
-	conn, connCh, err := ffgrpc.NewClientConn(c.addr)
+	opts, err := c.auth.DialOptions()
+	if err != nil {
+		c.log.Errorf("Start: auth error. %s", err)
+		return err
+	}
+
+	conn, connCh, err := ffgrpc.NewClientConn(c.addr, opts...)
 	if err != nil {
 		c.log.Errorf("Start: client create error. %s", err)
 		return err
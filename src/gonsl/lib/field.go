@@ -19,9 +19,9 @@ package gonslib
 
 import (
 	"fmt"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	"golang.org/x/sys/unix"
 
 	log "github.com/sirupsen/logrus"
@@ -32,6 +32,8 @@ const (
 	fieldPortMask    = 0xff
 	fieldEthTypeMask = 0xffff
 	fieldIPProtoMask = 0xff
+	fieldVlanMask    = 0x0fff
+	fieldL4PortMask  = 0xffff
 )
 
 //
@@ -39,8 +41,8 @@ const (
 //
 type FieldEntry interface {
 	key() string
-	setTo(int, uint32, opennsl.FieldEntry)
-	getFrom(int, opennsl.FieldEntry) error
+	toHal(uint32) *hal.FieldEntry
+	fromHal(*hal.FieldEntry) error
 }
 
 const (
@@ -50,11 +52,22 @@ const (
 	fieldPriDstIPv4
 	fieldPriDstIPv6
 	fieldPriIPProto
+	fieldPriSrcIPv4
+	fieldPriSrcIPv6
 	fieldPriLower
 )
 
+const (
+	fieldPriACLIPv4 = iota + 200
+	fieldPriACLIPv6
+)
+
+const (
+	fieldPriCopp = iota + 300
+)
+
 //
-// FieldGroups has opennsl field_groups.
+// FieldGroups has field_groups.
 //
 type FieldGroups struct {
 	EthDst  *FieldGroup
@@ -62,18 +75,28 @@ type FieldGroups struct {
 	DstIPv4 *FieldGroup
 	DstIPv6 *FieldGroup
 	IPProto *FieldGroup
+	SrcIPv4 *FieldGroup
+	SrcIPv6 *FieldGroup
+	ACLIPv4 *FieldGroup
+	ACLIPv6 *FieldGroup
+	Copp    *FieldGroup
 }
 
 //
 // NewFieldGroups returns new instance.
 //
-func NewFieldGroups(unit int) *FieldGroups {
+func NewFieldGroups(h hal.HAL) *FieldGroups {
 	return &FieldGroups{
-		EthDst:  NewFieldGroupEthDst(unit),
-		EthType: NewFieldGroupEthType(unit),
-		DstIPv4: NewFieldGroupDstIPv4(unit),
-		DstIPv6: NewFieldGroupDstIPv6(unit),
-		IPProto: NewFieldGroupIPProto(unit),
+		EthDst:  NewFieldGroupEthDst(h),
+		EthType: NewFieldGroupEthType(h),
+		DstIPv4: NewFieldGroupDstIPv4(h),
+		DstIPv6: NewFieldGroupDstIPv6(h),
+		IPProto: NewFieldGroupIPProto(h),
+		SrcIPv4: NewFieldGroupSrcIPv4(h),
+		SrcIPv6: NewFieldGroupSrcIPv6(h),
+		ACLIPv4: NewFieldGroupACLIPv4(h),
+		ACLIPv6: NewFieldGroupACLIPv6(h),
+		Copp:    NewFieldGroupCopp(h),
 	}
 }
 
@@ -81,41 +104,44 @@ func NewFieldGroups(unit int) *FieldGroups {
 // FieldGroup has cos, field_group and entries.
 //
 type FieldGroup struct {
-	unit    int
+	hal     hal.HAL
 	cos     uint32
-	group   opennsl.FieldGroup
-	entries map[string]opennsl.FieldEntry
+	group   hal.FieldGroupID
+	entries map[string]hal.FieldEntryID
 }
 
 //
 // NewFieldGroup returns new instance.
 //
-func NewFieldGroup(unit int, cos uint32, pri int, qs ...opennsl.FieldQualify) *FieldGroup {
-	qset := opennsl.NewFieldQSet()
-	qset.Add(qs...)
-	group, err := opennsl.FieldGroupCreate(unit, qset, pri)
+func NewFieldGroup(h hal.HAL, cos uint32, pri int, qs ...hal.FieldQualify) *FieldGroup {
+	group, err := h.FieldGroupCreate(pri, qs...)
 	if err != nil {
 		log.Errorf("FieldGroupCreate error. %s", err)
 		return nil
 	}
 
 	return &FieldGroup{
-		unit:    unit,
+		hal:     h,
 		cos:     cos,
 		group:   group,
-		entries: map[string]opennsl.FieldEntry{},
+		entries: map[string]hal.FieldEntryID{},
 	}
 }
 
 //
-// InstallEntry installs field group entry.
+// AddEntry installs field entry.
 //
-func (f *FieldGroup) installEntry(key string, entry opennsl.FieldEntry) error {
+func (f *FieldGroup) AddEntry(e FieldEntry) error {
+	key := e.key()
 	if _, ok := f.entries[key]; ok {
-		return fmt.Errorf("FieldEntry already exist. key='%s'", key)
+		err := fmt.Errorf("FieldEntry already exist. key='%s'", key)
+		log.Errorf("EntryInstall error. %s", err)
+		return err
 	}
 
-	if err := entry.Install(f.unit); err != nil {
+	entry, err := f.hal.FieldEntryCreate(f.group, e.toHal(f.cos))
+	if err != nil {
+		log.Errorf("EntryCreate error. %s", err)
 		return err
 	}
 
@@ -124,9 +150,10 @@ func (f *FieldGroup) installEntry(key string, entry opennsl.FieldEntry) error {
 }
 
 //
-// UninstallEntry uninstalls field group entry.
+// DeleteEntry uninstall field entry.
 //
-func (f *FieldGroup) uninstallEntry(key string) {
+func (f *FieldGroup) DeleteEntry(e FieldEntry) {
+	key := e.key()
 	entry, ok := f.entries[key]
 	if !ok {
 		log.Warnf("FieldEntry not found. key='%s'", key)
@@ -135,60 +162,65 @@ func (f *FieldGroup) uninstallEntry(key string) {
 
 	delete(f.entries, key)
 
-	if err := entry.Destroy(f.unit); err != nil {
+	if err := f.hal.FieldEntryDestroy(entry); err != nil {
 		log.Warnf("FieldEntry remove error. %s", err)
 	}
 }
 
 //
-// AddEntry installs field entry.
+// EntryID returns id of field entry installed.
 //
-func (f *FieldGroup) AddEntry(e FieldEntry) error {
-	entry, err := f.group.EntryCreate(f.unit)
-	if err != nil {
-		log.Errorf("EntryCreate error. %s", err)
-		return err
-	}
+func (f *FieldGroup) EntryID(e FieldEntry) (hal.FieldEntryID, bool) {
+	entry, ok := f.entries[e.key()]
+	return entry, ok
+}
 
-	e.setTo(f.unit, f.cos, entry)
-	if err := f.installEntry(e.key(), entry); err != nil {
-		entry.Destroy(f.unit)
-		log.Errorf("EntryInstall error. %s", err)
+//
+// GetEntry get field entry form H.W.
+//
+func (f *FieldGroup) GetEntry(e FieldEntry, entry hal.FieldEntryID) error {
+	halEntry, err := f.hal.FieldEntryGet(entry)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return e.fromHal(halEntry)
 }
 
 //
-// DeleteEntry uninstall field entry.
+// GetEntries get all field entry from H.W.
 //
-func (f *FieldGroup) DeleteEntry(e FieldEntry) {
-	f.uninstallEntry(e.key())
-}
+func (f *FieldGroup) GetEntries() ([]hal.FieldEntryID, error) {
+	entries := []hal.FieldEntryID{}
+	err := f.hal.FieldEntryTraverse(f.group, func(entry hal.FieldEntryID, e *hal.FieldEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
 
-//
-// GetEntry get field entry form H.W.
-//
-func (f *FieldGroup) GetEntry(e FieldEntry, entry opennsl.FieldEntry) error {
-	return e.getFrom(f.unit, entry)
+	return entries, err
 }
 
 //
-// GetEntries get all field entry from H.W.
+// newCPUFieldEntry returns field entry which copies packets to cpu.
 //
-func (f *FieldGroup) GetEntries() ([]opennsl.FieldEntry, error) {
-	return f.group.EntryMultiGet(f.unit, -1)
+func newCPUFieldEntry(cos uint32, inPort hal.Port) *hal.FieldEntry {
+	entry := &hal.FieldEntry{
+		InPort:     inPort,
+		InPortMask: fieldPortMask,
+	}
+	entry.AddAction(hal.FieldActionCosQCpuNew, cos)
+	entry.AddAction(hal.FieldActionCopyToCpu)
+	return entry
 }
 
 //
 // NewFieldGroupEthDst created new FieldGroup for FieldEntryEthDst.
 //
-func NewFieldGroupEthDst(unit int) *FieldGroup {
+func NewFieldGroupEthDst(h hal.HAL) *FieldGroup {
 	return NewFieldGroup(
-		unit, fieldCosDefault, fieldPriEthDst,
-		opennsl.FieldQualifyDstMac,
-		opennsl.FieldQualifyInPort,
+		h, fieldCosDefault, fieldPriEthDst,
+		hal.FieldQualifyDstMac,
+		hal.FieldQualifyInPort,
 	)
 }
 
@@ -198,12 +230,12 @@ func NewFieldGroupEthDst(unit int) *FieldGroup {
 type FieldEntryEthDst struct {
 	Dest   net.HardwareAddr
 	Mask   net.HardwareAddr
-	InPort opennsl.Port
+	InPort hal.Port
 }
 
 //
 // NewFieldEntryEthDst returns new FieldEntryEthDst.
-func NewFieldEntryEthDst(dest, mask net.HardwareAddr, inPort opennsl.Port) *FieldEntryEthDst {
+func NewFieldEntryEthDst(dest, mask net.HardwareAddr, inPort hal.Port) *FieldEntryEthDst {
 	return &FieldEntryEthDst{
 		Dest:   dest,
 		Mask:   mask,
@@ -222,27 +254,17 @@ func (e *FieldEntryEthDst) String() string {
 	return fmt.Sprintf("%s/%s in_port:%d", e.Dest, e.Mask, e.InPort)
 }
 
-func (e *FieldEntryEthDst) setTo(unit int, cos uint32, entry opennsl.FieldEntry) {
-	entry.Qualify().DstMAC(unit, e.Dest, e.Mask)
-	entry.Qualify().InPort(unit, e.InPort, fieldPortMask)
-	entry.Action().AddP(unit, opennsl.NewFieldActionCosQCpuNew(cos))
-	entry.Action().AddP(unit, opennsl.NewFieldActionCopyToCpu())
+func (e *FieldEntryEthDst) toHal(cos uint32) *hal.FieldEntry {
+	entry := newCPUFieldEntry(cos, e.InPort)
+	entry.DstMAC = e.Dest
+	entry.DstMACMask = e.Mask
+	return entry
 }
 
-func (e *FieldEntryEthDst) getFrom(unit int, entry opennsl.FieldEntry) error {
-	dest, mask, err := entry.Qualify().DstMACGet(unit)
-	if err != nil {
-		return err
-	}
-
-	inPort, _, err := entry.Qualify().InPortGet(unit)
-	if err != nil {
-		return err
-	}
-
-	e.Dest = dest
-	e.Mask = mask
-	e.InPort = inPort
+func (e *FieldEntryEthDst) fromHal(entry *hal.FieldEntry) error {
+	e.Dest = entry.DstMAC
+	e.Mask = entry.DstMACMask
+	e.InPort = entry.InPort
 
 	return nil
 }
@@ -250,11 +272,11 @@ func (e *FieldEntryEthDst) getFrom(unit int, entry opennsl.FieldEntry) error {
 //
 // NewFieldGroupEthType create new FieldGroup for FieldEntryEthType.
 //
-func NewFieldGroupEthType(unit int) *FieldGroup {
+func NewFieldGroupEthType(h hal.HAL) *FieldGroup {
 	return NewFieldGroup(
-		unit, fieldCosDefault, fieldPriEthType,
-		opennsl.FieldQualifyEtherType,
-		opennsl.FieldQualifyInPort,
+		h, fieldCosDefault, fieldPriEthType,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyInPort,
 	)
 }
 
@@ -263,13 +285,13 @@ func NewFieldGroupEthType(unit int) *FieldGroup {
 //
 type FieldEntryEthType struct {
 	EthType uint16
-	InPort  opennsl.Port
+	InPort  hal.Port
 }
 
 //
 // NewFieldEntryEthType returns new FieldEntryEthType.
 //
-func NewFieldEntryEthType(ethType uint16, inPort opennsl.Port) *FieldEntryEthType {
+func NewFieldEntryEthType(ethType uint16, inPort hal.Port) *FieldEntryEthType {
 	return &FieldEntryEthType{
 		EthType: ethType,
 		InPort:  inPort,
@@ -284,26 +306,16 @@ func (e *FieldEntryEthType) String() string {
 	return fmt.Sprintf("%04x in_port:%d", e.EthType, e.InPort)
 }
 
-func (e *FieldEntryEthType) setTo(unit int, cos uint32, entry opennsl.FieldEntry) {
-	entry.Qualify().InPort(unit, e.InPort, fieldPortMask)
-	entry.Qualify().EtherType(unit, opennsl.Ethertype(e.EthType), fieldEthTypeMask)
-	entry.Action().AddP(unit, opennsl.NewFieldActionCosQCpuNew(cos))
-	entry.Action().AddP(unit, opennsl.NewFieldActionCopyToCpu())
+func (e *FieldEntryEthType) toHal(cos uint32) *hal.FieldEntry {
+	entry := newCPUFieldEntry(cos, e.InPort)
+	entry.EthType = e.EthType
+	entry.EthTypeMask = fieldEthTypeMask
+	return entry
 }
 
-func (e *FieldEntryEthType) getFrom(unit int, entry opennsl.FieldEntry) error {
-	ethType, _, err := entry.Qualify().EtherTypeGet(unit)
-	if err != nil {
-		return err
-	}
-
-	inPort, _, err := entry.Qualify().InPortGet(unit)
-	if err != nil {
-		return err
-	}
-
-	e.EthType = uint16(ethType)
-	e.InPort = inPort
+func (e *FieldEntryEthType) fromHal(entry *hal.FieldEntry) error {
+	e.EthType = entry.EthType
+	e.InPort = entry.InPort
 
 	return nil
 }
@@ -311,24 +323,24 @@ func (e *FieldEntryEthType) getFrom(unit int, entry opennsl.FieldEntry) error {
 //
 // NewFieldGroupDstIPv4 returns new FieldGroup for FieldEntryDstIP(v4)
 //
-func NewFieldGroupDstIPv4(unit int) *FieldGroup {
+func NewFieldGroupDstIPv4(h hal.HAL) *FieldGroup {
 	return NewFieldGroup(
-		unit, fieldCosDefault, fieldPriDstIPv4,
-		opennsl.FieldQualifyEtherType,
-		opennsl.FieldQualifyDstIp,
-		opennsl.FieldQualifyInPort,
+		h, fieldCosDefault, fieldPriDstIPv4,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyDstIp,
+		hal.FieldQualifyInPort,
 	)
 }
 
 //
 // NewFieldGroupDstIPv6 returns new FieldGroup for FieldEntryDstIP(v6)
 //
-func NewFieldGroupDstIPv6(unit int) *FieldGroup {
+func NewFieldGroupDstIPv6(h hal.HAL) *FieldGroup {
 	return NewFieldGroup(
-		unit, fieldCosDefault, fieldPriDstIPv6,
-		opennsl.FieldQualifyEtherType,
-		opennsl.FieldQualifyDstIp6,
-		opennsl.FieldQualifyInPort,
+		h, fieldCosDefault, fieldPriDstIPv6,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyDstIp6,
+		hal.FieldQualifyInPort,
 	)
 }
 
@@ -336,17 +348,17 @@ func NewFieldGroupDstIPv6(unit int) *FieldGroup {
 // FieldEntryDstIP is field entry (DstIPv4 or DstIPv6).
 //
 type FieldEntryDstIP struct {
-	EthType opennsl.Ethertype
+	EthType uint16
 	Dest    *net.IPNet
-	InPort  opennsl.Port
+	InPort  hal.Port
 }
 
 //
 // NewFieldEntryDstIP returns new FieldEntryDstIP(IPv4 or IPv6)
 //
-func NewFieldEntryDstIP(dest *net.IPNet, inPort opennsl.Port, ethType uint16) *FieldEntryDstIP {
+func NewFieldEntryDstIP(dest *net.IPNet, inPort hal.Port, ethType uint16) *FieldEntryDstIP {
 	return &FieldEntryDstIP{
-		EthType: opennsl.Ethertype(ethType),
+		EthType: ethType,
 		Dest:    dest,
 		InPort:  inPort,
 	}
@@ -355,14 +367,14 @@ func NewFieldEntryDstIP(dest *net.IPNet, inPort opennsl.Port, ethType uint16) *F
 //
 // NewFieldEntryDstIPv4 returns new FieldEntryDstIP(IPv4)
 //
-func NewFieldEntryDstIPv4(dest *net.IPNet, inPort opennsl.Port) *FieldEntryDstIP {
+func NewFieldEntryDstIPv4(dest *net.IPNet, inPort hal.Port) *FieldEntryDstIP {
 	return NewFieldEntryDstIP(dest, inPort, unix.ETH_P_IP)
 }
 
 //
 // NewFieldEntryDstIPv6 returns new FieldEntryDstIP(IPv6)
 //
-func NewFieldEntryDstIPv6(dest *net.IPNet, inPort opennsl.Port) *FieldEntryDstIP {
+func NewFieldEntryDstIPv6(dest *net.IPNet, inPort hal.Port) *FieldEntryDstIP {
 	return NewFieldEntryDstIP(dest, inPort, unix.ETH_P_IPV6)
 }
 
@@ -374,42 +386,22 @@ func (e *FieldEntryDstIP) String() string {
 	return fmt.Sprintf("%s in_port=%d", e.Dest, e.InPort)
 }
 
-func (e *FieldEntryDstIP) setTo(unit int, cos uint32, entry opennsl.FieldEntry) {
-	entry.Qualify().InPort(unit, e.InPort, fieldPortMask)
-	entry.Qualify().EtherType(unit, e.EthType, fieldEthTypeMask)
-	if e.EthType == unix.ETH_P_IP {
-		entry.Qualify().DstIp(unit, e.Dest.IP, e.Dest.Mask)
-	} else {
-		entry.Qualify().DstIp6(unit, e.Dest.IP, e.Dest.Mask)
-	}
-	entry.Action().AddP(unit, opennsl.NewFieldActionCosQCpuNew(cos))
-	entry.Action().AddP(unit, opennsl.NewFieldActionCopyToCpu())
+func (e *FieldEntryDstIP) toHal(cos uint32) *hal.FieldEntry {
+	entry := newCPUFieldEntry(cos, e.InPort)
+	entry.EthType = e.EthType
+	entry.EthTypeMask = fieldEthTypeMask
+	entry.DstIP = e.Dest
+	return entry
 }
 
-func (e *FieldEntryDstIP) getFrom(unit int, entry opennsl.FieldEntry) error {
-	ethType, _, err := entry.Qualify().EtherTypeGet(unit)
-	if err != nil {
-		return err
+func (e *FieldEntryDstIP) fromHal(entry *hal.FieldEntry) error {
+	if entry.DstIP == nil {
+		return fmt.Errorf("DstIP not qualified")
 	}
 
-	ip, mask, err := func() (net.IP, net.IPMask, error) {
-		if ethType == unix.ETH_P_IPV6 {
-			return entry.Qualify().DstIp6Get(unit)
-		}
-		return entry.Qualify().DstIpGet(unit)
-	}()
-	if err != nil {
-		return err
-	}
-
-	inPort, _, err := entry.Qualify().InPortGet(unit)
-	if err != nil {
-		return err
-	}
-
-	e.EthType = ethType
-	e.Dest = &net.IPNet{IP: ip, Mask: mask}
-	e.InPort = inPort
+	e.EthType = entry.EthType
+	e.Dest = entry.DstIP
+	e.InPort = entry.InPort
 
 	return nil
 }
@@ -417,12 +409,12 @@ func (e *FieldEntryDstIP) getFrom(unit int, entry opennsl.FieldEntry) error {
 //
 // NewFieldGroupIPProto created new FieldGroup for FieldEntryIPProto.
 //
-func NewFieldGroupIPProto(unit int) *FieldGroup {
+func NewFieldGroupIPProto(h hal.HAL) *FieldGroup {
 	return NewFieldGroup(
-		unit, fieldCosDefault, fieldPriIPProto,
-		opennsl.FieldQualifyEtherType,
-		opennsl.FieldQualifyIpProtocol,
-		opennsl.FieldQualifyInPort,
+		h, fieldCosDefault, fieldPriIPProto,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyIpProtocol,
+		hal.FieldQualifyInPort,
 	)
 }
 
@@ -432,13 +424,13 @@ func NewFieldGroupIPProto(unit int) *FieldGroup {
 type FieldEntryIPProto struct {
 	EthType uint16
 	IPProto uint8
-	InPort  opennsl.Port
+	InPort  hal.Port
 }
 
 //
 // NewFieldEntryIPProto returns new FieldEntryIPProto.
 //
-func NewFieldEntryIPProto(ipProto uint8, ethType uint16, inPort opennsl.Port) *FieldEntryIPProto {
+func NewFieldEntryIPProto(ipProto uint8, ethType uint16, inPort hal.Port) *FieldEntryIPProto {
 	return &FieldEntryIPProto{
 		EthType: ethType,
 		IPProto: ipProto,
@@ -454,33 +446,369 @@ func (e *FieldEntryIPProto) String() string {
 	return fmt.Sprintf("%d eth_type:%04x in_port:%d", e.EthType, e.IPProto, e.InPort)
 }
 
-func (e *FieldEntryIPProto) setTo(unit int, cos uint32, entry opennsl.FieldEntry) {
-	entry.Qualify().InPort(unit, e.InPort, fieldPortMask)
-	entry.Qualify().EtherType(unit, opennsl.Ethertype(e.EthType), fieldEthTypeMask)
-	entry.Qualify().IpProtocol(unit, e.IPProto, fieldIPProtoMask)
-	entry.Action().AddP(unit, opennsl.NewFieldActionCosQCpuNew(cos))
-	entry.Action().AddP(unit, opennsl.NewFieldActionCopyToCpu())
+func (e *FieldEntryIPProto) toHal(cos uint32) *hal.FieldEntry {
+	entry := newCPUFieldEntry(cos, e.InPort)
+	entry.EthType = e.EthType
+	entry.EthTypeMask = fieldEthTypeMask
+	entry.IPProto = e.IPProto
+	entry.IPProtoMask = fieldIPProtoMask
+	return entry
 }
 
-func (e *FieldEntryIPProto) getFrom(unit int, entry opennsl.FieldEntry) error {
-	ethType, _, err := entry.Qualify().EtherTypeGet(unit)
-	if err != nil {
-		return err
+func (e *FieldEntryIPProto) fromHal(entry *hal.FieldEntry) error {
+	e.EthType = entry.EthType
+	e.IPProto = entry.IPProto
+	e.InPort = entry.InPort
+
+	return nil
+}
+
+//
+// NewFieldGroupSrcIPv4 returns new FieldGroup for FieldEntrySrcIP(v4)
+//
+func NewFieldGroupSrcIPv4(h hal.HAL) *FieldGroup {
+	return NewFieldGroup(
+		h, fieldCosDefault, fieldPriSrcIPv4,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifySrcIp,
+		hal.FieldQualifyInPort,
+	)
+}
+
+//
+// NewFieldGroupSrcIPv6 returns new FieldGroup for FieldEntrySrcIP(v6)
+//
+func NewFieldGroupSrcIPv6(h hal.HAL) *FieldGroup {
+	return NewFieldGroup(
+		h, fieldCosDefault, fieldPriSrcIPv6,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifySrcIp6,
+		hal.FieldQualifyInPort,
+	)
+}
+
+//
+// FieldEntrySrcIP is field entry (SrcIPv4 or SrcIPv6).
+// It sets VRF of matched packets.
+//
+type FieldEntrySrcIP struct {
+	EthType uint16
+	Src     *net.IPNet
+	InPort  hal.Port
+	Vrf     hal.Vrf
+}
+
+//
+// NewFieldEntrySrcIP returns new FieldEntrySrcIP(IPv4 or IPv6)
+//
+func NewFieldEntrySrcIP(src *net.IPNet, inPort hal.Port, vrf hal.Vrf, ethType uint16) *FieldEntrySrcIP {
+	return &FieldEntrySrcIP{
+		EthType: ethType,
+		Src:     src,
+		InPort:  inPort,
+		Vrf:     vrf,
 	}
+}
 
-	proto, _, err := entry.Qualify().IpProtocolGet(unit)
-	if err != nil {
-		return err
+//
+// NewFieldEntrySrcIPv4 returns new FieldEntrySrcIP(IPv4)
+//
+func NewFieldEntrySrcIPv4(src *net.IPNet, inPort hal.Port, vrf hal.Vrf) *FieldEntrySrcIP {
+	return NewFieldEntrySrcIP(src, inPort, vrf, unix.ETH_P_IP)
+}
+
+//
+// NewFieldEntrySrcIPv6 returns new FieldEntrySrcIP(IPv6)
+//
+func NewFieldEntrySrcIPv6(src *net.IPNet, inPort hal.Port, vrf hal.Vrf) *FieldEntrySrcIP {
+	return NewFieldEntrySrcIP(src, inPort, vrf, unix.ETH_P_IPV6)
+}
+
+func (e *FieldEntrySrcIP) key() string {
+	return fmt.Sprintf("%s_%d", e.Src, e.InPort)
+}
+
+func (e *FieldEntrySrcIP) String() string {
+	return fmt.Sprintf("%s in_port=%d vrf=%d", e.Src, e.InPort, e.Vrf)
+}
+
+func (e *FieldEntrySrcIP) toHal(cos uint32) *hal.FieldEntry {
+	entry := &hal.FieldEntry{
+		InPort:      e.InPort,
+		InPortMask:  fieldPortMask,
+		EthType:     e.EthType,
+		EthTypeMask: fieldEthTypeMask,
+		SrcIP:       e.Src,
 	}
+	entry.AddAction(hal.FieldActionVrfSet, uint32(e.Vrf))
+	return entry
+}
 
-	inPort, _, err := entry.Qualify().InPortGet(unit)
-	if err != nil {
-		return err
+func (e *FieldEntrySrcIP) fromHal(entry *hal.FieldEntry) error {
+	if entry.SrcIP == nil {
+		return fmt.Errorf("SrcIP not qualified")
+	}
+
+	e.EthType = entry.EthType
+	e.Src = entry.SrcIP
+	e.InPort = entry.InPort
+
+	return nil
+}
+
+//
+// NewFieldGroupACLIPv4 returns new FieldGroup for FieldEntryACL(v4)
+//
+func NewFieldGroupACLIPv4(h hal.HAL) *FieldGroup {
+	return NewFieldGroup(
+		h, fieldCosDefault, fieldPriACLIPv4,
+		hal.FieldQualifyInPort,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyOuterVlanId,
+		hal.FieldQualifySrcIp,
+		hal.FieldQualifyDstIp,
+		hal.FieldQualifyIpProtocol,
+		hal.FieldQualifyDSCP,
+		hal.FieldQualifyL4SrcPort,
+		hal.FieldQualifyL4DstPort,
+	)
+}
+
+//
+// NewFieldGroupACLIPv6 returns new FieldGroup for FieldEntryACL(v6)
+//
+func NewFieldGroupACLIPv6(h hal.HAL) *FieldGroup {
+	return NewFieldGroup(
+		h, fieldCosDefault, fieldPriACLIPv6,
+		hal.FieldQualifyInPort,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyOuterVlanId,
+		hal.FieldQualifySrcIp6,
+		hal.FieldQualifyDstIp6,
+		hal.FieldQualifyIpProtocol,
+		hal.FieldQualifyDSCP,
+		hal.FieldQualifyL4SrcPort,
+		hal.FieldQualifyL4DstPort,
+	)
+}
+
+//
+// FieldEntryACLAction is action of FieldEntryACL.
+//
+type FieldEntryACLAction struct {
+	Drop       bool
+	MirrorPort hal.Port // 0: not mirror
+	Queue      int      // < 0: not set
+	Dscp       int      // < 0: not set
+}
+
+//
+// NewFieldEntryACLAction returns new FieldEntryACLAction (permit).
+//
+func NewFieldEntryACLAction() *FieldEntryACLAction {
+	return &FieldEntryACLAction{
+		Queue: -1,
+		Dscp:  -1,
 	}
+}
+
+func (a *FieldEntryACLAction) String() string {
+	return fmt.Sprintf("drop:%t mirror:%d queue:%d dscp:%d", a.Drop, a.MirrorPort, a.Queue, a.Dscp)
+}
+
+//
+// FieldEntryACL is field entry (policy acl rule).
+// zero value fields are not qualified.
+//
+type FieldEntryACL struct {
+	EthType   uint16
+	InPort    hal.Port
+	VlanVid   hal.Vlan
+	Src       *net.IPNet
+	Dst       *net.IPNet
+	IPProto   uint8
+	Dscp      uint8
+	DscpMask  uint8
+	TpSrc     hal.L4Port
+	TpSrcMask hal.L4Port
+	TpDst     hal.L4Port
+	TpDstMask hal.L4Port
+	Priority  int
+	Action    *FieldEntryACLAction
+}
+
+//
+// NewFieldEntryACL returns new FieldEntryACL.
+//
+func NewFieldEntryACL(ethType uint16, inPort hal.Port, priority int) *FieldEntryACL {
+	return &FieldEntryACL{
+		EthType:  ethType,
+		InPort:   inPort,
+		Priority: priority,
+		Action:   NewFieldEntryACLAction(),
+	}
+}
+
+func (e *FieldEntryACL) key() string {
+	return fmt.Sprintf("%d_%d_%d_%s_%s_%d_%d/%d_%d/%d_%d/%d_%d",
+		e.EthType, e.InPort, e.VlanVid, e.Src, e.Dst, e.IPProto,
+		e.Dscp, e.DscpMask, e.TpSrc, e.TpSrcMask, e.TpDst, e.TpDstMask, e.Priority)
+}
+
+func (e *FieldEntryACL) String() string {
+	return fmt.Sprintf("eth_type:%04x in_port:%d vid:%d src:%s dst:%s proto:%d dscp:%d/%x sport:%d/%x dport:%d/%x prio:%d %s",
+		e.EthType, e.InPort, e.VlanVid, e.Src, e.Dst, e.IPProto,
+		e.Dscp, e.DscpMask, e.TpSrc, e.TpSrcMask, e.TpDst, e.TpDstMask, e.Priority, e.Action)
+}
+
+func (e *FieldEntryACL) toHal(cos uint32) *hal.FieldEntry {
+	entry := &hal.FieldEntry{
+		Priority:      e.Priority,
+		EthType:       e.EthType,
+		EthTypeMask:   fieldEthTypeMask,
+		Dscp:          e.Dscp,
+		DscpMask:      e.DscpMask,
+		L4SrcPort:     e.TpSrc,
+		L4SrcPortMask: e.TpSrcMask,
+		L4DstPort:     e.TpDst,
+		L4DstPortMask: e.TpDstMask,
+	}
+
+	if e.InPort != 0 {
+		entry.InPort = e.InPort
+		entry.InPortMask = fieldPortMask
+	}
+	if e.VlanVid != 0 {
+		entry.OuterVlan = e.VlanVid
+		entry.OuterVlanMask = fieldVlanMask
+	}
+	if e.Src != nil {
+		entry.SrcIP = e.Src
+	}
+	if e.Dst != nil {
+		entry.DstIP = e.Dst
+	}
+	if e.IPProto != 0 {
+		entry.IPProto = e.IPProto
+		entry.IPProtoMask = fieldIPProtoMask
+	}
+
+	if e.Action.Drop {
+		entry.AddAction(hal.FieldActionDrop)
+	}
+	if e.Action.MirrorPort != 0 {
+		entry.AddAction(hal.FieldActionMirrorIngress, uint32(e.Action.MirrorPort))
+	}
+	if e.Action.Queue >= 0 {
+		entry.AddAction(hal.FieldActionCosQNew, uint32(e.Action.Queue))
+	}
+	if e.Action.Dscp >= 0 {
+		entry.AddAction(hal.FieldActionDscpNew, uint32(e.Action.Dscp))
+	}
+
+	return entry
+}
+
+func (e *FieldEntryACL) fromHal(entry *hal.FieldEntry) error {
+	e.EthType = entry.EthType
+	e.InPort = entry.InPort
+
+	return nil
+}
+
+//
+// NewFieldGroupCopp returns new FieldGroup for FieldEntryCopp.
+// It has the highest priority to override cpu queue of other groups.
+//
+func NewFieldGroupCopp(h hal.HAL) *FieldGroup {
+	return NewFieldGroup(
+		h, fieldCosDefault, fieldPriCopp,
+		hal.FieldQualifyEtherType,
+		hal.FieldQualifyIpProtocol,
+		hal.FieldQualifyL4SrcPort,
+		hal.FieldQualifyL4DstPort,
+		hal.FieldQualifyTtl,
+		hal.FieldQualifyDstIp,
+		hal.FieldQualifyDstIp6,
+		hal.FieldQualifyDstIpLocal,
+	)
+}
+
+//
+// FieldEntryCopp is field entry (match of copp class).
+// Packets matched are queued to cpu queue of class and red packets
+// of policer are not copied to cpu. zero value fields are not qualified.
+// Local qualifies packets to local address (to cpu).
+//
+type FieldEntryCopp struct {
+	Class   string
+	Index   int
+	EthType uint16
+	IPProto uint8
+	TpSrc   hal.L4Port
+	TpDst   hal.L4Port
+	Ttl     uint8
+	TtlMask uint8
+	DstIP   *net.IPNet
+	Local   bool
+	Queue   uint32
+	Policer hal.PolicerID
+}
+
+func (e *FieldEntryCopp) key() string {
+	return fmt.Sprintf("%s_%d", e.Class, e.Index)
+}
+
+func (e *FieldEntryCopp) String() string {
+	return fmt.Sprintf("class:%s[%d] eth_type:%04x proto:%d sport:%d dport:%d ttl:%d/%x dst:%s local:%t queue:%d policer:%d",
+		e.Class, e.Index, e.EthType, e.IPProto, e.TpSrc, e.TpDst, e.Ttl, e.TtlMask, e.DstIP, e.Local, e.Queue, e.Policer)
+}
+
+//
+// toHal returns hal entry. cos of group is not used.
+//
+func (e *FieldEntryCopp) toHal(cos uint32) *hal.FieldEntry {
+	entry := &hal.FieldEntry{
+		Ttl:        e.Ttl,
+		TtlMask:    e.TtlMask,
+		DstIP:      e.DstIP,
+		DstIPLocal: e.Local,
+		Policer:    e.Policer,
+	}
+
+	if e.EthType != 0 {
+		entry.EthType = e.EthType
+		entry.EthTypeMask = fieldEthTypeMask
+	}
+	if e.IPProto != 0 {
+		entry.IPProto = e.IPProto
+		entry.IPProtoMask = fieldIPProtoMask
+	}
+	if e.TpSrc != 0 {
+		entry.L4SrcPort = e.TpSrc
+		entry.L4SrcPortMask = fieldL4PortMask
+	}
+	if e.TpDst != 0 {
+		entry.L4DstPort = e.TpDst
+		entry.L4DstPortMask = fieldL4PortMask
+	}
+
+	entry.AddAction(hal.FieldActionCosQCpuNew, e.Queue)
+	entry.AddAction(hal.FieldActionRpCopyToCpuCancel)
+
+	return entry
+}
 
-	e.EthType = uint16(ethType)
-	e.IPProto = proto
-	e.InPort = inPort
+func (e *FieldEntryCopp) fromHal(entry *hal.FieldEntry) error {
+	e.EthType = entry.EthType
+	e.IPProto = entry.IPProto
+	e.TpSrc = entry.L4SrcPort
+	e.TpDst = entry.L4DstPort
+	e.Ttl = entry.Ttl
+	e.TtlMask = entry.TtlMask
+	e.DstIP = entry.DstIP
+	e.Local = entry.DstIPLocal
+	e.Policer = entry.Policer
 
 	return nil
 }
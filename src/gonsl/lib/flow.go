@@ -29,13 +29,29 @@ import (
 //
 func (s *Server) FIBCFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod) {
 	// s.log.Debugf("FlowMod: %v %v", hdr, mod)
-	fibcapi.DispatchFlowMod(hdr, mod, s)
+	if err := fibcapi.DispatchFlowMod(hdr, mod, s); err == nil {
+		s.mods.UpdateFlowMod(mod)
+	}
 }
 
 //
-// FIBCMPLSFlowMod process FlowMod (MPLS)
+// FIBCFFMultipartFlowRequest process multipart-request(flow) from fibcd.
 //
-func (s *Server) FIBCMPLSFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod, flow *fibcapi.MPLSFlow) {
-	s.log.Debugf("FlowMod(MPLS): %v", hdr)
-	fibcapi.LogFlowMod(s.log, log.DebugLevel, mod)
+func (s *Server) FIBCFFMultipartFlowRequest(hdr *fibcnet.Header, mp *fibcapi.FFMultipart_Request, req *fibcapi.FFMultipart_FlowRequest) {
+	s.log.Debugf("Multipart(Flow): %v", hdr)
+	fibcapi.LogFFMultipartRequest(s.log, log.DebugLevel, mp, hdr.Xid)
+
+	mods, err := s.installedFlowMods()
+	if err != nil {
+		s.log.Errorf("Multipart(Flow): Read error. %s", err)
+		return
+	}
+
+	reply := fibcapi.NewFFMultipart_Reply_Flow(s.DpID(), mods)
+	if err := s.client.MultipartReply(reply, hdr.Xid); err != nil {
+		s.log.Errorf("Multipart(Flow): Write error. %s", err)
+		return
+	}
+
+	s.log.Debugf("Multipart(Flow): end.")
 }
@@ -21,9 +21,9 @@ import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
 	"fmt"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
@@ -42,9 +42,58 @@ func (s *Server) FIBCPolicyACLFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod,
 		return
 	}
 
-	inPort := opennsl.Port(port)
+	group, entry, err := s.policyACLFieldEntry(flow, hal.Port(port))
+	if err != nil {
+		s.log.Errorf("FlowMod(ACL): %s", err)
+		return
+	}
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD:
+		if err := group.AddEntry(entry); err != nil {
+			s.log.Errorf("FlowMod(ACL): AddEntry error. %s %s", entry, err)
+		}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		group.DeleteEntry(entry)
+
+	default:
+		s.log.Warnf("FlowMod(ACL): Invalid cmd. %s", mod.Cmd)
+	}
+}
 
+//
+// policyACLFieldEntry returns field group and entry of policy acl flow.
+//
+func (s *Server) policyACLFieldEntry(flow *fibcapi.PolicyACLFlow, inPort hal.Port) (*FieldGroup, FieldEntry, error) {
 	switch {
+	case flow.Priority != 0:
+		s.log.Debugf("FlowMod(ACL): rule")
+
+		return s.policyACLRuleFieldEntry(flow, inPort)
+
+	case len(flow.Match.IpSrc) != 0:
+		s.log.Debugf("FlowMod(ACL): ip_src")
+
+		if name := flow.GetAction().GetName(); name != fibcapi.PolicyACLFlow_Action_SET_VRF {
+			return nil, nil, fmt.Errorf("ip_src with action %s not supported.", name)
+		}
+
+		_, srcIP, err := net.ParseCIDR(flow.Match.IpSrc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid IP. %s", flow.Match.IpSrc)
+		}
+
+		vrf := hal.Vrf(flow.Action.Value)
+		switch flow.Match.EthType {
+		case unix.ETH_P_IP:
+			return s.Fields().SrcIPv4, NewFieldEntrySrcIPv4(srcIP, inPort, vrf), nil
+		case unix.ETH_P_IPV6:
+			return s.Fields().SrcIPv6, NewFieldEntrySrcIPv6(srcIP, inPort, vrf), nil
+		default:
+			return nil, nil, fmt.Errorf("Invalid ether type. %04x %s", flow.Match.EthType, srcIP)
+		}
+
 	case len(flow.Match.IpDst) != 0:
 		s.log.Debugf("FlowMod(ACL): ip_dst")
 
@@ -52,8 +101,7 @@ func (s *Server) FIBCPolicyACLFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod,
 		if err != nil {
 			ip := net.ParseIP(flow.Match.IpDst)
 			if ip == nil {
-				s.log.Errorf("FlowMod(ACL): Invalid IP. %s", flow.Match.IpDst)
-				return
+				return nil, nil, fmt.Errorf("Invalid IP. %s", flow.Match.IpDst)
 			}
 
 			bits := EtherTypeToLen(uint16(flow.Match.EthType))
@@ -64,77 +112,134 @@ func (s *Server) FIBCPolicyACLFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod,
 			}
 		}
 
-		switch mod.Cmd {
-		case fibcapi.FlowMod_ADD:
-			err := func() error {
-				switch flow.Match.EthType {
-				case unix.ETH_P_IP:
-					return s.Fields().DstIPv4.AddEntry(NewFieldEntryDstIPv4(dstIP, inPort))
-				case unix.ETH_P_IPV6:
-					return s.Fields().DstIPv6.AddEntry(NewFieldEntryDstIPv6(dstIP, inPort))
-				default:
-					return fmt.Errorf("Invalid ether type. %04x", flow.Match.EthType)
-				}
-			}()
-			if err != nil {
-				s.log.Errorf("FlowMod(ACL): AddEntry error. %s %s", dstIP, err)
-			}
-
-		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-			switch flow.Match.EthType {
-			case unix.ETH_P_IP:
-				s.Fields().DstIPv4.DeleteEntry(NewFieldEntryDstIPv4(dstIP, inPort))
-			case unix.ETH_P_IPV6:
-				s.Fields().DstIPv6.DeleteEntry(NewFieldEntryDstIPv6(dstIP, inPort))
-			default:
-				s.log.Warnf("FlowMod(ACL): DeleteEntry error. %s", dstIP)
-			}
-
+		switch flow.Match.EthType {
+		case unix.ETH_P_IP:
+			return s.Fields().DstIPv4, NewFieldEntryDstIPv4(dstIP, inPort), nil
+		case unix.ETH_P_IPV6:
+			return s.Fields().DstIPv6, NewFieldEntryDstIPv6(dstIP, inPort), nil
 		default:
-			s.log.Warnf("FlowMod(ACL): Invalid cmd. %s", mod.Cmd)
+			return nil, nil, fmt.Errorf("Invalid ether type. %04x %s", flow.Match.EthType, dstIP)
 		}
 
 	case flow.Match.EthType != 0:
 		s.log.Debugf("FlowMod(ACL): eth_type")
 
-		e := NewFieldEntryEthType(uint16(flow.Match.EthType), inPort)
-		switch mod.Cmd {
-		case fibcapi.FlowMod_ADD:
-			if err := s.Fields().EthType.AddEntry(e); err != nil {
-				s.log.Errorf("FlowMod(ACL): AddEntry error. %d %s", e, err)
-			}
-
-		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-			s.Fields().EthType.DeleteEntry(e)
-
-		default:
-			s.log.Warnf("FlowMod(ACL): Invalid cmd. %s", mod.Cmd)
-		}
+		return s.Fields().EthType, NewFieldEntryEthType(uint16(flow.Match.EthType), inPort), nil
 
 	case len(flow.Match.EthDst) > 0:
 		s.log.Debugf("FlowMod(ACL): eth_dst")
 
 		dstMAC, err := net.ParseMAC(flow.Match.EthDst)
 		if err != nil {
-			s.log.Errorf("FlowMod(ACL): Invalid MAC. %s", flow.Match.EthDst)
-			return
+			return nil, nil, fmt.Errorf("Invalid MAC. %s", flow.Match.EthDst)
 		}
 
-		entry := NewFieldEntryEthDst(dstMAC, fibcapi.HardwareAddrExactMask, inPort)
-		switch mod.Cmd {
-		case fibcapi.FlowMod_ADD:
-			if err := s.Fields().EthDst.AddEntry(entry); err != nil {
-				s.log.Errorf("FlowMod(ACL): AddEntry error. %s %s", entry, err)
-			}
+		return s.Fields().EthDst, NewFieldEntryEthDst(dstMAC, fibcapi.HardwareAddrExactMask, inPort), nil
 
-		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-			s.Fields().EthDst.DeleteEntry(entry)
+	default:
+		return nil, nil, fmt.Errorf("Ignored. %s", flow)
+	}
+}
+
+func parseACLRulePrefix(s string, ethType uint16) (*net.IPNet, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+
+	if _, ipnet, err := net.ParseCIDR(s); err == nil {
+		return ipnet, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("Invalid IP. %s", s)
+	}
+
+	bits := EtherTypeToLen(ethType)
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
 
+func newFieldEntryACLFromFlow(flow *fibcapi.PolicyACLFlow, inPort hal.Port) (*FieldEntryACL, error) {
+	m := flow.Match
+	ethType := uint16(m.EthType)
+
+	src, err := parseACLRulePrefix(m.IpSrc, ethType)
+	if err != nil {
+		return nil, err
+	}
+
+	dst, err := parseACLRulePrefix(m.IpDst, ethType)
+	if err != nil {
+		return nil, err
+	}
+
+	e := NewFieldEntryACL(ethType, inPort, int(flow.Priority))
+	e.VlanVid = hal.Vlan(m.VlanVid)
+	e.Src = src
+	e.Dst = dst
+	e.IPProto = uint8(m.IpProto)
+	e.Dscp = uint8(m.IpDscp)
+	e.DscpMask = uint8(m.IpDscpMask)
+	if m.TpSrc != 0 {
+		e.TpSrc = hal.L4Port(m.TpSrc)
+		e.TpSrcMask = hal.L4Port(m.TpSrcMask)
+		if e.TpSrcMask == 0 {
+			e.TpSrcMask = 0xffff
+		}
+	}
+	if m.TpDst != 0 {
+		e.TpDst = hal.L4Port(m.TpDst)
+		e.TpDstMask = hal.L4Port(m.TpDstMask)
+		if e.TpDstMask == 0 {
+			e.TpDstMask = 0xffff
+		}
+	}
+
+	for _, action := range flow.AllActions() {
+		switch action.Name {
+		case fibcapi.PolicyACLFlow_Action_DROP:
+			e.Action.Drop = true
+
+		case fibcapi.PolicyACLFlow_Action_PERMIT:
+			// no action
+
+		case fibcapi.PolicyACLFlow_Action_MIRROR:
+			port, _ := fibcapi.ParseDPPortId(action.Value)
+			e.Action.MirrorPort = hal.Port(port)
+
+		case fibcapi.PolicyACLFlow_Action_SET_QUEUE:
+			e.Action.Queue = int(action.Value)
+
+		case fibcapi.PolicyACLFlow_Action_SET_DSCP:
+			e.Action.Dscp = int(action.Value)
+
+		default:
+			return nil, fmt.Errorf("action %s not supported.", action.Name)
+		}
+	}
+
+	return e, nil
+}
+
+func (s *Server) policyACLRuleFieldEntry(flow *fibcapi.PolicyACLFlow, inPort hal.Port) (*FieldGroup, FieldEntry, error) {
+	group := func() *FieldGroup {
+		switch flow.Match.EthType {
+		case unix.ETH_P_IP:
+			return s.Fields().ACLIPv4
+		case unix.ETH_P_IPV6:
+			return s.Fields().ACLIPv6
 		default:
-			s.log.Warnf("FlowMod(ACL): Invalid cmd. %s", mod.Cmd)
+			return nil
 		}
+	}()
+	if group == nil {
+		return nil, nil, fmt.Errorf("Invalid ether type. %04x", flow.Match.EthType)
+	}
 
-	default:
-		s.log.Warnf("FlowMod(ACL): Ignored. %s", flow)
+	entry, err := newFieldEntryACLFromFlow(flow, inPort)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %s", flow, err)
 	}
+
+	return group, entry, nil
 }
@@ -20,9 +20,9 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -49,25 +49,30 @@ func (s *Server) FIBCBridgingFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod,
 	case fibcapi.LinkType_BRIDGE, fibcapi.LinkType_BOND:
 		s.log.Debugf("FlowMod(Bridge): %d %s skip.", port, portType)
 		return
-	}
 
-	vid := opennsl.Vlan(flow.Match.VlanVid)
+	case fibcapi.LinkType_VXLAN:
+		if err := s.vxlanBridgingFlowMod(mod.Cmd, flow.Match.TunnelId, ethDst, port); err != nil {
+			s.log.Errorf("FlowMod(Bridge): vxlan l2addr error. vni:%d %s %s", flow.Match.TunnelId, ethDst, err)
+		}
+		return
+	}
 
-	l2addr := opennsl.NewL2Addr(ethDst, vid)
-	if port != 0 {
-		l2addr.SetPort(opennsl.Port(port))
+	l2addr := &hal.L2Addr{
+		MAC:  ethDst,
+		Vlan: hal.Vlan(flow.Match.VlanVid),
+		Port: hal.Port(port),
 	}
 
 	switch mod.Cmd {
 	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY:
 		s.log.Debugf("FlowMod(Bridge): l2addr add. %s", l2addr)
-		if err := l2addr.Add(s.Unit()); err != nil {
+		if err := s.hal.L2AddrAdd(l2addr); err != nil {
 			s.log.Errorf("FlowMod(Bridge): l2addr add error. %s", err)
 		}
 
 	case fibcapi.FlowMod_DELETE:
 		s.log.Debugf("FlowMod(Bridge): l2addr delete. %s", l2addr)
-		if err := l2addr.Delete(s.Unit()); err != nil {
+		if err := s.hal.L2AddrDelete(l2addr.MAC, l2addr.Vlan); err != nil {
 			s.log.Errorf("FlowMod(Bridge): l2addr delete error. %s", err)
 		}
 
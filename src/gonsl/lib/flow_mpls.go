@@ -0,0 +1,136 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	"fmt"
+	hal "gonsl/hal"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func mplsFlowActionValue(flow *fibcapi.MPLSFlow, name fibcapi.MPLSFlow_Action_Name) (uint32, bool) {
+	for _, action := range flow.Actions {
+		if action.Name == name {
+			return action.Value, true
+		}
+	}
+	return 0, false
+}
+
+//
+// newMPLSTunnelSwitch returns MPLS tunnel switch (ILM) entry for MPLS flow.
+// - group=UNSPEC, SET_VRF  : POP and L3 lookup in VRF (termination).
+// - group=MPLS_INTERFACE   : POP(PHP) and forward to neighbor.
+// - group=MPLS_SWAP        : SWAP label and forward to neighbor.
+//
+func (s *Server) newMPLSTunnelSwitch(flow *fibcapi.MPLSFlow) (*hal.MplsTunnelSwitch, error) {
+	tsw := hal.NewMplsTunnelSwitch(hal.MplsLabel(flow.Match.Label))
+	tsw.Flags = hal.MPLS_SWITCH_TTL_DECREMENT
+
+	switch flow.GType {
+	case fibcapi.GroupMod_UNSPEC:
+		vrf, ok := mplsFlowActionValue(flow, fibcapi.MPLSFlow_Action_SET_VRF)
+		if !ok {
+			return nil, fmt.Errorf("SET_VRF not found. label:%d", flow.Match.Label)
+		}
+
+		tsw.Action = hal.MPLS_SWITCH_ACTION_POP
+		tsw.Vrf = hal.Vrf(vrf)
+
+	case fibcapi.GroupMod_MPLS_INTERFACE:
+		gid := fibcapi.NewMPLSInterfaceGroupID(flow.GId)
+		l3egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+		if !ok {
+			return nil, fmt.Errorf("MPLS-IF(%08x) not found. label:%d", gid, flow.Match.Label)
+		}
+
+		tsw.Action = hal.MPLS_SWITCH_ACTION_PHP
+		tsw.EgressIf = l3egrID
+
+	case fibcapi.GroupMod_MPLS_SWAP:
+		gid := NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_SWAP, flow.GId)
+		l3egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+		if !ok {
+			return nil, fmt.Errorf("MPLS-Swap(%08x) not found. label:%d", gid, flow.Match.Label)
+		}
+
+		l3egr, err := s.hal.L3EgressGet(l3egrID)
+		if err != nil {
+			return nil, err
+		}
+
+		tsw.Action = hal.MPLS_SWITCH_ACTION_SWAP
+		tsw.EgressLabel = l3egr.MplsLabel
+		tsw.EgressIf = l3egrID
+
+	default:
+		return nil, fmt.Errorf("Invalid Group type. %s", flow.GType)
+	}
+
+	return tsw, nil
+}
+
+//
+// FIBCMPLSFlowMod process FlowMod (MPLS)
+//
+func (s *Server) FIBCMPLSFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod, flow *fibcapi.MPLSFlow) {
+	s.log.Debugf("FlowMod(MPLS): %v", hdr)
+	fibcapi.LogFlowMod(s.log, log.DebugLevel, mod)
+
+	if flow.GType == fibcapi.GroupMod_MPLS_SWAP && flow.Match.Bos {
+		// tunnel switch entry does not match BOS.
+		// SWAP flows are sent for BOS=0/1, so install only BOS=0 one.
+		s.log.Debugf("FlowMod(MPLS): SWAP(BOS=1) skip. label:%d", flow.Match.Label)
+		return
+	}
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+		tsw, err := s.newMPLSTunnelSwitch(flow)
+		if err != nil {
+			s.log.Errorf("FlowMod(MPLS): %s", err)
+			return
+		}
+
+		if mod.Cmd != fibcapi.FlowMod_ADD {
+			// MODIFY replaces tunnel switch entry of the label.
+			tsw.Flags |= hal.MPLS_SWITCH_REPLACE
+		}
+
+		s.log.Debugf("FlowMod(MPLS): %s label:%d bos:%t %s", mod.Cmd, flow.Match.Label, flow.Match.Bos, tsw.Action)
+
+		if err := s.hal.MplsTunnelSwitchAdd(tsw); err != nil {
+			s.log.Errorf("FlowMod(MPLS): tunnel switch add error. label:%d %s", flow.Match.Label, err)
+		}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		tsw := hal.NewMplsTunnelSwitch(hal.MplsLabel(flow.Match.Label))
+
+		s.log.Debugf("FlowMod(MPLS): del label:%d bos:%t", flow.Match.Label, flow.Match.Bos)
+
+		if err := s.hal.MplsTunnelSwitchDelete(tsw); err != nil {
+			s.log.Errorf("FlowMod(MPLS): tunnel switch delete error. label:%d %s", flow.Match.Label, err)
+		}
+
+	default:
+		s.log.Errorf("FlowMod(MPLS): Invalid Command. %d", mod.Cmd)
+	}
+}
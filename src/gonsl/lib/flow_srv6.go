@@ -0,0 +1,131 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var srv6LocalSidActions = map[fibcapi.SRv6LocalSidFlow_Action_Name]hal.SRv6LocalSidAction{
+	fibcapi.SRv6LocalSidFlow_Action_END:     hal.SRV6_LOCAL_SID_END,
+	fibcapi.SRv6LocalSidFlow_Action_END_X:   hal.SRV6_LOCAL_SID_END_X,
+	fibcapi.SRv6LocalSidFlow_Action_END_T:   hal.SRV6_LOCAL_SID_END_T,
+	fibcapi.SRv6LocalSidFlow_Action_END_DX6: hal.SRV6_LOCAL_SID_END_DX6,
+	fibcapi.SRv6LocalSidFlow_Action_END_DX4: hal.SRV6_LOCAL_SID_END_DX4,
+	fibcapi.SRv6LocalSidFlow_Action_END_DT6: hal.SRV6_LOCAL_SID_END_DT6,
+	fibcapi.SRv6LocalSidFlow_Action_END_DT4: hal.SRV6_LOCAL_SID_END_DT4,
+}
+
+//
+// NewSRv6LocalSidBindKey returns new L3EgressBindKey of local sid.
+// It keeps nexthop l3 egress alive while local sid refers it.
+//
+func NewSRv6LocalSidBindKey(sid *hal.SRv6LocalSid) L3EgressBindKey {
+	return L3EgressBindKey{
+		Host: false,
+		Vrf:  sid.Vrf,
+		Dst:  fmt.Sprintf("sid:%s", sid.Sid),
+	}
+}
+
+//
+// NewSRv6LocalSid converts SRv6LocalSidFlow to hal.SRv6LocalSid.
+// Egress is not resolved.
+//
+func NewSRv6LocalSid(flow *fibcapi.SRv6LocalSidFlow) (*hal.SRv6LocalSid, error) {
+	_, sid, err := net.ParseCIDR(flow.Match.Sid)
+	if err != nil {
+		return nil, err
+	}
+
+	action, ok := srv6LocalSidActions[flow.Action.Name]
+	if !ok {
+		return nil, fmt.Errorf("Invalid action. %s", flow.Action.Name)
+	}
+
+	localSid := &hal.SRv6LocalSid{
+		Sid:    sid,
+		Vrf:    hal.Vrf(flow.Match.Vrf),
+		Action: action,
+	}
+
+	switch action {
+	case hal.SRV6_LOCAL_SID_END_T, hal.SRV6_LOCAL_SID_END_DT6, hal.SRV6_LOCAL_SID_END_DT4:
+		localSid.Table = hal.Vrf(flow.Action.Value)
+	}
+
+	return localSid, nil
+}
+
+//
+// FIBCSRv6LocalSidFlowMod process FlowMod(SRv6 Local SID)
+//
+func (s *Server) FIBCSRv6LocalSidFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod, flow *fibcapi.SRv6LocalSidFlow) {
+	s.log.Debugf("FlowMod(SRv6-SID): %v", hdr)
+	fibcapi.LogFlowMod(s.log, log.DebugLevel, mod)
+
+	localSid, err := NewSRv6LocalSid(flow)
+	if err != nil {
+		s.log.Errorf("FlowMod(SRv6-SID): %s", err)
+		return
+	}
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+		hasEgress := flow.GType == fibcapi.GroupMod_L3_UNICAST
+		if hasEgress {
+			egrID, ok := s.idmaps.L3Egress.Get(flow.GId)
+			if !ok {
+				s.log.Errorf("FlowMod(SRv6-SID): L3Egress(neid:%08x) not found.", flow.GId)
+				return
+			}
+			localSid.Egress = egrID
+		}
+
+		if err := s.hal.SRv6LocalSidAdd(localSid); err != nil {
+			s.log.Errorf("FlowMod(SRv6-SID): local sid add error. %s", err)
+			return
+		}
+
+		if hasEgress {
+			if oldID, ok := s.egrRefs.Bind(NewSRv6LocalSidBindKey(localSid), localSid.Egress); ok {
+				s.l3EgressUnref(oldID)
+			}
+		} else if oldID, ok := s.egrRefs.Unbind(NewSRv6LocalSidBindKey(localSid)); ok {
+			s.l3EgressUnref(oldID)
+		}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		if err := s.hal.SRv6LocalSidDelete(localSid); err != nil {
+			s.log.Errorf("FlowMod(SRv6-SID): local sid delete error. %s", err)
+		}
+
+		if egrID, ok := s.egrRefs.Unbind(NewSRv6LocalSidBindKey(localSid)); ok {
+			s.l3EgressUnref(egrID)
+		}
+
+	default:
+		s.log.Errorf("FlowMod(SRv6-SID): Invalid Cmd. %d", mod.Cmd)
+	}
+}
@@ -20,8 +20,8 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
-
-	"github.com/beluganos/go-opennsl/opennsl"
+	"fmt"
+	hal "gonsl/hal"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -33,54 +33,82 @@ func (s *Server) FIBCTerminationMacFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 	s.log.Debugf("FlowMod(TermMAC): %v", hdr)
 	fibcapi.LogFlowMod(s.log, log.DebugLevel, mod)
 
+	l2addr, err := s.terminationMacL2Addr(flow)
+	if err != nil {
+		s.log.Errorf("FlowMod(TermMAC): %s", err)
+		return
+	}
+
+	if l2addr == nil {
+		return
+	}
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD:
+		s.log.Debugf("FlowMod(TermMAC): L2Addr add. %s port:%d vid:%d", flow.Match.EthDst, flow.Match.InPort, l2addr.Vlan)
+		if err := s.hal.L2AddrAdd(l2addr); err != nil {
+			s.log.Errorf("FlowMod(TermMAC): L2 Addr Add error. %v %s", l2addr, err)
+		}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		s.log.Debugf("FlowMod(TermMAC): L2Addr del. %s port:%d vid:%d", flow.Match.EthDst, flow.Match.InPort, l2addr.Vlan)
+		if err := s.hal.L2AddrDelete(l2addr.MAC, l2addr.Vlan); err != nil {
+			s.log.Errorf("FlowMod(TermMAC): L2 Addr Delete error. %v %s", l2addr, err)
+		}
+
+	default:
+		s.log.Warnf("FlowMod(TermMAC): Ignored. %s %s", mod.Cmd, flow)
+	}
+}
+
+//
+// terminationMacL2Addr returns l2 addr (L3LOOKUP) of termination mac flow.
+// It returns nil if no entry is installed for the flow.
+//
+func (s *Server) terminationMacL2Addr(flow *fibcapi.TerminationMacFlow) (*hal.L2Addr, error) {
 	port, portType := fibcapi.ParseDPPortId(flow.Match.InPort)
 	switch portType {
 	case fibcapi.LinkType_BRIDGE, fibcapi.LinkType_BOND:
 		s.log.Debugf("FlowMod(TermMAC): %d %s skip", port, portType)
-		return
+		return nil, nil
 	}
 
 	mac, mask, err := fibcapi.ParseMaskedMAC(flow.Match.EthDst)
 	if err != nil {
-		s.log.Errorf("FlowMod(TermMAC): Invalid MAC. %s", err)
-		return
+		return nil, fmt.Errorf("Invalid MAC. %s", err)
 	}
 
 	if mask.String() != fibcapi.HWADDR_EXACT_MASK {
 		s.log.Debugf("FlowMod(TermMAC): MAC is masked. %s '%s'", flow.Match.EthDst, mask)
-		return
+		return nil, nil
 	}
 
-	if ethType := flow.Match.EthType; ethType != fibcapi.ETHTYPE_IPV4 && ethType != fibcapi.ETHTYPE_IPV6 {
+	switch ethType := flow.Match.EthType; ethType {
+	case fibcapi.ETHTYPE_IPV4, fibcapi.ETHTYPE_IPV6:
+		// pass
+
+	case fibcapi.ETHTYPE_MPLS:
+		// L2 entry with L3LOOKUP (added by IPv4/6) also enables
+		// MPLS tunnel switch lookup, so no other entry is needed.
+		s.log.Debugf("FlowMod(TermMAC): MPLS %s skip", flow.Match.EthDst)
+		return nil, nil
+
+	default:
 		s.log.Debugf("FlowMod(TermMAC): Not IPv4/6. %d %s", ethType, flow.Match.EthDst)
-		return
+		return nil, nil
 	}
 
-	vlan := func() opennsl.Vlan {
-		if vid := opennsl.Vlan(flow.Match.VlanVid); vid != opennsl.VLAN_ID_NONE {
+	vlan := func() hal.Vlan {
+		if vid := hal.Vlan(flow.Match.VlanVid); vid != hal.VLAN_ID_NONE {
 			return vid
 		}
-		return opennsl.VlanDefaultMustGet(s.Unit())
+		return s.hal.VlanDefault()
 	}()
 
-	l2addr := opennsl.NewL2Addr(mac, vlan)
-	l2addr.SetFlags(opennsl.L2_L3LOOKUP | opennsl.L2_STATIC)
-	l2addr.SetPort(opennsl.Port(port))
-
-	switch mod.Cmd {
-	case fibcapi.FlowMod_ADD:
-		s.log.Debugf("FlowMod(TermMAC): L2Addr add. %s/%s port:%d vid:%d", mac, mask, flow.Match.InPort, vlan)
-		if err := l2addr.Add(s.Unit()); err != nil {
-			s.log.Errorf("FlowMod(TermMAC): L2 Addr Add error. %v %s", l2addr, err)
-		}
-
-	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-		s.log.Debugf("FlowMod(TermMAC): L2Addr del. %s/%s port:%d vid:%d", mac, mask, flow.Match.InPort, vlan)
-		if err := l2addr.Delete(s.Unit()); err != nil {
-			s.log.Errorf("FlowMod(TermMAC): L2 Addr Delete error. %v %s", l2addr, err)
-		}
-
-	default:
-		s.log.Warnf("FlowMod(TermMAC): Ignored. %s %s", mod.Cmd, flow)
-	}
+	return &hal.L2Addr{
+		Flags: hal.L2_L3LOOKUP | hal.L2_STATIC,
+		MAC:   mac,
+		Vlan:  vlan,
+		Port:  hal.Port(port),
+	}, nil
 }
@@ -20,9 +20,9 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
@@ -40,7 +40,7 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 		return
 	}
 
-	vrf := opennsl.Vrf(flow.Match.Vrf)
+	vrf := hal.Vrf(flow.Match.Vrf)
 	neid := flow.GId
 
 	switch flow.GType {
@@ -49,21 +49,17 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 		case fibcapi.UnicastRoutingFlow_NEIGH:
 			s.log.Debugf("FlowMod(U.C.): Neigh %s neid:%08x", ip, neid)
 
-			l3host := opennsl.NewL3Host()
-
-			if IPToAF(ip) == unix.AF_INET {
-				l3host.SetIPAddr(ip)
-			} else {
-				l3host.SetIP6Addr(ip)
-				l3host.SetFlags(opennsl.L3_IP6)
+			l3host := &hal.L3Host{
+				IP:  ip,
+				Vrf: vrf,
 			}
 
-			if vrf != 0 {
-				l3host.SetVRF(vrf)
+			if IPToAF(ip) != unix.AF_INET {
+				l3host.Flags = hal.L3_IP6
 			}
 
 			switch mod.Cmd {
-			case fibcapi.FlowMod_ADD:
+			case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
 				l3egrID, ok := s.idmaps.L3Egress.Get(neid)
 				if !ok {
 					s.log.Errorf("FlowMod(U.C.): Neigh L3Egress(neid:%08x) not found.", neid)
@@ -72,17 +68,14 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 
 				s.log.Debugf("FlowMod(U.C.): Neigh %s l3eg:%d", ip, l3egrID)
 
-				l3host.SetEgressID(l3egrID)
+				l3host.EgressID = l3egrID
 
-				if err := l3host.Add(s.Unit()); err != nil {
+				if err := s.l3HostAdd(l3host); err != nil {
 					s.log.Errorf("FlowMod(U.C.): Neigh L3Host add error. %s", err)
 				}
 
-			case fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
-				s.log.Warnf("FlowMod(U.C.): Neigh L3Host modify unsupported.")
-
 			case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-				if err := l3host.Delete(s.Unit()); err != nil {
+				if err := s.l3HostDelete(l3host); err != nil {
 					s.log.Errorf("FlowMod(U.C.): Neigh L3Host delete error. %s", err)
 				}
 
@@ -93,21 +86,17 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 		case fibcapi.UnicastRoutingFlow_ROUTE:
 			s.log.Debugf("FlowMod(U.C.): Route %s neid:%08x", ipnet, neid)
 
-			l3route := opennsl.NewL3Route()
-
-			if IPToAF(ip) == unix.AF_INET {
-				l3route.SetIP4Net(ipnet)
-			} else {
-				l3route.SetIP6Net(ipnet)
-				l3route.SetFlags(opennsl.L3_IP6)
+			l3route := &hal.L3Route{
+				Dst: ipnet,
+				Vrf: vrf,
 			}
 
-			if vrf != 0 {
-				l3route.SetVRF(vrf)
+			if IPToAF(ip) != unix.AF_INET {
+				l3route.Flags = hal.L3_IP6
 			}
 
 			switch mod.Cmd {
-			case fibcapi.FlowMod_ADD:
+			case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
 				l3egrID, ok := s.idmaps.L3Egress.Get(neid)
 				if !ok {
 					s.log.Errorf("FlowMod(U.C.): Route L3Egress(neid:%08x) not found.", neid)
@@ -116,17 +105,14 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 
 				s.log.Debugf("FlowMod(U.C.): Route %s l3eg:%d", ipnet, l3egrID)
 
-				l3route.SetEgressID(l3egrID)
+				l3route.EgressID = l3egrID
 
-				if err := l3route.Add(s.Unit()); err != nil {
+				if err := s.l3RouteAdd(l3route); err != nil {
 					s.log.Errorf("FlowMod(U.C.): Route L3Route add error. %s", err)
 				}
 
-			case fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
-				s.log.Warnf("FlowMod(U.C.): Route L3Route modify unsupported.")
-
 			case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
-				if err := l3route.Delete(s.Unit()); err != nil {
+				if err := s.l3RouteDelete(l3route); err != nil {
 					s.log.Errorf("FlowMod(U.C.): Route L3Route delete error. %s", err)
 				}
 
@@ -139,10 +125,122 @@ func (s *Server) FIBCUnicastRoutingFlowMod(hdr *fibcnet.Header, mod *fibcapi.Flo
 		}
 
 	case fibcapi.GroupMod_L3_ECMP:
-		s.log.Warnf("FlowMod(U.C.): ECMP %s", ipnet)
+		ecmpID := flow.GId
+
+		s.log.Debugf("FlowMod(U.C.): ECMP %s ecmp:%d", ipnet, ecmpID)
+
+		l3route := &hal.L3Route{
+			Flags: hal.L3_MULTIPATH,
+			Dst:   ipnet,
+			Vrf:   vrf,
+		}
+
+		if IPToAF(ip) != unix.AF_INET {
+			l3route.Flags |= hal.L3_IP6
+		}
+
+		switch mod.Cmd {
+		case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+			ecmpEgrID, ok := s.idmaps.L3Ecmps.Get(ecmpID)
+			if !ok {
+				s.log.Errorf("FlowMod(U.C.): ECMP L3-ECMP(%d) not found.", ecmpID)
+				return
+			}
+
+			s.log.Debugf("FlowMod(U.C.): ECMP %s l3eg:%d", ipnet, ecmpEgrID)
+
+			l3route.EgressID = ecmpEgrID
+
+			if err := s.l3RouteAdd(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): ECMP L3Route add error. %s", err)
+			}
+
+		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+			if err := s.l3RouteDelete(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): ECMP L3Route delete error. %s", err)
+			}
+
+		default:
+			s.log.Errorf("FlowMod(U.C.): ECMP Invalid Command. %d", mod.Cmd)
+		}
 
 	case fibcapi.GroupMod_MPLS_L3_VPN:
-		s.log.Warnf("FlowMod(U.C.): MPLS_L3_VPN  %s", ipnet)
+		gid := NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_L3_VPN, flow.GId)
+
+		s.log.Debugf("FlowMod(U.C.): MPLS %s gid:%08x", ipnet, gid)
+
+		l3route := &hal.L3Route{
+			Dst: ipnet,
+			Vrf: vrf,
+		}
+
+		if IPToAF(ip) != unix.AF_INET {
+			l3route.Flags = hal.L3_IP6
+		}
+
+		switch mod.Cmd {
+		case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+			l3egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+			if !ok {
+				s.log.Errorf("FlowMod(U.C.): MPLS L3-VPN(%08x) not found.", gid)
+				return
+			}
+
+			s.log.Debugf("FlowMod(U.C.): MPLS %s l3eg:%d", ipnet, l3egrID)
+
+			l3route.EgressID = l3egrID
+
+			if err := s.l3RouteAdd(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): MPLS L3Route add error. %s", err)
+			}
+
+		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+			if err := s.l3RouteDelete(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): MPLS L3Route delete error. %s", err)
+			}
+
+		default:
+			s.log.Errorf("FlowMod(U.C.): MPLS Invalid Command. %d", mod.Cmd)
+		}
+
+	case fibcapi.GroupMod_SRV6_ENCAP:
+		gid := fibcapi.NewSRv6EncapGroupID(flow.GId)
+
+		s.log.Debugf("FlowMod(U.C.): SRv6 %s gid:%08x", ipnet, gid)
+
+		l3route := &hal.L3Route{
+			Dst: ipnet,
+			Vrf: vrf,
+		}
+
+		if IPToAF(ip) != unix.AF_INET {
+			l3route.Flags = hal.L3_IP6
+		}
+
+		switch mod.Cmd {
+		case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+			encapID, ok := s.idmaps.SRv6Encaps.Get(gid)
+			if !ok {
+				s.log.Errorf("FlowMod(U.C.): SRv6 Encap(%08x) not found.", gid)
+				return
+			}
+
+			s.log.Debugf("FlowMod(U.C.): SRv6 %s l3eg:%d", ipnet, encapID)
+
+			l3route.EgressID = encapID
+
+			if err := s.l3RouteAdd(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): SRv6 L3Route add error. %s", err)
+			}
+
+		case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+			if err := s.l3RouteDelete(l3route); err != nil {
+				s.log.Errorf("FlowMod(U.C.): SRv6 L3Route delete error. %s", err)
+			}
+
+		default:
+			s.log.Errorf("FlowMod(U.C.): SRv6 Invalid Command. %d", mod.Cmd)
+		}
 
 	default:
 		s.log.Errorf("FlowMod(U.C.): Invalid Group type. %d", flow.GType)
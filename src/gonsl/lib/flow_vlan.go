@@ -20,8 +20,7 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
-
-	"github.com/beluganos/go-opennsl/opennsl"
+	hal "gonsl/hal"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -47,20 +46,20 @@ func (s *Server) fibcVLANFlowMod(hdr *fibcnet.Header, mod *fibcapi.FlowMod, flow
 		return
 	}
 
-	vlan := NewL3Vlan(s.Unit(), opennsl.Vlan(flow.Match.Vid))
-	vlan.Pbmp.Add(opennsl.Port(port))
-	vlan.Vlan = s.vlanPorts.ConvVID(opennsl.Port(port), vlan.Vid)
+	vlan := NewL3Vlan(s.hal, hal.Vlan(flow.Match.Vid))
+	vlan.Pbmp.Add(hal.Port(port))
+	vlan.Vlan = s.vlanPorts.ConvVID(hal.Port(port), vlan.Vid)
 
 	switch mod.Cmd {
 	case fibcapi.FlowMod_ADD:
 		s.log.Infof("FlowMod(VLAN): ADD Port. %s", vlan)
-		if err := vlan.Create(s.Unit()); err != nil {
+		if err := vlan.Create(s.hal); err != nil {
 			s.log.Errorf("FlowMod(VLAN): ADD Port error. %s", err)
 		}
 
 	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
 		s.log.Infof("FlowMod(VLAN): DEL port. %s", vlan)
-		if err := vlan.Delete(s.Unit()); err != nil {
+		if err := vlan.Delete(s.hal); err != nil {
 			s.log.Errorf("FlowMod(VLAN): DEL Port error. %s", err)
 		}
 
@@ -74,9 +73,9 @@ func (s *Server) fibcVLANFlowModBrVlan(hdr *fibcnet.Header, mod *fibcapi.FlowMod
 	fibcapi.LogFlowMod(s.log, log.DebugLevel, mod)
 
 	port, _ := fibcapi.ParseDPPortId(flow.Match.InPort)
-	vid := opennsl.Vlan(flow.Match.Vid)
-	vlan := NewBrVlan(s.Unit(), vid)
-	vlan.Pbmp.Add(opennsl.Port(port))
+	vid := hal.Vlan(flow.Match.Vid)
+	vlan := NewBrVlan(s.hal, vid)
+	vlan.Pbmp.Add(hal.Port(port))
 
 	if (flags & fibcapi.BridgeVlanInfo_PVID) != 0 {
 		// Drop tagged packet.
@@ -85,39 +84,39 @@ func (s *Server) fibcVLANFlowModBrVlan(hdr *fibcnet.Header, mod *fibcapi.FlowMod
 
 	if (flags & fibcapi.BridgeVlanInfo_UNTAGGED) != 0 {
 		// Egress packets are untagged.
-		vlan.UntagBmp.Add(opennsl.Port(port))
+		vlan.UntagBmp.Add(hal.Port(port))
 	}
 
 	switch mod.Cmd {
 	case fibcapi.FlowMod_ADD:
 		s.log.Infof("FlowMod(BrVLAN): ADD Port. %s", vlan)
-		if err := vlan.Create(s.Unit()); err != nil {
+		if err := vlan.Create(s.hal); err != nil {
 			s.log.Errorf("FlowMod(BrVLAN): ADD Port error. %s", err)
 			return
 		}
 
-		if err := s.notifyL2Addrs(opennsl.Port(port), vid); err != nil {
+		if err := s.notifyL2Addrs(hal.Port(port), vid); err != nil {
 			s.log.Errorf("FlowMod(BrVLAN): Notify L2Addrs error. %s", err)
 		}
 
 	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
 		s.log.Infof("FlowMod(BrVLAN): DEL Port. %s", vlan)
-		vlan.Delete(s.Unit())
+		vlan.Delete(s.hal)
 
 	default:
 		s.log.Errorf("FlowMod(BrVLAN): Invalid cmd. %s %s", mod.Cmd, vlan)
 	}
 }
 
-func (s *Server) notifyL2Addrs(portId opennsl.Port, vid opennsl.Vlan) error {
+func (s *Server) notifyL2Addrs(portId hal.Port, vid hal.Vlan) error {
 	l2addrs := []*L2addrmonEntry{}
-	if err := opennsl.L2Traverse(s.Unit(), func(unit int, l2addr *opennsl.L2Addr) opennsl.OpenNSLError {
-		if l2addr.Port() == portId || l2addr.VID() == vid {
-			e := NewL2addrmonEntry(l2addr, opennsl.L2_CALLBACK_ADD)
+	if err := s.hal.L2AddrTraverse(func(l2addr *hal.L2Addr) error {
+		if l2addr.Port == portId || l2addr.Vlan == vid {
+			e := NewL2addrmonEntry(l2addr, hal.L2_CALLBACK_ADD)
 			l2addrs = append(l2addrs, e)
 		}
 
-		return opennsl.E_NONE
+		return nil
 
 	}); err != nil {
 		return err
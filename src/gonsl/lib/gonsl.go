@@ -18,91 +18,22 @@
 package gonslib
 
 import (
-	"github.com/beluganos/go-opennsl/opennsl"
-	"github.com/beluganos/go-opennsl/sal"
+	hal "gonsl/hal"
 
 	log "github.com/sirupsen/logrus"
 )
 
 //
-// SimInit initialize simlator mode.
+// DriverInfo outputs information of driver.
 //
-func SimInit(unit int) {
-	// FOR DEBUG ONLY
-	log.Infof("Initialize for simulator.")
-	if err := opennsl.PortInit(unit); err != nil {
-		log.Debugf("opennsl.PortInit error. %s", err)
-	}
-	if _, err := opennsl.VlanDefaultMustGet(unit).Create(unit); err != nil {
-		log.Debugf("opennsl.VlanCreate error. %s", err)
-	}
-}
-
-//
-// DriverInit initialize opennsl driver.
-//
-func DriverInit(unit int, cfg *ONSLConfig) error {
-	var init *sal.Init
-	if cfg != nil {
-		init = &sal.Init{}
-		init.Free()
-		init.SetCfgFname(cfg.Config)
-
-		log.Infof("Driver init uses config %s", cfg.Config)
-	}
-
-	if err := init.Init(); err != nil {
-		log.Errorf("Initializer error. %s", err)
-		return err
-	}
-
-	if err := PortDefaultConfig(unit); err != nil {
-		log.Errorf("Port default config error. %s", err)
-		return err
-	}
-
-	if err := PortStatInit(unit); err != nil {
-		log.Errorf("Stat init error. %s", err)
-		return err
-	}
-
-	if err := RxInit(unit); err != nil {
-		log.Errorf("Rx Init error. %s", err)
-		return err
-	}
-
-	if err := opennsl.SwitchL3EgressMode.Set(unit, 1); err != nil {
-		log.Errorf(".SwitchL3EgressMode.Set %s", err)
-		return err
-	}
-
-	log.Infof("DriverInit ok. unit=%d", unit)
-	return nil
-}
-
-func DriverInfo(unit int) {
-	ver := sal.VersionGet()
-	log.Infof("OpenNSL version %s", ver)
-
-	info, err := opennsl.InfoGet(unit)
+func DriverInfo(h hal.HAL) {
+	info, err := h.Info()
 	if err != nil {
 		log.Warnf("Info get error. %s", err)
-	} else {
-		log.Infof("Info device=%d rev=%d", info.Device(), info.Revision())
+		return
 	}
 
-	l3info, err := opennsl.L3InfoGet(unit)
-	if err != nil {
-		log.Warnf("L3Info get error. %s", err)
-	} else {
-		log.Infof("L3Info iface=%d/%d host=%d route=%d", l3info.UsedIface(), l3info.MaxIface(), l3info.MaxHost(), l3info.MaxRoute())
-	}
-}
-
-//
-// DriverExit terminates opennsl driver.
-//
-func DriverExit() {
-	log.Infof("DriverExit()")
-	sal.DriverExit()
+	log.Infof("Driver %s version %s", info.Name, info.Version)
+	log.Infof("Info device=%d rev=%d", info.Device, info.Revision)
+	log.Infof("L3Info iface=%d/%d host=%d route=%d", info.UsedIface, info.MaxIface, info.MaxHost, info.MaxRoute)
 }
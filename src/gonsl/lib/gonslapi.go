@@ -19,10 +19,9 @@ package gonslib
 
 import (
 	api "gonsl/api"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
-
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
@@ -30,8 +29,7 @@ import (
 )
 
 const (
-	l3HostTraverseMax  = 1024
-	l3RouteTraverseMax = 1024
+	mplsLabelMax = 2
 )
 
 //
@@ -75,7 +73,7 @@ func (s *APIServer) Start(addr string) error {
 // GetFieldEntries process api.GetFieldEntriesRequest.
 //
 func (s *APIServer) GetFieldEntries(ctxt context.Context, req *api.GetFieldEntriesRequest) (*api.GetFieldEntriesReply, error) {
-	var entries []opennsl.FieldEntry
+	var entries []hal.FieldEntryID
 	var err error
 
 	fields := s.server.Fields()
@@ -141,15 +139,16 @@ func (s *APIServer) GetFieldEntries(ctxt context.Context, req *api.GetFieldEntri
 //
 
 func (s *APIServer) GetPortInfos(ctxt context.Context, req *api.GetPortInfosRequest) (*api.GetPortInfosReply, error) {
-	pbmp, err := PortBmpGet(s.server.Unit())
+	h := s.server.HAL()
+	pbmp, err := h.PortBmp()
 	if err != nil {
 		return nil, err
 	}
 
 	portInfos := []*api.PortInfo{}
-	err = pbmp.Each(func(port opennsl.Port) error {
-		pinfo := opennsl.NewPortInfo()
-		if err := pinfo.PortSelectiveGet(s.server.Unit(), port); err != nil {
+	err = pbmp.Each(func(port hal.Port) error {
+		pinfo, err := h.PortInfoGet(port)
+		if err != nil {
 			return err
 		}
 
@@ -169,9 +168,9 @@ func (s *APIServer) GetPortInfos(ctxt context.Context, req *api.GetPortInfosRequ
 //
 func (s *APIServer) GetVlans(ctxt context.Context, req *api.GetVlansRequest) (*api.GetVlansReply, error) {
 	entries := []*api.VlanEntry{}
-	err := opennsl.VlanTraverse(s.server.Unit(), func(unit int, vlan opennsl.Vlan, pbmp *opennsl.PBmp, ubmp *opennsl.PBmp) opennsl.OpenNSLError {
+	err := s.server.HAL().VlanTraverse(func(vlan hal.Vlan, pbmp *hal.PBmp, ubmp *hal.PBmp) error {
 		entries = append(entries, NewVlanEntryAPI(vlan, pbmp, ubmp))
-		return opennsl.E_NONE
+		return nil
 	})
 
 	if err != nil {
@@ -186,9 +185,9 @@ func (s *APIServer) GetVlans(ctxt context.Context, req *api.GetVlansRequest) (*a
 //
 func (s *APIServer) GetL2Addrs(ctxt context.Context, req *api.GetL2AddrsRequest) (*api.GetL2AddrsReply, error) {
 	l2addrs := []*api.L2Addr{}
-	err := opennsl.L2Traverse(s.server.Unit(), func(unit int, l2addr *opennsl.L2Addr) opennsl.OpenNSLError {
+	err := s.server.HAL().L2AddrTraverse(func(l2addr *hal.L2Addr) error {
 		l2addrs = append(l2addrs, NewL2AddrAPI(l2addr))
-		return opennsl.E_NONE
+		return nil
 	})
 
 	if err != nil {
@@ -203,8 +202,8 @@ func (s *APIServer) GetL2Addrs(ctxt context.Context, req *api.GetL2AddrsRequest)
 //
 func (s *APIServer) GetL2Stations(ctxt context.Context, req *api.GetL2StationsRequest) (*api.GetL2StationsReply, error) {
 	l2stations := []*api.L2Station{}
-	s.server.idmaps.L2Stations.Traverse(func(key L2StationIDKey, l2stationId opennsl.L2StationID) bool {
-		l2station, err := l2stationId.Get(s.server.Unit())
+	s.server.idmaps.L2Stations.Traverse(func(key L2StationIDKey, l2stationId hal.L2StationID) bool {
+		l2station, err := s.server.HAL().L2StationGet(l2stationId)
 		if err != nil {
 			s.log.Errorf("L2StationGet error. %d %s", l2stationId, err)
 		} else {
@@ -225,7 +224,7 @@ func (s *APIServer) FindL3Iface(ctxt context.Context, req *api.FindL3IfaceReques
 		return nil, err
 	}
 
-	l3iface, err := opennsl.L3IfaceFind(s.server.Unit(), mac, opennsl.Vlan(req.Vid))
+	l3iface, err := s.server.HAL().L3IfaceFind(mac, hal.Vlan(req.Vid))
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +236,7 @@ func (s *APIServer) FindL3Iface(ctxt context.Context, req *api.FindL3IfaceReques
 // GetL3Iface process api.GetL3IfaceRequest.
 //
 func (s *APIServer) GetL3Iface(ctxt context.Context, req *api.GetL3IfaceRequest) (*api.GetL3IfaceReply, error) {
-	l3iface, err := opennsl.L3IfaceGet(s.server.Unit(), opennsl.L3IfaceID(req.IfaceId))
+	l3iface, err := s.server.HAL().L3IfaceGet(hal.L3IfaceID(req.IfaceId))
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +250,8 @@ func (s *APIServer) GetL3Iface(ctxt context.Context, req *api.GetL3IfaceRequest)
 func (s *APIServer) GetL3Ifaces(ctxt context.Context, req *api.GetL3IfacesRequest) (*api.GetL3IfacesReply, error) {
 
 	l3Ifaces := []*api.L3Iface{}
-	s.server.idmaps.L3Ifaces.Traverse(func(key L3IfaceIDKey, ifaceId opennsl.L3IfaceID) bool {
-		l3iface, err := opennsl.L3IfaceGet(s.server.Unit(), ifaceId)
+	s.server.idmaps.L3Ifaces.Traverse(func(key L3IfaceIDKey, ifaceId hal.L3IfaceID) bool {
+		l3iface, err := s.server.HAL().L3IfaceGet(ifaceId)
 		if err != nil {
 			s.log.Errorf("GetL3Ifaces: L3IfaceGet error. %d %s", ifaceId, err)
 		} else {
@@ -270,9 +269,9 @@ func (s *APIServer) GetL3Ifaces(ctxt context.Context, req *api.GetL3IfacesReques
 //
 func (s *APIServer) GetL3Egresses(ctxt context.Context, req *api.GetL3EgressesRequest) (*api.GetL3EgressesReply, error) {
 	l3egrs := []*api.L3Egress{}
-	err := opennsl.L3EgressTraverse(s.server.Unit(), func(unit int, l3egrId opennsl.L3EgressID, l3egr *opennsl.L3Egress) opennsl.OpenNSLError {
+	err := s.server.HAL().L3EgressTraverse(func(l3egrId hal.L3EgressID, l3egr *hal.L3Egress) error {
 		l3egrs = append(l3egrs, NewL3EgressAPI(l3egrId, l3egr))
-		return opennsl.E_NONE
+		return nil
 	})
 
 	if err != nil {
@@ -287,19 +286,16 @@ func (s *APIServer) GetL3Egresses(ctxt context.Context, req *api.GetL3EgressesRe
 //
 func (s *APIServer) GetL3Hosts(ctxt context.Context, req *api.GetL3HostsRequest) (*api.GetL3HostsReply, error) {
 	hosts := []*api.L3Host{}
-	err := opennsl.L3HostTraverse(s.server.Unit(), 0, 0, l3HostTraverseMax, func(unit int, index int, host *opennsl.L3Host) opennsl.OpenNSLError {
+	f := func(host *hal.L3Host) error {
 		hosts = append(hosts, NewL3HostAPI(host))
-		return opennsl.E_NONE
-	})
+		return nil
+	}
 
-	if err != nil {
+	if err := s.server.HAL().L3HostTraverse(hal.L3_NONE, f); err != nil {
 		return nil, err
 	}
 
-	err = opennsl.L3HostTraverse(s.server.Unit(), uint32(opennsl.L3_IP6), 0, l3HostTraverseMax, func(unit int, index int, host *opennsl.L3Host) opennsl.OpenNSLError {
-		hosts = append(hosts, NewL3HostAPI(host))
-		return opennsl.E_NONE
-	})
+	err := s.server.HAL().L3HostTraverse(hal.L3_IP6, f)
 
 	if err != nil {
 		return nil, err
@@ -313,19 +309,16 @@ func (s *APIServer) GetL3Hosts(ctxt context.Context, req *api.GetL3HostsRequest)
 //
 func (s *APIServer) GetL3Routes(ctxt context.Context, req *api.GetL3RoutesRequest) (*api.GetL3RoutesReply, error) {
 	routes := []*api.L3Route{}
-	err := opennsl.L3RouteTraverse(s.server.Unit(), 0, 0, l3RouteTraverseMax, func(unit int, index int, route *opennsl.L3Route) opennsl.OpenNSLError {
+	f := func(route *hal.L3Route) error {
 		routes = append(routes, NewL3RouteAPI(route))
-		return opennsl.E_NONE
-	})
+		return nil
+	}
 
-	if err != nil {
+	if err := s.server.HAL().L3RouteTraverse(hal.L3_NONE, f); err != nil {
 		return nil, err
 	}
 
-	err = opennsl.L3RouteTraverse(s.server.Unit(), uint32(opennsl.L3_IP6), 0, l3RouteTraverseMax, func(unit int, index int, route *opennsl.L3Route) opennsl.OpenNSLError {
-		routes = append(routes, NewL3RouteAPI(route))
-		return opennsl.E_NONE
-	})
+	err := s.server.HAL().L3RouteTraverse(hal.L3_IP6, f)
 
 	if err != nil {
 		return nil, err
@@ -339,24 +332,36 @@ func (s *APIServer) GetL3Routes(ctxt context.Context, req *api.GetL3RoutesReques
 //
 func (s *APIServer) GetIDMapEntries(ctxt context.Context, req *api.GetIDMapEntriesRequest) (*api.GetIDMapEntriesReply, error) {
 	entries := []*api.IDMapEntry{}
-	s.server.idmaps.L3Ifaces.Traverse(func(key L3IfaceIDKey, value opennsl.L3IfaceID) bool {
+	s.server.idmaps.L3Ifaces.Traverse(func(key L3IfaceIDKey, value hal.L3IfaceID) bool {
 		entry := api.NewIDMapEntry(api.IDMapNameL3Iface, key.String(), uint32(value))
 		entries = append(entries, entry)
 		return true
 	})
 
-	s.server.idmaps.L3Egress.Traverse(func(key L3EgressIDKey, value opennsl.L3EgressID) bool {
+	s.server.idmaps.L3Egress.Traverse(func(key L3EgressIDKey, value hal.L3EgressID) bool {
 		entry := api.NewIDMapEntry(api.IDMapNameL3Egress, key.String(), uint32(value))
 		entries = append(entries, entry)
 		return true
 	})
 
-	s.server.idmaps.Trunks.Traverse(func(key TrunkIDKey, value opennsl.Trunk) bool {
+	s.server.idmaps.Trunks.Traverse(func(key TrunkIDKey, value hal.Trunk) bool {
 		entry := api.NewIDMapEntry(api.IDMapNameTrunk, key.String(), uint32(value))
 		entries = append(entries, entry)
 		return true
 	})
 
+	s.server.idmaps.MPLSEgress.Traverse(func(key MPLSEgressIDKey, value hal.L3EgressID) bool {
+		entry := api.NewIDMapEntry(api.IDMapNameMPLSEgress, key.String(), uint32(value))
+		entries = append(entries, entry)
+		return true
+	})
+
+	s.server.idmaps.MPLSIfaces.Traverse(func(key MPLSIfaceIDKey, value hal.L3IfaceID) bool {
+		entry := api.NewIDMapEntry(api.IDMapNameMPLSIface, key.String(), uint32(value))
+		entries = append(entries, entry)
+		return true
+	})
+
 	return api.NewGetIDMapEntriesReply(entries), nil
 }
 
@@ -365,9 +370,9 @@ func (s *APIServer) GetIDMapEntries(ctxt context.Context, req *api.GetIDMapEntri
 //
 func (s *APIServer) GetTunnelInitiators(ctxt context.Context, req *api.GetTunnelInitiatorsRequest) (*api.GetTunnelInitiatorsReply, error) {
 	tunnels := []*api.TunnelInitiator{}
-	opennsl.TunnelInitiatorTraverse(s.server.Unit(), func(unit int, initiator *opennsl.TunnelInitiator) opennsl.OpenNSLError {
+	s.server.HAL().TunnelInitiatorTraverse(func(initiator *hal.TunnelInitiator) error {
 		tunnels = append(tunnels, NewTunnelInitiatorAPI(initiator))
-		return opennsl.E_NONE
+		return nil
 	})
 	return api.NewGetTunnelInitiatorsReply(tunnels), nil
 }
@@ -377,9 +382,119 @@ func (s *APIServer) GetTunnelInitiators(ctxt context.Context, req *api.GetTunnel
 //
 func (s *APIServer) GetTunnelTerminators(ctxt context.Context, req *api.GetTunnelTerminatorsRequest) (*api.GetTunnelTerminatorsReply, error) {
 	tunnels := []*api.TunnelTerminator{}
-	opennsl.TunnelTerminatorTraverse(s.server.Unit(), func(unit int, terminator *opennsl.TunnelTerminator) opennsl.OpenNSLError {
+	s.server.HAL().TunnelTerminatorTraverse(func(terminator *hal.TunnelTerminator) error {
 		tunnels = append(tunnels, NewTunnelTerminatorAPI(terminator))
-		return opennsl.E_NONE
+		return nil
 	})
 	return api.NewGetTunnelTerminatorsReply(tunnels), nil
 }
+
+//
+// GetMPLSTunnelInitiators process api.GetMPLSTunnelInitiatorsRequest.
+//
+func (s *APIServer) GetMPLSTunnelInitiators(ctxt context.Context, req *api.GetMPLSTunnelInitiatorsRequest) (*api.GetMPLSTunnelInitiatorsReply, error) {
+	tunnels := []*api.MPLSTunnelInitiator{}
+	s.server.idmaps.MPLSIfaces.Traverse(func(key MPLSIfaceIDKey, ifaceID hal.L3IfaceID) bool {
+		labels, err := s.server.HAL().MplsTunnelInitiatorGet(ifaceID, mplsLabelMax)
+		if err != nil {
+			log.Warnf("GetMPLSTunnelInitiators: iface:%d %s", ifaceID, err)
+			return true
+		}
+
+		tunnels = append(tunnels, NewMPLSTunnelInitiatorAPI(ifaceID, labels))
+		return true
+	})
+	return api.NewGetMPLSTunnelInitiatorsReply(tunnels), nil
+}
+
+//
+// GetMPLSTunnelSwitches process api.GetMPLSTunnelSwitchesRequest.
+//
+func (s *APIServer) GetMPLSTunnelSwitches(ctxt context.Context, req *api.GetMPLSTunnelSwitchesRequest) (*api.GetMPLSTunnelSwitchesReply, error) {
+	switches := []*api.MPLSTunnelSwitch{}
+	err := s.server.HAL().MplsTunnelSwitchTraverse(func(tsw *hal.MplsTunnelSwitch) error {
+		switches = append(switches, NewMPLSTunnelSwitchAPI(tsw))
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewGetMPLSTunnelSwitchesReply(switches), nil
+}
+
+//
+// AddMirrorSession process api.AddMirrorSessionRequest.
+//
+func (s *APIServer) AddMirrorSession(ctxt context.Context, req *api.AddMirrorSessionRequest) (*api.AddMirrorSessionReply, error) {
+	m, err := NewMirrorSessionFromAPI(req.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.server.MirrorSessionAdd(m); err != nil {
+		return nil, err
+	}
+
+	return &api.AddMirrorSessionReply{}, nil
+}
+
+//
+// DelMirrorSession process api.DelMirrorSessionRequest.
+//
+func (s *APIServer) DelMirrorSession(ctxt context.Context, req *api.DelMirrorSessionRequest) (*api.DelMirrorSessionReply, error) {
+	if err := s.server.MirrorSessionDelete(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &api.DelMirrorSessionReply{}, nil
+}
+
+//
+// GetMirrorSessions process api.GetMirrorSessionsRequest.
+//
+func (s *APIServer) GetMirrorSessions(ctxt context.Context, req *api.GetMirrorSessionsRequest) (*api.GetMirrorSessionsReply, error) {
+	sessions := []*api.MirrorSession{}
+	for _, m := range s.server.MirrorSessions() {
+		sessions = append(sessions, NewMirrorSessionAPI(m))
+	}
+
+	return api.NewGetMirrorSessionsReply(sessions), nil
+}
+
+//
+// GetCoppStats process api.GetCoppStatsRequest.
+//
+func (s *APIServer) GetCoppStats(ctxt context.Context, req *api.GetCoppStatsRequest) (*api.GetCoppStatsReply, error) {
+	statsList, err := s.server.CoppStats()
+	if err != nil {
+		s.log.Errorf("GetCoppStats: CoppStats error. %s", err)
+		return nil, err
+	}
+
+	stats := make([]*api.CoppClassStats, len(statsList))
+	for index, st := range statsList {
+		stats[index] = NewCoppClassStatsAPI(st)
+	}
+
+	return api.NewGetCoppStatsReply(stats), nil
+}
+
+//
+// GetTrunks process api.GetTrunksRequest.
+//
+func (s *APIServer) GetTrunks(ctxt context.Context, req *api.GetTrunksRequest) (*api.GetTrunksReply, error) {
+	statsList, err := s.server.LagStats()
+	if err != nil {
+		s.log.Errorf("GetTrunks: LagStats error. %s", err)
+		return nil, err
+	}
+
+	trunks := make([]*api.Trunk, len(statsList))
+	for index, st := range statsList {
+		trunks[index] = NewTrunkAPI(st)
+	}
+
+	return api.NewGetTrunksReply(trunks), nil
+}
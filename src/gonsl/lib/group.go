@@ -29,53 +29,23 @@ import (
 //
 func (s *Server) FIBCGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod) {
 	// s.log.Debugf("GroupMod: %v %v", hdr, mod)
-	fibcapi.DispatchGroupMod(hdr, mod, s)
+	if err := fibcapi.DispatchGroupMod(hdr, mod, s); err == nil {
+		s.mods.UpdateGroupMod(mod)
+	}
 }
 
 //
-// FIBCMPLSInterfaceGroupMod process GroupMod(MPLS Interface)
+// FIBCFFMultipartGroupDescRequest process multipart-request(group desc) from fibcd.
 //
-func (s *Server) FIBCMPLSInterfaceGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSInterfaceGroup) {
-	s.log.Debugf("GroupMod(MPLS-IF): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
-}
-
-//
-// FIBCMPLSLabelL2VpnGroupMod process GroupMod(MPLS Label(L2 VPN))
-//
-func (s *Server) FIBCMPLSLabelL2VpnGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
-	s.log.Debugf("GroupMod(MPLS-L2VPN): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
-}
-
-//
-// FIBCMPLSLabelL3VpnGroupMod process GroupMod(MPLS Label(L3 VPN))
-//
-func (s *Server) FIBCMPLSLabelL3VpnGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
-	s.log.Debugf("GroupMod(MPLS-L3VPN): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
-}
+func (s *Server) FIBCFFMultipartGroupDescRequest(hdr *fibcnet.Header, mp *fibcapi.FFMultipart_Request, req *fibcapi.FFMultipart_GroupDescRequest) {
+	s.log.Debugf("Multipart(GroupDesc): %v", hdr)
+	fibcapi.LogFFMultipartRequest(s.log, log.DebugLevel, mp, hdr.Xid)
 
-//
-// FIBCMPLSLabelTun1GroupMod process GroupMod(MPLS Label(Tunnel1))
-//
-func (s *Server) FIBCMPLSLabelTun1GroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
-	s.log.Debugf("GroupMod(MPLS-Tun1): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
-}
+	reply := fibcapi.NewFFMultipart_Reply_GroupDesc(s.DpID(), s.installedGroupMods())
+	if err := s.client.MultipartReply(reply, hdr.Xid); err != nil {
+		s.log.Errorf("Multipart(GroupDesc): Write error. %s", err)
+		return
+	}
 
-//
-// FIBCMPLSLabelTun2GroupMod process GroupMod(MPLS Label(Tunnel2))
-//
-func (s *Server) FIBCMPLSLabelTun2GroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
-	s.log.Debugf("GroupMod(MPLS-Tun2): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
-}
-
-//
-// FIBCMPLSLabelSwapGroupMod process GroupMod(MPLS Label(Swap))
-//
-func (s *Server) FIBCMPLSLabelSwapGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
-	s.log.Debugf("GroupMod(MPLS-Swap): %v", hdr)
-	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+	s.log.Debugf("Multipart(GroupDesc): end.")
 }
@@ -21,13 +21,13 @@ import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
 	"fmt"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *Server) fibcL2InterfaceGroupTrunkAdd(port uint32, vid uint16) error {
+func (s *Server) fibcL2InterfaceGroupTrunkAdd(port uint32, vid uint16, attrs *fibcapi.BondAttrs) error {
 	s.log.Debugf("GroupMod(L2-IF): add Trunk. port:%x vid:%d", port, vid)
 
 	if _, ok := s.idmaps.Trunks.Get(port, vid); ok {
@@ -35,13 +35,19 @@ func (s *Server) fibcL2InterfaceGroupTrunkAdd(port uint32, vid uint16) error {
 		return nil
 	}
 
-	trunk, err := opennsl.TrunkCreate(s.Unit(), opennsl.TRUNK_FLAG_NONE)
+	trunk, err := s.hal.TrunkCreate()
 	if err != nil {
 		s.log.Errorf("GroupMod(L2-IF): create Trunk error. %s", err)
 		return err
 	}
 
 	s.idmaps.Trunks.Register(port, vid, trunk)
+	s.lags.Add(NewLag(port, vid, trunk, attrs))
+
+	if err := s.LagUpdate(port, vid, func(*Lag) {}); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk set error. id:%x %s", trunk, err)
+		return err
+	}
 
 	s.log.Debugf("GroupMod(L2-IF): add Trunk. id:%x port:%x vid:%d", trunk, port, vid)
 
@@ -57,19 +63,33 @@ func (s *Server) fibcL2InterfaceGroupTrunkDel(port uint32, vid uint16) error {
 		return nil
 	}
 
-	if err := trunk.Destroy(s.Unit()); err != nil {
+	if err := s.hal.TrunkDestroy(trunk); err != nil {
 		s.log.Errorf("GroupMod(L2-IF): Trunk destroy error. %d %e", trunk, err)
 		return err
 	}
 
 	s.idmaps.Trunks.Unregister(port, vid)
+	s.lags.Delete(port, vid)
 
 	s.log.Debugf("GroupMod(L2-IF): del Trunk. id:%x port:%x vid:%d", trunk, port, vid)
 
 	return nil
 }
 
-func (s *Server) fibcL2InterfaceGroupTrunkMemberAdd(master, slave uint32, vid uint16) error {
+func (s *Server) fibcL2InterfaceGroupTrunkUpd(port uint32, vid uint16, attrs *fibcapi.BondAttrs) error {
+	s.log.Debugf("GroupMod(L2-IF): upd Trunk. port:%x vid:%d", port, vid)
+
+	if err := s.LagUpdate(port, vid, func(lag *Lag) { lag.Attrs = attrs }); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk update error. port:%x vid:%d %s", port, vid, err)
+		return err
+	}
+
+	s.log.Debugf("GroupMod(L2-IF): upd Trunk. port:%x vid:%d %v", port, vid, attrs)
+
+	return nil
+}
+
+func (s *Server) fibcL2InterfaceGroupTrunkMemberAdd(master, slave uint32, vid uint16, attrs *fibcapi.BondSlaveAttrs) error {
 	s.log.Debugf("GroupMod(L2-IF): add Trunk member. master:%x slave:%x vid:%d", master, slave, vid)
 
 	trunk, ok := s.idmaps.Trunks.Get(master, vid)
@@ -79,18 +99,14 @@ func (s *Server) fibcL2InterfaceGroupTrunkMemberAdd(master, slave uint32, vid ui
 	}
 
 	port, _ := fibcapi.ParseDPPortId(slave)
-	gport, err := opennsl.Port(port).GPortGet(s.Unit())
-	if err != nil {
-		s.log.Errorf("GroupMod(L2-IF): Trunk member gport error. port:%d vid:%d %s",
-			port, vid, err)
+	if err := s.hal.TrunkMemberAdd(trunk, hal.Port(port)); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk member add error. id:%x port:%d vid:%d %s",
+			trunk, port, vid, err)
 		return err
 	}
 
-	member := opennsl.NewTrunkMember()
-	member.SetGPort(gport)
-
-	if err := trunk.MemberAdd(s.Unit(), member); err != nil {
-		s.log.Errorf("GroupMod(L2-IF): Trunk member add error. id:%x port:%d vid:%d %s",
+	if err := s.LagUpdate(master, vid, func(lag *Lag) { lag.SetMember(hal.Port(port), attrs) }); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk set error. id:%x port:%d vid:%d %s",
 			trunk, port, vid, err)
 		return err
 	}
@@ -111,18 +127,14 @@ func (s *Server) fibcL2InterfaceGroupTrunkMemberDel(master, slave uint32, vid ui
 	}
 
 	port, _ := fibcapi.ParseDPPortId(slave)
-	gport, err := opennsl.Port(port).GPortGet(s.Unit())
-	if err != nil {
-		s.log.Errorf("GroupMod(L2-IF): Trunk member gport error. port:%d vid:%d %s",
-			port, vid, err)
+	if err := s.hal.TrunkMemberDelete(trunk, hal.Port(port)); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk member del error. id:%x port:%d vid:%d %s",
+			trunk, port, vid, err)
 		return err
 	}
 
-	member := opennsl.NewTrunkMember()
-	member.SetGPort(gport)
-
-	if err := trunk.MemberDelete(s.Unit(), member); err != nil {
-		s.log.Errorf("GroupMod(L2-IF): Trunk member del error. id:%x port:%d vid:%d %s",
+	if err := s.LagUpdate(master, vid, func(lag *Lag) { lag.DelMember(hal.Port(port)) }); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk set error. id:%x port:%d vid:%d %s",
 			trunk, port, vid, err)
 		return err
 	}
@@ -133,6 +145,22 @@ func (s *Server) fibcL2InterfaceGroupTrunkMemberDel(master, slave uint32, vid ui
 	return nil
 }
 
+func (s *Server) fibcL2InterfaceGroupTrunkMemberUpd(master, slave uint32, vid uint16, attrs *fibcapi.BondSlaveAttrs) error {
+	s.log.Debugf("GroupMod(L2-IF): upd Trunk member. master:%x slave:%x vid:%d", master, slave, vid)
+
+	port, _ := fibcapi.ParseDPPortId(slave)
+	if err := s.LagUpdate(master, vid, func(lag *Lag) { lag.SetMember(hal.Port(port), attrs) }); err != nil {
+		s.log.Errorf("GroupMod(L2-IF): Trunk member update error. master:%x port:%d vid:%d %s",
+			master, port, vid, err)
+		return err
+	}
+
+	s.log.Debugf("GroupMod(L2-IF): upd Trunk member. master:%x port:%d vid:%d %v",
+		master, port, vid, attrs)
+
+	return nil
+}
+
 func (s *Server) fibcL2InterfaceGroupAdd(port uint32, vid uint16, mac string, vrf uint32) error {
 	if _, ok := s.idmaps.L3Ifaces.Get(port, vid); ok {
 		s.log.Warnf("GroupMod(L2-IF): L2-IF already exists. port:%x vid:%d", port, vid)
@@ -145,24 +173,24 @@ func (s *Server) fibcL2InterfaceGroupAdd(port uint32, vid uint16, mac string, vr
 		return err
 	}
 
-	pvid := s.vlanPorts.ConvVID(opennsl.Port(port), opennsl.Vlan(vid))
+	pvid := s.vlanPorts.ConvVID(hal.Port(port), hal.Vlan(vid))
 
-	l3iface := opennsl.NewL3Iface()
-	l3iface.SetMAC(hwaddr)
-	l3iface.SetVID(opennsl.Vlan(pvid))
-	if vrf != 0 {
-		l3iface.SetVRF(opennsl.Vrf(vrf))
+	l3iface := &hal.L3Iface{
+		MAC:  hwaddr,
+		Vlan: pvid,
+		Vrf:  hal.Vrf(vrf),
 	}
 
-	if err := l3iface.Create(s.Unit()); err != nil {
+	ifaceID, err := s.hal.L3IfaceCreate(l3iface)
+	if err != nil {
 		s.log.Errorf("GroupMod(L2-IF): L3Iface create error. %s", err)
 		return err
 	}
 
-	s.idmaps.L3Ifaces.Register(port, vid, l3iface.IfaceID())
+	s.idmaps.L3Ifaces.Register(port, vid, ifaceID)
 
 	s.log.Debugf("GroupMod(L2-IF): add L3Iface. id:%x port:%x vid:%d/%d.",
-		l3iface.IfaceID(), port, vid, pvid)
+		ifaceID, port, vid, pvid)
 
 	return nil
 }
@@ -176,9 +204,7 @@ func (s *Server) fibcL2InterfaceGroupDel(port uint32, vid uint16) error {
 
 	s.idmaps.L3Ifaces.Unregister(port, vid)
 
-	l3iface := opennsl.NewL3Iface()
-	l3iface.SetIfaceID(ifaceID)
-	if err := l3iface.Delete(s.Unit()); err != nil {
+	if err := s.hal.L3IfaceDelete(ifaceID); err != nil {
 		s.log.Errorf("GroupMod(L2-IF): L3Iface delete error. port:%x vid:%d %s", port, vid, err)
 		return err
 	}
@@ -202,18 +228,17 @@ func (s *Server) fibcL2InterfaceGroupUpd(port uint32, vid uint16, mac string, vr
 		return err
 	}
 
-	pvid := s.vlanPorts.ConvVID(opennsl.Port(port), opennsl.Vlan(vid))
+	pvid := s.vlanPorts.ConvVID(hal.Port(port), hal.Vlan(vid))
 
-	l3iface := opennsl.NewL3Iface()
-	l3iface.SetFlags(opennsl.L3_WITH_ID | opennsl.L3_REPLACE)
-	l3iface.SetIfaceID(ifaceID)
-	l3iface.SetMAC(hwaddr)
-	l3iface.SetVID(opennsl.Vlan(pvid))
-	if vrf != 0 {
-		l3iface.SetVRF(opennsl.Vrf(vrf))
+	l3iface := &hal.L3Iface{
+		Flags:   hal.L3_WITH_ID | hal.L3_REPLACE,
+		IfaceID: ifaceID,
+		MAC:     hwaddr,
+		Vlan:    pvid,
+		Vrf:     hal.Vrf(vrf),
 	}
 
-	if err := l3iface.Create(s.Unit()); err != nil {
+	if _, err := s.hal.L3IfaceCreate(l3iface); err != nil {
 		s.log.Errorf("GroupMod(L2-IF): L3Iface create error. %s", err)
 		return err
 	}
@@ -245,9 +270,9 @@ func (s *Server) FIBCL2InterfaceGroupMod(hdr *fibcnet.Header, mod *fibcapi.Group
 		}
 
 		if portType == fibcapi.LinkType_BOND {
-			s.fibcL2InterfaceGroupTrunkAdd(group.PortId, vid)
+			s.fibcL2InterfaceGroupTrunkAdd(group.PortId, vid, group.Bond)
 		} else if masterType == fibcapi.LinkType_BOND {
-			s.fibcL2InterfaceGroupTrunkMemberAdd(group.Master, group.PortId, vid)
+			s.fibcL2InterfaceGroupTrunkMemberAdd(group.Master, group.PortId, vid, group.BondSlave)
 		}
 
 	case fibcapi.GroupMod_DELETE:
@@ -262,6 +287,12 @@ func (s *Server) FIBCL2InterfaceGroupMod(hdr *fibcnet.Header, mod *fibcapi.Group
 	case fibcapi.GroupMod_MODIFY:
 		s.fibcL2InterfaceGroupUpd(group.PortId, vid, group.HwAddr, group.Vrf)
 
+		if portType == fibcapi.LinkType_BOND {
+			s.fibcL2InterfaceGroupTrunkUpd(group.PortId, vid, group.Bond)
+		} else if masterType == fibcapi.LinkType_BOND {
+			s.fibcL2InterfaceGroupTrunkMemberUpd(group.Master, group.PortId, vid, group.BondSlave)
+		}
+
 	default:
 		s.log.Errorf("GroupMod(L2-IF): Invalid command. %d", mod.Cmd)
 	}
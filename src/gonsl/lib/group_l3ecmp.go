@@ -0,0 +1,93 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//
+// FIBCL3EcmpGroupMod process GroupMod(L3 ECMP)
+//
+func (s *Server) FIBCL3EcmpGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.L3EcmpGroup) {
+	s.log.Debugf("GroupMod(L3-ECMP): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	ecmpID := group.EcmpId
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		// ADD of existing ecmp replaces it in place as well as MODIFY.
+		oldEcmpEgrID, exists := s.idmaps.L3Ecmps.Get(ecmpID)
+
+		members := []hal.L3EgressID{}
+		for _, neid := range group.NeIds {
+			l3egrID, ok := s.idmaps.L3Egress.Get(neid)
+			if !ok {
+				s.log.Warnf("GroupMod(L3-ECMP): L3-UC(neid:%08x) not found.", neid)
+				continue
+			}
+			members = append(members, l3egrID)
+		}
+
+		if len(members) == 0 {
+			s.log.Errorf("GroupMod(L3-ECMP): no L3-UC in L3-ECMP(%d).", ecmpID)
+			return
+		}
+
+		ecmp := &hal.L3EgressEcmp{
+			MaxPaths: len(members),
+			Members:  members,
+		}
+		if exists {
+			ecmp.Flags = hal.L3_REPLACE | hal.L3_WITH_ID
+			ecmp.EgressID = oldEcmpEgrID
+		}
+
+		ecmpEgrID, err := s.hal.L3EgressEcmpCreate(ecmp)
+		if err != nil {
+			s.log.Errorf("GroupMod(L3-ECMP): L3 Egress ECMP create error. %s", err)
+			return
+		}
+
+		s.log.Debugf("GroupMod(L3-ECMP): L3-ECMP(%d) l3eg:%d members:%v", ecmpID, ecmpEgrID, members)
+
+		s.idmaps.L3Ecmps.Register(ecmpID, ecmpEgrID)
+
+		for _, oldMember := range s.egrRefs.SetMembers(ecmpEgrID, members) {
+			s.l3EgressUnref(oldMember)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		ecmpEgrID, ok := s.idmaps.L3Ecmps.Get(ecmpID)
+		if !ok {
+			s.log.Errorf("GroupMod(L3-ECMP): L3-ECMP(%d) not found.", ecmpID)
+			return
+		}
+
+		s.idmaps.L3Ecmps.Unregister(ecmpID)
+		s.l3EgressRelease(ecmpEgrID)
+
+	default:
+		s.log.Errorf("GroupMod(L3-ECMP): Invalid Cmd. %d", mod.Cmd)
+	}
+}
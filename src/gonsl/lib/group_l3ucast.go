@@ -20,8 +20,8 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -37,7 +37,7 @@ func (s *Server) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMo
 	_, portType := fibcapi.ParseDPPortId(group.PortId)
 
 	var (
-		trunk   opennsl.Trunk
+		trunk   hal.Trunk
 		isTrunk bool
 	)
 
@@ -50,11 +50,9 @@ func (s *Server) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMo
 
 	switch mod.Cmd {
 	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
-		oldL3egrID, ok := s.idmaps.L3Egress.Get(neid)
-		if ok && (mod.Cmd != fibcapi.GroupMod_MODIFY) {
-			s.log.Errorf("GroupMod(L3-UC): L3-UC(neid:%d) already exists. ", neid)
-			return
-		}
+		// ADD of existing neighbor (e.g. NEWNEIGH by mac change)
+		// replaces l3 egress in place as well as MODIFY.
+		oldL3egrID, exists := s.idmaps.L3Egress.Get(neid)
 
 		ifaceID, ok := s.idmaps.L3Ifaces.Get(group.PortId, vid)
 		if !ok {
@@ -62,29 +60,30 @@ func (s *Server) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMo
 			return
 		}
 
-		flags := opennsl.L3_NONE
-		if mod.Cmd == fibcapi.GroupMod_MODIFY {
-			flags = opennsl.L3_REPLACE | opennsl.L3_WITH_ID
+		flags := hal.L3_NONE
+		if exists {
+			flags = hal.L3_REPLACE | hal.L3_WITH_ID
 		}
 
-		phyPort := opennsl.Port(group.PhyPortId)
-		pvid := s.vlanPorts.ConvVID(phyPort, opennsl.VlanDefaultMustGet(s.Unit()))
+		phyPort := hal.Port(group.PhyPortId)
+		pvid := s.vlanPorts.ConvVID(phyPort, s.hal.VlanDefault())
 
-		tunnelInitiatorAdd(s.Unit(), group, ifaceID, pvid)
-		tunnelTerminatorAdd(s.Unit(), group)
+		tunnelInitiatorAdd(s.hal, group, ifaceID, pvid)
+		tunnelTerminatorAdd(s.hal, group)
 
-		l3egr := opennsl.NewL3Egress()
-		l3egr.SetIfaceID(opennsl.L3IfaceID(ifaceID))
-		l3egr.SetMAC(group.GetEthDstHwAddr())
-		l3egr.SetVID(opennsl.Vlan(pvid))
+		l3egr := &hal.L3Egress{
+			IfaceID: ifaceID,
+			MAC:     group.GetEthDstHwAddr(),
+			Vlan:    pvid,
+		}
 		if isTrunk {
-			flags |= opennsl.L3_TGID
-			l3egr.SetTrunk(trunk)
+			flags |= hal.L3_TGID
+			l3egr.Trunk = trunk
 		} else {
-			l3egr.SetPort(phyPort)
+			l3egr.Port = phyPort
 		}
 
-		l3egrID, err := l3egr.Create(s.Unit(), flags, oldL3egrID)
+		l3egrID, err := s.hal.L3EgressCreate(flags, l3egr, oldL3egrID)
 		if err != nil {
 			s.log.Errorf("GroupMod(L3-UC): L3 Egress create error. %s", err)
 			return
@@ -100,12 +99,9 @@ func (s *Server) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMo
 		}
 
 		s.idmaps.L3Egress.Unregister(neid)
+		s.l3EgressRelease(l3egrID)
 
-		if err := l3egrID.Destroy(s.Unit()); err != nil {
-			s.log.Errorf("GroupMod(L3-UC): L3 Egress delete error. %s", err)
-		}
-
-		tunnelTerminatorDelete(s.Unit(), group)
+		tunnelTerminatorDelete(s.hal, group)
 
 		vid := group.GetAdjustedVlanVid()
 		ifaceID, ok := s.idmaps.L3Ifaces.Get(group.PortId, vid)
@@ -114,7 +110,7 @@ func (s *Server) FIBCL3UnicastGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMo
 			return
 		}
 
-		tunnelInitiatorDelete(s.Unit(), group, ifaceID)
+		tunnelInitiatorDelete(s.hal, group, ifaceID)
 
 	default:
 		s.log.Errorf("GroupMod(L3-UC): Invalid Cmd. %d", mod.Cmd)
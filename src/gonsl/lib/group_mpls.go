@@ -0,0 +1,420 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	"fmt"
+	hal "gonsl/hal"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	mplsLabelTTL    = 64
+	mplsLabelMaxNum = 2
+)
+
+//
+// NewMPLSLabelGroupID returns group id of mpls label group.
+//
+func NewMPLSLabelGroupID(gtype fibcapi.GroupMod_GType, dstId uint32) uint32 {
+	return fibcapi.NewMPLSLabelGroupID(fibcapi.MPLSLabelGroup_subtype[gtype], dstId)
+}
+
+//
+// mplsLowerGroupID returns the id of the group that mpls label group points to.
+// - ne_id != 0      : MPLS Interface group (neighbor)
+// - new_dst_id != 0 : MPLS Tunnel1 group (inner label of double label)
+//
+func mplsLowerGroupID(group *fibcapi.MPLSLabelGroup) uint32 {
+	if group.NeId != 0 {
+		return fibcapi.NewMPLSInterfaceGroupID(group.NeId)
+	}
+	return NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_TUNNEL1, group.NewDstId)
+}
+
+func newMPLSEgressLabel(label uint32) *hal.MplsEgressLabel {
+	return &hal.MplsEgressLabel{
+		Flags: hal.MPLS_EGRESS_LABEL_TTL_DECREMENT,
+		Label: hal.MplsLabel(label),
+		TTL:   mplsLabelTTL,
+	}
+}
+
+//
+// FIBCMPLSInterfaceGroupMod process GroupMod(MPLS Interface)
+//
+func (s *Server) FIBCMPLSInterfaceGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSInterfaceGroup) {
+	s.log.Debugf("GroupMod(MPLS-IF): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	gid := fibcapi.NewMPLSInterfaceGroupID(group.NeId)
+	vid := group.GetAdjustedVlanVid()
+	_, portType := fibcapi.ParseDPPortId(group.PortId)
+
+	var (
+		trunk   hal.Trunk
+		isTrunk bool
+	)
+
+	if portType == fibcapi.LinkType_BOND {
+		if trunk, isTrunk = s.idmaps.Trunks.Get(group.PortId, vid); !isTrunk {
+			s.log.Errorf("GroupMod(MPLS-IF): Trunk(port:%d, vid:%d) not found.", group.PortId, vid)
+			return
+		}
+	}
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		// ADD of existing neighbor replaces l3 egress in place as well as MODIFY.
+		oldL3egrID, exists := s.idmaps.MPLSEgress.Get(gid)
+
+		ifaceID, ok := s.idmaps.L3Ifaces.Get(group.PortId, vid)
+		if !ok {
+			s.log.Errorf("GroupMod(MPLS-IF): L2-IF(port:%d, vid:%d) not found.", group.PortId, vid)
+			return
+		}
+
+		flags := hal.L3_NONE
+		if exists {
+			flags = hal.L3_REPLACE | hal.L3_WITH_ID
+		}
+
+		port := hal.Port(group.PortId)
+		pvid := s.vlanPorts.ConvVID(port, s.hal.VlanDefault())
+
+		l3egr := &hal.L3Egress{
+			IfaceID: ifaceID,
+			MAC:     group.GetEthDstHwAddr(),
+			Vlan:    pvid,
+		}
+		if isTrunk {
+			flags |= hal.L3_TGID
+			l3egr.Trunk = trunk
+		} else {
+			l3egr.Port = port
+		}
+
+		l3egrID, err := s.hal.L3EgressCreate(flags, l3egr, oldL3egrID)
+		if err != nil {
+			s.log.Errorf("GroupMod(MPLS-IF): L3 Egress create error. %s", err)
+			return
+		}
+
+		s.idmaps.MPLSEgress.Register(gid, l3egrID)
+
+		if exists {
+			s.mplsUpdateDependents(gid)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		l3egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+		if !ok {
+			s.log.Errorf("GroupMod(MPLS-IF): MPLS-IF(%08x) not found.", gid)
+			return
+		}
+
+		s.idmaps.MPLSEgress.Unregister(gid)
+		s.l3EgressRelease(l3egrID)
+
+	default:
+		s.log.Errorf("GroupMod(MPLS-IF): Invalid Cmd. %d", mod.Cmd)
+	}
+}
+
+//
+// FIBCMPLSLabelL2VpnGroupMod process GroupMod(MPLS Label(L2 VPN))
+//
+func (s *Server) FIBCMPLSLabelL2VpnGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
+	s.log.Debugf("GroupMod(MPLS-L2VPN): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	s.log.Warnf("GroupMod(MPLS-L2VPN): not supported.")
+}
+
+//
+// FIBCMPLSLabelL3VpnGroupMod process GroupMod(MPLS Label(L3 VPN))
+//
+func (s *Server) FIBCMPLSLabelL3VpnGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
+	s.log.Debugf("GroupMod(MPLS-L3VPN): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	s.mplsLabelEgressMod("MPLS-L3VPN", mod.Cmd, group)
+}
+
+//
+// FIBCMPLSLabelTun1GroupMod process GroupMod(MPLS Label(Tunnel1))
+//
+func (s *Server) FIBCMPLSLabelTun1GroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
+	s.log.Debugf("GroupMod(MPLS-Tun1): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	s.mplsTunnelEgressMod("MPLS-Tun1", mod.Cmd, group)
+}
+
+//
+// FIBCMPLSLabelTun2GroupMod process GroupMod(MPLS Label(Tunnel2))
+//
+func (s *Server) FIBCMPLSLabelTun2GroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
+	s.log.Debugf("GroupMod(MPLS-Tun2): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	s.mplsTunnelEgressMod("MPLS-Tun2", mod.Cmd, group)
+}
+
+//
+// FIBCMPLSLabelSwapGroupMod process GroupMod(MPLS Label(Swap))
+//
+func (s *Server) FIBCMPLSLabelSwapGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.MPLSLabelGroup) {
+	s.log.Debugf("GroupMod(MPLS-Swap): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	s.mplsLabelEgressMod("MPLS-Swap", mod.Cmd, group)
+}
+
+//
+// mplsLowerEgress returns L3 Egress which mpls label group points to.
+//
+func (s *Server) mplsLowerEgress(group *fibcapi.MPLSLabelGroup) (*hal.L3Egress, error) {
+	lowerID := mplsLowerGroupID(group)
+	lowerEgrID, ok := s.idmaps.MPLSEgress.Get(lowerID)
+	if !ok {
+		return nil, fmt.Errorf("lower group(%08x) not found", lowerID)
+	}
+
+	return s.hal.L3EgressGet(lowerEgrID)
+}
+
+//
+// newMPLSEgress returns new L3 Egress copied from lower egress.
+//
+func newMPLSEgress(lower *hal.L3Egress, ifaceID hal.L3IfaceID) *hal.L3Egress {
+	l3egr := &hal.L3Egress{
+		IfaceID: ifaceID,
+		MAC:     lower.MAC,
+		Vlan:    lower.Vlan,
+	}
+	if lower.IsTrunk() {
+		l3egr.Trunk = lower.Trunk
+	} else {
+		l3egr.Port = lower.Port
+	}
+	return l3egr
+}
+
+//
+// mplsTunnelLabels returns labels pushed by mpls tunnel group (outermost first).
+// Tunnel2 pushes the labels of the lower Tunnel1 group as outer labels
+// because its L3 Interface replaces the one of Tunnel1.
+//
+func (s *Server) mplsTunnelLabels(group *fibcapi.MPLSLabelGroup, lower *hal.L3Egress) ([]*hal.MplsEgressLabel, error) {
+	labels := []*hal.MplsEgressLabel{}
+	if group.GType == fibcapi.GroupMod_MPLS_TUNNEL2 {
+		lowerLabels, err := s.hal.MplsTunnelInitiatorGet(lower.IfaceID, mplsLabelMaxNum-1)
+		if err != nil {
+			return nil, fmt.Errorf("lower labels not found. iface:%d %s", lower.IfaceID, err)
+		}
+		labels = append(labels, lowerLabels...)
+	}
+
+	return append(labels, newMPLSEgressLabel(group.NewLabel)), nil
+}
+
+//
+// mplsUpdateDependents re-writes L3 Egress of mpls label groups pointing to
+// the group (gid) after it is replaced, because they hold a copy of
+// MAC, port and vlan of the lower egress.
+//
+func (s *Server) mplsUpdateDependents(gid uint32) {
+	for _, mod := range s.mods.ListGroupMods() {
+		group := mod.GetMplsLabel()
+		if group == nil || mplsLowerGroupID(group) != gid {
+			continue
+		}
+
+		s.log.Debugf("GroupMod(MPLS): %08x update %s %08x", gid, mod.GType, mod.GroupID())
+
+		switch mod.GType {
+		case fibcapi.GroupMod_MPLS_L3_VPN:
+			s.mplsLabelEgressMod("MPLS-L3VPN", fibcapi.GroupMod_MODIFY, group)
+
+		case fibcapi.GroupMod_MPLS_SWAP:
+			s.mplsLabelEgressMod("MPLS-Swap", fibcapi.GroupMod_MODIFY, group)
+
+		case fibcapi.GroupMod_MPLS_TUNNEL1:
+			s.mplsTunnelEgressMod("MPLS-Tun1", fibcapi.GroupMod_MODIFY, group)
+
+		case fibcapi.GroupMod_MPLS_TUNNEL2:
+			s.mplsTunnelEgressMod("MPLS-Tun2", fibcapi.GroupMod_MODIFY, group)
+		}
+	}
+}
+
+//
+// mplsLabelEgressMod creates L3 Egress with a label (L3 VPN, Swap).
+// The label is pushed (L3 VPN) or set as swapped label (Swap)
+// on top of the labels of the lower group.
+//
+func (s *Server) mplsLabelEgressMod(name string, cmd fibcapi.GroupMod_Cmd, group *fibcapi.MPLSLabelGroup) {
+	gid := NewMPLSLabelGroupID(group.GType, group.DstId)
+
+	switch cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		oldL3egrID, exists := s.idmaps.MPLSEgress.Get(gid)
+
+		lower, err := s.mplsLowerEgress(group)
+		if err != nil {
+			s.log.Errorf("GroupMod(%s): %08x %s", name, gid, err)
+			return
+		}
+
+		flags := lower.Flags & hal.L3_TGID
+		if exists {
+			flags |= hal.L3_REPLACE | hal.L3_WITH_ID
+		}
+
+		l3egr := newMPLSEgress(lower, lower.IfaceID)
+		l3egr.MplsLabel = newMPLSEgressLabel(group.NewLabel)
+
+		l3egrID, err := s.hal.L3EgressCreate(flags, l3egr, oldL3egrID)
+		if err != nil {
+			s.log.Errorf("GroupMod(%s): L3 Egress create error. %s", name, err)
+			return
+		}
+
+		s.log.Debugf("GroupMod(%s): %08x label:%d l3egr:%d", name, gid, group.NewLabel, l3egrID)
+
+		s.idmaps.MPLSEgress.Register(gid, l3egrID)
+
+		if exists {
+			s.mplsUpdateDependents(gid)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		l3egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+		if !ok {
+			s.log.Errorf("GroupMod(%s): %08x not found.", name, gid)
+			return
+		}
+
+		s.idmaps.MPLSEgress.Unregister(gid)
+		s.l3EgressRelease(l3egrID)
+
+	default:
+		s.log.Errorf("GroupMod(%s): Invalid Cmd. %d", name, cmd)
+	}
+}
+
+//
+// mplsTunnelEgressMod creates L3 Interface with MPLS tunnel initiator
+// and L3 Egress on it (Tunnel1, Tunnel2).
+//
+func (s *Server) mplsTunnelEgressMod(name string, cmd fibcapi.GroupMod_Cmd, group *fibcapi.MPLSLabelGroup) {
+	gid := NewMPLSLabelGroupID(group.GType, group.DstId)
+
+	switch cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		oldL3egrID, exists := s.idmaps.MPLSEgress.Get(gid)
+
+		lower, err := s.mplsLowerEgress(group)
+		if err != nil {
+			s.log.Errorf("GroupMod(%s): %08x %s", name, gid, err)
+			return
+		}
+
+		ifaceID, ok := s.idmaps.MPLSIfaces.Get(gid)
+		if !ok {
+			baseIface, err := s.hal.L3IfaceGet(lower.IfaceID)
+			if err != nil {
+				s.log.Errorf("GroupMod(%s): L3 Iface(%d) not found. %s", name, lower.IfaceID, err)
+				return
+			}
+
+			l3iface := &hal.L3Iface{
+				MAC:  baseIface.MAC,
+				Vlan: baseIface.Vlan,
+				Vrf:  baseIface.Vrf,
+			}
+			if ifaceID, err = s.hal.L3IfaceCreate(l3iface); err != nil {
+				s.log.Errorf("GroupMod(%s): L3 Iface create error. %s", name, err)
+				return
+			}
+
+			s.idmaps.MPLSIfaces.Register(gid, ifaceID)
+		}
+
+		labels, err := s.mplsTunnelLabels(group, lower)
+		if err != nil {
+			s.log.Errorf("GroupMod(%s): %08x %s", name, gid, err)
+			return
+		}
+
+		if err := s.hal.MplsTunnelInitiatorSet(ifaceID, labels); err != nil {
+			s.log.Errorf("GroupMod(%s): MPLS tunnel initiator set error. iface:%d %s", name, ifaceID, err)
+			return
+		}
+
+		flags := lower.Flags & hal.L3_TGID
+		if exists {
+			flags |= hal.L3_REPLACE | hal.L3_WITH_ID
+		}
+
+		l3egr := newMPLSEgress(lower, ifaceID)
+		l3egrID, err := s.hal.L3EgressCreate(flags, l3egr, oldL3egrID)
+		if err != nil {
+			s.log.Errorf("GroupMod(%s): L3 Egress create error. %s", name, err)
+			return
+		}
+
+		s.log.Debugf("GroupMod(%s): %08x label:%d iface:%d l3egr:%d", name, gid, group.NewLabel, ifaceID, l3egrID)
+
+		s.idmaps.MPLSEgress.Register(gid, l3egrID)
+
+		if exists {
+			s.mplsUpdateDependents(gid)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		if l3egrID, ok := s.idmaps.MPLSEgress.Get(gid); ok {
+			s.idmaps.MPLSEgress.Unregister(gid)
+			s.l3EgressRelease(l3egrID)
+		} else {
+			s.log.Errorf("GroupMod(%s): %08x not found.", name, gid)
+		}
+
+		ifaceID, ok := s.idmaps.MPLSIfaces.Get(gid)
+		if !ok {
+			return
+		}
+
+		s.idmaps.MPLSIfaces.Unregister(gid)
+
+		if err := s.hal.MplsTunnelInitiatorClear(ifaceID); err != nil {
+			s.log.Errorf("GroupMod(%s): MPLS tunnel initiator clear error. iface:%d %s", name, ifaceID, err)
+		}
+
+		if err := s.hal.L3IfaceDelete(ifaceID); err != nil {
+			s.log.Errorf("GroupMod(%s): L3 Iface delete error. iface:%d %s", name, ifaceID, err)
+		}
+
+	default:
+		s.log.Errorf("GroupMod(%s): Invalid Cmd. %d", name, cmd)
+	}
+}
@@ -0,0 +1,125 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	"fmt"
+	hal "gonsl/hal"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//
+// NewSRv6EncapBindKey returns new L3EgressBindKey of srv6 encap.
+// It keeps underlay l3 egress alive while encap refers it.
+//
+func NewSRv6EncapBindKey(gid uint32) L3EgressBindKey {
+	return L3EgressBindKey{
+		Host: false,
+		Dst:  fmt.Sprintf("srv6:%08x", gid),
+	}
+}
+
+//
+// FIBCSRv6EncapGroupMod process GroupMod(SRv6 Encap)
+//
+func (s *Server) FIBCSRv6EncapGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.SRv6EncapGroup) {
+	s.log.Debugf("GroupMod(SRv6-ENCAP): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	gid := fibcapi.NewSRv6EncapGroupID(group.EncapId)
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD:
+		if _, ok := s.idmaps.SRv6Encaps.Get(gid); ok {
+			s.log.Errorf("GroupMod(SRv6-ENCAP): SRv6-ENCAP(%08x) already exists.", gid)
+			return
+		}
+
+		if err := s.srv6EncapAdd(gid, group); err != nil {
+			s.log.Errorf("GroupMod(SRv6-ENCAP): encap add error. %s", err)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		if err := s.srv6EncapDelete(gid); err != nil {
+			s.log.Errorf("GroupMod(SRv6-ENCAP): encap delete error. %s", err)
+		}
+
+	default:
+		s.log.Errorf("GroupMod(SRv6-ENCAP): Invalid Cmd. %d", mod.Cmd)
+	}
+}
+
+//
+// srv6EncapAdd creates srv6 encap via underlay l3 egress.
+//
+func (s *Server) srv6EncapAdd(gid uint32, group *fibcapi.SRv6EncapGroup) error {
+	egrID, ok := s.idmaps.L3Egress.Get(group.NeId)
+	if !ok {
+		return fmt.Errorf("L3-UC(neid:%08x) not found. encap(%08x)", group.NeId, gid)
+	}
+
+	encap := &hal.SRv6Encap{
+		Mode:     hal.SRv6EncapMode(group.Mode),
+		Segments: group.GetSegmentIPs(),
+		Egress:   egrID,
+	}
+
+	encapID, err := s.hal.SRv6EncapCreate(encap)
+	if err != nil {
+		return err
+	}
+
+	s.idmaps.SRv6Encaps.Register(gid, encapID)
+
+	if oldID, ok := s.egrRefs.Bind(NewSRv6EncapBindKey(gid), egrID); ok {
+		s.l3EgressUnref(oldID)
+	}
+
+	return nil
+}
+
+//
+// srv6EncapDelete destroys srv6 encap.
+// It fails if routes still refer the encap.
+//
+func (s *Server) srv6EncapDelete(gid uint32) error {
+	encapID, ok := s.idmaps.SRv6Encaps.Get(gid)
+	if !ok {
+		return fmt.Errorf("SRv6-ENCAP(%08x) not found.", gid)
+	}
+
+	if r, ok := s.egrRefs.Get(encapID); ok && r.Refs > 0 {
+		return fmt.Errorf("SRv6-ENCAP(%08x) is in use. refs:%d", gid, r.Refs)
+	}
+
+	if err := s.hal.SRv6EncapDestroy(encapID); err != nil {
+		return err
+	}
+
+	s.idmaps.SRv6Encaps.Unregister(gid)
+	s.egrRefs.Remove(encapID)
+
+	if egrID, ok := s.egrRefs.Unbind(NewSRv6EncapBindKey(gid)); ok {
+		s.l3EgressUnref(egrID)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	fibcnet "fabricflow/fibc/net"
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//
+// FIBCVxlanTunnelMod process GroupMod(VXLAN Tunnel)
+//
+func (s *Server) FIBCVxlanTunnelMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, tun *fibcapi.VxlanTunnel) {
+	s.log.Debugf("GroupMod(VXLAN-TUN): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		if _, ok := s.vxlans.Tunnel(tun.TunPortId); ok {
+			if mod.Cmd != fibcapi.GroupMod_MODIFY {
+				s.log.Errorf("GroupMod(VXLAN-TUN): tunnel(%d) already exists.", tun.TunPortId)
+				return
+			}
+
+			if err := s.vxlanTunnelDelete(tun.TunPortId); err != nil {
+				s.log.Errorf("GroupMod(VXLAN-TUN): tunnel delete error. %s", err)
+			}
+		}
+
+		if err := s.vxlanTunnelAdd(tun); err != nil {
+			s.log.Errorf("GroupMod(VXLAN-TUN): tunnel add error. %s", err)
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		if err := s.vxlanTunnelDelete(tun.TunPortId); err != nil {
+			s.log.Errorf("GroupMod(VXLAN-TUN): tunnel delete error. %s", err)
+		}
+
+	default:
+		s.log.Errorf("GroupMod(VXLAN-TUN): Invalid Cmd. %d", mod.Cmd)
+	}
+}
+
+//
+// FIBCL2OverlayGroupMod process GroupMod(L2 Overlay Flood)
+//
+func (s *Server) FIBCL2OverlayGroupMod(hdr *fibcnet.Header, mod *fibcapi.GroupMod, group *fibcapi.L2OverlayGroup) {
+	s.log.Debugf("GroupMod(L2-OVERLAY): %v", hdr)
+	fibcapi.LogGroupMod(s.log, log.DebugLevel, mod)
+
+	vni := group.TunnelId
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		if ok := s.vxlans.SetFlood(vni, group.TunPorts); !ok {
+			s.log.Errorf("GroupMod(L2-OVERLAY): vpn(vni:%d) not found.", vni)
+			return
+		}
+
+	case fibcapi.GroupMod_DELETE:
+		if ok := s.vxlans.SetFlood(vni, []uint32{}); !ok {
+			s.log.Debugf("GroupMod(L2-OVERLAY): vpn(vni:%d) already removed.", vni)
+			return
+		}
+
+	default:
+		s.log.Errorf("GroupMod(L2-OVERLAY): Invalid Cmd. %d", mod.Cmd)
+		return
+	}
+
+	if err := s.vxlanFloodUpdate(vni); err != nil {
+		s.log.Errorf("GroupMod(L2-OVERLAY): flood set error. %s", err)
+	}
+}
+
+//
+// vxlanVpnRef returns vpn of vni and increments reference count.
+// vpn is created if it does not exist.
+//
+func (s *Server) vxlanVpnRef(vni uint32, vid hal.Vlan) (hal.VxlanVpnID, error) {
+	if vpn, ok := s.vxlans.Vpn(vni); ok {
+		if vpn.Vlan != vid {
+			s.log.Warnf("VXLAN: vpn(vni:%d) is bound to vlan %d, not %d.", vni, vpn.Vlan, vid)
+		}
+
+		s.vxlans.RefVpn(vni, vpn.Vlan, vpn.VpnID)
+		return vpn.VpnID, nil
+	}
+
+	vpnID, err := s.hal.VxlanVpnCreate(&hal.VxlanVpn{Vni: vni, Vlan: vid})
+	if err != nil {
+		return 0, err
+	}
+
+	s.vxlans.RefVpn(vni, vid, vpnID)
+	return vpnID, nil
+}
+
+//
+// vxlanVpnUnref decrements reference count of vpn and
+// destroys it if no longer referred.
+//
+func (s *Server) vxlanVpnUnref(vni uint32) {
+	vpn, ok := s.vxlans.Vpn(vni)
+	if !ok {
+		return
+	}
+
+	if s.vxlans.UnrefVpn(vni) {
+		if err := s.hal.VxlanVpnDestroy(vpn.VpnID); err != nil {
+			s.log.Errorf("VXLAN: vpn(vni:%d) destroy error. %s", vni, err)
+		}
+	}
+}
+
+//
+// vxlanFloodUpdate sets network ports of flood list to vpn.
+//
+func (s *Server) vxlanFloodUpdate(vni uint32) error {
+	vpn, ok := s.vxlans.Vpn(vni)
+	if !ok {
+		return fmt.Errorf("vpn(vni:%d) not found.", vni)
+	}
+
+	return s.hal.VxlanFloodSet(vpn.VpnID, s.vxlans.FloodPorts(vni))
+}
+
+//
+// vxlanTunnelAdd adds network port to remote vtep via underlay l3 egress,
+// and restores flood list and l2 addresses via the tunnel.
+//
+func (s *Server) vxlanTunnelAdd(tun *fibcapi.VxlanTunnel) error {
+	vid := hal.Vlan(tun.VlanVid)
+	if vid == 0 {
+		return fmt.Errorf("tunnel(%d) is not bound to vlan.", tun.TunPortId)
+	}
+
+	egrID, ok := s.idmaps.L3Egress.Get(tun.NeId)
+	if !ok {
+		return fmt.Errorf("L3-UC(neid:%d) not found. tunnel(%d)", tun.NeId, tun.TunPortId)
+	}
+
+	vpnID, err := s.vxlanVpnRef(tun.Vni, vid)
+	if err != nil {
+		return err
+	}
+
+	port := &hal.VxlanPort{
+		VpnID:      vpnID,
+		SrcIP:      tun.GetLocalIP(),
+		DstIP:      tun.GetRemoteIP(),
+		UdpDstPort: hal.L4Port(tun.GetAdjustedUdpPort()),
+		TTL:        int(tun.Ttl),
+		Egress:     egrID,
+	}
+
+	portID, err := s.hal.VxlanPortAdd(port)
+	if err != nil {
+		s.vxlanVpnUnref(tun.Vni)
+		return err
+	}
+
+	if oldID, ok := s.egrRefs.Bind(NewVxlanTunnelBindKey(tun.TunPortId), egrID); ok {
+		s.l3EgressUnref(oldID)
+	}
+
+	s.vxlans.AddTunnel(&VxlanTunnelEntry{
+		TunPortID: tun.TunPortId,
+		Vni:       tun.Vni,
+		Port:      port,
+		PortID:    portID,
+	})
+
+	for _, mac := range s.vxlans.L2Addrs(tun.Vni, tun.TunPortId) {
+		l2addr := &hal.VxlanL2Addr{VpnID: vpnID, MAC: mac, PortID: portID}
+		if err := s.hal.VxlanL2AddrAdd(l2addr); err != nil {
+			s.log.Errorf("VXLAN: l2addr restore error. %s %s", l2addr, err)
+		}
+	}
+
+	return s.vxlanFloodUpdate(tun.Vni)
+}
+
+//
+// vxlanTunnelDelete deletes network port to remote vtep.
+// l2 addresses via the tunnel are kept in table to be restored.
+//
+func (s *Server) vxlanTunnelDelete(tunPortID uint32) error {
+	tun, ok := s.vxlans.DeleteTunnel(tunPortID)
+	if !ok {
+		return fmt.Errorf("tunnel(%d) not found.", tunPortID)
+	}
+
+	if err := s.vxlanFloodUpdate(tun.Vni); err != nil {
+		s.log.Warnf("VXLAN: flood set error. %s", err)
+	}
+
+	err := s.hal.VxlanPortDelete(tun.Port.VpnID, tun.PortID)
+
+	if egrID, ok := s.egrRefs.Unbind(NewVxlanTunnelBindKey(tunPortID)); ok {
+		s.l3EgressUnref(egrID)
+	}
+
+	s.vxlanVpnUnref(tun.Vni)
+
+	return err
+}
+
+//
+// vxlanBridgingFlowMod adds or deletes l2 address of remote vtep.
+// It is deferred until tunnel is added if tunnel does not exist.
+//
+func (s *Server) vxlanBridgingFlowMod(cmd fibcapi.FlowMod_Cmd, vni uint32, mac net.HardwareAddr, tunPortID uint32) error {
+	switch cmd {
+	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY:
+		s.vxlans.AddL2Addr(vni, mac, tunPortID)
+
+		tun, ok := s.vxlans.Tunnel(tunPortID)
+		if !ok || tun.Vni != vni {
+			s.log.Debugf("VXLAN: l2addr %s deferred. tunnel(%d) not found.", mac, tunPortID)
+			return nil
+		}
+
+		return s.hal.VxlanL2AddrAdd(&hal.VxlanL2Addr{
+			VpnID:  tun.Port.VpnID,
+			MAC:    mac,
+			PortID: tun.PortID,
+		})
+
+	case fibcapi.FlowMod_DELETE:
+		s.vxlans.DeleteL2Addr(vni, mac)
+
+		vpn, ok := s.vxlans.Vpn(vni)
+		if !ok {
+			return nil
+		}
+
+		return s.hal.VxlanL2AddrDelete(vpn.VpnID, mac)
+
+	default:
+		return fmt.Errorf("Invalid cmd. %d", cmd)
+	}
+}
@@ -54,6 +54,10 @@ func TestFlowHandlers(t *testing.T) {
 	if _, ok := s.(fibcapi.FIBCPolicyACLFlowModHandler); !ok {
 		t.Errorf("Server not implement handler(FIBCPolicyACLFlowModHandler)")
 	}
+
+	if _, ok := s.(fibcapi.FIBCSRv6LocalSidFlowModHandler); !ok {
+		t.Errorf("Server not implement handler(FIBCSRv6LocalSidFlowModHandler)")
+	}
 }
 
 func TestGroupHandlers(t *testing.T) {
@@ -69,6 +73,11 @@ func TestGroupHandlers(t *testing.T) {
 		t.Errorf("Server not implement handler(FIBCL3UnicastGroupModHandler)")
 	}
 
+	// L3EcmpGroup
+	if _, ok := s.(fibcapi.FIBCL3EcmpGroupModHandler); !ok {
+		t.Errorf("Server not implement handler(FIBCL3EcmpGroupModHandler)")
+	}
+
 	// MPLSInterfaceGroup
 	if _, ok := s.(fibcapi.FIBCMPLSInterfaceGroupModHandler); !ok {
 		t.Errorf("Server not implement handler(FIBCMPLSInterfaceGroupModHandler)")
@@ -94,6 +103,21 @@ func TestGroupHandlers(t *testing.T) {
 	if _, ok := s.(fibcapi.FIBCMPLSLabelSwapGroupModHandler); !ok {
 		t.Errorf("Server not implement handler(FIBCMPLSLabelSwapGroupModHandler)")
 	}
+
+	// L2OverlayGroup
+	if _, ok := s.(fibcapi.FIBCL2OverlayGroupModHandler); !ok {
+		t.Errorf("Server not implement handler(FIBCL2OverlayGroupModHandler)")
+	}
+
+	// VxlanTunnel
+	if _, ok := s.(fibcapi.FIBCVxlanTunnelModHandler); !ok {
+		t.Errorf("Server not implement handler(FIBCVxlanTunnelModHandler)")
+	}
+
+	// SRv6EncapGroup
+	if _, ok := s.(fibcapi.FIBCSRv6EncapGroupModHandler); !ok {
+		t.Errorf("Server not implement handler(FIBCSRv6EncapGroupModHandler)")
+	}
 }
 
 func TestHandlers(t *testing.T) {
@@ -110,4 +134,12 @@ func TestHandlers(t *testing.T) {
 	if _, ok := s.(fibcapi.FFMultipartPortDescRequestHandler); !ok {
 		t.Errorf("erver not implement handler(FFMultipartPortDescRequestHandler)")
 	}
+
+	if _, ok := s.(fibcapi.FFMultipartFlowRequestHandler); !ok {
+		t.Errorf("Server not implement handler(FFMultipartFlowRequestHandler)")
+	}
+
+	if _, ok := s.(fibcapi.FFMultipartGroupDescRequestHandler); !ok {
+		t.Errorf("Server not implement handler(FFMultipartGroupDescRequestHandler)")
+	}
 }
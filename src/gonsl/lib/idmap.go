@@ -19,9 +19,8 @@ package gonslib
 
 import (
 	"fmt"
+	hal "gonsl/hal"
 	"sync"
-
-	"github.com/beluganos/go-opennsl/opennsl"
 )
 
 //
@@ -31,7 +30,11 @@ type IDMaps struct {
 	L2Stations *L2StationIDMap
 	L3Ifaces   *L3IfaceIDMap
 	L3Egress   *L3EgressIDMap
+	L3Ecmps    *L3EcmpIDMap
 	Trunks     *TrunkIDMap
+	MPLSEgress *MPLSEgressIDMap
+	MPLSIfaces *MPLSIfaceIDMap
+	SRv6Encaps *L3EgressIDMap
 }
 
 //
@@ -42,7 +45,11 @@ func NewIDMaps() *IDMaps {
 		L2Stations: NewL2StationIDMap(),
 		L3Ifaces:   NewL3IfaceIDMap(),
 		L3Egress:   NewL3EgressIDMap(),
+		L3Ecmps:    NewL3EcmpIDMap(),
 		Trunks:     NewTrunkIDMap(),
+		MPLSEgress: NewMPLSEgressIDMap(),
+		MPLSIfaces: NewMPLSIfaceIDMap(),
+		SRv6Encaps: NewL3EgressIDMap(),
 	}
 }
 
@@ -82,7 +89,7 @@ func NewL2StationIDMap() *L2StationIDMap {
 //
 // Register registers id and l3egrId.
 //
-func (m *L2StationIDMap) Register(id uint32, l2stationId opennsl.L2StationID) bool {
+func (m *L2StationIDMap) Register(id uint32, l2stationId hal.L2StationID) bool {
 	_, ok := m.Map.LoadOrStore(NewL2StationIDKey(id), l2stationId)
 	return !ok
 }
@@ -97,9 +104,9 @@ func (m *L2StationIDMap) Unregister(id uint32) {
 //
 // Get returns L2StationID by id.
 //
-func (m *L2StationIDMap) Get(id uint32) (opennsl.L2StationID, bool) {
+func (m *L2StationIDMap) Get(id uint32) (hal.L2StationID, bool) {
 	if v, ok := m.Map.Load(NewL2StationIDKey(id)); ok {
-		return v.(opennsl.L2StationID), true
+		return v.(hal.L2StationID), true
 	}
 	return 0, false
 }
@@ -107,9 +114,9 @@ func (m *L2StationIDMap) Get(id uint32) (opennsl.L2StationID, bool) {
 //
 // Traverse enumerates all entries.
 //
-func (m *L2StationIDMap) Traverse(f func(L2StationIDKey, opennsl.L2StationID) bool) {
+func (m *L2StationIDMap) Traverse(f func(L2StationIDKey, hal.L2StationID) bool) {
 	m.Map.Range(func(key, value interface{}) bool {
-		return f(key.(L2StationIDKey), value.(opennsl.L2StationID))
+		return f(key.(L2StationIDKey), value.(hal.L2StationID))
 	})
 }
 
@@ -155,7 +162,7 @@ func NewL3IfaceIDMap() *L3IfaceIDMap {
 //
 // Register registers id and l3ifaceId.
 //
-func (m *L3IfaceIDMap) Register(port uint32, vid uint16, l3ifaceId opennsl.L3IfaceID) bool {
+func (m *L3IfaceIDMap) Register(port uint32, vid uint16, l3ifaceId hal.L3IfaceID) bool {
 	_, ok := m.Map.LoadOrStore(NewL3IfaceIDKey(port, vid), l3ifaceId)
 	return !ok
 }
@@ -171,9 +178,9 @@ func (m *L3IfaceIDMap) Unregister(port uint32, vid uint16) {
 // Get returns L3IfaceID by id.
 //
 
-func (m *L3IfaceIDMap) Get(port uint32, vid uint16) (opennsl.L3IfaceID, bool) {
+func (m *L3IfaceIDMap) Get(port uint32, vid uint16) (hal.L3IfaceID, bool) {
 	if v, ok := m.Map.Load(NewL3IfaceIDKey(port, vid)); ok {
-		return v.(opennsl.L3IfaceID), true
+		return v.(hal.L3IfaceID), true
 	}
 	return 0, false
 }
@@ -181,9 +188,9 @@ func (m *L3IfaceIDMap) Get(port uint32, vid uint16) (opennsl.L3IfaceID, bool) {
 //
 // Traverse enumerates all entries.
 //
-func (m *L3IfaceIDMap) Traverse(f func(L3IfaceIDKey, opennsl.L3IfaceID) bool) {
+func (m *L3IfaceIDMap) Traverse(f func(L3IfaceIDKey, hal.L3IfaceID) bool) {
 	m.Map.Range(func(key, value interface{}) bool {
-		return f(key.(L3IfaceIDKey), value.(opennsl.L3IfaceID))
+		return f(key.(L3IfaceIDKey), value.(hal.L3IfaceID))
 	})
 }
 
@@ -223,7 +230,7 @@ func NewL3EgressIDMap() *L3EgressIDMap {
 //
 // Register registers id and l3egrId.
 //
-func (m *L3EgressIDMap) Register(id uint32, l3egrId opennsl.L3EgressID) bool {
+func (m *L3EgressIDMap) Register(id uint32, l3egrId hal.L3EgressID) bool {
 	_, ok := m.Map.LoadOrStore(NewL3EgressIDKey(id), l3egrId)
 	return !ok
 }
@@ -238,9 +245,9 @@ func (m *L3EgressIDMap) Unregister(id uint32) {
 //
 // Get returns L3EgressID by id.
 //
-func (m *L3EgressIDMap) Get(id uint32) (opennsl.L3EgressID, bool) {
+func (m *L3EgressIDMap) Get(id uint32) (hal.L3EgressID, bool) {
 	if v, ok := m.Map.Load(NewL3EgressIDKey(id)); ok {
-		return v.(opennsl.L3EgressID), true
+		return v.(hal.L3EgressID), true
 	}
 	return 0, false
 }
@@ -248,9 +255,76 @@ func (m *L3EgressIDMap) Get(id uint32) (opennsl.L3EgressID, bool) {
 //
 // Traverse enumerates all entries.
 //
-func (m *L3EgressIDMap) Traverse(f func(L3EgressIDKey, opennsl.L3EgressID) bool) {
+func (m *L3EgressIDMap) Traverse(f func(L3EgressIDKey, hal.L3EgressID) bool) {
 	m.Map.Range(func(key, value interface{}) bool {
-		return f(key.(L3EgressIDKey), value.(opennsl.L3EgressID))
+		return f(key.(L3EgressIDKey), value.(hal.L3EgressID))
+	})
+}
+
+//
+// L3EcmpIDKey is key of L3EcmpIDMap
+//
+type L3EcmpIDKey uint32
+
+//
+// NewL3EcmpIDKey returns new L3EcmpIDKey
+//
+func NewL3EcmpIDKey(ecmpId uint32) L3EcmpIDKey {
+	return L3EcmpIDKey(ecmpId)
+}
+
+//
+// String returns string.
+//
+func (k L3EcmpIDKey) String() string {
+	return fmt.Sprintf("0x%08x", uint32(k))
+}
+
+//
+// L3EcmpIDMap has ecmp id(key) and l3-egress-id of ecmp(value).
+//
+type L3EcmpIDMap struct {
+	sync.Map
+}
+
+//
+// NewL3EcmpIDMap returns new L3EcmpIDMap
+//
+func NewL3EcmpIDMap() *L3EcmpIDMap {
+	return &L3EcmpIDMap{}
+}
+
+//
+// Register registers ecmp id and l3egrId.
+//
+func (m *L3EcmpIDMap) Register(id uint32, l3egrId hal.L3EgressID) bool {
+	_, ok := m.Map.LoadOrStore(NewL3EcmpIDKey(id), l3egrId)
+	return !ok
+}
+
+//
+// Unregister removes ecmp id.
+//
+func (m *L3EcmpIDMap) Unregister(id uint32) {
+	m.Map.Delete(NewL3EcmpIDKey(id))
+}
+
+//
+// Get returns L3EgressID of ecmp by id.
+//
+func (m *L3EcmpIDMap) Get(id uint32) (hal.L3EgressID, bool) {
+	if v, ok := m.Map.Load(NewL3EcmpIDKey(id)); ok {
+		return v.(hal.L3EgressID), true
+	}
+	return 0, false
+}
+
+//
+// Traverse enumerates all entries.
+//
+func (m *L3EcmpIDMap) Traverse(f func(L3EcmpIDKey, hal.L3EgressID) bool) {
+	m.Map.Range(func(key, value interface{}) bool {
+		return f(key.(L3EcmpIDKey), value.(hal.L3EgressID))
 	})
 }
 
@@ -296,7 +370,7 @@ func NewTrunkIDMap() *TrunkIDMap {
 //
 // Register registers id and trunkId.
 //
-func (m *TrunkIDMap) Register(lagid uint32, vid uint16, trunkId opennsl.Trunk) bool {
+func (m *TrunkIDMap) Register(lagid uint32, vid uint16, trunkId hal.Trunk) bool {
 	_, ok := m.Map.LoadOrStore(NewTrunkIDKey(lagid, vid), trunkId)
 	return !ok
 }
@@ -311,9 +385,144 @@ func (m *TrunkIDMap) Unregister(lagid uint32, vid uint16) {
 //
 // Get returns L3IfaceID by id.
 //
-func (m *TrunkIDMap) Get(lagid uint32, vid uint16) (opennsl.Trunk, bool) {
+func (m *TrunkIDMap) Get(lagid uint32, vid uint16) (hal.Trunk, bool) {
 	if v, ok := m.Map.Load(NewTrunkIDKey(lagid, vid)); ok {
-		return v.(opennsl.Trunk), true
+		return v.(hal.Trunk), true
+	}
+	return 0, false
+}
+
+//
+// Traverse enumerates all entries.
+//
+func (m *TrunkIDMap) Traverse(f func(TrunkIDKey, hal.Trunk) bool) {
+	m.Map.Range(func(key, value interface{}) bool {
+		return f(key.(TrunkIDKey), value.(hal.Trunk))
+	})
+}
+
+//
+// MPLSEgressIDKey is key of MPLSEgressIDMap
+//
+type MPLSEgressIDKey uint32
+
+//
+// NewMPLSEgressIDKey returns new MPLSEgressIDKey
+//
+func NewMPLSEgressIDKey(groupId uint32) MPLSEgressIDKey {
+	return MPLSEgressIDKey(groupId)
+}
+
+//
+// String returns string.
+//
+func (k MPLSEgressIDKey) String() string {
+	return fmt.Sprintf("0x%08x", uint32(k))
+}
+
+//
+// MPLSEgressIDMap has mpls group id(key) and l3-egress-id(value).
+//
+type MPLSEgressIDMap struct {
+	sync.Map
+}
+
+//
+// NewMPLSEgressIDMap returns new MPLSEgressIDMap
+//
+func NewMPLSEgressIDMap() *MPLSEgressIDMap {
+	return &MPLSEgressIDMap{}
+}
+
+//
+// Register registers group id and l3egrId.
+//
+func (m *MPLSEgressIDMap) Register(id uint32, l3egrId hal.L3EgressID) bool {
+	_, ok := m.Map.LoadOrStore(NewMPLSEgressIDKey(id), l3egrId)
+	return !ok
+}
+
+//
+// Unregister removes group id.
+//
+func (m *MPLSEgressIDMap) Unregister(id uint32) {
+	m.Map.Delete(NewMPLSEgressIDKey(id))
+}
+
+//
+// Get returns L3EgressID by group id.
+//
+func (m *MPLSEgressIDMap) Get(id uint32) (hal.L3EgressID, bool) {
+	if v, ok := m.Map.Load(NewMPLSEgressIDKey(id)); ok {
+		return v.(hal.L3EgressID), true
+	}
+	return 0, false
+}
+
+//
+// Traverse enumerates all entries.
+//
+func (m *MPLSEgressIDMap) Traverse(f func(MPLSEgressIDKey, hal.L3EgressID) bool) {
+	m.Map.Range(func(key, value interface{}) bool {
+		return f(key.(MPLSEgressIDKey), value.(hal.L3EgressID))
+	})
+}
+
+//
+// MPLSIfaceIDKey is key of MPLSIfaceIDMap
+//
+type MPLSIfaceIDKey uint32
+
+//
+// NewMPLSIfaceIDKey returns new MPLSIfaceIDKey
+//
+func NewMPLSIfaceIDKey(groupId uint32) MPLSIfaceIDKey {
+	return MPLSIfaceIDKey(groupId)
+}
+
+//
+// String returns string.
+//
+func (k MPLSIfaceIDKey) String() string {
+	return fmt.Sprintf("0x%08x", uint32(k))
+}
+
+//
+// MPLSIfaceIDMap has mpls group id(key) and l3-interface-id
+// which has mpls tunnel initiator(value).
+//
+type MPLSIfaceIDMap struct {
+	sync.Map
+}
+
+//
+// NewMPLSIfaceIDMap returns new MPLSIfaceIDMap
+//
+func NewMPLSIfaceIDMap() *MPLSIfaceIDMap {
+	return &MPLSIfaceIDMap{}
+}
+
+//
+// Register registers group id and l3ifaceId.
+//
+func (m *MPLSIfaceIDMap) Register(id uint32, l3ifaceId hal.L3IfaceID) bool {
+	_, ok := m.Map.LoadOrStore(NewMPLSIfaceIDKey(id), l3ifaceId)
+	return !ok
+}
+
+//
+// Unregister removes group id.
+//
+func (m *MPLSIfaceIDMap) Unregister(id uint32) {
+	m.Map.Delete(NewMPLSIfaceIDKey(id))
+}
+
+//
+// Get returns L3IfaceID by group id.
+//
+func (m *MPLSIfaceIDMap) Get(id uint32) (hal.L3IfaceID, bool) {
+	if v, ok := m.Map.Load(NewMPLSIfaceIDKey(id)); ok {
+		return v.(hal.L3IfaceID), true
 	}
 	return 0, false
 }
@@ -321,8 +530,8 @@ func (m *TrunkIDMap) Get(lagid uint32, vid uint16) (opennsl.Trunk, bool) {
 //
 // Traverse enumerates all entries.
 //
-func (m *TrunkIDMap) Traverse(f func(TrunkIDKey, opennsl.Trunk) bool) {
+func (m *MPLSIfaceIDMap) Traverse(f func(MPLSIfaceIDKey, hal.L3IfaceID) bool) {
 	m.Map.Range(func(key, value interface{}) bool {
-		return f(key.(TrunkIDKey), value.(opennsl.Trunk))
+		return f(key.(MPLSIfaceIDKey), value.(hal.L3IfaceID))
 	})
 }
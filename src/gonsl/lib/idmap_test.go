@@ -18,9 +18,8 @@
 package gonslib
 
 import (
+	hal "gonsl/hal"
 	"testing"
-
-	"github.com/beluganos/go-opennsl/opennsl"
 )
 
 func TestL3IfaceIDMap(t *testing.T) {
@@ -55,8 +54,8 @@ func TestL3IfaceIDMapTraverse(t *testing.T) {
 	m.Register(10, 101, 1001)
 	m.Register(11, 100, 1100)
 
-	ids := map[L3IfaceIDKey]opennsl.L3IfaceID{}
-	m.Traverse(func(key L3IfaceIDKey, ifaceId opennsl.L3IfaceID) bool {
+	ids := map[L3IfaceIDKey]hal.L3IfaceID{}
+	m.Traverse(func(key L3IfaceIDKey, ifaceId hal.L3IfaceID) bool {
 		ids[key] = ifaceId
 		return true
 	})
@@ -75,3 +74,29 @@ func TestL3IfaceIDMapTraverse(t *testing.T) {
 		t.Errorf("L3IfaceIDMap Traverse error.")
 	}
 }
+
+func TestMPLSEgressIDMap(t *testing.T) {
+	m := MPLSEgressIDMap{}
+
+	if v := m.Register(0x93000064, 100001); v != true {
+		t.Errorf("MPLSEgressIDMap Regiser error.")
+	}
+
+	if v := m.Register(0x93000064, 100002); v != false {
+		t.Errorf("MPLSEgressIDMap Regiser must be error.")
+	}
+
+	if v, b := m.Get(0x93000064); v != 100001 || !b {
+		t.Errorf("MPLSEgressIDMap Get error. %d %t", v, b)
+	}
+
+	if _, b := m.Get(0x92000064); b {
+		t.Errorf("MPLSEgressIDMap Get must be error. %t", b)
+	}
+
+	m.Unregister(0x93000064)
+
+	if _, b := m.Get(0x93000064); b {
+		t.Errorf("MPLSEgressIDMap Get must be error. %t", b)
+	}
+}
@@ -19,16 +19,15 @@ package gonslib
 
 import (
 	"fmt"
+	hal "gonsl/hal"
 	"sync"
 	"time"
-
-	"github.com/beluganos/go-opennsl/opennsl"
 )
 
 func (s *Server) L2AddrInit() {
 	agingTime := s.dpCfg.GetL2AgingTimer()
 	if agingTime > 0 {
-		if err := opennsl.L2AddrAgeTimerSet(s.Unit(), agingTime); err != nil {
+		if err := s.hal.L2AddrAgeTimerSet(agingTime); err != nil {
 			s.log.Warnf("L2AddrInit AgeTimeSet error. %s", err)
 		}
 
@@ -39,11 +38,11 @@ func (s *Server) L2AddrInit() {
 }
 
 type L2addrmonEntry struct {
-	L2Addr *opennsl.L2Addr
-	Oper   opennsl.L2CallbackOper
+	L2Addr *hal.L2Addr
+	Oper   hal.L2CallbackOper
 }
 
-func NewL2addrmonEntry(src *opennsl.L2Addr, oper opennsl.L2CallbackOper) *L2addrmonEntry {
+func NewL2addrmonEntry(src *hal.L2Addr, oper hal.L2CallbackOper) *L2addrmonEntry {
 	l2addr := *src
 	return &L2addrmonEntry{
 		L2Addr: &l2addr,
@@ -53,32 +52,32 @@ func NewL2addrmonEntry(src *opennsl.L2Addr, oper opennsl.L2CallbackOper) *L2addr
 
 func (e *L2addrmonEntry) SetAdd() bool {
 	switch e.Oper {
-	case opennsl.L2_CALLBACK_ADD:
+	case hal.L2_CALLBACK_ADD:
 		return true
 
-	case opennsl.L2_CALLBACK_DELETE:
-		e.Oper = opennsl.L2_CALLBACK_NONE
+	case hal.L2_CALLBACK_DELETE:
+		e.Oper = hal.L2_CALLBACK_NONE
 		return false
 
 	default:
 		// oper is NONE or other.
-		e.Oper = opennsl.L2_CALLBACK_ADD
+		e.Oper = hal.L2_CALLBACK_ADD
 		return true
 	}
 }
 
 func (e *L2addrmonEntry) SetDel() bool {
 	switch e.Oper {
-	case opennsl.L2_CALLBACK_ADD:
-		e.Oper = opennsl.L2_CALLBACK_NONE
+	case hal.L2_CALLBACK_ADD:
+		e.Oper = hal.L2_CALLBACK_NONE
 		return false
 
-	case opennsl.L2_CALLBACK_DELETE:
+	case hal.L2_CALLBACK_DELETE:
 		return true
 
 	default:
 		// oper is NONE or other.
-		e.Oper = opennsl.L2_CALLBACK_DELETE
+		e.Oper = hal.L2_CALLBACK_DELETE
 		return true
 	}
 }
@@ -91,8 +90,8 @@ func (e *L2addrmonEntry) String() string {
 	return fmt.Sprintf("%s %s", e.Oper, e.L2Addr)
 }
 
-func NewL2addrmonKeyFromL2Addr(l2addr *opennsl.L2Addr) string {
-	return fmt.Sprintf("%s_%d_%d", l2addr.MAC(), l2addr.VID(), l2addr.Port())
+func NewL2addrmonKeyFromL2Addr(l2addr *hal.L2Addr) string {
+	return fmt.Sprintf("%s_%d_%d", l2addr.MAC, l2addr.Vlan, l2addr.Port)
 }
 
 type L2addrmonTable struct {
@@ -157,10 +156,10 @@ func (t *L2addrmonTable) Del(delEntry *L2addrmonEntry) {
 
 func (t *L2addrmonTable) Put(e *L2addrmonEntry) {
 	switch e.Oper {
-	case opennsl.L2_CALLBACK_ADD:
+	case hal.L2_CALLBACK_ADD:
 		t.Add(e)
 
-	case opennsl.L2_CALLBACK_DELETE:
+	case hal.L2_CALLBACK_DELETE:
 		t.Del(e)
 
 	default:
@@ -192,21 +191,21 @@ func (s *Server) L2AddrMonStart(done <-chan struct{}) {
 	s.L2AddrInit()
 
 	sweepTime := s.dpCfg.GetL2SweepTime()
-	go s.L2AddrMonServe(s.Unit(), sweepTime, done)
+	go s.L2AddrMonServe(s.hal, sweepTime, done)
 }
 
-func (s *Server) L2AddrMonServe(unit int, sweepTime time.Duration, done <-chan struct{}) {
+func (s *Server) L2AddrMonServe(h hal.HAL, sweepTime time.Duration, done <-chan struct{}) {
 	ch := make(chan *L2addrmonEntry)
 	defer close(ch)
 
-	if err := opennsl.L2AddrRegister(unit, func(unitCb int, l2addr *opennsl.L2Addr, oper opennsl.L2CallbackOper) {
+	if err := h.L2AddrRegister(func(l2addr *hal.L2Addr, oper hal.L2CallbackOper) {
 		ch <- NewL2addrmonEntry(l2addr, oper)
 	}); err != nil {
 		s.log.Errorf("L2AddrMon: L2AddrRegister error. %s", err)
 		return
 	}
 
-	defer opennsl.L2AddrUnregister(unit)
+	defer h.L2AddrUnregister()
 
 	s.log.Infof("L2AddrMon: Started.")
 
@@ -0,0 +1,372 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"sync"
+)
+
+//
+// L3EgressBindKey is key of l3 host/route bound to l3 egress.
+//
+type L3EgressBindKey struct {
+	Host bool
+	Vrf  hal.Vrf
+	Dst  string
+}
+
+//
+// NewL3HostBindKey returns new L3EgressBindKey of l3 host.
+//
+func NewL3HostBindKey(host *hal.L3Host) L3EgressBindKey {
+	return L3EgressBindKey{
+		Host: true,
+		Vrf:  host.Vrf,
+		Dst:  host.IP.String(),
+	}
+}
+
+//
+// NewL3RouteBindKey returns new L3EgressBindKey of l3 route.
+//
+func NewL3RouteBindKey(route *hal.L3Route) L3EgressBindKey {
+	return L3EgressBindKey{
+		Host: false,
+		Vrf:  route.Vrf,
+		Dst:  route.Dst.String(),
+	}
+}
+
+//
+// String returns string.
+//
+func (k *L3EgressBindKey) String() string {
+	if k.Host {
+		return fmt.Sprintf("host:%s vrf:%d", k.Dst, k.Vrf)
+	}
+	return fmt.Sprintf("route:%s vrf:%d", k.Dst, k.Vrf)
+}
+
+//
+// L3EgressRef has reference count of l3 egress (or ecmp).
+//
+type L3EgressRef struct {
+	Refs    int
+	Ecmp    bool
+	Release bool
+	Members []hal.L3EgressID
+}
+
+//
+// L3EgressRefTable has reference counts of l3 egress objects
+// and l3 egress bound to each l3 host/route.
+// An l3 egress released by group delete is kept until
+// no l3 host/route/ecmp refers it.
+//
+type L3EgressRefTable struct {
+	mutex sync.Mutex
+	refs  map[hal.L3EgressID]*L3EgressRef
+	binds map[L3EgressBindKey]hal.L3EgressID
+}
+
+//
+// NewL3EgressRefTable returns new L3EgressRefTable
+//
+func NewL3EgressRefTable() *L3EgressRefTable {
+	return &L3EgressRefTable{
+		refs:  map[hal.L3EgressID]*L3EgressRef{},
+		binds: map[L3EgressBindKey]hal.L3EgressID{},
+	}
+}
+
+func (t *L3EgressRefTable) ref(egrID hal.L3EgressID) *L3EgressRef {
+	r, ok := t.refs[egrID]
+	if !ok {
+		r = &L3EgressRef{}
+		t.refs[egrID] = r
+	}
+	return r
+}
+
+//
+// unref decrements reference count and returns true
+// if egrID has been released and is no longer referred.
+//
+func (t *L3EgressRefTable) unref(egrID hal.L3EgressID) bool {
+	r, ok := t.refs[egrID]
+	if !ok {
+		return false
+	}
+
+	if r.Refs > 0 {
+		r.Refs--
+	}
+
+	if r.Refs > 0 {
+		return false
+	}
+
+	if !r.Release {
+		if len(r.Members) == 0 {
+			delete(t.refs, egrID)
+		}
+		return false
+	}
+
+	return true
+}
+
+//
+// Bind binds l3 host/route to egrID and increments reference count.
+// It returns l3 egress bound before if exists.
+//
+func (t *L3EgressRefTable) Bind(key L3EgressBindKey, egrID hal.L3EgressID) (hal.L3EgressID, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	oldID, ok := t.binds[key]
+	t.binds[key] = egrID
+	t.ref(egrID).Refs++
+
+	return oldID, ok
+}
+
+//
+// Unbind removes l3 host/route.
+// It returns l3 egress bound to key.
+//
+func (t *L3EgressRefTable) Unbind(key L3EgressBindKey) (hal.L3EgressID, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	egrID, ok := t.binds[key]
+	if ok {
+		delete(t.binds, key)
+	}
+
+	return egrID, ok
+}
+
+//
+// Lookup returns l3 egress bound to key.
+//
+func (t *L3EgressRefTable) Lookup(key L3EgressBindKey) (hal.L3EgressID, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	egrID, ok := t.binds[key]
+	return egrID, ok
+}
+
+//
+// Unref decrements reference count of egrID.
+// It returns true if egrID should be destroyed.
+//
+func (t *L3EgressRefTable) Unref(egrID hal.L3EgressID) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.unref(egrID)
+}
+
+//
+// SetMembers sets members of l3 ecmp and increments reference count of them.
+// It returns old members whose reference count must be decremented by caller.
+//
+func (t *L3EgressRefTable) SetMembers(ecmpID hal.L3EgressID, members []hal.L3EgressID) []hal.L3EgressID {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, member := range members {
+		t.ref(member).Refs++
+	}
+
+	r := t.ref(ecmpID)
+	r.Ecmp = true
+	oldMembers := r.Members
+	r.Members = members
+
+	return oldMembers
+}
+
+//
+// Release marks egrID released.
+// It returns true if egrID is not referred and should be destroyed now.
+//
+func (t *L3EgressRefTable) Release(egrID hal.L3EgressID) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	r := t.ref(egrID)
+	r.Release = true
+
+	return r.Refs == 0
+}
+
+//
+// Remove removes egrID and returns entry.
+//
+func (t *L3EgressRefTable) Remove(egrID hal.L3EgressID) (*L3EgressRef, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	r, ok := t.refs[egrID]
+	if ok {
+		delete(t.refs, egrID)
+	}
+
+	return r, ok
+}
+
+//
+// Get returns copy of entry.
+//
+func (t *L3EgressRefTable) Get(egrID hal.L3EgressID) (L3EgressRef, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if r, ok := t.refs[egrID]; ok {
+		return *r, true
+	}
+	return L3EgressRef{}, false
+}
+
+//
+// l3EgressUnref decrements reference count of egrID and
+// destroys it if it has been released and is no longer referred.
+//
+func (s *Server) l3EgressUnref(egrID hal.L3EgressID) {
+	if s.egrRefs.Unref(egrID) {
+		s.l3EgressDestroy(egrID)
+	}
+}
+
+//
+// l3EgressRelease destroys egrID, or defers it
+// until l3 host/route/ecmp referring it are removed.
+//
+func (s *Server) l3EgressRelease(egrID hal.L3EgressID) {
+	if !s.egrRefs.Release(egrID) {
+		s.log.Debugf("L3Egress(%d): destroy deferred.", egrID)
+		return
+	}
+
+	s.l3EgressDestroy(egrID)
+}
+
+//
+// l3EgressDestroy destroys l3 egress (or ecmp) and
+// decrements reference count of ecmp members.
+//
+func (s *Server) l3EgressDestroy(egrID hal.L3EgressID) {
+	r, _ := s.egrRefs.Remove(egrID)
+
+	if r != nil && r.Ecmp {
+		if err := s.hal.L3EgressEcmpDestroy(egrID); err != nil {
+			s.log.Errorf("L3Egress(%d): ECMP delete error. %s", egrID, err)
+		}
+	} else {
+		if err := s.hal.L3EgressDestroy(egrID); err != nil {
+			s.log.Errorf("L3Egress(%d): delete error. %s", egrID, err)
+		}
+	}
+
+	if r != nil {
+		for _, member := range r.Members {
+			s.l3EgressUnref(member)
+		}
+	}
+}
+
+//
+// l3HostAdd adds (or replaces) l3 host and
+// switches reference from old l3 egress to new one.
+// Existing host is replaced in place whichever ADD or MODIFY is processed,
+// because replace of neighbor arrives as ADD (NEWNEIGH).
+//
+func (s *Server) l3HostAdd(host *hal.L3Host) error {
+	key := NewL3HostBindKey(host)
+	if _, ok := s.egrRefs.Lookup(key); ok {
+		host.Flags |= hal.L3_REPLACE
+	}
+
+	if err := s.hal.L3HostAdd(host); err != nil {
+		return err
+	}
+
+	if oldID, ok := s.egrRefs.Bind(key, host.EgressID); ok {
+		s.l3EgressUnref(oldID)
+	}
+
+	return nil
+}
+
+//
+// l3HostDelete deletes l3 host and releases reference of l3 egress.
+//
+func (s *Server) l3HostDelete(host *hal.L3Host) error {
+	if err := s.hal.L3HostDelete(host); err != nil {
+		return err
+	}
+
+	if egrID, ok := s.egrRefs.Unbind(NewL3HostBindKey(host)); ok {
+		s.l3EgressUnref(egrID)
+	}
+
+	return nil
+}
+
+//
+// l3RouteAdd adds (or replaces) l3 route and
+// switches reference from old l3 egress to new one.
+// Existing route is replaced in place whichever ADD or MODIFY is processed,
+// because replace of route arrives as DELROUTE and NEWROUTE (ADD).
+//
+func (s *Server) l3RouteAdd(route *hal.L3Route) error {
+	key := NewL3RouteBindKey(route)
+	if _, ok := s.egrRefs.Lookup(key); ok {
+		route.Flags |= hal.L3_REPLACE
+	}
+
+	if err := s.hal.L3RouteAdd(route); err != nil {
+		return err
+	}
+
+	if oldID, ok := s.egrRefs.Bind(key, route.EgressID); ok {
+		s.l3EgressUnref(oldID)
+	}
+
+	return nil
+}
+
+//
+// l3RouteDelete deletes l3 route and releases reference of l3 egress.
+//
+func (s *Server) l3RouteDelete(route *hal.L3Route) error {
+	if err := s.hal.L3RouteDelete(route); err != nil {
+		return err
+	}
+
+	if egrID, ok := s.egrRefs.Unbind(NewL3RouteBindKey(route)); ok {
+		s.l3EgressUnref(egrID)
+	}
+
+	return nil
+}
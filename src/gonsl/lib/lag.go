@@ -0,0 +1,405 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	hal "gonsl/hal"
+	"sort"
+	"sync"
+)
+
+//
+// LagMemberStatNames is names of port stats of trunk member.
+//
+var LagMemberStatNames = []string{
+	"ifHCInOctets",
+	"ifHCInUcastPkts",
+	"ifHCOutOctets",
+	"ifHCOutUcastPkts",
+}
+
+var lagHashPolicyPsc = map[fibcapi.BondAttrs_HashPolicy]hal.TrunkPsc{
+	fibcapi.BondAttrs_LAYER2:   hal.TRUNK_PSC_SRCDSTMAC,
+	fibcapi.BondAttrs_LAYER2_3: hal.TRUNK_PSC_SRCDSTIP,
+	fibcapi.BondAttrs_LAYER3_4: hal.TRUNK_PSC_PORTFLOW,
+	fibcapi.BondAttrs_ENCAP2_3: hal.TRUNK_PSC_SRCDSTIP,
+	fibcapi.BondAttrs_ENCAP3_4: hal.TRUNK_PSC_PORTFLOW,
+}
+
+//
+// LagMember is member port of bond.
+// Attrs is nil if state of slave is not notified (static member).
+//
+type LagMember struct {
+	Port  hal.Port
+	Attrs *fibcapi.BondSlaveAttrs
+}
+
+func (m *LagMember) String() string {
+	return fmt.Sprintf("port:%d attrs:%v", m.Port, m.Attrs)
+}
+
+//
+// Lag is trunk of bond master.
+// Attrs is nil if bond attributes are not notified (static trunk).
+//
+type Lag struct {
+	PortID  uint32
+	Vid     uint16
+	Trunk   hal.Trunk
+	Attrs   *fibcapi.BondAttrs
+	Members []*LagMember
+}
+
+//
+// NewLag returns new instance.
+//
+func NewLag(portID uint32, vid uint16, trunk hal.Trunk, attrs *fibcapi.BondAttrs) *Lag {
+	return &Lag{
+		PortID:  portID,
+		Vid:     vid,
+		Trunk:   trunk,
+		Attrs:   attrs,
+		Members: []*LagMember{},
+	}
+}
+
+func (l *Lag) String() string {
+	return fmt.Sprintf("port:%x vid:%d trunk:%d attrs:%v members:%v", l.PortID, l.Vid, l.Trunk, l.Attrs, l.Members)
+}
+
+func (l *Lag) memberIndex(port hal.Port) int {
+	for index, m := range l.Members {
+		if m.Port == port {
+			return index
+		}
+	}
+	return -1
+}
+
+//
+// SetMember adds or updates member.
+//
+func (l *Lag) SetMember(port hal.Port, attrs *fibcapi.BondSlaveAttrs) {
+	if index := l.memberIndex(port); index >= 0 {
+		l.Members[index].Attrs = attrs
+		return
+	}
+
+	l.Members = append(l.Members, &LagMember{Port: port, Attrs: attrs})
+}
+
+//
+// DelMember deletes member.
+//
+func (l *Lag) DelMember(port hal.Port) {
+	if index := l.memberIndex(port); index >= 0 {
+		l.Members = append(l.Members[:index], l.Members[index+1:]...)
+	}
+}
+
+//
+// Psc returns port selection criteria by xmit_hash_policy.
+//
+func (l *Lag) Psc() hal.TrunkPsc {
+	if l.Attrs == nil {
+		return hal.TRUNK_PSC_SRCDSTMAC
+	}
+
+	if psc, ok := lagHashPolicyPsc[l.Attrs.HashPolicy]; ok {
+		return psc
+	}
+
+	return hal.TRUNK_PSC_SRCDSTMAC
+}
+
+//
+// MemberEnabled returns true if member may send packets.
+// active-backup: only active slave is enabled.
+// 802.3ad: slaves which are collecting and distributing
+//          in active aggregator are enabled.
+// others: all slaves whose link is up are enabled.
+//
+func (l *Lag) MemberEnabled(m *LagMember) bool {
+	a := m.Attrs
+	if a == nil {
+		return true
+	}
+
+	if !a.LinkUp {
+		return false
+	}
+
+	if l.Attrs == nil {
+		return true
+	}
+
+	switch l.Attrs.Mode {
+	case fibcapi.BondAttrs_ACTIVE_BACKUP:
+		return a.Active
+
+	case fibcapi.BondAttrs_IEEE_802_3AD:
+		if aggID := l.Attrs.AggregatorId; aggID != 0 && aggID != a.AggregatorId {
+			return false
+		}
+		return a.Collecting && a.Distributing
+
+	default:
+		return true
+	}
+}
+
+//
+// TrunkInfo returns trunk info to program hardware.
+// All members are disabled if number of enabled members is less than min_links.
+// If psc is not nil, it is used instead of xmit_hash_policy.
+//
+func (l *Lag) TrunkInfo(psc *hal.TrunkPsc) *hal.TrunkInfo {
+	info := &hal.TrunkInfo{
+		Psc:     l.Psc(),
+		Members: make([]*hal.TrunkMember, len(l.Members)),
+	}
+
+	if psc != nil {
+		info.Psc = *psc
+	}
+
+	enabled := 0
+	for index, m := range l.Members {
+		member := &hal.TrunkMember{
+			Port:     m.Port,
+			Disabled: !l.MemberEnabled(m),
+		}
+		if !member.Disabled {
+			enabled++
+		}
+		info.Members[index] = member
+	}
+
+	if l.Attrs != nil && enabled < int(l.Attrs.MinLinks) {
+		for _, member := range info.Members {
+			member.Disabled = true
+		}
+	}
+
+	return info
+}
+
+//
+// Copy returns deep copy.
+//
+func (l *Lag) Copy() *Lag {
+	lag := *l
+	lag.Members = make([]*LagMember, len(l.Members))
+	for index, m := range l.Members {
+		member := *m
+		lag.Members[index] = &member
+	}
+	return &lag
+}
+
+//
+// LagTable has trunks of bond masters.
+//
+type LagTable struct {
+	mutex sync.Mutex
+	lags  map[TrunkIDKey]*Lag
+	psc   *hal.TrunkPsc
+}
+
+//
+// NewLagTable returns new instance.
+//
+func NewLagTable() *LagTable {
+	return &LagTable{
+		lags: map[TrunkIDKey]*Lag{},
+	}
+}
+
+//
+// SetPsc sets port selection criteria used by all trunks.
+//
+func (t *LagTable) SetPsc(psc hal.TrunkPsc) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.psc = &psc
+}
+
+//
+// Add adds lag.
+//
+func (t *LagTable) Add(lag *Lag) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lags[NewTrunkIDKey(lag.PortID, lag.Vid)] = lag
+}
+
+//
+// Delete deletes lag.
+//
+func (t *LagTable) Delete(portID uint32, vid uint16) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.lags, NewTrunkIDKey(portID, vid))
+}
+
+//
+// Update calls f with lag and returns trunk info to program hardware.
+//
+func (t *LagTable) Update(portID uint32, vid uint16, f func(*Lag)) (hal.Trunk, *hal.TrunkInfo, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	lag, ok := t.lags[NewTrunkIDKey(portID, vid)]
+	if !ok {
+		return 0, nil, false
+	}
+
+	f(lag)
+	return lag.Trunk, lag.TrunkInfo(t.psc), true
+}
+
+//
+// List returns copy of lags in order of trunk id.
+//
+func (t *LagTable) List() []*Lag {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	lags := make([]*Lag, 0, len(t.lags))
+	for _, lag := range t.lags {
+		lags = append(lags, lag.Copy())
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Trunk < lags[j].Trunk })
+
+	return lags
+}
+
+//
+// LagMemberStats is state and port stats of trunk member.
+//
+type LagMemberStats struct {
+	Port    hal.Port
+	Enabled bool
+	Attrs   *fibcapi.BondSlaveAttrs
+	Stats   map[string]uint64
+}
+
+//
+// LagStats is trunk info and stats of members.
+//
+type LagStats struct {
+	Lag     *Lag
+	Psc     hal.TrunkPsc
+	Members []*LagMemberStats
+}
+
+//
+// LagConfigure sets hash fields of trunks.
+//
+func (s *Server) LagConfigure(cfg *LagConfig) error {
+	if len(cfg.HashFields) == 0 {
+		return nil
+	}
+
+	fields, err := hal.ParseTrunkHashFields(cfg.HashFields)
+	if err != nil {
+		s.log.Errorf("LagConfigure: invalid hash fields. %s", err)
+		return err
+	}
+
+	if err := s.hal.TrunkHashFieldsSet(fields); err != nil {
+		s.log.Errorf("LagConfigure: TrunkHashFieldsSet error. %s", err)
+		return err
+	}
+
+	s.lags.SetPsc(hal.TRUNK_PSC_PORTFLOW)
+
+	s.log.Infof("LagConfigure: hash fields %s", fields)
+
+	return nil
+}
+
+//
+// LagUpdate updates lag and programs trunk.
+//
+func (s *Server) LagUpdate(portID uint32, vid uint16, f func(*Lag)) error {
+	trunk, info, ok := s.lags.Update(portID, vid, f)
+	if !ok {
+		return fmt.Errorf("Lag not exists. port:%x vid:%d", portID, vid)
+	}
+
+	if err := s.hal.TrunkSet(trunk, info); err != nil {
+		s.log.Errorf("LagUpdate: TrunkSet error. id:%d %s", trunk, err)
+		return err
+	}
+
+	s.log.Debugf("LagUpdate: id:%d %s", trunk, info)
+
+	return nil
+}
+
+//
+// LagStats returns state and stats of all trunks.
+//
+func (s *Server) LagStats() ([]*LagStats, error) {
+	portStats := NewPortStats(LagMemberStatNames)
+
+	lags := s.lags.List()
+	statsList := make([]*LagStats, len(lags))
+	for index, lag := range lags {
+		info, err := s.hal.TrunkGet(lag.Trunk)
+		if err != nil {
+			return nil, err
+		}
+
+		enabled := map[hal.Port]bool{}
+		for _, m := range info.Members {
+			enabled[m.Port] = !m.Disabled
+		}
+
+		stats := &LagStats{
+			Lag:     lag,
+			Psc:     info.Psc,
+			Members: make([]*LagMemberStats, len(lag.Members)),
+		}
+
+		for mindex, m := range lag.Members {
+			values, err := portStats.Get(s.hal, m.Port)
+			if err != nil {
+				return nil, err
+			}
+
+			stats.Members[mindex] = &LagMemberStats{
+				Port:    m.Port,
+				Enabled: enabled[m.Port],
+				Attrs:   m.Attrs,
+				Stats:   values,
+			}
+		}
+
+		statsList[index] = stats
+	}
+
+	return statsList, nil
+}
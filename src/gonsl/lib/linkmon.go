@@ -19,8 +19,8 @@ package gonslib
 
 import (
 	fibcapi "fabricflow/fibc/api"
+	hal "gonsl/hal"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -29,17 +29,17 @@ const (
 )
 
 //
-// LinkInfo is opennsl port_info and port_no.
+// LinkInfo is port_info and port_no.
 //
 type LinkInfo struct {
-	opennsl.PortInfo
-	Port opennsl.Port
+	hal.PortInfo
+	Port hal.Port
 }
 
 //
 // NewLinkInfo create new instance of LinkInfo.
 //
-func NewLinkInfo(port opennsl.Port, info *opennsl.PortInfo) *LinkInfo {
+func NewLinkInfo(port hal.Port, info *hal.PortInfo) *LinkInfo {
 	return &LinkInfo{
 		Port:     port,
 		PortInfo: *info,
@@ -60,7 +60,7 @@ func (l *LinkInfo) PortNo() uint32 {
 // port is down: fibcapi.FFPORT_STATE_LINKDOWN
 //
 func (l *LinkInfo) PortState() uint32 {
-	if l.LinkStatus().IsUp() {
+	if l.LinkUp {
 		return fibcapi.FFPORT_STATE_NONE
 	}
 
@@ -72,7 +72,7 @@ func (l *LinkInfo) PortState() uint32 {
 //
 func (s *Server) LinkmonStart(done <-chan struct{}) <-chan *LinkInfo {
 	ch := make(chan *LinkInfo)
-	go LinkmonServe(s.Unit(), ch, done)
+	go LinkmonServe(s.hal, ch, done)
 
 	return ch
 }
@@ -80,15 +80,15 @@ func (s *Server) LinkmonStart(done <-chan struct{}) <-chan *LinkInfo {
 //
 // LinkmonServe monitor link state and notify.
 //
-func LinkmonServe(unit int, linkCh chan<- *LinkInfo, done <-chan struct{}) {
-	if err := opennsl.LinkscanRegister(unit, linkmonRegisterName, func(unit int, key string, port opennsl.Port, portInfo *opennsl.PortInfo) {
+func LinkmonServe(h hal.HAL, linkCh chan<- *LinkInfo, done <-chan struct{}) {
+	if err := h.LinkscanRegister(linkmonRegisterName, func(port hal.Port, portInfo *hal.PortInfo) {
 		linkCh <- NewLinkInfo(port, portInfo)
 	}); err != nil {
 		log.Errorf("LinkMon: LinkscanRegister error. %s", err)
 		return
 	}
 
-	defer opennsl.LinkscanUnregister(unit, linkmonRegisterName)
+	defer h.LinkscanUnregister(linkmonRegisterName)
 
 	log.Infof("LinkMon: Started.")
 
@@ -0,0 +1,324 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+	"sync"
+)
+
+const (
+	// MirrorTypeSPAN is mirror session to local port.
+	MirrorTypeSPAN = "span"
+	// MirrorTypeERSPAN is mirror session to remote collector (GRE).
+	MirrorTypeERSPAN = "erspan"
+
+	mirrorDefaultTTL = 64
+)
+
+var mirrorDirections = map[string]hal.MirrorPortFlags{
+	"both":    hal.MIRROR_PORT_BOTH,
+	"ingress": hal.MIRROR_PORT_INGRESS,
+	"egress":  hal.MIRROR_PORT_EGRESS,
+}
+
+//
+// ParseMirrorDirection parses direction of mirrored traffic.
+//
+func ParseMirrorDirection(s string) (hal.MirrorPortFlags, error) {
+	if flags, ok := mirrorDirections[s]; ok {
+		return flags, nil
+	}
+	return hal.MIRROR_PORT_NONE, fmt.Errorf("Invalid mirror direction. %s", s)
+}
+
+//
+// MirrorSession is mirror session.
+//
+type MirrorSession struct {
+	Name     string
+	Flags    hal.MirrorPortFlags
+	SrcPorts []hal.Port
+	Dest     *hal.MirrorDestination
+	DestID   hal.MirrorDestID
+}
+
+//
+// NewMirrorSession returns new instance.
+// dest is encapsulated in GRE (ERSPAN) if MIRROR_DEST_TUNNEL_IP_GRE is set.
+//
+func NewMirrorSession(name string, flags hal.MirrorPortFlags, srcPorts []hal.Port, dest *hal.MirrorDestination) (*MirrorSession, error) {
+	if len(name) == 0 {
+		return nil, fmt.Errorf("Mirror session name is empty.")
+	}
+
+	if len(srcPorts) == 0 {
+		return nil, fmt.Errorf("Mirror source ports are empty. %s", name)
+	}
+
+	for _, port := range srcPorts {
+		if port == dest.Port {
+			return nil, fmt.Errorf("Mirror source port is same as destination. %s %d", name, port)
+		}
+	}
+
+	if dest.IsTunnel() {
+		if dest.SrcIP.To4() == nil || dest.DstIP.To4() == nil {
+			return nil, fmt.Errorf("Invalid ERSPAN ip address. %s", name)
+		}
+		if len(dest.SrcMAC) == 0 || len(dest.DstMAC) == 0 {
+			return nil, fmt.Errorf("Invalid ERSPAN mac address. %s", name)
+		}
+		if dest.TTL == 0 {
+			dest.TTL = mirrorDefaultTTL
+		}
+		dest.GreProtocol = hal.MirrorGreProtocolERSPAN
+	}
+
+	return &MirrorSession{
+		Name:     name,
+		Flags:    flags,
+		SrcPorts: srcPorts,
+		Dest:     dest,
+	}, nil
+}
+
+//
+// NewMirrorSessionFromConfig returns new instance.
+//
+func NewMirrorSessionFromConfig(c *MirrorSessionConfig) (*MirrorSession, error) {
+	flags, err := ParseMirrorDirection(c.GetDirection())
+	if err != nil {
+		return nil, err
+	}
+
+	srcPorts := make([]hal.Port, len(c.SrcPorts))
+	for index, port := range c.SrcPorts {
+		srcPorts[index] = hal.Port(port)
+	}
+
+	dest := &hal.MirrorDestination{
+		Port: hal.Port(c.DstPort),
+	}
+
+	switch c.GetType() {
+	case MirrorTypeSPAN:
+		// nothing to do.
+
+	case MirrorTypeERSPAN:
+		dest.Flags = hal.MIRROR_DEST_TUNNEL_IP_GRE
+		dest.SrcIP = net.ParseIP(c.SrcIP)
+		dest.DstIP = net.ParseIP(c.DstIP)
+		if dest.SrcMAC, err = net.ParseMAC(c.SrcMAC); err != nil {
+			return nil, err
+		}
+		if dest.DstMAC, err = net.ParseMAC(c.DstMAC); err != nil {
+			return nil, err
+		}
+		dest.Vlan = hal.Vlan(c.Vid)
+		dest.TTL = c.TTL
+		dest.TOS = c.TOS
+
+	default:
+		return nil, fmt.Errorf("Invalid mirror type. %s", c.Type)
+	}
+
+	return NewMirrorSession(c.Name, flags, srcPorts, dest)
+}
+
+//
+// Type returns type of session.
+//
+func (m *MirrorSession) Type() string {
+	if m.Dest.IsTunnel() {
+		return MirrorTypeERSPAN
+	}
+	return MirrorTypeSPAN
+}
+
+func (m *MirrorSession) String() string {
+	return fmt.Sprintf("name:%s type:%s flags:%s src:%v dst:{%s} id:%d", m.Name, m.Type(), m.Flags, m.SrcPorts, m.Dest, m.DestID)
+}
+
+//
+// MirrorTable has mirror sessions.
+//
+type MirrorTable struct {
+	mutex    sync.Mutex
+	sessions map[string]*MirrorSession
+}
+
+//
+// NewMirrorTable returns new instance.
+//
+func NewMirrorTable() *MirrorTable {
+	return &MirrorTable{
+		sessions: map[string]*MirrorSession{},
+	}
+}
+
+//
+// Register registers session.
+//
+func (t *MirrorTable) Register(m *MirrorSession) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, ok := t.sessions[m.Name]; ok {
+		return false
+	}
+
+	t.sessions[m.Name] = m
+	return true
+}
+
+//
+// Unregister removes session and returns it.
+//
+func (t *MirrorTable) Unregister(name string) (*MirrorSession, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	m, ok := t.sessions[name]
+	if ok {
+		delete(t.sessions, name)
+	}
+
+	return m, ok
+}
+
+//
+// Has returns true if session exists.
+//
+func (t *MirrorTable) Has(name string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	_, ok := t.sessions[name]
+	return ok
+}
+
+//
+// List returns sessions sorted by name.
+//
+func (t *MirrorTable) List() []*MirrorSession {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	sessions := make([]*MirrorSession, 0, len(t.sessions))
+	for _, m := range t.sessions {
+		sessions = append(sessions, m)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Name < sessions[j].Name
+	})
+
+	return sessions
+}
+
+//
+// mirrorPortsDelete stops mirroring of ports.
+//
+func (s *Server) mirrorPortsDelete(m *MirrorSession, ports []hal.Port) {
+	for _, port := range ports {
+		if err := s.hal.MirrorPortDestDelete(port, m.Flags, m.DestID); err != nil {
+			s.log.Warnf("Mirror(%s): MirrorPortDestDelete error. port:%d %s", m.Name, port, err)
+		}
+	}
+}
+
+//
+// MirrorSessionAdd creates mirror destination and mirrors source ports to it.
+//
+func (s *Server) MirrorSessionAdd(m *MirrorSession) error {
+	if s.mirrors.Has(m.Name) {
+		return fmt.Errorf("Mirror session already exists. %s", m.Name)
+	}
+
+	destID, err := s.hal.MirrorDestCreate(m.Dest)
+	if err != nil {
+		return fmt.Errorf("MirrorDestCreate error. %s %s", m.Name, err)
+	}
+
+	m.DestID = destID
+
+	for index, port := range m.SrcPorts {
+		if err := s.hal.MirrorPortDestAdd(port, m.Flags, destID); err != nil {
+			s.mirrorPortsDelete(m, m.SrcPorts[:index])
+			s.hal.MirrorDestDestroy(destID)
+			return fmt.Errorf("MirrorPortDestAdd error. %s port:%d %s", m.Name, port, err)
+		}
+	}
+
+	if ok := s.mirrors.Register(m); !ok {
+		s.mirrorPortsDelete(m, m.SrcPorts)
+		s.hal.MirrorDestDestroy(destID)
+		return fmt.Errorf("Mirror session already exists. %s", m.Name)
+	}
+
+	s.log.Infof("Mirror(%s): added. %s", m.Name, m)
+	return nil
+}
+
+//
+// MirrorSessionDelete stops mirroring and destroys mirror destination.
+//
+func (s *Server) MirrorSessionDelete(name string) error {
+	m, ok := s.mirrors.Unregister(name)
+	if !ok {
+		return fmt.Errorf("Mirror session not found. %s", name)
+	}
+
+	s.mirrorPortsDelete(m, m.SrcPorts)
+
+	if err := s.hal.MirrorDestDestroy(m.DestID); err != nil {
+		return fmt.Errorf("MirrorDestDestroy error. %s %s", name, err)
+	}
+
+	s.log.Infof("Mirror(%s): deleted.", name)
+	return nil
+}
+
+//
+// MirrorSessions returns mirror sessions.
+//
+func (s *Server) MirrorSessions() []*MirrorSession {
+	return s.mirrors.List()
+}
+
+//
+// MirrorConfigure adds mirror sessions in config.
+//
+func (s *Server) MirrorConfigure(cfgs []*MirrorSessionConfig) error {
+	for _, cfg := range cfgs {
+		m, err := NewMirrorSessionFromConfig(cfg)
+		if err != nil {
+			return err
+		}
+
+		if err := s.MirrorSessionAdd(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,619 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+)
+
+//
+// modState is state of flow/group mod read back from HAL.
+//
+type modState int
+
+const (
+	modInstalled    modState = iota // exists in HAL.
+	modNotInstalled                 // does not exist in HAL (or differs).
+	modUnverified                   // can not be read back from HAL.
+)
+
+//
+// l3Entry is l3 host/route read back from HAL.
+//
+type l3Entry struct {
+	Match    *fibcapi.UnicastRoutingFlow_Match
+	EgressID hal.L3EgressID
+}
+
+//
+// newUnicastRoutingBindKey returns L3EgressBindKey of l3 host/route
+// which unicast routing flow is installed to.
+//
+func newUnicastRoutingBindKey(flow *fibcapi.UnicastRoutingFlow) (L3EgressBindKey, error) {
+	ip, ipnet, err := net.ParseCIDR(flow.Match.IpDst)
+	if err != nil {
+		return L3EgressBindKey{}, err
+	}
+
+	vrf := hal.Vrf(flow.Match.Vrf)
+	if flow.GType == fibcapi.GroupMod_L3_UNICAST && flow.Match.Origin == fibcapi.UnicastRoutingFlow_NEIGH {
+		return NewL3HostBindKey(&hal.L3Host{IP: ip, Vrf: vrf}), nil
+	}
+
+	return NewL3RouteBindKey(&hal.L3Route{Dst: ipnet, Vrf: vrf}), nil
+}
+
+//
+// l3Entries reads back all l3 hosts and routes from HAL.
+//
+func (s *Server) l3Entries() (map[L3EgressBindKey]*l3Entry, error) {
+	entries := map[L3EgressBindKey]*l3Entry{}
+
+	for _, flags := range []hal.L3Flags{hal.L3_NONE, hal.L3_IP6} {
+		if err := s.hal.L3HostTraverse(flags, func(host *hal.L3Host) error {
+			entries[NewL3HostBindKey(host)] = &l3Entry{
+				Match:    fibcapi.NewUnicastRoutingMatchNeigh(host.IP, uint8(host.Vrf)),
+				EgressID: host.EgressID,
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := s.hal.L3RouteTraverse(flags, func(route *hal.L3Route) error {
+			entries[NewL3RouteBindKey(route)] = &l3Entry{
+				Match:    fibcapi.NewUnicastRoutingMatchRoute(route.Dst, uint8(route.Vrf)),
+				EgressID: route.EgressID,
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+//
+// unicastRoutingEgress returns l3 egress which unicast routing flow refers.
+//
+func (s *Server) unicastRoutingEgress(flow *fibcapi.UnicastRoutingFlow) (hal.L3EgressID, bool) {
+	switch flow.GType {
+	case fibcapi.GroupMod_L3_UNICAST:
+		return s.idmaps.L3Egress.Get(flow.GId)
+	case fibcapi.GroupMod_L3_ECMP:
+		return s.idmaps.L3Ecmps.Get(flow.GId)
+	case fibcapi.GroupMod_MPLS_L3_VPN:
+		return s.idmaps.MPLSEgress.Get(NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_L3_VPN, flow.GId))
+	case fibcapi.GroupMod_SRV6_ENCAP:
+		return s.idmaps.SRv6Encaps.Get(fibcapi.NewSRv6EncapGroupID(flow.GId))
+	default:
+		return 0, false
+	}
+}
+
+//
+// unicastRoutingGroup returns group type and id of unicast routing flow
+// which refers egrID. It is the reverse of unicastRoutingEgress.
+//
+func (s *Server) unicastRoutingGroup(egrID hal.L3EgressID) (gtype fibcapi.GroupMod_GType, gid uint32, found bool) {
+	s.idmaps.L3Egress.Traverse(func(key L3EgressIDKey, id hal.L3EgressID) bool {
+		if id == egrID {
+			gtype, gid, found = fibcapi.GroupMod_L3_UNICAST, uint32(key), true
+		}
+		return !found
+	})
+	if found {
+		return
+	}
+
+	s.idmaps.L3Ecmps.Traverse(func(key L3EcmpIDKey, id hal.L3EgressID) bool {
+		if id == egrID {
+			gtype, gid, found = fibcapi.GroupMod_L3_ECMP, uint32(key), true
+		}
+		return !found
+	})
+	if found {
+		return
+	}
+
+	s.idmaps.MPLSEgress.Traverse(func(key MPLSEgressIDKey, id hal.L3EgressID) bool {
+		dstID := uint32(key) & 0x00ffffff
+		if id == egrID && uint32(key) == NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_L3_VPN, dstID) {
+			gtype, gid, found = fibcapi.GroupMod_MPLS_L3_VPN, dstID, true
+		}
+		return !found
+	})
+	if found {
+		return
+	}
+
+	s.idmaps.SRv6Encaps.Traverse(func(key L3EgressIDKey, id hal.L3EgressID) bool {
+		if id == egrID {
+			gtype, gid, found = fibcapi.GroupMod_SRV6_ENCAP, uint32(key)&0x00ffffff, true
+		}
+		return !found
+	})
+
+	return
+}
+
+//
+// halFlowEntries has entries of HAL which flows are installed to.
+//
+type halFlowEntries struct {
+	l2addrs map[string]*hal.L2Addr
+	tsws    map[hal.MplsLabel]*hal.MplsTunnelSwitch
+}
+
+func newL2AddrKey(mac net.HardwareAddr, vid hal.Vlan) string {
+	return fmt.Sprintf("%s/%d", mac, vid)
+}
+
+//
+// halFlowEntries reads back l2 addrs and mpls tunnel switches from HAL.
+//
+func (s *Server) halFlowEntries() (*halFlowEntries, error) {
+	entries := &halFlowEntries{
+		l2addrs: map[string]*hal.L2Addr{},
+		tsws:    map[hal.MplsLabel]*hal.MplsTunnelSwitch{},
+	}
+
+	if err := s.hal.L2AddrTraverse(func(l2addr *hal.L2Addr) error {
+		entries.l2addrs[newL2AddrKey(l2addr.MAC, l2addr.Vlan)] = l2addr
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.hal.MplsTunnelSwitchTraverse(func(tsw *hal.MplsTunnelSwitch) error {
+		entries.tsws[tsw.Label] = tsw
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+//
+// vlanFlowState returns state of ports in vlan added by vlan flow.
+//
+func (s *Server) vlanFlowState(flow *fibcapi.VLANFlow) modState {
+	p, portType := fibcapi.ParseDPPortId(flow.Match.InPort)
+	port := hal.Port(p)
+	vid := adjustVlan(s.hal, hal.Vlan(flow.Match.Vid))
+
+	flags, isBrVlan := flow.BridgeVlanInfoFlags()
+	if !isBrVlan {
+		if portType.IsVirtual() {
+			return modUnverified
+		}
+		vid = s.vlanPorts.ConvVID(port, vid)
+	}
+
+	pbmp, ubmp, err := s.hal.VlanPortGet(vid)
+	if err != nil || !pbmp.Has(port) {
+		return modNotInstalled
+	}
+
+	if untagged := (flags & fibcapi.BridgeVlanInfo_UNTAGGED) != 0; isBrVlan && untagged != ubmp.Has(port) {
+		return modNotInstalled
+	}
+
+	return modInstalled
+}
+
+//
+// termMacFlowState returns state of l2 addr (L3LOOKUP) added by termination mac flow.
+//
+func (s *Server) termMacFlowState(flow *fibcapi.TerminationMacFlow, l2addrs map[string]*hal.L2Addr) modState {
+	l2addr, err := s.terminationMacL2Addr(flow)
+	if err != nil || l2addr == nil {
+		return modUnverified
+	}
+
+	e, ok := l2addrs[newL2AddrKey(l2addr.MAC, l2addr.Vlan)]
+	if !ok || (e.Flags&hal.L2_L3LOOKUP) == 0 {
+		return modNotInstalled
+	}
+
+	return modInstalled
+}
+
+//
+// mplsFlowState returns state of mpls tunnel switch added by mpls flow.
+//
+func (s *Server) mplsFlowState(flow *fibcapi.MPLSFlow, tsws map[hal.MplsLabel]*hal.MplsTunnelSwitch) modState {
+	tsw, ok := tsws[hal.MplsLabel(flow.Match.Label)]
+	if !ok {
+		return modNotInstalled
+	}
+
+	action := func() hal.MplsSwitchAction {
+		switch flow.GType {
+		case fibcapi.GroupMod_MPLS_INTERFACE:
+			return hal.MPLS_SWITCH_ACTION_PHP
+		case fibcapi.GroupMod_MPLS_SWAP:
+			return hal.MPLS_SWITCH_ACTION_SWAP
+		default:
+			return hal.MPLS_SWITCH_ACTION_POP
+		}
+	}()
+	if tsw.Action != action {
+		return modNotInstalled
+	}
+
+	return modInstalled
+}
+
+//
+// policyACLFlowState returns state of field entry added by policy acl flow.
+//
+func (s *Server) policyACLFlowState(flow *fibcapi.PolicyACLFlow) modState {
+	port, portType := fibcapi.ParseDPPortId(flow.Match.InPort)
+	if portType.IsVirtual() {
+		return modUnverified
+	}
+
+	group, entry, err := s.policyACLFieldEntry(flow, hal.Port(port))
+	if err != nil {
+		return modUnverified
+	}
+
+	entryID, ok := group.EntryID(entry)
+	if !ok {
+		return modNotInstalled
+	}
+
+	if _, err := s.hal.FieldEntryGet(entryID); err != nil {
+		return modNotInstalled
+	}
+
+	return modInstalled
+}
+
+//
+// flowModState returns state of flow mod (except unicast routing) in HAL.
+//
+func (s *Server) flowModState(mod *fibcapi.FlowMod, entries *halFlowEntries) modState {
+	switch e := mod.Entry.(type) {
+	case *fibcapi.FlowMod_Vlan:
+		return s.vlanFlowState(e.Vlan)
+	case *fibcapi.FlowMod_TermMac:
+		return s.termMacFlowState(e.TermMac, entries.l2addrs)
+	case *fibcapi.FlowMod_Mpls1:
+		return s.mplsFlowState(e.Mpls1, entries.tsws)
+	case *fibcapi.FlowMod_Acl:
+		return s.policyACLFlowState(e.Acl)
+	default:
+		// bridging, multicast routing and srv6 local sid.
+		return modUnverified
+	}
+}
+
+//
+// installedFlowMods returns flow mods installed to HAL.
+// Unicast routing flows are rebuilt from l3 hosts/routes read back from HAL:
+// - flows whose l3 host/route does not exist are not returned.
+// - flows whose l3 host/route refers other egress are returned with group of that egress.
+// - l3 hosts/routes which no flow is processed for are returned as new flows.
+// Other flows are read back by entries of HAL which they are installed to,
+// and flows which can not be read back are returned with cmd NOP (unverified).
+//
+func (s *Server) installedFlowMods() ([]*fibcapi.FlowMod, error) {
+	entries, err := s.l3Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	halEntries, err := s.halFlowEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []*fibcapi.FlowMod{}
+	for _, mod := range s.mods.ListFlowMods() {
+		flow := mod.GetUnicast()
+		if flow == nil {
+			switch s.flowModState(mod, halEntries) {
+			case modInstalled:
+				mods = append(mods, mod)
+
+			case modUnverified:
+				mod.Cmd = fibcapi.FlowMod_NOP
+				mods = append(mods, mod)
+
+			default:
+				s.log.Debugf("FlowDesc: %s %s not found in HAL.", mod.Table, mod.MatchKey())
+			}
+			continue
+		}
+
+		key, err := newUnicastRoutingBindKey(flow)
+		if err != nil {
+			s.log.Warnf("FlowDesc: Invalid IP address. %s %s", flow.Match.IpDst, err)
+			continue
+		}
+
+		entry, ok := entries[key]
+		if !ok {
+			s.log.Debugf("FlowDesc: %s not found in HAL.", &key)
+			continue
+		}
+
+		delete(entries, key)
+
+		if egrID, ok := s.unicastRoutingEgress(flow); !ok || egrID != entry.EgressID {
+			flow.GType, flow.GId, _ = s.unicastRoutingGroup(entry.EgressID)
+			s.log.Debugf("FlowDesc: %s l3eg:%d unmatch.", &key, entry.EgressID)
+		}
+
+		mods = append(mods, mod)
+	}
+
+	keys := make([]L3EgressBindKey, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	for _, key := range keys {
+		entry := entries[key]
+		gtype, gid, ok := s.unicastRoutingGroup(entry.EgressID)
+		if !ok {
+			// not installed by flow mod.
+			continue
+		}
+
+		flow := fibcapi.NewUnicastRoutingFlow(entry.Match, nil, gtype, gid)
+		mods = append(mods, flow.ToMod(fibcapi.FlowMod_ADD, ""))
+	}
+
+	return mods, nil
+}
+
+//
+// l2InterfaceGroupState returns state of l3 iface added by l2 interface group.
+// hwaddr and vrf of group are updated by l3 iface read back from HAL.
+//
+func (s *Server) l2InterfaceGroupState(group *fibcapi.L2InterfaceGroup) modState {
+	ifaceID, ok := s.idmaps.L3Ifaces.Get(group.PortId, group.GetAdjustedVlanVid())
+	if !ok {
+		return modNotInstalled
+	}
+
+	iface, err := s.hal.L3IfaceGet(ifaceID)
+	if err != nil {
+		return modNotInstalled
+	}
+
+	if mac := iface.MAC.String(); mac != group.HwAddr {
+		group.HwAddr = mac
+	}
+
+	group.Vrf = uint32(iface.Vrf)
+
+	return modInstalled
+}
+
+//
+// l3UnicastGroupState returns state of l3 egress added by l3 unicast group.
+// dst mac and port of group are updated by l3 egress read back from HAL.
+//
+func (s *Server) l3UnicastGroupState(group *fibcapi.L3UnicastGroup) modState {
+	egrID, ok := s.idmaps.L3Egress.Get(group.NeId)
+	if !ok {
+		return modNotInstalled
+	}
+
+	egr, err := s.hal.L3EgressGet(egrID)
+	if err != nil {
+		s.log.Debugf("GroupDesc: L3-UC(neid:%d) l3eg:%d not found in HAL. %s", group.NeId, egrID, err)
+		return modNotInstalled
+	}
+
+	if mac := egr.MAC.String(); mac != group.GetEthDstHwAddr().String() {
+		group.EthDst = mac
+	}
+
+	if port := uint32(egr.Port); !egr.IsTrunk() && port != group.PhyPortId {
+		group.PhyPortId = port
+	}
+
+	return modInstalled
+}
+
+//
+// l3EcmpGroupState returns state of l3 egress ecmp added by l3 ecmp group.
+// members of group are updated by l3 egress ecmp read back from HAL.
+//
+func (s *Server) l3EcmpGroupState(group *fibcapi.L3EcmpGroup) modState {
+	ecmpEgrID, ok := s.idmaps.L3Ecmps.Get(group.EcmpId)
+	if !ok {
+		return modNotInstalled
+	}
+
+	ecmp, err := s.hal.L3EgressEcmpGet(ecmpEgrID)
+	if err != nil {
+		return modNotInstalled
+	}
+
+	neIDs := map[hal.L3EgressID]uint32{}
+	s.idmaps.L3Egress.Traverse(func(key L3EgressIDKey, id hal.L3EgressID) bool {
+		neIDs[id] = uint32(key)
+		return true
+	})
+
+	members := map[uint32]struct{}{}
+	for _, member := range ecmp.Members {
+		if neID, ok := neIDs[member]; ok {
+			members[neID] = struct{}{}
+		}
+	}
+
+	matched := len(members) == len(group.NeIds)
+	for _, neID := range group.NeIds {
+		if _, ok := members[neID]; !ok {
+			matched = false
+		}
+	}
+
+	if !matched {
+		group.NeIds = make([]uint32, 0, len(members))
+		for neID := range members {
+			group.NeIds = append(group.NeIds, neID)
+		}
+		sort.Slice(group.NeIds, func(i, j int) bool { return group.NeIds[i] < group.NeIds[j] })
+	}
+
+	return modInstalled
+}
+
+//
+// mplsInterfaceGroupState returns state of l3 egress added by mpls interface group.
+// dst mac and port of group are updated by l3 egress read back from HAL.
+//
+func (s *Server) mplsInterfaceGroupState(group *fibcapi.MPLSInterfaceGroup) modState {
+	egrID, ok := s.idmaps.MPLSEgress.Get(fibcapi.NewMPLSInterfaceGroupID(group.NeId))
+	if !ok {
+		return modNotInstalled
+	}
+
+	egr, err := s.hal.L3EgressGet(egrID)
+	if err != nil {
+		return modNotInstalled
+	}
+
+	if mac := egr.MAC.String(); mac != group.GetEthDstHwAddr().String() {
+		group.EthDst = mac
+	}
+
+	if port := uint32(egr.Port); !egr.IsTrunk() && port != group.PortId {
+		group.PortId = port
+	}
+
+	return modInstalled
+}
+
+//
+// mplsLabelGroupState returns state of l3 egress (and tunnel initiator)
+// added by mpls label group. new label of group is updated by label read back from HAL.
+//
+func (s *Server) mplsLabelGroupState(gtype fibcapi.GroupMod_GType, group *fibcapi.MPLSLabelGroup) modState {
+	gid := NewMPLSLabelGroupID(gtype, group.DstId)
+
+	egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+	if !ok {
+		return modNotInstalled
+	}
+
+	egr, err := s.hal.L3EgressGet(egrID)
+	if err != nil {
+		return modNotInstalled
+	}
+
+	switch gtype {
+	case fibcapi.GroupMod_MPLS_L3_VPN, fibcapi.GroupMod_MPLS_SWAP:
+		if egr.MplsLabel == nil {
+			return modNotInstalled
+		}
+
+		group.NewLabel = uint32(egr.MplsLabel.Label)
+
+	case fibcapi.GroupMod_MPLS_TUNNEL1, fibcapi.GroupMod_MPLS_TUNNEL2:
+		ifaceID, ok := s.idmaps.MPLSIfaces.Get(gid)
+		if !ok || ifaceID != egr.IfaceID {
+			return modNotInstalled
+		}
+
+		labels, err := s.hal.MplsTunnelInitiatorGet(ifaceID, mplsLabelMaxNum)
+		if err != nil || len(labels) == 0 {
+			return modNotInstalled
+		}
+
+		found := false
+		for _, label := range labels {
+			if uint32(label.Label) == group.NewLabel {
+				found = true
+			}
+		}
+		if !found {
+			return modNotInstalled
+		}
+
+	default:
+		return modUnverified
+	}
+
+	return modInstalled
+}
+
+//
+// groupModState returns state of group mod in HAL.
+//
+func (s *Server) groupModState(mod *fibcapi.GroupMod) modState {
+	switch e := mod.Entry.(type) {
+	case *fibcapi.GroupMod_L2Iface:
+		return s.l2InterfaceGroupState(e.L2Iface)
+	case *fibcapi.GroupMod_L3Unicast:
+		return s.l3UnicastGroupState(e.L3Unicast)
+	case *fibcapi.GroupMod_L3Ecmp:
+		return s.l3EcmpGroupState(e.L3Ecmp)
+	case *fibcapi.GroupMod_MplsIface:
+		return s.mplsInterfaceGroupState(e.MplsIface)
+	case *fibcapi.GroupMod_MplsLabel:
+		return s.mplsLabelGroupState(mod.GType, e.MplsLabel)
+	default:
+		// multicast, overlay, vxlan tunnel and srv6 encap.
+		return modUnverified
+	}
+}
+
+//
+// installedGroupMods returns group mods installed to HAL.
+// Groups are compared with entries read back from HAL:
+// - groups whose entry does not exist are not returned.
+// - groups whose entry differs are returned with values of that entry.
+// - groups which can not be read back are returned with cmd NOP (unverified).
+//
+func (s *Server) installedGroupMods() []*fibcapi.GroupMod {
+	mods := []*fibcapi.GroupMod{}
+	for _, mod := range s.mods.ListGroupMods() {
+		switch s.groupModState(mod) {
+		case modInstalled:
+			mods = append(mods, mod)
+
+		case modUnverified:
+			mod.Cmd = fibcapi.GroupMod_NOP
+			mods = append(mods, mod)
+
+		default:
+			s.log.Debugf("GroupDesc: %s %08x not found in HAL.", mod.GType, mod.GroupID())
+		}
+	}
+
+	return mods
+}
@@ -0,0 +1,148 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	fibcapi "fabricflow/fibc/api"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+//
+// ModTableEntry is entry of ModTable.
+//
+type ModTableEntry struct {
+	Mod proto.Message
+	seq uint64
+}
+
+//
+// ModTable has flow/group mods processed by gonsld.
+// It is used to list entries to be read back from HAL.
+//
+type ModTable struct {
+	mutex  sync.Mutex
+	flows  map[string]*ModTableEntry
+	groups map[uint32]*ModTableEntry
+
+	seq uint64
+}
+
+//
+// NewModTable returns new ModTable.
+//
+func NewModTable() *ModTable {
+	return &ModTable{
+		flows:  map[string]*ModTableEntry{},
+		groups: map[uint32]*ModTableEntry{},
+	}
+}
+
+func (t *ModTable) nextSeq() uint64 {
+	t.seq++
+	return t.seq
+}
+
+//
+// UpdateFlowMod adds, replaces or removes flow entry by cmd of mod.
+//
+func (t *ModTable) UpdateFlowMod(mod *fibcapi.FlowMod) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := mod.MatchKey()
+
+	switch mod.Cmd {
+	case fibcapi.FlowMod_ADD, fibcapi.FlowMod_MODIFY, fibcapi.FlowMod_MODIFY_STRICT:
+		m := proto.Clone(mod).(*fibcapi.FlowMod)
+		m.Cmd = fibcapi.FlowMod_ADD
+		t.flows[key] = &ModTableEntry{Mod: m, seq: t.nextSeq()}
+
+	case fibcapi.FlowMod_DELETE, fibcapi.FlowMod_DELETE_STRICT:
+		delete(t.flows, key)
+	}
+}
+
+//
+// UpdateGroupMod adds, replaces or removes group entry by cmd of mod.
+//
+func (t *ModTable) UpdateGroupMod(mod *fibcapi.GroupMod) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	gid := mod.GroupID()
+
+	switch mod.Cmd {
+	case fibcapi.GroupMod_ADD, fibcapi.GroupMod_MODIFY:
+		m := proto.Clone(mod).(*fibcapi.GroupMod)
+		m.Cmd = fibcapi.GroupMod_ADD
+		t.groups[gid] = &ModTableEntry{Mod: m, seq: t.nextSeq()}
+
+	case fibcapi.GroupMod_DELETE:
+		delete(t.groups, gid)
+	}
+}
+
+func sortedModTableEntries(entries []*ModTableEntry) []*ModTableEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+	return entries
+}
+
+//
+// ListFlowMods returns copy of flow mods in order of last registration.
+//
+func (t *ModTable) ListFlowMods() []*fibcapi.FlowMod {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	entries := make([]*ModTableEntry, 0, len(t.flows))
+	for _, e := range t.flows {
+		entries = append(entries, e)
+	}
+
+	mods := make([]*fibcapi.FlowMod, len(entries))
+	for index, e := range sortedModTableEntries(entries) {
+		mods[index] = proto.Clone(e.Mod).(*fibcapi.FlowMod)
+	}
+
+	return mods
+}
+
+//
+// ListGroupMods returns copy of group mods in order of last registration.
+//
+func (t *ModTable) ListGroupMods() []*fibcapi.GroupMod {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	entries := make([]*ModTableEntry, 0, len(t.groups))
+	for _, e := range t.groups {
+		entries = append(entries, e)
+	}
+
+	mods := make([]*fibcapi.GroupMod, len(entries))
+	for index, e := range sortedModTableEntries(entries) {
+		mods[index] = proto.Clone(e.Mod).(*fibcapi.GroupMod)
+	}
+
+	return mods
+}
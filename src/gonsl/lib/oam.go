@@ -20,13 +20,11 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
-const OAMAuditRouteCntTraverseNum = 1024
-
 func (s *Server) fibcOAMAuditRouteCntReply(count uint64, xid uint32) {
 	reply := fibcapi.OAM_Reply{
 		DpId:    s.DpID(),
@@ -45,44 +43,22 @@ func (s *Server) fibcOAMAuditRouteCntReply(count uint64, xid uint32) {
 	}
 }
 
-func (s *Server) fibcOAMAuditRoutCnt(flag uint32) (uint64, error) {
-	var (
-		startIndex uint32
-		count      uint64
-	)
-
-	for {
-		endIndex := startIndex + OAMAuditRouteCntTraverseNum
-		routeCount := uint64(0)
-
-		s.log.Debugf("OAM(AuditRouteCnt): L3RouteTraverse flag=0x%x sta=%d ent=%d",
-			flag, startIndex, endIndex)
-
-		err := opennsl.L3RouteTraverse(s.Unit(), flag, startIndex, endIndex, func(rtUnit int, rtIndex int, route *opennsl.L3Route) opennsl.OpenNSLError {
-			routeCount++
-			return opennsl.E_NONE
-		})
-
-		if err != nil {
-			s.log.Errorf("OAM(AuditRouteCnt): L3RouteTraverse flag=0x%x sta=%d ent=%d error. %s",
-				flag, startIndex, endIndex, err)
-			return 0, err
-		}
-
-		s.log.Debugf("OAM(AuditRouteCnt): L3RouteTraverse flag=0x%x sta=%d ent=%d route=%d",
-			flag, startIndex, endIndex, routeCount)
-
-		if routeCount == 0 {
-			s.log.Debugf("OAM(AuditRouteCnt): L3RouteTraverse flag=0x%x sta=%d ent=%d end.",
-				flag, startIndex, endIndex)
-			break
-		}
-
-		count += routeCount
-		startIndex += (OAMAuditRouteCntTraverseNum + 1)
+func (s *Server) fibcOAMAuditRoutCnt(flags hal.L3Flags) (uint64, error) {
+	var count uint64
+
+	s.log.Debugf("OAM(AuditRouteCnt): L3RouteTraverse flags=%s", flags)
+
+	err := s.hal.L3RouteTraverse(flags, func(route *hal.L3Route) error {
+		count++
+		return nil
+	})
+
+	if err != nil {
+		s.log.Errorf("OAM(AuditRouteCnt): L3RouteTraverse flags=%s error. %s", flags, err)
+		return 0, err
 	}
 
-	s.log.Debugf("OAM(AuditRouteCnt): flag=0x%x count=%d", flag, count)
+	s.log.Debugf("OAM(AuditRouteCnt): flags=%s count=%d", flags, count)
 
 	return count, nil
 }
@@ -91,12 +67,12 @@ func (s *Server) FIBCOAMAuditRouteCntRequest(hdr *fibcnet.Header, oam *fibcapi.O
 	s.log.Debugf("OAM(AuditRouteCnt): %v", hdr)
 	fibcapi.LogOAMRequest(s.log, log.DebugLevel, oam, hdr.Xid)
 
-	countV4, err := s.fibcOAMAuditRoutCnt(0)
+	countV4, err := s.fibcOAMAuditRoutCnt(hal.L3_NONE)
 	if err != nil {
 		return err
 	}
 
-	countV6, err := s.fibcOAMAuditRoutCnt(uint32(opennsl.L3_IP6))
+	countV6, err := s.fibcOAMAuditRoutCnt(hal.L3_IP6)
 	if err != nil {
 		return err
 	}
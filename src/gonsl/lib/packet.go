@@ -20,85 +20,170 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
+	hal "gonsl/hal"
+	"sort"
+	"sync"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
-	packetRxPri     = 10
-	packetRxSize    = 16 * 1024
-	packetRxPerChan = 10
-	packerRxChans   = 4
-	packetRxPps     = 30000
-	packetTxPadsize = 4
+	packetRxPri = 10
 )
 
+type rxQueue struct {
+	pri  int
+	stop chan struct{}
+}
+
 //
-// RxInit initialize opennsl rx function.
+// RxQueueTable has cpu queues receiving packets.
+// Callback is registered for each queue, so that queues are
+// added or removed without stopping rx of other queues.
 //
-func RxInit(unit int) error {
-	pcfg, err := opennsl.PortConfigGet(unit)
-	if err != nil {
-		log.Errorf("RxInit: PortConfigGet error. %s", err)
-		return err
-	}
+type RxQueueTable struct {
+	mutex  sync.Mutex
+	hal    hal.HAL
+	rxCh   chan<- *hal.Packet
+	done   <-chan struct{}
+	queues map[uint32]*rxQueue
+}
 
-	bmp, _ := pcfg.PBmp(opennsl.PORT_CONFIG_CPU)
-	if err := opennsl.VlanDefaultMustGet(unit).PortAdd(unit, bmp, bmp); err != nil {
-		log.Errorf("DEFAULT_VLAN.PortAdd. %s", err)
-		return err
+//
+// NewRxQueueTable returns new instance.
+//
+func NewRxQueueTable(h hal.HAL) *RxQueueTable {
+	return &RxQueueTable{
+		hal:    h,
+		queues: map[uint32]*rxQueue{},
 	}
-
-	log.Infof("Rx init ok.")
-	return nil
 }
 
 //
-// RxStart starts receiving packets.
+// Start sets channel to send packets received.
+// Queues are registered by Update after Start.
 //
-func (s *Server) RxStart(done <-chan struct{}) <-chan *opennsl.Pkt {
-	rxCh := make(chan *opennsl.Pkt)
-	go RxServe(s.Unit(), rxCh, done)
+func (t *RxQueueTable) Start(rxCh chan<- *hal.Packet, done <-chan struct{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 
-	return rxCh
+	t.rxCh = rxCh
+	t.done = done
 }
 
 //
-// RxServe serve receiving packets.
+// Update registers queues added and unregisters queues removed.
+// An error of a queue is logged and other queues are registered.
 //
-func RxServe(unit int, rxCh chan<- *opennsl.Pkt, done <-chan struct{}) {
+func (t *RxQueueTable) Update(queues []uint32) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 
-	flg := opennsl.NewRxCallbackFlags(fieldCosDefault)
-	err := opennsl.RxRegister(unit, packetRxPri, flg, func(unit int, pkt *opennsl.Pkt) {
-		rxCh <- pkt
-	})
-	if err != nil {
-		log.Errorf("RxPacket: RxRegister error. %s", err)
+	if t.rxCh == nil {
 		return
 	}
 
-	defer opennsl.RxUnregister(unit, packetRxPri)
+	select {
+	case <-t.done:
+		return
+	default:
+	}
 
-	if active := opennsl.RxActive(unit); !active {
-		cfg := opennsl.NewRxCfg()
-		cfg.SetPktSize(packetRxSize)
-		cfg.SetPktsPerChain(packetRxPerChan)
-		cfg.SetGlobalPps(packetRxPps)
-		cfg.ChanCfg(1).SetChains(packerRxChans)
-		// cfg.ChanCfg(1).SetCosBmp(0xffffffff)
+	queueSet := map[uint32]struct{}{}
+	for _, queue := range queues {
+		queueSet[queue] = struct{}{}
 
-		if err := opennsl.RxStart(unit, cfg); err != nil {
-			log.Errorf("RxPacket: RxStart error. %s", err)
-			return
+		if _, ok := t.queues[queue]; ok {
+			continue
 		}
 
-		defer cfg.Stop(unit)
+		q, err := t.register(queue)
+		if err != nil {
+			log.Errorf("RxPacket: RxRegister error. queue:%d %s", queue, err)
+			continue
+		}
 
-		log.Infof("RxPacket: activated.")
+		t.queues[queue] = q
+		log.Infof("RxPacket: queue:%d started.", queue)
 	}
 
-	log.Infof("RxPacket: Started.")
+	for queue, q := range t.queues {
+		if _, ok := queueSet[queue]; !ok {
+			close(q.stop)
+			delete(t.queues, queue)
+		}
+	}
+}
+
+//
+// Queues returns queues registered.
+//
+func (t *RxQueueTable) Queues() []uint32 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	queues := make([]uint32, 0, len(t.queues))
+	for queue := range t.queues {
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i] < queues[j] })
+
+	return queues
+}
+
+func (t *RxQueueTable) register(queue uint32) (*rxQueue, error) {
+	q := &rxQueue{
+		pri:  packetRxPri + int(queue),
+		stop: make(chan struct{}),
+	}
+
+	rxCh, done := t.rxCh, t.done
+	err := t.hal.RxRegister(q.pri, queue, func(pkt *hal.Packet) {
+		select {
+		case rxCh <- pkt:
+		case <-q.stop:
+		case <-done:
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-q.stop:
+		case <-done:
+		}
+
+		t.hal.RxUnregister(q.pri)
+		log.Infof("RxPacket: queue:%d exit.", queue)
+	}()
+
+	return q, nil
+}
+
+//
+// RxStart starts receiving packets of default queue and queues of copp classes.
+// Queues are updated by CoppConfigure.
+//
+func (s *Server) RxStart(done <-chan struct{}) <-chan *hal.Packet {
+	rxCh := make(chan *hal.Packet)
+	s.rxQueues.Start(rxCh, done)
+	s.rxQueues.Update(s.copp.Queues())
+
+	return rxCh
+}
+
+//
+// RxServe serve receiving packets copied to cpu queues.
+// An error of a queue does not stop receiving of other queues.
+//
+func RxServe(h hal.HAL, queues []uint32, rxCh chan<- *hal.Packet, done <-chan struct{}) {
+	t := NewRxQueueTable(h)
+	t.Start(rxCh, done)
+	t.Update(queues)
+
+	log.Infof("RxPacket: Started. queues:%v", t.Queues())
 
 	<-done
 
@@ -113,25 +198,14 @@ func (s *Server) FIBCFFPacketOut(hdr *fibcnet.Header, pktout *fibcapi.FFPacketOu
 		s.log.Debugf("PacketOUT: %v %d %d", hdr, pktout.GetPortNo(), len(pktout.Data))
 	}
 
-	pkt, err := opennsl.PktAlloc(s.Unit(), len(pktout.Data)+packetTxPadsize, opennsl.PKT_F_NONE)
-	if err != nil {
-		s.log.Errorf("PacketOut: PktAlloc error. %s", err)
-		return
-	}
-
-	defer pkt.Free(s.Unit())
-
-	if err := pkt.Memcpy(0, pktout.GetData()); err != nil {
-		s.log.Errorf("PacketOut: Memcpy error. %s", err)
-		return
+	pkt := &hal.Packet{
+		DstPort: hal.Port(pktout.GetPortNo()),
+		Data:    pktout.GetData(),
 	}
 
-	pkt.TxPBmp().Clear()
-	pkt.TxPBmp().Add(opennsl.Port(pktout.GetPortNo()))
-
 	s.dumpTxPkt(pkt)
 
-	if err := pkt.Tx(s.Unit()); err != nil {
+	if err := s.hal.Tx(pkt); err != nil {
 		s.log.Errorf("PacketOut: Send error. %s", err)
 		return
 	}
@@ -20,85 +20,28 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	fibcnet "fabricflow/fibc/net"
-
-	"github.com/beluganos/go-opennsl/opennsl"
+	hal "gonsl/hal"
 
 	log "github.com/sirupsen/logrus"
 )
 
-//
-// PortBmpGet returns port map instance.
-//
-func PortBmpGet(unit int) (*opennsl.PBmp, error) {
-	portcfg, err := opennsl.PortConfigGet(unit)
-	if err != nil {
-		return nil, err
-	}
-
-	return portcfg.PBmp(opennsl.PORT_CONFIG_E)
-}
-
-//
-// PortDefaultConfig setup ports.
-//
-func PortDefaultConfig(unit int) error {
-	pbmp, err := PortBmpGet(unit)
-	if err != nil {
-		return err
-	}
-
-	portInfo := opennsl.NewPortInfo()
-	portInfo.SetSpeed(0)
-	portInfo.SetDuplex(opennsl.PORT_DUPLEX_FULL)
-	portInfo.SetPauseRX(opennsl.PORT_ABILITY_PAUSE_RX)
-	portInfo.SetPauseTX(opennsl.PORT_ABILITY_PAUSE_TX)
-	portInfo.SetLinkscan(opennsl.LINKSCAN_MODE_SW)
-	portInfo.SetAutoNeg(false)
-	portInfo.SetEnable(true)
-	portInfo.SetActionMask(opennsl.NewPortAttr(
-		opennsl.PORT_ATTR_AUTONEG_MASK,
-		opennsl.PORT_ATTR_DUPLEX_MASK,
-		opennsl.PORT_ATTR_PAUSE_TX_MASK,
-		opennsl.PORT_ATTR_PAUSE_RX_MASK,
-		opennsl.PORT_ATTR_LINKSCAN_MASK,
-		opennsl.PORT_ATTR_ENABLE_MASK,
-		opennsl.PORT_ATTR_SPEED_MASK,
-	))
-	stg := opennsl.Stg(1)
-
-	return pbmp.Each(func(port opennsl.Port) error {
-		if err := stg.StpSet(unit, port, opennsl.STG_STP_FORWARD); err != nil {
-			log.Errorf("StgSet error. %d %s", port, err)
-			return err
-		}
-
-		if err := port.SelectiveSet(unit, portInfo); err != nil {
-			log.Errorf("SelectiveSet error. %d %s", port, err)
-			return err
-		}
-
-		log.Infof("PortDefaultConfig %d ok.", port)
-		return nil
-	})
-}
-
 //
 // PortDefaultVlanConfig registers port to default vlan.
 //
-func PortDefaultVlanConfig(unit int) error {
-	pbmp, err := PortBmpGet(unit)
+func PortDefaultVlanConfig(h hal.HAL) error {
+	pbmp, err := h.PortBmp()
 	if err != nil {
 		log.Errorf("PortBmpGet error. %s", err)
 		return err
 	}
 
-	if err := opennsl.VlanDefaultMustGet(unit).PortAdd(unit, pbmp, pbmp); err != nil {
+	if err := h.VlanPortAdd(h.VlanDefault(), pbmp, pbmp); err != nil {
 		log.Errorf("PortAdd error. %s", err)
 		return err
 	}
 
-	return pbmp.Each(func(port opennsl.Port) error {
-		if err := port.UntaggedVlanSet(unit, opennsl.VlanDefaultMustGet(unit)); err != nil {
+	return pbmp.Each(func(port hal.Port) error {
+		if err := h.PortUntaggedVlanSet(port, h.VlanDefault()); err != nil {
 			log.Errorf("UntaggedVlanSet error. %d %s", port, err)
 			return err
 		}
@@ -108,24 +51,12 @@ func PortDefaultVlanConfig(unit int) error {
 	})
 }
 
-//
-// PortInfoGet returns opennsl port info.
-//
-func PortInfoGet(unit int, port opennsl.Port) (*opennsl.PortInfo, error) {
-	info := opennsl.NewPortInfo()
-	if err := info.PortSelectiveGet(unit, port); err != nil {
-		return nil, err
-	}
-
-	return info, nil
-}
-
-func (s *Server) fibcFFMultipartPortList(portNo uint32) ([]opennsl.Port, error) {
+func (s *Server) fibcFFMultipartPortList(portNo uint32) ([]hal.Port, error) {
 	if portNo != 0xffffffff {
-		return []opennsl.Port{opennsl.Port(portNo)}, nil
+		return []hal.Port{hal.Port(portNo)}, nil
 	}
 
-	pbmp, err := PortBmpGet(s.Unit())
+	pbmp, err := s.hal.PortBmp()
 	if err != nil {
 		s.log.Errorf("Multipart(Port): PortBmpGet error. %s", err)
 		return nil, err
@@ -140,7 +71,7 @@ func (s *Server) fibcFFMultipartPortRequestGet(hdr *fibcnet.Header, req *fibcapi
 		return
 	}
 
-	statsList, err := NewPortStats(req.Names).GetAll(s.Unit(), ports)
+	statsList, err := NewPortStats(req.Names).GetAll(s.hal, ports)
 	if err != nil {
 		s.log.Errorf("Multipart(Port): PortStatsGetAll error. %s", err)
 		return
@@ -167,7 +98,7 @@ func (s *Server) fibcFFMultipartPortRequestReset(hdr *fibcnet.Header, req *fibca
 	for _, portID := range ports {
 		s.log.Debugf("Multipart(Port): StatClear port=%d", portID)
 
-		if err := opennsl.StatClear(s.Unit(), portID); err != nil {
+		if err := s.hal.PortStatClear(portID); err != nil {
 			s.log.Errorf("Multipart(Port): StatClear error. port=%d %s", portID, err)
 		}
 	}
@@ -201,25 +132,26 @@ func (s *Server) FIBCFFMultipartPortDescRequest(hdr *fibcnet.Header, mp *fibcapi
 	s.log.Debugf("Multipart(PortDesc): %v", hdr)
 	fibcapi.LogFFMultipartRequest(s.log, log.DebugLevel, mp, hdr.Xid)
 
-	pbmp, err := PortBmpGet(s.Unit())
+	pbmp, err := s.hal.PortBmp()
 	if err != nil {
 		s.log.Errorf("Multipart(PortDesc): PortBmpGet error. %s", err)
 		return
 	}
 
 	ffports := []*fibcapi.FFPort{}
-	err = pbmp.Each(func(port opennsl.Port) error {
-		linkStatus, _ := port.LinkStatusGet(s.Unit())
-		curSpeed, _ := port.SpeedGet(s.Unit())
-		maxSpeed, _ := port.SpeedMax(s.Unit())
-		hwaddr, _ := port.PauseAddrGet(s.Unit())
+	err = pbmp.Each(func(port hal.Port) error {
+		pinfo, err := s.hal.PortInfoGet(port)
+		if err != nil {
+			s.log.Warnf("Multipart(PortDesc): PortInfoGet error. port:%d %s", port, err)
+			return nil
+		}
 
 		ffport := fibcapi.NewFFPort(uint32(port))
-		ffport.CurrSpeed = uint32(curSpeed)
-		ffport.MaxSpeed = uint32(maxSpeed)
-		ffport.HwAddr = hwaddr.String()
+		ffport.CurrSpeed = uint32(pinfo.Speed)
+		ffport.MaxSpeed = uint32(pinfo.SpeedMax)
+		ffport.HwAddr = pinfo.HwAddr.String()
 		ffport.State = func() uint32 {
-			if linkStatus == 0 {
+			if !pinfo.LinkUp {
 				return 0x1 // OFPPS_LINK_DOWN
 			}
 			return 0
@@ -250,16 +182,16 @@ func (s *Server) FIBCFFPortMod(hdr *fibcnet.Header, mod *fibcapi.FFPortMod) {
 		return
 	}
 
-	port := opennsl.Port(portId)
+	port := hal.Port(portId)
 
 	switch mod.Status {
 	case fibcapi.PortStatus_UP:
-		if err := port.EnableSet(s.Unit(), opennsl.PORT_ENABLE_TRUE); err != nil {
+		if err := s.hal.PortEnableSet(port, true); err != nil {
 			s.log.Errorf("PortMod: PortEnableSet(TRUE) error. port %d. %s", port, err)
 		}
 
 	case fibcapi.PortStatus_DOWN:
-		if err := port.EnableSet(s.Unit(), opennsl.PORT_ENABLE_FALSE); err != nil {
+		if err := s.hal.PortEnableSet(port, false); err != nil {
 			s.log.Errorf("PortMod: PortEnableSet(FALSE) error. port %d. %s", port, err)
 		}
 
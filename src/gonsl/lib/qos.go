@@ -0,0 +1,240 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"sync"
+)
+
+//
+// NewQosMap returns classification map of qos config.
+//
+func NewQosMap(cfg *QosConfig, trust hal.QosTrust) (*hal.QosMap, error) {
+	m := hal.NewQosMap(trust)
+	for _, class := range cfg.Classes {
+		if class.TC < 0 || class.TC >= hal.COSQ_NUM {
+			return nil, fmt.Errorf("Invalid tc. %s", class)
+		}
+
+		queue := hal.CosQueue(class.TC)
+
+		for _, dscp := range class.Dscp {
+			if dscp < 0 || dscp >= hal.DSCP_NUM {
+				return nil, fmt.Errorf("Invalid dscp. %s", class)
+			}
+			m.Dscp[uint8(dscp)] = queue
+		}
+
+		for _, pcp := range class.Pcp {
+			if pcp < 0 || pcp >= hal.PCP_NUM {
+				return nil, fmt.Errorf("Invalid pcp. %s", class)
+			}
+			m.Pcp[uint8(pcp)] = queue
+		}
+	}
+
+	return m, nil
+}
+
+//
+// NewCosqPortSched returns scheduler of qos port config.
+// Queues not in config are scheduled with weight 1 and no shaping.
+//
+func NewCosqPortSched(cfg *QosPortConfig) (*hal.CosqPortSched, error) {
+	mode, err := hal.ParseCosqSchedMode(cfg.GetSched())
+	if err != nil {
+		return nil, err
+	}
+
+	sched := newDefaultCosqPortSched()
+	sched.Mode = mode
+
+	for _, q := range cfg.Queues {
+		if q.TC < 0 || q.TC >= hal.COSQ_NUM {
+			return nil, fmt.Errorf("Invalid tc. %s", q)
+		}
+
+		if q.MaxKbps != 0 && q.MinKbps > q.MaxKbps {
+			return nil, fmt.Errorf("Invalid rate. %s", q)
+		}
+
+		qcfg := sched.Queues[q.TC]
+		qcfg.KbitsMin = q.MinKbps
+		qcfg.KbitsMax = q.MaxKbps
+		if q.Weight > 0 {
+			qcfg.Weight = q.Weight
+		}
+	}
+
+	return sched, nil
+}
+
+func newDefaultCosqPortSched() *hal.CosqPortSched {
+	queues := make([]*hal.CosqQueueConfig, hal.COSQ_NUM)
+	for index := range queues {
+		queues[index] = &hal.CosqQueueConfig{
+			Queue:  hal.CosQueue(index),
+			Weight: 1,
+		}
+	}
+
+	return &hal.CosqPortSched{
+		Mode:   hal.COSQ_SCHED_STRICT,
+		Queues: queues,
+	}
+}
+
+//
+// QosPortEntry is qos settings of port.
+//
+type QosPortEntry struct {
+	Map   *hal.QosMap
+	Sched *hal.CosqPortSched
+}
+
+//
+// QosTable has qos settings applied to ports.
+//
+type QosTable struct {
+	mutex sync.Mutex
+	ports map[hal.Port]*QosPortEntry
+}
+
+//
+// NewQosTable returns new instance.
+//
+func NewQosTable() *QosTable {
+	return &QosTable{
+		ports: map[hal.Port]*QosPortEntry{},
+	}
+}
+
+//
+// NewQosPortEntries returns qos settings of each port.
+// Later port config overrides earlier one if ports overlap.
+//
+func NewQosPortEntries(cfg *QosConfig, allPorts []hal.Port) (map[hal.Port]*QosPortEntry, error) {
+	entries := map[hal.Port]*QosPortEntry{}
+
+	for _, pcfg := range cfg.Ports {
+		trust, err := hal.ParseQosTrust(pcfg.GetTrust())
+		if err != nil {
+			return nil, err
+		}
+
+		qmap, err := NewQosMap(cfg, trust)
+		if err != nil {
+			return nil, err
+		}
+
+		sched, err := NewCosqPortSched(pcfg)
+		if err != nil {
+			return nil, err
+		}
+
+		ports := allPorts
+		if len(pcfg.Ports) != 0 {
+			ports = make([]hal.Port, len(pcfg.Ports))
+			for index, port := range pcfg.Ports {
+				ports[index] = hal.Port(port)
+			}
+		}
+
+		for _, port := range ports {
+			entries[port] = &QosPortEntry{
+				Map:   qmap,
+				Sched: sched,
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+//
+// Update replaces settings and returns ports which are no longer configured.
+//
+func (t *QosTable) Update(entries map[hal.Port]*QosPortEntry) []hal.Port {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	removed := []hal.Port{}
+	for port := range t.ports {
+		if _, ok := entries[port]; !ok {
+			removed = append(removed, port)
+		}
+	}
+
+	t.ports = entries
+	return removed
+}
+
+//
+// Get returns settings of port.
+//
+func (t *QosTable) Get(port hal.Port) (*QosPortEntry, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	e, ok := t.ports[port]
+	return e, ok
+}
+
+//
+// QosConfigure applies qos config to ports.
+// Ports removed from config are reset to default (no classification and strict).
+//
+func (s *Server) QosConfigure(cfg *QosConfig) error {
+	pbmp, err := s.hal.PortBmp()
+	if err != nil {
+		return err
+	}
+
+	entries, err := NewQosPortEntries(cfg, pbmp.PortList())
+	if err != nil {
+		return err
+	}
+
+	for port, e := range entries {
+		if err := s.hal.QosPortMapSet(port, e.Map); err != nil {
+			return fmt.Errorf("QosPortMapSet error. port:%d %s", port, err)
+		}
+
+		if err := s.hal.CosqPortSchedSet(port, e.Sched); err != nil {
+			return fmt.Errorf("CosqPortSchedSet error. port:%d %s", port, err)
+		}
+
+		s.log.Debugf("Qos: port:%d %s %s", port, e.Map, e.Sched)
+	}
+
+	for _, port := range s.qos.Update(entries) {
+		if err := s.hal.QosPortMapSet(port, hal.NewQosMap(hal.QOS_TRUST_NONE)); err != nil {
+			s.log.Warnf("Qos: QosPortMapSet(reset) error. port:%d %s", port, err)
+		}
+
+		if err := s.hal.CosqPortSchedSet(port, newDefaultCosqPortSched()); err != nil {
+			s.log.Warnf("Qos: CosqPortSchedSet(reset) error. port:%d %s", port, err)
+		}
+
+		s.log.Debugf("Qos: port:%d reset.", port)
+	}
+
+	return nil
+}
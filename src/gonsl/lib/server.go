@@ -18,10 +18,9 @@
 package gonslib
 
 import (
-	"bytes"
 	fibcapi "fabricflow/fibc/api"
+	hal "gonsl/hal"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -29,12 +28,21 @@ import (
 // Server is main service of gonsld.
 //
 type Server struct {
+	hal       hal.HAL
 	client    FIBController
 	dpCfg     *DpConfig
 	logCfg    *LogConfig
 	fields    *FieldGroups
 	idmaps    *IDMaps
+	egrRefs   *L3EgressRefTable
+	qos       *QosTable
+	mirrors   *MirrorTable
+	copp      *CoppTable
+	rxQueues  *RxQueueTable
+	lags      *LagTable
+	vxlans    *VxlanTable
 	vlanPorts *VlanPortTable
+	mods      *ModTable
 	l2addrCh  chan []*L2addrmonEntry
 
 	log *log.Entry
@@ -43,22 +51,31 @@ type Server struct {
 //
 // NewServer cerates new instance of Server.
 //
-func NewServer(dpCfg *DpConfig, logCfg *LogConfig) *Server {
+func NewServer(h hal.HAL, dpCfg *DpConfig, logCfg *LogConfig) *Server {
 	return &Server{
-		client:    NewFIBController(dpCfg.GetFIBCType(), dpCfg.GetHost(), dpCfg.DpID),
+		hal:       h,
+		client:    NewFIBController(dpCfg.GetFIBCType(), dpCfg.GetHost(), dpCfg.DpID, dpCfg.GetFIBCAuth()),
 		dpCfg:     dpCfg,
 		logCfg:    logCfg,
-		fields:    NewFieldGroups(dpCfg.Unit),
+		fields:    NewFieldGroups(h),
 		idmaps:    NewIDMaps(),
+		egrRefs:   NewL3EgressRefTable(),
+		qos:       NewQosTable(),
+		mirrors:   NewMirrorTable(),
+		copp:      NewCoppTable(),
+		rxQueues:  NewRxQueueTable(h),
+		lags:      NewLagTable(),
+		vxlans:    NewVxlanTable(),
 		vlanPorts: NewVlanPortTableFromConfig(&dpCfg.BlockBcast),
+		mods:      NewModTable(),
 		l2addrCh:  make(chan []*L2addrmonEntry),
 
 		log: log.WithFields(log.Fields{"module": "server"}),
 	}
 }
 
-func (s *Server) Unit() int {
-	return s.dpCfg.Unit
+func (s *Server) HAL() hal.HAL {
+	return s.hal
 }
 
 func (s *Server) DpID() uint64 {
@@ -98,8 +115,6 @@ FOR_LOOP:
 func (s *Server) sendMessages(done <-chan struct{}) {
 	s.log.Debugf("Sender: started")
 
-	var rxBuf bytes.Buffer
-
 	defer s.client.Stop()
 	rxCh := s.RxStart(done)
 	linkCh := s.LinkmonStart(done)
@@ -124,14 +139,9 @@ FOR_LOOP:
 		case pkt := <-rxCh:
 			s.dumpRxPkt(pkt)
 
-			rxBuf.Reset()
-			if _, err := pkt.WriteTo(&rxBuf); err != nil {
-				s.log.Errorf("Sender: pkt.WriteTo error. %s", err)
-			} else {
-				pktIn := fibcapi.NewFFPacketIn(s.DpID(), uint32(pkt.SrcPort()), rxBuf.Bytes())
-				if err := s.client.PacketIn(pktIn); err != nil {
-					s.log.Errorf("Sender: client.Write error. %s", err)
-				}
+			pktIn := fibcapi.NewFFPacketIn(s.DpID(), uint32(pkt.SrcPort), pkt.Data)
+			if err := s.client.PacketIn(pktIn); err != nil {
+				s.log.Errorf("Sender: client.Write error. %s", err)
 			}
 
 		case linkInfo := <-linkCh:
@@ -147,12 +157,12 @@ FOR_LOOP:
 		case entries := <-s.l2addrCh:
 			s.log.Debugf("Sender: L2AddrEntry %d", len(entries))
 
-			reason := func(oper opennsl.L2CallbackOper) fibcapi.L2Addr_Reason {
+			reason := func(oper hal.L2CallbackOper) fibcapi.L2Addr_Reason {
 				switch oper {
-				case opennsl.L2_CALLBACK_ADD:
+				case hal.L2_CALLBACK_ADD:
 					return fibcapi.L2Addr_ADD
 
-				case opennsl.L2_CALLBACK_DELETE:
+				case hal.L2_CALLBACK_DELETE:
 					return fibcapi.L2Addr_DELETE
 
 				default:
@@ -163,9 +173,9 @@ FOR_LOOP:
 			addrs := make([]*fibcapi.L2Addr, len(entries))
 			for index, entry := range entries {
 				addrs[index] = fibcapi.NewL2AddrDP(
-					entry.L2Addr.MAC(),
-					uint16(entry.L2Addr.VID()),
-					uint32(entry.L2Addr.Port()),
+					entry.L2Addr.MAC,
+					uint16(entry.L2Addr.Vlan),
+					uint32(entry.L2Addr.Port),
 					reason(entry.Oper),
 				)
 			}
@@ -189,7 +199,7 @@ func (s *Server) Start(done <-chan struct{}) error {
 	s.log.Infof("Start:")
 
 	if block := s.dpCfg.BlockBcast.Block(); !block {
-		if err := PortDefaultVlanConfig(s.Unit()); err != nil {
+		if err := PortDefaultVlanConfig(s.hal); err != nil {
 			s.log.Errorf("Start: PortDefaultVlanConfig error. %s", err)
 			return err
 		}
@@ -197,6 +207,31 @@ func (s *Server) Start(done <-chan struct{}) error {
 		s.log.Infof("Start: PortDefaultVlanConfig ok.")
 	}
 
+	if err := s.QosConfigure(&s.dpCfg.Qos); err != nil {
+		s.log.Errorf("Start: QosConfigure error. %s", err)
+		return err
+	}
+
+	if err := s.LagConfigure(&s.dpCfg.Lag); err != nil {
+		s.log.Errorf("Start: LagConfigure error. %s", err)
+		return err
+	}
+
+	if err := s.CoppConfigure(&s.dpCfg.Copp); err != nil {
+		s.log.Errorf("Start: CoppConfigure error. %s", err)
+		return err
+	}
+
+	if err := s.MirrorConfigure(s.dpCfg.Mirrors); err != nil {
+		s.log.Errorf("Start: MirrorConfigure error. %s", err)
+		return err
+	}
+
+	if err := s.SFlowStart(done); err != nil {
+		s.log.Errorf("Start: SFlowStart error. %s", err)
+		return err
+	}
+
 	s.log.Infof("FIBCConroller: %s", s.client)
 
 	go s.client.Start()
@@ -0,0 +1,1596 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"bytes"
+	"encoding/binary"
+	fibcapi "fabricflow/fibc/api"
+	api "gonsl/api"
+	hal "gonsl/hal"
+	halsim "gonsl/hal/sim"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/sys/unix"
+)
+
+func newTestSimServer(t *testing.T) (*Server, *halsim.Sim) {
+	sim := halsim.NewSimWithPortRange(1, 4)
+	if err := sim.Init(); err != nil {
+		t.Fatalf("Sim Init error. %s", err)
+	}
+
+	return NewServer(sim, &DpConfig{DpID: 1}, &LogConfig{}), sim
+}
+
+func addTestNeigh(s *Server, port uint32, neid uint32) {
+	l2iface := &fibcapi.L2InterfaceGroup{
+		PortId: port,
+		HwAddr: "00:11:22:33:44:55",
+	}
+	s.FIBCL2InterfaceGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_ADD}, l2iface)
+
+	l3ucast := &fibcapi.L3UnicastGroup{
+		NeId:      neid,
+		PortId:    port,
+		PhyPortId: port,
+		EthDst:    "66:77:88:99:aa:bb",
+		EthSrc:    "00:11:22:33:44:55",
+	}
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_ADD}, l3ucast)
+}
+
+func TestServerSim_L3Unicast(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+
+	ifaces := sim.L3Ifaces()
+	if len(ifaces) != 1 {
+		t.Fatalf("L3Ifaces unmatch. %v", ifaces)
+	}
+
+	if mac := ifaces[0].MAC.String(); mac != "00:11:22:33:44:55" {
+		t.Errorf("L3Iface mac unmatch. %s", mac)
+	}
+
+	egrID, ok := s.idmaps.L3Egress.Get(10)
+	if !ok {
+		t.Fatalf("L3Egress not registered.")
+	}
+
+	egr, err := sim.L3EgressGet(egrID)
+	if err != nil {
+		t.Fatalf("L3EgressGet error. %s", err)
+	}
+
+	if egr.Port != 1 || egr.IfaceID != ifaces[0].IfaceID {
+		t.Errorf("L3Egress unmatch. %s", egr)
+	}
+
+	flow := &fibcapi.UnicastRoutingFlow{
+		Match: &fibcapi.UnicastRoutingFlow_Match{
+			IpDst:  "10.0.1.0/24",
+			Origin: fibcapi.UnicastRoutingFlow_ROUTE,
+		},
+		GType: fibcapi.GroupMod_L3_UNICAST,
+		GId:   10,
+	}
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_ADD}, flow)
+
+	flow6 := &fibcapi.UnicastRoutingFlow{
+		Match: &fibcapi.UnicastRoutingFlow_Match{
+			IpDst:  "2001:db8::1/128",
+			Origin: fibcapi.UnicastRoutingFlow_NEIGH,
+		},
+		GType: fibcapi.GroupMod_L3_UNICAST,
+		GId:   10,
+	}
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_ADD}, flow6)
+
+	apiServer := NewAPIServer(s)
+
+	routes, err := apiServer.GetL3Routes(context.Background(), &api.GetL3RoutesRequest{})
+	if err != nil {
+		t.Fatalf("GetL3Routes error. %s", err)
+	}
+
+	if n := len(routes.Routes); n != 1 {
+		t.Fatalf("GetL3Routes unmatch. %v", routes.Routes)
+	}
+
+	if r := routes.Routes[0]; r.IpAddr != "10.0.1.0/24" || r.EgressId != uint32(egrID) {
+		t.Errorf("GetL3Routes unmatch. %v", r)
+	}
+
+	hosts, err := apiServer.GetL3Hosts(context.Background(), &api.GetL3HostsRequest{})
+	if err != nil {
+		t.Fatalf("GetL3Hosts error. %s", err)
+	}
+
+	if n := len(hosts.Hosts); n != 1 {
+		t.Fatalf("GetL3Hosts unmatch. %v", hosts.Hosts)
+	}
+
+	if h := hosts.Hosts[0]; h.Ip6Addr != "2001:db8::1" || (h.Flags&uint32(hal.L3_IP6)) == 0 {
+		t.Errorf("GetL3Hosts unmatch. %v", h)
+	}
+
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_DELETE}, flow)
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_DELETE}, flow6)
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+
+	if _, err := sim.L3EgressGet(egrID); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID)
+	}
+}
+
+func TestServerSim_PolicyACL(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	flow := &fibcapi.PolicyACLFlow{
+		Match: &fibcapi.PolicyACLFlow_Match{
+			IpDst:   "10.0.0.1",
+			EthType: unix.ETH_P_IP,
+			InPort:  2,
+		},
+	}
+	s.FIBCPolicyACLFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_ADD}, flow)
+
+	entries, err := s.Fields().DstIPv4.GetEntries()
+	if err != nil {
+		t.Fatalf("GetEntries error. %s", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("GetEntries unmatch. %v", entries)
+	}
+
+	e := FieldEntryDstIP{}
+	if err := s.Fields().DstIPv4.GetEntry(&e, entries[0]); err != nil {
+		t.Fatalf("GetEntry error. %s", err)
+	}
+
+	if e.Dest.String() != "10.0.0.1/32" || e.InPort != 2 {
+		t.Errorf("GetEntry unmatch. %s", &e)
+	}
+
+	entry, _ := sim.FieldEntryGet(entries[0])
+	if _, ok := entry.Action(hal.FieldActionCopyToCpu); !ok {
+		t.Errorf("FieldEntry has no CopyToCpu action. %v", entry.Actions)
+	}
+
+	s.FIBCPolicyACLFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_DELETE}, flow)
+
+	if entries, _ := s.Fields().DstIPv4.GetEntries(); len(entries) != 0 {
+		t.Errorf("FieldEntry not deleted. %v", entries)
+	}
+}
+
+func TestServerSim_PacketOut(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	data := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x08, 0x06}
+	s.FIBCFFPacketOut(nil, fibcapi.NewFFPacketOut(1, 3, data))
+
+	pkts := sim.TxPackets()
+	if len(pkts) != 1 {
+		t.Fatalf("TxPackets unmatch. %v", pkts)
+	}
+
+	if pkts[0].DstPort != 3 || !bytes.Equal(pkts[0].Data, data) {
+		t.Errorf("TxPackets unmatch. %v", pkts[0])
+	}
+}
+
+func getTestSimRouteEgress(t *testing.T, sim *halsim.Sim, dst string) hal.L3EgressID {
+	var egrID hal.L3EgressID
+	found := false
+	sim.L3RouteTraverse(hal.L3_NONE, func(r *hal.L3Route) error {
+		if r.Dst.String() == dst {
+			egrID = r.EgressID
+			found = true
+		}
+		return nil
+	})
+
+	if !found {
+		t.Fatalf("L3Route %s not found.", dst)
+	}
+
+	return egrID
+}
+
+func TestServerSim_L3RouteModify(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	addTestNeigh(s, 2, 11)
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+	egrID11, _ := s.idmaps.L3Egress.Get(11)
+
+	flow := &fibcapi.UnicastRoutingFlow{
+		Match: &fibcapi.UnicastRoutingFlow_Match{
+			IpDst:  "10.0.1.0/24",
+			Origin: fibcapi.UnicastRoutingFlow_ROUTE,
+		},
+		GType: fibcapi.GroupMod_L3_UNICAST,
+		GId:   10,
+	}
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_ADD}, flow)
+
+	if egrID := getTestSimRouteEgress(t, sim, "10.0.1.0/24"); egrID != egrID10 {
+		t.Errorf("L3Route egress unmatch. %d", egrID)
+	}
+
+	// neid 10 is deleted while route refers it.
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+
+	if _, err := sim.L3EgressGet(egrID10); err != nil {
+		t.Errorf("L3Egress deleted while referred. %d", egrID10)
+	}
+
+	flow.GId = 11
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_MODIFY}, flow)
+
+	if egrID := getTestSimRouteEgress(t, sim, "10.0.1.0/24"); egrID != egrID11 {
+		t.Errorf("L3Route egress unmatch. %d", egrID)
+	}
+
+	if _, err := sim.L3EgressGet(egrID10); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID10)
+	}
+
+	if _, ok := s.egrRefs.Get(egrID10); ok {
+		t.Errorf("L3EgressRef not removed. %d", egrID10)
+	}
+
+	if r, ok := s.egrRefs.Get(egrID11); !ok || r.Refs != 1 {
+		t.Errorf("L3EgressRef unmatch. %v %t", r, ok)
+	}
+
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_DELETE}, flow)
+
+	if _, ok := s.egrRefs.Get(egrID11); ok {
+		t.Errorf("L3EgressRef not removed. %d", egrID11)
+	}
+
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 11, PortId: 2})
+
+	if _, err := sim.L3EgressGet(egrID11); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID11)
+	}
+}
+
+func TestServerSim_L3EcmpModify(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	addTestNeigh(s, 2, 11)
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+
+	ecmp := &fibcapi.L3EcmpGroup{EcmpId: 1, NeIds: []uint32{10, 11}}
+	s.FIBCL3EcmpGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_ADD}, ecmp)
+
+	ecmpEgrID, ok := s.idmaps.L3Ecmps.Get(1)
+	if !ok {
+		t.Fatalf("L3Ecmp not registered.")
+	}
+
+	flow := &fibcapi.UnicastRoutingFlow{
+		Match: &fibcapi.UnicastRoutingFlow_Match{
+			IpDst:  "10.0.2.0/24",
+			Origin: fibcapi.UnicastRoutingFlow_ROUTE,
+		},
+		GType: fibcapi.GroupMod_L3_ECMP,
+		GId:   1,
+	}
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_ADD}, flow)
+
+	// neid 10 is removed from ecmp and deleted.
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+
+	if _, err := sim.L3EgressGet(egrID10); err != nil {
+		t.Errorf("L3Egress deleted while referred. %d", egrID10)
+	}
+
+	ecmp.NeIds = []uint32{11}
+	s.FIBCL3EcmpGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_MODIFY}, ecmp)
+
+	if _, err := sim.L3EgressGet(egrID10); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID10)
+	}
+
+	// ecmp is deleted while route refers it.
+	s.FIBCL3EcmpGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, ecmp)
+
+	if _, err := sim.L3EgressEcmpGet(ecmpEgrID); err != nil {
+		t.Errorf("L3EgressEcmp deleted while referred. %d", ecmpEgrID)
+	}
+
+	s.FIBCUnicastRoutingFlowMod(nil, &fibcapi.FlowMod{Cmd: fibcapi.FlowMod_DELETE}, flow)
+
+	if _, err := sim.L3EgressEcmpGet(ecmpEgrID); err == nil {
+		t.Errorf("L3EgressEcmp not deleted. %d", ecmpEgrID)
+	}
+}
+
+func TestServerSim_Qos(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	cfg := &QosConfig{
+		Classes: []*QosClassConfig{
+			{TC: 5, Dscp: []int{46}, Pcp: []int{5}},
+			{TC: 1, Dscp: []int{10, 12}},
+		},
+		Ports: []*QosPortConfig{
+			{
+				Sched: "wdrr",
+				Queues: []*QosQueueConfig{
+					{TC: 5, Weight: 8, MaxKbps: 1000},
+				},
+			},
+			{
+				Ports: []int{4},
+				Trust: "pcp",
+			},
+		},
+	}
+
+	if err := s.QosConfigure(cfg); err != nil {
+		t.Fatalf("QosConfigure error. %s", err)
+	}
+
+	m, err := sim.QosPortMap(1)
+	if err != nil {
+		t.Fatalf("QosPortMap error. %s", err)
+	}
+
+	if m.Trust != hal.QOS_TRUST_DSCP || m.DscpQueue(46) != 5 || m.DscpQueue(12) != 1 || m.DscpQueue(0) != 0 {
+		t.Errorf("QosPortMap unmatch. %s", m)
+	}
+
+	sched, err := sim.CosqPortSched(1)
+	if err != nil {
+		t.Fatalf("CosqPortSched error. %s", err)
+	}
+
+	if q := sched.Queues[5]; sched.Mode != hal.COSQ_SCHED_WDRR || q.Weight != 8 || q.KbitsMax != 1000 {
+		t.Errorf("CosqPortSched unmatch. %s", sched)
+	}
+
+	if m, _ := sim.QosPortMap(4); m.Trust != hal.QOS_TRUST_PCP || m.PcpQueue(5) != 5 {
+		t.Errorf("QosPortMap unmatch. %s", m)
+	}
+
+	// reload with port 1 only.
+	cfg.Ports = []*QosPortConfig{{Ports: []int{1}}}
+	if err := s.QosConfigure(cfg); err != nil {
+		t.Fatalf("QosConfigure error. %s", err)
+	}
+
+	if m, _ := sim.QosPortMap(4); m.Trust != hal.QOS_TRUST_NONE {
+		t.Errorf("QosPortMap not reset. %s", m)
+	}
+
+	if sched, _ := sim.CosqPortSched(1); sched.Mode != hal.COSQ_SCHED_STRICT {
+		t.Errorf("CosqPortSched unmatch. %s", sched)
+	}
+
+	cfg.Classes = []*QosClassConfig{{TC: 8}}
+	if err := s.QosConfigure(cfg); err == nil {
+		t.Errorf("QosConfigure must be error.")
+	}
+}
+
+func TestServerSim_QosStats(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	sim.SetPortStat(1, "ifInOctets", 100)
+	sim.SetCosqStat(1, 5, hal.COSQ_STAT_OUT_PACKETS, 10)
+
+	stats, err := NewPortStats([]string{"ifInOctets", "cosq5OutPkts", "cosq0DropPkts"}).Get(s.hal, 1)
+	if err != nil {
+		t.Fatalf("PortStats.Get error. %s", err)
+	}
+
+	if stats["ifInOctets"] != 100 || stats["cosq5OutPkts"] != 10 || len(stats) != 3 {
+		t.Errorf("PortStats.Get unmatch. %v", stats)
+	}
+}
+
+func TestServerSim_Mirror(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	cfgs := []*MirrorSessionConfig{
+		{Name: "span1", Direction: "ingress", SrcPorts: []int{1, 2}, DstPort: 4},
+	}
+	if err := s.MirrorConfigure(cfgs); err != nil {
+		t.Fatalf("MirrorConfigure error. %s", err)
+	}
+
+	apiServer := NewAPIServer(s)
+
+	erspan := &api.MirrorSession{
+		Name:     "erspan1",
+		Type:     api.MirrorSession_ERSPAN,
+		SrcPorts: []uint32{3},
+		DstPort:  4,
+		SrcIp:    "10.0.0.1",
+		DstIp:    "10.0.1.1",
+		SrcMac:   "00:11:22:33:44:55",
+		DstMac:   "66:77:88:99:aa:bb",
+	}
+	if _, err := apiServer.AddMirrorSession(context.Background(), api.NewAddMirrorSessionRequest(erspan)); err != nil {
+		t.Fatalf("AddMirrorSession error. %s", err)
+	}
+
+	if _, err := apiServer.AddMirrorSession(context.Background(), api.NewAddMirrorSessionRequest(erspan)); err == nil {
+		t.Errorf("AddMirrorSession must be error.")
+	}
+
+	invalid := &api.MirrorSession{Name: "invalid", SrcPorts: []uint32{4}, DstPort: 4}
+	if _, err := apiServer.AddMirrorSession(context.Background(), api.NewAddMirrorSessionRequest(invalid)); err == nil {
+		t.Errorf("AddMirrorSession must be error.")
+	}
+
+	reply, err := apiServer.GetMirrorSessions(context.Background(), api.NewGetMirrorSessionsRequest())
+	if err != nil {
+		t.Fatalf("GetMirrorSessions error. %s", err)
+	}
+
+	if n := len(reply.Sessions); n != 2 {
+		t.Fatalf("GetMirrorSessions unmatch. %v", reply.Sessions)
+	}
+
+	if m := reply.Sessions[0]; m.Name != "erspan1" || m.Type != api.MirrorSession_ERSPAN || m.Ttl != mirrorDefaultTTL {
+		t.Errorf("GetMirrorSessions unmatch. %v", m)
+	}
+
+	if m := reply.Sessions[1]; m.Name != "span1" || m.Direction != api.MirrorSession_INGRESS {
+		t.Errorf("GetMirrorSessions unmatch. %v", m)
+	}
+
+	destID := hal.MirrorDestID(reply.Sessions[0].DestId)
+	dest, err := sim.MirrorDestGet(destID)
+	if err != nil {
+		t.Fatalf("MirrorDestGet error. %s", err)
+	}
+
+	if !dest.IsTunnel() || dest.GreProtocol != hal.MirrorGreProtocolERSPAN || dest.DstIP.String() != "10.0.1.1" {
+		t.Errorf("MirrorDestGet unmatch. %s", dest)
+	}
+
+	if dests := sim.MirrorPortDestGet(3); dests[destID] != hal.MIRROR_PORT_BOTH {
+		t.Errorf("MirrorPortDestGet unmatch. %v", dests)
+	}
+
+	if _, err := apiServer.DelMirrorSession(context.Background(), api.NewDelMirrorSessionRequest("erspan1")); err != nil {
+		t.Fatalf("DelMirrorSession error. %s", err)
+	}
+
+	if dests := sim.MirrorPortDestGet(3); len(dests) != 0 {
+		t.Errorf("MirrorPortDestGet unmatch. %v", dests)
+	}
+
+	if _, err := sim.MirrorDestGet(destID); err == nil {
+		t.Errorf("MirrorDest not deleted. %d", destID)
+	}
+
+	if _, err := apiServer.DelMirrorSession(context.Background(), api.NewDelMirrorSessionRequest("erspan1")); err == nil {
+		t.Errorf("DelMirrorSession must be error.")
+	}
+}
+
+func TestServerSim_SFlow(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	collector, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket error. %s", err)
+	}
+	defer collector.Close()
+
+	s.dpCfg.SFlow = SFlowConfig{
+		Agent:      "10.0.0.1",
+		Collectors: []string{collector.LocalAddr().String()},
+		PollingSec: 1,
+		Ports: []*SFlowPortConfig{
+			{Ports: []int{1, 2}, Ingress: 100, Egress: 200},
+		},
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	if err := s.SFlowStart(done); err != nil {
+		t.Fatalf("SFlowStart error. %s", err)
+	}
+
+	if cos := sim.SampleCos(); cos != sflowCos {
+		t.Errorf("SampleCos unmatch. %d", cos)
+	}
+
+	if rate, _ := sim.PortSampleRateGet(1); rate.Ingress != 100 || rate.Egress != 200 {
+		t.Errorf("PortSampleRateGet unmatch. %s", rate)
+	}
+
+	if rate, _ := sim.PortSampleRateGet(3); rate.Enabled() {
+		t.Errorf("PortSampleRateGet unmatch. %s", rate)
+	}
+
+	sim.SetPortStat(1, "ifHCInOctets", 12345)
+
+	recv := func(format uint32) []byte {
+		buf := make([]byte, 1500)
+		collector.SetReadDeadline(time.Now().Add(3 * time.Second))
+		for {
+			n, _, err := collector.ReadFrom(buf)
+			if err != nil {
+				t.Fatalf("ReadFrom error. %s", err)
+			}
+			// first sample format is after header(28).
+			if n > 32 && binary.BigEndian.Uint32(buf[28:]) == format {
+				return buf[:n]
+			}
+		}
+	}
+
+	pkt := &hal.Packet{SrcPort: 1, DstPort: 2, Data: make([]byte, 200)}
+	if _, err := sim.InjectSample(pkt, hal.PACKET_REASON_SAMPLE_EGRESS); err != nil {
+		t.Fatalf("InjectSample error. %s", err)
+	}
+
+	data := recv(SFlowFormatFlowSample)
+	u32 := func(offset int) uint32 { return binary.BigEndian.Uint32(data[offset:]) }
+
+	// source id, rate, pool, drops, input, output
+	if src, rate, input, output := u32(40), u32(44), u32(56), u32(60); src != 2 || rate != 200 || input != 0 || output != 2 {
+		t.Errorf("Flow sample unmatch. src:%d rate:%d in:%d out:%d", src, rate, input, output)
+	}
+
+	// frame length, header length
+	if l, h := u32(80), u32(88); l != 204 || h != configDefaultSFlowHeaderSize {
+		t.Errorf("Flow sample unmatch. len:%d header:%d", l, h)
+	}
+
+	if _, err := sim.InjectSample(&hal.Packet{SrcPort: 3, Data: []byte{}}, hal.PACKET_REASON_SAMPLE_INGRESS); err == nil {
+		t.Errorf("InjectSample must be error.")
+	}
+
+	data = recv(SFlowFormatCounterSample)
+	u32 = func(offset int) uint32 { return binary.BigEndian.Uint32(data[offset:]) }
+
+	if n := u32(24); n != 2 {
+		t.Errorf("Counter samples unmatch. %d", n)
+	}
+
+	if src, index := u32(40), u32(56); src != 1 || index != 1 {
+		t.Errorf("Counter sample unmatch. src:%d index:%d", src, index)
+	}
+
+	if octets := binary.BigEndian.Uint64(data[80:]); octets != 12345 {
+		t.Errorf("Counter sample unmatch. in octets:%d", octets)
+	}
+}
+
+func TestServerSim_Copp(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	cfg := &CoppConfig{
+		Classes: []*CoppClassConfig{
+			{Name: "arp", Queue: 3, Pps: 100},
+			{Name: "bgp", Queue: 6, Pps: 500, Burst: 50},
+			{Name: "snmp", Pps: 10, Matches: []*CoppMatchConfig{{EthType: 0x0800, IPProto: 17, L4DstPort: 161}}},
+		},
+	}
+
+	if err := s.CoppConfigure(cfg); err != nil {
+		t.Fatalf("CoppConfigure error. %s", err)
+	}
+
+	entries, _ := s.Fields().Copp.GetEntries()
+	if n := len(entries); n != 6 {
+		t.Errorf("Copp entries unmatch. %d", n)
+	}
+
+	arp := &FieldEntryCopp{Class: "arp", Index: 0}
+	arpID, ok := s.Fields().Copp.EntryID(arp)
+	if !ok {
+		t.Fatalf("Copp entry not found. %s", arp)
+	}
+
+	e, err := sim.FieldEntryGet(arpID)
+	if err != nil {
+		t.Fatalf("FieldEntryGet error. %s", err)
+	}
+
+	if a, ok := e.Action(hal.FieldActionCosQCpuNew); !ok || a.Param0 != 3 {
+		t.Errorf("Copp entry unmatch. %s", e)
+	}
+
+	if _, ok := e.Action(hal.FieldActionRpCopyToCpuCancel); !ok || e.EthType != unix.ETH_P_ARP {
+		t.Errorf("Copp entry unmatch. %s", e)
+	}
+
+	if p, err := sim.PolicerGet(e.Policer); err != nil || !p.Packets || p.Rate != 100 || p.Burst != 100 {
+		t.Errorf("Copp policer unmatch. %s %v", p, err)
+	}
+
+	if e.DstIPLocal {
+		t.Errorf("Copp entry unmatch. %s", e)
+	}
+
+	// ip classes meter packets to local address only (not transit).
+	for _, entry := range []*FieldEntryCopp{{Class: "bgp", Index: 0}, {Class: "snmp", Index: 0}} {
+		entryID, _ := s.Fields().Copp.EntryID(entry)
+		if e, err := sim.FieldEntryGet(entryID); err != nil || !e.DstIPLocal {
+			t.Errorf("Copp entry unmatch. %s %v", e, err)
+		}
+	}
+
+	if queues := s.copp.Queues(); len(queues) != 3 || queues[0] != 1 || queues[1] != 3 || queues[2] != 6 {
+		t.Errorf("Copp queues unmatch. %v", queues)
+	}
+
+	sim.SetFieldEntryStat(arpID, &hal.FieldEntryStat{GreenPackets: 10, RedPackets: 2})
+
+	reply, err := NewAPIServer(s).GetCoppStats(context.Background(), api.NewGetCoppStatsRequest())
+	if err != nil {
+		t.Fatalf("GetCoppStats error. %s", err)
+	}
+
+	if n := len(reply.Stats); n != 3 {
+		t.Fatalf("GetCoppStats unmatch. %v", reply.Stats)
+	}
+
+	if st := reply.Stats[0]; st.Name != "arp" || st.Queue != 3 || st.AcceptPackets != 10 || st.DropPackets != 2 {
+		t.Errorf("GetCoppStats unmatch. %v", st)
+	}
+
+	if st := reply.Stats[1]; st.Name != "bgp" || st.Pps != 500 || st.Burst != 50 || st.AcceptPackets != 0 {
+		t.Errorf("GetCoppStats unmatch. %v", st)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	rxCh := s.RxStart(done)
+	time.Sleep(100 * time.Millisecond)
+
+	go sim.InjectRx(&hal.Packet{Cos: 3, SrcPort: 1, Data: []byte{1, 2, 3}})
+
+	select {
+	case pkt := <-rxCh:
+		if pkt.Cos != 3 || pkt.SrcPort != 1 {
+			t.Errorf("RxServe unmatch. %s", pkt)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("RxServe timeout.")
+	}
+
+	bgp := &FieldEntryCopp{Class: "bgp", Index: 0}
+	bgpID, _ := s.Fields().Copp.EntryID(bgp)
+	bgpEntry, _ := sim.FieldEntryGet(bgpID)
+
+	cfg.Classes = cfg.Classes[:1]
+	if err := s.CoppConfigure(cfg); err != nil {
+		t.Fatalf("CoppConfigure error. %s", err)
+	}
+
+	if entries, _ := s.Fields().Copp.GetEntries(); len(entries) != 1 {
+		t.Errorf("Copp entries unmatch. %v", entries)
+	}
+
+	if _, err := sim.PolicerGet(bgpEntry.Policer); err == nil {
+		t.Errorf("Copp policer not destroyed. %d", bgpEntry.Policer)
+	}
+
+	// queue of bgp class is unregistered, and queue of arp keeps receiving.
+	if queues := s.rxQueues.Queues(); len(queues) != 2 || queues[0] != fieldCosDefault || queues[1] != 3 {
+		t.Errorf("RxQueues unmatch. %v", queues)
+	}
+
+	for retry := 0; sim.InjectRx(&hal.Packet{Cos: 6}) != 0; retry++ {
+		if retry > 10 {
+			t.Fatalf("RxUnregister timeout.")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	go sim.InjectRx(&hal.Packet{Cos: 3, SrcPort: 2, Data: []byte{1, 2, 3}})
+
+	select {
+	case pkt := <-rxCh:
+		if pkt.Cos != 3 || pkt.SrcPort != 2 {
+			t.Errorf("RxServe unmatch. %s", pkt)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("RxServe timeout.")
+	}
+}
+
+func TestServerSim_CoppBuiltin(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	cfg := &CoppConfig{Classes: []*CoppClassConfig{}}
+	for _, name := range CoppBuiltinClasses() {
+		cfg.Classes = append(cfg.Classes, &CoppClassConfig{Name: name, Pps: 100})
+	}
+
+	if err := s.CoppConfigure(cfg); err != nil {
+		t.Fatalf("CoppConfigure error. %s", err)
+	}
+
+	entries, _ := s.Fields().Copp.GetEntries()
+	for _, entryID := range entries {
+		e, err := sim.FieldEntryGet(entryID)
+		if err != nil {
+			t.Fatalf("FieldEntryGet error. %s", err)
+		}
+
+		// ip entries except ttl exceeded must be limited to packets to cpu.
+		isIP := e.EthType == unix.ETH_P_IP || e.EthType == unix.ETH_P_IPV6
+		if isIP && e.TtlMask == 0 && !e.DstIPLocal && e.DstIP == nil {
+			t.Errorf("Copp entry matches transit packets. %s", e)
+		}
+
+		if e.DstIP != nil && !e.DstIP.IP.IsLinkLocalMulticast() {
+			t.Errorf("Copp entry unmatch. %s", e)
+		}
+	}
+}
+
+func TestServerSim_CoppInvalid(t *testing.T) {
+	s, _ := newTestSimServer(t)
+
+	cfgs := []*CoppConfig{
+		{Classes: []*CoppClassConfig{{Name: "arp", Queue: 8, Pps: 100}}},
+		{Classes: []*CoppClassConfig{{Name: "arp"}}},
+		{Classes: []*CoppClassConfig{{Name: "unknown", Pps: 100}}},
+		{Classes: []*CoppClassConfig{{Name: "arp", Pps: 100}, {Name: "arp", Pps: 200}}},
+	}
+
+	for _, cfg := range cfgs {
+		if err := s.CoppConfigure(cfg); err == nil {
+			t.Errorf("CoppConfigure must be error. %s", cfg)
+		}
+	}
+
+	if entries, _ := s.Fields().Copp.GetEntries(); len(entries) != 0 {
+		t.Errorf("Copp entries unmatch. %v", entries)
+	}
+}
+
+func modTestBond(s *Server, cmd fibcapi.GroupMod_Cmd, bond uint32, attrs *fibcapi.BondAttrs) {
+	l2iface := &fibcapi.L2InterfaceGroup{
+		PortId: bond,
+		HwAddr: "00:11:22:33:44:55",
+		Bond:   attrs,
+	}
+	s.FIBCL2InterfaceGroupMod(nil, &fibcapi.GroupMod{Cmd: cmd}, l2iface)
+}
+
+func modTestBondSlave(s *Server, cmd fibcapi.GroupMod_Cmd, bond, port uint32, attrs *fibcapi.BondSlaveAttrs) {
+	l2iface := &fibcapi.L2InterfaceGroup{
+		PortId:    port,
+		HwAddr:    "00:11:22:33:44:55",
+		Master:    bond,
+		BondSlave: attrs,
+	}
+	s.FIBCL2InterfaceGroupMod(nil, &fibcapi.GroupMod{Cmd: cmd}, l2iface)
+}
+
+func TestServerSim_Lag(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	bond := fibcapi.NewDPPortId(10, fibcapi.LinkType_BOND)
+	bondAttrs := &fibcapi.BondAttrs{
+		Mode:         fibcapi.BondAttrs_IEEE_802_3AD,
+		HashPolicy:   fibcapi.BondAttrs_LAYER3_4,
+		AggregatorId: 1,
+	}
+
+	modTestBond(s, fibcapi.GroupMod_ADD, bond, bondAttrs)
+	modTestBondSlave(s, fibcapi.GroupMod_ADD, bond, 1, &fibcapi.BondSlaveAttrs{
+		LinkUp: true, AggregatorId: 1, Collecting: true, Distributing: true,
+	})
+	modTestBondSlave(s, fibcapi.GroupMod_ADD, bond, 2, &fibcapi.BondSlaveAttrs{
+		LinkUp: true, AggregatorId: 1, Collecting: true,
+	})
+	modTestBondSlave(s, fibcapi.GroupMod_ADD, bond, 3, &fibcapi.BondSlaveAttrs{
+		LinkUp: true, AggregatorId: 2, Collecting: true, Distributing: true,
+	})
+
+	trunk, ok := s.idmaps.Trunks.Get(bond, fibcapi.OFPVID_UNTAGGED)
+	if !ok {
+		t.Fatalf("Trunk not registered.")
+	}
+
+	info, err := sim.TrunkGet(trunk)
+	if err != nil {
+		t.Fatalf("TrunkGet error. %s", err)
+	}
+
+	if info.Psc != hal.TRUNK_PSC_PORTFLOW {
+		t.Errorf("Trunk psc unmatch. %s", info)
+	}
+
+	if ports := info.EnabledPorts(); len(ports) != 1 || ports[0] != 1 || len(info.Members) != 3 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	// port2 starts distributing.
+	modTestBondSlave(s, fibcapi.GroupMod_MODIFY, bond, 2, &fibcapi.BondSlaveAttrs{
+		LinkUp: true, AggregatorId: 1, Collecting: true, Distributing: true,
+	})
+
+	if info, _ = sim.TrunkGet(trunk); len(info.EnabledPorts()) != 2 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	// min_links is not satisfied.
+	bondAttrs.MinLinks = 3
+	modTestBond(s, fibcapi.GroupMod_MODIFY, bond, bondAttrs)
+
+	if info, _ = sim.TrunkGet(trunk); len(info.EnabledPorts()) != 0 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	bondAttrs.MinLinks = 1
+	modTestBond(s, fibcapi.GroupMod_MODIFY, bond, bondAttrs)
+
+	sim.SetPortStat(1, "ifHCOutOctets", 1000)
+	sim.SetPortStat(1, "ifHCOutUcastPkts", 10)
+
+	reply, err := NewAPIServer(s).GetTrunks(context.Background(), api.NewGetTrunksRequest())
+	if err != nil {
+		t.Fatalf("GetTrunks error. %s", err)
+	}
+
+	if n := len(reply.Trunks); n != 1 {
+		t.Fatalf("GetTrunks unmatch. %v", reply.Trunks)
+	}
+
+	tr := reply.Trunks[0]
+	if tr.TrunkId != uint32(trunk) || tr.PortId != bond || tr.Mode != "IEEE_802_3AD" || tr.Psc != "portflow" || len(tr.Members) != 3 {
+		t.Errorf("GetTrunks unmatch. %v", tr)
+	}
+
+	if m := tr.Members[0]; m.Port != 1 || !m.Enabled || m.OutOctets != 1000 || m.OutUcastPackets != 10 {
+		t.Errorf("GetTrunks member unmatch. %v", m)
+	}
+
+	if m := tr.Members[2]; m.Port != 3 || m.Enabled || m.AggregatorId != 2 {
+		t.Errorf("GetTrunks member unmatch. %v", m)
+	}
+
+	modTestBondSlave(s, fibcapi.GroupMod_DELETE, bond, 2, nil)
+
+	if info, _ = sim.TrunkGet(trunk); len(info.Members) != 2 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	modTestBondSlave(s, fibcapi.GroupMod_DELETE, bond, 1, nil)
+	modTestBondSlave(s, fibcapi.GroupMod_DELETE, bond, 3, nil)
+	modTestBond(s, fibcapi.GroupMod_DELETE, bond, nil)
+
+	if _, err := sim.TrunkGet(trunk); err == nil {
+		t.Errorf("Trunk not destroyed. %d", trunk)
+	}
+
+	if lags := s.lags.List(); len(lags) != 0 {
+		t.Errorf("Lags unmatch. %v", lags)
+	}
+}
+
+func TestServerSim_LagActiveBackup(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	if err := s.LagConfigure(&LagConfig{HashFields: []string{"l3", "l4"}}); err != nil {
+		t.Fatalf("LagConfigure error. %s", err)
+	}
+
+	if fields := sim.TrunkHashFields(); fields != hal.TRUNK_HASH_L3|hal.TRUNK_HASH_L4 {
+		t.Errorf("TrunkHashFields unmatch. %s", fields)
+	}
+
+	bond := fibcapi.NewDPPortId(10, fibcapi.LinkType_BOND)
+	modTestBond(s, fibcapi.GroupMod_ADD, bond, &fibcapi.BondAttrs{
+		Mode:       fibcapi.BondAttrs_ACTIVE_BACKUP,
+		HashPolicy: fibcapi.BondAttrs_LAYER2,
+	})
+	modTestBondSlave(s, fibcapi.GroupMod_ADD, bond, 1, &fibcapi.BondSlaveAttrs{LinkUp: true, Active: true})
+	modTestBondSlave(s, fibcapi.GroupMod_ADD, bond, 2, &fibcapi.BondSlaveAttrs{LinkUp: true})
+
+	trunk, _ := s.idmaps.Trunks.Get(bond, fibcapi.OFPVID_UNTAGGED)
+	info, err := sim.TrunkGet(trunk)
+	if err != nil {
+		t.Fatalf("TrunkGet error. %s", err)
+	}
+
+	// hash fields in config override xmit_hash_policy.
+	if info.Psc != hal.TRUNK_PSC_PORTFLOW {
+		t.Errorf("Trunk psc unmatch. %s", info)
+	}
+
+	if ports := info.EnabledPorts(); len(ports) != 1 || ports[0] != 1 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	// failover to port2.
+	modTestBondSlave(s, fibcapi.GroupMod_MODIFY, bond, 1, &fibcapi.BondSlaveAttrs{})
+	modTestBondSlave(s, fibcapi.GroupMod_MODIFY, bond, 2, &fibcapi.BondSlaveAttrs{LinkUp: true, Active: true})
+
+	if info, _ = sim.TrunkGet(trunk); len(info.EnabledPorts()) != 1 || info.EnabledPorts()[0] != 2 {
+		t.Errorf("Trunk members unmatch. %s", info)
+	}
+
+	if err := s.LagConfigure(&LagConfig{HashFields: []string{"tcp_flags"}}); err == nil {
+		t.Errorf("LagConfigure must be error.")
+	}
+}
+
+func modTestVxlanTunnel(s *Server, cmd fibcapi.GroupMod_Cmd, tunPortID uint32, remote string, neid uint32) {
+	tun := fibcapi.NewVxlanTunnel(tunPortID, 100, 10, net.ParseIP("10.0.0.1"), net.ParseIP(remote), 0, neid, 1)
+	s.FIBCVxlanTunnelMod(nil, &fibcapi.GroupMod{Cmd: cmd}, tun)
+}
+
+func modTestVxlanBridging(s *Server, cmd fibcapi.FlowMod_Cmd, mac string, tunPortID uint32) {
+	flow := &fibcapi.BridgingFlow{
+		Match:  &fibcapi.BridgingFlow_Match{EthDst: mac, TunnelId: 100},
+		Action: &fibcapi.BridgingFlow_Action{Name: fibcapi.BridgingFlow_Action_OUTPUT, Value: fibcapi.NewVxlanTunnelDPPort(tunPortID)},
+	}
+	s.FIBCBridgingFlowMod(nil, &fibcapi.FlowMod{Cmd: cmd}, flow)
+}
+
+func TestServerSim_Vxlan(t *testing.T) {
+	s, sim := newTestSimServer(t)
+	sim.VlanCreate(10)
+
+	addTestNeigh(s, 1, 10)
+	addTestNeigh(s, 2, 11)
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+
+	// l2 address before tunnel is deferred.
+	modTestVxlanBridging(s, fibcapi.FlowMod_ADD, "00:00:00:00:00:01", 1)
+
+	modTestVxlanTunnel(s, fibcapi.GroupMod_ADD, 1, "10.0.0.2", 10)
+	modTestVxlanTunnel(s, fibcapi.GroupMod_ADD, 2, "10.0.0.3", 11)
+
+	vpn, ok := s.vxlans.Vpn(100)
+	if !ok || vpn.Refs != 2 {
+		t.Fatalf("VxlanVpn unmatch. %v %t", vpn, ok)
+	}
+
+	tun1, _ := s.vxlans.Tunnel(1)
+	mac1, _ := net.ParseMAC("00:00:00:00:00:01")
+	if l2addr, err := sim.VxlanL2AddrGet(vpn.VpnID, mac1); err != nil || l2addr.PortID != tun1.PortID {
+		t.Errorf("VxlanL2Addr unmatch. %v %v", l2addr, err)
+	}
+
+	group := fibcapi.NewL2OverlayGroup(100, 0, 10, []uint32{1, 2})
+	s.FIBCL2OverlayGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_ADD}, group)
+
+	if flood, _ := sim.VxlanFloodGet(vpn.VpnID); len(flood) != 2 {
+		t.Errorf("VxlanFlood unmatch. %v", flood)
+	}
+
+	// underlay neigh is deleted while tunnel refers it.
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+
+	if _, err := sim.L3EgressGet(egrID10); err != nil {
+		t.Errorf("L3Egress deleted while referred. %d", egrID10)
+	}
+
+	// tunnel is moved to other underlay neigh.
+	modTestVxlanTunnel(s, fibcapi.GroupMod_MODIFY, 1, "10.0.0.2", 11)
+
+	if _, err := sim.L3EgressGet(egrID10); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID10)
+	}
+
+	tun1, _ = s.vxlans.Tunnel(1)
+	if l2addr, err := sim.VxlanL2AddrGet(vpn.VpnID, mac1); err != nil || l2addr.PortID != tun1.PortID {
+		t.Errorf("VxlanL2Addr not restored. %v %v", l2addr, err)
+	}
+
+	if flood, _ := sim.VxlanFloodGet(vpn.VpnID); len(flood) != 2 {
+		t.Errorf("VxlanFlood not restored. %v", flood)
+	}
+
+	modTestVxlanBridging(s, fibcapi.FlowMod_DELETE, "00:00:00:00:00:01", 1)
+
+	if _, err := sim.VxlanL2AddrGet(vpn.VpnID, mac1); err == nil {
+		t.Errorf("VxlanL2Addr not deleted.")
+	}
+
+	s.FIBCL2OverlayGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, group)
+	modTestVxlanTunnel(s, fibcapi.GroupMod_DELETE, 1, "10.0.0.2", 11)
+	modTestVxlanTunnel(s, fibcapi.GroupMod_DELETE, 2, "10.0.0.3", 11)
+
+	if _, ok := s.vxlans.Vpn(100); ok {
+		t.Errorf("VxlanVpn not removed.")
+	}
+
+	if _, err := sim.VxlanVpnGet(vpn.VpnID); err == nil {
+		t.Errorf("VxlanVpn not destroyed. %d", vpn.VpnID)
+	}
+}
+
+func modTestSRv6LocalSid(s *Server, cmd fibcapi.FlowMod_Cmd, sid string, name fibcapi.SRv6LocalSidFlow_Action_Name, neid uint32) {
+	_, ipnet, _ := net.ParseCIDR(sid)
+	match := fibcapi.NewSRv6LocalSidMatch(ipnet, 0)
+	action := fibcapi.NewSRv6LocalSidAction(name, 0)
+	gtype := fibcapi.GroupMod_UNSPEC
+	if neid != 0 {
+		gtype = fibcapi.GroupMod_L3_UNICAST
+	}
+	flow := fibcapi.NewSRv6LocalSidFlow(match, action, gtype, neid)
+	s.FIBCSRv6LocalSidFlowMod(nil, flow.ToMod(cmd, ""), flow)
+}
+
+func TestServerSim_SRv6(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+
+	segs := []net.IP{net.ParseIP("fc00:2::1"), net.ParseIP("fc00:1::1")}
+	group := fibcapi.NewSRv6EncapGroup(1, fibcapi.SRv6EncapGroup_ENCAP, segs, 10)
+	s.FIBCSRv6EncapGroupMod(nil, group.ToMod(fibcapi.GroupMod_ADD, ""), group)
+
+	encapID, ok := s.idmaps.SRv6Encaps.Get(fibcapi.NewSRv6EncapGroupID(1))
+	if !ok {
+		t.Fatalf("SRv6Encap not registered.")
+	}
+
+	if encap, err := sim.SRv6EncapGet(encapID); err != nil || encap.Egress != egrID10 || len(encap.Segments) != 2 || encap.Mode != hal.SRV6_ENCAP_ENCAP {
+		t.Errorf("SRv6Encap unmatch. %v %v", encap, err)
+	}
+
+	_, dst, _ := net.ParseCIDR("10.1.0.0/24")
+	route := fibcapi.NewUnicastRoutingFlow(fibcapi.NewUnicastRoutingMatchRoute(dst, 0), nil, fibcapi.GroupMod_SRV6_ENCAP, 1)
+	s.FIBCUnicastRoutingFlowMod(nil, route.ToMod(fibcapi.FlowMod_ADD, ""), route)
+
+	if egrID := getTestSimRouteEgress(t, sim, "10.1.0.0/24"); egrID != encapID {
+		t.Errorf("L3Route egress unmatch. %d", egrID)
+	}
+
+	// encap referred by route is not deleted.
+	s.FIBCSRv6EncapGroupMod(nil, group.ToMod(fibcapi.GroupMod_DELETE, ""), group)
+	if _, err := sim.SRv6EncapGet(encapID); err != nil {
+		t.Errorf("SRv6Encap deleted while referred. %s", err)
+	}
+
+	// underlay neigh is deleted while encap refers it.
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+	if _, err := sim.L3EgressGet(egrID10); err != nil {
+		t.Errorf("L3Egress deleted while referred. %d", egrID10)
+	}
+
+	s.FIBCUnicastRoutingFlowMod(nil, route.ToMod(fibcapi.FlowMod_DELETE, ""), route)
+	s.FIBCSRv6EncapGroupMod(nil, group.ToMod(fibcapi.GroupMod_DELETE, ""), group)
+
+	if _, err := sim.SRv6EncapGet(encapID); err == nil {
+		t.Errorf("SRv6Encap not deleted. %d", encapID)
+	}
+	if _, err := sim.L3EgressGet(egrID10); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID10)
+	}
+}
+
+func TestServerSim_SRv6LocalSid(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+
+	_, sid1, _ := net.ParseCIDR("fc00:1::100/128")
+	_, sid2, _ := net.ParseCIDR("fc00:1::200/128")
+
+	modTestSRv6LocalSid(s, fibcapi.FlowMod_ADD, "fc00:1::100/128", fibcapi.SRv6LocalSidFlow_Action_END_DT4, 0)
+	modTestSRv6LocalSid(s, fibcapi.FlowMod_ADD, "fc00:1::200/128", fibcapi.SRv6LocalSidFlow_Action_END_DX4, 10)
+
+	if e, err := sim.SRv6LocalSidGet(0, sid1); err != nil || e.Action != hal.SRV6_LOCAL_SID_END_DT4 {
+		t.Errorf("SRv6LocalSid unmatch. %v %v", e, err)
+	}
+
+	if e, err := sim.SRv6LocalSidGet(0, sid2); err != nil || e.Action != hal.SRV6_LOCAL_SID_END_DX4 || e.Egress != egrID10 {
+		t.Errorf("SRv6LocalSid unmatch. %v %v", e, err)
+	}
+
+	// nexthop not found.
+	modTestSRv6LocalSid(s, fibcapi.FlowMod_ADD, "fc00:1::300/128", fibcapi.SRv6LocalSidFlow_Action_END_X, 99)
+	_, sid3, _ := net.ParseCIDR("fc00:1::300/128")
+	if e, err := sim.SRv6LocalSidGet(0, sid3); err == nil {
+		t.Errorf("SRv6LocalSid must not be added. %v", e)
+	}
+
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_DELETE}, &fibcapi.L3UnicastGroup{NeId: 10, PortId: 1})
+	if _, err := sim.L3EgressGet(egrID10); err != nil {
+		t.Errorf("L3Egress deleted while referred. %d", egrID10)
+	}
+
+	modTestSRv6LocalSid(s, fibcapi.FlowMod_DELETE, "fc00:1::100/128", fibcapi.SRv6LocalSidFlow_Action_END_DT4, 0)
+	modTestSRv6LocalSid(s, fibcapi.FlowMod_DELETE, "fc00:1::200/128", fibcapi.SRv6LocalSidFlow_Action_END_DX4, 10)
+
+	if _, err := sim.SRv6LocalSidGet(0, sid1); err == nil {
+		t.Errorf("SRv6LocalSid not deleted.")
+	}
+	if _, err := sim.L3EgressGet(egrID10); err == nil {
+		t.Errorf("L3Egress not deleted. %d", egrID10)
+	}
+}
+
+func modTestRoute(s *Server, cmd fibcapi.FlowMod_Cmd, dst string, neid uint32) {
+	_, ipnet, _ := net.ParseCIDR(dst)
+	match := fibcapi.NewUnicastRoutingMatchRoute(ipnet, 0)
+	flow := fibcapi.NewUnicastRoutingFlow(match, nil, fibcapi.GroupMod_L3_UNICAST, neid)
+	s.FIBCFlowMod(nil, flow.ToMod(cmd, ""))
+}
+
+func TestServerSim_InstalledMods(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	addTestNeigh(s, 2, 11)
+
+	l3ucast := &fibcapi.L3UnicastGroup{
+		NeId:      10,
+		PortId:    1,
+		PhyPortId: 1,
+		EthDst:    "66:77:88:99:aa:bb",
+		EthSrc:    "00:11:22:33:44:55",
+	}
+	s.mods.UpdateGroupMod(l3ucast.ToMod(fibcapi.GroupMod_ADD, ""))
+
+	modTestRoute(s, fibcapi.FlowMod_ADD, "10.0.1.0/24", 10)
+	modTestRoute(s, fibcapi.FlowMod_ADD, "10.0.2.0/24", 10)
+	modTestRoute(s, fibcapi.FlowMod_ADD, "10.0.3.0/24", 10)
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+	egrID11, _ := s.idmaps.L3Egress.Get(11)
+
+	// HAL diverges from flow/group mods processed.
+	_, dst1, _ := net.ParseCIDR("10.0.1.0/24")
+	_, dst2, _ := net.ParseCIDR("10.0.2.0/24")
+	_, dst4, _ := net.ParseCIDR("10.0.4.0/24")
+	sim.L3RouteDelete(&hal.L3Route{Dst: dst1})
+	sim.L3RouteAdd(&hal.L3Route{Flags: hal.L3_REPLACE, Dst: dst2, EgressID: egrID11})
+	sim.L3RouteAdd(&hal.L3Route{Dst: dst4, EgressID: egrID10})
+
+	egr, _ := sim.L3EgressGet(egrID10)
+	egr.MAC, _ = net.ParseMAC("66:77:88:99:aa:cc")
+	if _, err := sim.L3EgressCreate(hal.L3_REPLACE|hal.L3_WITH_ID, egr, egrID10); err != nil {
+		t.Fatalf("L3EgressCreate error. %s", err)
+	}
+
+	flows, err := s.installedFlowMods()
+	if err != nil {
+		t.Fatalf("installedFlowMods error. %s", err)
+	}
+
+	if len(flows) != 3 {
+		t.Fatalf("installedFlowMods unmatch. %v", flows)
+	}
+
+	expected := []struct {
+		dst  string
+		neid uint32
+	}{
+		{dst: "10.0.2.0/24", neid: 11},
+		{dst: "10.0.3.0/24", neid: 10},
+		{dst: "10.0.4.0/24", neid: 10},
+	}
+
+	for index, e := range expected {
+		flow := flows[index].GetUnicast()
+		if flow.Match.IpDst != e.dst || flow.GType != fibcapi.GroupMod_L3_UNICAST || flow.GId != e.neid {
+			t.Errorf("installedFlowMods[%d] unmatch. %v", index, flow)
+		}
+	}
+
+	groups := s.installedGroupMods()
+	if len(groups) != 1 {
+		t.Fatalf("installedGroupMods unmatch. %v", groups)
+	}
+
+	if group := groups[0].GetL3Unicast(); group.NeId != 10 || group.EthDst != "66:77:88:99:aa:cc" {
+		t.Errorf("installedGroupMods unmatch. %v", group)
+	}
+
+	// group deleted from HAL is not returned.
+	modTestRoute(s, fibcapi.FlowMod_DELETE, "10.0.2.0/24", 10)
+	modTestRoute(s, fibcapi.FlowMod_DELETE, "10.0.3.0/24", 10)
+	sim.L3RouteDelete(&hal.L3Route{Dst: dst2})
+	sim.L3RouteDelete(&hal.L3Route{Dst: dst4})
+	if err := sim.L3EgressDestroy(egrID10); err != nil {
+		t.Fatalf("L3EgressDestroy error. %s", err)
+	}
+
+	if groups := s.installedGroupMods(); len(groups) != 0 {
+		t.Errorf("installedGroupMods unmatch. %v", groups)
+	}
+}
+
+func TestServerSim_InstalledModsReadBack(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	ethSrc, _ := net.ParseMAC("00:11:22:33:44:55")
+	ethDst, _ := net.ParseMAC("66:77:88:99:aa:bb")
+
+	groups := []*fibcapi.GroupMod{
+		fibcapi.NewL2InterfaceGroup(1, 0, false, ethSrc, 1500, 0, 0).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewL2InterfaceGroup(2, 0, false, ethSrc, 1500, 0, 0).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewL3UnicastGroup(10, 1, 1, 0, ethDst, ethSrc).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewL3UnicastGroup(11, 2, 2, 0, ethDst, ethSrc).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewL3EcmpGroup(1, []uint32{10, 11}).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewMPLSInterfaceGroup(10, 1, 0, ethDst, ethSrc).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL1, 100, 10100, 10, 0).ToMod(fibcapi.GroupMod_ADD, ""),
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_L3_VPN, 200, 10200, 10, 0).ToMod(fibcapi.GroupMod_ADD, ""),
+	}
+	for _, mod := range groups {
+		s.FIBCGroupMod(nil, mod)
+	}
+
+	flows := []*fibcapi.FlowMod{
+		fibcapi.NewVLANFlow(fibcapi.NewVLANFlowMatch(1, 0, 0), nil, 0).ToMod(fibcapi.FlowMod_ADD, ""),
+		fibcapi.NewTermMACFlow(fibcapi.NewTermMACMatch(1, fibcapi.ETHTYPE_IPV4, "00:11:22:33:44:55", 0), nil, 0).ToMod(fibcapi.FlowMod_ADD, ""),
+		fibcapi.NewMPLSFlow(fibcapi.NewMPLSMatch(300, true), []*fibcapi.MPLSFlow_Action{
+			{Name: fibcapi.MPLSFlow_Action_SET_VRF, Value: 1},
+		}, 0, fibcapi.GroupMod_UNSPEC, 0).ToMod(fibcapi.FlowMod_ADD, ""),
+		fibcapi.NewPolicyACLFlow(&fibcapi.PolicyACLFlow_Match{EthType: unix.ETH_P_ARP, InPort: 1}, 0).ToMod(fibcapi.FlowMod_ADD, ""),
+		fibcapi.NewBridgingFlow(fibcapi.NewBridgingFlowMatch("00:11:22:33:44:66", 10, 0), fibcapi.NewBridgingFlowAction("OUTPUT", 1)).ToMod(fibcapi.FlowMod_ADD, ""),
+	}
+	for _, mod := range flows {
+		s.FIBCFlowMod(nil, mod)
+	}
+
+	// HAL diverges from flow/group mods processed.
+	aclEntries, _ := s.Fields().EthType.GetEntries()
+	if len(aclEntries) != 1 {
+		t.Fatalf("FieldEntry unmatch. %v", aclEntries)
+	}
+	sim.FieldEntryDestroy(aclEntries[0])
+
+	sim.MplsTunnelSwitchDelete(hal.NewMplsTunnelSwitch(300))
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+	ecmpEgrID, _ := s.idmaps.L3Ecmps.Get(1)
+	ecmp := &hal.L3EgressEcmp{
+		Flags:    hal.L3_REPLACE | hal.L3_WITH_ID,
+		EgressID: ecmpEgrID,
+		Members:  []hal.L3EgressID{egrID10},
+	}
+	if _, err := sim.L3EgressEcmpCreate(ecmp); err != nil {
+		t.Fatalf("L3EgressEcmpCreate error. %s", err)
+	}
+
+	tunIfaceID, _ := s.idmaps.MPLSIfaces.Get(NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_TUNNEL1, 100))
+	sim.MplsTunnelInitiatorSet(tunIfaceID, []*hal.MplsEgressLabel{newMPLSEgressLabel(9999)})
+
+	installedFlows, err := s.installedFlowMods()
+	if err != nil {
+		t.Fatalf("installedFlowMods error. %s", err)
+	}
+
+	expectedFlows := []struct {
+		table fibcapi.FlowMod_Table
+		cmd   fibcapi.FlowMod_Cmd
+	}{
+		{table: fibcapi.FlowMod_VLAN, cmd: fibcapi.FlowMod_ADD},
+		{table: fibcapi.FlowMod_TERM_MAC, cmd: fibcapi.FlowMod_ADD},
+		{table: fibcapi.FlowMod_BRIDGING, cmd: fibcapi.FlowMod_NOP},
+	}
+
+	if len(installedFlows) != len(expectedFlows) {
+		t.Fatalf("installedFlowMods unmatch. %v", installedFlows)
+	}
+
+	for index, e := range expectedFlows {
+		if flow := installedFlows[index]; flow.Table != e.table || flow.Cmd != e.cmd {
+			t.Errorf("installedFlowMods[%d] unmatch. %s %s", index, flow.Table, flow.Cmd)
+		}
+	}
+
+	installedGroups := map[fibcapi.GroupMod_GType]*fibcapi.GroupMod{}
+	for _, mod := range s.installedGroupMods() {
+		installedGroups[mod.GType] = mod
+	}
+
+	if n := len(installedGroups); n != 5 {
+		t.Errorf("installedGroupMods unmatch. %v", installedGroups)
+	}
+
+	if _, ok := installedGroups[fibcapi.GroupMod_MPLS_TUNNEL1]; ok {
+		t.Errorf("installedGroupMods unmatch. tunnel1 must not be returned.")
+	}
+
+	if mod, ok := installedGroups[fibcapi.GroupMod_L3_ECMP]; !ok || len(mod.GetL3Ecmp().NeIds) != 1 || mod.GetL3Ecmp().NeIds[0] != 10 {
+		t.Errorf("installedGroupMods unmatch. %v", mod)
+	}
+
+	if mod, ok := installedGroups[fibcapi.GroupMod_MPLS_L3_VPN]; !ok || mod.GetMplsLabel().NewLabel != 10200 || mod.Cmd != fibcapi.GroupMod_ADD {
+		t.Errorf("installedGroupMods unmatch. %v", mod)
+	}
+
+	if mod, ok := installedGroups[fibcapi.GroupMod_L2_INTERFACE]; !ok || mod.GetL2Iface().HwAddr != "00:11:22:33:44:55" {
+		t.Errorf("installedGroupMods unmatch. %v", mod)
+	}
+}
+
+func TestServerSim_L3AddExisting(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+	addTestNeigh(s, 2, 11)
+
+	egrID10, _ := s.idmaps.L3Egress.Get(10)
+	egrID11, _ := s.idmaps.L3Egress.Get(11)
+
+	// route is replaced by ADD (DELROUTE is not sent before NEWROUTE).
+	modTestRoute(s, fibcapi.FlowMod_ADD, "10.0.1.0/24", 10)
+	modTestRoute(s, fibcapi.FlowMod_ADD, "10.0.1.0/24", 11)
+
+	if egrID := getTestSimRouteEgress(t, sim, "10.0.1.0/24"); egrID != egrID11 {
+		t.Errorf("L3Route egress unmatch. %d", egrID)
+	}
+
+	if _, ok := s.egrRefs.Get(egrID10); ok {
+		t.Errorf("L3EgressRef not removed. %d", egrID10)
+	}
+
+	if r, ok := s.egrRefs.Get(egrID11); !ok || r.Refs != 1 {
+		t.Errorf("L3EgressRef unmatch. %v %t", r, ok)
+	}
+
+	// neighbor is replaced by ADD (NEWNEIGH of mac change).
+	l3ucast := &fibcapi.L3UnicastGroup{
+		NeId:      11,
+		PortId:    2,
+		PhyPortId: 2,
+		EthDst:    "66:77:88:99:aa:cc",
+		EthSrc:    "00:11:22:33:44:55",
+	}
+	s.FIBCL3UnicastGroupMod(nil, &fibcapi.GroupMod{Cmd: fibcapi.GroupMod_ADD}, l3ucast)
+
+	if egrID, _ := s.idmaps.L3Egress.Get(11); egrID != egrID11 {
+		t.Errorf("L3Egress not replaced in place. %d", egrID)
+	}
+
+	egr, err := sim.L3EgressGet(egrID11)
+	if err != nil {
+		t.Fatalf("L3EgressGet error. %s", err)
+	}
+
+	if mac := egr.MAC.String(); mac != "66:77:88:99:aa:cc" {
+		t.Errorf("L3Egress mac unmatch. %s", mac)
+	}
+
+	if egrID := getTestSimRouteEgress(t, sim, "10.0.1.0/24"); egrID != egrID11 {
+		t.Errorf("L3Route egress unmatch. %d", egrID)
+	}
+}
+
+func TestServerSim_MPLSModify(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+
+	ethSrc, _ := net.ParseMAC("00:11:22:33:44:55")
+	ethDst, _ := net.ParseMAC("66:77:88:99:aa:bb")
+	mplsIf := fibcapi.NewMPLSInterfaceGroup(10, 1, 0, ethDst, ethSrc)
+	s.FIBCGroupMod(nil, mplsIf.ToMod(fibcapi.GroupMod_ADD, ""))
+
+	groups := []*fibcapi.MPLSLabelGroup{
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL1, 100, 10100, 10, 0),
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_L3_VPN, 200, 10200, 0, 100),
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_SWAP, 300, 10300, 10, 0),
+		fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_SWAP, 301, 10301, 10, 0),
+	}
+	for _, group := range groups {
+		s.FIBCGroupMod(nil, group.ToMod(fibcapi.GroupMod_ADD, ""))
+	}
+
+	// neighbor mac of MPLS-IF is changed.
+	ethDst, _ = net.ParseMAC("66:77:88:99:aa:cc")
+	mplsIf = fibcapi.NewMPLSInterfaceGroup(10, 1, 0, ethDst, ethSrc)
+	s.FIBCGroupMod(nil, mplsIf.ToMod(fibcapi.GroupMod_MODIFY, ""))
+
+	for _, group := range groups {
+		gid := NewMPLSLabelGroupID(group.GType, group.DstId)
+		egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+		if !ok {
+			t.Fatalf("MPLS Egress not found. %08x", gid)
+		}
+
+		egr, err := sim.L3EgressGet(egrID)
+		if err != nil {
+			t.Fatalf("L3EgressGet error. %08x %s", gid, err)
+		}
+
+		if mac := egr.MAC.String(); mac != "66:77:88:99:aa:cc" {
+			t.Errorf("MPLS Egress mac unmatch. %08x %s", gid, mac)
+		}
+	}
+
+	// label route is changed to another swap group.
+	match := fibcapi.NewMPLSMatch(20000, false)
+	flow := fibcapi.NewMPLSFlow(match, nil, 0, fibcapi.GroupMod_MPLS_SWAP, 300)
+	s.FIBCFlowMod(nil, flow.ToMod(fibcapi.FlowMod_ADD, ""))
+
+	flow = fibcapi.NewMPLSFlow(match, nil, 0, fibcapi.GroupMod_MPLS_SWAP, 301)
+	s.FIBCFlowMod(nil, flow.ToMod(fibcapi.FlowMod_MODIFY, ""))
+
+	egrID, _ := s.idmaps.MPLSEgress.Get(NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_SWAP, 301))
+
+	tsws := []*hal.MplsTunnelSwitch{}
+	sim.MplsTunnelSwitchTraverse(func(tsw *hal.MplsTunnelSwitch) error {
+		tsws = append(tsws, tsw)
+		return nil
+	})
+
+	if len(tsws) != 1 {
+		t.Fatalf("MplsTunnelSwitch unmatch. %v", tsws)
+	}
+
+	if tsw := tsws[0]; tsw.EgressIf != egrID || tsw.EgressLabelValue() != 10301 {
+		t.Errorf("MplsTunnelSwitch not replaced. %s", tsw)
+	}
+
+	if (tsws[0].Flags & hal.MPLS_SWITCH_REPLACE) != 0 {
+		t.Errorf("MplsTunnelSwitch flags unmatch. %x", tsws[0].Flags)
+	}
+}
+
+func TestServerSim_MPLSTunnel2(t *testing.T) {
+	s, sim := newTestSimServer(t)
+
+	addTestNeigh(s, 1, 10)
+
+	ethSrc, _ := net.ParseMAC("00:11:22:33:44:55")
+	ethDst, _ := net.ParseMAC("66:77:88:99:aa:bb")
+	mplsIf := fibcapi.NewMPLSInterfaceGroup(10, 1, 0, ethDst, ethSrc)
+	s.FIBCGroupMod(nil, mplsIf.ToMod(fibcapi.GroupMod_ADD, ""))
+
+	tun1 := fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL1, 100, 10100, 10, 0)
+	tun2 := fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL2, 101, 10101, 0, 100)
+	s.FIBCGroupMod(nil, tun1.ToMod(fibcapi.GroupMod_ADD, ""))
+	s.FIBCGroupMod(nil, tun2.ToMod(fibcapi.GroupMod_ADD, ""))
+
+	gid := NewMPLSLabelGroupID(fibcapi.GroupMod_MPLS_TUNNEL2, 101)
+	egrID, ok := s.idmaps.MPLSEgress.Get(gid)
+	if !ok {
+		t.Fatalf("MPLS Egress not found. %08x", gid)
+	}
+
+	checkLabels := func(labels ...uint32) {
+		ifaceID, ok := s.idmaps.MPLSIfaces.Get(gid)
+		if !ok {
+			t.Fatalf("MPLS Iface not found. %08x", gid)
+		}
+
+		egr, err := sim.L3EgressGet(egrID)
+		if err != nil || egr.IfaceID != ifaceID {
+			t.Fatalf("L3EgressGet unmatch. %v %s", egr, err)
+		}
+
+		ls, err := sim.MplsTunnelInitiatorGet(ifaceID, mplsLabelMaxNum)
+		if err != nil {
+			t.Fatalf("MplsTunnelInitiatorGet error. %s", err)
+		}
+
+		if len(ls) != len(labels) {
+			t.Fatalf("MPLS Tunnel labels unmatch. %v", ls)
+		}
+		for i, label := range labels {
+			if uint32(ls[i].Label) != label {
+				t.Errorf("MPLS Tunnel label[%d] unmatch. %d", i, ls[i].Label)
+			}
+		}
+	}
+
+	checkLabels(10100, 10101)
+
+	// label of Tunnel2 is replaced.
+	tun2 = fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL2, 101, 20101, 0, 100)
+	s.FIBCGroupMod(nil, tun2.ToMod(fibcapi.GroupMod_MODIFY, ""))
+
+	checkLabels(10100, 20101)
+
+	// label of lower Tunnel1 is replaced.
+	tun1 = fibcapi.NewMPLSLabelGroup(fibcapi.GroupMod_MPLS_TUNNEL1, 100, 20100, 10, 0)
+	s.FIBCGroupMod(nil, tun1.ToMod(fibcapi.GroupMod_MODIFY, ""))
+
+	checkLabels(20100, 20101)
+}
+
+func TestServerSim_RxServeQueueError(t *testing.T) {
+	_, sim := newTestSimServer(t)
+
+	// callback of queue 2 is already registered, so server of queue 2 exits.
+	sim.RxRegister(packetRxPri+2, 2, func(*hal.Packet) {})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	rxCh := make(chan *hal.Packet, 4)
+	go RxServe(sim, []uint32{1, 2, 3}, rxCh, done)
+
+	for retry := 0; sim.InjectRx(&hal.Packet{Cos: 3}) == 0; retry++ {
+		if retry > 10 {
+			t.Fatalf("RxRegister timeout.")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	<-rxCh
+
+	for _, cos := range []int{1, 3} {
+		if n := sim.InjectRx(&hal.Packet{Cos: cos, SrcPort: 1}); n != 1 {
+			t.Errorf("RxServe unmatch. cos:%d %d", cos, n)
+		}
+
+		select {
+		case pkt := <-rxCh:
+			if pkt.Cos != cos {
+				t.Errorf("RxServe unmatch. %s", pkt)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("RxServe timeout. cos:%d", cos)
+		}
+	}
+
+	// server of queue 5 exits, and other queues keep receiving.
+	table := NewRxQueueTable(sim)
+	table.Start(rxCh, done)
+	table.Update([]uint32{4, 5})
+	table.Update([]uint32{4})
+
+	for retry := 0; sim.InjectRx(&hal.Packet{Cos: 5}) != 0; retry++ {
+		if retry > 10 {
+			t.Fatalf("RxUnregister timeout.")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for len(rxCh) > 0 {
+		<-rxCh
+	}
+
+	for _, cos := range []int{1, 4} {
+		if n := sim.InjectRx(&hal.Packet{Cos: cos}); n != 1 {
+			t.Errorf("RxServe unmatch. cos:%d %d", cos, n)
+		}
+
+		select {
+		case pkt := <-rxCh:
+			if pkt.Cos != cos {
+				t.Errorf("RxServe unmatch. %s", pkt)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("RxServe timeout. cos:%d", cos)
+		}
+	}
+}
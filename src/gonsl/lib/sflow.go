@@ -0,0 +1,283 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+)
+
+//
+// sFlow version 5 (https://sflow.org/sflow_version_5.txt)
+//
+const (
+	SFlowVersion5 uint32 = 5
+
+	SFlowAddrTypeIPv4 uint32 = 1
+	SFlowAddrTypeIPv6 uint32 = 2
+
+	SFlowFormatFlowSample    uint32 = 1
+	SFlowFormatCounterSample uint32 = 2
+
+	SFlowFormatRawPacketHeader uint32 = 1
+	SFlowFormatIfCounters      uint32 = 1
+
+	SFlowHeaderProtocolEthernet uint32 = 1
+
+	SFlowIfTypeEthernet  uint32 = 6
+	SFlowIfDirectionFull uint32 = 1
+	SFlowIfStatusAdminUp uint32 = 1 << 0
+	SFlowIfStatusOperUp  uint32 = 1 << 1
+
+	SFlowSourceTypeIfIndex uint32 = 0
+
+	sflowFrameCheckSequence uint32 = 4
+)
+
+//
+// NewSFlowDataSource returns data source id of port (ifIndex).
+//
+func NewSFlowDataSource(port hal.Port) uint32 {
+	return (SFlowSourceTypeIfIndex << 24) | (uint32(port) & 0x00ffffff)
+}
+
+func sflowWrite(buf *bytes.Buffer, values ...interface{}) {
+	for _, value := range values {
+		binary.Write(buf, binary.BigEndian, value)
+	}
+}
+
+func sflowWriteOpaque(buf *bytes.Buffer, data []byte) {
+	buf.Write(data)
+	if pad := len(data) % 4; pad != 0 {
+		buf.Write(make([]byte, 4-pad))
+	}
+}
+
+// sflowWriteRecord writes format, length and data of sample or record.
+func sflowWriteRecord(buf *bytes.Buffer, format uint32, f func(*bytes.Buffer)) {
+	var data bytes.Buffer
+	f(&data)
+
+	sflowWrite(buf, format, uint32(data.Len()))
+	buf.Write(data.Bytes())
+}
+
+//
+// SFlowSample is flow sample or counter sample.
+//
+type SFlowSample interface {
+	Format() uint32
+	Encode(*bytes.Buffer)
+}
+
+//
+// SFlowFlowSample is flow sample with raw packet header.
+//
+type SFlowFlowSample struct {
+	SeqNum      uint32
+	SourceID    uint32
+	Rate        uint32
+	Pool        uint32
+	Drops       uint32
+	Input       uint32
+	Output      uint32
+	FrameLength uint32
+	Header      []byte
+}
+
+//
+// Format returns data format of flow sample.
+//
+func (s *SFlowFlowSample) Format() uint32 {
+	return SFlowFormatFlowSample
+}
+
+//
+// Encode writes flow sample to buffer.
+//
+func (s *SFlowFlowSample) Encode(buf *bytes.Buffer) {
+	sflowWrite(buf, s.SeqNum, s.SourceID, s.Rate, s.Pool, s.Drops, s.Input, s.Output)
+	sflowWrite(buf, uint32(1)) // number of flow records.
+	sflowWriteRecord(buf, SFlowFormatRawPacketHeader, func(b *bytes.Buffer) {
+		sflowWrite(b, SFlowHeaderProtocolEthernet, s.FrameLength, sflowFrameCheckSequence, uint32(len(s.Header)))
+		sflowWriteOpaque(b, s.Header)
+	})
+}
+
+func (s *SFlowFlowSample) String() string {
+	return fmt.Sprintf("seq:%d src:%d rate:%d pool:%d in:%d out:%d len:%d/%d",
+		s.SeqNum, s.SourceID, s.Rate, s.Pool, s.Input, s.Output, len(s.Header), s.FrameLength)
+}
+
+//
+// SFlowIfCounters is generic interface counters.
+//
+type SFlowIfCounters struct {
+	Index           uint32
+	Type            uint32
+	Speed           uint64
+	Direction       uint32
+	Status          uint32
+	InOctets        uint64
+	InUcastPkts     uint32
+	InMcastPkts     uint32
+	InBcastPkts     uint32
+	InDiscards      uint32
+	InErrors        uint32
+	InUnknownProtos uint32
+	OutOctets       uint64
+	OutUcastPkts    uint32
+	OutMcastPkts    uint32
+	OutBcastPkts    uint32
+	OutDiscards     uint32
+	OutErrors       uint32
+	Promiscuous     uint32
+}
+
+//
+// SFlowIfCountersStatNames is names of port stats used by SFlowIfCounters.
+//
+var SFlowIfCountersStatNames = []string{
+	"ifHCInOctets",
+	"ifHCInUcastPkts",
+	"ifHCInMulticastPkts",
+	"ifHCInBroadcastPkts",
+	"ifInDiscards",
+	"ifInErrors",
+	"ifInUnknownProtos",
+	"ifHCOutOctets",
+	"ifHCOutUcastPkts",
+	"ifHCOutMulticastPkts",
+	"ifHCOutBroadcastPkts",
+	"ifOutDiscards",
+	"ifOutErrors",
+}
+
+//
+// NewSFlowIfCounters returns counters of port.
+// stats is result of PortStats.Get with SFlowIfCountersStatNames.
+//
+func NewSFlowIfCounters(port hal.Port, info *hal.PortInfo, stats map[string]uint64) *SFlowIfCounters {
+	status := uint32(0)
+	if info.Enable {
+		status |= SFlowIfStatusAdminUp
+	}
+	if info.LinkUp {
+		status |= SFlowIfStatusOperUp
+	}
+
+	return &SFlowIfCounters{
+		Index:           uint32(port),
+		Type:            SFlowIfTypeEthernet,
+		Speed:           uint64(info.Speed) * 1000000,
+		Direction:       SFlowIfDirectionFull,
+		Status:          status,
+		InOctets:        stats["ifHCInOctets"],
+		InUcastPkts:     uint32(stats["ifHCInUcastPkts"]),
+		InMcastPkts:     uint32(stats["ifHCInMulticastPkts"]),
+		InBcastPkts:     uint32(stats["ifHCInBroadcastPkts"]),
+		InDiscards:      uint32(stats["ifInDiscards"]),
+		InErrors:        uint32(stats["ifInErrors"]),
+		InUnknownProtos: uint32(stats["ifInUnknownProtos"]),
+		OutOctets:       stats["ifHCOutOctets"],
+		OutUcastPkts:    uint32(stats["ifHCOutUcastPkts"]),
+		OutMcastPkts:    uint32(stats["ifHCOutMulticastPkts"]),
+		OutBcastPkts:    uint32(stats["ifHCOutBroadcastPkts"]),
+		OutDiscards:     uint32(stats["ifOutDiscards"]),
+		OutErrors:       uint32(stats["ifOutErrors"]),
+	}
+}
+
+//
+// Encode writes counters to buffer.
+//
+func (c *SFlowIfCounters) Encode(buf *bytes.Buffer) {
+	sflowWrite(buf, c.Index, c.Type, c.Speed, c.Direction, c.Status)
+	sflowWrite(buf, c.InOctets, c.InUcastPkts, c.InMcastPkts, c.InBcastPkts, c.InDiscards, c.InErrors, c.InUnknownProtos)
+	sflowWrite(buf, c.OutOctets, c.OutUcastPkts, c.OutMcastPkts, c.OutBcastPkts, c.OutDiscards, c.OutErrors)
+	sflowWrite(buf, c.Promiscuous)
+}
+
+//
+// SFlowCounterSample is counter sample with generic interface counters.
+//
+type SFlowCounterSample struct {
+	SeqNum   uint32
+	SourceID uint32
+	Counters *SFlowIfCounters
+}
+
+//
+// Format returns data format of counter sample.
+//
+func (s *SFlowCounterSample) Format() uint32 {
+	return SFlowFormatCounterSample
+}
+
+//
+// Encode writes counter sample to buffer.
+//
+func (s *SFlowCounterSample) Encode(buf *bytes.Buffer) {
+	sflowWrite(buf, s.SeqNum, s.SourceID)
+	sflowWrite(buf, uint32(1)) // number of counter records.
+	sflowWriteRecord(buf, SFlowFormatIfCounters, s.Counters.Encode)
+}
+
+func (s *SFlowCounterSample) String() string {
+	return fmt.Sprintf("seq:%d src:%d", s.SeqNum, s.SourceID)
+}
+
+//
+// SFlowDatagram is sflow v5 datagram.
+//
+type SFlowDatagram struct {
+	Agent      net.IP
+	SubAgentID uint32
+	SeqNum     uint32
+	Uptime     uint32 // msec
+	Samples    []SFlowSample
+}
+
+//
+// Encode returns binary of datagram.
+//
+func (d *SFlowDatagram) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	sflowWrite(&buf, SFlowVersion5)
+	if ip := d.Agent.To4(); ip != nil {
+		sflowWrite(&buf, SFlowAddrTypeIPv4)
+		buf.Write(ip)
+	} else if ip := d.Agent.To16(); ip != nil {
+		sflowWrite(&buf, SFlowAddrTypeIPv6)
+		buf.Write(ip)
+	} else {
+		return nil, fmt.Errorf("Invalid agent address. %s", d.Agent)
+	}
+
+	sflowWrite(&buf, d.SubAgentID, d.SeqNum, d.Uptime, uint32(len(d.Samples)))
+	for _, sample := range d.Samples {
+		sflowWriteRecord(&buf, sample.Format(), sample.Encode)
+	}
+
+	return buf.Bytes(), nil
+}
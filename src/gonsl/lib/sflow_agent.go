@@ -0,0 +1,368 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	sflowRxPri      = 20
+	sflowCos        = 0 // cos of sampled packets copied to cpu.
+	sflowQueueSize  = 1024
+	sflowMaxSamples = 8 // max samples per datagram.
+)
+
+//
+// NewSFlowPortRates returns sampling rates of each port.
+// Later port config overrides earlier one if ports overlap.
+//
+func NewSFlowPortRates(cfg *SFlowConfig, allPorts []hal.Port) map[hal.Port]*hal.PortSampleRate {
+	rates := map[hal.Port]*hal.PortSampleRate{}
+
+	for _, pcfg := range cfg.Ports {
+		ports := allPorts
+		if len(pcfg.Ports) != 0 {
+			ports = make([]hal.Port, len(pcfg.Ports))
+			for index, port := range pcfg.Ports {
+				ports[index] = hal.Port(port)
+			}
+		}
+
+		for _, port := range ports {
+			rates[port] = &hal.PortSampleRate{
+				Ingress: pcfg.Ingress,
+				Egress:  pcfg.Egress,
+			}
+		}
+	}
+
+	return rates
+}
+
+type sflowSource struct {
+	seqNum uint32
+	pool   uint32
+}
+
+//
+// SFlowAgent encodes samples and sends them to collectors.
+//
+type SFlowAgent struct {
+	agent      net.IP
+	subAgentID uint32
+	headerSize uint32
+	collectors []string
+	rates      map[hal.Port]*hal.PortSampleRate
+	conns      []net.Conn
+	startTime  time.Time
+	seqNum     uint32
+	drops      uint32
+	flowSrcs   map[uint32]*sflowSource
+	cntrSeqs   map[uint32]uint32
+
+	log *log.Entry
+}
+
+//
+// NewSFlowAgent returns new instance.
+//
+func NewSFlowAgent(cfg *SFlowConfig, rates map[hal.Port]*hal.PortSampleRate) (*SFlowAgent, error) {
+	agent := net.ParseIP(cfg.Agent)
+	if agent == nil {
+		return nil, fmt.Errorf("Invalid sflow agent address. '%s'", cfg.Agent)
+	}
+
+	return &SFlowAgent{
+		agent:      agent,
+		subAgentID: cfg.SubAgentID,
+		headerSize: cfg.GetHeaderSize(),
+		collectors: cfg.GetCollectors(),
+		rates:      rates,
+		conns:      []net.Conn{},
+		startTime:  time.Now(),
+		flowSrcs:   map[uint32]*sflowSource{},
+		cntrSeqs:   map[uint32]uint32{},
+
+		log: log.WithFields(log.Fields{"module": "sflow"}),
+	}, nil
+}
+
+//
+// Connect opens sockets to collectors.
+//
+func (a *SFlowAgent) Connect() error {
+	for _, collector := range a.collectors {
+		conn, err := net.Dial("udp", collector)
+		if err != nil {
+			a.Close()
+			return err
+		}
+
+		a.conns = append(a.conns, conn)
+	}
+
+	return nil
+}
+
+//
+// Close closes sockets to collectors.
+//
+func (a *SFlowAgent) Close() {
+	for _, conn := range a.conns {
+		conn.Close()
+	}
+	a.conns = []net.Conn{}
+}
+
+//
+// Drop counts sampled packet dropped before encoded.
+//
+func (a *SFlowAgent) Drop() {
+	atomic.AddUint32(&a.drops, 1)
+}
+
+func (a *SFlowAgent) flowSource(sourceID uint32) *sflowSource {
+	src, ok := a.flowSrcs[sourceID]
+	if !ok {
+		src = &sflowSource{}
+		a.flowSrcs[sourceID] = src
+	}
+	return src
+}
+
+//
+// NewFlowSample returns flow sample of sampled packet.
+// It returns false if packet is not sampled on configured port.
+//
+func (a *SFlowAgent) NewFlowSample(pkt *hal.Packet) (*SFlowFlowSample, bool) {
+	var port hal.Port
+	var input, output, sampleRate uint32
+
+	switch {
+	case (pkt.Reasons & hal.PACKET_REASON_SAMPLE_INGRESS) != 0:
+		port, input = pkt.SrcPort, uint32(pkt.SrcPort)
+		if rate, ok := a.rates[port]; ok {
+			sampleRate = rate.Ingress
+		}
+
+	case (pkt.Reasons & hal.PACKET_REASON_SAMPLE_EGRESS) != 0:
+		port, output = pkt.DstPort, uint32(pkt.DstPort)
+		if rate, ok := a.rates[port]; ok {
+			sampleRate = rate.Egress
+		}
+	}
+
+	if sampleRate == 0 {
+		return nil, false
+	}
+
+	header := pkt.Data
+	if uint32(len(header)) > a.headerSize {
+		header = header[:a.headerSize]
+	}
+
+	sourceID := NewSFlowDataSource(port)
+	src := a.flowSource(sourceID)
+	src.seqNum++
+	src.pool += sampleRate
+
+	return &SFlowFlowSample{
+		SeqNum:      src.seqNum,
+		SourceID:    sourceID,
+		Rate:        sampleRate,
+		Pool:        src.pool,
+		Drops:       atomic.LoadUint32(&a.drops),
+		Input:       input,
+		Output:      output,
+		FrameLength: uint32(len(pkt.Data)) + sflowFrameCheckSequence,
+		Header:      header,
+	}, true
+}
+
+//
+// NewCounterSamples returns counter samples of sampled ports.
+//
+func (a *SFlowAgent) NewCounterSamples(h hal.HAL) ([]SFlowSample, error) {
+	ports := make([]hal.Port, 0, len(a.rates))
+	for port := range a.rates {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	statsList, err := NewPortStats(SFlowIfCountersStatNames).GetAll(h, ports)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]SFlowSample, 0, len(ports))
+	for index, port := range ports {
+		info, err := h.PortInfoGet(port)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceID := NewSFlowDataSource(port)
+		a.cntrSeqs[sourceID]++
+
+		samples = append(samples, &SFlowCounterSample{
+			SeqNum:   a.cntrSeqs[sourceID],
+			SourceID: sourceID,
+			Counters: NewSFlowIfCounters(port, info, statsList[index]),
+		})
+	}
+
+	return samples, nil
+}
+
+//
+// Send sends samples to collectors.
+//
+func (a *SFlowAgent) Send(samples []SFlowSample) error {
+	for len(samples) > 0 {
+		n := len(samples)
+		if n > sflowMaxSamples {
+			n = sflowMaxSamples
+		}
+
+		a.seqNum++
+		dgram := SFlowDatagram{
+			Agent:      a.agent,
+			SubAgentID: a.subAgentID,
+			SeqNum:     a.seqNum,
+			Uptime:     uint32(time.Since(a.startTime) / time.Millisecond),
+			Samples:    samples[:n],
+		}
+
+		data, err := dgram.Encode()
+		if err != nil {
+			return err
+		}
+
+		for _, conn := range a.conns {
+			if _, err := conn.Write(data); err != nil {
+				a.log.Warnf("Send: Write error. %s %s", conn.RemoteAddr(), err)
+			}
+		}
+
+		samples = samples[n:]
+	}
+
+	return nil
+}
+
+//
+// SFlowStart sets sampling rates of ports and starts sflow agent.
+//
+func (s *Server) SFlowStart(done <-chan struct{}) error {
+	cfg := &s.dpCfg.SFlow
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	pbmp, err := s.hal.PortBmp()
+	if err != nil {
+		return err
+	}
+
+	rates := NewSFlowPortRates(cfg, pbmp.PortList())
+	agent, err := NewSFlowAgent(cfg, rates)
+	if err != nil {
+		return err
+	}
+
+	if err := s.hal.SampleCosSet(sflowCos); err != nil {
+		return fmt.Errorf("SampleCosSet error. %s", err)
+	}
+
+	for port, rate := range rates {
+		if err := s.hal.PortSampleRateSet(port, rate); err != nil {
+			return fmt.Errorf("PortSampleRateSet error. port:%d %s", port, err)
+		}
+
+		s.log.Debugf("SFlow: port:%d %s", port, rate)
+	}
+
+	if err := agent.Connect(); err != nil {
+		return err
+	}
+
+	sampleCh := make(chan *hal.Packet, sflowQueueSize)
+	err = s.hal.RxRegister(sflowRxPri, sflowCos, func(pkt *hal.Packet) {
+		select {
+		case sampleCh <- pkt:
+		default:
+			agent.Drop()
+		}
+	})
+	if err != nil {
+		agent.Close()
+		return err
+	}
+
+	go s.sflowServe(agent, sampleCh, time.Duration(cfg.GetPollingSec())*time.Second, done)
+
+	return nil
+}
+
+func (s *Server) sflowServe(agent *SFlowAgent, sampleCh <-chan *hal.Packet, interval time.Duration, done <-chan struct{}) {
+	defer agent.Close()
+	defer s.hal.RxUnregister(sflowRxPri)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.log.Infof("SFlow: Started.")
+
+	for {
+		select {
+		case pkt := <-sampleCh:
+			sample, ok := agent.NewFlowSample(pkt)
+			if !ok {
+				s.log.Debugf("SFlow: not sampled. %s", pkt)
+				continue
+			}
+
+			if err := agent.Send([]SFlowSample{sample}); err != nil {
+				s.log.Errorf("SFlow: Send error. %s", err)
+			}
+
+		case <-ticker.C:
+			samples, err := agent.NewCounterSamples(s.hal)
+			if err != nil {
+				s.log.Errorf("SFlow: NewCounterSamples error. %s", err)
+				continue
+			}
+
+			if err := agent.Send(samples); err != nil {
+				s.log.Errorf("SFlow: Send error. %s", err)
+			}
+
+		case <-done:
+			s.log.Infof("SFlow: Exit.")
+			return
+		}
+	}
+}
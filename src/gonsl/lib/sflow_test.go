@@ -0,0 +1,154 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"encoding/binary"
+	hal "gonsl/hal"
+	"net"
+	"testing"
+)
+
+func TestSFlowDatagram_Encode(t *testing.T) {
+	dgram := SFlowDatagram{
+		Agent:      net.ParseIP("10.0.0.1"),
+		SubAgentID: 1,
+		SeqNum:     2,
+		Uptime:     3,
+		Samples: []SFlowSample{
+			&SFlowFlowSample{
+				SeqNum:      1,
+				SourceID:    NewSFlowDataSource(5),
+				Rate:        1024,
+				Pool:        1024,
+				Input:       5,
+				FrameLength: 64,
+				Header:      make([]byte, 14),
+			},
+			&SFlowCounterSample{
+				SeqNum:   1,
+				SourceID: NewSFlowDataSource(5),
+				Counters: NewSFlowIfCounters(5, &hal.PortInfo{LinkUp: true, Enable: true, Speed: 10000}, map[string]uint64{"ifHCInOctets": 100}),
+			},
+		},
+	}
+
+	data, err := dgram.Encode()
+	if err != nil {
+		t.Fatalf("Encode error. %s", err)
+	}
+
+	// header(28) + flow sample(8+72) + counter sample(8+108)
+	if n := len(data); n != 224 {
+		t.Fatalf("Encode unmatch. len=%d", n)
+	}
+
+	u32 := func(offset int) uint32 { return binary.BigEndian.Uint32(data[offset:]) }
+
+	if v := u32(0); v != SFlowVersion5 {
+		t.Errorf("Encode unmatch. version=%d", v)
+	}
+
+	if v := u32(4); v != SFlowAddrTypeIPv4 {
+		t.Errorf("Encode unmatch. addr type=%d", v)
+	}
+
+	if ip := net.IP(data[8:12]); !ip.Equal(dgram.Agent) {
+		t.Errorf("Encode unmatch. agent=%s", ip)
+	}
+
+	if v := u32(24); v != 2 {
+		t.Errorf("Encode unmatch. samples=%d", v)
+	}
+
+	if f, l := u32(28), u32(32); f != SFlowFormatFlowSample || l != 72 {
+		t.Errorf("Encode unmatch. flow sample format=%d len=%d", f, l)
+	}
+
+	// raw packet header: header protocol, frame length, stripped, header length.
+	if p, l, s, h := u32(76), u32(80), u32(84), u32(88); p != SFlowHeaderProtocolEthernet || l != 64 || s != 4 || h != 14 {
+		t.Errorf("Encode unmatch. raw header %d %d %d %d", p, l, s, h)
+	}
+
+	if f, l := u32(108), u32(112); f != SFlowFormatCounterSample || l != 108 {
+		t.Errorf("Encode unmatch. counter sample format=%d len=%d", f, l)
+	}
+
+	if f, l := u32(128), u32(132); f != SFlowFormatIfCounters || l != 88 {
+		t.Errorf("Encode unmatch. if counters format=%d len=%d", f, l)
+	}
+
+	if speed := binary.BigEndian.Uint64(data[144:]); speed != 10000000000 {
+		t.Errorf("Encode unmatch. speed=%d", speed)
+	}
+
+	if status := u32(156); status != SFlowIfStatusAdminUp|SFlowIfStatusOperUp {
+		t.Errorf("Encode unmatch. status=%d", status)
+	}
+
+	if octets := binary.BigEndian.Uint64(data[160:]); octets != 100 {
+		t.Errorf("Encode unmatch. in octets=%d", octets)
+	}
+}
+
+func TestSFlowDatagram_EncodeIPv6(t *testing.T) {
+	dgram := SFlowDatagram{
+		Agent: net.ParseIP("2001:db8::1"),
+	}
+
+	data, err := dgram.Encode()
+	if err != nil {
+		t.Fatalf("Encode error. %s", err)
+	}
+
+	if n := len(data); n != 40 {
+		t.Errorf("Encode unmatch. len=%d", n)
+	}
+
+	if v := binary.BigEndian.Uint32(data[4:]); v != SFlowAddrTypeIPv6 {
+		t.Errorf("Encode unmatch. addr type=%d", v)
+	}
+
+	dgram.Agent = nil
+	if _, err := dgram.Encode(); err == nil {
+		t.Errorf("Encode must be error.")
+	}
+}
+
+func TestSFlowConfig_GetCollectors(t *testing.T) {
+	cfg := SFlowConfig{
+		Collectors: []string{"10.0.0.100", "10.0.0.101:9999", "2001:db8::100"},
+	}
+
+	collectors := cfg.GetCollectors()
+	if len(collectors) != 3 {
+		t.Fatalf("GetCollectors unmatch. %v", collectors)
+	}
+
+	if c := collectors[0]; c != "10.0.0.100:6343" {
+		t.Errorf("GetCollectors unmatch. %s", c)
+	}
+
+	if c := collectors[1]; c != "10.0.0.101:9999" {
+		t.Errorf("GetCollectors unmatch. %s", c)
+	}
+
+	if c := collectors[2]; c != "[2001:db8::100]:6343" {
+		t.Errorf("GetCollectors unmatch. %s", c)
+	}
+}
@@ -18,58 +18,50 @@
 package gonslib
 
 import (
-	"github.com/beluganos/go-opennsl/opennsl"
-
-	log "github.com/sirupsen/logrus"
+	hal "gonsl/hal"
 )
 
 //
-// PortStatInit initialze opennsl port stats function.
+// PortStats is list of stats names.
 //
-func PortStatInit(unit int) error {
-	if err := opennsl.StatInit(unit); err != nil {
-		log.Errorf("Stat init error. %s", err)
-		return err
-	}
-
-	log.Infof("Stat init ok.")
-	return nil
-}
-
-//
-// PortStats is list of opennsl.StatVal.
-//
-type PortStats []opennsl.StatVal
+type PortStats []string
 
 //
 // NewPortStats creates new instance.
 //
 func NewPortStats(names []string) PortStats {
-	statVals := PortStats{}
-	for _, name := range names {
-		statVal, err := opennsl.ParseStatVal(name)
-		if err != nil {
-			log.Errorf("ParsePortStats error. %s", err)
-		} else {
-			statVals = append(statVals, statVal)
-		}
-	}
-
-	return statVals
+	return PortStats(names)
 }
 
 //
 // Get gets stats of specified port.
+// Names of queue counters (e.g. cosq3OutPkts) get stats of egress queue.
 //
-func (p PortStats) Get(unit int, port opennsl.Port) (map[string]uint64, error) {
-	values, err := opennsl.StatValMultiGet(unit, opennsl.Port(port), p...)
+func (p PortStats) Get(h hal.HAL, port hal.Port) (map[string]uint64, error) {
+	names := []string{}
+	cosqNames := map[hal.CosQueue][]hal.CosqStat{}
+	for _, name := range p {
+		if queue, stat, err := hal.ParseCosqStatName(name); err == nil {
+			cosqNames[queue] = append(cosqNames[queue], stat)
+		} else {
+			names = append(names, name)
+		}
+	}
+
+	stats, err := h.PortStatGet(port, names)
 	if err != nil {
 		return nil, err
 	}
 
-	stats := map[string]uint64{}
-	for index, statVal := range p {
-		stats[statVal.String()] = values[index]
+	for queue, cosqStats := range cosqNames {
+		values, err := h.CosqStatGet(port, queue, cosqStats)
+		if err != nil {
+			return nil, err
+		}
+
+		for stat, value := range values {
+			stats[hal.CosqStatName(queue, stat)] = value
+		}
 	}
 
 	return stats, nil
@@ -78,11 +70,11 @@ func (p PortStats) Get(unit int, port opennsl.Port) (map[string]uint64, error) {
 //
 // GetAll gets Stats of all port.
 //
-func (p PortStats) GetAll(unit int, ports []opennsl.Port) ([]map[string]uint64, error) {
+func (p PortStats) GetAll(h hal.HAL, ports []hal.Port) ([]map[string]uint64, error) {
 	statsList := make([]map[string]uint64, len(ports))
 
 	for index, port := range ports {
-		stats, err := p.Get(unit, port)
+		stats, err := p.Get(h, port)
 		if err != nil {
 			return nil, err
 		}
@@ -19,50 +19,46 @@ package gonslib
 
 import (
 	fibcapi "fabricflow/fibc/api"
+	hal "gonsl/hal"
 	"net"
 
-	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
 
-func tunnelinitiatorSetSrcMAC(unit int, ifaceId opennsl.L3IfaceID, hwaddr net.HardwareAddr, vid opennsl.Vlan) error {
-	l3iface, err := opennsl.L3IfaceGet(unit, ifaceId)
+func tunnelinitiatorSetSrcMAC(h hal.HAL, ifaceId hal.L3IfaceID, hwaddr net.HardwareAddr, vid hal.Vlan) error {
+	l3iface, err := h.L3IfaceGet(ifaceId)
 	if err != nil {
 		return err
 	}
 
-	l3iface.SetFlags(opennsl.L3_REPLACE | opennsl.L3_WITH_ID)
-	l3iface.SetMAC(hwaddr)
-	l3iface.SetVID(vid)
+	l3iface.Flags = hal.L3_REPLACE | hal.L3_WITH_ID
+	l3iface.IfaceID = ifaceId
+	l3iface.MAC = hwaddr
+	l3iface.Vlan = vid
 
 	log.Debugf("GroupMod(L3-UC) initiator mac replaced. %d %s vid:%d", ifaceId, hwaddr, vid)
-	return l3iface.Create(unit)
+	_, err = h.L3IfaceCreate(l3iface)
+	return err
 }
 
-func tunnelInitiatorAdd(unit int, group *fibcapi.L3UnicastGroup, ifaceId opennsl.L3IfaceID, pvid opennsl.Vlan) {
+func tunnelInitiatorAdd(h hal.HAL, group *fibcapi.L3UnicastGroup, ifaceId hal.L3IfaceID, pvid hal.Vlan) {
 
-	tun := &opennsl.TunnelInitiator{}
-	tun.Init()
-	tun.SetTTL(64)
-	tun.SetVID(pvid)
-	tun.SetL3IfaceID(ifaceId)
+	tun := &hal.TunnelInitiator{
+		TTL:       64,
+		Vlan:      pvid,
+		L3IfaceID: ifaceId,
+	}
 
 	switch group.TunType {
 	case fibcapi.TunnelType_IPIP:
-		tun.SetType(opennsl.TunnelTypeIPIP4encap)
-		tun.SetDstIP4(group.GetTunRemoteIP())
-		tun.SetSrcIP4(group.GetTunLocalIP())
-
-		log.Debugf("GroupMod(L3-UC) tunnel initiator add. type=%s iface=%d vid=%d dst=%s src=%s",
-			tun.Type(), tun.L3IfaceID(), tun.VID(), tun.DstIP4(), tun.SrcIP4())
+		tun.Type = hal.TunnelTypeIPIP4encap
+		tun.DstIP = group.GetTunRemoteIP()
+		tun.SrcIP = group.GetTunLocalIP()
 
 	case fibcapi.TunnelType_IPV6:
-		tun.SetType(opennsl.TunnelTypeIPIP6encap)
-		tun.SetDstIP6(group.GetTunRemoteIP())
-		tun.SetSrcIP6(group.GetTunLocalIP())
-
-		log.Debugf("GroupMod(L3-UC) tunnel initiator add. type=%s iface=%d vid=%d dst=%s src=%s",
-			tun.Type(), tun.L3IfaceID(), tun.VID(), tun.DstIP6(), tun.SrcIP6())
+		tun.Type = hal.TunnelTypeIPIP6encap
+		tun.DstIP = group.GetTunRemoteIP()
+		tun.SrcIP = group.GetTunLocalIP()
 
 	case fibcapi.TunnelType_NOP:
 		log.Debugf("GroupMod(L3-UC) tunnel initiator add. type=%s iface=%d not tunnel.", group.TunType, ifaceId)
@@ -73,19 +69,20 @@ func tunnelInitiatorAdd(unit int, group *fibcapi.L3UnicastGroup, ifaceId opennsl
 		return
 	}
 
-	if err := tunnelinitiatorSetSrcMAC(unit, ifaceId, group.GetEthSrcHwAddr(), pvid); err != nil {
+	log.Debugf("GroupMod(L3-UC) tunnel initiator add. type=%s iface=%d vid=%d dst=%s src=%s",
+		tun.Type, tun.L3IfaceID, tun.Vlan, tun.DstIP, tun.SrcIP)
+
+	if err := tunnelinitiatorSetSrcMAC(h, ifaceId, group.GetEthSrcHwAddr(), pvid); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel initiator set-mac errror. iface:%d %s", ifaceId, err)
 		return
 	}
 
-	iface := opennsl.NewL3Iface()
-	iface.SetIfaceID(ifaceId)
-	if err := tun.Create(unit, iface); err != nil {
+	if err := h.TunnelInitiatorCreate(ifaceId, tun); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel initiator  crate error. iface:%d %s", ifaceId, err)
 	}
 }
 
-func tunnelInitiatorDelete(unit int, group *fibcapi.L3UnicastGroup, ifaceId opennsl.L3IfaceID) {
+func tunnelInitiatorDelete(h hal.HAL, group *fibcapi.L3UnicastGroup, ifaceId hal.L3IfaceID) {
 
 	switch group.TunType {
 	case fibcapi.TunnelType_IPIP:
@@ -98,66 +95,53 @@ func tunnelInitiatorDelete(unit int, group *fibcapi.L3UnicastGroup, ifaceId open
 
 	log.Debugf("GroupMod(L3-UC) tunnel initiator del. type=%s iface=%d", group.TunType, ifaceId)
 
-	iface := opennsl.NewL3Iface()
-	iface.SetIfaceID(ifaceId)
-
-	if err := iface.TunnelInitiatorClear(unit); err != nil {
+	if err := h.TunnelInitiatorClear(ifaceId); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel initiator clear error. iface:%d %s", ifaceId, err)
 	}
 
-	if err := tunnelinitiatorSetSrcMAC(unit, ifaceId, fibcapi.HardwareAddrDummy, 1); err != nil {
+	if err := tunnelinitiatorSetSrcMAC(h, ifaceId, fibcapi.HardwareAddrDummy, 1); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel initiator L3-IF clear error. iface:%d %s", ifaceId, err)
 	}
 }
 
-func newTunnelTerminator4(dst, src net.IP, port opennsl.Port, tunType opennsl.TunnelType) *opennsl.TunnelTerminator {
+func newTunnelTerminator(dst, src net.IP, bits int, port hal.Port, tunType hal.TunnelType) *hal.TunnelTerminator {
 
-	mask := net.CIDRMask(32, 32)
-	tun := opennsl.NewTunnelTerminator(tunType)
-	tun.SetDstIP4(dst)
-	tun.SetDstIPMask4(mask)
-	tun.SetSrcIP4(src)
-	tun.SetSrcIPMask4(mask)
-	tun.PBmp().Add(port)
-	// tun.SetVID(vid)
+	mask := net.CIDRMask(bits, bits)
+	tun := &hal.TunnelTerminator{
+		Type:  tunType,
+		DstIP: &net.IPNet{IP: dst, Mask: mask},
+		SrcIP: &net.IPNet{IP: src, Mask: mask},
+		PBmp:  hal.NewPBmp(port),
+	}
 
 	log.Debugf("GroupMod(L3-UC) tunnel terminator. type=%s port=%d vid=%d dst=%s src=%s",
-		tun.Type(), port, tun.VID(), tun.DstIPNet4(), tun.SrcIPNet4())
+		tun.Type, port, tun.Vlan, tun.DstIP, tun.SrcIP)
 
 	return tun
 }
 
-func newTunnelTerminator6(dst, src net.IP, port opennsl.Port, tunType opennsl.TunnelType) *opennsl.TunnelTerminator {
-
-	mask := net.CIDRMask(128, 128)
-	tun := opennsl.NewTunnelTerminator(tunType)
-	tun.SetDstIP6(dst)
-	tun.SetDstIPMask6(mask)
-	tun.SetSrcIP6(src)
-	tun.SetSrcIPMask6(mask)
-	tun.PBmp().Add(port)
-	// tun.SetVID(vid)
-
-	log.Debugf("GroupMod(L3-UC) tunnel terminator. type=%s port=%d vid=%d dst=%s src=%s",
-		tun.Type(), port, tun.VID(), tun.DstIPNet6(), tun.SrcIPNet6())
+func newTunnelTerminator4(dst, src net.IP, port hal.Port, tunType hal.TunnelType) *hal.TunnelTerminator {
+	return newTunnelTerminator(dst.To4(), src.To4(), 32, port, tunType)
+}
 
-	return tun
+func newTunnelTerminator6(dst, src net.IP, port hal.Port, tunType hal.TunnelType) *hal.TunnelTerminator {
+	return newTunnelTerminator(dst.To16(), src.To16(), 128, port, tunType)
 }
 
-func newTunnelTerminators(group *fibcapi.L3UnicastGroup) (to4Tun *opennsl.TunnelTerminator, to6Tun *opennsl.TunnelTerminator) {
+func newTunnelTerminators(group *fibcapi.L3UnicastGroup) (to4Tun *hal.TunnelTerminator, to6Tun *hal.TunnelTerminator) {
 
 	dst := group.GetTunLocalIP()
 	src := group.GetTunRemoteIP()
-	port := opennsl.Port(group.PhyPortId)
+	port := hal.Port(group.PhyPortId)
 
 	switch group.TunType {
 	case fibcapi.TunnelType_IPIP:
-		to4Tun = newTunnelTerminator4(dst, src, port, opennsl.TunnelTypeIPIP4toIP4)
-		to6Tun = newTunnelTerminator4(dst, src, port, opennsl.TunnelTypeIPIP4toIP6)
+		to4Tun = newTunnelTerminator4(dst, src, port, hal.TunnelTypeIPIP4toIP4)
+		to6Tun = newTunnelTerminator4(dst, src, port, hal.TunnelTypeIPIP4toIP6)
 
 	case fibcapi.TunnelType_IPV6:
-		to4Tun = newTunnelTerminator6(dst, src, port, opennsl.TunnelTypeIPIP6toIP4)
-		to6Tun = newTunnelTerminator6(dst, src, port, opennsl.TunnelTypeIPIP6toIP6)
+		to4Tun = newTunnelTerminator6(dst, src, port, hal.TunnelTypeIPIP6toIP4)
+		to6Tun = newTunnelTerminator6(dst, src, port, hal.TunnelTypeIPIP6toIP6)
 
 	case fibcapi.TunnelType_NOP:
 		log.Debugf("GroupMod(L3-UC) tunnel terminator init. type=%s port=%d not tunnel.", group.TunType, port)
@@ -171,7 +155,7 @@ func newTunnelTerminators(group *fibcapi.L3UnicastGroup) (to4Tun *opennsl.Tunnel
 	return
 }
 
-func tunnelTerminatorAdd(unit int, group *fibcapi.L3UnicastGroup) {
+func tunnelTerminatorAdd(h hal.HAL, group *fibcapi.L3UnicastGroup) {
 	log.Debugf("GroupMod(L3-UC) tunnel terminator add.")
 
 	to4Tun, to6Tun := newTunnelTerminators(group)
@@ -179,15 +163,15 @@ func tunnelTerminatorAdd(unit int, group *fibcapi.L3UnicastGroup) {
 		return
 	}
 
-	if err := to4Tun.Create(unit); err != nil {
+	if err := h.TunnelTerminatorCreate(to4Tun); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel terminator(to ipv6) create error. %v %s", to4Tun, err)
 	}
-	if err := to6Tun.Create(unit); err != nil {
+	if err := h.TunnelTerminatorCreate(to6Tun); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel terminator(to ipv6) create error. %v %s", to6Tun, err)
 	}
 }
 
-func tunnelTerminatorDelete(unit int, group *fibcapi.L3UnicastGroup) {
+func tunnelTerminatorDelete(h hal.HAL, group *fibcapi.L3UnicastGroup) {
 	log.Debugf("GroupMod(L3-UC) tunnel terminator delete.")
 
 	to4Tun, to6Tun := newTunnelTerminators(group)
@@ -195,10 +179,10 @@ func tunnelTerminatorDelete(unit int, group *fibcapi.L3UnicastGroup) {
 		return
 	}
 
-	if err := to4Tun.Delete(unit); err != nil {
+	if err := h.TunnelTerminatorDelete(to4Tun); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel terminator(to ipv4) delete error. %v %s", to4Tun, err)
 	}
-	if err := to6Tun.Delete(unit); err != nil {
+	if err := h.TunnelTerminatorDelete(to6Tun); err != nil {
 		log.Errorf("GroupMod(L3-UC) tunnel terminator(to ipv6) delete error. %v %s", to6Tun, err)
 	}
 }
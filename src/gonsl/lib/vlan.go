@@ -20,17 +20,16 @@ package gonslib
 import (
 	fibcapi "fabricflow/fibc/api"
 	"fmt"
+	hal "gonsl/hal"
 	"sync"
-
-	"github.com/beluganos/go-opennsl/opennsl"
 )
 
 type VlanPortKey struct {
-	Port opennsl.Port
-	Vid  opennsl.Vlan
+	Port hal.Port
+	Vid  hal.Vlan
 }
 
-func NewVlanPortKey(port opennsl.Port, vid opennsl.Vlan) *VlanPortKey {
+func NewVlanPortKey(port hal.Port, vid hal.Vlan) *VlanPortKey {
 	return &VlanPortKey{
 		Port: port,
 		Vid:  vid,
@@ -42,26 +41,26 @@ func (k *VlanPortKey) String() string {
 }
 
 type VlanPortEntry struct {
-	Vid opennsl.Vlan
+	Vid hal.Vlan
 }
 
-func NewVlanPortEntry(vid opennsl.Vlan) *VlanPortEntry {
+func NewVlanPortEntry(vid hal.Vlan) *VlanPortEntry {
 	return &VlanPortEntry{
 		Vid: vid,
 	}
 }
 
 func NewVlanPortEntryDefault() *VlanPortEntry {
-	return NewVlanPortEntry(opennsl.VLAN_ID_DEFAULT)
+	return NewVlanPortEntry(hal.VLAN_ID_DEFAULT)
 }
 
 type VlanPortTable struct {
 	entries    map[string]*VlanPortEntry
 	mutex      sync.RWMutex
-	minPort    opennsl.Port
-	maxPort    opennsl.Port
-	baseVid    opennsl.Vlan
-	defaultVid opennsl.Vlan
+	minPort    hal.Port
+	maxPort    hal.Port
+	baseVid    hal.Vlan
+	defaultVid hal.Vlan
 }
 
 func NewVlanPortTable() *VlanPortTable {
@@ -70,23 +69,23 @@ func NewVlanPortTable() *VlanPortTable {
 		minPort:    0,
 		maxPort:    0,
 		baseVid:    1,
-		defaultVid: opennsl.VLAN_ID_DEFAULT,
+		defaultVid: hal.VLAN_ID_DEFAULT,
 	}
 }
 
-func (t *VlanPortTable) SetMinPort(minPort opennsl.Port) {
+func (t *VlanPortTable) SetMinPort(minPort hal.Port) {
 	t.minPort = minPort
 }
 
-func (t *VlanPortTable) SetMaxPort(maxPort opennsl.Port) {
+func (t *VlanPortTable) SetMaxPort(maxPort hal.Port) {
 	t.maxPort = maxPort
 }
 
-func (t *VlanPortTable) SetBaseVID(vid opennsl.Vlan) {
+func (t *VlanPortTable) SetBaseVID(vid hal.Vlan) {
 	t.baseVid = vid
 }
 
-func (t *VlanPortTable) SetDefaultVID(vid opennsl.Vlan) {
+func (t *VlanPortTable) SetDefaultVID(vid hal.Vlan) {
 	t.defaultVid = vid
 }
 
@@ -112,7 +111,7 @@ func (t *VlanPortTable) Insert(key *VlanPortKey, entry *VlanPortEntry) bool {
 	return true
 }
 
-func (t *VlanPortTable) ConvVID(port opennsl.Port, vid opennsl.Vlan) opennsl.Vlan {
+func (t *VlanPortTable) ConvVID(port hal.Port, vid hal.Vlan) hal.Vlan {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
 
@@ -130,13 +129,13 @@ func (t *VlanPortTable) ConvVID(port opennsl.Port, vid opennsl.Vlan) opennsl.Vla
 	}
 
 	if port := key.Port; (port >= t.minPort) && (port <= t.maxPort) {
-		return opennsl.Vlan(port-t.minPort+1) + t.baseVid
+		return hal.Vlan(port-t.minPort+1) + t.baseVid
 	}
 
 	return t.defaultVid
 }
 
-func (t *VlanPortTable) Has(vid opennsl.Vlan) bool {
+func (t *VlanPortTable) Has(vid hal.Vlan) bool {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
 
@@ -144,7 +143,7 @@ func (t *VlanPortTable) Has(vid opennsl.Vlan) bool {
 		return false
 	}
 
-	if maxPort := opennsl.Port(vid-t.baseVid) + t.minPort - 1; maxPort > t.maxPort {
+	if maxPort := hal.Port(vid-t.baseVid) + t.minPort - 1; maxPort > t.maxPort {
 		return false
 	}
 
@@ -153,13 +152,13 @@ func (t *VlanPortTable) Has(vid opennsl.Vlan) bool {
 
 func NewVlanPortTableFromConfig(config *BlockBcastConfig) *VlanPortTable {
 	table := NewVlanPortTable()
-	table.SetBaseVID(opennsl.Vlan(config.Range.GetBaseVID()))
-	table.SetMinPort(opennsl.Port(config.Range.Min))
-	table.SetMaxPort(opennsl.Port(config.Range.Max))
+	table.SetBaseVID(hal.Vlan(config.Range.GetBaseVID()))
+	table.SetMinPort(hal.Port(config.Range.Min))
+	table.SetMaxPort(hal.Port(config.Range.Max))
 
 	for _, port := range config.Ports {
-		e := NewVlanPortEntry(opennsl.Vlan(port.PVid))
-		k := NewVlanPortKey(opennsl.Port(port.Port), opennsl.Vlan(port.Vid))
+		e := NewVlanPortEntry(hal.Vlan(port.PVid))
+		k := NewVlanPortKey(hal.Port(port.Port), hal.Vlan(port.Vid))
 		table.Insert(k, e)
 	}
 
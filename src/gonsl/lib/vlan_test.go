@@ -18,91 +18,90 @@
 package gonslib
 
 import (
+	hal "gonsl/hal"
 	"testing"
-
-	"github.com/beluganos/go-opennsl/opennsl"
 )
 
 func TestVlanPortTable_ConvVlan_no_change(t *testing.T) {
 	var (
-		vid opennsl.Vlan
+		vid hal.Vlan
 	)
 
-	defaultVid := opennsl.Vlan(2)
+	defaultVid := hal.Vlan(2)
 	tbl := NewVlanPortTable()
 	tbl.SetDefaultVID(defaultVid)
 
-	vid = tbl.ConvVID(opennsl.Port(50), opennsl.Vlan(2))
+	vid = tbl.ConvVID(hal.Port(50), hal.Vlan(2))
 	if vid != defaultVid {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(50), opennsl.Vlan(1))
-	if vid != opennsl.Vlan(1) {
+	vid = tbl.ConvVID(hal.Port(50), hal.Vlan(1))
+	if vid != hal.Vlan(1) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(50), opennsl.Vlan(3))
-	if vid != opennsl.Vlan(3) {
+	vid = tbl.ConvVID(hal.Port(50), hal.Vlan(3))
+	if vid != hal.Vlan(3) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 }
 
 func TestVlanPortTable_ConvVlan_minmax(t *testing.T) {
 	var (
-		vid opennsl.Vlan
+		vid hal.Vlan
 	)
 
 	tbl := NewVlanPortTable()
-	tbl.SetMinPort(opennsl.Port(50))
-	tbl.SetMaxPort(opennsl.Port(55))
+	tbl.SetMinPort(hal.Port(50))
+	tbl.SetMaxPort(hal.Port(55))
 
-	vid = tbl.ConvVID(opennsl.Port(49), opennsl.Vlan(1))
-	if vid != opennsl.Vlan(1) {
+	vid = tbl.ConvVID(hal.Port(49), hal.Vlan(1))
+	if vid != hal.Vlan(1) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(50), opennsl.Vlan(1))
-	if vid != opennsl.Vlan(2) {
+	vid = tbl.ConvVID(hal.Port(50), hal.Vlan(1))
+	if vid != hal.Vlan(2) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(55), opennsl.Vlan(1))
-	if vid != opennsl.Vlan(7) {
+	vid = tbl.ConvVID(hal.Port(55), hal.Vlan(1))
+	if vid != hal.Vlan(7) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(56), opennsl.Vlan(1))
-	if vid != opennsl.Vlan(1) {
+	vid = tbl.ConvVID(hal.Port(56), hal.Vlan(1))
+	if vid != hal.Vlan(1) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(49), opennsl.Vlan(2))
-	if vid != opennsl.Vlan(2) {
+	vid = tbl.ConvVID(hal.Port(49), hal.Vlan(2))
+	if vid != hal.Vlan(2) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(50), opennsl.Vlan(2))
-	if vid != opennsl.Vlan(2) {
+	vid = tbl.ConvVID(hal.Port(50), hal.Vlan(2))
+	if vid != hal.Vlan(2) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(55), opennsl.Vlan(2))
-	if vid != opennsl.Vlan(2) {
+	vid = tbl.ConvVID(hal.Port(55), hal.Vlan(2))
+	if vid != hal.Vlan(2) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 
-	vid = tbl.ConvVID(opennsl.Port(56), opennsl.Vlan(2))
-	if vid != opennsl.Vlan(2) {
+	vid = tbl.ConvVID(hal.Port(56), hal.Vlan(2))
+	if vid != hal.Vlan(2) {
 		t.Errorf("ConvVID unmatch. vid=%d", vid)
 	}
 }
 
 func TestVlanPortTableHasDefaultVIDBase(t *testing.T) {
 	tbl := NewVlanPortTable()
-	tbl.SetMinPort(opennsl.Port(50))
-	tbl.SetMaxPort(opennsl.Port(55))
-	tbl.SetBaseVID(opennsl.Vlan(1))
+	tbl.SetMinPort(hal.Port(50))
+	tbl.SetMaxPort(hal.Port(55))
+	tbl.SetBaseVID(hal.Vlan(1))
 
 	// port: 49 -> x
 	// port: 50 -> 2
@@ -130,9 +129,9 @@ func TestVlanPortTableHasDefaultVIDBase(t *testing.T) {
 
 func TestVlanPortTableHas(t *testing.T) {
 	tbl := NewVlanPortTable()
-	tbl.SetMinPort(opennsl.Port(50))
-	tbl.SetMaxPort(opennsl.Port(55))
-	tbl.SetBaseVID(opennsl.Vlan(4000))
+	tbl.SetMinPort(hal.Port(50))
+	tbl.SetMaxPort(hal.Port(55))
+	tbl.SetBaseVID(hal.Vlan(4000))
 
 	// port: 49 -> x
 	// port: 50 -> 4001
@@ -0,0 +1,258 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonslib
+
+import (
+	"fmt"
+	hal "gonsl/hal"
+	"net"
+	"sort"
+	"sync"
+)
+
+//
+// VxlanVpnEntry is vxlan vpn shared by tunnels of same vni.
+//
+type VxlanVpnEntry struct {
+	Vni   uint32
+	Vlan  hal.Vlan
+	VpnID hal.VxlanVpnID
+	Flood []uint32 // tun_port_id
+	Refs  int
+}
+
+//
+// VxlanTunnelEntry is tunnel (network port) to remote vtep.
+//
+type VxlanTunnelEntry struct {
+	TunPortID uint32
+	Vni       uint32
+	Port      *hal.VxlanPort
+	PortID    hal.VxlanPortID
+}
+
+//
+// NewVxlanTunnelBindKey returns new L3EgressBindKey of vxlan tunnel.
+// It keeps underlay l3 egress alive while tunnel refers it.
+//
+func NewVxlanTunnelBindKey(tunPortID uint32) L3EgressBindKey {
+	return L3EgressBindKey{
+		Host: false,
+		Dst:  fmt.Sprintf("vxlan:%d", tunPortID),
+	}
+}
+
+//
+// VxlanTable is table of vxlan vpns, tunnels and
+// l2 addresses of remote vteps.
+//
+type VxlanTable struct {
+	mutex   sync.Mutex
+	vpns    map[uint32]*VxlanVpnEntry
+	tunnels map[uint32]*VxlanTunnelEntry
+	l2addrs map[uint32]map[string]uint32 // vni -> mac -> tun_port_id
+}
+
+//
+// NewVxlanTable returns new instance.
+//
+func NewVxlanTable() *VxlanTable {
+	return &VxlanTable{
+		vpns:    map[uint32]*VxlanVpnEntry{},
+		tunnels: map[uint32]*VxlanTunnelEntry{},
+		l2addrs: map[uint32]map[string]uint32{},
+	}
+}
+
+//
+// Vpn returns copy of vpn entry.
+//
+func (t *VxlanTable) Vpn(vni uint32) (VxlanVpnEntry, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if v, ok := t.vpns[vni]; ok {
+		e := *v
+		e.Flood = append([]uint32{}, v.Flood...)
+		return e, true
+	}
+	return VxlanVpnEntry{}, false
+}
+
+//
+// RefVpn registers vpn (if new) and increments reference count.
+//
+func (t *VxlanTable) RefVpn(vni uint32, vlan hal.Vlan, vpnID hal.VxlanVpnID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	v, ok := t.vpns[vni]
+	if !ok {
+		v = &VxlanVpnEntry{
+			Vni:   vni,
+			Vlan:  vlan,
+			VpnID: vpnID,
+			Flood: []uint32{},
+		}
+		t.vpns[vni] = v
+	}
+	v.Refs++
+}
+
+//
+// UnrefVpn decrements reference count of vpn.
+// It returns true if vpn is no longer referred and removes it.
+//
+func (t *VxlanTable) UnrefVpn(vni uint32) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	v, ok := t.vpns[vni]
+	if !ok {
+		return false
+	}
+
+	if v.Refs--; v.Refs > 0 {
+		return false
+	}
+
+	delete(t.vpns, vni)
+	delete(t.l2addrs, vni)
+	return true
+}
+
+//
+// SetFlood replaces flood list of vpn.
+//
+func (t *VxlanTable) SetFlood(vni uint32, tunPortIDs []uint32) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	v, ok := t.vpns[vni]
+	if ok {
+		v.Flood = append([]uint32{}, tunPortIDs...)
+	}
+	return ok
+}
+
+//
+// FloodPorts returns network ports of flood list of vpn.
+// Tunnels not offloaded yet are skipped.
+//
+func (t *VxlanTable) FloodPorts(vni uint32) []hal.VxlanPortID {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	portIDs := []hal.VxlanPortID{}
+	if v, ok := t.vpns[vni]; ok {
+		for _, tunPortID := range v.Flood {
+			if tun, ok := t.tunnels[tunPortID]; ok && tun.Vni == vni {
+				portIDs = append(portIDs, tun.PortID)
+			}
+		}
+	}
+
+	sort.Slice(portIDs, func(i, j int) bool { return portIDs[i] < portIDs[j] })
+	return portIDs
+}
+
+//
+// AddTunnel registers tunnel.
+//
+func (t *VxlanTable) AddTunnel(tun *VxlanTunnelEntry) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.tunnels[tun.TunPortID] = tun
+}
+
+//
+// DeleteTunnel removes tunnel and returns it.
+//
+func (t *VxlanTable) DeleteTunnel(tunPortID uint32) (*VxlanTunnelEntry, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	tun, ok := t.tunnels[tunPortID]
+	if ok {
+		delete(t.tunnels, tunPortID)
+	}
+	return tun, ok
+}
+
+//
+// Tunnel returns tunnel.
+//
+func (t *VxlanTable) Tunnel(tunPortID uint32) (*VxlanTunnelEntry, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	tun, ok := t.tunnels[tunPortID]
+	return tun, ok
+}
+
+//
+// AddL2Addr registers l2 address of remote vtep.
+//
+func (t *VxlanTable) AddL2Addr(vni uint32, mac net.HardwareAddr, tunPortID uint32) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	macs, ok := t.l2addrs[vni]
+	if !ok {
+		macs = map[string]uint32{}
+		t.l2addrs[vni] = macs
+	}
+	macs[mac.String()] = tunPortID
+}
+
+//
+// DeleteL2Addr removes l2 address of remote vtep.
+//
+func (t *VxlanTable) DeleteL2Addr(vni uint32, mac net.HardwareAddr) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if macs, ok := t.l2addrs[vni]; ok {
+		delete(macs, mac.String())
+	}
+}
+
+//
+// L2Addrs returns l2 addresses via tunnel.
+//
+func (t *VxlanTable) L2Addrs(vni uint32, tunPortID uint32) []net.HardwareAddr {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	keys := []string{}
+	for key, id := range t.l2addrs[vni] {
+		if id == tunPortID {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	macs := make([]net.HardwareAddr, 0, len(keys))
+	for _, key := range keys {
+		if mac, err := net.ParseMAC(key); err == nil {
+			macs = append(macs, mac)
+		}
+	}
+	return macs
+}
@@ -32,6 +32,7 @@ type FIBCVsApiHandler interface {
 
 type FIBCVsApiClient struct {
 	Addr     string
+	Auth     *ffgrpc.ClientAuth
 	Listener FIBCVsApiHandler
 
 	conn   *grpc.ClientConn
@@ -42,7 +43,12 @@ type FIBCVsApiClient struct {
 }
 
 func (c *FIBCVsApiClient) init() error {
-	conn, connCh, err := ffgrpc.NewClientConn(c.Addr)
+	opts, err := c.Auth.DialOptions()
+	if err != nil {
+		return err
+	}
+
+	conn, connCh, err := ffgrpc.NewClientConn(c.Addr, opts...)
 	if err != nil {
 		return err
 	}
@@ -18,9 +18,11 @@
 package main
 
 import (
+	ffgrpc "fabricflow/util/grpc"
 	"fmt"
 	"govsw/pkgs/govsw"
 	"os"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
@@ -47,6 +49,13 @@ type Args struct {
 	ApiPort  uint16
 	FibcAddr string
 	FibcPort uint16
+	FibcHAs  []string
+
+	FibcTLSCA         string
+	FibcTLSCert       string
+	FibcTLSKey        string
+	FibcTLSServerName string
+	FibcToken         string
 
 	StripWritePkt uint16
 	StripReadPkt  uint16
@@ -63,6 +72,12 @@ func (a *Args) Parse() {
 	flag.Uint16VarP(&a.ApiPort, "api-port", "", ARGS_API_PORT, "api listen port.")
 	flag.StringVarP(&a.FibcAddr, "fibc-addr", "", ARGS_FIBC_ADDR, "fibcd address.")
 	flag.Uint16VarP(&a.FibcPort, "fibc-port", "", ARGS_FIBC_PORT, "fibcd port.")
+	flag.StringSliceVarP(&a.FibcHAs, "fibc-addrs", "", []string{}, "fibcd addresses (host:port,...). override fibc-addr/port.")
+	flag.StringVarP(&a.FibcTLSCA, "fibc-tls-ca", "", "", "CA file to verify fibcd certificate.")
+	flag.StringVarP(&a.FibcTLSCert, "fibc-tls-cert", "", "", "client certificate file.")
+	flag.StringVarP(&a.FibcTLSKey, "fibc-tls-key", "", "", "client private key file.")
+	flag.StringVarP(&a.FibcTLSServerName, "fibc-tls-server-name", "", "", "fibcd server name.")
+	flag.StringVarP(&a.FibcToken, "fibc-token", "", "", "token to connect fibcd.")
 	flag.Uint16VarP(&a.StripWritePkt, "strip-write-pkt", "", ARGS_STRIP_WPKT, "strip size for write packet.")
 	flag.Uint16VarP(&a.StripReadPkt, "strip-read-pkt", "", ARGS_STRIP_RPKT, "strip size for read packet.")
 	flag.BoolVarP(&a.Verbose, "verbose", "v", false, "show detail messages.")
@@ -81,9 +96,22 @@ func (a *Args) ApiListenAddr() string {
 }
 
 func (a *Args) FIBCListenAddr() string {
+	if len(a.FibcHAs) != 0 {
+		return strings.Join(a.FibcHAs, ",")
+	}
 	return fmt.Sprintf("%s:%d", a.FibcAddr, a.FibcPort)
 }
 
+func (a *Args) FIBCAuth() *ffgrpc.ClientAuth {
+	return &ffgrpc.ClientAuth{
+		CAFile:     a.FibcTLSCA,
+		CertFile:   a.FibcTLSCert,
+		KeyFile:    a.FibcTLSKey,
+		ServerName: a.FibcTLSServerName,
+		Token:      a.FibcToken,
+	}
+}
+
 func main() {
 	args := NewArgs()
 
@@ -126,6 +154,7 @@ func main() {
 	fibcsrv := FIBCVsApiClient{
 		Listener: app,
 		Addr:     args.FIBCListenAddr(),
+		Auth:     args.FIBCAuth(),
 	}
 	if err := fibcsrv.Start(done); err != nil {
 		log.Errorf("FIBCDpApiClient Start error. %s", err)